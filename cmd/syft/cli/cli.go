@@ -47,6 +47,9 @@ func create(id clio.Identification, out io.Writer) (clio.Application, *cobra.Com
 		commands.Cataloger(app),
 		commands.Attest(app),
 		commands.Convert(app),
+		commands.Daemon(app),
+		commands.Batch(app),
+		commands.VerifySBOM(app),
 		clio.VersionCommand(id),
 		clio.ConfigCommand(app, nil),
 		cranecmd.NewCmdAuthLogin(id.Name), // syft login uses the same command as crane
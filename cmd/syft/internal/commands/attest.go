@@ -119,7 +119,7 @@ func runAttest(ctx context.Context, id clio.Identification, opts *attestOptions,
 		return fmt.Errorf("unable to build SBOM: %w", err)
 	}
 
-	if err = writeSBOMToFormattedFile(s, f, opts); err != nil {
+	if err = writeSBOMToFormattedFile(ctx, s, f, opts); err != nil {
 		return fmt.Errorf("unable to write SBOM to file: %w", err)
 	}
 
@@ -132,12 +132,12 @@ func runAttest(ctx context.Context, id clio.Identification, opts *attestOptions,
 	return nil
 }
 
-func writeSBOMToFormattedFile(s *sbom.SBOM, sbomFile io.Writer, opts *attestOptions) error {
+func writeSBOMToFormattedFile(ctx context.Context, s *sbom.SBOM, sbomFile io.Writer, opts *attestOptions) error {
 	if sbomFile == nil {
 		return fmt.Errorf("no output file provided")
 	}
 
-	encs, err := opts.Format.Encoders()
+	encs, err := opts.Output.Encoders(ctx, opts.Registry.ToOptions())
 	if err != nil {
 		return fmt.Errorf("unable to create encoders: %w", err)
 	}
@@ -83,7 +83,7 @@ func Test_writeSBOMToFormattedFile(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			sbomFile := &bytes.Buffer{}
 
-			err := writeSBOMToFormattedFile(tt.args.s, sbomFile, tt.args.opts)
+			err := writeSBOMToFormattedFile(context.Background(), tt.args.s, sbomFile, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("writeSBOMToFormattedFile() error = %v, wantErr %v", err, tt.wantErr)
 				return
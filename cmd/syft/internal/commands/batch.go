@@ -0,0 +1,485 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/cmd/syft/internal/ui"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/format"
+)
+
+const (
+	batchExample = `  {{.appName}} {{.command}} -f targets.yaml                                   scan all targets, writing syft-json SBOMs to the current directory
+  {{.appName}} {{.command}} -f targets.yaml -o spdx-json --output-dir sboms   write SPDX JSON SBOMs to the "sboms" directory
+  {{.appName}} {{.command}} -f targets.yaml --max-concurrent-scans 4          scan up to 4 targets at the same time
+  {{.appName}} {{.command}} -f targets.yaml --summary json                    show the summary of all scans as JSON
+
+  The targets file lists the sources to scan (images, directories, archives, ...) with optional per-target settings:
+
+  targets:
+    - source: alpine:latest
+    - source: ./my-project
+      from: dir
+      name: my-project
+      version: 1.0.0
+    - source: ubuntu:22.04
+      platform: linux/arm64
+      output:
+        - spdx-json=ubuntu.spdx.json
+`
+
+	batchSummaryTable = "table"
+	batchSummaryJSON  = "json"
+)
+
+type batchOptions struct {
+	options.Config  `yaml:",inline" mapstructure:",squash"`
+	options.Output  `yaml:",inline" mapstructure:",squash"`
+	options.Catalog `yaml:",inline" mapstructure:",squash"`
+	Batch           batchConfig   `yaml:"batch" json:"batch" mapstructure:"batch"`
+	Cache           options.Cache `json:"-" yaml:"cache" mapstructure:"cache"`
+}
+
+type batchConfig struct {
+	File               string `yaml:"file" json:"file" mapstructure:"file"`
+	OutputDir          string `yaml:"output-dir" json:"output-dir" mapstructure:"output-dir"`
+	MaxConcurrentScans int    `yaml:"max-concurrent-scans" json:"max-concurrent-scans" mapstructure:"max-concurrent-scans"`
+	Summary            string `yaml:"summary" json:"summary" mapstructure:"summary"`
+}
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+	clio.FieldDescriber
+} = (*batchConfig)(nil)
+
+func defaultBatchOptions() *batchOptions {
+	out := options.DefaultOutput()
+	// outputs are written to a file per target, so only the format names may be given
+	out.AllowToFile = false
+	out.OutputFile.Enabled = false
+	out.Outputs = []string{"syft-json"}
+
+	return &batchOptions{
+		Output:  out,
+		Catalog: options.DefaultCatalog(),
+		Batch: batchConfig{
+			OutputDir:          ".",
+			MaxConcurrentScans: 1,
+			Summary:            batchSummaryTable,
+		},
+		Cache: options.DefaultCache(),
+	}
+}
+
+func (o *batchConfig) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.File, "file", "f",
+		"path to the file listing the targets to scan")
+
+	flags.StringVarP(&o.OutputDir, "output-dir", "",
+		"directory to write the SBOM of each target to")
+
+	flags.IntVarP(&o.MaxConcurrentScans, "max-concurrent-scans", "",
+		"maximum number of targets to scan at the same time")
+
+	flags.StringVarP(&o.Summary, "summary", "",
+		fmt.Sprintf("format of the summary of all scans (available: %s, %s)", batchSummaryTable, batchSummaryJSON))
+}
+
+func (o *batchConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.File, "path to the file listing the targets to scan")
+	descriptions.Add(&o.OutputDir, "directory to write the SBOM of each target to (targets with explicit output files are written relative to this directory)")
+	descriptions.Add(&o.MaxConcurrentScans, "maximum number of targets to scan at the same time")
+	descriptions.Add(&o.Summary, "format of the summary of all scans (available: table, json)")
+}
+
+func (o *batchConfig) PostLoad() error {
+	if o.MaxConcurrentScans < 1 {
+		o.MaxConcurrentScans = 1
+	}
+	switch o.Summary {
+	case batchSummaryTable, batchSummaryJSON:
+	case "":
+		o.Summary = batchSummaryTable
+	default:
+		return fmt.Errorf("unsupported summary format %q (available: %s, %s)", o.Summary, batchSummaryTable, batchSummaryJSON)
+	}
+	return nil
+}
+
+//nolint:dupl
+func Batch(app clio.Application) *cobra.Command {
+	id := app.ID()
+
+	opts := defaultBatchOptions()
+
+	return app.SetupCommand(&cobra.Command{
+		Use:   "batch -f TARGETS",
+		Short: "Generate SBOMs for multiple sources",
+		Long:  "[Experimental] Generate an SBOM for each source listed in a targets file, using a shared configuration",
+		Example: internal.Tprintf(batchExample, map[string]interface{}{
+			"appName": id.Name,
+			"command": "batch",
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			restoreStdout := ui.CaptureStdoutToTraceLog()
+			defer restoreStdout()
+
+			return runBatch(cmd.Context(), id, opts)
+		},
+	}, opts)
+}
+
+// batchManifest is the file listing all targets to scan.
+type batchManifest struct {
+	Targets []batchTarget `yaml:"targets"`
+}
+
+// batchTarget is a single source to scan, along with any settings that differ from the shared configuration.
+type batchTarget struct {
+	// Source is the user input describing what to scan (e.g. "alpine:latest", "dir:/path")
+	Source string `yaml:"source"`
+
+	// From is the source provider(s) to use (e.g. "docker", "registry", "oci-dir", comma separated)
+	From string `yaml:"from"`
+
+	// Platform is an optional platform specifier for container image sources
+	Platform string `yaml:"platform"`
+
+	// Name and Version set the name and version of the target being analyzed
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+
+	// Output is the list of "<format>=<file>" outputs to write (defaults to the shared output formats)
+	Output []string `yaml:"output"`
+}
+
+// batchResult is the outcome of scanning a single target.
+type batchResult struct {
+	Source   string   `json:"source"`
+	Name     string   `json:"name,omitempty"`
+	Status   string   `json:"status"`
+	Packages int      `json:"packages"`
+	Outputs  []string `json:"outputs"`
+	Error    string   `json:"error,omitempty"`
+}
+
+const (
+	batchStatusSuccess = "success"
+	batchStatusFailed  = "failed"
+)
+
+func runBatch(ctx context.Context, id clio.Identification, opts *batchOptions) error {
+	if opts.Batch.File == "" {
+		return fmt.Errorf("a targets file is required (--file)")
+	}
+
+	manifest, err := readBatchManifest(opts.Batch.File)
+	if err != nil {
+		return err
+	}
+
+	encoders, err := opts.Output.Encoders(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return err
+	}
+
+	outputs, err := batchTargetOutputs(manifest.Targets, opts.Outputs, opts.Batch.OutputDir, format.NewEncoderCollection(encoders...))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.Batch.OutputDir, 0755); err != nil {
+		return fmt.Errorf("unable to create output directory %q: %w", opts.Batch.OutputDir, err)
+	}
+
+	// the SBOM configuration (including compiled binary classifiers) is created once and shared by all scans
+	sbomConfig := opts.Catalog.ToSBOMConfig(id)
+
+	results := make([]batchResult, len(manifest.Targets))
+	slots := make(chan struct{}, opts.Batch.MaxConcurrentScans)
+	wg := sync.WaitGroup{}
+
+	for i, target := range manifest.Targets {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int, target batchTarget) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			results[i] = scanBatchTarget(ctx, opts, sbomConfig, target, outputs[i])
+		}(i, target)
+	}
+
+	wg.Wait()
+
+	report, err := renderBatchSummary(opts.Batch.Summary, results)
+	if err != nil {
+		return err
+	}
+	bus.Report(report)
+
+	var failed int
+	for _, r := range results {
+		if r.Status != batchStatusSuccess {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d targets failed to scan", failed, len(results))
+	}
+	return nil
+}
+
+func readBatchManifest(path string) (*batchManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open targets file: %w", err)
+	}
+	defer f.Close()
+
+	var manifest batchManifest
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse targets file %q: %w", path, err)
+	}
+
+	if len(manifest.Targets) == 0 {
+		return nil, fmt.Errorf("no targets found in %q", path)
+	}
+
+	for i, t := range manifest.Targets {
+		if strings.TrimSpace(t.Source) == "" {
+			return nil, fmt.Errorf("target %d in %q has no source", i+1, path)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// batchTargetOutputs returns the "<format>=<file>" outputs for each target. Targets without explicit outputs are
+// written to a file per shared output format, named after the target. All relative paths are within the output directory,
+// and no two outputs may be written to the same file (since targets are scanned concurrently).
+func batchTargetOutputs(targets []batchTarget, formats []string, outputDir string, encoders *format.EncoderCollection) ([][]string, error) {
+	ids := batchTargetIDs(targets)
+	written := make(map[string]string)
+
+	var all [][]string
+	for i, t := range targets {
+		var outputs []string
+		if len(t.Output) > 0 {
+			for _, o := range t.Output {
+				opt := options.ParseOutputOption(o)
+				if opt.File == "" {
+					return nil, fmt.Errorf("invalid output %q for target %q (expected '<format>=<file>')", o, t.Source)
+				}
+				if encoders.GetByString(opt.Name) == nil {
+					return nil, fmt.Errorf("unsupported output format %q for target %q", opt.Name, t.Source)
+				}
+				file, err := batchOutputFile(outputDir, opt.File)
+				if err != nil {
+					return nil, fmt.Errorf("invalid output %q for target %q: %w", o, t.Source, err)
+				}
+				opt.File = file
+				outputs = append(outputs, opt.String())
+			}
+		} else {
+			for _, name := range formats {
+				opt := options.ParseOutputOption(name)
+				enc := encoders.GetByString(opt.Name)
+				if enc == nil {
+					return nil, fmt.Errorf("unsupported output format %q", opt.Name)
+				}
+				opt.File = filepath.Join(outputDir, ids[i]+batchFileSuffix(enc.ID().String()))
+				outputs = append(outputs, opt.String())
+			}
+		}
+
+		for _, o := range outputs {
+			file, err := filepath.Abs(options.ParseOutputOption(o).File)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve output file for target %q: %w", t.Source, err)
+			}
+			if other, ok := written[file]; ok {
+				return nil, fmt.Errorf("output file %q of target %q is also written by target %q", file, t.Source, other)
+			}
+			written[file] = t.Source
+		}
+		all = append(all, outputs)
+	}
+	return all, nil
+}
+
+// batchOutputFile returns the cleaned path of an explicit output file, where relative paths are joined onto (and must
+// remain within) the output directory.
+func batchOutputFile(outputDir, file string) (string, error) {
+	if filepath.IsAbs(file) {
+		return filepath.Clean(file), nil
+	}
+	rel := filepath.Clean(file)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("relative output file must be within the output directory")
+	}
+	return filepath.Join(outputDir, rel), nil
+}
+
+// batchTargetIDs returns a unique, file name safe identifier for each target (based on the name or source).
+func batchTargetIDs(targets []batchTarget) []string {
+	assigned := make(map[string]bool)
+	var ids []string
+	for _, t := range targets {
+		id := t.Name
+		if id == "" {
+			id = t.Source
+		}
+		id = strings.Trim(unsafeFileNameChars.ReplaceAllString(id, "_"), "_.")
+		if id == "" {
+			id = "target"
+		}
+
+		// a generated suffix may itself collide with another target's ID (e.g. "a", "a", "a-2"), so always check
+		// against every ID assigned so far
+		unique := id
+		for n := 2; assigned[unique]; n++ {
+			unique = fmt.Sprintf("%s-%d", id, n)
+		}
+		assigned[unique] = true
+		ids = append(ids, unique)
+	}
+	return ids
+}
+
+// batchFileSuffix returns the file suffix for the given format (e.g. ".spdx.json" for "spdx-json").
+func batchFileSuffix(formatID string) string {
+	switch {
+	case strings.HasSuffix(formatID, "-json"):
+		return "." + strings.TrimSuffix(formatID, "-json") + ".json"
+	case strings.HasSuffix(formatID, "-xml"):
+		return "." + strings.TrimSuffix(formatID, "-xml") + ".xml"
+	case formatID == "spdx-tag-value":
+		return ".spdx"
+	default:
+		return "." + formatID + ".txt"
+	}
+}
+
+func scanBatchTarget(ctx context.Context, opts *batchOptions, sbomConfig *syft.CreateSBOMConfig, target batchTarget, outputs []string) batchResult {
+	result := batchResult{
+		Source: target.Source,
+		Name:   target.Name,
+		Status: batchStatusFailed,
+	}
+
+	fail := func(err error) batchResult {
+		log.WithFields("source", target.Source, "error", err).Debug("batch target failed")
+		result.Error = err.Error()
+		return result
+	}
+
+	// per-target source options are applied to a copy of the shared catalog configuration
+	catalog := opts.Catalog
+	if target.From != "" {
+		catalog.From = nil
+		for _, f := range strings.Split(target.From, ",") {
+			catalog.From = append(catalog.From, strings.TrimSpace(f))
+		}
+	}
+	if target.Platform != "" {
+		catalog.Platform = target.Platform
+	}
+	if target.Name != "" {
+		catalog.Source.Name = target.Name
+	}
+	if target.Version != "" {
+		catalog.Source.Version = target.Version
+	}
+
+	s, err := scanSource(ctx, &catalog, sbomConfig, target.Source)
+	if err != nil {
+		return fail(err)
+	}
+
+	// the writer is created only after a successful scan, since creating it truncates all output files
+	out := opts.Output
+	out.AllowToFile = true
+	out.Outputs = outputs
+	writer, err := out.SBOMWriter(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return fail(err)
+	}
+
+	if err := writer.Write(*s); err != nil {
+		return fail(fmt.Errorf("failed to write SBOM: %w", err))
+	}
+
+	for _, o := range outputs {
+		result.Outputs = append(result.Outputs, options.ParseOutputOption(o).File)
+	}
+	result.Packages = s.Artifacts.Packages.PackageCount()
+	result.Status = batchStatusSuccess
+	return result
+}
+
+func renderBatchSummary(summaryFormat string, results []batchResult) (string, error) {
+	if summaryFormat == batchSummaryJSON {
+		type document struct {
+			Targets []batchResult `json:"targets"`
+		}
+		for i := range results {
+			if results[i].Outputs == nil {
+				// ensure collections are not null
+				results[i].Outputs = []string{}
+			}
+		}
+		by, err := json.Marshal(document{Targets: results})
+		if err != nil {
+			return "", fmt.Errorf("unable to render batch summary: %w", err)
+		}
+		return string(by), nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"Source", "Status", "Packages", "Outputs"})
+
+	var errs []string
+	for _, r := range results {
+		source := r.Source
+		if r.Name != "" {
+			source = fmt.Sprintf("%s (%s)", r.Source, r.Name)
+		}
+		t.AppendRow(table.Row{source, r.Status, r.Packages, strings.Join(r.Outputs, "\n")})
+		if r.Error != "" {
+			errs = append(errs, fmt.Sprintf("  - %s: %s", r.Source, r.Error))
+		}
+	}
+
+	report := t.Render()
+	if len(errs) > 0 {
+		report += "\n\nErrors:\n" + strings.Join(errs, "\n")
+	}
+	return report, nil
+}
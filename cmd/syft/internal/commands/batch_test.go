@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/syftjson"
+)
+
+func Test_readBatchManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     []batchTarget
+		wantErr  string
+	}{
+		{
+			name: "valid targets",
+			contents: `targets:
+  - source: alpine:latest
+  - source: ./project
+    from: dir
+    name: project
+    version: 1.0.0
+    output:
+      - spdx-json=project.spdx.json
+`,
+			want: []batchTarget{
+				{Source: "alpine:latest"},
+				{Source: "./project", From: "dir", Name: "project", Version: "1.0.0", Output: []string{"spdx-json=project.spdx.json"}},
+			},
+		},
+		{
+			name:     "unknown field",
+			contents: "targets:\n  - source: alpine:latest\n    sources: dir\n",
+			wantErr:  "field sources not found",
+		},
+		{
+			name:     "missing source",
+			contents: "targets:\n  - name: alpine\n",
+			wantErr:  "target 1 in",
+		},
+		{
+			name:     "no targets",
+			contents: "targets: []\n",
+			wantErr:  "no targets found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "targets.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.contents), 0600))
+
+			got, err := readBatchManifest(path)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Targets)
+		})
+	}
+}
+
+func Test_batchTargetOutputs(t *testing.T) {
+	encoders, err := defaultBatchOptions().Format.Encoders()
+	require.NoError(t, err)
+	collection := format.NewEncoderCollection(encoders...)
+
+	targets := []batchTarget{
+		{Source: "alpine:latest"},
+		{Source: "registry:alpine:latest"},
+		{Source: "dir:./project", Name: "my project"},
+		{Source: "ubuntu:22.04", Output: []string{"spdx-tag-value=ubuntu.spdx", "json=/abs/ubuntu.json"}},
+		{Source: "alpine:latest"},
+	}
+
+	got, err := batchTargetOutputs(targets, []string{"syft-json", "cyclonedx"}, "out", collection)
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"syft-json=out/alpine_latest.syft.json", "cyclonedx=out/alpine_latest.cyclonedx.xml"},
+		{"syft-json=out/registry_alpine_latest.syft.json", "cyclonedx=out/registry_alpine_latest.cyclonedx.xml"},
+		{"syft-json=out/my_project.syft.json", "cyclonedx=out/my_project.cyclonedx.xml"},
+		{"spdx-tag-value=out/ubuntu.spdx", "json=/abs/ubuntu.json"},
+		{"syft-json=out/alpine_latest-2.syft.json", "cyclonedx=out/alpine_latest-2.cyclonedx.xml"},
+	}, got)
+
+	_, err = batchTargetOutputs([]batchTarget{{Source: "alpine", Output: []string{"bogus=file"}}}, nil, "out", collection)
+	require.ErrorContains(t, err, `unsupported output format "bogus"`)
+
+	_, err = batchTargetOutputs([]batchTarget{{Source: "alpine", Output: []string{"syft-json"}}}, nil, "out", collection)
+	require.ErrorContains(t, err, "expected '<format>=<file>'")
+
+	_, err = batchTargetOutputs([]batchTarget{{Source: "alpine", Output: []string{"syft-json=../alpine.json"}}}, nil, "out", collection)
+	require.ErrorContains(t, err, "must be within the output directory")
+
+	_, err = batchTargetOutputs([]batchTarget{{Source: "alpine", Output: []string{"syft-json=sub/../../alpine.json"}}}, nil, "out", collection)
+	require.ErrorContains(t, err, "must be within the output directory")
+
+	// explicit outputs may not collide with each other...
+	_, err = batchTargetOutputs([]batchTarget{
+		{Source: "alpine", Output: []string{"syft-json=sbom.json"}},
+		{Source: "ubuntu", Output: []string{"spdx-json=./sbom.json"}},
+	}, nil, "out", collection)
+	require.ErrorContains(t, err, `is also written by target "alpine"`)
+
+	// ...or with the generated outputs of other targets
+	_, err = batchTargetOutputs([]batchTarget{
+		{Source: "alpine"},
+		{Source: "ubuntu", Output: []string{"syft-json=alpine.syft.json"}},
+	}, []string{"syft-json"}, "out", collection)
+	require.ErrorContains(t, err, `is also written by target "alpine"`)
+}
+
+func Test_batchTargetIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []batchTarget
+		want    []string
+	}{
+		{
+			name:    "duplicates are suffixed",
+			targets: []batchTarget{{Source: "a"}, {Source: "a"}, {Source: "a"}},
+			want:    []string{"a", "a-2", "a-3"},
+		},
+		{
+			name:    "suffixed ID collides with a later target",
+			targets: []batchTarget{{Source: "a"}, {Source: "a"}, {Source: "a-2"}},
+			want:    []string{"a", "a-2", "a-2-2"},
+		},
+		{
+			name:    "suffixed ID collides with an earlier target",
+			targets: []batchTarget{{Source: "a-2"}, {Source: "a"}, {Source: "a"}},
+			want:    []string{"a-2", "a", "a-3"},
+		},
+		{
+			name:    "name takes precedence and unsafe characters are replaced",
+			targets: []batchTarget{{Source: "alpine:latest"}, {Source: "dir:.", Name: "alpine_latest"}, {Source: "..."}},
+			want:    []string{"alpine_latest", "alpine_latest-2", "target"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, batchTargetIDs(tt.targets))
+		})
+	}
+}
+
+func Test_runBatch(t *testing.T) {
+	project := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(project, "requirements.txt"), []byte("requests==2.31.0\n"), 0600))
+
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "targets.yaml")
+	require.NoError(t, os.WriteFile(manifest, []byte(`targets:
+  - source: dir:`+project+`
+    name: project
+  - source: `+project+`
+    from: dir
+    name: other
+    output:
+      - syft-json=nested/other.json
+  - source: dir:`+filepath.Join(dir, "does-not-exist")+`
+`), 0600))
+
+	out := filepath.Join(dir, "sboms")
+	opts := defaultBatchOptions()
+	opts.Batch.File = manifest
+	opts.Batch.OutputDir = out
+	opts.Batch.MaxConcurrentScans = 2
+
+	err := runBatch(context.Background(), clio.Identification{Name: "syft", Version: "test"}, opts)
+	require.ErrorContains(t, err, "1 of 3 targets failed to scan")
+
+	for _, path := range []string{"project.syft.json", "nested/other.json"} {
+		f, err := os.Open(filepath.Join(out, path))
+		require.NoError(t, err)
+
+		s, id, _, err := format.Decode(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+		assert.Equal(t, syftjson.ID, id)
+		assert.Equal(t, 1, s.Artifacts.Packages.PackageCount())
+	}
+
+	// no output is written for targets that failed
+	entries, err := os.ReadDir(out)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
+
+func Test_renderBatchSummary(t *testing.T) {
+	results := []batchResult{
+		{Source: "alpine:latest", Status: batchStatusSuccess, Packages: 14, Outputs: []string{"alpine_latest.syft.json"}},
+		{Source: "dir:missing", Name: "missing", Status: batchStatusFailed, Error: "not found"},
+	}
+
+	got, err := renderBatchSummary(batchSummaryJSON, results)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"targets": [
+		{"source": "alpine:latest", "status": "success", "packages": 14, "outputs": ["alpine_latest.syft.json"]},
+		{"source": "dir:missing", "name": "missing", "status": "failed", "packages": 0, "outputs": [], "error": "not found"}
+	]}`, got)
+
+	got, err = renderBatchSummary(batchSummaryTable, results)
+	require.NoError(t, err)
+	assert.Contains(t, got, "alpine_latest.syft.json")
+	assert.Contains(t, got, "dir:missing (missing)")
+	assert.Contains(t, got, "Errors:\n  - dir:missing: not found")
+}
@@ -18,10 +18,11 @@ import (
 )
 
 type catalogerListOptions struct {
-	Output            string   `yaml:"output" json:"output" mapstructure:"output"`
-	DefaultCatalogers []string `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
-	SelectCatalogers  []string `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
-	ShowHidden        bool     `yaml:"show-hidden" json:"show-hidden" mapstructure:"show-hidden"`
+	Output            string              `yaml:"output" json:"output" mapstructure:"output"`
+	DefaultCatalogers []string            `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
+	SelectCatalogers  []string            `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
+	CatalogerTags     map[string][]string `yaml:"cataloger-tags" json:"cataloger-tags" mapstructure:"cataloger-tags"`
+	ShowHidden        bool                `yaml:"show-hidden" json:"show-hidden" mapstructure:"show-hidden"`
 }
 
 func (o *catalogerListOptions) AddFlags(flags clio.FlagSet) {
@@ -70,11 +71,15 @@ func runCatalogerList(opts *catalogerListOptions) error {
 }
 
 func catalogerListReport(opts *catalogerListOptions, allTasks []task.Task) (string, error) {
-	selectedTasks, selectionEvidence, err := task.Select(allTasks,
-		pkgcataloging.NewSelectionRequest().
-			WithDefaults(opts.DefaultCatalogers...).
-			WithExpression(opts.SelectCatalogers...),
-	)
+	selection := pkgcataloging.NewSelectionRequest().
+		WithDefaults(opts.DefaultCatalogers...).
+		WithExpression(opts.SelectCatalogers...)
+
+	for tag, nameOrTags := range opts.CatalogerTags {
+		selection = selection.WithTags(tag, nameOrTags...)
+	}
+
+	selectedTasks, selectionEvidence, err := task.Select(allTasks, selection)
 	if err != nil {
 		return "", fmt.Errorf("unable to select catalogers: %w", err)
 	}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -13,6 +14,7 @@ import (
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
 )
 
 const (
@@ -47,11 +49,11 @@ func Convert(app clio.Application) *cobra.Command {
 		}),
 		Args:    validateConvertArgs,
 		PreRunE: applicationUpdateCheck(id, &opts.UpdateCheck),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			restoreStdout := ui.CaptureStdoutToTraceLog()
 			defer restoreStdout()
 
-			return RunConvert(opts, args[0])
+			return RunConvert(cmd.Context(), opts, args[0])
 		},
 	}, opts)
 }
@@ -60,14 +62,28 @@ func validateConvertArgs(cmd *cobra.Command, args []string) error {
 	return validateArgs(cmd, args, "an SBOM argument is required")
 }
 
-func RunConvert(opts *ConvertOptions, userInput string) error {
+func RunConvert(ctx context.Context, opts *ConvertOptions, userInput string) error {
 	log.Warn("convert is an experimental feature, run `syft convert -h` for help")
 
-	writer, err := opts.SBOMWriter()
+	writer, err := opts.SBOMWriter(ctx, nil)
 	if err != nil {
 		return err
 	}
 
+	s, err := decodeSBOM(userInput)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Write(*s); err != nil {
+		return fmt.Errorf("failed to write SBOM: %w", err)
+	}
+
+	return nil
+}
+
+// decodeSBOM reads an SBOM in any supported format from the given file (or from STDIN when the input is "-").
+func decodeSBOM(userInput string) (*sbom.SBOM, error) {
 	var reader io.ReadSeekCloser
 
 	if userInput == "-" {
@@ -78,7 +94,7 @@ func RunConvert(opts *ConvertOptions, userInput string) error {
 	} else {
 		f, err := os.Open(userInput)
 		if err != nil {
-			return fmt.Errorf("failed to open SBOM file: %w", err)
+			return nil, fmt.Errorf("failed to open SBOM file: %w", err)
 		}
 		defer func() {
 			_ = f.Close()
@@ -88,16 +104,12 @@ func RunConvert(opts *ConvertOptions, userInput string) error {
 
 	s, _, _, err := format.Decode(reader)
 	if err != nil {
-		return fmt.Errorf("failed to decode SBOM: %w", err)
+		return nil, fmt.Errorf("failed to decode SBOM: %w", err)
 	}
 
 	if s == nil {
-		return fmt.Errorf("no SBOM produced")
-	}
-
-	if err := writer.Write(*s); err != nil {
-		return fmt.Errorf("failed to write SBOM: %w", err)
+		return nil, fmt.Errorf("no SBOM produced")
 	}
 
-	return nil
+	return s, nil
 }
@@ -0,0 +1,449 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/cmd/syft/internal/ui"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/cyclonedxxml"
+	"github.com/anchore/syft/syft/format/github"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/format/spdxtagvalue"
+	"github.com/anchore/syft/syft/format/syftdelta"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+const (
+	daemonExample = `  {{.appName}} {{.command}}                                   listen for scan requests on the default socket
+  {{.appName}} {{.command}} --socket /tmp/syft.sock           listen for scan requests on the given socket
+  {{.appName}} {{.command}} -o spdx-json                      use spdx-json for requests that do not specify an output format
+
+  Requests are made over HTTP on the socket, for example:
+
+  curl --unix-socket /tmp/syft.sock http://localhost/scan -d '{"source": "alpine:latest", "output": "syft-json"}'
+`
+
+	daemonShutdownTimeout = 10 * time.Second
+
+	// daemonMaxRequestSize is the largest scan request body accepted (requests only describe what to scan)
+	daemonMaxRequestSize = 1 << 20
+)
+
+// daemonContentTypes are the media types of the SBOM formats that the daemon may respond with (the content type of any
+// other format is detected from the response)
+var daemonContentTypes = map[sbom.FormatID]string{
+	syftjson.ID:      "application/vnd.syft+json",
+	spdxjson.ID:      "application/spdx+json",
+	spdxtagvalue.ID:  "text/spdx",
+	cyclonedxjson.ID: "application/vnd.cyclonedx+json",
+	cyclonedxxml.ID:  "application/vnd.cyclonedx+xml",
+	github.ID:        "application/json",
+	syftdelta.ID:     "application/json",
+}
+
+type daemonOptions struct {
+	options.Config  `yaml:",inline" mapstructure:",squash"`
+	options.Output  `yaml:",inline" mapstructure:",squash"`
+	options.Catalog `yaml:",inline" mapstructure:",squash"`
+	Daemon          daemonConfig  `yaml:"daemon" json:"daemon" mapstructure:"daemon"`
+	Cache           options.Cache `json:"-" yaml:"cache" mapstructure:"cache"`
+}
+
+type daemonConfig struct {
+	Socket             string `yaml:"socket" json:"socket" mapstructure:"socket"`
+	MaxConcurrentScans int    `yaml:"max-concurrent-scans" json:"max-concurrent-scans" mapstructure:"max-concurrent-scans"`
+}
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+	clio.FieldDescriber
+} = (*daemonConfig)(nil)
+
+func defaultDaemonOptions(name string) *daemonOptions {
+	out := options.DefaultOutput()
+	out.AllowMultipleOutputs = false
+	out.AllowToFile = false
+	out.OutputFile.Enabled = false
+	out.Outputs = []string{"syft-json"}
+
+	return &daemonOptions{
+		Output:  out,
+		Catalog: options.DefaultCatalog(),
+		Daemon: daemonConfig{
+			Socket:             filepath.Join(xdg.RuntimeDir, name+".sock"),
+			MaxConcurrentScans: 1,
+		},
+		Cache: options.DefaultCache(),
+	}
+}
+
+func (o *daemonConfig) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.Socket, "socket", "",
+		"path to the unix socket to listen on for scan requests")
+}
+
+func (o *daemonConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.Socket, "path to the unix socket to listen on for scan requests")
+	descriptions.Add(&o.MaxConcurrentScans, "maximum number of scans to run at the same time (additional requests wait for a free slot)")
+}
+
+func (o *daemonConfig) PostLoad() error {
+	if o.Socket == "" {
+		return fmt.Errorf("a socket path is required")
+	}
+	if o.MaxConcurrentScans < 1 {
+		o.MaxConcurrentScans = 1
+	}
+	return nil
+}
+
+func Daemon(app clio.Application) *cobra.Command {
+	id := app.ID()
+
+	opts := defaultDaemonOptions(id.Name)
+
+	return app.SetupCommand(&cobra.Command{
+		Use:   "daemon",
+		Short: "Serve scan requests over a local socket",
+		Long:  "[Experimental] Run a long-lived process that accepts scan requests over a unix socket, keeping configuration, caches, and cataloger matchers warm between scans",
+		Example: internal.Tprintf(daemonExample, map[string]interface{}{
+			"appName": id.Name,
+			"command": "daemon",
+		}),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			restoreStdout := ui.CaptureStdoutToTraceLog()
+			defer restoreStdout()
+
+			return runDaemon(cmd.Context(), id, opts)
+		},
+	}, opts)
+}
+
+func runDaemon(ctx context.Context, id clio.Identification, opts *daemonOptions) error {
+	server, err := newDaemonServer(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+
+	listener, err := listenOnSocket(opts.Daemon.Socket)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields("socket", opts.Daemon.Socket).Info("listening for scan requests")
+
+	return server.serve(ctx, listener)
+}
+
+// listenOnSocket creates a unix socket listener at the given path, removing any stale socket left behind by a
+// previous process (but refusing to take over a socket that is still in use).
+func listenOnSocket(path string) (net.Listener, error) {
+	if _, err := os.Stat(path); err == nil {
+		if conn, err := net.Dial("unix", path); err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("socket %q is already in use", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("unable to remove stale socket %q: %w", path, err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("unable to create socket directory: %w", err)
+	}
+
+	// the socket is created within a private directory and only moved into place once only the current user is able to
+	// request scans, so that other users are never able to connect to it (regardless of the umask)
+	privateDir, err := os.MkdirTemp(filepath.Dir(path), ".syft-daemon-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create socket directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(privateDir); err != nil {
+			log.WithFields("path", privateDir, "error", err).Debug("unable to remove socket directory")
+		}
+	}()
+
+	privatePath := filepath.Join(privateDir, "socket")
+	listener, err := net.Listen("unix", privatePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on socket %q: %w", path, err)
+	}
+	// the socket is removed from its final location when closed instead (see socketListener)
+	if l, ok := listener.(*net.UnixListener); ok {
+		l.SetUnlinkOnClose(false)
+	}
+
+	if err := os.Chmod(privatePath, 0600); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("unable to set socket permissions: %w", err)
+	}
+
+	if err := os.Rename(privatePath, path); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("unable to move socket to %q: %w", path, err)
+	}
+
+	return socketListener{Listener: listener, path: path}, nil
+}
+
+// socketListener is a unix socket listener which removes the socket when closed.
+type socketListener struct {
+	net.Listener
+	path string
+}
+
+func (l socketListener) Close() error {
+	err := l.Listener.Close()
+	if rmErr := os.Remove(l.path); rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) {
+		log.WithFields("socket", l.path, "error", rmErr).Debug("unable to remove socket")
+	}
+	return err
+}
+
+// daemonScanRequest is the body of a scan request made to the daemon.
+type daemonScanRequest struct {
+	// Source is the user input describing what to scan (e.g. "alpine:latest", "dir:/path")
+	Source string `json:"source"`
+
+	// From is the set of source providers to use (e.g. docker, registry, oci-dir, ...)
+	From []string `json:"from,omitempty"`
+
+	// Output is the SBOM format to respond with (defaults to the daemon's configured output format)
+	Output string `json:"output,omitempty"`
+
+	// Platform is an optional platform specifier for container image sources
+	Platform string `json:"platform,omitempty"`
+
+	// Name and Version set the name and version of the target being analyzed
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type daemonErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// daemonServer holds all state that is kept warm between scan requests.
+type daemonServer struct {
+	catalog       options.Catalog
+	sbomConfig    *syft.CreateSBOMConfig
+	encoders      *format.EncoderCollection
+	defaultOutput string
+	slots         chan struct{}
+}
+
+func newDaemonServer(ctx context.Context, id clio.Identification, opts *daemonOptions) (*daemonServer, error) {
+	encoders, err := opts.Output.Encoders(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	defaultOutput := ""
+	if len(opts.Outputs) > 0 {
+		defaultOutput = opts.Outputs[0]
+	}
+
+	collection := format.NewEncoderCollection(encoders...)
+	if collection.GetByString(defaultOutput) == nil {
+		return nil, fmt.Errorf("unsupported output format %q", defaultOutput)
+	}
+
+	return &daemonServer{
+		catalog: opts.Catalog,
+		// the SBOM configuration (including compiled binary classifiers) is created once and shared by all scans
+		sbomConfig:    opts.Catalog.ToSBOMConfig(id),
+		encoders:      collection,
+		defaultOutput: defaultOutput,
+		slots:         make(chan struct{}, opts.Daemon.MaxConcurrentScans),
+	}, nil
+}
+
+func (d *daemonServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", d.handleHealth)
+	mux.HandleFunc("/scan", d.handleScan)
+	return mux
+}
+
+func (d *daemonServer) serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{
+		Handler:           d.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errs:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info("shutting down daemon")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), daemonShutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+func (d *daemonServer) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+func (d *daemonServer) handleScan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeDaemonError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	var req daemonScanRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, daemonMaxRequestSize)).Decode(&req); err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeDaemonError(w, status, fmt.Errorf("invalid scan request: %w", err))
+		return
+	}
+
+	if req.Source == "" {
+		writeDaemonError(w, http.StatusBadRequest, fmt.Errorf("a source is required"))
+		return
+	}
+
+	output := req.Output
+	if output == "" {
+		output = d.defaultOutput
+	}
+
+	encoder := d.encoders.GetByString(output)
+	if encoder == nil {
+		writeDaemonError(w, http.StatusBadRequest, fmt.Errorf("unsupported output format %q", output))
+		return
+	}
+
+	ctx := r.Context()
+
+	// wait for a free scan slot (or for the client to give up)
+	select {
+	case d.slots <- struct{}{}:
+		defer func() { <-d.slots }()
+	case <-ctx.Done():
+		writeDaemonError(w, http.StatusServiceUnavailable, ctx.Err())
+		return
+	}
+
+	s, err := d.scan(ctx, req)
+	if err != nil {
+		writeDaemonError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	contents, err := format.Encode(*s, encoder)
+	if err != nil {
+		writeDaemonError(w, http.StatusInternalServerError, fmt.Errorf("failed to encode SBOM: %w", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", daemonContentType(encoder.ID(), contents))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(contents); err != nil {
+		log.WithFields("error", err).Debug("unable to write scan response")
+	}
+}
+
+func (d *daemonServer) scan(ctx context.Context, req daemonScanRequest) (*sbom.SBOM, error) {
+	// per-request source options are applied to a copy of the daemon's catalog configuration
+	catalog := d.catalog
+	if len(req.From) > 0 {
+		catalog.From = req.From
+	}
+	if req.Platform != "" {
+		catalog.Platform = req.Platform
+	}
+	if req.Name != "" {
+		catalog.Source.Name = req.Name
+	}
+	if req.Version != "" {
+		catalog.Source.Version = req.Version
+	}
+
+	return scanSource(ctx, &catalog, d.sbomConfig, req.Source)
+}
+
+// scanSource creates an SBOM for the given user input, using a (possibly shared) SBOM configuration.
+func scanSource(ctx context.Context, catalog *options.Catalog, sbomConfig *syft.CreateSBOMConfig, userInput string) (*sbom.SBOM, error) {
+	sources, userInput := resolveSources(catalog.From, userInput)
+
+	src, err := getSource(ctx, catalog, userInput, sources...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Tracef("unable to close source: %+v", err)
+		}
+	}()
+
+	s, err := syft.CreateSBOM(ctx, src, sbomConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		return nil, fmt.Errorf("no SBOM produced for %q", userInput)
+	}
+
+	return s, nil
+}
+
+func writeDaemonError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if encErr := json.NewEncoder(w).Encode(daemonErrorResponse{Error: err.Error()}); encErr != nil {
+		log.WithFields("error", encErr).Debug("unable to write error response")
+	}
+}
+
+// daemonContentType returns the media type of an SBOM encoded with the given format.
+func daemonContentType(id sbom.FormatID, contents []byte) string {
+	if contentType, ok := daemonContentTypes[id]; ok {
+		return contentType
+	}
+	return http.DetectContentType(contents)
+}
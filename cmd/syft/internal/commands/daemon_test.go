@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/format/table"
+)
+
+func newTestDaemonServer(t *testing.T) *daemonServer {
+	t.Helper()
+	opts := defaultDaemonOptions("syft")
+	server, err := newDaemonServer(context.Background(), clio.Identification{Name: "syft", Version: "test"}, opts)
+	require.NoError(t, err)
+	return server
+}
+
+func Test_daemonServer_handleScan(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte("requests==2.31.0\n"), 0600))
+
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "scan directory",
+			method:     http.MethodPost,
+			body:       `{"source": "dir:` + dir + `", "name": "my-project"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing source",
+			method:     http.MethodPost,
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "a source is required",
+		},
+		{
+			name:       "unsupported output",
+			method:     http.MethodPost,
+			body:       `{"source": "dir:` + dir + `", "output": "bogus"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  `unsupported output format "bogus"`,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPost,
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid scan request",
+		},
+		{
+			name:       "request too large",
+			method:     http.MethodPost,
+			body:       `{"source": "` + strings.Repeat("a", daemonMaxRequestSize) + `"}`,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantError:  "invalid scan request",
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantError:  "method GET not allowed",
+		},
+	}
+
+	server := newTestDaemonServer(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/scan", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+
+			server.handler().ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantError != "" {
+				var resp daemonErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Contains(t, resp.Error, tt.wantError)
+				return
+			}
+
+			assert.Equal(t, "application/vnd.syft+json", rec.Header().Get("Content-Type"))
+
+			s, id, _, err := format.Decode(bytes.NewReader(rec.Body.Bytes()))
+			require.NoError(t, err)
+			assert.Equal(t, syftjson.ID, id)
+			assert.Equal(t, "my-project", s.Source.Name)
+			assert.Equal(t, 1, s.Artifacts.Packages.PackageCount())
+		})
+	}
+}
+
+func Test_daemonServer_serve(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "syft.sock")
+
+	listener, err := listenOnSocket(socket)
+	require.NoError(t, err)
+
+	info, err := os.Stat(socket)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the socket is created privately and then moved into place, leaving nothing else behind
+	entries, err := os.ReadDir(filepath.Dir(socket))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// a socket that is in use must not be taken over
+	_, err = listenOnSocket(socket)
+	require.ErrorContains(t, err, "already in use")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- newTestDaemonServer(t).serve(ctx, listener)
+	}()
+
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+
+	resp, err := client.Get("http://localhost/health")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	// the socket is removed once the daemon stops listening
+	assert.NoFileExists(t, socket)
+}
+
+func Test_daemonContentType(t *testing.T) {
+	assert.Equal(t, "application/spdx+json", daemonContentType(spdxjson.ID, []byte("{}")))
+	assert.Equal(t, "text/plain; charset=utf-8", daemonContentType(table.ID, []byte("NAME  VERSION  TYPE\n")))
+}
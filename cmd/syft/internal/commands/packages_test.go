@@ -58,7 +58,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			err: func() error {
 				var err error
 				err = multierror.Append(err, errors.New("foo"))
-				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed})
+				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed})
 				err = multierror.Append(err, errors.New("bar"))
 				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed})
 				err = multierror.Append(err, errors.New("last"))
@@ -67,7 +67,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				expected := `5 errors occurred:
 	* foo
-	* invalid expression: "foo": tags are not allowed with this operation (must use exact names)
+	* invalid expression: "+all": cannot use the 'all' operand in this context
 	* bar
 	* invalid expression: "bar": names are not allowed with this operation (must use tags)
 	* last
@@ -76,15 +76,15 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 				return assert.Equal(t, expected, err.Error())
 			},
 			wantExpErrs: []task.ErrInvalidExpression{
-				{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed},
+				{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed},
 				{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed},
 			},
 			wantHelp: `Suggestions:
 
- ❖ Given expression "--select-catalogers foo"
-   However, tags are not allowed with this operation (must use exact names).
-   Adding groups of catalogers may result in surprising behavior (create inaccurate SBOMs).
-   If you are certain this is what you want to do, use "--override-default-catalogers foo" instead.
+ ❖ Given expression "--select-catalogers +all"
+   However, you cannot use the 'all' operand in this context.
+   It seems like you are intending to use all catalogers (which is not recommended).
+   ... Did you mean "--override-default-catalogers all" instead?
 
  ❖ Given expression "--select-catalogers bar"
    However, names are not allowed with this operation (must use tags).
@@ -97,7 +97,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			err: func() error {
 				var err error
 				err = multierror.Append(err, fmt.Errorf("foo: %w", fmt.Errorf("bar: %w", errors.New("last"))))
-				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed})
+				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed})
 				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed})
 				err = multierror.Append(err, errors.New("bottom"))
 
@@ -106,7 +106,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				expected := `top: middle: 4 errors occurred:
 	* foo: bar: last
-	* invalid expression: "foo": tags are not allowed with this operation (must use exact names)
+	* invalid expression: "+all": cannot use the 'all' operand in this context
 	* invalid expression: "bar": names are not allowed with this operation (must use tags)
 	* bottom
 
@@ -114,15 +114,15 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 				return assert.Equal(t, expected, err.Error())
 			},
 			wantExpErrs: []task.ErrInvalidExpression{
-				{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed},
+				{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed},
 				{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed},
 			},
 			wantHelp: `Suggestions:
 
- ❖ Given expression "--select-catalogers foo"
-   However, tags are not allowed with this operation (must use exact names).
-   Adding groups of catalogers may result in surprising behavior (create inaccurate SBOMs).
-   If you are certain this is what you want to do, use "--override-default-catalogers foo" instead.
+ ❖ Given expression "--select-catalogers +all"
+   However, you cannot use the 'all' operand in this context.
+   It seems like you are intending to use all catalogers (which is not recommended).
+   ... Did you mean "--override-default-catalogers all" instead?
 
  ❖ Given expression "--select-catalogers bar"
    However, names are not allowed with this operation (must use tags).
@@ -135,7 +135,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			err: func() error {
 				var err error
 				err = multierror.Append(err, fmt.Errorf("foo: %w", fmt.Errorf("bar: %w", errors.New("last"))))
-				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed})
+				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed})
 				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed})
 				err = multierror.Append(err, errors.New("bottom"))
 
@@ -145,7 +145,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				expected := `top: middle: 4 errors occurred:
 	* foo: bar: last
-	* invalid expression: "foo": tags are not allowed with this operation (must use exact names)
+	* invalid expression: "+all": cannot use the 'all' operand in this context
 	* invalid expression: "bar": names are not allowed with this operation (must use tags)
 	* bottom
 
@@ -153,15 +153,15 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 				return assert.Equal(t, expected, err.Error())
 			},
 			wantExpErrs: []task.ErrInvalidExpression{
-				{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed},
+				{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed},
 				{Expression: "bar", Operation: task.SubSelectOperation, Err: task.ErrNamesNotAllowed},
 			},
 			wantHelp: `Suggestions:
 
- ❖ Given expression "--select-catalogers foo"
-   However, tags are not allowed with this operation (must use exact names).
-   Adding groups of catalogers may result in surprising behavior (create inaccurate SBOMs).
-   If you are certain this is what you want to do, use "--override-default-catalogers foo" instead.
+ ❖ Given expression "--select-catalogers +all"
+   However, you cannot use the 'all' operand in this context.
+   It seems like you are intending to use all catalogers (which is not recommended).
+   ... Did you mean "--override-default-catalogers all" instead?
 
  ❖ Given expression "--select-catalogers bar"
    However, names are not allowed with this operation (must use tags).
@@ -173,7 +173,7 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			name: "preserve for any errors within ErrInvalidExpression types",
 			err: func() error {
 				var err error
-				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed})
+				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed})
 				err = multierror.Append(err, task.ErrInvalidExpression{Expression: "bar", Operation: task.SubSelectOperation, Err: errors.New("explanation")}) // this is what makes this test different...
 
 				return err
@@ -181,22 +181,22 @@ func Test_filterExpressionErrors_expressionErrorsHelp(t *testing.T) {
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				// note: the errors are removed and the help text shows the enriched error help
 				expected := `2 errors occurred:
-	* invalid expression: "foo": tags are not allowed with this operation (must use exact names)
+	* invalid expression: "+all": cannot use the 'all' operand in this context
 	* invalid expression: "bar": explanation
 
 `
 				return assert.Equal(t, expected, err.Error())
 			},
 			wantExpErrs: []task.ErrInvalidExpression{
-				{Expression: "foo", Operation: task.AddOperation, Err: task.ErrTagsNotAllowed},
+				{Expression: "+all", Operation: task.AddOperation, Err: task.ErrAllNotAllowed},
 				{Expression: "bar", Operation: task.SubSelectOperation, Err: errors.New("explanation")},
 			},
 			wantHelp: `Suggestions:
 
- ❖ Given expression "--select-catalogers foo"
-   However, tags are not allowed with this operation (must use exact names).
-   Adding groups of catalogers may result in surprising behavior (create inaccurate SBOMs).
-   If you are certain this is what you want to do, use "--override-default-catalogers foo" instead.
+ ❖ Given expression "--select-catalogers +all"
+   However, you cannot use the 'all' operand in this context.
+   It seems like you are intending to use all catalogers (which is not recommended).
+   ... Did you mean "--override-default-catalogers all" instead?
 
 `,
 		},
@@ -266,16 +266,16 @@ func Test_expressionSuggestions(t *testing.T) {
 `,
 		},
 		{
-			name: "ErrTagsNotAllowed with add operation",
+			name: "ErrAllNotAllowed with add operation",
 			expErr: task.ErrInvalidExpression{
-				Err:        task.ErrTagsNotAllowed,
+				Err:        task.ErrAllNotAllowed,
 				Operation:  task.AddOperation,
-				Expression: "+example",
+				Expression: "+all",
 			},
-			want: ` ❖ Given expression "--select-catalogers +example"
-   However, tags are not allowed with this operation (must use exact names).
-   Adding groups of catalogers may result in surprising behavior (create inaccurate SBOMs).
-   If you are certain this is what you want to do, use "--override-default-catalogers example" instead.
+			want: ` ❖ Given expression "--select-catalogers +all"
+   However, you cannot use the 'all' operand in this context.
+   It seems like you are intending to use all catalogers (which is not recommended).
+   ... Did you mean "--override-default-catalogers all" instead?
 `,
 		},
 		{
@@ -27,6 +27,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
 	"github.com/anchore/syft/syft/source/sourceproviders"
+	"github.com/anchore/syft/syft/source/transformsource"
 )
 
 const (
@@ -57,6 +58,8 @@ const (
 `
 	nonImageSchemeHelp = `    {{.appName}} {{.command}} dir:path/to/yourproject                  read directly from a path on disk (any directory)
     {{.appName}} {{.command}} file:path/to/yourproject/file            read directly from a path on disk (any single file)
+    {{.appName}} {{.command}} file:path/to/rootfs.squashfs             read the contents of a squashfs image (e.g. firmware, snap, or live-CD root filesystems)
+    {{.appName}} {{.command}} file:path/to/installer.iso               read the contents of an ISO image (e.g. installer or live images)
 `
 	scanSchemeHelp = "\n  " + schemeHelpHeader + "\n" + imageSchemeHelp + nonImageSchemeHelp
 
@@ -168,19 +171,20 @@ func validateArgs(cmd *cobra.Command, args []string, error string) error {
 }
 
 func runScan(ctx context.Context, id clio.Identification, opts *scanOptions, userInput string) error {
-	writer, err := opts.SBOMWriter()
-	if err != nil {
-		return err
+	sources, userInput := resolveSources(opts.From, userInput)
+
+	// these scans create their own writers once scanning has finished, so the outputs must not be created (truncated) here
+	if len(opts.Platforms) > 0 {
+		return runPlatformsScan(ctx, id, opts, userInput, sources)
 	}
 
-	sources := opts.From
-	if len(sources) == 0 {
-		// extract a scheme if it matches any provider tag; this is a holdover for compatibility, using the --from flag is recommended
-		explicitSource, newUserInput := stereoscope.ExtractSchemeSource(userInput, allSourceProviderTags()...)
-		if explicitSource != "" {
-			sources = append(sources, explicitSource)
-			userInput = newUserInput
-		}
+	if opts.Source.Image.OCILayout.All {
+		return runOCILayoutScan(ctx, id, opts, userInput, sources)
+	}
+
+	writer, err := opts.SBOMWriter(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return err
 	}
 
 	src, err := getSource(ctx, &opts.Catalog, userInput, sources...)
@@ -213,21 +217,46 @@ func runScan(ctx context.Context, id clio.Identification, opts *scanOptions, use
 	return nil
 }
 
+// resolveSources returns the source providers to use for the given user input, falling back to any scheme found
+// within the user input when no explicit sources are given.
+func resolveSources(from []string, userInput string) ([]string, string) {
+	sources := from
+	if len(sources) == 0 {
+		// extract a scheme if it matches any provider tag; this is a holdover for compatibility, using the --from flag is recommended
+		explicitSource, newUserInput := stereoscope.ExtractSchemeSource(userInput, allSourceProviderTags()...)
+		if explicitSource != "" {
+			sources = append(sources, explicitSource)
+			userInput = newUserInput
+		}
+	}
+	return sources, userInput
+}
+
 func getSource(ctx context.Context, opts *options.Catalog, userInput string, sources ...string) (source.Source, error) {
+	ociLayout, err := opts.Source.Image.OCILayout.ToSelection()
+	if err != nil {
+		return nil, err
+	}
+
+	transformations, err := transformsource.ParsePipeline(opts.Via...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source transformation: %w", err)
+	}
+
 	cfg := syft.DefaultGetSourceConfig().
 		WithRegistryOptions(opts.Registry.ToOptions()).
-		WithAlias(source.Alias{
-			Name:    opts.Source.Name,
-			Version: opts.Source.Version,
-		}).
+		WithAlias(opts.Source.ToAlias()).
 		WithExcludeConfig(source.ExcludeConfig{
 			Paths: opts.Exclusions,
 		}).
 		WithBasePath(opts.Source.BasePath).
+		WithOCILayoutSelection(ociLayout).
+		WithContainerdStoreConfig(opts.Source.Image.Containerd.ToConfig()).
+		WithContainersStorageConfig(opts.Source.Image.ContainersStorage.ToConfig()).
 		WithSources(sources...).
-		WithDefaultImagePullSource(opts.Source.Image.DefaultPullSource)
+		WithDefaultImagePullSource(opts.Source.Image.DefaultPullSource).
+		WithTransformations(transformations...)
 
-	var err error
 	var platform *image.Platform
 
 	if opts.Platform != "" {
@@ -383,8 +412,6 @@ func getExplanation(expErr task.ErrInvalidExpression) string {
 	if errors.Is(err, task.ErrUnknownNameOrTag) {
 		noun := ""
 		switch expErr.Operation {
-		case task.AddOperation:
-			noun = "name"
 		case task.SubSelectOperation:
 			noun = "tag"
 		default:
@@ -401,10 +428,6 @@ func getExplanation(expErr task.ErrInvalidExpression) string {
 		return "However, " + err.Error() + "." // nolint:goconst
 	}
 
-	if errors.Is(err, task.ErrTagsNotAllowed) {
-		return "However, " + err.Error() + ".\nAdding groups of catalogers may result in surprising behavior (create inaccurate SBOMs)." // nolint:goconst
-	}
-
 	if errors.Is(err, task.ErrAllNotAllowed) {
 		return "However, you " + err.Error() + ".\nIt seems like you are intending to use all catalogers (which is not recommended)."
 	}
@@ -423,8 +446,8 @@ func getHintPhrase(expErr task.ErrInvalidExpression) string {
 
 	switch expErr.Operation {
 	case task.AddOperation:
-		if errors.Is(expErr.Err, task.ErrTagsNotAllowed) {
-			return fmt.Sprintf("If you are certain this is what you want to do, use %q instead.", "--override-default-catalogers "+trimOperation(expErr.Expression))
+		if errors.Is(expErr.Err, task.ErrAllNotAllowed) {
+			return fmt.Sprintf("... Did you mean %q instead?", "--override-default-catalogers "+trimOperation(expErr.Expression))
 		}
 
 	case task.SubSelectOperation:
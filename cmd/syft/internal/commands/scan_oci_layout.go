@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/oci"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// runOCILayoutScan scans every image (matching any selection criteria) within an OCI layout directory, writing one
+// SBOM per image. Outputs written to a file are suffixed with the image name and digest, while outputs written to
+// stdout are written one after the other.
+func runOCILayoutScan(ctx context.Context, id clio.Identification, opts *scanOptions, userInput string, sources []string) error {
+	for _, s := range sources {
+		if s != oci.Directory {
+			return fmt.Errorf("scanning all images is only supported for OCI layout directories (given source %q)", s)
+		}
+	}
+
+	selection, err := opts.Source.Image.OCILayout.ToSelection()
+	if err != nil {
+		return err
+	}
+
+	var platform *image.Platform
+	if opts.Platform != "" {
+		platform, err = image.NewPlatform(opts.Platform)
+		if err != nil {
+			return fmt.Errorf("invalid platform: %w", err)
+		}
+	}
+
+	images, err := stereoscopesource.OCILayoutImages(userInput)
+	if err != nil {
+		return err
+	}
+
+	images = uniqueOCILayoutImages(stereoscopesource.FilterOCILayoutImages(images, selection, platform))
+	if len(images) == 0 {
+		return fmt.Errorf("no images found in OCI layout %q", userInput)
+	}
+
+	var errs error
+	for _, img := range images {
+		if err := scanOCILayoutImage(ctx, id, opts, userInput, img); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("unable to scan image %s: %w", img, err))
+		}
+	}
+	return errs
+}
+
+func scanOCILayoutImage(ctx context.Context, id clio.Identification, opts *scanOptions, userInput string, img stereoscopesource.OCILayoutImage) error {
+	// the image was already filtered by the user selection, select it (only) by digest from here on
+	catalogOpts := opts.Catalog
+	catalogOpts.Source.Image.OCILayout.Name = ""
+	catalogOpts.Source.Image.OCILayout.Annotations = nil
+	catalogOpts.Source.Image.OCILayout.Digest = img.Digest
+
+	src, err := getSource(ctx, &catalogOpts, userInput, oci.Directory)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Tracef("unable to close source: %+v", err)
+		}
+	}()
+
+	s, err := generateSBOM(ctx, id, src, &catalogOpts)
+	if err != nil {
+		return err
+	}
+
+	if s == nil {
+		return fmt.Errorf("no SBOM produced")
+	}
+
+	// the writer is created only after a successful scan, since creating it truncates all output files
+	writer, err := outputWithFileSuffix(opts.Output, ociLayoutImageSuffix(img)).SBOMWriter(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Write(*s); err != nil {
+		return fmt.Errorf("failed to write SBOM: %w", err)
+	}
+
+	return nil
+}
+
+// uniqueOCILayoutImages removes any images that reference an already seen manifest (e.g. an image tagged twice).
+func uniqueOCILayoutImages(images []stereoscopesource.OCILayoutImage) []stereoscopesource.OCILayoutImage {
+	seen := make(map[string]struct{})
+	var out []stereoscopesource.OCILayoutImage
+	for _, img := range images {
+		if _, ok := seen[img.Digest]; ok {
+			continue
+		}
+		seen[img.Digest] = struct{}{}
+		out = append(out, img)
+	}
+	return out
+}
+
+// ociLayoutImageSuffix returns a file name safe identifier for the image (e.g. "1.0-3f1b9c4a8e2d").
+func ociLayoutImageSuffix(img stereoscopesource.OCILayoutImage) string {
+	digest := img.Digest
+	if _, hex, ok := strings.Cut(digest, ":"); ok {
+		digest = hex
+	}
+	if len(digest) > 12 {
+		digest = digest[:12]
+	}
+
+	if img.Name == "" {
+		return digest
+	}
+	return unsafeFileNameChars.ReplaceAllString(img.Name, "_") + "-" + digest
+}
+
+// outputWithFileSuffix returns a copy of the given output options where all output files have the given suffix
+// added to the file name (before the extension).
+func outputWithFileSuffix(o options.Output, suffix string) options.Output {
+	withSuffix := func(path string) string {
+		if path == "" {
+			return path
+		}
+		ext := filepath.Ext(path)
+		return strings.TrimSuffix(path, ext) + "-" + suffix + ext
+	}
+
+	outputs := make([]string, len(o.Outputs))
+	for i, output := range o.Outputs {
+		opt := options.ParseOutputOption(output)
+		opt.File = withSuffix(opt.File)
+		outputs[i] = opt.String()
+	}
+
+	o.Outputs = outputs
+	o.LegacyFile = withSuffix(o.LegacyFile)
+	return o
+}
@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+func Test_runOCILayoutScan(t *testing.T) {
+	dir := t.TempDir()
+	p, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+
+	var digests []string
+	for _, name := range []string{"app", "tools"} {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		digests = append(digests, digest.Hex[:12])
+		require.NoError(t, p.AppendImage(img, layout.WithAnnotations(map[string]string{stereoscopesource.OCIRefNameAnnotation: name})))
+	}
+
+	out := t.TempDir()
+	opts := defaultScanOptions()
+	opts.Outputs = []string{"syft-json=" + filepath.Join(out, "sbom.json")}
+	opts.Source.Image.OCILayout.All = true
+
+	require.NoError(t, runOCILayoutScan(context.Background(), clio.Identification{Name: "syft", Version: "test"}, opts, dir, nil))
+
+	entries, err := os.ReadDir(out)
+	require.NoError(t, err)
+
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name())
+	}
+	sort.Strings(got)
+
+	assert.Equal(t, []string{
+		"sbom-app-" + digests[0] + ".json",
+		"sbom-tools-" + digests[1] + ".json",
+	}, got)
+
+	// the output as given is left untouched, only the suffixed outputs are written
+	base := filepath.Join(out, "sbom.json")
+	require.NoError(t, os.WriteFile(base, []byte("previous"), 0600))
+	require.NoError(t, runScan(context.Background(), clio.Identification{Name: "syft", Version: "test"}, opts, dir))
+	contents, err := os.ReadFile(base)
+	require.NoError(t, err)
+	assert.Equal(t, "previous", string(contents))
+
+	// only OCI layout directories are supported
+	err = runOCILayoutScan(context.Background(), clio.Identification{}, opts, dir, []string{"docker"})
+	require.ErrorContains(t, err, "only supported for OCI layout directories")
+}
+
+func Test_scanOCILayoutImage_failedScanKeepsOutputs(t *testing.T) {
+	dir := t.TempDir()
+	_, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+
+	out := t.TempDir()
+	existing := filepath.Join(out, "sbom-missing-3f1b9c4a8e2d.json")
+	require.NoError(t, os.WriteFile(existing, []byte("previous"), 0600))
+
+	opts := defaultScanOptions()
+	opts.Outputs = []string{"syft-json=" + filepath.Join(out, "sbom.json")}
+
+	img := stereoscopesource.OCILayoutImage{Name: "missing", Digest: "sha256:3f1b9c4a8e2d7f6e5d4c3b2a1f0e9d8c"}
+	require.Error(t, scanOCILayoutImage(context.Background(), clio.Identification{Name: "syft", Version: "test"}, opts, dir, img))
+
+	contents, err := os.ReadFile(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "previous", string(contents))
+}
+
+func Test_ociLayoutImageSuffix(t *testing.T) {
+	tests := []struct {
+		name string
+		img  stereoscopesource.OCILayoutImage
+		want string
+	}{
+		{
+			name: "digest only",
+			img:  stereoscopesource.OCILayoutImage{Digest: "sha256:3f1b9c4a8e2d7f6e5d4c3b2a1f0e9d8c"},
+			want: "3f1b9c4a8e2d",
+		},
+		{
+			name: "name and digest",
+			img:  stereoscopesource.OCILayoutImage{Name: "docker.io/library/alpine:3.19", Digest: "sha256:3f1b9c4a8e2d7f6e5d4c3b2a1f0e9d8c"},
+			want: "docker.io_library_alpine_3.19-3f1b9c4a8e2d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ociLayoutImageSuffix(tt.img))
+		})
+	}
+}
+
+func Test_outputWithFileSuffix(t *testing.T) {
+	o := options.DefaultOutput()
+	o.Outputs = []string{"syft-table", "syft-json=out/sbom.json", "spdx-json=sbom", "cyclonedx-json,pretty:true=cdx.json"}
+	o.LegacyFile = "legacy.txt"
+
+	got := outputWithFileSuffix(o, "app")
+
+	assert.Equal(t, []string{"syft-table", "syft-json=out/sbom-app.json", "spdx-json=sbom-app", "cyclonedx-json,pretty:true=cdx-app.json"}, got.Outputs)
+	assert.Equal(t, "legacy-app.txt", got.LegacyFile)
+
+	// the original options must not be modified
+	assert.Equal(t, "syft-json=out/sbom.json", o.Outputs[1])
+}
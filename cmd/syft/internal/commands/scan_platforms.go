@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+// allPlatforms selects every platform of a multi-platform image.
+const allPlatforms = "all"
+
+// runPlatformsScan scans several platforms of a multi-platform image (manifest list or OCI index), writing either one
+// SBOM per platform (where outputs written to a file are suffixed with the platform) or a single combined SBOM.
+func runPlatformsScan(ctx context.Context, id clio.Identification, opts *scanOptions, userInput string, sources []string) error {
+	if opts.Source.Image.OCILayout.All {
+		return fmt.Errorf("cannot scan several platforms and all images within an OCI layout at the same time")
+	}
+
+	requested, err := requestedPlatforms(opts.Platforms)
+	if err != nil {
+		return err
+	}
+
+	selection, err := opts.Source.Image.OCILayout.ToSelection()
+	if err != nil {
+		return err
+	}
+
+	sourceName, available, err := stereoscopesource.IndexPlatforms(ctx, userInput, sources, opts.Registry.ToOptions(), selection)
+	if err != nil {
+		return err
+	}
+
+	platforms := available
+	if requested != nil {
+		platforms = stereoscopesource.MatchPlatforms(available, requested...)
+	}
+	if len(platforms) == 0 {
+		return fmt.Errorf("no platforms of %q match %s (available platforms: %s)", userInput, strings.Join(opts.Platforms, ", "), strings.Join(available, ", "))
+	}
+
+	log.WithFields("image", userInput, "platforms", strings.Join(platforms, ", ")).Debug("scanning platforms of multi-platform image")
+
+	var sboms []*sbom.SBOM
+	var errs error
+	for _, platform := range platforms {
+		s, err := scanPlatform(ctx, id, opts, userInput, sourceName, platform)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("unable to scan platform %s: %w", platform, err))
+			continue
+		}
+
+		if opts.CombinePlatforms {
+			sboms = append(sboms, s)
+			continue
+		}
+
+		writer, err := outputWithFileSuffix(opts.Output, platformSuffix(platform)).SBOMWriter(ctx, opts.Registry.ToOptions())
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(*s); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to write SBOM for platform %s: %w", platform, err))
+		}
+	}
+
+	if !opts.CombinePlatforms || errs != nil {
+		return errs
+	}
+
+	combined, err := syft.CombinePlatformSBOMs(sboms...)
+	if err != nil {
+		return err
+	}
+
+	writer, err := opts.SBOMWriter(ctx, opts.Registry.ToOptions())
+	if err != nil {
+		return err
+	}
+	if err := writer.Write(*combined); err != nil {
+		return fmt.Errorf("failed to write SBOM: %w", err)
+	}
+	return nil
+}
+
+func scanPlatform(ctx context.Context, id clio.Identification, opts *scanOptions, userInput, sourceName, platform string) (*sbom.SBOM, error) {
+	catalogOpts := opts.Catalog
+	catalogOpts.Platform = platform
+	catalogOpts.Platforms = nil
+
+	src, err := getSource(ctx, &catalogOpts, userInput, sourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Tracef("unable to close source: %+v", err)
+		}
+	}()
+
+	s, err := generateSBOM(ctx, id, src, &catalogOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		return nil, fmt.Errorf("no SBOM produced")
+	}
+	return s, nil
+}
+
+// requestedPlatforms parses the requested platforms, where nil is returned when all platforms are requested.
+func requestedPlatforms(values []string) ([]*image.Platform, error) {
+	var platforms []*image.Platform
+	for _, v := range values {
+		if strings.EqualFold(v, allPlatforms) {
+			return nil, nil
+		}
+		p, err := image.NewPlatform(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid platform: %w", err)
+		}
+		platforms = append(platforms, p)
+	}
+	return platforms, nil
+}
+
+// platformSuffix returns a file name safe identifier for the platform (e.g. "linux_arm64_v8").
+func platformSuffix(platform string) string {
+	return unsafeFileNameChars.ReplaceAllString(strings.ReplaceAll(platform, "/", "_"), "_")
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+)
+
+// newMultiPlatformLayout creates an OCI layout directory holding a multi-platform image for the given platforms.
+func newMultiPlatformLayout(t *testing.T, platforms ...string) string {
+	t.Helper()
+
+	var addenda []mutate.IndexAddendum
+	for _, p := range platforms {
+		platform, err := v1.ParsePlatform(p)
+		require.NoError(t, err)
+
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		cf, err := img.ConfigFile()
+		require.NoError(t, err)
+		cf = cf.DeepCopy()
+		cf.OS = platform.OS
+		cf.Architecture = platform.Architecture
+		cf.Variant = platform.Variant
+		img, err = mutate.ConfigFile(img, cf)
+		require.NoError(t, err)
+
+		addenda = append(addenda, mutate.IndexAddendum{Add: img, Descriptor: v1.Descriptor{Platform: platform}})
+	}
+
+	dir := t.TempDir()
+	p, err := layout.Write(dir, empty.Index)
+	require.NoError(t, err)
+	require.NoError(t, p.AppendIndex(mutate.AppendManifests(empty.Index, addenda...)))
+	return dir
+}
+
+func Test_runPlatformsScan(t *testing.T) {
+	dir := newMultiPlatformLayout(t, "linux/amd64", "linux/arm64/v8", "linux/s390x")
+	id := clio.Identification{Name: "syft", Version: "test"}
+
+	outputFiles := func(t *testing.T, out string) []string {
+		entries, err := os.ReadDir(out)
+		require.NoError(t, err)
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Name())
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	t.Run("one SBOM per selected platform", func(t *testing.T) {
+		out := t.TempDir()
+		opts := defaultScanOptions()
+		opts.Outputs = []string{"syft-json=" + filepath.Join(out, "sbom.json")}
+		opts.Platforms = []string{"linux/amd64", "arm64"}
+
+		require.NoError(t, runPlatformsScan(context.Background(), id, opts, dir, nil))
+		assert.Equal(t, []string{"sbom-linux_amd64.json", "sbom-linux_arm64_v8.json"}, outputFiles(t, out))
+	})
+
+	t.Run("the output as given is not created", func(t *testing.T) {
+		out := t.TempDir()
+		opts := defaultScanOptions()
+		opts.Outputs = []string{"syft-json=" + filepath.Join(out, "sbom.json")}
+		opts.Platforms = []string{"linux/s390x"}
+
+		require.NoError(t, runScan(context.Background(), id, opts, dir))
+		assert.Equal(t, []string{"sbom-linux_s390x.json"}, outputFiles(t, out))
+	})
+
+	t.Run("the output as given is not truncated", func(t *testing.T) {
+		out := t.TempDir()
+		base := filepath.Join(out, "sbom.json")
+		require.NoError(t, os.WriteFile(base, []byte("previous"), 0600))
+
+		opts := defaultScanOptions()
+		opts.Outputs = []string{"syft-json=" + base}
+		opts.Platforms = []string{"windows/amd64"}
+		opts.CombinePlatforms = true
+
+		require.Error(t, runScan(context.Background(), id, opts, dir))
+		contents, err := os.ReadFile(base)
+		require.NoError(t, err)
+		assert.Equal(t, "previous", string(contents))
+	})
+
+	t.Run("combined SBOM for all platforms", func(t *testing.T) {
+		out := t.TempDir()
+		opts := defaultScanOptions()
+		opts.Outputs = []string{"syft-json=" + filepath.Join(out, "sbom.json")}
+		opts.Platforms = []string{"all"}
+		opts.CombinePlatforms = true
+
+		require.NoError(t, runPlatformsScan(context.Background(), id, opts, dir, nil))
+		assert.Equal(t, []string{"sbom.json"}, outputFiles(t, out))
+
+		contents, err := os.ReadFile(filepath.Join(out, "sbom.json"))
+		require.NoError(t, err)
+		var doc struct {
+			Source struct {
+				Metadata struct {
+					Architecture string `json:"architecture"`
+				} `json:"metadata"`
+			} `json:"source"`
+		}
+		require.NoError(t, json.Unmarshal(contents, &doc))
+		assert.Empty(t, doc.Source.Metadata.Architecture)
+	})
+
+	t.Run("no matching platforms", func(t *testing.T) {
+		opts := defaultScanOptions()
+		opts.Platforms = []string{"windows/amd64"}
+
+		err := runPlatformsScan(context.Background(), id, opts, dir, nil)
+		require.ErrorContains(t, err, "available platforms: linux/amd64, linux/arm64/v8, linux/s390x")
+	})
+}
+
+func Test_platformSuffix(t *testing.T) {
+	assert.Equal(t, "linux_arm64_v8", platformSuffix("linux/arm64/v8"))
+	assert.Equal(t, "windows_amd64", platformSuffix("windows/amd64"))
+}
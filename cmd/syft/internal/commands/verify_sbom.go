@@ -0,0 +1,375 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/cmd/syft/internal/ui"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/bus"
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format/syftdelta"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+const (
+	verifySBOMExample = `  {{.appName}} {{.command}} img.syft.json alpine:latest              check that the packages and files described by the SBOM are found in the image
+  {{.appName}} {{.command}} project.spdx.json dir:./project         check an SBOM against a directory
+  {{.appName}} {{.command}} img.syft.json alpine:latest --report json   show the verification report as JSON
+  {{.appName}} {{.command}} - alpine:latest                         read the SBOM from STDIN
+`
+
+	verifySBOMReportTable = "table"
+	verifySBOMReportJSON  = "json"
+)
+
+type verifySBOMOptions struct {
+	options.Config  `yaml:",inline" mapstructure:",squash"`
+	options.Catalog `yaml:",inline" mapstructure:",squash"`
+	VerifySBOM      verifySBOMConfig `yaml:"verify-sbom" json:"verify-sbom" mapstructure:"verify-sbom"`
+	Cache           options.Cache    `json:"-" yaml:"cache" mapstructure:"cache"`
+}
+
+type verifySBOMConfig struct {
+	Report string `yaml:"report" json:"report" mapstructure:"report"`
+}
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+	clio.FieldDescriber
+} = (*verifySBOMConfig)(nil)
+
+func defaultVerifySBOMOptions() *verifySBOMOptions {
+	return &verifySBOMOptions{
+		Catalog: options.DefaultCatalog(),
+		VerifySBOM: verifySBOMConfig{
+			Report: verifySBOMReportTable,
+		},
+		Cache: options.DefaultCache(),
+	}
+}
+
+func (o *verifySBOMConfig) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.Report, "report", "",
+		fmt.Sprintf("format of the verification report (available: %s, %s)", verifySBOMReportTable, verifySBOMReportJSON))
+}
+
+func (o *verifySBOMConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.Report, "format of the verification report (available: table, json)")
+}
+
+func (o *verifySBOMConfig) PostLoad() error {
+	switch o.Report {
+	case verifySBOMReportTable, verifySBOMReportJSON:
+	case "":
+		o.Report = verifySBOMReportTable
+	default:
+		return fmt.Errorf("unsupported report format %q (available: %s, %s)", o.Report, verifySBOMReportTable, verifySBOMReportJSON)
+	}
+	return nil
+}
+
+//nolint:dupl
+func VerifySBOM(app clio.Application) *cobra.Command {
+	id := app.ID()
+
+	opts := defaultVerifySBOMOptions()
+
+	return app.SetupCommand(&cobra.Command{
+		Use:   "verify-sbom [SBOM] [SOURCE]",
+		Short: "Verify that an SBOM still describes a source",
+		Long:  "[Experimental] Check that the packages and file digests described by an SBOM still match a source (container image, directory, ...), reporting any drift",
+		Example: internal.Tprintf(verifySBOMExample, map[string]interface{}{
+			"appName": id.Name,
+			"command": "verify-sbom",
+		}),
+		Args: validateVerifySBOMArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			restoreStdout := ui.CaptureStdoutToTraceLog()
+			defer restoreStdout()
+
+			return runVerifySBOM(cmd.Context(), id, opts, args[0], args[1])
+		},
+	}, opts)
+}
+
+func validateVerifySBOMArgs(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		// in the case that no arguments are given we want to show the help text and return with a non-0 return code.
+		if err := cmd.Help(); err != nil {
+			return fmt.Errorf("unable to display help: %w", err)
+		}
+		return fmt.Errorf("an SBOM and a source argument are required")
+	}
+
+	return cobra.ExactArgs(2)(cmd, args)
+}
+
+// verifyDriftType describes how a source differs from what an SBOM claims.
+type verifyDriftType string
+
+const (
+	// packageMissingDrift is a package described by the SBOM that is no longer found in the source
+	packageMissingDrift verifyDriftType = "package-missing"
+	// packageVersionDrift is a package described by the SBOM that is found in the source at another version
+	packageVersionDrift verifyDriftType = "package-version-changed"
+	// packageUnclaimedDrift is a package found in the source that is not described by the SBOM
+	packageUnclaimedDrift verifyDriftType = "package-unclaimed"
+	// fileMissingDrift is a file with digests in the SBOM that is no longer found in the source
+	fileMissingDrift verifyDriftType = "file-missing"
+	// fileModifiedDrift is a file with digests in the SBOM whose contents in the source no longer match
+	fileModifiedDrift verifyDriftType = "file-modified"
+)
+
+// verifyReport is the outcome of checking an SBOM against a source.
+type verifyReport struct {
+	SBOM     string              `json:"sbom"`
+	Source   string              `json:"source"`
+	Packages verifyPackageCounts `json:"packages"`
+	Files    verifyFileCounts    `json:"files"`
+	Drift    []verifyDrift       `json:"drift"`
+}
+
+type verifyPackageCounts struct {
+	Verified  int `json:"verified"`
+	Missing   int `json:"missing"`
+	Changed   int `json:"changed"`
+	Unclaimed int `json:"unclaimed"`
+}
+
+type verifyFileCounts struct {
+	Verified int `json:"verified"`
+	Missing  int `json:"missing"`
+	Modified int `json:"modified"`
+}
+
+// verifyDrift is a single difference between the SBOM and the source. The name is the package name (for package
+// drift) or the file path (for file drift), while claimed and actual hold the package version or the file digest.
+type verifyDrift struct {
+	Type    verifyDriftType `json:"type"`
+	Name    string          `json:"name"`
+	Claimed string          `json:"claimed,omitempty"`
+	Actual  string          `json:"actual,omitempty"`
+}
+
+func runVerifySBOM(ctx context.Context, id clio.Identification, opts *verifySBOMOptions, sbomInput, userInput string) error {
+	log.Warn("verify-sbom is an experimental feature, run `syft verify-sbom -h` for help")
+
+	claimed, err := decodeSBOM(sbomInput)
+	if err != nil {
+		return err
+	}
+
+	sources, userInput := resolveSources(opts.From, userInput)
+
+	src, err := getSource(ctx, &opts.Catalog, userInput, sources...)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Tracef("unable to close source: %+v", err)
+		}
+	}()
+
+	actual, err := generateSBOM(ctx, id, src, &opts.Catalog)
+	if err != nil {
+		return err
+	}
+
+	if actual == nil {
+		return fmt.Errorf("no SBOM produced for %q", userInput)
+	}
+
+	resolver, err := src.FileResolver(source.ParseScope(opts.Scope))
+	if err != nil {
+		return fmt.Errorf("unable to get file resolver: %w", err)
+	}
+
+	report := verifySBOM(*claimed, *actual, resolver)
+	report.SBOM = sbomInput
+	report.Source = userInput
+
+	rendered, err := renderVerifyReport(opts.VerifySBOM.Report, report)
+	if err != nil {
+		return err
+	}
+	bus.Report(rendered)
+
+	if len(report.Drift) > 0 {
+		return fmt.Errorf("found %d differences between the SBOM and %q", len(report.Drift), userInput)
+	}
+	return nil
+}
+
+// verifySBOM compares the packages claimed by an SBOM with the packages cataloged from the source, and checks the
+// digests of all files claimed by the SBOM against the contents of the same files within the source.
+func verifySBOM(claimed, actual sbom.SBOM, resolver file.Resolver) verifyReport {
+	report := verifyReport{
+		Drift: []verifyDrift{},
+	}
+	verifyPackages(&report, claimed, actual)
+	verifyFiles(&report, claimed, resolver)
+	return report
+}
+
+func verifyPackages(report *verifyReport, claimed, actual sbom.SBOM) {
+	delta := syftdelta.Compute(claimed, actual)
+	report.Packages.Verified = delta.Unchanged
+
+	for _, e := range delta.Events {
+		switch e.Type {
+		case syftdelta.RemovedEvent:
+			report.Packages.Missing++
+			report.Drift = append(report.Drift, verifyDrift{Type: packageMissingDrift, Name: e.Previous.Name, Claimed: e.Previous.Version})
+		case syftdelta.AddedEvent:
+			report.Packages.Unclaimed++
+			report.Drift = append(report.Drift, verifyDrift{Type: packageUnclaimedDrift, Name: e.Current.Name, Actual: e.Current.Version})
+		case syftdelta.ChangedEvent:
+			// only the version is verified, since other details (e.g. licenses or CPEs) may not survive conversion
+			// between SBOM formats or may differ between tool versions
+			if !slices.Contains(e.Changes, syftdelta.VersionChange) {
+				report.Packages.Verified++
+				continue
+			}
+			report.Packages.Changed++
+			report.Drift = append(report.Drift, verifyDrift{Type: packageVersionDrift, Name: e.Previous.Name, Claimed: e.Previous.Version, Actual: e.Current.Version})
+		}
+	}
+}
+
+func verifyFiles(report *verifyReport, claimed sbom.SBOM, resolver file.Resolver) {
+	// files are checked against the source as it is seen by the resolver, so a path claimed within several layers is
+	// checked once (against the digests claimed for the uppermost layer)
+	claimedDigests := claimed.FileDigestsByPath()
+	var paths []string
+	for path := range claimedDigests {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		drift, err := verifyFile(resolver, path, claimedDigests[path])
+		if err != nil {
+			log.WithFields("path", path, "error", err).Debug("unable to verify file digests")
+			continue
+		}
+
+		switch {
+		case drift == nil:
+			report.Files.Verified++
+		case drift.Type == fileMissingDrift:
+			report.Files.Missing++
+			report.Drift = append(report.Drift, *drift)
+		default:
+			report.Files.Modified++
+			report.Drift = append(report.Drift, *drift)
+		}
+	}
+}
+
+// verifyFile returns the drift for a single file when the claimed digests do not match the contents of the file
+// within the source (or when the file is missing). Only digests of supported algorithms are checked.
+func verifyFile(resolver file.Resolver, path string, claimed []file.Digest) (*verifyDrift, error) {
+	var algorithms []string
+	expected := make(map[string]string)
+	for _, d := range claimed {
+		algorithm := intFile.CleanDigestAlgorithmName(d.Algorithm)
+		if _, err := intFile.Hashers(algorithm); err != nil {
+			continue
+		}
+		algorithms = append(algorithms, algorithm)
+		expected[algorithm] = d.Value
+	}
+	if len(algorithms) == 0 {
+		return nil, fmt.Errorf("no supported digest algorithms")
+	}
+
+	hashes, err := intFile.Hashers(algorithms...)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := resolver.FilesByPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return &verifyDrift{Type: fileMissingDrift, Name: path, Claimed: formatDigest(algorithms[0], expected[algorithms[0]])}, nil
+	}
+
+	reader, err := resolver.FileContentsByLocation(locations[0])
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(reader, path)
+
+	digests, err := intFile.NewDigestsFromFile(reader, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := make(map[string]string)
+	for _, d := range digests {
+		actual[d.Algorithm] = d.Value
+	}
+
+	for _, algorithm := range algorithms {
+		if actual[algorithm] != expected[algorithm] {
+			return &verifyDrift{
+				Type:    fileModifiedDrift,
+				Name:    path,
+				Claimed: formatDigest(algorithm, expected[algorithm]),
+				Actual:  formatDigest(algorithm, actual[algorithm]),
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+func formatDigest(algorithm, value string) string {
+	if value == "" {
+		return ""
+	}
+	return algorithm + ":" + value
+}
+
+func renderVerifyReport(reportFormat string, report verifyReport) (string, error) {
+	if reportFormat == verifySBOMReportJSON {
+		by, err := json.Marshal(report)
+		if err != nil {
+			return "", fmt.Errorf("unable to render verification report: %w", err)
+		}
+		return string(by), nil
+	}
+
+	summary := fmt.Sprintf("Packages: %d verified, %d missing, %d changed, %d unclaimed\nFiles:    %d verified, %d missing, %d modified",
+		report.Packages.Verified, report.Packages.Missing, report.Packages.Changed, report.Packages.Unclaimed,
+		report.Files.Verified, report.Files.Missing, report.Files.Modified)
+
+	if len(report.Drift) == 0 {
+		return fmt.Sprintf("No drift found between the SBOM and %q\n\n%s", report.Source, summary), nil
+	}
+
+	t := table.NewWriter()
+	t.SetStyle(table.StyleLight)
+	t.AppendHeader(table.Row{"Drift", "Name", "Claimed", "Actual"})
+	for _, d := range report.Drift {
+		t.AppendRow(table.Row{d.Type, d.Name, d.Claimed, d.Actual})
+	}
+
+	return t.Render() + "\n\n" + summary, nil
+}
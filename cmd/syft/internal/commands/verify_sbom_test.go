@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func sha256Digest(contents string) file.Digest {
+	return file.Digest{Algorithm: "sha256", Value: fmt.Sprintf("%x", sha256.Sum256([]byte(contents)))}
+}
+
+func Test_verifySBOM(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "same.txt"), []byte("same"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "modified.txt"), []byte("modified"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "layered.txt"), []byte("upper"), 0600))
+
+	src, err := directorysource.NewFromPath(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = src.Close() })
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	python := func(name, version string, licenses ...string) pkg.Package {
+		p := pkg.Package{Name: name, Version: version, Type: pkg.PythonPkg, Licenses: pkg.NewLicenseSet(pkg.NewLicensesFromValues(licenses...)...)}
+		p.SetID()
+		return p
+	}
+
+	claimed := sbom.SBOM{
+		Source: source.Description{
+			Metadata: source.ImageMetadata{
+				Layers: []source.LayerMetadata{{Digest: "sha256:aaaa"}, {Digest: "sha256:bbbb"}},
+			},
+		},
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(
+				python("requests", "2.31.0"),
+				python("flask", "3.0.0"),
+				python("six", "1.16.0", "MIT"),
+			),
+			FileDigests: map[file.Coordinates][]file.Digest{
+				{RealPath: "/same.txt"}:     {sha256Digest("same")},
+				{RealPath: "/modified.txt"}: {sha256Digest("original")},
+				{RealPath: "/missing.txt"}:  {sha256Digest("missing")},
+				// a path claimed within several layers is verified against the uppermost layer
+				{RealPath: "/layered.txt", FileSystemID: "sha256:aaaa"}: {sha256Digest("lower")},
+				{RealPath: "/layered.txt", FileSystemID: "sha256:bbbb"}: {sha256Digest("upper")},
+				// files with only unsupported digest algorithms are not verified
+				{RealPath: "/unsupported.txt"}: {{Algorithm: "crc32", Value: "deadbeef"}},
+			},
+		},
+	}
+
+	actual := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(
+				python("requests", "2.32.0"),
+				// details other than the version are not verified
+				python("six", "1.16.0", "BSD"),
+				python("urllib3", "2.0.7"),
+			),
+		},
+	}
+
+	got := verifySBOM(claimed, actual, resolver)
+
+	assert.Equal(t, verifyPackageCounts{Verified: 1, Missing: 1, Changed: 1, Unclaimed: 1}, got.Packages)
+	assert.Equal(t, verifyFileCounts{Verified: 2, Missing: 1, Modified: 1}, got.Files)
+	assert.ElementsMatch(t, []verifyDrift{
+		{Type: packageMissingDrift, Name: "flask", Claimed: "3.0.0"},
+		{Type: packageVersionDrift, Name: "requests", Claimed: "2.31.0", Actual: "2.32.0"},
+		{Type: packageUnclaimedDrift, Name: "urllib3", Actual: "2.0.7"},
+		{Type: fileMissingDrift, Name: "/missing.txt", Claimed: "sha256:" + sha256Digest("missing").Value},
+		{Type: fileModifiedDrift, Name: "/modified.txt", Claimed: "sha256:" + sha256Digest("original").Value, Actual: "sha256:" + sha256Digest("modified").Value},
+	}, got.Drift)
+}
+
+func Test_runVerifySBOM(t *testing.T) {
+	id := clio.Identification{Name: "syft", Version: "test"}
+
+	project := t.TempDir()
+	requirements := filepath.Join(project, "requirements.txt")
+	require.NoError(t, os.WriteFile(requirements, []byte("requests==2.31.0\n"), 0600))
+
+	opts := defaultVerifySBOMOptions()
+	s, err := scanSource(context.Background(), &opts.Catalog, opts.Catalog.ToSBOMConfig(id), "dir:"+project)
+	require.NoError(t, err)
+
+	sbomFile, err := os.Create(filepath.Join(t.TempDir(), "project.syft.json"))
+	require.NoError(t, err)
+	require.NoError(t, syftjson.NewFormatEncoder().Encode(sbomFile, *s))
+	require.NoError(t, sbomFile.Close())
+
+	require.NoError(t, runVerifySBOM(context.Background(), id, opts, sbomFile.Name(), "dir:"+project))
+
+	require.NoError(t, os.WriteFile(requirements, []byte("requests==2.32.0\n"), 0600))
+	require.ErrorContains(t, runVerifySBOM(context.Background(), id, opts, sbomFile.Name(), "dir:"+project), "found 1 differences")
+}
+
+func Test_renderVerifyReport(t *testing.T) {
+	report := verifyReport{
+		SBOM:     "img.syft.json",
+		Source:   "alpine:latest",
+		Packages: verifyPackageCounts{Verified: 10, Changed: 1},
+		Files:    verifyFileCounts{Verified: 3},
+		Drift: []verifyDrift{
+			{Type: packageVersionDrift, Name: "busybox", Claimed: "1.36.1-r5", Actual: "1.36.1-r6"},
+		},
+	}
+
+	got, err := renderVerifyReport(verifySBOMReportJSON, report)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"sbom": "img.syft.json",
+		"source": "alpine:latest",
+		"packages": {"verified": 10, "missing": 0, "changed": 1, "unclaimed": 0},
+		"files": {"verified": 3, "missing": 0, "modified": 0},
+		"drift": [{"type": "package-version-changed", "name": "busybox", "claimed": "1.36.1-r5", "actual": "1.36.1-r6"}]
+	}`, got)
+
+	got, err = renderVerifyReport(verifySBOMReportTable, report)
+	require.NoError(t, err)
+	assert.Contains(t, got, "package-version-changed")
+	assert.Contains(t, got, "Packages: 10 verified, 0 missing, 1 changed, 0 unclaimed")
+
+	got, err = renderVerifyReport(verifySBOMReportTable, verifyReport{Source: "alpine:latest"})
+	require.NoError(t, err)
+	assert.Contains(t, got, `No drift found between the SBOM and "alpine:latest"`)
+}
@@ -17,39 +17,55 @@ import (
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/file/cataloger/executable"
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
+	"github.com/anchore/syft/syft/file/cataloger/keymaterial"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
 	"github.com/anchore/syft/syft/pkg/cataloger/binary"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
+	"github.com/anchore/syft/syft/pkg/cataloger/helm"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
 	"github.com/anchore/syft/syft/pkg/cataloger/kernel"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
+	"github.com/anchore/syft/syft/pkg/cataloger/snap"
 	"github.com/anchore/syft/syft/source"
 )
 
 type Catalog struct {
 	// high-level cataloger configuration
-	Catalogers        []string            `yaml:"-" json:"catalogers" mapstructure:"catalogers"` // deprecated and not shown in yaml output
-	DefaultCatalogers []string            `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
-	SelectCatalogers  []string            `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
-	Package           packageConfig       `yaml:"package" json:"package" mapstructure:"package"`
-	File              fileConfig          `yaml:"file" json:"file" mapstructure:"file"`
-	Scope             string              `yaml:"scope" json:"scope" mapstructure:"scope"`
-	Parallelism       int                 `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"` // the number of catalog workers to run in parallel
-	Relationships     relationshipsConfig `yaml:"relationships" json:"relationships" mapstructure:"relationships"`
+	Catalogers        []string             `yaml:"-" json:"catalogers" mapstructure:"catalogers"` // deprecated and not shown in yaml output
+	DefaultCatalogers []string             `yaml:"default-catalogers" json:"default-catalogers" mapstructure:"default-catalogers"`
+	SelectCatalogers  []string             `yaml:"select-catalogers" json:"select-catalogers" mapstructure:"select-catalogers"`
+	CatalogerTags     map[string][]string  `yaml:"cataloger-tags" json:"cataloger-tags" mapstructure:"cataloger-tags"`
+	Package           packageConfig        `yaml:"package" json:"package" mapstructure:"package"`
+	File              fileConfig           `yaml:"file" json:"file" mapstructure:"file"`
+	Scope             string               `yaml:"scope" json:"scope" mapstructure:"scope"`
+	Parallelism       int                  `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"` // the number of catalog workers to run in parallel
+	Relationships     relationshipsConfig  `yaml:"relationships" json:"relationships" mapstructure:"relationships"`
+	Classification    classificationConfig `yaml:"classification" json:"classification" mapstructure:"classification"`
+	Ownership         ownershipConfig      `yaml:"ownership" json:"ownership" mapstructure:"ownership"`
+	Reachability      reachabilityConfig   `yaml:"reachability" json:"reachability" mapstructure:"reachability"`
+	Footprint         footprintConfig      `yaml:"footprint" json:"footprint" mapstructure:"footprint"`
+	EOL               eolConfig            `yaml:"eol" json:"eol" mapstructure:"eol"`
+	SupplyChain       supplyChainConfig    `yaml:"supply-chain" json:"supply-chain" mapstructure:"supply-chain"`
 
 	// ecosystem-specific cataloger configuration
 	Golang      golangConfig      `yaml:"golang" json:"golang" mapstructure:"golang"`
+	Helm        helmConfig        `yaml:"helm" json:"helm" mapstructure:"helm"`
 	Java        javaConfig        `yaml:"java" json:"java" mapstructure:"java"`
 	JavaScript  javaScriptConfig  `yaml:"javascript" json:"javascript" mapstructure:"javascript"`
 	LinuxKernel linuxKernelConfig `yaml:"linux-kernel" json:"linux-kernel" mapstructure:"linux-kernel"`
 	Python      pythonConfig      `yaml:"python" json:"python" mapstructure:"python"`
+	Snap        snapConfig        `yaml:"snap" json:"snap" mapstructure:"snap"`
 
 	// configuration for the source (the subject being analyzed)
-	Registry   registryConfig `yaml:"registry" json:"registry" mapstructure:"registry"`
-	From       []string       `yaml:"from" json:"from" mapstructure:"from"`
-	Platform   string         `yaml:"platform" json:"platform" mapstructure:"platform"`
-	Source     sourceConfig   `yaml:"source" json:"source" mapstructure:"source"`
-	Exclusions []string       `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
+	Registry         registryConfig `yaml:"registry" json:"registry" mapstructure:"registry"`
+	From             []string       `yaml:"from" json:"from" mapstructure:"from"`
+	Via              []string       `yaml:"via" json:"via" mapstructure:"via"`
+	Platform         string         `yaml:"platform" json:"platform" mapstructure:"platform"`
+	Platforms        []string       `yaml:"platforms" json:"platforms" mapstructure:"platforms"`
+	CombinePlatforms bool           `yaml:"combine-platforms" json:"combine-platforms" mapstructure:"combine-platforms"`
+	Source           sourceConfig   `yaml:"source" json:"source" mapstructure:"source"`
+	Exclusions       []string       `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
 }
 
 var _ interface {
@@ -60,36 +76,55 @@ var _ interface {
 
 func DefaultCatalog() Catalog {
 	return Catalog{
-		Scope:         source.SquashedScope.String(),
-		Package:       defaultPackageConfig(),
-		LinuxKernel:   defaultLinuxKernelConfig(),
-		Golang:        defaultGolangConfig(),
-		Java:          defaultJavaConfig(),
-		File:          defaultFileConfig(),
-		Relationships: defaultRelationshipsConfig(),
-		Source:        defaultSourceConfig(),
-		Parallelism:   1,
+		Scope:          source.SquashedScope.String(),
+		Package:        defaultPackageConfig(),
+		LinuxKernel:    defaultLinuxKernelConfig(),
+		Golang:         defaultGolangConfig(),
+		Helm:           defaultHelmConfig(),
+		Java:           defaultJavaConfig(),
+		Snap:           defaultSnapConfig(),
+		File:           defaultFileConfig(),
+		Relationships:  defaultRelationshipsConfig(),
+		Classification: defaultClassificationConfig(),
+		Ownership:      defaultOwnershipConfig(),
+		Reachability:   defaultReachabilityConfig(),
+		Footprint:      defaultFootprintConfig(),
+		EOL:            defaultEOLConfig(),
+		SupplyChain:    defaultSupplyChainConfig(),
+		Source:         defaultSourceConfig(),
+		Parallelism:    1,
 	}
 }
 
 func (cfg Catalog) ToSBOMConfig(id clio.Identification) *syft.CreateSBOMConfig {
+	selection := pkgcataloging.NewSelectionRequest().
+		WithDefaults(cfg.DefaultCatalogers...).
+		WithExpression(cfg.SelectCatalogers...)
+
+	for tag, nameOrTags := range cfg.CatalogerTags {
+		selection = selection.WithTags(tag, nameOrTags...)
+	}
+
 	return syft.DefaultCreateSBOMConfig().
 		WithTool(id.Name, id.Version).
 		WithParallelism(cfg.Parallelism).
 		WithRelationshipsConfig(cfg.ToRelationshipsConfig()).
+		WithClassificationConfig(cfg.Classification.config()).
+		WithOwnershipConfig(cfg.Ownership.config()).
+		WithReachabilityConfig(cfg.Reachability.config()).
+		WithFootprintConfig(cfg.Footprint.config()).
+		WithEOLConfig(cfg.EOL.config()).
+		WithSupplyChainConfig(cfg.SupplyChain.config()).
 		WithSearchConfig(cfg.ToSearchConfig()).
 		WithPackagesConfig(cfg.ToPackagesConfig()).
 		WithFilesConfig(cfg.ToFilesConfig()).
-		WithCatalogerSelection(
-			pkgcataloging.NewSelectionRequest().
-				WithDefaults(cfg.DefaultCatalogers...).
-				WithExpression(cfg.SelectCatalogers...),
-		)
+		WithCatalogerSelection(selection)
 }
 
 func (cfg Catalog) ToSearchConfig() cataloging.SearchConfig {
 	return cataloging.SearchConfig{
 		Scope: source.ParseScope(cfg.Scope),
+		Gates: cfg.Package.Gates,
 	}
 }
 
@@ -119,6 +154,27 @@ func (cfg Catalog) ToFilesConfig() filecataloging.Config {
 			MIMETypes: executable.DefaultConfig().MIMETypes,
 			Globs:     cfg.File.Executable.Globs,
 		},
+		KeyMaterial: keyMaterialConfig(cfg.File.KeyMaterial),
+		Snippets:    snippetsConfig(cfg.File.Snippets),
+	}
+}
+
+func keyMaterialConfig(cfg fileKeyMaterial) keymaterial.Config {
+	if !cfg.Enabled {
+		return keymaterial.Config{}
+	}
+	return keymaterial.Config{
+		Globs: cfg.Globs,
+	}
+}
+
+func snippetsConfig(cfg fileSnippets) snippet.Config {
+	if !cfg.Enabled {
+		return snippet.Config{}
+	}
+	return snippet.Config{
+		Globs:              cfg.Globs,
+		SkipFilesAboveSize: cfg.SkipFilesAboveSize,
 	}
 }
 
@@ -141,6 +197,8 @@ func (cfg Catalog) ToPackagesConfig() pkgcataloging.Config {
 					WithFromBuildSettings(cfg.Golang.MainModuleVersion.FromBuildSettings).
 					WithFromLDFlags(cfg.Golang.MainModuleVersion.FromLDFlags),
 			),
+		Helm: helm.DefaultCatalogerConfig().
+			WithCatalogImages(cfg.Helm.CatalogImages),
 		JavaScript: javascript.DefaultCatalogerConfig().
 			WithSearchRemoteLicenses(cfg.JavaScript.SearchRemoteLicenses).
 			WithNpmBaseURL(cfg.JavaScript.NpmBaseURL),
@@ -150,6 +208,9 @@ func (cfg Catalog) ToPackagesConfig() pkgcataloging.Config {
 		Python: python.CatalogerConfig{
 			GuessUnpinnedRequirements: cfg.Python.GuessUnpinnedRequirements,
 		},
+		Snap: snap.DefaultCatalogerConfig().
+			WithSearchContents(cfg.Snap.SearchContents).
+			WithMaxContentsSize(cfg.Snap.MaxContentsSize),
 		JavaArchive: java.DefaultArchiveCatalogerConfig().
 			WithUseMavenLocalRepository(cfg.Java.UseMavenLocalRepository).
 			WithMavenLocalRepositoryDir(cfg.Java.MavenLocalRepositoryDir).
@@ -170,9 +231,18 @@ func (cfg *Catalog) AddFlags(flags clio.FlagSet) {
 	flags.StringArrayVarP(&cfg.From, "from", "",
 		"specify the source behavior to use (e.g. docker, registry, oci-dir, ...)")
 
+	flags.StringArrayVarP(&cfg.Via, "via", "",
+		"transform the input before resolving the source, in order (e.g. 'decrypt=hex:key.hex,decompress,unpack,select=rootfs.squashfs,unpack')")
+
 	flags.StringVarP(&cfg.Platform, "platform", "",
 		"an optional platform specifier for container image sources (e.g. 'linux/arm64', 'linux/arm64/v8', 'arm64', 'linux')")
 
+	flags.StringArrayVarP(&cfg.Platforms, "platforms", "",
+		"scan several platforms of a multi-platform image, producing one SBOM per platform (e.g. 'all', 'linux/amd64,linux/arm64')")
+
+	flags.BoolVarP(&cfg.CombinePlatforms, "combine-platforms", "",
+		"combine the SBOMs of all scanned platforms into a single SBOM, where each package is qualified by its platform")
+
 	flags.StringArrayVarP(&cfg.Exclusions, "exclude", "",
 		"exclude paths from being scanned using a glob expression")
 
@@ -191,7 +261,7 @@ func (cfg *Catalog) AddFlags(flags clio.FlagSet) {
 		"set the base set of catalogers to use (defaults to 'image' or 'directory' depending on the scan source)")
 
 	flags.StringArrayVarP(&cfg.SelectCatalogers, "select-catalogers", "",
-		"add, remove, and filter the catalogers to be used")
+		"add, remove, and filter the catalogers to be used (e.g. '+binary,-java,os&image')")
 
 	flags.StringVarP(&cfg.Source.Name, "source-name", "",
 		"set the name of the target being analyzed")
@@ -199,12 +269,41 @@ func (cfg *Catalog) AddFlags(flags clio.FlagSet) {
 	flags.StringVarP(&cfg.Source.Version, "source-version", "",
 		"set the version of the target being analyzed")
 
+	flags.StringVarP(&cfg.Source.Supplier, "source-supplier", "",
+		"set the supplier (organization) of the target being analyzed")
+
+	flags.StringVarP(&cfg.Source.PURL, "source-purl", "",
+		"set the package URL of the target being analyzed (also sets the name and version when not given)")
+
+	flags.StringVarP(&cfg.Source.CPE, "source-cpe", "",
+		"set the CPE of the target being analyzed")
+
 	flags.StringVarP(&cfg.Source.BasePath, "base-path", "",
 		"base directory for scanning, no links will be followed above this directory, and all paths will be reported relative to this directory")
+
+	flags.StringVarP(&cfg.Source.Image.OCILayout.Name, "oci-layout-name", "",
+		"select the image within an OCI layout directory by name (the 'org.opencontainers.image.ref.name' annotation)")
+
+	flags.StringVarP(&cfg.Source.Image.OCILayout.Digest, "oci-layout-digest", "",
+		"select the image within an OCI layout directory by manifest digest")
+
+	flags.StringArrayVarP(&cfg.Source.Image.OCILayout.Annotations, "oci-layout-annotation", "",
+		"select the image within an OCI layout directory by annotation (e.g. 'org.opencontainers.image.version=1.0')")
+
+	flags.BoolVarP(&cfg.Source.Image.OCILayout.All, "oci-layout-all", "",
+		"scan all (selected) images within an OCI layout directory, producing one SBOM per image")
 }
 
 func (cfg *Catalog) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&cfg.Via, `transformations applied, in order, to the input before resolving the source (options: "decompress",
+"decrypt=<key-file>" or "decrypt=hex:<key-file>", "unpack", "select=<path>")`)
+	descriptions.Add(&cfg.Platforms, `scan several platforms of a multi-platform image (manifest list or OCI index) from a registry or OCI layout
+directory, either "all" platforms or the given platforms (e.g. "linux/amd64", "arm64")`)
+	descriptions.Add(&cfg.CombinePlatforms, `combine the SBOMs of all scanned platforms into a single SBOM (instead of one SBOM per platform), where each
+package is annotated with its platform`)
 	descriptions.Add(&cfg.Parallelism, "number of cataloger workers to run in parallel")
+	descriptions.Add(&cfg.CatalogerTags, `user-defined tags that apply to all catalogers matching any of the given names or tags (including other
+user-defined tags), usable within cataloger selection expressions like any other tag (e.g. {"my-tag": ["java", "python-installed-package-cataloger"]})`)
 }
 
 func (cfg *Catalog) PostLoad() error {
@@ -228,6 +327,14 @@ func (cfg *Catalog) PostLoad() error {
 	}
 
 	cfg.From = flatten(cfg.From)
+	cfg.Platforms = flatten(cfg.Platforms)
+
+	if cfg.Platform != "" && len(cfg.Platforms) > 0 {
+		return fmt.Errorf("cannot use both 'platform' and 'platforms' options")
+	}
+	if cfg.CombinePlatforms && len(cfg.Platforms) == 0 {
+		return fmt.Errorf("the 'combine-platforms' option requires the 'platforms' option")
+	}
 
 	cfg.Catalogers = flatten(cfg.Catalogers)
 	cfg.DefaultCatalogers = flatten(cfg.DefaultCatalogers)
@@ -241,5 +348,5 @@ func (cfg *Catalog) PostLoad() error {
 		return fmt.Errorf("bad scope value %q", cfg.Scope)
 	}
 
-	return nil
+	return cfg.Package.validateGates()
 }
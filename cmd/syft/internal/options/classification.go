@@ -0,0 +1,92 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+	fangs.FieldDescriber
+} = (*classificationConfig)(nil)
+
+type classificationConfig struct {
+	Rules []classificationRule `yaml:"rules" json:"rules" mapstructure:"rules"`
+	Flags []string             `yaml:"-" json:"-" mapstructure:"-"` // --classify <classification>=<path>[,<path>...]
+}
+
+type classificationRule struct {
+	Classification string   `yaml:"classification" json:"classification" mapstructure:"classification"`
+	Paths          []string `yaml:"paths" json:"paths" mapstructure:"paths"`
+}
+
+func defaultClassificationConfig() classificationConfig {
+	return classificationConfig{}
+}
+
+func (c *classificationConfig) AddFlags(flags clio.FlagSet) {
+	flags.StringArrayVarP(&c.Flags, "classify", "",
+		"classify packages found under the given paths or globs (e.g. 'first-party=/app,/srv/app' or 'vendored=/opt/vendor')")
+}
+
+func (c *classificationConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.Rules, `rules that classify packages by where they were found, so that outputs can separate (for example) first-party
+application components from base OS packages. Locations of packages under any of the paths (directories or glob
+patterns) of a rule are annotated with the rule classification, e.g.:
+rules:
+  - classification: first-party
+    paths: ["/app", "/srv/**/*.jar"]`)
+}
+
+func (c *classificationConfig) PostLoad() error {
+	for _, flag := range c.Flags {
+		rule, err := parseClassificationFlag(flag)
+		if err != nil {
+			return err
+		}
+		c.Rules = append(c.Rules, rule)
+	}
+	c.Flags = nil
+
+	for _, rule := range c.Rules {
+		if rule.Classification == "" {
+			return fmt.Errorf("classification rule for paths %v is missing a classification", rule.Paths)
+		}
+		if len(rule.Paths) == 0 {
+			return fmt.Errorf("classification rule %q has no paths", rule.Classification)
+		}
+	}
+	return nil
+}
+
+func parseClassificationFlag(value string) (classificationRule, error) {
+	classification, paths, ok := strings.Cut(value, "=")
+	classification = strings.TrimSpace(classification)
+	if !ok || classification == "" {
+		return classificationRule{}, fmt.Errorf("invalid classification %q (expected <classification>=<path>[,<path>...])", value)
+	}
+
+	rule := classificationRule{Classification: classification}
+	for _, p := range strings.Split(paths, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			rule.Paths = append(rule.Paths, p)
+		}
+	}
+	return rule, nil
+}
+
+func (c classificationConfig) config() cataloging.ClassificationConfig {
+	var rules []cataloging.ClassificationRule
+	for _, r := range c.Rules {
+		rules = append(rules, cataloging.ClassificationRule{
+			Classification: r.Classification,
+			Paths:          r.Paths,
+		})
+	}
+	return cataloging.DefaultClassificationConfig().WithRules(rules...)
+}
@@ -0,0 +1,74 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+func TestClassificationConfig_PostLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     classificationConfig
+		want    []classificationRule
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "no rules",
+		},
+		{
+			name: "rules from flags are appended to configured rules",
+			cfg: classificationConfig{
+				Rules: []classificationRule{{Classification: "first-party", Paths: []string{"/app"}}},
+				Flags: []string{"vendored=/opt/vendor, /srv/**/vendor/**"},
+			},
+			want: []classificationRule{
+				{Classification: "first-party", Paths: []string{"/app"}},
+				{Classification: "vendored", Paths: []string{"/opt/vendor", "/srv/**/vendor/**"}},
+			},
+		},
+		{
+			name:    "flag without classification",
+			cfg:     classificationConfig{Flags: []string{"/app"}},
+			wantErr: require.Error,
+		},
+		{
+			name:    "rule without paths",
+			cfg:     classificationConfig{Flags: []string{"first-party="}},
+			wantErr: require.Error,
+		},
+		{
+			name:    "rule without classification",
+			cfg:     classificationConfig{Rules: []classificationRule{{Paths: []string{"/app"}}}},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			err := tt.cfg.PostLoad()
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, tt.cfg.Rules)
+			assert.Empty(t, tt.cfg.Flags)
+		})
+	}
+}
+
+func TestClassificationConfig_config(t *testing.T) {
+	cfg := classificationConfig{
+		Rules: []classificationRule{{Classification: "first-party", Paths: []string{"/app"}}},
+	}
+
+	assert.Equal(t, cataloging.ClassificationConfig{
+		Rules: []cataloging.ClassificationRule{{Classification: "first-party", Paths: []string{"/app"}}},
+	}, cfg.config())
+}
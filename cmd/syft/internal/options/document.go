@@ -0,0 +1,138 @@
+//nolint:gosec // sha1 is used as a required hash function for SPDX, not a crypto function
+package options
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// sourceDateEpochEnv is the standard variable for reproducible timestamps (see https://reproducible-builds.org/specs/source-date-epoch/)
+const sourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+var _ interface {
+	clio.FlagAdder
+	clio.PostLoader
+	clio.FieldDescriber
+} = (*Document)(nil)
+
+// Document contains the user configuration for the identity of the SBOM document, allowing SBOMs that describe the
+// same subject over time (e.g. one for each release) to form a lineage of document versions.
+type Document struct {
+	Version   int    `yaml:"version" json:"version" mapstructure:"version"`
+	Previous  string `yaml:"previous" json:"previous" mapstructure:"previous"`
+	Timestamp string `yaml:"timestamp" json:"timestamp" mapstructure:"timestamp"`
+}
+
+func (o *Document) AddFlags(flags clio.FlagSet) {
+	flags.IntVarP(&o.Version, "document-version", "",
+		"the version of the SBOM document within its lineage (e.g. a build number)")
+	flags.StringVarP(&o.Previous, "previous-document", "",
+		"the path to the previous SBOM document that this document supersedes")
+	flags.StringVarP(&o.Timestamp, "document-timestamp", "",
+		"the creation time of the SBOM document (RFC 3339 or seconds since the unix epoch)")
+}
+
+func (o *Document) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.Version, `the version of the SBOM document within its lineage (e.g. a build number), which must increase with each
+new document. When a previous document is given this defaults to the next version after the previous document`)
+	descriptions.Add(&o.Previous, `the path to the previous SBOM document that this document supersedes (in any supported format).
+CycloneDX documents continue the serial number of the previous document, and SPDX documents amend it`)
+	descriptions.Add(&o.Timestamp, `the creation time of the SBOM document as RFC 3339 or seconds since the unix epoch, used instead of the
+current time (defaults to the SOURCE_DATE_EPOCH environment variable when set)`)
+}
+
+func (o *Document) PostLoad() error {
+	if o.Version < 0 {
+		return fmt.Errorf("invalid document version %d: must not be negative", o.Version)
+	}
+
+	if o.Previous != "" {
+		path, err := expandFilePath(o.Previous)
+		if err != nil {
+			return err
+		}
+		o.Previous = path
+	}
+
+	_, err := o.timestamp()
+	return err
+}
+
+// timestamp returns the configured creation time of the document, if any.
+func (o Document) timestamp() (time.Time, error) {
+	value := o.Timestamp
+	if value == "" {
+		value = os.Getenv(sourceDateEpochEnv)
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid document timestamp %q: must be RFC 3339 or seconds since the unix epoch", value)
+	}
+	return t, nil
+}
+
+// document returns the configured document identity, which includes the identity of the previous document when
+// one is given. The returned bool indicates whether any document configuration was provided.
+func (o Document) document() (sbom.Document, bool, error) {
+	timestamp, err := o.timestamp()
+	if err != nil {
+		return sbom.Document{}, false, err
+	}
+
+	doc := sbom.Document{
+		Version:   o.Version,
+		Timestamp: timestamp,
+	}
+
+	if o.Previous == "" {
+		return doc, doc.Version > 0 || !doc.Timestamp.IsZero(), nil
+	}
+
+	by, err := os.ReadFile(o.Previous)
+	if err != nil {
+		return doc, false, fmt.Errorf("unable to read previous SBOM document: %w", err)
+	}
+
+	previous, _, _, err := format.Decode(bytes.NewReader(by))
+	if err != nil {
+		return doc, false, fmt.Errorf("unable to decode previous SBOM document %q: %w", o.Previous, err)
+	}
+	if previous == nil {
+		return doc, false, fmt.Errorf("unable to identify the format of previous SBOM document %q", o.Previous)
+	}
+
+	doc.Previous = &sbom.PreviousDocument{
+		SerialNumber: previous.Document.SerialNumber,
+		Version:      previous.Document.Version,
+		Namespace:    previous.Document.Namespace,
+		SHA1:         fmt.Sprintf("%x", sha1.Sum(by)),
+	}
+
+	// all versions of a CycloneDX BOM share the serial number of the first version
+	doc.SerialNumber = previous.Document.SerialNumber
+
+	switch {
+	case doc.Version == 0 && doc.Previous.Version > 0:
+		doc.Version = doc.Previous.Version + 1
+	case doc.Version > 0 && doc.Version <= doc.Previous.Version:
+		return doc, false, fmt.Errorf("document version %d must be greater than the version of the previous document (%d)", doc.Version, doc.Previous.Version)
+	}
+
+	return doc, true, nil
+}
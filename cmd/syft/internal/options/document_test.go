@@ -0,0 +1,175 @@
+package options
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func writePreviousDocument(t *testing.T, encoder sbom.FormatEncoder, doc sbom.Document) (string, string) {
+	t.Helper()
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+		Document: doc,
+	}
+
+	path := filepath.Join(t.TempDir(), "previous.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Encode(f, s))
+	require.NoError(t, f.Close())
+
+	by, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return path, fmt.Sprintf("%x", sha1.Sum(by))
+}
+
+func TestDocument_document(t *testing.T) {
+	cdxEncoder, err := cyclonedxjson.NewFormatEncoderWithConfig(cyclonedxjson.DefaultEncoderConfig())
+	require.NoError(t, err)
+	spdxEncoder, err := spdxjson.NewFormatEncoderWithConfig(spdxjson.DefaultEncoderConfig())
+	require.NoError(t, err)
+
+	serial := "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79"
+	cdxPath, cdxSHA1 := writePreviousDocument(t, cdxEncoder, sbom.Document{SerialNumber: serial, Version: 2})
+	namespace := "https://anchore.com/dir/my-app-b3553b0c-32bf-4bb3-96a1-263bcb922f10"
+	spdxPath, spdxSHA1 := writePreviousDocument(t, spdxEncoder, sbom.Document{Namespace: namespace})
+
+	tests := []struct {
+		name    string
+		cfg     Document
+		env     string
+		want    sbom.Document
+		wantOK  bool
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name: "not configured",
+		},
+		{
+			name:   "version",
+			cfg:    Document{Version: 7},
+			want:   sbom.Document{Version: 7},
+			wantOK: true,
+		},
+		{
+			name:   "timestamp as RFC 3339",
+			cfg:    Document{Timestamp: "2024-05-01T14:00:00+02:00"},
+			want:   sbom.Document{Timestamp: time.Date(2024, 5, 1, 14, 0, 0, 0, time.FixedZone("", 2*60*60))},
+			wantOK: true,
+		},
+		{
+			name:   "timestamp from SOURCE_DATE_EPOCH",
+			env:    "1714564800",
+			want:   sbom.Document{Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+			wantOK: true,
+		},
+		{
+			name:    "invalid timestamp",
+			cfg:     Document{Timestamp: "yesterday"},
+			wantErr: require.Error,
+		},
+		{
+			name: "continues the version of a previous CycloneDX document",
+			cfg:  Document{Previous: cdxPath},
+			want: sbom.Document{
+				SerialNumber: serial,
+				Version:      3,
+				Previous:     &sbom.PreviousDocument{SerialNumber: serial, Version: 2, SHA1: cdxSHA1},
+			},
+			wantOK: true,
+		},
+		{
+			name: "explicit version after a previous CycloneDX document",
+			cfg:  Document{Previous: cdxPath, Version: 10},
+			want: sbom.Document{
+				SerialNumber: serial,
+				Version:      10,
+				Previous:     &sbom.PreviousDocument{SerialNumber: serial, Version: 2, SHA1: cdxSHA1},
+			},
+			wantOK: true,
+		},
+		{
+			name:    "version must increase",
+			cfg:     Document{Previous: cdxPath, Version: 2},
+			wantErr: require.Error,
+		},
+		{
+			name: "previous SPDX document",
+			cfg:  Document{Previous: spdxPath},
+			want: sbom.Document{
+				Previous: &sbom.PreviousDocument{Namespace: namespace, SHA1: spdxSHA1},
+			},
+			wantOK: true,
+		},
+		{
+			name:    "missing previous document",
+			cfg:     Document{Previous: filepath.Join(t.TempDir(), "missing.json")},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(sourceDateEpochEnv, tt.env)
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			got, ok, err := tt.cfg.document()
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_sbomDocumentWriter(t *testing.T) {
+	var written []sbom.SBOM
+	recorder := sbomWriterFunc(func(s sbom.SBOM) error {
+		written = append(written, s)
+		return nil
+	})
+
+	decoded := sbom.SBOM{
+		Document: sbom.Document{
+			SerialNumber: "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+			Version:      1,
+			Namespace:    "https://anchore.com/dir/my-app-b3553b0c-32bf-4bb3-96a1-263bcb922f10",
+		},
+	}
+
+	w := &sbomDocumentWriter{writer: recorder, document: sbom.Document{Version: 2}}
+	require.NoError(t, w.Write(decoded))
+
+	previous := &sbom.PreviousDocument{SerialNumber: "urn:uuid:0ce56c7f-b603-4a87-bfa6-e73f6a7e71ab", Version: 4}
+	w = &sbomDocumentWriter{writer: recorder, document: sbom.Document{SerialNumber: previous.SerialNumber, Version: 5, Previous: previous}}
+	require.NoError(t, w.Write(decoded))
+
+	require.Len(t, written, 2)
+	// the serial number is kept while a new namespace is generated for the new version
+	assert.Equal(t, sbom.Document{SerialNumber: "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", Version: 2}, written[0].Document)
+	assert.Equal(t, sbom.Document{SerialNumber: previous.SerialNumber, Version: 5, Previous: previous}, written[1].Document)
+}
+
+type sbomWriterFunc func(sbom.SBOM) error
+
+func (f sbomWriterFunc) Write(s sbom.SBOM) error {
+	return f(s)
+}
@@ -0,0 +1,37 @@
+package options
+
+import (
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ fangs.FieldDescriber = (*eolConfig)(nil)
+
+type eolConfig struct {
+	Enabled      bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	SearchRemote bool   `yaml:"search-remote" json:"search-remote" mapstructure:"search-remote"`
+	APIURL       string `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+}
+
+func defaultEOLConfig() eolConfig {
+	def := cataloging.DefaultEOLConfig()
+	return eolConfig{
+		Enabled:      def.Enabled,
+		SearchRemote: def.SearchRemote,
+		APIURL:       def.APIURL,
+	}
+}
+
+func (c *eolConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.Enabled, `annotate the linux distribution and major runtime packages (e.g. python, nodejs) with when their
+release cycle reaches its end of life, using the dataset vendored within syft`)
+	descriptions.Add(&c.SearchRemote, `update the vendored end of life dataset from the API (responses are cached across runs)`)
+	descriptions.Add(&c.APIURL, `base URL of the endoflife.date compatible API`)
+}
+
+func (c eolConfig) config() cataloging.EOLConfig {
+	return cataloging.DefaultEOLConfig().
+		WithEnabled(c.Enabled).
+		WithSearchRemote(c.SearchRemote).
+		WithAPIURL(c.APIURL)
+}
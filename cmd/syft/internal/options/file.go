@@ -9,12 +9,16 @@ import (
 	"github.com/anchore/clio"
 	intFile "github.com/anchore/syft/internal/file"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/keymaterial"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
 )
 
 type fileConfig struct {
-	Metadata   fileMetadata   `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
-	Content    fileContent    `yaml:"content" json:"content" mapstructure:"content"`
-	Executable fileExecutable `yaml:"executable" json:"executable" mapstructure:"executable"`
+	Metadata    fileMetadata    `yaml:"metadata" json:"metadata" mapstructure:"metadata"`
+	Content     fileContent     `yaml:"content" json:"content" mapstructure:"content"`
+	Executable  fileExecutable  `yaml:"executable" json:"executable" mapstructure:"executable"`
+	KeyMaterial fileKeyMaterial `yaml:"key-material" json:"key-material" mapstructure:"key-material"`
+	Snippets    fileSnippets    `yaml:"snippets" json:"snippets" mapstructure:"snippets"`
 }
 
 type fileMetadata struct {
@@ -31,6 +35,17 @@ type fileExecutable struct {
 	Globs []string `yaml:"globs" json:"globs" mapstructure:"globs"`
 }
 
+type fileKeyMaterial struct {
+	Enabled bool     `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	Globs   []string `yaml:"globs" json:"globs" mapstructure:"globs"`
+}
+
+type fileSnippets struct {
+	Enabled            bool     `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	SkipFilesAboveSize int64    `yaml:"skip-files-above-size" json:"skip-files-above-size" mapstructure:"skip-files-above-size"`
+	Globs              []string `yaml:"globs" json:"globs" mapstructure:"globs"`
+}
+
 func defaultFileConfig() fileConfig {
 	return fileConfig{
 		Metadata: fileMetadata{
@@ -43,6 +58,15 @@ func defaultFileConfig() fileConfig {
 		Executable: fileExecutable{
 			Globs: nil,
 		},
+		KeyMaterial: fileKeyMaterial{
+			Enabled: false,
+			Globs:   keymaterial.DefaultConfig().Globs,
+		},
+		Snippets: fileSnippets{
+			Enabled:            false,
+			SkipFilesAboveSize: snippet.DefaultConfig().SkipFilesAboveSize,
+			Globs:              snippet.DefaultConfig().Globs,
+		},
 	}
 }
 
@@ -75,4 +99,13 @@ Options include:
 	descriptions.Add(&c.Content.Globs, `file globs for the cataloger to match on`)
 
 	descriptions.Add(&c.Executable.Globs, `file globs for the cataloger to match on`)
+
+	descriptions.Add(&c.KeyMaterial.Enabled, `capture non-sensitive details of certificates, keys, and keystores (e.g. subject, issuer, algorithms, key sizes, and
+expiry) for the files selected by the file-metadata selection (secret key material is never captured)`)
+	descriptions.Add(&c.KeyMaterial.Globs, `file globs for the cataloger to match on`)
+
+	descriptions.Add(&c.Snippets.Enabled, `capture the regions of files that carry their own license or copyright evidence (e.g. the preserved banner comments
+of third-party libraries within a bundled JavaScript file) as snippets, which are included in SPDX output with byte ranges`)
+	descriptions.Add(&c.Snippets.SkipFilesAboveSize, `skip searching a file entirely if it is above the given size (unit = bytes)`)
+	descriptions.Add(&c.Snippets.Globs, `file globs for the cataloger to match on`)
 }
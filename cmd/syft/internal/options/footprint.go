@@ -0,0 +1,29 @@
+package options
+
+import (
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ fangs.FieldDescriber = (*footprintConfig)(nil)
+
+type footprintConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+}
+
+func defaultFootprintConfig() footprintConfig {
+	def := cataloging.DefaultFootprintConfig()
+	return footprintConfig{
+		Enabled: def.Enabled,
+	}
+}
+
+func (c *footprintConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.Enabled, `record the installed size and number of files owned by each package, along with the breakdown by
+container image layer (helping to investigate what contributes to the size of an image)`)
+}
+
+func (c footprintConfig) config() cataloging.FootprintConfig {
+	return cataloging.DefaultFootprintConfig().
+		WithEnabled(c.Enabled)
+}
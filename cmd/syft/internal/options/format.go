@@ -1,9 +1,24 @@
 package options
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+
 	"github.com/anchore/clio"
+	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/checksums"
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/cyclonedxxml"
+	"github.com/anchore/syft/syft/format/spdxjson"
 	"github.com/anchore/syft/syft/format/spdxtagvalue"
+	"github.com/anchore/syft/syft/format/syftdelta"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/format/table"
+	"github.com/anchore/syft/syft/format/template"
 	"github.com/anchore/syft/syft/sbom"
 )
 
@@ -20,6 +35,9 @@ type Format struct {
 	SPDXJSON      FormatSPDXJSON      `yaml:"spdx-json" json:"spdx-json" mapstructure:"spdx-json" description:"all spdx-json format options"`
 	CyclonedxJSON FormatCyclonedxJSON `yaml:"cyclonedx-json" json:"cyclonedx-json" mapstructure:"cyclonedx-json" description:"all cyclonedx-json format options"`
 	CyclonedxXML  FormatCyclonedxXML  `yaml:"cyclonedx-xml" json:"cyclonedx-xml" mapstructure:"cyclonedx-xml" description:"all cyclonedx-xml format options"`
+	Table         FormatTable         `yaml:"table" json:"table" mapstructure:"table" description:"all syft-table format options"`
+	Delta         FormatSyftDelta     `yaml:"delta" json:"delta" mapstructure:"delta" description:"all syft-delta-json format options"`
+	Checksums     FormatChecksums     `yaml:"checksums" json:"checksums" mapstructure:"checksums" description:"all checksums format options"`
 }
 
 func (o *Format) PostLoad() error {
@@ -27,6 +45,7 @@ func (o *Format) PostLoad() error {
 	o.SPDXJSON.Pretty = multiLevelOption[bool](false, o.Pretty, o.SPDXJSON.Pretty)
 	o.CyclonedxJSON.Pretty = multiLevelOption[bool](false, o.Pretty, o.CyclonedxJSON.Pretty)
 	o.CyclonedxXML.Pretty = multiLevelOption[bool](false, o.Pretty, o.CyclonedxXML.Pretty)
+	o.Delta.Pretty = multiLevelOption[bool](false, o.Pretty, o.Delta.Pretty)
 
 	return nil
 }
@@ -43,6 +62,11 @@ over to the final syft 1.0 json output this option can be used to ease the trans
 
 Note: long term support for this option is not guaranteed (it may change or break at any time)`)
 
+	descriptions.Add(&o.Table.Summary, `show the number of packages found per package type instead of listing every package`)
+
+	descriptions.Add(&o.Checksums.Algorithm, `the digest algorithm of the file digests listed by the checksums output format (a SHA256SUMS-style manifest
+of cataloged files), which must be one of the algorithms configured in 'file.metadata.digests'`)
+
 	descriptions.Add(&o.Template.Path, `path to the template file to use when rendering the output with the template output format. 
 Note that all template paths are based on the current syft-json schema`)
 	descriptions.Add(&o.Template.Legacy, `if true, uses the go structs for the syft-json format for templating. 
@@ -50,12 +74,17 @@ if false, uses the syft-json output for templating (which follows the syft JSON
 
 Note: long term support for this option is not guaranteed (it may change or break at any time)`)
 
+	descriptions.Add(&o.Delta.Previous, `the previous SBOM to compare against when using the syft-delta-json output format, which emits only
+the added, removed, and changed components as an event document. This may be a path to an SBOM file in any
+supported format, or "registry:<image>" to use the most recent SBOM attached to the image as an OCI referrer`)
+
 	prettyDescription := `include space indentation and newlines
 note: inherits default value from 'format.pretty' or 'false' if parent is unset`
 	descriptions.Add(&o.SyftJSON.Pretty, prettyDescription)
 	descriptions.Add(&o.SPDXJSON.Pretty, prettyDescription)
 	descriptions.Add(&o.CyclonedxJSON.Pretty, prettyDescription)
 	descriptions.Add(&o.CyclonedxXML.Pretty, prettyDescription)
+	descriptions.Add(&o.Delta.Pretty, prettyDescription)
 }
 
 func DefaultFormat() Format {
@@ -65,6 +94,9 @@ func DefaultFormat() Format {
 		SPDXJSON:      DefaultFormatSPDXJSON(),
 		CyclonedxJSON: DefaultFormatCyclonedxJSON(),
 		CyclonedxXML:  DefaultFormatCyclonedxXML(),
+		Table:         DefaultFormatTable(),
+		Delta:         DefaultFormatSyftDelta(),
+		Checksums:     DefaultFormatChecksums(),
 	}
 }
 
@@ -76,9 +108,89 @@ func (o Format) Encoders() ([]sbom.FormatEncoder, error) {
 		SPDXTagValue:  spdxtagvalue.EncoderConfig{Version: format.AllVersions}, // we support multiple versions, not just a single version
 		CyclonedxJSON: o.CyclonedxJSON.config(format.AllVersions),              // we support multiple versions, not just a single version
 		CyclonedxXML:  o.CyclonedxXML.config(format.AllVersions),               // we support multiple versions, not just a single version
+		Table:         o.Table.config(),
+		Delta:         o.Delta.config(),
+		Checksums:     o.Checksums.config(),
 	}.Encoders()
 }
 
+// WithRegistry returns a copy of the format configuration which uses the given context and registry options when
+// fetching anything from a registry (e.g. the previous SBOM of the syft-delta-json format).
+func (o Format) WithRegistry(ctx context.Context, registryOptions *image.RegistryOptions) Format {
+	o.Delta = o.Delta.withRegistry(ctx, registryOptions)
+	return o
+}
+
+// withOptions returns a copy of the format configuration with the given options (from a single output, e.g.
+// "-o syft-json,pretty:true") applied to the given format.
+func (o Format) withOptions(id sbom.FormatID, opts map[string]string) (Format, error) {
+	var errs error
+	for key, value := range opts {
+		if err := o.setOption(id, key, value); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return o, errs
+}
+
+func (o *Format) setOption(id sbom.FormatID, key, value string) error {
+	var target any
+	switch {
+	case key == "pretty" && id == syftjson.ID:
+		target = &o.SyftJSON.Pretty
+	case key == "pretty" && id == spdxjson.ID:
+		target = &o.SPDXJSON.Pretty
+	case key == "pretty" && id == cyclonedxjson.ID:
+		target = &o.CyclonedxJSON.Pretty
+	case key == "pretty" && id == cyclonedxxml.ID:
+		target = &o.CyclonedxXML.Pretty
+	case key == "legacy" && id == syftjson.ID:
+		target = &o.SyftJSON.Legacy
+	case key == "legacy" && id == template.ID:
+		target = &o.Template.Legacy
+	case key == "template" && id == template.ID:
+		target = &o.Template.Path
+	case key == "pretty" && id == syftdelta.ID:
+		target = &o.Delta.Pretty
+	case key == "previous" && id == syftdelta.ID:
+		target = &o.Delta.Previous
+	case key == "summary" && id == table.ID:
+		target = &o.Table.Summary
+	case key == "algorithm" && id == checksums.ID:
+		target = &o.Checksums.Algorithm
+	default:
+		return fmt.Errorf("unsupported option %q for format %q", key, id)
+	}
+
+	switch t := target.(type) {
+	case *string:
+		if value == "" {
+			return fmt.Errorf("option %q requires a value", key)
+		}
+		*t = value
+	case *bool:
+		// an option without a value enables the option
+		if value == "" {
+			value = "true"
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for option %q: %w", key, err)
+		}
+		*t = b
+	case **bool:
+		if value == "" {
+			value = "true"
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for option %q: %w", key, err)
+		}
+		*t = &b
+	}
+	return nil
+}
+
 func multiLevelOption[T any](defaultValue T, option ...*T) *T {
 	result := defaultValue
 	for _, opt := range option {
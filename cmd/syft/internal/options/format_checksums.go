@@ -0,0 +1,21 @@
+package options
+
+import (
+	"github.com/anchore/syft/syft/format/checksums"
+)
+
+type FormatChecksums struct {
+	Algorithm string `yaml:"algorithm" json:"algorithm" mapstructure:"algorithm"`
+}
+
+func DefaultFormatChecksums() FormatChecksums {
+	return FormatChecksums{
+		Algorithm: checksums.DefaultEncoderConfig().Algorithm,
+	}
+}
+
+func (o FormatChecksums) config() checksums.EncoderConfig {
+	return checksums.EncoderConfig{
+		Algorithm: o.Algorithm,
+	}
+}
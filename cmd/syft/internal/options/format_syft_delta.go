@@ -0,0 +1,105 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/registry"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/syftdelta"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// registryReferrerPrefix indicates that the previous SBOM should be looked up as an OCI referrer of the given image.
+const registryReferrerPrefix = "registry:"
+
+type FormatSyftDelta struct {
+	Previous string `yaml:"previous" json:"previous" mapstructure:"previous"`
+	Pretty   *bool  `yaml:"pretty" json:"pretty" mapstructure:"pretty"`
+
+	// fetchReferrer fetches the previous SBOM attached to an image in a registry (configured by the command, since
+	// this requires the command context and registry options)
+	fetchReferrer func(imageRef string) (io.ReadCloser, error)
+}
+
+func DefaultFormatSyftDelta() FormatSyftDelta {
+	return FormatSyftDelta{}
+}
+
+func (o FormatSyftDelta) config() syftdelta.EncoderConfig {
+	cfg := syftdelta.DefaultEncoderConfig()
+	if o.Pretty != nil {
+		cfg.Pretty = *o.Pretty
+	}
+
+	if o.Previous == "" {
+		return cfg
+	}
+
+	// the previous SBOM is only loaded when a delta is encoded, so that other outputs are unaffected by it
+	cfg.PreviousLoader = o.loadPrevious
+	cfg.PreviousReference = o.Previous
+	return cfg
+}
+
+// withRegistry returns a copy of the options which fetch a previous SBOM from a registry with the given context and
+// registry options (e.g. credentials, insecure registries, and CA certificates).
+func (o FormatSyftDelta) withRegistry(ctx context.Context, registryOptions *image.RegistryOptions) FormatSyftDelta {
+	if registryOptions == nil {
+		registryOptions = &image.RegistryOptions{}
+	}
+	o.fetchReferrer = func(imageRef string) (io.ReadCloser, error) {
+		ref, err := name.ParseReference(imageRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse image reference %q: %w", imageRef, err)
+		}
+		return syftdelta.ReferrerSBOM(ctx, imageRef, registry.RemoteOptions(ctx, ref, *registryOptions)...)
+	}
+	return o
+}
+
+func (o FormatSyftDelta) loadPrevious() (*sbom.SBOM, error) {
+	reader, err := o.openPrevious()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	previous, _, _, err := format.Decode(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode previous SBOM %q: %w", o.Previous, err)
+	}
+	if previous == nil {
+		return nil, fmt.Errorf("unable to identify the format of previous SBOM %q", o.Previous)
+	}
+	return previous, nil
+}
+
+func (o FormatSyftDelta) openPrevious() (io.ReadCloser, error) {
+	if ref, ok := strings.CutPrefix(o.Previous, registryReferrerPrefix); ok {
+		if o.fetchReferrer != nil {
+			return o.fetchReferrer(ref)
+		}
+		return syftdelta.ReferrerSBOM(context.Background(), ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+
+	path, err := homedir.Expand(o.Previous)
+	if err != nil {
+		return nil, fmt.Errorf("unable to expand path %q: %w", o.Previous, err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open previous SBOM: %w", err)
+	}
+	return f, nil
+}
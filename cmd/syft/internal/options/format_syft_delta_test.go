@@ -0,0 +1,125 @@
+package options
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+func writePreviousSBOM(t *testing.T) string {
+	t.Helper()
+
+	p := pkg.Package{Name: "musl", Version: "1.2.4", Type: pkg.ApkPkg}
+	p.SetID()
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(p),
+		},
+		Source: source.Description{
+			ID:       "previous",
+			Name:     "alpine",
+			Version:  "3.18",
+			Metadata: source.ImageMetadata{UserInput: "alpine:3.18"},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "previous.syft.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, syftjson.NewFormatEncoder().Encode(f, s))
+	return path
+}
+
+func TestFormatSyftDelta_config(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          FormatSyftDelta
+		wantPrevious bool
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{
+			name: "no previous SBOM",
+			cfg:  FormatSyftDelta{},
+		},
+		{
+			name:         "previous SBOM from path",
+			cfg:          FormatSyftDelta{Previous: writePreviousSBOM(t)},
+			wantPrevious: true,
+		},
+		{
+			name:         "missing previous SBOM",
+			cfg:          FormatSyftDelta{Previous: filepath.Join(t.TempDir(), "missing.json")},
+			wantPrevious: true,
+			wantErr:      require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			got := tt.cfg.config()
+			assert.Nil(t, got.Previous)
+			if !tt.wantPrevious {
+				assert.Nil(t, got.PreviousLoader)
+				return
+			}
+			assert.Equal(t, tt.cfg.Previous, got.PreviousReference)
+
+			require.NotNil(t, got.PreviousLoader)
+			previous, err := got.PreviousLoader()
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, 1, previous.Artifacts.Packages.PackageCount())
+		})
+	}
+}
+
+func TestFormat_Encoders_previousNotLoaded(t *testing.T) {
+	cfg := DefaultFormat()
+	// the previous SBOM is only needed by the delta output format, so it does not affect other output formats
+	cfg.Delta.Previous = "registry:localhost:1/missing:latest"
+	cfg.Delta.fetchReferrer = func(string) (io.ReadCloser, error) {
+		t.Fatal("the previous SBOM should not be fetched")
+		return nil, nil
+	}
+
+	encoders, err := cfg.Encoders()
+	require.NoError(t, err)
+
+	enc := format.NewEncoderCollection(encoders...).GetByString(syftjson.ID.String())
+	require.NotNil(t, enc)
+	var buf bytes.Buffer
+	require.NoError(t, enc.Encode(&buf, sbom.SBOM{}))
+}
+
+func TestFormat_WithRegistry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// the command context is used when fetching the previous SBOM, so a cancelled command does not wait on the registry
+	cancel()
+
+	cfg := DefaultFormat().WithRegistry(ctx, &image.RegistryOptions{InsecureUseHTTP: true})
+	cfg.Delta.Previous = "registry:localhost:1/alpine:latest"
+
+	_, err := cfg.Delta.config().PreviousLoader()
+	require.ErrorIs(t, err, context.Canceled)
+}
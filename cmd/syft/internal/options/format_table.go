@@ -0,0 +1,21 @@
+package options
+
+import (
+	"github.com/anchore/syft/syft/format/table"
+)
+
+type FormatTable struct {
+	Summary bool `yaml:"summary" json:"summary" mapstructure:"summary"`
+}
+
+func DefaultFormatTable() FormatTable {
+	return FormatTable{
+		Summary: false,
+	}
+}
+
+func (o FormatTable) config() table.EncoderConfig {
+	return table.EncoderConfig{
+		Summary: o.Summary,
+	}
+}
@@ -0,0 +1,22 @@
+package options
+
+import "github.com/anchore/clio"
+
+type helmConfig struct {
+	CatalogImages bool `json:"catalog-images" yaml:"catalog-images" mapstructure:"catalog-images"`
+}
+
+func defaultHelmConfig() helmConfig {
+	return helmConfig{
+		CatalogImages: false,
+	}
+}
+
+var _ interface {
+	clio.FieldDescriber
+} = (*helmConfig)(nil)
+
+func (o *helmConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.CatalogImages, `whether to record the container image references declared within the default values (values.yaml)
+of helm charts within the chart metadata`)
+}
@@ -1,19 +1,22 @@
 package options
 
 import (
+	"context"
 	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/scylladb/go-set/strset"
 
 	"github.com/anchore/clio"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft/format/checksums"
 	"github.com/anchore/syft/syft/format/cyclonedxjson"
 	"github.com/anchore/syft/syft/format/cyclonedxxml"
 	"github.com/anchore/syft/syft/format/github"
 	"github.com/anchore/syft/syft/format/spdxjson"
 	"github.com/anchore/syft/syft/format/spdxtagvalue"
+	"github.com/anchore/syft/syft/format/syftdelta"
 	"github.com/anchore/syft/syft/format/syftjson"
 	"github.com/anchore/syft/syft/format/table"
 	"github.com/anchore/syft/syft/format/template"
@@ -21,6 +24,9 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
+// outputFlagExample is the example given in the help of the output flag.
+const outputFlagExample = "syft-json,pretty:true=sbom.json"
+
 var _ interface {
 	clio.FlagAdder
 	clio.PostLoader
@@ -35,6 +41,7 @@ type Output struct {
 	Outputs              []string `yaml:"output" json:"output" mapstructure:"output"` // -o, the format to use for output
 	OutputFile           `yaml:",inline" json:"" mapstructure:",squash"`
 	Format               `yaml:"format" json:"format" mapstructure:"format"`
+	Document             Document `yaml:"document" json:"document" mapstructure:"document"`
 }
 
 func DefaultOutput() Output {
@@ -51,7 +58,7 @@ func DefaultOutput() Output {
 
 func (o *Output) PostLoad() error {
 	var errs error
-	for _, loader := range []clio.PostLoader{&o.OutputFile, &o.Format} {
+	for _, loader := range []clio.PostLoader{&o.OutputFile, &o.Format, &o.Document} {
 		if err := loader.PostLoad(); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -68,7 +75,7 @@ func (o *Output) AddFlags(flags clio.FlagSet) {
 	sort.Strings(names)
 
 	flags.StringArrayVarP(&o.Outputs, "output", "o",
-		fmt.Sprintf("report output format (<format>=<file> to output to a file), formats=%v", names))
+		fmt.Sprintf("report output format (<format>=<file> to output to a file, <format>,<option>:<value>[=<file>] to set format options for a single output, e.g. %q), formats=%v", outputFlagExample, names))
 }
 
 func (o *Output) DescribeFields(descriptions clio.FieldDescriptionSet) {
@@ -77,43 +84,64 @@ to specify multiple output files in differing formats, use a list:
 output:
   - "syft-json=<syft-json-output-file>"
   - "spdx-json=<spdx-json-output-file>"
+format options can be set for a single output with comma separated <option>:<value> pairs after the format name (before
+the "=" of any output file, where an option without a value enables a boolean option), which take precedence over the
+format options below for that output only (options: pretty, legacy, template, summary, previous, algorithm), e.g.:
+output:
+  - "table,summary"
+  - "syft-json,pretty=<syft-json-output-file>"
+  - "checksums,algorithm:sha256=SHA256SUMS"
 `)
 }
 
-func (o Output) SBOMWriter() (sbom.Writer, error) {
+// SBOMWriter returns a writer for all outputs, where anything fetched from a registry while writing (e.g. the previous
+// SBOM of the syft-delta-json format) uses the given context and registry options.
+func (o Output) SBOMWriter(ctx context.Context, registryOptions *image.RegistryOptions) (sbom.Writer, error) {
 	names := o.OutputNameSet()
 
 	if len(o.Outputs) > 1 && !o.AllowMultipleOutputs {
 		return nil, fmt.Errorf("only one output format is allowed (given %d: %s)", len(o.Outputs), names)
 	}
 
-	usesTemplateOutput := names.Has(string(template.ID))
+	for _, output := range o.Outputs {
+		opt := ParseOutputOption(output)
+
+		if opt.Name == string(template.ID) && o.Format.Template.Path == "" && opt.Options["template"] == "" {
+			return nil, fmt.Errorf(`must specify path to template file when using "template" output format`)
+		}
 
-	if usesTemplateOutput && o.Format.Template.Path == "" {
-		return nil, fmt.Errorf(`must specify path to template file when using "template" output format`)
+		if opt.Name == string(syftdelta.ID) && o.Format.Delta.Previous == "" && opt.Options["previous"] == "" {
+			return nil, fmt.Errorf(`must specify the previous SBOM when using %q output format`, syftdelta.ID)
+		}
+
+		if !o.AllowToFile && opt.File != "" {
+			return nil, fmt.Errorf("file output is not allowed ('-o format=path' should be '-o format')")
+		}
 	}
 
-	encoders, err := o.Encoders()
+	writer, err := makeSBOMWriter(o.Outputs, o.LegacyFile, o.Format.WithRegistry(ctx, registryOptions))
 	if err != nil {
 		return nil, err
 	}
 
-	if !o.AllowToFile {
-		for _, opt := range o.Outputs {
-			if strings.Contains(opt, "=") {
-				return nil, fmt.Errorf("file output is not allowed ('-o format=path' should be '-o format')")
-			}
-		}
+	doc, ok, err := o.Document.document()
+	if err != nil || !ok {
+		return writer, err
 	}
 
-	return makeSBOMWriter(o.Outputs, o.LegacyFile, encoders)
+	return &sbomDocumentWriter{writer: writer, document: doc}, nil
+}
+
+// Encoders returns the encoders of all supported formats, where anything fetched from a registry while encoding (e.g.
+// the previous SBOM of the syft-delta-json format) uses the given context and registry options.
+func (o Output) Encoders(ctx context.Context, registryOptions *image.RegistryOptions) ([]sbom.FormatEncoder, error) {
+	return o.Format.WithRegistry(ctx, registryOptions).Encoders()
 }
 
 func (o Output) OutputNameSet() *strset.Set {
 	names := strset.New()
 	for _, output := range o.Outputs {
-		fields := strings.Split(output, "=")
-		names.Add(fields[0])
+		names.Add(ParseOutputOption(output).Name)
 	}
 
 	return names
@@ -127,6 +155,8 @@ func supportedIDs() []sbom.FormatID {
 		table.ID,
 		text.ID,
 		template.ID,
+		syftdelta.ID,
+		checksums.ID,
 
 		// encoders that support multiple versions
 		cyclonedxxml.ID,
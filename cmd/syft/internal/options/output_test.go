@@ -1,6 +1,7 @@
 package options
 
 import (
+	"context"
 	"testing"
 
 	"github.com/scylladb/go-set/strset"
@@ -29,8 +30,9 @@ func Test_getEncoders(t *testing.T) {
 	opts := DefaultOutput()
 	require.NoError(t, opts.Format.PostLoad())
 	opts.Format.Template.Path = "somewhere"
+	opts.Format.Delta.Previous = writePreviousSBOM(t)
 
-	encoders, err := opts.Encoders()
+	encoders, err := opts.Format.Encoders()
 	require.NoError(t, err)
 	require.NotEmpty(t, encoders)
 
@@ -162,7 +164,7 @@ func Test_EncoderCollection_ByString_IDOnly_Defaults(t *testing.T) {
 	require.NoError(t, opts.Format.PostLoad())
 	opts.Format.Template.Path = "somewhere"
 
-	defaultEncoders, err := opts.Encoders()
+	defaultEncoders, err := opts.Format.Encoders()
 	require.NoError(t, err)
 
 	encoders := format.NewEncoderCollection(defaultEncoders...)
@@ -187,7 +189,7 @@ func Test_OutputHonorsAllowFile(t *testing.T) {
 		o.AllowToFile = false
 		o.Outputs = []string{"table=/tmp/somefile"}
 
-		w, err := o.SBOMWriter()
+		w, err := o.SBOMWriter(context.Background(), nil)
 		assert.Nil(t, w)
 		assert.ErrorContains(t, err, "file output is not allowed")
 	})
@@ -196,7 +198,7 @@ func Test_OutputHonorsAllowFile(t *testing.T) {
 		o.AllowToFile = true
 		o.Outputs = []string{"table=/tmp/somefile"}
 
-		w, err := o.SBOMWriter()
+		w, err := o.SBOMWriter(context.Background(), nil)
 		assert.NotNil(t, w)
 		assert.NoError(t, err)
 	})
@@ -0,0 +1,35 @@
+package options
+
+import (
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ fangs.FieldDescriber = (*ownershipConfig)(nil)
+
+type ownershipConfig struct {
+	ModulePrefixes []string `yaml:"module-prefixes" json:"module-prefixes" mapstructure:"module-prefixes"`
+	GroupIDs       []string `yaml:"group-ids" json:"group-ids" mapstructure:"group-ids"`
+	Scopes         []string `yaml:"scopes" json:"scopes" mapstructure:"scopes"`
+	RegistryHosts  []string `yaml:"registry-hosts" json:"registry-hosts" mapstructure:"registry-hosts"`
+}
+
+func defaultOwnershipConfig() ownershipConfig {
+	return ownershipConfig{}
+}
+
+func (c *ownershipConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.ModulePrefixes, `prefixes of package names or module paths of internal (first-party) packages (e.g. "github.com/acme/").
+When any ownership heuristic is configured, every package is classified as either "internal" or "third-party"`)
+	descriptions.Add(&c.GroupIDs, `Java group IDs of internal packages, including any group beneath them (e.g. "com.acme")`)
+	descriptions.Add(&c.Scopes, `npm scopes of internal packages (e.g. "@acme")`)
+	descriptions.Add(&c.RegistryHosts, `hosts of internal registries and repositories that packages are resolved from (e.g. "npm.acme.internal")`)
+}
+
+func (c ownershipConfig) config() cataloging.OwnershipConfig {
+	return cataloging.DefaultOwnershipConfig().
+		WithModulePrefixes(c.ModulePrefixes...).
+		WithGroupIDs(c.GroupIDs...).
+		WithScopes(c.Scopes...).
+		WithRegistryHosts(c.RegistryHosts...)
+}
@@ -0,0 +1,27 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+func TestOwnershipConfig_config(t *testing.T) {
+	assert.False(t, defaultOwnershipConfig().config().IsEnabled())
+
+	cfg := ownershipConfig{
+		ModulePrefixes: []string{"github.com/acme/"},
+		GroupIDs:       []string{"com.acme"},
+		Scopes:         []string{"@acme"},
+		RegistryHosts:  []string{"npm.acme.internal"},
+	}
+
+	assert.Equal(t, cataloging.OwnershipConfig{
+		ModulePrefixes: []string{"github.com/acme/"},
+		GroupIDs:       []string{"com.acme"},
+		Scopes:         []string{"@acme"},
+		RegistryHosts:  []string{"npm.acme.internal"},
+	}, cfg.config())
+}
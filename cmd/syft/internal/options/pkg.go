@@ -1,14 +1,19 @@
 package options
 
 import (
+	"fmt"
+
+	"github.com/bmatcuk/doublestar/v4"
+
 	"github.com/anchore/clio"
 	"github.com/anchore/syft/syft/cataloging"
 )
 
 type packageConfig struct {
-	SearchUnindexedArchives         bool `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
-	SearchIndexedArchives           bool `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
-	ExcludeBinaryOverlapByOwnership bool `yaml:"exclude-binary-overlap-by-ownership" json:"exclude-binary-overlap-by-ownership" mapstructure:"exclude-binary-overlap-by-ownership"` // exclude synthetic binary packages owned by os package files
+	SearchUnindexedArchives         bool                   `yaml:"search-unindexed-archives" json:"search-unindexed-archives" mapstructure:"search-unindexed-archives"`
+	SearchIndexedArchives           bool                   `yaml:"search-indexed-archives" json:"search-indexed-archives" mapstructure:"search-indexed-archives"`
+	ExcludeBinaryOverlapByOwnership bool                   `yaml:"exclude-binary-overlap-by-ownership" json:"exclude-binary-overlap-by-ownership" mapstructure:"exclude-binary-overlap-by-ownership"` // exclude synthetic binary packages owned by os package files
+	Gates                           cataloging.SearchGates `yaml:"gates" json:"gates" mapstructure:"gates"`
 }
 
 var _ interface {
@@ -23,6 +28,28 @@ note: enabling this may result in a performance impact since all discovered comp
 note: for now this only applies to the java package cataloger`)
 	descriptions.Add(&o.ExcludeBinaryOverlapByOwnership, `allows users to exclude synthetic binary packages from the sbom
 these packages are removed if an overlap with a non-synthetic package is found`)
+	descriptions.Add(&o.Gates.OS.Include, `limit the paths OS package catalogers may search to those matching at least one glob (default: all paths)`)
+	descriptions.Add(&o.Gates.OS.Exclude, `prevent OS package catalogers from searching paths matching any glob`)
+	descriptions.Add(&o.Gates.Language.Include, `limit the paths language package catalogers may search to those matching at least one glob (default: all paths)`)
+	descriptions.Add(&o.Gates.Language.Exclude, `prevent language package catalogers from searching paths matching any glob`)
+	descriptions.Add(&o.Gates.Binary.Include, `limit the paths binary package catalogers may search to those matching at least one glob (default: all paths)
+e.g. ["/usr/**", "/opt/**"]`)
+	descriptions.Add(&o.Gates.Binary.Exclude, `prevent binary package catalogers from searching paths matching any glob`)
+}
+
+func (o packageConfig) validateGates() error {
+	for group, gate := range map[string]cataloging.PathGate{
+		"os":       o.Gates.OS,
+		"language": o.Gates.Language,
+		"binary":   o.Gates.Binary,
+	} {
+		for _, pattern := range append(gate.Include, gate.Exclude...) {
+			if !doublestar.ValidatePattern(pattern) {
+				return fmt.Errorf("invalid %s search gate pattern: %q", group, pattern)
+			}
+		}
+	}
+	return nil
 }
 
 func defaultPackageConfig() packageConfig {
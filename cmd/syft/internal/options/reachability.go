@@ -0,0 +1,34 @@
+package options
+
+import (
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ fangs.FieldDescriber = (*reachabilityConfig)(nil)
+
+type reachabilityConfig struct {
+	EntrypointClosure bool `yaml:"entrypoint-closure" json:"entrypoint-closure" mapstructure:"entrypoint-closure"`
+	Imports           bool `yaml:"imports" json:"imports" mapstructure:"imports"`
+}
+
+func defaultReachabilityConfig() reachabilityConfig {
+	def := cataloging.DefaultReachabilityConfig()
+	return reachabilityConfig{
+		EntrypointClosure: def.EntrypointClosure,
+		Imports:           def.Imports,
+	}
+}
+
+func (c *reachabilityConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.EntrypointClosure, `annotate packages with files reachable from the entrypoint (or cmd) of container images, following
+shared libraries and script interpreters (helping to prioritize findings for what the image actually runs)`)
+	descriptions.Add(&c.Imports, `annotate packages imported by first-party Python, JavaScript, and Ruby source files (best-effort static
+analysis of imports, helping to prioritize findings for the dependencies the code actually uses)`)
+}
+
+func (c reachabilityConfig) config() cataloging.ReachabilityConfig {
+	return cataloging.DefaultReachabilityConfig().
+		WithEntrypointClosure(c.EntrypointClosure).
+		WithImports(c.Imports)
+}
@@ -0,0 +1,30 @@
+package options
+
+import (
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/syft/pkg/cataloger/snap"
+)
+
+type snapConfig struct {
+	SearchContents  bool  `json:"search-contents" yaml:"search-contents" mapstructure:"search-contents"`
+	MaxContentsSize int64 `json:"max-contents-size" yaml:"max-contents-size" mapstructure:"max-contents-size"`
+}
+
+func defaultSnapConfig() snapConfig {
+	def := snap.DefaultCatalogerConfig()
+	return snapConfig{
+		SearchContents:  def.SearchContents,
+		MaxContentsSize: def.MaxContentsSize,
+	}
+}
+
+var _ interface {
+	clio.FieldDescriber
+} = (*snapConfig)(nil)
+
+func (o *snapConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.SearchContents, `whether to catalog the packages within snaps and other squashfs images (.snap and .squashfs files),
+where packages found within a snap are related to the snap that contains them`)
+	descriptions.Add(&o.MaxContentsSize, `the largest amount of uncompressed data (in bytes) that will be extracted from a single image in order
+to catalog the packages within it`)
+}
@@ -8,12 +8,19 @@ import (
 	"github.com/scylladb/go-set/strset"
 
 	"github.com/anchore/clio"
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/source"
 	"github.com/anchore/syft/syft/source/sourceproviders"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
 type sourceConfig struct {
 	Name     string      `json:"name" yaml:"name" mapstructure:"name"`
 	Version  string      `json:"version" yaml:"version" mapstructure:"version"`
+	Supplier string      `json:"supplier" yaml:"supplier" mapstructure:"supplier"`
+	PURL     string      `json:"purl" yaml:"purl" mapstructure:"purl"`
+	CPE      string      `json:"cpe" yaml:"cpe" mapstructure:"cpe"`
 	BasePath string      `yaml:"base-path" json:"base-path" mapstructure:"base-path"` // specify base path for all file paths
 	File     fileSource  `json:"file" yaml:"file" mapstructure:"file"`
 	Image    imageSource `json:"image" yaml:"image" mapstructure:"image"`
@@ -25,16 +32,48 @@ type fileSource struct {
 
 var _ interface {
 	clio.FieldDescriber
+	clio.PostLoader
 } = (*sourceConfig)(nil)
 
 func (o *sourceConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
+	descriptions.Add(&o.Name, `set the name of the top-level component (the scanned target)`)
+	descriptions.Add(&o.Version, `set the version of the top-level component (the scanned target)`)
+	descriptions.Add(&o.Supplier, `set the supplier (organization) of the top-level component (the scanned target)`)
+	descriptions.Add(&o.PURL, `set the package URL of the top-level component (the scanned target); the name and version
+are derived from the package URL when not otherwise given`)
+	descriptions.Add(&o.CPE, `set the CPE of the top-level component (the scanned target)`)
 	descriptions.Add(&o.File.Digests, `the file digest algorithms to use on the scanned file (options: "md5", "sha1", "sha224", "sha256", "sha384", "sha512")`)
 	descriptions.Add(&o.Image.DefaultPullSource, `allows users to specify which image source should be used to generate the sbom
-valid values are: registry, docker, podman`)
+valid values are: registry, docker, podman, containerd, containers-storage`)
+	descriptions.Add(&o.Image.OCILayout.Name, `select the image within an OCI layout directory with the given "org.opencontainers.image.ref.name" annotation`)
+	descriptions.Add(&o.Image.OCILayout.Digest, `select the image within an OCI layout directory with the given manifest digest`)
+	descriptions.Add(&o.Image.OCILayout.Annotations, `select the image within an OCI layout directory having all the given annotations (as "key=value")`)
+	descriptions.Add(&o.Image.OCILayout.All, `scan all (selected) images within an OCI layout directory, producing one SBOM per image`)
+	descriptions.Add(&o.Image.Containerd.Root, `the root directory of containerd, used to read images directly from the containerd store when the
+containerd API is unavailable (default: /var/lib/containerd)`)
+	descriptions.Add(&o.Image.Containerd.Namespace, `the containerd namespace to read images from the containerd store (by default all namespaces are searched,
+preferring "default", "k8s.io", and "moby")`)
+	descriptions.Add(&o.Image.ContainersStorage.Root, `the root directory of containers-storage, used to read images pulled by CRI-O or podman directly from disk
+(by default the storage of a rootless user is searched, as configured in storage.conf, followed by /var/lib/containers/storage)`)
 }
 
 type imageSource struct {
-	DefaultPullSource string `json:"default-pull-source" yaml:"default-pull-source" mapstructure:"default-pull-source"`
+	DefaultPullSource string                  `json:"default-pull-source" yaml:"default-pull-source" mapstructure:"default-pull-source"`
+	OCILayout         ociLayoutSource         `json:"oci-layout" yaml:"oci-layout" mapstructure:"oci-layout"`
+	Containerd        containerdSource        `json:"containerd" yaml:"containerd" mapstructure:"containerd"`
+	ContainersStorage containersStorageSource `json:"containers-storage" yaml:"containers-storage" mapstructure:"containers-storage"`
+}
+
+type containerdSource struct {
+	Root      string `json:"root" yaml:"root" mapstructure:"root"`
+	Namespace string `json:"namespace" yaml:"namespace" mapstructure:"namespace"`
+}
+
+type ociLayoutSource struct {
+	Name        string   `json:"name" yaml:"name" mapstructure:"name"`
+	Digest      string   `json:"digest" yaml:"digest" mapstructure:"digest"`
+	Annotations []string `json:"annotations" yaml:"annotations" mapstructure:"annotations"`
+	All         bool     `json:"all" yaml:"all" mapstructure:"all"`
 }
 
 func defaultSourceConfig() sourceConfig {
@@ -49,6 +88,43 @@ func defaultSourceConfig() sourceConfig {
 	}
 }
 
+func (o *sourceConfig) PostLoad() error {
+	if o.PURL != "" {
+		purl, err := packageurl.FromString(o.PURL)
+		if err != nil {
+			return fmt.Errorf("invalid source package URL %q: %w", o.PURL, err)
+		}
+		if o.Name == "" {
+			o.Name = purl.Name
+			if purl.Namespace != "" {
+				o.Name = purl.Namespace + "/" + purl.Name
+			}
+		}
+		if o.Version == "" {
+			o.Version = purl.Version
+		}
+	}
+
+	if o.CPE != "" {
+		if _, err := cpe.New(o.CPE, ""); err != nil {
+			return fmt.Errorf("invalid source CPE %q: %w", o.CPE, err)
+		}
+	}
+
+	return nil
+}
+
+// ToAlias returns the identity of the top-level component (the scanned target).
+func (o sourceConfig) ToAlias() source.Alias {
+	return source.Alias{
+		Name:     o.Name,
+		Version:  o.Version,
+		Supplier: o.Supplier,
+		PURL:     o.PURL,
+		CPE:      o.CPE,
+	}
+}
+
 func (c *fileSource) PostLoad() error {
 	digests := strset.New(c.Digests...).List()
 	sort.Strings(digests)
@@ -60,7 +136,50 @@ func (c imageSource) PostLoad() error {
 	return checkDefaultSourceValues(c.DefaultPullSource)
 }
 
-var validDefaultSourceValues = []string{"registry", "docker", "podman", ""}
+func (c *ociLayoutSource) PostLoad() error {
+	_, err := c.ToSelection()
+	return err
+}
+
+// ToSelection returns the criteria used to select images within an OCI layout directory.
+func (c ociLayoutSource) ToSelection() (stereoscopesource.OCILayoutSelection, error) {
+	selection := stereoscopesource.OCILayoutSelection{
+		Name:   c.Name,
+		Digest: c.Digest,
+	}
+	for _, annotation := range c.Annotations {
+		key, value, ok := strings.Cut(annotation, "=")
+		if !ok || key == "" {
+			return selection, fmt.Errorf("invalid OCI layout annotation %q (expected 'key=value')", annotation)
+		}
+		if selection.Annotations == nil {
+			selection.Annotations = make(map[string]string)
+		}
+		selection.Annotations[key] = value
+	}
+	return selection, nil
+}
+
+// ToConfig returns the configuration for reading images directly from the containerd store.
+func (c containerdSource) ToConfig() stereoscopesource.ContainerdStoreConfig {
+	return stereoscopesource.ContainerdStoreConfig{
+		Root:      c.Root,
+		Namespace: c.Namespace,
+	}
+}
+
+type containersStorageSource struct {
+	Root string `json:"root" yaml:"root" mapstructure:"root"`
+}
+
+// ToConfig returns the configuration for reading images directly from containers-storage.
+func (c containersStorageSource) ToConfig() stereoscopesource.ContainersStorageConfig {
+	return stereoscopesource.ContainersStorageConfig{
+		Root: c.Root,
+	}
+}
+
+var validDefaultSourceValues = []string{"registry", "docker", "podman", "containerd", "containers-storage", ""}
 
 func checkDefaultSourceValues(source string) error {
 	validValues := strset.New(validDefaultSourceValues...)
@@ -35,3 +35,66 @@ func Test_fileSource_PostLoad(t *testing.T) {
 		})
 	}
 }
+
+func Test_sourceConfig_PostLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     sourceConfig
+		want    sourceConfig
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "derive name and version from package URL",
+			cfg: sourceConfig{
+				PURL: "pkg:npm/%40acme/app@1.2.3",
+			},
+			want: sourceConfig{
+				Name:    "@acme/app",
+				Version: "1.2.3",
+				PURL:    "pkg:npm/%40acme/app@1.2.3",
+			},
+		},
+		{
+			name: "explicit name and version take precedence",
+			cfg: sourceConfig{
+				Name:    "app",
+				Version: "2.0.0",
+				PURL:    "pkg:generic/app@1.2.3",
+				CPE:     "cpe:2.3:a:acme:app:2.0.0:*:*:*:*:*:*:*",
+			},
+			want: sourceConfig{
+				Name:    "app",
+				Version: "2.0.0",
+				PURL:    "pkg:generic/app@1.2.3",
+				CPE:     "cpe:2.3:a:acme:app:2.0.0:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "invalid package URL",
+			cfg: sourceConfig{
+				PURL: "not-a-purl",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "invalid CPE",
+			cfg: sourceConfig{
+				CPE: "not-a-cpe",
+			},
+			wantErr: assert.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = assert.NoError
+			}
+			err := tt.cfg.PostLoad()
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, tt.cfg)
+		})
+	}
+}
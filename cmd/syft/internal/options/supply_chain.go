@@ -0,0 +1,34 @@
+package options
+
+import (
+	"github.com/anchore/fangs"
+	"github.com/anchore/syft/syft/cataloging"
+)
+
+var _ fangs.FieldDescriber = (*supplyChainConfig)(nil)
+
+type supplyChainConfig struct {
+	Enabled     bool   `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+	DatasetPath string `yaml:"dataset-path" json:"dataset-path" mapstructure:"dataset-path"`
+}
+
+func defaultSupplyChainConfig() supplyChainConfig {
+	def := cataloging.DefaultSupplyChainConfig()
+	return supplyChainConfig{
+		Enabled:     def.Enabled,
+		DatasetPath: def.DatasetPath,
+	}
+}
+
+func (c *supplyChainConfig) DescribeFields(descriptions fangs.FieldDescriptionSet) {
+	descriptions.Add(&c.Enabled, `annotate packages that may be typosquats of popular packages, and internal packages (see "ownership")
+whose names exist on public registries with a higher version (dependency confusion), using the dataset
+of popular packages vendored within syft`)
+	descriptions.Add(&c.DatasetPath, `path to a JSON dataset of popular packages to use in place of the vendored dataset`)
+}
+
+func (c supplyChainConfig) config() cataloging.SupplyChainConfig {
+	return cataloging.DefaultSupplyChainConfig().
+		WithEnabled(c.Enabled).
+		WithDatasetPath(c.DatasetPath)
+}
@@ -29,8 +29,8 @@ var _ interface {
 
 // makeSBOMWriter creates a sbom.Writer for output or returns an error. this will either return a valid writer
 // or an error but neither both and if there is no error, sbom.Writer.Close() should be called
-func makeSBOMWriter(outputs []string, defaultFile string, encoders []sbom.FormatEncoder) (sbom.Writer, error) {
-	outputOptions, err := parseSBOMOutputFlags(outputs, defaultFile, encoders)
+func makeSBOMWriter(outputs []string, defaultFile string, cfg Format) (sbom.Writer, error) {
+	outputOptions, err := parseSBOMOutputFlags(outputs, defaultFile, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,11 @@ func makeSBOMWriter(outputs []string, defaultFile string, encoders []sbom.Format
 }
 
 // parseSBOMOutputFlags utility to parse command-line option strings and retain the existing behavior of default format and file
-func parseSBOMOutputFlags(outputs []string, defaultFile string, encoders []sbom.FormatEncoder) (out []sbomWriterDescription, errs error) {
+func parseSBOMOutputFlags(outputs []string, defaultFile string, cfg Format) (out []sbomWriterDescription, errs error) {
+	encoders, err := cfg.Encoders()
+	if err != nil {
+		return nil, err
+	}
 	encoderCollection := format.NewEncoderCollection(encoders...)
 
 	// always should have one option -- we generally get the default of "table", but just make sure
@@ -52,26 +56,30 @@ func parseSBOMOutputFlags(outputs []string, defaultFile string, encoders []sbom.
 		outputs = append(outputs, table.ID.String())
 	}
 
-	for _, name := range outputs {
-		name = strings.TrimSpace(name)
-
-		// split to at most two parts for <format>=<file>
-		parts := strings.SplitN(name, "=", 2)
-
-		// the format name is the first part
-		name = parts[0]
+	for _, output := range outputs {
+		opt := ParseOutputOption(output)
 
 		// default to the --file or empty string if not specified
 		file := defaultFile
 
 		// If a file is specified as part of the output formatName, use that
-		if len(parts) > 1 {
-			file = parts[1]
+		if opt.File != "" {
+			file = opt.File
 		}
 
-		enc := encoderCollection.GetByString(name)
+		collection := encoderCollection
+		if len(opt.Options) > 0 {
+			// format options given for this output take precedence over the format configuration for this output only
+			collection, err = outputEncoders(cfg, encoderCollection, opt)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+		}
+
+		enc := collection.GetByString(opt.Name)
 		if enc == nil {
-			errs = multierror.Append(errs, fmt.Errorf(`unsupported output format "%s", supported formats are: %+v`, name, formatVersionOptions(encoderCollection.NameVersions())))
+			errs = multierror.Append(errs, fmt.Errorf(`unsupported output format "%s", supported formats are: %+v`, opt.Name, formatVersionOptions(encoderCollection.NameVersions())))
 			continue
 		}
 
@@ -80,6 +88,79 @@ func parseSBOMOutputFlags(outputs []string, defaultFile string, encoders []sbom.
 	return out, errs
 }
 
+// outputEncoders returns the encoders configured with the format options given for a single output.
+func outputEncoders(cfg Format, defaults *format.EncoderCollection, opt OutputOption) (*format.EncoderCollection, error) {
+	id := sbom.FormatID(opt.Name)
+	if enc := defaults.GetByString(opt.Name); enc != nil {
+		id = enc.ID()
+	}
+
+	cfg, err := cfg.withOptions(id, opt.Options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options for output %q: %w", opt.Name, err)
+	}
+
+	encoders, err := cfg.Encoders()
+	if err != nil {
+		return nil, err
+	}
+	return format.NewEncoderCollection(encoders...), nil
+}
+
+// OutputOption is a single output (-o) value in the form <format>[,<option>[:<value>]...][=<file>], where the options
+// configure the format for this output only (e.g. "syft-json,pretty=sbom.json", "checksums,algorithm:sha1=SHA256SUMS",
+// or "table,summary:true"). An option without a value enables a boolean option.
+type OutputOption struct {
+	Name    string
+	File    string
+	Options map[string]string
+}
+
+// ParseOutputOption parses a single output (-o) value. The first "=" always separates the format (and its options)
+// from the file, so the file name is taken as-is (and may contain commas, colons, or "="), while option values may not
+// contain commas or "=".
+func ParseOutputOption(value string) OutputOption {
+	spec, file, _ := strings.Cut(strings.TrimSpace(value), "=")
+
+	parts := strings.Split(spec, ",")
+	opt := OutputOption{Name: strings.TrimSpace(parts[0]), File: file}
+	for _, part := range parts[1:] {
+		key, val, _ := strings.Cut(part, ":")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if opt.Options == nil {
+			opt.Options = make(map[string]string)
+		}
+		opt.Options[key] = strings.TrimSpace(val)
+	}
+
+	return opt
+}
+
+func (o OutputOption) String() string {
+	var sb strings.Builder
+	sb.WriteString(o.Name)
+
+	keys := make([]string, 0, len(o.Options))
+	for k := range o.Options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sb.WriteString("," + k)
+		if v := o.Options[k]; v != "" {
+			sb.WriteString(":" + v)
+		}
+	}
+
+	if o.File != "" {
+		sb.WriteString("=" + o.File)
+	}
+	return sb.String()
+}
+
 // formatVersionOptions takes a list like ["github-json", "syft-json@11.0.0", "cyclonedx-xml@1.0", "cyclondx-xml@1.1"...]
 // and formats it into a human-readable string like:
 //
@@ -210,6 +291,31 @@ func (m *sbomMultiWriter) Write(s sbom.SBOM) (errs error) {
 	return errs
 }
 
+// sbomDocumentWriter applies the configured document identity to each SBOM before writing it
+type sbomDocumentWriter struct {
+	writer   sbom.Writer
+	document sbom.Document
+}
+
+func (w *sbomDocumentWriter) Write(s sbom.SBOM) error {
+	switch {
+	case w.document.Previous != nil:
+		// this is a new version of the previous document, so any identity of the given SBOM (e.g. from a decoded
+		// document) no longer applies
+		s.Document = w.document
+	default:
+		if w.document.Version > 0 {
+			// each version of an SPDX document requires a new namespace
+			s.Document.Version = w.document.Version
+			s.Document.Namespace = ""
+		}
+		if !w.document.Timestamp.IsZero() {
+			s.Document.Timestamp = w.document.Timestamp
+		}
+	}
+	return w.writer.Write(s)
+}
+
 // sbomStreamWriter implements sbom.Writer for a given format and io.Writer, also providing a close function for cleanup
 type sbomStreamWriter struct {
 	format sbom.FormatEncoder
@@ -36,20 +36,148 @@ func Test_MakeSBOMWriter(t *testing.T) {
 				return assert.ErrorContains(t, err, `unsupported output format "unknown", supported formats are:`)
 			},
 		},
+		{
+			name:    "with format options",
+			outputs: []string{"table,summary:true", "json,pretty:true,legacy"},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "delta with previous SBOM option",
+			outputs: []string{"syft-delta-json,previous:" + writePreviousSBOM(t)},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unsupported format option",
+			outputs: []string{"table,pretty:true"},
+			wantErr: func(t assert.TestingT, err error, bla ...interface{}) bool {
+				return assert.ErrorContains(t, err, `unsupported option "pretty" for format "syft-table"`)
+			},
+		},
+		{
+			name:    "invalid format option value",
+			outputs: []string{"json,pretty:maybe"},
+			wantErr: func(t assert.TestingT, err error, bla ...interface{}) bool {
+				return assert.ErrorContains(t, err, `invalid value for option "pretty"`)
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			opt := DefaultOutput()
 			require.NoError(t, opt.Format.PostLoad())
-			encoders, err := opt.Encoders()
-			require.NoError(t, err)
-			_, err = makeSBOMWriter(tt.outputs, "", encoders)
+			_, err := makeSBOMWriter(tt.outputs, "", opt.Format)
 			tt.wantErr(t, err)
 		})
 	}
 }
 
+func Test_ParseOutputOption(t *testing.T) {
+	tests := []struct {
+		value      string
+		want       OutputOption
+		wantString string
+	}{
+		{
+			value:      "table",
+			want:       OutputOption{Name: "table"},
+			wantString: "table",
+		},
+		{
+			value:      " json=sbom.json ",
+			want:       OutputOption{Name: "json", File: "sbom.json"},
+			wantString: "json=sbom.json",
+		},
+		{
+			value:      "table,summary:true",
+			want:       OutputOption{Name: "table", Options: map[string]string{"summary": "true"}},
+			wantString: "table,summary:true",
+		},
+		{
+			value:      "spdx-json@2.2,pretty:true,legacy=sbom.json",
+			want:       OutputOption{Name: "spdx-json@2.2", File: "sbom.json", Options: map[string]string{"pretty": "true", "legacy": ""}},
+			wantString: "spdx-json@2.2,legacy,pretty:true=sbom.json",
+		},
+		{
+			// an option without a value is never mistaken for the file name
+			value:      "syft-json,pretty=sbom.json",
+			want:       OutputOption{Name: "syft-json", File: "sbom.json", Options: map[string]string{"pretty": ""}},
+			wantString: "syft-json,pretty=sbom.json",
+		},
+		{
+			// ...nor is the file name mistaken for the value of a string option
+			value:      "checksums,algorithm:sha1=SHA256SUMS",
+			want:       OutputOption{Name: "checksums", File: "SHA256SUMS", Options: map[string]string{"algorithm": "sha1"}},
+			wantString: "checksums,algorithm:sha1=SHA256SUMS",
+		},
+		{
+			// option values may hold colons
+			value:      "syft-delta-json,previous:registry:alpine:latest",
+			want:       OutputOption{Name: "syft-delta-json", Options: map[string]string{"previous": "registry:alpine:latest"}},
+			wantString: "syft-delta-json,previous:registry:alpine:latest",
+		},
+		{
+			// options are only read before the file, so this is a file name with a comma
+			value:      "json=a,b.json",
+			want:       OutputOption{Name: "json", File: "a,b.json"},
+			wantString: "json=a,b.json",
+		},
+		{
+			// ...even when the file name looks like it has options
+			value:      "json=out,v:1=x.json",
+			want:       OutputOption{Name: "json", File: "out,v:1=x.json"},
+			wantString: "json=out,v:1=x.json",
+		},
+		{
+			value:      "json,pretty:true=out,v=1.json",
+			want:       OutputOption{Name: "json", File: "out,v=1.json", Options: map[string]string{"pretty": "true"}},
+			wantString: "json,pretty:true=out,v=1.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := ParseOutputOption(tt.value)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantString, got.String())
+		})
+	}
+}
+
+func Test_parseSBOMOutputFlags_formatOptions(t *testing.T) {
+	cfg := DefaultFormat()
+	require.NoError(t, cfg.PostLoad())
+
+	out, err := parseSBOMOutputFlags([]string{"syft-json=plain.json", "syft-json,pretty=pretty.json"}, "", cfg)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+
+	s := sbom.SBOM{}
+	var plain, pretty strings.Builder
+	require.NoError(t, out[0].Format.Encode(&plain, s))
+	require.NoError(t, out[1].Format.Encode(&pretty, s))
+
+	assert.NotContains(t, plain.String(), "\n  ")
+	assert.Less(t, strings.Count(plain.String(), "\n"), strings.Count(pretty.String(), "\n"))
+	assert.Equal(t, "pretty.json", out[1].Path)
+}
+
+func Test_parseSBOMOutputFlags_flagExample(t *testing.T) {
+	assert.Equal(t, OutputOption{Name: "syft-json", File: "sbom.json", Options: map[string]string{"pretty": "true"}}, ParseOutputOption(outputFlagExample))
+
+	cfg := DefaultFormat()
+	require.NoError(t, cfg.PostLoad())
+
+	out, err := parseSBOMOutputFlags([]string{outputFlagExample}, "", cfg)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, "sbom.json", out[0].Path)
+
+	var pretty strings.Builder
+	require.NoError(t, out[0].Format.Encode(&pretty, sbom.SBOM{}))
+	assert.Contains(t, pretty.String(), "\n  ")
+}
+
 func dummyFormat(name string) sbom.FormatEncoder {
 	return dummyEncoder{name: name}
 }
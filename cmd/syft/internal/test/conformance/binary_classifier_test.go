@@ -0,0 +1,65 @@
+package conformance
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+)
+
+// snippetsDir contains binary snippets captured from real binaries built for a variety of architectures.
+const snippetsDir = "../../../../../syft/pkg/cataloger/binary/test-fixtures/classifiers/snippets"
+
+// Test_BinaryClassifierParity verifies that binary classifiers produce the same results regardless of the platform
+// syft is running on (or was built for), including binaries that target a different architecture than the host.
+func Test_BinaryClassifierParity(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		wantName    string
+		wantVersion string
+	}{
+		{
+			fixture:     "busybox/1.36.1/linux-amd64",
+			wantName:    "busybox",
+			wantVersion: "1.36.1",
+		},
+		{
+			fixture:     "go/1.21.3/linux-amd64",
+			wantName:    "go",
+			wantVersion: "1.21.3",
+		},
+		{
+			fixture:     "openssl/1.1.1w/linux-arm64",
+			wantName:    "openssl",
+			wantVersion: "1.1.1w",
+		},
+		{
+			fixture:     "fluent-bit/2.2.1/linux-arm64",
+			wantName:    "fluent-bit",
+			wantVersion: "2.2.1",
+		},
+		{
+			fixture:     "java-jre-openjdk-arm64-eclipse/11.0.22/linux-arm64",
+			wantName:    "java/jre",
+			wantVersion: "11.0.22+7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			cfg := defaultSBOMConfig().WithCatalogerSelection(
+				pkgcataloging.NewSelectionRequest().WithDefaults("binary-classifier-cataloger"),
+			)
+
+			s := catalogDirectoryWithConfig(t, filepath.FromSlash(snippetsDir+"/"+tt.fixture), cfg)
+
+			pkgs := s.Artifacts.Packages.Sorted()
+			require.Len(t, pkgs, 1)
+			assert.Equal(t, tt.wantName, pkgs[0].Name)
+			assert.Equal(t, tt.wantVersion, pkgs[0].Version)
+		})
+	}
+}
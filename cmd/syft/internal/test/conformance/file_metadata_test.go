@@ -0,0 +1,147 @@
+package conformance
+
+import (
+	"bytes"
+	"crypto"
+	"debug/elf"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cataloging/filecataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// Test_FileMetadataParity verifies that file metadata, digests, and executable details are captured identically
+// regardless of the platform syft is running on.
+func Test_FileMetadataParity(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "hello.txt"), []byte("hello, world!\n"), 0644)
+	writeFile(t, filepath.Join(dir, "bin", "app-le"), elfHeader(t, binary.LittleEndian, elf.EM_AARCH64), 0755)
+	writeFile(t, filepath.Join(dir, "bin", "app-be"), elfHeader(t, binary.BigEndian, elf.EM_S390), 0755)
+
+	filesCfg := filecataloging.DefaultConfig()
+	filesCfg.Selection = file.AllFilesSelection
+	filesCfg.Hashers = []crypto.Hash{crypto.SHA256}
+
+	cfg := defaultSBOMConfig().WithFilesConfig(filesCfg)
+
+	s := catalogDirectoryWithConfig(t, dir, cfg)
+
+	t.Run("regular file", func(t *testing.T) {
+		coords := coordinatesByPath(t, s, "/hello.txt")
+
+		metadata := s.Artifacts.FileMetadata[coords]
+		assert.Equal(t, int64(14), metadata.Size())
+		assert.Equal(t, "text/plain", metadata.MIMEType)
+
+		require.Len(t, s.Artifacts.FileDigests[coords], 1)
+		assert.Equal(t, "4dca0fd5f424a31b03ab807cbae77eb32bf2d089eed1cee154b3afed458de0dc", s.Artifacts.FileDigests[coords][0].Value)
+	})
+
+	t.Run("encoded file mode", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("windows does not support unix file permissions")
+		}
+
+		modes := encodedModesByPath(t, s)
+		assert.Equal(t, 644, modes["/hello.txt"])
+		assert.Equal(t, 755, modes["/bin/app-le"])
+		assert.Equal(t, 755, modes["/bin/app-be"])
+	})
+
+	t.Run("executables of either endianness", func(t *testing.T) {
+		le, ok := s.Artifacts.Executables[coordinatesByPath(t, s, "/bin/app-le")]
+		require.True(t, ok, "little endian ELF binary not detected as an executable")
+		be, ok := s.Artifacts.Executables[coordinatesByPath(t, s, "/bin/app-be")]
+		require.True(t, ok, "big endian ELF binary not detected as an executable")
+
+		assert.Equal(t, file.ELF, le.Format)
+		assert.Equal(t, le, be)
+	})
+}
+
+func writeFile(t *testing.T, path string, contents []byte, mode os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, contents, mode))
+	// the umask may have stripped permissions when writing the file
+	require.NoError(t, os.Chmod(path, mode))
+}
+
+// elfHeader returns a minimal 64-bit ELF executable (a header without any program or section headers, padded to a
+// full page) for the given byte order and machine.
+func elfHeader(t *testing.T, order binary.ByteOrder, machine elf.Machine) []byte {
+	t.Helper()
+
+	data := elf.ELFDATA2LSB
+	if order == binary.BigEndian {
+		data = elf.ELFDATA2MSB
+	}
+
+	header := elf.Header64{
+		Type:      uint16(elf.ET_EXEC),
+		Machine:   uint16(machine),
+		Version:   uint32(elf.EV_CURRENT),
+		Ehsize:    uint16(binary.Size(elf.Header64{})),
+		Phentsize: uint16(binary.Size(elf.Prog64{})),
+		Shentsize: uint16(binary.Size(elf.Section64{})),
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(data)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, order, header))
+	buf.Write(make([]byte, 4096-buf.Len()))
+	return buf.Bytes()
+}
+
+func coordinatesByPath(t *testing.T, s sbom.SBOM, path string) file.Coordinates {
+	t.Helper()
+	for _, coords := range s.AllCoordinates() {
+		if coords.RealPath == path {
+			return coords
+		}
+	}
+	require.Failf(t, "missing file", "no coordinates found for %q", path)
+	return file.Coordinates{}
+}
+
+// encodedModesByPath returns the file modes as encoded within the syft JSON format.
+func encodedModesByPath(t *testing.T, s sbom.SBOM) map[string]int {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, syftjson.NewFormatEncoder().Encode(&buf, s))
+
+	var doc struct {
+		Files []struct {
+			Location struct {
+				Path string `json:"path"`
+			} `json:"location"`
+			Metadata *struct {
+				Mode int `json:"mode"`
+			} `json:"metadata"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	modes := make(map[string]int)
+	for _, f := range doc.Files {
+		if f.Metadata != nil {
+			modes[f.Location.Path] = f.Metadata.Mode
+		}
+	}
+	return modes
+}
@@ -0,0 +1,41 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/syft/cmd/syft/internal/options"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func catalogDirectoryWithConfig(t *testing.T, dir string, cfg *syft.CreateSBOMConfig) sbom.SBOM {
+	t.Helper()
+
+	if len(cfg.CatalogerSelection.DefaultNamesOrTags) == 0 {
+		cfg.CatalogerSelection = cfg.CatalogerSelection.WithDefaults(pkgcataloging.DirectoryTag)
+	}
+
+	src, err := syft.GetSource(context.Background(), dir, syft.DefaultGetSourceConfig().WithSources("dir"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, src.Close())
+	})
+
+	s, err := syft.CreateSBOM(context.Background(), src, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+
+	return *s
+}
+
+func defaultSBOMConfig() *syft.CreateSBOMConfig {
+	return options.DefaultCatalog().ToSBOMConfig(clio.Identification{
+		Name:    "syft-tester",
+		Version: "v0.99.0",
+	})
+}
@@ -156,6 +156,8 @@ var dirOnlyTestCases = []testCase{
 		pkgInfo: map[string]string{
 			"@babel/code-frame": "7.10.4",
 			"get-stdin":         "8.0.0",
+			// globally installed packages
+			"typescript": "5.4.2",
 		},
 	},
 	{
@@ -171,6 +173,8 @@ var dirOnlyTestCases = []testCase{
 			"jsonschema":         "2.6.0",
 			"passlib":            "1.7.2",
 			"mypy":               "v0.770",
+			// pipx installed packages
+			"black": "24.2.0",
 			// common to image and directory
 			"pygments":     "2.6.1",
 			"requests":     "2.22.0",
@@ -207,6 +211,8 @@ var dirOnlyTestCases = []testCase{
 			"memchr":        "2.3.3",
 			"nom":           "4.2.3",
 			"version_check": "0.1.5",
+			// cargo installed crates
+			"ripgrep": "14.1.0",
 		},
 	},
 	{
@@ -268,8 +274,10 @@ var dirOnlyTestCases = []testCase{
 		duplicates:  1, // joda-time is included in both pom.xml AND the .jar collection
 		pkgInfo: map[string]string{
 			"example-java-app-maven": "0.1.0",
+			"gradle":                 "8.5", // pinned by the gradle wrapper
 			"joda-time":              "2.9.2",
 			"junit":                  "4.12",
+			"markdown":               "", // the jetbrains plugin jar (which has no version in the manifest)
 		},
 	},
 	{
@@ -396,6 +404,288 @@ var dirOnlyTestCases = []testCase{
 			"hdt": "0.5.2",
 		},
 	},
+	{
+		name:    "find bazel module packages",
+		pkgType: pkg.BazelModulePkg,
+		pkgInfo: map[string]string{
+			"rules_cc":  "0.0.9",
+			"platforms": "0.0.8",
+		},
+	},
+	{
+		name:    "find buck dependency packages",
+		pkgType: pkg.BuckPkg,
+		pkgInfo: map[string]string{
+			"zlib": "1.3.1",
+		},
+	},
+	{
+		name:        "find carthage packages",
+		pkgType:     pkg.CarthagePkg,
+		pkgLanguage: pkg.Swift,
+		pkgInfo: map[string]string{
+			"Alamofire": "5.6.4",
+		},
+	},
+	{
+		name:        "find unity packages",
+		pkgType:     pkg.UnityPkg,
+		pkgLanguage: pkg.Dotnet,
+		pkgInfo: map[string]string{
+			"com.unity.textmeshpro": "3.0.6",
+			"com.unity.ugui":        "1.0.0",
+		},
+	},
+	{
+		name:        "find unreal engine plugins",
+		pkgType:     pkg.UnrealPkg,
+		pkgLanguage: pkg.CPP,
+		pkgInfo: map[string]string{
+			"LowEntryExtStdLib": "3.4.0",
+		},
+	},
+	{
+		name:        "find jetbrains plugins",
+		pkgType:     pkg.JetBrainsPluginPkg,
+		pkgLanguage: pkg.Java,
+		pkgInfo: map[string]string{
+			"Markdown": "241.14494.240",
+		},
+	},
+	{
+		name:        "find vscode extensions",
+		pkgType:     pkg.VSCodeExtensionPkg,
+		pkgLanguage: pkg.JavaScript,
+		pkgInfo: map[string]string{
+			"golang.go": "0.41.0",
+		},
+	},
+	{
+		name:        "find android apps",
+		pkgType:     pkg.AndroidAppPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"com.example.hello": "1.0.0",
+		},
+	},
+	{
+		name:        "find ios apps",
+		pkgType:     pkg.IOSAppPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"com.example.hello": "1.0.0",
+		},
+	},
+	{
+		name:        "find ios frameworks",
+		pkgType:     pkg.IOSFrameworkPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"Alamofire": "5.8.1",
+		},
+	},
+	{
+		name:        "find macos apps",
+		pkgType:     pkg.MacOSAppPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"com.example.hello": "1.2.3",
+		},
+	},
+	{
+		name:        "find macos frameworks",
+		pkgType:     pkg.MacOSFrameworkPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"Sparkle":     "2.6.0",
+			"libcrypto.3": "3.0.0",
+		},
+	},
+	{
+		name:        "find macos installer packages",
+		pkgType:     pkg.MacOSInstallerPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"com.example.tools": "3.1",
+		},
+	},
+	{
+		name:        "find windows assemblies",
+		pkgType:     pkg.WindowsAssemblyPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"Microsoft.Windows.Common-Controls": "6.0.19041.1110",
+		},
+	},
+	{
+		name:        "find windows drivers",
+		pkgType:     pkg.WindowsDriverPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"contosonet": "2.4.1.0",
+		},
+	},
+	{
+		name:        "find windows installer packages",
+		pkgType:     pkg.WindowsMSIPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		duplicates:  1, // the widget installer is also within the payload of the agent setup program
+		pkgInfo: map[string]string{
+			"Contoso Widget": "4.2.0.1200",
+		},
+	},
+	{
+		name:        "find windows setup programs",
+		pkgType:     pkg.WindowsInstallerPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"Contoso Agent": "3.1.0",
+		},
+	},
+	{
+		name:        "find snaps",
+		pkgType:     pkg.SnapPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"contoso-agent": "3.1.0",
+		},
+	},
+	{
+		name:        "find ml models",
+		pkgType:     pkg.MLModelPkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"TinyLlama": "v1.1",
+		},
+	},
+	{
+		name:        "find firmware images",
+		pkgType:     pkg.FirmwarePkg,
+		pkgLanguage: pkg.UnknownLanguage,
+		pkgInfo: map[string]string{
+			"SystemFirmware":  "65538",
+			"bcm2711-rpi-4-b": "",
+			// described by the linux-firmware WHENCE file
+			"iwlwifi": "",
+		},
+	},
+	{
+		name:        "find wasm modules",
+		pkgType:     pkg.WasmModulePkg,
+		pkgLanguage: pkg.Rust,
+		pkgInfo: map[string]string{
+			"hello": "0.1.0",
+		},
+	},
+	{
+		name:    "find vim plugins",
+		pkgType: pkg.VimPluginPkg,
+		pkgInfo: map[string]string{
+			"telescope.nvim": "a0bbec21143c7bc5f8bb02e0005fa0b982edc026",
+		},
+	},
+	{
+		name:    "find ansible collections",
+		pkgType: pkg.AnsibleCollectionPkg,
+		pkgInfo: map[string]string{
+			"community.general": "8.6.0",
+		},
+	},
+	{
+		name:    "find ansible roles",
+		pkgType: pkg.AnsibleRolePkg,
+		pkgInfo: map[string]string{
+			"geerlingguy.docker": "7.0.2",
+		},
+	},
+	{
+		name:    "find chef cookbooks",
+		pkgType: pkg.ChefCookbookPkg,
+		pkgInfo: map[string]string{
+			"apt": "7.5.22",
+		},
+	},
+	{
+		name:    "find helm charts",
+		pkgType: pkg.HelmChartPkg,
+		pkgInfo: map[string]string{
+			"nginx": "18.1.2",
+		},
+	},
+	{
+		name:    "find pulumi plugins",
+		pkgType: pkg.PulumiPluginPkg,
+		pkgInfo: map[string]string{
+			"aws": "6.45.0",
+		},
+	},
+	{
+		name:    "find puppet modules",
+		pkgType: pkg.PuppetModulePkg,
+		pkgInfo: map[string]string{
+			"puppetlabs-stdlib": "9.6.0",
+		},
+	},
+	{
+		name:    "find texlive packages",
+		pkgType: pkg.TeXLivePkg,
+		pkgInfo: map[string]string{
+			"amsmath": "2.17t",
+		},
+	},
+	{
+		name:    "find toolchains",
+		pkgType: pkg.ToolchainPkg,
+		pkgInfo: map[string]string{
+			"nodejs": "20.11.0",
+			"maven":  "3.9.6",
+		},
+	},
+	{
+		name:        "find osgi bundles",
+		pkgType:     pkg.OSGiBundlePkg,
+		pkgLanguage: pkg.Java,
+		pkgInfo: map[string]string{
+			"org.eclipse.equinox.common": "3.18.100.v20230730-1117",
+		},
+	},
+	{
+		name:    "find images referenced by dev environments",
+		pkgType: pkg.ContainerImagePkg,
+		pkgInfo: map[string]string{
+			"mcr.microsoft.com/devcontainers/go": "1.22",
+		},
+	},
+	{
+		name:    "find dev container features",
+		pkgType: pkg.DevContainerFeaturePkg,
+		pkgInfo: map[string]string{
+			"ghcr.io/devcontainers/features/node": "1",
+		},
+	},
+	{
+		name:    "find emacs packages",
+		pkgType: pkg.EmacsPackagePkg,
+		pkgInfo: map[string]string{
+			"magit": "4992c3d1f64e0e983692c7a61d47069f47380dbf",
+		},
+	},
+	{
+		name:        "find browser extensions",
+		pkgType:     pkg.BrowserExtensionPkg,
+		pkgLanguage: pkg.JavaScript,
+		pkgInfo: map[string]string{
+			"uBlock Origin": "1.55.0",
+		},
+	},
+	{
+		name:        "find meson wrap packages",
+		pkgType:     pkg.MesonWrapPkg,
+		pkgLanguage: pkg.CPP,
+		pkgInfo: map[string]string{
+			"fmt": "10.2.1",
+		},
+	},
 	{
 		name:    "find github action packages (from usage in workflow files and composite actions)",
 		pkgType: pkg.GithubActionPkg,
@@ -80,6 +80,41 @@ func TestPkgCoverageImage(t *testing.T) {
 	definedPkgs.Remove(string(pkg.SwiplPackPkg))
 	definedPkgs.Remove(string(pkg.GithubActionPkg))
 	definedPkgs.Remove(string(pkg.GithubActionWorkflowPkg))
+	definedPkgs.Remove(string(pkg.BazelModulePkg))
+	definedPkgs.Remove(string(pkg.BuckPkg))
+	definedPkgs.Remove(string(pkg.MesonWrapPkg))
+	definedPkgs.Remove(string(pkg.CarthagePkg))
+	definedPkgs.Remove(string(pkg.UnityPkg))
+	definedPkgs.Remove(string(pkg.UnrealPkg))
+	definedPkgs.Remove(string(pkg.JetBrainsPluginPkg))
+	definedPkgs.Remove(string(pkg.VSCodeExtensionPkg))
+	definedPkgs.Remove(string(pkg.WasmModulePkg))
+	definedPkgs.Remove(string(pkg.AndroidAppPkg))
+	definedPkgs.Remove(string(pkg.IOSAppPkg), string(pkg.IOSFrameworkPkg))
+	definedPkgs.Remove(string(pkg.MacOSAppPkg), string(pkg.MacOSFrameworkPkg), string(pkg.MacOSInstallerPkg))
+	definedPkgs.Remove(string(pkg.WindowsAssemblyPkg), string(pkg.WindowsDriverPkg), string(pkg.WindowsInstallerPkg), string(pkg.WindowsMSIPkg))
+	definedPkgs.Remove(string(pkg.MLModelPkg))
+	definedPkgs.Remove(string(pkg.FirmwarePkg))
+	definedPkgs.Remove(string(pkg.BrowserExtensionPkg))
+	definedPkgs.Remove(string(pkg.VimPluginPkg))
+	definedPkgs.Remove(string(pkg.EmacsPackagePkg))
+	definedPkgs.Remove(string(pkg.TeXLivePkg))
+	definedPkgs.Remove(string(pkg.ToolchainPkg))
+	definedPkgs.Remove(string(pkg.DevContainerFeaturePkg))
+	definedPkgs.Remove(string(pkg.OSGiBundlePkg))
+	definedPkgs.Remove(string(pkg.AnsibleCollectionPkg))
+	definedPkgs.Remove(string(pkg.AnsibleRolePkg))
+	definedPkgs.Remove(string(pkg.PuppetModulePkg))
+	definedPkgs.Remove(string(pkg.ChefCookbookPkg))
+	definedPkgs.Remove(string(pkg.PulumiPluginPkg))
+	definedPkgs.Remove(string(pkg.HelmChartPkg))
+	definedPkgs.Remove(string(pkg.APIServicePkg))
+	definedPkgs.Remove(string(pkg.CryptoAssetPkg))
+	definedPkgs.Remove(string(pkg.OSAccountPkg))
+	definedPkgs.Remove(string(pkg.ScheduledTaskPkg))
+	definedPkgs.Remove(string(pkg.SnapPkg))
+	definedPkgs.Remove(string(pkg.NetworkServicePkg))
+	definedPkgs.Remove(string(pkg.ContainerImagePkg))
 
 	var cases []testCase
 	cases = append(cases, commonTestCases...)
@@ -220,6 +255,12 @@ func TestPkgCoverageDirectory(t *testing.T) {
 	definedPkgs.Remove(string(pkg.LinuxKernelModulePkg))
 	definedPkgs.Remove(string(pkg.Rpkg))
 	definedPkgs.Remove(string(pkg.UnknownPkg))
+	// only cataloged when explicitly selected
+	definedPkgs.Remove(string(pkg.APIServicePkg))
+	definedPkgs.Remove(string(pkg.OSAccountPkg))
+	definedPkgs.Remove(string(pkg.ScheduledTaskPkg))
+	definedPkgs.Remove(string(pkg.NetworkServicePkg))
+	definedPkgs.Remove(string(pkg.CryptoAssetPkg))
 
 	// for directory scans we should not expect to see any of the following package types
 	definedPkgs.Remove(string(pkg.KbPkg))
@@ -67,7 +67,7 @@ func TestEncodeDecodeEncodeCycleComparison(t *testing.T) {
 
 	opts := options.DefaultOutput()
 	require.NoError(t, opts.PostLoad())
-	encoderList, err := opts.Encoders()
+	encoderList, err := opts.Format.Encoders()
 	require.NoError(t, err)
 
 	encoders := format.NewEncoderCollection(encoderList...)
@@ -53,8 +53,9 @@ func TestAllPackageCatalogersReachableInTasks(t *testing.T) {
 	assert.Equal(t, len(taskTagsByName), constructorCount, "mismatch in number of cataloger constructors and task names")
 
 	for taskName, tags := range taskTagsByName {
-		if taskName == "sbom-cataloger" {
-			continue // this is a special case
+		switch taskName {
+		case "sbom-cataloger", "api-service-cataloger", "crypto-material-cataloger", "crypto-library-cataloger", "os-account-cataloger", "scheduled-task-cataloger", "network-service-cataloger", "container-image-reference-cataloger":
+			continue // these are special cases (only used when explicitly selected)
 		}
 		if !strset.New(tags...).HasAny(pkgcataloging.ImageTag, pkgcataloging.DirectoryTag) {
 			t.Errorf("task %q is missing 'directory' or 'image' a tag", taskName)
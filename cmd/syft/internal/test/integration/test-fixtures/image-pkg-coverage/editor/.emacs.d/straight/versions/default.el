@@ -0,0 +1,2 @@
+(("magit" . "4992c3d1f64e0e983692c7a61d47069f47380dbf"))
+:gamma
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/anchore/syft/syft/buildkit"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// This is an SBOM generator for BuildKit, which catalogs the image (and any build stages marked with
+// BUILDKIT_SBOM_SCAN_STAGE=true) while the image is being built. Build this into an image and reference it with:
+//
+//	docker buildx build --attest type=sbom,generator=<this-image> .
+func main() {
+	cfg, err := buildkit.ConfigFromEnvironment()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// an example hook that can amend each SBOM with information known during the build
+	cfg.BeforeExport = func(target buildkit.Target, s *sbom.SBOM) error {
+		s.Descriptor.Name = "buildkit-syft-example"
+		fmt.Fprintf(os.Stderr, "cataloged %d packages from %s\n", s.Artifacts.Packages.PackageCount(), target)
+		return nil
+	}
+
+	if err := buildkit.Scan(context.Background(), cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/imagebuilder"
+)
+
+/*
+ This shows how an image builder that assembles images in-process (such as ko) can catalog the image it is building
+ without first pushing it to a registry or saving it as a tarball. The base image is fetched as the builder would, and
+ the application layer is created from a local directory (standing in for the layers the builder creates). The version
+ of the main module of the application binary is passed along since binaries built from a local checkout report
+ "(devel)" as the main module version.
+*/
+
+const defaultBaseImage = "cgr.dev/chainguard/static:latest"
+
+func main() {
+	base, err := crane.Pull(defaultBaseImage, crane.WithPlatform(&v1.Platform{OS: "linux", Architecture: "amd64"}))
+	if err != nil {
+		panic(err)
+	}
+
+	app, err := imagebuilder.LayerFromFS(os.DirFS(appDirectory()), "/ko-app")
+	if err != nil {
+		panic(err)
+	}
+
+	s, err := imagebuilder.CreateSBOM(context.Background(), imagebuilder.Image{
+		Reference: "ko.local/app:latest",
+		Base:      base,
+		Layers:    []v1.Layer{app},
+		Modules: []imagebuilder.Module{
+			{BinaryPath: "/ko-app/app", Path: "github.com/example/app", Version: "v1.2.3"},
+		},
+	}, imagebuilder.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	// show the SBOM as syft JSON
+	if err := syftjson.NewFormatEncoder().Encode(os.Stdout, *s); err != nil {
+		panic(err)
+	}
+}
+
+func appDirectory() string {
+	// read the directory to place into the application layer from the command line or use the current directory
+	if len(os.Args) > 1 {
+		return os.Args[1]
+	}
+	return "."
+}
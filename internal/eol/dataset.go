@@ -0,0 +1,171 @@
+/*
+Package eol provides the release cycles of products (operating system distributions and language runtimes) along with
+when each cycle reaches its end of life, in the shape of the endoflife.date API (https://endoflife.date/docs/api).
+*/
+package eol
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/cache"
+	"github.com/anchore/syft/internal/log"
+)
+
+//go:embed data/eol.json
+var vendoredData []byte
+
+// Cycle is a single release cycle of a product (e.g. "3.12" for python).
+type Cycle struct {
+	Cycle string `json:"cycle"`
+	EOL   Date   `json:"eol"`
+}
+
+// Date is when a release cycle reaches its end of life: either a date (YYYY-MM-DD), or "true" or "false" when it is
+// only known whether the cycle has reached its end of life.
+type Date string
+
+// UnmarshalJSON accepts both dates and booleans, as either may be given by the endoflife.date API.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case string:
+		*d = Date(v)
+	case bool:
+		*d = Date(fmt.Sprintf("%t", v))
+	case nil:
+		*d = ""
+	default:
+		return fmt.Errorf("unexpected end of life value: %s", string(data))
+	}
+	return nil
+}
+
+// IsDate indicates if the end of life is a specific date (as opposed to only whether the end of life was reached).
+func (d Date) IsDate() bool {
+	_, err := time.Parse(time.DateOnly, string(d))
+	return err == nil
+}
+
+type vendoredDataset struct {
+	Updated  string             `json:"updated"`
+	Products map[string][]Cycle `json:"products"`
+}
+
+// Dataset provides the release cycles of products from the dataset vendored within syft or, when an API URL is given,
+// from an endoflife.date compatible API. API responses are cached across runs, and the vendored dataset is used when
+// a product cannot be fetched.
+type Dataset struct {
+	apiURL   string
+	vendored vendoredDataset
+	cache    cache.Resolver[[]Cycle]
+}
+
+// NewDataset returns the release cycle dataset, optionally updated from the given endoflife.date compatible API URL
+// (e.g. "https://endoflife.date/api").
+func NewDataset(apiURL string) (*Dataset, error) {
+	var vendored vendoredDataset
+	if err := json.Unmarshal(vendoredData, &vendored); err != nil {
+		return nil, fmt.Errorf("unable to parse vendored end of life dataset: %w", err)
+	}
+
+	d := &Dataset{
+		apiURL:   strings.TrimSuffix(apiURL, "/"),
+		vendored: vendored,
+	}
+	if d.apiURL != "" {
+		d.cache = cache.GetResolver[[]Cycle]("eol", "v1")
+	}
+	return d, nil
+}
+
+// Cycles returns all known release cycles of the given product (e.g. "python").
+func (d *Dataset) Cycles(product string) []Cycle {
+	if d.cache != nil {
+		cycles, err := d.cache.Resolve(product, func() ([]Cycle, error) {
+			return d.fetch(product)
+		})
+		if err == nil && len(cycles) > 0 {
+			return cycles
+		}
+		log.WithFields("product", product, "error", err).Debug("unable to fetch release cycles, using the vendored dataset")
+	}
+	return d.vendored.Products[product]
+}
+
+// Find returns the release cycle of the product that the given version belongs to (e.g. "3.12" for python 3.12.1).
+// When multiple cycles match, the most specific cycle is returned.
+func (d *Dataset) Find(product, version string) (Cycle, bool) {
+	version = normalizeVersion(version)
+	if version == "" {
+		return Cycle{}, false
+	}
+
+	var found Cycle
+	for _, c := range d.Cycles(product) {
+		if !inCycle(version, c.Cycle) {
+			continue
+		}
+		if len(c.Cycle) > len(found.Cycle) {
+			found = c
+		}
+	}
+	return found, found.Cycle != ""
+}
+
+func (d *Dataset) fetch(product string) ([]Cycle, error) {
+	requestURL := fmt.Sprintf("%s/%s.json", d.apiURL, url.PathEscape(product))
+	log.Tracef("fetching release cycles from %s", requestURL)
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	resp, err := client.Get(requestURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch release cycles: %w", err)
+	}
+	defer internal.CloseAndLogError(resp.Body, requestURL)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch release cycles: %s", resp.Status)
+	}
+
+	var cycles []Cycle
+	if err := json.NewDecoder(resp.Body).Decode(&cycles); err != nil {
+		return nil, fmt.Errorf("unable to parse release cycles: %w", err)
+	}
+	return cycles, nil
+}
+
+// normalizeVersion removes parts of a version that are not part of the upstream version, such as an epoch
+// (e.g. "1:3.11.2-1") or a prefix (e.g. "go1.22.1" or "v20.11.0").
+func normalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if _, rest, found := strings.Cut(version, ":"); found {
+		version = rest
+	}
+	version = strings.TrimPrefix(version, "go")
+	version = strings.TrimPrefix(version, "v")
+	return version
+}
+
+// inCycle indicates if the version belongs to the release cycle, where the cycle must match a whole number of version
+// components (e.g. "3.1" matches "3.1.4" but not "3.11.2").
+func inCycle(version, cycle string) bool {
+	if cycle == "" || !strings.HasPrefix(version, cycle) {
+		return false
+	}
+	rest := version[len(cycle):]
+	return rest == "" || strings.IndexAny(rest[:1], ".-+_~") == 0
+}
@@ -0,0 +1,136 @@
+package eol
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataset_Find(t *testing.T) {
+	d, err := NewDataset("")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		product string
+		version string
+		want    Cycle
+		found   bool
+	}{
+		{
+			name:    "patch version",
+			product: "python",
+			version: "3.8.10",
+			want:    Cycle{Cycle: "3.8", EOL: "2024-10-07"},
+			found:   true,
+		},
+		{
+			name:    "cycle is not a prefix of a longer component",
+			product: "python",
+			version: "3.10.4",
+			want:    Cycle{Cycle: "3.10", EOL: "2026-10-31"},
+			found:   true,
+		},
+		{
+			name:    "distro package version with epoch and revision",
+			product: "python",
+			version: "1:3.11.2-6+deb12u1",
+			want:    Cycle{Cycle: "3.11", EOL: "2027-10-31"},
+			found:   true,
+		},
+		{
+			name:    "major version cycles",
+			product: "nodejs",
+			version: "v18.17.1",
+			want:    Cycle{Cycle: "18", EOL: "2025-04-30"},
+			found:   true,
+		},
+		{
+			name:    "go stdlib version",
+			product: "go",
+			version: "go1.21.3",
+			want:    Cycle{Cycle: "1.21", EOL: "2024-08-13"},
+			found:   true,
+		},
+		{
+			name:    "exact version",
+			product: "ubuntu",
+			version: "22.04",
+			want:    Cycle{Cycle: "22.04", EOL: "2027-06-01"},
+			found:   true,
+		},
+		{
+			name:    "still supported without a date",
+			product: "go",
+			version: "1.24.2",
+			want:    Cycle{Cycle: "1.24", EOL: "false"},
+			found:   true,
+		},
+		{
+			name:    "unknown cycle",
+			product: "python",
+			version: "4.0.0",
+		},
+		{
+			name:    "unknown product",
+			product: "cobol",
+			version: "1.0",
+		},
+		{
+			name:    "no version",
+			product: "python",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := d.Find(tt.product, tt.version)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDataset_Remote(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.URL.Path != "/api/python.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"cycle": "3.14", "eol": "2030-10-31", "latest": "3.14.0"},
+			{"cycle": "3.13", "eol": true},
+		})
+	}))
+	defer server.Close()
+
+	d, err := NewDataset(server.URL + "/api/")
+	require.NoError(t, err)
+
+	got, found := d.Find("python", "3.14.0")
+	assert.True(t, found)
+	assert.Equal(t, Cycle{Cycle: "3.14", EOL: "2030-10-31"}, got)
+
+	got, found = d.Find("python", "3.13.1")
+	assert.True(t, found)
+	assert.Equal(t, Cycle{Cycle: "3.13", EOL: "true"}, got)
+
+	// the vendored dataset is used when the product cannot be fetched
+	got, found = d.Find("nodejs", "20.11.0")
+	assert.True(t, found)
+	assert.Equal(t, Cycle{Cycle: "20", EOL: "2026-04-30"}, got)
+
+	assert.Contains(t, requests, "/api/nodejs.json")
+}
+
+func TestDate_IsDate(t *testing.T) {
+	assert.True(t, Date("2024-10-07").IsDate())
+	assert.False(t, Date("true").IsDate())
+	assert.False(t, Date("false").IsDate())
+	assert.False(t, Date("").IsDate())
+}
@@ -0,0 +1,46 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// FilesystemImage is a kind of filesystem image (e.g. a squashfs or ISO image) that can be extracted into a directory.
+type FilesystemImage struct {
+	Name    string
+	Detect  func(path string) bool
+	Extract func(imagePath, dir string) error
+}
+
+// filesystemImages are the kinds of filesystem images that can be extracted, in the order they are detected.
+var filesystemImages = []FilesystemImage{
+	{Name: "squashfs", Detect: IsSquashfs, Extract: ExtractSquashfs},
+	{Name: "ISO", Detect: IsISO, Extract: ExtractISO},
+}
+
+// DetectFilesystemImage returns the kind of filesystem image of the given file as detected by its contents (since
+// there are no common file extensions for them, e.g. squashfs images may be ".squashfs", ".sqsh", ".snap", or ".img"
+// files), or nil if the file is not a filesystem image.
+func DetectFilesystemImage(path string) *FilesystemImage {
+	for _, image := range filesystemImages {
+		if image.Detect(path) {
+			return &image
+		}
+	}
+	return nil
+}
+
+// extractSymlink creates a symlink at the given target for the named entry of an extracted filesystem image. Absolute
+// link targets are made relative, so that they resolve within the extracted image instead of the host.
+func extractSymlink(name, linkTarget, target string) error {
+	if path.IsAbs(linkTarget) {
+		var err error
+		linkTarget, err = filepath.Rel(filepath.Dir("/"+name), linkTarget)
+		if err != nil {
+			return fmt.Errorf("unable to relativize symlink %q: %w", name, err)
+		}
+	}
+	return os.Symlink(filepath.FromSlash(linkTarget), target)
+}
@@ -0,0 +1,82 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/anchore/syft/internal/isoimage"
+	"github.com/anchore/syft/internal/log"
+)
+
+// IsISO indicates if the given file is an ISO image (e.g. an installer or live image with an ISO9660 or UDF
+// filesystem), regardless of the file extension.
+func IsISO(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	return isoimage.IsImage(f)
+}
+
+// ExtractISO extracts the directories, regular files, and symlinks within the given ISO image into the given directory,
+// along with any boot images that are not otherwise files within the image (within a "[BOOT]" directory). Absolute
+// symlinks are made relative so that they resolve within the extracted image instead of the host. Device files, named
+// pipes, and sockets are not extracted.
+func ExtractISO(imagePath, dir string) error {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return fmt.Errorf("unable to open ISO image: %w", err)
+	}
+	defer f.Close()
+
+	image, err := isoimage.Open(f)
+	if err != nil {
+		return fmt.Errorf("unable to read ISO image: %w", err)
+	}
+	log.WithFields("path", imagePath, "format", image.Format).Trace("extracting ISO image")
+
+	extracted := make(map[string]bool)
+	skipped := make(map[string]bool)
+	for _, entry := range image.Files() {
+		if extracted[entry.Path] || skipped[path.Dir(entry.Path)] {
+			// never extract through an entry that has already been extracted (e.g. a symlink with the name of a directory)
+			log.WithFields("path", entry.Path).Debug("skipping duplicate entry within ISO image")
+			if entry.Mode.IsDir() {
+				skipped[entry.Path] = true
+			}
+			continue
+		}
+		extracted[entry.Path] = true
+		target := filepath.Join(dir, filepath.FromSlash(entry.Path))
+
+		var err error
+		switch {
+		case entry.Mode.IsDir():
+			err = os.MkdirAll(target, entry.Mode.Perm()|0o700)
+		case entry.Mode&os.ModeSymlink != 0:
+			err = extractSymlink(entry.Path, entry.LinkTarget, target)
+		case entry.Mode.IsRegular():
+			err = extractISOFile(entry, target)
+		default:
+			log.WithFields("path", entry.Path, "type", entry.Mode.Type().String()).Trace("skipping special file within ISO image")
+		}
+		if err != nil {
+			return fmt.Errorf("unable to extract %q within ISO image: %w", entry.Path, err)
+		}
+	}
+	return nil
+}
+
+func extractISOFile(entry isoimage.File, target string) error {
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, entry.Mode.Perm()|0o600)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+
+	return safeCopy(dst, entry.Open())
+}
@@ -0,0 +1,46 @@
+//go:build !windows
+// +build !windows
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsISO(t *testing.T) {
+	assert.True(t, IsISO("test-fixtures/iso/rootfs.iso"))
+	assert.False(t, IsISO("test-fixtures/squashfs/rootfs-gzip.squashfs"))
+	assert.False(t, IsISO("test-fixtures/iso/missing.iso"))
+}
+
+func TestExtractISO(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, ExtractISO("test-fixtures/iso/rootfs.iso", dir))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "var", "lib", "dpkg", "status"))
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "Package: zlib1g")
+
+	// absolute symlinks resolve within the extracted image
+	link, err := os.Readlink(filepath.Join(dir, "etc", "os-release"))
+	require.NoError(t, err)
+	assert.Equal(t, "../usr/lib/os-release", link)
+
+	contents, err = os.ReadFile(filepath.Join(dir, "etc", "os-release"))
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "ID=debian")
+
+	// boot images that are not files within the image are extracted as well
+	contents, err = os.ReadFile(filepath.Join(dir, "[BOOT]", "1-EFI-NoEmul.img"))
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "EFI BOOT IMAGE")
+}
+
+func TestExtractISO_notISO(t *testing.T) {
+	require.Error(t, ExtractISO("test-fixtures/digest.txt", t.TempDir()))
+}
@@ -0,0 +1,119 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/sylabs/squashfs"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// squashfsMagic is found at the start of every squashfs image (little-endian, as written by mksquashfs).
+var squashfsMagic = []byte("hsqs")
+
+// IsSquashfs indicates if the given file is a squashfs image (e.g. a snap, firmware, or live-CD root filesystem),
+// regardless of the file extension.
+func IsSquashfs(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	header := make([]byte, len(squashfsMagic))
+	if _, err := io.ReadFull(f, header); err != nil {
+		return false
+	}
+	return bytes.Equal(header, squashfsMagic)
+}
+
+// ExtractSquashfs extracts the directories, regular files, and symlinks within the given squashfs image (with any
+// compression supported by squashfs, e.g. gzip, xz, or zstd) into the given directory. Absolute symlinks are made
+// relative so that they resolve within the extracted image instead of the host. Device files, named pipes, and sockets
+// are not extracted.
+func ExtractSquashfs(imagePath, dir string) error {
+	f, err := os.Open(imagePath)
+	if err != nil {
+		return fmt.Errorf("unable to open squashfs image: %w", err)
+	}
+	defer f.Close()
+
+	image, err := squashfs.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("unable to read squashfs image: %w", err)
+	}
+
+	extracted := make(map[string]bool)
+	return fs.WalkDir(image, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if extracted[name] {
+			// never extract through an entry that has already been extracted (e.g. a symlink with the name of a directory)
+			log.WithFields("path", name).Debug("skipping duplicate entry within squashfs image")
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		extracted[name] = true
+		target := filepath.Join(dir, filepath.FromSlash(name))
+
+		if d.IsDir() {
+			return os.MkdirAll(target, directoryPerm(d))
+		}
+		return extractSquashfsEntry(image, name, target)
+	})
+}
+
+// extractSquashfsEntry extracts a non-directory entry within a squashfs image. Note that the entry type is only known
+// once the entry has been opened, since directory listings do not describe symlinks or special files.
+func extractSquashfsEntry(image *squashfs.Reader, name, target string) error {
+	f, err := image.Open(name)
+	if err != nil {
+		return fmt.Errorf("unable to open %q within squashfs image: %w", name, err)
+	}
+	defer f.Close()
+
+	entry, ok := f.(*squashfs.File)
+	if !ok {
+		return fmt.Errorf("unexpected file type %T for %q within squashfs image", f, name)
+	}
+
+	switch {
+	case entry.IsSymlink():
+		return extractSymlink(name, entry.SymlinkPath(), target)
+	case entry.IsRegular():
+		return extractSquashfsFile(entry, name, target)
+	default:
+		log.WithFields("path", name).Trace("skipping special file within squashfs image")
+		return nil
+	}
+}
+
+func extractSquashfsFile(entry *squashfs.File, name, target string) error {
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, entry.Mode().Perm()|0o600)
+	if err != nil {
+		return fmt.Errorf("unable to create %q: %w", target, err)
+	}
+	defer dst.Close()
+
+	if err := safeCopy(dst, entry); err != nil {
+		return fmt.Errorf("unable to extract %q within squashfs image: %w", name, err)
+	}
+	return nil
+}
+
+// directoryPerm returns the permissions of the given directory, ensuring that the directory can still be extracted into.
+func directoryPerm(d fs.DirEntry) fs.FileMode {
+	perm := fs.FileMode(0o700)
+	if info, err := d.Info(); err == nil {
+		perm |= info.Mode().Perm()
+	}
+	return perm
+}
@@ -0,0 +1,80 @@
+//go:build !windows
+// +build !windows
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSquashfs(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{
+			name: "squashfs image",
+			path: "test-fixtures/squashfs/rootfs-gzip.squashfs",
+			want: true,
+		},
+		{
+			name: "other file",
+			path: "test-fixtures/digest.txt",
+			want: false,
+		},
+		{
+			name: "missing file",
+			path: "test-fixtures/squashfs/missing.squashfs",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSquashfs(tt.path))
+		})
+	}
+}
+
+func TestExtractSquashfs(t *testing.T) {
+	for _, compression := range []string{"gzip", "xz", "zstd"} {
+		t.Run(compression, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, ExtractSquashfs(filepath.Join("test-fixtures", "squashfs", "rootfs-"+compression+".squashfs"), dir))
+
+			contents, err := os.ReadFile(filepath.Join(dir, "var", "lib", "dpkg", "status"))
+			require.NoError(t, err)
+			assert.Contains(t, string(contents), "Package: zlib1g")
+
+			// absolute symlinks resolve within the extracted image
+			link, err := os.Readlink(filepath.Join(dir, "etc", "os-release"))
+			require.NoError(t, err)
+			assert.Equal(t, "../usr/lib/os-release", link)
+
+			contents, err = os.ReadFile(filepath.Join(dir, "etc", "os-release"))
+			require.NoError(t, err)
+			assert.Contains(t, string(contents), "ID=debian")
+		})
+	}
+}
+
+func TestExtractSquashfs_notSquashfs(t *testing.T) {
+	require.Error(t, ExtractSquashfs("test-fixtures/digest.txt", t.TempDir()))
+}
+
+func TestExtractSquashfs_duplicateEntries(t *testing.T) {
+	// the image lists a symlink to a path outside of the extraction directory, followed by a file with the same name
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "contents")
+	require.NoError(t, ExtractSquashfs("test-fixtures/squashfs/duplicate-entries.squashfs", dir))
+
+	// only one of the entries is extracted, and nothing is written through the symlink
+	assert.NoFileExists(t, filepath.Join(parent, "outside"))
+	_, err := os.Lstat(filepath.Join(dir, "etc", "passwd"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,139 @@
+package isoimage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+)
+
+const (
+	// bootDirectory is the directory that boot images are listed within, as done by 7-Zip.
+	bootDirectory = "[BOOT]"
+
+	bootCatalogEntrySize = 32
+	// virtualSectorSize is the size of the sectors counted by boot catalog entries.
+	virtualSectorSize = 512
+
+	validationEntryHeader = 0x01
+	sectionHeader         = 0x90
+	finalSectionHeader    = 0x91
+	bootableIndicator     = 0x88
+	extensionIndicator    = 0x44
+	extensionFollowsFlag  = 0x20
+)
+
+var (
+	bootPlatforms = map[byte]string{
+		0x00: "x86",
+		0x01: "PowerPC",
+		0x02: "Mac",
+		0xEF: "EFI",
+	}
+
+	// bootMediaSizes are the sizes of emulated floppy disks, by media type.
+	bootMediaSizes = map[byte]int64{
+		1: 1200 * 1024,
+		2: 1440 * 1024,
+		3: 2880 * 1024,
+	}
+
+	bootMediaNames = map[byte]string{
+		0: "NoEmul",
+		1: "1.2M",
+		2: "1.44M",
+		3: "2.88M",
+		4: "HardDisk",
+	}
+)
+
+// bootImages returns the boot images described by the El Torito boot catalog at the given sector, excluding boot
+// images that are already files within the image (such as isolinux.bin or efiboot.img).
+func bootImages(r io.ReaderAt, catalogSector uint32, files []File) ([]File, error) {
+	catalog, err := readAt(r, int64(catalogSector)*sectorSize, sectorSize)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read El Torito boot catalog: %w", err)
+	}
+	if catalog[0] != validationEntryHeader || catalog[30] != 0x55 || catalog[31] != 0xAA {
+		return nil, fmt.Errorf("invalid El Torito boot catalog at sector %d", catalogSector)
+	}
+
+	fileOffsets := make(map[int64]bool)
+	for _, f := range files {
+		if len(f.extents) > 0 {
+			fileOffsets[f.extents[0].offset] = true
+		}
+	}
+
+	var images []File
+	add := func(platform byte, entry []byte) {
+		if entry[0] != bootableIndicator {
+			return
+		}
+		media := entry[1] & 0x0F
+		offset := int64(binary.LittleEndian.Uint32(entry[8:12])) * sectorSize
+		size, ok := bootMediaSizes[media]
+		if !ok {
+			size = int64(binary.LittleEndian.Uint16(entry[6:8])) * virtualSectorSize
+		}
+		if size == 0 || fileOffsets[offset] {
+			return
+		}
+
+		name := fmt.Sprintf("%d-%s-%s.img", len(images)+1, bootPlatformName(platform), bootMediaName(media))
+		images = append(images, File{
+			Path:    bootDirectory + "/" + name,
+			Mode:    0o444,
+			Size:    size,
+			r:       r,
+			extents: []extent{{offset: offset, length: size}},
+		})
+	}
+
+	// the validation entry describes the platform of the initial (default) entry that follows it
+	add(catalog[1], catalog[bootCatalogEntrySize:2*bootCatalogEntrySize])
+
+	for pos := 2 * bootCatalogEntrySize; pos+bootCatalogEntrySize <= len(catalog); {
+		header := catalog[pos : pos+bootCatalogEntrySize]
+		if header[0] != sectionHeader && header[0] != finalSectionHeader {
+			break
+		}
+		platform := header[1]
+		entries := int(binary.LittleEndian.Uint16(header[2:4]))
+		pos += bootCatalogEntrySize
+
+		for i := 0; i < entries && pos+bootCatalogEntrySize <= len(catalog); i++ {
+			entry := catalog[pos : pos+bootCatalogEntrySize]
+			add(platform, entry)
+			pos += bootCatalogEntrySize
+
+			// section entries may be followed by extension entries
+			for entry[1]&extensionFollowsFlag != 0 && pos+bootCatalogEntrySize <= len(catalog) && catalog[pos] == extensionIndicator {
+				entry = catalog[pos : pos+bootCatalogEntrySize]
+				pos += bootCatalogEntrySize
+			}
+		}
+		if header[0] == finalSectionHeader {
+			break
+		}
+	}
+
+	if len(images) == 0 {
+		return nil, nil
+	}
+	return append([]File{{Path: bootDirectory, Mode: fs.ModeDir | 0o555}}, images...), nil
+}
+
+func bootPlatformName(platform byte) string {
+	if name, ok := bootPlatforms[platform]; ok {
+		return name
+	}
+	return fmt.Sprintf("platform%d", platform)
+}
+
+func bootMediaName(media byte) string {
+	if name, ok := bootMediaNames[media]; ok {
+		return name
+	}
+	return fmt.Sprintf("media%d", media)
+}
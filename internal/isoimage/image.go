@@ -0,0 +1,203 @@
+package isoimage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	// sectorSize is the size of the sectors holding volume descriptors, regardless of the logical block size.
+	sectorSize = 2048
+
+	// firstVolumeDescriptorSector is the first sector after the system area, where the volume descriptors start.
+	firstVolumeDescriptorSector = 16
+
+	// maxVolumeDescriptors bounds the volume descriptors (and volume recognition descriptors) read.
+	maxVolumeDescriptors = 64
+
+	// maxDepth bounds the depth of the directory tree read, guarding against directory loops.
+	maxDepth = 256
+
+	// maxMetadataSize bounds the size of the directories and symlinks read into memory.
+	maxMetadataSize = 64 * 1024 * 1024
+)
+
+// Format is the filesystem of an ISO image that files were read from.
+type Format string
+
+const (
+	// ISO9660 is a plain ISO9660 filesystem, with short upper case names (e.g. "README.TXT").
+	ISO9660 Format = "iso9660"
+	// RockRidge is an ISO9660 filesystem with the Rock Ridge extensions, describing POSIX names, modes, and symlinks.
+	RockRidge Format = "rock-ridge"
+	// Joliet is an ISO9660 filesystem with the Joliet extensions, describing unicode names.
+	Joliet Format = "joliet"
+	// UDF is a Universal Disk Format filesystem (as used by DVDs and Windows installer images).
+	UDF Format = "udf"
+)
+
+// File is a directory, regular file, symlink, or special file within an ISO image.
+type File struct {
+	// Path is the slash-separated path of the file relative to the root of the image (e.g. "usr/lib/os-release").
+	Path string
+	// Mode describes the type and permissions of the file.
+	Mode fs.FileMode
+	// Size is the size of the contents of a regular file.
+	Size int64
+	// LinkTarget is the target of a symlink, as recorded in the image.
+	LinkTarget string
+
+	r        io.ReaderAt
+	extents  []extent
+	embedded []byte
+}
+
+// extent is a contiguous range of the contents of a file, which is either stored within the image or (when sparse)
+// made of zeros.
+type extent struct {
+	offset int64
+	length int64
+	sparse bool
+}
+
+// Open returns the contents of a regular file.
+func (f File) Open() io.Reader {
+	if f.embedded != nil {
+		return bytes.NewReader(f.embedded)
+	}
+	readers := make([]io.Reader, 0, len(f.extents))
+	for _, e := range f.extents {
+		if e.sparse {
+			readers = append(readers, io.LimitReader(zeros{}, e.length))
+			continue
+		}
+		readers = append(readers, io.NewSectionReader(f.r, e.offset, e.length))
+	}
+	return io.LimitReader(io.MultiReader(readers...), f.Size)
+}
+
+// Image is the filesystem of an ISO image (e.g. an installer or live image) along with any boot images it holds.
+type Image struct {
+	// Format is the filesystem that files were read from, where the richest filesystem within the image is preferred.
+	Format Format
+
+	files []File
+}
+
+// Files returns the files within the image, where directories are listed before their contents. Boot images that are
+// not otherwise files within the image (as described by El Torito boot catalogs) are listed within a "[BOOT]" directory.
+func (img *Image) Files() []File {
+	return img.files
+}
+
+// IsImage indicates if the given contents are an ISO image (with an ISO9660 or UDF filesystem).
+func IsImage(r io.ReaderAt) bool {
+	identifier := make([]byte, 5)
+	if _, err := r.ReadAt(identifier, firstVolumeDescriptorSector*sectorSize+1); err != nil {
+		return false
+	}
+	switch string(identifier) {
+	case standardIdentifier, beginningExtendedAreaIdentifier:
+		return true
+	}
+	return false
+}
+
+// Open reads the filesystem of the given ISO image. Rock Ridge extensions are preferred (since they describe POSIX
+// modes and symlinks), then a UDF filesystem, then Joliet extensions, and finally the plain ISO9660 filesystem.
+func Open(r io.ReaderAt) (*Image, error) {
+	descriptors, err := readVolumeDescriptors(r)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := readFilesystem(r, descriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	if descriptors.bootCatalog != nil {
+		boot, err := bootImages(r, *descriptors.bootCatalog, img.files)
+		if err != nil {
+			log.WithFields("error", err).Debug("unable to read boot images of ISO image")
+		}
+		img.files = append(img.files, boot...)
+	}
+	return img, nil
+}
+
+func readFilesystem(r io.ReaderAt, descriptors *volumeDescriptors) (*Image, error) {
+	var plain []File
+	if descriptors.primary != nil {
+		files, rockRidge, err := readISO9660(r, *descriptors.primary, false)
+		if err != nil {
+			return nil, err
+		}
+		if rockRidge {
+			return &Image{Format: RockRidge, files: files}, nil
+		}
+		plain = files
+	}
+
+	if descriptors.udf {
+		files, err := readUDF(r)
+		if err == nil {
+			return &Image{Format: UDF, files: files}, nil
+		}
+		if descriptors.primary == nil {
+			return nil, err
+		}
+		log.WithFields("error", err).Debug("unable to read UDF filesystem of ISO image, falling back to ISO9660")
+	}
+
+	if descriptors.joliet != nil {
+		files, _, err := readISO9660(r, *descriptors.joliet, true)
+		if err != nil {
+			return nil, err
+		}
+		return &Image{Format: Joliet, files: files}, nil
+	}
+
+	if descriptors.primary == nil {
+		return nil, fmt.Errorf("no filesystem found within ISO image")
+	}
+	return &Image{Format: ISO9660, files: plain}, nil
+}
+
+// childPath returns the path of a named entry within the given directory, where names that cannot be a single path
+// element are rejected.
+func childPath(dir, name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/\x00") {
+		return "", fmt.Errorf("invalid file name %q", name)
+	}
+	if dir == "" {
+		return name, nil
+	}
+	return path.Join(dir, name), nil
+}
+
+func readAt(r io.ReaderAt, offset, length int64) ([]byte, error) {
+	if length < 0 || length > maxMetadataSize {
+		return nil, fmt.Errorf("invalid length %d at offset %d", length, offset)
+	}
+	b := make([]byte, length)
+	if n, err := r.ReadAt(b, offset); n < len(b) {
+		return nil, fmt.Errorf("unable to read %d bytes at offset %d: %w", length, offset, err)
+	}
+	return b, nil
+}
+
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
@@ -0,0 +1,167 @@
+package isoimage
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var longName = strings.Repeat("a", 120) + ".txt"
+
+func TestOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		format   Format
+		contents map[string]string
+		modes    map[string]fs.FileMode
+		links    map[string]string
+		missing  []string
+	}{
+		{
+			name:    "rock ridge",
+			fixture: "test-fixtures/rockridge.iso",
+			format:  RockRidge,
+			contents: map[string]string{
+				"usr/lib/os-release":    "ID=debian\nVERSION_ID=\"12\"\n",
+				"var/lib/dpkg/status":   "Package: zlib1g",
+				"usr/lib/日本語.txt":       "unicode\n",
+				"usr/lib/" + longName:   "long\n",
+				"isolinux/isolinux.bin": "ISOLINUX",
+				// the EFI boot image is not a file within the filesystem
+				"[BOOT]/1-EFI-NoEmul.img": "EFI BOOT IMAGE",
+			},
+			modes: map[string]fs.FileMode{
+				"usr/lib":             fs.ModeDir | 0o755,
+				"var/lib/dpkg/status": 0o600,
+				"etc/os-release":      fs.ModeSymlink | 0o777,
+			},
+			links: map[string]string{
+				"etc/os-release": "/usr/lib/os-release",
+			},
+			// boot images that are files within the filesystem are not repeated
+			missing: []string{"[BOOT]/1-x86-NoEmul.img"},
+		},
+		{
+			name:    "joliet",
+			fixture: "test-fixtures/joliet.iso",
+			format:  Joliet,
+			contents: map[string]string{
+				"usr/lib/os-release":  "ID=debian\nVERSION_ID=\"12\"\n",
+				"var/lib/dpkg/status": "Package: zlib1g",
+				"usr/lib/日本語.txt":     "unicode\n",
+			},
+			modes: map[string]fs.FileMode{
+				"usr/lib":             fs.ModeDir | 0o555,
+				"var/lib/dpkg/status": 0o444,
+			},
+			// symlinks cannot be described without Rock Ridge extensions
+			missing: []string{"etc/os-release"},
+		},
+		{
+			name:    "UDF",
+			fixture: "test-fixtures/udf.iso",
+			format:  UDF,
+			contents: map[string]string{
+				"usr/lib/os-release":  "ID=debian\nVERSION_ID=\"12\"\n",
+				"var/lib/dpkg/status": "Package: zlib1g",
+				"usr/lib/日本語.txt":     "unicode\n",
+				"usr/lib/" + longName: "long\n",
+			},
+			modes: map[string]fs.FileMode{
+				"usr/lib":             fs.ModeDir | 0o755,
+				"var/lib/dpkg/status": 0o600,
+				"etc/os-release":      fs.ModeSymlink | 0o777,
+			},
+			links: map[string]string{
+				"etc/os-release": "/usr/lib/os-release",
+			},
+		},
+		{
+			name:    "plain ISO9660",
+			fixture: "test-fixtures/iso9660.iso",
+			format:  ISO9660,
+			// plain ISO9660 names are short upper case names (here, isolinux/isolinux.bin)
+			contents: map[string]string{
+				"D003/F004": "ISOLINUX",
+			},
+			modes: map[string]fs.FileMode{
+				"D003":      fs.ModeDir | 0o555,
+				"D003/F004": 0o444,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.fixture)
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+			require.True(t, IsImage(f))
+
+			img, err := Open(f)
+			require.NoError(t, err)
+			assert.Equal(t, tt.format, img.Format)
+
+			files := make(map[string]File)
+			for _, file := range img.Files() {
+				files[file.Path] = file
+			}
+
+			for p, want := range tt.contents {
+				file, ok := files[p]
+				require.True(t, ok, "missing file %q", p)
+				got, err := io.ReadAll(file.Open())
+				require.NoError(t, err)
+				assert.Contains(t, string(got), want, "contents of %q", p)
+				assert.Equal(t, file.Size, int64(len(got)), "size of %q", p)
+			}
+			for p, want := range tt.modes {
+				file, ok := files[p]
+				require.True(t, ok, "missing file %q", p)
+				assert.Equal(t, want, file.Mode, "mode of %q", p)
+			}
+			for p, want := range tt.links {
+				assert.Equal(t, want, files[p].LinkTarget, "link target of %q", p)
+			}
+			for _, p := range tt.missing {
+				assert.NotContains(t, files, p)
+			}
+		})
+	}
+}
+
+func TestOpen_directoriesBeforeContents(t *testing.T) {
+	f, err := os.Open("test-fixtures/rockridge.iso")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	img, err := Open(f)
+	require.NoError(t, err)
+
+	seen := map[string]bool{"": true}
+	for _, file := range img.Files() {
+		dir := ""
+		if idx := strings.LastIndex(file.Path, "/"); idx >= 0 {
+			dir = file.Path[:idx]
+		}
+		assert.True(t, seen[dir], "%q listed before its directory", file.Path)
+		seen[file.Path] = true
+	}
+}
+
+func TestIsImage_notAnImage(t *testing.T) {
+	f, err := os.Open("image_test.go")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	assert.False(t, IsImage(f))
+
+	_, err = Open(f)
+	assert.Error(t, err)
+}
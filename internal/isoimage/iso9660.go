@@ -0,0 +1,359 @@
+package isoimage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	standardIdentifier                = "CD001"
+	beginningExtendedAreaIdentifier   = "BEA01"
+	terminatingExtendedAreaIdentifier = "TEA01"
+
+	bootRecordType              = 0
+	primaryVolumeDescriptorType = 1
+	supplementaryDescriptorType = 2
+	terminatorDescriptorType    = 255
+
+	// elToritoSystemIdentifier identifies boot records describing El Torito boot catalogs.
+	elToritoSystemIdentifier = "EL TORITO SPECIFICATION"
+
+	// rootDirectoryRecordOffset is the offset of the root directory record within primary and supplementary volume
+	// descriptors.
+	rootDirectoryRecordOffset = 156
+
+	directoryFlag     = 0x02
+	multiExtentFlag   = 0x80
+	minDirectoryEntry = 33
+)
+
+// jolietEscapeSequences identify supplementary volume descriptors of Joliet filesystems (UCS-2 levels 1 to 3).
+var jolietEscapeSequences = []string{"%/@", "%/C", "%/E"}
+
+// volumeDescriptor describes the root of an ISO9660 directory tree.
+type volumeDescriptor struct {
+	blockSize  int64
+	rootExtent uint32
+	rootSize   uint32
+}
+
+type volumeDescriptors struct {
+	primary     *volumeDescriptor
+	joliet      *volumeDescriptor
+	bootCatalog *uint32
+	udf         bool
+}
+
+// readVolumeDescriptors reads the volume descriptors of ISO9660 filesystems and the volume recognition sequence
+// indicating a UDF filesystem, which (for UDF bridge images) follows the ISO9660 volume descriptors.
+func readVolumeDescriptors(r io.ReaderAt) (*volumeDescriptors, error) {
+	var descriptors volumeDescriptors
+	for i := int64(0); i < maxVolumeDescriptors; i++ {
+		b, err := readAt(r, (firstVolumeDescriptorSector+i)*sectorSize, sectorSize)
+		if err != nil {
+			break
+		}
+
+		identifier := string(b[1:6])
+		if strings.HasPrefix(identifier, "NSR0") {
+			descriptors.udf = true
+			continue
+		}
+		if identifier == terminatingExtendedAreaIdentifier {
+			break
+		}
+		if identifier != standardIdentifier {
+			if identifier == beginningExtendedAreaIdentifier || identifier == "BOOT2" || identifier == "CDW02" {
+				continue
+			}
+			break
+		}
+
+		switch b[0] {
+		case bootRecordType:
+			if strings.TrimRight(string(b[7:39]), "\x00 ") == elToritoSystemIdentifier {
+				catalog := binary.LittleEndian.Uint32(b[71:75])
+				descriptors.bootCatalog = &catalog
+			}
+		case primaryVolumeDescriptorType:
+			if descriptors.primary == nil {
+				descriptors.primary = newVolumeDescriptor(b)
+			}
+		case supplementaryDescriptorType:
+			if descriptors.joliet == nil && isJoliet(b) {
+				descriptors.joliet = newVolumeDescriptor(b)
+			}
+		case terminatorDescriptorType:
+			// UDF volume recognition descriptors may follow
+		}
+	}
+
+	if descriptors.primary == nil && !descriptors.udf {
+		return nil, fmt.Errorf("no ISO9660 or UDF volume descriptors found")
+	}
+	return &descriptors, nil
+}
+
+func newVolumeDescriptor(b []byte) *volumeDescriptor {
+	blockSize := int64(binary.LittleEndian.Uint16(b[128:130]))
+	if blockSize == 0 {
+		blockSize = sectorSize
+	}
+	root := b[rootDirectoryRecordOffset:]
+	return &volumeDescriptor{
+		blockSize:  blockSize,
+		rootExtent: binary.LittleEndian.Uint32(root[2:6]),
+		rootSize:   binary.LittleEndian.Uint32(root[10:14]),
+	}
+}
+
+func isJoliet(b []byte) bool {
+	escape := string(b[88:91])
+	for _, e := range jolietEscapeSequences {
+		if escape == e {
+			return true
+		}
+	}
+	return false
+}
+
+// directoryRecord is an entry within an ISO9660 directory.
+type directoryRecord struct {
+	name      []byte
+	flags     byte
+	extent    uint32
+	size      uint32
+	systemUse []byte
+}
+
+func (d directoryRecord) isDir() bool {
+	return d.flags&directoryFlag != 0
+}
+
+// isoReader reads an ISO9660 directory tree, along with any Joliet or Rock Ridge extensions.
+type isoReader struct {
+	r         io.ReaderAt
+	blockSize int64
+	joliet    bool
+	rockRidge *rockRidgeReader
+	visited   map[uint32]bool
+	files     []File
+}
+
+// readISO9660 reads the files within the directory tree of the given volume descriptor, indicating if the tree
+// has Rock Ridge extensions (which are not considered for Joliet trees).
+func readISO9660(r io.ReaderAt, descriptor volumeDescriptor, joliet bool) ([]File, bool, error) {
+	reader := &isoReader{
+		r:         r,
+		blockSize: descriptor.blockSize,
+		joliet:    joliet,
+		visited:   map[uint32]bool{descriptor.rootExtent: true},
+	}
+
+	records, err := reader.readDirectory(descriptor.rootExtent, descriptor.rootSize)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to read root directory of ISO image: %w", err)
+	}
+	if !joliet && len(records) > 0 {
+		// the system use area of the first entry of the root directory identifies Rock Ridge extensions
+		reader.rockRidge = newRockRidgeReader(r, descriptor.blockSize, records[0].systemUse)
+	}
+
+	if err := reader.walk("", records, 0); err != nil {
+		return nil, false, err
+	}
+	return reader.files, reader.rockRidge != nil, nil
+}
+
+func (i *isoReader) walk(dir string, records []directoryRecord, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("directory tree of ISO image is too deep")
+	}
+
+	// the first two entries of every directory are the directory itself and its parent
+	if len(records) >= 2 {
+		records = records[2:]
+	} else {
+		records = nil
+	}
+
+	for idx := 0; idx < len(records); idx++ {
+		record := records[idx]
+
+		// files larger than 4GiB are made of several consecutive entries with the same name
+		extents := []extent{{offset: int64(record.extent) * i.blockSize, length: int64(record.size)}}
+		size := int64(record.size)
+		for record.flags&multiExtentFlag != 0 && idx+1 < len(records) {
+			idx++
+			record = records[idx]
+			extents = append(extents, extent{offset: int64(record.extent) * i.blockSize, length: int64(record.size)})
+			size += int64(record.size)
+		}
+
+		f := File{
+			Mode:    0o444,
+			Size:    size,
+			r:       i.r,
+			extents: extents,
+		}
+		name := i.name(record)
+		if record.isDir() {
+			f.Mode = fs.ModeDir | 0o555
+			f.Size = 0
+		}
+
+		extentOfDir := record.extent
+		sizeOfDir := record.size
+		if i.rockRidge != nil {
+			entry := i.rockRidge.read(record.systemUse)
+			if entry.relocated {
+				// relocated directories are read through the child links that refer to them
+				continue
+			}
+			if entry.name != "" {
+				name = entry.name
+			}
+			if entry.hasMode {
+				f.Mode = entry.mode
+			}
+			if entry.childLink != nil {
+				f.Mode = fs.ModeDir | f.Mode.Perm()
+				extentOfDir = *entry.childLink
+				sizeOfDir = 0
+			}
+			if f.Mode&fs.ModeSymlink != 0 {
+				f.LinkTarget = entry.linkTarget
+				f.Size = 0
+				f.extents = nil
+			}
+		}
+
+		p, err := childPath(dir, name)
+		if err != nil {
+			log.WithFields("directory", dir, "error", err).Debug("skipping entry within ISO image")
+			continue
+		}
+		f.Path = p
+
+		if !f.Mode.IsDir() {
+			i.files = append(i.files, f)
+			continue
+		}
+
+		f.extents = nil
+		if i.visited[extentOfDir] {
+			log.WithFields("path", p).Debug("skipping directory loop within ISO image")
+			continue
+		}
+		i.visited[extentOfDir] = true
+		i.files = append(i.files, f)
+
+		children, err := i.readDirectory(extentOfDir, sizeOfDir)
+		if err != nil {
+			return fmt.Errorf("unable to read directory %q of ISO image: %w", p, err)
+		}
+		if err := i.walk(p, children, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDirectory reads the entries of the directory at the given extent. When the size is not known, it is read from
+// the first entry of the directory (describing the directory itself).
+func (i *isoReader) readDirectory(location, size uint32) ([]directoryRecord, error) {
+	offset := int64(location) * i.blockSize
+	if size == 0 {
+		first, err := readAt(i.r, offset, sectorSize)
+		if err != nil {
+			return nil, err
+		}
+		record, _, ok := parseDirectoryRecord(first)
+		if !ok {
+			return nil, fmt.Errorf("invalid directory at block %d", location)
+		}
+		size = record.size
+	}
+
+	b, err := readAt(i.r, offset, int64(size))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []directoryRecord
+	for pos := 0; pos < len(b); {
+		if b[pos] == 0 {
+			// entries do not cross sector boundaries, where the remainder of a sector is zero-filled
+			pos = (pos/sectorSize + 1) * sectorSize
+			continue
+		}
+		record, length, ok := parseDirectoryRecord(b[pos:])
+		if !ok {
+			return nil, fmt.Errorf("invalid directory entry at offset %d", offset+int64(pos))
+		}
+		records = append(records, record)
+		pos += length
+	}
+	return records, nil
+}
+
+func parseDirectoryRecord(b []byte) (directoryRecord, int, bool) {
+	if len(b) < minDirectoryEntry {
+		return directoryRecord{}, 0, false
+	}
+	length := int(b[0])
+	nameLength := int(b[32])
+	if length < minDirectoryEntry+nameLength || length > len(b) {
+		return directoryRecord{}, 0, false
+	}
+
+	systemUseStart := minDirectoryEntry + nameLength
+	if nameLength%2 == 0 {
+		// names of even length are followed by a padding byte
+		systemUseStart++
+	}
+	var systemUse []byte
+	if systemUseStart < length {
+		systemUse = b[systemUseStart:length]
+	}
+
+	return directoryRecord{
+		name:      b[minDirectoryEntry : minDirectoryEntry+nameLength],
+		flags:     b[25],
+		extent:    binary.LittleEndian.Uint32(b[2:6]),
+		size:      binary.LittleEndian.Uint32(b[10:14]),
+		systemUse: systemUse,
+	}, length, true
+}
+
+// name returns the name of an entry, without the file version (e.g. ";1") or an empty extension.
+func (i *isoReader) name(record directoryRecord) string {
+	var name string
+	if i.joliet {
+		name = decodeUCS2(record.name)
+	} else {
+		name = string(record.name)
+	}
+	if !record.isDir() {
+		if idx := strings.LastIndexByte(name, ';'); idx >= 0 {
+			name = name[:idx]
+		}
+		name = strings.TrimSuffix(name, ".")
+	}
+	return name
+}
+
+// decodeUCS2 decodes big-endian UCS-2 (or UTF-16) names, as used by Joliet and UDF filesystems.
+func decodeUCS2(b []byte) string {
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		units = append(units, binary.BigEndian.Uint16(b[i:i+2]))
+	}
+	return string(utf16.Decode(units))
+}
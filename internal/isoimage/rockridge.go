@@ -0,0 +1,195 @@
+package isoimage
+
+import (
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+const (
+	// maxContinuationAreas bounds the continuation areas followed for the system use entries of a single directory
+	// entry, guarding against continuation loops.
+	maxContinuationAreas = 16
+
+	rockRidgeNameCurrent = 0x02
+	rockRidgeNameParent  = 0x04
+
+	rockRidgeComponentContinue = 0x01
+	rockRidgeComponentCurrent  = 0x02
+	rockRidgeComponentParent   = 0x04
+	rockRidgeComponentRoot     = 0x08
+
+	posixTypeMask    = 0o170000
+	posixSocket      = 0o140000
+	posixSymlink     = 0o120000
+	posixBlockDevice = 0o060000
+	posixDirectory   = 0o040000
+	posixCharDevice  = 0o020000
+	posixNamedPipe   = 0o010000
+)
+
+// rockRidgeReader reads the System Use Sharing Protocol (SUSP) entries of directory entries, as used by the Rock Ridge
+// extensions to describe POSIX names, modes, and symlinks.
+type rockRidgeReader struct {
+	r         io.ReaderAt
+	blockSize int64
+	// skip is the number of bytes to skip at the start of the system use area of each directory entry
+	skip int
+}
+
+// rockRidgeEntry describes a directory entry as extended by Rock Ridge.
+type rockRidgeEntry struct {
+	name       string
+	mode       fs.FileMode
+	hasMode    bool
+	linkTarget string
+	// childLink is the location of a directory that has been relocated (since the directory tree was too deep)
+	childLink *uint32
+	// relocated indicates that the entry is a relocated directory, which is read through its child link instead
+	relocated bool
+}
+
+// newRockRidgeReader returns a reader of Rock Ridge extensions when the given system use area (of the first entry of
+// the root directory) starts with an SUSP indicator, and nil otherwise.
+func newRockRidgeReader(r io.ReaderAt, blockSize int64, systemUse []byte) *rockRidgeReader {
+	if len(systemUse) < 7 || string(systemUse[0:2]) != "SP" || systemUse[4] != 0xBE || systemUse[5] != 0xEF {
+		return nil
+	}
+	return &rockRidgeReader{
+		r:         r,
+		blockSize: blockSize,
+		skip:      int(systemUse[6]),
+	}
+}
+
+func (rr *rockRidgeReader) read(systemUse []byte) rockRidgeEntry {
+	var entry rockRidgeEntry
+	var name strings.Builder
+	var link symlinkBuilder
+
+	if rr.skip < len(systemUse) {
+		systemUse = systemUse[rr.skip:]
+	} else {
+		systemUse = nil
+	}
+
+	for areas := 0; systemUse != nil && areas < maxContinuationAreas; areas++ {
+		var next []byte
+		for len(systemUse) >= 4 {
+			signature := string(systemUse[0:2])
+			length := int(systemUse[2])
+			if length < 4 || length > len(systemUse) {
+				break
+			}
+			data := systemUse[4:length]
+			systemUse = systemUse[length:]
+
+			switch signature {
+			case "CE":
+				if len(data) >= 24 {
+					block := int64(binary.LittleEndian.Uint32(data[0:4]))
+					offset := int64(binary.LittleEndian.Uint32(data[8:12]))
+					size := int64(binary.LittleEndian.Uint32(data[16:20]))
+					if b, err := readAt(rr.r, block*rr.blockSize+offset, size); err == nil {
+						next = b
+					}
+				}
+			case "PX":
+				if len(data) >= 4 {
+					entry.mode = posixFileMode(binary.LittleEndian.Uint32(data[0:4]))
+					entry.hasMode = true
+				}
+			case "NM":
+				if len(data) >= 1 && data[0]&(rockRidgeNameCurrent|rockRidgeNameParent) == 0 {
+					name.Write(data[1:])
+				}
+			case "SL":
+				if len(data) >= 1 {
+					link.add(data[1:])
+				}
+			case "CL":
+				if len(data) >= 4 {
+					location := binary.LittleEndian.Uint32(data[0:4])
+					entry.childLink = &location
+				}
+			case "RE":
+				entry.relocated = true
+			case "ST":
+				systemUse = nil
+			}
+		}
+		systemUse = next
+	}
+
+	entry.name = name.String()
+	entry.linkTarget = link.String()
+	return entry
+}
+
+// symlinkBuilder builds the target of a symlink from the components of SL entries, where a component may be split
+// across several entries.
+type symlinkBuilder struct {
+	components []string
+	absolute   bool
+	continued  bool
+}
+
+func (s *symlinkBuilder) add(b []byte) {
+	for len(b) >= 2 {
+		flags := b[0]
+		length := int(b[1])
+		if 2+length > len(b) {
+			return
+		}
+		content := string(b[2 : 2+length])
+		b = b[2+length:]
+
+		switch {
+		case flags&rockRidgeComponentRoot != 0:
+			s.components = nil
+			s.absolute = true
+			s.continued = false
+			continue
+		case flags&rockRidgeComponentCurrent != 0:
+			content = "."
+		case flags&rockRidgeComponentParent != 0:
+			content = ".."
+		}
+
+		if s.continued && len(s.components) > 0 {
+			s.components[len(s.components)-1] += content
+		} else {
+			s.components = append(s.components, content)
+		}
+		s.continued = flags&rockRidgeComponentContinue != 0
+	}
+}
+
+func (s *symlinkBuilder) String() string {
+	target := strings.Join(s.components, "/")
+	if s.absolute {
+		return "/" + target
+	}
+	return target
+}
+
+// posixFileMode converts POSIX file mode bits (st_mode) to a file mode.
+func posixFileMode(mode uint32) fs.FileMode {
+	m := fs.FileMode(mode & 0o777)
+	switch mode & posixTypeMask {
+	case posixDirectory:
+		m |= fs.ModeDir
+	case posixSymlink:
+		m |= fs.ModeSymlink
+	case posixBlockDevice:
+		m |= fs.ModeDevice
+	case posixCharDevice:
+		m |= fs.ModeDevice | fs.ModeCharDevice
+	case posixNamedPipe:
+		m |= fs.ModeNamedPipe
+	case posixSocket:
+		m |= fs.ModeSocket
+	}
+	return m
+}
@@ -0,0 +1,51 @@
+package isoimage
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymlinkBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries [][]byte
+		want    string
+	}{
+		{
+			name:    "absolute",
+			entries: [][]byte{{0x08, 0, 0, 3, 'u', 's', 'r', 0, 3, 'l', 'i', 'b'}},
+			want:    "/usr/lib",
+		},
+		{
+			name:    "relative with parent and current directories",
+			entries: [][]byte{{0x04, 0, 0x02, 0, 0, 3, 'b', 'i', 'n'}},
+			want:    ".././bin",
+		},
+		{
+			name: "component continued across entries",
+			entries: [][]byte{
+				{0x08, 0, rockRidgeComponentContinue, 3, 'o', 's', '-'},
+				{0, 7, 'r', 'e', 'l', 'e', 'a', 's', 'e'},
+			},
+			want: "/os-release",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s symlinkBuilder
+			for _, e := range tt.entries {
+				s.add(e)
+			}
+			assert.Equal(t, tt.want, s.String())
+		})
+	}
+}
+
+func TestPosixFileMode(t *testing.T) {
+	assert.Equal(t, fs.ModeDir|0o755, posixFileMode(0o040755))
+	assert.Equal(t, fs.ModeSymlink|0o777, posixFileMode(0o120777))
+	assert.Equal(t, fs.FileMode(0o644), posixFileMode(0o100644))
+	assert.Equal(t, fs.ModeDevice|fs.ModeCharDevice|0o666, posixFileMode(0o020666))
+}
@@ -0,0 +1,511 @@
+package isoimage
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// UDF descriptor tag identifiers (ECMA-167).
+const (
+	tagAnchorVolumeDescriptorPointer = 2
+	tagPartitionDescriptor           = 5
+	tagLogicalVolumeDescriptor       = 6
+	tagTerminatingDescriptor         = 8
+	tagFileSetDescriptor             = 256
+	tagFileIdentifierDescriptor      = 257
+	tagAllocationExtentDescriptor    = 258
+	tagFileEntry                     = 261
+	tagExtendedFileEntry             = 266
+)
+
+const (
+	// anchorSector is the sector of the anchor volume descriptor pointer, which locates the volume descriptor sequence.
+	anchorSector = 256
+
+	// maxVolumeDescriptorSequence bounds the descriptors read from the volume descriptor sequence.
+	maxVolumeDescriptorSequence = 64
+
+	// maxAllocationExtents bounds the allocation extent descriptors followed for a single file, guarding against loops.
+	maxAllocationExtents = 1024
+
+	udfFileTypeDirectory   = 4
+	udfFileTypeRegular     = 5
+	udfFileTypeBlockDevice = 6
+	udfFileTypeCharDevice  = 7
+	udfFileTypeFIFO        = 9
+	udfFileTypeSocket      = 10
+	udfFileTypeSymlink     = 12
+
+	udfShortAllocation    = 0
+	udfLongAllocation     = 1
+	udfEmbeddedAllocation = 3
+
+	udfExtentRecorded     = 0
+	udfExtentContinuation = 3
+
+	udfCharacteristicDirectory = 0x02
+	udfCharacteristicDeleted   = 0x04
+	udfCharacteristicParent    = 0x08
+)
+
+// Partition maps of type 1 map a logical partition directly onto a partition descriptor. Sparable partitions (type 2
+// partition maps used by rewritable media) are read the same way, since images do not have defective sectors to spare.
+const (
+	udfPartitionMapType1 = 1
+	udfPartitionMapType2 = 2
+
+	udfSparablePartition = "*UDF Sparable Partition"
+)
+
+// longAD is a long allocation descriptor, locating an extent within any partition.
+type longAD struct {
+	length    uint32
+	block     uint32
+	partition uint16
+}
+
+func parseLongAD(b []byte) longAD {
+	return longAD{
+		length:    binary.LittleEndian.Uint32(b[0:4]),
+		block:     binary.LittleEndian.Uint32(b[4:8]),
+		partition: binary.LittleEndian.Uint16(b[8:10]),
+	}
+}
+
+// udfReader reads the file set of a UDF filesystem, as recorded by UDF 1.02 to 2.01 (e.g. DVDs and Windows installer
+// images). Virtual and metadata partitions (as used by write-once media and UDF 2.50 or later) are not supported.
+type udfReader struct {
+	r         io.ReaderAt
+	blockSize int64
+	// partitionStarts are the starting sectors of the partitions, by partition reference number
+	partitionStarts []int64
+	visited         map[longAD]bool
+	files           []File
+}
+
+func readUDF(r io.ReaderAt) ([]File, error) {
+	u := &udfReader{
+		r:         r,
+		blockSize: sectorSize,
+		visited:   make(map[longAD]bool),
+	}
+
+	fileSet, err := u.readVolumeDescriptorSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	fsd, err := u.readDescriptor(fileSet, tagFileSetDescriptor)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read UDF file set descriptor: %w", err)
+	}
+	root := parseLongAD(fsd[400:416])
+	root.length = 0
+	u.visited[root] = true
+
+	entry, err := u.readFileEntry(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read UDF root directory: %w", err)
+	}
+	if err := u.walk("", entry, 0); err != nil {
+		return nil, err
+	}
+	return u.files, nil
+}
+
+// readVolumeDescriptorSequence reads the partitions of the logical volume, returning the location of its file set.
+func (u *udfReader) readVolumeDescriptorSequence() (longAD, error) {
+	anchor, err := readAt(u.r, anchorSector*sectorSize, sectorSize)
+	if err != nil || !validTag(anchor, tagAnchorVolumeDescriptorPointer) {
+		return longAD{}, fmt.Errorf("no UDF anchor volume descriptor pointer found")
+	}
+	sequenceLength := binary.LittleEndian.Uint32(anchor[16:20])
+	sequenceStart := int64(binary.LittleEndian.Uint32(anchor[20:24]))
+
+	partitions := make(map[uint16]int64)
+	var logicalVolume []byte
+	for i := int64(0); i < int64(sequenceLength)/sectorSize && i < maxVolumeDescriptorSequence; i++ {
+		b, err := readAt(u.r, (sequenceStart+i)*sectorSize, sectorSize)
+		if err != nil {
+			return longAD{}, fmt.Errorf("unable to read UDF volume descriptor sequence: %w", err)
+		}
+		switch {
+		case validTag(b, tagPartitionDescriptor):
+			number := binary.LittleEndian.Uint16(b[22:24])
+			partitions[number] = int64(binary.LittleEndian.Uint32(b[188:192]))
+		case validTag(b, tagLogicalVolumeDescriptor):
+			logicalVolume = b
+		case validTag(b, tagTerminatingDescriptor):
+			i = maxVolumeDescriptorSequence
+		}
+	}
+	if logicalVolume == nil {
+		return longAD{}, fmt.Errorf("no UDF logical volume descriptor found")
+	}
+
+	u.blockSize = int64(binary.LittleEndian.Uint32(logicalVolume[212:216]))
+	if u.blockSize != sectorSize {
+		return longAD{}, fmt.Errorf("unsupported UDF logical block size %d", u.blockSize)
+	}
+
+	mapCount := int(binary.LittleEndian.Uint32(logicalVolume[268:272]))
+	maps := logicalVolume[440:]
+	for i := 0; i < mapCount; i++ {
+		if len(maps) < 2 || int(maps[1]) < 2 || int(maps[1]) > len(maps) {
+			return longAD{}, fmt.Errorf("invalid UDF partition map")
+		}
+		partitionMap := maps[:maps[1]]
+		maps = maps[maps[1]:]
+
+		var number uint16
+		switch {
+		case partitionMap[0] == udfPartitionMapType1 && len(partitionMap) >= 6:
+			number = binary.LittleEndian.Uint16(partitionMap[4:6])
+		case partitionMap[0] == udfPartitionMapType2 && len(partitionMap) >= 40 && strings.TrimRight(string(partitionMap[5:28]), "\x00") == udfSparablePartition:
+			number = binary.LittleEndian.Uint16(partitionMap[38:40])
+		default:
+			return longAD{}, fmt.Errorf("unsupported UDF partition map (type %d)", partitionMap[0])
+		}
+
+		start, ok := partitions[number]
+		if !ok {
+			return longAD{}, fmt.Errorf("no UDF partition descriptor found for partition %d", number)
+		}
+		u.partitionStarts = append(u.partitionStarts, start)
+	}
+
+	return parseLongAD(logicalVolume[248:264]), nil
+}
+
+// offset returns the offset within the image of the given block of a partition.
+func (u *udfReader) offset(partition uint16, block uint32) (int64, error) {
+	if int(partition) >= len(u.partitionStarts) {
+		return 0, fmt.Errorf("invalid UDF partition reference %d", partition)
+	}
+	return (u.partitionStarts[partition] + int64(block)) * u.blockSize, nil
+}
+
+func (u *udfReader) readDescriptor(location longAD, tag uint16) ([]byte, error) {
+	offset, err := u.offset(location.partition, location.block)
+	if err != nil {
+		return nil, err
+	}
+	b, err := readAt(u.r, offset, u.blockSize)
+	if err != nil {
+		return nil, err
+	}
+	if !validTag(b, tag) {
+		return nil, fmt.Errorf("expected UDF descriptor %d at block %d of partition %d", tag, location.block, location.partition)
+	}
+	return b, nil
+}
+
+// validTag indicates if the given descriptor has the given tag identifier along with a valid tag checksum.
+func validTag(b []byte, tag uint16) bool {
+	if len(b) < 16 || binary.LittleEndian.Uint16(b[0:2]) != tag {
+		return false
+	}
+	var checksum byte
+	for i := 0; i < 16; i++ {
+		if i != 4 {
+			checksum += b[i]
+		}
+	}
+	return checksum == b[4]
+}
+
+// udfFileEntry is a (possibly extended) file entry, describing the type, permissions, and contents of a file.
+type udfFileEntry struct {
+	fileType byte
+	mode     fs.FileMode
+	size     int64
+	extents  []extent
+	embedded []byte
+}
+
+func (u *udfReader) readFileEntry(location longAD) (*udfFileEntry, error) {
+	offset, err := u.offset(location.partition, location.block)
+	if err != nil {
+		return nil, err
+	}
+	b, err := readAt(u.r, offset, u.blockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended attributes and allocation descriptors follow the (extended) file entry
+	var attributesStart int
+	switch {
+	case validTag(b, tagFileEntry):
+		attributesStart = 176
+	case validTag(b, tagExtendedFileEntry):
+		attributesStart = 216
+	default:
+		return nil, fmt.Errorf("expected UDF file entry at block %d of partition %d", location.block, location.partition)
+	}
+	attributesLength := int(binary.LittleEndian.Uint32(b[attributesStart-8 : attributesStart-4]))
+	allocationLength := int(binary.LittleEndian.Uint32(b[attributesStart-4 : attributesStart]))
+	allocationStart := attributesStart + attributesLength
+	if allocationStart+allocationLength > len(b) {
+		return nil, fmt.Errorf("invalid UDF file entry at block %d of partition %d", location.block, location.partition)
+	}
+	allocation := b[allocationStart : allocationStart+allocationLength]
+
+	entry := &udfFileEntry{
+		fileType: b[27],
+		mode:     udfPermissions(binary.LittleEndian.Uint32(b[44:48])),
+		size:     int64(binary.LittleEndian.Uint64(b[56:64])),
+	}
+
+	switch binary.LittleEndian.Uint16(b[34:36]) & 0x07 {
+	case udfEmbeddedAllocation:
+		if entry.size > int64(len(allocation)) {
+			return nil, fmt.Errorf("invalid embedded UDF file entry at block %d of partition %d", location.block, location.partition)
+		}
+		entry.embedded = allocation[:entry.size]
+	case udfShortAllocation:
+		entry.extents, err = u.readExtents(allocation, location.partition, false)
+	case udfLongAllocation:
+		entry.extents, err = u.readExtents(allocation, location.partition, true)
+	default:
+		err = fmt.Errorf("unsupported UDF allocation descriptors")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// readExtents reads the extents of the contents of a file from its allocation descriptors, following any allocation
+// extent descriptors that continue them.
+func (u *udfReader) readExtents(allocation []byte, partition uint16, long bool) ([]extent, error) {
+	size := 8
+	if long {
+		size = 16
+	}
+
+	var extents []extent
+	for continuations := 0; continuations < maxAllocationExtents; {
+		var next []byte
+		for ; len(allocation) >= size; allocation = allocation[size:] {
+			length := binary.LittleEndian.Uint32(allocation[0:4])
+			extentType := length >> 30
+			length &= 0x3FFFFFFF
+			if length == 0 {
+				break
+			}
+
+			block := binary.LittleEndian.Uint32(allocation[4:8])
+			extentPartition := partition
+			if long {
+				extentPartition = binary.LittleEndian.Uint16(allocation[8:10])
+			}
+
+			if extentType == udfExtentContinuation {
+				b, err := u.readDescriptor(longAD{block: block, partition: extentPartition}, tagAllocationExtentDescriptor)
+				if err != nil {
+					return nil, err
+				}
+				continued := 24 + int(binary.LittleEndian.Uint32(b[20:24]))
+				if continued > len(b) {
+					return nil, fmt.Errorf("invalid UDF allocation extent descriptor")
+				}
+				next = b[24:continued]
+				break
+			}
+
+			if extentType != udfExtentRecorded {
+				extents = append(extents, extent{length: int64(length), sparse: true})
+				continue
+			}
+			offset, err := u.offset(extentPartition, block)
+			if err != nil {
+				return nil, err
+			}
+			extents = append(extents, extent{offset: offset, length: int64(length)})
+		}
+		if next == nil {
+			return extents, nil
+		}
+		allocation = next
+		continuations++
+	}
+	return nil, fmt.Errorf("too many UDF allocation extent descriptors")
+}
+
+// contents reads the contents of a (small) file entry, such as a directory or symlink.
+func (u *udfReader) contents(entry *udfFileEntry) ([]byte, error) {
+	if entry.embedded != nil {
+		return entry.embedded, nil
+	}
+	if entry.size > maxMetadataSize {
+		return nil, fmt.Errorf("UDF metadata too large (%d bytes)", entry.size)
+	}
+	f := File{Size: entry.size, r: u.r, extents: entry.extents}
+	b, err := io.ReadAll(f.Open())
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) != entry.size {
+		return nil, fmt.Errorf("truncated UDF file entry contents")
+	}
+	return b, nil
+}
+
+func (u *udfReader) walk(dir string, entry *udfFileEntry, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("directory tree of UDF filesystem is too deep")
+	}
+	if entry.fileType != udfFileTypeDirectory {
+		return fmt.Errorf("UDF entry %q is not a directory", dir)
+	}
+	b, err := u.contents(entry)
+	if err != nil {
+		return fmt.Errorf("unable to read UDF directory %q: %w", dir, err)
+	}
+
+	for pos := 0; pos+38 <= len(b); {
+		fid := b[pos:]
+		if !validTag(fid, tagFileIdentifierDescriptor) {
+			return fmt.Errorf("invalid UDF file identifier descriptor within directory %q", dir)
+		}
+		characteristics := fid[18]
+		identifierLength := int(fid[19])
+		icb := parseLongAD(fid[20:36])
+		implementationUseLength := int(binary.LittleEndian.Uint16(fid[36:38]))
+		identifierStart := 38 + implementationUseLength
+		length := (identifierStart + identifierLength + 3) &^ 3
+		if identifierStart+identifierLength > len(fid) {
+			return fmt.Errorf("invalid UDF file identifier descriptor within directory %q", dir)
+		}
+		identifier := fid[identifierStart : identifierStart+identifierLength]
+		pos += length
+
+		if characteristics&(udfCharacteristicDeleted|udfCharacteristicParent) != 0 {
+			continue
+		}
+
+		p, err := childPath(dir, decodeDString(identifier))
+		if err != nil {
+			log.WithFields("directory", dir, "error", err).Debug("skipping entry within UDF filesystem")
+			continue
+		}
+
+		icb.length = 0
+		if err := u.addFile(p, icb, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *udfReader) addFile(p string, location longAD, depth int) error {
+	entry, err := u.readFileEntry(location)
+	if err != nil {
+		return fmt.Errorf("unable to read UDF file %q: %w", p, err)
+	}
+
+	f := File{Path: p, Mode: entry.mode, r: u.r}
+	switch entry.fileType {
+	case udfFileTypeDirectory:
+		// the directory characteristic of the file identifier is not trusted, since any identifier may refer to a
+		// directory (including one of its own ancestors)
+		if u.visited[location] {
+			log.WithFields("path", p).Debug("skipping directory loop within UDF filesystem")
+			return nil
+		}
+		u.visited[location] = true
+		f.Mode |= fs.ModeDir
+		u.files = append(u.files, f)
+		return u.walk(p, entry, depth+1)
+	case udfFileTypeRegular:
+		f.Size = entry.size
+		f.extents = entry.extents
+		f.embedded = entry.embedded
+	case udfFileTypeSymlink:
+		b, err := u.contents(entry)
+		if err != nil {
+			return fmt.Errorf("unable to read UDF symlink %q: %w", p, err)
+		}
+		f.Mode |= fs.ModeSymlink
+		f.LinkTarget = udfSymlinkTarget(b)
+	case udfFileTypeBlockDevice:
+		f.Mode |= fs.ModeDevice
+	case udfFileTypeCharDevice:
+		f.Mode |= fs.ModeDevice | fs.ModeCharDevice
+	case udfFileTypeFIFO:
+		f.Mode |= fs.ModeNamedPipe
+	case udfFileTypeSocket:
+		f.Mode |= fs.ModeSocket
+	default:
+		log.WithFields("path", p, "type", entry.fileType).Debug("skipping unsupported entry within UDF filesystem")
+		return nil
+	}
+	u.files = append(u.files, f)
+	return nil
+}
+
+// udfPermissions converts UDF permissions to file mode permissions. UDF permissions hold five bits for each of the
+// owner, group, and others (where the lowest three bits are execute, write, and read, as for POSIX permissions).
+func udfPermissions(permissions uint32) fs.FileMode {
+	owner := (permissions >> 10) & 0o7
+	group := (permissions >> 5) & 0o7
+	other := permissions & 0o7
+	return fs.FileMode(owner<<6 | group<<3 | other)
+}
+
+// decodeDString decodes OSTA compressed unicode, as used by UDF file identifiers.
+func decodeDString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	switch b[0] {
+	case 8:
+		runes := make([]rune, len(b)-1)
+		for i, c := range b[1:] {
+			runes[i] = rune(c)
+		}
+		return string(runes)
+	case 16:
+		return decodeUCS2(b[1:])
+	}
+	return ""
+}
+
+// udfSymlinkTarget returns the target of a symlink from its path components (ECMA-167 4/14.16).
+func udfSymlinkTarget(b []byte) string {
+	var components []string
+	absolute := false
+	for len(b) >= 4 {
+		componentType := b[0]
+		length := int(b[1])
+		if 4+length > len(b) {
+			break
+		}
+		identifier := b[4 : 4+length]
+		b = b[4+length:]
+
+		switch componentType {
+		case 1, 2:
+			components = nil
+			absolute = true
+		case 3:
+			components = append(components, "..")
+		case 4:
+			components = append(components, ".")
+		case 5:
+			components = append(components, decodeDString(identifier))
+		}
+	}
+
+	target := strings.Join(components, "/")
+	if absolute {
+		return "/" + target
+	}
+	return target
+}
@@ -0,0 +1,87 @@
+package isoimage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUDFSymlinkTarget(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []byte
+		want       string
+	}{
+		{
+			name:       "absolute",
+			components: []byte{2, 0, 0, 0, 5, 4, 0, 0, 8, 'u', 's', 'r', 5, 4, 0, 0, 8, 'l', 'i', 'b'},
+			want:       "/usr/lib",
+		},
+		{
+			name:       "relative",
+			components: []byte{3, 0, 0, 0, 4, 0, 0, 0, 5, 4, 0, 0, 8, 'b', 'i', 'n'},
+			want:       ".././bin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, udfSymlinkTarget(tt.components))
+		})
+	}
+}
+
+func TestUDFPermissions(t *testing.T) {
+	// owner read/write/execute, group read/execute, others read (along with the UDF attribute and delete permissions)
+	assert.Equal(t, fs.FileMode(0o754), udfPermissions(0x1F<<10|0x15<<5|0x04))
+}
+
+func TestDecodeDString(t *testing.T) {
+	assert.Equal(t, "café", decodeDString([]byte{8, 'c', 'a', 'f', 0xE9}))
+	assert.Equal(t, "日本", decodeDString([]byte{16, 0x65, 0xE5, 0x67, 0x2C}))
+	assert.Equal(t, "", decodeDString(nil))
+}
+
+func TestReadUDF_unflaggedDirectoryLoop(t *testing.T) {
+	b, err := os.ReadFile("test-fixtures/udf.iso")
+	require.NoError(t, err)
+
+	// point the "usr" file identifier back at the root directory, without the directory characteristic
+	u := &udfReader{r: bytes.NewReader(b), blockSize: sectorSize}
+	fileSet, err := u.readVolumeDescriptorSequence()
+	require.NoError(t, err)
+	fsd, err := u.readDescriptor(fileSet, tagFileSetDescriptor)
+	require.NoError(t, err)
+	root := fsd[400:416]
+
+	var patched bool
+	for pos := 0; pos+38 <= len(b); pos += 4 {
+		fid := b[pos:]
+		if !validTag(fid, tagFileIdentifierDescriptor) {
+			continue
+		}
+		identifierStart := 38 + int(binary.LittleEndian.Uint16(fid[36:38]))
+		if decodeDString(fid[identifierStart:identifierStart+int(fid[19])]) != "usr" {
+			continue
+		}
+		fid[18] &^= udfCharacteristicDirectory
+		copy(fid[24:30], root[4:10])
+		patched = true
+	}
+	require.True(t, patched)
+
+	files, err := readUDF(bytes.NewReader(b))
+	require.NoError(t, err)
+
+	paths := make(map[string]bool)
+	for _, f := range files {
+		paths[f.Path] = true
+	}
+	assert.True(t, paths["var/lib/dpkg/status"])
+	assert.False(t, paths["usr"])
+	assert.False(t, paths["usr/lib/os-release"])
+}
@@ -0,0 +1,238 @@
+package reachability
+
+import (
+	"bufio"
+	"bytes"
+	"debug/elf"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/executable"
+)
+
+// the maximum length of a shebang line that is considered (longer lines are truncated)
+const maxShebangLength = 512
+
+// Closure is the set of files reachable from the executables run by an entrypoint.
+type Closure struct {
+	paths map[string]struct{}
+}
+
+// NewClosure returns a closure of the given paths.
+func NewClosure(paths ...string) Closure {
+	c := Closure{paths: make(map[string]struct{})}
+	for _, p := range paths {
+		c.paths[p] = struct{}{}
+	}
+	return c
+}
+
+// Contains indicates if the given path is within the closure, also considering the alternate path of the file on
+// systems where /bin, /sbin, and /lib are symlinks into /usr (e.g. /bin/bash for /usr/bin/bash).
+func (c Closure) Contains(p string) bool {
+	if _, ok := c.paths[p]; ok {
+		return true
+	}
+	if alt, ok := strings.CutPrefix(p, "/usr/"); ok {
+		_, exists := c.paths["/"+alt]
+		return exists
+	}
+	_, exists := c.paths["/usr"+p]
+	return exists
+}
+
+// Paths returns the sorted paths (both real and access paths) of all files within the closure.
+func (c Closure) Paths() []string {
+	var paths []string
+	for p := range c.paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// EntrypointClosure returns the closure of files reachable from the executables run by the given entrypoint, following
+// the shared libraries (and program interpreters) of ELF binaries and the interpreters of scripts with a shebang.
+func EntrypointClosure(resolver file.Resolver, entrypoint Entrypoint) Closure {
+	w := walker{
+		resolver:   resolver,
+		entrypoint: entrypoint,
+		closure:    NewClosure(),
+		visited:    make(map[string]struct{}),
+	}
+
+	for _, command := range entrypoint.Commands() {
+		w.queue = append(w.queue, w.resolveCommand(command)...)
+	}
+
+	for len(w.queue) > 0 {
+		next := w.queue[0]
+		w.queue = w.queue[1:]
+		w.visit(next)
+	}
+
+	return w.closure
+}
+
+type walker struct {
+	resolver   file.Resolver
+	entrypoint Entrypoint
+	closure    Closure
+	visited    map[string]struct{}
+	queue      []file.Location
+}
+
+func (w *walker) visit(location file.Location) {
+	w.closure.paths[location.RealPath] = struct{}{}
+	if location.AccessPath != "" {
+		w.closure.paths[location.AccessPath] = struct{}{}
+	}
+
+	if _, ok := w.visited[location.RealPath]; ok {
+		return
+	}
+	w.visited[location.RealPath] = struct{}{}
+
+	contents, err := w.resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read file within entrypoint closure")
+		return
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	reader := bufio.NewReader(contents)
+	magic, err := reader.Peek(4)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte("#!")):
+		w.queue = append(w.queue, w.scriptDependencies(reader)...)
+	case bytes.Equal(magic, []byte(elf.ELFMAG)):
+		w.queue = append(w.queue, w.elfDependencies(location, reader)...)
+	}
+}
+
+// scriptDependencies returns the interpreter of a script (from the shebang), resolving the command run by env
+// (e.g. "#!/usr/bin/env python3") as well.
+func (w *walker) scriptDependencies(reader *bufio.Reader) []file.Location {
+	line, err := reader.ReadSlice('\n')
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil
+	}
+	if len(line) > maxShebangLength {
+		line = line[:maxShebangLength]
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(string(line), "#!"))
+	if len(fields) == 0 {
+		return nil
+	}
+
+	locations := w.resolveCommand(fields[0])
+	if path.Base(fields[0]) == "env" {
+		for _, arg := range fields[1:] {
+			if strings.HasPrefix(arg, "-") || isAssignment(arg) {
+				continue
+			}
+			locations = append(locations, w.resolveCommand(arg)...)
+			break
+		}
+	}
+	return locations
+}
+
+// elfDependencies returns the program interpreter (dynamic linker) and shared libraries needed by an ELF binary.
+func (w *walker) elfDependencies(location file.Location, reader io.Reader) []file.Location {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil
+	}
+
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to parse ELF binary within entrypoint closure")
+		return nil
+	}
+
+	var locations []file.Location
+	for _, prog := range f.Progs {
+		if prog.Type != elf.PT_INTERP {
+			continue
+		}
+		interpreter, err := io.ReadAll(prog.Open())
+		if err != nil {
+			continue
+		}
+		locations = append(locations, w.filesByPath(strings.TrimRight(string(interpreter), "\x00"))...)
+	}
+
+	libraries, err := f.ImportedLibraries()
+	if err != nil {
+		return locations
+	}
+
+	searchPath := executable.NewELFDynamicSection(f).LibrarySearchPath(path.Dir(location.RealPath))
+	for _, library := range libraries {
+		locations = append(locations, w.resolveLibrary(library, searchPath)...)
+	}
+	return locations
+}
+
+func (w *walker) resolveLibrary(library string, searchPath []string) []file.Location {
+	if strings.Contains(library, "/") {
+		return w.filesByPath(library)
+	}
+
+	for _, dir := range searchPath {
+		if locations := w.filesByPath(path.Join(dir, library)); len(locations) > 0 {
+			return locations
+		}
+	}
+
+	// the default library search path (and the ld.so cache) varies by distribution, so consider any library with the
+	// same name
+	locations, err := w.resolver.FilesByGlob("**/" + library)
+	if err != nil {
+		log.WithFields("library", library, "error", err).Trace("unable to resolve library within entrypoint closure")
+		return nil
+	}
+	return locations
+}
+
+// resolveCommand returns the executable for a command, which is either a path (relative to the working directory) or
+// the name of an executable within the search path.
+func (w *walker) resolveCommand(command string) []file.Location {
+	if command == "" {
+		return nil
+	}
+
+	if strings.Contains(command, "/") {
+		if !path.IsAbs(command) {
+			command = path.Join("/", w.entrypoint.WorkingDir, command)
+		}
+		return w.filesByPath(command)
+	}
+
+	for _, dir := range w.entrypoint.Path {
+		if locations := w.filesByPath(path.Join(dir, command)); len(locations) > 0 {
+			return locations
+		}
+	}
+	return nil
+}
+
+func (w *walker) filesByPath(p string) []file.Location {
+	locations, err := w.resolver.FilesByPath(p)
+	if err != nil {
+		log.WithFields("path", p, "error", err).Trace("unable to resolve path within entrypoint closure")
+		return nil
+	}
+	return locations
+}
@@ -0,0 +1,108 @@
+package reachability
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+// fixtureResolver resolves absolute paths to files within the test-fixtures/root directory.
+type fixtureResolver struct {
+	*file.MockResolver
+}
+
+func newFixtureResolver(t *testing.T) fixtureResolver {
+	var paths []string
+	err := filepath.WalkDir("test-fixtures/root", func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel("test-fixtures/root", p)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, "/"+filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fixtureResolver{MockResolver: file.NewMockResolverForPaths(paths...)}
+}
+
+func (r fixtureResolver) FileContentsByLocation(location file.Location) (io.ReadCloser, error) {
+	return os.Open(filepath.Join("test-fixtures/root", location.RealPath))
+}
+
+func TestEntrypointClosure(t *testing.T) {
+	tests := []struct {
+		name       string
+		entrypoint Entrypoint
+		want       []string
+	}{
+		{
+			name: "dynamically linked executable from a shell command",
+			entrypoint: Entrypoint{
+				Args: []string{"/bin/sh", "-c", "FOO=bar exec app --verbose"},
+				Path: []string{"/usr/local/bin", "/usr/bin"},
+			},
+			want: []string{
+				"/lib64/ld-linux-x86-64.so.2",
+				"/usr/bin/app",
+				"/usr/lib/app/libfoo.so",
+				"/usr/lib/x86_64-linux-gnu/libc.so.6",
+			},
+		},
+		{
+			name: "script interpreted via env",
+			entrypoint: Entrypoint{
+				Args: []string{"entrypoint.sh"},
+				Path: []string{"/usr/local/bin", "/usr/bin"},
+			},
+			want: []string{
+				"/usr/bin/env",
+				"/usr/bin/python3",
+				"/usr/local/bin/entrypoint.sh",
+			},
+		},
+		{
+			name: "relative to the working directory",
+			entrypoint: Entrypoint{
+				Args:       []string{"./entrypoint.sh"},
+				WorkingDir: "/usr/local/bin",
+			},
+			// note: python3 is not found without a search path
+			want: []string{
+				"/usr/bin/env",
+				"/usr/local/bin/entrypoint.sh",
+			},
+		},
+		{
+			name: "missing executable",
+			entrypoint: Entrypoint{
+				Args: []string{"nginx"},
+				Path: []string{"/usr/sbin"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closure := EntrypointClosure(newFixtureResolver(t), tt.entrypoint)
+			assert.Equal(t, tt.want, closure.Paths())
+		})
+	}
+}
+
+func TestClosure_Contains(t *testing.T) {
+	closure := NewClosure("/usr/bin/bash", "/lib/libc.so")
+
+	assert.True(t, closure.Contains("/usr/bin/bash"))
+	assert.True(t, closure.Contains("/bin/bash"))
+	assert.True(t, closure.Contains("/usr/lib/libc.so"))
+	assert.False(t, closure.Contains("/usr/bin/sh"))
+}
@@ -0,0 +1,125 @@
+package reachability
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// defaultPath is the PATH used by container runtimes when the image does not define one.
+const defaultPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// Entrypoint describes what a container runs when started from an image.
+type Entrypoint struct {
+	// Args are the entrypoint followed by the command (the cmd is only used as arguments when there is an entrypoint).
+	Args []string
+
+	// Path are the directories searched for executables referenced by name.
+	Path []string
+
+	// WorkingDir is the directory that relative executable paths are resolved against.
+	WorkingDir string
+}
+
+type imageConfig struct {
+	Config struct {
+		Entrypoint []string `json:"Entrypoint"`
+		Cmd        []string `json:"Cmd"`
+		Env        []string `json:"Env"`
+		WorkingDir string   `json:"WorkingDir"`
+	} `json:"config"`
+}
+
+// ImageEntrypoint returns the entrypoint of the image as described by the image config, or nil if the image does not
+// define an entrypoint or cmd.
+func ImageEntrypoint(metadata source.ImageMetadata) (*Entrypoint, error) {
+	if len(metadata.RawConfig) == 0 {
+		return nil, nil
+	}
+
+	var cfg imageConfig
+	if err := json.Unmarshal(metadata.RawConfig, &cfg); err != nil {
+		return nil, err
+	}
+
+	args := append(append([]string{}, cfg.Config.Entrypoint...), cfg.Config.Cmd...)
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	searchPath := defaultPath
+	for _, env := range cfg.Config.Env {
+		if value, ok := strings.CutPrefix(env, "PATH="); ok {
+			searchPath = value
+		}
+	}
+
+	e := &Entrypoint{
+		Args:       args,
+		WorkingDir: cfg.Config.WorkingDir,
+	}
+	for _, dir := range strings.Split(searchPath, ":") {
+		if dir != "" {
+			e.Path = append(e.Path, dir)
+		}
+	}
+	return e, nil
+}
+
+// Commands returns the executables run by the entrypoint. This is the first argument, along with the command (or
+// script) run by a shell when the entrypoint is a shell invocation (e.g. the shell form of an entrypoint:
+// /bin/sh -c "exec app").
+func (e Entrypoint) Commands() []string {
+	if len(e.Args) == 0 {
+		return nil
+	}
+
+	commands := []string{e.Args[0]}
+	if len(e.Args) < 2 || !isShell(e.Args[0]) {
+		return commands
+	}
+
+	switch {
+	case e.Args[1] == "-c":
+		if len(e.Args) > 2 {
+			if c := shellCommand(e.Args[2]); c != "" {
+				commands = append(commands, c)
+			}
+		}
+	case !strings.HasPrefix(e.Args[1], "-"):
+		// a script run by the shell
+		commands = append(commands, e.Args[1])
+	}
+	return commands
+}
+
+func isShell(command string) bool {
+	switch path.Base(command) {
+	case "sh", "bash", "ash", "dash", "zsh":
+		return true
+	}
+	return false
+}
+
+// shellCommand returns the first command run by the given shell script, skipping over variable assignments and exec.
+func shellCommand(script string) string {
+	for _, word := range strings.Fields(script) {
+		word = strings.Trim(word, `"'`)
+		switch {
+		case word == "" || word == "exec":
+			continue
+		case isAssignment(word):
+			// e.g. FOO=bar app
+			continue
+		}
+		return word
+	}
+	return ""
+}
+
+func isAssignment(word string) bool {
+	name, _, found := strings.Cut(word, "=")
+	return found && name != "" && !strings.Contains(name, "/")
+}
@@ -0,0 +1,93 @@
+package reachability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestImageEntrypoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    *Entrypoint
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:   "entrypoint with cmd",
+			config: `{"config":{"Entrypoint":["/docker-entrypoint.sh"],"Cmd":["nginx","-g","daemon off;"],"Env":["PATH=/opt/bin:/usr/bin","LANG=C"],"WorkingDir":"/srv"}}`,
+			want: &Entrypoint{
+				Args:       []string{"/docker-entrypoint.sh", "nginx", "-g", "daemon off;"},
+				Path:       []string{"/opt/bin", "/usr/bin"},
+				WorkingDir: "/srv",
+			},
+		},
+		{
+			name:   "cmd with the default path",
+			config: `{"config":{"Cmd":["python3","app.py"]}}`,
+			want: &Entrypoint{
+				Args: []string{"python3", "app.py"},
+				Path: []string{"/usr/local/sbin", "/usr/local/bin", "/usr/sbin", "/usr/bin", "/sbin", "/bin"},
+			},
+		},
+		{
+			name:   "no entrypoint or cmd",
+			config: `{"config":{"Env":["PATH=/usr/bin"]}}`,
+		},
+		{
+			name: "no config",
+		},
+		{
+			name:    "invalid config",
+			config:  `{`,
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := ImageEntrypoint(source.ImageMetadata{RawConfig: []byte(tt.config)})
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEntrypoint_Commands(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "exec form",
+			args: []string{"/usr/bin/app", "--port", "8080"},
+			want: []string{"/usr/bin/app"},
+		},
+		{
+			name: "shell form",
+			args: []string{"/bin/sh", "-c", "exec app --port 8080"},
+			want: []string{"/bin/sh", "app"},
+		},
+		{
+			name: "shell form with variable assignments",
+			args: []string{"/bin/bash", "-c", "PORT=8080 LOG=/var/log/app.log '/opt/app/bin/server'"},
+			want: []string{"/bin/bash", "/opt/app/bin/server"},
+		},
+		{
+			name: "shell running a script",
+			args: []string{"sh", "/entrypoint.sh"},
+			want: []string{"sh", "/entrypoint.sh"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Entrypoint{Args: tt.args}.Commands())
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package reachability
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// the maximum amount of a source file that is scanned for imports (imports are expected at the start of a file)
+const maxSourceFileSize = 1024 * 1024
+
+// dependencyDirs are directories holding installed (third-party) code, which are not considered first-party sources.
+var dependencyDirs = map[string]struct{}{
+	"site-packages":    {},
+	"dist-packages":    {},
+	"__pypackages__":   {},
+	"node_modules":     {},
+	"bower_components": {},
+	"jspm_packages":    {},
+	"gems":             {},
+	"vendor":           {},
+	".git":             {},
+}
+
+type importScanner struct {
+	language pkg.Language
+	globs    []string
+	imports  func(line string) []string
+}
+
+var importScanners = []importScanner{
+	{
+		language: pkg.Python,
+		globs:    []string{"**/*.py"},
+		imports:  pythonImports,
+	},
+	{
+		language: pkg.JavaScript,
+		globs:    []string{"**/*.js", "**/*.mjs", "**/*.cjs", "**/*.jsx", "**/*.ts", "**/*.mts", "**/*.cts", "**/*.tsx"},
+		imports:  javascriptImports,
+	},
+	{
+		language: pkg.Ruby,
+		globs:    []string{"**/*.rb"},
+		imports:  rubyImports,
+	},
+}
+
+// Imports are the modules imported by first-party source files, by language. These are the top-level modules for
+// Python, the package names for JavaScript, and the required paths for Ruby.
+type Imports struct {
+	modules map[pkg.Language]map[string]struct{}
+}
+
+// NewImports returns imports of the given modules for a language.
+func NewImports(language pkg.Language, modules ...string) Imports {
+	i := Imports{modules: make(map[pkg.Language]map[string]struct{})}
+	for _, m := range modules {
+		i.add(language, m)
+	}
+	return i
+}
+
+func (i Imports) add(language pkg.Language, module string) {
+	if _, ok := i.modules[language]; !ok {
+		i.modules[language] = make(map[string]struct{})
+	}
+	i.modules[language][module] = struct{}{}
+}
+
+// Contains indicates if the given module is imported by any source file of the language.
+func (i Imports) Contains(language pkg.Language, module string) bool {
+	_, ok := i.modules[language][module]
+	return ok
+}
+
+// Modules returns the sorted modules imported by source files of the language.
+func (i Imports) Modules(language pkg.Language) []string {
+	var modules []string
+	for m := range i.modules[language] {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// SourceImports returns the modules imported by the Python, JavaScript, and Ruby source files found by the resolver,
+// ignoring files within directories of installed dependencies and any files the given function excludes (e.g. files
+// owned by packages). This is a best-effort static analysis, where dynamic imports by computed names are not found.
+func SourceImports(resolver file.Resolver, exclude func(file.Location) bool) Imports {
+	imports := Imports{modules: make(map[pkg.Language]map[string]struct{})}
+	for _, scanner := range importScanners {
+		visited := make(map[string]struct{})
+		for _, glob := range scanner.globs {
+			locations, err := resolver.FilesByGlob(glob)
+			if err != nil {
+				log.WithFields("glob", glob, "error", err).Trace("unable to resolve source files for import scanning")
+				continue
+			}
+			for _, location := range locations {
+				if _, ok := visited[location.RealPath]; ok {
+					continue
+				}
+				visited[location.RealPath] = struct{}{}
+
+				if isDependencyPath(location.RealPath) || exclude != nil && exclude(location) {
+					continue
+				}
+				for _, module := range scanSource(resolver, location, scanner.imports) {
+					imports.add(scanner.language, module)
+				}
+			}
+		}
+	}
+	return imports
+}
+
+func scanSource(resolver file.Resolver, location file.Location, extract func(string) []string) []string {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read source file for import scanning")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	var modules []string
+	scanner := bufio.NewScanner(io.LimitReader(contents, maxSourceFileSize))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSourceFileSize)
+	for scanner.Scan() {
+		modules = append(modules, extract(scanner.Text())...)
+	}
+	if err := scanner.Err(); err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to scan source file for imports")
+	}
+	return modules
+}
+
+// isDependencyPath indicates if the path is within a directory of installed dependencies or of a language runtime
+// (e.g. /usr/lib/python3.12 or /usr/local/lib/ruby).
+func isDependencyPath(p string) bool {
+	segments := strings.Split(path.Dir(p), "/")
+	for i, segment := range segments {
+		if _, ok := dependencyDirs[segment]; ok {
+			return true
+		}
+		if i > 0 && segments[i-1] == "lib" && (strings.HasPrefix(segment, "python") || segment == "ruby") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	pythonImportPattern     = regexp.MustCompile(`^\s*import\s+([\w.]+(?:\s+as\s+\w+)?(?:\s*,\s*[\w.]+(?:\s+as\s+\w+)?)*)`)
+	pythonFromImportPattern = regexp.MustCompile(`^\s*from\s+([\w.]+)\s+import\b`)
+)
+
+// pythonImports returns the top-level modules imported by a line of Python source (e.g. "import os.path" or
+// "from requests.adapters import HTTPAdapter"), where relative imports are ignored.
+func pythonImports(line string) []string {
+	if match := pythonFromImportPattern.FindStringSubmatch(line); match != nil {
+		if strings.HasPrefix(match[1], ".") {
+			return nil
+		}
+		return []string{pythonTopLevel(match[1])}
+	}
+
+	match := pythonImportPattern.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+
+	var modules []string
+	for _, clause := range strings.Split(match[1], ",") {
+		fields := strings.Fields(clause)
+		if len(fields) > 0 {
+			modules = append(modules, pythonTopLevel(fields[0]))
+		}
+	}
+	return modules
+}
+
+func pythonTopLevel(module string) string {
+	top, _, _ := strings.Cut(module, ".")
+	return strings.ToLower(top)
+}
+
+var javascriptImportPatterns = []*regexp.Regexp{
+	// const x = require("pkg")
+	regexp.MustCompile(`\brequire\s*\(\s*['"]([^'"]+)['"]\s*\)`),
+	// import("pkg")
+	regexp.MustCompile(`\bimport\s*\(\s*['"]([^'"]+)['"]\s*\)`),
+	// import x from "pkg", export * from "pkg", and the end of multi-line imports: } from "pkg"
+	regexp.MustCompile(`(?:^|[\s}*])from\s*['"]([^'"]+)['"]`),
+	// import "pkg"
+	regexp.MustCompile(`^\s*import\s*['"]([^'"]+)['"]`),
+}
+
+// javascriptImports returns the packages imported (or required) by a line of JavaScript or TypeScript source, where
+// relative imports and builtin modules (e.g. "node:fs") are ignored.
+func javascriptImports(line string) []string {
+	var modules []string
+	for _, pattern := range javascriptImportPatterns {
+		for _, match := range pattern.FindAllStringSubmatch(line, -1) {
+			if name := javascriptPackage(match[1]); name != "" {
+				modules = append(modules, name)
+			}
+		}
+	}
+	return modules
+}
+
+// javascriptPackage returns the package name of an import specifier (e.g. "@scope/pkg" for "@scope/pkg/sub/path").
+func javascriptPackage(specifier string) string {
+	if specifier == "" || strings.HasPrefix(specifier, ".") || strings.HasPrefix(specifier, "/") || strings.Contains(specifier, ":") {
+		return ""
+	}
+
+	parts := strings.Split(specifier, "/")
+	if strings.HasPrefix(specifier, "@") {
+		if len(parts) < 2 {
+			return ""
+		}
+		return parts[0] + "/" + parts[1]
+	}
+	return parts[0]
+}
+
+var rubyRequirePattern = regexp.MustCompile(`^\s*require\s*\(?\s*['"]([^'"]+)['"]`)
+
+// rubyImports returns the path required by a line of Ruby source (e.g. "active_support/core_ext" for
+// require "active_support/core_ext"), where relative requires are ignored.
+func rubyImports(line string) []string {
+	match := rubyRequirePattern.FindStringSubmatch(line)
+	if match == nil || strings.HasPrefix(match[1], ".") || strings.HasPrefix(match[1], "/") {
+		return nil
+	}
+	return []string{match[1]}
+}
@@ -0,0 +1,83 @@
+package reachability
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestSourceImports(t *testing.T) {
+	var paths []string
+	err := filepath.WalkDir("test-fixtures/imports", func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		paths = append(paths, filepath.ToSlash(p))
+		return nil
+	})
+	require.NoError(t, err)
+
+	resolver := file.NewMockResolverForPaths(paths...)
+
+	imports := SourceImports(resolver, nil)
+	assert.Equal(t, []string{"flask", "importlib", "numpy", "os", "requests", "sys", "yaml"}, imports.Modules(pkg.Python))
+	assert.Equal(t, []string{"@acme/charts", "@types/express-serve-static-core", "axios", "debounce", "express", "lodash", "react", "reflect-metadata"}, imports.Modules(pkg.JavaScript))
+	assert.Equal(t, []string{"active_support/core_ext", "json", "rack/test"}, imports.Modules(pkg.Ruby))
+
+	// excluded files are not scanned
+	imports = SourceImports(resolver, func(l file.Location) bool {
+		return filepath.Ext(l.RealPath) != ".rb"
+	})
+	assert.Empty(t, imports.Modules(pkg.Python))
+	assert.Empty(t, imports.Modules(pkg.JavaScript))
+	assert.True(t, imports.Contains(pkg.Ruby, "json"))
+}
+
+func Test_isDependencyPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/app/main.py", want: false},
+		{path: "/app/lib/tasks.rb", want: false},
+		{path: "/usr/lib/python3/dist-packages/yaml/__init__.py", want: true},
+		{path: "/app/.venv/lib/python3.12/site-packages/flask/app.py", want: true},
+		{path: "/usr/local/lib/python3.12/json/__init__.py", want: true},
+		{path: "/usr/local/lib/ruby/3.3.0/json.rb", want: true},
+		{path: "/app/node_modules/express/index.js", want: true},
+		{path: "/app/vendor/bundle/ruby/3.3.0/gems/rack-3.0.0/lib/rack.rb", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, isDependencyPath(tt.path))
+		})
+	}
+}
+
+func Test_javascriptPackage(t *testing.T) {
+	tests := []struct {
+		specifier string
+		want      string
+	}{
+		{specifier: "react", want: "react"},
+		{specifier: "lodash/fp", want: "lodash"},
+		{specifier: "@scope/pkg/sub/path", want: "@scope/pkg"},
+		{specifier: "@scope", want: ""},
+		{specifier: "./local", want: ""},
+		{specifier: "../parent", want: ""},
+		{specifier: "/abs/path", want: ""},
+		{specifier: "node:fs", want: ""},
+		{specifier: "https://esm.sh/react", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.specifier, func(t *testing.T) {
+			assert.Equal(t, tt.want, javascriptPackage(tt.specifier))
+		})
+	}
+}
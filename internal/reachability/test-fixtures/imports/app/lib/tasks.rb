@@ -0,0 +1,5 @@
+require "json"
+require 'active_support/core_ext'
+require("rack/test")
+require_relative "helpers"
+require "./local"
@@ -0,0 +1,17 @@
+"""An example application."""
+import os, sys
+import numpy as np
+import yaml.constructor
+from requests.adapters import HTTPAdapter
+from . import settings
+from .models import User
+
+from flask import (
+    Flask,
+    jsonify,
+)
+
+
+def main():
+    import importlib  # nested imports are found too
+    return Flask(__name__)
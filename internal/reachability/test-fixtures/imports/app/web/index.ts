@@ -0,0 +1,16 @@
+import express from "express";
+import { Router } from 'express';
+import * as _ from "lodash/fp";
+import type { Options } from "@types/express-serve-static-core";
+import {
+  useState,
+  useEffect,
+} from "react";
+import "reflect-metadata";
+import { helper } from "./helper";
+import fs from "node:fs";
+
+const axios = require("axios");
+const chart = await import("@acme/charts/line");
+
+export { default as debounce } from "debounce";
@@ -0,0 +1,45 @@
+/*
+Package registry provides access to container registries configured the same way as for pulling images (e.g. for
+fetching image indexes or SBOMs attached to images).
+*/
+package registry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal/log"
+)
+
+// RemoteOptions returns the options to access the registry of the given reference, configured the same way as for
+// pulling images from a registry.
+func RemoteOptions(ctx context.Context, ref name.Reference, registryOptions image.RegistryOptions) []remote.Option {
+	options := []remote.Option{remote.WithContext(ctx)}
+
+	registryName := ref.Context().RegistryStr()
+	authenticator := registryOptions.Authenticator(registryName)
+	switch {
+	case authenticator != nil:
+		options = append(options, remote.WithAuth(authenticator))
+	case registryOptions.Keychain != nil:
+		options = append(options, remote.WithAuthFromKeychain(registryOptions.Keychain))
+	default:
+		options = append(options, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	}
+
+	tlsConfig, err := registryOptions.TLSConfig(registryName)
+	if err != nil {
+		log.WithFields("registry", registryName, "error", err).Warn("unable to configure TLS transport")
+	} else if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		options = append(options, remote.WithTransport(transport))
+	}
+
+	return options
+}
@@ -0,0 +1,37 @@
+package relationship
+
+import (
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// AccountShells creates relationships between user accounts and the packages that own their login shells (per the
+// package manager metadata), where the package providing the shell is a dependency of the account.
+func AccountShells(catalog *pkg.Collection) []artifact.Relationship {
+	if catalog == nil {
+		return nil
+	}
+
+	var edges []artifact.Relationship
+	var index ownersByPath
+	for _, account := range catalog.Sorted(pkg.OSAccountPkg) {
+		user, ok := account.Metadata.(pkg.OSUserAccount)
+		if !ok || user.Shell == "" {
+			continue
+		}
+
+		if index == nil {
+			index = newOwnersByPath(catalog)
+		}
+
+		for _, owner := range index.owners(user.Shell) {
+			edges = append(edges, artifact.Relationship{
+				From: owner,
+				To:   account,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	return edges
+}
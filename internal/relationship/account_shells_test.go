@@ -0,0 +1,107 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestAccountShells(t *testing.T) {
+	bash := pkg.Package{
+		Name: "bash",
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package: "bash",
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/bin/bash"},
+				{Path: "/etc/bash.bashrc"},
+			},
+		},
+	}
+	bash.SetID()
+
+	dash := pkg.Package{
+		Name: "dash",
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package: "dash",
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/usr/bin/dash"},
+			},
+		},
+	}
+	dash.SetID()
+
+	root := pkg.Package{
+		Name:     "root",
+		Type:     pkg.OSAccountPkg,
+		Metadata: pkg.OSUserAccount{Shell: "/bin/bash", Login: true},
+	}
+	root.SetID()
+
+	// note: the shell is owned by the package under /usr/bin (where /bin is a symlink to /usr/bin)
+	dev := pkg.Package{
+		Name:     "dev",
+		Type:     pkg.OSAccountPkg,
+		Metadata: pkg.OSUserAccount{UID: 1000, Shell: "/bin/dash", Login: true},
+	}
+	dev.SetID()
+
+	daemon := pkg.Package{
+		Name:     "daemon",
+		Type:     pkg.OSAccountPkg,
+		Metadata: pkg.OSUserAccount{UID: 1, Shell: "/usr/sbin/nologin"},
+	}
+	daemon.SetID()
+
+	group := pkg.Package{
+		Name:     "root",
+		Type:     pkg.OSAccountPkg,
+		Metadata: pkg.OSGroupAccount{},
+	}
+	group.SetID()
+
+	tests := []struct {
+		name    string
+		catalog *pkg.Collection
+		want    []artifact.Relationship
+	}{
+		{
+			name:    "no catalog",
+			catalog: nil,
+			want:    nil,
+		},
+		{
+			name:    "no accounts",
+			catalog: pkg.NewCollection(bash, dash),
+			want:    nil,
+		},
+		{
+			name:    "accounts related to the packages owning their shells",
+			catalog: pkg.NewCollection(bash, dash, root, dev, daemon, group),
+			want: []artifact.Relationship{
+				{
+					From: bash,
+					To:   root,
+					Type: artifact.DependencyOfRelationship,
+				},
+				{
+					From: dash,
+					To:   dev,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AccountShells(tt.catalog)
+			Sort(got)
+			Sort(tt.want)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package binary
 
 import (
 	"path"
+	"strings"
 
 	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/relationship"
@@ -49,7 +50,7 @@ func generateRelationships(resolver file.Resolver, accessor sbomsync.Accessor, i
 					continue
 				}
 
-				populateRelationships(exec, parentPkg, resolver, addRelationship, index)
+				populateRelationships(exec, evidentLocation.Coordinates, parentPkg, resolver, addRelationship, index)
 			}
 		}
 	})
@@ -142,13 +143,24 @@ func getBinaryPackagesToDelete(resolver file.Resolver, s *sbom.SBOM) []artifact.
 	return pkgsToDelete
 }
 
-func populateRelationships(exec file.Executable, parentPkg pkg.Package, resolver file.Resolver, addRelationship func(artifact.Relationship), index *sharedLibraryIndex) {
+func populateRelationships(exec file.Executable, execCoordinates file.Coordinates, parentPkg pkg.Package, resolver file.Resolver, addRelationship func(artifact.Relationship), index *sharedLibraryIndex) {
+	searchPath := exec.ELFDynamicSection.LibrarySearchPath(path.Dir(execCoordinates.RealPath))
+
 	for _, libReference := range exec.ImportedLibraries {
 		// for each library reference, check s.Artifacts.Packages.Sorted(pkg.BinaryPkg) for a binary package that represents that library
 		// if found, create a relationship between the parent package and the library package
 		// if not found do nothing.
 		// note: we only care about package-to-package relationships
 
+		// the dynamic linker considers the search path embedded within the binary (RUNPATH or RPATH) first, so when the
+		// library is found there we know exactly which library is used
+		if libLocations := resolveFromSearchPath(resolver, libReference, searchPath); len(libLocations) > 0 {
+			for _, loc := range libLocations {
+				addLibraryRelationships(loc.Coordinates, index.owningPackages(loc.Coordinates), parentPkg, addRelationship)
+			}
+			continue
+		}
+
 		// find the basename of the library
 		libBasename := path.Base(libReference)
 		libLocations, err := resolver.FilesByGlob("**/" + libBasename)
@@ -160,25 +172,57 @@ func populateRelationships(exec file.Executable, parentPkg pkg.Package, resolver
 		for _, loc := range libLocations {
 			// are you in our index?
 			realBaseName := path.Base(loc.RealPath)
-			pkgCollection := index.owningLibraryPackage(realBaseName)
-			if pkgCollection.PackageCount() < 1 {
-				addRelationship(
-					artifact.Relationship{
-						From: loc.Coordinates,
-						To:   parentPkg,
-						Type: artifact.DependencyOfRelationship,
-					},
-				)
-			}
-			for _, p := range pkgCollection.Sorted() {
-				addRelationship(
-					artifact.Relationship{
-						From: p,
-						To:   parentPkg,
-						Type: artifact.DependencyOfRelationship,
-					},
-				)
-			}
+			addLibraryRelationships(loc.Coordinates, index.owningLibraryPackage(realBaseName), parentPkg, addRelationship)
+		}
+
+		if len(libLocations) > 0 {
+			continue
+		}
+
+		// there is no file named after the library, however, there may be a library that is known by that name (e.g.
+		// "libfoo.so.1" may only exist as "libfoo.so.1.2.3" when the versioned symlinks were not preserved)
+		for _, coord := range index.librariesBySOName(libReference) {
+			addLibraryRelationships(coord, index.owningPackages(coord), parentPkg, addRelationship)
+		}
+	}
+}
+
+func addLibraryRelationships(libCoordinates file.Coordinates, owners *pkg.Collection, parentPkg pkg.Package, addRelationship func(artifact.Relationship)) {
+	if owners.PackageCount() < 1 {
+		addRelationship(
+			artifact.Relationship{
+				From: libCoordinates,
+				To:   parentPkg,
+				Type: artifact.DependencyOfRelationship,
+			},
+		)
+	}
+	for _, p := range owners.Sorted() {
+		addRelationship(
+			artifact.Relationship{
+				From: p,
+				To:   parentPkg,
+				Type: artifact.DependencyOfRelationship,
+			},
+		)
+	}
+}
+
+func resolveFromSearchPath(resolver file.Resolver, libReference string, searchPath []string) []file.Location {
+	if strings.Contains(libReference, "/") {
+		// a needed entry with a slash is a path to the library, not a name to be searched for
+		return nil
+	}
+
+	for _, dir := range searchPath {
+		libLocations, err := resolver.FilesByPath(path.Join(dir, libReference))
+		if err != nil {
+			log.WithFields("lib", libReference, "dir", dir, "error", err).Trace("unable to resolve library within search path")
+			continue
+		}
+		if len(libLocations) > 0 {
+			return libLocations
 		}
 	}
+	return nil
 }
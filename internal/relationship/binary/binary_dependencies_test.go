@@ -213,6 +213,65 @@ func TestNewDependencyRelationships(t *testing.T) {
 		},
 	}
 
+	// coordinates for an application that bundles its own libraries (found via RUNPATH) and links against a library
+	// that is only present under its full version (found via SONAME)
+	appCoordinate := file.NewCoordinates("/opt/app/bin/app", "")
+	bundledGlibcCoordinate := file.NewCoordinates("/opt/app/lib/libc.so.6", "")
+	fooCoordinate := file.NewCoordinates("/usr/lib64/libfoo.so.1.2.3", "")
+
+	bundledGlibcPackage := pkg.Package{
+		Name:      "glibc",
+		Version:   "2.39",
+		Locations: file.NewLocationSet(file.NewLocation(bundledGlibcCoordinate.RealPath)),
+		Type:      pkg.BinaryPkg,
+	}
+	bundledGlibcPackage.SetID()
+
+	fooPackage := pkg.Package{
+		Name:      "libfoo",
+		Version:   "1.2.3-1.el8",
+		Locations: file.NewLocationSet(file.NewLocation(fooCoordinate.RealPath)),
+		Type:      pkg.RpmPkg,
+		Metadata: pkg.RpmDBEntry{
+			Files: []pkg.RpmFileRecord{
+				{
+					Path: fooCoordinate.RealPath,
+				},
+			},
+		},
+	}
+	fooPackage.SetID()
+
+	appPackage := pkg.Package{
+		Name:    "app",
+		Version: "1.0.0",
+		Locations: file.NewLocationSet(
+			file.NewLocation(appCoordinate.RealPath).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+		Type: pkg.BinaryPkg,
+	}
+	appPackage.SetID()
+
+	appExecutable := func(dynamicSection *file.ELFDynamicSection) file.Executable {
+		return file.Executable{
+			Format:        "elf",
+			HasEntrypoint: true,
+			ImportedLibraries: []string{
+				"libc.so.6",
+			},
+			ELFDynamicSection: dynamicSection,
+		}
+	}
+
+	fooExecutable := file.Executable{
+		Format:            "elf",
+		HasExports:        true,
+		ImportedLibraries: []string{},
+		ELFDynamicSection: &file.ELFDynamicSection{
+			SOName: "libfoo.so.1",
+		},
+	}
+
 	tests := []struct {
 		name                    string
 		resolver                file.Resolver
@@ -308,6 +367,104 @@ func TestNewDependencyRelationships(t *testing.T) {
 			},
 			packages: []pkg.Package{glibCPackage, syftTestFixturePackage},
 		},
+		{
+			name: "given an executable with a runpath relative to its origin, expect only the library within the runpath to be used",
+			resolver: file.NewMockResolverForPaths(
+				glibcCoordinate.RealPath,
+				bundledGlibcCoordinate.RealPath,
+				appCoordinate.RealPath,
+			),
+			coordinateIndex: map[file.Coordinates]file.Executable{
+				glibcCoordinate:        glibcExecutable,
+				bundledGlibcCoordinate: glibcExecutable,
+				appCoordinate: appExecutable(&file.ELFDynamicSection{
+					RunPath: []string{"$ORIGIN/../lib"},
+				}),
+			},
+			packages: []pkg.Package{glibCPackage, bundledGlibcPackage, appPackage},
+			want: []artifact.Relationship{
+				{
+					From: bundledGlibcPackage,
+					To:   appPackage,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
+		{
+			name: "given an executable with an rpath and a runpath, expect the rpath to be ignored",
+			resolver: file.NewMockResolverForPaths(
+				glibcCoordinate.RealPath,
+				bundledGlibcCoordinate.RealPath,
+				appCoordinate.RealPath,
+			),
+			coordinateIndex: map[file.Coordinates]file.Executable{
+				glibcCoordinate:        glibcExecutable,
+				bundledGlibcCoordinate: glibcExecutable,
+				appCoordinate: appExecutable(&file.ELFDynamicSection{
+					RPath:   []string{"/opt/app/lib"},
+					RunPath: []string{"/usr/lib64"},
+				}),
+			},
+			packages: []pkg.Package{glibCPackage, bundledGlibcPackage, appPackage},
+			want: []artifact.Relationship{
+				{
+					From: glibCPackage,
+					To:   appPackage,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
+		{
+			name: "given an executable with a search path that cannot be resolved, expect all libraries with the same name to be used",
+			resolver: file.NewMockResolverForPaths(
+				glibcCoordinate.RealPath,
+				bundledGlibcCoordinate.RealPath,
+				appCoordinate.RealPath,
+			),
+			coordinateIndex: map[file.Coordinates]file.Executable{
+				glibcCoordinate:        glibcExecutable,
+				bundledGlibcCoordinate: glibcExecutable,
+				appCoordinate: appExecutable(&file.ELFDynamicSection{
+					RunPath: []string{"./libs"},
+				}),
+			},
+			packages: []pkg.Package{glibCPackage, bundledGlibcPackage, appPackage},
+			want: []artifact.Relationship{
+				{
+					From: glibCPackage,
+					To:   appPackage,
+					Type: artifact.DependencyOfRelationship,
+				},
+				{
+					From: bundledGlibcPackage,
+					To:   appPackage,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
+		{
+			name: "given an executable that needs a library only present under another filename, expect the library to be found by SONAME",
+			resolver: file.NewMockResolverForPaths(
+				fooCoordinate.RealPath,
+				appCoordinate.RealPath,
+			),
+			coordinateIndex: map[file.Coordinates]file.Executable{
+				fooCoordinate: fooExecutable,
+				appCoordinate: {
+					Format:            "elf",
+					HasEntrypoint:     true,
+					ImportedLibraries: []string{"libfoo.so.1"},
+				},
+			},
+			packages: []pkg.Package{fooPackage, appPackage},
+			want: []artifact.Relationship{
+				{
+					From: fooPackage,
+					To:   appPackage,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 
 type sharedLibraryIndex struct {
 	libLocationsByBasename map[string]file.CoordinateSet
+	libLocationsBySOName   map[string]file.CoordinateSet
 	allLibLocations        file.CoordinateSet
 	packagesByLibraryPath  map[file.Coordinates]*pkg.Collection
 }
@@ -19,6 +20,7 @@ type sharedLibraryIndex struct {
 func newShareLibIndex(resolver file.Resolver, accessor sbomsync.Accessor) *sharedLibraryIndex {
 	s := &sharedLibraryIndex{
 		libLocationsByBasename: make(map[string]file.CoordinateSet),
+		libLocationsBySOName:   make(map[string]file.CoordinateSet),
 		allLibLocations:        file.NewCoordinateSet(),
 		packagesByLibraryPath:  make(map[file.Coordinates]*pkg.Collection),
 	}
@@ -29,8 +31,8 @@ func newShareLibIndex(resolver file.Resolver, accessor sbomsync.Accessor) *share
 }
 
 func (i *sharedLibraryIndex) build(resolver file.Resolver, accessor sbomsync.Accessor) {
-	// 1. map out all locations that provide libraries (indexed by the basename)
-	i.libLocationsByBasename, i.allLibLocations = locationsThatProvideLibraries(accessor)
+	// 1. map out all locations that provide libraries (indexed by the basename and SONAME)
+	i.libLocationsByBasename, i.libLocationsBySOName, i.allLibLocations = locationsThatProvideLibraries(accessor)
 
 	// 2. for each library path, find all packages that claim ownership of the library
 	i.packagesByLibraryPath = packagesWithLibraryOwnership(resolver, accessor, i.allLibLocations)
@@ -51,9 +53,28 @@ func (i *sharedLibraryIndex) owningLibraryPackage(libraryBasename string) *pkg.C
 	return collection
 }
 
-func locationsThatProvideLibraries(accessor sbomsync.Accessor) (map[string]file.CoordinateSet, file.CoordinateSet) {
-	// map out all locations that provide libraries (indexed by the basename)
+// librariesBySOName returns the locations of all libraries that are known by the given SONAME (DT_SONAME).
+func (i *sharedLibraryIndex) librariesBySOName(soname string) []file.Coordinates {
+	set, ok := i.libLocationsBySOName[soname]
+	if !ok {
+		return nil
+	}
+	return set.ToSlice()
+}
+
+// owningPackages returns all packages that own the library at the given location.
+func (i *sharedLibraryIndex) owningPackages(coord file.Coordinates) *pkg.Collection {
+	collection := pkg.NewCollection()
+	if pkgSet, ok := i.packagesByLibraryPath[coord]; ok {
+		collection.Add(pkgSet.Sorted()...)
+	}
+	return collection
+}
+
+func locationsThatProvideLibraries(accessor sbomsync.Accessor) (map[string]file.CoordinateSet, map[string]file.CoordinateSet, file.CoordinateSet) {
+	// map out all locations that provide libraries (indexed by the basename and SONAME)
 	libLocationsByBasename := make(map[string]file.CoordinateSet)
+	libLocationsBySOName := make(map[string]file.CoordinateSet)
 	allLibLocations := file.NewCoordinateSet()
 
 	accessor.ReadFromSBOM(func(s *sbom.SBOM) {
@@ -68,11 +89,21 @@ func locationsThatProvideLibraries(accessor sbomsync.Accessor) (map[string]file.
 			set.Add(coord)
 			allLibLocations.Add(coord)
 			libLocationsByBasename[basename] = set
+
+			// the SONAME is what other binaries reference as needed, which may differ from the filename of the
+			// library (e.g. when the versioned symlinks to the library are missing)
+			if f.ELFDynamicSection != nil && f.ELFDynamicSection.SOName != "" {
+				soname := f.ELFDynamicSection.SOName
+				sonameSet := libLocationsBySOName[soname]
+				sonameSet.Add(coord)
+				libLocationsBySOName[soname] = sonameSet
+			}
 		}
 	})
 
-	return libLocationsByBasename, allLibLocations
+	return libLocationsByBasename, libLocationsBySOName, allLibLocations
 }
+
 func packagesWithLibraryOwnership(resolver file.Resolver, accessor sbomsync.Accessor, allLibLocations file.CoordinateSet) map[file.Coordinates]*pkg.Collection {
 	// map out all packages that claim ownership of a library at a specific path
 	packagesByLibraryPath := make(map[file.Coordinates]*pkg.Collection)
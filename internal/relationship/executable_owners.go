@@ -0,0 +1,58 @@
+package relationship
+
+import (
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// ExecutableOwners creates relationships between host inventory entries (scheduled tasks and network services) and the
+// packages that own the programs they run (per the package manager metadata), where the package providing the program
+// is a dependency of the entry. Entries which run the file they are defined in (e.g. init scripts) are already related
+// to the owning package by file ownership overlap, so are not considered here.
+func ExecutableOwners(catalog *pkg.Collection) []artifact.Relationship {
+	if catalog == nil {
+		return nil
+	}
+
+	var edges []artifact.Relationship
+	var index ownersByPath
+	for _, p := range catalog.Sorted(pkg.ScheduledTaskPkg, pkg.NetworkServicePkg) {
+		executable := executableOf(p)
+		if executable == "" || definedWithin(p, executable) {
+			continue
+		}
+
+		if index == nil {
+			index = newOwnersByPath(catalog)
+		}
+
+		for _, owner := range index.owners(executable) {
+			edges = append(edges, artifact.Relationship{
+				From: owner,
+				To:   p,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	return edges
+}
+
+func executableOf(p pkg.Package) string {
+	switch metadata := p.Metadata.(type) {
+	case pkg.ScheduledTaskEntry:
+		return metadata.Executable
+	case pkg.NetworkServiceEntry:
+		return metadata.Executable
+	}
+	return ""
+}
+
+func definedWithin(p pkg.Package, path string) bool {
+	for _, l := range p.Locations.ToSlice() {
+		if l.RealPath == path || l.AccessPath == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestExecutableOwners(t *testing.T) {
+	logrotate := pkg.Package{
+		Name: "logrotate",
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package: "logrotate",
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/usr/sbin/logrotate"},
+				{Path: "/etc/cron.daily/logrotate"},
+			},
+		},
+	}
+	logrotate.SetID()
+
+	openssh := pkg.Package{
+		Name: "openssh-server",
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package: "openssh-server",
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/etc/init.d/ssh"},
+				{Path: "/usr/sbin/sshd"},
+			},
+		},
+	}
+	openssh.SetID()
+
+	timer := pkg.Package{
+		Name:      "logrotate",
+		Locations: file.NewLocationSet(file.NewLocation("/lib/systemd/system/logrotate.timer")),
+		Type:      pkg.ScheduledTaskPkg,
+		Metadata: pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.SystemdTimerScheduler,
+			Schedule:   "OnCalendar=daily",
+			Command:    "/usr/sbin/logrotate /etc/logrotate.conf",
+			Executable: "/usr/sbin/logrotate",
+			Unit:       "logrotate.service",
+		},
+	}
+	timer.SetID()
+
+	// note: the init script is related to the package by file ownership overlap instead
+	initScript := pkg.Package{
+		Name:      "ssh",
+		Locations: file.NewLocationSet(file.NewLocation("/etc/init.d/ssh")),
+		Type:      pkg.ScheduledTaskPkg,
+		Metadata: pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.RCScheduler,
+			Executable: "/etc/init.d/ssh",
+		},
+	}
+	initScript.SetID()
+
+	tftp := pkg.Package{
+		Name: "tftpd-hpa",
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgDBEntry{
+			Package: "tftpd-hpa",
+			Files: []pkg.DpkgFileRecord{
+				{Path: "/usr/sbin/in.tftpd"},
+			},
+		},
+	}
+	tftp.SetID()
+
+	service := pkg.Package{
+		Name:      "tftp",
+		Locations: file.NewLocationSet(file.NewLocation("/etc/xinetd.d/tftp")),
+		Type:      pkg.NetworkServicePkg,
+		Metadata: pkg.NetworkServiceEntry{
+			Source:     pkg.XinetdServiceSource,
+			Listeners:  []pkg.NetworkServiceListener{{Address: "69", Protocol: "udp"}},
+			Command:    "/usr/sbin/in.tftpd -s /var/lib/tftpboot",
+			Executable: "/usr/sbin/in.tftpd",
+		},
+	}
+	service.SetID()
+
+	unowned := pkg.Package{
+		Name:      "backup.sh",
+		Locations: file.NewLocationSet(file.NewLocation("/var/spool/cron/crontabs/root")),
+		Type:      pkg.ScheduledTaskPkg,
+		Metadata: pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "@daily",
+			User:       "root",
+			Command:    "/usr/local/bin/backup.sh",
+			Executable: "/usr/local/bin/backup.sh",
+		},
+	}
+	unowned.SetID()
+
+	tests := []struct {
+		name    string
+		catalog *pkg.Collection
+		want    []artifact.Relationship
+	}{
+		{
+			name:    "no catalog",
+			catalog: nil,
+			want:    nil,
+		},
+		{
+			name:    "tasks and services related to the packages owning their executables",
+			catalog: pkg.NewCollection(logrotate, openssh, tftp, timer, initScript, service, unowned),
+			want: []artifact.Relationship{
+				{
+					From: logrotate,
+					To:   timer,
+					Type: artifact.DependencyOfRelationship,
+				},
+				{
+					From: tftp,
+					To:   service,
+					Type: artifact.DependencyOfRelationship,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExecutableOwners(tt.catalog))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package relationship
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// ownersByPath indexes the packages by the files they own (per the package manager metadata).
+type ownersByPath map[string][]pkg.Package
+
+func newOwnersByPath(catalog *pkg.Collection) ownersByPath {
+	owners := make(ownersByPath)
+	for _, p := range catalog.Sorted() {
+		fileOwner, ok := p.Metadata.(pkg.FileOwner)
+		if !ok {
+			continue
+		}
+		for _, ownedFilePath := range fileOwner.OwnedFiles() {
+			owners[ownedFilePath] = append(owners[ownedFilePath], p)
+		}
+	}
+	return owners
+}
+
+// owners returns the packages owning the given file, also considering the alternate path of the file on systems where
+// /bin, /sbin, and /lib are symlinks into /usr (e.g. a package owning /usr/bin/bash for a reference to /bin/bash).
+func (o ownersByPath) owners(p string) []pkg.Package {
+	if owners := o[p]; len(owners) > 0 {
+		return owners
+	}
+
+	if alt, ok := strings.CutPrefix(p, "/usr/"); ok {
+		return o["/"+alt]
+	}
+	return o["/usr"+p]
+}
@@ -0,0 +1,158 @@
+/*
+Package supplychain provides an offline dataset of popular packages on public registries (along with their latest
+versions), which is used to identify packages that may be typosquats of popular packages or that are prone to
+dependency confusion.
+*/
+package supplychain
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:embed data/popular.json
+var vendoredData []byte
+
+// minNearMissLength is the minimum length of the name of a popular package to consider near-misses of, since short
+// names are within a single edit of many unrelated names (e.g. "six" and "sip").
+const minNearMissLength = 5
+
+// pythonNameSeparators matches runs of the characters that are equivalent within python package names (see PEP 503).
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+type datasetFile struct {
+	Updated    string                       `json:"updated"`
+	Ecosystems map[string]map[string]string `json:"ecosystems"`
+}
+
+type popularPackage struct {
+	name    string
+	version string
+}
+
+// Dataset provides the popular packages of public registries by ecosystem ("npm", "pypi", "gem", "cargo", "nuget").
+type Dataset struct {
+	// packages are the popular packages of each ecosystem by normalized name
+	packages map[string]map[string]popularPackage
+
+	// names are the sorted normalized names of the popular packages of each ecosystem
+	names map[string][]string
+}
+
+// NewDataset returns the popular package dataset from the given file, or the dataset vendored within syft when no
+// path is given. The file maps each ecosystem to the latest version of each popular package by name.
+func NewDataset(path string) (*Dataset, error) {
+	data := vendoredData
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read popular package dataset: %w", err)
+		}
+	}
+
+	var f datasetFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("unable to parse popular package dataset: %w", err)
+	}
+
+	d := &Dataset{
+		packages: make(map[string]map[string]popularPackage),
+		names:    make(map[string][]string),
+	}
+	for ecosystem, pkgs := range f.Ecosystems {
+		d.packages[ecosystem] = make(map[string]popularPackage)
+		for name, version := range pkgs {
+			normalized := normalizeName(ecosystem, name)
+			d.packages[ecosystem][normalized] = popularPackage{name: name, version: version}
+			d.names[ecosystem] = append(d.names[ecosystem], normalized)
+		}
+		sort.Strings(d.names[ecosystem])
+	}
+	return d, nil
+}
+
+// Latest returns the latest version of the given package on the public registry of the ecosystem, when the package is
+// a popular package.
+func (d *Dataset) Latest(ecosystem, name string) (string, bool) {
+	p, ok := d.packages[ecosystem][normalizeName(ecosystem, name)]
+	return p.version, ok
+}
+
+// NearMiss returns the name of the popular package that the given package name is a near-miss of (a single edit or
+// transposition away, or differing only in separators), or an empty string when there is none. Popular packages are
+// never near-misses of one another.
+func (d *Dataset) NearMiss(ecosystem, name string) string {
+	normalized := normalizeName(ecosystem, name)
+	if _, ok := d.packages[ecosystem][normalized]; ok {
+		return ""
+	}
+
+	for _, candidate := range d.names[ecosystem] {
+		if len(candidate) < minNearMissLength {
+			continue
+		}
+		if withinOneEdit(normalized, candidate) || stripSeparators(normalized) == stripSeparators(candidate) {
+			return d.packages[ecosystem][candidate].name
+		}
+	}
+	return ""
+}
+
+// normalizeName returns the name as compared by the registry of the ecosystem, which is case-insensitive for all
+// supported registries (where python additionally considers "-", "_", and "." equivalent).
+func normalizeName(ecosystem, name string) string {
+	name = strings.ToLower(name)
+	if ecosystem == "pypi" {
+		name = pythonNameSeparators.ReplaceAllString(name, "-")
+	}
+	return name
+}
+
+func stripSeparators(name string) string {
+	return strings.NewReplacer("-", "", "_", "", ".", "").Replace(name)
+}
+
+// withinOneEdit indicates if the names differ by a single insertion, deletion, substitution, or transposition of
+// adjacent characters.
+func withinOneEdit(a, b string) bool {
+	if a == b {
+		return false
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+
+	switch len(rb) - len(ra) {
+	case 0:
+		var diffs []int
+		for i := range ra {
+			if ra[i] != rb[i] {
+				diffs = append(diffs, i)
+			}
+		}
+		switch len(diffs) {
+		case 1:
+			return true
+		case 2:
+			i, j := diffs[0], diffs[1]
+			return j == i+1 && ra[i] == rb[j] && ra[j] == rb[i]
+		}
+		return false
+	case 1:
+		// the longer name must be the shorter name with a single inserted character
+		i := 0
+		for i < len(ra) && ra[i] == rb[i] {
+			i++
+		}
+		return string(ra[i:]) == string(rb[i+1:])
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package supplychain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataset_Latest(t *testing.T) {
+	d, err := NewDataset("")
+	require.NoError(t, err)
+
+	version, ok := d.Latest("npm", "lodash")
+	assert.True(t, ok)
+	assert.Equal(t, "4.17.21", version)
+
+	// python names are normalized
+	version, ok = d.Latest("pypi", "Python_DateUtil")
+	assert.True(t, ok)
+	assert.Equal(t, "2.9.0.post0", version)
+
+	_, ok = d.Latest("npm", "left-pad")
+	assert.False(t, ok)
+
+	_, ok = d.Latest("maven", "lodash")
+	assert.False(t, ok)
+}
+
+func TestDataset_NearMiss(t *testing.T) {
+	d, err := NewDataset("")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ecosystem string
+		pkg       string
+		want      string
+	}{
+		{
+			name:      "substitution",
+			ecosystem: "npm",
+			pkg:       "lodahs",
+			want:      "lodash",
+		},
+		{
+			name:      "insertion",
+			ecosystem: "pypi",
+			pkg:       "requestss",
+			want:      "requests",
+		},
+		{
+			name:      "deletion",
+			ecosystem: "npm",
+			pkg:       "expres",
+			want:      "express",
+		},
+		{
+			name:      "transposition",
+			ecosystem: "pypi",
+			pkg:       "reqeusts",
+			want:      "requests",
+		},
+		{
+			name:      "separators",
+			ecosystem: "npm",
+			pkg:       "crossenv",
+			want:      "cross-env",
+		},
+		{
+			name:      "separators equivalent within python names are not near-misses",
+			ecosystem: "pypi",
+			pkg:       "python_dateutil",
+		},
+		{
+			name:      "popular packages are not near-misses of one another",
+			ecosystem: "npm",
+			pkg:       "react-dom",
+		},
+		{
+			name:      "short names are not considered",
+			ecosystem: "pypi",
+			pkg:       "sip",
+		},
+		{
+			name:      "more than one edit",
+			ecosystem: "npm",
+			pkg:       "lowdash-es",
+		},
+		{
+			name:      "other ecosystems",
+			ecosystem: "gem",
+			pkg:       "lodahs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, d.NearMiss(tt.ecosystem, tt.pkg))
+		})
+	}
+}
+
+func TestNewDataset_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "popular.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"ecosystems":{"npm":{"Acme-Widgets":"2.0.0"}}}`), 0600))
+
+	d, err := NewDataset(path)
+	require.NoError(t, err)
+
+	version, ok := d.Latest("npm", "acme-widgets")
+	assert.True(t, ok)
+	assert.Equal(t, "2.0.0", version)
+	assert.Equal(t, "Acme-Widgets", d.NearMiss("npm", "acme-widget"))
+
+	_, ok = d.Latest("npm", "lodash")
+	assert.False(t, ok, "the vendored dataset is replaced")
+
+	_, err = NewDataset(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func Test_withinOneEdit(t *testing.T) {
+	assert.False(t, withinOneEdit("lodash", "lodash"))
+	assert.True(t, withinOneEdit("lodash", "lodas"))
+	assert.True(t, withinOneEdit("lodas", "lodash"))
+	assert.True(t, withinOneEdit("lodash", "lodahs"))
+	assert.False(t, withinOneEdit("lodash", "ldoahs"))
+	assert.False(t, withinOneEdit("lodash", "lo"))
+}
@@ -0,0 +1,89 @@
+package task
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// NewClassificationTask returns a task that annotates the locations of packages that fall under any of the configured
+// path rules with the classification of each matching rule. No task is returned when there are no rules.
+func NewClassificationTask(cfg cataloging.ClassificationConfig) Task {
+	if len(cfg.Rules) == 0 {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+			classifyPackages(s.Artifacts.Packages, cfg.Rules)
+		})
+		return nil
+	}
+
+	return NewTask("classification-cataloger", fn)
+}
+
+func classifyPackages(pkgs *pkg.Collection, rules []cataloging.ClassificationRule) {
+	annotatePackageLocations(pkgs, func(pkg.Package) locationAnnotator {
+		return func(l file.Location) (file.Location, bool) {
+			classifications := matchingClassifications(l.RealPath, rules)
+			if len(classifications) == 0 {
+				return l, false
+			}
+			return l.WithAnnotation(pkg.ClassificationAnnotationKey, strings.Join(classifications, ",")), true
+		}
+	})
+}
+
+// matchingClassifications returns the sorted, unique classifications of all rules that match the given path.
+func matchingClassifications(p string, rules []cataloging.ClassificationRule) []string {
+	set := make(map[string]struct{})
+	for _, rule := range rules {
+		if rule.Classification == "" {
+			continue
+		}
+		for _, rulePath := range rule.Paths {
+			if pathMatches(p, rulePath) {
+				set[rule.Classification] = struct{}{}
+				break
+			}
+		}
+	}
+
+	var classifications []string
+	for c := range set {
+		classifications = append(classifications, c)
+	}
+	sort.Strings(classifications)
+	return classifications
+}
+
+// pathMatches indicates if the given path is the rule path, is within the rule path (as a directory), or matches
+// the rule path as a glob pattern.
+func pathMatches(p, rulePath string) bool {
+	if rulePath == "" {
+		return false
+	}
+
+	dir := path.Clean("/" + rulePath)
+	if p == dir || dir == "/" || strings.HasPrefix(p, dir+"/") {
+		return true
+	}
+
+	matches, err := doublestar.Match(rulePath, p)
+	if err != nil {
+		log.WithFields("pattern", rulePath, "error", err).Debug("invalid classification path pattern")
+		return false
+	}
+	return matches
+}
@@ -0,0 +1,84 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func TestNewClassificationTask(t *testing.T) {
+	assert.Nil(t, NewClassificationTask(cataloging.DefaultClassificationConfig()))
+
+	newPackage := func(name string, paths ...string) pkg.Package {
+		var locations []file.Location
+		for _, p := range paths {
+			locations = append(locations, file.NewLocation(p))
+		}
+		p := pkg.Package{Name: name, Version: "1.0.0", Locations: file.NewLocationSet(locations...)}
+		p.SetID()
+		return p
+	}
+
+	app := newPackage("app", "/app/package.json")
+	vendored := newPackage("left-pad", "/app/vendor/left-pad/package.json")
+	jar := newPackage("lib", "/srv/service/lib.jar", "/usr/share/java/lib.jar")
+	musl := newPackage("musl", "/lib/apk/db/installed")
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(app, vendored, jar, musl),
+		},
+	}
+
+	tsk := NewClassificationTask(cataloging.DefaultClassificationConfig().WithRules(
+		cataloging.ClassificationRule{Classification: "first-party", Paths: []string{"/app/", "/srv/**/*.jar"}},
+		cataloging.ClassificationRule{Classification: "vendored", Paths: []string{"app/vendor"}},
+	))
+	require.NotNil(t, tsk)
+	require.NoError(t, tsk.Execute(context.Background(), nil, sbomsync.NewBuilder(&s)))
+
+	annotations := func(p pkg.Package) map[string]string {
+		got := s.Artifacts.Packages.Package(p.ID())
+		require.NotNil(t, got, "package ID must not change")
+		values := make(map[string]string)
+		for _, l := range got.Locations.ToSlice() {
+			values[l.RealPath] = l.Annotations[pkg.ClassificationAnnotationKey]
+		}
+		return values
+	}
+
+	assert.Equal(t, map[string]string{"/app/package.json": "first-party"}, annotations(app))
+	assert.Equal(t, map[string]string{"/app/vendor/left-pad/package.json": "first-party,vendored"}, annotations(vendored))
+	assert.Equal(t, map[string]string{"/srv/service/lib.jar": "first-party", "/usr/share/java/lib.jar": ""}, annotations(jar))
+	assert.Equal(t, map[string]string{"/lib/apk/db/installed": ""}, annotations(musl))
+}
+
+func Test_pathMatches(t *testing.T) {
+	tests := []struct {
+		path     string
+		rulePath string
+		want     bool
+	}{
+		{path: "/app", rulePath: "/app", want: true},
+		{path: "/app/main.go", rulePath: "/app", want: true},
+		{path: "/app/main.go", rulePath: "/app/", want: true},
+		{path: "/application/main.go", rulePath: "/app", want: false},
+		{path: "/opt/vendor/lib/x.jar", rulePath: "opt/vendor", want: true},
+		{path: "/srv/a/b/x.jar", rulePath: "/srv/**/*.jar", want: true},
+		{path: "/srv/a/b/x.war", rulePath: "/srv/**/*.jar", want: false},
+		{path: "/usr/lib/x", rulePath: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path+" "+tt.rulePath, func(t *testing.T) {
+			assert.Equal(t, tt.want, pathMatches(tt.path, tt.rulePath))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/sbom"
 )
 
 // TODO: add tui element here?
@@ -17,8 +18,31 @@ func NewEnvironmentTask() Task {
 			builder.SetLinuxDistribution(*release)
 		}
 
+		if cfg := linux.IdentifyCryptoConfiguration(resolver); cfg != nil {
+			addSourceAnnotations(builder, cfg.Annotations())
+		}
+
+		if inventory := linux.IdentifySystemInventory(resolver); inventory != nil {
+			addSourceAnnotations(builder, inventory.Annotations())
+		}
+
+		if repositories := linux.IdentifyPackageRepositories(resolver); repositories != nil {
+			addSourceAnnotations(builder, repositories.Annotations())
+		}
+
 		return nil
 	}
 
 	return NewTask("environment-cataloger", fn)
 }
+
+func addSourceAnnotations(builder sbomsync.Builder, annotations map[string]string) {
+	builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+		if s.Source.Annotations == nil {
+			s.Source.Annotations = make(map[string]string)
+		}
+		for k, v := range annotations {
+			s.Source.Annotations[k] = v
+		}
+	})
+}
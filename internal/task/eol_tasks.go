@@ -0,0 +1,127 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/syft/internal/eol"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// eolDistroProducts maps linux distribution IDs (from os-release) to the products describing their release cycles.
+var eolDistroProducts = map[string]string{
+	"alpine": "alpine",
+	"amzn":   "amazon-linux",
+	"centos": "centos",
+	"debian": "debian",
+	"fedora": "fedora",
+	"rhel":   "rhel",
+	"ubuntu": "ubuntu",
+}
+
+// eolPackageProducts maps the names of major runtime packages to the products describing their release cycles.
+var eolPackageProducts = map[string]string{
+	"go":         "go",
+	"golang":     "go",
+	"libphp":     "php",
+	"node":       "nodejs",
+	"nodejs":     "nodejs",
+	"php":        "php",
+	"php-cli":    "php",
+	"php-fpm":    "php",
+	"postgresql": "postgresql",
+	"python":     "python",
+	"python3":    "python",
+	"ruby":       "ruby",
+}
+
+// eolPackageTypes are the types of packages that may be runtimes. Language packages are not considered since their
+// names may coincide with a runtime (e.g. the "node" npm package).
+var eolPackageTypes = map[pkg.Type]bool{
+	pkg.AlpmPkg:   true,
+	pkg.ApkPkg:    true,
+	pkg.BinaryPkg: true,
+	pkg.DebPkg:    true,
+	pkg.RpmPkg:    true,
+}
+
+// NewEOLTask returns a task that annotates the linux distribution and major runtime packages with when their release
+// cycle reaches its end of life. No task is returned when disabled.
+func NewEOLTask(cfg cataloging.EOLConfig) Task {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		var apiURL string
+		if cfg.SearchRemote {
+			apiURL = cfg.APIURL
+		}
+
+		dataset, err := eol.NewDataset(apiURL)
+		if err != nil {
+			return fmt.Errorf("unable to load end of life dataset: %w", err)
+		}
+
+		builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+			annotateDistroEOL(s.Artifacts.LinuxDistribution, dataset)
+			annotatePackageEOL(s.Artifacts.Packages, dataset)
+		})
+		return nil
+	}
+
+	return NewTask("eol-cataloger", fn)
+}
+
+// annotateDistroEOL sets when support for the linux distribution ends, unless already stated by the distribution.
+func annotateDistroEOL(release *linux.Release, dataset *eol.Dataset) {
+	if release == nil || release.SupportEnd != "" {
+		return
+	}
+
+	product, ok := eolDistroProducts[release.ID]
+	if !ok {
+		return
+	}
+
+	cycle, ok := dataset.Find(product, release.VersionID)
+	if !ok || !cycle.EOL.IsDate() {
+		return
+	}
+	release.SupportEnd = string(cycle.EOL)
+}
+
+func annotatePackageEOL(pkgs *pkg.Collection, dataset *eol.Dataset) {
+	annotatePackageLocations(pkgs, func(p pkg.Package) locationAnnotator {
+		product := eolProduct(p)
+		if product == "" {
+			return nil
+		}
+
+		cycle, ok := dataset.Find(product, p.Version)
+		if !ok {
+			return nil
+		}
+		return withAnnotations(map[string]string{
+			pkg.ReleaseCycleAnnotationKey: cycle.Cycle,
+			pkg.EndOfLifeAnnotationKey:    string(cycle.EOL),
+		})
+	})
+}
+
+// eolProduct returns the product describing the release cycles of the package (or an empty string when the package
+// is not a known runtime).
+func eolProduct(p pkg.Package) string {
+	if p.Type == pkg.GoModulePkg && p.Name == "stdlib" {
+		return "go"
+	}
+	if !eolPackageTypes[p.Type] {
+		return ""
+	}
+	return eolPackageProducts[p.Name]
+}
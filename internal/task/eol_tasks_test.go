@@ -0,0 +1,94 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/eol"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestNewEOLTask(t *testing.T) {
+	assert.Nil(t, NewEOLTask(cataloging.DefaultEOLConfig()), "disabled")
+	assert.NotNil(t, NewEOLTask(cataloging.DefaultEOLConfig().WithEnabled(true)))
+}
+
+func Test_annotateDistroEOL(t *testing.T) {
+	dataset, err := eol.NewDataset("")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		release *linux.Release
+		want    string
+	}{
+		{
+			name:    "point release",
+			release: &linux.Release{ID: "alpine", VersionID: "3.18.4"},
+			want:    "2025-05-09",
+		},
+		{
+			name:    "distribution ID differs from the product",
+			release: &linux.Release{ID: "amzn", VersionID: "2023"},
+			want:    "2029-06-30",
+		},
+		{
+			name:    "support end stated by the distribution is kept",
+			release: &linux.Release{ID: "fedora", VersionID: "40", SupportEnd: "2025-05-13"},
+			want:    "2025-05-13",
+		},
+		{
+			name:    "no end of life date",
+			release: &linux.Release{ID: "fedora", VersionID: "42"},
+		},
+		{
+			name:    "unknown distribution",
+			release: &linux.Release{ID: "wolfi", VersionID: "20230201"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotateDistroEOL(tt.release, dataset)
+			assert.Equal(t, tt.want, tt.release.SupportEnd)
+		})
+	}
+
+	// no release is tolerated
+	annotateDistroEOL(nil, dataset)
+}
+
+func Test_annotatePackageEOL(t *testing.T) {
+	dataset, err := eol.NewDataset("")
+	require.NoError(t, err)
+
+	python := newTestPackage(pkg.Package{Name: "python", Version: "3.8.10", Type: pkg.BinaryPkg}, "/usr/bin/python3.8")
+	nodejs := newTestPackage(pkg.Package{Name: "nodejs", Version: "20.11.1-r0", Type: pkg.ApkPkg}, "/lib/apk/db/installed")
+	stdlib := newTestPackage(pkg.Package{Name: "stdlib", Version: "go1.24.2", Type: pkg.GoModulePkg}, "/usr/bin/app")
+	npmNode := newTestPackage(pkg.Package{Name: "node", Version: "18.0.0", Type: pkg.NpmPkg}, "/app/package-lock.json")
+	curl := newTestPackage(pkg.Package{Name: "curl", Version: "8.5.0", Type: pkg.ApkPkg}, "/lib/apk/db/installed")
+
+	pkgs := pkg.NewCollection(python, nodejs, stdlib, npmNode, curl)
+	annotatePackageEOL(pkgs, dataset)
+
+	got := func(p pkg.Package) pkg.Package {
+		found := pkgs.Package(p.ID())
+		require.NotNil(t, found, "package ID must not change")
+		return *found
+	}
+
+	assert.Equal(t, "3.8", pkg.ReleaseCycle(got(python)))
+	assert.Equal(t, "2024-10-07", pkg.EndOfLife(got(python)))
+
+	assert.Equal(t, "20", pkg.ReleaseCycle(got(nodejs)))
+	assert.Equal(t, "2026-04-30", pkg.EndOfLife(got(nodejs)))
+
+	assert.Equal(t, "1.24", pkg.ReleaseCycle(got(stdlib)))
+	assert.Equal(t, "false", pkg.EndOfLife(got(stdlib)))
+
+	assert.Empty(t, pkg.EndOfLife(got(npmNode)), "language packages are not runtimes")
+	assert.Empty(t, pkg.EndOfLife(got(curl)))
+}
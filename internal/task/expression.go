@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/scylladb/go-set/strset"
@@ -13,6 +14,14 @@ import (
 
 var expressionNodePattern = regexp.MustCompile(`^([a-zA-Z0-9][a-zA-Z0-9-+]*)+$`)
 
+const (
+	// IntersectionOperator joins terms within a single operand such that a task must match all terms (e.g. "os&image").
+	IntersectionOperator = "&"
+
+	// NegationOperator prefixes a term within an operand such that a task must not match the term (e.g. "binary&!go").
+	NegationOperator = "!"
+)
+
 const (
 	SetOperation       Operation = "set"
 	AddOperation       Operation = "add"
@@ -25,9 +34,11 @@ var (
 	ErrInvalidToken     = fmt.Errorf("invalid token given: only alphanumeric characters and hyphens are allowed")
 	ErrInvalidOperator  = fmt.Errorf("invalid operator given")
 	ErrUnknownNameOrTag = fmt.Errorf("unknown name or tag given")
-	ErrTagsNotAllowed   = fmt.Errorf("tags are not allowed with this operation (must use exact names)")
 	ErrNamesNotAllowed  = fmt.Errorf("names are not allowed with this operation (must use tags)")
 	ErrAllNotAllowed    = fmt.Errorf("cannot use the 'all' operand in this context")
+	ErrTagConflict      = fmt.Errorf("tag conflicts with an existing cataloger name")
+	ErrReservedTag      = fmt.Errorf("tag conflicts with a built-in tag")
+	ErrTagCycle         = fmt.Errorf("tag refers to itself through other user-defined tags")
 )
 
 // ErrInvalidExpression represents an expression that cannot be parsed or can be parsed but is logically invalid.
@@ -50,7 +61,8 @@ func newErrInvalidExpression(exp string, op Operation, err error) ErrInvalidExpr
 }
 
 // Expression represents a single operation-operand pair with (all validation errors).
-// E.g. "+foo", "-bar", or "something" are all expressions. Some validations are relevant to not only the
+// E.g. "+foo", "-bar", or "something" are all expressions. An operand may be made up of several terms joined by the
+// intersection operator, each of which may be negated (e.g. "os&image" or "+binary&!go"). Some validations are relevant to not only the
 // syntax (operation and operator) but other are sensitive to the context of the operand (e.g. if a given operand
 // is a tag or a name, validated against the operation).
 type Expression struct {
@@ -69,12 +81,22 @@ type Expressions []Expression
 type expressionContext struct {
 	Names *strset.Set
 	Tags  *strset.Set
+
+	// UserTags are the user-defined tags for each task (by task name).
+	UserTags map[string]*strset.Set
+}
+
+// term is a single (possibly negated) name or tag within an operand.
+type term struct {
+	Token   string
+	Negated bool
 }
 
 func newExpressionContext(ts []Task) *expressionContext {
 	ec := &expressionContext{
-		Names: strset.New(tasks(ts).Names()...),
-		Tags:  strset.New(tasks(ts).Tags()...),
+		Names:    strset.New(tasks(ts).Names()...),
+		Tags:     strset.New(tasks(ts).Tags()...),
+		UserTags: make(map[string]*strset.Set),
 	}
 
 	ec.Tags.Add("all")
@@ -82,50 +104,130 @@ func newExpressionContext(ts []Task) *expressionContext {
 	return ec
 }
 
+// addUserTags associates each user-defined tag with all tasks that match any of the given names or tags. User-defined
+// tags may refer to other user-defined tags, and may then be used within expressions like any other tag.
+func (ec *expressionContext) addUserTags(ts []Task, userTags map[string][]string) []error {
+	var errs []error
+
+	var tags []string
+	for tag := range userTags {
+		tags = append(tags, tag)
+	}
+	// always ensure there is a stable ordering of errors
+	sort.Strings(tags)
+
+	valid := strset.New()
+	for _, tag := range tags {
+		switch {
+		case !isValidNode(tag):
+			errs = append(errs, newErrInvalidExpression(tag, SetOperation, ErrInvalidToken))
+		case ec.Names.Has(tag):
+			errs = append(errs, newErrInvalidExpression(tag, SetOperation, ErrTagConflict))
+		case ec.Tags.Has(tag):
+			// this includes the "all" tag, which would otherwise be silently shadowed
+			errs = append(errs, newErrInvalidExpression(tag, SetOperation, ErrReservedTag))
+		default:
+			valid.Add(tag)
+		}
+	}
+
+	// user-defined tags are resolved depth-first so that any tag referred to is resolved before the tag referring to it
+	visiting := strset.New()
+	visited := strset.New()
+	var resolve func(tag string) []error
+	resolve = func(tag string) []error {
+		if visited.Has(tag) {
+			return nil
+		}
+		if visiting.Has(tag) {
+			return []error{newErrInvalidExpression(tag, SetOperation, ErrTagCycle)}
+		}
+		visiting.Add(tag)
+		defer func() {
+			visiting.Remove(tag)
+			visited.Add(tag)
+		}()
+
+		for _, nameOrTag := range userTags[tag] {
+			if !valid.Has(nameOrTag) {
+				continue
+			}
+			if nestedErrs := resolve(nameOrTag); len(nestedErrs) > 0 {
+				return nestedErrs
+			}
+		}
+
+		var tagErrs []error
+		for _, nameOrTag := range userTags[tag] {
+			if !ec.Tags.Has(nameOrTag) && !ec.Names.Has(nameOrTag) {
+				tagErrs = append(tagErrs, newErrInvalidExpression(nameOrTag, SetOperation, ErrUnknownNameOrTag))
+				continue
+			}
+			for _, t := range ts {
+				if !isSelected(t, nameOrTag, ec.UserTags[t.Name()]) {
+					continue
+				}
+				if _, ok := ec.UserTags[t.Name()]; !ok {
+					ec.UserTags[t.Name()] = strset.New()
+				}
+				ec.UserTags[t.Name()].Add(tag)
+			}
+		}
+
+		ec.Tags.Add(tag)
+
+		return tagErrs
+	}
+
+	for _, tag := range tags {
+		if valid.Has(tag) {
+			errs = append(errs, resolve(tag)...)
+		}
+	}
+
+	return errs
+}
+
 // newExpression creates a new validated Expression object relative to the task names and tags.
 func (ec expressionContext) newExpression(exp string, operation Operation, token string) Expression {
-	if token == "" {
+	newInvalidExpression := func(err error) Expression {
 		return Expression{
 			Operation: operation,
 			Operand:   token,
-			Errors:    []error{newErrInvalidExpression(exp, operation, ErrEmptyToken)},
+			Errors:    []error{newErrInvalidExpression(exp, operation, err)},
 		}
 	}
 
-	if !isValidNode(token) {
-		return Expression{
-			Operation: operation,
-			Operand:   token,
-			Errors:    []error{newErrInvalidExpression(exp, operation, ErrInvalidToken)},
-		}
+	if token == "" {
+		return newInvalidExpression(ErrEmptyToken)
 	}
 
-	var err error
-	switch operation {
-	case SetOperation, RemoveOperation:
-		// names and tags allowed
-		if !ec.Tags.Has(token) && !ec.Names.Has(token) {
-			err = newErrInvalidExpression(exp, operation, ErrUnknownNameOrTag)
+	terms := parseTerms(token)
+	var positive bool
+	for _, t := range terms {
+		if t.Token == "" {
+			return newInvalidExpression(ErrEmptyToken)
 		}
-	case AddOperation:
-		// only names are allowed
-		if !ec.Names.Has(token) {
-			if ec.Tags.Has(token) {
-				err = newErrInvalidExpression(exp, operation, ErrTagsNotAllowed)
-			} else {
-				err = newErrInvalidExpression(exp, operation, ErrUnknownNameOrTag)
-			}
+		if !isValidNode(t.Token) {
+			return newInvalidExpression(ErrInvalidToken)
 		}
-	case SubSelectOperation:
-		if token == "all" {
-			// special case: we cannot sub-select all (this is most likely a misconfiguration and the user intended to use the set operation)
-			err = newErrInvalidExpression(exp, operation, ErrAllNotAllowed)
-		} else if !ec.Tags.Has(token) {
-			// only tags are allowed...
-			if ec.Names.Has(token) {
-				err = newErrInvalidExpression(exp, operation, ErrNamesNotAllowed)
-			} else {
-				err = newErrInvalidExpression(exp, operation, ErrUnknownNameOrTag)
+		positive = positive || !t.Negated
+	}
+
+	if !positive {
+		// negated terms may only narrow an intersection (e.g. "os&!rpm"), removal must use the remove operation
+		return newInvalidExpression(ErrInvalidToken)
+	}
+
+	var err error
+	switch {
+	case token == "all" && (operation == SubSelectOperation || operation == AddOperation):
+		// special case: we cannot sub-select or add all (this is most likely a misconfiguration and the user intended to use the set operation)
+		err = newErrInvalidExpression(exp, operation, ErrAllNotAllowed)
+	default:
+		for _, t := range terms {
+			if err = ec.validateTerm(exp, operation, t); err != nil {
+				break
 			}
 		}
 	}
@@ -142,6 +244,38 @@ func (ec expressionContext) newExpression(exp string, operation Operation, token
 	}
 }
 
+// validateTerm ensures the term refers to a known name or tag that is allowed for the given operation.
+func (ec expressionContext) validateTerm(exp string, operation Operation, t term) error {
+	isTag := ec.Tags.Has(t.Token)
+	isName := ec.Names.Has(t.Token)
+
+	if !isTag && !isName {
+		return newErrInvalidExpression(exp, operation, ErrUnknownNameOrTag)
+	}
+
+	if operation == SubSelectOperation && !isTag && !t.Negated {
+		// sub-selecting by name would only ever select a single task, which is most likely a misconfiguration
+		// and the user intended to use the add operation
+		return newErrInvalidExpression(exp, operation, ErrNamesNotAllowed)
+	}
+
+	return nil
+}
+
+// parseTerms splits the given operand into all terms joined by the intersection operator.
+func parseTerms(operand string) []term {
+	var terms []term
+	for _, field := range strings.Split(operand, IntersectionOperator) {
+		t := term{Token: strings.TrimSpace(field)}
+		if strings.HasPrefix(t.Token, NegationOperator) {
+			t.Negated = true
+			t.Token = strings.TrimSpace(strings.TrimPrefix(t.Token, NegationOperator))
+		}
+		terms = append(terms, t)
+	}
+	return terms
+}
+
 func newExpressionsFromSelectionRequest(nc *expressionContext, selectionRequest pkgcataloging.SelectionRequest) Expressions {
 	var all Expressions
 
@@ -101,11 +101,58 @@ func Test_newExpressionsFromSelectionRequest(t *testing.T) {
 			expectedErrors: []error{ErrInvalidToken},
 		},
 		{
-			name:           "invalid name",
+			name:        "add operation by tag",
+			basis:       []string{},
+			expressions: []string{"+t1"},
+			expected: []Expression{
+				{Operation: AddOperation, Operand: "t1"},
+			},
+		},
+		{
+			name:        "intersection operations",
+			basis:       []string{"t1&t2"},
+			expressions: []string{"+t3&!4", "-t4&5", "t1&!3"},
+			expected: []Expression{
+				{Operation: SetOperation, Operand: "t1&t2"},
+				{Operation: SubSelectOperation, Operand: "t1&!3"},
+				{Operation: RemoveOperation, Operand: "t4&5"},
+				{Operation: AddOperation, Operand: "t3&!4"},
+			},
+		},
+		{
+			name:           "only negated terms",
+			basis:          []string{},
+			expressions:    []string{"+!t1"},
+			expected:       nil,
+			expectedErrors: []error{ErrInvalidToken},
+		},
+		{
+			name:           "empty intersection term",
+			basis:          []string{},
+			expressions:    []string{"t1&"},
+			expected:       nil,
+			expectedErrors: []error{ErrEmptyToken},
+		},
+		{
+			name:           "unknown intersection term",
+			basis:          []string{},
+			expressions:    []string{"-t1&bogus"},
+			expected:       nil,
+			expectedErrors: []error{ErrUnknownNameOrTag},
+		},
+		{
+			name:           "invalid name within intersection",
+			basis:          []string{},
+			expressions:    []string{"t1&1"},
+			expected:       nil,
+			expectedErrors: []error{ErrNamesNotAllowed},
+		},
+		{
+			name:           "invalid use of all with add operation",
 			basis:          []string{},
-			expressions:    []string{"+t1"},
+			expressions:    []string{"+all"},
 			expected:       nil,
-			expectedErrors: []error{ErrTagsNotAllowed},
+			expectedErrors: []error{ErrAllNotAllowed},
 		},
 		{
 			name:           "invalid tag",
@@ -240,3 +287,79 @@ func Test_expressionNodes_sort(t *testing.T) {
 		})
 	}
 }
+
+func Test_expressionContext_addUserTags(t *testing.T) {
+	ts := []Task{
+		dummyTask("1", "t1"),
+		dummyTask("2", "t2"),
+		dummyTask("3", "t3"),
+	}
+
+	tests := []struct {
+		name           string
+		userTags       map[string][]string
+		wantUserTags   map[string][]string
+		expectedErrors []error
+	}{
+		{
+			name: "nested user tags",
+			userTags: map[string][]string{
+				"a": {"b", "3"},
+				"b": {"t1"},
+			},
+			wantUserTags: map[string][]string{
+				"1": {"a", "b"},
+				"3": {"a"},
+			},
+		},
+		{
+			name: "cyclic user tags",
+			userTags: map[string][]string{
+				"a": {"b"},
+				"b": {"c", "t2"},
+				"c": {"a"},
+			},
+			wantUserTags:   map[string][]string{},
+			expectedErrors: []error{ErrTagCycle},
+		},
+		{
+			name: "self-referencing user tag",
+			userTags: map[string][]string{
+				"a": {"a"},
+			},
+			wantUserTags:   map[string][]string{},
+			expectedErrors: []error{ErrTagCycle},
+		},
+		{
+			name: "reserved tag names",
+			userTags: map[string][]string{
+				"1":   {"t2"},
+				"all": {"t2"},
+				"t1":  {"t2"},
+			},
+			wantUserTags:   map[string][]string{},
+			expectedErrors: []error{ErrTagConflict, ErrReservedTag, ErrReservedTag},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ec := newExpressionContext(ts)
+			errs := ec.addUserTags(ts, tt.userTags)
+
+			require.Len(t, errs, len(tt.expectedErrors))
+			for i, err := range tt.expectedErrors {
+				var target ErrInvalidExpression
+				require.ErrorAs(t, errs[i], &target)
+				assert.Equal(t, err, target.Err)
+			}
+
+			got := make(map[string][]string)
+			for name, tags := range ec.UserTags {
+				got[name] = tags.List()
+				sort.Strings(got[name])
+			}
+			assert.Equal(t, tt.wantUserTags, got)
+		})
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
 	"github.com/anchore/syft/syft/file/cataloger/filedigest"
 	"github.com/anchore/syft/syft/file/cataloger/filemetadata"
+	"github.com/anchore/syft/syft/file/cataloger/keymaterial"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 )
@@ -126,6 +128,61 @@ func NewExecutableCatalogerTask(selection file.Selection, cfg executable.Config)
 	return NewTask("file-executable-cataloger", fn)
 }
 
+func NewKeyMaterialCatalogerTask(selection file.Selection, cfg keymaterial.Config) Task {
+	if selection == file.NoFilesSelection || len(cfg.Globs) == 0 {
+		return nil
+	}
+
+	cat := keymaterial.NewCataloger(cfg)
+
+	fn := func(ctx context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		accessor := builder.(sbomsync.Accessor)
+
+		coordinates, ok := coordinatesForSelection(selection, builder.(sbomsync.Accessor))
+		if !ok {
+			return nil
+		}
+
+		result, err := cat.Catalog(ctx, resolver, coordinates...)
+		if err != nil {
+			return fmt.Errorf("unable to catalog key material: %w", err)
+		}
+
+		accessor.WriteToSBOM(func(sbom *sbom.SBOM) {
+			sbom.Artifacts.KeyMaterial = result
+		})
+
+		return nil
+	}
+
+	return NewTask("file-key-material-cataloger", fn)
+}
+
+func NewFileSnippetCatalogerTask(cfg snippet.Config) Task {
+	if len(cfg.Globs) == 0 {
+		return nil
+	}
+
+	cat := snippet.NewCataloger(cfg)
+
+	fn := func(ctx context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		accessor := builder.(sbomsync.Accessor)
+
+		result, err := cat.Catalog(ctx, resolver)
+		if err != nil {
+			return fmt.Errorf("unable to catalog file snippets: %w", err)
+		}
+
+		accessor.WriteToSBOM(func(sbom *sbom.SBOM) {
+			sbom.Artifacts.FileSnippets = result
+		})
+
+		return nil
+	}
+
+	return NewTask("file-snippet-cataloger", fn)
+}
+
 // TODO: this should be replaced with a fix that allows passing a coordinate or location iterator to the cataloger
 // Today internal to both cataloger this functions differently: a slice of coordinates vs a channel of locations
 func coordinatesForSelection(selection file.Selection, accessor sbomsync.Accessor) ([]file.Coordinates, bool) {
@@ -8,6 +8,8 @@ import (
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/keymaterial"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 )
@@ -102,3 +104,52 @@ func Test_coordinatesForSelection(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewKeyMaterialCatalogerTask(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection file.Selection
+		cfg       keymaterial.Config
+		enabled   bool
+	}{
+		{
+			name:      "no globs",
+			selection: file.AllFilesSelection,
+			cfg:       keymaterial.Config{},
+			enabled:   false,
+		},
+		{
+			name:      "no files",
+			selection: file.NoFilesSelection,
+			cfg:       keymaterial.DefaultConfig(),
+			enabled:   false,
+		},
+		{
+			name:      "files owned by packages",
+			selection: file.FilesOwnedByPackageSelection,
+			cfg:       keymaterial.DefaultConfig(),
+			enabled:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tsk := NewKeyMaterialCatalogerTask(tt.selection, tt.cfg)
+			if !tt.enabled {
+				assert.Nil(t, tsk)
+				return
+			}
+			if assert.NotNil(t, tsk) {
+				assert.Equal(t, "file-key-material-cataloger", tsk.Name())
+			}
+		})
+	}
+}
+
+func Test_NewFileSnippetCatalogerTask(t *testing.T) {
+	assert.Nil(t, NewFileSnippetCatalogerTask(snippet.Config{}))
+
+	tsk := NewFileSnippetCatalogerTask(snippet.DefaultConfig())
+	if assert.NotNil(t, tsk) {
+		assert.Equal(t, "file-snippet-cataloger", tsk.Name())
+	}
+}
@@ -0,0 +1,129 @@
+package task
+
+import (
+	"context"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// NewFootprintTask returns a task that records the installed size and number of files owned by each package. No task
+// is returned when footprints are not enabled.
+func NewFootprintTask(cfg cataloging.FootprintConfig) Task {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	fn := func(_ context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		accessor := builder.(sbomsync.Accessor)
+
+		var footprints map[artifact.ID]pkg.Footprint
+		accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+			footprints = packageFootprints(resolver, s.Artifacts.Packages)
+		})
+
+		accessor.WriteToSBOM(func(s *sbom.SBOM) {
+			s.Artifacts.PackageFootprints = footprints
+		})
+		return nil
+	}
+
+	return NewTask("footprint-cataloger", fn)
+}
+
+func packageFootprints(resolver file.Resolver, pkgs *pkg.Collection) map[artifact.ID]pkg.Footprint {
+	footprints := make(map[artifact.ID]pkg.Footprint)
+	if pkgs == nil {
+		return footprints
+	}
+
+	for _, p := range pkgs.Sorted() {
+		if f, ok := packageFootprint(resolver, p); ok {
+			footprints[p.ID()] = f
+		}
+	}
+	return footprints
+}
+
+// packageFootprint sums the sizes of the regular files owned by the package. Note that when cataloging all layers of
+// an image, a file that is modified by later layers is counted once for each layer it is found in.
+func packageFootprint(resolver file.Resolver, p pkg.Package) (pkg.Footprint, bool) {
+	var footprint pkg.Footprint
+	layers := make(map[string]*pkg.LayerFootprint)
+	var layerOrder []string
+
+	seen := file.NewCoordinateSet()
+	for _, ownedPath := range footprintPaths(p) {
+		locations, err := resolver.FilesByPath(ownedPath)
+		if err != nil {
+			log.WithFields("error", err, "path", ownedPath).Trace("unable to find path for owned file")
+			continue
+		}
+
+		for _, loc := range locations {
+			// owned paths may resolve to the same file (e.g. via symlinks), which should only be counted once
+			if seen.Contains(loc.Coordinates) {
+				continue
+			}
+			seen.Add(loc.Coordinates)
+
+			metadata, err := resolver.FileMetadataByLocation(loc)
+			if err != nil {
+				log.WithFields("error", err, "path", loc.RealPath).Trace("unable to get metadata for owned file")
+				continue
+			}
+			if metadata.Type != stereoscopeFile.TypeRegular || metadata.FileInfo == nil {
+				continue
+			}
+
+			size := metadata.Size()
+			footprint.Size += size
+			footprint.FileCount++
+
+			if loc.FileSystemID == "" {
+				continue
+			}
+			layer, ok := layers[loc.FileSystemID]
+			if !ok {
+				layer = &pkg.LayerFootprint{Layer: loc.FileSystemID}
+				layers[loc.FileSystemID] = layer
+				layerOrder = append(layerOrder, loc.FileSystemID)
+			}
+			layer.Size += size
+			layer.FileCount++
+		}
+	}
+
+	if footprint.FileCount == 0 {
+		return footprint, false
+	}
+
+	for _, id := range layerOrder {
+		footprint.Layers = append(footprint.Layers, *layers[id])
+	}
+	return footprint, true
+}
+
+// footprintPaths returns the paths of the files that make up the package: the files owned by the package according to
+// the package manager, or the files that binary packages were found in.
+func footprintPaths(p pkg.Package) []string {
+	if p.Type == pkg.BinaryPkg {
+		var paths []string
+		for _, loc := range p.Locations.ToSlice() {
+			paths = append(paths, loc.RealPath)
+		}
+		return paths
+	}
+
+	fileOwner, ok := p.Metadata.(pkg.FileOwner)
+	if !ok {
+		return nil
+	}
+	return fileOwner.OwnedFiles()
+}
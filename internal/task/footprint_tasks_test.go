@@ -0,0 +1,112 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// layeredResolver resolves paths to files within specific layers, only implementing what is needed for footprints.
+type layeredResolver struct {
+	file.Resolver
+	locations map[string][]file.Location
+	metadata  map[file.Coordinates]file.Metadata
+}
+
+func (r layeredResolver) FilesByPath(paths ...string) ([]file.Location, error) {
+	var locations []file.Location
+	for _, p := range paths {
+		locations = append(locations, r.locations[p]...)
+	}
+	return locations, nil
+}
+
+func (r layeredResolver) FileMetadataByLocation(l file.Location) (file.Metadata, error) {
+	return r.metadata[l.Coordinates], nil
+}
+
+func TestNewFootprintTask(t *testing.T) {
+	assert.Nil(t, NewFootprintTask(cataloging.DefaultFootprintConfig()), "disabled")
+	assert.NotNil(t, NewFootprintTask(cataloging.DefaultFootprintConfig().WithEnabled(true)))
+}
+
+func Test_packageFootprints(t *testing.T) {
+	regular := func(size int64) file.Metadata {
+		return file.Metadata{FileInfo: stereoscopeFile.ManualInfo{SizeValue: size}, Type: stereoscopeFile.TypeRegular}
+	}
+
+	curlBase := file.NewLocationFromCoordinates(file.NewCoordinates("/usr/bin/curl", "sha256:base"))
+	curlUpgrade := file.NewLocationFromCoordinates(file.NewCoordinates("/usr/bin/curl", "sha256:upgrade"))
+	libcurl := file.NewLocationFromCoordinates(file.NewCoordinates("/usr/lib/libcurl.so.4.8.0", "sha256:base"))
+	libcurlLink := file.NewVirtualLocationFromCoordinates(libcurl.Coordinates, "/usr/lib/libcurl.so.4")
+	docs := file.NewLocationFromCoordinates(file.NewCoordinates("/usr/share/doc/curl", "sha256:base"))
+	app := file.NewLocationFromCoordinates(file.NewCoordinates("/app/server", "sha256:app"))
+
+	resolver := layeredResolver{
+		locations: map[string][]file.Location{
+			curlBase.RealPath:      {curlBase, curlUpgrade},
+			libcurl.RealPath:       {libcurl},
+			libcurlLink.AccessPath: {libcurlLink},
+			docs.RealPath:          {docs},
+			app.RealPath:           {app},
+		},
+		metadata: map[file.Coordinates]file.Metadata{
+			curlBase.Coordinates:    regular(100),
+			curlUpgrade.Coordinates: regular(120),
+			libcurl.Coordinates:     regular(50),
+			docs.Coordinates:        {Type: stereoscopeFile.TypeDirectory},
+			app.Coordinates:         regular(1000),
+		},
+	}
+
+	curl := pkg.Package{Name: "curl", Type: pkg.RpmPkg, Metadata: pkg.RpmDBEntry{
+		Name: "curl",
+		Files: []pkg.RpmFileRecord{
+			{Path: "/usr/bin/curl"},
+			{Path: "/usr/lib/libcurl.so.4"},
+			{Path: "/usr/lib/libcurl.so.4.8.0"},
+			{Path: "/usr/share/doc/curl"},
+			{Path: "/usr/share/man/man1/curl.1.gz"}, // removed from the image
+		},
+	}}
+	curl.SetID()
+
+	server := pkg.Package{Name: "server", Type: pkg.BinaryPkg, Locations: file.NewLocationSet(app)}
+	server.SetID()
+
+	express := pkg.Package{Name: "express", Type: pkg.NpmPkg, Metadata: pkg.NpmPackage{Name: "express"}}
+	express.SetID()
+
+	missing := pkg.Package{Name: "missing", Type: pkg.RpmPkg, Metadata: pkg.RpmDBEntry{
+		Name:  "missing",
+		Files: []pkg.RpmFileRecord{{Path: "/usr/bin/missing"}},
+	}}
+	missing.SetID()
+
+	got := packageFootprints(resolver, pkg.NewCollection(curl, server, express, missing))
+
+	assert.Equal(t, pkg.Footprint{
+		Size:      270,
+		FileCount: 3,
+		Layers: []pkg.LayerFootprint{
+			{Layer: "sha256:base", Size: 150, FileCount: 2},
+			{Layer: "sha256:upgrade", Size: 120, FileCount: 1},
+		},
+	}, got[curl.ID()], "directories are not counted and symlinked files are counted once")
+
+	assert.Equal(t, pkg.Footprint{
+		Size:      1000,
+		FileCount: 1,
+		Layers: []pkg.LayerFootprint{
+			{Layer: "sha256:app", Size: 1000, FileCount: 1},
+		},
+	}, got[server.ID()], "binary packages are made up of the files they were found in")
+
+	assert.NotContains(t, got, express.ID(), "packages without owned files have no footprint")
+	assert.NotContains(t, got, missing.ID(), "packages without any files present have no footprint")
+}
@@ -0,0 +1,177 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/file"
+)
+
+var _ file.Resolver = (*gatedResolver)(nil)
+
+// gatedResolver decorates a resolver such that only paths allowed by all path gates are visible to the caller.
+type gatedResolver struct {
+	delegate file.Resolver
+	gates    []cataloging.PathGate
+}
+
+// newGatedResolver wraps the given resolver with the search gates that apply to a cataloger with the given tags. If
+// no gates apply then the original resolver is returned.
+func newGatedResolver(delegate file.Resolver, gates cataloging.SearchGates, tags ...string) file.Resolver {
+	applicable := gatesForTags(gates, tags...)
+	if len(applicable) == 0 {
+		return delegate
+	}
+	return &gatedResolver{
+		delegate: delegate,
+		gates:    applicable,
+	}
+}
+
+func gatesForTags(gates cataloging.SearchGates, tags ...string) []cataloging.PathGate {
+	tagSet := strset.New(tags...)
+	var applicable []cataloging.PathGate
+	for tag, gate := range map[string]cataloging.PathGate{
+		pkgcataloging.OSTag:       gates.OS,
+		pkgcataloging.LanguageTag: gates.Language,
+		pkgcataloging.BinaryTag:   gates.Binary,
+	} {
+		if tagSet.Has(tag) && !gate.IsEmpty() {
+			applicable = append(applicable, gate)
+		}
+	}
+	return applicable
+}
+
+func (r *gatedResolver) allowed(location file.Location) bool {
+	for _, gate := range r.gates {
+		if !gateAllows(gate, location.RealPath, location.AccessPath) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *gatedResolver) allowedPath(path string) bool {
+	for _, gate := range r.gates {
+		if !gateAllows(gate, path) {
+			return false
+		}
+	}
+	return true
+}
+
+// gateAllows indicates if any of the given paths (e.g. the real and access path for a single location) are visible
+// through the given gate. Exclusions for any path take precedence over inclusions.
+func gateAllows(gate cataloging.PathGate, paths ...string) bool {
+	var included bool
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		if matchesAny(gate.Exclude, p) {
+			return false
+		}
+		if len(gate.Include) == 0 || matchesAny(gate.Include, p) {
+			included = true
+		}
+	}
+	return included
+}
+
+func matchesAny(patterns []string, path string) bool {
+	for _, pattern := range patterns {
+		// a pattern for a directory additionally matches everything under it
+		for _, candidate := range []string{pattern, strings.TrimSuffix(pattern, "/") + "/**"} {
+			matches, err := doublestar.Match(candidate, path)
+			if err != nil {
+				log.WithFields("pattern", pattern, "error", err).Trace("unable to match search gate pattern")
+				continue
+			}
+			if matches {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *gatedResolver) filter(locations []file.Location, err error) ([]file.Location, error) {
+	if err != nil {
+		return nil, err
+	}
+	var out []file.Location
+	for _, l := range locations {
+		if r.allowed(l) {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (r *gatedResolver) FileContentsByLocation(location file.Location) (io.ReadCloser, error) {
+	if !r.allowed(location) {
+		return nil, fmt.Errorf("no such location: %+v", location.RealPath)
+	}
+	return r.delegate.FileContentsByLocation(location)
+}
+
+func (r *gatedResolver) FileMetadataByLocation(location file.Location) (file.Metadata, error) {
+	if !r.allowed(location) {
+		return file.Metadata{}, fmt.Errorf("no such location: %+v", location.RealPath)
+	}
+	return r.delegate.FileMetadataByLocation(location)
+}
+
+func (r *gatedResolver) HasPath(path string) bool {
+	if !r.allowedPath(path) {
+		return false
+	}
+	return r.delegate.HasPath(path)
+}
+
+func (r *gatedResolver) FilesByPath(paths ...string) ([]file.Location, error) {
+	return r.filter(r.delegate.FilesByPath(paths...))
+}
+
+func (r *gatedResolver) FilesByGlob(patterns ...string) ([]file.Location, error) {
+	return r.filter(r.delegate.FilesByGlob(patterns...))
+}
+
+func (r *gatedResolver) FilesByMIMEType(types ...string) ([]file.Location, error) {
+	return r.filter(r.delegate.FilesByMIMEType(types...))
+}
+
+func (r *gatedResolver) RelativeFileByPath(location file.Location, path string) *file.Location {
+	l := r.delegate.RelativeFileByPath(location, path)
+	if l != nil && !r.allowed(*l) {
+		return nil
+	}
+	return l
+}
+
+func (r *gatedResolver) AllLocations(ctx context.Context) <-chan file.Location {
+	c := make(chan file.Location)
+	go func() {
+		defer close(c)
+		for location := range r.delegate.AllLocations(ctx) {
+			if !r.allowed(location) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case c <- location:
+			}
+		}
+	}()
+	return c
+}
@@ -0,0 +1,136 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/file"
+)
+
+func Test_newGatedResolver(t *testing.T) {
+	paths := []string{
+		"/usr/bin/python3",
+		"/usr/lib/python3/site-packages/requests/METADATA",
+		"/opt/app/bin/server",
+		"/home/user/.cache/pip/METADATA",
+		"/var/lib/dpkg/status",
+	}
+
+	gates := cataloging.SearchGates{
+		OS: cataloging.PathGate{
+			Include: []string{"/var/lib/**"},
+		},
+		Language: cataloging.PathGate{
+			Exclude: []string{"/home"},
+		},
+		Binary: cataloging.PathGate{
+			Include: []string{"/usr/**", "/opt"},
+			Exclude: []string{"/usr/lib/**"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		gates cataloging.SearchGates
+		tags  []string
+		want  []string
+	}{
+		{
+			name:  "no gates configured",
+			gates: cataloging.SearchGates{},
+			tags:  []string{pkgcataloging.BinaryTag},
+			want:  paths,
+		},
+		{
+			name:  "no applicable gates",
+			gates: gates,
+			tags:  []string{pkgcataloging.DeclaredTag, "something"},
+			want:  paths,
+		},
+		{
+			name:  "os gate",
+			gates: gates,
+			tags:  []string{pkgcataloging.OSTag},
+			want: []string{
+				"/var/lib/dpkg/status",
+			},
+		},
+		{
+			name:  "language gate (directory exclusion)",
+			gates: gates,
+			tags:  []string{pkgcataloging.LanguageTag},
+			want: []string{
+				"/usr/bin/python3",
+				"/usr/lib/python3/site-packages/requests/METADATA",
+				"/opt/app/bin/server",
+				"/var/lib/dpkg/status",
+			},
+		},
+		{
+			name:  "binary gate (exclusion takes precedence)",
+			gates: gates,
+			tags:  []string{pkgcataloging.BinaryTag},
+			want: []string{
+				"/usr/bin/python3",
+				"/opt/app/bin/server",
+			},
+		},
+		{
+			name:  "all applicable gates must allow the path",
+			gates: gates,
+			tags:  []string{pkgcataloging.LanguageTag, pkgcataloging.BinaryTag},
+			want: []string{
+				"/usr/bin/python3",
+				"/opt/app/bin/server",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delegate := file.NewMockResolverForPaths(paths...)
+			resolver := newGatedResolver(delegate, tt.gates, tt.tags...)
+
+			var got []string
+			for l := range resolver.AllLocations(context.Background()) {
+				got = append(got, l.RealPath)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+
+			locations, err := resolver.FilesByGlob("**/*")
+			require.NoError(t, err)
+			got = nil
+			for _, l := range locations {
+				got = append(got, l.RealPath)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+
+			for _, p := range paths {
+				allowed := false
+				for _, w := range tt.want {
+					if w == p {
+						allowed = true
+					}
+				}
+				assert.Equal(t, allowed, resolver.HasPath(p), "unexpected HasPath result for %q", p)
+			}
+		})
+	}
+}
+
+func Test_gatedResolver_deniesGatedContent(t *testing.T) {
+	delegate := file.NewMockResolverForPaths("test-fixtures/does-not-matter")
+	resolver := newGatedResolver(delegate, cataloging.SearchGates{
+		Binary: cataloging.PathGate{Exclude: []string{"test-fixtures/**"}},
+	}, pkgcataloging.BinaryTag)
+
+	_, err := resolver.FileContentsByLocation(file.NewLocation("test-fixtures/does-not-matter"))
+	require.Error(t, err)
+
+	_, err = resolver.FileMetadataByLocation(file.NewLocation("test-fixtures/does-not-matter"))
+	require.Error(t, err)
+}
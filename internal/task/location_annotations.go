@@ -0,0 +1,56 @@
+package task
+
+import (
+	"maps"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// locationAnnotator annotates a single location of a package, indicating if the location was annotated.
+type locationAnnotator func(file.Location) (file.Location, bool)
+
+// annotatePackageLocations annotates the locations of each package with the annotator returned for the package, where
+// packages without an annotator (or without any annotated location) are left as-is. Each location given to the
+// annotator holds its own copy of the annotations, so annotations may be added in place.
+func annotatePackageLocations(pkgs *pkg.Collection, annotatorFor func(pkg.Package) locationAnnotator) {
+	if pkgs == nil {
+		return
+	}
+
+	for _, p := range pkgs.Sorted() {
+		annotate := annotatorFor(p)
+		if annotate == nil {
+			continue
+		}
+
+		var changed bool
+		locations := p.Locations.ToSlice()
+		for i, l := range locations {
+			// the annotations map may be shared with other copies of the location, so it must not be modified in place
+			l.Annotations = maps.Clone(l.Annotations)
+			if annotated, ok := annotate(l); ok {
+				locations[i] = annotated
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		// note: location annotations are not considered in the package ID, so the package ID is stable
+		p.Locations = file.NewLocationSet(locations...)
+		pkgs.Delete(p.ID())
+		pkgs.Add(p)
+	}
+}
+
+// withAnnotations returns an annotator that adds the given annotations to every location.
+func withAnnotations(annotations map[string]string) locationAnnotator {
+	return func(l file.Location) (file.Location, bool) {
+		for key, value := range annotations {
+			l = l.WithAnnotation(key, value)
+		}
+		return l, true
+	}
+}
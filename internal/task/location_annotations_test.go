@@ -0,0 +1,78 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func Test_annotatePackageLocations(t *testing.T) {
+	// the packages share the same annotations map for their locations
+	shared := file.NewLocation("/app/package-lock.json").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	newPackage := func(name string, locations ...file.Location) pkg.Package {
+		p := pkg.Package{Name: name, Version: "1.0.0", Type: pkg.NpmPkg, Locations: file.NewLocationSet(locations...)}
+		p.SetID()
+		return p
+	}
+
+	annotated := newPackage("express", shared, file.NewLocation("/app/node_modules/express/package.json"))
+	skipped := newPackage("lodash", shared)
+	unchanged := newPackage("axios", shared)
+
+	pkgs := pkg.NewCollection(annotated, skipped, unchanged)
+	annotatePackageLocations(pkgs, func(p pkg.Package) locationAnnotator {
+		switch p.Name {
+		case "express":
+			return func(l file.Location) (file.Location, bool) {
+				if l.RealPath != "/app/package-lock.json" {
+					return l, false
+				}
+				return l.WithAnnotation("key", "value"), true
+			}
+		case "axios":
+			return func(l file.Location) (file.Location, bool) {
+				return l.WithAnnotation("key", "ignored"), false
+			}
+		}
+		return nil
+	})
+
+	annotations := func(p pkg.Package) map[string]string {
+		got := pkgs.Package(p.ID())
+		require.NotNil(t, got, "package ID must not change")
+		values := make(map[string]string)
+		for _, l := range got.Locations.ToSlice() {
+			values[l.RealPath] = l.Annotations["key"]
+		}
+		return values
+	}
+
+	assert.Equal(t, map[string]string{"/app/package-lock.json": "value", "/app/node_modules/express/package.json": ""}, annotations(annotated))
+	assert.Equal(t, map[string]string{"/app/package-lock.json": ""}, annotations(skipped))
+	assert.Equal(t, map[string]string{"/app/package-lock.json": ""}, annotations(unchanged), "locations that are not annotated are discarded")
+
+	// existing annotations are retained
+	for _, l := range pkgs.Package(annotated.ID()).Locations.ToSlice() {
+		if l.RealPath == shared.RealPath {
+			assert.Equal(t, pkg.PrimaryEvidenceAnnotation, l.Annotations[pkg.EvidenceAnnotationKey])
+		}
+	}
+
+	// the original (shared) location values are not modified
+	assert.NotContains(t, shared.Annotations, "key")
+
+	// no collection is tolerated
+	annotatePackageLocations(nil, func(pkg.Package) locationAnnotator { return nil })
+}
+
+// newTestPackage returns the given package found at the given path.
+func newTestPackage(p pkg.Package, path string) pkg.Package {
+	p.Locations = file.NewLocationSet(file.NewLocation(path))
+	p.SetID()
+	return p
+}
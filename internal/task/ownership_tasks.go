@@ -0,0 +1,196 @@
+package task
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// NewOwnershipTask returns a task that annotates the locations of every package as either internal (first-party) or
+// third-party based on the configured heuristics. No task is returned when there are no heuristics configured.
+func NewOwnershipTask(cfg cataloging.OwnershipConfig) Task {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+			classifyOwnership(s.Artifacts.Packages, cfg)
+		})
+		return nil
+	}
+
+	return NewTask("ownership-cataloger", fn)
+}
+
+func classifyOwnership(pkgs *pkg.Collection, cfg cataloging.OwnershipConfig) {
+	annotatePackageLocations(pkgs, func(p pkg.Package) locationAnnotator {
+		ownership := pkg.ThirdPartyOwnership
+		if isInternal(p, cfg) {
+			ownership = pkg.InternalOwnership
+		}
+		return withAnnotations(map[string]string{pkg.OwnershipAnnotationKey: ownership})
+	})
+}
+
+// isInternal indicates if any of the configured heuristics identifies the package as an internal package.
+func isInternal(p pkg.Package, cfg cataloging.OwnershipConfig) bool {
+	// note: packages without a valid package URL may still be matched by name and metadata
+	purl, _ := packageurl.FromString(p.PURL)
+
+	return matchesModulePrefix(p, purl, cfg.ModulePrefixes) ||
+		matchesGroupID(p, purl, cfg.GroupIDs) ||
+		matchesScope(p, purl, cfg.Scopes) ||
+		matchesRegistryHost(p, purl, cfg.RegistryHosts)
+}
+
+func matchesModulePrefix(p pkg.Package, purl packageurl.PackageURL, prefixes []string) bool {
+	names := []string{p.Name}
+	if purl.Namespace != "" {
+		names = append(names, purl.Namespace+"/"+purl.Name)
+	}
+
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			continue
+		}
+		for _, name := range names {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesGroupID(p pkg.Package, purl packageurl.PackageURL, groupIDs []string) bool {
+	var groups []string
+	if purl.Type == packageurl.TypeMaven && purl.Namespace != "" {
+		groups = append(groups, purl.Namespace)
+	}
+	if metadata, ok := p.Metadata.(pkg.JavaArchive); ok && metadata.PomProperties != nil && metadata.PomProperties.GroupID != "" {
+		groups = append(groups, metadata.PomProperties.GroupID)
+	}
+
+	for _, groupID := range groupIDs {
+		if groupID == "" {
+			continue
+		}
+		for _, group := range groups {
+			if group == groupID || strings.HasPrefix(group, groupID+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesScope(p pkg.Package, purl packageurl.PackageURL, scopes []string) bool {
+	var scope string
+	switch {
+	case purl.Type == packageurl.TypeNPM && purl.Namespace != "":
+		scope = purl.Namespace
+	case p.Type == pkg.NpmPkg && strings.HasPrefix(p.Name, "@"):
+		scope, _, _ = strings.Cut(p.Name, "/")
+	default:
+		return false
+	}
+	scope = strings.TrimPrefix(scope, "@")
+
+	for _, s := range scopes {
+		if s = strings.TrimPrefix(s, "@"); s != "" && s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRegistryHost(p pkg.Package, purl packageurl.PackageURL, hosts []string) bool {
+	if len(hosts) == 0 {
+		return false
+	}
+
+	for _, source := range packageSources(p, purl) {
+		host, hostname := sourceHost(source)
+		if host == "" {
+			continue
+		}
+		for _, h := range hosts {
+			h = strings.ToLower(h)
+			if h == "" {
+				continue
+			}
+			if host == h || hostname == h || strings.HasSuffix(hostname, "."+h) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// packageSources returns the URLs (or hosts) of the registries or repositories that the package was resolved from.
+func packageSources(p pkg.Package, purl packageurl.PackageURL) []string {
+	var sources []string
+	for _, q := range purl.Qualifiers {
+		if q.Key == "repository_url" || q.Key == "download_url" {
+			sources = append(sources, q.Value)
+		}
+	}
+
+	switch metadata := p.Metadata.(type) {
+	case pkg.NpmPackageLockEntry:
+		sources = append(sources, metadata.Resolved)
+	case pkg.YarnLockEntry:
+		sources = append(sources, metadata.Resolved)
+	case pkg.BunLockEntry:
+		sources = append(sources, metadata.Resolved)
+	case pkg.PythonPipfileLockEntry:
+		sources = append(sources, metadata.Index)
+	case pkg.PythonPoetryLockEntry:
+		sources = append(sources, metadata.Index)
+	case pkg.RustCargoLockEntry:
+		sources = append(sources, metadata.Source)
+	case pkg.BazelModuleEntry:
+		sources = append(sources, metadata.Registry)
+	case pkg.ContainerImageReferenceEntry:
+		sources = append(sources, metadata.Registry)
+	case pkg.DevContainerFeatureEntry:
+		sources = append(sources, metadata.Registry)
+	}
+
+	if p.Type == pkg.GoModulePkg {
+		// go modules are fetched from the host within the module path (when not using a proxy)
+		sources = append(sources, p.Name)
+	}
+
+	return sources
+}
+
+// sourceHost returns the host (which may include a port) and the hostname of the given URL, where URLs may have a
+// protocol prefix (e.g. "registry+https://" for cargo) or be a bare host or path (e.g. "npm.acme.internal/repo").
+func sourceHost(source string) (string, string) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return "", ""
+	}
+
+	if _, rest, found := strings.Cut(source, "+"); found && strings.Contains(rest, "://") {
+		source = rest
+	}
+	if !strings.Contains(source, "://") {
+		source = "//" + source
+	}
+
+	u, err := url.Parse(source)
+	if err != nil || u.Host == "" {
+		return "", ""
+	}
+	return strings.ToLower(u.Host), strings.ToLower(u.Hostname())
+}
@@ -0,0 +1,148 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func TestNewOwnershipTask(t *testing.T) {
+	assert.Nil(t, NewOwnershipTask(cataloging.DefaultOwnershipConfig()))
+
+	internal := newTestPackage(pkg.Package{
+		Name:    "github.com/acme/billing",
+		Version: "v1.2.0",
+		Type:    pkg.GoModulePkg,
+		PURL:    "pkg:golang/github.com/acme/billing@v1.2.0",
+	}, "/app/go.mod")
+	thirdParty := newTestPackage(pkg.Package{
+		Name:    "github.com/google/uuid",
+		Version: "v1.6.0",
+		Type:    pkg.GoModulePkg,
+		PURL:    "pkg:golang/github.com/google/uuid@v1.6.0",
+	}, "/app/go.mod")
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(internal, thirdParty),
+		},
+	}
+
+	tsk := NewOwnershipTask(cataloging.DefaultOwnershipConfig().WithModulePrefixes("github.com/acme/"))
+	require.NotNil(t, tsk)
+	require.NoError(t, tsk.Execute(context.Background(), nil, sbomsync.NewBuilder(&s)))
+
+	ownership := func(p pkg.Package) string {
+		got := s.Artifacts.Packages.Package(p.ID())
+		require.NotNil(t, got, "package ID must not change")
+		return pkg.Ownership(*got)
+	}
+
+	assert.Equal(t, pkg.InternalOwnership, ownership(internal))
+	assert.Equal(t, pkg.ThirdPartyOwnership, ownership(thirdParty))
+}
+
+func Test_isInternal(t *testing.T) {
+	cfg := cataloging.DefaultOwnershipConfig().
+		WithModulePrefixes("github.com/acme/").
+		WithGroupIDs("com.acme").
+		WithScopes("@acme").
+		WithRegistryHosts("registry.acme.internal", "acme.jfrog.io")
+
+	tests := []struct {
+		name string
+		p    pkg.Package
+		want bool
+	}{
+		{
+			name: "module prefix",
+			p:    pkg.Package{Name: "github.com/acme/billing", Type: pkg.GoModulePkg},
+			want: true,
+		},
+		{
+			name: "module prefix within purl namespace",
+			p:    pkg.Package{Name: "billing", PURL: "pkg:golang/github.com/acme/billing@v1.0.0"},
+			want: true,
+		},
+		{
+			name: "group ID",
+			p:    pkg.Package{Name: "billing", Type: pkg.JavaPkg, PURL: "pkg:maven/com.acme/billing@1.0.0"},
+			want: true,
+		},
+		{
+			name: "nested group ID",
+			p:    pkg.Package{Name: "billing", Type: pkg.JavaPkg, PURL: "pkg:maven/com.acme.payments/billing@1.0.0"},
+			want: true,
+		},
+		{
+			name: "group ID from pom properties",
+			p: pkg.Package{Name: "billing", Type: pkg.JavaPkg, Metadata: pkg.JavaArchive{
+				PomProperties: &pkg.JavaPomProperties{GroupID: "com.acme", ArtifactID: "billing"},
+			}},
+			want: true,
+		},
+		{
+			name: "group ID sharing a prefix",
+			p:    pkg.Package{Name: "billing", Type: pkg.JavaPkg, PURL: "pkg:maven/com.acmecorp/billing@1.0.0"},
+			want: false,
+		},
+		{
+			name: "npm scope",
+			p:    pkg.Package{Name: "@acme/ui", Type: pkg.NpmPkg, PURL: "pkg:npm/%40acme/ui@1.0.0"},
+			want: true,
+		},
+		{
+			name: "npm scope without purl",
+			p:    pkg.Package{Name: "@acme/ui", Type: pkg.NpmPkg},
+			want: true,
+		},
+		{
+			name: "other npm scope",
+			p:    pkg.Package{Name: "@types/node", Type: pkg.NpmPkg, PURL: "pkg:npm/%40types/node@20.0.0"},
+			want: false,
+		},
+		{
+			name: "npm resolved from internal registry",
+			p: pkg.Package{Name: "left-pad", Type: pkg.NpmPkg, Metadata: pkg.NpmPackageLockEntry{
+				Resolved: "https://registry.acme.internal/left-pad/-/left-pad-1.3.0.tgz",
+			}},
+			want: true,
+		},
+		{
+			name: "cargo registry subdomain",
+			p: pkg.Package{Name: "billing", Type: pkg.RustPkg, Metadata: pkg.RustCargoLockEntry{
+				Source: "registry+https://cargo.acme.jfrog.io/index",
+			}},
+			want: true,
+		},
+		{
+			name: "purl repository URL",
+			p:    pkg.Package{Name: "billing", Type: pkg.JavaPkg, PURL: "pkg:maven/org.example/billing@1.0.0?repository_url=https://acme.jfrog.io/maven"},
+			want: true,
+		},
+		{
+			name: "go module host",
+			p:    pkg.Package{Name: "registry.acme.internal/team/billing", Type: pkg.GoModulePkg},
+			want: true,
+		},
+		{
+			name: "public registry",
+			p: pkg.Package{Name: "left-pad", Type: pkg.NpmPkg, Metadata: pkg.NpmPackageLockEntry{
+				Resolved: "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz",
+			}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isInternal(tt.p, cfg))
+		})
+	}
+}
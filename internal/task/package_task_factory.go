@@ -100,7 +100,8 @@ func NewPackageTask(cfg CatalogingFactoryConfig, c pkg.Cataloger, tags ...string
 
 		t := bus.StartCatalogerTask(info, -1, "")
 
-		pkgs, relationships, err := c.Catalog(ctx, resolver)
+		// note: the search gates only limit what the cataloger can discover, not what is used to describe what was found
+		pkgs, relationships, err := c.Catalog(ctx, newGatedResolver(resolver, cfg.SearchConfig.Gates, tags...))
 		if err != nil {
 			return fmt.Errorf("unable to catalog packages with %q: %w", c.Name(), err)
 		}
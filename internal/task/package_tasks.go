@@ -4,32 +4,62 @@ import (
 	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/alpine"
+	"github.com/anchore/syft/syft/pkg/cataloger/android"
+	"github.com/anchore/syft/syft/pkg/cataloger/ansible"
+	"github.com/anchore/syft/syft/pkg/cataloger/apiservice"
 	"github.com/anchore/syft/syft/pkg/cataloger/arch"
+	"github.com/anchore/syft/syft/pkg/cataloger/bazel"
 	"github.com/anchore/syft/syft/pkg/cataloger/binary"
+	"github.com/anchore/syft/syft/pkg/cataloger/browser"
+	"github.com/anchore/syft/syft/pkg/cataloger/buck"
+	"github.com/anchore/syft/syft/pkg/cataloger/chef"
+	"github.com/anchore/syft/syft/pkg/cataloger/containerimage"
 	"github.com/anchore/syft/syft/pkg/cataloger/cpp"
+	"github.com/anchore/syft/syft/pkg/cataloger/cryptoasset"
 	"github.com/anchore/syft/syft/pkg/cataloger/dart"
 	"github.com/anchore/syft/syft/pkg/cataloger/debian"
 	"github.com/anchore/syft/syft/pkg/cataloger/dotnet"
+	"github.com/anchore/syft/syft/pkg/cataloger/editorplugin"
 	"github.com/anchore/syft/syft/pkg/cataloger/elixir"
 	"github.com/anchore/syft/syft/pkg/cataloger/erlang"
+	"github.com/anchore/syft/syft/pkg/cataloger/firmware"
 	"github.com/anchore/syft/syft/pkg/cataloger/gentoo"
 	"github.com/anchore/syft/syft/pkg/cataloger/githubactions"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
 	"github.com/anchore/syft/syft/pkg/cataloger/haskell"
+	"github.com/anchore/syft/syft/pkg/cataloger/helm"
+	"github.com/anchore/syft/syft/pkg/cataloger/ios"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
+	"github.com/anchore/syft/syft/pkg/cataloger/jetbrains"
 	"github.com/anchore/syft/syft/pkg/cataloger/kernel"
 	"github.com/anchore/syft/syft/pkg/cataloger/lua"
+	"github.com/anchore/syft/syft/pkg/cataloger/macos"
+	"github.com/anchore/syft/syft/pkg/cataloger/meson"
+	"github.com/anchore/syft/syft/pkg/cataloger/mlmodel"
+	"github.com/anchore/syft/syft/pkg/cataloger/networkservice"
 	"github.com/anchore/syft/syft/pkg/cataloger/nix"
+	"github.com/anchore/syft/syft/pkg/cataloger/osaccount"
 	"github.com/anchore/syft/syft/pkg/cataloger/php"
+	"github.com/anchore/syft/syft/pkg/cataloger/pulumi"
+	"github.com/anchore/syft/syft/pkg/cataloger/puppet"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
 	"github.com/anchore/syft/syft/pkg/cataloger/r"
 	"github.com/anchore/syft/syft/pkg/cataloger/redhat"
 	"github.com/anchore/syft/syft/pkg/cataloger/ruby"
 	"github.com/anchore/syft/syft/pkg/cataloger/rust"
 	sbomCataloger "github.com/anchore/syft/syft/pkg/cataloger/sbom"
+	"github.com/anchore/syft/syft/pkg/cataloger/scheduledtask"
+	"github.com/anchore/syft/syft/pkg/cataloger/snap"
 	"github.com/anchore/syft/syft/pkg/cataloger/swift"
 	"github.com/anchore/syft/syft/pkg/cataloger/swipl"
+	"github.com/anchore/syft/syft/pkg/cataloger/texlive"
+	"github.com/anchore/syft/syft/pkg/cataloger/toolchain"
+	"github.com/anchore/syft/syft/pkg/cataloger/unity"
+	"github.com/anchore/syft/syft/pkg/cataloger/unreal"
+	"github.com/anchore/syft/syft/pkg/cataloger/vscode"
+	"github.com/anchore/syft/syft/pkg/cataloger/wasm"
+	"github.com/anchore/syft/syft/pkg/cataloger/windows"
 	"github.com/anchore/syft/syft/pkg/cataloger/wordpress"
 )
 
@@ -50,14 +80,18 @@ func DefaultPackageTaskFactories() PackageTaskFactories {
 		newSimplePackageTaskFactory(cpp.NewConanInfoCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "cpp", "conan"),
 		newSimplePackageTaskFactory(javascript.NewPackageCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "javascript", "node"),
 		newSimplePackageTaskFactory(php.NewComposerInstalledCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "php", "composer"),
+		newSimplePackageTaskFactory(php.NewExtensionCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "php", "pecl", "extension"),
 		newSimplePackageTaskFactory(r.NewPackageCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "r"),
 		newSimplePackageTaskFactory(ruby.NewInstalledGemSpecCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "ruby", "gem", "gemspec"),
-		newSimplePackageTaskFactory(rust.NewAuditBinaryCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "rust", "binary"),
+		newSimplePackageTaskFactory(rust.NewAuditBinaryCataloger, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "rust", pkgcataloging.BinaryTag),
 
 		// language-specific package declared catalogers ///////////////////////////////////////////////////////////////////////////
 		newSimplePackageTaskFactory(cpp.NewConanCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "cpp", "conan"),
+		newSimplePackageTaskFactory(meson.NewWrapCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "cpp", "meson"),
 		newSimplePackageTaskFactory(dart.NewPubspecLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "dart"),
 		newSimplePackageTaskFactory(dotnet.NewDotnetDepsCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "dotnet", "c#"),
+		newSimplePackageTaskFactory(dotnet.NewDotnetPackagesLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "dotnet", "c#", "nuget"),
+		newSimplePackageTaskFactory(dotnet.NewDotnetPaketLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "dotnet", "c#", "paket"),
 		newSimplePackageTaskFactory(elixir.NewMixLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "elixir"),
 		newSimplePackageTaskFactory(erlang.NewRebarLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "erlang"),
 		newSimplePackageTaskFactory(erlang.NewOTPCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "erlang", "otp"),
@@ -69,6 +103,7 @@ func DefaultPackageTaskFactories() PackageTaskFactories {
 			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "go", "golang", "gomod",
 		),
 		newSimplePackageTaskFactory(java.NewGradleLockfileCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "java", "gradle"),
+		newSimplePackageTaskFactory(java.NewBuildToolWrapperCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "java", "gradle", "maven"),
 		newPackageTaskFactory(
 			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
 				return java.NewPomCataloger(cfg.PackagesConfig.JavaArchive)
@@ -92,18 +127,33 @@ func DefaultPackageTaskFactories() PackageTaskFactories {
 		newSimplePackageTaskFactory(ruby.NewGemFileLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "ruby", "gem"),
 		newSimplePackageTaskFactory(ruby.NewGemSpecCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "ruby", "gem", "gemspec"),
 		newSimplePackageTaskFactory(rust.NewCargoLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "rust", "cargo"),
+		newSimplePackageTaskFactory(swift.NewCarthageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "swift", "carthage"),
 		newSimplePackageTaskFactory(swift.NewCocoapodsCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "swift", "cocoapods"),
 		newSimplePackageTaskFactory(swift.NewSwiftPackageManagerCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "swift", "spm"),
 		newSimplePackageTaskFactory(swipl.NewSwiplPackCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "swipl", "pack"),
+		newSimplePackageTaskFactory(unity.NewPackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "unity", "upm"),
+		newSimplePackageTaskFactory(unreal.NewPluginCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.LanguageTag, "unreal", "uplugin"),
+		newSimplePackageTaskFactory(ansible.NewRequirementsCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "ansible", "galaxy"),
+		newSimplePackageTaskFactory(chef.NewLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "chef", "berkshelf", "policyfile"),
+		newSimplePackageTaskFactory(helm.NewLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "helm", "kubernetes"),
+		newSimplePackageTaskFactory(pulumi.NewProjectCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "pulumi"),
+		newSimplePackageTaskFactory(puppet.NewPuppetfileLockCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "puppet", "forge", "librarian-puppet"),
 
 		// language-specific package for both image and directory scans (but not necessarily declared) ////////////////////////////////////////
-		newSimplePackageTaskFactory(dotnet.NewDotnetPortableExecutableCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "dotnet", "c#", "binary"),
+		newSimplePackageTaskFactory(dotnet.NewDotnetPortableExecutableCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "dotnet", "c#", pkgcataloging.BinaryTag),
 		newSimplePackageTaskFactory(python.NewInstalledPackageCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "python"),
+		newSimplePackageTaskFactory(python.NewPipxCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "python", "pipx"),
+		newSimplePackageTaskFactory(python.NewFrozenApplicationCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "python", "pyinstaller", "pex", "shiv", "py2exe", pkgcataloging.BinaryTag),
+		newSimplePackageTaskFactory(rust.NewCargoInstallCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "rust", "cargo"),
+		// note: the package.json files of globally installed packages are already cataloged for image scans (by the javascript-package-cataloger), so this is only used by default when scanning directories
+		newSimplePackageTaskFactory(javascript.NewGlobalPackageCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.LanguageTag, "javascript", "node", "npm", "yarn"),
+		newSimplePackageTaskFactory(javascript.NewBundleCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "javascript", "node", "npm", "bundle", "sourcemap"),
+		newSimplePackageTaskFactory(javascript.NewElectronCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "javascript", "node", "npm", "electron", "asar"),
 		newPackageTaskFactory(
 			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
 				return golang.NewGoModuleBinaryCataloger(cfg.PackagesConfig.Golang)
 			},
-			pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "go", "golang", "gomod", "binary",
+			pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "go", "golang", "gomod", pkgcataloging.BinaryTag,
 		),
 		newPackageTaskFactory(
 			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
@@ -112,17 +162,84 @@ func DefaultPackageTaskFactories() PackageTaskFactories {
 			pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "java", "maven",
 		),
 		newSimplePackageTaskFactory(java.NewNativeImageCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "java"),
+		newSimplePackageTaskFactory(java.NewOSGiBundleCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "java", "osgi", "eclipse", "p2"),
 		newSimplePackageTaskFactory(nix.NewStoreCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "nix"),
 		newSimplePackageTaskFactory(lua.NewPackageCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.LanguageTag, "lua"),
+		newSimplePackageTaskFactory(ansible.NewInstalledCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "ansible", "galaxy"),
+		newSimplePackageTaskFactory(chef.NewCookbookCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "chef", "cookbook"),
+		newPackageTaskFactory(
+			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
+				return helm.NewChartCataloger(cfg.PackagesConfig.Helm)
+			},
+			pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "helm", "kubernetes",
+		),
+		newSimplePackageTaskFactory(pulumi.NewPluginCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "pulumi"),
+		newSimplePackageTaskFactory(puppet.NewModuleCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "puppet", "forge"),
+		newSimplePackageTaskFactory(browser.NewExtensionCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "browser", "chrome", "chromium", "firefox", "extension"),
+		newSimplePackageTaskFactory(editorplugin.NewEmacsPackageCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "editor", "emacs", "straight"),
+		newSimplePackageTaskFactory(jetbrains.NewPluginCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "jetbrains", "intellij", "plugin"),
+		newSimplePackageTaskFactory(texlive.NewTlpdbCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "texlive", "tex", "latex", "ctan"),
+		newSimplePackageTaskFactory(toolchain.NewSDKMANCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "toolchain", "sdkman"),
+		newSimplePackageTaskFactory(editorplugin.NewVimPluginCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "editor", "vim", "neovim", "lazy", "vim-plug"),
+		newSimplePackageTaskFactory(vscode.NewExtensionCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "vscode", "code-server", "extension"),
+		newSimplePackageTaskFactory(android.NewCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "android"),
+		newSimplePackageTaskFactory(ios.NewCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "ios", "ipa"),
+		newSimplePackageTaskFactory(macos.NewCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "macos", "framework", "dylib"),
+		newSimplePackageTaskFactory(macos.NewReceiptCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "macos", "pkgutil", "receipt"),
+		newSimplePackageTaskFactory(windows.NewAssemblyCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "windows", "winsxs", "gac", "assembly"),
+		newSimplePackageTaskFactory(windows.NewDriverCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "windows", "driver", "driverstore", "inf"),
+		newPackageTaskFactory(
+			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
+				return windows.NewMSICataloger(windowsInstallerPayloadCatalogers(cfg)...)
+			},
+			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.ImageTag, "windows", "msi", "installer",
+		),
+		newPackageTaskFactory(
+			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
+				payloadCatalogers := windowsInstallerPayloadCatalogers(cfg)
+				// setup programs often bootstrap a Windows Installer package
+				payloadCatalogers = append(payloadCatalogers, windows.NewMSICataloger(payloadCatalogers...))
+				return windows.NewInstallerCataloger(payloadCatalogers...)
+			},
+			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.ImageTag, "windows", "installer", "nsis", "inno-setup",
+		),
+		newPackageTaskFactory(
+			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
+				return snap.NewCataloger(cfg.PackagesConfig.Snap, squashFSContentCatalogers(cfg)...)
+			},
+			pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "snap", "squashfs",
+		),
+		newSimplePackageTaskFactory(wasm.NewCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "wasm", "webassembly"),
+		newSimplePackageTaskFactory(mlmodel.NewCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "ml", "ai", "model", "gguf", "onnx", "safetensors", "huggingface"),
 
 		// other package catalogers ///////////////////////////////////////////////////////////////////////////
 		newPackageTaskFactory(
 			func(cfg CatalogingFactoryConfig) pkg.Cataloger {
 				return binary.NewClassifierCataloger(cfg.PackagesConfig.Binary)
 			},
-			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "binary",
+			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.BinaryTag,
 		),
-		newSimplePackageTaskFactory(binary.NewELFPackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "binary", "elf-package"),
+		newSimplePackageTaskFactory(binary.NewELFPackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.BinaryTag, "elf-package"),
+		newSimplePackageTaskFactory(binary.NewStaticLibraryCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, pkgcataloging.BinaryTag, "static-library"),
+		// note: services described by API specifications rather than packages, so this is only used when explicitly selected
+		newSimplePackageTaskFactory(apiservice.NewCataloger, "api", "api-service"),
+		// note: images referenced by deployment manifests rather than packages (and any YAML file may be a manifest), so this is only used when explicitly selected
+		newSimplePackageTaskFactory(containerimage.NewCataloger, "container-image", "image-reference", "gitops"),
+		newSimplePackageTaskFactory(bazel.NewModuleCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "bazel", "bzlmod"),
+		newSimplePackageTaskFactory(buck.NewDependencyCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "buck", "buck2"),
+		newSimplePackageTaskFactory(debian.NewSourcePackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "dpkg", "debian", "deb-source"),
+		newSimplePackageTaskFactory(redhat.NewSourcePackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "rpm", "redhat", "rpm-source"),
+		newSimplePackageTaskFactory(arch.NewSourcePackageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "alpm", "archlinux", "pkgbuild"),
+		newSimplePackageTaskFactory(toolchain.NewManifestCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "toolchain", "asdf", "mise"),
+		newSimplePackageTaskFactory(containerimage.NewDevEnvironmentCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "devcontainer", "devfile", "dev-environment"),
+		// note: device tree blobs are shipped by kernel packages for every supported board, so firmware images are only cataloged by default when scanning directories (e.g. extracted firmware archives)
+		newSimplePackageTaskFactory(firmware.NewCataloger, pkgcataloging.DirectoryTag, "firmware", "uefi", "capsule", "device-tree"),
+		// note: firmware files are usually owned by OS packages such as linux-firmware, so this cataloger is only enabled by default for directory scans (e.g. /lib/firmware)
+		newSimplePackageTaskFactory(firmware.NewLinuxFirmwareCataloger, pkgcataloging.DirectoryTag, "firmware", "linux-firmware", "whence"),
+		newSimplePackageTaskFactory(firmware.NewMicrocodeCataloger, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "firmware", "microcode", "cpu-microcode"),
+		// note: cryptographic assets rather than packages, so these are only used when explicitly selected
+		newSimplePackageTaskFactory(cryptoasset.NewMaterialCataloger, "crypto", "cbom", "crypto-material"),
+		newSimplePackageTaskFactory(cryptoasset.NewLibraryCataloger, "crypto", "cbom", "crypto-library"),
 		newSimplePackageTaskFactory(githubactions.NewActionUsageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "github", "github-actions"),
 		newSimplePackageTaskFactory(githubactions.NewWorkflowUsageCataloger, pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, "github", "github-actions"),
 		newPackageTaskFactory(
@@ -131,7 +248,43 @@ func DefaultPackageTaskFactories() PackageTaskFactories {
 			},
 			pkgcataloging.DeclaredTag, pkgcataloging.DirectoryTag, pkgcataloging.InstalledTag, pkgcataloging.ImageTag, "linux", "kernel",
 		),
+		// note: host inventory (accounts, scheduled tasks, and network services) rather than packages, so these are only used when explicitly selected
+		newSimplePackageTaskFactory(osaccount.NewCataloger, "host-inventory", "os-account", "account"),
+		newSimplePackageTaskFactory(scheduledtask.NewCataloger, "host-inventory", "scheduled-task", "cron", "systemd-timer"),
+		newSimplePackageTaskFactory(networkservice.NewCataloger, "host-inventory", "network-service", "xinetd", "nginx", "apache"),
 		newSimplePackageTaskFactory(sbomCataloger.NewCataloger, "sbom"), // note: not evidence of installed packages
 		newSimplePackageTaskFactory(wordpress.NewWordpressPluginCataloger, pkgcataloging.DirectoryTag, pkgcataloging.ImageTag, "wordpress"),
 	}
 }
+
+// windowsInstallerPayloadCatalogers returns the catalogers that are run against the files extracted from Windows
+// installers, which are application binaries along with the runtime dependencies that they ship with.
+func windowsInstallerPayloadCatalogers(cfg CatalogingFactoryConfig) []pkg.Cataloger {
+	return []pkg.Cataloger{
+		binary.NewClassifierCataloger(cfg.PackagesConfig.Binary),
+		dotnet.NewDotnetDepsCataloger(),
+		dotnet.NewDotnetPortableExecutableCataloger(),
+		golang.NewGoModuleBinaryCataloger(cfg.PackagesConfig.Golang),
+		java.NewArchiveCataloger(cfg.PackagesConfig.JavaArchive),
+		javascript.NewPackageCataloger(),
+		python.NewInstalledPackageCataloger(),
+	}
+}
+
+// squashFSContentCatalogers returns the catalogers that are run against the files within snaps and other squashfs
+// images, which are either applications bundled with the libraries that they depend on or complete root filesystems.
+func squashFSContentCatalogers(cfg CatalogingFactoryConfig) []pkg.Cataloger {
+	return []pkg.Cataloger{
+		alpine.NewDBCataloger(),
+		debian.NewDBCataloger(),
+		redhat.NewDBCataloger(),
+		binary.NewClassifierCataloger(cfg.PackagesConfig.Binary),
+		dotnet.NewDotnetDepsCataloger(),
+		golang.NewGoModuleBinaryCataloger(cfg.PackagesConfig.Golang),
+		java.NewArchiveCataloger(cfg.PackagesConfig.JavaArchive),
+		javascript.NewPackageCataloger(),
+		python.NewInstalledPackageCataloger(),
+		ruby.NewInstalledGemSpecCataloger(),
+		rust.NewAuditBinaryCataloger(),
+	}
+}
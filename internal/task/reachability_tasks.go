@@ -0,0 +1,192 @@
+package task
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/reachability"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// NewEntrypointClosureTask returns a task that annotates the packages with files reachable from the entrypoint (or cmd)
+// of the container image being cataloged. No task is returned when disabled, or when the source is not an image with
+// an entrypoint.
+func NewEntrypointClosureTask(cfg cataloging.ReachabilityConfig, src source.Description) Task {
+	if !cfg.EntrypointClosure {
+		return nil
+	}
+
+	metadata, ok := src.Metadata.(source.ImageMetadata)
+	if !ok {
+		return nil
+	}
+
+	entrypoint, err := reachability.ImageEntrypoint(metadata)
+	if err != nil {
+		log.WithFields("error", err).Debug("unable to determine image entrypoint")
+		return nil
+	}
+	if entrypoint == nil {
+		return nil
+	}
+
+	fn := func(_ context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		closure := reachability.EntrypointClosure(resolver, *entrypoint)
+		builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+			annotateEntrypointClosure(s.Artifacts.Packages, closure)
+		})
+		return nil
+	}
+
+	return NewTask("entrypoint-closure-cataloger", fn)
+}
+
+func annotateEntrypointClosure(pkgs *pkg.Collection, closure reachability.Closure) {
+	annotateReachability(pkgs, pkg.EntrypointClosureReachability, func(p pkg.Package) bool {
+		return inClosure(p, closure)
+	})
+}
+
+// annotateReachability adds the given reachability hint to all locations of the packages that match.
+func annotateReachability(pkgs *pkg.Collection, hint string, matches func(pkg.Package) bool) {
+	annotatePackageLocations(pkgs, func(p pkg.Package) locationAnnotator {
+		if !matches(p) {
+			return nil
+		}
+		return func(l file.Location) (file.Location, bool) {
+			return l.WithAnnotation(pkg.ReachabilityAnnotationKey, withHint(l.Annotations[pkg.ReachabilityAnnotationKey], hint)), true
+		}
+	})
+}
+
+// withHint returns the (comma separated) reachability hints with the given hint added.
+func withHint(value, hint string) string {
+	if value == "" {
+		return hint
+	}
+	hints := strings.Split(value, ",")
+	if slices.Contains(hints, hint) {
+		return value
+	}
+	hints = append(hints, hint)
+	sort.Strings(hints)
+	return strings.Join(hints, ",")
+}
+
+// inClosure indicates if the package was found from (or owns) any file within the closure.
+func inClosure(p pkg.Package, closure reachability.Closure) bool {
+	for _, l := range p.Locations.ToSlice() {
+		if closure.Contains(l.RealPath) || l.AccessPath != "" && closure.Contains(l.AccessPath) {
+			return true
+		}
+	}
+
+	if fileOwner, ok := p.Metadata.(pkg.FileOwner); ok {
+		for _, f := range fileOwner.OwnedFiles() {
+			if closure.Contains(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewImportsTask returns a task that annotates the packages that are imported by first-party Python, JavaScript, and
+// Ruby source files (those not owned by a package or within a directory of installed dependencies). No task is
+// returned when disabled.
+func NewImportsTask(cfg cataloging.ReachabilityConfig) Task {
+	if !cfg.Imports {
+		return nil
+	}
+
+	fn := func(_ context.Context, resolver file.Resolver, builder sbomsync.Builder) error {
+		accessor := builder.(sbomsync.Accessor)
+
+		var owned map[string]struct{}
+		accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+			owned = ownedFiles(s.Artifacts.Packages)
+		})
+
+		imports := reachability.SourceImports(resolver, func(l file.Location) bool {
+			_, ok := owned[l.RealPath]
+			return ok
+		})
+
+		accessor.WriteToSBOM(func(s *sbom.SBOM) {
+			annotateImports(s.Artifacts.Packages, imports)
+		})
+		return nil
+	}
+
+	return NewTask("imports-cataloger", fn)
+}
+
+func annotateImports(pkgs *pkg.Collection, imports reachability.Imports) {
+	annotateReachability(pkgs, pkg.ImportedReachability, func(p pkg.Package) bool {
+		return isImported(p, imports)
+	})
+}
+
+// isImported indicates if the package provides any module imported by first-party source code.
+func isImported(p pkg.Package, imports reachability.Imports) bool {
+	switch p.Type {
+	case pkg.PythonPkg:
+		// the importable modules of a distribution commonly differ from the distribution name (e.g. PyYAML provides
+		// "yaml"), which is described by the top-level packages when known
+		if metadata, ok := p.Metadata.(pkg.PythonPackage); ok {
+			for _, module := range metadata.TopLevelPackages {
+				if imports.Contains(pkg.Python, strings.ToLower(module)) {
+					return true
+				}
+			}
+		}
+		module := strings.NewReplacer("-", "_", ".", "_").Replace(strings.ToLower(p.Name))
+		return imports.Contains(pkg.Python, module)
+	case pkg.NpmPkg:
+		return imports.Contains(pkg.JavaScript, p.Name)
+	case pkg.GemPkg:
+		for _, required := range imports.Modules(pkg.Ruby) {
+			if requiresGem(required, p.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requiresGem indicates if the required path is (likely) provided by the gem, where gems conventionally provide
+// paths of the gem name (e.g. "rack" or "rack/utils"), of the gem name with dashes as directories (e.g. "rack/test"
+// for rack-test), or of the gem name with underscores (e.g. "active_support" for activesupport).
+func requiresGem(required, gem string) bool {
+	if required == gem || strings.HasPrefix(required, gem+"/") || strings.ReplaceAll(required, "/", "-") == gem {
+		return true
+	}
+
+	root, _, _ := strings.Cut(required, "/")
+	normalize := strings.NewReplacer("-", "", "_", "").Replace
+	return strings.EqualFold(normalize(root), normalize(gem))
+}
+
+// ownedFiles returns the paths of all files owned by the packages.
+func ownedFiles(pkgs *pkg.Collection) map[string]struct{} {
+	owned := make(map[string]struct{})
+	if pkgs == nil {
+		return owned
+	}
+	for p := range pkgs.Enumerate() {
+		if fileOwner, ok := p.Metadata.(pkg.FileOwner); ok {
+			for _, f := range fileOwner.OwnedFiles() {
+				owned[f] = struct{}{}
+			}
+		}
+	}
+	return owned
+}
@@ -0,0 +1,159 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/reachability"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestNewEntrypointClosureTask(t *testing.T) {
+	image := source.Description{
+		Metadata: source.ImageMetadata{RawConfig: []byte(`{"config":{"Entrypoint":["/usr/bin/app"]}}`)},
+	}
+	enabled := cataloging.DefaultReachabilityConfig().WithEntrypointClosure(true)
+
+	assert.Nil(t, NewEntrypointClosureTask(cataloging.DefaultReachabilityConfig(), image), "disabled")
+	assert.Nil(t, NewEntrypointClosureTask(enabled, source.Description{Metadata: source.DirectoryMetadata{Path: "/app"}}), "not an image")
+	assert.Nil(t, NewEntrypointClosureTask(enabled, source.Description{Metadata: source.ImageMetadata{RawConfig: []byte(`{"config":{}}`)}}), "no entrypoint")
+	assert.NotNil(t, NewEntrypointClosureTask(enabled, image))
+}
+
+func Test_annotateEntrypointClosure(t *testing.T) {
+	app := newTestPackage(pkg.Package{Name: "app", Type: pkg.BinaryPkg}, "/usr/bin/app")
+	libc := newTestPackage(pkg.Package{Name: "libc6", Type: pkg.DebPkg, Metadata: pkg.DpkgDBEntry{
+		Package: "libc6",
+		Files:   []pkg.DpkgFileRecord{{Path: "/lib/x86_64-linux-gnu/libc.so.6"}},
+	}}, "/var/lib/dpkg/status")
+	curl := newTestPackage(pkg.Package{Name: "curl", Type: pkg.DebPkg, Metadata: pkg.DpkgDBEntry{
+		Package: "curl",
+		Files:   []pkg.DpkgFileRecord{{Path: "/usr/bin/curl"}},
+	}}, "/var/lib/dpkg/status")
+
+	pkgs := pkg.NewCollection(app, libc, curl)
+	annotateEntrypointClosure(pkgs, reachability.NewClosure("/usr/bin/app", "/usr/lib/x86_64-linux-gnu/libc.so.6"))
+
+	inClosure := func(p pkg.Package) bool {
+		got := pkgs.Package(p.ID())
+		if !assert.NotNil(t, got, "package ID must not change") {
+			return false
+		}
+		return pkg.InEntrypointClosure(*got)
+	}
+
+	assert.True(t, inClosure(app))
+	assert.True(t, inClosure(libc), "owned files are considered")
+	assert.False(t, inClosure(curl))
+}
+
+func TestNewImportsTask(t *testing.T) {
+	assert.Nil(t, NewImportsTask(cataloging.DefaultReachabilityConfig()), "disabled")
+	assert.NotNil(t, NewImportsTask(cataloging.DefaultReachabilityConfig().WithImports(true)))
+}
+
+func Test_annotateImports(t *testing.T) {
+	newPackage := func(p pkg.Package, path string) pkg.Package {
+		p.Locations = file.NewLocationSet(file.NewLocation(path).WithAnnotation(pkg.ReachabilityAnnotationKey, pkg.EntrypointClosureReachability))
+		p.SetID()
+		return p
+	}
+
+	pyyaml := newPackage(pkg.Package{Name: "PyYAML", Type: pkg.PythonPkg, Metadata: pkg.PythonPackage{
+		Name:             "PyYAML",
+		TopLevelPackages: []string{"_yaml", "yaml"},
+	}}, "/usr/lib/python3/dist-packages/PyYAML-6.0.1.dist-info/METADATA")
+	django := newPackage(pkg.Package{Name: "Django", Type: pkg.PythonPkg}, "/app/requirements.txt")
+	express := newPackage(pkg.Package{Name: "express", Type: pkg.NpmPkg}, "/app/package-lock.json")
+
+	pkgs := pkg.NewCollection(pyyaml, django, express)
+	annotateImports(pkgs, reachability.NewImports(pkg.Python, "yaml", "django"))
+
+	got := func(p pkg.Package) pkg.Package {
+		found := pkgs.Package(p.ID())
+		require.NotNil(t, found, "package ID must not change")
+		return *found
+	}
+
+	// existing hints are retained
+	assert.Equal(t, []string{pkg.EntrypointClosureReachability, pkg.ImportedReachability}, pkg.Reachability(got(pyyaml)))
+	assert.True(t, pkg.IsImported(got(django)))
+	assert.False(t, pkg.IsImported(got(express)))
+}
+
+func Test_isImported(t *testing.T) {
+	javascript := reachability.NewImports(pkg.JavaScript, "express", "@acme/charts")
+	ruby := reachability.NewImports(pkg.Ruby, "active_support/core_ext", "rack/test", "json")
+
+	tests := []struct {
+		name    string
+		p       pkg.Package
+		imports reachability.Imports
+		want    bool
+	}{
+		{
+			name:    "npm package",
+			p:       pkg.Package{Name: "express", Type: pkg.NpmPkg},
+			imports: javascript,
+			want:    true,
+		},
+		{
+			name:    "scoped npm package",
+			p:       pkg.Package{Name: "@acme/charts", Type: pkg.NpmPkg},
+			imports: javascript,
+			want:    true,
+		},
+		{
+			name:    "npm package not imported",
+			p:       pkg.Package{Name: "lodash", Type: pkg.NpmPkg},
+			imports: javascript,
+			want:    false,
+		},
+		{
+			name:    "gem with underscores in the required path",
+			p:       pkg.Package{Name: "activesupport", Type: pkg.GemPkg},
+			imports: ruby,
+			want:    true,
+		},
+		{
+			name:    "gem with dashes as directories",
+			p:       pkg.Package{Name: "rack-test", Type: pkg.GemPkg},
+			imports: ruby,
+			want:    true,
+		},
+		{
+			name:    "gem providing a required directory",
+			p:       pkg.Package{Name: "rack", Type: pkg.GemPkg},
+			imports: ruby,
+			want:    true,
+		},
+		{
+			name:    "gem not required",
+			p:       pkg.Package{Name: "nokogiri", Type: pkg.GemPkg},
+			imports: ruby,
+			want:    false,
+		},
+		{
+			name:    "python distribution name",
+			p:       pkg.Package{Name: "typing-extensions", Type: pkg.PythonPkg},
+			imports: reachability.NewImports(pkg.Python, "typing_extensions"),
+			want:    true,
+		},
+		{
+			name:    "other ecosystem with the same name",
+			p:       pkg.Package{Name: "json", Type: pkg.DebPkg},
+			imports: ruby,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isImported(tt.p, tt.imports))
+		})
+	}
+}
@@ -61,6 +61,15 @@ func finalizeRelationships(resolver file.Resolver, builder sbomsync.Builder, cfg
 		s.Relationships = append(s.Relationships, newBinaryRelationships...)
 	})
 	builder.AddRelationships(newBinaryRelationships...)
+
+	// add relationships showing the packages providing the login shells of user accounts and the programs run by
+	// scheduled tasks and network services (package-to-package)
+	var hostInventoryRelationships []artifact.Relationship
+	accessor.ReadFromSBOM(func(s *sbom.SBOM) {
+		hostInventoryRelationships = append(relationship.AccountShells(s.Artifacts.Packages), relationship.ExecutableOwners(s.Artifacts.Packages)...)
+	})
+	builder.AddRelationships(hostInventoryRelationships...)
+
 	// add source "contains package" relationship (source-to-package)
 	var sourceRelationships []artifact.Relationship
 	accessor.ReadFromSBOM(func(s *sbom.SBOM) {
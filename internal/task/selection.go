@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/scylladb/go-set/strset"
 
 	"github.com/anchore/syft/internal/log"
@@ -53,17 +54,24 @@ func newSelection() Selection {
 // represent all other operations. The parsed expressions are then evaluated against the given tasks to return
 // a subset (or the same) set of tasks.
 func Select(allTasks []Task, selectionRequest pkgcataloging.SelectionRequest) ([]Task, Selection, error) {
-	nodes := newExpressionsFromSelectionRequest(newExpressionContext(allTasks), selectionRequest)
+	ec := newExpressionContext(allTasks)
+	tagErrs := ec.addUserTags(allTasks, selectionRequest.Tags)
 
-	finalTasks, selection := selectByExpressions(allTasks, nodes)
+	nodes := newExpressionsFromSelectionRequest(ec, selectionRequest)
+
+	finalTasks, selection := selectByExpressions(allTasks, nodes, ec.UserTags)
 
 	selection.Request = selectionRequest
 
+	if len(tagErrs) > 0 {
+		return finalTasks, selection, multierror.Append(nodes.Validate(), tagErrs...)
+	}
+
 	return finalTasks, selection, nodes.Validate()
 }
 
-// selectByExpressions the set of tasks to run based on the given expression(s).
-func selectByExpressions(ts tasks, nodes Expressions) (tasks, Selection) {
+// selectByExpressions the set of tasks to run based on the given expression(s) (and any user-defined tags by task name).
+func selectByExpressions(ts tasks, nodes Expressions, userTags map[string]*strset.Set) (tasks, Selection) {
 	if len(nodes) == 0 {
 		return ts, newSelection()
 	}
@@ -82,7 +90,7 @@ func selectByExpressions(ts tasks, nodes Expressions) (tasks, Selection) {
 		if len(node.Errors) > 0 {
 			continue
 		}
-		selectedTasks, selections := evaluateExpression(ts, node)
+		selectedTasks, selections := evaluateExpression(ts, node, userTags)
 
 		for name, ss := range selections {
 			if selection, exists := allSelections[name]; exists {
@@ -125,12 +133,12 @@ func selectByExpressions(ts tasks, nodes Expressions) (tasks, Selection) {
 
 // evaluateExpression returns the set of tasks that match the given expression (as well as all tokens that were matched
 // on to reach the returned conclusion).
-func evaluateExpression(ts tasks, node Expression) ([]Task, map[string]TokenSelection) {
+func evaluateExpression(ts tasks, node Expression, userTags map[string]*strset.Set) ([]Task, map[string]TokenSelection) {
 	selection := make(map[string]TokenSelection)
 	var finalTasks []Task
 
 	for _, t := range ts {
-		if !isSelected(t, node.Operand) {
+		if !isSelected(t, node.Operand, userTags[t.Name()]) {
 			continue
 		}
 
@@ -154,8 +162,19 @@ func evaluateExpression(ts tasks, node Expression) ([]Task, map[string]TokenSele
 	return finalTasks, selection
 }
 
-// isSelected returns true if the given task matches the given token. If the token is "all" then the task is always selected.
-func isSelected(td Task, token string) bool {
+// isSelected returns true if the given task matches all terms within the given operand (considering the given
+// user-defined tags for the task). If a term is "all" then the task always matches the term.
+func isSelected(td Task, operand string, userTags *strset.Set) bool {
+	for _, t := range parseTerms(operand) {
+		if matchesToken(td, t.Token, userTags) == t.Negated {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesToken returns true if the given task has the given token as a name or tag.
+func matchesToken(td Task, token string, userTags *strset.Set) bool {
 	if token == "all" {
 		return true
 	}
@@ -167,6 +186,10 @@ func isSelected(td Task, token string) bool {
 		}
 	}
 
+	if userTags != nil && userTags.Has(token) {
+		return true
+	}
+
 	// only do exact name matching
 	if td.Name() == token {
 		return true
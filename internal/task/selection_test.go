@@ -70,6 +70,7 @@ func TestSelect(t *testing.T) {
 		allTasks    []Task
 		basis       []string
 		expressions []string
+		tags        map[string][]string
 		wantNames   []string
 		wantTokens  map[string]TokenSelection
 		wantRequest pkgcataloging.SelectionRequest
@@ -194,7 +195,7 @@ func TestSelect(t *testing.T) {
 				"-dpkg",
 				"os",
 				// invalid...
-				"+python",
+				"+bogus",
 				"rust-cargo-lock-cataloger",
 			},
 			wantNames: []string{
@@ -232,7 +233,7 @@ func TestSelect(t *testing.T) {
 				DefaultNamesOrTags: []string{"image"},
 				SubSelectTags:      []string{"os", "rust-cargo-lock-cataloger"},
 				RemoveNamesOrTags:  []string{"dpkg"},
-				AddNames:           []string{"github-actions-usage-cataloger", "python"},
+				AddNames:           []string{"github-actions-usage-cataloger", "bogus"},
 			},
 			wantErr: assert.Error, // !important!
 		},
@@ -323,6 +324,182 @@ func TestSelect(t *testing.T) {
 				DefaultNamesOrTags: []string{"gemspec", "python"},
 			},
 		},
+		{
+			name:     "intersection and negation expressions",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"image",
+			},
+			expressions: []string{
+				"os&!rpm",
+				"-apk",
+				"+binary&declared",
+			},
+			wantNames: []string{
+				"alpm-db-cataloger",
+				"dpkg-db-cataloger",
+				"portage-cataloger",
+				"binary-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				// selected
+				"alpm-db-cataloger": newTokenSelection([]string{"image", "os&!rpm"}, nil),
+				"dpkg-db-cataloger": newTokenSelection([]string{"image", "os&!rpm"}, nil),
+				"portage-cataloger": newTokenSelection([]string{"image", "os&!rpm"}, nil),
+				"binary-cataloger":  newTokenSelection([]string{"image", "binary&declared"}, nil),
+
+				// ultimately not selected
+				"apk-db-cataloger":                     newTokenSelection([]string{"image", "os&!rpm"}, []string{"apk"}),
+				"rpm-db-cataloger":                     newTokenSelection([]string{"image"}, nil),
+				"conan-info-cataloger":                 newTokenSelection([]string{"image"}, nil),
+				"javascript-package-cataloger":         newTokenSelection([]string{"image"}, nil),
+				"php-composer-installed-cataloger":     newTokenSelection([]string{"image"}, nil),
+				"ruby-installed-gemspec-cataloger":     newTokenSelection([]string{"image"}, nil),
+				"rust-cargo-lock-cataloger":            newTokenSelection([]string{"image"}, nil),
+				"dotnet-portable-executable-cataloger": newTokenSelection([]string{"image"}, nil),
+				"python-installed-package-cataloger":   newTokenSelection([]string{"image"}, nil),
+				"go-module-binary-cataloger":           newTokenSelection([]string{"image"}, nil),
+				"java-archive-cataloger":               newTokenSelection([]string{"image"}, nil),
+				"graalvm-native-image-cataloger":       newTokenSelection([]string{"image"}, nil),
+				"sbom-cataloger":                       newTokenSelection([]string{"image"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"image"},
+				SubSelectTags:      []string{"os&!rpm"},
+				RemoveNamesOrTags:  []string{"apk"},
+				AddNames:           []string{"binary&declared"},
+			},
+		},
+		{
+			name:     "user-defined tags",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"mine",
+			},
+			expressions: []string{
+				"mine&!graalvm-native-image-cataloger",
+			},
+			tags: map[string][]string{
+				"mine": {"java", "python-installed-package-cataloger"},
+			},
+			wantNames: []string{
+				"python-installed-package-cataloger",
+				"java-archive-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				"python-installed-package-cataloger": newTokenSelection([]string{"mine", "mine&!graalvm-native-image-cataloger"}, nil),
+				"java-archive-cataloger":             newTokenSelection([]string{"mine", "mine&!graalvm-native-image-cataloger"}, nil),
+				"graalvm-native-image-cataloger":     newTokenSelection([]string{"mine"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"mine"},
+				SubSelectTags:      []string{"mine&!graalvm-native-image-cataloger"},
+				Tags: map[string][]string{
+					"mine": {"java", "python-installed-package-cataloger"},
+				},
+			},
+		},
+		{
+			name:     "invalid user-defined tags",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"python",
+			},
+			tags: map[string][]string{
+				"java-archive-cataloger": {"java"},
+				"mine":                   {"bogus"},
+			},
+			wantNames: []string{
+				"python-installed-package-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				"python-installed-package-cataloger": newTokenSelection([]string{"python"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"python"},
+				Tags: map[string][]string{
+					"java-archive-cataloger": {"java"},
+					"mine":                   {"bogus"},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name:     "nested user-defined tags",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"mine",
+			},
+			tags: map[string][]string{
+				"mine":    {"my-java", "python-installed-package-cataloger"},
+				"my-java": {"java-archive-cataloger"},
+			},
+			wantNames: []string{
+				"python-installed-package-cataloger",
+				"java-archive-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				"python-installed-package-cataloger": newTokenSelection([]string{"mine"}, nil),
+				"java-archive-cataloger":             newTokenSelection([]string{"mine"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"mine"},
+				Tags: map[string][]string{
+					"mine":    {"my-java", "python-installed-package-cataloger"},
+					"my-java": {"java-archive-cataloger"},
+				},
+			},
+		},
+		{
+			name:     "cyclic user-defined tags",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"python",
+			},
+			tags: map[string][]string{
+				"mine":  {"yours", "java"},
+				"yours": {"mine"},
+			},
+			wantNames: []string{
+				"python-installed-package-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				"python-installed-package-cataloger": newTokenSelection([]string{"python"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"python"},
+				Tags: map[string][]string{
+					"mine":  {"yours", "java"},
+					"yours": {"mine"},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name:     "user-defined tags cannot shadow built-in tags",
+			allTasks: createDummyTasks(),
+			basis: []string{
+				"python",
+			},
+			tags: map[string][]string{
+				"all":  {"python"},
+				"java": {"python"},
+			},
+			wantNames: []string{
+				"python-installed-package-cataloger",
+			},
+			wantTokens: map[string]TokenSelection{
+				"python-installed-package-cataloger": newTokenSelection([]string{"python"}, nil),
+			},
+			wantRequest: pkgcataloging.SelectionRequest{
+				DefaultNamesOrTags: []string{"python"},
+				Tags: map[string][]string{
+					"all":  {"python"},
+					"java": {"python"},
+				},
+			},
+			wantErr: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -331,6 +508,9 @@ func TestSelect(t *testing.T) {
 			}
 
 			req := pkgcataloging.NewSelectionRequest().WithDefaults(tt.basis...).WithExpression(tt.expressions...)
+			for tag, nameOrTags := range tt.tags {
+				req = req.WithTags(tag, nameOrTags...)
+			}
 
 			got, gotEvidence, err := Select(tt.allTasks, req)
 			tt.wantErr(t, err)
@@ -0,0 +1,98 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/go-version"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/sbomsync"
+	"github.com/anchore/syft/internal/supplychain"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// supplyChainEcosystems maps package types to the ecosystems of the public registries they are published to.
+var supplyChainEcosystems = map[pkg.Type]string{
+	pkg.DotnetPkg: "nuget",
+	pkg.GemPkg:    "gem",
+	pkg.NpmPkg:    "npm",
+	pkg.PythonPkg: "pypi",
+	pkg.RustPkg:   "cargo",
+}
+
+// NewSupplyChainTask returns a task that annotates packages that may be typosquats of popular packages, as well as
+// internal packages that are prone to dependency confusion. No task is returned when disabled.
+func NewSupplyChainTask(cfg cataloging.SupplyChainConfig) Task {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	fn := func(_ context.Context, _ file.Resolver, builder sbomsync.Builder) error {
+		dataset, err := supplychain.NewDataset(cfg.DatasetPath)
+		if err != nil {
+			return fmt.Errorf("unable to load popular package dataset: %w", err)
+		}
+
+		builder.(sbomsync.Accessor).WriteToSBOM(func(s *sbom.SBOM) {
+			annotateSupplyChain(s.Artifacts.Packages, dataset)
+		})
+		return nil
+	}
+
+	return NewTask("supply-chain-cataloger", fn)
+}
+
+func annotateSupplyChain(pkgs *pkg.Collection, dataset *supplychain.Dataset) {
+	annotatePackageLocations(pkgs, func(p pkg.Package) locationAnnotator {
+		ecosystem, ok := supplyChainEcosystems[p.Type]
+		if !ok {
+			return nil
+		}
+
+		annotations := make(map[string]string)
+		if popular := dataset.NearMiss(ecosystem, p.Name); popular != "" {
+			log.WithFields("package", p.Name, "version", p.Version, "popular", popular).Warn("package name is a near-miss of a popular package (possible typosquat)")
+			annotations[pkg.TyposquatAnnotationKey] = popular
+		}
+		if public := publicVersion(p, ecosystem, dataset); public != "" {
+			log.WithFields("package", p.Name, "version", p.Version, "public", public).Warn("internal package exists on a public registry with a higher version (possible dependency confusion)")
+			annotations[pkg.DependencyConfusionAnnotationKey] = public
+		}
+		if len(annotations) == 0 {
+			return nil
+		}
+		return withAnnotations(annotations)
+	})
+}
+
+// publicVersion returns the version of an internal package on the public registry of the ecosystem when it is higher
+// than the version of the internal package (or an empty string otherwise). Packages are only considered internal when
+// classified as such by the ownership heuristics, and versions that cannot be compared are not considered.
+func publicVersion(p pkg.Package, ecosystem string, dataset *supplychain.Dataset) string {
+	if pkg.Ownership(p) != pkg.InternalOwnership {
+		return ""
+	}
+
+	latest, ok := dataset.Latest(ecosystem, p.Name)
+	if !ok {
+		return ""
+	}
+
+	public, err := version.NewVersion(latest)
+	if err != nil {
+		return ""
+	}
+	internal, err := version.NewVersion(p.Version)
+	if err != nil {
+		log.WithFields("package", p.Name, "version", p.Version, "error", err).Trace("unable to compare version with the public registry")
+		return ""
+	}
+
+	if !public.GreaterThan(internal) {
+		return ""
+	}
+	return latest
+}
@@ -0,0 +1,60 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/internal/supplychain"
+	"github.com/anchore/syft/syft/cataloging"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func TestNewSupplyChainTask(t *testing.T) {
+	assert.Nil(t, NewSupplyChainTask(cataloging.DefaultSupplyChainConfig()), "disabled")
+	assert.NotNil(t, NewSupplyChainTask(cataloging.DefaultSupplyChainConfig().WithEnabled(true)))
+}
+
+func Test_annotateSupplyChain(t *testing.T) {
+	dataset, err := supplychain.NewDataset("")
+	require.NoError(t, err)
+
+	newPackage := func(p pkg.Package, path, ownership string) pkg.Package {
+		l := file.NewLocation(path)
+		if ownership != "" {
+			l = l.WithAnnotation(pkg.OwnershipAnnotationKey, ownership)
+		}
+		p.Locations = file.NewLocationSet(l)
+		p.SetID()
+		return p
+	}
+
+	typosquat := newPackage(pkg.Package{Name: "reqeusts", Version: "1.0.0", Type: pkg.PythonPkg}, "/app/requirements.txt", "")
+	popular := newPackage(pkg.Package{Name: "requests", Version: "2.31.0", Type: pkg.PythonPkg}, "/app/requirements.txt", "")
+	confused := newPackage(pkg.Package{Name: "lodash", Version: "1.0.0", Type: pkg.NpmPkg}, "/app/package-lock.json", pkg.InternalOwnership)
+	current := newPackage(pkg.Package{Name: "axios", Version: "99.0.0", Type: pkg.NpmPkg}, "/app/package-lock.json", pkg.InternalOwnership)
+	thirdParty := newPackage(pkg.Package{Name: "express", Version: "4.0.0", Type: pkg.NpmPkg}, "/app/package-lock.json", pkg.ThirdPartyOwnership)
+	unsupported := newPackage(pkg.Package{Name: "lodahs", Version: "1.0.0", Type: pkg.JavaPkg}, "/app/app.jar", "")
+
+	pkgs := pkg.NewCollection(typosquat, popular, confused, current, thirdParty, unsupported)
+	annotateSupplyChain(pkgs, dataset)
+
+	got := func(p pkg.Package) pkg.Package {
+		found := pkgs.Package(p.ID())
+		require.NotNil(t, found, "package ID must not change")
+		return *found
+	}
+
+	assert.Equal(t, "requests", pkg.TyposquatOf(got(typosquat)))
+	assert.Empty(t, pkg.TyposquatOf(got(popular)))
+	assert.Empty(t, pkg.DependencyConfusion(got(popular)), "only internal packages are prone to dependency confusion")
+
+	assert.Equal(t, "4.17.21", pkg.DependencyConfusion(got(confused)))
+	assert.Equal(t, pkg.InternalOwnership, pkg.Ownership(got(confused)))
+	assert.Empty(t, pkg.DependencyConfusion(got(current)), "the public version is not higher")
+	assert.Empty(t, pkg.DependencyConfusion(got(thirdParty)))
+
+	assert.Empty(t, pkg.TyposquatOf(got(unsupported)), "no public registry dataset for the ecosystem")
+}
@@ -0,0 +1,194 @@
+/*
+Package buildkit implements the BuildKit SBOM scanner protocol, allowing for an SBOM attestation to be generated by this
+library during an image build (including for the snapshots of intermediate build stages) instead of scanning the final
+image after the fact. BuildKit runs the scanner as a generator image, for example:
+
+	docker buildx build --attest type=sbom,generator=<scanner-image> .
+
+BuildKit mounts the root filesystem of the image being built (and any stages marked with BUILDKIT_SBOM_SCAN_STAGE) into
+the scanner, and collects the in-toto statements the scanner writes to the destination directory.
+*/
+package buildkit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+const (
+	// SourceEnvVar is the path to the root filesystem of the image being built.
+	SourceEnvVar = "BUILDKIT_SCAN_SOURCE"
+
+	// SourceExtrasEnvVar is the path to a directory containing the root filesystems of additional build stages to scan
+	// (one directory per stage).
+	SourceExtrasEnvVar = "BUILDKIT_SCAN_SOURCE_EXTRAS"
+
+	// DestinationEnvVar is the path to the directory that the SBOM attestations should be written to.
+	DestinationEnvVar = "BUILDKIT_SCAN_DESTINATION"
+)
+
+// Target is a single root filesystem to catalog, either the final image or the snapshot of a build stage.
+type Target struct {
+	// Name is the name of the build stage, which is empty for the final image.
+	Name string
+
+	// Path is where the root filesystem is mounted.
+	Path string
+}
+
+// Config is the configuration for a single BuildKit scan.
+type Config struct {
+	// Source is the path to the root filesystem of the image being built.
+	Source string
+
+	// SourceExtras is the (optional) path to the directory containing the root filesystems of additional stages.
+	SourceExtras string
+
+	// Destination is the path to the directory to write SBOM attestations to.
+	Destination string
+
+	// SBOM is the configuration used to catalog each target (when nil the default image cataloging configuration is used).
+	SBOM *syft.CreateSBOMConfig
+
+	// BeforeExport is an (optional) hook that is called with the SBOM for each target before it is written, allowing
+	// for callers to add to or amend the SBOM contents (e.g. with build information only known to the frontend).
+	BeforeExport func(Target, *sbom.SBOM) error
+}
+
+// ConfigFromEnvironment returns the scan configuration provided by BuildKit through environment variables.
+func ConfigFromEnvironment() (Config, error) {
+	cfg := Config{
+		Source:       os.Getenv(SourceEnvVar),
+		SourceExtras: os.Getenv(SourceExtrasEnvVar),
+		Destination:  os.Getenv(DestinationEnvVar),
+	}
+
+	if cfg.Source == "" {
+		return cfg, fmt.Errorf("%s is not set (is this running as a BuildKit SBOM generator?)", SourceEnvVar)
+	}
+	if cfg.Destination == "" {
+		return cfg, fmt.Errorf("%s is not set (is this running as a BuildKit SBOM generator?)", DestinationEnvVar)
+	}
+
+	return cfg, nil
+}
+
+// DefaultSBOMConfig returns the cataloging configuration used for each target when none is provided. Since each
+// target is the root filesystem of an image (not a project directory) the image cataloger selection is used.
+func DefaultSBOMConfig() *syft.CreateSBOMConfig {
+	return syft.DefaultCreateSBOMConfig().
+		WithCatalogerSelection(
+			pkgcataloging.NewSelectionRequest().WithDefaults(pkgcataloging.ImageTag),
+		)
+}
+
+// Targets returns the final image root filesystem followed by the root filesystem of each additional stage (sorted by
+// stage name).
+func (c Config) Targets() ([]Target, error) {
+	targets := []Target{{Path: c.Source}}
+
+	if c.SourceExtras == "" {
+		return targets, nil
+	}
+
+	entries, err := os.ReadDir(c.SourceExtras)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return targets, nil
+		}
+		return nil, fmt.Errorf("unable to read additional scan sources: %w", err)
+	}
+
+	var extras []Target
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		extras = append(extras, Target{
+			Name: entry.Name(),
+			Path: filepath.Join(c.SourceExtras, entry.Name()),
+		})
+	}
+
+	sort.Slice(extras, func(i, j int) bool {
+		return extras[i].Name < extras[j].Name
+	})
+
+	return append(targets, extras...), nil
+}
+
+// Scan catalogs the final image and each additional stage, writing an SBOM attestation for each to the destination.
+func Scan(ctx context.Context, cfg Config) error {
+	targets, err := cfg.Targets()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cfg.Destination, 0o755); err != nil {
+		return fmt.Errorf("unable to create destination directory: %w", err)
+	}
+
+	sbomCfg := cfg.SBOM
+	if sbomCfg == nil {
+		sbomCfg = DefaultSBOMConfig()
+	}
+
+	for _, target := range targets {
+		s, err := catalogTarget(ctx, target, sbomCfg)
+		if err != nil {
+			return fmt.Errorf("unable to catalog %s: %w", target, err)
+		}
+
+		if cfg.BeforeExport != nil {
+			if err := cfg.BeforeExport(target, s); err != nil {
+				return fmt.Errorf("unable to prepare SBOM for %s: %w", target, err)
+			}
+		}
+
+		if err := writeStatement(filepath.Join(cfg.Destination, target.filename()), *s); err != nil {
+			return fmt.Errorf("unable to write SBOM attestation for %s: %w", target, err)
+		}
+	}
+
+	return nil
+}
+
+func catalogTarget(ctx context.Context, target Target, cfg *syft.CreateSBOMConfig) (*sbom.SBOM, error) {
+	// the target path is treated as the root of the filesystem so that all paths are reported as they are within the
+	// image (and so that absolute symlinks are resolved within the image)
+	src, err := directorysource.New(directorysource.Config{
+		Path:  target.Path,
+		Base:  target.Path,
+		Alias: source.Alias{Name: target.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	return syft.CreateSBOM(ctx, src, cfg)
+}
+
+// filename is the name of the attestation file written for the target.
+func (t Target) filename() string {
+	if t.Name == "" {
+		return "sbom.spdx.json"
+	}
+	return fmt.Sprintf("sbom-%s.spdx.json", t.Name)
+}
+
+func (t Target) String() string {
+	if t.Name == "" {
+		return "image root filesystem"
+	}
+	return fmt.Sprintf("stage %q root filesystem", t.Name)
+}
@@ -0,0 +1,109 @@
+package buildkit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func TestConfigFromEnvironment(t *testing.T) {
+	t.Setenv(SourceEnvVar, "/run/src")
+	t.Setenv(SourceExtrasEnvVar, "/run/extras")
+	t.Setenv(DestinationEnvVar, "/run/out")
+
+	cfg, err := ConfigFromEnvironment()
+	require.NoError(t, err)
+	assert.Equal(t, "/run/src", cfg.Source)
+	assert.Equal(t, "/run/extras", cfg.SourceExtras)
+	assert.Equal(t, "/run/out", cfg.Destination)
+
+	t.Setenv(DestinationEnvVar, "")
+	_, err = ConfigFromEnvironment()
+	assert.ErrorContains(t, err, DestinationEnvVar)
+}
+
+func TestConfig_Targets(t *testing.T) {
+	cfg := Config{
+		Source:       "test-fixtures/rootfs",
+		SourceExtras: "test-fixtures/extras",
+	}
+
+	targets, err := cfg.Targets()
+	require.NoError(t, err)
+	assert.Equal(t, []Target{
+		{Path: "test-fixtures/rootfs"},
+		{Name: "build", Path: "test-fixtures/extras/build"},
+	}, targets)
+
+	cfg.SourceExtras = "test-fixtures/does-not-exist"
+	targets, err = cfg.Targets()
+	require.NoError(t, err)
+	assert.Len(t, targets, 1)
+}
+
+func TestScan(t *testing.T) {
+	dest := t.TempDir()
+
+	var hooked []string
+	cfg := Config{
+		Source:       "test-fixtures/rootfs",
+		SourceExtras: "test-fixtures/extras",
+		Destination:  dest,
+		BeforeExport: func(target Target, _ *sbom.SBOM) error {
+			hooked = append(hooked, target.Name)
+			return nil
+		},
+	}
+
+	require.NoError(t, Scan(context.Background(), cfg))
+	assert.Equal(t, []string{"", "build"}, hooked)
+
+	tests := []struct {
+		filename string
+		expected []string
+	}{
+		{
+			filename: "sbom.spdx.json",
+			expected: []string{"musl", "test-fixtures/rootfs"},
+		},
+		{
+			filename: "sbom-build.spdx.json",
+			// note: the stage name is used to describe the stage root filesystem
+			expected: []string{"build", "gcc", "musl"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.filename, func(t *testing.T) {
+			contents, err := os.ReadFile(filepath.Join(dest, test.filename))
+			require.NoError(t, err)
+
+			var statement Statement
+			require.NoError(t, json.Unmarshal(contents, &statement))
+			assert.Equal(t, InTotoStatementType, statement.Type)
+			assert.Equal(t, SPDXPredicateType, statement.PredicateType)
+
+			var doc struct {
+				Packages []struct {
+					Name string `json:"name"`
+				} `json:"packages"`
+			}
+			require.NoError(t, json.Unmarshal(statement.Predicate, &doc))
+
+			var names []string
+			for _, p := range doc.Packages {
+				names = append(names, p.Name)
+			}
+			sort.Strings(names)
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package buildkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+const (
+	// InTotoStatementType is the in-toto statement type of the written attestations.
+	InTotoStatementType = "https://in-toto.io/Statement/v0.1"
+
+	// SPDXPredicateType is the predicate type of the written attestations.
+	SPDXPredicateType = "https://spdx.dev/Document"
+)
+
+// Statement is an in-toto statement with an SBOM predicate. The subject is not included since BuildKit fills in the
+// subject with the image being built when collecting the attestation.
+type Statement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// NewStatement returns an in-toto statement with the given SBOM (encoded as SPDX JSON) as the predicate.
+func NewStatement(s sbom.SBOM) (*Statement, error) {
+	encoder, err := spdxjson.NewFormatEncoderWithConfig(spdxjson.DefaultEncoderConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	predicate, err := format.Encode(s, encoder)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode SBOM: %w", err)
+	}
+
+	return &Statement{
+		Type:          InTotoStatementType,
+		PredicateType: SPDXPredicateType,
+		Predicate:     bytes.TrimSpace(predicate),
+	}, nil
+}
+
+func writeStatement(path string, s sbom.SBOM) error {
+	statement, err := NewStatement(s)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.Marshal(statement)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, contents, 0o644) //nolint:gosec // attestations are read back by BuildKit and are not sensitive
+}
@@ -0,0 +1,23 @@
+package cataloging
+
+// ClassificationRule assigns a user-defined classification (e.g. "first-party" or "vendored") to everything found
+// under any of the given paths. Paths may be directories (which match everything beneath them) or glob patterns.
+type ClassificationRule struct {
+	Classification string   `yaml:"classification" json:"classification" mapstructure:"classification"`
+	Paths          []string `yaml:"paths" json:"paths" mapstructure:"paths"`
+}
+
+type ClassificationConfig struct {
+	// Rules are applied to the locations of every package found; matching locations are annotated with the
+	// classification of every rule that matches.
+	Rules []ClassificationRule `yaml:"rules" json:"rules" mapstructure:"rules"`
+}
+
+func DefaultClassificationConfig() ClassificationConfig {
+	return ClassificationConfig{}
+}
+
+func (c ClassificationConfig) WithRules(rules ...ClassificationRule) ClassificationConfig {
+	c.Rules = rules
+	return c
+}
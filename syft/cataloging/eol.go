@@ -0,0 +1,39 @@
+package cataloging
+
+const defaultEOLAPIURL = "https://endoflife.date/api"
+
+type EOLConfig struct {
+	// Enabled enables annotating the linux distribution and major runtime packages (e.g. python, nodejs) with when
+	// their release cycle reaches its end of life, using the dataset vendored within syft.
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+
+	// SearchRemote enables updating the vendored dataset from the endoflife.date compatible API at APIURL. Responses
+	// are cached across runs.
+	SearchRemote bool `yaml:"search-remote" json:"search-remote" mapstructure:"search-remote"`
+
+	// APIURL is the base URL of the endoflife.date compatible API.
+	APIURL string `yaml:"api-url" json:"api-url" mapstructure:"api-url"`
+}
+
+func DefaultEOLConfig() EOLConfig {
+	return EOLConfig{
+		Enabled:      false,
+		SearchRemote: false,
+		APIURL:       defaultEOLAPIURL,
+	}
+}
+
+func (c EOLConfig) WithEnabled(enabled bool) EOLConfig {
+	c.Enabled = enabled
+	return c
+}
+
+func (c EOLConfig) WithSearchRemote(enabled bool) EOLConfig {
+	c.SearchRemote = enabled
+	return c
+}
+
+func (c EOLConfig) WithAPIURL(url string) EOLConfig {
+	c.APIURL = url
+	return c
+}
@@ -11,13 +11,17 @@ import (
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/file/cataloger/executable"
 	"github.com/anchore/syft/syft/file/cataloger/filecontent"
+	"github.com/anchore/syft/syft/file/cataloger/keymaterial"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
 )
 
 type Config struct {
-	Selection  file.Selection     `yaml:"selection" json:"selection" mapstructure:"selection"`
-	Hashers    []crypto.Hash      `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
-	Content    filecontent.Config `yaml:"content" json:"content" mapstructure:"content"`
-	Executable executable.Config  `yaml:"executable" json:"executable" mapstructure:"executable"`
+	Selection   file.Selection     `yaml:"selection" json:"selection" mapstructure:"selection"`
+	Hashers     []crypto.Hash      `yaml:"hashers" json:"hashers" mapstructure:"hashers"`
+	Content     filecontent.Config `yaml:"content" json:"content" mapstructure:"content"`
+	Executable  executable.Config  `yaml:"executable" json:"executable" mapstructure:"executable"`
+	KeyMaterial keymaterial.Config `yaml:"key-material" json:"key-material" mapstructure:"key-material"`
+	Snippets    snippet.Config     `yaml:"snippets" json:"snippets" mapstructure:"snippets"`
 }
 
 type configMarshaledForm struct {
@@ -84,3 +88,13 @@ func (cfg Config) WithContentConfig(content filecontent.Config) Config {
 	cfg.Content = content
 	return cfg
 }
+
+func (cfg Config) WithKeyMaterialConfig(keyMaterial keymaterial.Config) Config {
+	cfg.KeyMaterial = keyMaterial
+	return cfg
+}
+
+func (cfg Config) WithSnippetsConfig(snippets snippet.Config) Config {
+	cfg.Snippets = snippets
+	return cfg
+}
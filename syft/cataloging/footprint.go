@@ -0,0 +1,18 @@
+package cataloging
+
+type FootprintConfig struct {
+	// Enabled enables recording the installed size and number of files owned by each package (and the breakdown of
+	// these by container image layer).
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+}
+
+func DefaultFootprintConfig() FootprintConfig {
+	return FootprintConfig{
+		Enabled: false,
+	}
+}
+
+func (c FootprintConfig) WithEnabled(enabled bool) FootprintConfig {
+	c.Enabled = enabled
+	return c
+}
@@ -0,0 +1,48 @@
+package cataloging
+
+// OwnershipConfig describes the heuristics that identify internal (first-party) packages. When any heuristic is
+// configured, every package is classified as either internal (when any heuristic matches) or third-party.
+type OwnershipConfig struct {
+	// ModulePrefixes are prefixes of package names or module paths (e.g. "github.com/acme/") of internal packages.
+	ModulePrefixes []string `yaml:"module-prefixes" json:"module-prefixes" mapstructure:"module-prefixes"`
+
+	// GroupIDs are Java group IDs of internal packages, which also match any group beneath them (e.g. "com.acme"
+	// matches "com.acme.billing").
+	GroupIDs []string `yaml:"group-ids" json:"group-ids" mapstructure:"group-ids"`
+
+	// Scopes are npm scopes of internal packages (e.g. "@acme").
+	Scopes []string `yaml:"scopes" json:"scopes" mapstructure:"scopes"`
+
+	// RegistryHosts are hosts of internal registries and repositories (e.g. "npm.acme.internal"), which are matched
+	// against the URLs packages were resolved from (when known).
+	RegistryHosts []string `yaml:"registry-hosts" json:"registry-hosts" mapstructure:"registry-hosts"`
+}
+
+func DefaultOwnershipConfig() OwnershipConfig {
+	return OwnershipConfig{}
+}
+
+func (c OwnershipConfig) WithModulePrefixes(prefixes ...string) OwnershipConfig {
+	c.ModulePrefixes = prefixes
+	return c
+}
+
+func (c OwnershipConfig) WithGroupIDs(groupIDs ...string) OwnershipConfig {
+	c.GroupIDs = groupIDs
+	return c
+}
+
+func (c OwnershipConfig) WithScopes(scopes ...string) OwnershipConfig {
+	c.Scopes = scopes
+	return c
+}
+
+func (c OwnershipConfig) WithRegistryHosts(hosts ...string) OwnershipConfig {
+	c.RegistryHosts = hosts
+	return c
+}
+
+// IsEnabled indicates if any heuristic has been configured.
+func (c OwnershipConfig) IsEnabled() bool {
+	return len(c.ModulePrefixes) > 0 || len(c.GroupIDs) > 0 || len(c.Scopes) > 0 || len(c.RegistryHosts) > 0
+}
@@ -3,28 +3,34 @@ package pkgcataloging
 import (
 	"github.com/anchore/syft/syft/pkg/cataloger/binary"
 	"github.com/anchore/syft/syft/pkg/cataloger/golang"
+	"github.com/anchore/syft/syft/pkg/cataloger/helm"
 	"github.com/anchore/syft/syft/pkg/cataloger/java"
 	"github.com/anchore/syft/syft/pkg/cataloger/javascript"
 	"github.com/anchore/syft/syft/pkg/cataloger/kernel"
 	"github.com/anchore/syft/syft/pkg/cataloger/python"
+	"github.com/anchore/syft/syft/pkg/cataloger/snap"
 )
 
 type Config struct {
 	Binary      binary.ClassifierCatalogerConfig  `yaml:"binary" json:"binary" mapstructure:"binary"`
 	Golang      golang.CatalogerConfig            `yaml:"golang" json:"golang" mapstructure:"golang"`
+	Helm        helm.CatalogerConfig              `yaml:"helm" json:"helm" mapstructure:"helm"`
 	JavaArchive java.ArchiveCatalogerConfig       `yaml:"java-archive" json:"java-archive" mapstructure:"java-archive"`
 	JavaScript  javascript.CatalogerConfig        `yaml:"javascript" json:"javascript" mapstructure:"javascript"`
 	LinuxKernel kernel.LinuxKernelCatalogerConfig `yaml:"linux-kernel" json:"linux-kernel" mapstructure:"linux-kernel"`
 	Python      python.CatalogerConfig            `yaml:"python" json:"python" mapstructure:"python"`
+	Snap        snap.CatalogerConfig              `yaml:"snap" json:"snap" mapstructure:"snap"`
 }
 
 func DefaultConfig() Config {
 	return Config{
 		Binary:      binary.DefaultClassifierCatalogerConfig(),
 		Golang:      golang.DefaultCatalogerConfig(),
+		Helm:        helm.DefaultCatalogerConfig(),
 		LinuxKernel: kernel.DefaultLinuxKernelCatalogerConfig(),
 		Python:      python.DefaultCatalogerConfig(),
 		JavaArchive: java.DefaultArchiveCatalogerConfig(),
+		Snap:        snap.DefaultCatalogerConfig(),
 	}
 }
 
@@ -38,6 +44,11 @@ func (c Config) WithGolangConfig(cfg golang.CatalogerConfig) Config {
 	return c
 }
 
+func (c Config) WithHelmConfig(cfg helm.CatalogerConfig) Config {
+	c.Helm = cfg
+	return c
+}
+
 func (c Config) WithJavascriptConfig(cfg javascript.CatalogerConfig) Config {
 	c.JavaScript = cfg
 	return c
@@ -57,3 +68,8 @@ func (c Config) WithJavaArchiveConfig(cfg java.ArchiveCatalogerConfig) Config {
 	c.JavaArchive = cfg
 	return c
 }
+
+func (c Config) WithSnapConfig(cfg snap.CatalogerConfig) Config {
+	c.Snap = cfg
+	return c
+}
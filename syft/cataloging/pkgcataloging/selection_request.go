@@ -9,6 +9,9 @@ type SelectionRequest struct {
 	SubSelectTags      []string `json:"selection,omitempty"`
 	AddNames           []string `json:"addition,omitempty"`
 	RemoveNamesOrTags  []string `json:"removal,omitempty"`
+
+	// Tags are user-defined tags (by tag) that apply to all catalogers matching any of the given names or tags.
+	Tags map[string][]string `json:"tags,omitempty"`
 }
 
 func NewSelectionRequest() SelectionRequest {
@@ -50,6 +53,18 @@ func (s SelectionRequest) WithRemovals(nameOrTags ...string) SelectionRequest {
 	return s
 }
 
+// WithTags defines a new tag that applies to all catalogers matching any of the given names or tags. The tag may then
+// be used within any expression like any other tag.
+func (s SelectionRequest) WithTags(tag string, nameOrTags ...string) SelectionRequest {
+	tags := make(map[string][]string, len(s.Tags)+1)
+	for k, v := range s.Tags {
+		tags[k] = v
+	}
+	tags[tag] = append(tags[tag], nameOrTags...)
+	s.Tags = tags
+	return s
+}
+
 func cleanSelection(tags []string) []string {
 	var cleaned []string
 	for _, tag := range tags {
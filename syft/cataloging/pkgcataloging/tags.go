@@ -21,4 +21,7 @@ const (
 
 	// LanguageTag should be used to identify catalogers that cataloging language-specific packages.
 	LanguageTag = "language"
+
+	// BinaryTag should be used to identify catalogers that catalog packages from binary files.
+	BinaryTag = "binary"
 )
@@ -0,0 +1,28 @@
+package cataloging
+
+type ReachabilityConfig struct {
+	// EntrypointClosure enables annotating the packages with files that are reachable from the entrypoint (or cmd) of
+	// a container image, following shared libraries and script interpreters.
+	EntrypointClosure bool `yaml:"entrypoint-closure" json:"entrypoint-closure" mapstructure:"entrypoint-closure"`
+
+	// Imports enables annotating the packages that are imported by first-party Python, JavaScript, and Ruby source
+	// files, as found by best-effort static analysis of the imports within each file.
+	Imports bool `yaml:"imports" json:"imports" mapstructure:"imports"`
+}
+
+func DefaultReachabilityConfig() ReachabilityConfig {
+	return ReachabilityConfig{
+		EntrypointClosure: false,
+		Imports:           false,
+	}
+}
+
+func (c ReachabilityConfig) WithEntrypointClosure(enabled bool) ReachabilityConfig {
+	c.EntrypointClosure = enabled
+	return c
+}
+
+func (c ReachabilityConfig) WithImports(enabled bool) ReachabilityConfig {
+	c.Imports = enabled
+	return c
+}
@@ -4,6 +4,30 @@ import "github.com/anchore/syft/syft/source"
 
 type SearchConfig struct {
 	Scope source.Scope `yaml:"scope" json:"scope" mapstructure:"scope"`
+	Gates SearchGates  `yaml:"gates" json:"gates" mapstructure:"gates"`
+}
+
+// SearchGates restricts which paths each group of package catalogers is able to search. This is useful for reducing
+// scan times on sources where full coverage is not needed (e.g. only running binary catalogers under /usr and /opt).
+type SearchGates struct {
+	// OS applies to all catalogers tagged with "os"
+	OS PathGate `yaml:"os" json:"os" mapstructure:"os"`
+
+	// Language applies to all catalogers tagged with "language"
+	Language PathGate `yaml:"language" json:"language" mapstructure:"language"`
+
+	// Binary applies to all catalogers tagged with "binary"
+	Binary PathGate `yaml:"binary" json:"binary" mapstructure:"binary"`
+}
+
+// PathGate describes the set of paths that are visible to a cataloger. Patterns are globs (supporting "**") and
+// always apply to any paths nested under a matching directory as well.
+type PathGate struct {
+	// Include is the set of patterns that a path must match at least one of to be visible (when empty all paths are included).
+	Include []string `yaml:"include" json:"include" mapstructure:"include"`
+
+	// Exclude is the set of patterns that hide a path when matched (takes precedence over Include).
+	Exclude []string `yaml:"exclude" json:"exclude" mapstructure:"exclude"`
 }
 
 func DefaultSearchConfig() SearchConfig {
@@ -16,3 +40,13 @@ func (c SearchConfig) WithScope(scope source.Scope) SearchConfig {
 	c.Scope = scope
 	return c
 }
+
+func (c SearchConfig) WithGates(gates SearchGates) SearchConfig {
+	c.Gates = gates
+	return c
+}
+
+// IsEmpty indicates if the gate does not restrict any paths.
+func (g PathGate) IsEmpty() bool {
+	return len(g.Include) == 0 && len(g.Exclude) == 0
+}
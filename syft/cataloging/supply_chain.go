@@ -0,0 +1,29 @@
+package cataloging
+
+type SupplyChainConfig struct {
+	// Enabled enables flagging packages that may be typosquats of popular packages (names that are near-misses of
+	// popular package names) and internal packages that are prone to dependency confusion (names of internal packages
+	// that exist on public registries with a higher version), using the dataset of popular packages vendored within
+	// syft. Internal packages are identified by the ownership heuristics (see OwnershipConfig).
+	Enabled bool `yaml:"enabled" json:"enabled" mapstructure:"enabled"`
+
+	// DatasetPath is the path to a dataset of popular packages to use in place of the vendored dataset, mapping each
+	// ecosystem (e.g. "npm") to the latest version of each package by name.
+	DatasetPath string `yaml:"dataset-path" json:"dataset-path" mapstructure:"dataset-path"`
+}
+
+func DefaultSupplyChainConfig() SupplyChainConfig {
+	return SupplyChainConfig{
+		Enabled: false,
+	}
+}
+
+func (c SupplyChainConfig) WithEnabled(enabled bool) SupplyChainConfig {
+	c.Enabled = enabled
+	return c
+}
+
+func (c SupplyChainConfig) WithDatasetPath(path string) SupplyChainConfig {
+	c.DatasetPath = path
+	return c
+}
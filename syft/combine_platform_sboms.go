@@ -0,0 +1,201 @@
+package syft
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// CombinePlatformSBOMs combines the SBOMs created for several platforms of the same multi-platform image (manifest list
+// or OCI index) into a single SBOM. Each package is annotated with the platform of the image it was found in (see
+// pkg.Platform) and is given an ID unique to that platform, so that identical packages found within several platforms
+// are kept apart. The source of the combined SBOM describes the image without any platform-specific details.
+func CombinePlatformSBOMs(sboms ...*sbom.SBOM) (*sbom.SBOM, error) {
+	if len(sboms) == 0 {
+		return nil, fmt.Errorf("no SBOMs to combine")
+	}
+
+	platforms := make([]string, len(sboms))
+	for i, s := range sboms {
+		platform, err := sbomPlatform(s.Source)
+		if err != nil {
+			return nil, err
+		}
+		platforms[i] = platform
+	}
+
+	src, err := combinedPlatformSource(sboms)
+	if err != nil {
+		return nil, err
+	}
+	srcNode := sourceIDNode(src.ID)
+
+	out := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+		Source:     src,
+		Descriptor: sboms[0].Descriptor,
+	}
+
+	for i, s := range sboms {
+		packages, err := platformPackages(s, platforms[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range packages {
+			out.Artifacts.Packages.Add(p)
+		}
+
+		for id, footprint := range s.Artifacts.PackageFootprints {
+			p, ok := packages[id]
+			if !ok {
+				continue
+			}
+			if out.Artifacts.PackageFootprints == nil {
+				out.Artifacts.PackageFootprints = make(map[artifact.ID]pkg.Footprint)
+			}
+			out.Artifacts.PackageFootprints[p.ID()] = footprint
+		}
+
+		out.Artifacts.FileMetadata = combineFileArtifacts(out.Artifacts.FileMetadata, s.Artifacts.FileMetadata)
+		out.Artifacts.FileDigests = combineFileArtifacts(out.Artifacts.FileDigests, s.Artifacts.FileDigests)
+		out.Artifacts.FileContents = combineFileArtifacts(out.Artifacts.FileContents, s.Artifacts.FileContents)
+		out.Artifacts.FileLicenses = combineFileArtifacts(out.Artifacts.FileLicenses, s.Artifacts.FileLicenses)
+		out.Artifacts.Executables = combineFileArtifacts(out.Artifacts.Executables, s.Artifacts.Executables)
+		out.Artifacts.KeyMaterial = combineFileArtifacts(out.Artifacts.KeyMaterial, s.Artifacts.KeyMaterial)
+		out.Artifacts.FileSnippets = combineFileArtifacts(out.Artifacts.FileSnippets, s.Artifacts.FileSnippets)
+		out.Artifacts.UnreadablePaths = append(out.Artifacts.UnreadablePaths, s.Artifacts.UnreadablePaths...)
+
+		if out.Artifacts.LinuxDistribution == nil {
+			out.Artifacts.LinuxDistribution = s.Artifacts.LinuxDistribution
+		}
+
+		node := func(i artifact.Identifiable) artifact.Identifiable {
+			if string(i.ID()) == s.Source.ID {
+				return srcNode
+			}
+			if p, ok := packages[i.ID()]; ok {
+				return p
+			}
+			return i
+		}
+		for _, r := range s.Relationships {
+			r.From = node(r.From)
+			r.To = node(r.To)
+			out.Relationships = append(out.Relationships, r)
+		}
+	}
+
+	return out, nil
+}
+
+// sbomPlatform returns the platform (e.g. "linux/arm64/v8") of the image described by the given source.
+func sbomPlatform(src source.Description) (string, error) {
+	metadata, ok := src.Metadata.(source.ImageMetadata)
+	if !ok {
+		return "", fmt.Errorf("only SBOMs of container images can be combined by platform (got %T)", src.Metadata)
+	}
+
+	var fields []string
+	for _, f := range []string{metadata.OS, metadata.Architecture, metadata.Variant} {
+		if f != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("no platform found for image %q", metadata.UserInput)
+	}
+	return strings.Join(fields, "/"), nil
+}
+
+// combinedPlatformSource describes the multi-platform image, keeping only the details shared by all platforms.
+func combinedPlatformSource(sboms []*sbom.SBOM) (source.Description, error) {
+	var ids []string
+	for _, s := range sboms {
+		ids = append(ids, s.Source.ID)
+	}
+	id, err := artifact.IDByHash(ids)
+	if err != nil {
+		return source.Description{}, fmt.Errorf("unable to create ID for combined source: %w", err)
+	}
+
+	first := sboms[0].Source
+	metadata := first.Metadata.(source.ImageMetadata)
+
+	return source.Description{
+		ID:       string(id),
+		Name:     first.Name,
+		Version:  first.Version,
+		Supplier: first.Supplier,
+		PURL:     first.PURL,
+		CPE:      first.CPE,
+		Metadata: source.ImageMetadata{
+			UserInput: metadata.UserInput,
+			Tags:      metadata.Tags,
+		},
+	}, nil
+}
+
+// platformPackages returns the packages of the given SBOM, annotated with the given platform and with IDs unique to
+// the platform, keyed by their original ID.
+func platformPackages(s *sbom.SBOM, platform string) (map[artifact.ID]pkg.Package, error) {
+	packages := make(map[artifact.ID]pkg.Package)
+	if s.Artifacts.Packages == nil {
+		return packages, nil
+	}
+
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		original := p.ID()
+
+		var locations []file.Location
+		for _, l := range p.Locations.ToSlice() {
+			// copy the annotations, since they may be shared with the original package
+			annotations := make(map[string]string, len(l.Annotations)+1)
+			for k, v := range l.Annotations {
+				annotations[k] = v
+			}
+			l.Annotations = annotations
+			locations = append(locations, l.WithAnnotation(pkg.PlatformAnnotationKey, platform))
+		}
+		p.Locations = file.NewLocationSet(locations...)
+
+		id, err := artifact.IDByHash(struct {
+			Platform string
+			ID       artifact.ID
+		}{Platform: platform, ID: original})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create ID for package %s@%s (%s): %w", p.Name, p.Version, platform, err)
+		}
+		p.OverrideID(id)
+
+		packages[original] = p
+	}
+	return packages, nil
+}
+
+func combineFileArtifacts[T any](into, from map[file.Coordinates]T) map[file.Coordinates]T {
+	for coordinates, value := range from {
+		if into == nil {
+			into = make(map[file.Coordinates]T)
+		}
+		if _, exists := into[coordinates]; !exists {
+			into[coordinates] = value
+		}
+	}
+	return into
+}
+
+var _ artifact.Identifiable = (*sourceIDNode)(nil)
+
+// sourceIDNode is the combined source as a relationship node.
+type sourceIDNode string
+
+func (s sourceIDNode) ID() artifact.ID {
+	return artifact.ID(s)
+}
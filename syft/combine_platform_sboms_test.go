@@ -0,0 +1,116 @@
+package syft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestCombinePlatformSBOMs(t *testing.T) {
+	newPlatformSBOM := func(id, arch, variant string) (*sbom.SBOM, pkg.Package) {
+		p := pkg.Package{
+			Name:    "busybox",
+			Version: "1.36.1",
+			Type:    pkg.ApkPkg,
+			Locations: file.NewLocationSet(
+				file.NewLocation("/lib/apk/db/installed").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		}
+		p.SetID()
+
+		coordinates := file.NewCoordinates("/bin/busybox", "sha256:layer-"+arch)
+		s := &sbom.SBOM{
+			Artifacts: sbom.Artifacts{
+				Packages:     pkg.NewCollection(p),
+				FileMetadata: map[file.Coordinates]file.Metadata{coordinates: {}},
+			},
+			Source: source.Description{
+				ID:   id,
+				Name: "busybox",
+				Metadata: source.ImageMetadata{
+					UserInput:      "busybox:latest",
+					ManifestDigest: "sha256:" + arch,
+					Tags:           []string{"busybox:latest"},
+					OS:             "linux",
+					Architecture:   arch,
+					Variant:        variant,
+				},
+			},
+			Relationships: []artifact.Relationship{
+				{From: sourceIDNode(id), To: p, Type: artifact.ContainsRelationship},
+				{From: p, To: coordinates, Type: artifact.ContainsRelationship},
+			},
+		}
+		return s, p
+	}
+
+	amd64, amd64Pkg := newPlatformSBOM("amd64-source", "amd64", "")
+	arm64, arm64Pkg := newPlatformSBOM("arm64-source", "arm64", "v8")
+
+	// identical packages found within each platform have the same ID
+	require.Equal(t, amd64Pkg.ID(), arm64Pkg.ID())
+
+	combined, err := CombinePlatformSBOMs(amd64, arm64)
+	require.NoError(t, err)
+
+	assert.Equal(t, source.ImageMetadata{UserInput: "busybox:latest", Tags: []string{"busybox:latest"}}, combined.Source.Metadata)
+	assert.Equal(t, "busybox", combined.Source.Name)
+	assert.NotEmpty(t, combined.Source.ID)
+
+	packages := combined.Artifacts.Packages.Sorted()
+	require.Len(t, packages, 2)
+
+	var platforms []string
+	ids := make(map[artifact.ID]string)
+	for _, p := range packages {
+		platform := pkg.Platform(p)
+		platforms = append(platforms, platform)
+		ids[p.ID()] = platform
+		// existing annotations are kept
+		assert.Equal(t, pkg.PrimaryEvidenceAnnotation, p.Locations.ToSlice()[0].Annotations[pkg.EvidenceAnnotationKey])
+	}
+	assert.ElementsMatch(t, []string{"linux/amd64", "linux/arm64/v8"}, platforms)
+	assert.Len(t, ids, 2)
+
+	// the original packages are not modified
+	assert.Empty(t, pkg.Platform(amd64Pkg))
+	assert.Empty(t, pkg.Platform(amd64.Artifacts.Packages.Sorted()[0]))
+
+	assert.Len(t, combined.Artifacts.FileMetadata, 2)
+
+	require.Len(t, combined.Relationships, 4)
+	for _, r := range combined.Relationships {
+		switch from := r.From.(type) {
+		case sourceIDNode:
+			assert.Equal(t, combined.Source.ID, string(from.ID()))
+			to, ok := r.To.(pkg.Package)
+			require.True(t, ok)
+			assert.Contains(t, ids, to.ID())
+		case pkg.Package:
+			assert.Contains(t, ids, from.ID())
+			to, ok := r.To.(file.Coordinates)
+			require.True(t, ok)
+			assert.Equal(t, "sha256:layer-"+map[string]string{"linux/amd64": "amd64", "linux/arm64/v8": "arm64"}[ids[from.ID()]], to.FileSystemID)
+		default:
+			t.Fatalf("unexpected relationship: %+v", r)
+		}
+	}
+}
+
+func TestCombinePlatformSBOMs_invalid(t *testing.T) {
+	_, err := CombinePlatformSBOMs()
+	require.Error(t, err)
+
+	_, err = CombinePlatformSBOMs(&sbom.SBOM{Source: source.Description{Metadata: source.DirectoryMetadata{Path: "/"}}})
+	require.ErrorContains(t, err, "only SBOMs of container images")
+
+	_, err = CombinePlatformSBOMs(&sbom.SBOM{Source: source.Description{Metadata: source.ImageMetadata{UserInput: "app"}}})
+	require.ErrorContains(t, err, "no platform found")
+}
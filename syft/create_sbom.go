@@ -9,10 +9,12 @@ import (
 	"github.com/scylladb/go-set/strset"
 
 	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/internal/sbomsync"
 	"github.com/anchore/syft/internal/task"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/event/monitor"
+	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
@@ -28,6 +30,11 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	return createSBOM(ctx, src, cfg, nil)
+}
+
+// createSBOM catalogs the given source, optionally decorating the file resolver used by all cataloging tasks.
+func createSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig, decorate func(file.Resolver) file.Resolver) (*sbom.SBOM, error) {
 	srcMetadata := src.Describe()
 
 	taskGroups, audit, err := cfg.makeTaskGroups(srcMetadata)
@@ -40,6 +47,11 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 		return nil, fmt.Errorf("unable to get file resolver: %w", err)
 	}
 
+	taskResolver := resolver
+	if decorate != nil {
+		taskResolver = decorate(resolver)
+	}
+
 	s := sbom.SBOM{
 		Source: srcMetadata,
 		Descriptor: sbom.Descriptor{
@@ -65,7 +77,7 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 
 	builder := sbomsync.NewBuilder(&s, monitorPackageCount(packageCatalogingProgress))
 	for i := range taskGroups {
-		err := task.NewTaskExecutor(taskGroups[i], cfg.Parallelism).Execute(ctx, resolver, builder, catalogingProgress)
+		err := task.NewTaskExecutor(taskGroups[i], cfg.Parallelism).Execute(ctx, taskResolver, builder, catalogingProgress)
 		if err != nil {
 			// TODO: tie this to the open progress monitors...
 			return nil, fmt.Errorf("failed to run tasks: %w", err)
@@ -75,9 +87,26 @@ func CreateSBOM(ctx context.Context, src source.Source, cfg *CreateSBOMConfig) (
 	packageCatalogingProgress.SetCompleted()
 	catalogingProgress.SetCompleted()
 
+	s.Artifacts.UnreadablePaths = unreadablePaths(resolver)
+
 	return &s, nil
 }
 
+// unreadablePaths captures any paths the resolver observed but could not read (e.g. when scanning unprivileged), so
+// that these can be distinguished from paths that are not present at all.
+func unreadablePaths(resolver file.Resolver) []file.UnreadablePath {
+	reporter, ok := resolver.(file.UnreadablePathsReporter)
+	if !ok {
+		return nil
+	}
+
+	paths := reporter.UnreadablePaths()
+	if len(paths) > 0 {
+		log.WithFields("count", len(paths)).Warn("unable to read some paths (results may be incomplete)")
+	}
+	return paths
+}
+
 func monitorPackageCount(prog *monitor.CatalogerTaskProgress) func(s *sbom.SBOM) {
 	return func(s *sbom.SBOM) {
 		count := humanize.Comma(int64(s.Artifacts.Packages.PackageCount()))
@@ -22,6 +22,12 @@ type CreateSBOMConfig struct {
 	Search             cataloging.SearchConfig
 	Relationships      cataloging.RelationshipsConfig
 	DataGeneration     cataloging.DataGenerationConfig
+	Classification     cataloging.ClassificationConfig
+	Ownership          cataloging.OwnershipConfig
+	Reachability       cataloging.ReachabilityConfig
+	Footprint          cataloging.FootprintConfig
+	EOL                cataloging.EOLConfig
+	SupplyChain        cataloging.SupplyChainConfig
 	Packages           pkgcataloging.Config
 	Files              filecataloging.Config
 	Parallelism        int
@@ -41,6 +47,12 @@ func DefaultCreateSBOMConfig() *CreateSBOMConfig {
 		Search:               cataloging.DefaultSearchConfig(),
 		Relationships:        cataloging.DefaultRelationshipsConfig(),
 		DataGeneration:       cataloging.DefaultDataGenerationConfig(),
+		Classification:       cataloging.DefaultClassificationConfig(),
+		Ownership:            cataloging.DefaultOwnershipConfig(),
+		Reachability:         cataloging.DefaultReachabilityConfig(),
+		Footprint:            cataloging.DefaultFootprintConfig(),
+		EOL:                  cataloging.DefaultEOLConfig(),
+		SupplyChain:          cataloging.DefaultSupplyChainConfig(),
 		Packages:             pkgcataloging.DefaultConfig(),
 		Files:                filecataloging.DefaultConfig(),
 		Parallelism:          1,
@@ -112,6 +124,47 @@ func (c *CreateSBOMConfig) WithDataGenerationConfig(cfg cataloging.DataGeneratio
 	return c
 }
 
+// WithClassificationConfig allows for defining path rules that classify the packages found under those paths.
+func (c *CreateSBOMConfig) WithClassificationConfig(cfg cataloging.ClassificationConfig) *CreateSBOMConfig {
+	c.Classification = cfg
+	return c
+}
+
+// WithOwnershipConfig allows for defining heuristics that classify each package as internal (first-party) or
+// third-party.
+func (c *CreateSBOMConfig) WithOwnershipConfig(cfg cataloging.OwnershipConfig) *CreateSBOMConfig {
+	c.Ownership = cfg
+	return c
+}
+
+// WithReachabilityConfig allows for defining which reachability hints (e.g. the entrypoint closure of an image) should
+// be annotated on packages.
+func (c *CreateSBOMConfig) WithReachabilityConfig(cfg cataloging.ReachabilityConfig) *CreateSBOMConfig {
+	c.Reachability = cfg
+	return c
+}
+
+// WithFootprintConfig allows for defining if the installed size and number of files owned by each package should be
+// recorded.
+func (c *CreateSBOMConfig) WithFootprintConfig(cfg cataloging.FootprintConfig) *CreateSBOMConfig {
+	c.Footprint = cfg
+	return c
+}
+
+// WithEOLConfig allows for defining if the linux distribution and major runtime packages should be annotated with when
+// their release cycle reaches its end of life.
+func (c *CreateSBOMConfig) WithEOLConfig(cfg cataloging.EOLConfig) *CreateSBOMConfig {
+	c.EOL = cfg
+	return c
+}
+
+// WithSupplyChainConfig allows for defining if packages that may be typosquats of popular packages, or internal
+// packages prone to dependency confusion, should be annotated for supply-chain review.
+func (c *CreateSBOMConfig) WithSupplyChainConfig(cfg cataloging.SupplyChainConfig) *CreateSBOMConfig {
+	c.SupplyChain = cfg
+	return c
+}
+
 // WithPackagesConfig allows for defining any specific behavior for syft-implemented catalogers.
 func (c *CreateSBOMConfig) WithPackagesConfig(cfg pkgcataloging.Config) *CreateSBOMConfig {
 	c.Packages = cfg
@@ -165,6 +218,7 @@ func (c *CreateSBOMConfig) makeTaskGroups(src source.Description) ([][]task.Task
 	// generate package and file tasks based on the configuration
 	environmentTasks := c.environmentTasks()
 	relationshipsTasks := c.relationshipTasks(src)
+	classificationTasks := c.classificationTasks(src)
 	fileTasks := c.fileTasks()
 	pkgTasks, selectionEvidence, err := c.packageTasks(src)
 	if err != nil {
@@ -179,6 +233,11 @@ func (c *CreateSBOMConfig) makeTaskGroups(src source.Description) ([][]task.Task
 		taskGroups = append(taskGroups, append(pkgTasks, fileTasks...))
 	}
 
+	// classifying packages by location must be done after all packages have been cataloged
+	if len(classificationTasks) > 0 {
+		taskGroups = append(taskGroups, classificationTasks)
+	}
+
 	// all relationship work must be done after all nodes (files and packages) have been cataloged
 	if len(relationshipsTasks) > 0 {
 		taskGroups = append(taskGroups, relationshipsTasks)
@@ -214,6 +273,12 @@ func (c *CreateSBOMConfig) fileTasks() []task.Task {
 	if t := task.NewExecutableCatalogerTask(c.Files.Selection, c.Files.Executable); t != nil {
 		tsks = append(tsks, t)
 	}
+	if t := task.NewKeyMaterialCatalogerTask(c.Files.Selection, c.Files.KeyMaterial); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewFileSnippetCatalogerTask(c.Files.Snippets); t != nil {
+		tsks = append(tsks, t)
+	}
 
 	return tsks
 }
@@ -264,6 +329,7 @@ func finalSelectionRequest(req pkgcataloging.SelectionRequest, src source.Descri
 
 		req.RemoveNamesOrTags = replaceDefaultTagReferences(defaultTag, req.RemoveNamesOrTags)
 		req.SubSelectTags = replaceDefaultTagReferences(defaultTag, req.SubSelectTags)
+		req.AddNames = replaceDefaultTagReferences(defaultTag, req.AddNames)
 	}
 
 	return &req, nil
@@ -317,6 +383,36 @@ func (c *CreateSBOMConfig) relationshipTasks(src source.Description) []task.Task
 	return tsks
 }
 
+// classificationTasks returns the set of tasks that should be run to classify packages based on where they were found,
+// who owns them, and how they are reachable, as well as to account for the files they own, when they reach their end
+// of life, and whether they warrant supply-chain review.
+func (c *CreateSBOMConfig) classificationTasks(src source.Description) []task.Task {
+	var tsks []task.Task
+
+	if t := task.NewClassificationTask(c.Classification); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewOwnershipTask(c.Ownership); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewEntrypointClosureTask(c.Reachability, src); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewImportsTask(c.Reachability); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewFootprintTask(c.Footprint); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewEOLTask(c.EOL); t != nil {
+		tsks = append(tsks, t)
+	}
+	if t := task.NewSupplyChainTask(c.SupplyChain); t != nil {
+		tsks = append(tsks, t)
+	}
+	return tsks
+}
+
 // environmentTasks returns the set of tasks that should be run to identify what is being scanned or the context
 // of where it is being scanned. Today this is used to identify the linux distribution release for container images
 // being scanned.
@@ -355,10 +451,20 @@ func findDefaultTag(src source.Description) (string, error) {
 }
 
 func replaceDefaultTagReferences(defaultTag string, lst []string) []string {
-	for i, tag := range lst {
-		if strings.ToLower(tag) == "default" {
-			lst[i] = defaultTag
+	for i, exp := range lst {
+		// the default tag may be referenced by any term within an intersection (e.g. "default&!java")
+		terms := strings.Split(exp, task.IntersectionOperator)
+		for j, term := range terms {
+			negated := strings.HasPrefix(term, task.NegationOperator)
+			if strings.ToLower(strings.TrimPrefix(term, task.NegationOperator)) != "default" {
+				continue
+			}
+			terms[j] = defaultTag
+			if negated {
+				terms[j] = task.NegationOperator + defaultTag
+			}
 		}
+		lst[i] = strings.Join(terms, task.IntersectionOperator)
 	}
 	return lst
 }
@@ -418,6 +418,11 @@ func Test_replaceDefaultTagReferences(t *testing.T) {
 			lst:  []string{"foo", "default", "bar"},
 			want: []string{"foo", "replacement", "bar"},
 		},
+		{
+			name: "replace default tag within intersections",
+			lst:  []string{"os&default", "binary&!default"},
+			want: []string{"os&replacement", "binary&!replacement"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
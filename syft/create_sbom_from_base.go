@@ -0,0 +1,208 @@
+package syft
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/fileresolver"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// CreateSBOMFromBase creates a software bill-of-materials for the given image source, where the image is built on top
+// of the image described by the given base SBOM. Only files within the layers added on top of the base image are
+// searched for by catalogers, and the base SBOM contents are carried over except for packages and files whose evidence
+// was removed (e.g. by a whiteout) or replaced by the new layers. This allows for build systems that produce images
+// layer-by-layer to pay only for the cataloging of the layers that changed. Note that the base SBOM must have been
+// created from the squashed representation of the base image, and that relationships between packages found in the
+// base layers and packages found in the new layers are only discovered when cataloging the new layers can observe them.
+func CreateSBOMFromBase(ctx context.Context, base *sbom.SBOM, src source.Source, cfg *CreateSBOMConfig) (*sbom.SBOM, error) {
+	if base == nil {
+		return nil, fmt.Errorf("no base SBOM provided")
+	}
+	if cfg == nil {
+		cfg = DefaultCreateSBOMConfig()
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if cfg.Search.Scope != source.SquashedScope {
+		return nil, fmt.Errorf("only the %q scope is supported when creating an SBOM from a base SBOM (got %q)", source.SquashedScope, cfg.Search.Scope)
+	}
+
+	newLayers, err := layersAddedToBase(base.Source, src.Describe())
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields("layers", len(newLayers)).Debug("cataloging layers added to the base image")
+
+	var imageResolver file.Resolver
+	s, err := createSBOM(ctx, src, cfg, func(resolver file.Resolver) file.Resolver {
+		imageResolver = resolver
+		return fileresolver.NewLayerFilterDecorator(resolver, newLayers...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mergeBaseSBOM(s, base, newStaleCoordinatesChecker(imageResolver))
+
+	return s, nil
+}
+
+// layersAddedToBase returns the digests of the layers of the given image that are not part of the base image, ensuring
+// that the image is in fact built on top of the base image.
+func layersAddedToBase(base, image source.Description) ([]string, error) {
+	baseMetadata, ok := base.Metadata.(source.ImageMetadata)
+	if !ok {
+		return nil, fmt.Errorf("base SBOM does not describe a container image (got %T)", base.Metadata)
+	}
+	imageMetadata, ok := image.Metadata.(source.ImageMetadata)
+	if !ok {
+		return nil, fmt.Errorf("source is not a container image (got %T)", image.Metadata)
+	}
+
+	if len(imageMetadata.Layers) < len(baseMetadata.Layers) {
+		return nil, fmt.Errorf("image has fewer layers (%d) than the base image (%d)", len(imageMetadata.Layers), len(baseMetadata.Layers))
+	}
+
+	for i, layer := range baseMetadata.Layers {
+		if imageMetadata.Layers[i].Digest != layer.Digest {
+			return nil, fmt.Errorf("image is not built on the base image: layer %d is %q but expected %q", i, imageMetadata.Layers[i].Digest, layer.Digest)
+		}
+	}
+
+	var digests []string
+	for _, layer := range imageMetadata.Layers[len(baseMetadata.Layers):] {
+		digests = append(digests, layer.Digest)
+	}
+	return digests, nil
+}
+
+// staleCoordinatesChecker determines if file coordinates from the base image are no longer visible in the final image,
+// either since the path was removed or since the path is now provided by another layer.
+type staleCoordinatesChecker struct {
+	resolver file.Resolver
+	cache    map[file.Coordinates]bool
+}
+
+func newStaleCoordinatesChecker(resolver file.Resolver) *staleCoordinatesChecker {
+	return &staleCoordinatesChecker{
+		resolver: resolver,
+		cache:    make(map[file.Coordinates]bool),
+	}
+}
+
+func (c *staleCoordinatesChecker) isStale(coordinates file.Coordinates) bool {
+	if stale, ok := c.cache[coordinates]; ok {
+		return stale
+	}
+
+	stale := true
+	locations, err := c.resolver.FilesByPath(coordinates.RealPath)
+	if err != nil {
+		log.WithFields("path", coordinates.RealPath, "error", err).Trace("unable to resolve base image path")
+	}
+	for _, l := range locations {
+		if l.RealPath == coordinates.RealPath && l.FileSystemID == coordinates.FileSystemID {
+			stale = false
+			break
+		}
+	}
+
+	c.cache[coordinates] = stale
+	return stale
+}
+
+// isStalePackage indicates if the evidence for the given package is no longer visible in the final image. Primary
+// evidence is considered when available, otherwise all locations of the package are considered.
+func (c *staleCoordinatesChecker) isStalePackage(p pkg.Package) bool {
+	locations := p.Locations.ToSlice()
+
+	var evidence []file.Location
+	for _, l := range locations {
+		if l.Annotations[pkg.EvidenceAnnotationKey] == pkg.PrimaryEvidenceAnnotation {
+			evidence = append(evidence, l)
+		}
+	}
+	if len(evidence) == 0 {
+		evidence = locations
+	}
+
+	for _, l := range evidence {
+		if c.isStale(l.Coordinates) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeBaseSBOM carries over all packages, files, and relationships from the base SBOM into the given SBOM (which
+// describes only the new layers) that are still visible within the final image.
+func mergeBaseSBOM(s *sbom.SBOM, base *sbom.SBOM, checker *staleCoordinatesChecker) {
+	removed := strset.New()
+	if base.Artifacts.Packages != nil {
+		for _, p := range base.Artifacts.Packages.Sorted() {
+			if checker.isStalePackage(p) {
+				removed.Add(string(p.ID()))
+				continue
+			}
+			s.Artifacts.Packages.Add(p)
+		}
+	}
+
+	log.WithFields("removed", removed.Size()).Debug("merged base SBOM packages")
+
+	s.Artifacts.FileMetadata = mergeFileArtifacts(s.Artifacts.FileMetadata, base.Artifacts.FileMetadata, checker)
+	s.Artifacts.FileDigests = mergeFileArtifacts(s.Artifacts.FileDigests, base.Artifacts.FileDigests, checker)
+	s.Artifacts.FileContents = mergeFileArtifacts(s.Artifacts.FileContents, base.Artifacts.FileContents, checker)
+	s.Artifacts.FileLicenses = mergeFileArtifacts(s.Artifacts.FileLicenses, base.Artifacts.FileLicenses, checker)
+	s.Artifacts.Executables = mergeFileArtifacts(s.Artifacts.Executables, base.Artifacts.Executables, checker)
+	s.Artifacts.KeyMaterial = mergeFileArtifacts(s.Artifacts.KeyMaterial, base.Artifacts.KeyMaterial, checker)
+	s.Artifacts.FileSnippets = mergeFileArtifacts(s.Artifacts.FileSnippets, base.Artifacts.FileSnippets, checker)
+
+	if s.Artifacts.LinuxDistribution == nil {
+		s.Artifacts.LinuxDistribution = base.Artifacts.LinuxDistribution
+	}
+
+	isStale := func(i artifact.Identifiable) bool {
+		switch v := i.(type) {
+		case pkg.Package:
+			return removed.Has(string(v.ID()))
+		case file.Coordinates:
+			return checker.isStale(v)
+		case file.Location:
+			return checker.isStale(v.Coordinates)
+		}
+		return false
+	}
+
+	for _, r := range base.Relationships {
+		if isStale(r.From) || isStale(r.To) {
+			continue
+		}
+		s.Relationships = append(s.Relationships, r)
+	}
+}
+
+func mergeFileArtifacts[T any](into, from map[file.Coordinates]T, checker *staleCoordinatesChecker) map[file.Coordinates]T {
+	for coordinates, value := range from {
+		if checker.isStale(coordinates) {
+			continue
+		}
+		if into == nil {
+			into = make(map[file.Coordinates]T)
+		}
+		if _, exists := into[coordinates]; !exists {
+			into[coordinates] = value
+		}
+	}
+	return into
+}
@@ -0,0 +1,172 @@
+package syft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/linux"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+func Test_layersAddedToBase(t *testing.T) {
+	layers := func(digests ...string) source.Description {
+		var l []source.LayerMetadata
+		for _, d := range digests {
+			l = append(l, source.LayerMetadata{Digest: d})
+		}
+		return source.Description{Metadata: source.ImageMetadata{Layers: l}}
+	}
+
+	tests := []struct {
+		name    string
+		base    source.Description
+		image   source.Description
+		want    []string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:  "new layers on top of base",
+			base:  layers("sha256:a", "sha256:b"),
+			image: layers("sha256:a", "sha256:b", "sha256:c", "sha256:d"),
+			want:  []string{"sha256:c", "sha256:d"},
+		},
+		{
+			name:  "no new layers",
+			base:  layers("sha256:a"),
+			image: layers("sha256:a"),
+		},
+		{
+			name:    "image not built on base",
+			base:    layers("sha256:a", "sha256:b"),
+			image:   layers("sha256:a", "sha256:x", "sha256:c"),
+			wantErr: require.Error,
+		},
+		{
+			name:    "image has fewer layers than base",
+			base:    layers("sha256:a", "sha256:b"),
+			image:   layers("sha256:a"),
+			wantErr: require.Error,
+		},
+		{
+			name:    "base is not an image",
+			base:    source.Description{Metadata: source.DirectoryMetadata{Path: "/"}},
+			image:   layers("sha256:a"),
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			got, err := layersAddedToBase(tt.base, tt.image)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_mergeBaseSBOM(t *testing.T) {
+	baseLayer, newLayer := "sha256:base", "sha256:new"
+
+	location := func(path, layer string) file.Location {
+		return file.NewLocationFromCoordinates(file.Coordinates{RealPath: path, FileSystemID: layer}).
+			WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	newPackage := func(name string, locations ...file.Location) pkg.Package {
+		p := pkg.Package{Name: name, Version: "1.0", Locations: file.NewLocationSet(locations...)}
+		p.SetID()
+		return p
+	}
+
+	// the final image: busybox is untouched, the apk DB was rewritten by the new layer, and python was deleted
+	resolver := &layeredResolver{
+		locations: []file.Location{
+			location("/bin/busybox", baseLayer),
+			location("/lib/apk/db/installed", newLayer),
+		},
+	}
+
+	busybox := newPackage("busybox", location("/bin/busybox", baseLayer))
+	musl := newPackage("musl", location("/lib/apk/db/installed", baseLayer))
+	python := newPackage("python", location("/usr/lib/python3.12/site-packages/x/METADATA", baseLayer))
+	newMusl := newPackage("musl", location("/lib/apk/db/installed", newLayer))
+
+	base := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(busybox, musl, python),
+			FileMetadata: map[file.Coordinates]file.Metadata{
+				{RealPath: "/bin/busybox", FileSystemID: baseLayer}:          {},
+				{RealPath: "/lib/apk/db/installed", FileSystemID: baseLayer}: {},
+			},
+			LinuxDistribution: &linux.Release{ID: "alpine"},
+		},
+		Relationships: []artifact.Relationship{
+			{From: busybox, To: file.Coordinates{RealPath: "/bin/busybox", FileSystemID: baseLayer}, Type: artifact.ContainsRelationship},
+			{From: musl, To: busybox, Type: artifact.DependencyOfRelationship},
+		},
+	}
+
+	s := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(newMusl),
+		},
+	}
+
+	mergeBaseSBOM(s, base, newStaleCoordinatesChecker(resolver))
+
+	var names []string
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		names = append(names, p.Name+"@"+p.Locations.ToSlice()[0].FileSystemID)
+	}
+	assert.Equal(t, []string{"busybox@sha256:base", "musl@sha256:new"}, names)
+
+	assert.Equal(t, map[file.Coordinates]file.Metadata{
+		{RealPath: "/bin/busybox", FileSystemID: baseLayer}: {},
+	}, s.Artifacts.FileMetadata)
+
+	assert.Equal(t, &linux.Release{ID: "alpine"}, s.Artifacts.LinuxDistribution)
+
+	require.Len(t, s.Relationships, 1)
+	assert.Equal(t, busybox.ID(), s.Relationships[0].From.ID())
+}
+
+func TestCreateSBOMFromBase_invalidInput(t *testing.T) {
+	imageSource := source.FromDescription(source.Description{Metadata: source.ImageMetadata{}})
+
+	_, err := CreateSBOMFromBase(context.Background(), nil, imageSource, nil)
+	assert.ErrorContains(t, err, "no base SBOM")
+
+	cfg := DefaultCreateSBOMConfig()
+	cfg.Search.Scope = source.AllLayersScope
+	_, err = CreateSBOMFromBase(context.Background(), &sbom.SBOM{}, imageSource, cfg)
+	assert.ErrorContains(t, err, "scope is supported")
+
+	_, err = CreateSBOMFromBase(context.Background(), &sbom.SBOM{}, imageSource, nil)
+	assert.ErrorContains(t, err, "base SBOM does not describe a container image")
+}
+
+// layeredResolver resolves paths to locations with file system IDs (as a squashed image resolver would).
+type layeredResolver struct {
+	file.Resolver
+	locations []file.Location
+}
+
+func (r *layeredResolver) FilesByPath(paths ...string) ([]file.Location, error) {
+	var results []file.Location
+	for _, p := range paths {
+		for _, l := range r.locations {
+			if l.RealPath == p {
+				results = append(results, l)
+			}
+		}
+	}
+	return results, nil
+}
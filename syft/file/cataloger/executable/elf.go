@@ -31,6 +31,7 @@ func findELFFeatures(data *file.Executable, reader unionreader.UnionReader) erro
 
 	data.ImportedLibraries = libs
 	data.ELFSecurityFeatures = findELFSecurityFeatures(f)
+	data.ELFDynamicSection = NewELFDynamicSection(f)
 	data.HasEntrypoint = elfHasEntrypoint(f)
 	data.HasExports = elfHasExports(f)
 
@@ -51,6 +52,46 @@ func findELFSecurityFeatures(f *elf.File) *file.ELFSecurityFeatures {
 	}
 }
 
+// NewELFDynamicSection returns the SONAME, RPATH, and RUNPATH entries of the dynamic section (or nil if there are none,
+// e.g. for statically linked binaries).
+func NewELFDynamicSection(f *elf.File) *file.ELFDynamicSection {
+	var section file.ELFDynamicSection
+
+	if names := elfDynStrings(f, elf.DT_SONAME); len(names) > 0 {
+		section.SOName = names[0]
+	}
+	section.RPath = elfSearchPaths(elfDynStrings(f, elf.DT_RPATH))
+	section.RunPath = elfSearchPaths(elfDynStrings(f, elf.DT_RUNPATH))
+
+	if section.SOName == "" && len(section.RPath) == 0 && len(section.RunPath) == 0 {
+		return nil
+	}
+	return &section
+}
+
+func elfDynStrings(f *elf.File, tag elf.DynTag) []string {
+	values, err := f.DynString(tag)
+	if err != nil {
+		// TODO: known-unknowns
+		log.WithFields("error", err, "tag", tag).Trace("unable to read dynamic string from elf file")
+		return nil
+	}
+	return values
+}
+
+// elfSearchPaths splits colon-separated search path entries into individual directories.
+func elfSearchPaths(values []string) []string {
+	var dirs []string
+	for _, value := range values {
+		for _, dir := range strings.Split(value, ":") {
+			if dir != "" {
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	return dirs
+}
+
 func isElfSymbolTableStripped(file *elf.File) bool {
 	return file.Section(".symtab") == nil
 }
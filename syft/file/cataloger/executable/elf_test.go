@@ -158,6 +158,84 @@ func Test_findELFSecurityFeatures(t *testing.T) {
 	}
 }
 
+func TestNewELFDynamicSection(t *testing.T) {
+	readerForFixture := func(t *testing.T, fixture string) unionreader.UnionReader {
+		t.Helper()
+		f, err := os.Open(filepath.Join("test-fixtures/elf", fixture))
+		require.NoError(t, err)
+		return f
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+		want    *file.ELFDynamicSection
+	}{
+		{
+			name:    "no dynamic section entries of interest",
+			fixture: "bin/with_nx",
+			want:    nil,
+		},
+		{
+			name:    "detect rpath",
+			fixture: "bin/with_rpath",
+			want: &file.ELFDynamicSection{
+				RPath: []string{"./libs"},
+			},
+		},
+		{
+			name:    "detect runpath",
+			fixture: "bin/with_runpath",
+			want: &file.ELFDynamicSection{
+				RunPath: []string{"./libs"},
+			},
+		},
+		{
+			name:    "detect soname",
+			fixture: "bin/with_soname.so",
+			want: &file.ELFDynamicSection{
+				SOName: "libwith_soname.so.1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := elf.NewFile(readerForFixture(t, tt.fixture))
+			require.NoError(t, err)
+
+			got := NewELFDynamicSection(f)
+
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("NewELFDynamicSection() mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
+
+func Test_elfSearchPaths(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   []string
+	}{
+		{
+			name:   "empty",
+			values: nil,
+			want:   nil,
+		},
+		{
+			name:   "colon separated with empty entries",
+			values: []string{"$ORIGIN/../lib::/opt/lib", "/usr/local/lib:"},
+			want:   []string{"$ORIGIN/../lib", "/opt/lib", "/usr/local/lib"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, elfSearchPaths(tt.values))
+		})
+	}
+}
+
 func Test_elfHasEntrypoint(t *testing.T) {
 
 	readerForFixture := func(t *testing.T, fixture string) unionreader.UnionReader {
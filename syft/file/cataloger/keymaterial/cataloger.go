@@ -0,0 +1,129 @@
+package keymaterial
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/bus"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/event/monitor"
+	"github.com/anchore/syft/syft/file"
+	intKeyMaterial "github.com/anchore/syft/syft/internal/keymaterial"
+)
+
+type Config struct {
+	Globs []string `json:"globs" yaml:"globs" mapstructure:"globs"`
+}
+
+type Cataloger struct {
+	globs []string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Globs: []string{
+			// certificates and keys
+			"**/*.pem", "**/*.crt", "**/*.cer", "**/*.cert", "**/*.der", "**/*.key", "**/*.pub",
+			"**/id_rsa", "**/id_dsa", "**/id_ecdsa", "**/id_ed25519",
+			// keystores
+			"**/*.jks", "**/*.jceks", "**/*.keystore", "**/*.p12", "**/*.pfx", "**/cacerts",
+		},
+	}
+}
+
+func NewCataloger(cfg Config) *Cataloger {
+	return &Cataloger{
+		globs: cfg.Globs,
+	}
+}
+
+// Catalog returns the details of certificates and keys found within files matching the configured globs. When
+// coordinates are provided, only the matching files at these coordinates are considered.
+func (i *Cataloger) Catalog(_ context.Context, resolver file.Resolver, coordinates ...file.Coordinates) (map[file.Coordinates]file.KeyMaterial, error) {
+	results := make(map[file.Coordinates]file.KeyMaterial)
+
+	locations, err := resolver.FilesByGlob(i.globs...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get file locations for key material: %w", err)
+	}
+
+	if len(coordinates) > 0 {
+		locations = filterByCoordinates(locations, coordinates)
+	}
+
+	prog := catalogingProgress(int64(len(locations)))
+	for _, location := range locations {
+		prog.AtomicStage.Set(location.Path())
+
+		material, ok, err := catalogLocation(resolver, location)
+		if internal.IsErrPathPermission(err) {
+			log.Debugf("key material cataloger skipping %q: %+v", location.RealPath, err)
+			continue
+		}
+
+		if err != nil {
+			prog.SetError(err)
+			return nil, fmt.Errorf("failed to process file %q: %w", location.RealPath, err)
+		}
+
+		prog.Increment()
+
+		if ok {
+			results[location.Coordinates] = material
+		}
+	}
+
+	log.Debugf("key material cataloger processed %d files", prog.Current())
+
+	prog.AtomicStage.Set(fmt.Sprintf("%s files", humanize.Comma(prog.Current())))
+	prog.SetCompleted()
+
+	return results, nil
+}
+
+func catalogLocation(resolver file.Resolver, location file.Location) (file.KeyMaterial, bool, error) {
+	contentReader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return file.KeyMaterial{}, false, err
+	}
+	defer internal.CloseAndLogError(contentReader, location.AccessPath)
+
+	contents, err := io.ReadAll(contentReader)
+	if err != nil {
+		return file.KeyMaterial{}, false, internal.ErrPath{Context: "key-material-cataloger", Path: location.RealPath, Err: err}
+	}
+
+	if material, ok := intKeyMaterial.ParseKeystore(contents); ok {
+		return material, true, nil
+	}
+
+	material, ok := intKeyMaterial.Parse(contents)
+	return material, ok, nil
+}
+
+func filterByCoordinates(locations []file.Location, coordinates []file.Coordinates) []file.Location {
+	set := file.NewCoordinateSet(coordinates...)
+
+	var filtered []file.Location
+	for _, location := range locations {
+		if set.Contains(location.Coordinates) {
+			filtered = append(filtered, location)
+		}
+	}
+	return filtered
+}
+
+func catalogingProgress(locations int64) *monitor.CatalogerTaskProgress {
+	info := monitor.GenericTask{
+		Title: monitor.Title{
+			Default: "Key material",
+		},
+		ParentID: monitor.TopLevelCatalogingTaskID,
+	}
+
+	return bus.StartCatalogerTask(info, locations, "")
+}
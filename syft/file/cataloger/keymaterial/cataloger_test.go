@@ -0,0 +1,92 @@
+package keymaterial
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestKeyMaterialCataloger(t *testing.T) {
+	allFiles := []string{
+		"test-fixtures/etc/ssl/certs/chain.pem",
+		"test-fixtures/etc/ssl/certs/invalid.pem",
+		"test-fixtures/keystores/app.jks",
+		"test-fixtures/readme.txt",
+	}
+
+	chain := file.NewLocation("test-fixtures/etc/ssl/certs/chain.pem").Coordinates
+	keystore := file.NewLocation("test-fixtures/keystores/app.jks").Coordinates
+
+	tests := []struct {
+		name        string
+		globs       []string
+		coordinates []file.Coordinates
+		expected    map[file.Coordinates]string
+	}{
+		{
+			name:  "default globs",
+			globs: DefaultConfig().Globs,
+			expected: map[file.Coordinates]string{
+				chain:    "PEM",
+				keystore: "JKS",
+			},
+		},
+		{
+			name:  "custom globs",
+			globs: []string{"**/*.jks"},
+			expected: map[file.Coordinates]string{
+				keystore: "JKS",
+			},
+		},
+		{
+			name:        "restricted to coordinates",
+			globs:       DefaultConfig().Globs,
+			coordinates: []file.Coordinates{keystore, file.NewLocation("test-fixtures/readme.txt").Coordinates},
+			expected: map[file.Coordinates]string{
+				keystore: "JKS",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewCataloger(Config{Globs: test.globs})
+
+			resolver := file.NewMockResolverForPaths(allFiles...)
+			actual, err := c.Catalog(context.Background(), resolver, test.coordinates...)
+			require.NoError(t, err)
+
+			formats := make(map[file.Coordinates]string)
+			for coordinates, material := range actual {
+				formats[coordinates] = material.Format
+			}
+			assert.Equal(t, test.expected, formats)
+		})
+	}
+}
+
+func TestKeyMaterialCataloger_noSecrets(t *testing.T) {
+	resolver := file.NewMockResolverForPaths("test-fixtures/keystores/app.jks")
+
+	actual, err := NewCataloger(DefaultConfig()).Catalog(context.Background(), resolver)
+	require.NoError(t, err)
+
+	material, ok := actual[file.NewLocation("test-fixtures/keystores/app.jks").Coordinates]
+	require.True(t, ok)
+
+	assert.Len(t, material.Certificates, 3)
+	require.Len(t, material.Keys, 1)
+	assert.Equal(t, file.Key{
+		Alias:                "app",
+		Type:                 "private-key",
+		Algorithm:            "RSA",
+		Size:                 2048,
+		Format:               "PKCS#8",
+		Encrypted:            true,
+		PublicKeyFingerprint: "89eb18ee1f38617d6cdd36af6ad3da0ac7153f5a9bbdbf5d61b25a94a4a7415e",
+	}, material.Keys[0])
+}
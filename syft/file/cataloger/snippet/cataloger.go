@@ -0,0 +1,122 @@
+package snippet
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dustin/go-humanize"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/bus"
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/event/monitor"
+	"github.com/anchore/syft/syft/file"
+)
+
+type Config struct {
+	// Globs are the file patterns that must be matched for a file to be considered for cataloging.
+	Globs []string `yaml:"globs" json:"globs" mapstructure:"globs"`
+
+	// SkipFilesAboveSize is the maximum file size (in bytes) to allow to be considered while cataloging. If the file is larger than this size it will be skipped.
+	SkipFilesAboveSize int64 `yaml:"skip-files-above-size" json:"skip-files-above-size" mapstructure:"skip-files-above-size"`
+}
+
+type Cataloger struct {
+	globs                     []string
+	skipFilesAboveSizeInBytes int64
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Globs: []string{
+			// bundled (and often minified) scripts and stylesheets
+			"**/*.js", "**/*.mjs", "**/*.cjs", "**/*.css",
+		},
+		SkipFilesAboveSize: 10 * intFile.MB,
+	}
+}
+
+func NewCataloger(cfg Config) *Cataloger {
+	return &Cataloger{
+		globs:                     cfg.Globs,
+		skipFilesAboveSizeInBytes: cfg.SkipFilesAboveSize,
+	}
+}
+
+// Catalog returns the regions of files matching the configured globs that carry their own license or copyright
+// evidence (see Find).
+func (i *Cataloger) Catalog(_ context.Context, resolver file.Resolver) (map[file.Coordinates][]file.Snippet, error) {
+	results := make(map[file.Coordinates][]file.Snippet)
+
+	locations, err := resolver.FilesByGlob(i.globs...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get file locations for snippets: %w", err)
+	}
+
+	prog := catalogingProgress(int64(len(locations)))
+	for _, location := range locations {
+		prog.AtomicStage.Set(location.Path())
+
+		metadata, err := resolver.FileMetadataByLocation(location)
+		if err != nil {
+			prog.SetError(err)
+			return nil, err
+		}
+
+		if i.skipFilesAboveSizeInBytes > 0 && metadata.Size() > i.skipFilesAboveSizeInBytes {
+			continue
+		}
+
+		snippets, err := catalogLocation(resolver, location)
+		if internal.IsErrPathPermission(err) {
+			log.Debugf("snippet cataloger skipping %q: %+v", location.RealPath, err)
+			continue
+		}
+
+		if err != nil {
+			prog.SetError(err)
+			return nil, fmt.Errorf("failed to process file %q: %w", location.RealPath, err)
+		}
+
+		prog.Increment()
+
+		if len(snippets) > 0 {
+			results[location.Coordinates] = snippets
+		}
+	}
+
+	log.Debugf("snippet cataloger processed %d files", prog.Current())
+
+	prog.AtomicStage.Set(fmt.Sprintf("%s files", humanize.Comma(prog.Current())))
+	prog.SetCompleted()
+
+	return results, nil
+}
+
+func catalogLocation(resolver file.Resolver, location file.Location) ([]file.Snippet, error) {
+	contentReader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contentReader, location.AccessPath)
+
+	contents, err := io.ReadAll(contentReader)
+	if err != nil {
+		return nil, internal.ErrPath{Context: "snippet-cataloger", Path: location.RealPath, Err: err}
+	}
+
+	return Find(contents), nil
+}
+
+func catalogingProgress(locations int64) *monitor.CatalogerTaskProgress {
+	info := monitor.GenericTask{
+		Title: monitor.Title{
+			Default: "File snippets",
+		},
+		ParentID: monitor.TopLevelCatalogingTaskID,
+	}
+
+	return bus.StartCatalogerTask(info, locations, "")
+}
@@ -0,0 +1,67 @@
+package snippet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestSnippetCataloger(t *testing.T) {
+	allFiles := []string{
+		"test-fixtures/app/dist/app.min.js",
+		"test-fixtures/app/dist/app.css",
+		"test-fixtures/app/dist/plain.js",
+	}
+
+	bundle := file.NewLocation("test-fixtures/app/dist/app.min.js").Coordinates
+	stylesheet := file.NewLocation("test-fixtures/app/dist/app.css").Coordinates
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected map[file.Coordinates][]string
+	}{
+		{
+			name: "default config",
+			cfg:  DefaultConfig(),
+			expected: map[file.Coordinates][]string{
+				bundle:     {"jQuery", "Lodash", "React"},
+				stylesheet: {""},
+			},
+		},
+		{
+			name: "custom globs",
+			cfg:  Config{Globs: []string{"**/*.css"}},
+			expected: map[file.Coordinates][]string{
+				stylesheet: {""},
+			},
+		},
+		{
+			name:     "files above size are skipped",
+			cfg:      Config{Globs: DefaultConfig().Globs, SkipFilesAboveSize: 10},
+			expected: map[file.Coordinates][]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := NewCataloger(test.cfg)
+
+			resolver := file.NewMockResolverForPaths(allFiles...)
+			actual, err := c.Catalog(context.Background(), resolver)
+			require.NoError(t, err)
+
+			names := make(map[file.Coordinates][]string)
+			for coordinates, snippets := range actual {
+				for _, s := range snippets {
+					names[coordinates] = append(names[coordinates], s.Name)
+				}
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package snippet
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal/spdxlicense"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/license"
+)
+
+var (
+	commentStart = []byte("/*")
+	commentEnd   = []byte("*/")
+	newline      = []byte("\n")
+
+	// e.g. "@license MIT" or "@license React" (or a lone "@license" tag, followed by the name on the next line)
+	licenseTagPattern = regexp.MustCompile(`^@license(?:\s+(.+))?$`)
+
+	// e.g. "Released under the MIT license" or "Licensed under MIT"
+	licensedUnderPattern = regexp.MustCompile(`(?i)\b(?:licensed|released|distributed|available)\s+under\s+(?:the\s+)?([\w.+-]+)`)
+
+	// e.g. "MIT License" or "(MIT Licensed)"
+	namedLicensePattern = regexp.MustCompile(`(?i)([\w.+-]+)\s+licen[sc]e`)
+
+	apacheLicensePattern = regexp.MustCompile(`(?i)\bApache License,?\s+Version\s+2\.0\b`)
+
+	// e.g. "Copyright (c) 2010-2024 Jane Doe" or "(c) OpenJS Foundation", but not "the above copyright notice"
+	copyrightPattern = regexp.MustCompile(`(?:[Cc]opyright|COPYRIGHT)(?:\s*(?:\([cC]\)|©))?\s+(?:\d{4}|[A-Z])|(?:\([cC]\)|©)\s*(?:\d{4}|[A-Z])`)
+
+	// e.g. "jQuery v3.7.1" or "@vue/shared 3.4.21"
+	nameVersionPattern = regexp.MustCompile(`^(@?[\w.-]+(?:/[\w.-]+)?)\s+v?(\d+(?:\.\d+)+[\w.+-]*)(?:\s|$)`)
+
+	namePattern = regexp.MustCompile(`^@?[\w.-]+(?:/[\w.-]+)?$`)
+)
+
+// Find returns the regions of the given file contents with license or copyright evidence, which are the block comments
+// ("/* ... */", including the "/*! ... */" comments that minifiers preserve) that declare a license or state a
+// copyright notice. Note that each region covers only the comment itself and not the code that follows it, since the
+// extent of the embedded code cannot be determined reliably.
+func Find(contents []byte) []file.Snippet {
+	var snippets []file.Snippet
+	line, pos := 1, 0
+	for {
+		start := bytes.Index(contents[pos:], commentStart)
+		if start < 0 {
+			break
+		}
+		start += pos
+
+		end := bytes.Index(contents[start+len(commentStart):], commentEnd)
+		if end < 0 {
+			break
+		}
+		end += start + len(commentStart) + len(commentEnd)
+
+		// comments don't nest, so a preceding "/*" (e.g. within a string literal) doesn't start this comment
+		if nested := bytes.LastIndex(contents[start+len(commentStart):end-len(commentEnd)], commentStart); nested >= 0 {
+			start += len(commentStart) + nested
+		}
+
+		line += bytes.Count(contents[pos:start], newline)
+		comment := contents[start:end]
+		lines := bytes.Count(comment, newline)
+
+		if s, ok := fromComment(comment); ok {
+			s.Offset = start
+			s.Extent = end - start
+			s.StartLine = line
+			s.EndLine = line + lines
+			snippets = append(snippets, s)
+		}
+
+		line += lines
+		pos = end
+	}
+	return snippets
+}
+
+func fromComment(comment []byte) (file.Snippet, bool) {
+	if !hasEvidence(comment) {
+		return file.Snippet{}, false
+	}
+
+	var s file.Snippet
+	licenses := newOrderedSet()
+	copyrights := newOrderedSet()
+
+	var nameFollows bool
+	for i, line := range commentLines(comment) {
+		if nameFollows && s.Name == "" {
+			s.Name, s.Version = nameAndVersion(line, true)
+		}
+		nameFollows = false
+
+		if match := licenseTagPattern.FindStringSubmatch(line); match != nil {
+			value := strings.TrimSpace(match[1])
+			if value == "" {
+				nameFollows = true
+			} else if expression, err := license.ParseExpression(value); err == nil {
+				licenses.add(expression)
+			} else if s.Name == "" {
+				s.Name, s.Version = nameAndVersion(value, true)
+			}
+		}
+
+		for j, segment := range strings.Split(line, "|") {
+			segment = strings.TrimSpace(segment)
+			if i == 0 && j == 0 && s.Name == "" {
+				s.Name, s.Version = nameAndVersion(segment, false)
+			}
+
+			if loc := copyrightPattern.FindStringIndex(segment); loc != nil {
+				copyrights.add(strings.TrimSpace(segment[loc[0]:]))
+			}
+
+			for _, id := range licenseIDs(segment) {
+				licenses.add(id)
+			}
+		}
+	}
+
+	if len(licenses.values) == 0 && len(copyrights.values) == 0 {
+		return file.Snippet{}, false
+	}
+
+	s.Licenses = licenses.values
+	s.Copyrights = copyrights.values
+	return s, true
+}
+
+// hasEvidence is a quick check of whether a comment may hold any license or copyright evidence.
+func hasEvidence(comment []byte) bool {
+	lower := bytes.ToLower(comment)
+	for _, marker := range []string{"licen", "copyright", "(c)", "©"} {
+		if bytes.Contains(lower, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+// commentLines returns the non-empty lines of a block comment without the comment delimiters and the leading "*" of
+// each line.
+func commentLines(comment []byte) []string {
+	body := string(comment[len(commentStart) : len(comment)-len(commentEnd)])
+	body = strings.TrimPrefix(body, "!")
+
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "*"))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// licenseIDs returns the SPDX license IDs named by license statements within the given text.
+func licenseIDs(text string) []string {
+	var candidates []string
+	for _, match := range licensedUnderPattern.FindAllStringSubmatch(text, -1) {
+		candidates = append(candidates, match[1])
+	}
+	for _, match := range namedLicensePattern.FindAllStringSubmatch(text, -1) {
+		candidates = append(candidates, match[1])
+	}
+	if apacheLicensePattern.MatchString(text) {
+		candidates = append(candidates, "Apache-2.0")
+	}
+
+	var ids []string
+	for _, candidate := range candidates {
+		if id, ok := spdxlicense.ID(strings.TrimRight(candidate, ".")); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// nameAndVersion returns the name and version of the embedded code from the given text (e.g. "jQuery v3.7.1"). When
+// allowed, a lone name (without a version) is also accepted.
+func nameAndVersion(text string, allowNameOnly bool) (string, string) {
+	if match := nameVersionPattern.FindStringSubmatch(text); match != nil {
+		return match[1], match[2]
+	}
+	if !allowNameOnly {
+		return "", ""
+	}
+	fields := strings.Fields(text)
+	if len(fields) > 0 && namePattern.MatchString(fields[0]) {
+		return fields[0], ""
+	}
+	return "", ""
+}
+
+// orderedSet is a set of strings that retains the order that values are first added in.
+type orderedSet struct {
+	seen   map[string]bool
+	values []string
+}
+
+func newOrderedSet() *orderedSet {
+	return &orderedSet{seen: make(map[string]bool)}
+}
+
+func (s *orderedSet) add(value string) {
+	if value == "" || s.seen[value] {
+		return
+	}
+	s.seen[value] = true
+	s.values = append(s.values, value)
+}
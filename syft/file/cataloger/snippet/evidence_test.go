@@ -0,0 +1,129 @@
+package snippet
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected []file.Snippet
+	}{
+		{
+			name:     "no comments",
+			contents: "var a = 1;\n",
+		},
+		{
+			name:     "comment without evidence",
+			contents: "/*! For license information please see app.js.LICENSE.txt */\nvar a = 1;\n",
+		},
+		{
+			name:     "unterminated comment",
+			contents: "/*! Copyright 2024 Jane Doe",
+		},
+		{
+			name:     "license text without a copyright notice",
+			contents: "/* The above copyright notice and this permission notice shall be included */",
+		},
+		{
+			name:     "preserved banner with name and version",
+			contents: "var a=1;\n/*! Vue.js v2.6.14 (c) 2014-2021 Evan You | Released under the MIT License. */var b=2;",
+			expected: []file.Snippet{
+				{
+					Name:       "Vue.js",
+					Version:    "2.6.14",
+					Offset:     9,
+					Extent:     78,
+					StartLine:  2,
+					EndLine:    2,
+					Licenses:   []string{"MIT"},
+					Copyrights: []string{"(c) 2014-2021 Evan You"},
+				},
+			},
+		},
+		{
+			name:     "license tag with an expression",
+			contents: "/**\n * @license Apache-2.0 OR MIT\n * Copyright 2020 Example\n */",
+			expected: []file.Snippet{
+				{
+					Offset:     0,
+					Extent:     63,
+					StartLine:  1,
+					EndLine:    4,
+					Licenses:   []string{"Apache-2.0 OR MIT"},
+					Copyrights: []string{"Copyright 2020 Example"},
+				},
+			},
+		},
+		{
+			name:     "apache license statement",
+			contents: "/* Copyright 2024 Example Corp. Licensed under the Apache License, Version 2.0 */",
+			expected: []file.Snippet{
+				{
+					Offset:     0,
+					Extent:     81,
+					StartLine:  1,
+					EndLine:    1,
+					Licenses:   []string{"Apache-2.0"},
+					Copyrights: []string{"Copyright 2024 Example Corp. Licensed under the Apache License, Version 2.0"},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Find([]byte(test.contents)))
+		})
+	}
+}
+
+func TestFind_bundle(t *testing.T) {
+	contents, err := os.ReadFile("test-fixtures/app/dist/app.min.js")
+	require.NoError(t, err)
+
+	expected := []file.Snippet{
+		{
+			Name:       "jQuery",
+			Version:    "3.7.1",
+			Offset:     61,
+			Extent:     88,
+			StartLine:  2,
+			EndLine:    2,
+			Copyrights: []string{"(c) OpenJS Foundation and other contributors"},
+		},
+		{
+			Name:       "Lodash",
+			Offset:     214,
+			Extent:     187,
+			StartLine:  4,
+			EndLine:    9,
+			Licenses:   []string{"MIT"},
+			Copyrights: []string{"Copyright OpenJS Foundation and other contributors <https://openjsf.org/>"},
+		},
+		{
+			// note: the "/*" within the preceding string literal does not start this comment
+			Name:       "React",
+			Offset:     507,
+			Extent:     233,
+			StartLine:  12,
+			EndLine:    19,
+			Licenses:   []string{"MIT"},
+			Copyrights: []string{"Copyright (c) Facebook, Inc. and its affiliates."},
+		},
+	}
+
+	actual := Find(contents)
+	assert.Equal(t, expected, actual)
+
+	for _, s := range actual {
+		assert.Equal(t, "/*", string(contents[s.Offset:s.Offset+2]))
+		assert.Equal(t, "*/", string(contents[s.Offset+s.Extent-2:s.Offset+s.Extent]))
+	}
+}
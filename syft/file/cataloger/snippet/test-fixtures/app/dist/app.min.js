@@ -0,0 +1,19 @@
+/*! For license information please see app.js.LICENSE.txt */
+/*! jQuery v3.7.1 | (c) OpenJS Foundation and other contributors | jquery.org/license */
+!function(e,t){"use strict";e.jq=t}(this,function(){return 1});
+/**
+ * @license
+ * Lodash <https://lodash.com/>
+ * Copyright OpenJS Foundation and other contributors <https://openjsf.org/>
+ * Released under MIT license <https://lodash.com/license>
+ */
+;(function(){var _={};this._=_}).call(this);
+/* app code */var app=function(){return"/* not a comment"};
+/** @license React
+ * react.production.min.js
+ *
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ *
+ * This source code is licensed under the MIT license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
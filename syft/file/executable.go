@@ -1,5 +1,10 @@
 package file
 
+import (
+	"path"
+	"strings"
+)
+
 type (
 	ExecutableFormat   string
 	RelocationReadOnly string
@@ -23,6 +28,44 @@ type Executable struct {
 	HasEntrypoint       bool                 `json:"hasEntrypoint" yaml:"hasEntrypoint" mapstructure:"hasEntrypoint"`
 	ImportedLibraries   []string             `json:"importedLibraries" yaml:"importedLibraries" mapstructure:"importedLibraries"`
 	ELFSecurityFeatures *ELFSecurityFeatures `json:"elfSecurityFeatures,omitempty" yaml:"elfSecurityFeatures" mapstructure:"elfSecurityFeatures"`
+	ELFDynamicSection   *ELFDynamicSection   `json:"elfDynamicSection,omitempty" yaml:"elfDynamicSection" mapstructure:"elfDynamicSection"`
+}
+
+// ELFDynamicSection captures the entries of the ELF dynamic section that are used by the dynamic linker to locate
+// shared libraries (the needed libraries, DT_NEEDED, are captured as the ImportedLibraries of the executable).
+type ELFDynamicSection struct {
+	// SOName is the name the shared library is known by (DT_SONAME), which is what other binaries list as needed
+	SOName string `json:"soname,omitempty" yaml:"soname" mapstructure:"soname"`
+
+	// RPath are the (deprecated) library search paths embedded in the binary (DT_RPATH)
+	RPath []string `json:"rpath,omitempty" yaml:"rpath" mapstructure:"rpath"`
+
+	// RunPath are the library search paths embedded in the binary (DT_RUNPATH), which take precedence over any RPath
+	RunPath []string `json:"runpath,omitempty" yaml:"runpath" mapstructure:"runpath"`
+}
+
+// LibrarySearchPath returns the directories from the RUNPATH (or RPATH when there is no RUNPATH), where $ORIGIN is the
+// given directory of the binary. Directories that cannot be resolved independently of the runtime environment (e.g.
+// relative to the working directory, or with $LIB or $PLATFORM) are not included.
+func (s *ELFDynamicSection) LibrarySearchPath(origin string) []string {
+	if s == nil {
+		return nil
+	}
+
+	dirs := s.RunPath
+	if len(dirs) == 0 {
+		dirs = s.RPath
+	}
+
+	var searchPath []string
+	for _, dir := range dirs {
+		dir = strings.ReplaceAll(strings.ReplaceAll(dir, "${ORIGIN}", origin), "$ORIGIN", origin)
+		if !path.IsAbs(dir) || strings.Contains(dir, "$") {
+			continue
+		}
+		searchPath = append(searchPath, path.Clean(dir))
+	}
+	return searchPath
 }
 
 type ELFSecurityFeatures struct {
@@ -0,0 +1,45 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestELFDynamicSection_LibrarySearchPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		section *ELFDynamicSection
+		want    []string
+	}{
+		{
+			name: "no dynamic section",
+		},
+		{
+			name:    "runpath takes precedence over rpath",
+			section: &ELFDynamicSection{RPath: []string{"/opt/rpath"}, RunPath: []string{"/opt/runpath/"}},
+			want:    []string{"/opt/runpath"},
+		},
+		{
+			name:    "rpath without runpath",
+			section: &ELFDynamicSection{RPath: []string{"/opt/rpath"}},
+			want:    []string{"/opt/rpath"},
+		},
+		{
+			name:    "origin is expanded",
+			section: &ELFDynamicSection{RunPath: []string{"$ORIGIN/../lib", "${ORIGIN}/lib64"}},
+			want:    []string{"/app/lib", "/app/bin/lib64"},
+		},
+		{
+			name:    "directories that depend on the runtime environment are dropped",
+			section: &ELFDynamicSection{RunPath: []string{"lib", "/usr/$LIB", "/opt/${PLATFORM}", "/opt/lib"}},
+			want:    []string{"/opt/lib"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.section.LibrarySearchPath("/app/bin"))
+		})
+	}
+}
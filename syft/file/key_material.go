@@ -0,0 +1,76 @@
+package file
+
+// KeyMaterial represents the non-sensitive details of the certificates and keys found within a file (such as a PEM
+// file or a keystore). Note that secret values (e.g. private key bytes) are never captured.
+type KeyMaterial struct {
+	// Format denotes how the file is encoded: "PEM", "DER", "OpenSSH", "JKS", "JCEKS", or "PKCS#12"
+	Format string `json:"format" yaml:"format" mapstructure:"format"`
+
+	// Version is the version of the keystore format (keystores only)
+	Version int `json:"version,omitempty" yaml:"version,omitempty" mapstructure:"version"`
+
+	// Entries is the number of entries declared by the keystore (keystores only)
+	Entries int `json:"entries,omitempty" yaml:"entries,omitempty" mapstructure:"entries"`
+
+	Certificates []Certificate `json:"certificates,omitempty" yaml:"certificates,omitempty" mapstructure:"certificates"`
+	Keys         []Key         `json:"keys,omitempty" yaml:"keys,omitempty" mapstructure:"keys"`
+}
+
+// Certificate represents an X.509 certificate found within a file.
+type Certificate struct {
+	// Alias is the name of the keystore entry the certificate was found in (keystores only)
+	Alias string `json:"alias,omitempty" yaml:"alias,omitempty" mapstructure:"alias"`
+
+	Subject      string `json:"subject" yaml:"subject" mapstructure:"subject"`
+	Issuer       string `json:"issuer" yaml:"issuer" mapstructure:"issuer"`
+	SerialNumber string `json:"serialNumber" yaml:"serialNumber" mapstructure:"serialNumber"`
+
+	// NotBefore and NotAfter are the validity period of the certificate (RFC 3339 timestamps)
+	NotBefore string `json:"notBefore" yaml:"notBefore" mapstructure:"notBefore"`
+	NotAfter  string `json:"notAfter" yaml:"notAfter" mapstructure:"notAfter"`
+
+	// SignatureAlgorithm is the algorithm the certificate is signed with (e.g. "SHA256-RSA" or "ECDSA-SHA384")
+	SignatureAlgorithm string `json:"signatureAlgorithm" yaml:"signatureAlgorithm" mapstructure:"signatureAlgorithm"`
+
+	// PublicKeyAlgorithm is the algorithm of the subject public key (e.g. "RSA", "ECDSA", or "Ed25519")
+	PublicKeyAlgorithm string `json:"publicKeyAlgorithm" yaml:"publicKeyAlgorithm" mapstructure:"publicKeyAlgorithm"`
+
+	// PublicKeySize is the size of the subject public key in bits
+	PublicKeySize int `json:"publicKeySize,omitempty" yaml:"publicKeySize,omitempty" mapstructure:"publicKeySize"`
+
+	// Curve is the named elliptic curve of the subject public key (e.g. "P-256")
+	Curve string `json:"curve,omitempty" yaml:"curve,omitempty" mapstructure:"curve"`
+
+	IsCA bool `json:"isCA" yaml:"isCA" mapstructure:"isCA"`
+
+	// SHA256Fingerprint is the hex encoded sha256 digest of the DER encoded certificate
+	SHA256Fingerprint string `json:"sha256Fingerprint" yaml:"sha256Fingerprint" mapstructure:"sha256Fingerprint"`
+}
+
+// Key represents a private or public key found within a file.
+type Key struct {
+	// Alias is the name of the keystore entry the key was found in (keystores only)
+	Alias string `json:"alias,omitempty" yaml:"alias,omitempty" mapstructure:"alias"`
+
+	// Type is either "private-key" or "public-key"
+	Type string `json:"type" yaml:"type" mapstructure:"type"`
+
+	// Algorithm is the algorithm of the key (e.g. "RSA", "ECDSA", "Ed25519", or "DSA"), which may not be known for
+	// encrypted private keys
+	Algorithm string `json:"algorithm,omitempty" yaml:"algorithm,omitempty" mapstructure:"algorithm"`
+
+	// Size is the size of the key in bits
+	Size int `json:"size,omitempty" yaml:"size,omitempty" mapstructure:"size"`
+
+	// Curve is the named elliptic curve of the key (e.g. "P-256")
+	Curve string `json:"curve,omitempty" yaml:"curve,omitempty" mapstructure:"curve"`
+
+	// Format is the encoding of the key: "PKCS#1", "PKCS#8", "SEC1", "PKIX", or "OpenSSH"
+	Format string `json:"format,omitempty" yaml:"format,omitempty" mapstructure:"format"`
+
+	// Encrypted indicates if the (private) key is protected by a passphrase
+	Encrypted bool `json:"encrypted" yaml:"encrypted" mapstructure:"encrypted"`
+
+	// PublicKeyFingerprint is the hex encoded sha256 digest of the public key (PKIX DER or SSH wire format), when known
+	PublicKeyFingerprint string `json:"publicKeyFingerprint,omitempty" yaml:"publicKeyFingerprint,omitempty" mapstructure:"publicKeyFingerprint"`
+}
@@ -0,0 +1,25 @@
+package file
+
+// Snippet represents a region of a file with its own license or copyright evidence, such as the preserved banner
+// comment of a third-party library that has been bundled into a larger file (e.g. a minified JavaScript bundle).
+type Snippet struct {
+	// Name is the name of the embedded code, when stated by the evidence (e.g. "jQuery")
+	Name string `json:"name,omitempty" yaml:"name,omitempty" mapstructure:"name"`
+
+	// Version is the version of the embedded code, when stated by the evidence
+	Version string `json:"version,omitempty" yaml:"version,omitempty" mapstructure:"version"`
+
+	// Offset is the zero-based byte offset of the start of the region and Extent is the length of the region in bytes
+	Offset int `json:"offset" yaml:"offset" mapstructure:"offset"`
+	Extent int `json:"extent" yaml:"extent" mapstructure:"extent"`
+
+	// StartLine and EndLine are the (one-based, inclusive) line numbers of the region
+	StartLine int `json:"startLine" yaml:"startLine" mapstructure:"startLine"`
+	EndLine   int `json:"endLine" yaml:"endLine" mapstructure:"endLine"`
+
+	// Licenses are the SPDX license expressions declared within the region
+	Licenses []string `json:"licenses,omitempty" yaml:"licenses,omitempty" mapstructure:"licenses"`
+
+	// Copyrights are the copyright notices stated within the region
+	Copyrights []string `json:"copyrights,omitempty" yaml:"copyrights,omitempty" mapstructure:"copyrights"`
+}
@@ -0,0 +1,85 @@
+package file
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"syscall"
+)
+
+// UnreadableReason describes why a path that exists within a source could not be read.
+type UnreadableReason string
+
+const (
+	// PermissionDeniedReason indicates the path could not be accessed due to insufficient privileges.
+	PermissionDeniedReason UnreadableReason = "permission-denied"
+	// IOErrorReason indicates the path could not be read due to a low-level I/O failure.
+	IOErrorReason UnreadableReason = "io-error"
+	// UnknownReason indicates the path could not be read for any other reason.
+	UnknownReason UnreadableReason = "unknown"
+)
+
+// UnreadablePath is a path observed within a source that could not be accessed or read (as opposed to a path that
+// is not present at all).
+type UnreadablePath struct {
+	Path   string
+	Reason UnreadableReason
+	Error  string
+}
+
+// UnreadablePathsReporter is implemented by resolvers that keep track of paths that could not be accessed or read.
+type UnreadablePathsReporter interface {
+	UnreadablePaths() []UnreadablePath
+}
+
+// NewUnreadablePath creates an UnreadablePath for the given path, classifying the reason from the given error.
+func NewUnreadablePath(p string, err error) UnreadablePath {
+	u := UnreadablePath{
+		Path:   p,
+		Reason: UnreadableReasonFromError(err),
+	}
+	if err != nil {
+		u.Error = err.Error()
+	}
+	return u
+}
+
+// UnreadableReasonFromError classifies the given error into an UnreadableReason.
+func UnreadableReasonFromError(err error) UnreadableReason {
+	switch {
+	case err == nil:
+		return UnknownReason
+	case errors.Is(err, fs.ErrPermission):
+		return PermissionDeniedReason
+	case errors.Is(err, syscall.EIO):
+		return IOErrorReason
+	default:
+		return UnknownReason
+	}
+}
+
+// UnreadablePathCount is the number of unreadable paths found directly within a single directory.
+type UnreadablePathCount struct {
+	Directory string
+	Count     int
+}
+
+// UnreadablePathCountsByDirectory groups the given unreadable paths by their parent directory, sorted by directory.
+func UnreadablePathCountsByDirectory(paths []UnreadablePath) []UnreadablePathCount {
+	counts := make(map[string]int)
+	for _, p := range paths {
+		counts[path.Dir(p.Path)]++
+	}
+
+	var results []UnreadablePathCount
+	for dir, count := range counts {
+		results = append(results, UnreadablePathCount{Directory: dir, Count: count})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Directory < results[j].Directory
+	})
+
+	return results
+}
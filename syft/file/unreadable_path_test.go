@@ -0,0 +1,67 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnreadableReasonFromError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want UnreadableReason
+	}{
+		{
+			name: "permission denied",
+			err:  &os.PathError{Op: "open", Path: "/etc/shadow", Err: syscall.EACCES},
+			want: PermissionDeniedReason,
+		},
+		{
+			name: "wrapped permission denied",
+			err:  fmt.Errorf("unable to read: %w", os.ErrPermission),
+			want: PermissionDeniedReason,
+		},
+		{
+			name: "io error",
+			err:  &os.PathError{Op: "read", Path: "/mnt/disk", Err: syscall.EIO},
+			want: IOErrorReason,
+		},
+		{
+			name: "other error",
+			err:  errors.New("something else"),
+			want: UnknownReason,
+		},
+		{
+			name: "no error",
+			want: UnknownReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UnreadableReasonFromError(tt.err))
+		})
+	}
+}
+
+func TestUnreadablePathCountsByDirectory(t *testing.T) {
+	paths := []UnreadablePath{
+		NewUnreadablePath("/root/.ssh", os.ErrPermission),
+		NewUnreadablePath("/etc/shadow", os.ErrPermission),
+		NewUnreadablePath("/etc/gshadow", os.ErrPermission),
+		NewUnreadablePath("/var/lib/secret/db", syscall.EIO),
+	}
+
+	expected := []UnreadablePathCount{
+		{Directory: "/etc", Count: 2},
+		{Directory: "/root", Count: 1},
+		{Directory: "/var/lib/secret", Count: 1},
+	}
+
+	assert.Equal(t, expected, UnreadablePathCountsByDirectory(paths))
+	assert.Empty(t, UnreadablePathCountsByDirectory(nil))
+}
@@ -0,0 +1,110 @@
+/*
+Package checksums provides an encoder for a plain checksum manifest of the cataloged files (path and digest), in the
+format read by "sha256sum --check" and similar tools (e.g. a SHA256SUMS file).
+*/
+package checksums
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+const ID sbom.FormatID = "checksums"
+
+type EncoderConfig struct {
+	Algorithm string // the digest algorithm of the file digests to list (e.g. "sha256")
+}
+
+type encoder struct {
+	cfg EncoderConfig
+}
+
+func NewFormatEncoder() sbom.FormatEncoder {
+	return encoder{
+		cfg: DefaultEncoderConfig(),
+	}
+}
+
+func NewFormatEncoderWithConfig(cfg EncoderConfig) (sbom.FormatEncoder, error) {
+	if cfg.Algorithm == "" {
+		cfg.Algorithm = DefaultEncoderConfig().Algorithm
+	}
+	if _, err := intFile.Hashers(cfg.Algorithm); err != nil {
+		return nil, err
+	}
+	return encoder{
+		cfg: cfg,
+	}, nil
+}
+
+func DefaultEncoderConfig() EncoderConfig {
+	return EncoderConfig{
+		Algorithm: "sha256",
+	}
+}
+
+func (e encoder) ID() sbom.FormatID {
+	return ID
+}
+
+func (e encoder) Aliases() []string {
+	return []string{
+		"sums",
+	}
+}
+
+func (e encoder) Version() string {
+	return sbom.AnyVersion
+}
+
+type entry struct {
+	path   string
+	digest string
+}
+
+// Encode writes a "<digest>  <path>" line for each cataloged file with a digest of the configured algorithm, sorted by
+// path. Files without such a digest (e.g. when the algorithm is not one of the configured "file.metadata.digests") are
+// not listed. A path cataloged within several layers of a container image is listed once, with the digest of the file
+// as seen within the squashed image.
+func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
+	algorithm := intFile.CleanDigestAlgorithmName(e.cfg.Algorithm)
+
+	var entries []entry
+	for path, digests := range s.FileDigestsByPath() {
+		for _, d := range digests {
+			if d.Value == "" || intFile.CleanDigestAlgorithmName(d.Algorithm) != algorithm {
+				continue
+			}
+			entries = append(entries, entry{path: path, digest: d.Value})
+			break
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].path < entries[j].path
+	})
+
+	for _, en := range entries {
+		prefix, path := escapePath(en.path)
+		if _, err := fmt.Fprintf(writer, "%s%s  %s\n", prefix, en.digest, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var pathEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+
+// escapePath escapes the given path the way GNU coreutils does (so that a path cannot break the line format): when the
+// path holds a backslash, newline, or carriage return these are escaped and the line is prefixed with a backslash.
+func escapePath(path string) (string, string) {
+	if !strings.ContainsAny(path, "\\\n\r") {
+		return "", path
+	}
+	return `\`, pathEscaper.Replace(path)
+}
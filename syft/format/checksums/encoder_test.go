@@ -0,0 +1,110 @@
+package checksums
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestChecksumsEncoder(t *testing.T) {
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			FileDigests: map[file.Coordinates][]file.Digest{
+				file.NewCoordinates("/usr/bin/b", "layer-1"): {
+					{Algorithm: "sha1", Value: "bbbb1"},
+					{Algorithm: "sha256", Value: "bbbb256"},
+				},
+				file.NewCoordinates("/usr/bin/a", "layer-1"): {
+					{Algorithm: "sha256", Value: "aaaa256"},
+				},
+				// the same file in another layer with the same digest is listed once
+				file.NewCoordinates("/usr/bin/a", "layer-2"): {
+					{Algorithm: "sha256", Value: "aaaa256"},
+				},
+				file.NewCoordinates("/etc/only-sha1", "layer-1"): {
+					{Algorithm: "sha1", Value: "cccc1"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		algorithm string
+		want      string
+	}{
+		{
+			name:      "sha256",
+			algorithm: "sha256",
+			want:      "aaaa256  /usr/bin/a\nbbbb256  /usr/bin/b\n",
+		},
+		{
+			name:      "sha1 with a differently formatted algorithm name",
+			algorithm: "SHA-1",
+			want:      "cccc1  /etc/only-sha1\nbbbb1  /usr/bin/b\n",
+		},
+		{
+			name:      "no digests of the algorithm",
+			algorithm: "sha512",
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := NewFormatEncoderWithConfig(EncoderConfig{Algorithm: tt.algorithm})
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, enc.Encode(&buf, s))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestChecksumsEncoder_layers(t *testing.T) {
+	s := sbom.SBOM{
+		Source: source.Description{
+			Metadata: source.ImageMetadata{
+				Layers: []source.LayerMetadata{{Digest: "layer-b"}, {Digest: "layer-a"}},
+			},
+		},
+		Artifacts: sbom.Artifacts{
+			FileDigests: map[file.Coordinates][]file.Digest{
+				// the file is replaced in the uppermost layer, which is the one seen within the squashed image
+				file.NewCoordinates("/etc/os-release", "layer-b"): {{Algorithm: "sha256", Value: "lower"}},
+				file.NewCoordinates("/etc/os-release", "layer-a"): {{Algorithm: "sha256", Value: "upper"}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewFormatEncoder().Encode(&buf, s))
+	assert.Equal(t, "upper  /etc/os-release\n", buf.String())
+}
+
+func TestChecksumsEncoder_escapesPaths(t *testing.T) {
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			FileDigests: map[file.Coordinates][]file.Digest{
+				file.NewCoordinates("/tmp/a\nbbbb  /etc/passwd", ""): {{Algorithm: "sha256", Value: "aaaa"}},
+				file.NewCoordinates(`/tmp/back\slash`, ""):           {{Algorithm: "sha256", Value: "cccc"}},
+				file.NewCoordinates("/tmp/carriage\rreturn", ""):     {{Algorithm: "sha256", Value: "dddd"}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewFormatEncoder().Encode(&buf, s))
+	assert.Equal(t, `\aaaa  /tmp/a\nbbbb  /etc/passwd`+"\n"+`\cccc  /tmp/back\\slash`+"\n"+`\dddd  /tmp/carriage\rreturn`+"\n", buf.String())
+}
+
+func TestNewFormatEncoderWithConfig_unsupportedAlgorithm(t *testing.T) {
+	_, err := NewFormatEncoderWithConfig(EncoderConfig{Algorithm: "crc32"})
+	require.Error(t, err)
+}
@@ -26,15 +26,38 @@ func ToFormatModel(s sbom.SBOM) *cyclonedx.BOM {
 	// https://github.com/CycloneDX/specification/blob/master/schema/bom-1.3-strict.schema.json#L36
 	// "pattern": "^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
 	cdxBOM.SerialNumber = uuid.New().URN()
+	if s.Document.SerialNumber != "" {
+		// all versions of a BOM share the same serial number
+		cdxBOM.SerialNumber = s.Document.SerialNumber
+	}
+	if s.Document.Version > 0 {
+		cdxBOM.Version = s.Document.Version
+	}
+	cdxBOM.ExternalReferences = toPreviousBomReference(s.Document.Previous)
 	cdxBOM.Metadata = toBomDescriptor(s.Descriptor.Name, s.Descriptor.Version, s.Source)
+	if !s.Document.Timestamp.IsZero() {
+		cdxBOM.Metadata.Timestamp = s.Document.Timestamp.Format(time.RFC3339)
+	}
 
 	packages := s.Artifacts.Packages.Sorted()
-	components := make([]cyclonedx.Component, len(packages))
-	for i, p := range packages {
-		components[i] = helpers.EncodeComponent(p)
+	components := make([]cyclonedx.Component, 0, len(packages))
+	var services []cyclonedx.Service
+	for _, p := range packages {
+		if helpers.IsService(p) {
+			services = append(services, helpers.EncodeService(p))
+			continue
+		}
+		component := helpers.EncodeComponent(p)
+		if f, ok := s.Artifacts.PackageFootprints[p.ID()]; ok {
+			helpers.AddFootprintProperties(&component, f)
+		}
+		components = append(components, component)
 	}
 	components = append(components, toOSComponent(s.Artifacts.LinuxDistribution)...)
 	cdxBOM.Components = &components
+	if len(services) > 0 {
+		cdxBOM.Services = &services
+	}
 
 	dependencies := toDependencies(s.Relationships)
 	if len(dependencies) > 0 {
@@ -44,6 +67,21 @@ func ToFormatModel(s sbom.SBOM) *cyclonedx.BOM {
 	return cdxBOM
 }
 
+// toPreviousBomReference returns a BOM-Link (see https://cyclonedx.org/capabilities/bomlink/) to the document that this
+// BOM supersedes.
+func toPreviousBomReference(previous *sbom.PreviousDocument) *[]cyclonedx.ExternalReference {
+	if previous == nil || previous.SerialNumber == "" || previous.Version < 1 {
+		return nil
+	}
+	return &[]cyclonedx.ExternalReference{
+		{
+			URL:     fmt.Sprintf("urn:cdx:%s/%d", strings.TrimPrefix(previous.SerialNumber, "urn:uuid:"), previous.Version),
+			Type:    cyclonedx.ERTypeBOM,
+			Comment: "previous version",
+		},
+	}
+}
+
 func toOSComponent(distro *linux.Release) []cyclonedx.Component {
 	if distro == nil {
 		return []cyclonedx.Component{}
@@ -208,21 +246,39 @@ func toDependencies(relationships []artifact.Relationship) []cyclonedx.Dependenc
 }
 
 func toBomProperties(srcMetadata source.Description) *[]cyclonedx.Property {
-	metadata, ok := srcMetadata.Metadata.(source.ImageMetadata)
-	if ok {
-		props := helpers.EncodeProperties(metadata.Labels, "syft:image:labels")
-		// return nil if props is nil to avoid creating a pointer to a nil slice,
-		// which results in a null JSON value that does not comply with the CycloneDX schema.
-		// https://github.com/anchore/grype/issues/1759
-		if props == nil {
-			return nil
-		}
-		return &props
+	var props []cyclonedx.Property
+	if metadata, ok := srcMetadata.Metadata.(source.ImageMetadata); ok {
+		props = append(props, helpers.EncodeProperties(metadata.Labels, "syft:image:labels")...)
 	}
-	return nil
+	props = append(props, helpers.EncodeProperties(srcMetadata.Annotations, "syft:source:annotations")...)
+
+	// return nil if props is nil to avoid creating a pointer to a nil slice,
+	// which results in a null JSON value that does not comply with the CycloneDX schema.
+	// https://github.com/anchore/grype/issues/1759
+	if props == nil {
+		return nil
+	}
+	return &props
 }
 
 func toBomDescriptorComponent(srcMetadata source.Description) *cyclonedx.Component {
+	c := toBomDescriptorSourceComponent(srcMetadata)
+	if c == nil {
+		return nil
+	}
+
+	c.PackageURL = srcMetadata.PURL
+	c.CPE = srcMetadata.CPE
+	if srcMetadata.Supplier != "" {
+		c.Supplier = &cyclonedx.OrganizationalEntity{
+			Name: srcMetadata.Supplier,
+		}
+	}
+
+	return c
+}
+
+func toBomDescriptorSourceComponent(srcMetadata source.Description) *cyclonedx.Component {
 	name := srcMetadata.Name
 	version := srcMetadata.Version
 	switch metadata := srcMetadata.Metadata.(type) {
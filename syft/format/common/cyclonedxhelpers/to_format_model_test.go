@@ -3,6 +3,7 @@ package cyclonedxhelpers
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	"github.com/google/go-cmp/cmp"
@@ -143,6 +144,120 @@ func Test_relationships(t *testing.T) {
 	}
 }
 
+func Test_services(t *testing.T) {
+	library := pkg.Package{
+		Name:    "library",
+		Version: "1.0.0",
+		PURL:    "pkg:generic/library@1.0.0",
+		Type:    pkg.NpmPkg,
+	}
+	library.SetID()
+
+	service := pkg.Package{
+		Name:    "helloworld.v1.Greeter",
+		Type:    pkg.APIServicePkg,
+		FoundBy: "api-service-cataloger",
+		Metadata: pkg.APIServiceEntry{
+			Specification:        "grpc",
+			SpecificationVersion: "proto3",
+			Endpoints:            []string{"/helloworld.v1.Greeter/SayHello"},
+		},
+	}
+	service.SetID()
+
+	bom := ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(library, service),
+		},
+	})
+
+	require.NotNil(t, bom.Components)
+	require.Len(t, *bom.Components, 1)
+	assert.Equal(t, "library", (*bom.Components)[0].Name)
+
+	require.NotNil(t, bom.Services)
+	require.Len(t, *bom.Services, 1)
+	got := (*bom.Services)[0]
+	assert.Equal(t, string(service.ID()), got.BOMRef)
+	assert.Equal(t, "helloworld.v1.Greeter", got.Name)
+	require.NotNil(t, got.Endpoints)
+	assert.Equal(t, []string{"/helloworld.v1.Greeter/SayHello"}, *got.Endpoints)
+
+	decoded, err := helpers.ToSyftModel(bom)
+	require.NoError(t, err)
+	assert.Equal(t, 2, decoded.Artifacts.Packages.PackageCount())
+	var services []pkg.Package
+	for p := range decoded.Artifacts.Packages.Enumerate(pkg.APIServicePkg) {
+		services = append(services, p)
+	}
+	require.Len(t, services, 1)
+	assert.Equal(t, service.Metadata, services[0].Metadata)
+}
+
+func Test_footprintProperties(t *testing.T) {
+	curl := pkg.Package{
+		Name:    "curl",
+		Version: "8.5.0",
+		Type:    pkg.RpmPkg,
+	}
+	curl.SetID()
+
+	bom := ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(curl),
+			PackageFootprints: map[artifact.ID]pkg.Footprint{
+				curl.ID(): {Size: 270, FileCount: 3},
+			},
+		},
+	})
+
+	require.NotNil(t, bom.Components)
+	require.Len(t, *bom.Components, 1)
+	require.NotNil(t, (*bom.Components)[0].Properties)
+	assert.Subset(t, *(*bom.Components)[0].Properties, []cyclonedx.Property{
+		{Name: "syft:package:installedSize", Value: "270"},
+		{Name: "syft:package:fileCount", Value: "3"},
+	})
+}
+
+func Test_documentLineage(t *testing.T) {
+	bom := ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+		Document: sbom.Document{
+			SerialNumber: "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+			Version:      3,
+			Timestamp:    time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			Previous: &sbom.PreviousDocument{
+				SerialNumber: "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+				Version:      2,
+			},
+		},
+	})
+
+	assert.Equal(t, "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79", bom.SerialNumber)
+	assert.Equal(t, 3, bom.Version)
+	assert.Equal(t, "2024-05-01T12:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, &[]cyclonedx.ExternalReference{
+		{
+			URL:     "urn:cdx:3e671687-395b-41f5-a30f-a58921a69b79/2",
+			Type:    cyclonedx.ERTypeBOM,
+			Comment: "previous version",
+		},
+	}, bom.ExternalReferences)
+
+	// without lineage a new serial number is generated for the first version of the BOM
+	bom = ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+	})
+	assert.Regexp(t, "^urn:uuid:", bom.SerialNumber)
+	assert.Equal(t, 1, bom.Version)
+	assert.Nil(t, bom.ExternalReferences)
+}
+
 func Test_toBomDescriptor(t *testing.T) {
 	type args struct {
 		name        string
@@ -217,6 +332,45 @@ func Test_toBomDescriptor(t *testing.T) {
 					},
 				}},
 		},
+		{
+			name: "with top-level component identity",
+			args: args{
+				name:    "test-image",
+				version: "1.0.0",
+				srcMetadata: source.Description{
+					Name:     "my-app",
+					Version:  "2.3.4",
+					Supplier: "Acme, Inc.",
+					PURL:     "pkg:generic/acme/my-app@2.3.4",
+					CPE:      "cpe:2.3:a:acme:my-app:2.3.4:*:*:*:*:*:*:*",
+					Metadata: source.DirectoryMetadata{
+						Path: "/some/path",
+					},
+				},
+			},
+			want: &cyclonedx.Metadata{
+				Tools: &cyclonedx.ToolsChoice{
+					Components: &[]cyclonedx.Component{
+						{
+							Type:    cyclonedx.ComponentTypeApplication,
+							Author:  "anchore",
+							Name:    "test-image",
+							Version: "1.0.0",
+						},
+					},
+				},
+				Component: &cyclonedx.Component{
+					Type:    cyclonedx.ComponentTypeFile,
+					Name:    "my-app",
+					Version: "2.3.4",
+					Supplier: &cyclonedx.OrganizationalEntity{
+						Name: "Acme, Inc.",
+					},
+					CPE:        "cpe:2.3:a:acme:my-app:2.3.4:*:*:*:*:*:*:*",
+					PackageURL: "pkg:generic/acme/my-app@2.3.4",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -273,6 +427,35 @@ func Test_toBomProperties(t *testing.T) {
 			},
 			props: nil,
 		},
+		{
+			name: "ImageMetadata with labels and annotations",
+			srcMetadata: source.Description{
+				Metadata: source.ImageMetadata{
+					Labels: map[string]string{
+						"label1": "value1",
+					},
+				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
+			},
+			props: &[]cyclonedx.Property{
+				{Name: "syft:image:labels:label1", Value: "value1"},
+				{Name: "syft:source:annotations:linux:crypto:fips-mode", Value: "enabled"},
+			},
+		},
+		{
+			name: "annotations for any source",
+			srcMetadata: source.Description{
+				Metadata: source.DirectoryMetadata{},
+				Annotations: map[string]string{
+					"linux:crypto:policy": "FIPS",
+				},
+			},
+			props: &[]cyclonedx.Property{
+				{Name: "syft:source:annotations:linux:crypto:policy", Value: "FIPS"},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/distribution/reference"
+	"github.com/github/go-spdx/v2/spdxexp"
 	"github.com/spdx/tools-golang/spdx"
 
 	"github.com/anchore/packageurl-go"
@@ -45,6 +46,14 @@ const (
 //nolint:funlen
 func ToFormatModel(s sbom.SBOM) *spdx.Document {
 	name, namespace := helpers.DocumentNameAndNamespace(s.Source, s.Descriptor)
+	if s.Document.Namespace != "" {
+		namespace = s.Document.Namespace
+	}
+
+	created := time.Now()
+	if !s.Document.Timestamp.IsZero() {
+		created = s.Document.Timestamp
+	}
 
 	rels := relationship.NewIndex(s.Relationships...)
 	packages := toPackages(rels, s.Artifacts.Packages, s)
@@ -79,6 +88,13 @@ func ToFormatModel(s sbom.SBOM) *spdx.Document {
 	// add the root document relationship
 	allRelationships = append(allRelationships, documentDescribesRelationship)
 
+	previousRef, previousRelationship := toPreviousDocument(s.Document.Previous)
+	if previousRelationship != nil {
+		allRelationships = append(allRelationships, previousRelationship)
+	}
+
+	files := toFiles(s)
+
 	return &spdx.Document{
 		// 6.1: SPDX Version; should be in the format "SPDX-x.x"
 		// Cardinality: mandatory, one
@@ -117,7 +133,7 @@ func ToFormatModel(s sbom.SBOM) *spdx.Document {
 
 		// 6.6: External Document References
 		// Cardinality: optional, one or many
-		ExternalDocumentReferences: nil,
+		ExternalDocumentReferences: previousRef,
 
 		// 6.11: Document Comment
 		// Cardinality: optional, one
@@ -144,19 +160,49 @@ func ToFormatModel(s sbom.SBOM) *spdx.Document {
 
 			// 6.9: Created: data format YYYY-MM-DDThh:mm:ssZ
 			// Cardinality: mandatory, one
-			Created: time.Now().UTC().Format(time.RFC3339),
+			Created: created.UTC().Format(time.RFC3339),
 
 			// 6.10: Creator Comment
 			// Cardinality: optional, one
 			CreatorComment: "",
 		},
 		Packages:      packages,
-		Files:         toFiles(s),
+		Files:         files,
+		Snippets:      toSnippets(files),
 		Relationships: allRelationships,
 		OtherLicenses: toOtherLicenses(s.Artifacts.Packages),
 	}
 }
 
+// toPreviousDocument returns a reference to the document that this document supersedes along with the relationship
+// stating that this document amends it. SPDX requires the checksum of referenced documents, so the previous document
+// is only referenced when its checksum is known.
+func toPreviousDocument(previous *sbom.PreviousDocument) ([]spdx.ExternalDocumentRef, *spdx.Relationship) {
+	if previous == nil || previous.Namespace == "" || previous.SHA1 == "" {
+		return nil, nil
+	}
+
+	ref := spdx.ExternalDocumentRef{
+		DocumentRefID: "previous",
+		URI:           previous.Namespace,
+		Checksum: spdx.Checksum{
+			Algorithm: spdx.SHA1,
+			Value:     previous.SHA1,
+		},
+	}
+
+	return []spdx.ExternalDocumentRef{ref}, &spdx.Relationship{
+		RefA: spdx.DocElementID{
+			ElementRefID: "DOCUMENT",
+		},
+		Relationship: string(helpers.AmendsRelationship),
+		RefB: spdx.DocElementID{
+			DocumentRefID: ref.DocumentRefID,
+			ElementRefID:  "DOCUMENT",
+		},
+	}
+}
+
 func toRootRelationships(rootPackage *spdx.Package, packages []*spdx.Package) (out []*spdx.Relationship) {
 	for _, p := range packages {
 		out = append(out, &spdx.Relationship{
@@ -243,27 +289,56 @@ func toRootPackage(s source.Description) *spdx.Package {
 		PackageChecksums:          checksums,
 		PackageExternalReferences: nil,
 		PrimaryPackagePurpose:     purpose,
-		PackageSupplier: &spdx.Supplier{
-			Supplier: helpers.NOASSERTION,
-		},
-		PackageDownloadLocation: helpers.NOASSERTION,
-		PackageLicenseConcluded: helpers.NOASSERTION,
-		PackageLicenseDeclared:  helpers.NOASSERTION,
+		PackageSupplier:           toRootPackageSupplier(s.Supplier),
+		PackageDownloadLocation:   helpers.NOASSERTION,
+		PackageLicenseConcluded:   helpers.NOASSERTION,
+		PackageLicenseDeclared:    helpers.NOASSERTION,
 	}
 
-	if purl != nil {
-		p.PackageExternalReferences = []*spdx.PackageExternalReference{
-			{
-				Category: string(helpers.PackageManagerReferenceCategory),
-				RefType:  string(helpers.PurlExternalRefType),
-				Locator:  purl.String(),
-			},
-		}
+	// a user provided package URL takes precedence over any derived from the source
+	purlLocator := s.PURL
+	if purlLocator == "" && purl != nil {
+		purlLocator = purl.String()
+	}
+
+	if purlLocator != "" {
+		p.PackageExternalReferences = append(p.PackageExternalReferences, &spdx.PackageExternalReference{
+			Category: string(helpers.PackageManagerReferenceCategory),
+			RefType:  string(helpers.PurlExternalRefType),
+			Locator:  purlLocator,
+		})
+	}
+
+	if s.CPE != "" {
+		p.PackageExternalReferences = append(p.PackageExternalReferences, &spdx.PackageExternalReference{
+			Category: string(helpers.SecurityReferenceCategory),
+			RefType:  string(helpers.Cpe23ExternalRefType),
+			Locator:  s.CPE,
+		})
 	}
 
 	return p
 }
 
+// toRootPackageSupplier returns the supplier of the root package (which is always considered to be an organization).
+func toRootPackageSupplier(supplier string) *spdx.Supplier {
+	if supplier == "" {
+		return &spdx.Supplier{
+			Supplier: helpers.NOASSERTION,
+		}
+	}
+
+	return &spdx.Supplier{
+		Supplier:     supplier,
+		SupplierType: "Organization",
+	}
+}
+
+// PackageSPDXID returns the SPDX identifier (without the "SPDXRef-" prefix) that the given package is encoded with.
+func PackageSPDXID(p pkg.Package) spdx.ElementID {
+	return toSPDXID(p)
+}
+
 func toSPDXID(identifiable artifact.Identifiable) spdx.ElementID {
 	maxLen := 40
 	id := ""
@@ -613,13 +688,15 @@ func toFiles(s sbom.SBOM) (results []*spdx.File) {
 
 		// TODO: add file classifications (?) and content as a snippet
 
+		fileID := toSPDXID(coordinates)
+
 		var comment string
 		if coordinates.FileSystemID != "" {
 			comment = fmt.Sprintf("layerID: %s", coordinates.FileSystemID)
 		}
 
 		results = append(results, &spdx.File{
-			FileSPDXIdentifier: toSPDXID(coordinates),
+			FileSPDXIdentifier: fileID,
 			FileComment:        comment,
 			// required, no attempt made to determine license information
 			LicenseConcluded: noAssertion,
@@ -629,6 +706,7 @@ func toFiles(s sbom.SBOM) (results []*spdx.File) {
 			LicenseInfoInFiles: []string{ // required in SPDX 2.2
 				helpers.NOASSERTION,
 			},
+			Snippets: toFileSnippets(fileID, artifacts.FileSnippets[coordinates]),
 		})
 	}
 
@@ -642,6 +720,104 @@ func toFiles(s sbom.SBOM) (results []*spdx.File) {
 	return results
 }
 
+// toSnippets returns the snippets of all files, in file order.
+func toSnippets(files []*spdx.File) (results []spdx.Snippet) {
+	for _, f := range files {
+		var snippets []spdx.Snippet
+		for _, s := range f.Snippets {
+			snippets = append(snippets, *s)
+		}
+		sort.Slice(snippets, func(i, j int) bool {
+			return snippets[i].Ranges[0].StartPointer.Offset < snippets[j].Ranges[0].StartPointer.Offset
+		})
+		results = append(results, snippets...)
+	}
+	return results
+}
+
+// toFileSnippets returns the regions of a file with their own license or copyright evidence (e.g. third-party code
+// bundled into a larger file) as snippets of the file. Snippets are attached to the file as well as listed in the
+// document, since the tag-value format only describes snippets within their file.
+func toFileSnippets(fileID spdx.ElementID, snippets []file.Snippet) map[spdx.ElementID]*spdx.Snippet {
+	if len(snippets) == 0 {
+		return nil
+	}
+
+	results := make(map[spdx.ElementID]*spdx.Snippet)
+	for _, s := range snippets {
+		id := spdx.ElementID(helpers.SanitizeElementID(fmt.Sprintf("Snippet-%s-%d", strings.TrimPrefix(string(fileID), prefixFile+"-"), s.Offset)))
+
+		copyrightText := noAssertion
+		if len(s.Copyrights) > 0 {
+			copyrightText = strings.Join(s.Copyrights, "\n")
+		}
+
+		results[id] = &spdx.Snippet{
+			// 9.1: Snippet SPDX Identifier: "SPDXRef-[idstring]"
+			// Cardinality: mandatory, one
+			SnippetSPDXIdentifier: id,
+
+			// 9.2: Snippet from File SPDX Identifier
+			// Cardinality: mandatory, one
+			SnippetFromFileSPDXIdentifier: fileID,
+
+			// 9.3: Snippet Byte Range: [start byte]:[end byte] (one-based and inclusive)
+			// Cardinality: mandatory, one
+			// 9.4: Snippet Line Range: [start line]:[end line]
+			// Cardinality: optional, one
+			Ranges: []spdx.SnippetRange{
+				{
+					StartPointer: spdx.SnippetRangePointer{Offset: s.Offset + 1, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{Offset: s.Offset + s.Extent, FileSPDXIdentifier: fileID},
+				},
+				{
+					StartPointer: spdx.SnippetRangePointer{LineNumber: s.StartLine, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{LineNumber: s.EndLine, FileSPDXIdentifier: fileID},
+				},
+			},
+
+			// 9.5: Snippet Concluded License: SPDX License Expression, "NONE" or "NOASSERTION"
+			// Cardinality: optional, one
+			// no attempt is made to conclude a license beyond what the snippet declares
+			SnippetLicenseConcluded: noAssertion,
+
+			// 9.6: License Information in Snippet: SPDX License Expression, "NONE" or "NOASSERTION"
+			// Cardinality: optional, one or many
+			LicenseInfoInSnippet: toSnippetLicenseInfo(s.Licenses),
+
+			// 9.8: Snippet Copyright Text: copyright notice(s) text, "NONE" or "NOASSERTION"
+			// Cardinality: mandatory, one
+			SnippetCopyrightText: copyrightText,
+
+			// 9.10: Snippet Name
+			// Cardinality: optional, one
+			SnippetName: strings.TrimSpace(s.Name + " " + s.Version),
+		}
+	}
+	return results
+}
+
+// toSnippetLicenseInfo returns the license IDs referenced by the given license expressions.
+func toSnippetLicenseInfo(expressions []string) []string {
+	var ids []string
+	for _, expression := range expressions {
+		licenses, err := spdxexp.ExtractLicenses(expression)
+		if err != nil {
+			log.WithFields("error", err, "expression", expression).Trace("unable to extract licenses from snippet license expression")
+			continue
+		}
+		for _, l := range licenses {
+			if !slices.Contains(ids, l) {
+				ids = append(ids, l)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return []string{noAssertion}
+	}
+	return ids
+}
+
 func toFileChecksums(digests []file.Digest) (checksums []spdx.Checksum) {
 	checksums = make([]spdx.Checksum, 0, len(digests))
 	for _, digest := range digests {
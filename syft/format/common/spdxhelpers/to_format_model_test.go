@@ -3,7 +3,9 @@ package spdxhelpers
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -264,6 +266,51 @@ func Test_toFormatModel(t *testing.T) {
 	}
 }
 
+func Test_toFormatModel_documentLineage(t *testing.T) {
+	doc := ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+		Document: sbom.Document{
+			Timestamp: time.Date(2024, 5, 1, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			Previous: &sbom.PreviousDocument{
+				Namespace: "https://anchore.com/dir/my-app-b3553b0c-32bf-4bb3-96a1-263bcb922f10",
+				SHA1:      "1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e",
+			},
+		},
+	})
+
+	assert.Equal(t, "2024-05-01T12:00:00Z", doc.CreationInfo.Created)
+	assert.Equal(t, []spdx.ExternalDocumentRef{
+		{
+			DocumentRefID: "previous",
+			URI:           "https://anchore.com/dir/my-app-b3553b0c-32bf-4bb3-96a1-263bcb922f10",
+			Checksum:      spdx.Checksum{Algorithm: spdx.SHA1, Value: "1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e"},
+		},
+	}, doc.ExternalDocumentReferences)
+	assert.Contains(t, doc.Relationships, &spdx.Relationship{
+		RefA:         spdx.DocElementID{ElementRefID: "DOCUMENT"},
+		Relationship: string(helpers.AmendsRelationship),
+		RefB:         spdx.DocElementID{DocumentRefID: "previous", ElementRefID: "DOCUMENT"},
+	})
+
+	// the previous document cannot be referenced without its checksum
+	doc = ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+		},
+		Document: sbom.Document{
+			Namespace: "https://anchore.com/dir/my-app-0ce56c7f-b603-4a87-bfa6-e73f6a7e71ab",
+			Previous: &sbom.PreviousDocument{
+				Namespace: "https://anchore.com/dir/my-app-b3553b0c-32bf-4bb3-96a1-263bcb922f10",
+			},
+		},
+	})
+
+	assert.Equal(t, "https://anchore.com/dir/my-app-0ce56c7f-b603-4a87-bfa6-e73f6a7e71ab", doc.DocumentNamespace)
+	assert.Nil(t, doc.ExternalDocumentReferences)
+}
+
 func Test_toPackageChecksums(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -517,6 +564,110 @@ func Test_toFileChecksums(t *testing.T) {
 	}
 }
 
+func Test_toFileSnippets(t *testing.T) {
+	coordinates := file.NewCoordinates("/app/dist/app.min.js", "")
+	fileID := toSPDXID(coordinates)
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(),
+			FileSnippets: map[file.Coordinates][]file.Snippet{
+				coordinates: {
+					{
+						Name:       "React",
+						Offset:     507,
+						Extent:     233,
+						StartLine:  12,
+						EndLine:    19,
+						Licenses:   []string{"Apache-2.0 OR MIT"},
+						Copyrights: []string{"Copyright A", "Copyright B"},
+					},
+					{
+						Name:       "jQuery",
+						Version:    "3.7.1",
+						Offset:     61,
+						Extent:     88,
+						StartLine:  2,
+						EndLine:    2,
+						Copyrights: []string{"(c) OpenJS Foundation"},
+					},
+				},
+			},
+		},
+	}
+
+	doc := ToFormatModel(s)
+
+	require.Len(t, doc.Files, 1)
+	assert.Len(t, doc.Files[0].Snippets, 2)
+
+	expected := []spdx.Snippet{
+		{
+			SnippetSPDXIdentifier:         spdx.ElementID("Snippet-" + strings.TrimPrefix(string(fileID), "File-") + "-61"),
+			SnippetFromFileSPDXIdentifier: fileID,
+			Ranges: []spdx.SnippetRange{
+				{
+					StartPointer: spdx.SnippetRangePointer{Offset: 62, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{Offset: 149, FileSPDXIdentifier: fileID},
+				},
+				{
+					StartPointer: spdx.SnippetRangePointer{LineNumber: 2, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{LineNumber: 2, FileSPDXIdentifier: fileID},
+				},
+			},
+			SnippetLicenseConcluded: "NOASSERTION",
+			LicenseInfoInSnippet:    []string{"NOASSERTION"},
+			SnippetCopyrightText:    "(c) OpenJS Foundation",
+			SnippetName:             "jQuery 3.7.1",
+		},
+		{
+			SnippetSPDXIdentifier:         spdx.ElementID("Snippet-" + strings.TrimPrefix(string(fileID), "File-") + "-507"),
+			SnippetFromFileSPDXIdentifier: fileID,
+			Ranges: []spdx.SnippetRange{
+				{
+					StartPointer: spdx.SnippetRangePointer{Offset: 508, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{Offset: 740, FileSPDXIdentifier: fileID},
+				},
+				{
+					StartPointer: spdx.SnippetRangePointer{LineNumber: 12, FileSPDXIdentifier: fileID},
+					EndPointer:   spdx.SnippetRangePointer{LineNumber: 19, FileSPDXIdentifier: fileID},
+				},
+			},
+			SnippetLicenseConcluded: "NOASSERTION",
+			LicenseInfoInSnippet:    []string{"Apache-2.0", "MIT"},
+			SnippetCopyrightText:    "Copyright A\nCopyright B",
+			SnippetName:             "React",
+		},
+	}
+	assert.Equal(t, expected, doc.Snippets)
+
+	// converting back restores the snippets (although the version is no longer separate from the name, and license
+	// expressions are reduced to the licenses they refer to)
+	actual, err := ToSyftModel(doc)
+	require.NoError(t, err)
+	assert.Equal(t, map[file.Coordinates][]file.Snippet{
+		coordinates: {
+			{
+				Name:       "jQuery 3.7.1",
+				Offset:     61,
+				Extent:     88,
+				StartLine:  2,
+				EndLine:    2,
+				Copyrights: []string{"(c) OpenJS Foundation"},
+			},
+			{
+				Name:       "React",
+				Offset:     507,
+				Extent:     233,
+				StartLine:  12,
+				EndLine:    19,
+				Licenses:   []string{"Apache-2.0", "MIT"},
+				Copyrights: []string{"Copyright A", "Copyright B"},
+			},
+		},
+	}, actual.Artifacts.FileSnippets)
+}
+
 func Test_fileIDsForPackage(t *testing.T) {
 	p := pkg.Package{
 		Name: "bogus",
@@ -780,6 +931,82 @@ func Test_toSPDXID(t *testing.T) {
 	}
 }
 
+func Test_toRootPackage_identity(t *testing.T) {
+	tests := []struct {
+		name         string
+		src          source.Description
+		wantSupplier *spdx.Supplier
+		wantRefs     []*spdx.PackageExternalReference
+	}{
+		{
+			name: "no identity",
+			src: source.Description{
+				Name:     "some/path",
+				Metadata: source.DirectoryMetadata{Path: "some/path"},
+			},
+			wantSupplier: &spdx.Supplier{
+				Supplier: helpers.NOASSERTION,
+			},
+		},
+		{
+			name: "supplier, package URL and CPE",
+			src: source.Description{
+				Name:     "my-app",
+				Version:  "2.3.4",
+				Supplier: "Acme, Inc.",
+				PURL:     "pkg:generic/acme/my-app@2.3.4",
+				CPE:      "cpe:2.3:a:acme:my-app:2.3.4:*:*:*:*:*:*:*",
+				Metadata: source.DirectoryMetadata{Path: "some/path"},
+			},
+			wantSupplier: &spdx.Supplier{
+				Supplier:     "Acme, Inc.",
+				SupplierType: "Organization",
+			},
+			wantRefs: []*spdx.PackageExternalReference{
+				{
+					Category: string(helpers.PackageManagerReferenceCategory),
+					RefType:  string(helpers.PurlExternalRefType),
+					Locator:  "pkg:generic/acme/my-app@2.3.4",
+				},
+				{
+					Category: string(helpers.SecurityReferenceCategory),
+					RefType:  string(helpers.Cpe23ExternalRefType),
+					Locator:  "cpe:2.3:a:acme:my-app:2.3.4:*:*:*:*:*:*:*",
+				},
+			},
+		},
+		{
+			name: "package URL overrides the image package URL",
+			src: source.Description{
+				Name: "my-image",
+				PURL: "pkg:oci/my-image@sha256%3Aabc",
+				Metadata: source.ImageMetadata{
+					UserInput:      "my-image:latest",
+					ManifestDigest: "sha256:2731251dc34951c0e50fcc643b4c5f74922dad1a5d98f302b504cf46cd5d9368",
+				},
+			},
+			wantSupplier: &spdx.Supplier{
+				Supplier: helpers.NOASSERTION,
+			},
+			wantRefs: []*spdx.PackageExternalReference{
+				{
+					Category: string(helpers.PackageManagerReferenceCategory),
+					RefType:  string(helpers.PurlExternalRefType),
+					Locator:  "pkg:oci/my-image@sha256%3Aabc",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toRootPackage(tt.src)
+			assert.Equal(t, tt.wantSupplier, got.PackageSupplier)
+			assert.Equal(t, tt.wantRefs, got.PackageExternalReferences)
+		})
+	}
+}
+
 func Test_otherLicenses(t *testing.T) {
 	pkg1 := pkg.Package{
 		Name:    "first-pkg",
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -41,17 +42,67 @@ func ToSyftModel(doc *spdx.Document) (*sbom.SBOM, error) {
 			FileDigests:       map[file.Coordinates][]file.Digest{},
 			LinuxDistribution: findLinuxReleaseByPURL(doc),
 		},
+		Document: sbom.Document{
+			Namespace: doc.DocumentNamespace,
+		},
 	}
 
 	collectSyftPackages(s, spdxIDMap, doc.Packages)
 
 	collectSyftFiles(s, spdxIDMap, doc)
 
+	collectSyftSnippets(s, spdxIDMap, doc)
+
 	s.Relationships = toSyftRelationships(spdxIDMap, doc)
 
 	return s, nil
 }
 
+// UnresolvedRelationships returns a warning for each element referenced by the document relationships that is not
+// defined in the document (such relationships are dropped by ToSyftModel). References to elements in other documents
+// are not considered.
+func UnresolvedRelationships(doc *spdx.Document) []string {
+	if doc == nil {
+		return nil
+	}
+
+	defined := map[spdx.ElementID]bool{
+		doc.SPDXIdentifier: true,
+	}
+	for _, p := range doc.Packages {
+		defined[p.PackageSPDXIdentifier] = true
+		for _, f := range p.Files {
+			defined[f.FileSPDXIdentifier] = true
+		}
+	}
+	for _, f := range doc.Files {
+		defined[f.FileSPDXIdentifier] = true
+	}
+	for _, s := range doc.Snippets {
+		defined[s.SnippetSPDXIdentifier] = true
+	}
+
+	var warnings []string
+	reported := make(map[spdx.ElementID]bool)
+	check := func(ref common.DocElementID) {
+		if ref.DocumentRefID != "" || ref.SpecialID != "" || defined[ref.ElementRefID] || reported[ref.ElementRefID] {
+			return
+		}
+		reported[ref.ElementRefID] = true
+		warnings = append(warnings, fmt.Sprintf("relationship refers to undefined element %q", "SPDXRef-"+string(ref.ElementRefID)))
+	}
+	for _, r := range doc.Relationships {
+		check(r.RefA)
+		check(r.RefB)
+	}
+	return warnings
+}
+
+// PackageID returns the ID of the package that the given SPDX package is converted into.
+func PackageID(p *spdx.Package) artifact.ID {
+	return toSyftPackage(p).ID()
+}
+
 func isDirectory(name string) bool {
 	if name == "." || name == ".." || strings.HasSuffix(name, "/") || !strings.Contains(path.Base(name), ".") {
 		return true
@@ -305,6 +356,76 @@ func collectSyftFiles(s *sbom.SBOM, spdxIDMap map[string]any, doc *spdx.Document
 	}
 }
 
+// collectSyftSnippets adds the snippets of known files, which are listed by the document (JSON) or within their
+// file (tag-value).
+func collectSyftSnippets(s *sbom.SBOM, spdxIDMap map[string]any, doc *spdx.Document) {
+	var snippets []*spdx.Snippet
+	for i := range doc.Snippets {
+		snippets = append(snippets, &doc.Snippets[i])
+	}
+	files := doc.Files
+	for _, p := range doc.Packages {
+		files = append(files, p.Files...)
+	}
+	for _, f := range files {
+		for _, snippet := range f.Snippets {
+			snippets = append(snippets, snippet)
+		}
+	}
+
+	seen := make(map[spdx.ElementID]bool)
+	for _, snippet := range snippets {
+		if snippet == nil || seen[snippet.SnippetSPDXIdentifier] {
+			continue
+		}
+		seen[snippet.SnippetSPDXIdentifier] = true
+
+		l, ok := spdxIDMap[string(snippet.SnippetFromFileSPDXIdentifier)].(file.Location)
+		if !ok {
+			continue
+		}
+		if s.Artifacts.FileSnippets == nil {
+			s.Artifacts.FileSnippets = make(map[file.Coordinates][]file.Snippet)
+		}
+		s.Artifacts.FileSnippets[l.Coordinates] = append(s.Artifacts.FileSnippets[l.Coordinates], toSyftSnippet(snippet))
+	}
+
+	for _, fileSnippets := range s.Artifacts.FileSnippets {
+		sort.Slice(fileSnippets, func(i, j int) bool {
+			return fileSnippets[i].Offset < fileSnippets[j].Offset
+		})
+	}
+}
+
+func toSyftSnippet(snippet *spdx.Snippet) file.Snippet {
+	s := file.Snippet{
+		Name: snippet.SnippetName,
+	}
+	for _, r := range snippet.Ranges {
+		if r.StartPointer.Offset > 0 && r.EndPointer.Offset >= r.StartPointer.Offset {
+			s.Offset = r.StartPointer.Offset - 1
+			s.Extent = r.EndPointer.Offset - r.StartPointer.Offset + 1
+		}
+		if r.StartPointer.LineNumber > 0 && r.EndPointer.LineNumber >= r.StartPointer.LineNumber {
+			s.StartLine = r.StartPointer.LineNumber
+			s.EndLine = r.EndPointer.LineNumber
+		}
+	}
+	for _, l := range snippet.LicenseInfoInSnippet {
+		if l != helpers.NOASSERTION && l != helpers.NONE {
+			s.Licenses = append(s.Licenses, l)
+		}
+	}
+	if text := snippet.SnippetCopyrightText; text != helpers.NOASSERTION && text != helpers.NONE {
+		for _, c := range strings.Split(text, "\n") {
+			if c = strings.TrimSpace(c); c != "" {
+				s.Copyrights = append(s.Copyrights, c)
+			}
+		}
+	}
+	return s
+}
+
 func toFileDigests(f *spdx.File) (digests []file.Digest) {
 	for _, digest := range f.Checksums {
 		digests = append(digests, file.Digest{
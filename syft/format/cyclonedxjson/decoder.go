@@ -1,6 +1,7 @@
 package cyclonedxjson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,7 +15,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*decoder)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*decoder)(nil)
 
 type decoder struct {
 	decoder cyclonedxutil.Decoder
@@ -27,30 +28,55 @@ func NewFormatDecoder() sbom.FormatDecoder {
 }
 
 func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := d.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+func (d decoder) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", err
+		return nil, "", "", nil, err
 	}
 
 	id, version := d.Identify(reader)
 	if id != ID {
-		return nil, "", "", fmt.Errorf("not a cyclonedx json document")
+		return nil, "", "", nil, fmt.Errorf("not a cyclonedx json document")
 	}
 	if version == "" {
-		return nil, "", "", fmt.Errorf("unsupported cyclonedx json document version")
+		return nil, "", "", nil, fmt.Errorf("unsupported cyclonedx json document version")
 	}
 
-	doc, err := d.decoder.Decode(reader)
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return nil, id, version, nil, fmt.Errorf("unable to seek to start of CycloneDX JSON SBOM: %w", err)
+	}
+
+	raw, err := io.ReadAll(reader)
 	if err != nil {
-		return nil, id, version, fmt.Errorf("unable to decode cyclonedx json document: %w", err)
+		return nil, id, version, nil, fmt.Errorf("unable to read cyclonedx json document: %w", err)
+	}
+
+	doc, err := d.decoder.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, id, version, nil, fmt.Errorf("unable to decode cyclonedx json document: %w", err)
 	}
 
 	s, err := helpers.ToSyftModel(doc)
 	if err != nil {
-		return nil, id, version, err
+		return nil, id, version, nil, err
+	}
+
+	warnings := helpers.UnresolvedDependencies(doc)
+
+	extensions, err := captureExtensions(raw, doc)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("unable to preserve unknown fields: %v", err))
 	}
+	s.Extensions = extensions
 
-	return s, id, version, nil
+	return s, id, version, warnings, nil
 }
 
 func (d decoder) Identify(r io.Reader) (sbom.FormatID, string) {
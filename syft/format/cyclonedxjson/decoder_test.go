@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anchore/syft/syft/format/internal/testutil"
 	"github.com/anchore/syft/syft/sbom"
 )
 
@@ -131,3 +132,28 @@ func TestDecoder_Identify(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoder_DecodeWithWarnings(t *testing.T) {
+	input := `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "components": [
+    {"bom-ref": "pkg:npm/left-pad@1.3.0", "type": "library", "name": "left-pad", "version": "1.3.0"}
+  ],
+  "dependencies": [
+    {"ref": "pkg:npm/left-pad@1.3.0", "dependsOn": ["pkg:npm/missing@1.0.0"]}
+  ]
+}`
+
+	dec := NewFormatDecoder().(sbom.FormatDecoderWithWarnings)
+	s, _, _, warnings, err := dec.DecodeWithWarnings(strings.NewReader(input))
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, []string{`dependency refers to undefined bom-ref "pkg:npm/missing@1.0.0"`}, warnings)
+}
+
+func TestDecoder_PreservesUnknownFields(t *testing.T) {
+	enc, err := NewFormatEncoderWithConfig(DefaultEncoderConfig())
+	require.NoError(t, err)
+	testutil.AssertUnknownFieldsRoundTrip(t, enc, NewFormatDecoder().(sbom.FormatDecoderWithWarnings), "components")
+}
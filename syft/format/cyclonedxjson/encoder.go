@@ -1,6 +1,10 @@
 package cyclonedxjson
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+
 	"github.com/CycloneDX/cyclonedx-go"
 
 	"github.com/anchore/syft/syft/format/internal/cyclonedxutil"
@@ -52,3 +56,28 @@ func (e encoder) Aliases() []string {
 func (e encoder) Version() string {
 	return e.cfg.Version
 }
+
+func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
+	if s.Extensions.Format != ID || s.Extensions.Empty() {
+		return e.Encoder.Encode(writer, s)
+	}
+
+	// fields from the decoded document that syft does not understand are added back to the encoded document
+	var buf bytes.Buffer
+	if err := e.Encoder.Encode(&buf, s); err != nil {
+		return err
+	}
+
+	indent := ""
+	if e.cfg.Pretty {
+		indent = "  "
+	}
+
+	out, err := restoreExtensions(buf.Bytes(), s.Extensions, s.Artifacts.Packages, indent)
+	if err != nil {
+		return fmt.Errorf("unable to restore unknown fields: %w", err)
+	}
+
+	_, err = writer.Write(out)
+	return err
+}
@@ -0,0 +1,61 @@
+package cyclonedxjson
+
+import (
+	"encoding/json"
+
+	"github.com/CycloneDX/cyclonedx-go"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/format/internal/cyclonedxutil/helpers"
+	"github.com/anchore/syft/syft/format/internal/unknownfields"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// extensionFields describes where fields that are not part of the CycloneDX specification are preserved from: the
+// BOM and each top-level component (by bom-ref, which is derived from the package when encoding).
+var extensionFields = unknownfields.Spec{
+	Document: cyclonedx.BOM{},
+	Elements: "components",
+	Element:  cyclonedx.Component{},
+	Key:      "bom-ref",
+}
+
+func captureExtensions(raw []byte, bom *cyclonedx.BOM) (sbom.Extensions, error) {
+	document, elements, err := unknownfields.Capture(raw, extensionFields)
+	if err != nil || (len(document) == 0 && len(elements) == 0) {
+		return sbom.Extensions{}, err
+	}
+
+	extensions := sbom.Extensions{
+		Format:   ID,
+		Document: document,
+	}
+	if bom.Components != nil {
+		for i := range *bom.Components {
+			c := &(*bom.Components)[i]
+			fields, ok := elements[c.BOMRef]
+			if !ok {
+				continue
+			}
+			if extensions.Packages == nil {
+				extensions.Packages = make(map[artifact.ID]map[string]json.RawMessage)
+			}
+			extensions.Packages[helpers.ComponentPackageID(c)] = fields
+		}
+	}
+	return extensions, nil
+}
+
+func restoreExtensions(encoded []byte, extensions sbom.Extensions, packages *pkg.Collection, indent string) ([]byte, error) {
+	elements := make(map[string]unknownfields.Fields)
+	for id, fields := range extensions.Packages {
+		if packages == nil {
+			break
+		}
+		if p := packages.Package(id); p != nil {
+			elements[helpers.DeriveBomRef(*p)] = fields
+		}
+	}
+	return unknownfields.Restore(encoded, extensionFields, extensions.Document, elements, indent)
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*decoder)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*decoder)(nil)
 
 type decoder struct {
 	decoder cyclonedxutil.Decoder
@@ -28,30 +28,38 @@ func NewFormatDecoder() sbom.FormatDecoder {
 }
 
 func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := d.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+func (d decoder) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", err
+		return nil, "", "", nil, err
 	}
 
 	id, version := d.Identify(reader)
 	if id != ID {
-		return nil, "", "", fmt.Errorf("not a cyclonedx xml document")
+		return nil, "", "", nil, fmt.Errorf("not a cyclonedx xml document")
 	}
 	if version == "" {
-		return nil, "", "", fmt.Errorf("unsupported cyclonedx xml document version")
+		return nil, "", "", nil, fmt.Errorf("unsupported cyclonedx xml document version")
 	}
 
 	doc, err := d.decoder.Decode(reader)
 	if err != nil {
-		return nil, id, version, fmt.Errorf("unable to decode cyclonedx xml document: %w", err)
+		return nil, id, version, nil, fmt.Errorf("unable to decode cyclonedx xml document: %w", err)
 	}
 
 	s, err := helpers.ToSyftModel(doc)
 	if err != nil {
-		return nil, id, version, err
+		return nil, id, version, nil, err
 	}
 
-	return s, id, version, nil
+	return s, id, version, helpers.UnresolvedDependencies(doc), nil
 }
 
 func (d decoder) Identify(r io.Reader) (sbom.FormatID, string) {
@@ -11,7 +11,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var staticDecoders sbom.FormatDecoder
+var staticDecoders sbom.FormatDecoderWithWarnings
 
 func init() {
 	staticDecoders = NewDecoderCollection(Decoders()...)
@@ -36,3 +36,9 @@ func Identify(reader io.Reader) (sbom.FormatID, string) {
 func Decode(reader io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
 	return staticDecoders.Decode(reader)
 }
+
+// DecodeWithWarnings takes a set of bytes and attempts to decode it into an SBOM, additionally returning non-fatal
+// problems found while decoding (which Decode only logs).
+func DecodeWithWarnings(reader io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
+	return staticDecoders.DecodeWithWarnings(reader)
+}
@@ -9,13 +9,13 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*DecoderCollection)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*DecoderCollection)(nil)
 
 type DecoderCollection struct {
 	decoders []sbom.FormatDecoder
 }
 
-func NewDecoderCollection(decoders ...sbom.FormatDecoder) sbom.FormatDecoder {
+func NewDecoderCollection(decoders ...sbom.FormatDecoder) sbom.FormatDecoderWithWarnings {
 	return &DecoderCollection{
 		decoders: decoders,
 	}
@@ -23,13 +23,23 @@ func NewDecoderCollection(decoders ...sbom.FormatDecoder) sbom.FormatDecoder {
 
 // Decode takes a set of bytes and attempts to decode it into an SBOM relative to the decoders in the collection.
 func (c *DecoderCollection) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := c.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+// DecodeWithWarnings is like Decode, additionally returning non-fatal problems found while decoding (for decoders
+// that are able to report them).
+func (c *DecoderCollection) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	if r == nil {
-		return nil, "", "", fmt.Errorf("no SBOM bytes provided")
+		return nil, "", "", nil, fmt.Errorf("no SBOM bytes provided")
 	}
 
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", fmt.Errorf("unable to create a seekable reader: %w", err)
+		return nil, "", "", nil, fmt.Errorf("unable to create a seekable reader: %w", err)
 	}
 
 	var bestID sbom.FormatID
@@ -42,14 +52,18 @@ func (c *DecoderCollection) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, stri
 			continue
 		}
 
-		return d.Decode(reader)
+		if dw, ok := d.(sbom.FormatDecoderWithWarnings); ok {
+			return dw.DecodeWithWarnings(reader)
+		}
+		s, id, version, err := d.Decode(reader)
+		return s, id, version, nil, err
 	}
 
 	if bestID != "" {
-		return nil, bestID, "", fmt.Errorf("sbom format found to be %q but the version is not supported", bestID)
+		return nil, bestID, "", nil, fmt.Errorf("sbom format found to be %q but the version is not supported", bestID)
 	}
 
-	return nil, "", "", fmt.Errorf("sbom format not recognized")
+	return nil, "", "", nil, fmt.Errorf("sbom format not recognized")
 }
 
 // Identify takes a set of bytes and attempts to identify the format of the SBOM relative to the decoders in the collection.
@@ -5,11 +5,13 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/anchore/syft/syft/format/checksums"
 	"github.com/anchore/syft/syft/format/cyclonedxjson"
 	"github.com/anchore/syft/syft/format/cyclonedxxml"
 	"github.com/anchore/syft/syft/format/github"
 	"github.com/anchore/syft/syft/format/spdxjson"
 	"github.com/anchore/syft/syft/format/spdxtagvalue"
+	"github.com/anchore/syft/syft/format/syftdelta"
 	"github.com/anchore/syft/syft/format/syftjson"
 	"github.com/anchore/syft/syft/format/table"
 	"github.com/anchore/syft/syft/format/template"
@@ -26,6 +28,9 @@ type EncodersConfig struct {
 	SPDXTagValue  spdxtagvalue.EncoderConfig
 	CyclonedxJSON cyclonedxjson.EncoderConfig
 	CyclonedxXML  cyclonedxxml.EncoderConfig
+	Table         table.EncoderConfig
+	Delta         syftdelta.EncoderConfig
+	Checksums     checksums.EncoderConfig
 }
 
 func Encoders() []sbom.FormatEncoder {
@@ -41,6 +46,9 @@ func DefaultEncodersConfig() EncodersConfig {
 		SPDXTagValue:  spdxtagvalue.DefaultEncoderConfig(),
 		CyclonedxJSON: cyclonedxjson.DefaultEncoderConfig(),
 		CyclonedxXML:  cyclonedxxml.DefaultEncoderConfig(),
+		Table:         table.DefaultEncoderConfig(),
+		Delta:         syftdelta.DefaultEncoderConfig(),
+		Checksums:     checksums.DefaultEncoderConfig(),
 	}
 
 	// empty value means to support all versions
@@ -59,10 +67,15 @@ func (o EncodersConfig) Encoders() ([]sbom.FormatEncoder, error) {
 		l.addWithErr(template.ID)(o.templateEncoders())
 	}
 
+	if o.Delta.Previous != nil || o.Delta.PreviousLoader != nil {
+		l.addWithErr(syftdelta.ID)(o.deltaEncoders())
+	}
+
 	l.addWithErr(syftjson.ID)(o.syftJSONEncoders())
-	l.add(table.ID)(table.NewFormatEncoder())
+	l.addWithErr(table.ID)(o.tableEncoders())
 	l.add(text.ID)(text.NewFormatEncoder())
 	l.add(github.ID)(github.NewFormatEncoder())
+	l.addWithErr(checksums.ID)(o.checksumsEncoders())
 	l.addWithErr(cyclonedxxml.ID)(o.cyclonedxXMLEncoders())
 	l.addWithErr(cyclonedxjson.ID)(o.cyclonedxJSONEncoders())
 	l.addWithErr(spdxjson.ID)(o.spdxJSONEncoders())
@@ -76,11 +89,26 @@ func (o EncodersConfig) templateEncoders() ([]sbom.FormatEncoder, error) {
 	return []sbom.FormatEncoder{enc}, err
 }
 
+func (o EncodersConfig) deltaEncoders() ([]sbom.FormatEncoder, error) {
+	enc, err := syftdelta.NewFormatEncoderWithConfig(o.Delta)
+	return []sbom.FormatEncoder{enc}, err
+}
+
 func (o EncodersConfig) syftJSONEncoders() ([]sbom.FormatEncoder, error) {
 	enc, err := syftjson.NewFormatEncoderWithConfig(o.SyftJSON)
 	return []sbom.FormatEncoder{enc}, err
 }
 
+func (o EncodersConfig) tableEncoders() ([]sbom.FormatEncoder, error) {
+	enc, err := table.NewFormatEncoderWithConfig(o.Table)
+	return []sbom.FormatEncoder{enc}, err
+}
+
+func (o EncodersConfig) checksumsEncoders() ([]sbom.FormatEncoder, error) {
+	enc, err := checksums.NewFormatEncoderWithConfig(o.Checksums)
+	return []sbom.FormatEncoder{enc}, err
+}
+
 func (o EncodersConfig) cyclonedxXMLEncoders() ([]sbom.FormatEncoder, error) {
 	var (
 		encs []sbom.FormatEncoder
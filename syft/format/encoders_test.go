@@ -14,6 +14,7 @@ import (
 	"github.com/anchore/syft/syft/format/internal/spdxutil"
 	"github.com/anchore/syft/syft/format/spdxjson"
 	"github.com/anchore/syft/syft/format/spdxtagvalue"
+	"github.com/anchore/syft/syft/format/syftdelta"
 	"github.com/anchore/syft/syft/format/syftjson"
 	"github.com/anchore/syft/syft/format/template"
 	"github.com/anchore/syft/syft/sbom"
@@ -32,11 +33,12 @@ func Test_Encoders(t *testing.T) {
 
 func expectedDefaultEncoders() *strset.Set {
 	expected := strset.New()
-	// note: template is not expected in the default encoders
+	// note: template and syft-delta-json are not expected in the default encoders
 	expected.Add("syft-json@" + internal.JSONSchemaVersion) // TODO: support multiple versions
 	expected.Add("syft-table@")                             // no version
 	expected.Add("syft-text@")                              // no version
 	expected.Add("github-json@")                            // no version
+	expected.Add("checksums@")                              // no version
 	for _, v := range spdxjson.SupportedVersions() {
 		expected.Add("spdx-json@" + v)
 	}
@@ -91,6 +93,28 @@ func TestEncodersConfig_Encoders(t *testing.T) {
 				return expected
 			}(),
 		},
+		{
+			name: "with previous SBOM for delta",
+			cfg: func() EncodersConfig {
+				cfg := DefaultEncodersConfig()
+				cfg.Delta.Previous = &sbom.SBOM{}
+				return cfg
+			}(),
+			want: func() *strset.Set {
+				expected := expectedDefaultEncoders()
+				expected.Add(string(syftdelta.ID) + "@")
+				return expected
+			}(),
+		},
+		{
+			name: "unsupported checksums algorithm",
+			cfg: func() EncodersConfig {
+				cfg := DefaultEncodersConfig()
+				cfg.Checksums.Algorithm = "crc32"
+				return cfg
+			}(),
+			wantErr: require.Error,
+		},
 		{
 			name: "explicit versions template",
 			cfg: EncodersConfig{
@@ -108,6 +132,7 @@ func TestEncodersConfig_Encoders(t *testing.T) {
 				expected.Add("syft-table@")                             // no version
 				expected.Add("syft-text@")                              // no version
 				expected.Add("github-json@")                            // no version
+				expected.Add("checksums@")                              // no version
 				expected.Add("spdx-json@" + spdxutil.DefaultVersion)
 				expected.Add("spdx-tag-value@" + spdxutil.DefaultVersion)
 				expected.Add("cyclonedx-json@" + cyclonedxutil.DefaultVersion)
@@ -35,5 +35,29 @@ func (e Encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 	enc.SetPretty(e.pretty)
 	enc.SetEscapeHTML(false)
 
-	return enc.EncodeVersion(bom, e.version)
+	if e.version < cyclonedx.SpecVersion1_6 {
+		removeCryptoProperties(bom.Components)
+		return enc.EncodeVersion(bom, e.version)
+	}
+
+	// note: the BOM model is already at the latest version (there is nothing to convert), however, the cyclonedx
+	// library does not yet know about the "cryptographic-asset" component type and would otherwise convert these
+	// components to applications when encoding a specific version.
+	return enc.Encode(bom)
+}
+
+// removeCryptoProperties downgrades cryptographic asset components (introduced in CycloneDX 1.6) to plain library
+// components, since older versions of the spec have no way to represent them.
+func removeCryptoProperties(components *[]cyclonedx.Component) {
+	if components == nil {
+		return
+	}
+	for i := range *components {
+		c := &(*components)[i]
+		if c.Type == cyclonedx.ComponentTypeCryptographicAsset {
+			c.Type = cyclonedx.ComponentTypeLibrary
+		}
+		c.CryptoProperties = nil
+		removeCryptoProperties(c.Components)
+	}
 }
@@ -0,0 +1,68 @@
+package cyclonedxutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func TestEncoder_cryptoAssets(t *testing.T) {
+	p := pkg.Package{
+		Name:     "truststore.jks",
+		Type:     pkg.CryptoAssetPkg,
+		Metadata: pkg.CryptoKeystore{Format: "JKS", Version: 2, Entries: 3},
+	}
+	p.SetID()
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(p),
+		},
+	}
+
+	tests := []struct {
+		version    string
+		wantType   cyclonedx.ComponentType
+		wantCrypto bool
+	}{
+		{
+			version:    "1.6",
+			wantType:   cyclonedx.ComponentTypeCryptographicAsset,
+			wantCrypto: true,
+		},
+		{
+			version:  "1.5",
+			wantType: cyclonedx.ComponentTypeLibrary,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			enc, err := NewEncoder(tt.version, cyclonedx.BOMFileFormatJSON, false)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, enc.Encode(&buf, s))
+
+			var bom cyclonedx.BOM
+			require.NoError(t, cyclonedx.NewBOMDecoder(&buf, cyclonedx.BOMFileFormatJSON).Decode(&bom))
+			require.NotNil(t, bom.Components)
+
+			var found bool
+			for _, c := range *bom.Components {
+				if c.Name != p.Name {
+					continue
+				}
+				found = true
+				assert.Equal(t, tt.wantType, c.Type)
+				assert.Equal(t, tt.wantCrypto, c.CryptoProperties != nil)
+			}
+			assert.True(t, found, "component not found")
+		})
+	}
+}
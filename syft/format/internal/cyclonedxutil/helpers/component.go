@@ -2,6 +2,8 @@ package helpers
 
 import (
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/CycloneDX/cyclonedx-go"
 
@@ -12,6 +14,41 @@ import (
 )
 
 func EncodeComponent(p pkg.Package) cyclonedx.Component {
+	componentType := cyclonedx.ComponentTypeLibrary
+	switch p.Type {
+	case pkg.BinaryPkg:
+		componentType = cyclonedx.ComponentTypeApplication
+	case pkg.MLModelPkg:
+		componentType = cyclonedx.ComponentTypeMachineLearningModel
+	case pkg.FirmwarePkg:
+		componentType = cyclonedx.ComponentTypeFirmware
+	}
+
+	cryptoProperties := encodeCryptoProperties(p)
+	if cryptoProperties != nil {
+		componentType = cyclonedx.ComponentTypeCryptographicAsset
+	}
+
+	return cyclonedx.Component{
+		Type:               componentType,
+		Name:               p.Name,
+		Group:              encodeGroup(p),
+		Version:            p.Version,
+		PackageURL:         p.PURL,
+		Licenses:           encodeLicenses(p),
+		CPE:                encodeSingleCPE(p),
+		Author:             encodeAuthor(p),
+		Publisher:          encodePublisher(p),
+		Description:        encodeDescription(p),
+		ExternalReferences: encodeExternalReferences(p),
+		Properties:         encodePackageProperties(p),
+		CryptoProperties:   cryptoProperties,
+		BOMRef:             DeriveBomRef(p),
+	}
+}
+
+// encodePackageProperties returns the syft-specific package fields (those that have no CycloneDX counterpart) as properties.
+func encodePackageProperties(p pkg.Package) *[]cyclonedx.Property {
 	props := EncodeProperties(p, "syft:package")
 
 	if p.Metadata != nil {
@@ -22,6 +59,63 @@ func EncodeComponent(p pkg.Package) cyclonedx.Component {
 		})
 	}
 
+	if classifications := pkg.Classifications(p); len(classifications) > 0 {
+		// user-defined classifications are recorded as location annotations, which are not otherwise encoded
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:classification",
+			Value: strings.Join(classifications, ","),
+		})
+	}
+
+	if ownership := pkg.Ownership(p); ownership != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:ownership",
+			Value: ownership,
+		})
+	}
+
+	if reachability := pkg.Reachability(p); len(reachability) > 0 {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:reachability",
+			Value: strings.Join(reachability, ","),
+		})
+	}
+
+	if cycle := pkg.ReleaseCycle(p); cycle != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:releaseCycle",
+			Value: cycle,
+		})
+	}
+
+	if eol := pkg.EndOfLife(p); eol != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:eol",
+			Value: eol,
+		})
+	}
+
+	if platform := pkg.Platform(p); platform != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:platform",
+			Value: platform,
+		})
+	}
+
+	if popular := pkg.TyposquatOf(p); popular != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:typosquatOf",
+			Value: popular,
+		})
+	}
+
+	if public := pkg.DependencyConfusion(p); public != "" {
+		props = append(props, cyclonedx.Property{
+			Name:  "syft:package:dependencyConfusion",
+			Value: public,
+		})
+	}
+
 	props = append(props, encodeCPEs(p)...)
 	locations := p.Locations.ToSlice()
 	if len(locations) > 0 {
@@ -31,31 +125,31 @@ func EncodeComponent(p pkg.Package) cyclonedx.Component {
 		props = append(props, EncodeProperties(p.Metadata, "syft:metadata")...)
 	}
 
-	var properties *[]cyclonedx.Property
-	if len(props) > 0 {
-		properties = &props
+	if len(props) == 0 {
+		return nil
 	}
+	return &props
+}
 
-	componentType := cyclonedx.ComponentTypeLibrary
-	if p.Type == pkg.BinaryPkg {
-		componentType = cyclonedx.ComponentTypeApplication
+// AddFootprintProperties adds the installed size (in bytes) and number of files owned by the package as properties of the
+// component.
+func AddFootprintProperties(c *cyclonedx.Component, f pkg.Footprint) {
+	var props []cyclonedx.Property
+	if c.Properties != nil {
+		props = *c.Properties
 	}
 
-	return cyclonedx.Component{
-		Type:               componentType,
-		Name:               p.Name,
-		Group:              encodeGroup(p),
-		Version:            p.Version,
-		PackageURL:         p.PURL,
-		Licenses:           encodeLicenses(p),
-		CPE:                encodeSingleCPE(p),
-		Author:             encodeAuthor(p),
-		Publisher:          encodePublisher(p),
-		Description:        encodeDescription(p),
-		ExternalReferences: encodeExternalReferences(p),
-		Properties:         properties,
-		BOMRef:             DeriveBomRef(p),
-	}
+	props = append(props,
+		cyclonedx.Property{
+			Name:  "syft:package:installedSize",
+			Value: strconv.FormatInt(f.Size, 10),
+		},
+		cyclonedx.Property{
+			Name:  "syft:package:fileCount",
+			Value: strconv.Itoa(f.FileCount),
+		},
+	)
+	c.Properties = &props
 }
 
 func DeriveBomRef(p pkg.Package) string {
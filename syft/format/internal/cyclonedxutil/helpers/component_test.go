@@ -149,6 +149,127 @@ func Test_encodeComponentProperties(t *testing.T) {
 				{Name: "syft:metadata:sourceRpm", Value: "dive-0.9.2-1.src.rpm"},
 			},
 		},
+		{
+			name: "with classified locations",
+			input: pkg.Package{
+				Name:    "app",
+				Version: "1.0.0",
+				Type:    pkg.NpmPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/app/package.json").WithAnnotation(pkg.ClassificationAnnotationKey, "first-party"),
+					file.NewLocation("/app/vendor/package.json").WithAnnotation(pkg.ClassificationAnnotationKey, "first-party,vendored"),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "npm"},
+				{Name: "syft:package:classification", Value: "first-party,vendored"},
+				{Name: "syft:location:0:path", Value: "/app/package.json"},
+				{Name: "syft:location:1:path", Value: "/app/vendor/package.json"},
+			},
+		},
+		{
+			name: "with ownership",
+			input: pkg.Package{
+				Name:    "@acme/billing",
+				Version: "2.0.0",
+				Type:    pkg.NpmPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/app/package-lock.json").WithAnnotation(pkg.OwnershipAnnotationKey, pkg.InternalOwnership),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "npm"},
+				{Name: "syft:package:ownership", Value: "internal"},
+				{Name: "syft:location:0:path", Value: "/app/package-lock.json"},
+			},
+		},
+		{
+			name: "within the entrypoint closure",
+			input: pkg.Package{
+				Name:    "app",
+				Version: "1.0.0",
+				Type:    pkg.BinaryPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/usr/bin/app").WithAnnotation(pkg.ReachabilityAnnotationKey, pkg.EntrypointClosureReachability),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "binary"},
+				{Name: "syft:package:reachability", Value: "entrypoint-closure"},
+				{Name: "syft:location:0:path", Value: "/usr/bin/app"},
+			},
+		},
+		{
+			name: "imported and within the entrypoint closure",
+			input: pkg.Package{
+				Name:    "requests",
+				Version: "2.31.0",
+				Type:    pkg.PythonPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA").WithAnnotation(pkg.ReachabilityAnnotationKey, "imported,entrypoint-closure"),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "python"},
+				{Name: "syft:package:reachability", Value: "entrypoint-closure,imported"},
+				{Name: "syft:location:0:path", Value: "/usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA"},
+			},
+		},
+		{
+			name: "with end of life",
+			input: pkg.Package{
+				Name:    "python",
+				Version: "3.8.10",
+				Type:    pkg.BinaryPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/usr/bin/python3.8").
+						WithAnnotation(pkg.ReleaseCycleAnnotationKey, "3.8").
+						WithAnnotation(pkg.EndOfLifeAnnotationKey, "2024-10-07"),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "binary"},
+				{Name: "syft:package:releaseCycle", Value: "3.8"},
+				{Name: "syft:package:eol", Value: "2024-10-07"},
+				{Name: "syft:location:0:path", Value: "/usr/bin/python3.8"},
+			},
+		},
+		{
+			name: "with platform",
+			input: pkg.Package{
+				Name:    "busybox",
+				Version: "1.36.1",
+				Type:    pkg.BinaryPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/bin/busybox").
+						WithAnnotation(pkg.PlatformAnnotationKey, "linux/arm64/v8"),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "binary"},
+				{Name: "syft:package:platform", Value: "linux/arm64/v8"},
+				{Name: "syft:location:0:path", Value: "/bin/busybox"},
+			},
+		},
+		{
+			name: "flagged for supply-chain review",
+			input: pkg.Package{
+				Name:    "lodahs",
+				Version: "1.0.0",
+				Type:    pkg.NpmPkg,
+				Locations: file.NewLocationSet(
+					file.NewLocation("/app/package-lock.json").
+						WithAnnotation(pkg.TyposquatAnnotationKey, "lodash").
+						WithAnnotation(pkg.DependencyConfusionAnnotationKey, "4.17.21"),
+				),
+			},
+			expected: []cyclonedx.Property{
+				{Name: "syft:package:type", Value: "npm"},
+				{Name: "syft:package:typosquatOf", Value: "lodash"},
+				{Name: "syft:package:dependencyConfusion", Value: "4.17.21"},
+				{Name: "syft:location:0:path", Value: "/app/package-lock.json"},
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -208,6 +329,44 @@ func Test_encodeCompomentType(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ml model package",
+			pkg: pkg.Package{
+				Name:    "TinyLlama",
+				Version: "v1.1",
+				Type:    pkg.MLModelPkg,
+			},
+			want: cyclonedx.Component{
+				Name:    "TinyLlama",
+				Version: "v1.1",
+				Type:    cyclonedx.ComponentTypeMachineLearningModel,
+				Properties: &[]cyclonedx.Property{
+					{
+						Name:  "syft:package:type",
+						Value: "ml-model",
+					},
+				},
+			},
+		},
+		{
+			name: "firmware package",
+			pkg: pkg.Package{
+				Name:    "SystemFirmware",
+				Version: "65538",
+				Type:    pkg.FirmwarePkg,
+			},
+			want: cyclonedx.Component{
+				Name:    "SystemFirmware",
+				Version: "65538",
+				Type:    cyclonedx.ComponentTypeFirmware,
+				Properties: &[]cyclonedx.Property{
+					{
+						Name:  "syft:package:type",
+						Value: "firmware",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"path"
+	"strings"
+
+	"github.com/CycloneDX/cyclonedx-go"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// encodeCryptoProperties returns the CycloneDX cryptographic asset properties for certificates, keys, and keystores
+// (nil for anything else, including crypto libraries, which are best represented as plain library components).
+func encodeCryptoProperties(p pkg.Package) *cyclonedx.CryptoProperties {
+	switch metadata := p.Metadata.(type) {
+	case pkg.CryptoCertificate:
+		return &cyclonedx.CryptoProperties{
+			AssetType: cyclonedx.CryptoAssetTypeCertificate,
+			CertificateProperties: &cyclonedx.CertificateProperties{
+				SubjectName:          metadata.SubjectName,
+				IssuerName:           metadata.IssuerName,
+				NotValidBefore:       metadata.NotValidBefore,
+				NotValidAfter:        metadata.NotValidAfter,
+				CertificateFormat:    "X.509",
+				CertificateExtension: encodeCertificateExtension(p),
+			},
+		}
+	case pkg.CryptoKey:
+		keyType := cyclonedx.RelatedCryptoMaterialTypePrivateKey
+		if metadata.KeyType == "public-key" {
+			keyType = cyclonedx.RelatedCryptoMaterialTypePublicKey
+		}
+		props := &cyclonedx.RelatedCryptoMaterialProperties{
+			Type:   keyType,
+			ID:     metadata.PublicKeyFingerprint,
+			Format: metadata.Format,
+		}
+		if metadata.Size > 0 {
+			size := metadata.Size
+			props.Size = &size
+		}
+		return &cyclonedx.CryptoProperties{
+			AssetType:                       cyclonedx.CryptoAssetTypeRelatedCryptoMaterial,
+			RelatedCryptoMaterialProperties: props,
+		}
+	case pkg.CryptoKeystore:
+		return &cyclonedx.CryptoProperties{
+			AssetType: cyclonedx.CryptoAssetTypeRelatedCryptoMaterial,
+			RelatedCryptoMaterialProperties: &cyclonedx.RelatedCryptoMaterialProperties{
+				Type:   cyclonedx.RelatedCryptoMaterialTypeOther,
+				Format: metadata.Format,
+			},
+		}
+	}
+	return nil
+}
+
+// encodeCertificateExtension returns the file extension (without the leading dot) of the file the certificate was
+// found in, e.g. "crt" or "pem".
+func encodeCertificateExtension(p pkg.Package) string {
+	for _, l := range p.Locations.ToSlice() {
+		if ext := strings.TrimPrefix(path.Ext(l.RealPath), "."); ext != "" {
+			return ext
+		}
+	}
+	return ""
+}
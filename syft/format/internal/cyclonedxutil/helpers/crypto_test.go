@@ -0,0 +1,144 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func Test_encodeCryptoProperties(t *testing.T) {
+	keySize := 2048
+
+	tests := []struct {
+		name string
+		pkg  pkg.Package
+		want *cyclonedx.CryptoProperties
+	}{
+		{
+			name: "certificate",
+			pkg: pkg.Package{
+				Name:      "example.com",
+				Type:      pkg.CryptoAssetPkg,
+				Locations: file.NewLocationSet(file.NewLocation("/etc/ssl/certs/example.crt")),
+				Metadata: pkg.CryptoCertificate{
+					SubjectName:    "CN=example.com",
+					IssuerName:     "CN=Example Root CA",
+					NotValidBefore: "2024-01-01T00:00:00Z",
+					NotValidAfter:  "2025-01-01T00:00:00Z",
+				},
+			},
+			want: &cyclonedx.CryptoProperties{
+				AssetType: cyclonedx.CryptoAssetTypeCertificate,
+				CertificateProperties: &cyclonedx.CertificateProperties{
+					SubjectName:          "CN=example.com",
+					IssuerName:           "CN=Example Root CA",
+					NotValidBefore:       "2024-01-01T00:00:00Z",
+					NotValidAfter:        "2025-01-01T00:00:00Z",
+					CertificateFormat:    "X.509",
+					CertificateExtension: "crt",
+				},
+			},
+		},
+		{
+			name: "private key",
+			pkg: pkg.Package{
+				Name: "RSA-2048",
+				Type: pkg.CryptoAssetPkg,
+				Metadata: pkg.CryptoKey{
+					KeyType:              "private-key",
+					Algorithm:            "RSA",
+					Size:                 2048,
+					Format:               "PKCS#1",
+					PublicKeyFingerprint: "99159f17",
+				},
+			},
+			want: &cyclonedx.CryptoProperties{
+				AssetType: cyclonedx.CryptoAssetTypeRelatedCryptoMaterial,
+				RelatedCryptoMaterialProperties: &cyclonedx.RelatedCryptoMaterialProperties{
+					Type:   cyclonedx.RelatedCryptoMaterialTypePrivateKey,
+					ID:     "99159f17",
+					Size:   &keySize,
+					Format: "PKCS#1",
+				},
+			},
+		},
+		{
+			name: "encrypted public key without a known size",
+			pkg: pkg.Package{
+				Name: "public-key",
+				Type: pkg.CryptoAssetPkg,
+				Metadata: pkg.CryptoKey{
+					KeyType: "public-key",
+					Format:  "OpenSSH",
+				},
+			},
+			want: &cyclonedx.CryptoProperties{
+				AssetType: cyclonedx.CryptoAssetTypeRelatedCryptoMaterial,
+				RelatedCryptoMaterialProperties: &cyclonedx.RelatedCryptoMaterialProperties{
+					Type:   cyclonedx.RelatedCryptoMaterialTypePublicKey,
+					Format: "OpenSSH",
+				},
+			},
+		},
+		{
+			name: "keystore",
+			pkg: pkg.Package{
+				Name:     "truststore.jks",
+				Type:     pkg.CryptoAssetPkg,
+				Metadata: pkg.CryptoKeystore{Format: "JKS", Version: 2, Entries: 3},
+			},
+			want: &cyclonedx.CryptoProperties{
+				AssetType: cyclonedx.CryptoAssetTypeRelatedCryptoMaterial,
+				RelatedCryptoMaterialProperties: &cyclonedx.RelatedCryptoMaterialProperties{
+					Type:   cyclonedx.RelatedCryptoMaterialTypeOther,
+					Format: "JKS",
+				},
+			},
+		},
+		{
+			name: "crypto library",
+			pkg: pkg.Package{
+				Name:     "libcrypto.so.3",
+				Type:     pkg.CryptoAssetPkg,
+				Metadata: pkg.CryptoLibrary{Implementation: "OpenSSL"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, encodeCryptoProperties(tt.pkg))
+		})
+	}
+}
+
+func Test_cryptoAssetComponentRoundTrip(t *testing.T) {
+	p := pkg.Package{
+		Name:      "Ed25519",
+		Type:      pkg.CryptoAssetPkg,
+		FoundBy:   "crypto-material-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/root/.ssh/id_ed25519")),
+		Metadata: pkg.CryptoKey{
+			KeyType:              "private-key",
+			Algorithm:            "Ed25519",
+			Size:                 256,
+			Format:               "OpenSSH",
+			Encrypted:            true,
+			PublicKeyFingerprint: "682498d9",
+		},
+	}
+	p.SetID()
+
+	c := EncodeComponent(p)
+	assert.Equal(t, cyclonedx.ComponentTypeCryptographicAsset, c.Type)
+	require.NotNil(t, c.CryptoProperties)
+
+	got := decodeComponent(&c)
+	require.NotNil(t, got)
+	assert.Equal(t, p.Type, got.Type)
+	assert.Equal(t, p.Metadata, got.Metadata)
+}
@@ -25,6 +25,10 @@ func ToSyftModel(bom *cyclonedx.BOM) (*sbom.SBOM, error) {
 		},
 		Source:     extractComponents(bom.Metadata),
 		Descriptor: extractDescriptor(bom.Metadata),
+		Document: sbom.Document{
+			SerialNumber: bom.SerialNumber,
+			Version:      bom.Version,
+		},
 	}
 
 	idMap := make(map[string]interface{})
@@ -52,6 +56,13 @@ func collectBomPackages(bom *cyclonedx.BOM, s *sbom.SBOM, idMap map[string]inter
 		componentsPresent = true
 	}
 
+	if bom.Services != nil {
+		for i := range *bom.Services {
+			collectServices(&(*bom.Services)[i], s, idMap)
+		}
+		componentsPresent = true
+	}
+
 	if !componentsPresent {
 		return fmt.Errorf("no components are defined in the CycloneDX BOM")
 	}
@@ -63,7 +74,7 @@ func collectPackages(component *cyclonedx.Component, s *sbom.SBOM, idMap map[str
 	switch component.Type {
 	case cyclonedx.ComponentTypeOS:
 	case cyclonedx.ComponentTypeContainer:
-	case cyclonedx.ComponentTypeApplication, cyclonedx.ComponentTypeFramework, cyclonedx.ComponentTypeLibrary:
+	case cyclonedx.ComponentTypeApplication, cyclonedx.ComponentTypeFramework, cyclonedx.ComponentTypeLibrary, cyclonedx.ComponentTypeCryptographicAsset, cyclonedx.ComponentTypeMachineLearningModel, cyclonedx.ComponentTypeFirmware:
 		p := decodeComponent(component)
 		idMap[component.BOMRef] = p
 		syftID := extractSyftPacakgeID(component.BOMRef)
@@ -82,6 +93,19 @@ func collectPackages(component *cyclonedx.Component, s *sbom.SBOM, idMap map[str
 	}
 }
 
+func collectServices(service *cyclonedx.Service, s *sbom.SBOM, idMap map[string]interface{}) {
+	p := decodeService(service)
+	idMap[service.BOMRef] = p
+	p.SetID()
+	s.Artifacts.Packages.Add(*p)
+
+	if service.Services != nil {
+		for i := range *service.Services {
+			collectServices(&(*service.Services)[i], s, idMap)
+		}
+	}
+}
+
 func extractSyftPacakgeID(i string) string {
 	instance, err := packageurl.FromString(i)
 	if err != nil {
@@ -221,6 +245,13 @@ func extractComponents(meta *cyclonedx.Metadata) source.Description {
 	}
 	c := meta.Component
 
+	var annotations map[string]string
+	if meta.Properties != nil {
+		if decoded := decodeProperties(*meta.Properties, "syft:source:annotations:"); len(decoded) > 0 {
+			annotations = decoded
+		}
+	}
+
 	switch c.Type {
 	case cyclonedx.ComponentTypeContainer:
 		var labels map[string]string
@@ -239,14 +270,16 @@ func extractComponents(meta *cyclonedx.Metadata) source.Description {
 				ManifestDigest: c.Version,
 				Labels:         labels,
 			},
+			Annotations: annotations,
 		}
 	case cyclonedx.ComponentTypeFile:
 		// TODO: can we decode alias name-version somehow? (it isn't be encoded in the first place yet)
 
 		// TODO: this is lossy... we can't know if this is a file or a directory
 		return source.Description{
-			ID:       "",
-			Metadata: source.FileMetadata{Path: c.Name},
+			ID:          "",
+			Metadata:    source.FileMetadata{Path: c.Name},
+			Annotations: annotations,
 		}
 	}
 	return source.Description{}
@@ -279,3 +312,66 @@ func extractDescriptor(meta *cyclonedx.Metadata) (desc sbom.Descriptor) {
 
 	return
 }
+
+// ComponentPackageID returns the ID of the package that the given component is decoded into.
+func ComponentPackageID(component *cyclonedx.Component) artifact.ID {
+	p := decodeComponent(component)
+	p.SetID()
+	return p.ID()
+}
+
+// UnresolvedDependencies returns a warning for each reference within the BOM dependencies that does not refer to a
+// component or service defined in the BOM (such dependencies are dropped when decoding).
+func UnresolvedDependencies(bom *cyclonedx.BOM) []string {
+	if bom == nil || bom.Dependencies == nil {
+		return nil
+	}
+
+	refs := make(map[string]bool)
+	if bom.Components != nil {
+		collectComponentRefs(*bom.Components, refs)
+	}
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		collectComponentRefs([]cyclonedx.Component{*bom.Metadata.Component}, refs)
+	}
+	if bom.Services != nil {
+		collectServiceRefs(*bom.Services, refs)
+	}
+
+	var warnings []string
+	reported := make(map[string]bool)
+	check := func(ref string) {
+		if refs[ref] || reported[ref] {
+			return
+		}
+		reported[ref] = true
+		warnings = append(warnings, fmt.Sprintf("dependency refers to undefined bom-ref %q", ref))
+	}
+	for _, d := range *bom.Dependencies {
+		check(d.Ref)
+		if d.Dependencies != nil {
+			for _, ref := range *d.Dependencies {
+				check(ref)
+			}
+		}
+	}
+	return warnings
+}
+
+func collectComponentRefs(components []cyclonedx.Component, refs map[string]bool) {
+	for _, c := range components {
+		refs[c.BOMRef] = true
+		if c.Components != nil {
+			collectComponentRefs(*c.Components, refs)
+		}
+	}
+}
+
+func collectServiceRefs(services []cyclonedx.Service, refs map[string]bool) {
+	for _, s := range services {
+		refs[s.BOMRef] = true
+		if s.Services != nil {
+			collectServiceRefs(*s.Services, refs)
+		}
+	}
+}
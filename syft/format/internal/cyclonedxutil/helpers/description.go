@@ -7,6 +7,8 @@ func encodeDescription(p pkg.Package) string {
 		switch metadata := p.Metadata.(type) {
 		case pkg.ApkDBEntry:
 			return metadata.Description
+		case pkg.APIServiceEntry:
+			return metadata.Description
 		case pkg.NpmPackage:
 			return metadata.Description
 		}
@@ -18,6 +20,8 @@ func decodeDescription(description string, metadata interface{}) {
 	switch meta := metadata.(type) {
 	case *pkg.ApkDBEntry:
 		meta.Description = description
+	case *pkg.APIServiceEntry:
+		meta.Description = description
 	case *pkg.NpmPackage:
 		meta.Description = description
 	}
@@ -29,6 +29,15 @@ func Test_encodeDescription(t *testing.T) {
 			},
 			expected: "a description!",
 		},
+		{
+			name: "from api service",
+			input: pkg.Package{
+				Metadata: pkg.APIServiceEntry{
+					Description: "a description!",
+				},
+			},
+			expected: "a description!",
+		},
 		{
 			name: "from npm",
 			input: pkg.Package{
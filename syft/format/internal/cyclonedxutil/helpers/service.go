@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"reflect"
+
+	"github.com/CycloneDX/cyclonedx-go"
+
+	"github.com/anchore/syft/syft/internal/packagemetadata"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// IsService indicates if the package is better represented as a CycloneDX service than as a component.
+func IsService(p pkg.Package) bool {
+	return p.Type == pkg.APIServicePkg
+}
+
+func EncodeService(p pkg.Package) cyclonedx.Service {
+	s := cyclonedx.Service{
+		BOMRef:      DeriveBomRef(p),
+		Name:        p.Name,
+		Version:     p.Version,
+		Description: encodeDescription(p),
+		Licenses:    encodeLicenses(p),
+		Properties:  encodePackageProperties(p),
+	}
+
+	if metadata, ok := p.Metadata.(pkg.APIServiceEntry); ok {
+		if len(metadata.Endpoints) > 0 {
+			endpoints := metadata.Endpoints
+			s.Endpoints = &endpoints
+		}
+		s.Authenticated = metadata.Authenticated
+		s.Data = encodeDataFlows(metadata.DataFlows)
+	}
+
+	return s
+}
+
+func encodeDataFlows(flows []pkg.APIServiceDataFlow) *[]cyclonedx.DataClassification {
+	if len(flows) == 0 {
+		return nil
+	}
+
+	var out []cyclonedx.DataClassification
+	for _, f := range flows {
+		out = append(out, cyclonedx.DataClassification{
+			Flow:           cyclonedx.DataFlow(f.Flow),
+			Classification: f.Classification,
+		})
+	}
+	return &out
+}
+
+func decodeService(s *cyclonedx.Service) *pkg.Package {
+	values := map[string]string{}
+	if s.Properties != nil {
+		for _, p := range *s.Properties {
+			values[p.Name] = p.Value
+		}
+	}
+
+	p := &pkg.Package{
+		Name:      s.Name,
+		Version:   s.Version,
+		Locations: decodeLocations(values),
+	}
+
+	DecodeInto(p, values, "syft:package", CycloneDXFields)
+
+	if metadataType := packagemetadata.ReflectTypeFromJSONName(values["syft:package:metadataType"]); metadataType != nil {
+		metaPtr := Decode(reflect.PtrTo(metadataType), values, "syft:metadata", CycloneDXFields)
+		decodeDescription(s.Description, metaPtr)
+		decodeServiceFields(s, metaPtr)
+		p.Metadata = PtrToStruct(metaPtr)
+	}
+
+	if p.Type == "" {
+		p.Type = pkg.APIServicePkg
+	}
+
+	return p
+}
+
+// decodeServiceFields populates the metadata fields which are represented by CycloneDX service fields (instead of properties).
+func decodeServiceFields(s *cyclonedx.Service, metadata interface{}) {
+	meta, ok := metadata.(*pkg.APIServiceEntry)
+	if !ok {
+		return
+	}
+
+	if s.Endpoints != nil {
+		meta.Endpoints = *s.Endpoints
+	}
+	meta.Authenticated = s.Authenticated
+	if s.Data != nil {
+		for _, d := range *s.Data {
+			meta.DataFlows = append(meta.DataFlows, pkg.APIServiceDataFlow{
+				Flow:           string(d.Flow),
+				Classification: d.Classification,
+			})
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newTestAPIServicePackage() pkg.Package {
+	authenticated := true
+	p := pkg.Package{
+		Name:      "Swagger Petstore",
+		Version:   "1.0.7",
+		Type:      pkg.APIServicePkg,
+		FoundBy:   "api-service-cataloger",
+		Locations: file.NewLocationSet(file.NewLocation("/api/openapi.yaml")),
+		Metadata: pkg.APIServiceEntry{
+			Specification:        "openapi",
+			SpecificationVersion: "3.0.3",
+			Description:          "A sample pet store server.",
+			Endpoints: []string{
+				"https://petstore.example.com/api/v3/pet",
+				"https://petstore.example.com/api/v3/store/order",
+			},
+			Authenticated: &authenticated,
+			DataFlows: []pkg.APIServiceDataFlow{
+				{Flow: "inbound", Classification: "Order"},
+				{Flow: "bi-directional", Classification: "Pet"},
+			},
+		},
+	}
+	p.SetID()
+	return p
+}
+
+func Test_EncodeService(t *testing.T) {
+	p := newTestAPIServicePackage()
+
+	got := EncodeService(p)
+
+	assert.Equal(t, string(p.ID()), got.BOMRef)
+	assert.Equal(t, "Swagger Petstore", got.Name)
+	assert.Equal(t, "1.0.7", got.Version)
+	assert.Equal(t, "A sample pet store server.", got.Description)
+	require.NotNil(t, got.Endpoints)
+	assert.Equal(t, []string{
+		"https://petstore.example.com/api/v3/pet",
+		"https://petstore.example.com/api/v3/store/order",
+	}, *got.Endpoints)
+	require.NotNil(t, got.Authenticated)
+	assert.True(t, *got.Authenticated)
+	require.NotNil(t, got.Data)
+	assert.Equal(t, []cyclonedx.DataClassification{
+		{Flow: cyclonedx.DataFlowInbound, Classification: "Order"},
+		{Flow: cyclonedx.DataFlowBidirectional, Classification: "Pet"},
+	}, *got.Data)
+}
+
+func Test_decodeService(t *testing.T) {
+	p := newTestAPIServicePackage()
+
+	s := EncodeService(p)
+	got := decodeService(&s)
+
+	require.NotNil(t, got)
+	assert.Equal(t, p.Name, got.Name)
+	assert.Equal(t, p.Version, got.Version)
+	assert.Equal(t, p.Type, got.Type)
+	assert.Equal(t, p.FoundBy, got.FoundBy)
+	assert.Equal(t, p.Locations.CoordinateSet().ToSlice(), got.Locations.CoordinateSet().ToSlice())
+	assert.Equal(t, p.Metadata, got.Metadata)
+}
@@ -50,6 +50,9 @@ func Originator(p pkg.Package) (typ string, author string) { // nolint: funlen
 	case pkg.DpkgDBEntry:
 		author = metadata.Maintainer
 
+	case pkg.DpkgSourceEntry:
+		author = metadata.Maintainer
+
 	case pkg.JavaArchive:
 		if metadata.Manifest != nil {
 			author = metadata.Manifest.Main.MustGet("Specification-Vendor")
@@ -103,12 +106,81 @@ func Originator(p pkg.Package) (typ string, author string) { // nolint: funlen
 		typ = orgType
 		author = metadata.Vendor
 
+	case pkg.RpmSourceEntry:
+		typ = orgType
+		author = metadata.Vendor
+
 	case pkg.WordpressPluginEntry:
 		// it seems that the vast majority of the time the author is an org, not a person
 		typ = orgType
 		author = metadata.Author
 	case pkg.SwiplPackEntry:
 		author = formatPersonOrOrg(metadata.Author, metadata.AuthorEmail)
+
+	case pkg.JetBrainsPluginEntry:
+		// the vendor is most often an org (e.g. "JetBrains") rather than a person
+		typ = orgType
+		author = metadata.Vendor
+
+	case pkg.JavaOSGiBundleEntry:
+		// bundles are almost always published by an org (e.g. "Eclipse.org") rather than a person
+		if metadata.Vendor != "" {
+			typ = orgType
+			author = metadata.Vendor
+		}
+
+	case pkg.UnrealPluginEntry:
+		// plugins are almost always created by an org (e.g. "Epic Games, Inc.") rather than a person
+		typ = orgType
+		author = metadata.CreatedBy
+
+	case pkg.BrowserExtensionEntry:
+		author = metadata.Author
+
+	case pkg.ChefCookbookMetadata:
+		author = formatPersonOrOrg(metadata.Maintainer, metadata.MaintainerEmail)
+
+	case pkg.HelmChartMetadata:
+		if len(metadata.Maintainers) > 0 {
+			author = formatPersonOrOrg(metadata.Maintainers[0].Name, metadata.Maintainers[0].Email)
+		}
+
+	case pkg.PuppetModuleMetadata:
+		author = metadata.Author
+
+	case pkg.AnsibleCollectionManifest:
+		if len(metadata.Authors) > 0 {
+			author = metadata.Authors[0]
+		}
+
+	case pkg.AnsibleRoleMeta:
+		author = metadata.Author
+
+	case pkg.VSCodeExtensionEntry:
+		// extensions are published by a marketplace publisher (which may be a person, but is most often an org)
+		typ = orgType
+		author = metadata.PublisherDisplayName
+		if author == "" {
+			author = metadata.Publisher
+		}
+
+	case pkg.WasmModuleEntry:
+		if len(metadata.Authors) > 0 {
+			author = metadata.Authors[0]
+		}
+
+	case pkg.WindowsDriverEntry:
+		// driver packages are provided by a hardware vendor (or Microsoft, for in-box drivers)
+		typ = orgType
+		author = metadata.Provider
+
+	case pkg.WindowsInstallerEntry:
+		typ = orgType
+		author = metadata.Publisher
+
+	case pkg.WindowsMSIEntry:
+		typ = orgType
+		author = metadata.Manufacturer
 	}
 
 	if typ == "" && author != "" {
@@ -11,36 +11,95 @@ import (
 
 func Test_OriginatorSupplier(t *testing.T) {
 	completionTester := packagemetadata.NewCompletionTester(t,
+		pkg.AlpmDependencyEntry{},
+		pkg.AlpmSourceArchiveEntry{},
+		pkg.AlpmSourceEntry{},
+		pkg.AndroidAppEntry{},
+		pkg.AnsibleRequirementsEntry{},
+		pkg.APIServiceEntry{},
+		pkg.BazelModuleEntry{},
 		pkg.BinarySignature{},
+		pkg.BuckDependencyEntry{},
+		pkg.BunLockEntry{},
+		pkg.CarthageBuildVersionEntry{},
+		pkg.CarthageCartfileResolvedEntry{},
+		pkg.ChefCookbookLockEntry{},
 		pkg.CocoaPodfileLockEntry{},
 		pkg.ConanV1LockEntry{},
 		pkg.ConanV2LockEntry{}, // the field Username might be the username of either the package originator or the supplier (unclear currently)
 		pkg.ConanfileEntry{},
 		pkg.ConaninfoEntry{},
+		pkg.ContainerImageReferenceEntry{},
+		pkg.DevContainerFeatureEntry{},
+		pkg.CryptoCertificate{},
+		pkg.CryptoKey{},
+		pkg.CryptoKeystore{},
+		pkg.CryptoLibrary{},
 		pkg.DartPubspecLockEntry{},
 		pkg.DotnetDepsEntry{},
+		pkg.DotnetPackagesLockEntry{},
+		pkg.DotnetPaketLockEntry{},
+		pkg.DpkgBuildDependencyEntry{},
 		pkg.ELFBinaryPackageNoteJSONPayload{},
+		pkg.ElectronRuntimeEntry{},
 		pkg.ElixirMixLockEntry{},
+		pkg.EmacsStraightLockEntry{},
 		pkg.ErlangRebarLockEntry{},
 		pkg.GolangBinaryBuildinfoEntry{},
 		pkg.GolangModuleEntry{},
 		pkg.HackageStackYamlLockEntry{},
 		pkg.HackageStackYamlEntry{},
+		pkg.IOSAppEntry{},
+		pkg.IOSFrameworkEntry{},
+		pkg.JavaBuildToolWrapperEntry{},
+		pkg.MacOSAppEntry{},
+		pkg.MacOSFrameworkEntry{},
+		pkg.MacOSReceiptEntry{},
+		pkg.WindowsAssemblyEntry{},
+		pkg.MLModelEntry{},
+		pkg.UEFIFirmwareEntry{},
+		pkg.DeviceTreeEntry{},
+		pkg.LinuxFirmwareEntry{},
+		pkg.CPUMicrocodeEntry{},
 		pkg.LinuxKernel{},
 		pkg.LuaRocksPackage{},
+		pkg.MesonWrapEntry{},
 		pkg.MicrosoftKbPatch{},
+		pkg.NeovimLazyLockEntry{},
+		pkg.NetworkServiceEntry{},
 		pkg.NixStoreEntry{},
+		pkg.NpmGlobalPackageEntry{},
+		pkg.NpmBundledPackageEntry{},
 		pkg.NpmPackageLockEntry{},
+		pkg.OSGroupAccount{},
+		pkg.OSUserAccount{},
 		pkg.PhpComposerInstalledEntry{},
+		pkg.PhpExtensionEntry{},
 		pkg.PhpPeclEntry{},
 		pkg.PortageEntry{},
+		pkg.HelmChartLockEntry{},
+		pkg.PulumiPluginEntry{},
+		pkg.PulumiProjectEntry{},
+		pkg.PuppetfileLockEntry{},
 		pkg.PythonPipfileLockEntry{},
+		pkg.PythonPipxEntry{},
 		pkg.PythonRequirementsEntry{},
 		pkg.PythonPoetryLockEntry{},
+		pkg.RpmBuildRequirementEntry{},
+		pkg.RpmSourceArchiveEntry{},
 		pkg.RustBinaryAuditEntry{},
+		pkg.RustCargoInstallEntry{},
 		pkg.RustCargoLockEntry{},
+		pkg.ScheduledTaskEntry{},
+		pkg.SnapEntry{},
 		pkg.SwiftPackageManagerResolvedEntry{},
 		pkg.SwiplPackEntry{},
+		pkg.TeXLiveTlpdbEntry{},
+		pkg.ToolchainEntry{},
+		pkg.UnityManifestEntry{},
+		pkg.UnityPackagesLockEntry{},
+		pkg.UnrealProjectPluginEntry{},
+		pkg.VimPlugSnapshotEntry{},
 		pkg.YarnLockEntry{},
 	)
 	tests := []struct {
@@ -107,6 +166,16 @@ func Test_OriginatorSupplier(t *testing.T) {
 			originator: "Person: auth",
 			supplier:   "Person: auth",
 		},
+		{
+			name: "from dpkg source package",
+			input: pkg.Package{
+				Metadata: pkg.DpkgSourceEntry{
+					Maintainer: "auth",
+				},
+			},
+			originator: "Person: auth",
+			supplier:   "Person: auth",
+		},
 		{
 			name: "from gem",
 			input: pkg.Package{
@@ -327,6 +396,16 @@ func Test_OriginatorSupplier(t *testing.T) {
 			originator: "Organization: auth",
 			supplier:   "Organization: auth",
 		},
+		{
+			name: "from rpm source package",
+			input: pkg.Package{
+				Metadata: pkg.RpmSourceEntry{
+					Vendor: "auth",
+				},
+			},
+			originator: "Organization: auth",
+			supplier:   "Organization: auth",
+		},
 		{
 			name: "from wordpress plugin",
 			input: pkg.Package{
@@ -337,6 +416,174 @@ func Test_OriginatorSupplier(t *testing.T) {
 			originator: "Organization: auth",
 			supplier:   "Organization: auth",
 		},
+		{
+			name: "from browser extension",
+			input: pkg.Package{
+				Metadata: pkg.BrowserExtensionEntry{
+					Browser:     pkg.FirefoxBrowser,
+					ExtensionID: "uBlock0@raymondhill.net",
+					Author:      "Raymond Hill",
+				},
+			},
+			originator: "Person: Raymond Hill",
+			supplier:   "Person: Raymond Hill",
+		},
+		{
+			name: "from ansible collection",
+			input: pkg.Package{
+				Metadata: pkg.AnsibleCollectionManifest{
+					Namespace: "community",
+					Authors:   []string{"Ansible (https://github.com/ansible)"},
+				},
+			},
+			originator: "Person: Ansible",
+			supplier:   "Person: Ansible",
+		},
+		{
+			name: "from ansible role",
+			input: pkg.Package{
+				Metadata: pkg.AnsibleRoleMeta{
+					Namespace: "geerlingguy",
+					Author:    "geerlingguy",
+				},
+			},
+			originator: "Person: geerlingguy",
+			supplier:   "Person: geerlingguy",
+		},
+		{
+			name: "from chef cookbook",
+			input: pkg.Package{
+				Metadata: pkg.ChefCookbookMetadata{
+					Maintainer:      "Sous Chefs",
+					MaintainerEmail: "help@sous-chefs.org",
+				},
+			},
+			originator: "Person: Sous Chefs (help@sous-chefs.org)",
+			supplier:   "Person: Sous Chefs (help@sous-chefs.org)",
+		},
+		{
+			name: "from helm chart",
+			input: pkg.Package{
+				Metadata: pkg.HelmChartMetadata{
+					Maintainers: []pkg.HelmChartMaintainer{
+						{Name: "Bitnami", Email: "containers@bitnami.com", URL: "https://github.com/bitnami/charts"},
+						{Name: "Someone Else", Email: "someone@example.com"},
+					},
+				},
+			},
+			originator: "Person: Bitnami (containers@bitnami.com)",
+			supplier:   "Person: Bitnami (containers@bitnami.com)",
+		},
+		{
+			name: "from puppet module",
+			input: pkg.Package{
+				Metadata: pkg.PuppetModuleMetadata{
+					Author: "puppetlabs",
+				},
+			},
+			originator: "Person: puppetlabs",
+			supplier:   "Person: puppetlabs",
+		},
+		{
+			name: "from jetbrains plugin",
+			input: pkg.Package{
+				Metadata: pkg.JetBrainsPluginEntry{
+					PluginID:  "org.jetbrains.kotlin",
+					Vendor:    "JetBrains",
+					VendorURL: "http://www.jetbrains.com",
+				},
+			},
+			originator: "Organization: JetBrains",
+			supplier:   "Organization: JetBrains",
+		},
+		{
+			name: "from OSGi bundle",
+			input: pkg.Package{
+				Metadata: pkg.JavaOSGiBundleEntry{
+					BundleName: "Apache Karaf :: Features :: Core",
+					Vendor:     "The Apache Software Foundation",
+				},
+			},
+			originator: "Organization: The Apache Software Foundation",
+			supplier:   "Organization: The Apache Software Foundation",
+		},
+		{
+			name: "from unreal plugin",
+			input: pkg.Package{
+				Metadata: pkg.UnrealPluginEntry{
+					CreatedBy:    "Epic Games, Inc.",
+					CreatedByURL: "https://epicgames.com",
+				},
+			},
+			originator: "Organization: Epic Games, Inc.",
+			supplier:   "Organization: Epic Games, Inc.",
+		},
+		{
+			name: "from vscode extension",
+			input: pkg.Package{
+				Metadata: pkg.VSCodeExtensionEntry{
+					Publisher:            "ms-python",
+					PublisherDisplayName: "Microsoft",
+				},
+			},
+			originator: "Organization: Microsoft",
+			supplier:   "Organization: Microsoft",
+		},
+		{
+			name: "from vscode extension without publisher display name",
+			input: pkg.Package{
+				Metadata: pkg.VSCodeExtensionEntry{
+					Publisher: "redhat",
+				},
+			},
+			originator: "Organization: redhat",
+			supplier:   "Organization: redhat",
+		},
+		{
+			name: "from wasm module",
+			input: pkg.Package{
+				Metadata: pkg.WasmModuleEntry{
+					Kind:    "component",
+					Authors: []string{"Jane Doe <jane@example.com>", "John Doe"},
+				},
+			},
+			originator: "Person: Jane Doe (jane@example.com)",
+			supplier:   "Person: Jane Doe (jane@example.com)",
+		},
+		{
+			name: "from windows driver",
+			input: pkg.Package{
+				Metadata: pkg.WindowsDriverEntry{
+					InfName:  "contosonet.inf",
+					Provider: "Contoso Ltd.",
+				},
+			},
+			originator: "Organization: Contoso Ltd.",
+			supplier:   "Organization: Contoso Ltd.",
+		},
+		{
+			name: "from windows setup program",
+			input: pkg.Package{
+				Metadata: pkg.WindowsInstallerEntry{
+					Format:      "nsis",
+					ProductName: "Contoso Agent",
+					Publisher:   "Contoso Ltd.",
+				},
+			},
+			originator: "Organization: Contoso Ltd.",
+			supplier:   "Organization: Contoso Ltd.",
+		},
+		{
+			name: "from windows installer package",
+			input: pkg.Package{
+				Metadata: pkg.WindowsMSIEntry{
+					ProductName:  "Contoso Widget",
+					Manufacturer: "Contoso Ltd.",
+				},
+			},
+			originator: "Organization: Contoso Ltd.",
+			supplier:   "Organization: Contoso Ltd.",
+		},
 		{
 			name: "from swipl pack",
 			input: pkg.Package{
@@ -12,16 +12,32 @@ func SourceInfo(p pkg.Package) string {
 	switch p.Type {
 	case pkg.AlpmPkg:
 		answer = "acquired package info from ALPM DB"
+	case pkg.AndroidAppPkg:
+		answer = "acquired package info from Android app manifest"
+	case pkg.AnsibleCollectionPkg, pkg.AnsibleRolePkg:
+		answer = "acquired package info from Ansible collection MANIFEST.json, role meta/main.yml, or Galaxy requirements.yml file"
+	case pkg.APIServicePkg:
+		answer = "acquired service info from API specification file"
 	case pkg.RpmPkg:
 		answer = "acquired package info from RPM DB"
 	case pkg.ApkPkg:
 		answer = "acquired package info from APK DB"
+	case pkg.BazelModulePkg:
+		answer = "acquired package info from Bazel module file or lockfile"
+	case pkg.BrowserExtensionPkg:
+		answer = "acquired package info from browser extension manifest.json or Firefox profile extensions.json file"
+	case pkg.BuckPkg:
+		answer = "acquired package info from Buck2 BUCK file or .buckconfig"
+	case pkg.CarthagePkg:
+		answer = "acquired package info from Carthage Cartfile.resolved file or build version file"
 	case pkg.DartPubPkg:
 		answer = "acquired package info from pubspec manifest"
 	case pkg.DebPkg:
 		answer = "acquired package info from DPKG DB"
 	case pkg.DotnetPkg:
 		answer = "acquired package info from dotnet project assets file"
+	case pkg.OSGiBundlePkg:
+		answer = "acquired package info from OSGi bundle manifest, Eclipse bundles.info file, or p2 artifact repository"
 	case pkg.NpmPkg:
 		answer = "acquired package info from installed node module manifest file"
 	case pkg.PythonPkg:
@@ -38,34 +54,98 @@ func SourceInfo(p pkg.Package) string {
 		answer = "acquired package info from PHP composer manifest"
 	case pkg.PhpPeclPkg:
 		answer = "acquired package info from PHP Pecl manifest"
+	case pkg.ChefCookbookPkg:
+		answer = "acquired package info from Chef cookbook metadata, Berksfile.lock, or Policyfile.lock.json file"
 	case pkg.CocoapodsPkg:
 		answer = "acquired package info from installed cocoapods manifest file"
 	case pkg.ConanPkg:
 		answer = "acquired package info from conan manifest"
+	case pkg.OSAccountPkg:
+		answer = "acquired account info from passwd and group files"
+	case pkg.ScheduledTaskPkg:
+		answer = "acquired scheduled task info from crontab, systemd timer, or startup script file"
+	case pkg.NetworkServicePkg:
+		answer = "acquired network service info from systemd socket, xinetd, nginx, or apache configuration file"
+	case pkg.ContainerImagePkg:
+		answer = "acquired image reference info from docker compose file, Kubernetes manifest, dev container configuration, or devfile"
+	case pkg.DevContainerFeaturePkg:
+		answer = "acquired feature reference info from dev container configuration (devcontainer.json)"
+	case pkg.CryptoAssetPkg:
+		answer = "acquired crypto asset info from certificate, key, keystore, or shared library file"
 	case pkg.PortagePkg:
 		answer = "acquired package info from portage DB"
+	case pkg.PulumiPluginPkg:
+		answer = "acquired package info from Pulumi plugin cache or Pulumi project file"
+	case pkg.PuppetModulePkg:
+		answer = "acquired package info from Puppet module metadata.json or Puppetfile.lock file"
+	case pkg.HelmChartPkg:
+		answer = "acquired package info from Helm Chart.yaml, Chart.lock, or packaged chart archive"
 	case pkg.HackagePkg:
 		answer = "acquired package info from cabal or stack manifest files"
 	case pkg.HexPkg:
 		answer = "acquired package info from rebar3 or mix manifest file"
+	case pkg.IOSAppPkg:
+		answer = "acquired package info from iOS app Info.plist"
+	case pkg.IOSFrameworkPkg:
+		answer = "acquired package info from framework Info.plist or dynamic library embedded within an iOS app"
+	case pkg.MacOSAppPkg:
+		answer = "acquired package info from macOS app Info.plist and executable"
+	case pkg.MacOSFrameworkPkg:
+		answer = "acquired package info from macOS framework Info.plist or dynamic library"
+	case pkg.MacOSInstallerPkg:
+		answer = "acquired package info from macOS installer package receipt"
+	case pkg.MLModelPkg:
+		answer = "acquired package info from ML model file header or HuggingFace model configuration"
+	case pkg.FirmwarePkg:
+		answer = "acquired package info from UEFI capsule, firmware volume, device tree blob, or linux-firmware WHENCE description of installed firmware files"
+	case pkg.JetBrainsPluginPkg:
+		answer = "acquired package info from JetBrains plugin descriptor (plugin.xml) within plugin jar"
 	case pkg.ErlangOTPPkg:
 		answer = "acquired package info from ErLang application resource file"
 	case pkg.LinuxKernelPkg:
 		answer = "acquired package info from linux kernel archive"
 	case pkg.LinuxKernelModulePkg:
 		answer = "acquired package info from linux kernel module files"
+	case pkg.MesonWrapPkg:
+		answer = "acquired package info from Meson wrap file"
 	case pkg.NixPkg:
 		answer = "acquired package info from nix store path"
 	case pkg.Rpkg:
 		answer = "acquired package info from R-package DESCRIPTION file"
 	case pkg.LuaRocksPkg:
 		answer = "acquired package info from Rockspec package file"
+	case pkg.SnapPkg:
+		answer = "acquired package info from snap meta/snap.yaml file"
 	case pkg.SwiftPkg:
 		answer = "acquired package info from resolved Swift package manifest"
 	case pkg.SwiplPackPkg:
 		answer = "acquired package info from SWI Prolo pack package file"
+	case pkg.TeXLivePkg:
+		answer = "acquired package info from TeX Live package database (texlive.tlpdb)"
+	case pkg.ToolchainPkg:
+		answer = "acquired package info from version manager configuration (.tool-versions or mise.toml) or SDKMAN candidates"
+	case pkg.UnityPkg:
+		answer = "acquired package info from Unity package manifest or packages-lock.json file"
+	case pkg.UnrealPkg:
+		answer = "acquired package info from Unreal Engine .uplugin or .uproject file"
+	case pkg.VimPluginPkg:
+		answer = "acquired package info from Vim plugin manager state (lazy-lock.json or vim-plug snapshot file)"
+	case pkg.EmacsPackagePkg:
+		answer = "acquired package info from straight.el version lockfile"
+	case pkg.VSCodeExtensionPkg:
+		answer = "acquired package info from VS Code extension package.json or extensions.json file"
 	case pkg.GithubActionPkg, pkg.GithubActionWorkflowPkg:
 		answer = "acquired package info from GitHub Actions workflow file or composite action file"
+	case pkg.WasmModulePkg:
+		answer = "acquired package info from WebAssembly module custom sections"
+	case pkg.WindowsAssemblyPkg:
+		answer = "acquired package info from Windows WinSxS component manifest or Global Assembly Cache directory"
+	case pkg.WindowsDriverPkg:
+		answer = "acquired package info from Windows driver store INF file"
+	case pkg.WindowsInstallerPkg:
+		answer = "acquired package info from Windows setup program product information"
+	case pkg.WindowsMSIPkg:
+		answer = "acquired package info from Windows Installer package database"
 	case pkg.WordpressPluginPkg:
 		answer = "acquired package info from found wordpress plugin PHP source files"
 	default:
@@ -95,6 +95,14 @@ func Test_SourceInfo(t *testing.T) {
 				"from installed java archive",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.OSGiBundlePkg,
+			},
+			expected: []string{
+				"from OSGi bundle manifest, Eclipse bundles.info file, or p2 artifact repository",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.GemPkg,
@@ -159,6 +167,166 @@ func Test_SourceInfo(t *testing.T) {
 				"from ALPM DB",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.AndroidAppPkg,
+			},
+			expected: []string{
+				"from Android app manifest",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.IOSAppPkg,
+			},
+			expected: []string{
+				"from iOS app Info.plist",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.IOSFrameworkPkg,
+			},
+			expected: []string{
+				"from framework Info.plist or dynamic library embedded within an iOS app",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MacOSAppPkg,
+			},
+			expected: []string{
+				"from macOS app Info.plist and executable",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MacOSFrameworkPkg,
+			},
+			expected: []string{
+				"from macOS framework Info.plist or dynamic library",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MacOSInstallerPkg,
+			},
+			expected: []string{
+				"from macOS installer package receipt",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MLModelPkg,
+			},
+			expected: []string{
+				"from ML model file header or HuggingFace model configuration",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.FirmwarePkg,
+			},
+			expected: []string{
+				"from UEFI capsule, firmware volume, device tree blob, or linux-firmware WHENCE",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.AnsibleCollectionPkg,
+			},
+			expected: []string{
+				"from Ansible collection MANIFEST.json, role meta/main.yml, or Galaxy requirements.yml file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.AnsibleRolePkg,
+			},
+			expected: []string{
+				"from Ansible collection MANIFEST.json, role meta/main.yml, or Galaxy requirements.yml file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.ContainerImagePkg,
+			},
+			expected: []string{
+				"from docker compose file, Kubernetes manifest, dev container configuration, or devfile",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.DevContainerFeaturePkg,
+			},
+			expected: []string{
+				"from dev container configuration (devcontainer.json)",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.CryptoAssetPkg,
+			},
+			expected: []string{
+				"from certificate, key, keystore, or shared library file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.OSAccountPkg,
+			},
+			expected: []string{
+				"from passwd and group files",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.ScheduledTaskPkg,
+			},
+			expected: []string{
+				"from crontab, systemd timer, or startup script file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.VimPluginPkg,
+			},
+			expected: []string{
+				"from Vim plugin manager state",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.EmacsPackagePkg,
+			},
+			expected: []string{
+				"from straight.el version lockfile",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.NetworkServicePkg,
+			},
+			expected: []string{
+				"from systemd socket, xinetd, nginx, or apache configuration file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.APIServicePkg,
+			},
+			expected: []string{
+				"from API specification file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.ChefCookbookPkg,
+			},
+			expected: []string{
+				"from Chef cookbook metadata, Berksfile.lock, or Policyfile.lock.json file",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.CocoapodsPkg,
@@ -183,6 +351,30 @@ func Test_SourceInfo(t *testing.T) {
 				"from portage DB",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.PulumiPluginPkg,
+			},
+			expected: []string{
+				"from Pulumi plugin cache or Pulumi project file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.PuppetModulePkg,
+			},
+			expected: []string{
+				"from Puppet module metadata.json or Puppetfile.lock file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.HelmChartPkg,
+			},
+			expected: []string{
+				"from Helm Chart.yaml, Chart.lock, or packaged chart archive",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.HackagePkg,
@@ -207,6 +399,14 @@ func Test_SourceInfo(t *testing.T) {
 				"from rebar3 or mix manifest file",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.JetBrainsPluginPkg,
+			},
+			expected: []string{
+				"from JetBrains plugin descriptor (plugin.xml) within plugin jar",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.ErlangOTPPkg,
@@ -255,6 +455,14 @@ func Test_SourceInfo(t *testing.T) {
 				"acquired package info from Rockspec package file",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.SnapPkg,
+			},
+			expected: []string{
+				"from snap meta/snap.yaml file",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.SwiftPkg,
@@ -271,6 +479,86 @@ func Test_SourceInfo(t *testing.T) {
 				"acquired package info from SWI Prolo pack package file",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.TeXLivePkg,
+			},
+			expected: []string{
+				"from TeX Live package database",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.ToolchainPkg,
+			},
+			expected: []string{
+				"from version manager configuration (.tool-versions or mise.toml) or SDKMAN candidates",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.UnityPkg,
+			},
+			expected: []string{
+				"acquired package info from Unity package manifest or packages-lock.json file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.UnrealPkg,
+			},
+			expected: []string{
+				"acquired package info from Unreal Engine .uplugin or .uproject file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.VSCodeExtensionPkg,
+			},
+			expected: []string{
+				"acquired package info from VS Code extension package.json or extensions.json file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WasmModulePkg,
+			},
+			expected: []string{
+				"acquired package info from WebAssembly module custom sections",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WindowsAssemblyPkg,
+			},
+			expected: []string{
+				"from Windows WinSxS component manifest or Global Assembly Cache directory",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WindowsDriverPkg,
+			},
+			expected: []string{
+				"from Windows driver store INF file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WindowsInstallerPkg,
+			},
+			expected: []string{
+				"from Windows setup program product information",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.WindowsMSIPkg,
+			},
+			expected: []string{
+				"from Windows Installer package database",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.GithubActionPkg,
@@ -287,6 +575,46 @@ func Test_SourceInfo(t *testing.T) {
 				"from GitHub Actions workflow file or composite action file",
 			},
 		},
+		{
+			input: pkg.Package{
+				Type: pkg.BazelModulePkg,
+			},
+			expected: []string{
+				"acquired package info from Bazel module file or lockfile",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.BrowserExtensionPkg,
+			},
+			expected: []string{
+				"acquired package info from browser extension manifest.json or Firefox profile extensions.json file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.BuckPkg,
+			},
+			expected: []string{
+				"acquired package info from Buck2 BUCK file or .buckconfig",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.CarthagePkg,
+			},
+			expected: []string{
+				"acquired package info from Carthage Cartfile.resolved file or build version file",
+			},
+		},
+		{
+			input: pkg.Package{
+				Type: pkg.MesonWrapPkg,
+			},
+			expected: []string{
+				"acquired package info from Meson wrap file",
+			},
+		},
 		{
 			input: pkg.Package{
 				Type: pkg.WordpressPluginPkg,
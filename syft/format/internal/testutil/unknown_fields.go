@@ -0,0 +1,57 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// AssertUnknownFieldsRoundTrip adds fields unknown to the format to an encoded document (at the top level and on the
+// element within the given array field that describes "package-1"), then asserts that the fields are still present
+// after decoding and encoding the document again.
+func AssertUnknownFieldsRoundTrip(t *testing.T, enc sbom.FormatEncoder, dec sbom.FormatDecoderWithWarnings, elements string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, enc.Encode(&buf, DirectoryInput(t, t.TempDir())))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	doc["x-other-tool"] = map[string]any{"scanned": true}
+	element := findElement(t, doc, elements, "package-1")
+	element["x-other-tool-note"] = "reviewed"
+
+	input, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	s, _, _, warnings, err := dec.DecodeWithWarnings(bytes.NewReader(input))
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	buf.Reset()
+	require.NoError(t, enc.Encode(&buf, *s))
+
+	var actual map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &actual))
+	assert.Equal(t, map[string]any{"scanned": true}, actual["x-other-tool"])
+	assert.Equal(t, "reviewed", findElement(t, actual, elements, "package-1")["x-other-tool-note"])
+}
+
+func findElement(t *testing.T, doc map[string]any, elements, name string) map[string]any {
+	t.Helper()
+	values, ok := doc[elements].([]any)
+	require.True(t, ok, "no %q elements in document", elements)
+	for _, v := range values {
+		element, ok := v.(map[string]any)
+		if ok && element["name"] == name {
+			return element
+		}
+	}
+	require.Failf(t, "element not found", "no %q element named %q", elements, name)
+	return nil
+}
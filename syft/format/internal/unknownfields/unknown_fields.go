@@ -0,0 +1,289 @@
+package unknownfields
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields are JSON object fields by name.
+type Fields map[string]json.RawMessage
+
+// Spec describes where unknown fields are captured from (and restored to) within a JSON document: the document object
+// itself and the objects within one of its array fields (e.g. packages), which are matched by a key field.
+type Spec struct {
+	// Document is a value of the struct type that the document is decoded into; any field not represented by this
+	// type (including embedded structs) is considered unknown.
+	Document any
+
+	// DocumentFields are additional document field names that are known, e.g. handled by a custom unmarshaler.
+	DocumentFields []string
+
+	// Elements is the name of the document array field holding the elements.
+	Elements string
+
+	// Element is a value of the struct type that each element is decoded into.
+	Element any
+
+	// ElementFields are additional element field names that are known.
+	ElementFields []string
+
+	// Key is the name of the element field that uniquely identifies each element.
+	Key string
+}
+
+// Capture returns the unknown fields of the given JSON document and of each element with unknown fields (by key).
+func Capture(raw []byte, spec Spec) (Fields, map[string]Fields, error) {
+	fields, err := parseObject(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	documentKnown := knownFields(spec.Document, spec.DocumentFields)
+	elementKnown := knownFields(spec.Element, spec.ElementFields)
+
+	var document Fields
+	var elements map[string]Fields
+	for _, f := range fields {
+		if f.name == spec.Elements && spec.Elements != "" {
+			elements, err = captureElements(f.value, spec.Key, elementKnown)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to read %q: %w", f.name, err)
+			}
+			continue
+		}
+		if documentKnown[strings.ToLower(f.name)] {
+			continue
+		}
+		if document == nil {
+			document = make(Fields)
+		}
+		document[f.name] = f.value
+	}
+
+	return document, elements, nil
+}
+
+func captureElements(raw json.RawMessage, key string, known map[string]bool) (map[string]Fields, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+
+	var elements map[string]Fields
+	for _, value := range values {
+		fields, err := parseObject(value)
+		if err != nil {
+			return nil, err
+		}
+
+		id, unknown := "", Fields{}
+		for _, f := range fields {
+			switch {
+			case f.name == key:
+				// elements without a string key can never be matched when restoring, so are skipped
+				_ = json.Unmarshal(f.value, &id)
+			case !known[strings.ToLower(f.name)]:
+				unknown[f.name] = f.value
+			}
+		}
+		if id == "" || len(unknown) == 0 {
+			continue
+		}
+		if elements == nil {
+			elements = make(map[string]Fields)
+		}
+		elements[id] = unknown
+	}
+	return elements, nil
+}
+
+// Restore adds the given unknown fields to an encoded JSON document, placing element fields onto the element with
+// the same key. Fields that are already present in the encoded document are left as-is. When indent is given, the
+// result is indented with it (matching json.Encoder.SetIndent("", indent)).
+func Restore(encoded []byte, spec Spec, document Fields, elements map[string]Fields, indent string) ([]byte, error) {
+	if len(document) == 0 && len(elements) == 0 {
+		return encoded, nil
+	}
+
+	fields, err := parseObject(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, f := range fields {
+		if f.name != spec.Elements || len(elements) == 0 {
+			continue
+		}
+		value, err := restoreElements(f.value, spec.Key, elements)
+		if err != nil {
+			return nil, fmt.Errorf("unable to restore fields in %q: %w", f.name, err)
+		}
+		fields[i].value = value
+	}
+
+	fields = appendMissing(fields, document)
+
+	out := marshalObject(fields)
+	if indent != "" {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, out, "", indent); err != nil {
+			return nil, err
+		}
+		out = buf.Bytes()
+	}
+
+	// json.Encoder always terminates the document with a newline
+	if bytes.HasSuffix(encoded, []byte("\n")) {
+		out = append(out, '\n')
+	}
+	return out, nil
+}
+
+func restoreElements(raw json.RawMessage, key string, elements map[string]Fields) (json.RawMessage, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, value := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		fields, err := parseObject(value)
+		if err != nil {
+			return nil, err
+		}
+		var id string
+		for _, f := range fields {
+			if f.name == key {
+				_ = json.Unmarshal(f.value, &id)
+			}
+		}
+		if unknown, ok := elements[id]; ok {
+			fields = appendMissing(fields, unknown)
+		}
+		buf.Write(marshalObject(fields))
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// appendMissing adds the given fields that are not yet present (in name order, for stable output).
+func appendMissing(fields []field, unknown Fields) []field {
+	present := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		present[f.name] = true
+	}
+	var names []string
+	for name := range unknown {
+		if !present[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fields = append(fields, field{name: name, value: unknown[name]})
+	}
+	return fields
+}
+
+// field is a single JSON object field; objects are kept as ordered fields so that the encoded field order is retained.
+type field struct {
+	name  string
+	value json.RawMessage
+}
+
+func parseObject(raw []byte) ([]field, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+
+	var fields []field
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object key: %v", tok)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field{name: name, value: value})
+	}
+	return fields, nil
+}
+
+func marshalObject(fields []field) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(f.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		// compact values so that re-indenting the whole document is consistent
+		if err := json.Compact(&buf, f.value); err != nil {
+			buf.Write(f.value)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
+// knownFields returns the (lower-cased, since encoding/json matches field names case-insensitively) JSON field names
+// of the given struct value.
+func knownFields(v any, extra []string) map[string]bool {
+	known := make(map[string]bool)
+	for _, name := range extra {
+		known[strings.ToLower(name)] = true
+	}
+	if v != nil {
+		addFields(reflect.TypeOf(v), known)
+	}
+	return known
+}
+
+func addFields(t reflect.Type, known map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" && tag == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			addFields(f.Type, known)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+}
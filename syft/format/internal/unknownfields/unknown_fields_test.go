@@ -0,0 +1,117 @@
+package unknownfields
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDocument struct {
+	Name     string        `json:"name"`
+	Elements []testElement `json:"elements"`
+	Ignored  string        `json:"-"`
+	embedded
+}
+
+type embedded struct {
+	Version string `json:"version"`
+}
+
+type testElement struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind,omitempty"`
+}
+
+var testSpec = Spec{
+	Document:       testDocument{},
+	DocumentFields: []string{"describes"},
+	Elements:       "elements",
+	Element:        testElement{},
+	Key:            "id",
+}
+
+func TestCapture(t *testing.T) {
+	raw := []byte(`{
+  "name": "doc",
+  "Version": "1",
+  "describes": ["a"],
+  "Ignored": "x",
+  "x-tool": {"enabled": true},
+  "elements": [
+    {"id": "a", "kind": "lib", "x-note": "keep"},
+    {"id": "b", "kind": "app"},
+    {"kind": "no-id", "x-note": "dropped"}
+  ]
+}`)
+
+	document, elements, err := Capture(raw, testSpec)
+	require.NoError(t, err)
+
+	assert.Equal(t, Fields{
+		"Ignored": json.RawMessage(`"x"`),
+		"x-tool":  json.RawMessage(`{"enabled": true}`),
+	}, document)
+	assert.Equal(t, map[string]Fields{
+		"a": {"x-note": json.RawMessage(`"keep"`)},
+	}, elements)
+}
+
+func TestCapture_notAnObject(t *testing.T) {
+	_, _, err := Capture([]byte(`["not", "an", "object"]`), testSpec)
+	require.Error(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoded  string
+		document Fields
+		elements map[string]Fields
+		indent   string
+		want     string
+	}{
+		{
+			name:    "nothing to restore",
+			encoded: "{\"name\": \"doc\"}\n",
+			want:    "{\"name\": \"doc\"}\n",
+		},
+		{
+			name:    "document and element fields",
+			encoded: "{\"name\":\"doc\",\"elements\":[{\"id\":\"a\"},{\"id\":\"b\"}]}\n",
+			document: Fields{
+				"z-tool": json.RawMessage(`1`),
+				"x-tool": json.RawMessage(`{"enabled": true}`),
+			},
+			elements: map[string]Fields{
+				"b": {"x-note": json.RawMessage(`"keep"`)},
+			},
+			want: "{\"name\":\"doc\",\"elements\":[{\"id\":\"a\"},{\"id\":\"b\",\"x-note\":\"keep\"}],\"x-tool\":{\"enabled\":true},\"z-tool\":1}\n",
+		},
+		{
+			name:    "existing fields are not replaced",
+			encoded: `{"name":"doc","x-tool":"new"}`,
+			document: Fields{
+				"x-tool": json.RawMessage(`"old"`),
+			},
+			want: `{"name":"doc","x-tool":"new"}`,
+		},
+		{
+			name:    "indented",
+			encoded: "{\n \"name\": \"doc\"\n}\n",
+			document: Fields{
+				"x-tool": json.RawMessage(`[1, 2]`),
+			},
+			indent: " ",
+			want:   "{\n \"name\": \"doc\",\n \"x-tool\": [\n  1,\n  2\n ]\n}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Restore([]byte(tt.encoded), testSpec, tt.document, tt.elements, tt.indent)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
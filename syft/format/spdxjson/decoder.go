@@ -1,6 +1,7 @@
 package spdxjson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,7 +15,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*decoder)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*decoder)(nil)
 
 type decoder struct {
 }
@@ -24,9 +25,17 @@ func NewFormatDecoder() sbom.FormatDecoder {
 }
 
 func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := d.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+func (d decoder) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", err
+		return nil, "", "", nil, err
 	}
 
 	// since spdx lib will always return the latest version of the document, we need to identify the version
@@ -34,26 +43,40 @@ func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error)
 	// decoded object we will always get the latest version (instead of the version we decoded from).
 	id, version := d.Identify(reader)
 	if id != ID {
-		return nil, "", "", fmt.Errorf("not a spdx json document")
+		return nil, "", "", nil, fmt.Errorf("not a spdx json document")
 	}
 	if version == "" {
-		return nil, "", "", fmt.Errorf("unsupported spdx json document version")
+		return nil, "", "", nil, fmt.Errorf("unsupported spdx json document version")
 	}
 
 	if _, err := reader.Seek(0, io.SeekStart); err != nil {
-		return nil, "", "", fmt.Errorf("unable to seek to start of SPDX JSON SBOM: %+v", err)
+		return nil, "", "", nil, fmt.Errorf("unable to seek to start of SPDX JSON SBOM: %+v", err)
+	}
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, id, version, nil, fmt.Errorf("unable to read spdx json: %w", err)
 	}
 
-	doc, err := spdxJson.Read(reader)
+	doc, err := spdxJson.Read(bytes.NewReader(raw))
 	if err != nil {
-		return nil, id, version, fmt.Errorf("unable to decode spdx json: %w", err)
+		return nil, id, version, nil, fmt.Errorf("unable to decode spdx json: %w", err)
 	}
 
 	s, err := spdxhelpers.ToSyftModel(doc)
 	if err != nil {
-		return nil, id, version, err
+		return nil, id, version, nil, err
 	}
-	return s, id, version, nil
+
+	warnings := spdxhelpers.UnresolvedRelationships(doc)
+
+	extensions, err := captureExtensions(raw, doc)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("unable to preserve unknown fields: %v", err))
+	}
+	s.Extensions = extensions
+
+	return s, id, version, warnings, nil
 }
 
 func (d decoder) Identify(r io.Reader) (sbom.FormatID, string) {
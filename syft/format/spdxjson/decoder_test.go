@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anchore/syft/syft/format/internal/testutil"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 )
@@ -178,3 +180,33 @@ func TestDecoder_Identify(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoder_DecodeWithWarnings(t *testing.T) {
+	input := `{
+  "spdxVersion": "SPDX-2.3",
+  "dataLicense": "CC0-1.0",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "example",
+  "documentNamespace": "https://example.com/example",
+  "creationInfo": {"created": "2024-01-01T00:00:00Z", "creators": ["Tool: example"]},
+  "packages": [
+    {"SPDXID": "SPDXRef-left-pad", "name": "left-pad", "versionInfo": "1.3.0", "downloadLocation": "NOASSERTION"}
+  ],
+  "relationships": [
+    {"spdxElementId": "SPDXRef-DOCUMENT", "relatedSpdxElement": "SPDXRef-left-pad", "relationshipType": "DESCRIBES"},
+    {"spdxElementId": "SPDXRef-left-pad", "relatedSpdxElement": "SPDXRef-missing", "relationshipType": "DEPENDS_ON"}
+  ]
+}`
+
+	dec := NewFormatDecoder().(sbom.FormatDecoderWithWarnings)
+	s, _, _, warnings, err := dec.DecodeWithWarnings(strings.NewReader(input))
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	assert.Equal(t, []string{`relationship refers to undefined element "SPDXRef-missing"`}, warnings)
+}
+
+func TestDecoder_PreservesUnknownFields(t *testing.T) {
+	enc, err := NewFormatEncoderWithConfig(DefaultEncoderConfig())
+	require.NoError(t, err)
+	testutil.AssertUnknownFieldsRoundTrip(t, enc, NewFormatDecoder().(sbom.FormatDecoderWithWarnings), "packages")
+}
@@ -1,9 +1,11 @@
 package spdxjson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spdx/tools-golang/convert"
 	"github.com/spdx/tools-golang/spdx/v2/v2_1"
@@ -61,6 +63,14 @@ func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 		return fmt.Errorf("unable to convert SBOM to SPDX document")
 	}
 
+	// the JSON schema requires the full "DocumentRef-" identifier, which (unlike the tag-value writer) is not added
+	// when encoding
+	for i, ref := range latestDoc.ExternalDocumentReferences {
+		if !strings.HasPrefix(ref.DocumentRefID, "DocumentRef-") {
+			latestDoc.ExternalDocumentReferences[i].DocumentRefID = "DocumentRef-" + ref.DocumentRefID
+		}
+	}
+
 	var err error
 	var encodeDoc any
 	switch e.cfg.Version {
@@ -85,13 +95,38 @@ func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 		return fmt.Errorf("unable to convert SBOM to SPDX document: %w", err)
 	}
 
+	if s.Extensions.Format != ID || s.Extensions.Empty() {
+		return e.encode(writer, encodeDoc)
+	}
+
+	// fields from the decoded document that syft does not understand are added back to the encoded document
+	var buf bytes.Buffer
+	if err := e.encode(&buf, encodeDoc); err != nil {
+		return err
+	}
+
+	out, err := restoreExtensions(buf.Bytes(), s.Extensions, s.Artifacts.Packages, e.indent())
+	if err != nil {
+		return fmt.Errorf("unable to restore unknown fields: %w", err)
+	}
+
+	_, err = writer.Write(out)
+	return err
+}
+
+func (e encoder) encode(writer io.Writer, doc any) error {
 	enc := json.NewEncoder(writer)
 
 	enc.SetEscapeHTML(false)
 
+	enc.SetIndent("", e.indent())
+
+	return enc.Encode(doc)
+}
+
+func (e encoder) indent() string {
 	if e.cfg.Pretty {
-		enc.SetIndent("", " ")
+		return " "
 	}
-
-	return enc.Encode(encodeDoc)
+	return ""
 }
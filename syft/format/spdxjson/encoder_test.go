@@ -83,6 +83,24 @@ func TestEscapeHTML(t *testing.T) {
 
 }
 
+func TestPreviousDocumentReference(t *testing.T) {
+	s := testutil.DirectoryInput(t, t.TempDir())
+	s.Document.Previous = &sbom.PreviousDocument{
+		Namespace: "https://anchore.com/dir/some/path-b3553b0c-32bf-4bb3-96a1-263bcb922f10",
+		SHA1:      "1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e",
+	}
+
+	enc, err := NewFormatEncoderWithConfig(DefaultEncoderConfig())
+	require.NoError(t, err)
+
+	var buffer bytes.Buffer
+	require.NoError(t, enc.Encode(&buffer, s))
+
+	actual := buffer.String()
+	assert.Contains(t, actual, `"externalDocumentId":"DocumentRef-previous"`)
+	assert.Contains(t, actual, `"relatedSpdxElement":"DocumentRef-previous:SPDXRef-DOCUMENT","relationshipType":"AMENDS"`)
+}
+
 func TestSPDXJSONDirectoryEncoder(t *testing.T) {
 	dir := t.TempDir()
 	testutil.AssertEncoderAgainstGoldenSnapshot(t,
@@ -0,0 +1,67 @@
+package spdxjson
+
+import (
+	"encoding/json"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/format/common/spdxhelpers"
+	"github.com/anchore/syft/syft/format/internal/unknownfields"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// extensionFields describes where fields that are not part of the SPDX specification are preserved from: the document
+// and each package (by SPDX identifier, which is derived from the package when encoding).
+var extensionFields = unknownfields.Spec{
+	Document: v2_3.Document{},
+	// these are decoded by the SPDX library into relationships
+	DocumentFields: []string{"documentDescribes"},
+	Elements:       "packages",
+	Element:        v2_3.Package{},
+	ElementFields:  []string{"hasFiles"},
+	Key:            "SPDXID",
+}
+
+func captureExtensions(raw []byte, doc *spdx.Document) (sbom.Extensions, error) {
+	document, elements, err := unknownfields.Capture(raw, extensionFields)
+	if err != nil || (len(document) == 0 && len(elements) == 0) {
+		return sbom.Extensions{}, err
+	}
+
+	extensions := sbom.Extensions{
+		Format:   ID,
+		Document: document,
+	}
+	for _, p := range doc.Packages {
+		fields, ok := elements[elementID(p.PackageSPDXIdentifier)]
+		if !ok {
+			continue
+		}
+		if extensions.Packages == nil {
+			extensions.Packages = make(map[artifact.ID]map[string]json.RawMessage)
+		}
+		extensions.Packages[spdxhelpers.PackageID(p)] = fields
+	}
+	return extensions, nil
+}
+
+func restoreExtensions(encoded []byte, extensions sbom.Extensions, packages *pkg.Collection, indent string) ([]byte, error) {
+	elements := make(map[string]unknownfields.Fields)
+	for id, fields := range extensions.Packages {
+		if packages == nil {
+			break
+		}
+		if p := packages.Package(id); p != nil {
+			elements[elementID(spdxhelpers.PackageSPDXID(*p))] = fields
+		}
+	}
+	return unknownfields.Restore(encoded, extensionFields, extensions.Document, elements, indent)
+}
+
+// elementID returns the identifier as it appears in SPDX JSON documents.
+func elementID(id spdx.ElementID) string {
+	return "SPDXRef-" + string(id)
+}
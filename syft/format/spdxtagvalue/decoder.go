@@ -14,7 +14,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*decoder)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*decoder)(nil)
 
 type decoder struct {
 }
@@ -24,9 +24,17 @@ func NewFormatDecoder() sbom.FormatDecoder {
 }
 
 func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := d.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+func (d decoder) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", err
+		return nil, "", "", nil, err
 	}
 
 	// since spdx lib will always return the latest version of the document, we need to identify the version
@@ -34,26 +42,27 @@ func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error)
 	// decoded object we will always get the latest version (instead of the version we decoded from).
 	id, version := d.Identify(reader)
 	if id != ID {
-		return nil, "", "", fmt.Errorf("not a spdx tag-value document")
+		return nil, "", "", nil, fmt.Errorf("not a spdx tag-value document")
 	}
 	if version == "" {
-		return nil, "", "", fmt.Errorf("unsupported spdx tag-value document version")
+		return nil, "", "", nil, fmt.Errorf("unsupported spdx tag-value document version")
 	}
 
 	if _, err := reader.Seek(0, io.SeekStart); err != nil {
-		return nil, "", "", fmt.Errorf("unable to seek to start of SPDX Tag-Value SBOM: %+v", err)
+		return nil, "", "", nil, fmt.Errorf("unable to seek to start of SPDX Tag-Value SBOM: %+v", err)
 	}
 
 	doc, err := tagvalue.Read(reader)
 	if err != nil {
-		return nil, id, version, fmt.Errorf("unable to decode spdx tag-value: %w", err)
+		return nil, id, version, nil, fmt.Errorf("unable to decode spdx tag-value: %w", err)
 	}
 
 	s, err := spdxhelpers.ToSyftModel(doc)
 	if err != nil {
-		return nil, id, version, err
+		return nil, id, version, nil, err
 	}
-	return s, id, version, nil
+
+	return s, id, version, spdxhelpers.UnresolvedRelationships(doc), nil
 }
 
 func (d decoder) Identify(r io.Reader) (sbom.FormatID, string) {
@@ -0,0 +1,199 @@
+package syftdelta
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// EventType describes how a component differs between the previous and the current SBOM.
+type EventType string
+
+const (
+	AddedEvent   EventType = "added"
+	RemovedEvent EventType = "removed"
+	ChangedEvent EventType = "changed"
+)
+
+// Change names an attribute of a component that differs between the previous and the current SBOM.
+type Change string
+
+const (
+	VersionChange  Change = "version"
+	PURLChange     Change = "purl"
+	LicensesChange Change = "licenses"
+	CPEsChange     Change = "cpes"
+)
+
+// Event is a single difference between the previous and the current SBOM. For added events only Current is set,
+// for removed events only Previous is set, and changed events have both.
+type Event struct {
+	Type     EventType
+	Current  *pkg.Package
+	Previous *pkg.Package
+	Changes  []Change
+}
+
+// Delta is the set of differences between the packages of two SBOMs.
+type Delta struct {
+	Events    []Event
+	Unchanged int
+}
+
+// Compute determines which packages were added, removed, or changed in the current SBOM relative to the previous
+// SBOM. Packages are matched by type and name (plus the purl namespace, when present); within a match, packages with
+// the same version are paired first and remaining packages are paired as version changes.
+func Compute(previous, current sbom.SBOM) Delta {
+	prev := groupPackages(previous)
+	curr := groupPackages(current)
+
+	keys := make(map[string]struct{})
+	for k := range prev {
+		keys[k] = struct{}{}
+	}
+	for k := range curr {
+		keys[k] = struct{}{}
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	var d Delta
+	for _, k := range sortedKeys {
+		d.compare(prev[k], curr[k])
+	}
+	return d
+}
+
+func (d *Delta) compare(previous, current []pkg.Package) {
+	matchedPrev := make([]bool, len(previous))
+	var unmatched []pkg.Package
+
+	// pair packages that have the same version
+	for _, c := range current {
+		idx := -1
+		for i, p := range previous {
+			if !matchedPrev[i] && p.Version == c.Version {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			unmatched = append(unmatched, c)
+			continue
+		}
+		matchedPrev[idx] = true
+		d.record(previous[idx], c)
+	}
+
+	var remaining []pkg.Package
+	for i, p := range previous {
+		if !matchedPrev[i] {
+			remaining = append(remaining, p)
+		}
+	}
+
+	// any leftover packages on both sides are considered to be the same component at a different version
+	for len(unmatched) > 0 && len(remaining) > 0 {
+		d.record(remaining[0], unmatched[0])
+		remaining, unmatched = remaining[1:], unmatched[1:]
+	}
+
+	for i := range unmatched {
+		d.Events = append(d.Events, Event{Type: AddedEvent, Current: &unmatched[i]})
+	}
+	for i := range remaining {
+		d.Events = append(d.Events, Event{Type: RemovedEvent, Previous: &remaining[i]})
+	}
+}
+
+func (d *Delta) record(previous, current pkg.Package) {
+	changes := differences(previous, current)
+	if len(changes) == 0 {
+		d.Unchanged++
+		return
+	}
+	d.Events = append(d.Events, Event{
+		Type:     ChangedEvent,
+		Current:  &current,
+		Previous: &previous,
+		Changes:  changes,
+	})
+}
+
+func differences(previous, current pkg.Package) []Change {
+	var changes []Change
+	if previous.Version != current.Version {
+		changes = append(changes, VersionChange)
+	}
+	if previous.PURL != current.PURL {
+		changes = append(changes, PURLChange)
+	}
+	if !equalStrings(licenseValues(previous), licenseValues(current)) {
+		changes = append(changes, LicensesChange)
+	}
+	if !equalStrings(cpeValues(previous), cpeValues(current)) {
+		changes = append(changes, CPEsChange)
+	}
+	return changes
+}
+
+// groupPackages returns all packages in the SBOM keyed by their identity (irrespective of version), each group
+// sorted for stable pairing.
+func groupPackages(s sbom.SBOM) map[string][]pkg.Package {
+	groups := make(map[string][]pkg.Package)
+	if s.Artifacts.Packages == nil {
+		return groups
+	}
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		k := identity(p)
+		groups[k] = append(groups[k], p)
+	}
+	return groups
+}
+
+func identity(p pkg.Package) string {
+	fields := []string{string(p.Type), p.Name}
+	if p.PURL != "" {
+		if purl, err := packageurl.FromString(p.PURL); err == nil {
+			fields = append(fields, purl.Namespace)
+		}
+	}
+	return strings.Join(fields, "/")
+}
+
+func licenseValues(p pkg.Package) []string {
+	var values []string
+	for _, l := range p.Licenses.ToSlice() {
+		values = append(values, l.Value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+func cpeValues(p pkg.Package) []string {
+	var values []string
+	for _, c := range p.CPEs {
+		values = append(values, c.Attributes.String())
+	}
+	sort.Strings(values)
+	return values
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package syftdelta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func newPackage(name, version string, licenses ...string) pkg.Package {
+	p := pkg.Package{
+		Name:     name,
+		Version:  version,
+		Type:     pkg.ApkPkg,
+		PURL:     "pkg:apk/alpine/" + name + "@" + version,
+		Licenses: pkg.NewLicenseSet(),
+	}
+	for _, l := range licenses {
+		p.Licenses.Add(pkg.NewLicense(l))
+	}
+	p.SetID()
+	return p
+}
+
+func newSBOM(pkgs ...pkg.Package) sbom.SBOM {
+	return sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(pkgs...),
+		},
+	}
+}
+
+type eventSummary struct {
+	Type     EventType
+	Current  string
+	Previous string
+	Changes  []Change
+}
+
+func summarize(d Delta) []eventSummary {
+	var summaries []eventSummary
+	for _, e := range d.Events {
+		s := eventSummary{Type: e.Type, Changes: e.Changes}
+		if e.Current != nil {
+			s.Current = e.Current.Name + "@" + e.Current.Version
+		}
+		if e.Previous != nil {
+			s.Previous = e.Previous.Name + "@" + e.Previous.Version
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name          string
+		previous      sbom.SBOM
+		current       sbom.SBOM
+		want          []eventSummary
+		wantUnchanged int
+	}{
+		{
+			name:          "identical",
+			previous:      newSBOM(newPackage("musl", "1.2.4", "MIT")),
+			current:       newSBOM(newPackage("musl", "1.2.4", "MIT")),
+			wantUnchanged: 1,
+		},
+		{
+			name:     "added and removed",
+			previous: newSBOM(newPackage("busybox", "1.36.1")),
+			current:  newSBOM(newPackage("zlib", "1.3.1")),
+			want: []eventSummary{
+				{Type: RemovedEvent, Previous: "busybox@1.36.1"},
+				{Type: AddedEvent, Current: "zlib@1.3.1"},
+			},
+		},
+		{
+			name:     "version upgrade",
+			previous: newSBOM(newPackage("openssl", "3.1.4")),
+			current:  newSBOM(newPackage("openssl", "3.1.5")),
+			want: []eventSummary{
+				{Type: ChangedEvent, Current: "openssl@3.1.5", Previous: "openssl@3.1.4", Changes: []Change{VersionChange, PURLChange}},
+			},
+		},
+		{
+			name:     "license change at the same version",
+			previous: newSBOM(newPackage("musl", "1.2.4", "MIT")),
+			current:  newSBOM(newPackage("musl", "1.2.4", "MIT", "BSD-2-Clause")),
+			want: []eventSummary{
+				{Type: ChangedEvent, Current: "musl@1.2.4", Previous: "musl@1.2.4", Changes: []Change{LicensesChange}},
+			},
+		},
+		{
+			name: "multiple versions of the same package",
+			previous: newSBOM(
+				newPackage("libcrypto", "3.1.4"),
+				newPackage("libcrypto", "1.1.1"),
+			),
+			current: newSBOM(
+				newPackage("libcrypto", "3.1.4"),
+				newPackage("libcrypto", "3.2.0"),
+				newPackage("libcrypto", "3.3.0"),
+			),
+			want: []eventSummary{
+				{Type: ChangedEvent, Current: "libcrypto@3.2.0", Previous: "libcrypto@1.1.1", Changes: []Change{VersionChange, PURLChange}},
+				{Type: AddedEvent, Current: "libcrypto@3.3.0"},
+			},
+			wantUnchanged: 1,
+		},
+		{
+			name:     "no previous packages",
+			previous: sbom.SBOM{},
+			current:  newSBOM(newPackage("musl", "1.2.4")),
+			want: []eventSummary{
+				{Type: AddedEvent, Current: "musl@1.2.4"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compute(tt.previous, tt.current)
+			assert.Equal(t, tt.want, summarize(got))
+			assert.Equal(t, tt.wantUnchanged, got.Unchanged)
+		})
+	}
+}
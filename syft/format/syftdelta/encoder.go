@@ -0,0 +1,87 @@
+package syftdelta
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/anchore/syft/syft/sbom"
+)
+
+var _ sbom.FormatEncoder = (*encoder)(nil)
+
+const ID sbom.FormatID = "syft-delta-json"
+
+// EncoderConfig configures the syft-delta-json format encoder.
+type EncoderConfig struct {
+	Previous          *sbom.SBOM                 // the SBOM to compare against (required, unless PreviousLoader is given)
+	PreviousLoader    func() (*sbom.SBOM, error) // loads the SBOM to compare against when first encoding (used when Previous is not given)
+	PreviousReference string                     // where the previous SBOM was obtained from (a path or image reference), recorded in the output
+	Pretty            bool                       // include spaces and newlines
+}
+
+type encoder struct {
+	cfg      EncoderConfig
+	previous func() (*sbom.SBOM, error)
+}
+
+func NewFormatEncoderWithConfig(cfg EncoderConfig) (sbom.FormatEncoder, error) {
+	previous := func() (*sbom.SBOM, error) { return cfg.Previous, nil }
+	if cfg.Previous == nil {
+		if cfg.PreviousLoader == nil {
+			return nil, errors.New("a previous SBOM is required to produce a delta")
+		}
+		// the previous SBOM may be expensive to obtain (e.g. from a registry), so it is only loaded once it is needed
+		previous = sync.OnceValues(cfg.PreviousLoader)
+	}
+	return encoder{
+		cfg:      cfg,
+		previous: previous,
+	}, nil
+}
+
+func DefaultEncoderConfig() EncoderConfig {
+	return EncoderConfig{
+		Pretty: false,
+	}
+}
+
+func (e encoder) ID() sbom.FormatID {
+	return ID
+}
+
+func (e encoder) Aliases() []string {
+	return []string{
+		"delta",
+		"syft-delta",
+	}
+}
+
+func (e encoder) Version() string {
+	return sbom.AnyVersion
+}
+
+func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
+	previous, err := e.previous()
+	if err != nil {
+		return err
+	}
+	if previous == nil {
+		return errors.New("a previous SBOM is required to produce a delta")
+	}
+
+	d := Compute(*previous, s)
+	doc := toDocument(d, s, *previous, e.cfg.PreviousReference, time.Now().UTC().Format(time.RFC3339))
+
+	enc := json.NewEncoder(writer)
+
+	enc.SetEscapeHTML(false)
+
+	if e.cfg.Pretty {
+		enc.SetIndent("", " ")
+	}
+
+	return enc.Encode(&doc)
+}
@@ -0,0 +1,113 @@
+package syftdelta
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestNewFormatEncoderWithConfig_requiresPrevious(t *testing.T) {
+	_, err := NewFormatEncoderWithConfig(DefaultEncoderConfig())
+	require.Error(t, err)
+}
+
+func TestEncode(t *testing.T) {
+	previous := newSBOM(newPackage("musl", "1.2.4", "MIT"), newPackage("busybox", "1.36.1"))
+	previous.Source = source.Description{ID: "prev", Name: "alpine", Version: "3.19"}
+
+	current := newSBOM(newPackage("musl", "1.2.5", "MIT"), newPackage("zlib", "1.3.1"))
+	current.Source = source.Description{ID: "curr", Name: "alpine", Version: "3.20"}
+	current.Descriptor = sbom.Descriptor{Name: "syft", Version: "v1.0.0"}
+
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{
+		Previous:          &previous,
+		PreviousReference: "alpine-3.19.spdx.json",
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, enc.Encode(&buf, current))
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	assert.NotEmpty(t, doc.Timestamp)
+	assert.Equal(t, Descriptor{Name: "syft", Version: "v1.0.0"}, doc.Descriptor)
+	assert.Equal(t, Source{ID: "curr", Name: "alpine", Version: "3.20"}, doc.Source)
+	assert.Equal(t, Source{ID: "prev", Name: "alpine", Version: "3.19", Reference: "alpine-3.19.spdx.json"}, doc.Previous)
+	assert.Equal(t, Summary{Added: 1, Removed: 1, Changed: 1}, doc.Summary)
+
+	require.Len(t, doc.Events, 3)
+
+	assert.Equal(t, RemovedEvent, doc.Events[0].Type)
+	assert.Nil(t, doc.Events[0].Component)
+	assert.Equal(t, "busybox", doc.Events[0].Previous.Name)
+
+	assert.Equal(t, ChangedEvent, doc.Events[1].Type)
+	assert.Equal(t, "1.2.5", doc.Events[1].Component.Version)
+	assert.Equal(t, "pkg:apk/alpine/musl@1.2.5", doc.Events[1].Component.PURL)
+	assert.Equal(t, []string{"MIT"}, doc.Events[1].Component.Licenses)
+	assert.Equal(t, "1.2.4", doc.Events[1].Previous.Version)
+	assert.Equal(t, []Change{VersionChange, PURLChange}, doc.Events[1].Changes)
+
+	assert.Equal(t, AddedEvent, doc.Events[2].Type)
+	assert.Equal(t, "zlib", doc.Events[2].Component.Name)
+	assert.Nil(t, doc.Events[2].Previous)
+}
+
+func TestEncode_noChanges(t *testing.T) {
+	s := newSBOM(newPackage("musl", "1.2.4"))
+
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{Previous: &s})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, enc.Encode(&buf, s))
+
+	var doc Document
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, Summary{Unchanged: 1}, doc.Summary)
+	// an empty list (not null) is always emitted so consumers can iterate without checks
+	assert.Contains(t, buf.String(), `"events":[]`)
+}
+
+func TestEncode_previousLoader(t *testing.T) {
+	s := newSBOM(newPackage("musl", "1.2.4"))
+
+	loads := 0
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{
+		PreviousLoader: func() (*sbom.SBOM, error) {
+			loads++
+			return &s, nil
+		},
+	})
+	require.NoError(t, err)
+	// the previous SBOM is not loaded until it is needed
+	assert.Zero(t, loads)
+
+	for range 2 {
+		var buf bytes.Buffer
+		require.NoError(t, enc.Encode(&buf, s))
+	}
+	assert.Equal(t, 1, loads)
+}
+
+func TestEncode_previousLoaderError(t *testing.T) {
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{
+		PreviousLoader: func() (*sbom.SBOM, error) {
+			return nil, errors.New("unable to fetch")
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.ErrorContains(t, enc.Encode(&buf, newSBOM()), "unable to fetch")
+}
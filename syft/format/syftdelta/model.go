@@ -0,0 +1,128 @@
+package syftdelta
+
+import (
+	"sort"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// Document is the event document emitted by the syft-delta-json format, describing only the components that differ
+// from a previous SBOM.
+type Document struct {
+	Timestamp  string        `json:"timestamp"`
+	Descriptor Descriptor    `json:"descriptor"`
+	Source     Source        `json:"source"`
+	Previous   Source        `json:"previous"`
+	Summary    Summary       `json:"summary"`
+	Events     []EventRecord `json:"events"`
+}
+
+// Descriptor describes the tool that created the document.
+type Descriptor struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Source identifies the subject of an SBOM that the delta was computed against.
+type Source struct {
+	ID        string `json:"id"`
+	Name      string `json:"name,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+// Summary holds the number of components in each event category.
+type Summary struct {
+	Added     int `json:"added"`
+	Removed   int `json:"removed"`
+	Changed   int `json:"changed"`
+	Unchanged int `json:"unchanged"`
+}
+
+// EventRecord is the serialized form of a single Event.
+type EventRecord struct {
+	Type      EventType  `json:"type"`
+	Component *Component `json:"component,omitempty"`
+	Previous  *Component `json:"previous,omitempty"`
+	Changes   []Change   `json:"changes,omitempty"`
+}
+
+// Component is the subset of package information needed to ingest an event.
+type Component struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Version   string   `json:"version"`
+	Type      pkg.Type `json:"type"`
+	FoundBy   string   `json:"foundBy,omitempty"`
+	PURL      string   `json:"purl,omitempty"`
+	CPEs      []string `json:"cpes,omitempty"`
+	Licenses  []string `json:"licenses,omitempty"`
+	Locations []string `json:"locations,omitempty"`
+}
+
+func toDocument(d Delta, current, previous sbom.SBOM, previousReference, timestamp string) Document {
+	doc := Document{
+		Timestamp: timestamp,
+		Descriptor: Descriptor{
+			Name:    current.Descriptor.Name,
+			Version: current.Descriptor.Version,
+		},
+		Source:   toSource(current, ""),
+		Previous: toSource(previous, previousReference),
+		Summary:  Summary{Unchanged: d.Unchanged},
+		Events:   []EventRecord{},
+	}
+
+	for _, e := range d.Events {
+		switch e.Type {
+		case AddedEvent:
+			doc.Summary.Added++
+		case RemovedEvent:
+			doc.Summary.Removed++
+		case ChangedEvent:
+			doc.Summary.Changed++
+		}
+		doc.Events = append(doc.Events, EventRecord{
+			Type:      e.Type,
+			Component: toComponent(e.Current),
+			Previous:  toComponent(e.Previous),
+			Changes:   e.Changes,
+		})
+	}
+
+	return doc
+}
+
+func toSource(s sbom.SBOM, reference string) Source {
+	return Source{
+		ID:        s.Source.ID,
+		Name:      s.Source.Name,
+		Version:   s.Source.Version,
+		Reference: reference,
+	}
+}
+
+func toComponent(p *pkg.Package) *Component {
+	if p == nil {
+		return nil
+	}
+
+	var locations []string
+	for _, l := range p.Locations.ToSlice() {
+		locations = append(locations, l.RealPath)
+	}
+	sort.Strings(locations)
+
+	return &Component{
+		ID:        string(p.ID()),
+		Name:      p.Name,
+		Version:   p.Version,
+		Type:      p.Type,
+		FoundBy:   p.FoundBy,
+		PURL:      p.PURL,
+		CPEs:      cpeValues(*p),
+		Licenses:  licenseValues(*p),
+		Locations: locations,
+	}
+}
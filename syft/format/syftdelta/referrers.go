@@ -0,0 +1,94 @@
+package syftdelta
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// createdAnnotation is the standard OCI annotation for the creation time of an artifact.
+const createdAnnotation = "org.opencontainers.image.created"
+
+// sbomArtifactTypes are the OCI artifact types that are considered to be SBOMs when looking up image referrers.
+var sbomArtifactTypes = map[string]struct{}{
+	"application/vnd.syft+json":          {},
+	"application/spdx+json":              {},
+	"application/vnd.cyclonedx+json":     {},
+	"application/vnd.cyclonedx+xml":      {},
+	"application/vnd.cyclonedx":          {},
+	"text/spdx":                          {},
+	"application/vnd.dev.cosign.sbom.v1": {},
+}
+
+// ReferrerSBOM finds the most recently created SBOM attached to the given image as an OCI referrer and returns
+// a reader for the raw SBOM document (the first layer of the referrer artifact). The caller is responsible for
+// decoding and closing the returned reader.
+func ReferrerSBOM(ctx context.Context, imageRef string, opts ...remote.Option) (io.ReadCloser, error) {
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse image reference %q: %w", imageRef, err)
+	}
+
+	opts = append([]remote.Option{remote.WithContext(ctx)}, opts...)
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve image %q: %w", imageRef, err)
+	}
+
+	index, err := remote.Referrers(ref.Context().Digest(desc.Digest.String()), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list referrers for %q: %w", imageRef, err)
+	}
+
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read referrers for %q: %w", imageRef, err)
+	}
+
+	candidate := latestSBOMReferrer(manifest.Manifests)
+	if candidate == nil {
+		return nil, fmt.Errorf("no SBOM referrers found for %q", imageRef)
+	}
+
+	artifact, err := remote.Image(ref.Context().Digest(candidate.Digest.String()), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch SBOM referrer %s: %w", candidate.Digest, err)
+	}
+
+	layers, err := artifact.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read SBOM referrer %s: %w", candidate.Digest, err)
+	}
+	if len(layers) == 0 {
+		return nil, fmt.Errorf("SBOM referrer %s has no content", candidate.Digest)
+	}
+
+	return layers[0].Uncompressed()
+}
+
+// latestSBOMReferrer returns the SBOM referrer with the newest creation annotation, preferring the last listed
+// referrer when creation times are equal or absent.
+func latestSBOMReferrer(descriptors []v1.Descriptor) *v1.Descriptor {
+	var candidates []v1.Descriptor
+	for _, d := range descriptors {
+		if _, ok := sbomArtifactTypes[d.ArtifactType]; ok {
+			candidates = append(candidates, d)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		// RFC 3339 timestamps sort lexically
+		return candidates[i].Annotations[createdAnnotation] < candidates[j].Annotations[createdAnnotation]
+	})
+
+	return &candidates[len(candidates)-1]
+}
@@ -0,0 +1,134 @@
+package syftdelta
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferrerSBOM(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	ref, err := name.ParseReference(host + "/app:latest")
+	require.NoError(t, err)
+
+	subject, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, subject))
+
+	subjectDesc, err := remote.Head(ref)
+	require.NoError(t, err)
+
+	pushArtifact(t, ref, *subjectDesc, "application/vnd.dev.sigstore.bundle.v0.3+json", `{"signature":true}`)
+	pushArtifact(t, ref, *subjectDesc, "application/spdx+json", `{"spdxVersion":"SPDX-2.3"}`)
+
+	reader, err := ReferrerSBOM(context.Background(), ref.String())
+	require.NoError(t, err)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, `{"spdxVersion":"SPDX-2.3"}`, string(content))
+}
+
+func TestReferrerSBOM_noSBOM(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	ref, err := name.ParseReference(host + "/app:latest")
+	require.NoError(t, err)
+
+	subject, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, subject))
+
+	_, err = ReferrerSBOM(context.Background(), ref.String())
+	require.ErrorContains(t, err, "no SBOM referrers found")
+}
+
+func pushArtifact(t *testing.T, subjectRef name.Reference, subject v1.Descriptor, artifactType, content string) {
+	t.Helper()
+
+	img, err := mutate.AppendLayers(empty.Image, static.NewLayer([]byte(content), types.MediaType(artifactType)))
+	require.NoError(t, err)
+	img = mutate.ConfigMediaType(img, types.MediaType(artifactType))
+	img = mutate.Subject(img, subject).(v1.Image)
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(subjectRef.Context().Digest(digest.String()), img))
+}
+
+func Test_latestSBOMReferrer(t *testing.T) {
+	descriptor := func(artifactType, created, digest string) v1.Descriptor {
+		d := v1.Descriptor{
+			ArtifactType: artifactType,
+			Digest:       v1.Hash{Algorithm: "sha256", Hex: digest},
+		}
+		if created != "" {
+			d.Annotations = map[string]string{createdAnnotation: created}
+		}
+		return d
+	}
+
+	tests := []struct {
+		name        string
+		descriptors []v1.Descriptor
+		want        string
+	}{
+		{
+			name: "no referrers",
+		},
+		{
+			name: "no SBOM referrers",
+			descriptors: []v1.Descriptor{
+				descriptor("application/vnd.dev.sigstore.bundle.v0.3+json", "", "a"),
+			},
+		},
+		{
+			name: "newest SBOM by creation time",
+			descriptors: []v1.Descriptor{
+				descriptor("application/spdx+json", "2024-06-02T00:00:00Z", "b"),
+				descriptor("application/vnd.cyclonedx+json", "2024-06-01T00:00:00Z", "c"),
+				descriptor("application/vnd.dev.sigstore.bundle.v0.3+json", "2024-06-03T00:00:00Z", "d"),
+			},
+			want: "b",
+		},
+		{
+			name: "last listed SBOM without creation times",
+			descriptors: []v1.Descriptor{
+				descriptor("application/vnd.syft+json", "", "e"),
+				descriptor("application/spdx+json", "", "f"),
+			},
+			want: "f",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := latestSBOMReferrer(tt.descriptors)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.Digest.Hex)
+		})
+	}
+}
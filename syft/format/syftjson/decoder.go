@@ -1,6 +1,7 @@
 package syftjson
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,7 +16,7 @@ import (
 	"github.com/anchore/syft/syft/sbom"
 )
 
-var _ sbom.FormatDecoder = (*decoder)(nil)
+var _ sbom.FormatDecoderWithWarnings = (*decoder)(nil)
 
 type decoder struct{}
 
@@ -24,32 +25,53 @@ func NewFormatDecoder() sbom.FormatDecoder {
 }
 
 func (d decoder) Decode(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, error) {
+	s, id, version, warnings, err := d.DecodeWithWarnings(r)
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+	return s, id, version, err
+}
+
+func (d decoder) DecodeWithWarnings(r io.Reader) (*sbom.SBOM, sbom.FormatID, string, []string, error) {
 	reader, err := stream.SeekableReader(r)
 	if err != nil {
-		return nil, "", "", err
+		return nil, "", "", nil, err
 	}
 
 	id, version := d.Identify(reader)
 	if version == "" || id != ID {
-		return nil, "", "", fmt.Errorf("not a syft-json document")
+		return nil, "", "", nil, fmt.Errorf("not a syft-json document")
 	}
 	var doc model.Document
 
 	if _, err := reader.Seek(0, io.SeekStart); err != nil {
-		return nil, "", "", fmt.Errorf("unable to seek to start of Syft JSON SBOM: %+v", err)
+		return nil, "", "", nil, fmt.Errorf("unable to seek to start of Syft JSON SBOM: %+v", err)
 	}
 
-	dec := json.NewDecoder(reader)
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, "", "", nil, fmt.Errorf("unable to read syft-json document: %w", err)
+	}
 
-	if err = dec.Decode(&doc); err != nil {
-		return nil, "", "", fmt.Errorf("unable to decode syft-json document: %w", err)
+	if err = json.NewDecoder(bytes.NewReader(raw)).Decode(&doc); err != nil {
+		return nil, "", "", nil, fmt.Errorf("unable to decode syft-json document: %w", err)
 	}
 
+	var warnings []string
 	if err := checkSupportedSchema(doc.Schema.Version, internal.JSONSchemaVersion); err != nil {
-		log.Warn(err)
+		warnings = append(warnings, err.Error())
+	}
+
+	s, conversionWarnings := toSyftModel(doc)
+	warnings = append(warnings, conversionWarnings...)
+
+	extensions, err := captureExtensions(raw)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("unable to preserve unknown fields: %v", err))
 	}
+	s.Extensions = extensions
 
-	return toSyftModel(doc), ID, doc.Schema.Version, nil
+	return s, ID, doc.Schema.Version, warnings, nil
 }
 
 func (d decoder) Identify(r io.Reader) (sbom.FormatID, string) {
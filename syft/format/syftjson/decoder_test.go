@@ -13,6 +13,7 @@ import (
 
 	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/cpe"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/format/internal/testutil"
@@ -237,6 +238,34 @@ func Test_encodeDecodeFileMetadata(t *testing.T) {
 					},
 				},
 			},
+			KeyMaterial: map[file.Coordinates]file.KeyMaterial{
+				c: {
+					Format: "PEM",
+					Keys: []file.Key{
+						{
+							Type:                 "public-key",
+							Algorithm:            "RSA",
+							Size:                 2048,
+							Format:               "PKIX",
+							PublicKeyFingerprint: "d34db33f",
+						},
+					},
+				},
+			},
+			FileSnippets: map[file.Coordinates][]file.Snippet{
+				c: {
+					{
+						Name:       "jQuery",
+						Version:    "3.7.1",
+						Offset:     61,
+						Extent:     88,
+						StartLine:  2,
+						EndLine:    2,
+						Licenses:   []string{"MIT"},
+						Copyrights: []string{"(c) OpenJS Foundation"},
+					},
+				},
+			},
 			LinuxDistribution: &linux.Release{
 				PrettyName:       "some os",
 				Name:             "os",
@@ -294,3 +323,29 @@ func Test_encodeDecodeFileMetadata(t *testing.T) {
 
 	require.Equal(t, s, *got)
 }
+
+func TestDecoder_DecodeWithWarnings(t *testing.T) {
+	s := testutil.DirectoryInput(t, t.TempDir())
+	s.Relationships = append(s.Relationships, artifact.Relationship{
+		From: s.Artifacts.Packages.Sorted()[0],
+		To:   s.Artifacts.Packages.Sorted()[1],
+		Type: "not-a-real-relationship",
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, NewFormatEncoder().Encode(&buf, s))
+
+	// simulate a document from a newer version of syft
+	input := strings.Replace(buf.String(), `"version":"`+internal.JSONSchemaVersion+`"`, `"version":"999.0.0"`, 1)
+
+	_, _, _, warnings, err := NewFormatDecoder().(sbom.FormatDecoderWithWarnings).DecodeWithWarnings(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf("document has schema version 999.0.0, but parser has older schema version (%s)", internal.JSONSchemaVersion),
+		`"unknown relationship type: not-a-real-relationship" occurred 1 time(s)`,
+	}, warnings)
+}
+
+func TestDecoder_PreservesUnknownFields(t *testing.T) {
+	testutil.AssertUnknownFieldsRoundTrip(t, NewFormatEncoder(), NewFormatDecoder().(sbom.FormatDecoderWithWarnings), "artifacts")
+}
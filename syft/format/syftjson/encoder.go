@@ -1,10 +1,13 @@
 package syftjson
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/format/syftjson/model"
 	"github.com/anchore/syft/syft/sbom"
 )
 
@@ -60,13 +63,38 @@ func (e encoder) Version() string {
 func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 	doc := ToFormatModel(s, e.cfg)
 
+	if s.Extensions.Format != ID || s.Extensions.Empty() {
+		return e.encode(writer, doc)
+	}
+
+	// fields from the decoded document that syft does not understand are added back to the encoded document
+	var buf bytes.Buffer
+	if err := e.encode(&buf, doc); err != nil {
+		return err
+	}
+
+	out, err := restoreExtensions(buf.Bytes(), s.Extensions, e.indent())
+	if err != nil {
+		return fmt.Errorf("unable to restore unknown fields: %w", err)
+	}
+
+	_, err = writer.Write(out)
+	return err
+}
+
+func (e encoder) encode(writer io.Writer, doc model.Document) error {
 	enc := json.NewEncoder(writer)
 
 	enc.SetEscapeHTML(false)
 
-	if e.cfg.Pretty {
-		enc.SetIndent("", " ")
-	}
+	enc.SetIndent("", e.indent())
 
 	return enc.Encode(&doc)
 }
+
+func (e encoder) indent() string {
+	if e.cfg.Pretty {
+		return " "
+	}
+	return ""
+}
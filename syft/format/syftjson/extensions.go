@@ -0,0 +1,46 @@
+package syftjson
+
+import (
+	"encoding/json"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/format/internal/unknownfields"
+	"github.com/anchore/syft/syft/format/syftjson/model"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// extensionFields describes where fields that are not part of the syft-json schema are preserved from: the document
+// and each package (by package ID, which is retained when decoding).
+var extensionFields = unknownfields.Spec{
+	Document: model.Document{},
+	Elements: "artifacts",
+	Element:  model.Package{},
+	Key:      "id",
+}
+
+func captureExtensions(raw []byte) (sbom.Extensions, error) {
+	document, elements, err := unknownfields.Capture(raw, extensionFields)
+	if err != nil || (len(document) == 0 && len(elements) == 0) {
+		return sbom.Extensions{}, err
+	}
+
+	extensions := sbom.Extensions{
+		Format:   ID,
+		Document: document,
+	}
+	for id, fields := range elements {
+		if extensions.Packages == nil {
+			extensions.Packages = make(map[artifact.ID]map[string]json.RawMessage)
+		}
+		extensions.Packages[artifact.ID(id)] = fields
+	}
+	return extensions, nil
+}
+
+func restoreExtensions(encoded []byte, extensions sbom.Extensions, indent string) ([]byte, error) {
+	elements := make(map[string]unknownfields.Fields)
+	for id, fields := range extensions.Packages {
+		elements[string(id)] = fields
+	}
+	return unknownfields.Restore(encoded, extensionFields, extensions.Document, elements, indent)
+}
@@ -0,0 +1,20 @@
+package model
+
+// Diagnostics captures information about how complete the cataloging results are for the given source.
+type Diagnostics struct {
+	UnreadablePaths            []UnreadablePath `json:"unreadablePaths,omitempty"`            // UnreadablePaths are paths that exist within the source but could not be read
+	UnreadableCountByDirectory []DirectoryCount `json:"unreadableCountByDirectory,omitempty"` // UnreadableCountByDirectory summarizes the unreadable paths per parent directory
+}
+
+// UnreadablePath is a path that exists within the source but could not be accessed or read (e.g. permission denied)
+type UnreadablePath struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+	Error  string `json:"error,omitempty"`
+}
+
+// DirectoryCount is the number of matching paths found directly within a single directory
+type DirectoryCount struct {
+	Directory string `json:"directory"`
+	Count     int    `json:"count"`
+}
@@ -0,0 +1,15 @@
+package model
+
+// DuplicateVersions is a package that was found in more than one version within the same SBOM
+type DuplicateVersions struct {
+	Name     string             `json:"name"`
+	Type     string             `json:"type"`
+	Versions []DuplicateVersion `json:"versions"`
+}
+
+// DuplicateVersion is a single version of a duplicated package, along with where it was found
+type DuplicateVersion struct {
+	Version   string   `json:"version"`
+	Artifacts []string `json:"artifacts"`           // Artifacts are the IDs of the packages found with this version
+	Locations []string `json:"locations,omitempty"` // Locations are the paths where packages with this version were found
+}
@@ -6,16 +6,21 @@ import (
 )
 
 type File struct {
-	ID         string             `json:"id"`
-	Location   file.Coordinates   `json:"location"`
-	Metadata   *FileMetadataEntry `json:"metadata,omitempty"`
-	Contents   string             `json:"contents,omitempty"`
-	Digests    []file.Digest      `json:"digests,omitempty"`
-	Licenses   []FileLicense      `json:"licenses,omitempty"`
-	Executable *file.Executable   `json:"executable,omitempty"`
+	ID          string             `json:"id"`
+	Location    file.Coordinates   `json:"location"`
+	Metadata    *FileMetadataEntry `json:"metadata,omitempty"`
+	Contents    string             `json:"contents,omitempty"`
+	Digests     []file.Digest      `json:"digests,omitempty"`
+	Licenses    []FileLicense      `json:"licenses,omitempty"`
+	Executable  *file.Executable   `json:"executable,omitempty"`
+	KeyMaterial *file.KeyMaterial  `json:"keyMaterial,omitempty"`
+	Snippets    []file.Snippet     `json:"snippets,omitempty"`
 }
 
 type FileMetadataEntry struct {
+	// Mode holds the unix permission and special bits as octal digits (e.g. 4755 for a setuid executable). Since schema
+	// 16.1.0 the file type is only given by Type, where earlier schemas held the Go file mode (e.g. 20000000755 for a
+	// directory).
 	Mode            int    `json:"mode"`
 	Type            string `json:"type"`
 	LinkDestination string `json:"linkDestination,omitempty"`
@@ -34,6 +34,7 @@ type PackageBasicData struct {
 	Language  pkg.Language    `json:"language"`
 	CPEs      cpes            `json:"cpes"`
 	PURL      string          `json:"purl"`
+	Footprint *pkg.Footprint  `json:"footprint,omitempty"` // Footprint is the installed size and number of files owned by the package (only when enabled)
 }
 
 type cpes []CPE
@@ -16,8 +16,13 @@ type Source struct {
 	ID       string      `json:"id"`
 	Name     string      `json:"name"`
 	Version  string      `json:"version"`
+	Supplier string      `json:"supplier,omitempty"`
+	PURL     string      `json:"purl,omitempty"`
+	CPE      string      `json:"cpe,omitempty"`
 	Type     string      `json:"type"`
 	Metadata interface{} `json:"metadata"`
+
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // sourceUnpacker is used to unmarshal Source objects
@@ -25,9 +30,14 @@ type sourceUnpacker struct {
 	ID       string          `json:"id,omitempty"`
 	Name     string          `json:"name"`
 	Version  string          `json:"version"`
+	Supplier string          `json:"supplier,omitempty"`
+	PURL     string          `json:"purl,omitempty"`
+	CPE      string          `json:"cpe,omitempty"`
 	Type     string          `json:"type"`
 	Metadata json.RawMessage `json:"metadata"`
 	Target   json.RawMessage `json:"target"` // pre-v9 schema support
+
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // UnmarshalJSON populates a source object from JSON bytes.
@@ -40,8 +50,12 @@ func (s *Source) UnmarshalJSON(b []byte) error {
 
 	s.Name = unpacker.Name
 	s.Version = unpacker.Version
+	s.Supplier = unpacker.Supplier
+	s.PURL = unpacker.PURL
+	s.CPE = unpacker.CPE
 	s.Type = unpacker.Type
 	s.ID = unpacker.ID
+	s.Annotations = unpacker.Annotations
 
 	if len(unpacker.Target) > 0 {
 		s.Type = cleanPreSchemaV9MetadataType(s.Type)
@@ -27,7 +27,8 @@ func TestSource_UnmarshalJSON(t *testing.T) {
 			input: []byte(`{
 				"id": "foobar",
 				"type": "directory",
-				"metadata": {"path": "/var/lib/foo", "base":"/nope"}
+				"metadata": {"path": "/var/lib/foo", "base":"/nope"},
+				"annotations": {"linux:crypto:fips-mode": "enabled"}
 			}`),
 			expected: &Source{
 				ID:   "foobar",
@@ -36,6 +37,9 @@ func TestSource_UnmarshalJSON(t *testing.T) {
 					Path: "/var/lib/foo",
 					//Base: "/nope", // note: should be ignored entirely
 				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
 			},
 		},
 		{
@@ -2,9 +2,12 @@ package syftjson
 
 import (
 	"fmt"
+	"io/fs"
 	"sort"
 	"strconv"
 
+	"github.com/scylladb/go-set/strset"
+
 	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
@@ -34,9 +37,12 @@ func metadataType(metadata interface{}, legacy bool) string {
 // ToFormatModel transforms the sbom import a format-specific model.
 func ToFormatModel(s sbom.SBOM, cfg EncoderConfig) model.Document {
 	return model.Document{
-		Artifacts:             toPackageModels(s.Artifacts.Packages, cfg),
+		Artifacts:             toPackageModels(s.Artifacts.Packages, s.Artifacts.PackageFootprints, cfg),
 		ArtifactRelationships: toRelationshipModel(s.Relationships),
 		Files:                 toFile(s),
+		Diagnostics:           toDiagnostics(s.Artifacts.UnreadablePaths),
+		LayerFootprints:       s.LayerFootprints(),
+		DuplicateVersions:     toDuplicateVersions(s.Artifacts.Packages),
 		Source:                toSourceModel(s.Source),
 		Distro:                toLinuxReleaser(s.Artifacts.LinuxDistribution),
 		Descriptor:            toDescriptor(s.Descriptor),
@@ -47,6 +53,61 @@ func ToFormatModel(s sbom.SBOM, cfg EncoderConfig) model.Document {
 	}
 }
 
+func toDiagnostics(paths []file.UnreadablePath) *model.Diagnostics {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	d := model.Diagnostics{}
+	for _, p := range paths {
+		d.UnreadablePaths = append(d.UnreadablePaths, model.UnreadablePath{
+			Path:   p.Path,
+			Reason: string(p.Reason),
+			Error:  p.Error,
+		})
+	}
+
+	for _, c := range file.UnreadablePathCountsByDirectory(paths) {
+		d.UnreadableCountByDirectory = append(d.UnreadableCountByDirectory, model.DirectoryCount{
+			Directory: c.Directory,
+			Count:     c.Count,
+		})
+	}
+
+	return &d
+}
+
+func toDuplicateVersions(catalog *pkg.Collection) []model.DuplicateVersions {
+	var out []model.DuplicateVersions
+	for _, d := range pkg.Duplicates(catalog) {
+		dv := model.DuplicateVersions{
+			Name: d.Name,
+			Type: string(d.Type),
+		}
+		for _, v := range d.Versions {
+			artifacts := make([]string, 0, len(v.Packages))
+			locations := strset.New()
+			for _, p := range v.Packages {
+				artifacts = append(artifacts, string(p.ID()))
+				for _, l := range p.Locations.ToSlice() {
+					locations.Add(l.RealPath)
+				}
+			}
+			sort.Strings(artifacts)
+			paths := locations.List()
+			sort.Strings(paths)
+
+			dv.Versions = append(dv.Versions, model.DuplicateVersion{
+				Version:   v.Version,
+				Artifacts: artifacts,
+				Locations: paths,
+			})
+		}
+		out = append(out, dv)
+	}
+	return out
+}
+
 func toLinuxReleaser(d *linux.Release) model.LinuxRelease {
 	if d == nil {
 		return model.LinuxRelease{}
@@ -124,14 +185,21 @@ func toFile(s sbom.SBOM) []model.File {
 			executable = &exec
 		}
 
+		var keyMaterial *file.KeyMaterial
+		if material, exists := artifacts.KeyMaterial[coordinates]; exists {
+			keyMaterial = &material
+		}
+
 		results = append(results, model.File{
-			ID:         string(coordinates.ID()),
-			Location:   coordinates,
-			Metadata:   toFileMetadataEntry(coordinates, metadata),
-			Digests:    digests,
-			Contents:   contents,
-			Licenses:   licenses,
-			Executable: executable,
+			ID:          string(coordinates.ID()),
+			Location:    coordinates,
+			Metadata:    toFileMetadataEntry(coordinates, metadata),
+			Digests:     digests,
+			Contents:    contents,
+			Licenses:    licenses,
+			Executable:  executable,
+			KeyMaterial: keyMaterial,
+			Snippets:    artifacts.FileSnippets[coordinates],
 		})
 	}
 
@@ -142,6 +210,29 @@ func toFile(s sbom.SBOM) []model.File {
 	return results
 }
 
+// specialModeBits maps the special file mode bits of Go to the unix mode bits that they are encoded as.
+var specialModeBits = []struct {
+	goMode   fs.FileMode
+	unixMode int
+}{
+	{goMode: fs.ModeSetuid, unixMode: 0o4000},
+	{goMode: fs.ModeSetgid, unixMode: 0o2000},
+	{goMode: fs.ModeSticky, unixMode: 0o1000},
+}
+
+// toUnixMode returns the unix permission and special bits of the given file mode (e.g. 0o4755 for a setuid
+// executable). The file type is captured separately, and the type bits would otherwise overflow the value on 32-bit
+// platforms (making the result depend on where syft was run).
+func toUnixMode(mode fs.FileMode) int {
+	unixMode := int(mode.Perm())
+	for _, bit := range specialModeBits {
+		if mode&bit.goMode != 0 {
+			unixMode |= bit.unixMode
+		}
+	}
+	return unixMode
+}
+
 func toFileMetadataEntry(coordinates file.Coordinates, metadata *file.Metadata) *model.FileMetadataEntry {
 	if metadata == nil {
 		return nil
@@ -152,7 +243,7 @@ func toFileMetadataEntry(coordinates file.Coordinates, metadata *file.Metadata)
 	if metadata != nil && metadata.FileInfo != nil {
 		var err error
 
-		mode, err = strconv.Atoi(fmt.Sprintf("%o", metadata.Mode()))
+		mode, err = strconv.Atoi(fmt.Sprintf("%o", toUnixMode(metadata.Mode())))
 		if err != nil {
 			log.Warnf("invalid mode found in file catalog @ location=%+v mode=%q: %+v", coordinates, metadata.Mode, err)
 			mode = 0
@@ -197,13 +288,17 @@ func toFileType(ty stereoscopeFile.Type) string {
 	}
 }
 
-func toPackageModels(catalog *pkg.Collection, cfg EncoderConfig) []model.Package {
+func toPackageModels(catalog *pkg.Collection, footprints map[artifact.ID]pkg.Footprint, cfg EncoderConfig) []model.Package {
 	artifacts := make([]model.Package, 0)
 	if catalog == nil {
 		return artifacts
 	}
 	for _, p := range catalog.Sorted() {
-		artifacts = append(artifacts, toPackageModel(p, cfg))
+		m := toPackageModel(p, cfg)
+		if f, ok := footprints[p.ID()]; ok {
+			m.Footprint = &f
+		}
+		artifacts = append(artifacts, m)
 	}
 	return artifacts
 }
@@ -299,8 +394,13 @@ func toSourceModel(src source.Description) model.Source {
 		ID:       src.ID,
 		Name:     src.Name,
 		Version:  src.Version,
+		Supplier: src.Supplier,
+		PURL:     src.PURL,
+		CPE:      src.CPE,
 		Type:     sourcemetadata.JSONName(src.Metadata),
 		Metadata: src.Metadata,
+
+		Annotations: src.Annotations,
 	}
 
 	if metadata, ok := src.Metadata.(source.ImageMetadata); ok {
@@ -2,6 +2,8 @@ package syftjson
 
 import (
 	"encoding/json"
+	"io/fs"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,10 +12,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/format/syftjson/model"
 	"github.com/anchore/syft/syft/internal/sourcemetadata"
 	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
 	"github.com/anchore/syft/syft/source"
 )
 
@@ -63,6 +67,9 @@ func Test_toSourceModel(t *testing.T) {
 					Path: "some/path",
 					Base: "some/base",
 				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
 			},
 			expected: model.Source{
 				ID:      "test-id",
@@ -73,6 +80,9 @@ func Test_toSourceModel(t *testing.T) {
 					Path: "some/path",
 					Base: "some/base",
 				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
 			},
 		},
 		{
@@ -299,6 +309,44 @@ func Test_toFileMetadataEntry(t *testing.T) {
 				Type: stereoscopeFile.TypeRegular.String(),
 			},
 		},
+		{
+			name: "directory type bits are not encoded",
+			metadata: &file.Metadata{
+				FileInfo: &stereoscopeFile.ManualInfo{
+					ModeValue: fs.ModeDir | 0755,
+				},
+				Type: stereoscopeFile.TypeDirectory,
+			},
+			want: &model.FileMetadataEntry{
+				Mode: 755,
+				Type: stereoscopeFile.TypeDirectory.String(),
+			},
+		},
+		{
+			name: "special bits are encoded",
+			metadata: &file.Metadata{
+				FileInfo: &stereoscopeFile.ManualInfo{
+					ModeValue: fs.ModeSetuid | 0755,
+				},
+			},
+			want: &model.FileMetadataEntry{
+				Mode: 4755,
+				Type: stereoscopeFile.TypeRegular.String(),
+			},
+		},
+		{
+			name: "all special bits are encoded as unix mode bits",
+			metadata: &file.Metadata{
+				FileInfo: &stereoscopeFile.ManualInfo{
+					ModeValue: fs.ModeDir | fs.ModeSetgid | fs.ModeSticky | 0775,
+				},
+				Type: stereoscopeFile.TypeDirectory,
+			},
+			want: &model.FileMetadataEntry{
+				Mode: 3775,
+				Type: stereoscopeFile.TypeDirectory.String(),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,3 +399,115 @@ func Test_toPackageModel_metadataType(t *testing.T) {
 		})
 	}
 }
+
+func Test_footprints(t *testing.T) {
+	withFootprint := pkg.Package{Name: "curl", Version: "8.5.0", Type: pkg.RpmPkg}
+	withFootprint.SetID()
+	withoutFootprint := pkg.Package{Name: "express", Version: "4.19.2", Type: pkg.NpmPkg}
+	withoutFootprint.SetID()
+
+	footprint := pkg.Footprint{
+		Size:      270,
+		FileCount: 3,
+		Layers: []pkg.LayerFootprint{
+			{Layer: "sha256:upgrade", Size: 120, FileCount: 1},
+			{Layer: "sha256:base", Size: 150, FileCount: 2},
+		},
+	}
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(withFootprint, withoutFootprint),
+			PackageFootprints: map[artifact.ID]pkg.Footprint{
+				withFootprint.ID(): footprint,
+			},
+		},
+		Source: source.Description{
+			Metadata: source.ImageMetadata{
+				Layers: []source.LayerMetadata{
+					{Digest: "sha256:base"},
+					{Digest: "sha256:upgrade"},
+				},
+			},
+		},
+	}
+
+	doc := ToFormatModel(s, EncoderConfig{})
+
+	require.Len(t, doc.Artifacts, 2)
+	for _, p := range doc.Artifacts {
+		switch p.ID {
+		case string(withFootprint.ID()):
+			assert.Equal(t, &footprint, p.Footprint)
+		default:
+			assert.Nil(t, p.Footprint)
+		}
+	}
+
+	// layers are in the order of the image
+	assert.Equal(t, []pkg.LayerFootprint{
+		{Layer: "sha256:base", Size: 150, FileCount: 2},
+		{Layer: "sha256:upgrade", Size: 120, FileCount: 1},
+	}, doc.LayerFootprints)
+
+	decoded, _ := toSyftModel(doc)
+	assert.Equal(t, s.Artifacts.PackageFootprints, decoded.Artifacts.PackageFootprints)
+}
+
+func Test_toDuplicateVersions(t *testing.T) {
+	newPackage := func(version string, paths ...string) pkg.Package {
+		var locations []file.Location
+		for _, p := range paths {
+			locations = append(locations, file.NewLocation(p))
+		}
+		p := pkg.Package{
+			Name:      "log4j-core",
+			Version:   version,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:maven/org.apache.logging.log4j/log4j-core@" + version,
+			Locations: file.NewLocationSet(locations...),
+		}
+		p.SetID()
+		return p
+	}
+
+	old := newPackage("2.14.1", "/app/lib/log4j-core-2.14.1.jar")
+	current := newPackage("2.17.1", "/app/lib/log4j-core-2.17.1.jar")
+	shaded := newPackage("2.17.1", "/app/plugins/plugin.jar", "/app/lib/log4j-core-2.17.1.jar")
+	unique := pkg.Package{Name: "express", Version: "4.19.2", Type: pkg.NpmPkg}
+	unique.SetID()
+
+	doc := ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(old, current, shaded, unique),
+		},
+	}, EncoderConfig{})
+
+	currentIDs := []string{string(current.ID()), string(shaded.ID())}
+	sort.Strings(currentIDs)
+
+	assert.Equal(t, []model.DuplicateVersions{
+		{
+			Name: "log4j-core",
+			Type: string(pkg.JavaPkg),
+			Versions: []model.DuplicateVersion{
+				{
+					Version:   "2.14.1",
+					Artifacts: []string{string(old.ID())},
+					Locations: []string{"/app/lib/log4j-core-2.14.1.jar"},
+				},
+				{
+					Version:   "2.17.1",
+					Artifacts: currentIDs,
+					Locations: []string{"/app/lib/log4j-core-2.17.1.jar", "/app/plugins/plugin.jar"},
+				},
+			},
+		},
+	}, doc.DuplicateVersions)
+
+	assert.Empty(t, ToFormatModel(sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(old, unique),
+		},
+	}, EncoderConfig{}).DuplicateVersions)
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -23,13 +24,16 @@ import (
 	"github.com/anchore/syft/syft/source"
 )
 
-func toSyftModel(doc model.Document) *sbom.SBOM {
+// toSyftModel converts the given document into an SBOM, along with non-fatal problems found while converting.
+func toSyftModel(doc model.Document) (*sbom.SBOM, []string) {
 	idAliases := make(map[string]string)
 
 	catalog := toSyftCatalog(doc.Artifacts, idAliases)
 
 	fileArtifacts := toSyftFiles(doc.Files)
 
+	relationships, errs := toSyftRelationships(&doc, catalog, doc.ArtifactRelationships, idAliases)
+
 	return &sbom.SBOM{
 		Artifacts: sbom.Artifacts{
 			Packages:          catalog,
@@ -38,20 +42,46 @@ func toSyftModel(doc model.Document) *sbom.SBOM {
 			FileContents:      fileArtifacts.FileContents,
 			FileLicenses:      fileArtifacts.FileLicenses,
 			Executables:       fileArtifacts.Executables,
+			KeyMaterial:       fileArtifacts.KeyMaterial,
+			FileSnippets:      fileArtifacts.FileSnippets,
 			LinuxDistribution: toSyftLinuxRelease(doc.Distro),
+			UnreadablePaths:   toSyftUnreadablePaths(doc.Diagnostics),
+			PackageFootprints: toSyftPackageFootprints(doc.Artifacts),
 		},
 		Source:        *toSyftSourceData(doc.Source),
 		Descriptor:    toSyftDescriptor(doc.Descriptor),
-		Relationships: warnConversionErrors(toSyftRelationships(&doc, catalog, doc.ArtifactRelationships, idAliases)),
+		Relationships: relationships,
+	}, deduplicateErrors(errs)
+}
+
+func toSyftUnreadablePaths(d *model.Diagnostics) []file.UnreadablePath {
+	if d == nil {
+		return nil
+	}
+
+	var paths []file.UnreadablePath
+	for _, p := range d.UnreadablePaths {
+		paths = append(paths, file.UnreadablePath{
+			Path:   p.Path,
+			Reason: file.UnreadableReason(p.Reason),
+			Error:  p.Error,
+		})
 	}
+	return paths
 }
 
-func warnConversionErrors[T any](converted []T, errors []error) []T {
-	errorMessages := deduplicateErrors(errors)
-	for _, msg := range errorMessages {
-		log.Warn(msg)
+func toSyftPackageFootprints(pkgs []model.Package) map[artifact.ID]pkg.Footprint {
+	var footprints map[artifact.ID]pkg.Footprint
+	for _, p := range pkgs {
+		if p.Footprint == nil {
+			continue
+		}
+		if footprints == nil {
+			footprints = make(map[artifact.ID]pkg.Footprint)
+		}
+		footprints[artifact.ID(p.ID)] = *p.Footprint
 	}
-	return converted
+	return footprints
 }
 
 func deduplicateErrors(errors []error) []string {
@@ -63,6 +93,7 @@ func deduplicateErrors(errors []error) []string {
 	for msg, count := range errorCounts {
 		errorMessages = append(errorMessages, fmt.Sprintf("%q occurred %d time(s)", msg, count))
 	}
+	sort.Strings(errorMessages)
 	return errorMessages
 }
 
@@ -73,6 +104,8 @@ func toSyftFiles(files []model.File) sbom.Artifacts {
 		FileContents: make(map[file.Coordinates]string),
 		FileLicenses: make(map[file.Coordinates][]file.License),
 		Executables:  make(map[file.Coordinates]file.Executable),
+		KeyMaterial:  make(map[file.Coordinates]file.KeyMaterial),
+		FileSnippets: make(map[file.Coordinates][]file.Snippet),
 	}
 
 	for _, f := range files {
@@ -130,6 +163,14 @@ func toSyftFiles(files []model.File) sbom.Artifacts {
 		if f.Executable != nil {
 			ret.Executables[coord] = *f.Executable
 		}
+
+		if f.KeyMaterial != nil {
+			ret.KeyMaterial[coord] = *f.KeyMaterial
+		}
+
+		if len(f.Snippets) > 0 {
+			ret.FileSnippets[coord] = f.Snippets
+		}
 	}
 
 	return ret
@@ -146,7 +187,20 @@ func safeFileModeConvert(val int) (fs.FileMode, error) {
 	if err != nil {
 		return 0, err
 	}
-	return os.FileMode(mode), nil
+	return fromUnixMode(mode), nil
+}
+
+// fromUnixMode returns the file mode for the given unix mode, where any unix special bits (e.g. 0o4000 for setuid)
+// are converted to the corresponding special bits of Go. Modes already given as Go file modes (as encoded by earlier
+// versions of syft) are returned as-is.
+func fromUnixMode(unixMode int64) os.FileMode {
+	mode := os.FileMode(unixMode) &^ 0o7000
+	for _, bit := range specialModeBits {
+		if int(unixMode)&bit.unixMode != 0 {
+			mode |= bit.goMode
+		}
+	}
+	return mode
 }
 
 func toSyftLicenses(m []model.License) (p []pkg.License) {
@@ -306,7 +360,12 @@ func toSyftSourceData(s model.Source) *source.Description {
 		ID:       s.ID,
 		Name:     s.Name,
 		Version:  s.Version,
+		Supplier: s.Supplier,
+		PURL:     s.PURL,
+		CPE:      s.CPE,
 		Metadata: s.Metadata,
+
+		Annotations: s.Annotations,
 	}
 }
 
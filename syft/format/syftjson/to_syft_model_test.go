@@ -39,6 +39,9 @@ func Test_toSyftSourceData(t *testing.T) {
 					Path: "some/path",
 					Base: "some/base",
 				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
 			},
 			expected: &source.Description{
 				ID:      "the-id",
@@ -48,6 +51,9 @@ func Test_toSyftSourceData(t *testing.T) {
 					Path: "some/path",
 					Base: "some/base",
 				},
+				Annotations: map[string]string{
+					"linux:crypto:fips-mode": "enabled",
+				},
 			},
 		},
 		{
@@ -175,7 +181,7 @@ func Test_toSyftSourceData(t *testing.T) {
 }
 
 func Test_idsHaveChanged(t *testing.T) {
-	s := toSyftModel(model.Document{
+	s, _ := toSyftModel(model.Document{
 		Source: model.Source{
 			Type:     "file",
 			Metadata: source.FileMetadata{Path: "some/path"},
@@ -344,11 +350,113 @@ func Test_toSyftFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "key material",
+			files: []model.File{
+				{
+					ID:       string(coord.ID()),
+					Location: coord,
+					KeyMaterial: &file.KeyMaterial{
+						Format:  "JKS",
+						Version: 2,
+						Entries: 1,
+						Certificates: []file.Certificate{
+							{
+								Alias:              "root",
+								Subject:            "CN=Test Root CA",
+								Issuer:             "CN=Test Root CA",
+								SerialNumber:       "1",
+								NotBefore:          "2024-01-01T00:00:00Z",
+								NotAfter:           "2034-01-01T00:00:00Z",
+								SignatureAlgorithm: "ECDSA-SHA256",
+								PublicKeyAlgorithm: "ECDSA",
+								PublicKeySize:      256,
+								Curve:              "P-256",
+								IsCA:               true,
+								SHA256Fingerprint:  "abc",
+							},
+						},
+					},
+				},
+			},
+			want: sbom.Artifacts{
+				FileMetadata: map[file.Coordinates]file.Metadata{},
+				FileDigests:  map[file.Coordinates][]file.Digest{},
+				Executables:  map[file.Coordinates]file.Executable{},
+				KeyMaterial: map[file.Coordinates]file.KeyMaterial{
+					coord: {
+						Format:  "JKS",
+						Version: 2,
+						Entries: 1,
+						Certificates: []file.Certificate{
+							{
+								Alias:              "root",
+								Subject:            "CN=Test Root CA",
+								Issuer:             "CN=Test Root CA",
+								SerialNumber:       "1",
+								NotBefore:          "2024-01-01T00:00:00Z",
+								NotAfter:           "2034-01-01T00:00:00Z",
+								SignatureAlgorithm: "ECDSA-SHA256",
+								PublicKeyAlgorithm: "ECDSA",
+								PublicKeySize:      256,
+								Curve:              "P-256",
+								IsCA:               true,
+								SHA256Fingerprint:  "abc",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "snippets",
+			files: []model.File{
+				{
+					ID:       string(coord.ID()),
+					Location: coord,
+					Snippets: []file.Snippet{
+						{
+							Name:       "jQuery",
+							Version:    "3.7.1",
+							Offset:     61,
+							Extent:     88,
+							StartLine:  2,
+							EndLine:    2,
+							Copyrights: []string{"(c) OpenJS Foundation"},
+						},
+					},
+				},
+			},
+			want: sbom.Artifacts{
+				FileMetadata: map[file.Coordinates]file.Metadata{},
+				FileDigests:  map[file.Coordinates][]file.Digest{},
+				Executables:  map[file.Coordinates]file.Executable{},
+				FileSnippets: map[file.Coordinates][]file.Snippet{
+					coord: {
+						{
+							Name:       "jQuery",
+							Version:    "3.7.1",
+							Offset:     61,
+							Extent:     88,
+							StartLine:  2,
+							EndLine:    2,
+							Copyrights: []string{"(c) OpenJS Foundation"},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.want.FileContents = make(map[file.Coordinates]string)
 			tt.want.FileLicenses = make(map[file.Coordinates][]file.License)
+			if tt.want.KeyMaterial == nil {
+				tt.want.KeyMaterial = make(map[file.Coordinates]file.KeyMaterial)
+			}
+			if tt.want.FileSnippets == nil {
+				tt.want.FileSnippets = make(map[file.Coordinates][]file.Snippet)
+			}
 			assert.Equal(t, tt.want, toSyftFiles(tt.files))
 		})
 	}
@@ -489,6 +597,18 @@ func Test_safeFileModeConvert(t *testing.T) {
 			want:    os.FileMode(511), // 777 in octal equals 511 in decimal
 			wantErr: false,
 		},
+		{
+			name:    "unix special bits",
+			val:     4755,
+			want:    os.ModeSetuid | 0o755,
+			wantErr: false,
+		},
+		{
+			name:    "go special bits (from earlier versions)",
+			val:     40000755,
+			want:    os.ModeSetuid | 0o755,
+			wantErr: false,
+		},
 		{
 			name:    "outside int32 high",
 			val:     int(math.MaxInt32) + 1,
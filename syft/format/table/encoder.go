@@ -7,18 +7,37 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/dustin/go-humanize"
 	"github.com/olekukonko/tablewriter"
 
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/sbom"
 )
 
 const ID sbom.FormatID = "syft-table"
 
+type EncoderConfig struct {
+	Summary bool // show the number of packages per package type instead of every package
+}
+
 type encoder struct {
+	cfg EncoderConfig
 }
 
 func NewFormatEncoder() sbom.FormatEncoder {
-	return encoder{}
+	return encoder{
+		cfg: DefaultEncoderConfig(),
+	}
+}
+
+func NewFormatEncoderWithConfig(cfg EncoderConfig) (sbom.FormatEncoder, error) {
+	return encoder{
+		cfg: cfg,
+	}, nil
+}
+
+func DefaultEncoderConfig() EncoderConfig {
+	return EncoderConfig{}
 }
 
 func (e encoder) ID() sbom.FormatID {
@@ -36,15 +55,28 @@ func (e encoder) Version() string {
 }
 
 func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
+	if e.cfg.Summary {
+		return encodeSummary(writer, s)
+	}
+
 	var rows [][]string
 
+	// the installed size is only shown when package footprints were recorded
+	withSize := len(s.Artifacts.PackageFootprints) > 0
+
 	columns := []string{"Name", "Version", "Type"}
+	if withSize {
+		columns = append(columns, "Size")
+	}
 	for _, p := range s.Artifacts.Packages.Sorted() {
 		row := []string{
 			p.Name,
 			p.Version,
 			string(p.Type),
 		}
+		if withSize {
+			row = append(row, footprintSize(s.Artifacts.PackageFootprints[p.ID()]))
+		}
 		rows = append(rows, row)
 	}
 
@@ -66,6 +98,110 @@ func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 	columns = append(columns, "") // add a column for duplicate annotations
 	rows = markDuplicateRows(rows)
 
+	renderTable(writer, columns, rows)
+
+	return nil
+}
+
+// encodeSummary writes the number of packages found for each package type (and in total), along with the installed
+// size of the packages for each type and image layer when package footprints were recorded. Packages found in more
+// than one version are listed afterwards.
+func encodeSummary(writer io.Writer, s sbom.SBOM) error {
+	counts := map[string]int{}
+	sizes := map[string]pkg.Footprint{}
+	var total int
+	var totalSize pkg.Footprint
+	for p := range s.Artifacts.Packages.Enumerate() {
+		counts[string(p.Type)]++
+		total++
+
+		f := s.Artifacts.PackageFootprints[p.ID()]
+		sizes[string(p.Type)] = addFootprint(sizes[string(p.Type)], f)
+		totalSize = addFootprint(totalSize, f)
+	}
+
+	if total == 0 {
+		_, err := fmt.Fprintln(writer, "No packages discovered")
+		return err
+	}
+
+	var types []string
+	for t := range counts {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	// the installed size is only shown when package footprints were recorded
+	withSize := len(s.Artifacts.PackageFootprints) > 0
+
+	columns := []string{"Type", "Packages"}
+	if withSize {
+		columns = append(columns, "Size", "Files")
+	}
+
+	var rows [][]string
+	for _, t := range types {
+		row := []string{t, fmt.Sprintf("%d", counts[t])}
+		if withSize {
+			row = append(row, footprintSize(sizes[t]), fmt.Sprintf("%d", sizes[t].FileCount))
+		}
+		rows = append(rows, row)
+	}
+	totalRow := []string{"total", fmt.Sprintf("%d", total)}
+	if withSize {
+		totalRow = append(totalRow, footprintSize(totalSize), fmt.Sprintf("%d", totalSize.FileCount))
+	}
+	rows = append(rows, totalRow)
+
+	renderTable(writer, columns, rows)
+
+	// layers are only known for container images
+	if layers := s.LayerFootprints(); len(layers) > 0 {
+		var layerRows [][]string
+		for _, l := range layers {
+			layerRows = append(layerRows, []string{l.Layer, humanize.Bytes(uint64(l.Size)), fmt.Sprintf("%d", l.FileCount)})
+		}
+
+		if _, err := fmt.Fprintln(writer); err != nil {
+			return err
+		}
+		renderTable(writer, []string{"Layer", "Size", "Files"}, layerRows)
+	}
+
+	if duplicates := pkg.Duplicates(s.Artifacts.Packages); len(duplicates) > 0 {
+		var duplicateRows [][]string
+		for _, d := range duplicates {
+			var versions []string
+			for _, v := range d.Versions {
+				versions = append(versions, v.Version)
+			}
+			duplicateRows = append(duplicateRows, []string{d.Name, string(d.Type), strings.Join(versions, ", ")})
+		}
+
+		if _, err := fmt.Fprintln(writer); err != nil {
+			return err
+		}
+		renderTable(writer, []string{"Duplicated", "Type", "Versions"}, duplicateRows)
+	}
+
+	return nil
+}
+
+func addFootprint(a, b pkg.Footprint) pkg.Footprint {
+	a.Size += b.Size
+	a.FileCount += b.FileCount
+	return a
+}
+
+// footprintSize returns the human-readable installed size of a package (or nothing when the package has no files).
+func footprintSize(f pkg.Footprint) string {
+	if f.FileCount == 0 {
+		return ""
+	}
+	return humanize.Bytes(uint64(f.Size))
+}
+
+func renderTable(writer io.Writer, columns []string, rows [][]string) {
 	table := tablewriter.NewWriter(writer)
 
 	table.SetHeader(columns)
@@ -83,8 +219,6 @@ func (e encoder) Encode(writer io.Writer, s sbom.SBOM) error {
 
 	table.AppendBulk(rows)
 	table.Render()
-
-	return nil
 }
 
 func markDuplicateRows(items [][]string) [][]string {
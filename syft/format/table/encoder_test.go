@@ -2,11 +2,18 @@ package table
 
 import (
 	"flag"
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/format/internal/testutil"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
 )
 
 var updateSnapshot = flag.Bool("update-table", false, "update the *.golden files for table format")
@@ -23,6 +30,135 @@ func TestTableEncoder(t *testing.T) {
 	)
 }
 
+func TestTableEncoder_Summary(t *testing.T) {
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{Summary: true})
+	require.NoError(t, err)
+
+	testutil.AssertEncoderAgainstGoldenSnapshot(t,
+		testutil.EncoderSnapshotTestConfig{
+			Subject:                     testutil.DirectoryInput(t, t.TempDir()),
+			Format:                      enc,
+			UpdateSnapshot:              *updateSnapshot,
+			PersistRedactionsInSnapshot: true,
+			IsJSON:                      false,
+		},
+	)
+}
+
+func TestTableEncoder_Footprints(t *testing.T) {
+	curl := pkg.Package{Name: "curl", Version: "8.5.0", Type: pkg.RpmPkg}
+	curl.SetID()
+	bash := pkg.Package{Name: "bash", Version: "5.2.26", Type: pkg.RpmPkg}
+	bash.SetID()
+	express := pkg.Package{Name: "express", Version: "4.19.2", Type: pkg.NpmPkg}
+	express.SetID()
+
+	s := sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(curl, bash, express),
+			PackageFootprints: map[artifact.ID]pkg.Footprint{
+				curl.ID(): {
+					Size:      270000,
+					FileCount: 3,
+					Layers: []pkg.LayerFootprint{
+						{Layer: "sha256:base", Size: 150000, FileCount: 2},
+						{Layer: "sha256:upgrade", Size: 120000, FileCount: 1},
+					},
+				},
+				bash.ID(): {
+					Size:      1500000,
+					FileCount: 10,
+					Layers: []pkg.LayerFootprint{
+						{Layer: "sha256:base", Size: 1500000, FileCount: 10},
+					},
+				},
+			},
+		},
+		Source: source.Description{
+			Metadata: source.ImageMetadata{
+				Layers: []source.LayerMetadata{
+					{Digest: "sha256:base"},
+					{Digest: "sha256:upgrade"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		cfg  EncoderConfig
+		want string
+	}{
+		{
+			name: "packages",
+			want: `NAME     VERSION  TYPE  SIZE
+bash     5.2.26   rpm   1.5 MB
+curl     8.5.0    rpm   270 kB
+express  4.19.2   npm
+`,
+		},
+		{
+			name: "summary",
+			cfg:  EncoderConfig{Summary: true},
+			want: `TYPE   PACKAGES  SIZE    FILES
+npm    1                 0
+rpm    2         1.8 MB  13
+total  3         1.8 MB  13
+
+LAYER           SIZE    FILES
+sha256:base     1.7 MB  12
+sha256:upgrade  120 kB  1
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := NewFormatEncoderWithConfig(tt.cfg)
+			require.NoError(t, err)
+
+			var buf strings.Builder
+			require.NoError(t, enc.Encode(&buf, s))
+			assert.Equal(t, tt.want, trimTrailingSpaces(buf.String()))
+		})
+	}
+}
+
+func TestTableEncoder_SummaryDuplicates(t *testing.T) {
+	var pkgs []pkg.Package
+	for _, p := range []pkg.Package{
+		{Name: "log4j-core", Version: "2.17.1", Type: pkg.JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1"},
+		{Name: "log4j-core", Version: "2.14.1", Type: pkg.JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		{Name: "log4j-core", Version: "2.12.4", Type: pkg.JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.12.4"},
+		{Name: "express", Version: "4.19.2", Type: pkg.NpmPkg},
+	} {
+		p.SetID()
+		pkgs = append(pkgs, p)
+	}
+
+	enc, err := NewFormatEncoderWithConfig(EncoderConfig{Summary: true})
+	require.NoError(t, err)
+
+	var buf strings.Builder
+	require.NoError(t, enc.Encode(&buf, sbom.SBOM{Artifacts: sbom.Artifacts{Packages: pkg.NewCollection(pkgs...)}}))
+	assert.Equal(t, `TYPE          PACKAGES
+java-archive  3
+npm           1
+total         4
+
+DUPLICATED  TYPE          VERSIONS
+log4j-core  java-archive  2.12.4, 2.14.1, 2.17.1
+`, trimTrailingSpaces(buf.String()))
+}
+
+// trimTrailingSpaces removes the padding at the end of each line of a rendered table.
+func trimTrailingSpaces(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
 func Test_markDuplicateRows(t *testing.T) {
 	data := [][]string{
 		{"1", "2", "3"},
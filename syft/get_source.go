@@ -7,7 +7,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/transformsource"
 )
 
 // GetSource uses all of Syft's known source providers to attempt to resolve the user input to a usable source.Source
@@ -16,6 +18,10 @@ func GetSource(ctx context.Context, userInput string, cfg *GetSourceConfig) (sou
 		cfg = DefaultGetSourceConfig()
 	}
 
+	if len(cfg.Transformations) > 0 {
+		return getTransformedSource(ctx, userInput, cfg)
+	}
+
 	providers, err := cfg.getProviders(userInput)
 	if err != nil {
 		return nil, err
@@ -54,6 +60,35 @@ func GetSource(ctx context.Context, userInput string, cfg *GetSourceConfig) (sou
 	return nil, sourceError(userInput, errs...)
 }
 
+// getTransformedSource resolves the result of running the configured transformations against the user input (which
+// must be a local path) to a source, where the intermediate results are removed once the source is closed.
+func getTransformedSource(ctx context.Context, userInput string, cfg *GetSourceConfig) (source.Source, error) {
+	transformed, cleanup, err := cfg.Transformations.Run(ctx, userInput)
+	if err != nil {
+		if cleanupErr := cleanup(); cleanupErr != nil {
+			log.WithFields("error", cleanupErr).Warn("unable to cleanup transformed source input")
+		}
+		return nil, sourceError(userInput, err)
+	}
+
+	untransformed := *cfg
+	untransformed.Transformations = nil
+
+	src, err := GetSource(ctx, transformed, &untransformed)
+	if err != nil {
+		if cleanupErr := cleanup(); cleanupErr != nil {
+			log.WithFields("error", cleanupErr).Warn("unable to cleanup transformed source input")
+		}
+		return nil, err
+	}
+
+	var alias source.Alias
+	if cfg.SourceProviderConfig != nil {
+		alias = cfg.SourceProviderConfig.Alias
+	}
+	return transformsource.NewSource(src, userInput, alias, cleanup), nil
+}
+
 func sourceError(userInput string, errs ...error) error {
 	switch len(errs) {
 	case 0:
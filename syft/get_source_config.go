@@ -8,6 +8,8 @@ import (
 	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/syft/source"
 	"github.com/anchore/syft/syft/source/sourceproviders"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+	"github.com/anchore/syft/syft/source/transformsource"
 )
 
 type GetSourceConfig struct {
@@ -19,6 +21,10 @@ type GetSourceConfig struct {
 
 	// DefaultImagePullSource will cause a particular image pull source to be used as the first pull source, followed by other pull sources
 	DefaultImagePullSource string
+
+	// Transformations are applied, in order, to the user input (a local file) before resolving the result to a source
+	// (e.g. decrypting and unpacking an archive that holds a disk image)
+	Transformations transformsource.Pipeline
 }
 
 func (c *GetSourceConfig) WithAlias(alias source.Alias) *GetSourceConfig {
@@ -51,6 +57,21 @@ func (c *GetSourceConfig) WithBasePath(basePath string) *GetSourceConfig {
 	return c
 }
 
+func (c *GetSourceConfig) WithOCILayoutSelection(selection stereoscopesource.OCILayoutSelection) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithOCILayoutSelection(selection)
+	return c
+}
+
+func (c *GetSourceConfig) WithContainerdStoreConfig(containerd stereoscopesource.ContainerdStoreConfig) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithContainerdStoreConfig(containerd)
+	return c
+}
+
+func (c *GetSourceConfig) WithContainersStorageConfig(containersStorage stereoscopesource.ContainersStorageConfig) *GetSourceConfig {
+	c.SourceProviderConfig = c.SourceProviderConfig.WithContainersStorageConfig(containersStorage)
+	return c
+}
+
 func (c *GetSourceConfig) WithSources(sources ...string) *GetSourceConfig {
 	c.Sources = sources
 	return c
@@ -61,6 +82,11 @@ func (c *GetSourceConfig) WithDefaultImagePullSource(defaultImagePullSource stri
 	return c
 }
 
+func (c *GetSourceConfig) WithTransformations(transformations ...transformsource.Transformation) *GetSourceConfig {
+	c.Transformations = transformations
+	return c
+}
+
 func (c *GetSourceConfig) getProviders(userInput string) ([]source.Provider, error) {
 	providers := collections.TaggedValueSet[source.Provider]{}.Join(sourceproviders.All(userInput, c.SourceProviderConfig)...)
 
@@ -0,0 +1,159 @@
+/*
+Package imagebuilder provides an integration API for image builders that assemble images in-process (such as ko, Jib,
+or Paketo buildpacks), allowing for the layers of an image to be cataloged as they are built instead of after a round
+trip through a registry, daemon, or image tarball. Builders that know more about the artifacts they place into an image
+(e.g. the Go module a binary was built from) may additionally pass that information along to be reflected in the SBOM.
+*/
+package imagebuilder
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/syft"
+	"github.com/anchore/syft/syft/cataloging/pkgcataloging"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+// Image describes an image assembled by a builder, as a base image with the layers added by the builder on top.
+type Image struct {
+	// Reference is the (optional) reference the image will be published as (e.g. "ko.local/app:latest").
+	Reference string
+
+	// Base is the (optional) base image the layers are added to. When nil the layers are added to an empty image.
+	Base v1.Image
+
+	// Layers are the layers added on top of the base image, in order.
+	Layers []v1.Layer
+
+	// Platform is the (optional) platform of the image, which overrides the platform of the base image.
+	Platform *v1.Platform
+
+	// Modules are the (optional) Go modules the builder compiled binaries from, keyed by path within the image.
+	Modules []Module
+}
+
+// Config is the configuration for cataloging an image from a builder.
+type Config struct {
+	// Source is the configuration for the image source (the reference is taken from the image when not provided).
+	Source stereoscopesource.ImageConfig
+
+	// SBOM is the configuration used to catalog the image (when nil the default image cataloging configuration is
+	// used).
+	SBOM *syft.CreateSBOMConfig
+}
+
+// DefaultSBOMConfig returns the cataloging configuration used when none is provided.
+func DefaultSBOMConfig() *syft.CreateSBOMConfig {
+	return syft.DefaultCreateSBOMConfig().
+		WithCatalogerSelection(
+			pkgcataloging.NewSelectionRequest().WithDefaults(pkgcataloging.ImageTag),
+		)
+}
+
+// Assemble returns the image described, with the builder layers appended to the base image.
+func (i Image) Assemble() (v1.Image, error) {
+	base := i.Base
+	if base == nil {
+		base = empty.Image
+	}
+
+	img, err := mutate.AppendLayers(base, i.Layers...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to append layers to base image: %w", err)
+	}
+
+	if i.Platform == nil {
+		return img, nil
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image config: %w", err)
+	}
+	cfg = cfg.DeepCopy()
+	cfg.OS = i.Platform.OS
+	cfg.Architecture = i.Platform.Architecture
+	cfg.Variant = i.Platform.Variant
+	cfg.OSVersion = i.Platform.OSVersion
+
+	img, err = mutate.ConfigFile(img, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set image platform: %w", err)
+	}
+	return img, nil
+}
+
+// NewSource returns a source for the image described, which must be closed by the caller when no longer needed.
+func NewSource(img Image, cfg stereoscopesource.ImageConfig) (source.Source, error) {
+	assembled, err := img.Assemble()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Reference == "" {
+		cfg.Reference = img.Reference
+	}
+
+	var metadata []image.AdditionalMetadata
+	if img.Reference != "" {
+		metadata = append(metadata, image.WithTags(img.Reference))
+	}
+
+	// the platform is only reported by stereoscope when provided explicitly
+	configFile, err := assembled.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image config: %w", err)
+	}
+	if configFile.OS != "" {
+		metadata = append(metadata, image.WithOS(configFile.OS))
+	}
+	if configFile.Architecture != "" {
+		metadata = append(metadata, image.WithArchitecture(configFile.Architecture, configFile.Variant))
+	}
+
+	tmpDirGen := file.NewTempDirGenerator("syft-imagebuilder")
+	contentTempDir, err := tmpDirGen.NewDirectory("imagebuilder-image")
+	if err != nil {
+		return nil, err
+	}
+
+	stereoscopeImage := image.New(assembled, tmpDirGen, contentTempDir, metadata...)
+	if err := stereoscopeImage.Read(); err != nil {
+		_ = stereoscopeImage.Cleanup()
+		return nil, fmt.Errorf("unable to read image: %w", err)
+	}
+
+	return stereoscopesource.New(stereoscopeImage, cfg), nil
+}
+
+// CreateSBOM catalogs the image described, reflecting any Go module information provided by the builder.
+func CreateSBOM(ctx context.Context, img Image, cfg Config) (*sbom.SBOM, error) {
+	src, err := NewSource(img, cfg.Source)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	sbomCfg := cfg.SBOM
+	if sbomCfg == nil {
+		sbomCfg = DefaultSBOMConfig()
+	}
+
+	s, err := syft.CreateSBOM(ctx, src, sbomCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	applyModules(s, img.Modules)
+
+	return s, nil
+}
@@ -0,0 +1,133 @@
+package imagebuilder
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
+)
+
+var osRelease = `NAME="Alpine Linux"
+ID=alpine
+VERSION_ID=3.20.2
+PRETTY_NAME="Alpine Linux v3.20"
+`
+
+var apkDB = `C:Q1bTpF/j5YQg/S0aAkb0dnBbIqr1w=
+P:musl
+V:1.2.5-r0
+A:x86_64
+S:411308
+I:622592
+T:the musl c library (libc) implementation
+U:https://musl.libc.org/
+L:MIT
+o:musl
+m:Natanael Copa <ncopa@alpinelinux.org>
+t:1712254046
+c:4fe5bbf9d44e4db7e2fd6a2f7c7d1a5d8bd0a38c
+F:lib
+R:ld-musl-x86_64.so.1
+a:0:0:755
+Z:Q1Ezy5kaPW+iCvMPpzxSYjWpYmUhI=
+
+`
+
+func TestCreateSBOM(t *testing.T) {
+	base, err := LayerFromFS(fstest.MapFS{
+		"etc/os-release":          {Data: []byte(osRelease)},
+		"lib/apk/db/installed":    {Data: []byte(apkDB)},
+		"lib/ld-musl-x86_64.so.1": {Data: []byte("not really a library")},
+	}, "/")
+	require.NoError(t, err)
+
+	app, err := LayerFromFS(fstest.MapFS{
+		"index.html": {Data: []byte("<html></html>")},
+	}, "/var/run/ko")
+	require.NoError(t, err)
+
+	img := Image{
+		Reference: "ko.local/app:latest",
+		Layers:    []v1.Layer{base, app},
+		Platform:  &v1.Platform{OS: "linux", Architecture: "arm64"},
+	}
+
+	s, err := CreateSBOM(context.Background(), img, Config{})
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		names = append(names, p.Name+"@"+p.Version)
+	}
+	assert.Equal(t, []string{"musl@1.2.5-r0"}, names)
+
+	require.NotNil(t, s.Artifacts.LinuxDistribution)
+	assert.Equal(t, "alpine", s.Artifacts.LinuxDistribution.ID)
+
+	metadata, ok := s.Source.Metadata.(source.ImageMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "ko.local/app:latest", metadata.UserInput)
+	assert.Equal(t, "arm64", metadata.Architecture)
+	assert.Len(t, metadata.Layers, 2)
+
+	coordinates := s.Artifacts.Packages.Sorted()[0].Locations.ToSlice()[0]
+	assert.Equal(t, "/lib/apk/db/installed", coordinates.RealPath)
+	assert.Equal(t, metadata.Layers[0].Digest, coordinates.FileSystemID)
+}
+
+func TestImage_Assemble(t *testing.T) {
+	layer, err := LayerFromFS(fstest.MapFS{
+		"app": {Data: []byte("binary"), Mode: 0o755},
+	}, "/ko-app")
+	require.NoError(t, err)
+
+	base, err := Image{Layers: []v1.Layer{layer}}.Assemble()
+	require.NoError(t, err)
+
+	img, err := Image{
+		Base:     base,
+		Layers:   []v1.Layer{layer},
+		Platform: &v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+	}.Assemble()
+	require.NoError(t, err)
+
+	layers, err := img.Layers()
+	require.NoError(t, err)
+	assert.Len(t, layers, 2)
+
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+	assert.Equal(t, "linux", cfg.OS)
+	assert.Equal(t, "arm", cfg.Architecture)
+	assert.Equal(t, "v7", cfg.Variant)
+
+	// the base image is not modified
+	baseCfg, err := base.ConfigFile()
+	require.NoError(t, err)
+	assert.Empty(t, baseCfg.Architecture)
+}
+
+func TestNewSource_fileResolver(t *testing.T) {
+	layer, err := LayerFromFS(fstest.MapFS{
+		"app":        {Data: []byte("binary"), Mode: 0o755},
+		"data/a.txt": {Data: []byte("a")},
+	}, "/ko-app")
+	require.NoError(t, err)
+
+	src, err := NewSource(Image{Layers: []v1.Layer{layer}}, stereoscopesource.ImageConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, src.Close()) })
+
+	resolver, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByPath("/ko-app/app", "/ko-app/data/a.txt")
+	require.NoError(t, err)
+	assert.Len(t, locations, 2)
+}
@@ -0,0 +1,105 @@
+package imagebuilder
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// LayerFromOpener returns a layer for the (compressed or uncompressed) tar stream returned by the given opener.
+func LayerFromOpener(opener tarball.Opener) (v1.Layer, error) {
+	return tarball.LayerFromOpener(opener)
+}
+
+// LayerFromFS returns a layer with the contents of the given filesystem, placed under the given directory within the
+// image (e.g. the "kodata" directory of a ko application placed at "/var/run/ko").
+func LayerFromFS(fsys fs.FS, dir string) (v1.Layer, error) {
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		reader, writer := io.Pipe()
+		go func() {
+			writer.CloseWithError(writeTar(writer, fsys, dir))
+		}()
+		return reader, nil
+	})
+}
+
+func writeTar(w io.Writer, fsys fs.FS, dir string) error {
+	tw := tar.NewWriter(w)
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		name := path.Join(dir, p)
+		if name == "" || name == "." || name == "/" {
+			return nil
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = readLink(fsys, p); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = trimRoot(name)
+		if d.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("unable to write tar header for %q: %w", name, err)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := fsys.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func readLink(fsys fs.FS, p string) (string, error) {
+	rl, ok := fsys.(interface {
+		ReadLink(name string) (string, error)
+	})
+	if !ok {
+		return "", fmt.Errorf("unable to read symlink %q: filesystem does not support reading links", p)
+	}
+	return rl.ReadLink(p)
+}
+
+// trimRoot returns the path relative to the root of the image, as expected for tar entries.
+func trimRoot(p string) string {
+	for len(p) > 0 && p[0] == '/' {
+		p = p[1:]
+	}
+	return p
+}
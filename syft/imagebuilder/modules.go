@@ -0,0 +1,123 @@
+package imagebuilder
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+// develVersion is the version the Go toolchain reports for the main module when building from a local checkout, which
+// is typical for images built by ko.
+const develVersion = "(devel)"
+
+// Module is the Go module that a binary within the image was built from, as known by the builder.
+type Module struct {
+	// BinaryPath is the path of the binary within the image (e.g. "/ko-app/app").
+	BinaryPath string
+
+	// Path is the module path of the main module (e.g. "github.com/example/app").
+	Path string
+
+	// Version is the version of the main module (e.g. "v1.2.3" or a pseudo-version).
+	Version string
+}
+
+// applyModules sets the version of the main module of each binary described by the builder, where the version could
+// not be determined from the binary itself. Relationships are updated to reference the amended packages.
+func applyModules(s *sbom.SBOM, modules []Module) {
+	if len(modules) == 0 || s.Artifacts.Packages == nil {
+		return
+	}
+
+	byBinary := make(map[string]Module)
+	for _, m := range modules {
+		if m.BinaryPath == "" || m.Version == "" {
+			continue
+		}
+		byBinary[m.BinaryPath] = m
+	}
+
+	replaced := make(map[artifact.ID]pkg.Package)
+	for _, p := range s.Artifacts.Packages.Sorted(pkg.GoModulePkg) {
+		m, ok := moduleForPackage(p, byBinary)
+		if !ok {
+			continue
+		}
+
+		oldID := p.ID()
+		p.Version = m.Version
+		p.PURL = goPackageURL(p.Name, p.Version)
+		p.SetID()
+
+		log.WithFields("module", p.Name, "version", p.Version).Trace("using main module version provided by image builder")
+
+		s.Artifacts.Packages.Delete(oldID)
+		s.Artifacts.Packages.Add(p)
+		replaced[oldID] = p
+	}
+
+	if len(replaced) == 0 {
+		return
+	}
+
+	amend := func(i artifact.Identifiable) artifact.Identifiable {
+		if p, ok := replaced[i.ID()]; ok {
+			return p
+		}
+		return i
+	}
+	for idx, r := range s.Relationships {
+		s.Relationships[idx].From = amend(r.From)
+		s.Relationships[idx].To = amend(r.To)
+	}
+}
+
+func moduleForPackage(p pkg.Package, byBinary map[string]Module) (Module, bool) {
+	if p.Version != develVersion && p.Version != "" {
+		return Module{}, false
+	}
+
+	meta, ok := p.Metadata.(pkg.GolangBinaryBuildinfoEntry)
+	if !ok || meta.MainModule != p.Name {
+		return Module{}, false
+	}
+
+	for _, l := range p.Locations.ToSlice() {
+		for _, candidate := range []string{l.RealPath, l.AccessPath} {
+			if m, ok := byBinary[candidate]; ok && m.Path == p.Name {
+				return m, true
+			}
+		}
+	}
+	return Module{}, false
+}
+
+// goPackageURL returns the package URL for a Go module (e.g. pkg:golang/github.com/example/app@v1.2.3).
+func goPackageURL(moduleName, moduleVersion string) string {
+	fields := strings.Split(moduleName, "/")
+
+	var namespace, name, subpath string
+	switch len(fields) {
+	case 1:
+		name = fields[0]
+	case 2:
+		namespace, name = fields[0], fields[1]
+	default:
+		namespace = strings.Join(fields[0:2], "/")
+		name = fields[2]
+		subpath = strings.Join(fields[3:], "/")
+	}
+
+	return packageurl.NewPackageURL(
+		packageurl.TypeGolang,
+		namespace,
+		name,
+		moduleVersion,
+		nil,
+		subpath,
+	).ToString()
+}
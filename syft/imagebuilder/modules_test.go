@@ -0,0 +1,70 @@
+package imagebuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+func Test_applyModules(t *testing.T) {
+	newGoPackage := func(name, version, mainModule, binary string) pkg.Package {
+		p := pkg.Package{
+			Name:      name,
+			Version:   version,
+			Type:      pkg.GoModulePkg,
+			PURL:      goPackageURL(name, version),
+			Locations: file.NewLocationSet(file.NewLocation(binary)),
+			Metadata:  pkg.GolangBinaryBuildinfoEntry{MainModule: mainModule},
+		}
+		p.SetID()
+		return p
+	}
+
+	app := newGoPackage("github.com/example/app", "(devel)", "github.com/example/app", "/ko-app/app")
+	dep := newGoPackage("golang.org/x/sync", "v0.7.0", "github.com/example/app", "/ko-app/app")
+	// a binary the builder does not describe
+	other := newGoPackage("github.com/example/other", "(devel)", "github.com/example/other", "/usr/bin/other")
+	// a main module whose version is already known
+	tool := newGoPackage("github.com/example/tool", "v0.3.0", "github.com/example/tool", "/ko-app/tool")
+
+	s := &sbom.SBOM{
+		Artifacts: sbom.Artifacts{
+			Packages: pkg.NewCollection(app, dep, other, tool),
+		},
+		Relationships: []artifact.Relationship{
+			{From: dep, To: app, Type: artifact.DependencyOfRelationship},
+		},
+	}
+
+	applyModules(s, []Module{
+		{BinaryPath: "/ko-app/app", Path: "github.com/example/app", Version: "v1.2.3"},
+		{BinaryPath: "/ko-app/tool", Path: "github.com/example/tool", Version: "v9.9.9"},
+		// the module path must match the main module of the binary
+		{BinaryPath: "/usr/bin/other", Path: "github.com/example/not-other", Version: "v1.0.0"},
+	})
+
+	var got []string
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		got = append(got, p.PURL)
+	}
+	assert.ElementsMatch(t, []string{
+		"pkg:golang/github.com/example/app@v1.2.3",
+		"pkg:golang/github.com/example/other@(devel)",
+		"pkg:golang/github.com/example/tool@v0.3.0",
+		"pkg:golang/golang.org/x/sync@v0.7.0",
+	}, got)
+
+	assert.Nil(t, s.Artifacts.Packages.Package(app.ID()))
+
+	require.Len(t, s.Relationships, 1)
+	to, ok := s.Relationships[0].To.(pkg.Package)
+	require.True(t, ok)
+	assert.Equal(t, "v1.2.3", to.Version)
+	assert.NotNil(t, s.Artifacts.Packages.Package(to.ID()))
+}
@@ -17,6 +17,7 @@ import (
 var ErrSkipPath = errors.New("skip path")
 
 var _ file.Resolver = (*Directory)(nil)
+var _ file.UnreadablePathsReporter = (*Directory)(nil)
 
 // Directory implements path and content access for the directory data source.
 type Directory struct {
@@ -26,6 +27,7 @@ type Directory struct {
 	index         filetree.IndexReader
 	searchContext filetree.Searcher
 	indexer       *directoryIndexer
+	unreadable    *unreadablePaths
 }
 
 func NewFromDirectory(root string, base string, pathFilters ...PathIndexVisitor) (*Directory, error) {
@@ -47,11 +49,12 @@ func newFromDirectoryWithoutIndex(root string, base string, pathFilters ...PathI
 	cleanBase := chroot.Base()
 
 	return &Directory{
-		path:    cleanRoot,
-		chroot:  *chroot,
-		tree:    filetree.New(),
-		index:   filetree.NewIndex(),
-		indexer: newDirectoryIndexer(cleanRoot, cleanBase, pathFilters...),
+		path:       cleanRoot,
+		chroot:     *chroot,
+		tree:       filetree.New(),
+		index:      filetree.NewIndex(),
+		indexer:    newDirectoryIndexer(cleanRoot, cleanBase, pathFilters...),
+		unreadable: newUnreadablePaths(),
 	}, nil
 }
 
@@ -68,9 +71,25 @@ func (r *Directory) buildIndex() error {
 	r.index = index
 	r.searchContext = filetree.NewSearchContext(tree, index)
 
+	for p, accessErr := range r.indexer.errPaths {
+		if windows.HostRunningOnWindows() {
+			p = windows.ToPosix(p)
+		}
+		r.unreadable.add(r.responsePath(p), accessErr)
+	}
+
 	return nil
 }
 
+// UnreadablePaths returns all paths (relative to the root of the directory resolver) that exist but could not be
+// accessed while indexing or could not be read when fetching file contents.
+func (r Directory) UnreadablePaths() []file.UnreadablePath {
+	if r.unreadable == nil {
+		return nil
+	}
+	return r.unreadable.list()
+}
+
 func (r Directory) requestPath(userPath string) (string, error) {
 	return r.chroot.ToNativePath(userPath)
 }
@@ -221,7 +240,12 @@ func (r Directory) FileContentsByLocation(location file.Location) (io.ReadCloser
 		filePath = windows.FromPosix(filePath)
 	}
 
-	return stereoscopeFile.NewLazyReadCloser(filePath), nil
+	reader := stereoscopeFile.NewLazyReadCloser(filePath)
+	if r.unreadable == nil {
+		return reader, nil
+	}
+
+	return newUnreadableTrackingReadCloser(reader, location.RealPath, r.unreadable), nil
 }
 
 func (r *Directory) AllLocations(ctx context.Context) <-chan file.Location {
@@ -26,12 +26,16 @@ type directoryIndexer struct {
 	path              string
 	base              string
 	pathIndexVisitors []PathIndexVisitor
+	exclusionVisitors []PathIndexVisitor
 	errPaths          map[string]error
 	tree              filetree.ReadWriter
 	index             filetree.Index
 }
 
 func newDirectoryIndexer(path, base string, visitors ...PathIndexVisitor) *directoryIndexer {
+	// paths that are excluded by mount type or by the caller should never be considered, even if they cannot be accessed
+	exclusionVisitors := append([]PathIndexVisitor{skipPathsByMountTypeAndName(path)}, visitors...)
+
 	i := &directoryIndexer{
 		path:  path,
 		base:  base,
@@ -41,11 +45,11 @@ func newDirectoryIndexer(path, base string, visitors ...PathIndexVisitor) *direc
 			[]PathIndexVisitor{
 				requireFileInfo,
 				disallowByFileType,
-				skipPathsByMountTypeAndName(path),
 			},
-			visitors...,
+			exclusionVisitors...,
 		),
-		errPaths: make(map[string]error),
+		exclusionVisitors: exclusionVisitors,
+		errPaths:          make(map[string]error),
 	}
 
 	// these additional stateful visitors should be the first thing considered when walking / indexing
@@ -288,13 +292,31 @@ func (r *directoryIndexer) indexPath(givenPath string, info os.FileInfo, err err
 	return newRoot, nil
 }
 
-func (r *directoryIndexer) disallowFileAccessErr(_, path string, _ os.FileInfo, err error) error {
+func (r *directoryIndexer) disallowFileAccessErr(base, path string, info os.FileInfo, err error) error {
+	if err != nil {
+		// there is no need to keep track of access errors for paths that would not have been indexed anyway
+		if excludeErr := r.excluded(base, path, info); excludeErr != nil {
+			return excludeErr
+		}
+	}
 	if r.isFileAccessErr(path, err) {
 		return ErrSkipPath
 	}
 	return nil
 }
 
+func (r *directoryIndexer) excluded(base, path string, info os.FileInfo) error {
+	for _, filterFn := range r.exclusionVisitors {
+		if filterFn == nil {
+			continue
+		}
+		if filterErr := filterFn(base, path, info, nil); filterErr != nil {
+			return filterErr
+		}
+	}
+	return nil
+}
+
 func (r *directoryIndexer) isFileAccessErr(path string, err error) bool {
 	// don't allow for errors to stop indexing, keep track of the paths and continue.
 	if err != nil {
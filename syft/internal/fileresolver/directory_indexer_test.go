@@ -135,6 +135,29 @@ func TestDirectoryIndexer_handleFileAccessErr(t *testing.T) {
 	}
 }
 
+func TestDirectoryIndexer_disallowFileAccessErr_excludedPaths(t *testing.T) {
+	excludeFn := func(_, path string, _ os.FileInfo, _ error) error {
+		if path == "/excluded" {
+			return ErrSkipPath
+		}
+		return nil
+	}
+
+	r := directoryIndexer{
+		exclusionVisitors: []PathIndexVisitor{excludeFn},
+		errPaths:          make(map[string]error),
+	}
+
+	assert.ErrorIs(t, r.disallowFileAccessErr("", "/excluded", nil, os.ErrPermission), ErrSkipPath)
+	assert.ErrorIs(t, r.disallowFileAccessErr("", "/included", nil, os.ErrPermission), ErrSkipPath)
+	assert.NoError(t, r.disallowFileAccessErr("", "/excluded", nil, nil))
+
+	_, excludedTracked := r.errPaths["/excluded"]
+	assert.False(t, excludedTracked, "excluded paths should not be tracked as access errors")
+	_, includedTracked := r.errPaths["/included"]
+	assert.True(t, includedTracked)
+}
+
 func TestDirectoryIndexer_IncludeRootPathInIndex(t *testing.T) {
 	filterFn := func(_, path string, _ os.FileInfo, _ error) error {
 		if path != "/" {
@@ -0,0 +1,67 @@
+package fileresolver
+
+import (
+	"context"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+// layerFilter decorates a resolver such that searches (by glob, by MIME type, or over all locations) only return
+// files that reside within the given layers. Direct lookups (by path, relative to another file, or of file contents
+// and metadata) are not filtered, so that catalogers can still reference supporting files from any layer.
+type layerFilter struct {
+	file.Resolver
+	layers *strset.Set
+}
+
+// NewLayerFilterDecorator creates a new resolver which wraps the provided (squashed) image resolver and limits the
+// discovery of files to the layers with the given digests.
+func NewLayerFilterDecorator(delegate file.Resolver, layerDigests ...string) file.Resolver {
+	return &layerFilter{
+		Resolver: delegate,
+		layers:   strset.New(layerDigests...),
+	}
+}
+
+func (r *layerFilter) FilesByGlob(patterns ...string) ([]file.Location, error) {
+	locations, err := r.Resolver.FilesByGlob(patterns...)
+	return r.filter(locations, err)
+}
+
+func (r *layerFilter) FilesByMIMEType(types ...string) ([]file.Location, error) {
+	locations, err := r.Resolver.FilesByMIMEType(types...)
+	return r.filter(locations, err)
+}
+
+func (r *layerFilter) AllLocations(ctx context.Context) <-chan file.Location {
+	c := make(chan file.Location)
+	go func() {
+		defer close(c)
+		for location := range r.Resolver.AllLocations(ctx) {
+			if !r.layers.Has(location.FileSystemID) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case c <- location:
+			}
+		}
+	}()
+	return c
+}
+
+func (r *layerFilter) filter(locations []file.Location, err error) ([]file.Location, error) {
+	if err != nil {
+		return nil, err
+	}
+	var results []file.Location
+	for _, location := range locations {
+		if r.layers.Has(location.FileSystemID) {
+			results = append(results, location)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,77 @@
+package fileresolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestLayerFilterDecorator(t *testing.T) {
+	locations := []file.Location{
+		file.NewLocationFromCoordinates(file.Coordinates{RealPath: "/etc/os-release", FileSystemID: "sha256:base"}),
+		file.NewLocationFromCoordinates(file.Coordinates{RealPath: "/usr/bin/app", FileSystemID: "sha256:app"}),
+		file.NewLocationFromCoordinates(file.Coordinates{RealPath: "/usr/lib/app.so", FileSystemID: "sha256:lib"}),
+	}
+
+	resolver := NewLayerFilterDecorator(&layeredResolver{mockResolver: &mockResolver{}, locations: locations}, "sha256:app", "sha256:lib")
+
+	expected := []string{"/usr/bin/app", "/usr/lib/app.so"}
+
+	found, err := resolver.FilesByGlob("**/*")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expected, locationPaths(found))
+
+	found, err = resolver.FilesByMIMEType("application/x-executable")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expected, locationPaths(found))
+
+	found = nil
+	for location := range resolver.AllLocations(context.Background()) {
+		found = append(found, location)
+	}
+	assert.ElementsMatch(t, expected, locationPaths(found))
+
+	// direct lookups are not filtered
+	found, err = resolver.FilesByPath("/etc/os-release")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/etc/os-release", "/usr/bin/app", "/usr/lib/app.so"}, locationPaths(found))
+	assert.True(t, resolver.HasPath("/etc/os-release"))
+	assert.NotNil(t, resolver.RelativeFileByPath(locations[1], "/etc/os-release"))
+}
+
+// layeredResolver is a mockResolver that returns locations with file system IDs (as an image resolver would).
+type layeredResolver struct {
+	*mockResolver
+	locations []file.Location
+}
+
+func (r *layeredResolver) FilesByPath(_ ...string) ([]file.Location, error) {
+	return r.locations, nil
+}
+
+func (r *layeredResolver) FilesByGlob(_ ...string) ([]file.Location, error) {
+	return r.locations, nil
+}
+
+func (r *layeredResolver) FilesByMIMEType(_ ...string) ([]file.Location, error) {
+	return r.locations, nil
+}
+
+func (r *layeredResolver) AllLocations(ctx context.Context) <-chan file.Location {
+	c := make(chan file.Location)
+	go func() {
+		defer close(c)
+		for _, location := range r.locations {
+			select {
+			case <-ctx.Done():
+				return
+			case c <- location:
+			}
+		}
+	}()
+	return c
+}
@@ -0,0 +1,119 @@
+package fileresolver
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"sort"
+	"sync"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+// unreadablePaths keeps track of paths that exist but could not be accessed while indexing or reading from a source.
+type unreadablePaths struct {
+	lock  sync.RWMutex
+	paths map[string]file.UnreadablePath
+}
+
+func newUnreadablePaths() *unreadablePaths {
+	return &unreadablePaths{
+		paths: make(map[string]file.UnreadablePath),
+	}
+}
+
+func (u *unreadablePaths) add(p string, err error) {
+	if errors.Is(err, fs.ErrNotExist) {
+		// the path is not present (e.g. it was removed while indexing), which is not the same as being unreadable
+		return
+	}
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if _, exists := u.paths[p]; exists {
+		return
+	}
+	u.paths[p] = file.NewUnreadablePath(p, err)
+}
+
+func (u *unreadablePaths) list() []file.UnreadablePath {
+	u.lock.RLock()
+	defer u.lock.RUnlock()
+
+	var results []file.UnreadablePath
+	for _, p := range u.paths {
+		results = append(results, p)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Path < results[j].Path
+	})
+
+	return results
+}
+
+// unreadableTrackingReadCloser records the path as unreadable when the underlying reader fails for any reason other
+// than reaching the end of the content (e.g. when the file cannot be opened due to permissions).
+type unreadableTrackingReadCloser struct {
+	io.ReadCloser
+	path    string
+	tracker *unreadablePaths
+}
+
+// unreadableTrackingUnionReader is an unreadableTrackingReadCloser for readers that also support random access, which
+// keeps these capabilities available to callers (e.g. binary catalogers that would otherwise read the entire file into
+// memory).
+type unreadableTrackingUnionReader struct {
+	unreadableTrackingReadCloser
+	readerAt io.ReaderAt
+	seeker   io.Seeker
+}
+
+// newUnreadableTrackingReadCloser wraps the given reader for the given path, recording the path as unreadable to the
+// given tracker when reading fails.
+func newUnreadableTrackingReadCloser(reader io.ReadCloser, path string, tracker *unreadablePaths) io.ReadCloser {
+	r := unreadableTrackingReadCloser{
+		ReadCloser: reader,
+		path:       path,
+		tracker:    tracker,
+	}
+
+	readerAt, isReaderAt := reader.(io.ReaderAt)
+	seeker, isSeeker := reader.(io.Seeker)
+	if !isReaderAt || !isSeeker {
+		return r
+	}
+
+	return unreadableTrackingUnionReader{
+		unreadableTrackingReadCloser: r,
+		readerAt:                     readerAt,
+		seeker:                       seeker,
+	}
+}
+
+func (r unreadableTrackingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.track(err)
+	return n, err
+}
+
+func (r unreadableTrackingReadCloser) track(err error) {
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.WithFields("path", r.path, "error", err).Trace("unable to read file")
+		r.tracker.add(r.path, err)
+	}
+}
+
+func (r unreadableTrackingUnionReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.readerAt.ReadAt(p, off)
+	r.track(err)
+	return n, err
+}
+
+func (r unreadableTrackingUnionReader) Seek(offset int64, whence int) (int64, error) {
+	n, err := r.seeker.Seek(offset, whence)
+	r.track(err)
+	return n, err
+}
@@ -0,0 +1,102 @@
+package fileresolver
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func Test_unreadablePaths(t *testing.T) {
+	u := newUnreadablePaths()
+	u.add("/b/file", os.ErrPermission)
+	u.add("/a/file", &os.PathError{Op: "read", Path: "/a/file", Err: syscall.EIO})
+	u.add("/a/missing", os.ErrNotExist)
+	u.add("/b/file", os.ErrInvalid)
+
+	expected := []file.UnreadablePath{
+		{
+			Path:   "/a/file",
+			Reason: file.IOErrorReason,
+			Error:  "read /a/file: input/output error",
+		},
+		{
+			Path:   "/b/file",
+			Reason: file.PermissionDeniedReason,
+			Error:  "permission denied",
+		},
+	}
+
+	assert.Equal(t, expected, u.list())
+}
+
+func TestDirectory_UnreadablePaths(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permissions are not enforced for the root user")
+	}
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "locked"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "locked", "file"), []byte("contents"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "secret"), []byte("contents"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "public"), []byte("contents"), 0o644))
+
+	require.NoError(t, os.Chmod(filepath.Join(root, "locked"), 0o000))
+	require.NoError(t, os.Chmod(filepath.Join(root, "secret"), 0o000))
+	t.Cleanup(func() {
+		_ = os.Chmod(filepath.Join(root, "locked"), 0o755)
+	})
+
+	resolver, err := NewFromDirectory(root, root)
+	require.NoError(t, err)
+
+	for _, p := range []string{"/secret", "/public"} {
+		locations, err := resolver.FilesByPath(p)
+		require.NoError(t, err)
+		require.Len(t, locations, 1)
+
+		reader, err := resolver.FileContentsByLocation(locations[0])
+		require.NoError(t, err)
+		_, _ = io.ReadAll(reader)
+		_ = reader.Close()
+	}
+
+	var paths []string
+	for _, p := range resolver.UnreadablePaths() {
+		assert.Equal(t, file.PermissionDeniedReason, p.Reason)
+		paths = append(paths, p.Path)
+	}
+
+	assert.Equal(t, []string{"/locked", "/secret"}, paths)
+}
+
+func TestDirectory_FileContentsByLocation_randomAccess(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "file"), []byte("contents"), 0o644))
+
+	resolver, err := NewFromDirectory(root, root)
+	require.NoError(t, err)
+
+	locations, err := resolver.FilesByPath("/file")
+	require.NoError(t, err)
+	require.Len(t, locations, 1)
+
+	reader, err := resolver.FileContentsByLocation(locations[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, reader.Close()) })
+
+	// binary catalogers rely on random access to avoid reading entire files into memory
+	require.Implements(t, (*io.ReaderAt)(nil), reader)
+	require.Implements(t, (*io.Seeker)(nil), reader)
+
+	buf := make([]byte, 4)
+	_, err = reader.(io.ReaderAt).ReadAt(buf, 4)
+	require.NoError(t, err)
+	assert.Equal(t, "ents", string(buf))
+}
@@ -0,0 +1,134 @@
+package keymaterial
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+var (
+	appCertificate = file.Certificate{
+		Subject:            "CN=app.example.com,O=Syft",
+		Issuer:             "CN=Test Root CA,O=Syft",
+		SerialNumber:       "326969465881928410888449500045096574037787267340",
+		NotBefore:          "2026-10-17T21:27:13Z",
+		NotAfter:           "2126-09-23T21:27:13Z",
+		SignatureAlgorithm: "ECDSA-SHA256",
+		PublicKeyAlgorithm: "RSA",
+		PublicKeySize:      2048,
+		SHA256Fingerprint:  "382258a0fb03d997aad6a1bbf6bd1a92437c0b0f8199b8e6e329114624682889",
+	}
+
+	rootCertificate = file.Certificate{
+		Subject:            "CN=Test Root CA,O=Syft",
+		Issuer:             "CN=Test Root CA,O=Syft",
+		SerialNumber:       "404095334190095520852344479437508746878563916489",
+		NotBefore:          "2026-10-17T21:27:13Z",
+		NotAfter:           "2126-09-23T21:27:13Z",
+		SignatureAlgorithm: "ECDSA-SHA256",
+		PublicKeyAlgorithm: "ECDSA",
+		PublicKeySize:      256,
+		Curve:              "P-256",
+		IsCA:               true,
+		SHA256Fingerprint:  "d94b213b452e003bdfdc6edd4870a35a75b1f553252d805a683d944b59e99598",
+	}
+
+	appKeyFingerprint = "89eb18ee1f38617d6cdd36af6ad3da0ac7153f5a9bbdbf5d61b25a94a4a7415e"
+)
+
+func withAlias(c file.Certificate, alias string) file.Certificate {
+	c.Alias = alias
+	return c
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected file.KeyMaterial
+		found    bool
+	}{
+		{
+			fixture: "test-fixtures/chain.pem",
+			expected: file.KeyMaterial{
+				Format:       PEMFormat,
+				Certificates: []file.Certificate{appCertificate, rootCertificate},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/app.key",
+			expected: file.KeyMaterial{
+				Format: PEMFormat,
+				Keys: []file.Key{
+					{
+						Type:                 PrivateKey,
+						Algorithm:            "RSA",
+						Size:                 2048,
+						Format:               "PKCS#8",
+						PublicKeyFingerprint: appKeyFingerprint,
+					},
+				},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/root-ca.der",
+			expected: file.KeyMaterial{
+				Format: DERFormat,
+				Certificates: []file.Certificate{
+					{
+						Subject:            "CN=Syft Test Root CA,O=Anchore",
+						Issuer:             "CN=Syft Test Root CA,O=Anchore",
+						SerialNumber:       "1001",
+						NotBefore:          "2024-01-01T00:00:00Z",
+						NotAfter:           "2034-01-01T00:00:00Z",
+						SignatureAlgorithm: "ECDSA-SHA256",
+						PublicKeyAlgorithm: "ECDSA",
+						PublicKeySize:      256,
+						Curve:              "P-256",
+						IsCA:               true,
+						SHA256Fingerprint:  "f44a4e4002c4fc0ca08da8151d9b523be1621f01fa52f3b4f790ca1c46df536d",
+					},
+				},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/id_ed25519.pub",
+			expected: file.KeyMaterial{
+				Format: OpenSSHFormat,
+				Keys: []file.Key{
+					{
+						Type:                 PublicKey,
+						Algorithm:            "Ed25519",
+						Size:                 256,
+						Format:               OpenSSHFormat,
+						PublicKeyFingerprint: "682498d9937b3aeecefa90c17dcde98e2f6784837e63efd6b8c27ee354ef846f",
+					},
+				},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/not-key-material.txt",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			contents, err := os.ReadFile(test.fixture)
+			require.NoError(t, err)
+
+			material, found := Parse(contents)
+			assert.Equal(t, test.found, found)
+			if d := cmp.Diff(test.expected, material); d != "" {
+				t.Errorf("unexpected key material (-want +got):\n%s", d)
+			}
+		})
+	}
+}
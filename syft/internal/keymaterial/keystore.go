@@ -0,0 +1,266 @@
+package keymaterial
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/pkcs12" //nolint:staticcheck // there is no maintained alternative for reading legacy PKCS#12 files
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+// keystore formats
+const (
+	JKSFormat    = "JKS"
+	JCEKSFormat  = "JCEKS"
+	PKCS12Format = "PKCS#12"
+)
+
+const (
+	jksMagic   = 0xFEEDFEED
+	jceksMagic = 0xCECECECE
+
+	// pkcs12Version is the only version of the PFX PDU (see RFC 7292 section 4)
+	pkcs12Version = 3
+)
+
+// JKS and JCEKS entry tags
+const (
+	jksPrivateKeyEntry  = 1
+	jksTrustedCertEntry = 2
+	jceksSecretKeyEntry = 3
+)
+
+// pfx is the outermost structure of a PKCS#12 keystore, where only the version is needed for identification.
+type pfx struct {
+	Version  int
+	AuthSafe asn1.RawValue
+	MacData  asn1.RawValue `asn1:"optional"`
+}
+
+// ParseKeystore returns the certificates and keys found within Java (JKS and JCEKS) and PKCS#12 keystores. Since
+// private keys are always protected by a password only the algorithm and size (from the certificate chain) are
+// described for these. False is returned if the contents are not a known keystore format.
+func ParseKeystore(contents []byte) (file.KeyMaterial, bool) {
+	if len(contents) >= 12 {
+		switch binary.BigEndian.Uint32(contents[0:4]) {
+		case jksMagic:
+			return parseJavaKeystore(JKSFormat, contents), true
+		case jceksMagic:
+			return parseJavaKeystore(JCEKSFormat, contents), true
+		}
+	}
+
+	var p pfx
+	if _, err := asn1.Unmarshal(contents, &p); err == nil && p.Version == pkcs12Version {
+		return parsePKCS12(contents, p.Version), true
+	}
+
+	return file.KeyMaterial{}, false
+}
+
+// parseJavaKeystore returns the entries of a JKS or JCEKS keystore, which has a header of: magic (4 bytes), version
+// (4 bytes), and number of entries (4 bytes). Certificates are stored in the clear, so these are described in full.
+func parseJavaKeystore(format string, contents []byte) file.KeyMaterial {
+	r := bytes.NewReader(contents[4:])
+	var version, count uint32
+	_ = binary.Read(r, binary.BigEndian, &version)
+	_ = binary.Read(r, binary.BigEndian, &count)
+
+	material := file.KeyMaterial{
+		Format:  format,
+		Version: int(version),
+		Entries: int(count),
+	}
+
+	for i := uint32(0); i < count; i++ {
+		if err := readJavaKeystoreEntry(r, &material); err != nil {
+			log.WithFields("format", format, "error", err).Trace("unable to read keystore entry")
+			break
+		}
+	}
+
+	return material
+}
+
+func readJavaKeystoreEntry(r *bytes.Reader, material *file.KeyMaterial) error {
+	var tag uint32
+	if err := binary.Read(r, binary.BigEndian, &tag); err != nil {
+		return err
+	}
+	alias, err := readJavaUTF(r)
+	if err != nil {
+		return err
+	}
+	// creation timestamp (milliseconds since the epoch)
+	if _, err := r.Seek(8, io.SeekCurrent); err != nil {
+		return err
+	}
+
+	switch tag {
+	case jksPrivateKeyEntry:
+		// the encrypted private key, followed by the certificate chain (where the first certificate is for the key)
+		if _, err := readJavaBytes(r); err != nil {
+			return err
+		}
+		var chainLength uint32
+		if err := binary.Read(r, binary.BigEndian, &chainLength); err != nil {
+			return err
+		}
+
+		key := file.Key{
+			Alias:     alias,
+			Type:      PrivateKey,
+			Format:    "PKCS#8",
+			Encrypted: true,
+		}
+		for i := uint32(0); i < chainLength; i++ {
+			cert, err := readJavaCertificate(r, material.Version)
+			if err != nil {
+				return err
+			}
+			if cert == nil {
+				continue
+			}
+			if i == 0 {
+				describePublicKey(cert.PublicKey, &key)
+				key.PublicKeyFingerprint = pkixFingerprint(cert.PublicKey)
+			}
+			c := NewCertificate(cert)
+			c.Alias = alias
+			material.Certificates = append(material.Certificates, c)
+		}
+		material.Keys = append(material.Keys, key)
+	case jksTrustedCertEntry:
+		cert, err := readJavaCertificate(r, material.Version)
+		if err != nil {
+			return err
+		}
+		if cert != nil {
+			c := NewCertificate(cert)
+			c.Alias = alias
+			material.Certificates = append(material.Certificates, c)
+		}
+	case jceksSecretKeyEntry:
+		// secret keys are serialized Java objects, which have no length prefix to skip over
+		return fmt.Errorf("unable to read past secret key entry %q", alias)
+	default:
+		return fmt.Errorf("unknown entry tag: %d", tag)
+	}
+
+	return nil
+}
+
+// readJavaCertificate reads a certificate from a keystore, which is prefixed with the certificate type for version 2
+// keystores (nil is returned for non-X.509 certificates).
+func readJavaCertificate(r *bytes.Reader, version int) (*x509.Certificate, error) {
+	certType := "X.509"
+	if version == 2 {
+		var err error
+		if certType, err = readJavaUTF(r); err != nil {
+			return nil, err
+		}
+	}
+	der, err := readJavaBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	if certType != "X.509" {
+		return nil, nil
+	}
+	return x509.ParseCertificate(der)
+}
+
+// readJavaUTF reads a string as written by java.io.DataOutputStream.writeUTF (a 2 byte length followed by the string).
+func readJavaUTF(r *bytes.Reader) (string, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// readJavaBytes reads a 4 byte length followed by that number of bytes.
+func readJavaBytes(r *bytes.Reader) ([]byte, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if int64(length) > int64(r.Len()) {
+		return nil, fmt.Errorf("length %d exceeds remaining %d bytes", length, r.Len())
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// parsePKCS12 returns the certificates and keys of a PKCS#12 keystore. The contents can only be read when the
+// keystore is not protected by a password (which is common for trust stores), otherwise only the format is known.
+func parsePKCS12(contents []byte, version int) file.KeyMaterial {
+	material := file.KeyMaterial{
+		Format:  PKCS12Format,
+		Version: version,
+	}
+
+	blocks, err := pkcs12.ToPEM(contents, "")
+	if err != nil {
+		log.WithFields("error", err).Trace("unable to read PKCS#12 keystore contents")
+		return material
+	}
+
+	for _, block := range blocks {
+		if err := addPKCS12Bag(&material, block); err != nil {
+			log.WithFields("type", block.Type, "error", err).Trace("unable to parse PKCS#12 bag")
+		}
+	}
+	material.Entries = len(blocks)
+
+	return material
+}
+
+// addPKCS12Bag adds the certificate or key from a PKCS#12 bag (as converted to a PEM block), where the alias is held
+// in the "friendlyName" header. Note that keys are converted to PKCS#1 (RSA) or SEC1 (ECDSA) even though the block
+// type is "PRIVATE KEY".
+func addPKCS12Bag(material *file.KeyMaterial, block *pem.Block) error {
+	alias := block.Headers["friendlyName"]
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return err
+		}
+		c := NewCertificate(cert)
+		c.Alias = alias
+		material.Certificates = append(material.Certificates, c)
+	case "PRIVATE KEY":
+		pub, err := publicKeyOf(x509.ParsePKCS1PrivateKey(block.Bytes))
+		if err != nil {
+			pub, err = publicKeyOf(x509.ParseECPrivateKey(block.Bytes))
+		}
+		if err != nil {
+			return err
+		}
+		key := file.Key{
+			Alias:  alias,
+			Type:   PrivateKey,
+			Format: "PKCS#8",
+		}
+		describePublicKey(pub, &key)
+		key.PublicKeyFingerprint = pkixFingerprint(pub)
+		material.Keys = append(material.Keys, key)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package keymaterial
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestParseKeystore(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected file.KeyMaterial
+		found    bool
+	}{
+		{
+			fixture: "test-fixtures/app.jks",
+			expected: file.KeyMaterial{
+				Format:  JKSFormat,
+				Version: 2,
+				Entries: 2,
+				Certificates: []file.Certificate{
+					withAlias(appCertificate, "app"),
+					withAlias(rootCertificate, "app"),
+					withAlias(rootCertificate, "root"),
+				},
+				Keys: []file.Key{
+					{
+						Alias:                "app",
+						Type:                 PrivateKey,
+						Algorithm:            "RSA",
+						Size:                 2048,
+						Format:               "PKCS#8",
+						Encrypted:            true,
+						PublicKeyFingerprint: appKeyFingerprint,
+					},
+				},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/app.p12",
+			expected: file.KeyMaterial{
+				Format:  PKCS12Format,
+				Version: 3,
+				Entries: 3,
+				Certificates: []file.Certificate{
+					withAlias(appCertificate, "app"),
+					rootCertificate,
+				},
+				Keys: []file.Key{
+					{
+						Alias:                "app",
+						Type:                 PrivateKey,
+						Algorithm:            "RSA",
+						Size:                 2048,
+						Format:               "PKCS#8",
+						PublicKeyFingerprint: appKeyFingerprint,
+					},
+				},
+			},
+			found: true,
+		},
+		{
+			fixture: "test-fixtures/chain.pem",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			contents, err := os.ReadFile(test.fixture)
+			require.NoError(t, err)
+
+			material, found := ParseKeystore(contents)
+			assert.Equal(t, test.found, found)
+			if d := cmp.Diff(test.expected, material); d != "" {
+				t.Errorf("unexpected key material (-want +got):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestParseKeystore_truncated(t *testing.T) {
+	contents, err := os.ReadFile("test-fixtures/app.jks")
+	require.NoError(t, err)
+
+	// the header is still reported when entries cannot be read
+	material, found := ParseKeystore(contents[:100])
+	require.True(t, found)
+	assert.Equal(t, file.KeyMaterial{Format: JKSFormat, Version: 2, Entries: 2}, material)
+}
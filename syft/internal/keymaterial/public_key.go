@@ -0,0 +1,100 @@
+package keymaterial
+
+import (
+	"crypto"
+	"crypto/dsa" //nolint:staticcheck // DSA keys are still found in the wild and should be described
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+const ed25519Algorithm = "Ed25519"
+
+// NewCertificate returns the (non-sensitive) details of the given certificate.
+func NewCertificate(cert *x509.Certificate) file.Certificate {
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	c := file.Certificate{
+		Subject:            cert.Subject.String(),
+		Issuer:             cert.Issuer.String(),
+		SerialNumber:       cert.SerialNumber.String(),
+		NotBefore:          cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:           cert.NotAfter.UTC().Format(time.RFC3339),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		PublicKeyAlgorithm: cert.PublicKeyAlgorithm.String(),
+		IsCA:               cert.IsCA,
+		SHA256Fingerprint:  hex.EncodeToString(fingerprint[:]),
+	}
+
+	var key file.Key
+	describePublicKey(cert.PublicKey, &key)
+	c.PublicKeySize = key.Size
+	c.Curve = key.Curve
+
+	return c
+}
+
+// publicKeyOf returns the public key of a parsed private key (or the error from parsing the private key).
+func publicKeyOf[T any](privateKey T, err error) (crypto.PublicKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	if signer, ok := any(privateKey).(interface{ Public() crypto.PublicKey }); ok {
+		return signer.Public(), nil
+	}
+	return nil, nil
+}
+
+// describePublicKey populates the algorithm, size and curve of the given key from the public key.
+func describePublicKey(pub crypto.PublicKey, key *file.Key) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		key.Algorithm = "RSA"
+		key.Size = k.N.BitLen()
+	case *ecdsa.PublicKey:
+		key.Algorithm = "ECDSA"
+		key.Size = k.Curve.Params().BitSize
+		key.Curve = k.Curve.Params().Name
+	case ed25519.PublicKey:
+		key.Algorithm = ed25519Algorithm
+		key.Size = ed25519.PublicKeySize * 8
+	case *ecdh.PublicKey:
+		key.Algorithm = "ECDH"
+		key.Curve = fmt.Sprint(k.Curve())
+	case *dsa.PublicKey:
+		key.Algorithm = "DSA"
+		key.Size = k.P.BitLen()
+	}
+}
+
+func describeSSHPublicKey(pub ssh.PublicKey, key *file.Key) {
+	if cryptoPub, ok := pub.(ssh.CryptoPublicKey); ok {
+		describePublicKey(cryptoPub.CryptoPublicKey(), key)
+	}
+	if key.Algorithm == "" {
+		// e.g. security key backed keys ("sk-ssh-ed25519@openssh.com")
+		key.Algorithm = pub.Type()
+	}
+
+	fingerprint := sha256.Sum256(pub.Marshal())
+	key.PublicKeyFingerprint = hex.EncodeToString(fingerprint[:])
+}
+
+func pkixFingerprint(pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return ""
+	}
+	fingerprint := sha256.Sum256(der)
+	return hex.EncodeToString(fingerprint[:])
+}
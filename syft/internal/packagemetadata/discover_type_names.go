@@ -20,6 +20,10 @@ var knownNonMetadataTypeNames = strset.New(
 	"Collection",
 	"License",
 	"LicenseSet",
+	"Footprint",
+	"LayerFootprint",
+	"Duplicate",
+	"DuplicateVersion",
 )
 
 func DiscoverTypeNames() ([]string, error) {
@@ -7,49 +7,124 @@ import "github.com/anchore/syft/syft/pkg"
 // AllTypes returns a list of all pkg metadata types that syft supports (that are represented in the pkg.Package.Metadata field).
 func AllTypes() []any {
 	return []any{
+		pkg.APIServiceEntry{},
 		pkg.AlpmDBEntry{},
+		pkg.AlpmDependencyEntry{},
+		pkg.AlpmSourceArchiveEntry{},
+		pkg.AlpmSourceEntry{},
+		pkg.AndroidAppEntry{},
+		pkg.AnsibleCollectionManifest{},
+		pkg.AnsibleRequirementsEntry{},
+		pkg.AnsibleRoleMeta{},
 		pkg.ApkDBEntry{},
+		pkg.BazelModuleEntry{},
 		pkg.BinarySignature{},
+		pkg.BrowserExtensionEntry{},
+		pkg.BuckDependencyEntry{},
+		pkg.BunLockEntry{},
+		pkg.CPUMicrocodeEntry{},
+		pkg.CarthageBuildVersionEntry{},
+		pkg.CarthageCartfileResolvedEntry{},
+		pkg.ChefCookbookLockEntry{},
+		pkg.ChefCookbookMetadata{},
 		pkg.CocoaPodfileLockEntry{},
 		pkg.ConanV1LockEntry{},
 		pkg.ConanV2LockEntry{},
 		pkg.ConanfileEntry{},
 		pkg.ConaninfoEntry{},
+		pkg.ContainerImageReferenceEntry{},
+		pkg.CryptoCertificate{},
+		pkg.CryptoKey{},
+		pkg.CryptoKeystore{},
+		pkg.CryptoLibrary{},
 		pkg.DartPubspecLockEntry{},
+		pkg.DevContainerFeatureEntry{},
+		pkg.DeviceTreeEntry{},
 		pkg.DotnetDepsEntry{},
+		pkg.DotnetPackagesLockEntry{},
+		pkg.DotnetPaketLockEntry{},
 		pkg.DotnetPortableExecutableEntry{},
+		pkg.DpkgBuildDependencyEntry{},
 		pkg.DpkgDBEntry{},
+		pkg.DpkgSourceEntry{},
 		pkg.ELFBinaryPackageNoteJSONPayload{},
+		pkg.ElectronRuntimeEntry{},
 		pkg.ElixirMixLockEntry{},
+		pkg.EmacsStraightLockEntry{},
 		pkg.ErlangRebarLockEntry{},
 		pkg.GolangBinaryBuildinfoEntry{},
 		pkg.GolangModuleEntry{},
 		pkg.HackageStackYamlEntry{},
 		pkg.HackageStackYamlLockEntry{},
+		pkg.HelmChartLockEntry{},
+		pkg.HelmChartMetadata{},
+		pkg.IOSAppEntry{},
+		pkg.IOSFrameworkEntry{},
 		pkg.JavaArchive{},
+		pkg.JavaBuildToolWrapperEntry{},
+		pkg.JavaOSGiBundleEntry{},
+		pkg.JetBrainsPluginEntry{},
+		pkg.LinuxFirmwareEntry{},
 		pkg.LinuxKernel{},
 		pkg.LinuxKernelModule{},
 		pkg.LuaRocksPackage{},
+		pkg.MLModelEntry{},
+		pkg.MacOSAppEntry{},
+		pkg.MacOSFrameworkEntry{},
+		pkg.MacOSReceiptEntry{},
+		pkg.MesonWrapEntry{},
 		pkg.MicrosoftKbPatch{},
+		pkg.NeovimLazyLockEntry{},
+		pkg.NetworkServiceEntry{},
 		pkg.NixStoreEntry{},
+		pkg.NpmBundledPackageEntry{},
+		pkg.NpmGlobalPackageEntry{},
 		pkg.NpmPackage{},
 		pkg.NpmPackageLockEntry{},
+		pkg.OSGroupAccount{},
+		pkg.OSUserAccount{},
 		pkg.PhpComposerInstalledEntry{},
 		pkg.PhpComposerLockEntry{},
+		pkg.PhpExtensionEntry{},
 		pkg.PhpPeclEntry{},
 		pkg.PortageEntry{},
+		pkg.PulumiPluginEntry{},
+		pkg.PulumiProjectEntry{},
+		pkg.PuppetModuleMetadata{},
+		pkg.PuppetfileLockEntry{},
 		pkg.PythonPackage{},
 		pkg.PythonPipfileLockEntry{},
+		pkg.PythonPipxEntry{},
 		pkg.PythonPoetryLockEntry{},
 		pkg.PythonRequirementsEntry{},
 		pkg.RDescription{},
 		pkg.RpmArchive{},
+		pkg.RpmBuildRequirementEntry{},
 		pkg.RpmDBEntry{},
+		pkg.RpmSourceArchiveEntry{},
+		pkg.RpmSourceEntry{},
 		pkg.RubyGemspec{},
 		pkg.RustBinaryAuditEntry{},
+		pkg.RustCargoInstallEntry{},
 		pkg.RustCargoLockEntry{},
+		pkg.ScheduledTaskEntry{},
+		pkg.SnapEntry{},
 		pkg.SwiftPackageManagerResolvedEntry{},
 		pkg.SwiplPackEntry{},
+		pkg.TeXLiveTlpdbEntry{},
+		pkg.ToolchainEntry{},
+		pkg.UEFIFirmwareEntry{},
+		pkg.UnityManifestEntry{},
+		pkg.UnityPackagesLockEntry{},
+		pkg.UnrealPluginEntry{},
+		pkg.UnrealProjectPluginEntry{},
+		pkg.VSCodeExtensionEntry{},
+		pkg.VimPlugSnapshotEntry{},
+		pkg.WasmModuleEntry{},
+		pkg.WindowsAssemblyEntry{},
+		pkg.WindowsDriverEntry{},
+		pkg.WindowsInstallerEntry{},
+		pkg.WindowsMSIEntry{},
 		pkg.WordpressPluginEntry{},
 		pkg.YarnLockEntry{},
 	}
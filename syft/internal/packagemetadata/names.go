@@ -63,48 +63,123 @@ func makeJSONTypes(types ...jsonType) jsonTypeMapping {
 // compatibility to support decoding older JSON documents.
 var jsonTypes = makeJSONTypes(
 	jsonNames(pkg.AlpmDBEntry{}, "alpm-db-entry", "AlpmMetadata"),
+	jsonNames(pkg.AlpmSourceEntry{}, "alpm-source-entry"),
+	jsonNames(pkg.AlpmDependencyEntry{}, "alpm-dependency-entry"),
+	jsonNames(pkg.AlpmSourceArchiveEntry{}, "alpm-source-archive-entry"),
+	jsonNames(pkg.AndroidAppEntry{}, "android-app-entry"),
+	jsonNames(pkg.AnsibleCollectionManifest{}, "ansible-collection-manifest"),
+	jsonNames(pkg.AnsibleRoleMeta{}, "ansible-role-meta"),
+	jsonNames(pkg.AnsibleRequirementsEntry{}, "ansible-requirements-entry"),
+	jsonNames(pkg.APIServiceEntry{}, "api-service-entry"),
 	jsonNames(pkg.ApkDBEntry{}, "apk-db-entry", "ApkMetadata"),
+	jsonNames(pkg.BazelModuleEntry{}, "bazel-module-entry"),
 	jsonNames(pkg.BinarySignature{}, "binary-signature", "BinaryMetadata"),
+	jsonNames(pkg.BrowserExtensionEntry{}, "browser-extension-entry"),
+	jsonNames(pkg.BuckDependencyEntry{}, "buck-dependency-entry"),
+	jsonNames(pkg.CarthageCartfileResolvedEntry{}, "carthage-cartfile-resolved-entry"),
+	jsonNames(pkg.CarthageBuildVersionEntry{}, "carthage-build-version-entry"),
+	jsonNames(pkg.ChefCookbookMetadata{}, "chef-cookbook-metadata"),
+	jsonNames(pkg.ChefCookbookLockEntry{}, "chef-cookbook-lock-entry"),
 	jsonNames(pkg.CocoaPodfileLockEntry{}, "cocoa-podfile-lock-entry", "CocoapodsMetadataType"),
 	jsonNames(pkg.ConanV1LockEntry{}, "c-conan-lock-entry", "ConanLockMetadataType"),
 	jsonNames(pkg.ConanV2LockEntry{}, "c-conan-lock-v2-entry"),
 	jsonNames(pkg.ConanfileEntry{}, "c-conan-file-entry", "ConanMetadataType"),
 	jsonNames(pkg.ConaninfoEntry{}, "c-conan-info-entry"),
+	jsonNames(pkg.ContainerImageReferenceEntry{}, "container-image-reference-entry"),
+	jsonNames(pkg.DevContainerFeatureEntry{}, "devcontainer-feature-entry"),
+	jsonNames(pkg.CryptoCertificate{}, "crypto-certificate"),
+	jsonNames(pkg.CryptoKey{}, "crypto-key"),
+	jsonNames(pkg.CryptoKeystore{}, "crypto-keystore"),
+	jsonNames(pkg.CryptoLibrary{}, "crypto-library"),
 	jsonNames(pkg.DartPubspecLockEntry{}, "dart-pubspec-lock-entry", "DartPubMetadata"),
 	jsonNames(pkg.DotnetDepsEntry{}, "dotnet-deps-entry", "DotnetDepsMetadata"),
 	jsonNames(pkg.DotnetPortableExecutableEntry{}, "dotnet-portable-executable-entry"),
+	jsonNames(pkg.DotnetPackagesLockEntry{}, "dotnet-packages-lock-entry"),
+	jsonNames(pkg.DotnetPaketLockEntry{}, "dotnet-paket-lock-entry"),
 	jsonNames(pkg.DpkgDBEntry{}, "dpkg-db-entry", "DpkgMetadata"),
+	jsonNames(pkg.DpkgSourceEntry{}, "dpkg-source-entry"),
+	jsonNames(pkg.DpkgBuildDependencyEntry{}, "dpkg-build-dependency-entry"),
 	jsonNames(pkg.ELFBinaryPackageNoteJSONPayload{}, "elf-binary-package-note-json-payload"),
 	jsonNames(pkg.RubyGemspec{}, "ruby-gemspec", "GemMetadata"),
 	jsonNames(pkg.GolangBinaryBuildinfoEntry{}, "go-module-buildinfo-entry", "GolangBinMetadata", "GolangMetadata"),
 	jsonNames(pkg.GolangModuleEntry{}, "go-module-entry", "GolangModMetadata"),
 	jsonNames(pkg.HackageStackYamlLockEntry{}, "haskell-hackage-stack-lock-entry", "HackageMetadataType"),
 	jsonNamesWithoutLookup(pkg.HackageStackYamlEntry{}, "haskell-hackage-stack-entry", "HackageMetadataType"), // the legacy value is split into two types, where the other is preferred
+	jsonNames(pkg.IOSAppEntry{}, "ios-app-entry"),
+	jsonNames(pkg.IOSFrameworkEntry{}, "ios-framework-entry"),
 	jsonNames(pkg.JavaArchive{}, "java-archive", "JavaMetadata"),
+	jsonNames(pkg.JavaBuildToolWrapperEntry{}, "java-build-tool-wrapper-entry"),
+	jsonNames(pkg.JavaOSGiBundleEntry{}, "java-osgi-bundle-entry"),
+	jsonNames(pkg.JetBrainsPluginEntry{}, "jetbrains-plugin-entry"),
+	jsonNames(pkg.MacOSAppEntry{}, "macos-app-entry"),
+	jsonNames(pkg.MacOSFrameworkEntry{}, "macos-framework-entry"),
+	jsonNames(pkg.MacOSReceiptEntry{}, "macos-receipt-entry"),
+	jsonNames(pkg.MesonWrapEntry{}, "meson-wrap-entry"),
+	jsonNames(pkg.MLModelEntry{}, "ml-model-entry"),
+	jsonNames(pkg.UEFIFirmwareEntry{}, "uefi-firmware-entry"),
+	jsonNames(pkg.DeviceTreeEntry{}, "device-tree-entry"),
+	jsonNames(pkg.LinuxFirmwareEntry{}, "linux-firmware-entry"),
+	jsonNames(pkg.CPUMicrocodeEntry{}, "cpu-microcode-entry"),
 	jsonNames(pkg.MicrosoftKbPatch{}, "microsoft-kb-patch", "KbPatchMetadata"),
+	jsonNames(pkg.WindowsAssemblyEntry{}, "windows-assembly-entry"),
+	jsonNames(pkg.WindowsDriverEntry{}, "windows-driver-entry"),
+	jsonNames(pkg.WindowsInstallerEntry{}, "windows-installer-entry"),
+	jsonNames(pkg.WindowsMSIEntry{}, "windows-msi-entry"),
 	jsonNames(pkg.LinuxKernel{}, "linux-kernel-archive", "LinuxKernel"),
 	jsonNames(pkg.LinuxKernelModule{}, "linux-kernel-module", "LinuxKernelModule"),
+	jsonNames(pkg.ElectronRuntimeEntry{}, "electron-runtime-entry"),
 	jsonNames(pkg.ElixirMixLockEntry{}, "elixir-mix-lock-entry", "MixLockMetadataType"),
+	jsonNames(pkg.EmacsStraightLockEntry{}, "emacs-straight-lock-entry"),
 	jsonNames(pkg.NixStoreEntry{}, "nix-store-entry", "NixStoreMetadata"),
 	jsonNames(pkg.NpmPackage{}, "javascript-npm-package", "NpmPackageJsonMetadata"),
 	jsonNames(pkg.NpmPackageLockEntry{}, "javascript-npm-package-lock-entry", "NpmPackageLockJsonMetadata"),
+	jsonNames(pkg.NpmGlobalPackageEntry{}, "javascript-npm-global-package-entry"),
+	jsonNames(pkg.NpmBundledPackageEntry{}, "javascript-npm-bundled-package-entry"),
 	jsonNames(pkg.YarnLockEntry{}, "javascript-yarn-lock-entry", "YarnLockJsonMetadata"),
+	jsonNames(pkg.BunLockEntry{}, "javascript-bun-lock-entry"),
+	jsonNames(pkg.NeovimLazyLockEntry{}, "neovim-lazy-lock-entry"),
+	jsonNames(pkg.NetworkServiceEntry{}, "network-service-entry"),
+	jsonNames(pkg.OSGroupAccount{}, "os-group-account"),
+	jsonNames(pkg.OSUserAccount{}, "os-user-account"),
+	jsonNames(pkg.ScheduledTaskEntry{}, "scheduled-task-entry"),
+	jsonNames(pkg.SnapEntry{}, "snap-entry"),
 	jsonNames(pkg.PhpComposerLockEntry{}, "php-composer-lock-entry", "PhpComposerJsonMetadata"),
 	jsonNamesWithoutLookup(pkg.PhpComposerInstalledEntry{}, "php-composer-installed-entry", "PhpComposerJsonMetadata"), // the legacy value is split into two types, where the other is preferred
+	jsonNames(pkg.PhpExtensionEntry{}, "php-extension-entry"),
 	jsonNames(pkg.PhpPeclEntry{}, "php-pecl-entry", "PhpPeclMetadata"),
 	jsonNames(pkg.PortageEntry{}, "portage-db-entry", "PortageMetadata"),
+	jsonNames(pkg.HelmChartMetadata{}, "helm-chart-metadata"),
+	jsonNames(pkg.HelmChartLockEntry{}, "helm-chart-lock-entry"),
+	jsonNames(pkg.PulumiPluginEntry{}, "pulumi-plugin-entry"),
+	jsonNames(pkg.PulumiProjectEntry{}, "pulumi-project-entry"),
+	jsonNames(pkg.PuppetModuleMetadata{}, "puppet-module-metadata"),
+	jsonNames(pkg.PuppetfileLockEntry{}, "puppetfile-lock-entry"),
 	jsonNames(pkg.PythonPackage{}, "python-package", "PythonPackageMetadata"),
 	jsonNames(pkg.PythonPipfileLockEntry{}, "python-pipfile-lock-entry", "PythonPipfileLockMetadata"),
 	jsonNames(pkg.PythonPoetryLockEntry{}, "python-poetry-lock-entry", "PythonPoetryLockMetadata"),
 	jsonNames(pkg.PythonRequirementsEntry{}, "python-pip-requirements-entry", "PythonRequirementsMetadata"),
+	jsonNames(pkg.PythonPipxEntry{}, "python-pipx-entry"),
 	jsonNames(pkg.ErlangRebarLockEntry{}, "erlang-rebar-lock-entry", "RebarLockMetadataType"),
 	jsonNames(pkg.RDescription{}, "r-description", "RDescriptionFileMetadataType"),
 	jsonNames(pkg.RpmDBEntry{}, "rpm-db-entry", "RpmMetadata", "RpmdbMetadata"),
 	jsonNamesWithoutLookup(pkg.RpmArchive{}, "rpm-archive", "RpmMetadata"), // the legacy value is split into two types, where the other is preferred
+	jsonNames(pkg.RpmSourceEntry{}, "rpm-source-entry"),
+	jsonNames(pkg.RpmBuildRequirementEntry{}, "rpm-build-requirement-entry"),
+	jsonNames(pkg.RpmSourceArchiveEntry{}, "rpm-source-archive-entry"),
 	jsonNames(pkg.SwiftPackageManagerResolvedEntry{}, "swift-package-manager-lock-entry", "SwiftPackageManagerMetadata"),
 	jsonNames(pkg.SwiplPackEntry{}, "swiplpack-package"),
+	jsonNames(pkg.TeXLiveTlpdbEntry{}, "texlive-tlpdb-entry"),
+	jsonNames(pkg.ToolchainEntry{}, "toolchain-entry"),
 	jsonNames(pkg.RustCargoLockEntry{}, "rust-cargo-lock-entry", "RustCargoPackageMetadata"),
 	jsonNamesWithoutLookup(pkg.RustBinaryAuditEntry{}, "rust-cargo-audit-entry", "RustCargoPackageMetadata"), // the legacy value is split into two types, where the other is preferred
+	jsonNames(pkg.RustCargoInstallEntry{}, "rust-cargo-install-entry"),
+	jsonNames(pkg.UnityManifestEntry{}, "unity-manifest-entry"),
+	jsonNames(pkg.UnityPackagesLockEntry{}, "unity-packages-lock-entry"),
+	jsonNames(pkg.UnrealPluginEntry{}, "unreal-plugin-entry"),
+	jsonNames(pkg.UnrealProjectPluginEntry{}, "unreal-project-plugin-entry"),
+	jsonNames(pkg.VimPlugSnapshotEntry{}, "vim-plug-snapshot-entry"),
+	jsonNames(pkg.VSCodeExtensionEntry{}, "vscode-extension-entry"),
+	jsonNames(pkg.WasmModuleEntry{}, "wasm-module-entry"),
 	jsonNames(pkg.WordpressPluginEntry{}, "wordpress-plugin-entry", "WordpressMetadata"),
 	jsonNames(pkg.LuaRocksPackage{}, "luarocks-package"),
 )
@@ -0,0 +1,222 @@
+package linux
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/go-logger"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+// source annotations describing the system-wide cryptographic configuration (see CryptoConfiguration.Annotations)
+const (
+	// FIPSModeAnnotation is "enabled" when the system is configured to operate in FIPS mode
+	FIPSModeAnnotation = "linux:crypto:fips-mode"
+
+	// FIPSMarkersAnnotation lists the (comma separated) paths of the files indicating FIPS mode
+	FIPSMarkersAnnotation = "linux:crypto:fips-markers"
+
+	// CryptoPolicyAnnotation is the system-wide crypto policy (e.g. "DEFAULT", "FIPS", or "FIPS:OSPP")
+	CryptoPolicyAnnotation = "linux:crypto:policy"
+
+	// OpenSSLFIPSProviderAnnotation lists the (comma separated) paths of the OpenSSL FIPS provider modules found
+	OpenSSLFIPSProviderAnnotation = "linux:crypto:openssl-fips-provider"
+)
+
+const (
+	cryptoPolicyConfigPath = "/etc/crypto-policies/config"
+	cryptoPolicyStatePath  = "/etc/crypto-policies/state/current"
+)
+
+// CryptoConfiguration represents the system-wide cryptographic configuration of a linux distribution.
+type CryptoConfiguration struct {
+	// FIPSMarkers are the paths of files indicating that the system is configured to operate in FIPS mode.
+	FIPSMarkers []string
+
+	// Policy is the system-wide crypto policy as configured with the crypto-policies package (e.g. on RHEL and Fedora).
+	Policy string
+
+	// OpenSSLFIPSProviders are the paths of the OpenSSL (3.x) FIPS provider modules found.
+	OpenSSLFIPSProviders []string
+}
+
+// fipsMarkers are files indicating FIPS mode, which is the case when the file exists and the contents (if any) match.
+var fipsMarkers = []struct {
+	path     string
+	contents *regexp.Regexp
+}{
+	{
+		// written by dracut-fips when FIPS mode is enabled (RHEL 7 and 8)
+		path: "/etc/system-fips",
+	},
+	{
+		// written by fips-mode-setup to include the FIPS module within the initramfs
+		path:     "/etc/dracut.conf.d/40-fips.conf",
+		contents: regexp.MustCompile(`(?m)^\s*add_dracutmodules\+?=.*\bfips\b`),
+	},
+	{
+		// the kernel flag, which is only present when scanning a running system
+		path:     "/proc/sys/crypto/fips_enabled",
+		contents: regexp.MustCompile(`^\s*1\s*$`),
+	},
+}
+
+// openSSLConfigPaths are the common locations of the OpenSSL configuration across distributions.
+var openSSLConfigPaths = []string{
+	"/etc/ssl/openssl.cnf",
+	"/etc/pki/tls/openssl.cnf",
+	"/usr/lib/ssl/openssl.cnf",
+	"/usr/local/ssl/openssl.cnf",
+}
+
+// openSSLFIPSDirective matches (uncommented) OpenSSL configuration lines which enable the FIPS provider, either by
+// including the FIPS module configuration or by loading the provider.
+var openSSLFIPSDirective = regexp.MustCompile(`^\s*(\.include\s+\S*fipsmodule\.cnf|fips\s*=\s*\S+)`)
+
+// IdentifyCryptoConfiguration discovers the system-wide cryptographic configuration (FIPS mode markers, the
+// crypto-policies configuration, and OpenSSL FIPS provider modules). Nil is returned if nothing was found.
+func IdentifyCryptoConfiguration(resolver file.Resolver) *CryptoConfiguration {
+	logger := log.Nested("operation", "identify-crypto-configuration")
+
+	var cfg CryptoConfiguration
+
+	for _, marker := range fipsMarkers {
+		contents, found := readFirst(resolver, logger, marker.path)
+		if found && (marker.contents == nil || marker.contents.MatchString(contents)) {
+			cfg.FIPSMarkers = append(cfg.FIPSMarkers, marker.path)
+		}
+	}
+
+	for _, p := range []string{cryptoPolicyConfigPath, cryptoPolicyStatePath} {
+		contents, found := readFirst(resolver, logger, p)
+		if !found {
+			continue
+		}
+		if cfg.Policy = parseCryptoPolicy(contents); cfg.Policy != "" {
+			if isFIPSPolicy(cfg.Policy) {
+				cfg.FIPSMarkers = append(cfg.FIPSMarkers, p)
+			}
+			break
+		}
+	}
+
+	for _, p := range openSSLConfigPaths {
+		contents, found := readFirst(resolver, logger, p)
+		if found && enablesOpenSSLFIPSProvider(contents) {
+			cfg.FIPSMarkers = append(cfg.FIPSMarkers, p)
+		}
+	}
+
+	cfg.OpenSSLFIPSProviders = findOpenSSLFIPSProviders(resolver, logger)
+
+	if len(cfg.FIPSMarkers) == 0 && cfg.Policy == "" && len(cfg.OpenSSLFIPSProviders) == 0 {
+		return nil
+	}
+
+	return &cfg
+}
+
+// FIPSMode indicates if the system is configured to operate in FIPS mode.
+func (c CryptoConfiguration) FIPSMode() bool {
+	return len(c.FIPSMarkers) > 0
+}
+
+// Annotations returns the configuration as source annotations.
+func (c CryptoConfiguration) Annotations() map[string]string {
+	annotations := make(map[string]string)
+	if c.FIPSMode() {
+		annotations[FIPSModeAnnotation] = "enabled"
+		annotations[FIPSMarkersAnnotation] = strings.Join(c.FIPSMarkers, ",")
+	}
+	if c.Policy != "" {
+		annotations[CryptoPolicyAnnotation] = c.Policy
+	}
+	if len(c.OpenSSLFIPSProviders) > 0 {
+		annotations[OpenSSLFIPSProviderAnnotation] = strings.Join(c.OpenSSLFIPSProviders, ",")
+	}
+	return annotations
+}
+
+// parseCryptoPolicy returns the policy from a crypto-policies config file, which holds a single policy name with any
+// number of sub-policies (e.g. "DEFAULT:SHA1") and may include comments.
+func parseCryptoPolicy(contents string) string {
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+func isFIPSPolicy(policy string) bool {
+	name, _, _ := strings.Cut(policy, ":")
+	return strings.EqualFold(name, "FIPS")
+}
+
+func enablesOpenSSLFIPSProvider(contents string) bool {
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if openSSLFIPSDirective.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func findOpenSSLFIPSProviders(resolver file.Resolver, logger logger.Logger) []string {
+	locations, err := resolver.FilesByGlob("**/ossl-modules/fips.so")
+	if err != nil {
+		logger.WithFields("error", err).Trace("unable to search for OpenSSL FIPS providers")
+		return nil
+	}
+
+	if len(locations) == 0 {
+		return nil
+	}
+
+	paths := strset.New()
+	for _, l := range locations {
+		// note: paths are relative when scanning a directory
+		paths.Add(path.Join("/", l.RealPath))
+	}
+	result := paths.List()
+	sort.Strings(result)
+	return result
+}
+
+// readFirst returns the contents of the first file found at the given path.
+func readFirst(resolver file.Resolver, logger logger.Logger, p string) (string, bool) {
+	locations, err := resolver.FilesByPath(p)
+	if err != nil {
+		logger.WithFields("error", err, "path", p).Trace("unable to get path")
+		return "", false
+	}
+	if len(locations) == 0 {
+		return "", false
+	}
+
+	contentReader, err := resolver.FileContentsByLocation(locations[0])
+	if err != nil {
+		logger.WithFields("error", err, "path", p).Trace("unable to get contents")
+		return "", false
+	}
+	defer internal.CloseAndLogError(contentReader, locations[0].AccessPath)
+
+	contents, err := io.ReadAll(contentReader)
+	if err != nil {
+		logger.WithFields("error", err, "path", p).Trace("unable to read contents")
+		return "", false
+	}
+	return string(contents), true
+}
@@ -0,0 +1,108 @@
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func TestIdentifyCryptoConfiguration(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		expected    *CryptoConfiguration
+		annotations map[string]string
+	}{
+		{
+			fixture: "test-fixtures/crypto/rhel-fips",
+			expected: &CryptoConfiguration{
+				FIPSMarkers: []string{
+					"/etc/system-fips",
+					"/etc/dracut.conf.d/40-fips.conf",
+					"/etc/crypto-policies/config",
+				},
+				Policy:               "FIPS:OSPP",
+				OpenSSLFIPSProviders: []string{"/usr/lib64/ossl-modules/fips.so"},
+			},
+			annotations: map[string]string{
+				FIPSModeAnnotation:            "enabled",
+				FIPSMarkersAnnotation:         "/etc/system-fips,/etc/dracut.conf.d/40-fips.conf,/etc/crypto-policies/config",
+				CryptoPolicyAnnotation:        "FIPS:OSPP",
+				OpenSSLFIPSProviderAnnotation: "/usr/lib64/ossl-modules/fips.so",
+			},
+		},
+		{
+			fixture: "test-fixtures/crypto/rhel-default",
+			expected: &CryptoConfiguration{
+				Policy: "DEFAULT:SHA1",
+			},
+			annotations: map[string]string{
+				CryptoPolicyAnnotation: "DEFAULT:SHA1",
+			},
+		},
+		{
+			fixture: "test-fixtures/crypto/openssl-fips",
+			expected: &CryptoConfiguration{
+				FIPSMarkers:          []string{"/etc/ssl/openssl.cnf"},
+				OpenSSLFIPSProviders: []string{"/usr/lib/ossl-modules/fips.so"},
+			},
+			annotations: map[string]string{
+				FIPSModeAnnotation:            "enabled",
+				FIPSMarkersAnnotation:         "/etc/ssl/openssl.cnf",
+				OpenSSLFIPSProviderAnnotation: "/usr/lib/ossl-modules/fips.so",
+			},
+		},
+		{
+			// the FIPS provider is installed, but not enabled within the OpenSSL configuration
+			fixture: "test-fixtures/crypto/openssl-provider-only",
+			expected: &CryptoConfiguration{
+				OpenSSLFIPSProviders: []string{"/usr/lib/x86_64-linux-gnu/ossl-modules/fips.so"},
+			},
+			annotations: map[string]string{
+				OpenSSLFIPSProviderAnnotation: "/usr/lib/x86_64-linux-gnu/ossl-modules/fips.so",
+			},
+		},
+		{
+			fixture:  "test-fixtures/crypto/none",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			s, err := directorysource.New(directorysource.Config{
+				Path: test.fixture,
+			})
+			require.NoError(t, err)
+
+			resolver, err := s.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			actual := IdentifyCryptoConfiguration(resolver)
+			assert.Equal(t, test.expected, actual)
+			if actual != nil {
+				assert.Equal(t, test.annotations, actual.Annotations())
+			}
+		})
+	}
+}
+
+func Test_parseCryptoPolicy(t *testing.T) {
+	tests := []struct {
+		contents string
+		want     string
+	}{
+		{contents: "DEFAULT\n", want: "DEFAULT"},
+		{contents: "# comment\n\n  FIPS:OSPP  # trailing\n", want: "FIPS:OSPP"},
+		{contents: "# only a comment\n", want: ""},
+		{contents: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contents, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseCryptoPolicy(tt.contents))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func TestIdentifyPackageRepositories(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		expected    *PackageRepositories
+		annotations map[string]string
+	}{
+		{
+			fixture: "test-fixtures/repositories/debian",
+			expected: &PackageRepositories{
+				Apt: []string{
+					"http://deb.debian.org/debian bullseye main",
+					"https://download.docker.com/linux/debian bullseye stable",
+					"https://apt.example.com/debian bullseye private",
+					"http://deb.debian.org/debian bookworm main",
+					"http://deb.debian.org/debian bookworm-updates main",
+					"https://deb.nodesource.com/node_20.x nodistro main",
+				},
+				Pip: []string{
+					"https://pypi.example.com/simple",
+					"https://pypi.internal.example.com/simple",
+					"https://download.pytorch.org/whl/cpu",
+				},
+				Npm: []string{
+					"https://npm.example.com/",
+					"https://registry.npmjs.org/",
+					"@myorg https://npm.pkg.github.com",
+				},
+			},
+			annotations: map[string]string{
+				AptRepositoriesAnnotation: "http://deb.debian.org/debian bullseye main,https://download.docker.com/linux/debian bullseye stable,https://apt.example.com/debian bullseye private,http://deb.debian.org/debian bookworm main,http://deb.debian.org/debian bookworm-updates main,https://deb.nodesource.com/node_20.x nodistro main",
+				PipRepositoriesAnnotation: "https://pypi.example.com/simple,https://pypi.internal.example.com/simple,https://download.pytorch.org/whl/cpu",
+				NpmRepositoriesAnnotation: "https://npm.example.com/,https://registry.npmjs.org/,@myorg https://npm.pkg.github.com",
+			},
+		},
+		{
+			fixture: "test-fixtures/repositories/rhel",
+			expected: &PackageRepositories{
+				Yum: []string{
+					"epel https://mirrors.fedoraproject.org/metalink?repo=epel-$releasever&arch=$basearch&infra=$infra&content=$contentdir",
+					"ubi-9-baseos-rpms https://cdn-ubi.redhat.com/content/public/ubi/dist/ubi9/9/$basearch/baseos/os",
+				},
+			},
+			annotations: map[string]string{
+				YumRepositoriesAnnotation: "epel https://mirrors.fedoraproject.org/metalink?repo=epel-$releasever&arch=$basearch&infra=$infra&content=$contentdir,ubi-9-baseos-rpms https://cdn-ubi.redhat.com/content/public/ubi/dist/ubi9/9/$basearch/baseos/os",
+			},
+		},
+		{
+			fixture: "test-fixtures/repositories/alpine",
+			expected: &PackageRepositories{
+				Apk: []string{
+					"https://dl-cdn.alpinelinux.org/alpine/v3.19/main",
+					"https://dl-cdn.alpinelinux.org/alpine/v3.19/community",
+					"@edge https://dl-cdn.alpinelinux.org/alpine/edge/main",
+				},
+				Pip: []string{
+					"https://pypi.org/simple",
+				},
+			},
+			annotations: map[string]string{
+				ApkRepositoriesAnnotation: "https://dl-cdn.alpinelinux.org/alpine/v3.19/main,https://dl-cdn.alpinelinux.org/alpine/v3.19/community,@edge https://dl-cdn.alpinelinux.org/alpine/edge/main",
+				PipRepositoriesAnnotation: "https://pypi.org/simple",
+			},
+		},
+		{
+			fixture:  "test-fixtures/repositories/none",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			s, err := directorysource.New(directorysource.Config{
+				Path: test.fixture,
+			})
+			require.NoError(t, err)
+
+			resolver, err := s.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			actual := IdentifyPackageRepositories(resolver)
+			assert.Equal(t, test.expected, actual)
+			if actual != nil {
+				assert.Equal(t, test.annotations, actual.Annotations())
+			}
+		})
+	}
+}
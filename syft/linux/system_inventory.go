@@ -0,0 +1,337 @@
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/go-logger"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+)
+
+// source annotations describing the system-level configuration inventory (see SystemInventory.Annotations)
+const (
+	// TZDataVersionAnnotation is the version of the timezone database (e.g. "2024a")
+	TZDataVersionAnnotation = "linux:tzdata:version"
+
+	// LocalesAnnotation lists the (comma separated) installed locales (e.g. "C.utf8,en_US.utf8")
+	LocalesAnnotation = "linux:locales"
+
+	// CABundlePathAnnotation is the path of the system CA trust bundle
+	CABundlePathAnnotation = "linux:ca-bundle:path"
+
+	// CABundleVersionAnnotation is the date of the Mozilla certificate data the CA trust bundle was generated from
+	CABundleVersionAnnotation = "linux:ca-bundle:version"
+
+	// CABundleCertificatesAnnotation is the number of certificates within the CA trust bundle
+	CABundleCertificatesAnnotation = "linux:ca-bundle:certificates"
+)
+
+const (
+	localeArchivePath = "/usr/lib/locale/locale-archive"
+
+	// localeArchiveMagic identifies a glibc locale archive (see locale/locarchive.h within glibc)
+	localeArchiveMagic = 0xde020109
+
+	// maxLocaleArchiveEntries bounds the size of the name hash table that is read (a full archive of all locales
+	// supported by glibc has less than 1000 entries)
+	maxLocaleArchiveEntries = 1 << 16
+)
+
+// tzdataVersionPaths are the files holding the version of the timezone database, where tzdata.zi starts with a
+// "# version 2024a" comment and +VERSION holds only the version.
+var tzdataVersionPaths = []string{
+	"/usr/share/zoneinfo/tzdata.zi",
+	"/usr/share/zoneinfo/+VERSION",
+}
+
+// caBundlePaths are the common locations of the system CA trust bundle across distributions (matching the locations
+// searched by the Go standard library).
+var caBundlePaths = []string{
+	"/etc/ssl/certs/ca-certificates.crt",                // Debian/Ubuntu/Gentoo etc.
+	"/etc/pki/tls/certs/ca-bundle.crt",                  // Fedora/RHEL 6
+	"/etc/ssl/ca-bundle.pem",                            // OpenSUSE
+	"/etc/pki/tls/cacert.pem",                           // OpenELEC
+	"/etc/pki/ca-trust/extracted/pem/tls-ca-bundle.pem", // CentOS/RHEL 7
+	"/etc/ssl/cert.pem",                                 // Alpine Linux
+}
+
+// tzdataVersionExp matches the version comment at the start of the tzdata.zi file (e.g. "# version 2024a").
+var tzdataVersionExp = regexp.MustCompile(`^#\s*version\s+(\S+)`)
+
+// caBundleVersionExp matches the header of bundles generated from the Mozilla certificate data (e.g. by curl's
+// mk-ca-bundle), for example "## Certificate data from Mozilla as of: Tue Jan 10 04:12:06 2023 GMT".
+var caBundleVersionExp = regexp.MustCompile(`(?m)^##\s*Certificate data from Mozilla (?:as of|last updated on):\s*(.+?)\s*$`)
+
+// SystemInventory represents the system-level configuration of a linux distribution that is not described by
+// packages (such as the timezone database, installed locales, and the CA trust bundle).
+type SystemInventory struct {
+	// TZDataVersion is the version of the timezone database (e.g. "2024a").
+	TZDataVersion string
+
+	// Locales are the names of the installed (compiled) locales.
+	Locales []string
+
+	// CABundle is the system CA trust bundle.
+	CABundle *CABundle
+}
+
+// CABundle represents the system CA trust bundle.
+type CABundle struct {
+	// Path is the path of the bundle.
+	Path string
+
+	// Version is the date of the Mozilla certificate data the bundle was generated from, which is only known for
+	// bundles that have the header written by curl's mk-ca-bundle.
+	Version string
+
+	// Certificates is the number of certificates within the bundle.
+	Certificates int
+}
+
+// IdentifySystemInventory discovers the system-level configuration inventory (the timezone database version, the
+// installed locales, and the CA trust bundle). Nil is returned if nothing was found.
+func IdentifySystemInventory(resolver file.Resolver) *SystemInventory {
+	logger := log.Nested("operation", "identify-system-inventory")
+
+	inventory := SystemInventory{
+		TZDataVersion: findTZDataVersion(resolver, logger),
+		Locales:       findLocales(resolver, logger),
+		CABundle:      findCABundle(resolver, logger),
+	}
+
+	if inventory.TZDataVersion == "" && len(inventory.Locales) == 0 && inventory.CABundle == nil {
+		return nil
+	}
+
+	return &inventory
+}
+
+// Annotations returns the inventory as source annotations.
+func (i SystemInventory) Annotations() map[string]string {
+	annotations := make(map[string]string)
+	if i.TZDataVersion != "" {
+		annotations[TZDataVersionAnnotation] = i.TZDataVersion
+	}
+	if len(i.Locales) > 0 {
+		annotations[LocalesAnnotation] = strings.Join(i.Locales, ",")
+	}
+	if i.CABundle != nil {
+		annotations[CABundlePathAnnotation] = i.CABundle.Path
+		annotations[CABundleCertificatesAnnotation] = strconv.Itoa(i.CABundle.Certificates)
+		if i.CABundle.Version != "" {
+			annotations[CABundleVersionAnnotation] = i.CABundle.Version
+		}
+	}
+	return annotations
+}
+
+func findTZDataVersion(resolver file.Resolver, logger logger.Logger) string {
+	for _, p := range tzdataVersionPaths {
+		contents, found := readFirst(resolver, logger, p)
+		if !found {
+			continue
+		}
+		if version := parseTZDataVersion(contents); version != "" {
+			return version
+		}
+	}
+	return ""
+}
+
+func parseTZDataVersion(contents string) string {
+	line, _, _ := strings.Cut(contents, "\n")
+	line = strings.TrimSpace(line)
+	if match := tzdataVersionExp.FindStringSubmatch(line); match != nil {
+		return match[1]
+	}
+	if strings.HasPrefix(line, "#") || strings.ContainsAny(line, " \t") {
+		return ""
+	}
+	// the +VERSION file
+	return line
+}
+
+// findLocales returns the locales found within the glibc locale archive, compiled locale directories (e.g. from
+// Debian's locales-all or C.utf8), and musl-locales (on Alpine).
+func findLocales(resolver file.Resolver, logger logger.Logger) []string {
+	locales := strset.New()
+
+	locales.Add(readLocaleArchive(resolver, logger)...)
+
+	for _, glob := range []string{"**/usr/lib/locale/*/LC_CTYPE", "**/usr/share/i18n/locales/musl/*"} {
+		locations, err := resolver.FilesByGlob(glob)
+		if err != nil {
+			logger.WithFields("error", err, "glob", glob).Trace("unable to search for locales")
+			continue
+		}
+		for _, l := range locations {
+			if strings.HasSuffix(l.RealPath, "LC_CTYPE") {
+				locales.Add(path.Base(path.Dir(l.RealPath)))
+				continue
+			}
+			locales.Add(path.Base(l.RealPath))
+		}
+	}
+
+	if locales.Size() == 0 {
+		return nil
+	}
+
+	result := locales.List()
+	sort.Strings(result)
+	return result
+}
+
+func readLocaleArchive(resolver file.Resolver, logger logger.Logger) []string {
+	locations, err := resolver.FilesByPath(localeArchivePath)
+	if err != nil || len(locations) == 0 {
+		return nil
+	}
+
+	contentReader, err := resolver.FileContentsByLocation(locations[0])
+	if err != nil {
+		logger.WithFields("error", err, "path", localeArchivePath).Trace("unable to get contents")
+		return nil
+	}
+	defer internal.CloseAndLogError(contentReader, locations[0].AccessPath)
+
+	// note: a locale archive is commonly large (over 100 MB when holding all locales), however, only the name hash
+	// table and the names are needed
+	reader, err := unionreader.GetUnionReader(contentReader)
+	if err != nil {
+		logger.WithFields("error", err, "path", localeArchivePath).Trace("unable to read contents")
+		return nil
+	}
+
+	names, err := parseLocaleArchive(reader)
+	if err != nil {
+		logger.WithFields("error", err, "path", localeArchivePath).Trace("unable to parse locale archive")
+		return nil
+	}
+	return names
+}
+
+// parseLocaleArchive returns the names of the locales within a glibc locale archive, which starts with a header of
+// 32-bit values (in the byte order of the system) followed by a hash table of names, where each entry is the hash
+// value, the offset of the name, and the offset of the locale record (empty entries have no name offset).
+func parseLocaleArchive(r io.ReaderAt) ([]string, error) {
+	header := make([]uint32, 14)
+	buf := make([]byte, len(header)*4)
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return nil, err
+	}
+
+	var order binary.ByteOrder
+	switch {
+	case binary.LittleEndian.Uint32(buf) == localeArchiveMagic:
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(buf) == localeArchiveMagic:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("not a locale archive")
+	}
+	for i := range header {
+		header[i] = order.Uint32(buf[i*4:])
+	}
+
+	namehashOffset, namehashSize := int64(header[2]), header[4]
+	if namehashSize > maxLocaleArchiveEntries {
+		return nil, fmt.Errorf("too many locale archive entries: %d", namehashSize)
+	}
+
+	table := make([]byte, namehashSize*12)
+	if _, err := r.ReadAt(table, namehashOffset); err != nil {
+		return nil, fmt.Errorf("unable to read name hash table: %w", err)
+	}
+
+	var names []string
+	for i := 0; i < int(namehashSize); i++ {
+		nameOffset := order.Uint32(table[i*12+4:])
+		if nameOffset == 0 {
+			continue
+		}
+		name, err := readCString(r, int64(nameOffset))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read locale name: %w", err)
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// readCString reads a null-terminated string at the given offset (locale names are short, so this is bounded).
+func readCString(r io.ReaderAt, offset int64) (string, error) {
+	buf := make([]byte, 256)
+	n, err := r.ReadAt(buf, offset)
+	if n == 0 && err != nil {
+		return "", err
+	}
+	name, _, found := bytes.Cut(buf[:n], []byte{0})
+	if !found {
+		return "", fmt.Errorf("unterminated name at offset %d", offset)
+	}
+	return string(name), nil
+}
+
+// findCABundle returns the first system CA trust bundle found, if any.
+func findCABundle(resolver file.Resolver, logger logger.Logger) *CABundle {
+	for _, p := range caBundlePaths {
+		contents, found := readFirst(resolver, logger, p)
+		if !found {
+			continue
+		}
+		bundle := parseCABundle([]byte(contents))
+		bundle.Path = p
+		return &bundle
+	}
+	return nil
+}
+
+func parseCABundle(contents []byte) CABundle {
+	var bundle CABundle
+
+	// the header is at the start of the bundle, before any certificates
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	var header strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "-----BEGIN") {
+			break
+		}
+		header.WriteString(line + "\n")
+	}
+	if match := caBundleVersionExp.FindStringSubmatch(header.String()); match != nil {
+		bundle.Version = match[1]
+	}
+
+	rest := contents
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE", "TRUSTED CERTIFICATE":
+			bundle.Certificates++
+		}
+	}
+
+	return bundle
+}
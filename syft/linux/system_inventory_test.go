@@ -0,0 +1,102 @@
+package linux
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+)
+
+func TestIdentifySystemInventory(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		expected    *SystemInventory
+		annotations map[string]string
+	}{
+		{
+			fixture: "test-fixtures/system/debian",
+			expected: &SystemInventory{
+				TZDataVersion: "2024a",
+				Locales:       []string{"C.utf8", "de_DE.utf8", "en_US.utf8"},
+				CABundle: &CABundle{
+					Path:         "/etc/ssl/certs/ca-certificates.crt",
+					Certificates: 2,
+				},
+			},
+			annotations: map[string]string{
+				TZDataVersionAnnotation:        "2024a",
+				LocalesAnnotation:              "C.utf8,de_DE.utf8,en_US.utf8",
+				CABundlePathAnnotation:         "/etc/ssl/certs/ca-certificates.crt",
+				CABundleCertificatesAnnotation: "2",
+			},
+		},
+		{
+			fixture: "test-fixtures/system/alpine",
+			expected: &SystemInventory{
+				TZDataVersion: "2023c",
+				Locales:       []string{"de_DE.UTF-8", "en_US.UTF-8"},
+				CABundle: &CABundle{
+					Path:         "/etc/ssl/cert.pem",
+					Version:      "Tue Jan 10 04:12:06 2023 GMT",
+					Certificates: 2,
+				},
+			},
+			annotations: map[string]string{
+				TZDataVersionAnnotation:        "2023c",
+				LocalesAnnotation:              "de_DE.UTF-8,en_US.UTF-8",
+				CABundlePathAnnotation:         "/etc/ssl/cert.pem",
+				CABundleVersionAnnotation:      "Tue Jan 10 04:12:06 2023 GMT",
+				CABundleCertificatesAnnotation: "2",
+			},
+		},
+		{
+			fixture:  "test-fixtures/system/none",
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			s, err := directorysource.New(directorysource.Config{
+				Path: test.fixture,
+			})
+			require.NoError(t, err)
+
+			resolver, err := s.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			actual := IdentifySystemInventory(resolver)
+			assert.Equal(t, test.expected, actual)
+			if actual != nil {
+				assert.Equal(t, test.annotations, actual.Annotations())
+			}
+		})
+	}
+}
+
+func Test_parseTZDataVersion(t *testing.T) {
+	tests := []struct {
+		contents string
+		want     string
+	}{
+		{contents: "# version 2024a\n# This zic input file is in the public domain.\n", want: "2024a"},
+		{contents: "2023c\n", want: "2023c"},
+		{contents: "# some other comment\n", want: ""},
+		{contents: "R d 1916 o - Jun 14 24 1 S\n", want: ""},
+		{contents: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contents, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseTZDataVersion(tt.contents))
+		})
+	}
+}
+
+func Test_parseLocaleArchive_notAnArchive(t *testing.T) {
+	_, err := parseLocaleArchive(bytes.NewReader(bytes.Repeat([]byte{0x01}, 64)))
+	require.Error(t, err)
+}
@@ -53,3 +53,46 @@ func (m AlpmDBEntry) OwnedFiles() (result []string) {
 	sort.Strings(result)
 	return result
 }
+
+// AlpmSourceEntry represents an Arch Linux build recipe (e.g. within an AUR repository), as described by either a
+// PKGBUILD or the .SRCINFO generated from it.
+type AlpmSourceEntry struct {
+	BasePackage string `json:"basepackage"`
+
+	// Version is the full version of the packages built from the recipe ("[epoch:]pkgver-pkgrel").
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+
+	Architectures []string `json:"architectures,omitempty"`
+
+	// Packages are the names of the packages built from the recipe (pkgname).
+	Packages []string `json:"packages,omitempty"`
+
+	Depends      []string `json:"depends,omitempty"`
+	MakeDepends  []string `json:"makedepends,omitempty"`
+	CheckDepends []string `json:"checkdepends,omitempty"`
+
+	// Sources are the sources of the recipe, which are URLs (optionally with a "name::" prefix and VCS fragment) or
+	// file names.
+	Sources []string `json:"sources,omitempty"`
+}
+
+// AlpmDependencyEntry represents a single dependency declared by an Arch Linux build recipe.
+type AlpmDependencyEntry struct {
+	// Kind is the array the dependency was declared in ("depends", "makedepends" or "checkdepends").
+	Kind string `json:"kind"`
+
+	// VersionConstraint is the version relation that the dependency must satisfy (e.g. ">=1.2").
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+}
+
+// AlpmSourceArchiveEntry represents a pinned source of an Arch Linux build recipe: either an archive where the name
+// and version are derived from the file name, or a VCS source pinned to a tag or commit.
+type AlpmSourceArchiveEntry struct {
+	// Source is the source as declared by the recipe.
+	Source string `json:"source"`
+
+	// Digest is the checksum of the source as declared by the recipe (e.g. within sha256sums).
+	Digest *file.Digest `json:"digest,omitempty"`
+}
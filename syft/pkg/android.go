@@ -0,0 +1,32 @@
+package pkg
+
+// AndroidAppEntry represents an Android application package (APK) or Android App Bundle (AAB) with the metadata
+// found within its manifest (AndroidManifest.xml).
+type AndroidAppEntry struct {
+	// Format is the packaging format of the application: an "apk" or an "aab" (App Bundle).
+	Format string `json:"format"`
+
+	// PackageName is the unique application ID of the app (e.g. "com.example.app").
+	PackageName string `json:"packageName"`
+
+	// VersionCode is the internal version number of the app, used by app stores to determine upgrades.
+	VersionCode int64 `json:"versionCode,omitempty"`
+
+	// VersionName is the version shown to users (e.g. "1.2.3").
+	VersionName string `json:"versionName,omitempty"`
+
+	// MinSDKVersion is the minimum API level required for the app to run.
+	MinSDKVersion string `json:"minSdkVersion,omitempty"`
+
+	// TargetSDKVersion is the API level the app targets.
+	TargetSDKVersion string `json:"targetSdkVersion,omitempty"`
+
+	// Permissions are the permissions requested by the app (from uses-permission elements).
+	Permissions []string `json:"permissions,omitempty"`
+
+	// DexFiles are the paths of the Dalvik executables within the archive (e.g. "classes.dex").
+	DexFiles []string `json:"dexFiles,omitempty"`
+
+	// NativeLibraries are the paths of the native shared libraries within the archive (e.g. "lib/arm64-v8a/libfoo.so").
+	NativeLibraries []string `json:"nativeLibraries,omitempty"`
+}
@@ -0,0 +1,68 @@
+package pkg
+
+const (
+	// AnsibleCollectionRequirement is a collection listed within an Ansible Galaxy requirements file.
+	AnsibleCollectionRequirement = "collection"
+
+	// AnsibleRoleRequirement is a role listed within an Ansible Galaxy requirements file.
+	AnsibleRoleRequirement = "role"
+)
+
+// AnsibleCollectionManifest represents the collection information of an installed Ansible collection (from
+// ansible_collections/<namespace>/<name>/MANIFEST.json).
+type AnsibleCollectionManifest struct {
+	// Namespace is the Galaxy namespace the collection is published under (e.g. "community").
+	Namespace string `json:"namespace"`
+
+	// Authors is the list of authors of the collection.
+	Authors []string `json:"authors,omitempty"`
+
+	// Description is the short description of the collection.
+	Description string `json:"description,omitempty"`
+
+	// Repository is the URL of the source repository of the collection.
+	Repository string `json:"repository,omitempty"`
+
+	// Dependencies is the collections required by this collection, keyed by fully qualified collection name, with
+	// the version constraint as the value.
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// AnsibleRoleMeta represents the Galaxy information of an installed Ansible role (from meta/main.yml), along with the
+// install information recorded by ansible-galaxy (from meta/.galaxy_install_info).
+type AnsibleRoleMeta struct {
+	// Namespace is the Galaxy namespace the role is published under.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Author is the author of the role.
+	Author string `json:"author,omitempty"`
+
+	// Description is the short description of the role.
+	Description string `json:"description,omitempty"`
+
+	// MinAnsibleVersion is the minimum version of Ansible the role supports.
+	MinAnsibleVersion string `json:"minAnsibleVersion,omitempty"`
+
+	// Dependencies is the names of the roles this role depends on.
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// InstallDate is when ansible-galaxy installed the role.
+	InstallDate string `json:"installDate,omitempty"`
+}
+
+// AnsibleRequirementsEntry represents a single role or collection within an Ansible Galaxy requirements file
+// (requirements.yml).
+type AnsibleRequirementsEntry struct {
+	// Kind is whether the entry is a role or a collection.
+	Kind string `json:"kind"`
+
+	// Source is where the role or collection is installed from, when not the default Galaxy server (e.g. a git
+	// repository URL or a Galaxy server URL).
+	Source string `json:"source,omitempty"`
+
+	// SourceType is the kind of source (e.g. "galaxy", "git", "url", or "file"), when given.
+	SourceType string `json:"sourceType,omitempty"`
+
+	// VersionConstraint is the version (or version range for collections) that is required, as given.
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+}
@@ -0,0 +1,33 @@
+package pkg
+
+// APIServiceEntry represents a service described by an API specification file: an OpenAPI (or Swagger) document, a
+// protobuf file declaring a gRPC service, or a GraphQL schema.
+type APIServiceEntry struct {
+	// Specification is the kind of document the service is described by: "openapi", "swagger", "grpc", or "graphql".
+	Specification string `json:"specification" cyclonedx:"specification"`
+
+	// SpecificationVersion is the version of the specification format (e.g. "3.0.3" for OpenAPI or "proto3" for gRPC).
+	SpecificationVersion string `json:"specificationVersion,omitempty" cyclonedx:"specificationVersion"`
+
+	Description string `json:"description,omitempty"`
+
+	// Endpoints are the URL paths (OpenAPI), method paths (gRPC, e.g. "/helloworld.Greeter/SayHello") or root
+	// operation fields (GraphQL, e.g. "query.user") exposed by the service.
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// Authenticated indicates if the service requires authentication (only known for OpenAPI documents which declare
+	// security requirements).
+	Authenticated *bool `json:"authenticated,omitempty"`
+
+	// DataFlows are the types of data sent to and returned by the service.
+	DataFlows []APIServiceDataFlow `json:"dataFlows,omitempty"`
+}
+
+// APIServiceDataFlow represents a type of data exchanged with a service.
+type APIServiceDataFlow struct {
+	// Flow is the direction of the data relative to the service: "inbound", "outbound", or "bi-directional".
+	Flow string `json:"flow"`
+
+	// Classification is the name of the type of the data (e.g. the schema, message or input type name).
+	Classification string `json:"classification"`
+}
@@ -0,0 +1,35 @@
+package pkg
+
+// BazelModuleEntry represents a single Bazel module dependency, either declared with bazel_dep() in a MODULE.bazel
+// file or resolved within a MODULE.bazel.lock file.
+type BazelModuleEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// RepoName is the apparent repository name the module is referred to by (if different from the module name).
+	RepoName string `json:"repoName,omitempty"`
+
+	// Registry is the URL of the registry the module is resolved from (e.g. https://bcr.bazel.build).
+	Registry string `json:"registry,omitempty"`
+
+	// Integrity is the subresource integrity value of the module source archive.
+	Integrity string `json:"integrity,omitempty"`
+
+	// DevDependency indicates the dependency is only used when the module is the root module.
+	DevDependency bool `json:"devDependency,omitempty"`
+
+	// Override describes where the module is sourced from when not resolved from a registry.
+	Override *BazelModuleOverride `json:"override,omitempty"`
+}
+
+// BazelModuleOverride represents a git_override(), archive_override(), or local_path_override() for a Bazel module.
+type BazelModuleOverride struct {
+	// Type is the kind of override: "git", "archive", or "local_path".
+	Type      string   `json:"type"`
+	Remote    string   `json:"remote,omitempty"`
+	Commit    string   `json:"commit,omitempty"`
+	Tag       string   `json:"tag,omitempty"`
+	URLs      []string `json:"urls,omitempty"`
+	Integrity string   `json:"integrity,omitempty"`
+	Path      string   `json:"path,omitempty"`
+}
@@ -0,0 +1,35 @@
+package pkg
+
+// browser families of extensions (see BrowserExtensionEntry.Browser)
+const (
+	ChromiumBrowser = "chromium"
+	FirefoxBrowser  = "firefox"
+)
+
+// BrowserExtensionEntry is a struct that represents an extension installed to a browser profile, as described by the
+// manifest.json file of an unpacked Chromium-based browser extension or the extensions.json file of a Firefox profile.
+type BrowserExtensionEntry struct {
+	// Browser is the family of browsers the extension is installed to ("chromium" for Chrome, Chromium, Edge, and
+	// Brave, or "firefox").
+	Browser string `mapstructure:"browser" json:"browser"`
+
+	// ExtensionID is the identifier of the extension (e.g. "cjpalhdlnbpafiamejdnhcphjbkeiagm" for Chromium-based
+	// browsers or "uBlock0@raymondhill.net" for Firefox).
+	ExtensionID string `mapstructure:"extensionId" json:"extensionId"`
+
+	// Profile is the name of the browser profile directory the extension is installed to (e.g. "Default").
+	Profile string `mapstructure:"profile" json:"profile,omitempty"`
+
+	Description string `mapstructure:"description" json:"description,omitempty"`
+	Author      string `mapstructure:"author" json:"author,omitempty"`
+	Homepage    string `mapstructure:"homepage" json:"homepage,omitempty"`
+
+	// ManifestVersion is the version of the extension manifest format (e.g. 2 or 3).
+	ManifestVersion int `mapstructure:"manifestVersion" json:"manifestVersion,omitempty"`
+
+	// UpdateURL is the URL the browser checks for updates of the extension (the web store unless self-hosted).
+	UpdateURL string `mapstructure:"updateUrl" json:"updateUrl,omitempty"`
+
+	// Disabled indicates the extension is installed but not active.
+	Disabled bool `mapstructure:"disabled" json:"disabled,omitempty"`
+}
@@ -0,0 +1,30 @@
+package pkg
+
+// BuckDependencyEntry represents a single third-party dependency of a Buck2 project, either fetched by a remote rule
+// (e.g. http_archive() or remote_file()) within a BUCK file or an external cell (e.g. the prelude) pinned within a
+// .buckconfig file.
+type BuckDependencyEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// Rule is the kind of rule the dependency is fetched with (e.g. "http_archive", "remote_file", "git_fetch", or "external_cell").
+	Rule string `json:"rule"`
+
+	// Target is the name of the Buck2 target (or cell) that fetches the dependency.
+	Target string `json:"target,omitempty"`
+
+	// URLs are the locations the dependency is downloaded from.
+	URLs []string `json:"urls,omitempty"`
+
+	// SHA256 is the expected digest of the downloaded content.
+	SHA256 string `json:"sha256,omitempty"`
+
+	// SHA1 is the expected digest of the downloaded content (used by remote_file() when no sha256 is given).
+	SHA1 string `json:"sha1,omitempty"`
+
+	// Repository is the git remote the dependency is cloned from.
+	Repository string `json:"repository,omitempty"`
+
+	// Commit is the git revision the dependency is pinned to.
+	Commit string `json:"commit,omitempty"`
+}
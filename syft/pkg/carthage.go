@@ -0,0 +1,31 @@
+package pkg
+
+// CarthageCartfileResolvedEntry represents a single dependency pinned within a Carthage Cartfile.resolved file.
+type CarthageCartfileResolvedEntry struct {
+	// Origin is the kind of dependency: "github", "git", or "binary".
+	Origin string `json:"origin"`
+
+	// Source is the GitHub repository (e.g. "Alamofire/Alamofire"), git repository URL, or binary project specification URL.
+	Source string `json:"source"`
+}
+
+// CarthageBuildVersionEntry represents a dependency built into the Carthage/Build directory, as described by its
+// Carthage/Build/.<name>.version file.
+type CarthageBuildVersionEntry struct {
+	// Commitish is the tag, branch, or commit the dependency was built from.
+	Commitish string `json:"commitish"`
+
+	// Frameworks are the frameworks built for each platform.
+	Frameworks []CarthageFramework `json:"frameworks,omitempty"`
+}
+
+// CarthageFramework represents a single framework built by Carthage for a platform.
+type CarthageFramework struct {
+	Name string `json:"name"`
+
+	// Platform is the platform the framework was built for (e.g. "iOS", "Mac", "tvOS", "watchOS").
+	Platform string `json:"platform"`
+
+	// Hash is the sha256 digest of the framework binary.
+	Hash string `json:"hash,omitempty"`
+}
@@ -0,0 +1,249 @@
+package android
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+)
+
+// chunk types of the Android binary XML format (see ResourceTypes.h within the Android framework)
+const (
+	xmlChunkType          = 0x0003
+	stringPoolChunkType   = 0x0001
+	resourceMapChunkType  = 0x0180
+	startElementChunkType = 0x0102
+
+	// the string pool is UTF-8 encoded (instead of UTF-16) when this flag is set
+	utf8StringPoolFlag = 0x100
+)
+
+// types of typed attribute values
+const (
+	stringValueType     = 0x03
+	intDecimalValueType = 0x10
+	intHexValueType     = 0x11
+	booleanValueType    = 0x12
+)
+
+var errTruncated = errors.New("unexpected end of data")
+
+// decodeBinaryXML returns the elements (in document order) of an XML document in the Android binary XML format, as
+// found within APKs (e.g. the compiled AndroidManifest.xml). Only the element names and attribute values are kept,
+// the hierarchy of the document is not.
+func decodeBinaryXML(data []byte) ([]element, error) {
+	if len(data) < 8 || binary.LittleEndian.Uint16(data) != xmlChunkType {
+		return nil, errors.New("not an Android binary XML document")
+	}
+
+	headerSize := int(binary.LittleEndian.Uint16(data[2:]))
+	size := int(binary.LittleEndian.Uint32(data[4:]))
+	if size > len(data) || headerSize > size {
+		return nil, errTruncated
+	}
+
+	var (
+		strings     []string
+		resourceIDs []uint32
+		elements    []element
+	)
+
+	for offset := headerSize; offset+8 <= size; {
+		chunkType := binary.LittleEndian.Uint16(data[offset:])
+		chunkHeaderSize := int(binary.LittleEndian.Uint16(data[offset+2:]))
+		chunkSize := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		if chunkSize < 8 || chunkHeaderSize > chunkSize || offset+chunkSize > size {
+			return nil, errTruncated
+		}
+		chunk := data[offset : offset+chunkSize]
+
+		var err error
+		switch chunkType {
+		case stringPoolChunkType:
+			strings, err = decodeStringPool(chunk, chunkHeaderSize)
+		case resourceMapChunkType:
+			resourceIDs = decodeResourceMap(chunk, chunkHeaderSize)
+		case startElementChunkType:
+			var e *element
+			e, err = decodeStartElement(chunk, chunkHeaderSize, strings, resourceIDs)
+			if e != nil {
+				elements = append(elements, *e)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		offset += chunkSize
+	}
+
+	return elements, nil
+}
+
+func decodeStringPool(chunk []byte, headerSize int) ([]string, error) {
+	if headerSize < 28 {
+		return nil, errTruncated
+	}
+
+	count := int(binary.LittleEndian.Uint32(chunk[8:]))
+	flags := binary.LittleEndian.Uint32(chunk[16:])
+	stringsStart := int(binary.LittleEndian.Uint32(chunk[20:]))
+	if headerSize+count*4 > len(chunk) || stringsStart > len(chunk) {
+		return nil, errTruncated
+	}
+
+	strings := make([]string, count)
+	for i := range strings {
+		offset := stringsStart + int(binary.LittleEndian.Uint32(chunk[headerSize+i*4:]))
+		if offset >= len(chunk) {
+			return nil, errTruncated
+		}
+
+		var (
+			s   string
+			err error
+		)
+		if flags&utf8StringPoolFlag != 0 {
+			s, err = decodeUTF8String(chunk[offset:])
+		} else {
+			s, err = decodeUTF16String(chunk[offset:])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read string %d: %w", i, err)
+		}
+		strings[i] = s
+	}
+
+	return strings, nil
+}
+
+// decodeUTF8String reads a string of a UTF-8 string pool, which is prefixed by both the UTF-16 length (in characters)
+// and the UTF-8 length (in bytes) of the string.
+func decodeUTF8String(data []byte) (string, error) {
+	_, n := utf8Length(data)
+	if n == 0 {
+		return "", errTruncated
+	}
+	length, m := utf8Length(data[n:])
+	if m == 0 || n+m+length > len(data) {
+		return "", errTruncated
+	}
+	return string(data[n+m : n+m+length]), nil
+}
+
+// utf8Length reads a length of a UTF-8 string pool entry, which takes up two bytes when the high bit is set.
+func utf8Length(data []byte) (int, int) {
+	if len(data) < 1 {
+		return 0, 0
+	}
+	if data[0]&0x80 == 0 {
+		return int(data[0]), 1
+	}
+	if len(data) < 2 {
+		return 0, 0
+	}
+	return int(data[0]&0x7f)<<8 | int(data[1]), 2
+}
+
+// decodeUTF16String reads a string of a UTF-16 string pool, which is prefixed by the length of the string (in code
+// units), taking up two code units when the high bit is set.
+func decodeUTF16String(data []byte) (string, error) {
+	if len(data) < 2 {
+		return "", errTruncated
+	}
+	length := int(binary.LittleEndian.Uint16(data))
+	start := 2
+	if length&0x8000 != 0 {
+		if len(data) < 4 {
+			return "", errTruncated
+		}
+		length = (length&0x7fff)<<16 | int(binary.LittleEndian.Uint16(data[2:]))
+		start = 4
+	}
+	if start+length*2 > len(data) {
+		return "", errTruncated
+	}
+
+	units := make([]uint16, length)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(data[start+i*2:])
+	}
+	return string(utf16.Decode(units)), nil
+}
+
+// decodeResourceMap reads the resource IDs of the attribute names, where each ID corresponds to the string of the
+// same index within the string pool.
+func decodeResourceMap(chunk []byte, headerSize int) []uint32 {
+	ids := make([]uint32, 0, (len(chunk)-headerSize)/4)
+	for offset := headerSize; offset+4 <= len(chunk); offset += 4 {
+		ids = append(ids, binary.LittleEndian.Uint32(chunk[offset:]))
+	}
+	return ids
+}
+
+func decodeStartElement(chunk []byte, headerSize int, strings []string, resourceIDs []uint32) (*element, error) {
+	// after the node header: namespace, name, attribute start, attribute size, and attribute count
+	if headerSize+14 > len(chunk) {
+		return nil, errTruncated
+	}
+	ext := chunk[headerSize:]
+
+	e := element{
+		name:       stringAt(strings, binary.LittleEndian.Uint32(ext[4:])),
+		attributes: make(map[string]string),
+	}
+
+	attributeStart := int(binary.LittleEndian.Uint16(ext[8:]))
+	attributeSize := int(binary.LittleEndian.Uint16(ext[10:]))
+	attributeCount := int(binary.LittleEndian.Uint16(ext[12:]))
+	if attributeSize < 20 {
+		return nil, fmt.Errorf("invalid attribute size: %d", attributeSize)
+	}
+	if attributeStart+attributeCount*attributeSize > len(ext) {
+		return nil, errTruncated
+	}
+
+	for i := 0; i < attributeCount; i++ {
+		attr := ext[attributeStart+i*attributeSize:]
+		nameIndex := binary.LittleEndian.Uint32(attr[4:])
+
+		var resourceID uint32
+		if int(nameIndex) < len(resourceIDs) {
+			resourceID = resourceIDs[nameIndex]
+		}
+		name := attributeName(resourceID, stringAt(strings, nameIndex))
+		if name == "" {
+			continue
+		}
+
+		rawValue := binary.LittleEndian.Uint32(attr[8:])
+		dataType := attr[15]
+		value := binary.LittleEndian.Uint32(attr[16:])
+
+		switch dataType {
+		case stringValueType:
+			e.attributes[name] = stringAt(strings, value)
+		case intDecimalValueType, intHexValueType:
+			e.attributes[name] = strconv.FormatInt(int64(int32(value)), 10)
+		case booleanValueType:
+			e.attributes[name] = strconv.FormatBool(value != 0)
+		default:
+			// references to resources (e.g. "@string/version") cannot be resolved without the resource table, however,
+			// the raw value may still be present
+			if s := stringAt(strings, rawValue); s != "" {
+				e.attributes[name] = s
+			}
+		}
+	}
+
+	return &e, nil
+}
+
+// stringAt returns the string at the given index of the string pool (where 0xffffffff denotes no string).
+func stringAt(strings []string, index uint32) string {
+	if int64(index) >= int64(len(strings)) {
+		return ""
+	}
+	return strings[index]
+}
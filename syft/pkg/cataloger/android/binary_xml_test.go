@@ -0,0 +1,67 @@
+package android
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_decodeBinaryXML(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		data    []byte
+		want    []element
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			name:    "UTF-16 string pool",
+			fixture: "test-fixtures/manifest/utf16.xml",
+			want: []element{
+				{
+					name: "manifest",
+					attributes: map[string]string{
+						"package":     "org.example.utf16",
+						"versionCode": "3",
+						"versionName": "3.0-béta",
+					},
+				},
+				{
+					name: "uses-sdk",
+					attributes: map[string]string{
+						"minSdkVersion": "26",
+					},
+				},
+			},
+		},
+		{
+			name:    "plain text XML",
+			data:    []byte(`<?xml version="1.0" encoding="utf-8"?><manifest package="com.example"/>`),
+			wantErr: require.Error,
+		},
+		{
+			name:    "truncated",
+			data:    []byte{0x03, 0x00, 0x08, 0x00, 0xff, 0x00, 0x00, 0x00},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			data := tt.data
+			if tt.fixture != "" {
+				var err error
+				data, err = os.ReadFile(tt.fixture)
+				require.NoError(t, err)
+			}
+
+			got, err := decodeBinaryXML(data)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package android provides a concrete Cataloger implementation for Android applications (APKs and App Bundles).
+*/
+package android
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for Android applications, describing each APK or App Bundle by its
+// manifest along with the libraries bundled within the app.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("android-app-cataloger").
+		WithParserByGlobs(parseAndroidApp, "**/*.apk", "**/*.aab")
+}
@@ -0,0 +1,17 @@
+package android
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"out/app.aab",
+			"sdcard/app.apk",
+		}).
+		TestCataloger(t, NewCataloger())
+}
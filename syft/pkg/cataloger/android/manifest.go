@@ -0,0 +1,63 @@
+package android
+
+import (
+	"strconv"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// resource IDs of the framework attributes of interest within the manifest, which identify attributes even when the
+// attribute names have been stripped (or obfuscated) from the binary XML string pool
+var frameworkAttributes = map[uint32]string{
+	0x01010003: "name",
+	0x0101020c: "minSdkVersion",
+	0x0101021b: "versionCode",
+	0x0101021c: "versionName",
+	0x01010270: "targetSdkVersion",
+}
+
+// element is an XML element of the manifest with its attributes by name (without a namespace).
+type element struct {
+	name       string
+	attributes map[string]string
+}
+
+// attributeName returns the name of an attribute, preferring the name of well-known framework attributes by resource ID.
+func attributeName(resourceID uint32, name string) string {
+	if n, ok := frameworkAttributes[resourceID]; ok {
+		return n
+	}
+	return name
+}
+
+// newAppEntry returns the app metadata described by the elements of an AndroidManifest.xml, or nil if there is no
+// manifest element with a package name.
+func newAppEntry(format string, elements []element) *pkg.AndroidAppEntry {
+	var entry *pkg.AndroidAppEntry
+	for _, e := range elements {
+		switch e.name {
+		case "manifest":
+			if entry != nil || e.attributes["package"] == "" {
+				continue
+			}
+			entry = &pkg.AndroidAppEntry{
+				Format:      format,
+				PackageName: e.attributes["package"],
+				VersionName: e.attributes["versionName"],
+			}
+			if code, err := strconv.ParseInt(e.attributes["versionCode"], 10, 64); err == nil {
+				entry.VersionCode = code
+			}
+		case "uses-sdk":
+			if entry != nil {
+				entry.MinSDKVersion = e.attributes["minSdkVersion"]
+				entry.TargetSDKVersion = e.attributes["targetSdkVersion"]
+			}
+		case "uses-permission", "uses-permission-sdk-23":
+			if entry != nil && e.attributes["name"] != "" {
+				entry.Permissions = append(entry.Permissions, e.attributes["name"])
+			}
+		}
+	}
+	return entry
+}
@@ -0,0 +1,85 @@
+package android
+
+import (
+	"strconv"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newAppPackage(entry pkg.AndroidAppEntry, location file.Location) pkg.Package {
+	// the version name is what users see, however, it is optional (unlike the version code)
+	version := entry.VersionName
+	if version == "" && entry.VersionCode != 0 {
+		version = strconv.FormatInt(entry.VersionCode, 10)
+	}
+
+	p := pkg.Package{
+		Name:      entry.PackageName,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		PURL:      packageURL(pkg.AndroidAppPkg.PackageURLType(), "", entry.PackageName, version),
+		Type:      pkg.AndroidAppPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newJavaLibraryPackage returns a package for a Java (or Kotlin) library that was compiled into the dex files of the app.
+func newJavaLibraryPackage(properties pkg.JavaPomProperties, virtualPath string, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      properties.ArtifactID,
+		Version:   properties.Version,
+		Locations: file.NewLocationSet(location),
+		Language:  pkg.Java,
+		PURL:      packageURL(packageurl.TypeMaven, properties.GroupID, properties.ArtifactID, properties.Version),
+		Type:      pkg.JavaPkg,
+		Metadata: pkg.JavaArchive{
+			VirtualPath:   virtualPath,
+			PomProperties: &properties,
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newNativeLibraryPackage returns a package for a native library of the app described by ELF package notes.
+func newNativeLibraryPackage(notes elfPackageNotes, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      notes.Name,
+		Version:   notes.Version,
+		Locations: file.NewLocationSet(location),
+		PURL:      notes.PURL,
+		Type:      pkg.BinaryPkg,
+		Metadata:  notes.ELFBinaryPackageNoteJSONPayload,
+	}
+
+	if p.PURL == "" {
+		p.PURL = packageURL(packageurl.TypeGeneric, "", notes.Name, notes.Version)
+	}
+
+	if notes.License != "" {
+		p.Licenses = pkg.NewLicenseSet(pkg.NewLicenseFromLocations(notes.License, location))
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(ty, namespace, name, version string) string {
+	return packageurl.NewPackageURL(
+		ty,
+		namespace,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,310 @@
+package android
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"debug/elf"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseAndroidApp
+
+const (
+	apkFormat = "apk"
+	aabFormat = "aab"
+
+	manifestPath = "AndroidManifest.xml"
+
+	// the module of an App Bundle that holds the manifest of the app
+	baseModule = "base"
+)
+
+// elfPackageNotes is the JSON content of the .note.package section of an ELF binary (see
+// https://systemd.io/ELF_PACKAGE_METADATA/).
+type elfPackageNotes struct {
+	Name                                string `json:"name"`
+	Version                             string `json:"version"`
+	PURL                                string `json:"purl"`
+	License                             string `json:"license"`
+	pkg.ELFBinaryPackageNoteJSONPayload `json:",inline"`
+}
+
+// parseAndroidApp is a parser function for APKs and App Bundles, returning a package for the app along with a package
+// for each library bundled within the app, which are Java libraries compiled into the dex files (as identified by the
+// version files and pom.properties files kept within META-INF) and native libraries with ELF package notes.
+func parseAndroidApp(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	archive, err := openArchive(reader)
+	if err != nil {
+		// note: Alpine packages also have the .apk extension, but are not zip archives
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read Android app as a zip archive")
+		return nil, nil, nil
+	}
+
+	format := apkFormat
+	if strings.EqualFold(path.Ext(reader.RealPath), ".aab") {
+		format = aabFormat
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var (
+		entry           *pkg.AndroidAppEntry
+		dexFiles        []string
+		nativeLibraries []string
+		libraries       []pkg.Package
+		seen            = make(map[string]struct{})
+	)
+
+	addLibrary := func(p pkg.Package) {
+		if _, exists := seen[p.PURL]; exists {
+			// the same native library is commonly bundled for multiple ABIs
+			return
+		}
+		seen[p.PURL] = struct{}{}
+		libraries = append(libraries, p)
+	}
+
+	for _, f := range archive.File {
+		module, name := appPath(format, f.Name)
+		switch {
+		case name == manifestPath && module == baseModule:
+			entry, err = readManifest(format, f)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to parse Android manifest: %w", err)
+			}
+		case path.Dir(name) == "." && path.Ext(name) == ".dex":
+			dexFiles = append(dexFiles, f.Name)
+		case strings.HasPrefix(name, "lib/") && path.Ext(name) == ".so":
+			nativeLibraries = append(nativeLibraries, f.Name)
+			notes, err := readELFNotes(f)
+			if err != nil {
+				log.WithFields("path", reader.RealPath, "library", f.Name, "error", err).Trace("unable to read native library")
+				continue
+			}
+			if notes != nil && notes.Name != "" {
+				addLibrary(newNativeLibraryPackage(*notes, location))
+			}
+		case path.Dir(name) == "META-INF" && path.Ext(name) == ".version":
+			properties, err := readVersionFile(f, name)
+			if err != nil {
+				log.WithFields("path", reader.RealPath, "file", f.Name, "error", err).Trace("unable to read library version file")
+				continue
+			}
+			if properties != nil {
+				addLibrary(newJavaLibraryPackage(*properties, reader.RealPath+":"+f.Name, location))
+			}
+		case strings.HasPrefix(name, "META-INF/maven/") && path.Base(name) == "pom.properties":
+			properties, err := readPomProperties(f)
+			if err != nil {
+				log.WithFields("path", reader.RealPath, "file", f.Name, "error", err).Trace("unable to read pom.properties")
+				continue
+			}
+			if properties != nil {
+				addLibrary(newJavaLibraryPackage(*properties, reader.RealPath+":"+f.Name, location))
+			}
+		}
+	}
+
+	if entry == nil {
+		return nil, nil, nil
+	}
+	entry.DexFiles = dexFiles
+	entry.NativeLibraries = nativeLibraries
+
+	app := newAppPackage(*entry, location)
+
+	pkgs := []pkg.Package{app}
+	var relationships []artifact.Relationship
+	for _, l := range libraries {
+		pkgs = append(pkgs, l)
+		relationships = append(relationships, artifact.Relationship{
+			From: l,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	return pkgs, relationships, nil
+}
+
+func openArchive(reader io.ReadCloser) (*zip.Reader, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return zip.NewReader(unionReader, size)
+}
+
+// appPath returns the module and the path within the module of the given archive entry, where the paths of App Bundle
+// modules are made equivalent to those of APKs (e.g. "base/root/META-INF/x.version" becomes "META-INF/x.version").
+func appPath(format, name string) (string, string) {
+	if format == apkFormat {
+		return baseModule, name
+	}
+
+	module, name, found := strings.Cut(name, "/")
+	if !found {
+		return "", ""
+	}
+
+	switch {
+	case name == "manifest/"+manifestPath:
+		return module, manifestPath
+	case strings.HasPrefix(name, "dex/"):
+		return module, strings.TrimPrefix(name, "dex/")
+	case strings.HasPrefix(name, "root/"):
+		return module, strings.TrimPrefix(name, "root/")
+	}
+	return module, name
+}
+
+func readManifest(format string, f *zip.File) (*pkg.AndroidAppEntry, error) {
+	data, err := readFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var elements []element
+	if format == aabFormat {
+		elements, err = decodeProtoXML(data)
+	} else {
+		elements, err = decodeBinaryXML(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newAppEntry(format, elements), nil
+}
+
+// readVersionFile returns the library described by a version file, which are kept within the META-INF directory of apps
+// for AndroidX and KotlinX libraries. These are named after the group and artifact of the library
+// (e.g. "androidx.core_core.version" or "kotlinx_coroutines_core.version") and hold the version of the library.
+func readVersionFile(f *zip.File, name string) (*pkg.JavaPomProperties, error) {
+	groupID, artifactID, found := strings.Cut(strings.TrimSuffix(path.Base(name), ".version"), "_")
+	if !found || artifactID == "" {
+		return nil, nil
+	}
+
+	switch {
+	case groupID == "kotlinx":
+		// e.g. org.jetbrains.kotlinx:kotlinx-coroutines-core
+		artifactID = "kotlinx-" + strings.ReplaceAll(artifactID, "_", "-")
+		groupID = "org.jetbrains.kotlinx"
+	case !strings.Contains(groupID, "."):
+		// the group cannot be determined
+		return nil, nil
+	}
+
+	data, err := readFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	version := strings.TrimSpace(string(data))
+	if version == "" {
+		return nil, nil
+	}
+
+	return &pkg.JavaPomProperties{
+		Path:       f.Name,
+		GroupID:    groupID,
+		ArtifactID: artifactID,
+		Version:    version,
+	}, nil
+}
+
+func readPomProperties(f *zip.File) (*pkg.JavaPomProperties, error) {
+	data, err := readFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := pkg.JavaPomProperties{Path: f.Name}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "groupId":
+			properties.GroupID = strings.TrimSpace(value)
+		case "artifactId":
+			properties.ArtifactID = strings.TrimSpace(value)
+		case "version":
+			properties.Version = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if properties.GroupID == "" || properties.ArtifactID == "" || properties.Version == "" {
+		return nil, nil
+	}
+	return &properties, nil
+}
+
+// readELFNotes returns the JSON package notes of a native library, if there are any.
+func readELFNotes(f *zip.File) (*elfPackageNotes, error) {
+	data, err := readFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	binary, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	section := binary.Section(".note.package")
+	if section == nil {
+		return nil, nil
+	}
+
+	content, err := section.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	var notes elfPackageNotes
+	if err := json.Unmarshal(bytes.TrimRight(content, "\x00"), &notes); err != nil {
+		return nil, err
+	}
+	return &notes, nil
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(rc, f.Name)
+
+	return io.ReadAll(rc)
+}
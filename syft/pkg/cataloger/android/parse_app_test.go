@@ -0,0 +1,143 @@
+package android
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseAndroidApp_APK(t *testing.T) {
+	location := file.NewLocation("test-fixtures/apk/app.apk")
+
+	app := pkg.Package{
+		Name:      "com.example.app",
+		Version:   "1.2.3",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:android/com.example.app@1.2.3",
+		Type:      pkg.AndroidAppPkg,
+		Metadata: pkg.AndroidAppEntry{
+			Format:           "apk",
+			PackageName:      "com.example.app",
+			VersionCode:      42,
+			VersionName:      "1.2.3",
+			MinSDKVersion:    "24",
+			TargetSDKVersion: "34",
+			Permissions: []string{
+				"android.permission.INTERNET",
+				"android.permission.CAMERA",
+			},
+			DexFiles: []string{
+				"classes.dex",
+				"classes2.dex",
+			},
+			NativeLibraries: []string{
+				"lib/arm64-v8a/libnative.so",
+				"lib/x86_64/libnative.so",
+			},
+		},
+	}
+
+	native := pkg.Package{
+		Name:      "libnative",
+		Version:   "2.4.1",
+		Locations: file.NewLocationSet(location),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", location)),
+		PURL:      "pkg:generic/libnative@2.4.1",
+		Type:      pkg.BinaryPkg,
+		Metadata: pkg.ELFBinaryPackageNoteJSONPayload{
+			Type: "generic",
+		},
+	}
+
+	core := javaLibrary("androidx.core", "core", "1.12.0", "META-INF/androidx.core_core.version", location)
+	coroutines := javaLibrary("org.jetbrains.kotlinx", "kotlinx-coroutines-core", "1.7.3", "META-INF/kotlinx_coroutines_core.version", location)
+	okhttp := javaLibrary("com.squareup.okhttp3", "okhttp", "4.12.0", "META-INF/maven/com.squareup.okhttp3/okhttp/pom.properties", location)
+
+	expectedPkgs := []pkg.Package{app, native, core, coroutines, okhttp}
+
+	var expectedRelationships []artifact.Relationship
+	for _, l := range []pkg.Package{native, core, coroutines, okhttp} {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{
+			From: l,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/apk/app.apk").
+		Expects(expectedPkgs, expectedRelationships).
+		TestParser(t, parseAndroidApp)
+}
+
+func TestParseAndroidApp_AAB(t *testing.T) {
+	location := file.NewLocation("test-fixtures/aab/bundle.aab")
+
+	app := pkg.Package{
+		Name:      "com.example.bundle",
+		Version:   "7",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:android/com.example.bundle@7",
+		Type:      pkg.AndroidAppPkg,
+		Metadata: pkg.AndroidAppEntry{
+			Format:        "aab",
+			PackageName:   "com.example.bundle",
+			VersionCode:   7,
+			MinSDKVersion: "21",
+			Permissions: []string{
+				"android.permission.INTERNET",
+			},
+			DexFiles: []string{
+				"base/dex/classes.dex",
+				"feature/dex/classes.dex",
+			},
+			NativeLibraries: []string{
+				"base/lib/x86_64/libplain.so",
+			},
+		},
+	}
+
+	activity := javaLibrary("androidx.activity", "activity", "1.8.2", "base/root/META-INF/androidx.activity_activity.version", location)
+
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/aab/bundle.aab").
+		Expects([]pkg.Package{app, activity}, []artifact.Relationship{
+			{
+				From: activity,
+				To:   app,
+				Type: artifact.DependencyOfRelationship,
+			},
+		}).
+		TestParser(t, parseAndroidApp)
+}
+
+func TestParseAndroidApp_AlpinePackage(t *testing.T) {
+	// alpine packages share the .apk extension, but are not Android apps
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/alpine/busybox-1.36.1-r0.apk").
+		Expects(nil, nil).
+		TestParser(t, parseAndroidApp)
+}
+
+func javaLibrary(groupID, artifactID, version, path string, location file.Location) pkg.Package {
+	return pkg.Package{
+		Name:      artifactID,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		Language:  pkg.Java,
+		PURL:      "pkg:maven/" + groupID + "/" + artifactID + "@" + version,
+		Type:      pkg.JavaPkg,
+		Metadata: pkg.JavaArchive{
+			VirtualPath: location.RealPath + ":" + path,
+			PomProperties: &pkg.JavaPomProperties{
+				Path:       path,
+				GroupID:    groupID,
+				ArtifactID: artifactID,
+				Version:    version,
+			},
+		},
+	}
+}
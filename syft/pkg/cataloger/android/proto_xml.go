@@ -0,0 +1,153 @@
+package android
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// field numbers of the aapt2 XML messages (see frameworks/base/tools/aapt2/Resources.proto)
+const (
+	xmlNodeElementField = 1
+
+	xmlElementNameField      = 3
+	xmlElementAttributeField = 4
+	xmlElementChildField     = 5
+
+	xmlAttributeNameField       = 2
+	xmlAttributeValueField      = 3
+	xmlAttributeResourceIDField = 5
+)
+
+// protobuf wire types
+const (
+	varintWireType  = 0
+	fixed64WireType = 1
+	bytesWireType   = 2
+	fixed32WireType = 5
+)
+
+// decodeProtoXML returns the elements (in document order) of an XML document in the protobuf format written by aapt2,
+// as found within Android App Bundles (e.g. base/manifest/AndroidManifest.xml). Only the element names and attribute
+// values are kept, the hierarchy of the document is not.
+func decodeProtoXML(data []byte) ([]element, error) {
+	var elements []element
+	if err := decodeXMLNode(data, &elements); err != nil {
+		return nil, err
+	}
+	if len(elements) == 0 {
+		return nil, errors.New("no XML elements found")
+	}
+	return elements, nil
+}
+
+func decodeXMLNode(data []byte, elements *[]element) error {
+	return readFields(data, func(field int, _ uint64, content []byte) error {
+		if field != xmlNodeElementField || content == nil {
+			return nil
+		}
+		return decodeXMLElement(content, elements)
+	})
+}
+
+func decodeXMLElement(data []byte, elements *[]element) error {
+	e := element{attributes: make(map[string]string)}
+
+	// the element is added before any children to keep document order
+	index := len(*elements)
+	*elements = append(*elements, e)
+
+	err := readFields(data, func(field int, _ uint64, content []byte) error {
+		switch field {
+		case xmlElementNameField:
+			e.name = string(content)
+		case xmlElementAttributeField:
+			name, value, err := decodeXMLAttribute(content)
+			if err != nil {
+				return err
+			}
+			if name != "" {
+				e.attributes[name] = value
+			}
+		case xmlElementChildField:
+			return decodeXMLNode(content, elements)
+		}
+		return nil
+	})
+
+	(*elements)[index] = e
+	return err
+}
+
+func decodeXMLAttribute(data []byte) (string, string, error) {
+	var (
+		name       string
+		value      string
+		resourceID uint32
+	)
+
+	err := readFields(data, func(field int, number uint64, content []byte) error {
+		switch field {
+		case xmlAttributeNameField:
+			name = string(content)
+		case xmlAttributeValueField:
+			value = string(content)
+		case xmlAttributeResourceIDField:
+			resourceID = uint32(number)
+		}
+		return nil
+	})
+
+	return attributeName(resourceID, name), value, err
+}
+
+// readFields calls the given function for each field of the given protobuf message, with either the number (for
+// varint and fixed size fields) or the content (for length-delimited fields) of the field.
+func readFields(data []byte, fn func(field int, number uint64, content []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+
+		var (
+			number  uint64
+			content []byte
+		)
+		switch key & 0x7 {
+		case varintWireType:
+			number, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errTruncated
+			}
+			data = data[n:]
+		case fixed64WireType:
+			if len(data) < 8 {
+				return errTruncated
+			}
+			number = binary.LittleEndian.Uint64(data)
+			data = data[8:]
+		case bytesWireType:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || size > uint64(len(data)-n) {
+				return errTruncated
+			}
+			content = data[n : n+int(size)]
+			data = data[n+int(size):]
+		case fixed32WireType:
+			if len(data) < 4 {
+				return errTruncated
+			}
+			number = uint64(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		default:
+			return fmt.Errorf("unsupported protobuf wire type: %d", key&0x7)
+		}
+
+		if err := fn(int(key>>3), number, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
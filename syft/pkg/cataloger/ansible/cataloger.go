@@ -0,0 +1,27 @@
+/*
+Package ansible provides concrete Cataloger implementations for Ansible collections and roles installed from (or
+declared for) Ansible Galaxy.
+*/
+package ansible
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewInstalledCataloger returns a new cataloger object for installed Ansible collections (MANIFEST.json) and roles
+// (meta/main.yml).
+func NewInstalledCataloger() pkg.Cataloger {
+	return generic.NewCataloger("ansible-galaxy-installed-cataloger").
+		WithParserByGlobs(parseCollectionManifest, "**/ansible_collections/*/*/MANIFEST.json").
+		WithParserByGlobs(parseRoleMeta, "**/roles/*/meta/main.yml", "**/roles/*/meta/main.yaml").
+		WithProcessors(dependency.Processor(installedDependencySpecifier))
+}
+
+// NewRequirementsCataloger returns a new cataloger object for the roles and collections declared within Ansible Galaxy
+// requirements files (requirements.yml).
+func NewRequirementsCataloger() pkg.Cataloger {
+	return generic.NewCataloger("ansible-galaxy-requirements-cataloger").
+		WithParserByGlobs(parseRequirements, "**/requirements.yml", "**/requirements.yaml")
+}
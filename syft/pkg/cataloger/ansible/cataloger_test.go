@@ -0,0 +1,151 @@
+package ansible
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestInstalledCataloger(t *testing.T) {
+	dockerCollectionLocation := file.NewLocation("usr/share/ansible/collections/ansible_collections/community/docker/MANIFEST.json")
+	filteringCollectionLocation := file.NewLocation("usr/share/ansible/collections/ansible_collections/community/library_inventory_filtering_v1/MANIFEST.json")
+	dockerRoleLocation := file.NewLocation("etc/ansible/roles/geerlingguy.docker/meta/main.yml")
+	pipRoleLocation := file.NewLocation("etc/ansible/roles/geerlingguy.pip/meta/main.yml")
+
+	dockerCollection := pkg.Package{
+		Name:    "community.docker",
+		Version: "3.10.3",
+		FoundBy: "ansible-galaxy-installed-cataloger",
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("GPL-3.0-or-later", dockerCollectionLocation),
+			pkg.NewLicenseFromLocations("Apache-2.0", dockerCollectionLocation),
+		),
+		Locations: file.NewLocationSet(dockerCollectionLocation),
+		PURL:      "pkg:ansible-collection/community/docker@3.10.3",
+		Type:      pkg.AnsibleCollectionPkg,
+		Metadata: pkg.AnsibleCollectionManifest{
+			Namespace:   "community",
+			Authors:     []string{"Ansible Docker Working Group"},
+			Description: "Modules and plugins for working with Docker",
+			Repository:  "https://github.com/ansible-collections/community.docker",
+			Dependencies: map[string]string{
+				"community.library_inventory_filtering_v1": ">=1.0.0",
+			},
+		},
+	}
+
+	filteringCollection := pkg.Package{
+		Name:      "community.library_inventory_filtering_v1",
+		Version:   "1.0.1",
+		FoundBy:   "ansible-galaxy-installed-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL-3.0-or-later", filteringCollectionLocation)),
+		Locations: file.NewLocationSet(filteringCollectionLocation),
+		PURL:      "pkg:ansible-collection/community/library_inventory_filtering_v1@1.0.1",
+		Type:      pkg.AnsibleCollectionPkg,
+		Metadata: pkg.AnsibleCollectionManifest{
+			Namespace: "community",
+			Authors:   []string{"Felix Fontein (github.com/felixfontein)"},
+		},
+	}
+
+	dockerRole := pkg.Package{
+		Name:     "geerlingguy.docker",
+		Version:  "7.0.2",
+		FoundBy:  "ansible-galaxy-installed-cataloger",
+		Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", dockerRoleLocation)),
+		Locations: file.NewLocationSet(
+			dockerRoleLocation,
+			file.NewLocation("etc/ansible/roles/geerlingguy.docker/meta/.galaxy_install_info"),
+		),
+		PURL: "pkg:ansible-role/geerlingguy/docker@7.0.2",
+		Type: pkg.AnsibleRolePkg,
+		Metadata: pkg.AnsibleRoleMeta{
+			Namespace:         "geerlingguy",
+			Author:            "geerlingguy",
+			Description:       "Docker for Linux.",
+			MinAnsibleVersion: "2.10",
+			Dependencies:      []string{"geerlingguy.pip"},
+			InstallDate:       "Mon 04 Mar 2024 10:00:00 AM",
+		},
+	}
+
+	pipRole := pkg.Package{
+		Name:     "geerlingguy.pip",
+		Version:  "3.0.3",
+		FoundBy:  "ansible-galaxy-installed-cataloger",
+		Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", pipRoleLocation)),
+		Locations: file.NewLocationSet(
+			pipRoleLocation,
+			file.NewLocation("etc/ansible/roles/geerlingguy.pip/meta/.galaxy_install_info"),
+		),
+		PURL: "pkg:ansible-role/geerlingguy/pip@3.0.3",
+		Type: pkg.AnsibleRolePkg,
+		Metadata: pkg.AnsibleRoleMeta{
+			Author:            "geerlingguy",
+			Description:       "Pip (Python package manager) for Linux.",
+			MinAnsibleVersion: "2.10",
+			InstallDate:       "Mon 04 Mar 2024 10:00:01 AM",
+		},
+	}
+
+	// note: roles within collections and roles without galaxy information (local to a playbook) are not cataloged
+	expectedPkgs := []pkg.Package{dockerCollection, filteringCollection, dockerRole, pipRole}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: filteringCollection,
+			To:   dockerCollection,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: pipRole,
+			To:   dockerRole,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewInstalledCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain installed collections and roles",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewInstalledCataloger(),
+			expected: []string{
+				"home/dev/.ansible/collections/ansible_collections/community/docker/MANIFEST.json",
+				"etc/ansible/roles/x/meta/main.yml",
+				"etc/ansible/roles/y/meta/main.yaml",
+			},
+		},
+		{
+			name:      "obtain requirements files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewRequirementsCataloger(),
+			expected: []string{
+				"project/requirements.yml",
+				"project/roles/requirements.yaml",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package ansible
+
+import (
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = installedDependencySpecifier
+
+// installedDependencySpecifier describes the dependencies between installed collections (by fully qualified name) and
+// between installed roles (by the name the role is installed as).
+func installedDependencySpecifier(p pkg.Package) dependency.Specification {
+	switch meta := p.Metadata.(type) {
+	case pkg.AnsibleCollectionManifest:
+		requires := make([]string, 0, len(meta.Dependencies))
+		for name := range meta.Dependencies {
+			requires = append(requires, name)
+		}
+		sort.Strings(requires)
+		return dependency.Specification{
+			ProvidesRequires: dependency.ProvidesRequires{
+				Provides: []string{p.Name},
+				Requires: requires,
+			},
+		}
+	case pkg.AnsibleRoleMeta:
+		return dependency.Specification{
+			ProvidesRequires: dependency.ProvidesRequires{
+				Provides: []string{p.Name},
+				Requires: meta.Dependencies,
+			},
+		}
+	}
+
+	log.Tracef("cataloger failed to extract ansible metadata for package %+v", p.Name)
+	return dependency.Specification{}
+}
@@ -0,0 +1,59 @@
+package ansible
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newCollectionPackage(name, version string, licenses []string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0].WithoutAnnotations(), licenses...)...),
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(pkg.AnsibleCollectionPkg, name, version),
+		Type:      pkg.AnsibleCollectionPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newRolePackage(name, version string, licenses []string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0].WithoutAnnotations(), licenses...)...),
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(pkg.AnsibleRolePkg, name, version),
+		Type:      pkg.AnsibleRolePkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL for a collection or role, where the Galaxy namespace (the part of the name before
+// the first ".") is the purl namespace (e.g. pkg:ansible-collection/community/general@8.6.0).
+func packageURL(ty pkg.Type, name, version string) string {
+	namespace, shortName, found := strings.Cut(name, ".")
+	if !found {
+		namespace, shortName = "", name
+	}
+
+	return packageurl.NewPackageURL(
+		ty.PackageURLType(),
+		namespace,
+		shortName,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,62 @@
+package ansible
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCollectionManifest
+
+type collectionManifest struct {
+	CollectionInfo collectionInfo `json:"collection_info"`
+}
+
+type collectionInfo struct {
+	Namespace    string            `json:"namespace"`
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Authors      []string          `json:"authors"`
+	Description  string            `json:"description"`
+	License      []string          `json:"license"`
+	Repository   string            `json:"repository"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// parseCollectionManifest is a parser function for the MANIFEST.json of an installed Ansible collection (written when
+// the collection is built, and installed along with it by ansible-galaxy).
+func parseCollectionManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var manifest collectionManifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse ansible collection MANIFEST.json: %w", err)
+	}
+
+	info := manifest.CollectionInfo
+	if info.Namespace == "" || info.Name == "" {
+		return nil, nil, nil
+	}
+	if len(info.Dependencies) == 0 {
+		info.Dependencies = nil
+	}
+
+	return []pkg.Package{
+		newCollectionPackage(
+			info.Namespace+"."+info.Name,
+			info.Version,
+			info.License,
+			pkg.AnsibleCollectionManifest{
+				Namespace:    info.Namespace,
+				Authors:      info.Authors,
+				Description:  info.Description,
+				Repository:   info.Repository,
+				Dependencies: info.Dependencies,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
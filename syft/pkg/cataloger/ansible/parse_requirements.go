@@ -0,0 +1,228 @@
+package ansible
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseRequirements
+
+type roleRequirement struct {
+	Src     string `yaml:"src"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Scm     string `yaml:"scm"`
+	Include string `yaml:"include"`
+}
+
+type collectionRequirement struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Source  string `yaml:"source"`
+	Type    string `yaml:"type"`
+}
+
+// parseRequirements is a parser function for Ansible Galaxy requirements files, which are either a mapping of the
+// roles and collections to install, or (in the older format) a list of the roles to install:
+//
+//	roles:
+//	  - name: geerlingguy.docker
+//	    version: 7.0.2
+//	collections:
+//	  - name: community.general
+//	    version: ">=8.0.0"
+//	  - ansible.posix
+//
+// Files with another structure (requirements.yml is not unique to Ansible) are ignored.
+func parseRequirements(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var document yaml.Node
+	if err := yaml.NewDecoder(reader).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to parse ansible galaxy requirements file: %w", err)
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) == 0 {
+		return nil, nil, nil
+	}
+
+	var roles, collections []yaml.Node
+	root := document.Content[0]
+	switch root.Kind {
+	case yaml.SequenceNode:
+		roles = nodes(root.Content)
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			switch root.Content[i].Value {
+			case "roles":
+				roles = nodes(root.Content[i+1].Content)
+			case "collections":
+				collections = nodes(root.Content[i+1].Content)
+			}
+		}
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	for i := range roles {
+		requirement, ok := decodeRoleRequirement(&roles[i])
+		if !ok {
+			continue
+		}
+		if p, ok := newRoleRequirementPackage(requirement, location); ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+	for i := range collections {
+		requirement, ok := decodeCollectionRequirement(&collections[i])
+		if !ok {
+			continue
+		}
+		if p, ok := newCollectionRequirementPackage(requirement, location); ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+func nodes(content []*yaml.Node) []yaml.Node {
+	var values []yaml.Node
+	for _, node := range content {
+		if node != nil {
+			values = append(values, *node)
+		}
+	}
+	return values
+}
+
+// decodeRoleRequirement decodes a role entry, which is either a mapping or (in the oldest format) a string of the
+// form "src[,version[,name]]".
+func decodeRoleRequirement(node *yaml.Node) (roleRequirement, bool) {
+	var requirement roleRequirement
+	switch node.Kind {
+	case yaml.ScalarNode:
+		fields := strings.Split(node.Value, ",")
+		requirement.Src = strings.TrimSpace(fields[0])
+		if len(fields) > 1 {
+			requirement.Version = strings.TrimSpace(fields[1])
+		}
+		if len(fields) > 2 {
+			requirement.Name = strings.TrimSpace(fields[2])
+		}
+	case yaml.MappingNode:
+		if err := node.Decode(&requirement); err != nil {
+			return requirement, false
+		}
+	default:
+		return requirement, false
+	}
+	// note: included requirement files are cataloged on their own when they are named requirements.yml
+	return requirement, requirement.Include == "" && (requirement.Src != "" || requirement.Name != "")
+}
+
+func decodeCollectionRequirement(node *yaml.Node) (collectionRequirement, bool) {
+	var requirement collectionRequirement
+	switch node.Kind {
+	case yaml.ScalarNode:
+		requirement.Name = node.Value
+	case yaml.MappingNode:
+		if err := node.Decode(&requirement); err != nil {
+			return requirement, false
+		}
+	default:
+		return requirement, false
+	}
+	return requirement, requirement.Name != ""
+}
+
+func newRoleRequirementPackage(requirement roleRequirement, location file.Location) (pkg.Package, bool) {
+	entry := pkg.AnsibleRequirementsEntry{
+		Kind:              pkg.AnsibleRoleRequirement,
+		SourceType:        requirement.Scm,
+		VersionConstraint: requirement.Version,
+	}
+
+	name := requirement.Name
+	if isSourceReference(requirement.Src) {
+		entry.Source = requirement.Src
+		if name == "" {
+			name = sourceName(requirement.Src)
+		}
+	} else if name == "" {
+		name = requirement.Src
+	}
+	if name == "" {
+		return pkg.Package{}, false
+	}
+
+	return newRolePackage(name, requirement.Version, nil, entry, location), true
+}
+
+func newCollectionRequirementPackage(requirement collectionRequirement, location file.Location) (pkg.Package, bool) {
+	entry := pkg.AnsibleRequirementsEntry{
+		Kind:              pkg.AnsibleCollectionRequirement,
+		Source:            requirement.Source,
+		SourceType:        requirement.Type,
+		VersionConstraint: requirement.Version,
+	}
+
+	name := requirement.Name
+	// collections installed from somewhere other than a Galaxy server are named by their location
+	if isSourceReference(name) || (requirement.Type != "" && requirement.Type != "galaxy") {
+		entry.Source = name
+		name = sourceName(name)
+	}
+	if name == "" {
+		return pkg.Package{}, false
+	}
+
+	return newCollectionPackage(name, exactVersion(requirement.Version), nil, entry, location), true
+}
+
+// isSourceReference reports whether the value refers to a location (a URL, an SCM reference, or a path) rather than a
+// Galaxy name.
+func isSourceReference(value string) bool {
+	return strings.Contains(value, "://") || strings.HasPrefix(value, "git+") || strings.HasPrefix(value, "git@") ||
+		strings.Contains(value, "/") || strings.HasSuffix(value, ".tar.gz")
+}
+
+// sourceName returns the name of a role or collection installed from the given location, which is the last element
+// of the location without the version and extension (e.g. "git+https://github.com/org/ansible-role-x.git,v1" is
+// named "ansible-role-x").
+func sourceName(source string) string {
+	source, _, _ = strings.Cut(source, ",")
+	source = strings.TrimRight(source, "/")
+	name := path.Base(source)
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSuffix(name, ".tar.gz")
+	name = strings.TrimSuffix(name, ".git")
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+// exactVersion returns the version of a collection requirement when it pins a single version (collection versions
+// may be ranges, such as ">=8.0.0,<9.0.0").
+func exactVersion(constraint string) string {
+	version := strings.TrimSpace(strings.TrimPrefix(constraint, "=="))
+	if version == "" || strings.ContainsAny(version, "<>=!*,") {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,159 @@
+package ansible
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseRequirements(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected func(location file.Location) []pkg.Package
+	}{
+		{
+			name:    "roles and collections",
+			fixture: "test-fixtures/requirements/requirements.yml",
+			expected: func(location file.Location) []pkg.Package {
+				return []pkg.Package{
+					{
+						Name:      "geerlingguy.docker",
+						Version:   "7.0.2",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-role/geerlingguy/docker@7.0.2",
+						Type:      pkg.AnsibleRolePkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleRoleRequirement,
+							VersionConstraint: "7.0.2",
+						},
+					},
+					{
+						Name:      "nginx_role",
+						Version:   "main",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-role/nginx_role@main",
+						Type:      pkg.AnsibleRolePkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleRoleRequirement,
+							Source:            "https://github.com/bennojoy/nginx",
+							VersionConstraint: "main",
+						},
+					},
+					{
+						Name:      "ansible-role-apache",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-role/ansible-role-apache",
+						Type:      pkg.AnsibleRolePkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:   pkg.AnsibleRoleRequirement,
+							Source: "git+https://github.com/geerlingguy/ansible-role-apache.git,3.2.0",
+						},
+					},
+					// note: a version range is not a version
+					{
+						Name:      "community.general",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-collection/community/general",
+						Type:      pkg.AnsibleCollectionPkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleCollectionRequirement,
+							VersionConstraint: ">=8.0.0,<9.0.0",
+						},
+					},
+					{
+						Name:      "ansible.posix",
+						Version:   "1.5.4",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-collection/ansible/posix@1.5.4",
+						Type:      pkg.AnsibleCollectionPkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleCollectionRequirement,
+							VersionConstraint: "==1.5.4",
+						},
+					},
+					{
+						Name:      "community.docker",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-collection/community/docker",
+						Type:      pkg.AnsibleCollectionPkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind: pkg.AnsibleCollectionRequirement,
+						},
+					},
+					{
+						Name:      "my_namespace.my_collection",
+						Version:   "1.0.0",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-collection/my_namespace/my_collection@1.0.0",
+						Type:      pkg.AnsibleCollectionPkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleCollectionRequirement,
+							Source:            "https://galaxy-dev.ansible.com",
+							VersionConstraint: "1.0.0",
+						},
+					},
+					{
+						Name:      "repo_name",
+						Version:   "devel",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-collection/repo_name@devel",
+						Type:      pkg.AnsibleCollectionPkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleCollectionRequirement,
+							Source:            "https://github.com/organization/repo_name.git",
+							SourceType:        "git",
+							VersionConstraint: "devel",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:    "roles list (older format)",
+			fixture: "test-fixtures/requirements/old/requirements.yml",
+			expected: func(location file.Location) []pkg.Package {
+				return []pkg.Package{
+					{
+						Name:      "geerlingguy.java",
+						Version:   "2.3.1",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-role/geerlingguy/java@2.3.1",
+						Type:      pkg.AnsibleRolePkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleRoleRequirement,
+							VersionConstraint: "2.3.1",
+						},
+					},
+					{
+						Name:      "geerlingguy.nodejs",
+						Version:   "6.1.0",
+						Locations: file.NewLocationSet(location),
+						PURL:      "pkg:ansible-role/geerlingguy/nodejs@6.1.0",
+						Type:      pkg.AnsibleRolePkg,
+						Metadata: pkg.AnsibleRequirementsEntry{
+							Kind:              pkg.AnsibleRoleRequirement,
+							VersionConstraint: "6.1.0",
+						},
+					},
+				}
+			},
+		},
+		{
+			name:    "not an ansible requirements file",
+			fixture: "test-fixtures/requirements/other/requirements.yml",
+			expected: func(file.Location) []pkg.Package {
+				return nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.TestFileParser(t, test.fixture, parseRequirements, test.expected(file.NewLocation(test.fixture)), []artifact.Relationship(nil))
+		})
+	}
+}
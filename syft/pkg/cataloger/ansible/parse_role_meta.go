@@ -0,0 +1,156 @@
+package ansible
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseRoleMeta
+
+type roleMeta struct {
+	GalaxyInfo   *galaxyInfo `yaml:"galaxy_info"`
+	Dependencies []yaml.Node `yaml:"dependencies"`
+}
+
+type galaxyInfo struct {
+	RoleName          string    `yaml:"role_name"`
+	Namespace         string    `yaml:"namespace"`
+	Author            string    `yaml:"author"`
+	Description       string    `yaml:"description"`
+	License           yaml.Node `yaml:"license"`
+	MinAnsibleVersion string    `yaml:"min_ansible_version"`
+}
+
+// galaxyInstallInfo is the install information recorded by ansible-galaxy next to the role metadata.
+type galaxyInstallInfo struct {
+	InstallDate string `yaml:"install_date"`
+	Version     string `yaml:"version"`
+}
+
+// parseRoleMeta is a parser function for the metadata of an installed Ansible role (meta/main.yml). The role is named
+// after the directory it is installed into (e.g. "geerlingguy.docker"), which is how other roles refer to it, and the
+// version is read from the install information that ansible-galaxy records (meta/.galaxy_install_info).
+func parseRoleMeta(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	// roles within a collection are part of the collection rather than installed on their own
+	if strings.Contains(reader.RealPath, "/ansible_collections/") {
+		return nil, nil, nil
+	}
+
+	var meta roleMeta
+	if err := yaml.NewDecoder(reader).Decode(&meta); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse ansible role metadata: %w", err)
+	}
+
+	// roles without galaxy information are not shared through Galaxy (e.g. roles local to a playbook)
+	if meta.GalaxyInfo == nil {
+		return nil, nil, nil
+	}
+	info := meta.GalaxyInfo
+
+	entry := pkg.AnsibleRoleMeta{
+		Namespace:         info.Namespace,
+		Author:            info.Author,
+		Description:       info.Description,
+		MinAnsibleVersion: info.MinAnsibleVersion,
+		Dependencies:      roleDependencies(meta.Dependencies),
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	var version string
+	if installInfo, installInfoLocation := readGalaxyInstallInfo(resolver, reader.Location); installInfo != nil {
+		version = installInfo.Version
+		entry.InstallDate = strings.TrimSpace(installInfo.InstallDate)
+		locations = append(locations, installInfoLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	name := path.Base(path.Dir(path.Dir(reader.RealPath)))
+
+	return []pkg.Package{newRolePackage(name, version, stringValues(&info.License), entry, locations...)}, nil, nil
+}
+
+// roleDependencies returns the names of the roles within a role dependency list, where each dependency is either the
+// name of the role or a mapping with the name (under "role", "name", or "src") and the variables to use.
+func roleDependencies(nodes []yaml.Node) []string {
+	var names []string
+	for i := range nodes {
+		node := &nodes[i]
+		switch node.Kind {
+		case yaml.ScalarNode:
+			names = append(names, node.Value)
+		case yaml.MappingNode:
+			var dependency struct {
+				Role string `yaml:"role"`
+				Name string `yaml:"name"`
+				Src  string `yaml:"src"`
+			}
+			if err := node.Decode(&dependency); err != nil {
+				continue
+			}
+			for _, name := range []string{dependency.Role, dependency.Name, dependency.Src} {
+				if name != "" {
+					names = append(names, name)
+					break
+				}
+			}
+		}
+	}
+	return names
+}
+
+// stringValues returns the values of a node that is either a single string or a list of strings.
+func stringValues(node *yaml.Node) []string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Value != "" {
+			return []string{node.Value}
+		}
+	case yaml.SequenceNode:
+		var values []string
+		for _, item := range node.Content {
+			if item.Kind == yaml.ScalarNode && item.Value != "" {
+				values = append(values, item.Value)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func readGalaxyInstallInfo(resolver file.Resolver, location file.Location) (*galaxyInstallInfo, *file.Location) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	installInfoPath := path.Join(path.Dir(location.RealPath), ".galaxy_install_info")
+	installInfoLocation := resolver.RelativeFileByPath(location, installInfoPath)
+	if installInfoLocation == nil {
+		return nil, nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*installInfoLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", installInfoPath).Trace("unable to read ansible galaxy install info")
+		return nil, nil
+	}
+	defer internal.CloseAndLogError(contents, installInfoLocation.RealPath)
+
+	var installInfo galaxyInstallInfo
+	if err := yaml.NewDecoder(contents).Decode(&installInfo); err != nil {
+		log.WithFields("error", err, "path", installInfoPath).Trace("unable to parse ansible galaxy install info")
+		return nil, nil
+	}
+
+	return &installInfo, installInfoLocation
+}
@@ -0,0 +1,23 @@
+/*
+Package apiservice provides a concrete Cataloger implementation for services described by API specification files
+(OpenAPI/Swagger documents, gRPC protobuf service definitions, and GraphQL schemas).
+*/
+package apiservice
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for services described by API specification files.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("api-service-cataloger").
+		WithParserByGlobs(parseOpenAPI,
+			"**/openapi.json", "**/openapi.yaml", "**/openapi.yml",
+			"**/*.openapi.json", "**/*.openapi.yaml", "**/*.openapi.yml",
+			"**/swagger.json", "**/swagger.yaml", "**/swagger.yml",
+			"**/*.swagger.json", "**/*.swagger.yaml", "**/*.swagger.yml",
+		).
+		WithParserByGlobs(parseProto, "**/*.proto").
+		WithParserByGlobs(parseGraphQL, "**/*.graphql", "**/*.graphqls", "**/*.gql")
+}
@@ -0,0 +1,47 @@
+package apiservice
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain API specification files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"api/openapi.yaml",
+				"api/openapi.yml",
+				"api/openapi.json",
+				"api/petstore.openapi.yaml",
+				"api/users.openapi.json",
+				"api/users.openapi.yml",
+				"api/swagger.json",
+				"api/swagger.yaml",
+				"api/swagger.yml",
+				"api/legacy.swagger.json",
+				"api/legacy.swagger.yaml",
+				"api/legacy.swagger.yml",
+				"proto/service.proto",
+				"graphql/schema.graphql",
+				"graphql/schema.graphqls",
+				"graphql/query.gql",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewCataloger())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package apiservice
+
+import (
+	"sort"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// data flow directions, relative to the service
+const (
+	inboundFlow       = "inbound"
+	outboundFlow      = "outbound"
+	bidirectionalFlow = "bi-directional"
+)
+
+func newAPIServicePackage(name, version string, metadata pkg.APIServiceEntry, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.APIServicePkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newDataFlows returns the data flows for the given type names sent to (inbound) and returned by (outbound) a service,
+// where types that are both sent and returned are bi-directional.
+func newDataFlows(inbound, outbound *strset.Set) []pkg.APIServiceDataFlow {
+	var flows []pkg.APIServiceDataFlow
+	for _, name := range strset.Union(inbound, outbound).List() {
+		flow := bidirectionalFlow
+		switch {
+		case !outbound.Has(name):
+			flow = inboundFlow
+		case !inbound.Has(name):
+			flow = outboundFlow
+		}
+		flows = append(flows, pkg.APIServiceDataFlow{
+			Flow:           flow,
+			Classification: name,
+		})
+	}
+
+	sort.Slice(flows, func(i, j int) bool {
+		return flows[i].Classification < flows[j].Classification
+	})
+
+	return flows
+}
@@ -0,0 +1,167 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseGraphQL
+
+var (
+	// graphQLIgnoredExp matches descriptions (block and single-line strings) and comments, which are not needed
+	// to describe the service and may contain braces.
+	graphQLIgnoredExp = regexp.MustCompile(`(?s)""".*?"""|"(?:[^"\\\n]|\\.)*"|#[^\n]*`)
+
+	// graphQLDefinitionExp matches the start of a type system definition with fields, for example:
+	// "type Query {", "extend type Mutation {", "input NewUser {" or "type User implements Node @key(fields: "id") {"
+	graphQLDefinitionExp = regexp.MustCompile(`\b(?:extend\s+)?(schema|type|input)\b\s*(\w*)[^{}]*\{`)
+
+	// graphQLRootOperationExp matches a root operation type within a schema definition, for example: "query: RootQuery"
+	graphQLRootOperationExp = regexp.MustCompile(`\b(query|mutation|subscription)\s*:\s*(\w+)`)
+
+	// graphQLFieldExp matches a field definition, for example: "user(id: ID!): User" or "users: [User!]! @deprecated"
+	graphQLFieldExp = regexp.MustCompile(`(?s)^(\w+)\s*(?:\((.*)\))?\s*:\s*([\[\]\w!\s]+)`)
+
+	// graphQLArgumentTypeExp matches the type of an argument within a field argument list, for example: "input: NewUser!"
+	graphQLArgumentTypeExp = regexp.MustCompile(`:\s*\[?\s*(\w+)`)
+)
+
+type graphQLDefinition struct {
+	kind   string
+	name   string
+	fields []string
+}
+
+// parseGraphQL is a parser function for GraphQL schema files, returning the service exposed by the root operation
+// types (Query, Mutation and Subscription) of the schema.
+func parseGraphQL(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read GraphQL schema: %w", err)
+	}
+
+	source := graphQLIgnoredExp.ReplaceAllString(string(contents), "")
+
+	roots := map[string]string{
+		"Query":        "query",
+		"Mutation":     "mutation",
+		"Subscription": "subscription",
+	}
+	objectTypes := strset.New()
+	inputTypes := strset.New()
+
+	var definitions []graphQLDefinition
+	for _, loc := range graphQLDefinitionExp.FindAllStringSubmatchIndex(source, -1) {
+		kind, name := source[loc[2]:loc[3]], source[loc[4]:loc[5]]
+		body := enclosedBlock(source[loc[1]:])
+
+		switch kind {
+		case "schema":
+			// the schema definition may rename the root operation types
+			roots = map[string]string{}
+			for _, match := range graphQLRootOperationExp.FindAllStringSubmatch(body, -1) {
+				roots[match[2]] = match[1]
+			}
+			continue
+		case "input":
+			inputTypes.Add(name)
+		default:
+			objectTypes.Add(name)
+		}
+
+		definitions = append(definitions, graphQLDefinition{
+			kind:   kind,
+			name:   name,
+			fields: graphQLFields(body),
+		})
+	}
+
+	inbound, outbound := strset.New(), strset.New()
+	var endpoints []string
+	for _, d := range definitions {
+		operation, ok := roots[d.name]
+		if !ok || d.kind != "type" {
+			continue
+		}
+
+		for _, field := range d.fields {
+			match := graphQLFieldExp.FindStringSubmatch(field)
+			if match == nil {
+				continue
+			}
+			endpoints = append(endpoints, operation+"."+match[1])
+
+			for _, arg := range graphQLArgumentTypeExp.FindAllStringSubmatch(match[2], -1) {
+				if inputTypes.Has(arg[1]) {
+					inbound.Add(arg[1])
+				}
+			}
+
+			returnType := strings.Trim(match[3], "[]! \t\n")
+			if objectTypes.Has(returnType) {
+				outbound.Add(returnType)
+			}
+		}
+	}
+
+	if len(endpoints) == 0 {
+		return nil, nil, nil
+	}
+	sort.Strings(endpoints)
+
+	name := strings.TrimSuffix(path.Base(reader.RealPath), path.Ext(reader.RealPath))
+
+	return []pkg.Package{
+		newAPIServicePackage(
+			name,
+			"",
+			pkg.APIServiceEntry{
+				Specification: "graphql",
+				Endpoints:     endpoints,
+				DataFlows:     newDataFlows(inbound, outbound),
+			},
+			reader.Location,
+		),
+	}, nil, nil
+}
+
+// graphQLFields splits the body of a type definition into its field definitions, keeping argument lists (which may
+// span several lines) together with the field they belong to.
+func graphQLFields(body string) []string {
+	var fields []string
+	var current strings.Builder
+	depth := 0
+	for _, c := range body {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '\n', ',':
+			if depth == 0 {
+				if field := strings.TrimSpace(current.String()); field != "" {
+					fields = append(fields, field)
+				}
+				current.Reset()
+				continue
+			}
+		}
+		current.WriteRune(c)
+	}
+	if field := strings.TrimSpace(current.String()); field != "" {
+		fields = append(fields, field)
+	}
+	return fields
+}
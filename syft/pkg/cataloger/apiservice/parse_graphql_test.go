@@ -0,0 +1,41 @@
+package apiservice
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseGraphQL(t *testing.T) {
+	fixture := "test-fixtures/graphql/schema.graphql"
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "schema",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Type:      pkg.APIServicePkg,
+			Metadata: pkg.APIServiceEntry{
+				Specification: "graphql",
+				Endpoints: []string{
+					"mutation.addBook",
+					"mutation.deleteBook",
+					"query.authors",
+					"query.book",
+					"query.books",
+					"query.version",
+				},
+				DataFlows: []pkg.APIServiceDataFlow{
+					{Flow: "outbound", Classification: "Author"},
+					{Flow: "outbound", Classification: "Book"},
+					{Flow: "inbound", Classification: "NewBook"},
+				},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseGraphQL, expectedPkgs, expectedRelationships)
+}
@@ -0,0 +1,173 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseOpenAPI
+
+// openAPIDocument is the subset of an OpenAPI 3.x (or Swagger 2.0) document needed to describe the service.
+type openAPIDocument struct {
+	OpenAPI string `yaml:"openapi"`
+	Swagger string `yaml:"swagger"`
+	Info    struct {
+		Title       string `yaml:"title"`
+		Version     string `yaml:"version"`
+		Description string `yaml:"description"`
+	} `yaml:"info"`
+
+	// OpenAPI 3.x server locations
+	Servers []struct {
+		URL string `yaml:"url"`
+	} `yaml:"servers"`
+
+	// Swagger 2.0 server location
+	Host     string   `yaml:"host"`
+	BasePath string   `yaml:"basePath"`
+	Schemes  []string `yaml:"schemes"`
+
+	Security []map[string][]string `yaml:"security"`
+
+	// Paths maps each path to its path item, where each method (e.g. "get") is an operation and other keys
+	// (e.g. "parameters" or "summary") describe the path item itself.
+	Paths map[string]map[string]yaml.Node `yaml:"paths"`
+}
+
+type openAPIOperation struct {
+	Security    []map[string][]string `yaml:"security"`
+	Parameters  any                   `yaml:"parameters"`
+	RequestBody any                   `yaml:"requestBody"`
+	Responses   any                   `yaml:"responses"`
+}
+
+var openAPIMethods = strset.New("get", "put", "post", "delete", "options", "head", "patch", "trace")
+
+// parseOpenAPI is a parser function for OpenAPI (and Swagger) documents, in either JSON or YAML, returning the service
+// described by the document.
+func parseOpenAPI(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read OpenAPI document: %w", err)
+	}
+
+	// note: JSON is a subset of YAML, so both forms are decoded the same way
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+
+	metadata := pkg.APIServiceEntry{
+		Description: strings.TrimSpace(doc.Info.Description),
+	}
+	switch {
+	case doc.OpenAPI != "":
+		metadata.Specification = "openapi"
+		metadata.SpecificationVersion = doc.OpenAPI
+	case doc.Swagger != "":
+		metadata.Specification = "swagger"
+		metadata.SpecificationVersion = doc.Swagger
+	default:
+		log.WithFields("path", reader.RealPath).Trace("file is not an OpenAPI document, skipping")
+		return nil, nil, nil
+	}
+
+	baseURL := doc.baseURL()
+	authenticated := len(doc.Security) > 0
+	inbound, outbound := strset.New(), strset.New()
+
+	var paths []string
+	for p := range doc.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		metadata.Endpoints = append(metadata.Endpoints, baseURL+p)
+
+		for method, node := range doc.Paths[p] {
+			if !openAPIMethods.Has(strings.ToLower(method)) {
+				continue
+			}
+
+			var op openAPIOperation
+			if err := node.Decode(&op); err != nil {
+				log.WithFields("path", reader.RealPath, "endpoint", p, "error", err).Trace("unable to parse OpenAPI operation")
+				continue
+			}
+
+			if len(op.Security) > 0 {
+				authenticated = true
+			}
+			inbound.Add(openAPISchemaRefs(op.Parameters)...)
+			inbound.Add(openAPISchemaRefs(op.RequestBody)...)
+			outbound.Add(openAPISchemaRefs(op.Responses)...)
+		}
+	}
+
+	if authenticated {
+		metadata.Authenticated = &authenticated
+	}
+	metadata.DataFlows = newDataFlows(inbound, outbound)
+
+	name := doc.Info.Title
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(reader.RealPath), path.Ext(reader.RealPath))
+	}
+
+	return []pkg.Package{
+		newAPIServicePackage(name, doc.Info.Version, metadata, reader.Location),
+	}, nil, nil
+}
+
+// baseURL returns the location the paths of the document are relative to (without a trailing slash), which may
+// itself be relative (e.g. "/v1") or empty.
+func (d openAPIDocument) baseURL() string {
+	var base string
+	switch {
+	case len(d.Servers) > 0:
+		base = d.Servers[0].URL
+	case d.Host != "":
+		scheme := "https"
+		if len(d.Schemes) > 0 {
+			scheme = d.Schemes[0]
+		}
+		base = scheme + "://" + d.Host + d.BasePath
+	default:
+		base = d.BasePath
+	}
+	return strings.TrimSuffix(base, "/")
+}
+
+// openAPISchemaRefs returns the names of all schemas referenced (via "$ref") within the given node, for example
+// "#/components/schemas/Pet" (OpenAPI 3.x) or "#/definitions/Pet" (Swagger 2.0) returns "Pet".
+func openAPISchemaRefs(node any) []string {
+	var refs []string
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok && (strings.Contains(ref, "/schemas/") || strings.HasPrefix(ref, "#/definitions/")) {
+			refs = append(refs, path.Base(ref))
+		}
+		for _, value := range v {
+			refs = append(refs, openAPISchemaRefs(value)...)
+		}
+	case []any:
+		for _, value := range v {
+			refs = append(refs, openAPISchemaRefs(value)...)
+		}
+	}
+	return refs
+}
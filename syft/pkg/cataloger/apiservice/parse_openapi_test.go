@@ -0,0 +1,82 @@
+package apiservice
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseOpenAPI(t *testing.T) {
+	authenticated := true
+
+	tests := []struct {
+		fixture string
+		want    []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/openapi/petstore.openapi.yaml",
+			want: []pkg.Package{
+				{
+					Name:    "Swagger Petstore",
+					Version: "1.0.7",
+					Type:    pkg.APIServicePkg,
+					Metadata: pkg.APIServiceEntry{
+						Specification:        "openapi",
+						SpecificationVersion: "3.0.3",
+						Description:          "A sample pet store server.",
+						Endpoints: []string{
+							"https://petstore.example.com/api/v3/pet",
+							"https://petstore.example.com/api/v3/pet/{petId}",
+							"https://petstore.example.com/api/v3/store/order",
+						},
+						Authenticated: &authenticated,
+						DataFlows: []pkg.APIServiceDataFlow{
+							{Flow: "inbound", Classification: "Order"},
+							{Flow: "outbound", Classification: "OrderReceipt"},
+							{Flow: "bi-directional", Classification: "Pet"},
+						},
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/swagger/swagger.json",
+			want: []pkg.Package{
+				{
+					Name:    "Inventory API",
+					Version: "2.1.0",
+					Type:    pkg.APIServicePkg,
+					Metadata: pkg.APIServiceEntry{
+						Specification:        "swagger",
+						SpecificationVersion: "2.0",
+						Endpoints: []string{
+							"http://inventory.example.com/v2/items",
+						},
+						DataFlows: []pkg.APIServiceDataFlow{
+							{Flow: "outbound", Classification: "Item"},
+							{Flow: "inbound", Classification: "NewItem"},
+						},
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/not-openapi/openapi.yaml",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			for i := range test.want {
+				test.want[i].Locations = file.NewLocationSet(file.NewLocation(test.fixture))
+			}
+
+			var expectedRelationships []artifact.Relationship
+
+			pkgtest.TestFileParser(t, test.fixture, parseOpenAPI, test.want, expectedRelationships)
+		})
+	}
+}
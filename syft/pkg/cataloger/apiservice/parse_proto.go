@@ -0,0 +1,110 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseProto
+
+var (
+	// protoCommentExp matches comments (and string literals, so that comment markers within strings are left as-is)
+	protoCommentExp = regexp.MustCompile(`(?s)"(?:[^"\\\n]|\\.)*"|//[^\n]*|/\*.*?\*/`)
+	protoSyntaxExp  = regexp.MustCompile(`(?m)^\s*(syntax|edition)\s*=\s*"([^"]+)"\s*;`)
+	protoPackageExp = regexp.MustCompile(`(?m)^\s*package\s+([\w.]+)\s*;`)
+	protoServiceExp = regexp.MustCompile(`\bservice\s+(\w+)\s*\{`)
+
+	// protoRPCExp matches a method within a service, for example:
+	// "rpc SayHello (HelloRequest) returns (HelloReply);" or "rpc Chat(stream Message) returns (stream Message) {}"
+	protoRPCExp = regexp.MustCompile(`\brpc\s+(\w+)\s*\(\s*(?:stream\s+)?([\w.]+)\s*\)\s*returns\s*\(\s*(?:stream\s+)?([\w.]+)\s*\)`)
+)
+
+// parseProto is a parser function for protobuf files, returning each gRPC service declared within the file.
+func parseProto(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read protobuf file: %w", err)
+	}
+
+	source := protoCommentExp.ReplaceAllStringFunc(string(contents), func(match string) string {
+		if strings.HasPrefix(match, `"`) {
+			return match
+		}
+		return ""
+	})
+
+	// files without a syntax statement are proto2
+	syntax := "proto2"
+	if match := protoSyntaxExp.FindStringSubmatch(source); match != nil {
+		syntax = match[2]
+		if match[1] == "edition" {
+			syntax = "edition " + syntax
+		}
+	}
+
+	var protoPackage string
+	if match := protoPackageExp.FindStringSubmatch(source); match != nil {
+		protoPackage = match[1]
+	}
+
+	var pkgs []pkg.Package
+	for _, loc := range protoServiceExp.FindAllStringSubmatchIndex(source, -1) {
+		name := source[loc[2]:loc[3]]
+		if protoPackage != "" {
+			name = protoPackage + "." + name
+		}
+
+		body := enclosedBlock(source[loc[1]:])
+
+		inbound, outbound := strset.New(), strset.New()
+		var endpoints []string
+		for _, rpc := range protoRPCExp.FindAllStringSubmatch(body, -1) {
+			endpoints = append(endpoints, "/"+name+"/"+rpc[1])
+			inbound.Add(rpc[2])
+			outbound.Add(rpc[3])
+		}
+
+		pkgs = append(pkgs, newAPIServicePackage(
+			name,
+			"",
+			pkg.APIServiceEntry{
+				Specification:        "grpc",
+				SpecificationVersion: syntax,
+				Endpoints:            endpoints,
+				DataFlows:            newDataFlows(inbound, outbound),
+			},
+			reader.Location,
+		))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// enclosedBlock returns the contents of a block up to the closing brace matching an (already consumed) opening brace.
+func enclosedBlock(contents string) string {
+	depth := 1
+	for i, c := range contents {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return contents[:i]
+			}
+		}
+	}
+	return contents
+}
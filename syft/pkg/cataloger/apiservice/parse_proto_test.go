@@ -0,0 +1,60 @@
+package apiservice
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseProto(t *testing.T) {
+	fixture := "test-fixtures/proto/greeter.proto"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "helloworld.v1.Greeter",
+			Locations: locations,
+			Type:      pkg.APIServicePkg,
+			Metadata: pkg.APIServiceEntry{
+				Specification:        "grpc",
+				SpecificationVersion: "proto3",
+				Endpoints: []string{
+					"/helloworld.v1.Greeter/SayHello",
+					"/helloworld.v1.Greeter/Chat",
+				},
+				DataFlows: []pkg.APIServiceDataFlow{
+					{Flow: "outbound", Classification: "HelloReply"},
+					{Flow: "bi-directional", Classification: "HelloRequest"},
+				},
+			},
+		},
+		{
+			Name:      "helloworld.v1.Health",
+			Locations: locations,
+			Type:      pkg.APIServicePkg,
+			Metadata: pkg.APIServiceEntry{
+				Specification:        "grpc",
+				SpecificationVersion: "proto3",
+				Endpoints: []string{
+					"/helloworld.v1.Health/Check",
+				},
+				DataFlows: []pkg.APIServiceDataFlow{
+					{Flow: "inbound", Classification: "HealthCheckRequest"},
+					{Flow: "outbound", Classification: "HealthCheckResponse"},
+				},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseProto, expectedPkgs, expectedRelationships)
+}
+
+func TestParseProto_noServices(t *testing.T) {
+	fixture := "test-fixtures/proto/messages.proto"
+
+	pkgtest.TestFileParser(t, fixture, parseProto, nil, nil)
+}
@@ -15,3 +15,11 @@ func NewDBCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseAlpmDB, pkg.AlpmDBGlob).
 		WithProcessors(dependency.Processor(dbEntryDependencySpecifier))
 }
+
+// NewSourcePackageCataloger returns a new cataloger object for Arch Linux build recipes (PKGBUILD and .SRCINFO files),
+// as found in AUR and ABS build repositories.
+func NewSourcePackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("alpm-source-package-cataloger").
+		WithParserByGlobs(parseSrcinfo, "**/.SRCINFO").
+		WithParserByGlobs(parsePkgbuild, "**/PKGBUILD")
+}
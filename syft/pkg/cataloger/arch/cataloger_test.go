@@ -327,3 +327,27 @@ func TestCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func Test_SourcePackageCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"aur/paru/.SRCINFO",
+			"aur/paru/PKGBUILD",
+			"aur/yay-bin/PKGBUILD",
+		}).
+		// the PKGBUILD parser looks for a .SRCINFO file in the same directory
+		IgnoreUnfulfilledPathResponses("aur/yay-bin/.SRCINFO").
+		TestCataloger(t, NewSourcePackageCataloger())
+}
+
+func TestSourcePackageCataloger_PrefersSrcinfo(t *testing.T) {
+	// note: the PKGBUILD alongside the .SRCINFO file is not reported
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsPackageStrings([]string{
+			"paru @ 2.0.3-1 (aur/paru/.SRCINFO)",
+			"yay-bin @ 12.3.5-1 (aur/yay-bin/PKGBUILD)",
+		}).
+		TestCataloger(t, NewSourcePackageCataloger())
+}
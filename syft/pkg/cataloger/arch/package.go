@@ -55,3 +55,52 @@ func packageURL(m *parsedData, distro *linux.Release) string {
 		"",
 	).ToString()
 }
+
+func newSourcePackage(m pkg.AlpmSourceEntry, licenses []string, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.BasePackage,
+		Version:   m.Version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(location, licenses...)...),
+		PURL:      packageurl.NewPackageURL("alpm", "", m.BasePackage, m.Version, nil, "").ToString(),
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.AlpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
+
+func newDependencyPackage(name string, m pkg.AlpmDependencyEntry, location file.Location) pkg.Package {
+	// only an exact version relation pins the version of the dependency
+	var version string
+	if v, ok := strings.CutPrefix(m.VersionConstraint, "="); ok {
+		version = v
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      packageurl.NewPackageURL("alpm", "", name, version, nil, "").ToString(),
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.AlpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
+
+func newSourceArchivePackage(name, version string, qualifiers packageurl.Qualifiers, m pkg.AlpmSourceArchiveEntry, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      packageurl.NewPackageURL(packageurl.TypeGeneric, "", name, version, qualifiers, "").ToString(),
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.AlpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
@@ -0,0 +1,198 @@
+package arch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parsePkgbuild
+
+	// pkgbuildAssignmentPattern matches a variable assignment (e.g. "pkgver=1.0", "depends=(" or "source+=(").
+	pkgbuildAssignmentPattern = regexp.MustCompile(`^(?P<name>[A-Za-z_][A-Za-z0-9_]*)(?P<append>\+?)=(?P<value>.*)$`)
+
+	// pkgbuildFunctionPattern matches the start of a function definition (e.g. "package() {").
+	pkgbuildFunctionPattern = regexp.MustCompile(`^(function\s+)?[A-Za-z_][A-Za-z0-9_-]*\s*\(\)`)
+
+	// pkgbuildVariablePattern matches a simple variable reference: "$name" or "${name}".
+	pkgbuildVariablePattern = regexp.MustCompile(`\$\{(?P<braced>[A-Za-z_][A-Za-z0-9_]*)\}|\$(?P<bare>[A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// parsePkgbuild is a parser function for PKGBUILD files, returning the package base along with its dependencies and
+// pinned sources. The PKGBUILD is not executed: only top-level assignments are read and simple variable references
+// are expanded, so values assigned within functions (e.g. for split packages) are not reported. When a .SRCINFO file
+// exists alongside the PKGBUILD then the PKGBUILD is skipped in favor of the .SRCINFO file.
+func parsePkgbuild(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if resolver != nil {
+		srcinfo, err := resolver.FilesByPath(path.Join(path.Dir(reader.RealPath), ".SRCINFO"))
+		if err == nil && len(srcinfo) > 0 {
+			log.WithFields("path", reader.RealPath).Trace("skipping PKGBUILD with a .SRCINFO file")
+			return nil, nil, nil
+		}
+	}
+
+	recipe, err := readPkgbuild(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read PKGBUILD file: %w", err)
+	}
+
+	pkgs, relationships := newSourcePackageWithDependencies(recipe, reader.Location)
+	return pkgs, relationships, nil
+}
+
+func readPkgbuild(reader file.LocationReadCloser) (buildRecipe, error) {
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	recipe := make(buildRecipe)
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		if pkgbuildFunctionPattern.MatchString(line) {
+			if !strings.HasSuffix(line, "}") {
+				// function bodies end with a closing brace at the start of a line
+				for i+1 < len(lines) && !strings.HasPrefix(lines[i+1], "}") {
+					i++
+				}
+				i++
+			}
+			continue
+		}
+
+		match := internal.MatchNamedCaptureGroups(pkgbuildAssignmentPattern, line)
+		if match["name"] == "" {
+			continue
+		}
+
+		var values []string
+		if text, ok := strings.CutPrefix(match["value"], "("); ok {
+			// arrays may span multiple lines
+			words, closed := shellWords(text, recipe, true)
+			for !closed && i+1 < len(lines) {
+				i++
+				text += "\n" + lines[i]
+				words, closed = shellWords(text, recipe, true)
+			}
+			values = words
+		} else if words, _ := shellWords(match["value"], recipe, false); len(words) > 0 {
+			values = words[:1]
+		}
+
+		if match["append"] != "" {
+			recipe[match["name"]] = append(recipe[match["name"]], values...)
+		} else {
+			recipe[match["name"]] = values
+		}
+	}
+
+	return recipe, nil
+}
+
+// shellWords splits the given text into words following shell quoting rules, expanding simple variable references
+// outside of single quotes. When closing is set the text is read up to the first unquoted ")", and whether it was
+// found is returned.
+func shellWords(text string, recipe buildRecipe, closing bool) ([]string, bool) {
+	var words []string
+	var word, unquoted strings.Builder
+	inWord := false
+
+	expand := func() {
+		word.WriteString(expandVariables(unquoted.String(), recipe))
+		unquoted.Reset()
+	}
+	flush := func() {
+		expand()
+		if inWord {
+			words = append(words, word.String())
+		}
+		word.Reset()
+		inWord = false
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '\'':
+			expand()
+			end := strings.IndexByte(text[i+1:], '\'')
+			if end < 0 {
+				end = len(text) - i - 1
+			}
+			word.WriteString(text[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+		case c == '"':
+			expand()
+			var quoted strings.Builder
+			for i++; i < len(text) && text[i] != '"'; i++ {
+				if text[i] == '\\' && i+1 < len(text) {
+					i++
+				}
+				quoted.WriteByte(text[i])
+			}
+			word.WriteString(expandVariables(quoted.String(), recipe))
+			inWord = true
+		case c == '\\' && i+1 < len(text):
+			i++
+			if text[i] != '\n' {
+				expand()
+				word.WriteByte(text[i])
+				inWord = true
+			}
+		case c == '#' && !inWord:
+			// comments run to the end of the line
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = len(text) - i
+			}
+			i += end
+		case c == ')' && closing:
+			flush()
+			return words, true
+		case c == ' ' || c == '\t' || c == '\n' || c == ';':
+			flush()
+		default:
+			unquoted.WriteByte(c)
+			inWord = true
+		}
+	}
+
+	flush()
+	return words, !closing
+}
+
+// expandVariables replaces references to variables that have been assigned, where an array reference refers to its
+// first element (as in bash). References to unknown variables are left as-is.
+func expandVariables(value string, recipe buildRecipe) string {
+	if !strings.Contains(value, "$") {
+		return value
+	}
+	return pkgbuildVariablePattern.ReplaceAllStringFunc(value, func(reference string) string {
+		match := internal.MatchNamedCaptureGroups(pkgbuildVariablePattern, reference)
+		name := match["braced"]
+		if name == "" {
+			name = match["bare"]
+		}
+		if _, ok := recipe[name]; ok {
+			return recipe.value(name)
+		}
+		return reference
+	})
+}
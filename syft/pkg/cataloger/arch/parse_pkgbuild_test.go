@@ -0,0 +1,162 @@
+package arch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePkgbuild(t *testing.T) {
+	fixture := "test-fixtures/source-package/yay/PKGBUILD"
+	location := file.NewLocation(fixture)
+
+	yay := pkg.Package{
+		Name:      "yay",
+		Version:   "1:12.3.5-1",
+		PURL:      "pkg:alpm/yay@1:12.3.5-1",
+		Locations: file.NewLocationSet(location),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL-3.0-or-later", location)),
+		Type:      pkg.AlpmPkg,
+		Metadata: pkg.AlpmSourceEntry{
+			BasePackage:   "yay",
+			Version:       "1:12.3.5-1",
+			Description:   "Yet another yogurt. Pacman wrapper and AUR helper written in go.",
+			URL:           "https://github.com/Jguer/yay",
+			Architectures: []string{"i686", "pentium4", "x86_64", "arm", "armv7h", "armv6h", "aarch64"},
+			Packages:      []string{"yay"},
+			// note: assignments within functions are not read
+			Depends:      []string{"pacman>6.1", "git", "glibc"},
+			MakeDepends:  []string{"go>=1.21", "gettext=0.22.4"},
+			CheckDepends: []string{"git"},
+			Sources: []string{
+				"yay-12.3.5.tar.gz::https://github.com/Jguer/yay/archive/v12.3.5.tar.gz",
+				"git+https://github.com/Jguer/aur.git#commit=4b6d3b4c2e0f9f8e3c9f1a2b3c4d5e6f7a8b9c0d",
+				"git+https://github.com/Jguer/votar.git#branch=main",
+				"yay.conf",
+				"https://example.org/downloads/$CARCH/extra-12.3.5.tar.gz",
+			},
+		},
+	}
+
+	dependency := func(name, version, kind, constraint string) pkg.Package {
+		purl := "pkg:alpm/" + name
+		if version != "" {
+			purl += "@" + version
+		}
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      purl,
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.AlpmPkg,
+			Metadata:  pkg.AlpmDependencyEntry{Kind: kind, VersionConstraint: constraint},
+		}
+	}
+
+	// note: duplicate dependencies are reported once
+	dependencies := []pkg.Package{
+		dependency("pacman", "", "depends", ">6.1"),
+		dependency("git", "", "depends", ""),
+		dependency("glibc", "", "depends", ""),
+		dependency("go", "", "makedepends", ">=1.21"),
+		dependency("gettext", "0.22.4", "makedepends", "=0.22.4"),
+	}
+
+	// note: local files, unpinned VCS sources and sources with unexpanded variables are not reported
+	sources := []pkg.Package{
+		{
+			// the archive is named by the "name::" prefix
+			Name:      "yay",
+			Version:   "12.3.5",
+			PURL:      "pkg:generic/yay@12.3.5?download_url=https://github.com/Jguer/yay/archive/v12.3.5.tar.gz",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.AlpmPkg,
+			Metadata: pkg.AlpmSourceArchiveEntry{
+				Source: "yay-12.3.5.tar.gz::https://github.com/Jguer/yay/archive/v12.3.5.tar.gz",
+				Digest: &file.Digest{Algorithm: "sha256", Value: "2fb6121a6eb4c5e6afaf22212b2ed15022500a4bc4ab7b7d6bcf8d6b3da5a2d2"},
+			},
+		},
+		{
+			Name:      "aur",
+			Version:   "4b6d3b4c2e0f9f8e3c9f1a2b3c4d5e6f7a8b9c0d",
+			PURL:      "pkg:generic/aur@4b6d3b4c2e0f9f8e3c9f1a2b3c4d5e6f7a8b9c0d?vcs_url=git%2Bhttps://github.com/Jguer/aur.git%404b6d3b4c2e0f9f8e3c9f1a2b3c4d5e6f7a8b9c0d",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.AlpmPkg,
+			Metadata: pkg.AlpmSourceArchiveEntry{
+				Source: "git+https://github.com/Jguer/aur.git#commit=4b6d3b4c2e0f9f8e3c9f1a2b3c4d5e6f7a8b9c0d",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+	for _, d := range dependencies {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: d, To: yay, Type: artifact.DependencyOfRelationship})
+	}
+	for _, s := range sources {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: yay, To: s, Type: artifact.ContainsRelationship})
+	}
+
+	expectedPkgs := append([]pkg.Package{yay}, dependencies...)
+	expectedPkgs = append(expectedPkgs, sources...)
+
+	pkgtest.TestFileParser(t, fixture, parsePkgbuild, expectedPkgs, expectedRelationships)
+}
+
+func Test_shellWords(t *testing.T) {
+	recipe := buildRecipe{
+		"pkgname": {"foo", "foo-docs"},
+		"pkgver":  {"1.2"},
+	}
+
+	tests := []struct {
+		name       string
+		text       string
+		closing    bool
+		wantWords  []string
+		wantClosed bool
+	}{
+		{
+			name:       "scalar",
+			text:       `"$pkgname-${pkgver}" # comment`,
+			wantWords:  []string{"foo-1.2"},
+			wantClosed: true,
+		},
+		{
+			name:       "single quotes are not expanded",
+			text:       `'$pkgname' "it's" a\ b`,
+			wantWords:  []string{"$pkgname", "it's", "a b"},
+			wantClosed: true,
+		},
+		{
+			name:       "unknown and complex references are kept",
+			text:       `$CARCH ${pkgver//./_}`,
+			wantWords:  []string{"$CARCH", "${pkgver//./_}"},
+			wantClosed: true,
+		},
+		{
+			name:       "array",
+			text:       "'a' # comment with )\n  b) c",
+			closing:    true,
+			wantWords:  []string{"a", "b"},
+			wantClosed: true,
+		},
+		{
+			name:      "unterminated array",
+			text:      "'a' \"b)\"",
+			closing:   true,
+			wantWords: []string{"a", "b)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, closed := shellWords(tt.text, recipe, tt.closing)
+			assert.Equal(t, tt.wantWords, words)
+			assert.Equal(t, tt.wantClosed, closed)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package arch
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseSrcinfo
+
+// parseSrcinfo is a parser function for .SRCINFO files (as generated by "makepkg --printsrcinfo"), returning the
+// package base along with its dependencies and pinned sources.
+func parseSrcinfo(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	recipe := make(buildRecipe)
+	inPackage := false
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case key == "pkgname":
+			// the package sections follow the package base section and may override its values for split packages
+			inPackage = true
+			recipe[key] = append(recipe[key], value)
+		case inPackage:
+			// only the dependencies of split packages are kept, other overrides (e.g. pkgdesc) are not reported
+			if isDependencyKey(key) && !slices.Contains(recipe[key], value) {
+				recipe[key] = append(recipe[key], value)
+			}
+		default:
+			recipe[key] = append(recipe[key], value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read .SRCINFO file: %w", err)
+	}
+
+	pkgs, relationships := newSourcePackageWithDependencies(recipe, reader.Location)
+	return pkgs, relationships, nil
+}
+
+func isDependencyKey(key string) bool {
+	key, _, _ = strings.Cut(key, "_")
+	return key == "depends"
+}
@@ -0,0 +1,94 @@
+package arch
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseSrcinfo(t *testing.T) {
+	fixture := "test-fixtures/source-package/zstd/.SRCINFO"
+	location := file.NewLocation(fixture)
+
+	zstd := pkg.Package{
+		Name:      "zstd",
+		Version:   "1.5.5-2",
+		PURL:      "pkg:alpm/zstd@1.5.5-2",
+		Locations: file.NewLocationSet(location),
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("BSD-3-Clause", location),
+			pkg.NewLicenseFromLocations("GPL-2.0-only", location),
+		),
+		Type: pkg.AlpmPkg,
+		Metadata: pkg.AlpmSourceEntry{
+			BasePackage:   "zstd",
+			Version:       "1.5.5-2",
+			Description:   "Zstandard - Fast real-time compression algorithm",
+			URL:           "https://facebook.github.io/zstd/",
+			Architectures: []string{"x86_64"},
+			Packages:      []string{"zstd", "zstd-tools"},
+			// note: the dependencies of split packages are included
+			Depends:      []string{"glibc", "gcc-libs", "zlib", "xz", "lz4", "libzstd.so=1-64"},
+			MakeDepends:  []string{"cmake", "gtest", "ninja"},
+			CheckDepends: []string{"gtest"},
+			Sources: []string{
+				"https://github.com/facebook/zstd/releases/download/v1.5.5/zstd-1.5.5.tar.zst",
+				"https://github.com/facebook/zstd/releases/download/v1.5.5/zstd-1.5.5.tar.zst.sig",
+			},
+		},
+	}
+
+	dependency := func(name, version, kind, constraint string) pkg.Package {
+		purl := "pkg:alpm/" + name
+		if version != "" {
+			purl += "@" + version
+		}
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      purl,
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.AlpmPkg,
+			Metadata:  pkg.AlpmDependencyEntry{Kind: kind, VersionConstraint: constraint},
+		}
+	}
+
+	dependencies := []pkg.Package{
+		dependency("glibc", "", "depends", ""),
+		dependency("gcc-libs", "", "depends", ""),
+		dependency("zlib", "", "depends", ""),
+		dependency("xz", "", "depends", ""),
+		dependency("lz4", "", "depends", ""),
+		dependency("libzstd.so", "1-64", "depends", "=1-64"),
+		dependency("cmake", "", "makedepends", ""),
+		dependency("gtest", "", "makedepends", ""),
+		dependency("ninja", "", "makedepends", ""),
+	}
+
+	// note: the signature is not reported as a source archive
+	archive := pkg.Package{
+		Name:      "zstd",
+		Version:   "1.5.5",
+		PURL:      "pkg:generic/zstd@1.5.5?download_url=https://github.com/facebook/zstd/releases/download/v1.5.5/zstd-1.5.5.tar.zst",
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.AlpmPkg,
+		Metadata: pkg.AlpmSourceArchiveEntry{
+			Source: "https://github.com/facebook/zstd/releases/download/v1.5.5/zstd-1.5.5.tar.zst",
+			Digest: &file.Digest{Algorithm: "sha256", Value: "ce264bca60eb2f0e99e4508cffd0d4d19dd362e84244d7fc941e79fa69ccf673"},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+	for _, d := range dependencies {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: d, To: zstd, Type: artifact.DependencyOfRelationship})
+	}
+	expectedRelationships = append(expectedRelationships, artifact.Relationship{From: zstd, To: archive, Type: artifact.ContainsRelationship})
+
+	expectedPkgs := append([]pkg.Package{zstd}, dependencies...)
+	expectedPkgs = append(expectedPkgs, archive)
+
+	pkgtest.TestFileParser(t, fixture, parseSrcinfo, expectedPkgs, expectedRelationships)
+}
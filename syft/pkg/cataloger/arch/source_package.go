@@ -0,0 +1,247 @@
+package arch
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+var (
+	// dependencyPattern matches a single dependency (e.g. "openssl>=3.0" or "libfoo.so=1-64").
+	dependencyPattern = regexp.MustCompile(`^(?P<name>[^<>=\s]+)(?P<constraint>[<>=]+\S+)?$`)
+
+	// sourceArchiveNameVersionPattern matches the name and version of an archive file name without its extension
+	// (e.g. "zstd-1.5.5" or "yay-v12.3.5").
+	sourceArchiveNameVersionPattern = regexp.MustCompile(`^(?P<name>.+?)-v?(?P<version>\d[\w.+~]*)$`)
+
+	sourceArchiveSuffixes = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst", ".tar.lz", ".tgz", ".tbz2", ".txz", ".tar", ".zip", ".crate", ".gem"}
+
+	// vcsProtocols are the version control systems supported by makepkg for sources.
+	vcsProtocols = map[string]bool{"git": true, "hg": true, "svn": true, "bzr": true, "fossil": true}
+
+	// checksumAlgorithms are the checksum arrays that are paired with the sources, in order of preference.
+	checksumAlgorithms = []string{"sha512", "sha384", "sha256", "sha224", "sha1", "md5"}
+)
+
+// buildRecipe holds the variables of a PKGBUILD or the fields of a .SRCINFO, where every variable is treated as an
+// array (scalar variables are arrays of a single value).
+type buildRecipe map[string][]string
+
+func (r buildRecipe) value(key string) string {
+	if values := r[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// archValues returns the values of the given array along with the values of all architecture-specific variants of
+// the array (e.g. "depends_x86_64").
+func (r buildRecipe) archValues(key string) []string {
+	var values []string
+	values = append(values, r[key]...)
+	for _, arch := range r["arch"] {
+		values = append(values, r[key+"_"+arch]...)
+	}
+	return values
+}
+
+func (r buildRecipe) entry() pkg.AlpmSourceEntry {
+	base := r.value("pkgbase")
+	if base == "" {
+		base = r.value("pkgname")
+	}
+
+	version := r.value("pkgver")
+	if rel := r.value("pkgrel"); rel != "" {
+		version += "-" + rel
+	}
+	if epoch := r.value("epoch"); epoch != "" && epoch != "0" {
+		version = epoch + ":" + version
+	}
+
+	return pkg.AlpmSourceEntry{
+		BasePackage:   base,
+		Version:       version,
+		Description:   r.value("pkgdesc"),
+		URL:           r.value("url"),
+		Architectures: r["arch"],
+		Packages:      r["pkgname"],
+		Depends:       r.archValues("depends"),
+		MakeDepends:   r.archValues("makedepends"),
+		CheckDepends:  r.archValues("checkdepends"),
+		Sources:       r.archValues("source"),
+	}
+}
+
+// digests returns the checksums of the sources, keyed by source. The checksum arrays are positional, so each one is
+// paired with the source array of the same architecture.
+func (r buildRecipe) digests() map[string]file.Digest {
+	digests := make(map[string]file.Digest)
+	suffixes := []string{""}
+	for _, arch := range r["arch"] {
+		suffixes = append(suffixes, "_"+arch)
+	}
+
+	for _, suffix := range suffixes {
+		sources := r["source"+suffix]
+		for _, algorithm := range checksumAlgorithms {
+			sums := r[algorithm+"sums"+suffix]
+			if len(sums) == 0 {
+				continue
+			}
+			for i, source := range sources {
+				if i < len(sums) && sums[i] != "SKIP" {
+					digests[source] = file.Digest{Algorithm: algorithm, Value: sums[i]}
+				}
+			}
+			break
+		}
+	}
+	return digests
+}
+
+func newSourcePackageWithDependencies(recipe buildRecipe, location file.Location) ([]pkg.Package, []artifact.Relationship) {
+	entry := recipe.entry()
+	if entry.BasePackage == "" {
+		return nil, nil
+	}
+
+	sourcePkg := newSourcePackage(entry, recipe["license"], location)
+	pkgs := []pkg.Package{sourcePkg}
+
+	var relationships []artifact.Relationship
+	seen := make(map[string]bool)
+	for _, kind := range []struct {
+		name         string
+		dependencies []string
+	}{
+		{name: "depends", dependencies: entry.Depends},
+		{name: "makedepends", dependencies: entry.MakeDepends},
+		{name: "checkdepends", dependencies: entry.CheckDepends},
+	} {
+		for _, dependency := range kind.dependencies {
+			match := internal.MatchNamedCaptureGroups(dependencyPattern, dependency)
+			name := match["name"]
+			if name == "" {
+				log.WithFields("dependency", dependency, "package", entry.BasePackage).Trace("unable to parse ALPM dependency")
+				continue
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			depPkg := newDependencyPackage(name, pkg.AlpmDependencyEntry{Kind: kind.name, VersionConstraint: match["constraint"]}, location)
+			pkgs = append(pkgs, depPkg)
+			relationships = append(relationships, artifact.Relationship{
+				From: depPkg,
+				To:   sourcePkg,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	digests := recipe.digests()
+	for _, source := range entry.Sources {
+		name, version, qualifiers, ok := pinnedSource(source)
+		if !ok {
+			continue
+		}
+
+		archive := pkg.AlpmSourceArchiveEntry{Source: source}
+		if digest, ok := digests[source]; ok {
+			archive.Digest = &digest
+		}
+
+		archivePkg := newSourceArchivePackage(name, version, qualifiers, archive, location)
+		pkgs = append(pkgs, archivePkg)
+		relationships = append(relationships, artifact.Relationship{
+			From: sourcePkg,
+			To:   archivePkg,
+			Type: artifact.ContainsRelationship,
+		})
+	}
+
+	return pkgs, relationships
+}
+
+// pinnedSource returns the name and version of a source that refers to a specific release: either a remote archive
+// with a version in its file name, or a VCS source pinned to a tag, commit or revision. Sources are in the form
+// "[name::]url[#fragment]", where the name renames the downloaded file and the fragment selects the VCS reference.
+func pinnedSource(source string) (string, string, packageurl.Qualifiers, bool) {
+	name, location, ok := strings.Cut(source, "::")
+	if !ok {
+		name, location = "", source
+	}
+	if strings.Contains(source, "$") {
+		// the source refers to variables that could not be expanded
+		return "", "", nil, false
+	}
+
+	scheme, _, ok := strings.Cut(location, "://")
+	if !ok {
+		// local files within the build repository
+		return "", "", nil, false
+	}
+
+	location, fragment, _ := strings.Cut(location, "#")
+	if vcs, _, _ := strings.Cut(scheme, "+"); vcsProtocols[vcs] {
+		return pinnedVCSSource(name, location, fragment)
+	}
+
+	if name == "" {
+		name = path.Base(strings.SplitN(location, "?", 2)[0])
+	}
+	archiveName, version := sourceArchiveNameVersion(name)
+	if version == "" {
+		return "", "", nil, false
+	}
+
+	return archiveName, version, packageurl.Qualifiers{{Key: "download_url", Value: location}}, true
+}
+
+func pinnedVCSSource(name, location, fragment string) (string, string, packageurl.Qualifiers, bool) {
+	key, ref, _ := strings.Cut(fragment, "=")
+	switch key {
+	case "tag", "commit", "revision":
+	default:
+		// sources without a reference (or following a branch) are not pinned
+		return "", "", nil, false
+	}
+	if ref == "" {
+		return "", "", nil, false
+	}
+
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(strings.TrimSuffix(location, "/")), ".git")
+	}
+
+	version := ref
+	if key == "tag" {
+		if v, ok := strings.CutPrefix(ref, "v"); ok && v != "" && v[0] >= '0' && v[0] <= '9' {
+			version = v
+		}
+	}
+
+	return name, version, packageurl.Qualifiers{{Key: "vcs_url", Value: location + "@" + ref}}, true
+}
+
+// sourceArchiveNameVersion returns the name and version of an archive from its file name (e.g. "zstd-1.5.5.tar.gz").
+func sourceArchiveNameVersion(fileName string) (string, string) {
+	for _, suffix := range sourceArchiveSuffixes {
+		base, ok := strings.CutSuffix(fileName, suffix)
+		if !ok || base == "" {
+			continue
+		}
+		match := internal.MatchNamedCaptureGroups(sourceArchiveNameVersionPattern, base)
+		return match["name"], match["version"]
+	}
+	return "", ""
+}
@@ -0,0 +1,59 @@
+package arch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/packageurl-go"
+)
+
+func Test_pinnedSource(t *testing.T) {
+	tests := []struct {
+		source         string
+		wantName       string
+		wantVersion    string
+		wantQualifiers packageurl.Qualifiers
+		wantOK         bool
+	}{
+		{
+			source:         "https://example.org/releases/foo-1.2.3.tar.gz?download=1",
+			wantName:       "foo",
+			wantVersion:    "1.2.3",
+			wantQualifiers: packageurl.Qualifiers{{Key: "download_url", Value: "https://example.org/releases/foo-1.2.3.tar.gz?download=1"}},
+			wantOK:         true,
+		},
+		{
+			source:         "git+https://github.com/org/project.git#tag=v2.0.1",
+			wantName:       "project",
+			wantVersion:    "2.0.1",
+			wantQualifiers: packageurl.Qualifiers{{Key: "vcs_url", Value: "git+https://github.com/org/project.git@v2.0.1"}},
+			wantOK:         true,
+		},
+		{
+			source:         "renamed::hg+https://hg.example.org/project#revision=42",
+			wantName:       "renamed",
+			wantVersion:    "42",
+			wantQualifiers: packageurl.Qualifiers{{Key: "vcs_url", Value: "hg+https://hg.example.org/project@42"}},
+			wantOK:         true,
+		},
+		{
+			source: "git+https://github.com/org/project.git",
+		},
+		{
+			source: "https://example.org/releases/latest.tar.gz",
+		},
+		{
+			source: "project.patch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			name, version, qualifiers, ok := pinnedSource(tt.source)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantVersion, version)
+			assert.Equal(t, tt.wantQualifiers, qualifiers)
+		})
+	}
+}
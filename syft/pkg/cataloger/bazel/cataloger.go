@@ -0,0 +1,16 @@
+/*
+Package bazel provides a concrete Cataloger implementation for Bazel modules (bzlmod).
+*/
+package bazel
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewModuleCataloger returns a new Bazel module cataloger object based on MODULE.bazel and MODULE.bazel.lock files.
+func NewModuleCataloger() pkg.Cataloger {
+	return generic.NewCataloger("bazel-module-cataloger").
+		WithParserByGlobs(parseModuleBazel, "**/MODULE.bazel").
+		WithParserByGlobs(parseModuleBazelLock, "**/MODULE.bazel.lock")
+}
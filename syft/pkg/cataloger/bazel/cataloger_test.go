@@ -0,0 +1,33 @@
+package bazel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain bazel module files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/MODULE.bazel",
+				"src/nested/MODULE.bazel.lock",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewModuleCataloger())
+		})
+	}
+}
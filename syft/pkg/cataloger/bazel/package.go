@@ -0,0 +1,65 @@
+package bazel
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const defaultRegistry = "https://bcr.bazel.build"
+
+func newModulePackage(m pkg.BazelModuleEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   m.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m),
+		Type:      pkg.BazelModulePkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(m pkg.BazelModuleEntry) string {
+	var qualifiers packageurl.Qualifiers
+
+	if m.Registry != "" && !isDefaultRegistry(m.Registry) {
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "repository_url",
+			Value: m.Registry,
+		})
+	}
+
+	if m.Override != nil && m.Override.Type == "git" && m.Override.Remote != "" {
+		ref := m.Override.Commit
+		if ref == "" {
+			ref = m.Override.Tag
+		}
+		vcsURL := m.Override.Remote
+		if ref != "" {
+			vcsURL += "@" + ref
+		}
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "vcs_url",
+			Value: vcsURL,
+		})
+	}
+
+	return packageurl.NewPackageURL(
+		"bazel",
+		"",
+		m.Name,
+		m.Version,
+		qualifiers,
+		"",
+	).ToString()
+}
+
+func isDefaultRegistry(registry string) bool {
+	return strings.TrimSuffix(registry, "/") == defaultRegistry
+}
@@ -0,0 +1,93 @@
+package bazel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/starlark"
+)
+
+var _ generic.Parser = parseModuleBazel
+
+// parseModuleBazel is a parser function for MODULE.bazel contents, returning all bazel_dep() modules along with
+// any override information that applies to them.
+func parseModuleBazel(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	calls, err := starlark.ReadCalls(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read MODULE.bazel file: %w", err)
+	}
+
+	var deps []pkg.BazelModuleEntry
+	overrides := make(map[string]starlark.Call)
+	for _, c := range calls {
+		switch c.Name {
+		case "bazel_dep":
+			name := c.String("name")
+			if name == "" {
+				continue
+			}
+			repoName := c.String("repo_name")
+			if repoName == name {
+				repoName = ""
+			}
+			deps = append(deps, pkg.BazelModuleEntry{
+				Name:          name,
+				Version:       c.String("version"),
+				RepoName:      repoName,
+				DevDependency: c.Bool("dev_dependency"),
+			})
+		case "git_override", "archive_override", "local_path_override", "single_version_override":
+			if moduleName := c.String("module_name"); moduleName != "" {
+				overrides[moduleName] = c
+			}
+		}
+	}
+
+	var pkgs []pkg.Package
+	for _, m := range deps {
+		if o, ok := overrides[m.Name]; ok {
+			applyOverride(&m, o)
+		}
+		pkgs = append(pkgs,
+			newModulePackage(
+				m,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		)
+	}
+
+	return pkgs, nil, nil
+}
+
+func applyOverride(m *pkg.BazelModuleEntry, c starlark.Call) {
+	switch c.Name {
+	case "single_version_override":
+		// this still resolves from a registry, just with a pinned version and/or registry
+		if v := c.String("version"); v != "" {
+			m.Version = v
+		}
+		m.Registry = c.String("registry")
+	case "git_override":
+		m.Override = &pkg.BazelModuleOverride{
+			Type:   "git",
+			Remote: c.String("remote"),
+			Commit: c.String("commit"),
+			Tag:    c.String("tag"),
+		}
+	case "archive_override":
+		m.Override = &pkg.BazelModuleOverride{
+			Type:      "archive",
+			URLs:      append(c.Strings("url"), c.Strings("urls")...),
+			Integrity: c.String("integrity"),
+		}
+	case "local_path_override":
+		m.Override = &pkg.BazelModuleOverride{
+			Type: "local_path",
+			Path: c.String("path"),
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package bazel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseModuleBazelLock
+
+const rootModuleKey = "<root>"
+
+type moduleBazelLock struct {
+	LockFileVersion int `json:"lockFileVersion"`
+
+	// Bazel 7.2+ lockfiles (version 7 and up) record the hashes of all registry files that were used during
+	// resolution, including a source.json for every selected module.
+	RegistryFileHashes map[string]string `json:"registryFileHashes"`
+
+	// Bazel 7.0 and 7.1 lockfiles (versions 3 to 6) record the entire resolved module dependency graph.
+	ModuleDepGraph map[string]moduleDepGraphEntry `json:"moduleDepGraph"`
+	Flags          struct {
+		CmdRegistries []string `json:"cmdRegistries"`
+	} `json:"flags"`
+}
+
+type moduleDepGraphEntry struct {
+	Name     string            `json:"name"`
+	Version  string            `json:"version"`
+	Key      string            `json:"key"`
+	RepoName string            `json:"repoName"`
+	Deps     map[string]string `json:"deps"`
+	RepoSpec *struct {
+		RuleClassName string `json:"ruleClassName"`
+		Attributes    struct {
+			URLs      []string `json:"urls"`
+			Integrity string   `json:"integrity"`
+			Remote    string   `json:"remote"`
+			Commit    string   `json:"commit"`
+			Tag       string   `json:"tag"`
+			Path      string   `json:"path"`
+		} `json:"attributes"`
+	} `json:"repoSpec"`
+}
+
+// parseModuleBazelLock is a parser function for MODULE.bazel.lock contents, returning all resolved modules.
+func parseModuleBazelLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock moduleBazelLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse MODULE.bazel.lock file: %w", err)
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	if len(lock.ModuleDepGraph) > 0 {
+		pkgs, relationships := parseModuleDepGraph(lock, location)
+		return pkgs, relationships, nil
+	}
+
+	return parseRegistryFileHashes(lock, location), nil, nil
+}
+
+func parseModuleDepGraph(lock moduleBazelLock, location file.Location) ([]pkg.Package, []artifact.Relationship) {
+	var registry string
+	if len(lock.Flags.CmdRegistries) == 1 {
+		registry = strings.TrimSuffix(lock.Flags.CmdRegistries[0], "/")
+	}
+
+	var keys []string
+	for key := range lock.ModuleDepGraph {
+		keys = append(keys, key)
+	}
+
+	// always ensure there is a stable ordering of packages
+	sort.Strings(keys)
+
+	pkgsByKey := make(map[string]pkg.Package)
+	var pkgs []pkg.Package
+	for _, key := range keys {
+		entry := lock.ModuleDepGraph[key]
+		// the root module is the project being scanned, and modules without a repo spec are built into bazel itself
+		// (e.g. bazel_tools)
+		if key == rootModuleKey || entry.RepoSpec == nil || entry.Name == "" {
+			continue
+		}
+
+		m := pkg.BazelModuleEntry{
+			Name:    entry.Name,
+			Version: entry.Version,
+		}
+		if entry.RepoName != entry.Name {
+			m.RepoName = entry.RepoName
+		}
+
+		attrs := entry.RepoSpec.Attributes
+		switch entry.RepoSpec.RuleClassName {
+		case "git_repository":
+			m.Override = &pkg.BazelModuleOverride{
+				Type:   "git",
+				Remote: attrs.Remote,
+				Commit: attrs.Commit,
+				Tag:    attrs.Tag,
+			}
+		case "local_repository":
+			m.Override = &pkg.BazelModuleOverride{
+				Type: "local_path",
+				Path: attrs.Path,
+			}
+		default:
+			m.Registry = registry
+			m.Integrity = attrs.Integrity
+		}
+
+		p := newModulePackage(m, location)
+		pkgsByKey[key] = p
+		pkgs = append(pkgs, p)
+	}
+
+	var relationships []artifact.Relationship
+	for _, key := range keys {
+		dependant, ok := pkgsByKey[key]
+		if !ok {
+			continue
+		}
+
+		var depKeys []string
+		for _, depKey := range lock.ModuleDepGraph[key].Deps {
+			depKeys = append(depKeys, depKey)
+		}
+		sort.Strings(depKeys)
+
+		for _, depKey := range depKeys {
+			dependency, ok := pkgsByKey[depKey]
+			if !ok {
+				continue
+			}
+			relationships = append(relationships, artifact.Relationship{
+				From: dependency,
+				To:   dependant,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	return pkgs, relationships
+}
+
+func parseRegistryFileHashes(lock moduleBazelLock, location file.Location) []pkg.Package {
+	var urls []string
+	for u := range lock.RegistryFileHashes {
+		urls = append(urls, u)
+	}
+
+	// always ensure there is a stable ordering of packages
+	sort.Strings(urls)
+
+	var pkgs []pkg.Package
+	for _, u := range urls {
+		// a source.json is only fetched for modules that were selected during resolution, where as MODULE.bazel
+		// files are fetched for every version that was considered
+		registry, name, version := parseRegistryModuleURL(u, "source.json")
+		if name == "" || version == "" {
+			continue
+		}
+
+		pkgs = append(pkgs, newModulePackage(
+			pkg.BazelModuleEntry{
+				Name:     name,
+				Version:  version,
+				Registry: registry,
+			},
+			location,
+		))
+	}
+
+	return pkgs
+}
+
+// parseRegistryModuleURL splits a registry file URL of the form "<registry>/modules/<name>/<version>/<file>" into its parts.
+func parseRegistryModuleURL(u, expectedFile string) (registry, name, version string) {
+	idx := strings.LastIndex(u, "/modules/")
+	if idx < 0 {
+		return "", "", ""
+	}
+
+	fields := strings.Split(u[idx+len("/modules/"):], "/")
+	if len(fields) != 3 || fields[2] != expectedFile {
+		return "", "", ""
+	}
+
+	return u[:idx], fields[0], fields[1]
+}
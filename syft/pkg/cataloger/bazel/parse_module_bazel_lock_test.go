@@ -0,0 +1,133 @@
+package bazel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseModuleBazelLock_DepGraph(t *testing.T) {
+	fixture := "test-fixtures/lock-v6/MODULE.bazel.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	platforms := pkg.Package{
+		Name:      "platforms",
+		Version:   "0.0.7",
+		PURL:      "pkg:bazel/platforms@0.0.7",
+		Locations: locations,
+		Type:      pkg.BazelModulePkg,
+		Metadata: pkg.BazelModuleEntry{
+			Name:      "platforms",
+			Version:   "0.0.7",
+			Registry:  "https://bcr.bazel.build",
+			Integrity: "sha256-OlYcmee9vpFzqmU/1Xn+hJ8djWc5V4CrR3Cx84FDHVE=",
+		},
+	}
+	rulesCC := pkg.Package{
+		Name:      "rules_cc",
+		Version:   "0.0.9",
+		PURL:      "pkg:bazel/rules_cc@0.0.9",
+		Locations: locations,
+		Type:      pkg.BazelModulePkg,
+		Metadata: pkg.BazelModuleEntry{
+			Name:      "rules_cc",
+			Version:   "0.0.9",
+			Registry:  "https://bcr.bazel.build",
+			Integrity: "sha256-IDeHW5pEVtzkp50RKorohbvEqtlo5lh9ym5k86CQDN8=",
+		},
+	}
+	rulesGo := pkg.Package{
+		Name:      "rules_go",
+		PURL:      "pkg:bazel/rules_go?vcs_url=https://github.com/bazelbuild/rules_go.git%40b8d4ebbfe2d0a2dd4eb7f6ee4e05a0b5c1e3d2c1",
+		Locations: locations,
+		Type:      pkg.BazelModulePkg,
+		Metadata: pkg.BazelModuleEntry{
+			Name:     "rules_go",
+			RepoName: "io_bazel_rules_go",
+			Override: &pkg.BazelModuleOverride{
+				Type:   "git",
+				Remote: "https://github.com/bazelbuild/rules_go.git",
+				Commit: "b8d4ebbfe2d0a2dd4eb7f6ee4e05a0b5c1e3d2c1",
+			},
+		},
+	}
+
+	expected := []pkg.Package{platforms, rulesCC, rulesGo}
+
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: platforms,
+			To:   rulesCC,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: platforms,
+			To:   rulesGo,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseModuleBazelLock, expected, expectedRelationships)
+}
+
+func TestParseModuleBazelLock_RegistryFileHashes(t *testing.T) {
+	fixture := "test-fixtures/lock-v13/MODULE.bazel.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "abseil-cpp",
+			Version:   "20230802.0",
+			PURL:      "pkg:bazel/abseil-cpp@20230802.0",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "abseil-cpp",
+				Version:  "20230802.0",
+				Registry: "https://bcr.bazel.build",
+			},
+		},
+		{
+			Name:      "platforms",
+			Version:   "0.0.8",
+			PURL:      "pkg:bazel/platforms@0.0.8",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "platforms",
+				Version:  "0.0.8",
+				Registry: "https://bcr.bazel.build",
+			},
+		},
+		{
+			Name:      "rules_cc",
+			Version:   "0.0.9",
+			PURL:      "pkg:bazel/rules_cc@0.0.9",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "rules_cc",
+				Version:  "0.0.9",
+				Registry: "https://bcr.bazel.build",
+			},
+		},
+		{
+			Name:      "protobuf",
+			Version:   "23.1",
+			PURL:      "pkg:bazel/protobuf@23.1?repository_url=https://registry.example.com",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "protobuf",
+				Version:  "23.1",
+				Registry: "https://registry.example.com",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseModuleBazelLock, expected, expectedRelationships)
+}
@@ -0,0 +1,111 @@
+package bazel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseModuleBazel(t *testing.T) {
+	fixture := "test-fixtures/MODULE.bazel"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "rules_cc",
+			Version:   "0.0.9",
+			PURL:      "pkg:bazel/rules_cc@0.0.9",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:    "rules_cc",
+				Version: "0.0.9",
+			},
+		},
+		{
+			Name:      "abseil-cpp",
+			Version:   "20230802.0",
+			PURL:      "pkg:bazel/abseil-cpp@20230802.0",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "abseil-cpp",
+				Version:  "20230802.0",
+				RepoName: "com_google_absl",
+			},
+		},
+		{
+			Name:      "googletest",
+			Version:   "1.14.0",
+			PURL:      "pkg:bazel/googletest@1.14.0",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:          "googletest",
+				Version:       "1.14.0",
+				DevDependency: true,
+			},
+		},
+		{
+			Name:      "protobuf",
+			Version:   "23.1",
+			PURL:      "pkg:bazel/protobuf@23.1?repository_url=https://registry.example.com",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:     "protobuf",
+				Version:  "23.1",
+				Registry: "https://registry.example.com",
+			},
+		},
+		{
+			Name:      "rules_go",
+			PURL:      "pkg:bazel/rules_go?vcs_url=https://github.com/bazelbuild/rules_go.git%40b8d4ebbfe2d0a2dd4eb7f6ee4e05a0b5c1e3d2c1",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name: "rules_go",
+				Override: &pkg.BazelModuleOverride{
+					Type:   "git",
+					Remote: "https://github.com/bazelbuild/rules_go.git",
+					Commit: "b8d4ebbfe2d0a2dd4eb7f6ee4e05a0b5c1e3d2c1",
+				},
+			},
+		},
+		{
+			Name:      "platforms",
+			Version:   "0.0.8",
+			PURL:      "pkg:bazel/platforms@0.0.8",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name:    "platforms",
+				Version: "0.0.8",
+				Override: &pkg.BazelModuleOverride{
+					Type:      "archive",
+					URLs:      []string{"https://github.com/bazelbuild/platforms/releases/download/0.0.8/platforms-0.0.8.tar.gz"},
+					Integrity: "sha256-gVBAZgU4ns7LbaB8vLUJ1WN6OrmiS8abEQFTE2fYnXQ=",
+				},
+			},
+		},
+		{
+			Name:      "my_local_lib",
+			PURL:      "pkg:bazel/my_local_lib",
+			Locations: locations,
+			Type:      pkg.BazelModulePkg,
+			Metadata: pkg.BazelModuleEntry{
+				Name: "my_local_lib",
+				Override: &pkg.BazelModuleOverride{
+					Type: "local_path",
+					Path: "third_party/my_local_lib",
+				},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseModuleBazel, expected, expectedRelationships)
+}
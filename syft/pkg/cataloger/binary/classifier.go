@@ -140,6 +140,23 @@ func fileNameTemplateVersionMatcher(fileNamePattern string, contentTemplate stri
 	}
 }
 
+// fileNameVersionMatcher matches the version within the name of the file alone, as is the case for shared libraries
+// installed with their full version (e.g. libcudart.so.12.2.140). The real path is used, so that links named by the
+// soname (e.g. libcudart.so.12) resolve to the file named by the full version.
+func fileNameVersionMatcher(fileNamePattern string) EvidenceMatcher {
+	pat := regexp.MustCompile(fileNamePattern)
+	return func(classifier Classifier, context matcherContext) ([]pkg.Package, error) {
+		matchMetadata := internal.MatchNamedCaptureGroups(pat, context.location.RealPath)
+
+		p := newClassifierPackage(classifier, context.location, matchMetadata)
+		if p == nil {
+			return nil, nil
+		}
+
+		return []pkg.Package{*p}, nil
+	}
+}
+
 func FileContentsVersionMatcher(pattern string) EvidenceMatcher {
 	pat := regexp.MustCompile(pattern)
 	return func(classifier Classifier, context matcherContext) ([]pkg.Package, error) {
@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -1039,6 +1040,67 @@ func Test_Cataloger_PositiveCases(t *testing.T) {
 				Metadata:  metadata("wordpress-cli-binary"),
 			},
 		},
+		{
+			// note: the soname (libcudart.so.12) links to the library named by the full version
+			logicalFixture: "cuda-cudart/12.2.140/linux-amd64",
+			expected: pkg.Package{
+				Name:      "cuda-cudart",
+				Version:   "12.2.140",
+				Type:      "binary",
+				PURL:      "pkg:generic/cuda-cudart@12.2.140",
+				Locations: locations("libcudart.so.12.2.140"),
+				Metadata:  metadata("cuda-runtime-lib"),
+			},
+		},
+		{
+			logicalFixture: "cudnn/8.9.7/linux-amd64",
+			expected: pkg.Package{
+				Name:      "cudnn",
+				Version:   "8.9.7",
+				Type:      "binary",
+				PURL:      "pkg:generic/cudnn@8.9.7",
+				Locations: locations("libcudnn.so.8.9.7", "include/cudnn_version.h"),
+				Metadata: pkg.BinarySignature{
+					Matches: []pkg.ClassifierMatch{
+						match("cudnn-lib", "libcudnn.so.8.9.7"),
+						match("cudnn-header", "include/cudnn_version.h"),
+					},
+				},
+			},
+		},
+		{
+			logicalFixture: "tensorrt/8.6.1/linux-amd64",
+			expected: pkg.Package{
+				Name:      "tensorrt",
+				Version:   "8.6.1",
+				Type:      "binary",
+				PURL:      "pkg:generic/tensorrt@8.6.1",
+				Locations: locations("libnvinfer.so.8.6.1"),
+				Metadata:  metadata("tensorrt-lib"),
+			},
+		},
+		{
+			logicalFixture: "tensorrt/8.5.3/linux-amd64",
+			expected: pkg.Package{
+				Name:      "tensorrt",
+				Version:   "8.5.3",
+				Type:      "binary",
+				PURL:      "pkg:generic/tensorrt@8.5.3",
+				Locations: locations("NvInferVersion.h"),
+				Metadata:  metadata("tensorrt-header"),
+			},
+		},
+		{
+			logicalFixture: "tensorrt/10.3.0/linux-amd64",
+			expected: pkg.Package{
+				Name:      "tensorrt",
+				Version:   "10.3.0",
+				Type:      "binary",
+				PURL:      "pkg:generic/tensorrt@10.3.0",
+				Locations: locations("NvInferVersion.h"),
+				Metadata:  metadata("tensorrt-header"),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1067,6 +1129,74 @@ func Test_Cataloger_PositiveCases(t *testing.T) {
 	}
 }
 
+func Test_Cataloger_DefaultClassifiers_GPURuntimeMetadataFiles(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected pkg.Package
+	}{
+		{
+			fixture: "cuda-12.2.2",
+			expected: pkg.Package{
+				Name:      "cuda",
+				Version:   "12.2.2",
+				PURL:      "pkg:generic/cuda@12.2.2",
+				Locations: locations("cuda-12.2/version.json"),
+				Metadata:  metadata("cuda-toolkit"),
+			},
+		},
+		{
+			fixture: "cuda-10.2.89",
+			expected: pkg.Package{
+				Name:      "cuda",
+				Version:   "10.2.89",
+				PURL:      "pkg:generic/cuda@10.2.89",
+				Locations: locations("cuda-10.2/version.txt"),
+				Metadata:  metadata("cuda-toolkit-legacy"),
+			},
+		},
+		{
+			fixture: "rocm-6.0.2",
+			expected: pkg.Package{
+				Name:      "rocm",
+				Version:   "6.0.2",
+				PURL:      "pkg:generic/rocm@6.0.2",
+				Locations: locations("rocm-6.0.2/.info/version"),
+				Metadata:  metadata("rocm-info"),
+			},
+		},
+		{
+			fixture: "rocm-6.1.0",
+			expected: pkg.Package{
+				Name:      "rocm",
+				Version:   "6.1.0",
+				PURL:      "pkg:generic/rocm@6.1.0",
+				Locations: locations("include/rocm-core/rocm_version.h"),
+				Metadata:  metadata("rocm-header"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			c := NewClassifierCataloger(DefaultClassifierCatalogerConfig())
+
+			// note: these files are identified by the directory holding them, so are not managed as snippets
+			src, err := directorysource.NewFromPath(filepath.Join("test-fixtures", "gpu-runtimes", test.fixture))
+			require.NoError(t, err)
+
+			resolver, err := src.FileResolver(source.SquashedScope)
+			require.NoError(t, err)
+
+			packages, _, err := c.Catalog(context.Background(), resolver)
+			require.NoError(t, err)
+
+			require.Len(t, packages, 1, "mismatched package count")
+
+			assertPackagesAreEqual(t, test.expected, packages[0])
+		})
+	}
+}
+
 func Test_Cataloger_DefaultClassifiers_PositiveCases_Image(t *testing.T) {
 	tests := []struct {
 		name         string
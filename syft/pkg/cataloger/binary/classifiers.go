@@ -525,6 +525,98 @@ func DefaultClassifiers() []Classifier {
 			PURL:    mustPURL("pkg:generic/wp-cli@version"),
 			CPEs:    singleCPE("cpe:2.3:a:wp-cli:wp-cli:*:*:*:*:*:*:*:*"),
 		},
+		{
+			Class:    "cuda-toolkit",
+			FileGlob: "**/cuda*/version.json",
+			EvidenceMatcher: FileContentsVersionMatcher(
+				// "cuda" : { "name" : "CUDA SDK", "version" : "12.2.2" }
+				`(?s)"cuda"\s*:\s*\{[^}]*?"version"\s*:\s*"(?P<version>[0-9]+\.[0-9]+\.[0-9]+)"`),
+			Package: "cuda",
+			PURL:    mustPURL("pkg:generic/cuda@version"),
+			CPEs:    singleCPE("cpe:2.3:a:nvidia:cuda_toolkit:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class:    "cuda-toolkit-legacy",
+			FileGlob: "**/cuda*/version.txt",
+			EvidenceMatcher: FileContentsVersionMatcher(
+				// CUDA Version 10.2.89
+				`(?m)^CUDA Version (?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
+			Package: "cuda",
+			PURL:    mustPURL("pkg:generic/cuda@version"),
+			CPEs:    singleCPE("cpe:2.3:a:nvidia:cuda_toolkit:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class:    "cuda-runtime-lib",
+			FileGlob: "**/libcudart.so.*",
+			EvidenceMatcher: fileNameVersionMatcher(
+				// libcudart.so.12.2.140
+				`(?:.*/|^)libcudart\.so\.(?P<version>[0-9]+\.[0-9]+\.[0-9]+)$`),
+			Package: "cuda-cudart",
+			PURL:    mustPURL("pkg:generic/cuda-cudart@version"),
+		},
+		{
+			Class:    "cudnn-lib",
+			FileGlob: "**/libcudnn.so.*",
+			EvidenceMatcher: fileNameVersionMatcher(
+				// libcudnn.so.8.9.7
+				`(?:.*/|^)libcudnn\.so\.(?P<version>[0-9]+\.[0-9]+\.[0-9]+)$`),
+			Package: "cudnn",
+			PURL:    mustPURL("pkg:generic/cudnn@version"),
+		},
+		{
+			Class:    "cudnn-header",
+			FileGlob: "**/cudnn_version*.h",
+			EvidenceMatcher: FileContentsVersionMatcher(
+				// #define CUDNN_MAJOR 8
+				// #define CUDNN_MINOR 9
+				// #define CUDNN_PATCHLEVEL 7
+				`(?s)#define CUDNN_MAJOR\s+(?P<major>[0-9]+).*?#define CUDNN_MINOR\s+(?P<minor>[0-9]+).*?#define CUDNN_PATCHLEVEL\s+(?P<patch>[0-9]+)`),
+			Package: "cudnn",
+			PURL:    mustPURL("pkg:generic/cudnn@version"),
+		},
+		{
+			Class:    "tensorrt-lib",
+			FileGlob: "**/libnvinfer.so.*",
+			EvidenceMatcher: fileNameVersionMatcher(
+				// libnvinfer.so.8.6.1
+				`(?:.*/|^)libnvinfer\.so\.(?P<version>[0-9]+\.[0-9]+\.[0-9]+)$`),
+			Package: "tensorrt",
+			PURL:    mustPURL("pkg:generic/tensorrt@version"),
+		},
+		{
+			Class:    "tensorrt-header",
+			FileGlob: "**/NvInferVersion.h",
+			EvidenceMatcher: evidenceMatchers(
+				// TensorRT 10+: #define TRT_MAJOR_ENTERPRISE 10
+				FileContentsVersionMatcher(
+					`(?s)#define TRT_MAJOR_ENTERPRISE\s+(?P<major>[0-9]+).*?#define TRT_MINOR_ENTERPRISE\s+(?P<minor>[0-9]+).*?#define TRT_PATCH_ENTERPRISE\s+(?P<patch>[0-9]+)`),
+				// #define NV_TENSORRT_MAJOR 8
+				FileContentsVersionMatcher(
+					`(?s)#define NV_TENSORRT_MAJOR\s+(?P<major>[0-9]+).*?#define NV_TENSORRT_MINOR\s+(?P<minor>[0-9]+).*?#define NV_TENSORRT_PATCH\s+(?P<patch>[0-9]+)`),
+			),
+			Package: "tensorrt",
+			PURL:    mustPURL("pkg:generic/tensorrt@version"),
+		},
+		{
+			Class:    "rocm-info",
+			FileGlob: "**/rocm*/.info/version",
+			EvidenceMatcher: FileContentsVersionMatcher(
+				// 6.0.2-115
+				`(?m)^(?P<version>[0-9]+\.[0-9]+\.[0-9]+)`),
+			Package: "rocm",
+			PURL:    mustPURL("pkg:generic/rocm@version"),
+		},
+		{
+			Class:    "rocm-header",
+			FileGlob: "**/rocm_version.h",
+			EvidenceMatcher: FileContentsVersionMatcher(
+				// #define ROCM_VERSION_MAJOR 6
+				// #define ROCM_VERSION_MINOR 0
+				// #define ROCM_VERSION_PATCH 2
+				`(?s)#define ROCM_VERSION_MAJOR\s+(?P<major>[0-9]+).*?#define ROCM_VERSION_MINOR\s+(?P<minor>[0-9]+).*?#define ROCM_VERSION_PATCH\s+(?P<patch>[0-9]+)`),
+			Package: "rocm",
+			PURL:    mustPURL("pkg:generic/rocm@version"),
+		},
 	}
 }
 
@@ -0,0 +1,272 @@
+package binary
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/mimetype"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const staticLibraryCatalogerName = "binary-static-library-cataloger"
+
+// staticLibraryCataloger finds native libraries that have been statically linked into executables (such as C libraries
+// linked into static Go, Rust, or C binaries), which otherwise go unreported since no package manager or shared library
+// dependency records them. Libraries are identified by fingerprints: strings that each library embeds into any binary
+// it is linked into (copyright notices, version banners, error message tables), regardless of whether the binary has
+// been stripped of its symbols.
+type staticLibraryCataloger struct {
+	fingerprints []Classifier
+}
+
+// NewStaticLibraryCataloger returns a cataloger that reports the native libraries statically linked into executables.
+func NewStaticLibraryCataloger() pkg.Cataloger {
+	return &staticLibraryCataloger{
+		fingerprints: staticLibraryFingerprints(),
+	}
+}
+
+// Name returns a string that uniquely describes the cataloger
+func (c staticLibraryCataloger) Name() string {
+	return staticLibraryCatalogerName
+}
+
+// Catalog is given an object to resolve file references and content, this function returns any discovered Packages
+// after analyzing the catalog source.
+func (c staticLibraryCataloger) Catalog(_ context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+	locations, err := resolver.FilesByMIMEType(mimetype.ExecutableMIMETypeSet.List()...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find executables: %w", err)
+	}
+
+	var packages []pkg.Package
+	for _, location := range locations {
+		newPkgs, err := c.catalogExecutable(resolver, location)
+		if err != nil {
+			log.WithFields("error", err, "path", location.RealPath).Debug("unable to fingerprint executable")
+			continue
+		}
+	newPackages:
+		for i := range newPkgs {
+			newPkg := &newPkgs[i]
+			for j := range packages {
+				p := &packages[j]
+				// consolidate identical libraries linked into different executables
+				if packagesMatch(p, newPkg) {
+					mergePackages(p, newPkg)
+					continue newPackages
+				}
+			}
+			packages = append(packages, *newPkg)
+		}
+	}
+
+	return packages, nil, nil
+}
+
+// catalogExecutable matches all fingerprints against a single executable, reading its contents only once.
+func (c staticLibraryCataloger) catalogExecutable(resolver file.Resolver, location file.Location) ([]pkg.Package, error) {
+	contents, err := getContents(matcherContext{resolver: resolver, location: location})
+	if err != nil {
+		return nil, err
+	}
+
+	matchContext := matcherContext{
+		resolver: resolver,
+		location: location,
+		getContents: func(matcherContext) ([]byte, error) {
+			return contents, nil
+		},
+	}
+
+	var packages []pkg.Package
+	for _, fingerprint := range c.fingerprints {
+		pkgs, err := fingerprint.EvidenceMatcher(fingerprint, matchContext)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pkgs {
+			p.FoundBy = staticLibraryCatalogerName
+			packages = append(packages, p)
+		}
+	}
+	return packages, nil
+}
+
+// staticallyLinked only applies the matcher to executables that are neither the shared library itself nor import it
+// (as determined by the given pattern matched against the shared library names), since dynamically linked
+// executables may embed the version of the library they were built against (e.g. OPENSSL_VERSION_TEXT) without
+// embedding the library itself.
+func staticallyLinked(sharedLibraryPattern string, matcher EvidenceMatcher) EvidenceMatcher {
+	pat := regexp.MustCompile(sharedLibraryPattern)
+	return func(classifier Classifier, context matcherContext) ([]pkg.Package, error) {
+		if pat.MatchString(path.Base(context.location.RealPath)) {
+			return nil, nil
+		}
+		libs, err := sharedLibraries(context)
+		if err != nil {
+			return nil, err
+		}
+		for _, lib := range libs {
+			if pat.MatchString(path.Base(lib)) {
+				return nil, nil
+			}
+		}
+		return matcher(classifier, context)
+	}
+}
+
+// fileContentsMatcher matches a library which does not embed its version, reporting it without a version.
+func fileContentsMatcher(pattern string) EvidenceMatcher {
+	pat := regexp.MustCompile(pattern)
+	return func(classifier Classifier, context matcherContext) ([]pkg.Package, error) {
+		contents, err := getContents(context)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get read contents for file: %w", err)
+		}
+
+		if !pat.Match(contents) {
+			return nil, nil
+		}
+
+		p := newClassifierPackage(classifier, context.location, map[string]string{"version": ""})
+		if p == nil {
+			return nil, nil
+		}
+
+		return []pkg.Package{*p}, nil
+	}
+}
+
+// nearbyVersionMatcher matches the version string closest to (and within the given number of bytes of) a string that
+// identifies the library, for libraries which embed their version without any surrounding context.
+func nearbyVersionMatcher(identifierPattern, versionPattern string, distance int) EvidenceMatcher {
+	identifierPat := regexp.MustCompile(identifierPattern)
+	versionPat := regexp.MustCompile(versionPattern)
+	versionIndex := versionPat.SubexpIndex("version")
+	return func(classifier Classifier, context matcherContext) ([]pkg.Package, error) {
+		contents, err := getContents(context)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get read contents for file: %w", err)
+		}
+
+		identifier := identifierPat.FindIndex(contents)
+		if identifier == nil {
+			return nil, nil
+		}
+
+		start := max(identifier[0]-distance, 0)
+		end := min(identifier[1]+distance, len(contents))
+
+		version := ""
+		closest := -1
+		for _, match := range versionPat.FindAllSubmatchIndex(contents[start:end], -1) {
+			d := abs(start + match[0] - identifier[0])
+			if closest < 0 || d < closest {
+				closest = d
+				version = string(contents[start+match[2*versionIndex] : start+match[2*versionIndex+1]])
+			}
+		}
+
+		if version == "" {
+			return nil, nil
+		}
+
+		p := newClassifierPackage(classifier, context.location, map[string]string{"version": version})
+		if p == nil {
+			return nil, nil
+		}
+
+		return []pkg.Package{*p}, nil
+	}
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// staticLibraryFingerprints returns the fingerprints of the libraries commonly statically linked into executables.
+func staticLibraryFingerprints() []Classifier {
+	return []Classifier{
+		{
+			Class: "zlib-static",
+			EvidenceMatcher: staticallyLinked(
+				`^(libz\.|zlib1?\.dll$)`,
+				FileContentsVersionMatcher(
+					// [NUL] deflate 1.3.1 Copyright 1995-2024 Jean-loup Gailly and Mark Adler
+					// [NUL] inflate 1.3.1 Copyright 1995-2024 Mark Adler
+					`[\x00 ](?:de|in)flate (?P<version>[0-9]+\.[0-9]+(?:\.[0-9]+){0,2}) Copyright 1995-[0-9]{4} (?:Jean-loup Gailly and )?Mark Adler`,
+				),
+			),
+			Package: "zlib",
+			PURL:    mustPURL("pkg:generic/zlib@version"),
+			CPEs:    singleCPE("cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class: "openssl-static",
+			EvidenceMatcher: staticallyLinked(
+				`^lib(ssl|crypto)[.-]`,
+				FileContentsVersionMatcher(
+					// OpenSSL 3.0.13 30 Jan 2024
+					// OpenSSL 1.1.1w  11 Sep 2023
+					`OpenSSL (?P<version>[0-9]+\.[0-9]+\.[0-9]+(?:[a-z]{1,2}|-alpha[0-9]+|-beta[0-9]+)?) +[0-9]{1,2} [A-Z][a-z]{2} [0-9]{4}`,
+				),
+			),
+			Package: "openssl",
+			PURL:    mustPURL("pkg:generic/openssl@version"),
+			CPEs:    singleCPE("cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class: "libcurl-static",
+			EvidenceMatcher: staticallyLinked(
+				`^libcurl[.-]`,
+				FileContentsVersionMatcher(
+					// libcurl/8.6.0[NUL]
+					`libcurl/(?P<version>[0-9]+\.[0-9]+\.[0-9]+)\x00`,
+				),
+			),
+			Package: "libcurl",
+			PURL:    mustPURL("pkg:generic/libcurl@version"),
+			CPEs:    singleCPE("cpe:2.3:a:haxx:libcurl:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class: "sqlite-static",
+			EvidenceMatcher: staticallyLinked(
+				`^(libsqlite3[.-]|sqlite3\.dll$)`,
+				nearbyVersionMatcher(
+					// the source ID, which is unique to sqlite:
+					// 2024-01-30 16:01:20 e876e51a0ed5c5b3126f52e532044363a014bc594cfefa87ffb5b82257cc467a
+					`[0-9]{4}-[0-9]{2}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2} [0-9a-f]{56,64}[0-9a-z]{0,8}\x00`,
+					// [NUL]3.45.1[NUL]
+					`\x00(?P<version>3\.[0-9]{1,2}\.[0-9]{1,2})\x00`,
+					4096,
+				),
+			),
+			Package: "sqlite",
+			PURL:    mustPURL("pkg:generic/sqlite@version"),
+			CPEs:    singleCPE("cpe:2.3:a:sqlite:sqlite:*:*:*:*:*:*:*:*"),
+		},
+		{
+			Class: "musl-static",
+			EvidenceMatcher: staticallyLinked(
+				`^(libc\.musl-|ld-musl-)`,
+				// musl does not embed its version into static executables, however its error message table (in this
+				// order) is unique to musl
+				fileContentsMatcher(
+					`Illegal byte sequence\x00Domain error\x00Result not representable\x00Not a tty\x00Permission denied\x00Operation not permitted\x00`,
+				),
+			),
+			Package: "musl",
+			PURL:    mustPURL("pkg:generic/musl@version"),
+			CPEs:    singleCPE("cpe:2.3:a:musl-libc:musl:*:*:*:*:*:*:*:*"),
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_StaticLibraryCataloger(t *testing.T) {
+	staticTool := file.NewLocation("static-tool")
+	otherTool := file.NewLocation("other-tool")
+
+	signature := func(class string, locations ...file.Location) pkg.BinarySignature {
+		var matches []pkg.ClassifierMatch
+		for _, l := range locations {
+			matches = append(matches, pkg.ClassifierMatch{Classifier: class, Location: l})
+		}
+		return pkg.BinarySignature{Matches: matches}
+	}
+
+	expected := []pkg.Package{
+		{
+			Name:      "zlib",
+			Version:   "1.3.1",
+			PURL:      "pkg:generic/zlib@1.3.1",
+			Locations: file.NewLocationSet(staticTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:zlib:zlib:1.3.1:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("zlib-static", staticTool),
+		},
+		{
+			Name:      "zlib",
+			Version:   "1.2.13",
+			PURL:      "pkg:generic/zlib@1.2.13",
+			Locations: file.NewLocationSet(otherTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:zlib:zlib:1.2.13:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("zlib-static", otherTool),
+		},
+		{
+			Name:      "openssl",
+			Version:   "3.0.13",
+			PURL:      "pkg:generic/openssl@3.0.13",
+			Locations: file.NewLocationSet(staticTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:openssl:openssl:3.0.13:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("openssl-static", staticTool),
+		},
+		{
+			Name:      "libcurl",
+			Version:   "8.6.0",
+			PURL:      "pkg:generic/libcurl@8.6.0",
+			Locations: file.NewLocationSet(staticTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:haxx:libcurl:8.6.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("libcurl-static", staticTool),
+		},
+		{
+			Name:      "sqlite",
+			Version:   "3.45.1",
+			PURL:      "pkg:generic/sqlite@3.45.1",
+			Locations: file.NewLocationSet(staticTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:sqlite:sqlite:3.45.1:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("sqlite-static", staticTool),
+		},
+		{
+			Name:      "musl",
+			PURL:      "pkg:generic/musl",
+			Locations: file.NewLocationSet(staticTool),
+			CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:musl-libc:musl:*:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Type:      pkg.BinaryPkg,
+			Metadata:  signature("musl-static", staticTool),
+		},
+	}
+
+	for i := range expected {
+		expected[i].FoundBy = staticLibraryCatalogerName
+	}
+
+	// note: the shared library (libz.so.1) and the file which is not an executable (zlib-notice.txt) are not reported
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/static-libraries").
+		Expects(expected, nil).
+		TestCataloger(t, NewStaticLibraryCataloger())
+}
@@ -0,0 +1,9 @@
+#ifndef NV_INFER_VERSION_H
+#define NV_INFER_VERSION_H
+
+#define NV_TENSORRT_MAJOR 8 //!< TensorRT major version.
+#define NV_TENSORRT_MINOR 5 //!< TensorRT minor version.
+#define NV_TENSORRT_PATCH 3 //!< TensorRT patch version.
+#define NV_TENSORRT_BUILD 1 //!< TensorRT build number.
+
+#endif // NV_INFER_VERSION_H
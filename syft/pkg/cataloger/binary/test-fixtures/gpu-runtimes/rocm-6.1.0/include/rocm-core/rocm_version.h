@@ -0,0 +1,10 @@
+#ifndef _ROCM_VERSION_H_
+#define _ROCM_VERSION_H_
+
+#define ROCM_VERSION_MAJOR   6
+#define ROCM_VERSION_MINOR   1
+#define ROCM_VERSION_PATCH   0
+
+#define ROCM_BUILD_INFO      "6.1.0-82"
+
+#endif /* _ROCM_VERSION_H_ */
@@ -0,0 +1,19 @@
+/*
+Package browser provides a concrete Cataloger implementation for extensions installed to Chromium-based browsers
+(Chrome, Chromium, Edge, and Brave) and Firefox.
+*/
+package browser
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewExtensionCataloger returns a new cataloger object for extensions installed to browser profiles, which are
+// unpacked within the Extensions directory of Chromium-based browser profiles (<profile>/Extensions/<id>/<version>)
+// and recorded within the extensions.json file of Firefox profiles.
+func NewExtensionCataloger() pkg.Cataloger {
+	return generic.NewCataloger("browser-extension-cataloger").
+		WithParserByGlobs(parseChromiumManifest, "**/Extensions/*/*/manifest.json").
+		WithParserByGlobs(parseFirefoxExtensionsJSON, "**/.mozilla/firefox/*/extensions.json")
+}
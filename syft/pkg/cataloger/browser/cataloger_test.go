@@ -0,0 +1,87 @@
+package browser
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain chromium extension manifests and firefox extensions.json files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"home/dev/.config/google-chrome/Default/Extensions/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa/1.0_0/manifest.json",
+				"home/dev/.mozilla/firefox/abcd1234.default-release/extensions.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				IgnoreUnfulfilledPathResponses("home/dev/.config/google-chrome/Default/Extensions/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa/1.0_0/manifest.json").
+				TestCataloger(t, NewExtensionCataloger())
+		})
+	}
+}
+
+func TestCataloger_Chromium(t *testing.T) {
+	uBlockLocation := file.NewLocation("Default/Extensions/cjpalhdlnbpafiamejdnhcphjbkeiagm/1.55.0_0/manifest.json")
+	paymentsLocation := file.NewLocation("Default/Extensions/nmmhkkegccagdldgiimedpiccmgmieda/1.0.0.6_0/manifest.json")
+
+	expected := []pkg.Package{
+		{
+			Name:      "uBlock Origin",
+			Version:   "1.55.0",
+			FoundBy:   "browser-extension-cataloger",
+			PURL:      "pkg:browser-extension/chromium/cjpalhdlnbpafiamejdnhcphjbkeiagm@1.55.0",
+			Locations: file.NewLocationSet(uBlockLocation),
+			Language:  pkg.JavaScript,
+			Type:      pkg.BrowserExtensionPkg,
+			Metadata: pkg.BrowserExtensionEntry{
+				Browser:         pkg.ChromiumBrowser,
+				ExtensionID:     "cjpalhdlnbpafiamejdnhcphjbkeiagm",
+				Profile:         "Default",
+				Description:     "Finally, an efficient blocker. Easy on CPU and memory.",
+				Author:          "Raymond Hill & contributors",
+				Homepage:        "https://github.com/gorhill/uBlock/",
+				ManifestVersion: 3,
+				UpdateURL:       "https://clients2.google.com/service/update2/crx",
+			},
+		},
+		{
+			Name:      "Chrome Web Store Payments",
+			Version:   "1.0.0.6",
+			FoundBy:   "browser-extension-cataloger",
+			PURL:      "pkg:browser-extension/chromium/nmmhkkegccagdldgiimedpiccmgmieda@1.0.0.6",
+			Locations: file.NewLocationSet(paymentsLocation),
+			Language:  pkg.JavaScript,
+			Type:      pkg.BrowserExtensionPkg,
+			Metadata: pkg.BrowserExtensionEntry{
+				Browser:         pkg.ChromiumBrowser,
+				ExtensionID:     "nmmhkkegccagdldgiimedpiccmgmieda",
+				Profile:         "Default",
+				Description:     "Chrome Web Store Payments",
+				Author:          "payments-team@google.com",
+				ManifestVersion: 2,
+				UpdateURL:       "https://clients2.google.com/service/update2/crx",
+			},
+		},
+	}
+
+	// note: the Temp directory is not an extension ID (it is used while an extension is being installed)
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/chromium").
+		Expects(expected, nil).
+		TestCataloger(t, NewExtensionCataloger())
+}
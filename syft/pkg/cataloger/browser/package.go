@@ -0,0 +1,40 @@
+package browser
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newExtensionPackage(name, version string, entry pkg.BrowserExtensionEntry, locations ...file.Location) pkg.Package {
+	if name == "" {
+		name = entry.ExtensionID
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(entry, version),
+		Language:  pkg.JavaScript,
+		Type:      pkg.BrowserExtensionPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL of an extension, which is identified by the browser family and extension ID
+// (rather than the display name), for example "pkg:browser-extension/chromium/cjpalhdlnbpafiamejdnhcphjbkeiagm@1.55.0".
+func packageURL(entry pkg.BrowserExtensionEntry, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.BrowserExtensionPkg.PackageURLType(),
+		entry.Browser,
+		entry.ExtensionID,
+		version,
+		nil,
+		"",
+	).ToString()
+}
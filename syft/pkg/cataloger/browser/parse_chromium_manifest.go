@@ -0,0 +1,144 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseChromiumManifest
+
+// chromiumExtensionIDExp matches the IDs of Chromium extensions, which are 32 characters in the range a-p (derived
+// from the hash of the public key of the extension).
+var chromiumExtensionIDExp = regexp.MustCompile(`^[a-p]{32}$`)
+
+// chromiumManifest represents the manifest.json file of an unpacked Chromium extension (see
+// https://developer.chrome.com/docs/extensions/reference/manifest).
+type chromiumManifest struct {
+	Name            string          `json:"name"`
+	Version         string          `json:"version"`
+	ManifestVersion int             `json:"manifest_version"`
+	Description     string          `json:"description"`
+	DefaultLocale   string          `json:"default_locale"`
+	Author          json.RawMessage `json:"author"`
+	HomepageURL     string          `json:"homepage_url"`
+	UpdateURL       string          `json:"update_url"`
+}
+
+// chromiumMessage is a translated value from the _locales/<locale>/messages.json file of an extension.
+type chromiumMessage struct {
+	Message string `json:"message"`
+}
+
+// parseChromiumManifest returns the extension described by a manifest.json file within the Extensions directory of a
+// Chromium-based browser profile, where the path is <profile>/Extensions/<extension ID>/<version>/manifest.json.
+func parseChromiumManifest(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	versionDir := path.Dir(reader.RealPath)
+	extensionDir := path.Dir(versionDir)
+	id := path.Base(extensionDir)
+	if !chromiumExtensionIDExp.MatchString(id) {
+		return nil, nil, nil
+	}
+
+	var manifest chromiumManifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse extension manifest.json file: %w", err)
+	}
+
+	if manifest.ManifestVersion == 0 || manifest.Version == "" {
+		return nil, nil, nil
+	}
+
+	messages := readChromiumMessages(resolver, reader.Location, manifest.DefaultLocale)
+
+	entry := pkg.BrowserExtensionEntry{
+		Browser:         pkg.ChromiumBrowser,
+		ExtensionID:     id,
+		Profile:         path.Base(path.Dir(path.Dir(extensionDir))),
+		Description:     localizedValue(messages, manifest.Description),
+		Author:          chromiumAuthor(manifest.Author),
+		Homepage:        manifest.HomepageURL,
+		ManifestVersion: manifest.ManifestVersion,
+		UpdateURL:       manifest.UpdateURL,
+	}
+
+	return []pkg.Package{
+		newExtensionPackage(
+			localizedValue(messages, manifest.Name),
+			manifest.Version,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
+
+// chromiumAuthor returns the author of an extension, which is either a string or an object with an email address.
+func chromiumAuthor(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var author string
+	if err := json.Unmarshal(raw, &author); err == nil {
+		return author
+	}
+
+	var obj struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		return obj.Email
+	}
+	return ""
+}
+
+// localizedValue replaces a placeholder for a translated value (e.g. "__MSG_appName__") with the value from the
+// messages of the default locale (where message names are case-insensitive), otherwise the placeholder is dropped.
+func localizedValue(messages map[string]string, value string) string {
+	if !strings.HasPrefix(value, "__MSG_") || !strings.HasSuffix(value, "__") || len(value) <= len("__MSG_____") {
+		return value
+	}
+	key := strings.TrimSuffix(strings.TrimPrefix(value, "__MSG_"), "__")
+	return messages[strings.ToLower(key)]
+}
+
+func readChromiumMessages(resolver file.Resolver, location file.Location, locale string) map[string]string {
+	if resolver == nil || locale == "" {
+		return nil
+	}
+
+	messagesPath := path.Join(path.Dir(location.RealPath), "_locales", locale, "messages.json")
+	messagesLocation := resolver.RelativeFileByPath(location, messagesPath)
+	if messagesLocation == nil {
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*messagesLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", messagesPath).Trace("unable to read extension messages")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, messagesLocation.RealPath)
+
+	var raw map[string]chromiumMessage
+	if err := json.NewDecoder(contents).Decode(&raw); err != nil {
+		log.WithFields("error", err, "path", messagesPath).Trace("unable to parse extension messages")
+		return nil
+	}
+
+	messages := make(map[string]string, len(raw))
+	for key, value := range raw {
+		messages[strings.ToLower(key)] = value.Message
+	}
+	return messages
+}
@@ -0,0 +1,82 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseFirefoxExtensionsJSON
+
+// firefoxExtensionsJSON represents the extensions.json file of a Firefox profile, which is the record of the add-ons
+// installed to the profile (extensions as well as themes, dictionaries, and language packs).
+type firefoxExtensionsJSON struct {
+	Addons []firefoxAddon `json:"addons"`
+}
+
+type firefoxAddon struct {
+	ID              string `json:"id"`
+	Version         string `json:"version"`
+	Type            string `json:"type"`
+	Location        string `json:"location"`
+	Active          bool   `json:"active"`
+	UserDisabled    bool   `json:"userDisabled"`
+	ManifestVersion int    `json:"manifestVersion"`
+	UpdateURL       string `json:"updateURL"`
+	DefaultLocale   struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Creator     string `json:"creator"`
+		HomepageURL string `json:"homepageURL"`
+	} `json:"defaultLocale"`
+}
+
+// parseFirefoxExtensionsJSON returns the extensions installed to a Firefox profile. Add-ons that are part of the
+// browser itself (with a location of "app-builtin" or "app-system-*") are not included.
+func parseFirefoxExtensionsJSON(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var extensions firefoxExtensionsJSON
+	if err := json.NewDecoder(reader).Decode(&extensions); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Firefox extensions.json file: %w", err)
+	}
+
+	profile := path.Base(path.Dir(reader.RealPath))
+
+	var pkgs []pkg.Package
+	for _, addon := range extensions.Addons {
+		if addon.ID == "" || addon.Type != "extension" || isBuiltinFirefoxAddon(addon.Location) {
+			continue
+		}
+
+		entry := pkg.BrowserExtensionEntry{
+			Browser:         pkg.FirefoxBrowser,
+			ExtensionID:     addon.ID,
+			Profile:         profile,
+			Description:     addon.DefaultLocale.Description,
+			Author:          addon.DefaultLocale.Creator,
+			Homepage:        addon.DefaultLocale.HomepageURL,
+			ManifestVersion: addon.ManifestVersion,
+			UpdateURL:       addon.UpdateURL,
+			Disabled:        !addon.Active || addon.UserDisabled,
+		}
+
+		pkgs = append(pkgs, newExtensionPackage(
+			addon.DefaultLocale.Name,
+			addon.Version,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+func isBuiltinFirefoxAddon(location string) bool {
+	return location == "app-builtin" || strings.HasPrefix(location, "app-system")
+}
@@ -0,0 +1,62 @@
+package browser
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseFirefoxExtensionsJSON(t *testing.T) {
+	fixture := "test-fixtures/firefox/.mozilla/firefox/abcd1234.default-release/extensions.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	// note: the builtin theme, system add-on, and dictionary are not included
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "uBlock Origin",
+			Version:   "1.55.0",
+			PURL:      "pkg:browser-extension/firefox/uBlock0%40raymondhill.net@1.55.0",
+			Locations: locations,
+			Language:  pkg.JavaScript,
+			Type:      pkg.BrowserExtensionPkg,
+			Metadata: pkg.BrowserExtensionEntry{
+				Browser:         pkg.FirefoxBrowser,
+				ExtensionID:     "uBlock0@raymondhill.net",
+				Profile:         "abcd1234.default-release",
+				Description:     "Finally, an efficient wide-spectrum content blocker. Easy on CPU and memory.",
+				Author:          "Raymond Hill",
+				Homepage:        "https://github.com/gorhill/uBlock#ublock-origin",
+				ManifestVersion: 2,
+			},
+		},
+		{
+			Name:      "Bitwarden Password Manager",
+			Version:   "2024.2.1",
+			PURL:      "pkg:browser-extension/firefox/{446900e4-71c2-419f-a6a7-df9c091e268b}@2024.2.1",
+			Locations: locations,
+			Language:  pkg.JavaScript,
+			Type:      pkg.BrowserExtensionPkg,
+			Metadata: pkg.BrowserExtensionEntry{
+				Browser:         pkg.FirefoxBrowser,
+				ExtensionID:     "{446900e4-71c2-419f-a6a7-df9c091e268b}",
+				Profile:         "abcd1234.default-release",
+				Description:     "At home, at work, or on the go, Bitwarden easily secures all your passwords, passkeys, and sensitive information.",
+				Author:          "Bitwarden Inc.",
+				Homepage:        "https://bitwarden.com",
+				ManifestVersion: 2,
+				Disabled:        true,
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseFirefoxExtensionsJSON, expectedPkgs, nil)
+}
+
+func TestParseFirefoxExtensionsJSON_Invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/glob-paths/home/dev/.mozilla/firefox/abcd1234.default-release/extensions.json").
+		WithError().
+		TestParser(t, parseFirefoxExtensionsJSON)
+}
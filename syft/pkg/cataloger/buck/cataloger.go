@@ -0,0 +1,17 @@
+/*
+Package buck provides a concrete Cataloger implementation for third-party dependencies of Buck2 projects.
+*/
+package buck
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewDependencyCataloger returns a new Buck2 cataloger object based on remote fetching rules within BUCK files and
+// external cells pinned within .buckconfig files.
+func NewDependencyCataloger() pkg.Cataloger {
+	return generic.NewCataloger("buck-dependency-cataloger").
+		WithParserByGlobs(parseBuckFile, "**/BUCK", "**/BUCK.v2").
+		WithParserByGlobs(parseBuckConfig, "**/.buckconfig")
+}
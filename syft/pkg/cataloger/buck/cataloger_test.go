@@ -0,0 +1,34 @@
+package buck
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain buck files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/BUCK",
+				"src/nested/BUCK.v2",
+				"src/nested/.buckconfig",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewDependencyCataloger())
+		})
+	}
+}
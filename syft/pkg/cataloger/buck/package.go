@@ -0,0 +1,149 @@
+package buck
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+var (
+	// e.g. https://crates.io/api/v1/crates/serde/1.0.188/download
+	cratesIOAPIPattern = regexp.MustCompile(`^https?://crates\.io/api/v1/crates/(?P<name>[^/]+)/(?P<version>[^/]+)/download$`)
+
+	// e.g. https://static.crates.io/crates/serde/serde-1.0.188.crate
+	cratesIOStaticPattern = regexp.MustCompile(`^https?://static\.crates\.io/crates/(?P<name>[^/]+)/[^/]+-(?P<version>[^/-]+)\.crate$`)
+
+	// e.g. zlib-1.3.1 or anyhow-v1.0.75
+	nameVersionPattern = regexp.MustCompile(`^(?P<name>.+?)-v?(?P<version>\d[\w.+~]*)$`)
+
+	archiveSuffixes = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst", ".tgz", ".tar", ".zip", ".crate", ".jar", ".whl"}
+)
+
+// remoteCoordinates is the identity of a dependency in its originating ecosystem as derived from its download URL.
+type remoteCoordinates struct {
+	purlType  string
+	namespace string
+	name      string
+	version   string
+}
+
+func newDependencyPackage(d pkg.BuckDependencyEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      d.Name,
+		Version:   d.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(d),
+		Type:      pkg.BuckPkg,
+		Metadata:  d,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(d pkg.BuckDependencyEntry) string {
+	for _, u := range d.URLs {
+		if c, ok := parseRemoteCoordinates(u); ok {
+			return packageurl.NewPackageURL(c.purlType, c.namespace, c.name, c.version, nil, "").ToString()
+		}
+	}
+
+	var qualifiers packageurl.Qualifiers
+	switch {
+	case d.Repository != "":
+		vcsURL := d.Repository
+		if d.Commit != "" {
+			vcsURL += "@" + d.Commit
+		}
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "vcs_url",
+			Value: vcsURL,
+		})
+	case len(d.URLs) > 0:
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "download_url",
+			Value: d.URLs[0],
+		})
+	}
+
+	return packageurl.NewPackageURL(
+		"buck",
+		"",
+		d.Name,
+		d.Version,
+		qualifiers,
+		"",
+	).ToString()
+}
+
+// parseRemoteCoordinates derives the package identity from download URLs that point to a well-known ecosystem
+// (crates.io archives and "mvn:" coordinates used by remote_file()).
+func parseRemoteCoordinates(u string) (remoteCoordinates, bool) {
+	for _, pattern := range []*regexp.Regexp{cratesIOAPIPattern, cratesIOStaticPattern} {
+		if match := pattern.FindStringSubmatch(u); match != nil {
+			return remoteCoordinates{
+				purlType: packageurl.TypeCargo,
+				name:     match[pattern.SubexpIndex("name")],
+				version:  match[pattern.SubexpIndex("version")],
+			}, true
+		}
+	}
+
+	if strings.HasPrefix(u, "mvn:") {
+		// mvn:[<server>:]<group>:<artifact>:<packaging>[:<classifier>]:<version>
+		fields := strings.Split(strings.TrimPrefix(u, "mvn:"), ":")
+		if len(fields) > 1 && (fields[0] == "http" || fields[0] == "https") {
+			// the optional server URL contains a scheme separator of its own
+			fields = fields[2:]
+		}
+		if len(fields) < 4 {
+			return remoteCoordinates{}, false
+		}
+		return remoteCoordinates{
+			purlType:  packageurl.TypeMaven,
+			namespace: fields[0],
+			name:      fields[1],
+			version:   fields[len(fields)-1],
+		}, true
+	}
+
+	return remoteCoordinates{}, false
+}
+
+// inferNameAndVersion makes a best-effort attempt to derive a package name and version for a remote rule, preferring
+// well-known download URLs, then the strip_prefix value, then the target name, and finally the URL filename.
+func inferNameAndVersion(target, stripPrefix string, urls []string) (string, string) {
+	for _, u := range urls {
+		if c, ok := parseRemoteCoordinates(u); ok {
+			return c.name, c.version
+		}
+	}
+
+	candidates := []string{path.Base(strings.TrimSuffix(stripPrefix, "/")), target}
+	for _, u := range urls {
+		candidates = append(candidates, path.Base(u))
+	}
+
+	for _, candidate := range candidates {
+		candidate = trimArchiveSuffix(candidate)
+		if match := nameVersionPattern.FindStringSubmatch(candidate); match != nil {
+			return match[nameVersionPattern.SubexpIndex("name")], match[nameVersionPattern.SubexpIndex("version")]
+		}
+	}
+
+	return target, ""
+}
+
+func trimArchiveSuffix(s string) string {
+	for _, suffix := range archiveSuffixes {
+		if strings.HasSuffix(s, suffix) {
+			return strings.TrimSuffix(s, suffix)
+		}
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package buck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/starlark"
+)
+
+var _ generic.Parser = parseBuckFile
+
+// parseBuckFile is a parser function for BUCK file contents, returning all third-party dependencies fetched by
+// remote rules (such as the http_archive() targets generated by reindeer for crates.io dependencies).
+func parseBuckFile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	calls, err := starlark.ReadCalls(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read BUCK file: %w", err)
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	for _, c := range calls {
+		d, ok := newDependencyEntry(c)
+		if !ok {
+			continue
+		}
+		pkgs = append(pkgs, newDependencyPackage(d, location))
+	}
+
+	return pkgs, nil, nil
+}
+
+func newDependencyEntry(c starlark.Call) (pkg.BuckDependencyEntry, bool) {
+	target := c.String("name")
+	if target == "" {
+		return pkg.BuckDependencyEntry{}, false
+	}
+
+	// rules may be referenced through a struct (e.g. "native.http_archive")
+	rule := c.Name[strings.LastIndex(c.Name, ".")+1:]
+
+	switch rule {
+	case "http_archive", "http_file", "remote_file":
+		urls := append(c.Strings("urls"), c.Strings("url")...)
+		if len(urls) == 0 {
+			return pkg.BuckDependencyEntry{}, false
+		}
+		name, version := inferNameAndVersion(target, c.String("strip_prefix"), urls)
+		return pkg.BuckDependencyEntry{
+			Name:    name,
+			Version: version,
+			Rule:    rule,
+			Target:  target,
+			URLs:    urls,
+			SHA256:  c.String("sha256"),
+			SHA1:    c.String("sha1"),
+		}, true
+	case "git_fetch":
+		repo := c.String("repo")
+		if repo == "" {
+			return pkg.BuckDependencyEntry{}, false
+		}
+		return pkg.BuckDependencyEntry{
+			Name:       target,
+			Version:    c.String("rev"),
+			Rule:       rule,
+			Target:     target,
+			Repository: repo,
+			Commit:     c.String("rev"),
+		}, true
+	}
+
+	return pkg.BuckDependencyEntry{}, false
+}
@@ -0,0 +1,136 @@
+package buck
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseBuckFile(t *testing.T) {
+	fixture := "test-fixtures/BUCK"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "anyhow",
+			Version:   "1.0.75",
+			PURL:      "pkg:cargo/anyhow@1.0.75",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:    "anyhow",
+				Version: "1.0.75",
+				Rule:    "http_archive",
+				Target:  "anyhow-1.0.75.crate",
+				URLs:    []string{"https://crates.io/api/v1/crates/anyhow/1.0.75/download"},
+				SHA256:  "a4668cab20f66d8d020e1fbc0ebe47217433c1b6c8f2040faf858554e394ace6",
+			},
+		},
+		{
+			Name:      "zlib",
+			Version:   "1.3.1",
+			PURL:      "pkg:buck/zlib@1.3.1?download_url=https://github.com/madler/zlib/releases/download/v1.3.1/zlib-1.3.1.tar.gz",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:    "zlib",
+				Version: "1.3.1",
+				Rule:    "http_archive",
+				Target:  "zlib",
+				URLs:    []string{"https://github.com/madler/zlib/releases/download/v1.3.1/zlib-1.3.1.tar.gz"},
+				SHA256:  "9a93b2b7dfdac77ceba5a558a580e74667dd6fede4585b91eefb60f03b72df23",
+			},
+		},
+		{
+			Name:      "hamcrest-core",
+			Version:   "1.3",
+			PURL:      "pkg:maven/org.hamcrest/hamcrest-core@1.3",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:    "hamcrest-core",
+				Version: "1.3",
+				Rule:    "remote_file",
+				Target:  "hamcrest-core",
+				URLs:    []string{"mvn:https://repo1.maven.org/maven2:org.hamcrest:hamcrest-core:jar:1.3"},
+				SHA1:    "42a25dc3219429f0e5d060061f71acb49bf010a0",
+			},
+		},
+		{
+			Name:      "fmt",
+			Version:   "e69e5f977d458f2650bb346dadf2ad30c5320281",
+			PURL:      "pkg:buck/fmt@e69e5f977d458f2650bb346dadf2ad30c5320281?vcs_url=https://github.com/fmtlib/fmt.git%40e69e5f977d458f2650bb346dadf2ad30c5320281",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:       "fmt",
+				Version:    "e69e5f977d458f2650bb346dadf2ad30c5320281",
+				Rule:       "git_fetch",
+				Target:     "fmt",
+				Repository: "https://github.com/fmtlib/fmt.git",
+				Commit:     "e69e5f977d458f2650bb346dadf2ad30c5320281",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseBuckFile, expected, expectedRelationships)
+}
+
+func Test_inferNameAndVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      string
+		stripPrefix string
+		urls        []string
+		wantName    string
+		wantVersion string
+	}{
+		{
+			name:        "static crates.io url",
+			target:      "serde-1.0.188.crate",
+			urls:        []string{"https://static.crates.io/crates/serde/serde-1.0.188.crate"},
+			wantName:    "serde",
+			wantVersion: "1.0.188",
+		},
+		{
+			name:        "strip prefix",
+			target:      "openssl",
+			stripPrefix: "openssl-3.1.4/",
+			urls:        []string{"https://example.com/download"},
+			wantName:    "openssl",
+			wantVersion: "3.1.4",
+		},
+		{
+			name:        "target name",
+			target:      "boost-1.83.0",
+			urls:        []string{"https://example.com/download"},
+			wantName:    "boost",
+			wantVersion: "1.83.0",
+		},
+		{
+			name:        "url filename",
+			target:      "libpng",
+			urls:        []string{"https://example.com/libpng-v1.6.40.tar.xz"},
+			wantName:    "libpng",
+			wantVersion: "1.6.40",
+		},
+		{
+			name:     "no version information",
+			target:   "something",
+			urls:     []string{"https://example.com/archive.zip"},
+			wantName: "something",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotVersion := inferNameAndVersion(tt.target, tt.stripPrefix, tt.urls)
+			if gotName != tt.wantName || gotVersion != tt.wantVersion {
+				t.Errorf("inferNameAndVersion() = (%q, %q), want (%q, %q)", gotName, gotVersion, tt.wantName, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package buck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/ini"
+)
+
+var _ generic.Parser = parseBuckConfig
+
+const externalCellSectionPrefix = "external_cell_"
+
+// parseBuckConfig is a parser function for .buckconfig contents, returning all external cells (e.g. the prelude or
+// shims) that are pinned to a git commit.
+func parseBuckConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	sections, err := ini.Read(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read .buckconfig file: %w", err)
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var cells []string
+	for cell, origin := range sections["external_cells"] {
+		// bundled cells ship with the buck2 binary itself and are not fetched
+		if origin == "git" {
+			cells = append(cells, cell)
+		}
+	}
+
+	// always ensure there is a stable ordering of packages
+	sort.Strings(cells)
+
+	var pkgs []pkg.Package
+	for _, cell := range cells {
+		section := sections[externalCellSectionPrefix+cell]
+		repo := section["git_origin"]
+		if repo == "" {
+			continue
+		}
+		commit := section["commit_hash"]
+		pkgs = append(pkgs, newDependencyPackage(
+			pkg.BuckDependencyEntry{
+				Name:       cell,
+				Version:    commit,
+				Rule:       "external_cell",
+				Target:     cell,
+				Repository: repo,
+				Commit:     commit,
+			},
+			location,
+		))
+	}
+
+	return pkgs, nil, nil
+}
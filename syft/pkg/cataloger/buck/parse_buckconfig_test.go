@@ -0,0 +1,51 @@
+package buck
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseBuckConfig(t *testing.T) {
+	fixture := "test-fixtures/buckconfig/.buckconfig"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "prelude",
+			Version:   "2ab3f3de9a0a5d5ad1d3e0b9a4a1e3e1c4a7e6b0",
+			PURL:      "pkg:buck/prelude@2ab3f3de9a0a5d5ad1d3e0b9a4a1e3e1c4a7e6b0?vcs_url=https://github.com/facebook/buck2-prelude.git%402ab3f3de9a0a5d5ad1d3e0b9a4a1e3e1c4a7e6b0",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:       "prelude",
+				Version:    "2ab3f3de9a0a5d5ad1d3e0b9a4a1e3e1c4a7e6b0",
+				Rule:       "external_cell",
+				Target:     "prelude",
+				Repository: "https://github.com/facebook/buck2-prelude.git",
+				Commit:     "2ab3f3de9a0a5d5ad1d3e0b9a4a1e3e1c4a7e6b0",
+			},
+		},
+		{
+			Name:      "shim",
+			Version:   "8b6d5c4a2f2e3e7d0c9a1b2c3d4e5f6a7b8c9d0e",
+			PURL:      "pkg:buck/shim@8b6d5c4a2f2e3e7d0c9a1b2c3d4e5f6a7b8c9d0e?vcs_url=https://github.com/facebook/buck2-shims-meta.git%408b6d5c4a2f2e3e7d0c9a1b2c3d4e5f6a7b8c9d0e",
+			Locations: locations,
+			Type:      pkg.BuckPkg,
+			Metadata: pkg.BuckDependencyEntry{
+				Name:       "shim",
+				Version:    "8b6d5c4a2f2e3e7d0c9a1b2c3d4e5f6a7b8c9d0e",
+				Rule:       "external_cell",
+				Target:     "shim",
+				Repository: "https://github.com/facebook/buck2-shims-meta.git",
+				Commit:     "8b6d5c4a2f2e3e7d0c9a1b2c3d4e5f6a7b8c9d0e",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseBuckConfig, expected, expectedRelationships)
+}
@@ -0,0 +1,29 @@
+/*
+Package chef provides concrete Cataloger implementations for Chef cookbooks, both installed and resolved by Berkshelf or
+Policyfiles.
+*/
+package chef
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewCookbookCataloger returns a new cataloger object for installed Chef cookbooks (metadata.json or metadata.rb within
+// a cookbooks directory, such as the Berkshelf or chef-client cookbook cache).
+func NewCookbookCataloger() pkg.Cataloger {
+	return generic.NewCataloger("chef-cookbook-cataloger").
+		WithParserByGlobs(parseCookbookMetadataJSON, "**/cookbooks/*/metadata.json").
+		WithParserByGlobs(parseCookbookMetadataRb, "**/cookbooks/*/metadata.rb").
+		WithProcessors(dependency.Processor(dependencySpecifier))
+}
+
+// NewLockCataloger returns a new cataloger object for the cookbooks resolved within Berksfile.lock and
+// Policyfile.lock.json files.
+func NewLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("chef-lock-cataloger").
+		WithParserByGlobs(parseBerksfileLock, "**/Berksfile.lock").
+		WithParserByGlobs(parsePolicyfileLock, "**/Policyfile.lock.json").
+		WithProcessors(dependency.Processor(dependencySpecifier))
+}
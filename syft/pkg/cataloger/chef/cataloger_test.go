@@ -0,0 +1,224 @@
+package chef
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCookbookCataloger(t *testing.T) {
+	aptLocation := file.NewLocation("var/chef/cache/cookbooks/apt/metadata.json")
+	nginxLocation := file.NewLocation("var/chef/cache/cookbooks/nginx/metadata.json")
+	lineLocation := file.NewLocation("root/.berkshelf/cookbooks/line-4.5.13/metadata.rb")
+
+	apt := pkg.Package{
+		Name:      "apt",
+		Version:   "7.5.22",
+		FoundBy:   "chef-cookbook-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", aptLocation)),
+		Locations: file.NewLocationSet(aptLocation),
+		PURL:      "pkg:chef/apt@7.5.22",
+		Type:      pkg.ChefCookbookPkg,
+		Metadata: pkg.ChefCookbookMetadata{
+			Maintainer:      "Sous Chefs",
+			MaintainerEmail: "help@sous-chefs.org",
+			Description:     "Configures apt and apt caches.",
+			SourceURL:       "https://github.com/sous-chefs/apt",
+		},
+	}
+
+	// note: the metadata.rb alongside the metadata.json is not considered
+	nginx := pkg.Package{
+		Name:      "nginx",
+		Version:   "12.2.0",
+		FoundBy:   "chef-cookbook-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", nginxLocation)),
+		Locations: file.NewLocationSet(nginxLocation),
+		PURL:      "pkg:chef/nginx@12.2.0",
+		Type:      pkg.ChefCookbookPkg,
+		Metadata: pkg.ChefCookbookMetadata{
+			Maintainer:      "Sous Chefs",
+			MaintainerEmail: "help@sous-chefs.org",
+			Description:     "Installs and configures nginx",
+			SourceURL:       "https://github.com/sous-chefs/nginx",
+			Dependencies: map[string]string{
+				"apt":  ">= 0.0.0",
+				"line": "~> 4.5",
+			},
+		},
+	}
+
+	line := pkg.Package{
+		Name:      "line",
+		Version:   "4.5.13",
+		FoundBy:   "chef-cookbook-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", lineLocation)),
+		Locations: file.NewLocationSet(lineLocation),
+		PURL:      "pkg:chef/line@4.5.13",
+		Type:      pkg.ChefCookbookPkg,
+		Metadata: pkg.ChefCookbookMetadata{
+			Maintainer:      "Sous Chefs",
+			MaintainerEmail: "help@sous-chefs.org",
+			Description:     "Provides line editing resources for use by recipes",
+			SourceURL:       "https://github.com/sous-chefs/line",
+		},
+	}
+
+	expectedPkgs := []pkg.Package{apt, nginx, line}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: apt,
+			To:   nginx,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: line,
+			To:   nginx,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewCookbookCataloger())
+}
+
+func TestLockCataloger_Berksfile(t *testing.T) {
+	location := file.NewLocation("Berksfile.lock")
+
+	newLockPackage := func(name, version string, entry pkg.ChefCookbookLockEntry) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "chef-lock-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:chef/" + name + "@" + version,
+			Type:      pkg.ChefCookbookPkg,
+			Metadata:  entry,
+		}
+	}
+
+	apt := newLockPackage("apt", "7.5.22", pkg.ChefCookbookLockEntry{})
+	line := newLockPackage("line", "4.5.13", pkg.ChefCookbookLockEntry{})
+	mycookbook := newLockPackage("mycookbook", "0.1.0", pkg.ChefCookbookLockEntry{
+		Source:       ".",
+		Dependencies: []string{"nginx"},
+	})
+	nginx := newLockPackage("nginx", "12.2.0", pkg.ChefCookbookLockEntry{
+		Source:       "https://github.com/sous-chefs/nginx.git",
+		Revision:     "3b0b5ba2d2d4bb2a6a7c2f5b5a9b3e7fc1a2b3c4",
+		Dependencies: []string{"apt", "line"},
+	})
+
+	expectedPkgs := []pkg.Package{apt, line, mycookbook, nginx}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: apt,
+			To:   nginx,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: line,
+			To:   nginx,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: nginx,
+			To:   mycookbook,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/lock/berkshelf").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewLockCataloger())
+}
+
+func TestLockCataloger_Policyfile(t *testing.T) {
+	location := file.NewLocation("Policyfile.lock.json")
+
+	apt := pkg.Package{
+		Name:      "apt",
+		Version:   "7.5.22",
+		FoundBy:   "chef-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:chef/apt@7.5.22",
+		Type:      pkg.ChefCookbookPkg,
+		Metadata: pkg.ChefCookbookLockEntry{
+			Source:     "https://supermarket.chef.io:443/api/v1/cookbooks/apt/versions/7.5.22/download",
+			Identifier: "f0c6b3b7d0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5",
+		},
+	}
+
+	base := pkg.Package{
+		Name:      "base",
+		Version:   "0.1.0",
+		FoundBy:   "chef-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:chef/base@0.1.0",
+		Type:      pkg.ChefCookbookPkg,
+		Metadata: pkg.ChefCookbookLockEntry{
+			Source:       ".",
+			Revision:     "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432",
+			Identifier:   "0a1b2c3d4e5f60718293a4b5c6d7e8f901234567",
+			Dependencies: []string{"apt"},
+		},
+	}
+
+	expectedPkgs := []pkg.Package{apt, base}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: apt,
+			To:   base,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/lock/policyfile").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewLockCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain installed cookbook metadata",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewCookbookCataloger(),
+			expected: []string{
+				"var/chef/cache/cookbooks/x/metadata.json",
+				"srv/chef-repo/cookbooks/y/metadata.rb",
+			},
+		},
+		{
+			name:      "obtain lock files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewLockCataloger(),
+			expected: []string{
+				"srv/chef-repo/Berksfile.lock",
+				"srv/chef-repo/policies/Policyfile.lock.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				IgnoreUnfulfilledPathResponses("srv/chef-repo/cookbooks/y/metadata.json").
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
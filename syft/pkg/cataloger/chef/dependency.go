@@ -0,0 +1,35 @@
+package chef
+
+import (
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = dependencySpecifier
+
+// dependencySpecifier describes the dependencies between cookbooks by cookbook name.
+func dependencySpecifier(p pkg.Package) dependency.Specification {
+	var requires []string
+	switch meta := p.Metadata.(type) {
+	case pkg.ChefCookbookMetadata:
+		for name := range meta.Dependencies {
+			requires = append(requires, name)
+		}
+		sort.Strings(requires)
+	case pkg.ChefCookbookLockEntry:
+		requires = meta.Dependencies
+	default:
+		log.Tracef("cataloger failed to extract chef metadata for package %+v", p.Name)
+		return dependency.Specification{}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{p.Name},
+			Requires: requires,
+		},
+	}
+}
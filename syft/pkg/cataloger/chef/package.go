@@ -0,0 +1,35 @@
+package chef
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name, version string, licenses []string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0].WithoutAnnotations(), licenses...)...),
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Type:      pkg.ChefCookbookPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL for a cookbook (e.g. pkg:chef/apt@7.5.22).
+func packageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.ChefCookbookPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
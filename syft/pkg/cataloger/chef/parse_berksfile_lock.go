@@ -0,0 +1,119 @@
+package chef
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseBerksfileLock
+
+type berksfileLockCookbook struct {
+	name    string
+	version string
+	entry   pkg.ChefCookbookLockEntry
+}
+
+// parseBerksfileLock is a parser function for Berksfile.lock contents, returning all cookbooks within the resolved
+// dependency graph. Where cookbooks are sourced from (other than the Supermarket) is taken from the DEPENDENCIES
+// section:
+//
+//	DEPENDENCIES
+//	  nginx
+//	    git: https://github.com/sous-chefs/nginx.git
+//	    revision: 3b0b5ba2d2d4bb2a6a7c2f5b5a9b3e7fc1a2b3c4
+//
+//	GRAPH
+//	  apt (7.5.22)
+//	  nginx (12.2.0)
+//	    apt (>= 0.0.0)
+func parseBerksfileLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	sources := make(map[string]pkg.ChefCookbookLockEntry)
+	var cookbooks []berksfileLockCookbook
+	var section, dependency string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent == 0 {
+			section = trimmed
+			continue
+		}
+
+		switch section {
+		case "DEPENDENCIES":
+			if indent == 2 {
+				dependency, _ = parseSpec(trimmed)
+				continue
+			}
+			key, value, found := strings.Cut(trimmed, ":")
+			if !found || dependency == "" {
+				continue
+			}
+			source := sources[dependency]
+			switch value = strings.TrimSpace(value); key {
+			case "git", "path":
+				source.Source = value
+			case "revision":
+				source.Revision = value
+			}
+			sources[dependency] = source
+		case "GRAPH":
+			name, version := parseSpec(trimmed)
+			if name == "" {
+				continue
+			}
+			if indent == 2 {
+				cookbooks = append(cookbooks, berksfileLockCookbook{name: name, version: version})
+				continue
+			}
+			if len(cookbooks) > 0 {
+				current := &cookbooks[len(cookbooks)-1]
+				current.entry.Dependencies = append(current.entry.Dependencies, name)
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Berksfile.lock: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, c := range cookbooks {
+		source := sources[c.name]
+		c.entry.Source = source.Source
+		c.entry.Revision = source.Revision
+		pkgs = append(pkgs,
+			newPackage(
+				c.name,
+				c.version,
+				nil,
+				c.entry,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		)
+	}
+
+	return pkgs, nil, nil
+}
+
+// parseSpec splits a spec line such as "apt (7.5.22)" into the cookbook name and the version (or version constraint)
+// within parenthesis.
+func parseSpec(spec string) (string, string) {
+	name, rest, _ := strings.Cut(spec, " ")
+	version := strings.TrimSpace(rest)
+	version = strings.TrimSuffix(strings.TrimPrefix(version, "("), ")")
+	return name, version
+}
@@ -0,0 +1,130 @@
+package chef
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parseCookbookMetadataJSON
+	_ generic.Parser = parseCookbookMetadataRb
+)
+
+// metadataRbPattern matches a single metadata.rb statement with string literal arguments, such as:
+//
+//	version 'x.y.z'
+//	depends "apt", "~> 7.0"
+var metadataRbPattern = regexp.MustCompile(`^(?P<key>[a-z_]+)\s*\(?\s*['"](?P<value>[^'"]*)['"](?:\s*,\s*['"](?P<constraint>[^'"]*)['"])?`)
+
+type cookbookMetadata struct {
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	Maintainer      string            `json:"maintainer"`
+	MaintainerEmail string            `json:"maintainer_email"`
+	Description     string            `json:"description"`
+	License         string            `json:"license"`
+	SourceURL       string            `json:"source_url"`
+	Dependencies    map[string]string `json:"dependencies"`
+}
+
+// parseCookbookMetadataJSON is a parser function for the metadata.json of a cookbook (compiled from metadata.rb when
+// the cookbook is uploaded or vendored).
+func parseCookbookMetadataJSON(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var metadata cookbookMetadata
+	if err := json.NewDecoder(reader).Decode(&metadata); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse chef cookbook metadata.json: %w", err)
+	}
+
+	return newCookbookPackages(metadata, reader.Location), nil, nil
+}
+
+// parseCookbookMetadataRb is a parser function for the metadata.rb of a cookbook. Only statements with literal string
+// arguments are considered, and cookbooks that also have a metadata.json are left to the metadata.json parser.
+func parseCookbookMetadataRb(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if resolver != nil {
+		jsonPath := path.Join(path.Dir(reader.Location.RealPath), "metadata.json")
+		if resolver.RelativeFileByPath(reader.Location, jsonPath) != nil {
+			return nil, nil, nil
+		}
+	}
+
+	metadata := cookbookMetadata{
+		Dependencies: make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		match := metadataRbPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		key, value := match[1], match[2]
+		switch key {
+		case "name":
+			metadata.Name = value
+		case "version":
+			metadata.Version = value
+		case "maintainer":
+			metadata.Maintainer = value
+		case "maintainer_email":
+			metadata.MaintainerEmail = value
+		case "description":
+			metadata.Description = value
+		case "license":
+			metadata.License = value
+		case "source_url":
+			metadata.SourceURL = value
+		case "depends":
+			metadata.Dependencies[value] = match[3]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse chef cookbook metadata.rb: %w", err)
+	}
+
+	return newCookbookPackages(metadata, reader.Location), nil, nil
+}
+
+func newCookbookPackages(metadata cookbookMetadata, location file.Location) []pkg.Package {
+	if metadata.Name == "" {
+		return nil
+	}
+
+	if len(metadata.Dependencies) == 0 {
+		metadata.Dependencies = nil
+	}
+
+	var licenses []string
+	if metadata.License != "" && !strings.EqualFold(metadata.License, "all rights reserved") {
+		licenses = append(licenses, metadata.License)
+	}
+
+	return []pkg.Package{
+		newPackage(
+			metadata.Name,
+			metadata.Version,
+			licenses,
+			pkg.ChefCookbookMetadata{
+				Maintainer:      metadata.Maintainer,
+				MaintainerEmail: metadata.MaintainerEmail,
+				Description:     metadata.Description,
+				SourceURL:       metadata.SourceURL,
+				Dependencies:    metadata.Dependencies,
+			},
+			location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}
+}
@@ -0,0 +1,82 @@
+package chef
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePolicyfileLock
+
+type policyfileLock struct {
+	CookbookLocks        map[string]policyfileCookbook `json:"cookbook_locks"`
+	SolutionDependencies struct {
+		// Dependencies is keyed by "name (version)", with a list of [name, constraint] pairs as the value
+		Dependencies map[string][][]string `json:"dependencies"`
+	} `json:"solution_dependencies"`
+}
+
+type policyfileCookbook struct {
+	Version    string `json:"version"`
+	Identifier string `json:"identifier"`
+	Source     string `json:"source"`
+	Origin     string `json:"origin"`
+	SCMInfo    *struct {
+		Revision string `json:"revision"`
+	} `json:"scm_info"`
+}
+
+// parsePolicyfileLock is a parser function for Policyfile.lock.json contents, returning all cookbooks locked for the
+// policy.
+func parsePolicyfileLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock policyfileLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Policyfile.lock.json: %w", err)
+	}
+
+	names := make([]string, 0, len(lock.CookbookLocks))
+	for name := range lock.CookbookLocks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		cookbook := lock.CookbookLocks[name]
+
+		entry := pkg.ChefCookbookLockEntry{
+			Source:     cookbook.Source,
+			Identifier: cookbook.Identifier,
+		}
+		if entry.Source == "" {
+			entry.Source = cookbook.Origin
+		}
+		if cookbook.SCMInfo != nil {
+			entry.Revision = cookbook.SCMInfo.Revision
+		}
+
+		for _, dep := range lock.SolutionDependencies.Dependencies[fmt.Sprintf("%s (%s)", name, cookbook.Version)] {
+			if len(dep) > 0 && dep[0] != "" {
+				entry.Dependencies = append(entry.Dependencies, dep[0])
+			}
+		}
+
+		pkgs = append(pkgs,
+			newPackage(
+				name,
+				cookbook.Version,
+				nil,
+				entry,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		)
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1 @@
+name 'y'
@@ -0,0 +1,12 @@
+name              'line'
+maintainer        'Sous Chefs'
+maintainer_email  'help@sous-chefs.org'
+license           'Apache-2.0'
+description       'Provides line editing resources for use by recipes'
+version           '4.5.13'
+source_url        'https://github.com/sous-chefs/line'
+issues_url        'https://github.com/sous-chefs/line/issues'
+chef_version      '>= 15.3'
+
+supports 'ubuntu'
+supports 'debian'
@@ -0,0 +1,3 @@
+# note: this cookbook also has a metadata.json, which takes precedence
+name              'nginx'
+version           '12.2.0'
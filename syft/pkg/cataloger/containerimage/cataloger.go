@@ -0,0 +1,34 @@
+/*
+Package containerimage provides a concrete Cataloger implementation for the container images referenced by deployment
+manifests (docker compose files, Kubernetes manifests, and rendered Helm templates), allowing for GitOps repositories to
+be inventoried for the images they deploy, along with the images and features referenced by development environment
+configurations (dev containers and devfiles).
+*/
+package containerimage
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for the container images referenced by compose files and Kubernetes
+// manifests. Since any YAML file may be a Kubernetes manifest this should only be used when explicitly selected.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("container-image-reference-cataloger").
+		WithParserByGlobs(parseComposeFile,
+			"**/docker-compose.yml", "**/docker-compose.yaml",
+			"**/docker-compose.*.yml", "**/docker-compose.*.yaml",
+			"**/compose.yml", "**/compose.yaml",
+		).
+		WithParserByGlobs(parseKubernetesManifest, "**/*.yaml", "**/*.yml")
+}
+
+// NewDevEnvironmentCataloger returns a new cataloger object for the images and features referenced by dev container
+// configurations and devfiles, which describe the development environment of a project.
+func NewDevEnvironmentCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dev-environment-cataloger").
+		WithParserByGlobs(parseDevContainer,
+			"**/.devcontainer/devcontainer.json", "**/.devcontainer/*/devcontainer.json", "**/.devcontainer.json",
+		).
+		WithParserByGlobs(parseDevfile, "**/devfile.yaml", "**/.devfile.yaml")
+}
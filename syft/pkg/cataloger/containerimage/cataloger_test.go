@@ -0,0 +1,296 @@
+package containerimage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger(t *testing.T) {
+	composeLocation := file.NewLocation("compose/docker-compose.yml")
+	deploymentLocation := file.NewLocation("k8s/deployment.yaml")
+	renderedLocation := file.NewLocation("rendered/manifest.yaml")
+
+	newImagePackage := func(name, version, purl string, entry pkg.ContainerImageReferenceEntry, location file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "container-image-reference-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      purl,
+			Type:      pkg.ContainerImagePkg,
+			Metadata:  entry,
+		}
+	}
+
+	// note: images that are built locally, templated, or set via environment variables are not considered
+	expectedPkgs := []pkg.Package{
+		newImagePackage("postgres", "sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b",
+			"pkg:oci/postgres@sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b?repository_url=docker.io/library/postgres",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "postgres@sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b",
+				Registry:     "docker.io",
+				Repository:   "library/postgres",
+				Digest:       "sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b",
+				ManifestType: "compose",
+				Workload:     "db",
+			}, composeLocation),
+		newImagePackage("nginx", "1.27", "pkg:oci/nginx?repository_url=docker.io/library/nginx&tag=1.27",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "nginx:1.27",
+				Registry:     "docker.io",
+				Repository:   "library/nginx",
+				Tag:          "1.27",
+				ManifestType: "compose",
+				Workload:     "web",
+			}, composeLocation),
+		newImagePackage("ghcr.io/example/migrations", "v3", "pkg:oci/migrations?repository_url=ghcr.io/example/migrations&tag=v3",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "ghcr.io/example/migrations:v3",
+				Registry:     "ghcr.io",
+				Repository:   "example/migrations",
+				Tag:          "v3",
+				ManifestType: "kubernetes",
+				Workload:     "Deployment/web",
+				Namespace:    "shop",
+				Container:    "migrate",
+			}, deploymentLocation),
+		newImagePackage("ghcr.io/example/shop", "v1.4.2", "pkg:oci/shop?repository_url=ghcr.io/example/shop&tag=v1.4.2",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "ghcr.io/example/shop:v1.4.2",
+				Registry:     "ghcr.io",
+				Repository:   "example/shop",
+				Tag:          "v1.4.2",
+				ManifestType: "kubernetes",
+				Workload:     "Deployment/web",
+				Namespace:    "shop",
+				Container:    "app",
+			}, deploymentLocation),
+		newImagePackage("envoyproxy/envoy", "v1.30.1", "pkg:oci/envoy?repository_url=docker.io/envoyproxy/envoy&tag=v1.30.1",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "envoyproxy/envoy:v1.30.1",
+				Registry:     "docker.io",
+				Repository:   "envoyproxy/envoy",
+				Tag:          "v1.30.1",
+				ManifestType: "kubernetes",
+				Workload:     "Deployment/web",
+				Namespace:    "shop",
+				Container:    "proxy",
+			}, deploymentLocation),
+		newImagePackage("busybox", "latest", "pkg:oci/busybox?repository_url=docker.io/library/busybox&tag=latest",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "busybox",
+				Registry:     "docker.io",
+				Repository:   "library/busybox",
+				Tag:          "latest",
+				ManifestType: "kubernetes",
+				Workload:     "CronJob/report",
+				Container:    "report",
+			}, deploymentLocation),
+		newImagePackage("bitnami/redis", "7.2.5-debian-12-r0", "pkg:oci/redis?repository_url=docker.io/bitnami/redis&tag=7.2.5-debian-12-r0",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "docker.io/bitnami/redis:7.2.5-debian-12-r0",
+				Registry:     "docker.io",
+				Repository:   "bitnami/redis",
+				Tag:          "7.2.5-debian-12-r0",
+				ManifestType: "kubernetes",
+				Workload:     "Pod/redis-master-0",
+				Container:    "redis",
+			}, renderedLocation),
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/gitops").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"src/docker-compose.yml",
+			"src/compose.yaml",
+			"src/docker-compose.prod.yaml",
+			"src/deploy/app.yml",
+			"src/deploy/app.yaml",
+			"svc/docker-compose.yaml",
+			"svc/docker-compose.dev.yml",
+			"svc/compose.yml",
+		}).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestDevEnvironmentCataloger(t *testing.T) {
+	devContainerLocation := file.NewLocation(".devcontainer/devcontainer.json")
+	dockerfileLocation := file.NewLocation(".devcontainer/Dockerfile")
+	pythonLocation := file.NewLocation(".devcontainer/python/devcontainer.json")
+	devfileLocation := file.NewLocation("devfile.yaml")
+	devfileDockerfileLocation := file.NewLocation("docker/Dockerfile")
+
+	newImagePackage := func(name, version, purl string, entry pkg.ContainerImageReferenceEntry, location file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "dev-environment-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      purl,
+			Type:      pkg.ContainerImagePkg,
+			Metadata:  entry,
+		}
+	}
+
+	newFeaturePackage := func(name, version, purl string, entry pkg.DevContainerFeatureEntry, location file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "dev-environment-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      purl,
+			Type:      pkg.DevContainerFeaturePkg,
+			Metadata:  entry,
+		}
+	}
+
+	// note: templated images, earlier build stages, scratch, and local, tarball, or short-form features are not considered
+	expectedPkgs := []pkg.Package{
+		newImagePackage("golang", "1.22", "pkg:oci/golang?repository_url=docker.io/library/golang&tag=1.22",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "golang:1.22",
+				Registry:     "docker.io",
+				Repository:   "library/golang",
+				Tag:          "1.22",
+				ManifestType: "dockerfile",
+				Workload:     "shop",
+			}, dockerfileLocation),
+		newImagePackage("mcr.microsoft.com/devcontainers/base", "bookworm", "pkg:oci/base?repository_url=mcr.microsoft.com/devcontainers/base&tag=bookworm",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "mcr.microsoft.com/devcontainers/base:bookworm",
+				Registry:     "mcr.microsoft.com",
+				Repository:   "devcontainers/base",
+				Tag:          "bookworm",
+				ManifestType: "dockerfile",
+				Workload:     "shop",
+			}, dockerfileLocation),
+		newFeaturePackage("ghcr.io/devcontainers/features/go", "1", "pkg:oci/go?repository_url=ghcr.io/devcontainers/features/go&tag=1&type=devcontainer-feature",
+			pkg.DevContainerFeatureEntry{
+				Reference:  "ghcr.io/devcontainers/features/go:1",
+				Registry:   "ghcr.io",
+				Repository: "devcontainers/features/go",
+				Tag:        "1",
+				Options:    pkg.KeyValues{{Key: "version", Value: "1.22"}},
+			}, devContainerLocation),
+		newFeaturePackage("ghcr.io/devcontainers/features/node", "1", "pkg:oci/node?repository_url=ghcr.io/devcontainers/features/node&tag=1&type=devcontainer-feature",
+			pkg.DevContainerFeatureEntry{
+				Reference:  "ghcr.io/devcontainers/features/node:1",
+				Registry:   "ghcr.io",
+				Repository: "devcontainers/features/node",
+				Tag:        "1",
+				Options: pkg.KeyValues{
+					{Key: "nodeGypDependencies", Value: "true"},
+					{Key: "version", Value: "20"},
+				},
+			}, devContainerLocation),
+		newImagePackage("mcr.microsoft.com/devcontainers/python", "3.12", "pkg:oci/python?repository_url=mcr.microsoft.com/devcontainers/python&tag=3.12",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "mcr.microsoft.com/devcontainers/python:3.12",
+				Registry:     "mcr.microsoft.com",
+				Repository:   "devcontainers/python",
+				Tag:          "3.12",
+				ManifestType: "devcontainer",
+				Workload:     "python",
+			}, pythonLocation),
+		newFeaturePackage("ghcr.io/devcontainers/features/docker-in-docker", "sha256:4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b",
+			"pkg:oci/docker-in-docker@sha256:4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b?repository_url=ghcr.io/devcontainers/features/docker-in-docker&type=devcontainer-feature",
+			pkg.DevContainerFeatureEntry{
+				Reference:  "ghcr.io/devcontainers/features/docker-in-docker@sha256:4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b",
+				Registry:   "ghcr.io",
+				Repository: "devcontainers/features/docker-in-docker",
+				Digest:     "sha256:4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b",
+			}, pythonLocation),
+		newImagePackage("quay.io/devfile/universal-developer-image", "ubi8-latest", "pkg:oci/universal-developer-image?repository_url=quay.io/devfile/universal-developer-image&tag=ubi8-latest",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "quay.io/devfile/universal-developer-image:ubi8-latest",
+				Registry:     "quay.io",
+				Repository:   "devfile/universal-developer-image",
+				Tag:          "ubi8-latest",
+				ManifestType: "devfile",
+				Workload:     "tools",
+			}, devfileLocation),
+		newImagePackage("registry.access.redhat.com/ubi9/go-toolset", "1.21", "pkg:oci/go-toolset?repository_url=registry.access.redhat.com/ubi9/go-toolset&tag=1.21",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "registry.access.redhat.com/ubi9/go-toolset:1.21",
+				Registry:     "registry.access.redhat.com",
+				Repository:   "ubi9/go-toolset",
+				Tag:          "1.21",
+				ManifestType: "dockerfile",
+				Workload:     "outerloop-build",
+			}, devfileDockerfileLocation),
+		newImagePackage("registry.access.redhat.com/ubi9/ubi-minimal", "sha256:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b",
+			"pkg:oci/ubi-minimal@sha256:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b?repository_url=registry.access.redhat.com/ubi9/ubi-minimal",
+			pkg.ContainerImageReferenceEntry{
+				Reference:    "registry.access.redhat.com/ubi9/ubi-minimal@sha256:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b",
+				Registry:     "registry.access.redhat.com",
+				Repository:   "ubi9/ubi-minimal",
+				Digest:       "sha256:9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b",
+				ManifestType: "dockerfile",
+				Workload:     "outerloop-build",
+			}, devfileDockerfileLocation),
+	}
+
+	// the package type is recovered from the package URL (e.g. when decoding CycloneDX or SPDX documents)
+	for _, p := range expectedPkgs {
+		assert.Equal(t, p.Type, pkg.TypeFromPURL(p.PURL), p.PURL)
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/dev-environment").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewDevEnvironmentCataloger())
+}
+
+func Test_DevEnvironmentCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/dev-environment-glob-paths").
+		ExpectsResolverContentQueries([]string{
+			".devcontainer/devcontainer.json",
+			".devcontainer/python/devcontainer.json",
+			"svc/.devcontainer.json",
+			"svc/devfile.yaml",
+			"app/.devfile.yaml",
+		}).
+		TestCataloger(t, NewDevEnvironmentCataloger())
+}
+
+func Test_stripJSONComments(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "line and block comments",
+			input: "{\n// comment\n\"a\": /* inline */ 1\n}",
+			want:  "{\n\n\"a\":  1\n}",
+		},
+		{
+			name:  "trailing commas",
+			input: `{"a": [1, 2,], "b": {"c": 3,},}`,
+			want:  `{"a": [1, 2], "b": {"c": 3}}`,
+		},
+		{
+			name:  "comment markers and commas within strings",
+			input: `{"url": "https://example.com/*x*/", "s": ",}", "q": "\"//"}`,
+			want:  `{"url": "https://example.com/*x*/", "s": ",}", "q": "\"//"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(stripJSONComments([]byte(tt.input))))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package containerimage
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// dockerfileImages returns the base images of the Dockerfile at the given path (relative to the directory of the
+// manifest that builds it), where workload names the dev container or component the Dockerfile is built for.
+func dockerfileImages(resolver file.Resolver, manifest file.Location, dockerfilePath, workload string) []pkg.Package {
+	if resolver == nil || dockerfilePath == "" || strings.Contains(dockerfilePath, "://") {
+		return nil
+	}
+
+	fullPath := path.Clean(path.Join(path.Dir(manifest.RealPath), dockerfilePath))
+	location := resolver.RelativeFileByPath(manifest, fullPath)
+	if location == nil {
+		log.WithFields("path", fullPath).Trace("unable to find Dockerfile referenced by dev environment")
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("error", err, "path", fullPath).Trace("unable to read Dockerfile")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	var pkgs []pkg.Package
+	for _, ref := range baseImages(contents) {
+		p, ok := newPackage(ref, pkg.ContainerImageReferenceEntry{
+			ManifestType: dockerfileManifest,
+			Workload:     workload,
+		}, *location)
+		if ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+	return pkgs
+}
+
+// baseImages returns the images referenced by the FROM instructions of a Dockerfile, in order. Stages built from
+// scratch or from an earlier stage of the same Dockerfile are not considered.
+func baseImages(reader io.Reader) []string {
+	var refs []string
+	stages := make(map[string]bool)
+
+	for _, instruction := range instructions(reader) {
+		fields := strings.Fields(instruction)
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "FROM") {
+			continue
+		}
+
+		args := fields[1:]
+		for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+			// flags such as --platform
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		ref := args[0]
+		if !strings.EqualFold(ref, "scratch") && !stages[strings.ToLower(ref)] {
+			refs = append(refs, ref)
+		}
+
+		if len(args) >= 3 && strings.EqualFold(args[1], "AS") {
+			stages[strings.ToLower(args[2])] = true
+		}
+	}
+
+	return refs
+}
+
+// instructions returns the instructions of a Dockerfile with line continuations joined and comments removed.
+func instructions(reader io.Reader) []string {
+	var (
+		result  []string
+		current strings.Builder
+	)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			current.WriteString(strings.TrimSuffix(line, "\\"))
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+		if instruction := strings.TrimSpace(current.String()); instruction != "" {
+			result = append(result, instruction)
+		}
+		current.Reset()
+	}
+	if instruction := strings.TrimSpace(current.String()); instruction != "" {
+		result = append(result, instruction)
+	}
+
+	return result
+}
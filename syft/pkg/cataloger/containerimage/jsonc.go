@@ -0,0 +1,53 @@
+package containerimage
+
+// stripJSONComments returns the given JSONC document (JSON with comments, as used by dev container configurations) as
+// plain JSON by removing line and block comments and trailing commas, leaving the contents of strings untouched.
+func stripJSONComments(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	// the index within out of a comma that may turn out to be a trailing comma
+	pendingComma := -1
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '"':
+			pendingComma = -1
+			start := i
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+			end := min(i+1, len(data))
+			out = append(out, data[start:end]...)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i+1 < len(data) && (data[i] != '*' || data[i+1] != '/') {
+				i++
+			}
+			i++
+		case c == ',':
+			pendingComma = len(out)
+			out = append(out, c)
+		case c == '}' || c == ']':
+			if pendingComma >= 0 {
+				// drop the trailing comma, keeping any whitespace that followed it
+				out = append(out[:pendingComma], out[pendingComma+1:]...)
+			}
+			pendingComma = -1
+			out = append(out, c)
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			out = append(out, c)
+		default:
+			pendingComma = -1
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
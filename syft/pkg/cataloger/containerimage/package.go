@@ -0,0 +1,164 @@
+package containerimage
+
+import (
+	"strings"
+
+	"github.com/distribution/reference"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	composeManifest      = "compose"
+	kubernetesManifest   = "kubernetes"
+	devcontainerManifest = "devcontainer"
+	devfileManifest      = "devfile"
+	dockerfileManifest   = "dockerfile"
+)
+
+// newPackage returns a package for the given image reference, returning false if the reference cannot be resolved
+// (e.g. when it is templated or depends on environment variables).
+func newPackage(ref string, entry pkg.ContainerImageReferenceEntry, location file.Location) (pkg.Package, bool) {
+	ref = strings.TrimSpace(ref)
+	named, ok := parseReference(ref, location)
+	if !ok {
+		return pkg.Package{}, false
+	}
+
+	entry.Reference = ref
+	entry.Registry = reference.Domain(named)
+	entry.Repository = reference.Path(named)
+	if tagged, ok := named.(reference.Tagged); ok {
+		entry.Tag = tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		entry.Digest = digested.Digest().String()
+	}
+	if entry.Tag == "" && entry.Digest == "" {
+		// an image referenced by name only implies the latest tag
+		entry.Tag = "latest"
+	}
+
+	version := entry.Tag
+	if version == "" {
+		version = entry.Digest
+	}
+
+	p := pkg.Package{
+		Name:      reference.FamiliarName(named),
+		Version:   version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		PURL:      packageURL(entry),
+		Type:      pkg.ContainerImagePkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p, true
+}
+
+// newFeaturePackage returns a package for the given dev container feature reference, returning false if the reference
+// is not of a feature published to an OCI registry (e.g. a local feature or a feature downloaded as a tarball).
+func newFeaturePackage(ref string, options pkg.KeyValues, location file.Location) (pkg.Package, bool) {
+	ref = strings.TrimSpace(ref)
+	if strings.HasPrefix(ref, ".") || strings.Contains(ref, "://") {
+		return pkg.Package{}, false
+	}
+
+	// features are always referenced by registry, where a reference without one is a deprecated short-form feature id
+	if idx := strings.Index(ref, "/"); idx < 0 || !strings.ContainsAny(ref[:idx], ".:") {
+		return pkg.Package{}, false
+	}
+
+	named, ok := parseReference(ref, location)
+	if !ok {
+		return pkg.Package{}, false
+	}
+
+	entry := pkg.DevContainerFeatureEntry{
+		Reference:  ref,
+		Registry:   reference.Domain(named),
+		Repository: reference.Path(named),
+		Options:    options,
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		entry.Tag = tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		entry.Digest = digested.Digest().String()
+	}
+	if entry.Tag == "" && entry.Digest == "" {
+		// a feature referenced by name only implies the latest tag
+		entry.Tag = "latest"
+	}
+
+	version := entry.Tag
+	if version == "" {
+		version = entry.Digest
+	}
+
+	p := pkg.Package{
+		Name:      reference.FamiliarName(named),
+		Version:   version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		PURL:      ociPackageURL(pkg.DevContainerFeaturePkg, entry.Registry, entry.Repository, entry.Tag, entry.Digest),
+		Type:      pkg.DevContainerFeaturePkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p, true
+}
+
+// parseReference returns the normalized form of the given image reference, returning false if the reference cannot be
+// resolved (e.g. when it is templated or depends on environment variables).
+func parseReference(ref string, location file.Location) (reference.Named, bool) {
+	if ref == "" || strings.Contains(ref, "$") || strings.Contains(ref, "{{") {
+		return nil, false
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		log.WithFields("reference", ref, "path", location.RealPath, "error", err).Trace("unable to parse image reference")
+		return nil, false
+	}
+	return named, true
+}
+
+// packageURL returns the package URL for an image, where the version is the digest of the image (when known) and the
+// repository and tag are qualifiers (e.g. pkg:oci/nginx@sha256:...?repository_url=docker.io/library/nginx&tag=1.27).
+func packageURL(entry pkg.ContainerImageReferenceEntry) string {
+	return ociPackageURL(pkg.ContainerImagePkg, entry.Registry, entry.Repository, entry.Tag, entry.Digest)
+}
+
+func ociPackageURL(ty pkg.Type, registry, repository, tag, digest string) string {
+	name := repository
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		name = name[idx+1:]
+	}
+
+	qualifiers := map[string]string{
+		"repository_url": registry + "/" + repository,
+	}
+	if tag != "" {
+		qualifiers["tag"] = tag
+	}
+	if ty != pkg.ContainerImagePkg {
+		// all OCI artifacts share the "oci" package URL type, so other artifacts are distinguished by qualifier
+		qualifiers[pkg.PURLQualifierType] = string(ty)
+	}
+
+	return packageurl.NewPackageURL(
+		ty.PackageURLType(),
+		"",
+		strings.ToLower(name),
+		digest,
+		packageurl.QualifiersFromMap(qualifiers),
+		"",
+	).ToString()
+}
@@ -0,0 +1,52 @@
+package containerimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseComposeFile
+
+type composeFile struct {
+	Services map[string]struct {
+		Image string `yaml:"image"`
+	} `yaml:"services"`
+}
+
+// parseComposeFile is a parser function for docker compose files, returning the images used by each service (services
+// that are only built from a local context without naming the image are not considered).
+func parseComposeFile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var compose composeFile
+	if err := yaml.NewDecoder(reader).Decode(&compose); err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("failed to parse compose file: %w", err)
+	}
+
+	var names []string
+	for name := range compose.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		p, ok := newPackage(compose.Services[name].Image, pkg.ContainerImageReferenceEntry{
+			ManifestType: composeManifest,
+			Workload:     name,
+		}, reader.Location)
+		if ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,111 @@
+package containerimage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDevContainer
+
+type devContainerConfig struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	Build struct {
+		Dockerfile string `json:"dockerfile"`
+	} `json:"build"`
+	// DockerFile is the deprecated form of build.dockerfile
+	DockerFile string                     `json:"dockerFile"`
+	Features   map[string]json.RawMessage `json:"features"`
+}
+
+// parseDevContainer is a parser function for dev container configurations (devcontainer.json), returning the image the
+// dev container is run from (or the base images of the Dockerfile it is built from) along with the features installed
+// into it. Local features and features downloaded as tarballs are not considered.
+func parseDevContainer(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read dev container configuration: %w", err)
+	}
+
+	var config devContainerConfig
+	if err := json.Unmarshal(stripJSONComments(data), &config); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse dev container configuration: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	if p, ok := newPackage(config.Image, pkg.ContainerImageReferenceEntry{
+		ManifestType: devcontainerManifest,
+		Workload:     config.Name,
+	}, reader.Location); ok {
+		pkgs = append(pkgs, p)
+	}
+
+	dockerfile := config.Build.Dockerfile
+	if dockerfile == "" {
+		dockerfile = config.DockerFile
+	}
+	pkgs = append(pkgs, dockerfileImages(resolver, reader.Location, dockerfile, config.Name)...)
+
+	var refs []string
+	for ref := range config.Features {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	for _, ref := range refs {
+		if p, ok := newFeaturePackage(ref, featureOptions(config.Features[ref]), reader.Location); ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+// featureOptions returns the options a feature is installed with, where a feature may be given a string as shorthand
+// for the "version" option.
+func featureOptions(value json.RawMessage) pkg.KeyValues {
+	var version string
+	if err := json.Unmarshal(value, &version); err == nil {
+		if version == "" {
+			return nil
+		}
+		return pkg.KeyValues{{Key: "version", Value: version}}
+	}
+
+	var options map[string]any
+	if err := json.Unmarshal(value, &options); err != nil {
+		return nil
+	}
+
+	var keys []string
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var result pkg.KeyValues
+	for _, key := range keys {
+		var v string
+		switch value := options[key].(type) {
+		case string:
+			v = value
+		case bool:
+			v = strconv.FormatBool(value)
+		case float64:
+			v = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			continue
+		}
+		result = append(result, pkg.KeyValue{Key: key, Value: v})
+	}
+	return result
+}
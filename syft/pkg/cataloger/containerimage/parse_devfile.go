@@ -0,0 +1,62 @@
+package containerimage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDevfile
+
+type devfile struct {
+	SchemaVersion string `yaml:"schemaVersion"`
+	Components    []struct {
+		Name      string `yaml:"name"`
+		Container *struct {
+			Image string `yaml:"image"`
+		} `yaml:"container"`
+		Image *struct {
+			Dockerfile *struct {
+				URI string `yaml:"uri"`
+			} `yaml:"dockerfile"`
+		} `yaml:"image"`
+	} `yaml:"components"`
+}
+
+// parseDevfile is a parser function for devfiles (version 2), returning the images used by container components along
+// with the base images of the Dockerfiles built by image components (when held alongside the devfile).
+func parseDevfile(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var df devfile
+	if err := yaml.NewDecoder(reader).Decode(&df); err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("failed to parse devfile: %w", err)
+	}
+	if df.SchemaVersion == "" {
+		// devfiles prior to version 2 are not supported
+		return nil, nil, nil
+	}
+
+	var pkgs []pkg.Package
+	for _, c := range df.Components {
+		switch {
+		case c.Container != nil:
+			if p, ok := newPackage(c.Container.Image, pkg.ContainerImageReferenceEntry{
+				ManifestType: devfileManifest,
+				Workload:     c.Name,
+			}, reader.Location); ok {
+				pkgs = append(pkgs, p)
+			}
+		case c.Image != nil && c.Image.Dockerfile != nil:
+			pkgs = append(pkgs, dockerfileImages(resolver, reader.Location, c.Image.Dockerfile.URI, c.Name)...)
+		}
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,131 @@
+package containerimage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseKubernetesManifest
+
+// containerListKeys are the fields of a pod spec that hold containers.
+var containerListKeys = []string{"initContainers", "containers", "ephemeralContainers"}
+
+// parseKubernetesManifest is a parser function for Kubernetes manifests (including multi-document files such as
+// rendered Helm templates), returning the images used by the containers of any resource. Containers are found wherever
+// they are nested within a resource (e.g. the pod template of a Deployment or the job template of a CronJob). Files
+// that are not Kubernetes manifests are ignored.
+func parseKubernetesManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	decoder := yaml.NewDecoder(reader)
+	for {
+		var doc map[string]any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// not all YAML files are Kubernetes manifests, and not all YAML files are valid YAML (e.g. unrendered
+			// Helm templates), so this is not considered an error
+			log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse file as a Kubernetes manifest")
+			break
+		}
+
+		pkgs = append(pkgs, resourcePackages(doc, reader.Location)...)
+	}
+
+	return pkgs, nil, nil
+}
+
+func resourcePackages(resource map[string]any, location file.Location) []pkg.Package {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	if apiVersion == "" || kind == "" {
+		return nil
+	}
+
+	if kind == "List" {
+		// e.g. the output of "kubectl get -o yaml"
+		items, _ := resource["items"].([]any)
+		var pkgs []pkg.Package
+		for _, item := range items {
+			if m, ok := item.(map[string]any); ok {
+				pkgs = append(pkgs, resourcePackages(m, location)...)
+			}
+		}
+		return pkgs
+	}
+
+	entry := pkg.ContainerImageReferenceEntry{
+		ManifestType: kubernetesManifest,
+		Workload:     kind,
+	}
+	if metadata, ok := resource["metadata"].(map[string]any); ok {
+		if name, ok := metadata["name"].(string); ok && name != "" {
+			entry.Workload = kind + "/" + name
+		}
+		entry.Namespace, _ = metadata["namespace"].(string)
+	}
+
+	var pkgs []pkg.Package
+	for _, c := range findContainers(resource["spec"]) {
+		entry.Container, _ = c["name"].(string)
+		image, _ := c["image"].(string)
+		if p, ok := newPackage(image, entry, location); ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+	return pkgs
+}
+
+// findContainers returns all containers found within the given resource spec, at any depth.
+func findContainers(node any) []map[string]any {
+	var containers []map[string]any
+	switch v := node.(type) {
+	case map[string]any:
+		for _, key := range containerListKeys {
+			list, ok := v[key].([]any)
+			if !ok {
+				continue
+			}
+			for _, item := range list {
+				if c, ok := item.(map[string]any); ok {
+					containers = append(containers, c)
+				}
+			}
+		}
+		var keys []string
+		for key := range v {
+			if !isContainerListKey(key) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			containers = append(containers, findContainers(v[key])...)
+		}
+	case []any:
+		for _, value := range v {
+			containers = append(containers, findContainers(value)...)
+		}
+	}
+	return containers
+}
+
+func isContainerListKey(key string) bool {
+	for _, k := range containerListKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
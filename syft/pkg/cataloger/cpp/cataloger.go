@@ -8,10 +8,11 @@ import (
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
 
-// NewConanCataloger returns a new C/C++ conanfile.txt and conan.lock cataloger object.
+// NewConanCataloger returns a new C/C++ conanfile.txt, conanfile.py, and conan.lock cataloger object.
 func NewConanCataloger() pkg.Cataloger {
 	return generic.NewCataloger("conan-cataloger").
 		WithParserByGlobs(parseConanfile, "**/conanfile.txt").
+		WithParserByGlobs(parseConanfilePy, "**/conanfile.py").
 		WithParserByGlobs(parseConanLock, "**/conan.lock")
 }
 
@@ -17,6 +17,7 @@ func TestCataloger_Globs(t *testing.T) {
 			fixture: "test-fixtures/glob-paths",
 			expected: []string{
 				"somewhere/src/conanfile.txt",
+				"somewhere/src/conanfile.py",
 				"somewhere/src/conan.lock",
 			},
 		},
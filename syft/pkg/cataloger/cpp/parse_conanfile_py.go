@@ -0,0 +1,125 @@
+package cpp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseConanfilePy
+
+var (
+	// matches the "requires" class attribute, which may be a single string, a tuple, or a list (possibly spanning lines):
+	//   requires = "zlib/1.2.13", "fmt/10.1.1"
+	//   requires = ["zlib/1.2.13", "fmt/10.1.1"]
+	conanfilePyRequiresAttrPattern = regexp.MustCompile(`(?m)^[ \t]*requires[ \t]*=[ \t]*(\[[^\]]*\]|\([^)]*\)|[^\n]*)`)
+
+	// matches calls to self.requires() within the requirements() method:
+	//   self.requires("zlib/1.2.13", transitive_headers=True)
+	conanfilePyRequiresCallPattern = regexp.MustCompile(`\bself\.requires\(\s*["']([^"']+)["']`)
+
+	conanfilePyStringPattern = regexp.MustCompile(`["']([^"']+)["']`)
+)
+
+// parseConanfilePy is a parser function for conanfile.py contents, returning all packages discovered from the
+// "requires" attribute and any self.requires() calls (tool, build, and test requirements are not included).
+func parseConanfilePy(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read conanfile.py file: %w", err)
+	}
+
+	text := stripPythonComments(string(contents))
+
+	var refs []string
+	for _, match := range conanfilePyRequiresAttrPattern.FindAllStringSubmatch(text, -1) {
+		for _, s := range conanfilePyStringPattern.FindAllStringSubmatch(match[1], -1) {
+			refs = append(refs, s[1])
+		}
+	}
+	for _, match := range conanfilePyRequiresCallPattern.FindAllStringSubmatch(text, -1) {
+		refs = append(refs, match[1])
+	}
+
+	seen := strset.New()
+	var pkgs []pkg.Package
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		// references built with string interpolation cannot be resolved statically
+		if ref == "" || strings.Contains(ref, "{") || seen.Has(ref) {
+			continue
+		}
+		seen.Add(ref)
+
+		p := newConanfilePackage(
+			pkg.ConanfileEntry{Ref: ref},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		)
+		if p == nil {
+			continue
+		}
+
+		pkgs = append(pkgs, *p)
+	}
+
+	return pkgs, nil, nil
+}
+
+// stripPythonComments removes all comments and the contents of triple-quoted strings (e.g. docstrings) from the given
+// python source, so that only code is matched (comment markers within other string literals are preserved).
+func stripPythonComments(contents string) string {
+	var b strings.Builder
+	// quote is the delimiter of the string literal being read (if any), which is three characters for triple-quoted strings
+	var quote string
+	for i := 0; i < len(contents); i++ {
+		c := contents[i]
+		triple := len(quote) == 3
+		switch {
+		case quote == "" && c == '#':
+			// skip the comment up to the end of the line
+			for i+1 < len(contents) && contents[i+1] != '\n' {
+				i++
+			}
+			continue
+		case quote == "" && (c == '"' || c == '\''):
+			quote = string(c)
+			if strings.HasPrefix(contents[i:], strings.Repeat(quote, 3)) {
+				quote = strings.Repeat(quote, 3)
+				i += 2
+				continue
+			}
+		case quote != "" && c == '\\':
+			// escaped characters never end the string
+			if !triple && i+1 < len(contents) {
+				b.WriteString(contents[i : i+2])
+			}
+			i++
+			continue
+		case quote != "" && strings.HasPrefix(contents[i:], quote):
+			i += len(quote) - 1
+			quote = ""
+			if triple {
+				continue
+			}
+		case triple:
+			// the contents of triple-quoted strings are dropped, keeping only line breaks
+			if c != '\n' {
+				continue
+			}
+		case quote != "" && c == '\n':
+			// single-quoted strings cannot span lines
+			quote = ""
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
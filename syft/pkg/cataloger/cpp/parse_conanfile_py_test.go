@@ -0,0 +1,105 @@
+package cpp
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseConanfilePy(t *testing.T) {
+	fixture := "test-fixtures/conanfile-py/conanfile.py"
+	fixtureLocationSet := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "zlib",
+			Version:   "1.3.1",
+			PURL:      "pkg:conan/zlib@1.3.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "zlib/1.3.1",
+			},
+		},
+		{
+			Name:      "openssl",
+			Version:   "3.2.1",
+			PURL:      "pkg:conan/openssl@3.2.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "openssl/3.2.1#1c9a9b8a2e1d6c4b2c8c0bcbd8f1a7e1",
+			},
+		},
+		{
+			Name:      "fmt",
+			Version:   "10.2.1",
+			PURL:      "pkg:conan/fmt@10.2.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "fmt/10.2.1",
+			},
+		},
+		{
+			Name:      "spdlog",
+			Version:   "1.13.0",
+			PURL:      "pkg:conan/my_user/spdlog@1.13.0?channel=my_channel",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "spdlog/1.13.0@my_user/my_channel",
+			},
+		},
+		{
+			Name:      "pthreads4w",
+			Version:   "3.0.0",
+			PURL:      "pkg:conan/pthreads4w@3.0.0",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "pthreads4w/3.0.0",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseConanfilePy, expected, nil)
+}
+
+func TestParseConanfilePy_docstrings(t *testing.T) {
+	// requirements mentioned within docstrings, comments, or other strings are not cataloged
+	fixture := "test-fixtures/conanfile-py-docstrings/conanfile.py"
+	fixtureLocationSet := file.NewLocationSet(file.NewLocation(fixture))
+	expected := []pkg.Package{
+		{
+			Name:      "zlib",
+			Version:   "1.3.1",
+			PURL:      "pkg:conan/zlib@1.3.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "zlib/1.3.1",
+			},
+		},
+		{
+			Name:      "fmt",
+			Version:   "10.2.1",
+			PURL:      "pkg:conan/fmt@10.2.1",
+			Locations: fixtureLocationSet,
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanfileEntry{
+				Ref: "fmt/10.2.1",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseConanfilePy, expected, nil)
+}
@@ -29,10 +29,11 @@ type conanLock struct {
 	Version      string `json:"version"`
 	ProfileHost  string `json:"profile_host"`
 	ProfileBuild string `json:"profile_build,omitempty"`
-	// conan v0.5+ lockfiles use "requires", "build_requires" and "python_requires"
+	// conan v0.5+ lockfiles use "requires", "build_requires", "python_requires" and "config_requires"
 	Requires       []string `json:"requires,omitempty"`
 	BuildRequires  []string `json:"build_requires,omitempty"`
 	PythonRequires []string `json:"python_requires,omitempty"`
+	ConfigRequires []string `json:"config_requires,omitempty"`
 }
 
 // parseConanLock is a parser function for conan.lock (v1 and V2) contents, returning all packages discovered.
@@ -103,14 +104,34 @@ func handleConanLockV1(cl conanLock, reader file.LocationReadCloser, parsedPkgRe
 	return pkgs
 }
 
-// handleConanLockV2 handles the parsing of conan lock v2 files (aka v0.5)
+// handleConanLockV2 handles the parsing of conan lock v2 files (aka v0.5), which are produced by conan 2.x
 func handleConanLockV2(cl conanLock, reader file.LocationReadCloser, indexToPkgMap map[string]pkg.Package) []pkg.Package {
+	// the kind of each requirement is kept, so that build tools and configuration are not mistaken for runtime dependencies
+	kinds := []struct {
+		kind string
+		refs []string
+	}{
+		{kind: "requires", refs: cl.Requires},
+		{kind: "build_requires", refs: cl.BuildRequires},
+		{kind: "python_requires", refs: cl.PythonRequires},
+		{kind: "config_requires", refs: cl.ConfigRequires},
+	}
+
+	var pkgs []pkg.Package
+	for _, k := range kinds {
+		pkgs = append(pkgs, conanLockV2Packages(k.kind, k.refs, reader, indexToPkgMap)...)
+	}
+	return pkgs
+}
+
+func conanLockV2Packages(kind string, refs []string, reader file.LocationReadCloser, indexToPkgMap map[string]pkg.Package) []pkg.Package {
 	var pkgs []pkg.Package
-	for _, ref := range cl.Requires {
+	for _, ref := range refs {
 		reference, name := parseConanV2Reference(ref)
 		if name == "" {
 			continue
 		}
+		reference.RequirementKind = kind
 
 		p := newConanReferencePackage(
 			reference,
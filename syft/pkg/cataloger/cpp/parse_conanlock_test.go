@@ -344,9 +344,10 @@ func TestParseConanLockV2(t *testing.T) {
 			Language:  pkg.CPP,
 			Type:      pkg.ConanPkg,
 			Metadata: pkg.ConanV2LockEntry{
-				Ref:            "matrix/1.1#905c3f0babc520684c84127378fefdd0%1675278901.7527816",
-				RecipeRevision: "905c3f0babc520684c84127378fefdd0",
-				TimeStamp:      "1675278901.7527816",
+				Ref:             "matrix/1.1#905c3f0babc520684c84127378fefdd0%1675278901.7527816",
+				RecipeRevision:  "905c3f0babc520684c84127378fefdd0",
+				TimeStamp:       "1675278901.7527816",
+				RequirementKind: "requires",
 			},
 		},
 		{
@@ -357,9 +358,10 @@ func TestParseConanLockV2(t *testing.T) {
 			Language:  pkg.CPP,
 			Type:      pkg.ConanPkg,
 			Metadata: pkg.ConanV2LockEntry{
-				Ref:            "sound32/1.0#83d4b7bf607b3b60a6546f8b58b5cdd7%1675278904.0791488",
-				RecipeRevision: "83d4b7bf607b3b60a6546f8b58b5cdd7",
-				TimeStamp:      "1675278904.0791488",
+				Ref:             "sound32/1.0#83d4b7bf607b3b60a6546f8b58b5cdd7%1675278904.0791488",
+				RecipeRevision:  "83d4b7bf607b3b60a6546f8b58b5cdd7",
+				TimeStamp:       "1675278904.0791488",
+				RequirementKind: "requires",
 			},
 		},
 	}
@@ -373,3 +375,71 @@ func TestParseConanLockV2(t *testing.T) {
 
 	pkgtest.TestFileParser(t, fixture, parseConanLock, expected, expectedRelationships)
 }
+
+func TestParseConanLockV2WithPackageIDs(t *testing.T) {
+	fixture := "test-fixtures/conanlock-v2-full/conan.lock"
+	expected := []pkg.Package{
+		{
+			Name:      "zlib",
+			Version:   "1.3.1",
+			PURL:      "pkg:conan/zlib@1.3.1",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanV2LockEntry{
+				Ref:             "zlib/1.3.1#f52e03ae3d251dec704634230cd806a2:9a4eb3ae9b93c5d4a0de1b9a0f3b5f3b7b2f3c2a#8e8b4c53fbc9e6d1a1f1a8b2bd9e3f61%1708593606.497",
+				PackageID:       "9a4eb3ae9b93c5d4a0de1b9a0f3b5f3b7b2f3c2a",
+				RecipeRevision:  "f52e03ae3d251dec704634230cd806a2",
+				PackageRevision: "8e8b4c53fbc9e6d1a1f1a8b2bd9e3f61",
+				TimeStamp:       "1708593606.497",
+				RequirementKind: "requires",
+			},
+		},
+		{
+			Name:      "openssl",
+			Version:   "3.2.1",
+			PURL:      "pkg:conan/my_user/openssl@3.2.1?channel=my_channel",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanV2LockEntry{
+				Ref:             "openssl/3.2.1@my_user/my_channel#2dd1f8d6f8f5c0c31c0f8e2d5a0c0d0e%1706618453.127",
+				Username:        "my_user",
+				Channel:         "my_channel",
+				RecipeRevision:  "2dd1f8d6f8f5c0c31c0f8e2d5a0c0d0e",
+				TimeStamp:       "1706618453.127",
+				RequirementKind: "requires",
+			},
+		},
+		{
+			Name:      "cmake",
+			Version:   "3.28.1",
+			PURL:      "pkg:conan/cmake@3.28.1",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanV2LockEntry{
+				Ref:             "cmake/3.28.1#b7d9b4a3e1d6e3c2f2a1f5b8e6a5f0e9%1704962463.51",
+				RecipeRevision:  "b7d9b4a3e1d6e3c2f2a1f5b8e6a5f0e9",
+				TimeStamp:       "1704962463.51",
+				RequirementKind: "build_requires",
+			},
+		},
+		{
+			Name:      "pyreq",
+			Version:   "1.0",
+			PURL:      "pkg:conan/pyreq@1.0",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.CPP,
+			Type:      pkg.ConanPkg,
+			Metadata: pkg.ConanV2LockEntry{
+				Ref:             "pyreq/1.0#0a1b2c3d4e5f60718293a4b5c6d7e8f9%1700000000.0",
+				RecipeRevision:  "0a1b2c3d4e5f60718293a4b5c6d7e8f9",
+				TimeStamp:       "1700000000.0",
+				RequirementKind: "python_requires",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseConanLock, expected, nil)
+}
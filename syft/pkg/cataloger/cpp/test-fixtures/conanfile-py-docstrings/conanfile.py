@@ -0,0 +1,21 @@
+from conan import ConanFile
+
+
+class DocumentedRecipe(ConanFile):
+    """
+    An example recipe, which may be configured with:
+
+        requires = "ignored/1.0.0"
+
+    or with self.requires("ignored-call/1.0.0") within requirements().
+    """
+
+    name = "documented"
+    description = 'a recipe with a "#" and an escaped \' quote in its description'
+    requires = "zlib/1.3.1"
+
+    def requirements(self):
+        '''
+        requires = ["ignored-single-quoted/2.0.0"]
+        '''
+        self.requires("fmt/10.2.1")  # a "quoted" comment
@@ -0,0 +1,32 @@
+from conan import ConanFile
+from conan.tools.cmake import CMakeToolchain, cmake_layout
+
+
+class ExampleRecipe(ConanFile):
+    name = "example"
+    version = "0.1.0"
+    settings = "os", "compiler", "build_type", "arch"
+    generators = "CMakeDeps"
+
+    # requires = "ignored/1.0.0"
+    requires = [
+        "zlib/1.3.1",  # compression
+        "openssl/3.2.1#1c9a9b8a2e1d6c4b2c8c0bcbd8f1a7e1",
+    ]
+    tool_requires = "cmake/3.28.1"
+    test_requires = "gtest/1.14.0"
+
+    def requirements(self):
+        self.requires("fmt/10.2.1", transitive_headers=True)
+        self.requires('spdlog/1.13.0@my_user/my_channel')
+        self.requires(f"boost/{self.version}")
+        self.requires("zlib/1.3.1")
+        if self.settings.os == "Windows":
+            self.requires("pthreads4w/3.0.0")
+
+    def build_requirements(self):
+        self.tool_requires("ninja/1.11.1")
+        self.test_requires("catch2/3.5.2")
+
+    def layout(self):
+        cmake_layout(self)
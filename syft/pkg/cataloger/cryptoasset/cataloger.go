@@ -0,0 +1,31 @@
+/*
+Package cryptoasset provides concrete Cataloger implementations for cryptographic assets: certificates, keys, and
+keystores (cryptographic material), as well as shared libraries implementing cryptographic functionality.
+*/
+package cryptoasset
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewMaterialCataloger returns a new cataloger object for certificates, private and public keys, and keystores.
+func NewMaterialCataloger() pkg.Cataloger {
+	return generic.NewCataloger("crypto-material-cataloger").
+		WithParserByGlobs(parseCertificatesAndKeys,
+			"**/*.pem", "**/*.crt", "**/*.cer", "**/*.cert", "**/*.der", "**/*.key", "**/*.pub",
+			"**/id_rsa", "**/id_dsa", "**/id_ecdsa", "**/id_ed25519",
+		).
+		WithParserByGlobs(parseKeystore, "**/*.jks", "**/*.jceks", "**/*.keystore", "**/*.p12", "**/*.pfx", "**/cacerts")
+}
+
+// NewLibraryCataloger returns a new cataloger object for shared libraries implementing cryptographic functionality.
+func NewLibraryCataloger() pkg.Cataloger {
+	var globs []string
+	for _, l := range cryptoLibraries {
+		globs = append(globs, "**/"+l.prefix+".so*")
+	}
+
+	return generic.NewCataloger("crypto-library-cataloger").
+		WithParserByGlobs(parseLibrary, globs...)
+}
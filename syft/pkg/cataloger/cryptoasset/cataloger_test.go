@@ -0,0 +1,69 @@
+package cryptoasset
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain certificate, key, and keystore files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewMaterialCataloger(),
+			expected: []string{
+				"etc/ssl/certs/ca.pem",
+				"etc/ssl/certs/server.crt",
+				"etc/ssl/certs/client.cer",
+				"etc/ssl/certs/other.cert",
+				"etc/ssl/certs/root.der",
+				"etc/ssl/private/server.key",
+				"home/user/.ssh/id_rsa",
+				"home/user/.ssh/id_dsa",
+				"home/user/.ssh/id_ecdsa",
+				"home/user/.ssh/id_ed25519",
+				"home/user/.ssh/id_rsa.pub",
+				"keystores/app.jks",
+				"keystores/app.jceks",
+				"keystores/app.keystore",
+				"keystores/app.p12",
+				"keystores/app.pfx",
+				"keystores/cacerts",
+			},
+		},
+		{
+			name:      "obtain crypto library files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewLibraryCataloger(),
+			expected: []string{
+				"usr/lib/libcrypto.so.3",
+				"usr/lib/libssl.so.3",
+				"usr/lib/libgcrypt.so.20",
+				"usr/lib/libsodium.so.23",
+				"usr/lib/libmbedcrypto.so.7",
+				"usr/lib/libmbedtls.so.14",
+				"usr/lib/libwolfssl.so.35",
+				"usr/lib/libgnutls.so.30",
+				"usr/lib/libnettle.so.8",
+				"usr/lib/libhogweed.so.6",
+				"usr/lib/libnss3.so",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
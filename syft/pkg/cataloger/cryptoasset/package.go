@@ -0,0 +1,51 @@
+package cryptoasset
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newCryptoAssetPackage(name string, metadata any, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.CryptoAssetPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newCertificatePackage(cert pkg.CryptoCertificate, commonName string, location file.Location) pkg.Package {
+	name := commonName
+	if name == "" {
+		name = cert.SubjectName
+	}
+	if name == "" {
+		name = path.Base(location.RealPath)
+	}
+	return newCryptoAssetPackage(name, cert, location)
+}
+
+func newKeyPackage(key pkg.CryptoKey, location file.Location) pkg.Package {
+	return newCryptoAssetPackage(keyName(key), key, location)
+}
+
+// keyName returns the name of a key based on the algorithm and its parameters, for example "RSA-2048", "ECDSA-P-256",
+// or "Ed25519" (falling back to the key type when the algorithm is not known).
+func keyName(key pkg.CryptoKey) string {
+	switch {
+	case key.Algorithm == "":
+		return key.KeyType
+	case key.Curve != "":
+		return key.Algorithm + "-" + key.Curve
+	case key.Size > 0 && key.Algorithm != "Ed25519":
+		return fmt.Sprintf("%s-%d", key.Algorithm, key.Size)
+	}
+	return key.Algorithm
+}
@@ -0,0 +1,95 @@
+package cryptoasset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/keymaterial"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCertificatesAndKeys
+
+// parseCertificatesAndKeys is a parser function for PEM files (which may hold any number of certificates and keys),
+// DER encoded certificates, and OpenSSH public keys, returning a package for each certificate and key found. Note
+// that only information about the keys is captured, never the key material itself.
+func parseCertificatesAndKeys(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	material, ok := keymaterial.Parse(contents)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var pkgs []pkg.Package
+	for _, c := range material.Certificates {
+		pkgs = append(pkgs, newCertificatePackage(toCertificateMetadata(c, material.Format), commonName(c.Subject), reader.Location))
+	}
+	for _, k := range material.Keys {
+		pkgs = append(pkgs, newKeyPackage(toKeyMetadata(k), reader.Location))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+func toCertificateMetadata(c file.Certificate, encoding string) pkg.CryptoCertificate {
+	return pkg.CryptoCertificate{
+		SubjectName:        c.Subject,
+		IssuerName:         c.Issuer,
+		SerialNumber:       c.SerialNumber,
+		NotValidBefore:     c.NotBefore,
+		NotValidAfter:      c.NotAfter,
+		SignatureAlgorithm: c.SignatureAlgorithm,
+		PublicKeyAlgorithm: c.PublicKeyAlgorithm,
+		PublicKeySize:      c.PublicKeySize,
+		Curve:              c.Curve,
+		IsCA:               c.IsCA,
+		Encoding:           encoding,
+		SHA256Fingerprint:  c.SHA256Fingerprint,
+	}
+}
+
+func toKeyMetadata(k file.Key) pkg.CryptoKey {
+	return pkg.CryptoKey{
+		KeyType:              k.Type,
+		Algorithm:            k.Algorithm,
+		Size:                 k.Size,
+		Curve:                k.Curve,
+		Format:               k.Format,
+		Encrypted:            k.Encrypted,
+		PublicKeyFingerprint: k.PublicKeyFingerprint,
+	}
+}
+
+// commonName returns the common name (CN) from an RFC 2253 distinguished name (e.g. "CN=example.com,O=Example").
+func commonName(subject string) string {
+	var attributes []string
+	start := 0
+	for i := 0; i < len(subject); i++ {
+		switch subject[i] {
+		case '\\':
+			i++ // skip the escaped character
+		case ',', '+':
+			attributes = append(attributes, subject[start:i])
+			start = i + 1
+		}
+	}
+	attributes = append(attributes, subject[start:])
+
+	for _, attribute := range attributes {
+		if value, found := strings.CutPrefix(attribute, "CN="); found {
+			return strings.ReplaceAll(value, `\`, "")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,269 @@
+package cryptoasset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCertificatesAndKeys(t *testing.T) {
+	rootCA := pkg.CryptoCertificate{
+		SubjectName:        "CN=Syft Test Root CA,O=Anchore",
+		IssuerName:         "CN=Syft Test Root CA,O=Anchore",
+		SerialNumber:       "1001",
+		NotValidBefore:     "2024-01-01T00:00:00Z",
+		NotValidAfter:      "2034-01-01T00:00:00Z",
+		SignatureAlgorithm: "ECDSA-SHA256",
+		PublicKeyAlgorithm: "ECDSA",
+		PublicKeySize:      256,
+		Curve:              "P-256",
+		IsCA:               true,
+		Encoding:           "PEM",
+		SHA256Fingerprint:  "f44a4e4002c4fc0ca08da8151d9b523be1621f01fa52f3b4f790ca1c46df536d",
+	}
+
+	rootCADER := rootCA
+	rootCADER.Encoding = "DER"
+
+	rsaFingerprint := "99159f17b3dafe7bd4b3db4b75eb54f36f17288c101cf11f4bb04c99c49bdb11"
+	sshFingerprint := "682498d9937b3aeecefa90c17dcde98e2f6784837e63efd6b8c27ee354ef846f"
+
+	tests := []struct {
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/certs/chain.pem",
+			expected: []pkg.Package{
+				{
+					Name:     "Syft Test Root CA",
+					Type:     pkg.CryptoAssetPkg,
+					Metadata: rootCA,
+				},
+				{
+					Name: "example.com",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoCertificate{
+						SubjectName:        "CN=example.com",
+						IssuerName:         "CN=Syft Test Root CA,O=Anchore",
+						SerialNumber:       "1002",
+						NotValidBefore:     "2024-01-01T00:00:00Z",
+						NotValidAfter:      "2025-01-01T00:00:00Z",
+						SignatureAlgorithm: "ECDSA-SHA256",
+						PublicKeyAlgorithm: "RSA",
+						PublicKeySize:      2048,
+						Encoding:           "PEM",
+						SHA256Fingerprint:  "65a6875e856dfb5e057f9c5816b27e719d08309c7b95308cc4033fc5748c6013",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/certs/root-ca.der",
+			expected: []pkg.Package{
+				{
+					Name:     "Syft Test Root CA",
+					Type:     pkg.CryptoAssetPkg,
+					Metadata: rootCADER,
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keys/rsa.key",
+			expected: []pkg.Package{
+				{
+					Name: "RSA-2048",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "private-key",
+						Algorithm:            "RSA",
+						Size:                 2048,
+						Format:               "PKCS#1",
+						PublicKeyFingerprint: rsaFingerprint,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keys/ec.key",
+			expected: []pkg.Package{
+				{
+					Name: "ECDSA-P-256",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "private-key",
+						Algorithm:            "ECDSA",
+						Size:                 256,
+						Curve:                "P-256",
+						Format:               "SEC1",
+						PublicKeyFingerprint: "6228145d2e82e6226a2102597a5839d6e87d0363835f5a4aa473814ada567f20",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keys/ed25519.pem",
+			expected: []pkg.Package{
+				{
+					Name: "Ed25519",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "private-key",
+						Algorithm:            "Ed25519",
+						Size:                 256,
+						Format:               "PKCS#8",
+						PublicKeyFingerprint: "7fdec71deea87d2cedaf415ff6536bb1970f8cad871593754a28332298b23f6d",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keys/public.pem",
+			expected: []pkg.Package{
+				{
+					Name: "RSA-2048",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "public-key",
+						Algorithm:            "RSA",
+						Size:                 2048,
+						Format:               "PKIX",
+						PublicKeyFingerprint: rsaFingerprint,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keys/encrypted.pem",
+			expected: []pkg.Package{
+				{
+					Name: "private-key",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:   "private-key",
+						Format:    "PKCS#8",
+						Encrypted: true,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/ssh/id_ed25519",
+			expected: []pkg.Package{
+				{
+					Name: "Ed25519",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "private-key",
+						Algorithm:            "Ed25519",
+						Size:                 256,
+						Format:               "OpenSSH",
+						PublicKeyFingerprint: sshFingerprint,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/ssh/encrypted/id_ed25519",
+			expected: []pkg.Package{
+				{
+					Name: "Ed25519",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "private-key",
+						Algorithm:            "Ed25519",
+						Size:                 256,
+						Format:               "OpenSSH",
+						Encrypted:            true,
+						PublicKeyFingerprint: sshFingerprint,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/ssh/id_ed25519.pub",
+			expected: []pkg.Package{
+				{
+					Name: "Ed25519",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKey{
+						KeyType:              "public-key",
+						Algorithm:            "Ed25519",
+						Size:                 256,
+						Format:               "OpenSSH",
+						PublicKeyFingerprint: sshFingerprint,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			locations := file.NewLocationSet(file.NewLocation(test.fixture))
+			for i := range test.expected {
+				test.expected[i].Locations = locations
+			}
+
+			pkgtest.TestFileParser(t, test.fixture, parseCertificatesAndKeys, test.expected, nil)
+		})
+	}
+}
+
+func Test_keyName(t *testing.T) {
+	tests := []struct {
+		key  pkg.CryptoKey
+		want string
+	}{
+		{
+			key:  pkg.CryptoKey{KeyType: "private-key", Algorithm: "RSA", Size: 4096},
+			want: "RSA-4096",
+		},
+		{
+			key:  pkg.CryptoKey{KeyType: "public-key", Algorithm: "ECDSA", Size: 384, Curve: "P-384"},
+			want: "ECDSA-P-384",
+		},
+		{
+			key:  pkg.CryptoKey{KeyType: "private-key", Algorithm: "Ed25519", Size: 256},
+			want: "Ed25519",
+		},
+		{
+			key:  pkg.CryptoKey{KeyType: "private-key", Algorithm: "DSA"},
+			want: "DSA",
+		},
+		{
+			key:  pkg.CryptoKey{KeyType: "private-key"},
+			want: "private-key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := keyName(tt.key); got != tt.want {
+				t.Errorf("keyName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_commonName(t *testing.T) {
+	tests := []struct {
+		subject  string
+		expected string
+	}{
+		{subject: "CN=example.com", expected: "example.com"},
+		{subject: "CN=Syft Test Root CA,O=Anchore", expected: "Syft Test Root CA"},
+		{subject: "O=Anchore,CN=example.com", expected: "example.com"},
+		{subject: `CN=Example\, Inc.,O=Anchore`, expected: "Example, Inc."},
+		{subject: "O=Anchore", expected: ""},
+		{subject: "", expected: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.subject, func(t *testing.T) {
+			assert.Equal(t, test.expected, commonName(test.subject))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package cryptoasset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/keymaterial"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseKeystore
+
+// parseKeystore is a parser function for Java (JKS and JCEKS) and PKCS#12 keystores, returning a single package
+// describing the keystore (the entries themselves are typically protected by a password).
+func parseKeystore(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	material, ok := keymaterial.ParseKeystore(contents)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	metadata := pkg.CryptoKeystore{
+		Format:  material.Format,
+		Version: material.Version,
+		Entries: material.Entries,
+	}
+
+	return []pkg.Package{
+		newCryptoAssetPackage(path.Base(reader.RealPath), metadata, reader.Location),
+	}, nil, nil
+}
@@ -0,0 +1,58 @@
+package cryptoasset
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseKeystore(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/keystores/truststore.jks",
+			expected: []pkg.Package{
+				{
+					Name: "truststore.jks",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKeystore{
+						Format:  "JKS",
+						Version: 2,
+						Entries: 3,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keystores/client.p12",
+			expected: []pkg.Package{
+				{
+					Name: "client.p12",
+					Type: pkg.CryptoAssetPkg,
+					Metadata: pkg.CryptoKeystore{
+						Format:  "PKCS#12",
+						Version: 3,
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/keystores/not-a-keystore.keystore",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			locations := file.NewLocationSet(file.NewLocation(test.fixture))
+			for i := range test.expected {
+				test.expected[i].Locations = locations
+			}
+
+			pkgtest.TestFileParser(t, test.fixture, parseKeystore, test.expected, nil)
+		})
+	}
+}
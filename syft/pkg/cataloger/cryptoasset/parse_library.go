@@ -0,0 +1,58 @@
+package cryptoasset
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseLibrary
+
+type cryptoLibrary struct {
+	prefix         string
+	implementation string
+}
+
+// cryptoLibraries are the shared libraries (by file name prefix) known to implement cryptographic functionality
+var cryptoLibraries = []cryptoLibrary{
+	{prefix: "libcrypto", implementation: "OpenSSL"},
+	{prefix: "libssl", implementation: "OpenSSL"},
+	{prefix: "libgcrypt", implementation: "libgcrypt"},
+	{prefix: "libsodium", implementation: "libsodium"},
+	{prefix: "libmbedcrypto", implementation: "Mbed TLS"},
+	{prefix: "libmbedtls", implementation: "Mbed TLS"},
+	{prefix: "libwolfssl", implementation: "wolfSSL"},
+	{prefix: "libgnutls", implementation: "GnuTLS"},
+	{prefix: "libnettle", implementation: "Nettle"},
+	{prefix: "libhogweed", implementation: "Nettle"},
+	{prefix: "libnss3", implementation: "NSS"},
+}
+
+// parseLibrary is a parser function for shared libraries implementing cryptographic functionality, where the
+// library is identified by file name only (the version is left to the binary and OS catalogers).
+func parseLibrary(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	name := path.Base(reader.RealPath)
+
+	implementation := libraryImplementation(name)
+	if implementation == "" {
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newCryptoAssetPackage(name, pkg.CryptoLibrary{Implementation: implementation}, reader.Location),
+	}, nil, nil
+}
+
+func libraryImplementation(name string) string {
+	for _, l := range cryptoLibraries {
+		if strings.HasPrefix(name, l.prefix+".so") {
+			return l.implementation
+		}
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package cryptoasset
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestLibraryCataloger(t *testing.T) {
+	expected := []pkg.Package{
+		{
+			Name:      "libcrypto.so.3",
+			FoundBy:   "crypto-library-cataloger",
+			Type:      pkg.CryptoAssetPkg,
+			Locations: file.NewLocationSet(file.NewLocation("usr/lib/libcrypto.so.3")),
+			Metadata:  pkg.CryptoLibrary{Implementation: "OpenSSL"},
+		},
+		{
+			Name:      "libgnutls.so.30",
+			FoundBy:   "crypto-library-cataloger",
+			Type:      pkg.CryptoAssetPkg,
+			Locations: file.NewLocationSet(file.NewLocation("usr/lib/libgnutls.so.30")),
+			Metadata:  pkg.CryptoLibrary{Implementation: "GnuTLS"},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/libraries").
+		Expects(expected, nil).
+		TestCataloger(t, NewLibraryCataloger())
+}
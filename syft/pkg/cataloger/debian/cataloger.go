@@ -17,3 +17,12 @@ func NewDBCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseDpkgDB, "**/var/lib/dpkg/status", "**/var/lib/dpkg/status.d/*", "**/lib/opkg/info/*.control", "**/lib/opkg/status").
 		WithProcessors(dependency.Processor(dbEntryDependencySpecifier))
 }
+
+// NewSourcePackageCataloger returns a new cataloger for Debian source packaging (the debian/control file of a source
+// tree, along with debian/changelog, and .dsc source control files), describing the source package and its build
+// dependencies.
+func NewSourcePackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dpkg-source-package-cataloger").
+		WithParserByGlobs(parseDebianControl, "**/debian/control").
+		WithParserByGlobs(parseDsc, "**/*.dsc")
+}
@@ -249,3 +249,29 @@ func TestCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func TestSourcePackageCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain source control files",
+			fixture: "test-fixtures/source-glob-paths",
+			expected: []string{
+				"src/debian/control",
+				"dist/pkg_1.0-1.dsc",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewSourcePackageCataloger())
+		})
+	}
+}
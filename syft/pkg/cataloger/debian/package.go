@@ -281,3 +281,60 @@ func md5Key(metadata pkg.DpkgDBEntry) string {
 	}
 	return contentKey
 }
+
+func newDpkgSourcePackage(name, version string, entry pkg.DpkgSourceEntry, licenses []pkg.License, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(licenses...),
+		Locations: file.NewLocationSet(locations...),
+		PURL:      sourcePackageURL(name, version, "source"),
+		Type:      pkg.DebPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newDpkgBuildDependencyPackage(name string, entry pkg.DpkgBuildDependencyEntry, location file.Location) pkg.Package {
+	// only an exact version relation pins the version of the build dependency
+	var version string
+	if v, ok := strings.CutPrefix(entry.VersionConstraint, "= "); ok {
+		version = v
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		PURL:      sourcePackageURL(name, version, ""),
+		Type:      pkg.DebPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// sourcePackageURL returns the PURL for packages described by Debian source packaging, which is not specific to a
+// distro release (so the "debian" namespace is assumed).
+func sourcePackageURL(name, version, arch string) string {
+	var qualifiers packageurl.Qualifiers
+	if arch != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{
+			pkg.PURLQualifierArch: arch,
+		})
+	}
+
+	return packageurl.NewPackageURL(
+		packageurl.TypeDebian,
+		"debian",
+		name,
+		version,
+		qualifiers,
+		"",
+	).ToString()
+}
@@ -0,0 +1,331 @@
+package debian
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	pgpSignedMessageHeader = "-----BEGIN PGP SIGNED MESSAGE-----"
+	pgpSignatureHeader     = "-----BEGIN PGP SIGNATURE-----"
+)
+
+var (
+	_ generic.Parser = parseDebianControl
+	_ generic.Parser = parseDsc
+
+	// changelogHeaderPattern matches the first line of a debian/changelog entry
+	// (e.g. "curl (8.5.0-2) unstable; urgency=medium").
+	changelogHeaderPattern = regexp.MustCompile(`^(?P<name>[a-z0-9][a-z0-9+.-]+) \((?P<version>[^ ()]+)\) (?P<distribution>[^;]+);`)
+
+	// relationPattern matches a single package relation of a build dependency field
+	// (e.g. "libssl-dev:native (>= 3.0) [linux-any] <!nocheck>").
+	relationPattern = regexp.MustCompile(`^(?P<name>[a-z0-9][a-z0-9+.-]+)(:[a-z0-9-]+)?\s*(\(\s*(?P<operator>[<>=]+)\s*(?P<constraintVersion>[^)\s]+)\s*\))?\s*(\[(?P<architectures>[^\]]*)\])?\s*(?P<profiles>(<[^>]*>\s*)*)$`)
+
+	buildDependencyFields = []struct {
+		field     string
+		relations func(pkg.DpkgSourceEntry) []string
+	}{
+		{field: "Build-Depends", relations: func(e pkg.DpkgSourceEntry) []string { return e.BuildDepends }},
+		{field: "Build-Depends-Indep", relations: func(e pkg.DpkgSourceEntry) []string { return e.BuildDependsIndep }},
+		{field: "Build-Depends-Arch", relations: func(e pkg.DpkgSourceEntry) []string { return e.BuildDependsArch }},
+	}
+)
+
+// parseDebianControl is a parser function for the debian/control file of a source tree, returning the source package
+// (versioned from the latest debian/changelog entry) along with its build dependencies.
+func parseDebianControl(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	stanzas, err := parseControlStanzas(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse debian/control file=%q: %w", reader.RealPath, err)
+	}
+
+	if len(stanzas) == 0 || stanzas[0]["Source"] == "" {
+		return nil, nil, nil
+	}
+
+	source := stanzas[0]
+	entry := newDpkgSourceEntry(source)
+	for _, stanza := range stanzas[1:] {
+		if name := stanza["Package"]; name != "" {
+			entry.Binaries = append(entry.Binaries, name)
+		}
+	}
+
+	debianDir := path.Dir(reader.RealPath)
+	var version string
+	var licenses []pkg.License
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	if resolver != nil {
+		if contents, location := readRelativeFile(resolver, reader.Location, path.Join(debianDir, "changelog")); location != nil {
+			version, entry.Distribution = parseLatestChangelogEntry(contents)
+			locations = append(locations, *location)
+		}
+
+		if contents, location := readRelativeFile(resolver, reader.Location, path.Join(debianDir, "source", "format")); location != nil {
+			entry.Format = strings.TrimSpace(contents)
+			locations = append(locations, *location)
+		}
+
+		if contents, location := readRelativeFile(resolver, reader.Location, path.Join(debianDir, "copyright")); location != nil {
+			for _, license := range parseLicensesFromCopyright(strings.NewReader(contents)) {
+				licenses = append(licenses, pkg.NewLicenseFromLocations(license, location.WithoutAnnotations()))
+			}
+			locations = append(locations, *location)
+		}
+	}
+
+	pkgs, relationships := newSourcePackageWithBuildDependencies(source["Source"], version, entry, licenses, locations...)
+	return pkgs, relationships, nil
+}
+
+// parseDsc is a parser function for .dsc source control files, returning the source package along with its build
+// dependencies.
+func parseDsc(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	stanzas, err := parseControlStanzas(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse .dsc file=%q: %w", reader.RealPath, err)
+	}
+
+	// note: the .dsc extension is not exclusive to Debian source packages, so only consider files with the fields
+	// that every source control file must have
+	if len(stanzas) == 0 || stanzas[0]["Source"] == "" || stanzas[0]["Version"] == "" {
+		log.WithFields("path", reader.RealPath).Trace("ignoring .dsc file without a source package name and version")
+		return nil, nil, nil
+	}
+
+	source := stanzas[0]
+	entry := newDpkgSourceEntry(source)
+	entry.Format = source["Format"]
+	entry.Binaries = splitPkgList(source["Binary"])
+
+	pkgs, relationships := newSourcePackageWithBuildDependencies(
+		source["Source"],
+		source["Version"],
+		entry,
+		nil,
+		reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+	)
+	return pkgs, relationships, nil
+}
+
+// newDpkgSourceEntry returns the metadata for the fields shared by the source stanza of debian/control and .dsc files.
+func newDpkgSourceEntry(source map[string]string) pkg.DpkgSourceEntry {
+	return pkg.DpkgSourceEntry{
+		Maintainer:        source["Maintainer"],
+		Uploaders:         splitPkgList(source["Uploaders"]),
+		Homepage:          source["Homepage"],
+		StandardsVersion:  source["StandardsVersion"],
+		VcsBrowser:        source["VcsBrowser"],
+		VcsGit:            source["VcsGit"],
+		BuildDepends:      splitRelations(source["BuildDepends"]),
+		BuildDependsIndep: splitRelations(source["BuildDependsIndep"]),
+		BuildDependsArch:  splitRelations(source["BuildDependsArch"]),
+	}
+}
+
+// newSourcePackageWithBuildDependencies returns the source package along with a package for each of its build
+// dependencies, related to the source package.
+func newSourcePackageWithBuildDependencies(name, version string, entry pkg.DpkgSourceEntry, licenses []pkg.License, locations ...file.Location) ([]pkg.Package, []artifact.Relationship) {
+	sourcePkg := newDpkgSourcePackage(name, version, entry, licenses, locations...)
+	pkgs := []pkg.Package{sourcePkg}
+
+	var relationships []artifact.Relationship
+	seen := make(map[string]bool)
+	for _, f := range buildDependencyFields {
+		for _, relation := range f.relations(entry) {
+			depName, dep, ok := parseBuildDependency(f.field, relation)
+			if !ok {
+				log.WithFields("relation", relation, "package", name).Trace("unable to parse debian build dependency")
+				continue
+			}
+			if seen[depName] {
+				continue
+			}
+			seen[depName] = true
+
+			depPkg := newDpkgBuildDependencyPackage(depName, dep, locations[0])
+			pkgs = append(pkgs, depPkg)
+			relationships = append(relationships, artifact.Relationship{
+				From: depPkg,
+				To:   sourcePkg,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	return pkgs, relationships
+}
+
+// parseBuildDependency returns the name and metadata for a single build dependency, where the first of any
+// alternatives is considered to be the dependency.
+func parseBuildDependency(field, relation string) (string, pkg.DpkgBuildDependencyEntry, bool) {
+	alternatives := strings.Split(relation, "|")
+
+	match := internal.MatchNamedCaptureGroups(relationPattern, strings.TrimSpace(alternatives[0]))
+	name := match["name"]
+	if name == "" {
+		return "", pkg.DpkgBuildDependencyEntry{}, false
+	}
+
+	entry := pkg.DpkgBuildDependencyEntry{
+		Field: field,
+	}
+
+	if architectures := strings.Fields(match["architectures"]); len(architectures) > 0 {
+		entry.Architectures = architectures
+	}
+
+	if match["operator"] != "" {
+		entry.VersionConstraint = match["operator"] + " " + match["constraintVersion"]
+	}
+
+	for _, profile := range strings.Split(match["profiles"], ">") {
+		profile = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(profile), "<"))
+		if profile != "" {
+			entry.Profiles = append(entry.Profiles, profile)
+		}
+	}
+
+	for _, alternative := range alternatives[1:] {
+		if alternative = stripVersionSpecifier(alternative); alternative != "" {
+			entry.Alternatives = append(entry.Alternatives, alternative)
+		}
+	}
+
+	return name, entry, true
+}
+
+// parseLatestChangelogEntry returns the version and distribution of the latest (first) debian/changelog entry.
+func parseLatestChangelogEntry(contents string) (string, string) {
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		match := internal.MatchNamedCaptureGroups(changelogHeaderPattern, line)
+		return match["version"], strings.TrimSpace(match["distribution"])
+	}
+	return "", ""
+}
+
+// parseControlStanzas returns the fields of each stanza (paragraph) within a deb822 control file, ignoring comments
+// and the OpenPGP signature that may surround the contents (as is the case for .dsc files). Field names have dashes
+// removed (e.g. "Build-Depends" is keyed as "BuildDepends").
+func parseControlStanzas(reader io.Reader) ([]map[string]string, error) {
+	contents, err := stripControlFileSyntax(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	buffedReader := bufio.NewReader(strings.NewReader(contents))
+	var stanzas []map[string]string
+	for {
+		fields, err := extractAllFields(buffedReader)
+		if err != nil && !errors.Is(err, errEndOfPackages) {
+			return nil, err
+		}
+
+		if len(fields) > 0 {
+			stanza := make(map[string]string)
+			for key, val := range fields {
+				if s, ok := val.(string); ok {
+					stanza[key] = s
+				}
+			}
+			stanzas = append(stanzas, stanza)
+		}
+
+		if err != nil {
+			break
+		}
+	}
+	return stanzas, nil
+}
+
+// stripControlFileSyntax removes comments and any OpenPGP signature from a deb822 control file, normalizing the
+// indentation of continuation lines so the contents can be read like a dpkg status file.
+func stripControlFileSyntax(reader io.Reader) (string, error) {
+	var sb strings.Builder
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	inArmorHeaders := false
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		switch {
+		case line == pgpSignedMessageHeader:
+			// skip the armor headers (e.g. "Hash: SHA512"), which end with an empty line
+			inArmorHeaders = true
+			continue
+		case inArmorHeaders:
+			inArmorHeaders = line != ""
+			continue
+		case line == pgpSignatureHeader:
+			sb.WriteString("\n")
+			return sb.String(), scanner.Err()
+		case strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "\t"):
+			line = " " + strings.TrimLeft(line, "\t")
+		}
+
+		// lines within a signed message that start with a dash are escaped with "- "
+		line = strings.TrimPrefix(line, "- ")
+
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String(), scanner.Err()
+}
+
+// splitRelations splits a comma-separated list of package relations, normalizing any whitespace (including line
+// breaks from continuation lines) within each relation.
+func splitRelations(relations string) []string {
+	var ret []string
+	for _, relation := range splitPkgList(relations) {
+		ret = append(ret, strings.Join(strings.Fields(relation), " "))
+	}
+	return ret
+}
+
+// readRelativeFile returns the contents and location (as supporting evidence) of a file relative to the given
+// location, or a nil location if the file does not exist or cannot be read.
+func readRelativeFile(resolver file.Resolver, location file.Location, p string) (string, *file.Location) {
+	relative := resolver.RelativeFileByPath(location, p)
+	if relative == nil {
+		return "", nil
+	}
+
+	reader, err := resolver.FileContentsByLocation(*relative)
+	if err != nil {
+		log.WithFields("error", err, "path", p).Trace("unable to read debian source package file")
+		return "", nil
+	}
+	defer internal.CloseAndLogError(reader, relative.RealPath)
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		log.WithFields("error", err, "path", p).Trace("unable to read debian source package file")
+		return "", nil
+	}
+
+	l := relative.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	return string(contents), &l
+}
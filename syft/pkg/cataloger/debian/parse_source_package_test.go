@@ -0,0 +1,228 @@
+package debian
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseDebianControl(t *testing.T) {
+	controlLocation := file.NewLocation("debian/control")
+	locations := file.NewLocationSet(
+		controlLocation,
+		file.NewLocation("debian/changelog"),
+		file.NewLocation("debian/source/format"),
+		file.NewLocation("debian/copyright"),
+	)
+
+	hello := pkg.Package{
+		Name:      "hello",
+		Version:   "2.10-3",
+		PURL:      "pkg:deb/debian/hello@2.10-3?arch=source",
+		Locations: locations,
+		Licenses: pkg.NewLicenseSet(
+			pkg.NewLicenseFromLocations("GPL-3+", file.NewLocation("debian/copyright")),
+		),
+		Type: pkg.DebPkg,
+		Metadata: pkg.DpkgSourceEntry{
+			Format:           "3.0 (quilt)",
+			Maintainer:       "Santiago Vila <sanvila@debian.org>",
+			Uploaders:        []string{"Jane Doe <jane@example.org>", "John Doe <john@example.org>"},
+			Homepage:         "https://www.gnu.org/software/hello/",
+			StandardsVersion: "4.6.2",
+			VcsBrowser:       "https://salsa.debian.org/sanvila/hello",
+			VcsGit:           "https://salsa.debian.org/sanvila/hello.git",
+			Distribution:     "unstable",
+			Binaries:         []string{"hello", "hello-doc"},
+			BuildDepends: []string{
+				"debhelper-compat (= 13)",
+				"autoconf (>= 2.71) <!nocheck>",
+				"gcc-multilib [amd64 i386] | gcc",
+				"pkgconf:native",
+			},
+			BuildDependsIndep: []string{"texinfo"},
+		},
+	}
+
+	deps := []pkg.Package{
+		{
+			Name:      "debhelper-compat",
+			Version:   "13",
+			PURL:      "pkg:deb/debian/debhelper-compat@13",
+			Locations: file.NewLocationSet(controlLocation),
+			Type:      pkg.DebPkg,
+			Metadata: pkg.DpkgBuildDependencyEntry{
+				Field:             "Build-Depends",
+				VersionConstraint: "= 13",
+			},
+		},
+		{
+			Name:      "autoconf",
+			PURL:      "pkg:deb/debian/autoconf",
+			Locations: file.NewLocationSet(controlLocation),
+			Type:      pkg.DebPkg,
+			Metadata: pkg.DpkgBuildDependencyEntry{
+				Field:             "Build-Depends",
+				VersionConstraint: ">= 2.71",
+				Profiles:          []string{"!nocheck"},
+			},
+		},
+		{
+			Name:      "gcc-multilib",
+			PURL:      "pkg:deb/debian/gcc-multilib",
+			Locations: file.NewLocationSet(controlLocation),
+			Type:      pkg.DebPkg,
+			Metadata: pkg.DpkgBuildDependencyEntry{
+				Field:         "Build-Depends",
+				Architectures: []string{"amd64", "i386"},
+				Alternatives:  []string{"gcc"},
+			},
+		},
+		{
+			Name:      "pkgconf",
+			PURL:      "pkg:deb/debian/pkgconf",
+			Locations: file.NewLocationSet(controlLocation),
+			Type:      pkg.DebPkg,
+			Metadata: pkg.DpkgBuildDependencyEntry{
+				Field: "Build-Depends",
+			},
+		},
+		{
+			Name:      "texinfo",
+			PURL:      "pkg:deb/debian/texinfo",
+			Locations: file.NewLocationSet(controlLocation),
+			Type:      pkg.DebPkg,
+			Metadata: pkg.DpkgBuildDependencyEntry{
+				Field: "Build-Depends-Indep",
+			},
+		},
+	}
+
+	// note: the packages within relationships are not updated with the cataloger name
+	var expectedRelationships []artifact.Relationship
+	for _, dep := range deps {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{
+			From: dep,
+			To:   hello,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	expectedPkgs := append([]pkg.Package{hello}, deps...)
+	for i := range expectedPkgs {
+		expectedPkgs[i].FoundBy = "dpkg-source-package-cataloger"
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/source-package/hello").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewSourcePackageCataloger())
+}
+
+func TestParseDsc(t *testing.T) {
+	fixture := "test-fixtures/source-package/hello_2.10-3.dsc"
+	location := file.NewLocation(fixture)
+
+	hello := pkg.Package{
+		Name:      "hello",
+		Version:   "2.10-3",
+		PURL:      "pkg:deb/debian/hello@2.10-3?arch=source",
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.DebPkg,
+		Metadata: pkg.DpkgSourceEntry{
+			Format:           "3.0 (quilt)",
+			Maintainer:       "Santiago Vila <sanvila@debian.org>",
+			Homepage:         "https://www.gnu.org/software/hello/",
+			StandardsVersion: "4.6.2",
+			VcsBrowser:       "https://salsa.debian.org/sanvila/hello",
+			VcsGit:           "https://salsa.debian.org/sanvila/hello.git",
+			Binaries:         []string{"hello", "hello-doc"},
+			BuildDepends:     []string{"debhelper-compat (= 13)", "autoconf (>=2.71) <!nocheck>"},
+		},
+	}
+
+	debhelper := pkg.Package{
+		Name:      "debhelper-compat",
+		Version:   "13",
+		PURL:      "pkg:deb/debian/debhelper-compat@13",
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.DebPkg,
+		Metadata: pkg.DpkgBuildDependencyEntry{
+			Field:             "Build-Depends",
+			VersionConstraint: "= 13",
+		},
+	}
+
+	autoconf := pkg.Package{
+		Name:      "autoconf",
+		PURL:      "pkg:deb/debian/autoconf",
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.DebPkg,
+		Metadata: pkg.DpkgBuildDependencyEntry{
+			Field:             "Build-Depends",
+			VersionConstraint: ">= 2.71",
+			Profiles:          []string{"!nocheck"},
+		},
+	}
+
+	expectedRelationships := []artifact.Relationship{
+		{From: debhelper, To: hello, Type: artifact.DependencyOfRelationship},
+		{From: autoconf, To: hello, Type: artifact.DependencyOfRelationship},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseDsc, []pkg.Package{hello, debhelper, autoconf}, expectedRelationships)
+}
+
+func TestParseDsc_NotASourcePackage(t *testing.T) {
+	pkgtest.TestFileParser(t, "test-fixtures/source-package/not-a-source-package.dsc", parseDsc, nil, nil)
+}
+
+func TestParseBuildDependency(t *testing.T) {
+	tests := []struct {
+		relation string
+		wantName string
+		want     pkg.DpkgBuildDependencyEntry
+		wantOK   bool
+	}{
+		{
+			relation: "libssl-dev:native (>= 3.0) [linux-any] <!nocheck> <!stage1>",
+			wantName: "libssl-dev",
+			want: pkg.DpkgBuildDependencyEntry{
+				Field:             "Build-Depends",
+				VersionConstraint: ">= 3.0",
+				Architectures:     []string{"linux-any"},
+				Profiles:          []string{"!nocheck", "!stage1"},
+			},
+			wantOK: true,
+		},
+		{
+			relation: "default-jdk | openjdk-17-jdk (>= 17) | java-sdk",
+			wantName: "default-jdk",
+			want: pkg.DpkgBuildDependencyEntry{
+				Field:        "Build-Depends",
+				Alternatives: []string{"openjdk-17-jdk", "java-sdk"},
+			},
+			wantOK: true,
+		},
+		{
+			relation: "${misc:Depends}",
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.relation, func(t *testing.T) {
+			name, got, ok := parseBuildDependency("Build-Depends", tt.relation)
+			assert.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				return
+			}
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
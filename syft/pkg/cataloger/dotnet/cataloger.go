@@ -6,6 +6,7 @@ package dotnet
 import (
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
 )
 
 // NewDotnetDepsCataloger returns a new Dotnet cataloger object base on deps json files.
@@ -14,8 +15,22 @@ func NewDotnetDepsCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseDotnetDeps, "**/*.deps.json")
 }
 
+// NewDotnetPackagesLockCataloger returns a new Dotnet cataloger object base on NuGet packages.lock.json files.
+func NewDotnetPackagesLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dotnet-packages-lock-cataloger").
+		WithParserByGlobs(parseDotnetPackagesLock, "**/packages.lock.json")
+}
+
+// NewDotnetPaketLockCataloger returns a new Dotnet cataloger object base on paket.lock files.
+func NewDotnetPaketLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("dotnet-paket-lock-cataloger").
+		WithParserByGlobs(parseDotnetPaketLock, "**/paket.lock")
+}
+
 // NewDotnetPortableExecutableCataloger returns a new Dotnet cataloger object base on portable executable files.
+// Binaries are related to the DLLs they import when those DLLs are also cataloged.
 func NewDotnetPortableExecutableCataloger() pkg.Cataloger {
 	return generic.NewCataloger("dotnet-portable-executable-cataloger").
-		WithParserByGlobs(parseDotnetPortableExecutable, "**/*.dll", "**/*.exe")
+		WithParserByGlobs(parseDotnetPortableExecutable, "**/*.dll", "**/*.exe").
+		WithProcessors(dependency.Processor(portableExecutableDependencySpecifier))
 }
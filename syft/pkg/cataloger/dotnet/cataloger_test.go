@@ -22,6 +22,22 @@ func TestCataloger_Globs(t *testing.T) {
 				"src/something.deps.json",
 			},
 		},
+		{
+			name:      "obtain packages.lock.json files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewDotnetPackagesLockCataloger(),
+			expected: []string{
+				"src/packages.lock.json",
+			},
+		},
+		{
+			name:      "obtain paket.lock files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewDotnetPaketLockCataloger(),
+			expected: []string{
+				"src/paket.lock",
+			},
+		},
 		{
 			name:      "obtain portable executable files",
 			fixture:   "test-fixtures/glob-paths",
@@ -0,0 +1,37 @@
+package dotnet
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = portableExecutableDependencySpecifier
+
+// portableExecutableDependencySpecifier describes a PE binary as providing its own file name and requiring the DLLs
+// it imports. DLL names are matched case-insensitively, as they are on Windows.
+func portableExecutableDependencySpecifier(p pkg.Package) dependency.Specification {
+	meta, ok := p.Metadata.(pkg.DotnetPortableExecutableEntry)
+	if !ok {
+		return dependency.Specification{}
+	}
+
+	var provides []string
+	for _, l := range p.Locations.ToSlice() {
+		provides = append(provides, strings.ToLower(path.Base(l.RealPath)))
+	}
+
+	var requires []string
+	for _, lib := range meta.ImportedLibraries {
+		requires = append(requires, strings.ToLower(lib))
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: provides,
+			Requires: requires,
+		},
+	}
+}
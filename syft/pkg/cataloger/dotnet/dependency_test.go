@@ -0,0 +1,87 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+func Test_portableExecutableDependencySpecifier(t *testing.T) {
+	tests := []struct {
+		name string
+		p    pkg.Package
+		want dependency.Specification
+	}{
+		{
+			name: "provides the file name and requires imported libraries",
+			p: pkg.Package{
+				Name:      "PuTTY suite",
+				Locations: file.NewLocationSet(file.NewLocation("/Program Files/PuTTY/PuTTY.exe")),
+				Metadata: pkg.DotnetPortableExecutableEntry{
+					ImportedLibraries: []string{"KERNEL32.dll", "ole32.dll"},
+				},
+			},
+			want: dependency.Specification{
+				ProvidesRequires: dependency.ProvidesRequires{
+					Provides: []string{"putty.exe"},
+					Requires: []string{"kernel32.dll", "ole32.dll"},
+				},
+			},
+		},
+		{
+			name: "no imports",
+			p: pkg.Package{
+				Name:      "ntdll",
+				Locations: file.NewLocationSet(file.NewLocation("/Windows/System32/ntdll.dll")),
+				Metadata:  pkg.DotnetPortableExecutableEntry{},
+			},
+			want: dependency.Specification{
+				ProvidesRequires: dependency.ProvidesRequires{
+					Provides: []string{"ntdll.dll"},
+				},
+			},
+		},
+		{
+			name: "other metadata",
+			p: pkg.Package{
+				Name:      "Newtonsoft.Json",
+				Locations: file.NewLocationSet(file.NewLocation("/app/app.deps.json")),
+				Metadata:  pkg.DotnetDepsEntry{},
+			},
+			want: dependency.Specification{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, portableExecutableDependencySpecifier(tt.p))
+		})
+	}
+}
+
+func Test_portableExecutableDependencies(t *testing.T) {
+	newPackage := func(name, path string, imports ...string) pkg.Package {
+		p := pkg.Package{
+			Name:      name,
+			Locations: file.NewLocationSet(file.NewLocation(path)),
+			Metadata: pkg.DotnetPortableExecutableEntry{
+				ImportedLibraries: imports,
+			},
+		}
+		p.SetID()
+		return p
+	}
+
+	ntdll := newPackage("ntdll", "/Windows/System32/ntdll.dll")
+	kernel32 := newPackage("kernel32", "/Windows/System32/kernel32.dll", "ntdll.dll")
+	app := newPackage("app", "/app/app.exe", "KERNEL32.dll", "missing.dll")
+
+	assert.ElementsMatch(t, []artifact.Relationship{
+		{From: ntdll, To: kernel32, Type: artifact.DependencyOfRelationship},
+		{From: kernel32, To: app, Type: artifact.DependencyOfRelationship},
+	}, dependency.Resolve(portableExecutableDependencySpecifier, []pkg.Package{ntdll, kernel32, app}))
+}
@@ -25,7 +25,39 @@ func newDotnetDepsPackage(nameVersion string, lib dotnetDepsLibrary, locations .
 		Name:      name,
 		Version:   version,
 		Locations: file.NewLocationSet(locations...),
-		PURL:      packageURL(m),
+		PURL:      packageURL(m.Name, m.Version),
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newDotnetPackagesLockPackage(m pkg.DotnetPackagesLockEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   m.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m.Name, m.Version),
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newDotnetPaketLockPackage(m pkg.DotnetPaketLockEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   m.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(m.Name, m.Version),
 		Language:  pkg.Dotnet,
 		Type:      pkg.DotnetPkg,
 		Metadata:  m,
@@ -57,7 +89,7 @@ func createNameAndVersion(name, version string) (nameVersion string) {
 	return
 }
 
-func packageURL(m pkg.DotnetDepsEntry) string {
+func packageURL(name, version string) string {
 	var qualifiers packageurl.Qualifiers
 
 	return packageurl.NewPackageURL(
@@ -73,8 +105,8 @@ func packageURL(m pkg.DotnetDepsEntry) string {
 		// official PURL type available.
 		packageurl.TypeNuget,
 		"",
-		m.Name,
-		m.Version,
+		name,
+		version,
 		qualifiers,
 		"",
 	).ToString()
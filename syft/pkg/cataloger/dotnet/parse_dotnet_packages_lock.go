@@ -0,0 +1,120 @@
+package dotnet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/relationship"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDotnetPackagesLock
+
+// dotnetPackagesLock represents a NuGet packages.lock.json file, which holds the resolved packages for each target
+// framework (and runtime identifier, e.g. "net6.0/linux-x64").
+type dotnetPackagesLock struct {
+	Version      int                                                `json:"version"`
+	Dependencies map[string]map[string]dotnetPackagesLockDependency `json:"dependencies"`
+}
+
+type dotnetPackagesLockDependency struct {
+	Type         string            `json:"type"`
+	Requested    string            `json:"requested"`
+	Resolved     string            `json:"resolved"`
+	ContentHash  string            `json:"contentHash"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// packages.lock.json dependency types
+const (
+	dotnetPackagesLockProject = "Project"
+)
+
+func parseDotnetPackagesLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock dotnetPackagesLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse packages.lock.json file: %w", err)
+	}
+
+	// sort the target frameworks so that the order of the packages is deterministic
+	var frameworks []string
+	for framework := range lock.Dependencies {
+		frameworks = append(frameworks, framework)
+	}
+	sort.Strings(frameworks)
+
+	var pkgs []pkg.Package
+	pkgMap := make(map[string]pkg.Package)
+	for _, framework := range frameworks {
+		deps := lock.Dependencies[framework]
+
+		var names []string
+		for name := range deps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			dep := deps[name]
+			// project references (within the same solution) are not resolved from a package source
+			if dep.Type == dotnetPackagesLockProject || dep.Resolved == "" {
+				continue
+			}
+
+			nameVersion := createNameAndVersion(name, dep.Resolved)
+			if _, ok := pkgMap[nameVersion]; ok {
+				continue
+			}
+
+			p := newDotnetPackagesLockPackage(
+				pkg.DotnetPackagesLockEntry{
+					Name:        name,
+					Version:     dep.Resolved,
+					ContentHash: dep.ContentHash,
+					Type:        dep.Type,
+				},
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			)
+			pkgs = append(pkgs, p)
+			pkgMap[nameVersion] = p
+		}
+	}
+
+	var relationships []artifact.Relationship
+	for _, framework := range frameworks {
+		deps := lock.Dependencies[framework]
+		for name, dep := range deps {
+			p, ok := pkgMap[createNameAndVersion(name, dep.Resolved)]
+			if !ok {
+				continue
+			}
+
+			for depName := range dep.Dependencies {
+				// the dependency versions are ranges, the resolved version is found in the same target framework
+				depNameVersion := createNameAndVersion(depName, deps[depName].Resolved)
+				depPkg, ok := pkgMap[depNameVersion]
+				if !ok {
+					log.Debug("unable to find package in map", depNameVersion)
+					continue
+				}
+				relationships = append(relationships, artifact.Relationship{
+					From: depPkg,
+					To:   p,
+					Type: artifact.DependencyOfRelationship,
+				})
+			}
+		}
+	}
+
+	pkg.Sort(pkgs)
+	relationships = relationship.NewIndex(relationships...).All()
+	relationship.Sort(relationships)
+
+	return pkgs, relationships, nil
+}
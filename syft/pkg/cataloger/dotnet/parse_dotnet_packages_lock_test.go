@@ -0,0 +1,83 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseDotnetPackagesLock(t *testing.T) {
+	fixture := "test-fixtures/packages-lock/packages.lock.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	platforms := pkg.Package{
+		Name:      "Microsoft.NETCore.Platforms",
+		Version:   "5.0.0",
+		PURL:      "pkg:nuget/Microsoft.NETCore.Platforms@5.0.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata: pkg.DotnetPackagesLockEntry{
+			Name:        "Microsoft.NETCore.Platforms",
+			Version:     "5.0.0",
+			ContentHash: "VyPlqzH2wavqquTcYpkIIAQ6WdenuKoFN0BdYBbCWsclXacSOHNQn66Gt4z5NBqEYW0FAPm5rlvki9ZiCij5xQ==",
+			Type:        "CentralTransitive",
+		},
+	}
+	newtonsoft := pkg.Package{
+		Name:      "Newtonsoft.Json",
+		Version:   "13.0.1",
+		PURL:      "pkg:nuget/Newtonsoft.Json@13.0.1",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata: pkg.DotnetPackagesLockEntry{
+			Name:        "Newtonsoft.Json",
+			Version:     "13.0.1",
+			ContentHash: "ppPFpBcvxdsfUonNcvITKqLl3bqxWbDCZIzDWHzjpdAHRFfZe0Dw9HmA0+za13IdyrgJwpkDTDA9fHaxOrt20A==",
+			Type:        "Direct",
+		},
+	}
+	serilog := pkg.Package{
+		Name:      "Serilog",
+		Version:   "2.10.0",
+		PURL:      "pkg:nuget/Serilog@2.10.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata: pkg.DotnetPackagesLockEntry{
+			Name:        "Serilog",
+			Version:     "2.10.0",
+			ContentHash: "+QX0hmf37a0/OZLxM3wL7V6/ADvC1XihXN4Kq/p6d8lCPfgkRdiuhbWlMaFjR9Av0dy5F0+MBeDmDdRZN/YwQA==",
+			Type:        "Transitive",
+		},
+	}
+	serilogConsole := pkg.Package{
+		Name:      "Serilog.Sinks.Console",
+		Version:   "4.1.0",
+		PURL:      "pkg:nuget/Serilog.Sinks.Console@4.1.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.DotnetPkg,
+		Metadata: pkg.DotnetPackagesLockEntry{
+			Name:        "Serilog.Sinks.Console",
+			Version:     "4.1.0",
+			ContentHash: "K6N5q+5fetjnJPvCmkWOpJ/V8IEIoMIB1s86OzBrbxwTyHxdx3pmz4H+8+O/Dc/ftUX12DM1aynx/dDowkwzqg==",
+			Type:        "Direct",
+		},
+	}
+
+	expectedPkgs := []pkg.Package{platforms, newtonsoft, serilog, serilogConsole}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: serilog,
+			To:   serilogConsole,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseDotnetPackagesLock, expectedPkgs, expectedRelationships)
+}
@@ -0,0 +1,153 @@
+package dotnet
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/relationship"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDotnetPaketLock
+
+var (
+	// paketPackageExp matches a resolved package within a NUGET section of a paket.lock file, for example:
+	// "    Newtonsoft.Json (13.0.1)" or "    FSharp.Core (6.0.1) - restriction: >= netstandard2.0"
+	paketPackageExp = regexp.MustCompile(`^ {4}(\S+) \(([^)]+)\)`)
+
+	// paketDependencyExp matches a dependency of the last resolved package, for example:
+	// "      System.Memory (>= 4.5.4) - restriction: >= netstandard2.0"
+	paketDependencyExp = regexp.MustCompile(`^ {6}(\S+)`)
+)
+
+// paketDefaultGroup is the name of the group of the dependencies which are not within an explicit group
+const paketDefaultGroup = "Main"
+
+type paketLockEntry struct {
+	pkg          pkg.Package
+	dependencies []string
+}
+
+// parseDotnetPaketLock parses a paket.lock file, which is made up of (optional) groups, each containing sections per
+// dependency source type, for example:
+//
+//	NUGET
+//	  remote: https://api.nuget.org/v3/index.json
+//	    Serilog (2.10.0)
+//	      System.Memory (>= 4.5.4)
+//	    System.Memory (4.5.4)
+//	GITHUB
+//	  remote: fsharp/FAKE
+//	    src/app/FakeLib/Globbing/Globbing.fs (0341a2e614eb2a7f34607cec914eb0ed83ce9add)
+//
+//	GROUP Build
+//	NUGET
+//	  ...
+//
+// only NuGet packages are cataloged.
+func parseDotnetPaketLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var (
+		groups  = map[string][]*paketLockEntry{}
+		order   []string
+		group   = paketDefaultGroup
+		section string
+		remote  string
+		current *paketLockEntry
+	)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" {
+			continue
+		}
+
+		// top-level lines are group names, section names (e.g. NUGET, GITHUB, HTTP) or options (e.g. "STORAGE: NONE")
+		if !strings.HasPrefix(line, " ") {
+			current = nil
+			remote = ""
+			if name, ok := strings.CutPrefix(line, "GROUP "); ok {
+				group = strings.TrimSpace(name)
+				section = ""
+				continue
+			}
+			section = line
+			continue
+		}
+
+		if section != "NUGET" {
+			continue
+		}
+
+		if r, ok := strings.CutPrefix(line, "  remote: "); ok {
+			remote = strings.TrimSpace(r)
+			current = nil
+			continue
+		}
+
+		if match := paketPackageExp.FindStringSubmatch(line); match != nil {
+			if _, ok := groups[group]; !ok {
+				order = append(order, group)
+			}
+			current = &paketLockEntry{
+				pkg: newDotnetPaketLockPackage(
+					pkg.DotnetPaketLockEntry{
+						Name:    match[1],
+						Version: match[2],
+						Remote:  remote,
+						Group:   group,
+					},
+					reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+				),
+			}
+			groups[group] = append(groups[group], current)
+			continue
+		}
+
+		if match := paketDependencyExp.FindStringSubmatch(line); match != nil && current != nil {
+			current.dependencies = append(current.dependencies, match[1])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse paket.lock file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	var relationships []artifact.Relationship
+	for _, g := range order {
+		// dependencies are resolved within the same group
+		byName := map[string]pkg.Package{}
+		for _, entry := range groups[g] {
+			pkgs = append(pkgs, entry.pkg)
+			byName[entry.pkg.Name] = entry.pkg
+		}
+
+		for _, entry := range groups[g] {
+			for _, name := range entry.dependencies {
+				dep, ok := byName[name]
+				if !ok {
+					log.Debug("unable to find package in paket.lock group", g, name)
+					continue
+				}
+				relationships = append(relationships, artifact.Relationship{
+					From: dep,
+					To:   entry.pkg,
+					Type: artifact.DependencyOfRelationship,
+				})
+			}
+		}
+	}
+
+	pkg.Sort(pkgs)
+	relationship.Sort(relationships)
+
+	return pkgs, relationships, nil
+}
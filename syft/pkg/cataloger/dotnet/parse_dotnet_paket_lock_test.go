@@ -0,0 +1,56 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseDotnetPaketLock(t *testing.T) {
+	fixture := "test-fixtures/paket-lock/paket.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	nuget := "https://api.nuget.org/v3/index.json"
+
+	newPkg := func(name, version, group string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      "pkg:nuget/" + name + "@" + version,
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.DotnetPkg,
+			Metadata: pkg.DotnetPaketLockEntry{
+				Name:    name,
+				Version: version,
+				Remote:  nuget,
+				Group:   group,
+			},
+		}
+	}
+
+	fsharpCore := newPkg("FSharp.Core", "6.0.1", "Main")
+	serilog := newPkg("Serilog", "2.10.0", "Main")
+	serilogConsole := newPkg("Serilog.Sinks.Console", "4.1.0", "Main")
+	systemMemory := newPkg("System.Memory", "4.5.4", "Main")
+	fakeTarget := newPkg("Fake.Core.Target", "5.23.1", "Build")
+	buildFsharpCore := newPkg("FSharp.Core", "6.0.3", "Build")
+
+	expectedPkgs := []pkg.Package{fakeTarget, fsharpCore, buildFsharpCore, serilog, serilogConsole, systemMemory}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: serilog,
+			To:   serilogConsole,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: buildFsharpCore,
+			To:   fakeTarget,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseDotnetPaketLock, expectedPkgs, expectedRelationships)
+}
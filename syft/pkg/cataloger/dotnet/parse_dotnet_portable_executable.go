@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/saferwall/pe"
+	"github.com/scylladb/go-set/strset"
 
 	version "github.com/anchore/go-version"
 	"github.com/anchore/packageurl-go"
@@ -26,7 +27,11 @@ func parseDotnetPortableExecutable(_ context.Context, _ file.Resolver, _ *generi
 		return nil, nil, fmt.Errorf("unable to read file: %w", err)
 	}
 
-	peFile, err := pe.NewBytes(by, &pe.Options{})
+	peFile, err := pe.NewBytes(by, &pe.Options{
+		// only the signer is reported, not whether the signature or certificate chain are valid
+		DisableCertValidation:      true,
+		DisableSignatureValidation: true,
+	})
 	if err != nil {
 		// TODO: known-unknown
 		log.Tracef("unable to create PE instance for file '%s': %v", f.RealPath, err)
@@ -54,9 +59,45 @@ func parseDotnetPortableExecutable(_ context.Context, _ file.Resolver, _ *generi
 		return nil, nil, nil
 	}
 
+	if metadata, ok := dotNetPkg.Metadata.(pkg.DotnetPortableExecutableEntry); ok {
+		metadata.ImportedLibraries = peImportedLibraries(peFile)
+		metadata.SignerSubject = peSignerSubject(peFile)
+		dotNetPkg.Metadata = metadata
+	}
+
 	return []pkg.Package{dotNetPkg}, nil, nil
 }
 
+// peImportedLibraries returns the names of the DLLs imported by the PE file, including those that are delay-loaded.
+func peImportedLibraries(f *pe.File) []string {
+	var libs []string
+	seen := strset.New()
+	add := func(name string) {
+		key := strings.ToLower(name)
+		if name == "" || seen.Has(key) {
+			return
+		}
+		seen.Add(key)
+		libs = append(libs, name)
+	}
+
+	for _, i := range f.Imports {
+		add(i.Name)
+	}
+	for _, i := range f.DelayImports {
+		add(i.Name)
+	}
+	return libs
+}
+
+// peSignerSubject returns the subject of the Authenticode signer certificate. Any nested signatures are ignored.
+func peSignerSubject(f *pe.File) string {
+	if !f.IsSigned || len(f.Certificates.Certificates) == 0 {
+		return ""
+	}
+	return f.Certificates.Certificates[0].Info.Subject
+}
+
 func buildDotNetPackage(versionResources map[string]string, f file.LocationReadCloser) (dnpkg pkg.Package, err error) {
 	name := findName(versionResources)
 	if name == "" {
@@ -3,6 +3,7 @@ package dotnet
 import (
 	"testing"
 
+	"github.com/saferwall/pe"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anchore/syft/syft/file"
@@ -346,3 +347,35 @@ func Test_spaceNormalize(t *testing.T) {
 		})
 	}
 }
+
+func Test_peImportedLibraries(t *testing.T) {
+	f := &pe.File{
+		Imports: []pe.Import{
+			{Name: "KERNEL32.dll"},
+			{Name: "USER32.dll"},
+		},
+		DelayImports: []pe.DelayImport{
+			{Name: "kernel32.dll"}, // already imported directly
+			{Name: "WINMM.dll"},
+			{Name: ""},
+		},
+	}
+
+	assert.Equal(t, []string{"KERNEL32.dll", "USER32.dll", "WINMM.dll"}, peImportedLibraries(f))
+	assert.Empty(t, peImportedLibraries(&pe.File{}))
+}
+
+func Test_peSignerSubject(t *testing.T) {
+	signed := &pe.File{
+		FileInfo: pe.FileInfo{IsSigned: true},
+		Certificates: pe.CertificateSection{
+			Certificates: []pe.Certificate{
+				{Info: pe.CertInfo{Subject: "GB, Cambridgeshire, Cambridge, Simon Tatham, Simon Tatham"}},
+				{Info: pe.CertInfo{Subject: "nested signature"}},
+			},
+		},
+	}
+
+	assert.Equal(t, "GB, Cambridgeshire, Cambridge, Simon Tatham, Simon Tatham", peSignerSubject(signed))
+	assert.Empty(t, peSignerSubject(&pe.File{}))
+}
@@ -0,0 +1,25 @@
+/*
+Package editorplugin provides concrete Cataloger implementations for the plugins installed to text editors by plugin
+managers: lazy.nvim and vim-plug for Vim and Neovim, and straight.el for Emacs.
+*/
+package editorplugin
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewVimPluginCataloger returns a new cataloger object for Vim and Neovim plugins recorded by lazy.nvim (lazy-lock.json)
+// and vim-plug (snapshot files written by :PlugSnapshot).
+func NewVimPluginCataloger() pkg.Cataloger {
+	return generic.NewCataloger("vim-plugin-cataloger").
+		WithParserByGlobs(parseLazyLock, "**/lazy-lock.json").
+		WithParserByGlobs(parsePlugSnapshot, "**/*snapshot*.vim")
+}
+
+// NewEmacsPackageCataloger returns a new cataloger object for Emacs packages recorded within straight.el version
+// lockfiles (e.g. ~/.emacs.d/straight/versions/default.el).
+func NewEmacsPackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("emacs-package-cataloger").
+		WithParserByGlobs(parseStraightLockfile, "**/straight/versions/*.el")
+}
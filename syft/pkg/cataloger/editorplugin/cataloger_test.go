@@ -0,0 +1,44 @@
+package editorplugin
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain lazy.nvim lockfiles and vim-plug snapshots",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewVimPluginCataloger(),
+			expected: []string{
+				"home/dev/.config/nvim/lazy-lock.json",
+				"home/dev/.vim/plug-snapshot.vim",
+			},
+		},
+		{
+			name:      "obtain straight.el lockfiles",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewEmacsPackageCataloger(),
+			expected: []string{
+				"home/dev/.emacs.d/straight/versions/default.el",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package editorplugin
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// newPluginPackage returns a package for a plugin, which is versioned by the commit of the plugin repository that is
+// installed (plugin managers track repositories rather than releases).
+func newPluginPackage(ty pkg.Type, name, commit string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   commit,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(ty, name, commit),
+		Type:      ty,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(ty pkg.Type, name, version string) string {
+	return packageurl.NewPackageURL(
+		ty.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,52 @@
+package editorplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseLazyLock
+
+// parseLazyLock is a parser function for lazy.nvim lockfiles, which map each plugin to the branch tracked and commit
+// installed, for example:
+//
+//	{
+//	  "lazy.nvim": { "branch": "main", "commit": "aedcd79811d491b60d0a6577a9c1701063c2a609" },
+//	  "telescope.nvim": { "branch": "master", "commit": "a0bbec21143c7bc5f8bb02e0005fa0b982edc026" }
+//	}
+func parseLazyLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock map[string]pkg.NeovimLazyLockEntry
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse lazy-lock.json file: %w", err)
+	}
+
+	names := make([]string, 0, len(lock))
+	for name := range lock {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		entry := lock[name]
+		if entry.Commit == "" {
+			continue
+		}
+		pkgs = append(pkgs, newPluginPackage(
+			pkg.VimPluginPkg,
+			name,
+			entry.Commit,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,44 @@
+package editorplugin
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseLazyLock(t *testing.T) {
+	fixture := "test-fixtures/lazy-lock.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	plugin := func(name, branch, commit string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   commit,
+			PURL:      "pkg:vim-plugin/" + name + "@" + commit,
+			Locations: locations,
+			Type:      pkg.VimPluginPkg,
+			Metadata: pkg.NeovimLazyLockEntry{
+				Branch: branch,
+				Commit: commit,
+			},
+		}
+	}
+
+	expectedPkgs := []pkg.Package{
+		plugin("lazy.nvim", "main", "aedcd79811d491b60d0a6577a9c1701063c2a609"),
+		plugin("nvim-treesitter", "master", "1ae9b0e4558fe7868f8cda2db65239cfb14836d0"),
+		plugin("plenary.nvim", "master", "4f71c0c4a196ceb656c824a70792f3df3ce6bb6d"),
+		plugin("telescope.nvim", "0.1.x", "a0bbec21143c7bc5f8bb02e0005fa0b982edc026"),
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseLazyLock, expectedPkgs, nil)
+}
+
+func TestParseLazyLock_Invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/glob-paths/home/dev/.config/nvim/lazy-lock.json").
+		WithError().
+		TestParser(t, parseLazyLock)
+}
@@ -0,0 +1,48 @@
+package editorplugin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePlugSnapshot
+
+// plugCommitExp matches the lines of a vim-plug snapshot which pin a plugin to a commit, for example:
+//
+//	silent! let g:plugs['fzf.vim'].commit = '45d96c9cb1213204479593236dfabf911ff15443'
+var plugCommitExp = regexp.MustCompile(`let\s+g:plugs\[['"]([^'"]+)['"]\]\.commit\s*=\s*['"]([0-9a-fA-F]+)['"]`)
+
+// parsePlugSnapshot is a parser function for vim-plug snapshot files (written by :PlugSnapshot), which are Vim scripts
+// restoring each plugin to the commit installed when the snapshot was taken.
+func parsePlugSnapshot(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		match := plugCommitExp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		name, commit := match[1], match[2]
+		pkgs = append(pkgs, newPluginPackage(
+			pkg.VimPluginPkg,
+			name,
+			commit,
+			pkg.VimPlugSnapshotEntry{Commit: commit},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read vim-plug snapshot file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
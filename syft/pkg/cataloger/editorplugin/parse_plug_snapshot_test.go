@@ -0,0 +1,33 @@
+package editorplugin
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePlugSnapshot(t *testing.T) {
+	fixture := "test-fixtures/plug-snapshot.vim"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	plugin := func(name, commit string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   commit,
+			PURL:      "pkg:vim-plugin/" + name + "@" + commit,
+			Locations: locations,
+			Type:      pkg.VimPluginPkg,
+			Metadata:  pkg.VimPlugSnapshotEntry{Commit: commit},
+		}
+	}
+
+	expectedPkgs := []pkg.Package{
+		plugin("fzf", "3f75a8369a2fd2d8e2b2a7ba36b0a8e6d3b6f3a4"),
+		plugin("fzf.vim", "45d96c9cb1213204479593236dfabf911ff15443"),
+		plugin("vim-fugitive", "2febbe1f00be04f16daa6464cb39214a8566ec4b"),
+	}
+
+	pkgtest.TestFileParser(t, fixture, parsePlugSnapshot, expectedPkgs, nil)
+}
@@ -0,0 +1,45 @@
+package editorplugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseStraightLockfile
+
+// straightEntryExp matches the entries of a straight.el version lockfile, which is an association list of repository
+// names to commits (followed by a keyword for the version of the lockfile format), for example:
+//
+//	(("magit" . "4992c3d1f64e0e983692c7a61d47069f47380dbf")
+//	 ("use-package" . "a6e856418d2ebd053b34e0ab2fda328abeba731c"))
+//	:gamma
+var straightEntryExp = regexp.MustCompile(`\(\s*"([^"]+)"\s*\.\s*"([0-9a-fA-F]+)"\s*\)`)
+
+// parseStraightLockfile is a parser function for straight.el version lockfiles (written by straight-freeze-versions).
+func parseStraightLockfile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read straight.el lockfile: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, match := range straightEntryExp.FindAllStringSubmatch(string(contents), -1) {
+		name, commit := match[1], match[2]
+		pkgs = append(pkgs, newPluginPackage(
+			pkg.EmacsPackagePkg,
+			name,
+			commit,
+			pkg.EmacsStraightLockEntry{Commit: commit},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
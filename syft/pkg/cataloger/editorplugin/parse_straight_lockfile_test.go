@@ -0,0 +1,35 @@
+package editorplugin
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseStraightLockfile(t *testing.T) {
+	fixture := "test-fixtures/straight/versions/default.el"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	emacsPackage := func(name, commit string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   commit,
+			PURL:      "pkg:emacs-package/" + name + "@" + commit,
+			Locations: locations,
+			Type:      pkg.EmacsPackagePkg,
+			Metadata:  pkg.EmacsStraightLockEntry{Commit: commit},
+		}
+	}
+
+	expectedPkgs := []pkg.Package{
+		emacsPackage("compat", "ecf53005abf6f0325d14e0e024222e22e982c8dd"),
+		emacsPackage("emacs-which-key", "4d20bc852545a2e602f59084a630f888542052b1"),
+		emacsPackage("magit", "4992c3d1f64e0e983692c7a61d47069f47380dbf"),
+		emacsPackage("straight.el", "b3760f5829dba37e855add7323304561eb57a3d4"),
+		emacsPackage("use-package", "a6e856418d2ebd053b34e0ab2fda328abeba731c"),
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseStraightLockfile, expectedPkgs, nil)
+}
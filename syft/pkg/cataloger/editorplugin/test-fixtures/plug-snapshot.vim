@@ -0,0 +1,10 @@
+" Generated by vim-plug
+" Wed Jan 10 09:14:28 UTC 2024
+" :source this file in vim to restore the snapshot
+" or execute: vim -S plug-snapshot.vim
+
+silent! let g:plugs['fzf'].commit = '3f75a8369a2fd2d8e2b2a7ba36b0a8e6d3b6f3a4'
+silent! let g:plugs['fzf.vim'].commit = '45d96c9cb1213204479593236dfabf911ff15443'
+silent! let g:plugs['vim-fugitive'].commit = '2febbe1f00be04f16daa6464cb39214a8566ec4b'
+
+PlugUpdate!
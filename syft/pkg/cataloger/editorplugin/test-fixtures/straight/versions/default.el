@@ -0,0 +1,6 @@
+(("compat" . "ecf53005abf6f0325d14e0e024222e22e982c8dd")
+ ("emacs-which-key" . "4d20bc852545a2e602f59084a630f888542052b1")
+ ("magit" . "4992c3d1f64e0e983692c7a61d47069f47380dbf")
+ ("straight.el" . "b3760f5829dba37e855add7323304561eb57a3d4")
+ ("use-package" . "a6e856418d2ebd053b34e0ab2fda328abeba731c"))
+:gamma
@@ -0,0 +1,35 @@
+/*
+Package firmware provides concrete Cataloger implementations for firmware images (UEFI capsules and firmware volumes,
+and flattened device tree blobs), for the firmware files installed for Linux kernel drivers, and for CPU microcode.
+*/
+package firmware
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for firmware images, describing each UEFI capsule or firmware image by the
+// firmware it delivers (FMP payloads) and the firmware files held by its volumes, and each device tree blob by the
+// board it describes.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("firmware-cataloger").
+		WithParserByGlobs(parseUEFIFirmware, "**/*.cap", "**/*.fd", "**/*.fv", "**/*.rom").
+		WithParserByGlobs(parseDeviceTree, "**/*.dtb", "**/*.dtbo")
+}
+
+// NewLinuxFirmwareCataloger returns a new cataloger object for the firmware files installed for Linux kernel drivers
+// (e.g. within /lib/firmware), describing the firmware loaded by each driver (along with its licenses) from the
+// linux-firmware WHENCE file rather than reporting each firmware file.
+func NewLinuxFirmwareCataloger() pkg.Cataloger {
+	return &linuxFirmwareCataloger{}
+}
+
+// NewMicrocodeCataloger returns a new cataloger object for CPU microcode files (Intel microcode updates and AMD microcode
+// containers, e.g. within /lib/firmware/intel-ucode and /lib/firmware/amd-ucode), describing each microcode update by
+// its vendor, the processors it applies to, and the revision it delivers.
+func NewMicrocodeCataloger() pkg.Cataloger {
+	return generic.NewCataloger("cpu-microcode-cataloger").
+		// note: the files within kernel/x86/microcode are the microcode loaded from an early initramfs
+		WithParserByGlobs(parseMicrocode, "**/intel-ucode/*", "**/amd-ucode/*.bin", "**/kernel/x86/microcode/GenuineIntel.bin", "**/kernel/x86/microcode/AuthenticAMD.bin")
+}
@@ -0,0 +1,168 @@
+package firmware
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"firmware/update.cap",
+			"firmware/OVMF_VARS.fd",
+			"firmware/DXEFV.fv",
+			"firmware/bios.rom",
+			"boot/dtbs/board.dtb",
+			"boot/dtbs/overlays/overlay.dtbo",
+		}).
+		TestCataloger(t, NewCataloger())
+}
+
+func primary(path string) file.Location {
+	return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+}
+
+func TestCataloger_UEFI(t *testing.T) {
+	expected := []pkg.Package{
+		{
+			// a flash image, where the volume is found past the start of the image
+			Name:      "OVMF_CODE",
+			FoundBy:   "firmware-cataloger",
+			Locations: file.NewLocationSet(primary("OVMF_CODE.fd")),
+			PURL:      "pkg:generic/OVMF_CODE",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.UEFIFirmwareEntry{
+				Format: "firmware-volume",
+				Volumes: []pkg.UEFIFirmwareVolume{
+					{
+						GUID:           "7cb8bdc9-f8eb-4f34-aaea-3ee4af6516a1",
+						FileSystemGUID: "8c8ce578-8a3d-4f1c-9935-896185c32dd3",
+						Size:           360,
+						// the padding file is not included
+						Files: []pkg.UEFIFirmwareFile{
+							{
+								GUID:    "d6a2cb7f-6a18-4e2f-b43b-9920a733700a",
+								Type:    "DXE_CORE",
+								Name:    "DxeCore",
+								Version: "1.0",
+							},
+							{
+								GUID: "222c386d-5abc-4fb4-b124-fbb82488acf4",
+								Type: "PEIM",
+								Name: "PlatformPei",
+							},
+							{
+								// the version is the build number when no version string is given
+								GUID:    "462caa21-7614-4503-836e-8ab6f4662331",
+								Type:    "APPLICATION",
+								Name:    "UiApp",
+								Version: "2",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			// a signed FMP capsule, versioned by the FMP payload header
+			Name:    "SystemFirmware",
+			Version: "65538",
+			FoundBy: "firmware-cataloger",
+			Locations: file.NewLocationSet(
+				primary("SystemFirmware.cap"),
+				file.NewLocation("SystemFirmware.metainfo.xml").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation),
+			),
+			PURL: "pkg:generic/SystemFirmware@65538",
+			Type: pkg.FirmwarePkg,
+			Metadata: pkg.UEFIFirmwareEntry{
+				Format: "capsule",
+				// the vendor is taken from the metainfo file that describes the firmware of the FMP payload
+				Vendor:      "Example Corp",
+				CapsuleGUID: "6dcbd5ed-e82d-4c44-bda1-7194199ad92a",
+				Payloads: []pkg.UEFICapsulePayload{
+					{
+						ImageTypeID:            "2d2b5c8e-3f4a-4b6c-8d9e-0a1b2c3d4e5f",
+						ImageIndex:             1,
+						Version:                65538,
+						LowestSupportedVersion: 65536,
+					},
+				},
+				Volumes: []pkg.UEFIFirmwareVolume{
+					{
+						GUID:           "c8e5ef3a-7a6a-4f8b-8e7a-1f1e0c2d4b5a",
+						FileSystemGUID: "8c8ce578-8a3d-4f1c-9935-896185c32dd3",
+						Size:           256,
+						Files: []pkg.UEFIFirmwareFile{
+							{
+								GUID:    "5c2f4a8e-1b3d-4e6f-9a0b-7c8d9e0f1a2b",
+								Type:    "DRIVER",
+								Name:    "SystemFirmwareUpdateDxe",
+								Version: "2.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/uefi").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_DeviceTree(t *testing.T) {
+	expected := []pkg.Package{
+		{
+			Name:      "bcm2711-rpi-4-b",
+			FoundBy:   "firmware-cataloger",
+			Locations: file.NewLocationSet(primary("bcm2711-rpi-4-b.dtb")),
+			PURL:      "pkg:generic/bcm2711-rpi-4-b",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.DeviceTreeEntry{
+				// the compatible property of child nodes (cpus) is not used
+				Model:      "Raspberry Pi 4 Model B",
+				Compatible: []string{"raspberrypi,4-model-b", "brcm,bcm2711"},
+			},
+		},
+		{
+			Name:      "disable-bt",
+			FoundBy:   "firmware-cataloger",
+			Locations: file.NewLocationSet(primary("overlays/disable-bt.dtbo")),
+			PURL:      "pkg:generic/disable-bt",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.DeviceTreeEntry{
+				Compatible: []string{"brcm,bcm2711"},
+				Overlay:    true,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/device-tree").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_Invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/invalid").
+		Expects(nil, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func Test_parseDeviceTree_Truncated(t *testing.T) {
+	// the header states a structure block beyond the end of the blob
+	header := "\xd0\x0d\xfe\xed" + "\x00\x00\x00\x28" + "\x00\x00\x00\x28" + "\x00\x00\x00\x28" + strings.Repeat("\x00", 20) + "\x00\x00\x00\x40"
+
+	pkgtest.NewCatalogTester().
+		FromString("truncated.dtb", header).
+		WithError().
+		TestParser(t, parseDeviceTree)
+}
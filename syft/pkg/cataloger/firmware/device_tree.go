@@ -0,0 +1,158 @@
+package firmware
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// flattened device tree structures, from the devicetree specification
+const (
+	fdtMagic      = 0xd00dfeed
+	fdtHeaderSize = 40
+
+	fdtBeginNode = 0x1
+	fdtEndNode   = 0x2
+	fdtProp      = 0x3
+	fdtNop       = 0x4
+	fdtEnd       = 0x9
+)
+
+var errInvalidDeviceTree = errors.New("invalid flattened device tree")
+
+// readDeviceTree returns the description of a flattened device tree blob by the properties of its root node (or nil
+// when the data is not a device tree blob).
+func readDeviceTree(data []byte) (*pkg.DeviceTreeEntry, error) {
+	if len(data) < fdtHeaderSize || binary.BigEndian.Uint32(data[0:4]) != fdtMagic {
+		return nil, nil
+	}
+
+	totalSize := binary.BigEndian.Uint32(data[4:8])
+	structOffset := binary.BigEndian.Uint32(data[8:12])
+	stringsOffset := binary.BigEndian.Uint32(data[12:16])
+	stringsSize := binary.BigEndian.Uint32(data[32:36])
+	structSize := binary.BigEndian.Uint32(data[36:40])
+	if uint64(totalSize) > uint64(len(data)) ||
+		uint64(structOffset)+uint64(structSize) > uint64(totalSize) ||
+		uint64(stringsOffset)+uint64(stringsSize) > uint64(totalSize) {
+		return nil, errInvalidDeviceTree
+	}
+
+	t := fdtWalker{
+		structure: data[structOffset : structOffset+structSize],
+		strings:   data[stringsOffset : stringsOffset+stringsSize],
+	}
+	return t.root()
+}
+
+type fdtWalker struct {
+	structure []byte
+	strings   []byte
+	offset    int
+}
+
+// root returns the description of the device tree by the properties of the root node, where the tree is an overlay
+// when the root node holds fragments (or the fixups of an overlay).
+func (t *fdtWalker) root() (*pkg.DeviceTreeEntry, error) {
+	entry := &pkg.DeviceTreeEntry{}
+
+	depth := 0
+	for {
+		token, err := t.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token {
+		case fdtBeginNode:
+			name, err := t.nodeName()
+			if err != nil {
+				return nil, err
+			}
+			depth++
+			if depth == 2 && (strings.HasPrefix(name, "fragment@") || name == "__fixups__") {
+				entry.Overlay = true
+			}
+		case fdtEndNode:
+			depth--
+			if depth <= 0 {
+				return entry, nil
+			}
+		case fdtProp:
+			name, value, err := t.property()
+			if err != nil {
+				return nil, err
+			}
+			if depth != 1 {
+				continue
+			}
+			switch name {
+			case "model":
+				entry.Model = string(bytes.TrimRight(value, "\x00"))
+			case "compatible":
+				entry.Compatible = stringList(value)
+			}
+		case fdtNop:
+		case fdtEnd:
+			return entry, nil
+		default:
+			return nil, errInvalidDeviceTree
+		}
+	}
+}
+
+func (t *fdtWalker) uint32() (uint32, error) {
+	if t.offset+4 > len(t.structure) {
+		return 0, errInvalidDeviceTree
+	}
+	v := binary.BigEndian.Uint32(t.structure[t.offset : t.offset+4])
+	t.offset += 4
+	return v, nil
+}
+
+func (t *fdtWalker) nodeName() (string, error) {
+	end := bytes.IndexByte(t.structure[t.offset:], 0)
+	if end < 0 {
+		return "", errInvalidDeviceTree
+	}
+	name := string(t.structure[t.offset : t.offset+end])
+	t.offset = align(t.offset+end+1, 4)
+	return name, nil
+}
+
+func (t *fdtWalker) property() (string, []byte, error) {
+	length, err := t.uint32()
+	if err != nil {
+		return "", nil, err
+	}
+	nameOffset, err := t.uint32()
+	if err != nil {
+		return "", nil, err
+	}
+	if uint64(t.offset)+uint64(length) > uint64(len(t.structure)) || int(nameOffset) >= len(t.strings) {
+		return "", nil, errInvalidDeviceTree
+	}
+
+	value := t.structure[t.offset : t.offset+int(length)]
+	t.offset = align(t.offset+int(length), 4)
+
+	name := t.strings[nameOffset:]
+	if end := bytes.IndexByte(name, 0); end >= 0 {
+		name = name[:end]
+	}
+	return string(name), value, nil
+}
+
+// stringList returns the strings of a NUL-separated string list property.
+func stringList(value []byte) []string {
+	var values []string
+	for _, v := range strings.Split(string(value), "\x00") {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,3 @@
+package whence
+
+//go:generate go run ./index-generator/ -o data/whence-index.json
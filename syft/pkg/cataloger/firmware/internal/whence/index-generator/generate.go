@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/firmware/internal/whence"
+)
+
+var (
+	// separatorPattern matches the lines separating the section for each driver
+	separatorPattern = regexp.MustCompile(`^-{10,}\s*$`)
+
+	// licenseReferencePattern matches the names of license files within the linux-firmware repository referenced by a
+	// licence statement (e.g. "Redistributable. See LICENCE.iwlwifi_firmware for details.")
+	licenseReferencePattern = regexp.MustCompile(`\b(LICEN[CS]E[._][A-Za-z0-9_.\-]*[A-Za-z0-9_]|GPL-[23])\b`)
+)
+
+// section represents the description of the firmware files for a driver within the WHENCE file.
+type section struct {
+	driver      string
+	description string
+	files       map[string]string
+	licence     []string
+}
+
+func generateIndexJSON(reader io.Reader) ([]byte, error) {
+	index, err := parseWhence(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	indexJSON, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal WHENCE index to JSON: %w", err)
+	}
+	return indexJSON, nil
+}
+
+// parseWhence parses the sections of a WHENCE file into an index of firmware files by driver, where sections for the
+// same driver are merged.
+func parseWhence(reader io.Reader) (*whence.Index, error) {
+	index := &whence.Index{Drivers: make(map[string]whence.Driver)}
+
+	add := func(s *section) {
+		if s == nil || s.driver == "" || len(s.files) == 0 {
+			return
+		}
+
+		d, ok := index.Drivers[s.driver]
+		if !ok {
+			d = whence.Driver{Description: s.description, Files: make(map[string]string)}
+		}
+		for f, v := range s.files {
+			d.Files[f] = v
+		}
+		for _, l := range licenseReferences(strings.Join(s.licence, "\n")) {
+			if !slices.Contains(d.Licenses, l) {
+				d.Licenses = append(d.Licenses, l)
+			}
+		}
+		index.Drivers[s.driver] = d
+	}
+
+	var current *section
+	var lastFile string
+	var inLicence bool
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if separatorPattern.MatchString(line) {
+			add(current)
+			current = &section{files: make(map[string]string)}
+			lastFile = ""
+			inLicence = false
+			continue
+		}
+
+		if current == nil {
+			// the preamble before the first section
+			continue
+		}
+
+		field, value, isField := splitField(line)
+		switch {
+		case isField && field == "Driver":
+			if current.driver == "" {
+				current.driver, current.description = parseDriver(value)
+			}
+			inLicence = false
+		case isField && (field == "File" || field == "RawFile"):
+			lastFile = unquote(value)
+			current.files[lastFile] = ""
+			inLicence = false
+		case isField && field == "Version":
+			if lastFile != "" {
+				current.files[lastFile] = value
+			}
+			inLicence = false
+		case isField && field == "Link":
+			link, target, ok := parseLink(value)
+			if ok {
+				current.files[link] = current.files[target]
+			}
+			inLicence = false
+		case isField && (field == "Licence" || field == "License"):
+			current.licence = append(current.licence, value)
+			inLicence = true
+		case isField:
+			// other fields (e.g. "Info" or "Original licence") are not indexed
+			inLicence = false
+		case inLicence:
+			current.licence = append(current.licence, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read WHENCE file: %w", err)
+	}
+
+	add(current)
+
+	return index, nil
+}
+
+// splitField splits a "Field: value" line, where field names start the line and do not hold spaces.
+func splitField(line string) (string, string, bool) {
+	if line == "" || line[0] == ' ' || line[0] == '\t' {
+		return "", "", false
+	}
+	field, value, ok := strings.Cut(line, ":")
+	if !ok || field == "" || strings.ContainsAny(field, " \t") {
+		return "", "", false
+	}
+	return field, strings.TrimSpace(value), true
+}
+
+// parseDriver splits a driver statement into the name of the driver and its description (e.g. "iwlwifi" and
+// "Intel Wireless Wifi" for "iwlwifi - Intel Wireless Wifi").
+func parseDriver(value string) (string, string) {
+	name, description, _ := strings.Cut(value, " ")
+	name = strings.TrimSuffix(name, ":")
+	description = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(description), "-:"))
+	return name, strings.TrimSuffix(description, ".")
+}
+
+// parseLink parses a link statement (e.g. "i915/skl_dmc_ver1.bin -> skl_dmc_ver1_27.bin"), where the target is
+// relative to the directory of the link.
+func parseLink(value string) (string, string, bool) {
+	link, target, ok := strings.Cut(value, "->")
+	if !ok {
+		return "", "", false
+	}
+	link = unquote(strings.TrimSpace(link))
+	target = unquote(strings.TrimSpace(target))
+	if link == "" || target == "" {
+		return "", "", false
+	}
+	return link, path.Join(path.Dir(link), target), true
+}
+
+// licenseReferences returns the license files (or license IDs) referenced by a licence statement.
+func licenseReferences(licence string) []string {
+	var refs []string
+	for _, ref := range licenseReferencePattern.FindAllString(licence, -1) {
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func unquote(value string) string {
+	return strings.Trim(value, `"`)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg/cataloger/firmware/internal/whence"
+)
+
+func Test_generateIndexJSON(t *testing.T) {
+	f, err := os.Open("testdata/WHENCE")
+	require.NoError(t, err)
+	defer f.Close()
+
+	indexJSON, err := generateIndexJSON(f)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile("testdata/expected-whence-index.json")
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(string(expected), string(indexJSON)); diff != "" {
+		t.Errorf("generateIndexJSON() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_parseWhence(t *testing.T) {
+	tests := []struct {
+		name   string
+		whence string
+		want   map[string]whence.Driver
+	}{
+		{
+			name: "files, raw files, versions, and links",
+			whence: `
+--------------------------------------------------------------------------
+
+Driver: vpu - Qualcomm video driver
+
+RawFile: qcom/venus-5.4/venus.mbn
+Version: 5.4-00053
+File: "qcom/venus 6.0/venus.mbn"
+Link: qcom/venus-5.4/current.mbn -> venus.mbn
+
+Licence: Redistributable. See LICENSE.qcom and qcom/NOTICE.txt for details
+`,
+			want: map[string]whence.Driver{
+				"vpu": {
+					Description: "Qualcomm video driver",
+					Licenses:    []string{"LICENSE.qcom"},
+					Files: map[string]string{
+						"qcom/venus-5.4/venus.mbn":   "5.4-00053",
+						"qcom/venus 6.0/venus.mbn":   "",
+						"qcom/venus-5.4/current.mbn": "5.4-00053",
+					},
+				},
+			},
+		},
+		{
+			name: "licences referencing license IDs",
+			whence: `
+--------------------------------------------------------------------------
+
+Driver: cxgb4 - Chelsio T4/T5 10Gb Ethernet adapters
+
+File: cxgb4/t4fw.bin
+
+Licence:
+  Redistributable under the terms of GPL-2 (see GPL-2 for details), or
+  LICENCE.chelsio_firmware.
+
+--------------------------------------------------------------------------
+`,
+			want: map[string]whence.Driver{
+				"cxgb4": {
+					Description: "Chelsio T4/T5 10Gb Ethernet adapters",
+					Licenses:    []string{"GPL-2", "LICENCE.chelsio_firmware"},
+					Files: map[string]string{
+						"cxgb4/t4fw.bin": "",
+					},
+				},
+			},
+		},
+		{
+			name: "sections without files are not indexed",
+			whence: `
+--------------------------------------------------------------------------
+
+Driver: snd-korg1212 - Korg 1212 IO audio card
+
+Licence: Unknown
+
+--------------------------------------------------------------------------
+`,
+			want: map[string]whence.Driver{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWhence(strings.NewReader(tt.whence))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.Drivers)
+		})
+	}
+}
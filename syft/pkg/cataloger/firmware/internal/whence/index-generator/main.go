@@ -0,0 +1,76 @@
+// This program downloads the latest WHENCE file from the linux-firmware repository and processes it into a JSON file that
+// can be embedded into Syft for describing the firmware files installed for the Linux kernel.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+)
+
+const whenceURL = "https://git.kernel.org/pub/scm/linux/kernel/git/firmware/linux-firmware.git/plain/WHENCE"
+
+func mainE() error {
+	var outputFilename, inputFilename string
+	flag.StringVar(&outputFilename, "o", "", "file location to save WHENCE index")
+	flag.StringVar(&inputFilename, "i", "", "file location of a WHENCE file to use instead of the latest available")
+	flag.Parse()
+
+	if outputFilename == "" {
+		return errors.New("-o is required")
+	}
+
+	var reader io.Reader
+	if inputFilename != "" {
+		f, err := os.Open(inputFilename)
+		if err != nil {
+			return fmt.Errorf("unable to open WHENCE file: %w", err)
+		}
+		defer f.Close()
+		reader = f
+	} else {
+		fmt.Println("Fetching WHENCE file...")
+		resp, err := http.Get(whenceURL)
+		if err != nil {
+			return fmt.Errorf("unable to get WHENCE file: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unable to get WHENCE file: %s", resp.Status)
+		}
+		reader = resp.Body
+	}
+
+	fmt.Println("Generating index...")
+	indexJSON, err := generateIndexJSON(reader)
+	if err != nil {
+		return err
+	}
+
+	// Write WHENCE index (JSON data) to disk
+	err = os.WriteFile(outputFilename, indexJSON, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to write WHENCE index to file: %w", err)
+	}
+
+	fmt.Println("Done!")
+
+	return nil
+}
+
+// errExit prints an error and exits with a non-zero exit code.
+func errExit(err error) {
+	log.Printf("command failed: %s", err)
+	os.Exit(1)
+}
+
+func main() {
+	if err := mainE(); err != nil {
+		errExit(err)
+	}
+}
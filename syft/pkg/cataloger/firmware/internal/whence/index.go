@@ -0,0 +1,33 @@
+package whence
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+//go:embed data/whence-index.json
+var indexData []byte
+
+var (
+	index     *Index
+	indexErr  error
+	indexOnce sync.Once
+)
+
+// Get returns the embedded index of firmware files described by the linux-firmware WHENCE file.
+func Get() (*Index, error) {
+	indexOnce.Do(func() {
+		if err := json.Unmarshal(indexData, &index); err != nil {
+			indexErr = fmt.Errorf("unable to unmarshal linux-firmware WHENCE index: %w", err)
+			return
+		}
+		if index == nil {
+			indexErr = fmt.Errorf("linux-firmware WHENCE index is empty")
+			return
+		}
+		index.indexFiles()
+	})
+	return index, indexErr
+}
@@ -0,0 +1,43 @@
+package whence
+
+// Index is an index of the firmware files described by the linux-firmware WHENCE file, keyed by the name of the driver
+// each file is loaded by.
+type Index struct {
+	Drivers map[string]Driver `json:"drivers"`
+
+	// driversByFile is the name of the driver for each firmware file path
+	driversByFile map[string]string
+}
+
+// Driver describes the firmware files loaded by a single driver, along with the licenses they are distributed under.
+type Driver struct {
+	// Description is the description of the driver (e.g. "Intel Wireless Wifi" for iwlwifi).
+	Description string `json:"description,omitempty"`
+
+	// Licenses are the licenses the firmware files are distributed under, being either the names of license files within
+	// the linux-firmware repository (e.g. "LICENCE.iwlwifi_firmware") or license IDs.
+	Licenses []string `json:"licenses,omitempty"`
+
+	// Files are the versions of the firmware files (empty when not stated), keyed by the path of each file relative to
+	// the firmware directory (e.g. "rtl_nic/rtl8168h-2.fw").
+	Files map[string]string `json:"files"`
+}
+
+// DriverOf returns the name of the driver that loads the firmware file at the given path (relative to the firmware
+// directory), when the file is described by the index.
+func (i *Index) DriverOf(p string) (string, bool) {
+	if i == nil {
+		return "", false
+	}
+	name, ok := i.driversByFile[p]
+	return name, ok
+}
+
+func (i *Index) indexFiles() {
+	i.driversByFile = make(map[string]string)
+	for name, d := range i.Drivers {
+		for f := range d.Files {
+			i.driversByFile[f] = name
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package firmware
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/firmware/internal/whence"
+)
+
+const (
+	linuxFirmwareCatalogerName = "linux-firmware-cataloger"
+
+	// linuxFirmwareDir is the directory component that firmware files are installed within (e.g. /lib/firmware or
+	// /usr/lib/firmware)
+	linuxFirmwareDir = "/firmware/"
+)
+
+// firmwareCompressionExtensions are the extensions of firmware files compressed by distributions, which are loaded by
+// the kernel under the name of the uncompressed file.
+var firmwareCompressionExtensions = []string{".xz", ".zst"}
+
+// linuxFirmwareCataloger finds the firmware files installed for Linux kernel drivers, describing the firmware loaded by
+// each driver (along with its licenses) from the linux-firmware WHENCE file rather than by each individual file.
+type linuxFirmwareCataloger struct{}
+
+// driverFirmware is the firmware found for a driver within a single firmware directory.
+type driverFirmware struct {
+	driver    string
+	locations []file.Location
+	files     map[string]string
+}
+
+func (c *linuxFirmwareCataloger) Name() string {
+	return linuxFirmwareCatalogerName
+}
+
+func (c *linuxFirmwareCataloger) Catalog(ctx context.Context, resolver file.Resolver) ([]pkg.Package, []artifact.Relationship, error) {
+	index, err := whence.Get()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to load linux-firmware WHENCE index: %w", err)
+	}
+
+	// note: we only need the paths of the files (not their contents), so we match all paths against the index
+	// rather than using globs, which also allows for scanning a firmware directory directly (e.g. /lib/firmware)
+	found := make(map[string]*driverFirmware)
+	var keys []string
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for location := range resolver.AllLocations(ctx) {
+		root, rel, driver, ok := findLinuxFirmware(index, location.RealPath)
+		if !ok {
+			continue
+		}
+
+		key := root + ":" + driver
+		f, ok := found[key]
+		if !ok {
+			f = &driverFirmware{driver: driver, files: make(map[string]string)}
+			found[key] = f
+			keys = append(keys, key)
+		}
+		f.locations = append(f.locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		f.files[rel] = index.Drivers[driver].Files[rel]
+	}
+
+	sort.Strings(keys)
+
+	var pkgs []pkg.Package
+	for _, key := range keys {
+		f := found[key]
+		d := index.Drivers[f.driver]
+
+		entry := pkg.LinuxFirmwareEntry{
+			Description: d.Description,
+		}
+		var paths []string
+		for rel := range f.files {
+			paths = append(paths, rel)
+		}
+		sort.Strings(paths)
+		for _, rel := range paths {
+			entry.Files = append(entry.Files, pkg.LinuxFirmwareFile{Path: rel, Version: f.files[rel]})
+		}
+
+		pkgs = append(pkgs, newLinuxFirmwarePackage(f.driver, entry, d.Licenses, f.locations...))
+	}
+
+	return pkgs, nil, nil
+}
+
+// findLinuxFirmware returns the firmware directory, the path relative to the firmware directory, and the driver of the
+// firmware file at the given path, when the file is described by the index. Files directly within the root are
+// considered as well, as is the case when scanning a firmware directory.
+func findLinuxFirmware(index *whence.Index, p string) (string, string, string, bool) {
+	for _, ext := range firmwareCompressionExtensions {
+		p = strings.TrimSuffix(p, ext)
+	}
+
+	for offset := 0; ; {
+		i := strings.Index(p[offset:], linuxFirmwareDir)
+		if i < 0 {
+			break
+		}
+		i += offset
+		rel := p[i+len(linuxFirmwareDir):]
+		if driver, ok := index.DriverOf(rel); ok {
+			return p[:i+len(linuxFirmwareDir)-1], rel, driver, true
+		}
+		offset = i + 1
+	}
+
+	rel := strings.TrimPrefix(p, "/")
+	if driver, ok := index.DriverOf(rel); ok {
+		return "/", rel, driver, true
+	}
+
+	return "", "", "", false
+}
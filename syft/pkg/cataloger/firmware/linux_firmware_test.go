@@ -0,0 +1,139 @@
+package firmware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/firmware/internal/whence"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestLinuxFirmwareCataloger(t *testing.T) {
+	expected := []pkg.Package{
+		{
+			Name:    "i915",
+			FoundBy: "linux-firmware-cataloger",
+			Locations: file.NewLocationSet(
+				primary("lib/firmware/i915/skl_dmc_ver1.bin"),
+				primary("lib/firmware/i915/tgl_guc_70.bin.zst"),
+			),
+			Licenses: pkg.NewLicenseSet(pkg.NewLicense("LICENSE.i915")),
+			PURL:     "pkg:generic/linux-firmware/i915",
+			Type:     pkg.FirmwarePkg,
+			Metadata: pkg.LinuxFirmwareEntry{
+				Description: "Intel Integrated Graphics driver",
+				Files: []pkg.LinuxFirmwareFile{
+					{Path: "i915/skl_dmc_ver1.bin"},
+					{Path: "i915/tgl_guc_70.bin"},
+				},
+			},
+		},
+		{
+			Name:    "iwlwifi",
+			FoundBy: "linux-firmware-cataloger",
+			Locations: file.NewLocationSet(
+				primary("lib/firmware/iwlwifi-3945-2.ucode"),
+				primary("lib/firmware/iwlwifi-8265-36.ucode.xz"),
+				primary("lib/firmware/iwlwifi-cc-a0-77.ucode"),
+			),
+			Licenses: pkg.NewLicenseSet(pkg.NewLicense("LICENCE.iwlwifi_firmware")),
+			PURL:     "pkg:generic/linux-firmware/iwlwifi",
+			Type:     pkg.FirmwarePkg,
+			Metadata: pkg.LinuxFirmwareEntry{
+				Description: "Intel Wireless Wifi",
+				Files: []pkg.LinuxFirmwareFile{
+					{Path: "iwlwifi-3945-2.ucode", Version: "15.32.2.9"},
+					{Path: "iwlwifi-8265-36.ucode"},
+					{Path: "iwlwifi-cc-a0-77.ucode"},
+				},
+			},
+		},
+		{
+			// a separate firmware directory, without licenses stated by the WHENCE file
+			Name:      "tg3",
+			FoundBy:   "linux-firmware-cataloger",
+			Locations: file.NewLocationSet(primary("usr/lib/firmware/tigon/tg3.bin")),
+			PURL:      "pkg:generic/linux-firmware/tg3",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.LinuxFirmwareEntry{
+				Description: "Broadcom Tigon3 based gigabit Ethernet cards",
+				Files: []pkg.LinuxFirmwareFile{
+					{Path: "tigon/tg3.bin"},
+				},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/linux-firmware").
+		Expects(expected, nil).
+		TestCataloger(t, NewLinuxFirmwareCataloger())
+}
+
+func Test_findLinuxFirmware(t *testing.T) {
+	index, err := whence.Get()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantRoot   string
+		wantRel    string
+		wantDriver string
+		wantOK     bool
+	}{
+		{
+			name:       "within the firmware directory",
+			path:       "/lib/firmware/rtw88/rtw8822c_fw.bin",
+			wantRoot:   "/lib/firmware",
+			wantRel:    "rtw88/rtw8822c_fw.bin",
+			wantDriver: "rtw88",
+			wantOK:     true,
+		},
+		{
+			name:       "compressed file",
+			path:       "/usr/lib/firmware/brcm/brcmfmac43455-sdio.bin.xz",
+			wantRoot:   "/usr/lib/firmware",
+			wantRel:    "brcm/brcmfmac43455-sdio.bin",
+			wantDriver: "brcmfmac",
+			wantOK:     true,
+		},
+		{
+			name:       "nested firmware directory",
+			path:       "/opt/firmware/lib/firmware/amdgpu/navi10_sos.bin",
+			wantRoot:   "/opt/firmware/lib/firmware",
+			wantRel:    "amdgpu/navi10_sos.bin",
+			wantDriver: "amdgpu",
+			wantOK:     true,
+		},
+		{
+			name:       "scanning the firmware directory",
+			path:       "/ath10k/QCA6174/hw3.0/firmware-6.bin",
+			wantRoot:   "/",
+			wantRel:    "ath10k/QCA6174/hw3.0/firmware-6.bin",
+			wantDriver: "ath10k",
+			wantOK:     true,
+		},
+		{
+			name: "file not described by the index",
+			path: "/lib/firmware/regulatory.db",
+		},
+		{
+			name: "known file name outside of a firmware directory",
+			path: "/home/user/amdgpu/navi10_sos.bin",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, rel, driver, ok := findLinuxFirmware(index, tt.path)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantRoot, root)
+			assert.Equal(t, tt.wantRel, rel)
+			assert.Equal(t, tt.wantDriver, driver)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package firmware
+
+import (
+	"encoding/xml"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// fwupdMetainfo represents the AppStream metainfo file that describes firmware distributed for fwupd (e.g. by the
+// Linux Vendor Firmware Service), which accompanies the firmware (such as a capsule) within the cabinet archive it is
+// distributed in.
+type fwupdMetainfo struct {
+	DeveloperName []localizedText `xml:"developer_name"`
+	Developer     struct {
+		Name []localizedText `xml:"name"`
+	} `xml:"developer"`
+	Provides struct {
+		Firmware []string `xml:"firmware"`
+	} `xml:"provides"`
+}
+
+type localizedText struct {
+	Lang  string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+// vendor returns the name of the firmware vendor, where the untranslated name is preferred.
+func (m fwupdMetainfo) vendor() string {
+	for _, names := range [][]localizedText{m.Developer.Name, m.DeveloperName} {
+		for _, name := range names {
+			if name.Lang == "" && strings.TrimSpace(name.Value) != "" {
+				return strings.TrimSpace(name.Value)
+			}
+		}
+	}
+	return ""
+}
+
+// provides returns true when the metainfo describes firmware with any of the given GUIDs.
+func (m fwupdMetainfo) provides(guids []string) bool {
+	for _, provided := range m.Provides.Firmware {
+		for _, guid := range guids {
+			if strings.EqualFold(strings.TrimSpace(provided), guid) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findCapsuleVendor returns the vendor of the firmware delivered by a capsule, as given by an fwupd metainfo file
+// alongside the capsule that describes the firmware (matched by the image type of the FMP payloads), along with the
+// location of the metainfo file.
+func findCapsuleVendor(resolver file.Resolver, location file.Location, entry pkg.UEFIFirmwareEntry) (string, *file.Location) {
+	if resolver == nil || len(entry.Payloads) == 0 {
+		return "", nil
+	}
+
+	var guids []string
+	for _, payload := range entry.Payloads {
+		guids = append(guids, payload.ImageTypeID)
+	}
+
+	locations, err := resolver.FilesByGlob(path.Join(path.Dir(location.RealPath), "*.metainfo.xml"))
+	if err != nil {
+		log.WithFields("error", err, "path", location.RealPath).Trace("unable to find fwupd metainfo files for capsule")
+		return "", nil
+	}
+
+	for _, l := range locations {
+		metainfo, err := readFwupdMetainfo(resolver, l)
+		if err != nil {
+			log.WithFields("error", err, "path", l.RealPath).Trace("unable to read fwupd metainfo file")
+			continue
+		}
+		if !metainfo.provides(guids) {
+			continue
+		}
+		if vendor := metainfo.vendor(); vendor != "" {
+			supporting := l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+			return vendor, &supporting
+		}
+	}
+
+	return "", nil
+}
+
+func readFwupdMetainfo(resolver file.Resolver, location file.Location) (*fwupdMetainfo, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	var metainfo fwupdMetainfo
+	if err := xml.NewDecoder(reader).Decode(&metainfo); err != nil {
+		return nil, err
+	}
+	return &metainfo, nil
+}
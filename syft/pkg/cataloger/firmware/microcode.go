@@ -0,0 +1,229 @@
+package firmware
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseMicrocode
+
+const (
+	// the largest microcode file that is read (combined microcode files are typically no larger than a few MiB)
+	maxMicrocodeSize = 64 << 20
+
+	intelMicrocodeVendor = "Intel"
+	amdMicrocodeVendor   = "AMD"
+
+	intelHeaderSize         = 48
+	intelDefaultDataSize    = 2000
+	intelExtendedHeaderSize = 20
+	intelExtendedSigSize    = 12
+
+	amdEquivalenceTableType = 0
+	amdPatchType            = 1
+	amdSectionHeaderSize    = 8
+	amdEquivalenceEntrySize = 16
+	amdPatchHeaderSize      = 64
+)
+
+// amdContainerMagic leads AMD microcode container files ("DMA" followed by a NUL, as stored).
+var amdContainerMagic = []byte{'D', 'M', 'A', 0}
+
+// parseMicrocode is a parser function for CPU microcode files (Intel microcode updates and AMD microcode containers),
+// returning a package for each microcode update, versioned by the microcode revision it delivers.
+func parseMicrocode(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := readAll(reader, maxMicrocodeSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read microcode: %w", err)
+	}
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	var updates []microcodeUpdate
+	if bytes.HasPrefix(data, amdContainerMagic) {
+		updates = readAMDMicrocode(data)
+	} else {
+		updates = readIntelMicrocode(data)
+	}
+
+	location := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	for _, u := range updates {
+		// note: the revision is formatted as reported by the kernel (e.g. within /proc/cpuinfo)
+		pkgs = append(pkgs, newPackage(u.name(), fmt.Sprintf("0x%x", u.revision), u.entry, location))
+	}
+
+	return pkgs, nil, nil
+}
+
+// microcodeUpdate is a single microcode update found within a microcode file.
+type microcodeUpdate struct {
+	revision uint32
+	entry    pkg.CPUMicrocodeEntry
+}
+
+// name returns the name of the microcode update, being the vendor and the family, model, and stepping of the first
+// processor it applies to, as used to name Intel microcode files (e.g. "intel-ucode-06-8e-0c").
+func (u microcodeUpdate) name() string {
+	name := strings.ToLower(u.entry.Vendor) + "-ucode"
+	if len(u.entry.ProcessorSignatures) > 0 {
+		s := u.entry.ProcessorSignatures[0]
+		name += fmt.Sprintf("-%02x-%02x-%02x", s.Family, s.Model, s.Stepping)
+	}
+	return name
+}
+
+// readIntelMicrocode returns the Intel microcode updates found within the data, where any number of updates may be
+// concatenated. Data that does not begin with a valid update is not considered to be Intel microcode.
+func readIntelMicrocode(data []byte) []microcodeUpdate {
+	var updates []microcodeUpdate
+	for len(data) >= intelHeaderSize {
+		headerVersion := binary.LittleEndian.Uint32(data[0:4])
+		loaderRevision := binary.LittleEndian.Uint32(data[20:24])
+		dataSize := binary.LittleEndian.Uint32(data[28:32])
+		totalSize := binary.LittleEndian.Uint32(data[32:36])
+		if dataSize == 0 {
+			dataSize = intelDefaultDataSize
+			totalSize = intelDefaultDataSize + intelHeaderSize
+		}
+
+		if headerVersion != 1 || loaderRevision != 1 || dataSize%4 != 0 || totalSize < dataSize+intelHeaderSize ||
+			uint64(totalSize) > uint64(len(data)) || checksum32(data[:totalSize]) != 0 {
+			break
+		}
+
+		update := data[:totalSize]
+		u := microcodeUpdate{
+			revision: binary.LittleEndian.Uint32(update[4:8]),
+			entry: pkg.CPUMicrocodeEntry{
+				Vendor:         intelMicrocodeVendor,
+				ProcessorFlags: fmt.Sprintf("0x%x", binary.LittleEndian.Uint32(update[24:28])),
+				Date:           bcdDate(binary.LittleEndian.Uint32(update[8:12])),
+			},
+		}
+		u.entry.ProcessorSignatures = append(u.entry.ProcessorSignatures, processorSignature(binary.LittleEndian.Uint32(update[12:16])))
+		u.entry.ProcessorSignatures = append(u.entry.ProcessorSignatures, readIntelExtendedSignatures(update[intelHeaderSize+dataSize:])...)
+
+		updates = append(updates, u)
+		data = data[totalSize:]
+	}
+	return updates
+}
+
+// readIntelExtendedSignatures returns the additional processor signatures that an Intel microcode update applies to,
+// as given by the extended signature table that may follow the update data.
+func readIntelExtendedSignatures(table []byte) []pkg.CPUProcessorSignature {
+	if len(table) < intelExtendedHeaderSize {
+		return nil
+	}
+
+	count := int(binary.LittleEndian.Uint32(table[0:4]))
+	if intelExtendedHeaderSize+count*intelExtendedSigSize > len(table) {
+		return nil
+	}
+
+	var signatures []pkg.CPUProcessorSignature
+	for i := 0; i < count; i++ {
+		offset := intelExtendedHeaderSize + i*intelExtendedSigSize
+		signatures = append(signatures, processorSignature(binary.LittleEndian.Uint32(table[offset:offset+4])))
+	}
+	return signatures
+}
+
+// readAMDMicrocode returns the patches found within an AMD microcode container, being an equivalence table (mapping
+// processor signatures to the equivalence IDs that patches refer to) followed by the patches.
+func readAMDMicrocode(data []byte) []microcodeUpdate {
+	offset := len(amdContainerMagic)
+	if offset+amdSectionHeaderSize > len(data) || binary.LittleEndian.Uint32(data[offset:]) != amdEquivalenceTableType {
+		return nil
+	}
+
+	tableSize := int(binary.LittleEndian.Uint32(data[offset+4:]))
+	offset += amdSectionHeaderSize
+	if offset+tableSize > len(data) {
+		return nil
+	}
+
+	// several processors may share an equivalence ID
+	equivalent := make(map[uint16][]pkg.CPUProcessorSignature)
+	for i := offset; i+amdEquivalenceEntrySize <= offset+tableSize; i += amdEquivalenceEntrySize {
+		signature := binary.LittleEndian.Uint32(data[i : i+4])
+		if signature == 0 {
+			break
+		}
+		id := binary.LittleEndian.Uint16(data[i+12 : i+14])
+		equivalent[id] = append(equivalent[id], processorSignature(signature))
+	}
+	offset += tableSize
+
+	var updates []microcodeUpdate
+	for offset+amdSectionHeaderSize <= len(data) {
+		sectionType := binary.LittleEndian.Uint32(data[offset:])
+		size := int(binary.LittleEndian.Uint32(data[offset+4:]))
+		offset += amdSectionHeaderSize
+		if sectionType != amdPatchType || size < amdPatchHeaderSize || offset+size > len(data) {
+			break
+		}
+
+		patch := data[offset : offset+size]
+		updates = append(updates, microcodeUpdate{
+			revision: binary.LittleEndian.Uint32(patch[4:8]),
+			entry: pkg.CPUMicrocodeEntry{
+				Vendor:              amdMicrocodeVendor,
+				ProcessorSignatures: equivalent[binary.LittleEndian.Uint16(patch[24:26])],
+				Date:                bcdDate(binary.LittleEndian.Uint32(patch[0:4])),
+			},
+		})
+		offset += size
+	}
+	return updates
+}
+
+// processorSignature returns the description of a CPUID processor signature (as returned in EAX for leaf 1).
+func processorSignature(signature uint32) pkg.CPUProcessorSignature {
+	family := (signature >> 8) & 0xf
+	model := (signature >> 4) & 0xf
+	if family == 0x6 || family == 0xf {
+		model |= ((signature >> 16) & 0xf) << 4
+	}
+	if family == 0xf {
+		family += (signature >> 20) & 0xff
+	}
+
+	return pkg.CPUProcessorSignature{
+		Signature: fmt.Sprintf("0x%08x", signature),
+		Family:    int(family),
+		Model:     int(model),
+		Stepping:  int(signature & 0xf),
+	}
+}
+
+// bcdDate returns the date of a microcode update, stored as binary-coded decimal digits in the form 0xMMDDYYYY (or an
+// empty string when the date is not valid).
+func bcdDate(d uint32) string {
+	s := fmt.Sprintf("%04x-%02x-%02x", d&0xffff, d>>24, (d>>16)&0xff)
+	if _, err := time.Parse(time.DateOnly, s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// checksum32 returns the sum of the little-endian 32-bit words of the data.
+func checksum32(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i+3 < len(b); i += 4 {
+		sum += binary.LittleEndian.Uint32(b[i : i+4])
+	}
+	return sum
+}
@@ -0,0 +1,125 @@
+package firmware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_MicrocodeCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"lib/firmware/intel-ucode/06-8e-0c",
+			"lib/firmware/amd-ucode/microcode_amd_fam19h.bin",
+			"initramfs/kernel/x86/microcode/GenuineIntel.bin",
+			"initramfs/kernel/x86/microcode/AuthenticAMD.bin",
+		}).
+		TestCataloger(t, NewMicrocodeCataloger())
+}
+
+func TestMicrocodeCataloger(t *testing.T) {
+	intel8e := primary("lib/firmware/intel-ucode/06-8e-0c")
+	intel9e := primary("lib/firmware/intel-ucode/06-9e-0a")
+	amd := primary("lib/firmware/amd-ucode/microcode_amd_fam17h.bin")
+
+	expected := []pkg.Package{
+		{
+			// an update with an extended signature table
+			Name:      "intel-ucode-06-8e-0c",
+			Version:   "0xf4",
+			FoundBy:   "cpu-microcode-cataloger",
+			Locations: file.NewLocationSet(intel8e),
+			PURL:      "pkg:generic/intel-ucode-06-8e-0c@0xf4",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.CPUMicrocodeEntry{
+				Vendor: "Intel",
+				ProcessorSignatures: []pkg.CPUProcessorSignature{
+					{Signature: "0x000806ec", Family: 6, Model: 0x8e, Stepping: 0xc},
+					{Signature: "0x000806eb", Family: 6, Model: 0x8e, Stepping: 0xb},
+				},
+				ProcessorFlags: "0x94",
+				Date:           "2023-02-22",
+			},
+		},
+		{
+			// the same revision for different platforms, within a single file
+			Name:      "intel-ucode-06-9e-0a",
+			Version:   "0xf8",
+			FoundBy:   "cpu-microcode-cataloger",
+			Locations: file.NewLocationSet(intel9e),
+			PURL:      "pkg:generic/intel-ucode-06-9e-0a@0xf8",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.CPUMicrocodeEntry{
+				Vendor: "Intel",
+				ProcessorSignatures: []pkg.CPUProcessorSignature{
+					{Signature: "0x000906ea", Family: 6, Model: 0x9e, Stepping: 0xa},
+				},
+				ProcessorFlags: "0x22",
+				Date:           "2023-03-01",
+			},
+		},
+		{
+			Name:      "intel-ucode-06-9e-0a",
+			Version:   "0xf8",
+			FoundBy:   "cpu-microcode-cataloger",
+			Locations: file.NewLocationSet(intel9e),
+			PURL:      "pkg:generic/intel-ucode-06-9e-0a@0xf8",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.CPUMicrocodeEntry{
+				Vendor: "Intel",
+				ProcessorSignatures: []pkg.CPUProcessorSignature{
+					{Signature: "0x000906ea", Family: 6, Model: 0x9e, Stepping: 0xa},
+				},
+				ProcessorFlags: "0x2",
+				Date:           "2023-03-01",
+			},
+		},
+		{
+			Name:      "amd-ucode-17-31-00",
+			Version:   "0x830107a",
+			FoundBy:   "cpu-microcode-cataloger",
+			Locations: file.NewLocationSet(amd),
+			PURL:      "pkg:generic/amd-ucode-17-31-00@0x830107a",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.CPUMicrocodeEntry{
+				Vendor: "AMD",
+				ProcessorSignatures: []pkg.CPUProcessorSignature{
+					{Signature: "0x00830f10", Family: 0x17, Model: 0x31, Stepping: 0},
+				},
+				Date: "2023-07-18",
+			},
+		},
+		{
+			Name:      "amd-ucode-17-60-01",
+			Version:   "0x8600106",
+			FoundBy:   "cpu-microcode-cataloger",
+			Locations: file.NewLocationSet(amd),
+			PURL:      "pkg:generic/amd-ucode-17-60-01@0x8600106",
+			Type:      pkg.FirmwarePkg,
+			Metadata: pkg.CPUMicrocodeEntry{
+				Vendor: "AMD",
+				ProcessorSignatures: []pkg.CPUProcessorSignature{
+					{Signature: "0x00860f01", Family: 0x17, Model: 0x60, Stepping: 1},
+				},
+				Date: "2022-11-22",
+			},
+		},
+	}
+
+	// note: the update with an invalid checksum (06-a7-01) is not reported
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/microcode").
+		Expects(expected, nil).
+		TestCataloger(t, NewMicrocodeCataloger())
+}
+
+func Test_bcdDate(t *testing.T) {
+	assert.Equal(t, "2003-07-23", bcdDate(0x07232003))
+	assert.Empty(t, bcdDate(0x13012020))
+	assert.Empty(t, bcdDate(0x0a012020))
+}
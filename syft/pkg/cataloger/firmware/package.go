@@ -0,0 +1,63 @@
+package firmware
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const linuxFirmwareNamespace = "linux-firmware"
+
+func newPackage(name, version string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL("", name, version),
+		Type:      pkg.FirmwarePkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newLinuxFirmwarePackage(driver string, entry pkg.LinuxFirmwareEntry, licenses []string, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      driver,
+		FoundBy:   linuxFirmwareCatalogerName,
+		Locations: file.NewLocationSet(locations...),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromValues(licenses...)...),
+		PURL:      packageURL(linuxFirmwareNamespace, driver, ""),
+		Type:      pkg.FirmwarePkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// imageName returns the name of the firmware image at the given path, being the name of the file without its extension
+// (e.g. "bcm2711-rpi-4-b" for "bcm2711-rpi-4-b.dtb").
+func imageName(p string) string {
+	name := path.Base(p)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+// packageURL returns the package URL for a firmware image, where firmware described by the linux-firmware WHENCE file
+// is namespaced by the repository (e.g. "pkg:generic/linux-firmware/iwlwifi").
+func packageURL(namespace, name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.FirmwarePkg.PackageURLType(),
+		namespace,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,100 @@
+package firmware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parseUEFIFirmware
+	_ generic.Parser = parseDeviceTree
+)
+
+const (
+	// the largest firmware image that is read (flash images are typically no larger than 64 MiB)
+	maxFirmwareSize = 256 << 20
+
+	// the largest device tree blob that is read
+	maxDeviceTreeSize = 16 << 20
+)
+
+// parseUEFIFirmware is a parser function for UEFI capsules and images holding firmware volumes, returning a package
+// versioned by the FMP payload delivered by a capsule (when stated), along with the vendor of the firmware when
+// described by an accompanying fwupd metainfo file.
+func parseUEFIFirmware(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := readAll(reader, maxFirmwareSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read UEFI firmware: %w", err)
+	}
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	entry := readUEFIFirmware(data)
+	if entry == nil {
+		return nil, nil, nil
+	}
+
+	var version string
+	for _, payload := range entry.Payloads {
+		if payload.Version != 0 {
+			version = strconv.FormatUint(uint64(payload.Version), 10)
+			break
+		}
+	}
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+	if vendor, location := findCapsuleVendor(resolver, reader.Location, *entry); location != nil {
+		entry.Vendor = vendor
+		locations = append(locations, *location)
+	}
+
+	return []pkg.Package{
+		newPackage(imageName(reader.Path()), version, *entry, locations...),
+	}, nil, nil
+}
+
+// parseDeviceTree is a parser function for flattened device tree blobs and overlays, returning a package described by
+// the root node of the tree.
+func parseDeviceTree(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := readAll(reader, maxDeviceTreeSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read device tree blob: %w", err)
+	}
+	if data == nil {
+		return nil, nil, nil
+	}
+
+	entry, err := readDeviceTree(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse device tree blob: %w", err)
+	}
+	if entry == nil {
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newPackage(imageName(reader.Path()), "", *entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// readAll returns the contents of the file, or nil when the file is larger than the given limit.
+func readAll(reader file.LocationReadCloser, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		log.WithFields("path", reader.RealPath, "limit", limit).Debug("firmware image is too large to catalog")
+		return nil, nil
+	}
+	return data, nil
+}
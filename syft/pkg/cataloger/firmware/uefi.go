@@ -0,0 +1,431 @@
+package firmware
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// UEFI structures, from the UEFI specification (capsules and FMP) and the Platform Initialization specification
+// (firmware volumes, files, and sections)
+const (
+	capsuleHeaderSize = 28
+
+	fmpCapsuleHeaderSize = 8
+	fmpImageHeaderSize   = 32 // version 1, version 2 and 3 append the hardware instance and capsule support fields
+	fmpPayloadHeaderSize = 16
+
+	fvHeaderSize      = 56 // up to the block map
+	fvExtHeaderSize   = 20
+	fvSignatureOffset = 40
+
+	ffsHeaderSize      = 24
+	ffsLargeHeaderSize = 32
+
+	sectionHeaderSize      = 4
+	sectionLargeHeaderSize = 8
+)
+
+const (
+	// fvbErasePolarity is the firmware volume attribute stating that erased bits are set (so that file states are inverted)
+	fvbErasePolarity = 0x800
+
+	ffsAttribLargeFile = 0x01
+	ffsFileTypePad     = 0xf0
+
+	ffsFileDataValid     = 0x04
+	ffsFileDeleted       = 0x10
+	ffsFileHeaderInvalid = 0x20
+
+	sectionVersion       = 0x14
+	sectionUserInterface = 0x15
+
+	winCertRevision     = 0x0200
+	winCertTypeEFIGUID  = 0x0ef1
+	fmpPayloadSignature = 0x3153534d // "MSS1"
+)
+
+const (
+	capsuleFormat        = "capsule"
+	firmwareVolumeFormat = "firmware-volume"
+)
+
+const (
+	fmpCapsuleGUID = "6dcbd5ed-e82d-4c44-bda1-7194199ad92a"
+)
+
+var fvSignature = []byte("_FVH")
+
+// capsuleGUIDs are the GUIDs of well-known capsule types, where other capsules are only recognized when holding
+// firmware volumes.
+var capsuleGUIDs = map[string]bool{
+	fmpCapsuleGUID:                         true,
+	"3b6686bd-0d76-4030-b70e-b5519e2fc5a0": true, // EFI_CAPSULE_GUID
+	"4a3ca68b-7723-48fb-803d-578cc1fec44d": true, // AMI Aptio signed capsule
+	"14eebb90-890a-43db-aed1-5d3c4588a418": true, // AMI Aptio unsigned capsule
+}
+
+// ffsFileSystemGUIDs are the file systems of firmware volumes that hold firmware files (FFSv2 and FFSv3).
+var ffsFileSystemGUIDs = map[string]bool{
+	"8c8ce578-8a3d-4f1c-9935-896185c32dd3": true,
+	"5473c07a-3dcb-4dca-bd6f-1e9689e7349a": true,
+}
+
+// ffsFileTypes are the names of the types of firmware files (EFI_FV_FILETYPE_*).
+var ffsFileTypes = map[byte]string{
+	0x01: "RAW",
+	0x02: "FREEFORM",
+	0x03: "SECURITY_CORE",
+	0x04: "PEI_CORE",
+	0x05: "DXE_CORE",
+	0x06: "PEIM",
+	0x07: "DRIVER",
+	0x08: "COMBINED_PEIM_DRIVER",
+	0x09: "APPLICATION",
+	0x0a: "MM",
+	0x0b: "FIRMWARE_VOLUME_IMAGE",
+	0x0c: "COMBINED_MM_DXE",
+	0x0d: "MM_CORE",
+	0x0e: "MM_STANDALONE",
+	0x0f: "MM_CORE_STANDALONE",
+}
+
+// readUEFIFirmware returns the description of a UEFI capsule or of an image holding firmware volumes (or nil when the
+// data is neither).
+func readUEFIFirmware(data []byte) *pkg.UEFIFirmwareEntry {
+	if entry := readCapsule(data); entry != nil {
+		return entry
+	}
+
+	volumes := readFirmwareVolumes(data)
+	if len(volumes) == 0 {
+		return nil
+	}
+	return &pkg.UEFIFirmwareEntry{
+		Format:  firmwareVolumeFormat,
+		Volumes: volumes,
+	}
+}
+
+// readCapsule returns the description of a UEFI capsule, along with the FMP payloads it delivers and the firmware
+// volumes found within its body (or nil when the data is not a capsule).
+func readCapsule(data []byte) *pkg.UEFIFirmwareEntry {
+	if len(data) < capsuleHeaderSize {
+		return nil
+	}
+
+	guid := guidString(data[0:16])
+	headerSize := binary.LittleEndian.Uint32(data[16:20])
+	imageSize := binary.LittleEndian.Uint32(data[24:28])
+	if headerSize < capsuleHeaderSize || imageSize < headerSize || uint64(imageSize) > uint64(len(data)) {
+		return nil
+	}
+
+	body := data[headerSize:imageSize]
+	volumes := readFirmwareVolumes(body)
+	if !capsuleGUIDs[guid] && len(volumes) == 0 {
+		return nil
+	}
+
+	entry := &pkg.UEFIFirmwareEntry{
+		Format:      capsuleFormat,
+		CapsuleGUID: guid,
+		Volumes:     volumes,
+	}
+	if guid == fmpCapsuleGUID {
+		entry.Payloads = readFMPPayloads(body)
+	}
+	return entry
+}
+
+// readFMPPayloads returns the firmware images delivered by the body of an FMP capsule (embedded drivers are skipped).
+func readFMPPayloads(data []byte) []pkg.UEFICapsulePayload {
+	if len(data) < fmpCapsuleHeaderSize || binary.LittleEndian.Uint32(data[0:4]) != 1 {
+		return nil
+	}
+
+	drivers := int(binary.LittleEndian.Uint16(data[4:6]))
+	count := drivers + int(binary.LittleEndian.Uint16(data[6:8]))
+	if fmpCapsuleHeaderSize+count*8 > len(data) {
+		return nil
+	}
+
+	var payloads []pkg.UEFICapsulePayload
+	for i := drivers; i < count; i++ {
+		offset := binary.LittleEndian.Uint64(data[fmpCapsuleHeaderSize+i*8:])
+		if offset >= uint64(len(data)) {
+			continue
+		}
+		if payload, ok := readFMPImage(data[offset:]); ok {
+			payloads = append(payloads, payload)
+		}
+	}
+	return payloads
+}
+
+// readFMPImage returns the description of a firmware image within an FMP capsule, where the version of the firmware is
+// taken from the FMP payload header that leads the image (following the authentication information, when signed).
+func readFMPImage(data []byte) (pkg.UEFICapsulePayload, bool) {
+	if len(data) < fmpImageHeaderSize {
+		return pkg.UEFICapsulePayload{}, false
+	}
+
+	version := binary.LittleEndian.Uint32(data[0:4])
+	payload := pkg.UEFICapsulePayload{
+		ImageTypeID: guidString(data[4:20]),
+		ImageIndex:  data[20],
+	}
+
+	headerSize := fmpImageHeaderSize
+	switch {
+	case version == 0:
+		return pkg.UEFICapsulePayload{}, false
+	case version >= 3:
+		headerSize += 16
+	case version == 2:
+		headerSize += 8
+	}
+	if len(data) < headerSize {
+		return pkg.UEFICapsulePayload{}, false
+	}
+	if version >= 2 {
+		payload.HardwareInstance = binary.LittleEndian.Uint64(data[32:40])
+	}
+
+	imageSize := uint64(binary.LittleEndian.Uint32(data[24:28]))
+	image := data[headerSize:]
+	if imageSize < uint64(len(image)) {
+		image = image[:imageSize]
+	}
+
+	image = skipImageAuthentication(image)
+	if len(image) >= fmpPayloadHeaderSize && binary.LittleEndian.Uint32(image[0:4]) == fmpPayloadSignature {
+		payload.Version = binary.LittleEndian.Uint32(image[8:12])
+		payload.LowestSupportedVersion = binary.LittleEndian.Uint32(image[12:16])
+	}
+
+	return payload, true
+}
+
+// skipImageAuthentication returns the image following the authentication information of a signed firmware image
+// (EFI_FIRMWARE_IMAGE_AUTHENTICATION, being a monotonic count followed by a WIN_CERTIFICATE_UEFI_GUID).
+func skipImageAuthentication(image []byte) []byte {
+	if len(image) < 16 {
+		return image
+	}
+
+	certLength := uint64(binary.LittleEndian.Uint32(image[8:12]))
+	revision := binary.LittleEndian.Uint16(image[12:14])
+	certType := binary.LittleEndian.Uint16(image[14:16])
+	if revision != winCertRevision || certType != winCertTypeEFIGUID || 8+certLength > uint64(len(image)) {
+		return image
+	}
+	return image[8+certLength:]
+}
+
+// readFirmwareVolumes returns the firmware volumes found anywhere within the data (such as within a flash image or the
+// body of a capsule), including volumes nested within the files of other volumes when not compressed.
+func readFirmwareVolumes(data []byte) []pkg.UEFIFirmwareVolume {
+	var volumes []pkg.UEFIFirmwareVolume
+	for offset := 0; offset < len(data); {
+		idx := bytes.Index(data[offset:], fvSignature)
+		if idx < 0 {
+			break
+		}
+		signature := offset + idx
+		if start := signature - fvSignatureOffset; start >= 0 {
+			if volume, ok := readFirmwareVolume(data[start:]); ok {
+				volumes = append(volumes, volume)
+			}
+		}
+		offset = signature + len(fvSignature)
+	}
+	return volumes
+}
+
+// readFirmwareVolume returns the description of the firmware volume at the start of the data, which is only
+// recognized when the header checksum is valid.
+func readFirmwareVolume(data []byte) (pkg.UEFIFirmwareVolume, bool) {
+	if len(data) < fvHeaderSize {
+		return pkg.UEFIFirmwareVolume{}, false
+	}
+
+	length := binary.LittleEndian.Uint64(data[32:40])
+	attributes := binary.LittleEndian.Uint32(data[44:48])
+	headerLength := int(binary.LittleEndian.Uint16(data[48:50]))
+	extHeaderOffset := int(binary.LittleEndian.Uint16(data[52:54]))
+	if headerLength < fvHeaderSize || headerLength%2 != 0 || uint64(headerLength) > length || length > uint64(len(data)) {
+		return pkg.UEFIFirmwareVolume{}, false
+	}
+	if checksum16(data[:headerLength]) != 0 {
+		return pkg.UEFIFirmwareVolume{}, false
+	}
+
+	data = data[:length]
+	volume := pkg.UEFIFirmwareVolume{
+		FileSystemGUID: guidString(data[16:32]),
+		Size:           length,
+	}
+
+	filesOffset := headerLength
+	if extHeaderOffset != 0 && extHeaderOffset+fvExtHeaderSize <= len(data) {
+		volume.GUID = guidString(data[extHeaderOffset : extHeaderOffset+16])
+		filesOffset = extHeaderOffset + int(binary.LittleEndian.Uint32(data[extHeaderOffset+16:extHeaderOffset+20]))
+	}
+
+	if ffsFileSystemGUIDs[volume.FileSystemGUID] {
+		volume.Files = readFirmwareFiles(data, align(filesOffset, 8), attributes&fvbErasePolarity != 0)
+	}
+
+	return volume, true
+}
+
+// readFirmwareFiles returns the firmware files of a volume, starting from the given offset within the volume and ending
+// at the free space of the volume. Padding files and files that are not valid (e.g. deleted) are not included.
+func readFirmwareFiles(volume []byte, offset int, erasePolarity bool) []pkg.UEFIFirmwareFile {
+	var files []pkg.UEFIFirmwareFile
+	for offset+ffsHeaderSize <= len(volume) {
+		header := volume[offset : offset+ffsHeaderSize]
+		if isErased(header, erasePolarity) {
+			break
+		}
+
+		size := uint64(uint24(header[20:23]))
+		headerSize := ffsHeaderSize
+		if header[19]&ffsAttribLargeFile != 0 {
+			if offset+ffsLargeHeaderSize > len(volume) {
+				break
+			}
+			size = binary.LittleEndian.Uint64(volume[offset+ffsHeaderSize : offset+ffsLargeHeaderSize])
+			headerSize = ffsLargeHeaderSize
+		}
+		if size < uint64(headerSize) || size > uint64(len(volume)-offset) {
+			break
+		}
+
+		fileType := header[18]
+		state := header[23]
+		if erasePolarity {
+			state = ^state
+		}
+
+		if fileType != ffsFileTypePad && isValidFileState(state) {
+			f := pkg.UEFIFirmwareFile{
+				GUID: guidString(header[0:16]),
+				Type: fileTypeName(fileType),
+			}
+			f.Name, f.Version = readFileSections(volume[offset+headerSize : offset+int(size)])
+			files = append(files, f)
+		}
+
+		offset = align(offset+int(size), 8)
+	}
+	return files
+}
+
+// readFileSections returns the name and version of a module, as given by the user interface and version sections of a
+// firmware file (sections within encapsulation sections, such as compressed sections, are not read).
+func readFileSections(data []byte) (string, string) {
+	var name, version string
+	for offset := 0; offset+sectionHeaderSize <= len(data); {
+		size := uint64(uint24(data[offset : offset+3]))
+		headerSize := sectionHeaderSize
+		if size == 0xffffff {
+			if offset+sectionLargeHeaderSize > len(data) {
+				break
+			}
+			size = uint64(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+			headerSize = sectionLargeHeaderSize
+		}
+		if size < uint64(headerSize) || size > uint64(len(data)-offset) {
+			break
+		}
+
+		body := data[offset+headerSize : offset+int(size)]
+		switch data[offset+3] {
+		case sectionUserInterface:
+			name = ucs2String(body)
+		case sectionVersion:
+			if len(body) >= 2 {
+				version = ucs2String(body[2:])
+				if build := binary.LittleEndian.Uint16(body[0:2]); version == "" && build != 0 {
+					version = strconv.Itoa(int(build))
+				}
+			}
+		}
+
+		offset = align(offset+int(size), 4)
+	}
+	return name, version
+}
+
+func fileTypeName(t byte) string {
+	if name, ok := ffsFileTypes[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02x", t)
+}
+
+// isValidFileState returns true when the file data is valid and the file is neither deleted nor has an invalid header.
+func isValidFileState(state byte) bool {
+	return state&ffsFileDataValid != 0 && state&(ffsFileDeleted|ffsFileHeaderInvalid) == 0
+}
+
+// isErased returns true when the data is free space within a firmware volume (all bits are the erase polarity).
+func isErased(data []byte, erasePolarity bool) bool {
+	erased := byte(0x00)
+	if erasePolarity {
+		erased = 0xff
+	}
+	for _, b := range data {
+		if b != erased {
+			return false
+		}
+	}
+	return true
+}
+
+// guidString returns the textual form of a GUID stored in the mixed-endian layout used by UEFI.
+func guidString(b []byte) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10],
+		b[10:16],
+	)
+}
+
+// ucs2String returns the string of NUL-terminated UCS-2 (little-endian) data.
+func ucs2String(b []byte) string {
+	var chars []uint16
+	for i := 0; i+1 < len(b); i += 2 {
+		c := binary.LittleEndian.Uint16(b[i : i+2])
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return string(utf16.Decode(chars))
+}
+
+// checksum16 returns the sum of the little-endian 16-bit words of the data.
+func checksum16(b []byte) uint16 {
+	var sum uint16
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += binary.LittleEndian.Uint16(b[i : i+2])
+	}
+	return sum
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
+
+func align(offset, alignment int) int {
+	return (offset + alignment - 1) &^ (alignment - 1)
+}
@@ -0,0 +1,41 @@
+package firmware
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_guidString(t *testing.T) {
+	// EFI_FIRMWARE_MANAGEMENT_CAPSULE_ID_GUID as stored within a capsule
+	b := []byte{0xed, 0xd5, 0xcb, 0x6d, 0x2d, 0xe8, 0x44, 0x4c, 0xbd, 0xa1, 0x71, 0x94, 0x19, 0x9a, 0xd9, 0x2a}
+	assert.Equal(t, "6dcbd5ed-e82d-4c44-bda1-7194199ad92a", guidString(b))
+}
+
+func Test_readFirmwareVolumes_InvalidChecksum(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/uefi/OVMF_CODE.fd")
+	require.NoError(t, err)
+	require.Len(t, readFirmwareVolumes(data), 1)
+
+	// the volume starts after 512 bytes of free space, corrupt the attributes of the volume header
+	data[512+44] ^= 0x01
+	assert.Empty(t, readFirmwareVolumes(data))
+}
+
+func Test_readFMPImage_Unsigned(t *testing.T) {
+	// a version 1 image header followed by the FMP payload header (without authentication information)
+	data := make([]byte, fmpImageHeaderSize+fmpPayloadHeaderSize)
+	data[0] = 1
+	data[20] = 2
+	data[24] = fmpPayloadHeaderSize
+	copy(data[fmpImageHeaderSize:], []byte{'M', 'S', 'S', '1', 16, 0, 0, 0, 7, 0, 0, 0, 3, 0, 0, 0})
+
+	payload, ok := readFMPImage(data)
+	require.True(t, ok)
+	assert.Equal(t, uint8(2), payload.ImageIndex)
+	assert.Equal(t, uint32(7), payload.Version)
+	assert.Equal(t, uint32(3), payload.LowestSupportedVersion)
+	assert.Zero(t, payload.HardwareInstance)
+}
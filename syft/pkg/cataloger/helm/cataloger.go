@@ -0,0 +1,27 @@
+/*
+Package helm provides concrete Cataloger implementations for Helm charts, both unpacked and packaged as chart archives,
+as well as the chart dependencies resolved within Chart.lock files.
+*/
+package helm
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewChartCataloger returns a new cataloger object for Helm charts (Chart.yaml files and packaged chart archives),
+// including vendored subcharts.
+func NewChartCataloger(cfg CatalogerConfig) pkg.Cataloger {
+	p := newChartParser(cfg)
+	return generic.NewCataloger("helm-chart-cataloger").
+		WithParserByGlobs(p.parseChartYAML, "**/Chart.yaml").
+		WithParserByGlobs(p.parseChartArchive, "**/*.tgz").
+		WithProcessors(dependency.Processor(dependencySpecifier))
+}
+
+// NewLockCataloger returns a new cataloger object for the chart dependencies resolved within Chart.lock files.
+func NewLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("helm-chart-lock-cataloger").
+		WithParserByGlobs(parseChartLock, "**/Chart.lock")
+}
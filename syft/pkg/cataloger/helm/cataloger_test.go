@@ -0,0 +1,184 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestChartCataloger(t *testing.T) {
+	myappLocation := file.NewLocation("myapp/Chart.yaml")
+	valuesLocation := file.NewLocation("myapp/values.yaml")
+	postgresqlLocation := file.NewLocation("myapp/charts/postgresql-15.5.0.tgz")
+	redisLocation := file.NewLocation("myapp/charts/redis/Chart.yaml")
+
+	myappMetadata := pkg.HelmChartMetadata{
+		APIVersion:  "v2",
+		Type:        "application",
+		AppVersion:  "2.8.0",
+		Description: "A Helm chart for the example application",
+		Home:        "https://example.com/myapp",
+		Sources:     []string{"https://github.com/example/myapp"},
+		Maintainers: []pkg.HelmChartMaintainer{
+			{Name: "Platform Team", Email: "platform@example.com"},
+		},
+		Dependencies: []pkg.HelmChartDependency{
+			{Name: "postgresql", Version: "~15.5.0", Repository: "https://charts.bitnami.com/bitnami"},
+			{Name: "redis", Version: "19.x.x", Repository: "file://charts/redis"},
+		},
+	}
+
+	postgresqlMetadata := pkg.HelmChartMetadata{
+		APIVersion:  "v2",
+		AppVersion:  "16.3.0",
+		Description: "PostgreSQL (Postgres) is an open source object-relational database.",
+		Home:        "https://bitnami.com",
+		Maintainers: []pkg.HelmChartMaintainer{
+			{Name: "Broadcom, Inc. All Rights Reserved.", URL: "https://github.com/bitnami/charts"},
+		},
+		Dependencies: []pkg.HelmChartDependency{
+			{Name: "common", Version: "2.x.x", Repository: "oci://registry-1.docker.io/bitnamicharts"},
+		},
+	}
+
+	newChartPackage := func(name, version string, metadata pkg.HelmChartMetadata, locations ...file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "helm-chart-cataloger",
+			Locations: file.NewLocationSet(locations...),
+			PURL:      "pkg:helm/" + name + "@" + version,
+			Type:      pkg.HelmChartPkg,
+			Metadata:  metadata,
+		}
+	}
+
+	redis := newChartPackage("redis", "19.6.4", pkg.HelmChartMetadata{
+		APIVersion:  "v2",
+		AppVersion:  "7.2.5",
+		Description: "Redis(R) is an open source, advanced key-value store.",
+	}, redisLocation)
+
+	expectedRelationships := func(myapp, postgresql pkg.Package) []artifact.Relationship {
+		return []artifact.Relationship{
+			{
+				From: postgresql,
+				To:   myapp,
+				Type: artifact.DependencyOfRelationship,
+			},
+			{
+				From: redis,
+				To:   myapp,
+				Type: artifact.DependencyOfRelationship,
+			},
+		}
+	}
+
+	t.Run("without images", func(t *testing.T) {
+		// note: archives that are not charts (or are not valid archives at all) are ignored
+		myapp := newChartPackage("myapp", "1.4.2", myappMetadata, myappLocation)
+		postgresql := newChartPackage("postgresql", "15.5.0", postgresqlMetadata, postgresqlLocation)
+
+		pkgtest.NewCatalogTester().
+			FromDirectory(t, "test-fixtures/charts").
+			Expects([]pkg.Package{myapp, postgresql, redis}, expectedRelationships(myapp, postgresql)).
+			TestCataloger(t, NewChartCataloger(DefaultCatalogerConfig()))
+	})
+
+	t.Run("with images", func(t *testing.T) {
+		// note: templated image references are not considered
+		myappMetadata.Images = []string{
+			"busybox:1.36",
+			"docker.io/library/nginx:1.27",
+			"example/migrations@sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b",
+			"ghcr.io/example/myapp:2.8.0",
+		}
+		postgresqlMetadata.Images = []string{
+			"docker.io/bitnami/postgresql:16.3.0-debian-12-r14",
+		}
+		myapp := newChartPackage("myapp", "1.4.2", myappMetadata, myappLocation, valuesLocation)
+		postgresql := newChartPackage("postgresql", "15.5.0", postgresqlMetadata, postgresqlLocation)
+
+		pkgtest.NewCatalogTester().
+			FromDirectory(t, "test-fixtures/charts").
+			Expects([]pkg.Package{myapp, postgresql, redis}, expectedRelationships(myapp, postgresql)).
+			TestCataloger(t, NewChartCataloger(DefaultCatalogerConfig().WithCatalogImages(true)))
+	})
+}
+
+func TestLockCataloger(t *testing.T) {
+	location := file.NewLocation("myapp/Chart.lock")
+	digest := "sha256:3a1e7c0b9d8f6e5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a"
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "postgresql",
+			Version:   "15.5.0",
+			FoundBy:   "helm-chart-lock-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:helm/postgresql@15.5.0?repository_url=https://charts.bitnami.com/bitnami",
+			Type:      pkg.HelmChartPkg,
+			Metadata: pkg.HelmChartLockEntry{
+				Repository: "https://charts.bitnami.com/bitnami",
+				Digest:     digest,
+			},
+		},
+		{
+			Name:      "redis",
+			Version:   "19.6.4",
+			FoundBy:   "helm-chart-lock-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:helm/redis@19.6.4?repository_url=file://charts/redis",
+			Type:      pkg.HelmChartPkg,
+			Metadata: pkg.HelmChartLockEntry{
+				Repository: "file://charts/redis",
+				Digest:     digest,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/charts").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewLockCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain charts",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewChartCataloger(DefaultCatalogerConfig()),
+			expected: []string{
+				"src/chart/Chart.yaml",
+				"src/chart/charts/b.tgz",
+				"opt/a.tgz",
+			},
+		},
+		{
+			name:      "obtain lock files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewLockCataloger(),
+			expected: []string{
+				"src/chart/Chart.lock",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package helm
+
+type CatalogerConfig struct {
+	CatalogImages bool `yaml:"catalog-images" json:"catalog-images" mapstructure:"catalog-images"`
+}
+
+func DefaultCatalogerConfig() CatalogerConfig {
+	return CatalogerConfig{
+		CatalogImages: false,
+	}
+}
+
+func (c CatalogerConfig) WithCatalogImages(input bool) CatalogerConfig {
+	c.CatalogImages = input
+	return c
+}
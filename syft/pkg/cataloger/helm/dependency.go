@@ -0,0 +1,30 @@
+package helm
+
+import (
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = dependencySpecifier
+
+// dependencySpecifier describes the dependencies between charts by chart name (e.g. a chart and its vendored subcharts).
+func dependencySpecifier(p pkg.Package) dependency.Specification {
+	meta, ok := p.Metadata.(pkg.HelmChartMetadata)
+	if !ok {
+		log.Tracef("cataloger failed to extract helm metadata for package %+v", p.Name)
+		return dependency.Specification{}
+	}
+
+	var requires []string
+	for _, d := range meta.Dependencies {
+		requires = append(requires, d.Name)
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{p.Name},
+			Requires: requires,
+		},
+	}
+}
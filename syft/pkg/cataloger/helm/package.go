@@ -0,0 +1,40 @@
+package helm
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name, version, repository string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version, repository),
+		Type:      pkg.HelmChartPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL for a chart, qualified by the repository it is fetched from when known
+// (e.g. pkg:helm/postgresql@15.5.0?repository_url=https://charts.bitnami.com/bitnami).
+func packageURL(name, version, repository string) string {
+	var qualifiers packageurl.Qualifiers
+	if repository != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{"repository_url": repository})
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.HelmChartPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		qualifiers,
+		"",
+	).ToString()
+}
@@ -0,0 +1,221 @@
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	chartFileName  = "Chart.yaml"
+	valuesFileName = "values.yaml"
+)
+
+type chartFile struct {
+	APIVersion  string   `yaml:"apiVersion"`
+	Name        string   `yaml:"name"`
+	Version     string   `yaml:"version"`
+	Type        string   `yaml:"type"`
+	AppVersion  string   `yaml:"appVersion"`
+	Description string   `yaml:"description"`
+	Home        string   `yaml:"home"`
+	Sources     []string `yaml:"sources"`
+	Maintainers []struct {
+		Name  string `yaml:"name"`
+		Email string `yaml:"email"`
+		URL   string `yaml:"url"`
+	} `yaml:"maintainers"`
+	Dependencies []struct {
+		Name       string `yaml:"name"`
+		Version    string `yaml:"version"`
+		Repository string `yaml:"repository"`
+	} `yaml:"dependencies"`
+}
+
+type chartParser struct {
+	cfg CatalogerConfig
+}
+
+func newChartParser(cfg CatalogerConfig) chartParser {
+	return chartParser{cfg: cfg}
+}
+
+// parseChartYAML is a parser function for the Chart.yaml of an unpacked chart, returning the chart described.
+func (c chartParser) parseChartYAML(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	chart, err := decodeChartFile(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	var images []string
+	if c.cfg.CatalogImages && resolver != nil {
+		var valuesLocation *file.Location
+		images, valuesLocation = findValuesImages(resolver, reader.Location)
+		if valuesLocation != nil {
+			locations = append(locations, valuesLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	p, ok := newChartPackage(chart, images, locations...)
+	if !ok {
+		return nil, nil, nil
+	}
+	return []pkg.Package{p}, nil, nil
+}
+
+// parseChartArchive is a parser function for a packaged chart (as created by "helm package"), returning the chart
+// described by the Chart.yaml at the root of the archive. Archives that are not charts are ignored.
+func (c chartParser) parseChartArchive(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read archive as gzip")
+		return nil, nil, nil
+	}
+	defer internal.CloseAndLogError(gzipReader, reader.RealPath)
+
+	// the order of entries within the archive is not guaranteed, so collect the files of interest at the root of
+	// the chart directory (subcharts within the "charts" directory are not considered)
+	charts := make(map[string][]byte)
+	values := make(map[string][]byte)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read archive as tar")
+			return nil, nil, nil
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		dir, name := path.Split(path.Clean(header.Name))
+		dir = strings.TrimSuffix(dir, "/")
+		if dir == "" || strings.Contains(dir, "/") {
+			continue
+		}
+
+		switch {
+		case name == chartFileName:
+			contents, err := io.ReadAll(tarReader)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to read %s from chart archive: %w", header.Name, err)
+			}
+			charts[dir] = contents
+		case name == valuesFileName && c.cfg.CatalogImages:
+			contents, err := io.ReadAll(tarReader)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to read %s from chart archive: %w", header.Name, err)
+			}
+			values[dir] = contents
+		}
+	}
+
+	// a packaged chart contains exactly one chart directory
+	if len(charts) != 1 {
+		return nil, nil, nil
+	}
+
+	var pkgs []pkg.Package
+	for dir, contents := range charts {
+		chart, err := decodeChartFile(bytes.NewReader(contents))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var images []string
+		if v, ok := values[dir]; ok {
+			images = imageReferences(bytes.NewReader(v))
+		}
+
+		p, ok := newChartPackage(chart, images, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		if ok {
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+func decodeChartFile(reader io.Reader) (chartFile, error) {
+	var chart chartFile
+	if err := yaml.NewDecoder(reader).Decode(&chart); err != nil && !errors.Is(err, io.EOF) {
+		return chart, fmt.Errorf("failed to parse Chart.yaml file: %w", err)
+	}
+	return chart, nil
+}
+
+func newChartPackage(chart chartFile, images []string, locations ...file.Location) (pkg.Package, bool) {
+	if chart.Name == "" || chart.Version == "" {
+		return pkg.Package{}, false
+	}
+
+	meta := pkg.HelmChartMetadata{
+		APIVersion:  chart.APIVersion,
+		Type:        chart.Type,
+		AppVersion:  chart.AppVersion,
+		Description: chart.Description,
+		Home:        chart.Home,
+		Sources:     chart.Sources,
+		Images:      images,
+	}
+	for _, m := range chart.Maintainers {
+		meta.Maintainers = append(meta.Maintainers, pkg.HelmChartMaintainer{
+			Name:  m.Name,
+			Email: m.Email,
+			URL:   m.URL,
+		})
+	}
+	for _, d := range chart.Dependencies {
+		if d.Name == "" {
+			continue
+		}
+		meta.Dependencies = append(meta.Dependencies, pkg.HelmChartDependency{
+			Name:       d.Name,
+			Version:    d.Version,
+			Repository: d.Repository,
+		})
+	}
+
+	return newPackage(chart.Name, chart.Version, "", meta, locations...), true
+}
+
+// findValuesImages returns the image references declared within the values.yaml alongside the given Chart.yaml.
+func findValuesImages(resolver file.Resolver, chartLocation file.Location) ([]string, *file.Location) {
+	valuesLocation := resolver.RelativeFileByPath(chartLocation, path.Join(path.Dir(chartLocation.RealPath), valuesFileName))
+	if valuesLocation == nil {
+		return nil, nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*valuesLocation)
+	if err != nil {
+		log.WithFields("path", valuesLocation.RealPath, "error", err).Debug("unable to read chart values file")
+		return nil, nil
+	}
+	defer internal.CloseAndLogError(contents, valuesLocation.RealPath)
+
+	images := imageReferences(contents)
+	if len(images) == 0 {
+		return nil, nil
+	}
+	return images, valuesLocation
+}
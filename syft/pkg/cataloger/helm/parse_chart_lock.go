@@ -0,0 +1,51 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseChartLock
+
+type chartLock struct {
+	Dependencies []struct {
+		Name       string `yaml:"name"`
+		Version    string `yaml:"version"`
+		Repository string `yaml:"repository"`
+	} `yaml:"dependencies"`
+	Digest string `yaml:"digest"`
+}
+
+// parseChartLock is a parser function for Chart.lock contents, returning all chart dependencies resolved.
+func parseChartLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock chartLock
+	if err := yaml.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Chart.lock file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, d := range lock.Dependencies {
+		if d.Name == "" || d.Version == "" {
+			continue
+		}
+		pkgs = append(pkgs, newPackage(
+			d.Name,
+			d.Version,
+			d.Repository,
+			pkg.HelmChartLockEntry{
+				Repository: d.Repository,
+				Digest:     lock.Digest,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
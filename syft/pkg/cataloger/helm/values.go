@@ -0,0 +1,92 @@
+package helm
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// imageReferences returns the container image references declared within chart values, following the common chart
+// conventions of an "image" key with either a full reference as the value (e.g. "image: nginx:1.27") or a mapping of
+// the reference parts (e.g. "image: {registry: docker.io, repository: bitnami/nginx, tag: 1.27.0}").
+func imageReferences(reader io.Reader) []string {
+	var values any
+	if err := yaml.NewDecoder(reader).Decode(&values); err != nil && err != io.EOF {
+		log.WithFields("error", err).Trace("unable to parse chart values")
+		return nil
+	}
+
+	refs := strset.New()
+	collectImageReferences(values, refs)
+
+	results := refs.List()
+	sort.Strings(results)
+	return results
+}
+
+func collectImageReferences(node any, refs *strset.Set) {
+	switch v := node.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if key == "image" {
+				if ref := imageReference(value); ref != "" {
+					refs.Add(ref)
+					continue
+				}
+			}
+			collectImageReferences(value, refs)
+		}
+	case []any:
+		for _, value := range v {
+			collectImageReferences(value, refs)
+		}
+	}
+}
+
+func imageReference(value any) string {
+	var ref string
+	switch v := value.(type) {
+	case string:
+		ref = v
+	case map[string]any:
+		repository := scalarString(v["repository"])
+		if repository == "" {
+			return ""
+		}
+		ref = repository
+		if registry := scalarString(v["registry"]); registry != "" {
+			ref = strings.TrimSuffix(registry, "/") + "/" + ref
+		}
+		if tag := scalarString(v["tag"]); tag != "" {
+			ref += ":" + tag
+		}
+		if digest := scalarString(v["digest"]); digest != "" {
+			ref += "@" + digest
+		}
+	}
+
+	ref = strings.TrimSpace(ref)
+	if strings.Contains(ref, "{{") {
+		// templated values cannot be resolved without rendering the chart
+		return ""
+	}
+	return ref
+}
+
+func scalarString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int, int64, float64, bool:
+		return fmt.Sprint(v)
+	}
+	return ""
+}
@@ -0,0 +1,51 @@
+/*
+Package ini provides a minimal reader for INI-like configuration files found alongside build system manifests (e.g.
+.buckconfig or Meson .wrap files). Values are not interpolated and line continuations are not supported.
+*/
+package ini
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// Sections is a mapping of section name to all key-value pairs within that section.
+type Sections map[string]map[string]string
+
+// Get returns the value for the given key within the given section (or an empty string if missing).
+func (s Sections) Get(section, key string) string {
+	return s[section][key]
+}
+
+// Read parses all sections from the given reader. Keys may be separated from values by either "=" or ":", and any
+// key-value pairs found before the first section header are ignored.
+func Read(reader io.Reader) (Sections, error) {
+	sections := make(Sections)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[name]; !ok {
+				sections[name] = make(map[string]string)
+			}
+			current = sections[name]
+			continue
+		}
+
+		idx := strings.IndexAny(line, "=:")
+		if idx < 0 || current == nil {
+			continue
+		}
+		current[strings.TrimSpace(line[:idx])] = strings.TrimSpace(line[idx+1:])
+	}
+
+	return sections, scanner.Err()
+}
@@ -0,0 +1,45 @@
+package ini
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	input := `
+ignored = before any section
+
+# a comment
+[first]
+  key = value
+  url = https://example.com/a=b
+; another comment
+colon: separated
+
+[second]
+empty =
+[first]
+merged = true
+`
+
+	sections, err := Read(strings.NewReader(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, Sections{
+		"first": {
+			"key":    "value",
+			"url":    "https://example.com/a=b",
+			"colon":  "separated",
+			"merged": "true",
+		},
+		"second": {
+			"empty": "",
+		},
+	}, sections)
+
+	assert.Equal(t, "value", sections.Get("first", "key"))
+	assert.Equal(t, "", sections.Get("missing", "key"))
+}
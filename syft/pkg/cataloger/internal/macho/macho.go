@@ -0,0 +1,153 @@
+/*
+Package macho reads the load commands of Mach-O binaries that describe dynamic linking: the identity of a dynamic
+library (LC_ID_DYLIB), the libraries that a binary links against (LC_LOAD_DYLIB and its variants), and the minimum OS
+version required (LC_VERSION_MIN_* or LC_BUILD_VERSION).
+*/
+package macho
+
+import (
+	"bytes"
+	"debug/macho"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// load commands, from https://opensource.apple.com/source/xnu/xnu-2050.18.24/EXTERNAL_HEADERS/mach-o/loader.h
+const (
+	loadCmdLoadDylib         = 0xc
+	loadCmdIDDylib           = 0xd
+	loadCmdLazyLoadDylib     = 0x20
+	loadCmdVersionMinMacOSX  = 0x24
+	loadCmdVersionMinIPhone  = 0x25
+	loadCmdVersionMinTVOS    = 0x2f
+	loadCmdVersionMinWatchOS = 0x30
+	loadCmdBuildVersion      = 0x32
+	loadCmdLoadWeakDylib     = 0x18 | 0x80000000
+	loadCmdReexportDylib     = 0x1f | 0x80000000
+	loadCmdLoadUpwardDylib   = 0x23 | 0x80000000
+)
+
+// Dylib is a dynamic library referenced by a load command.
+type Dylib struct {
+	// InstallName is the path that the library is loaded from (e.g. "@rpath/Sparkle.framework/Versions/B/Sparkle").
+	InstallName string
+
+	// CurrentVersion is the version of the library (e.g. "1.2.3").
+	CurrentVersion string
+
+	// CompatibilityVersion is the oldest version of the library that binaries linked against it are compatible with.
+	CompatibilityVersion string
+}
+
+// File describes the dynamic linking load commands of a Mach-O binary.
+type File struct {
+	// ID is the identity of the binary when it is a dynamic library (from LC_ID_DYLIB).
+	ID *Dylib
+
+	// Libraries are the dynamic libraries that the binary links against, in load order.
+	Libraries []Dylib
+
+	// MinimumOSVersion is the minimum version of the OS required by the binary (e.g. "11.0").
+	MinimumOSVersion string
+}
+
+// InstallNames returns the install names of all libraries that the binary links against.
+func (f File) InstallNames() []string {
+	var names []string
+	for _, l := range f.Libraries {
+		names = append(names, l.InstallName)
+	}
+	return names
+}
+
+// Read returns the dynamic linking load commands of a Mach-O binary, where universal binaries are described by the
+// first architecture.
+func Read(r io.ReaderAt) (*File, error) {
+	f, err := macho.NewFile(r)
+	if err != nil {
+		fat, fatErr := macho.NewFatFile(r)
+		if fatErr != nil {
+			return nil, err
+		}
+		defer fat.Close()
+		if len(fat.Arches) == 0 {
+			return nil, fmt.Errorf("universal binary without architectures")
+		}
+		f = fat.Arches[0].File
+	} else {
+		defer f.Close()
+	}
+
+	var result File
+	for _, load := range f.Loads {
+		raw := load.Raw()
+		if len(raw) < 8 {
+			continue
+		}
+
+		switch cmd := f.ByteOrder.Uint32(raw); cmd {
+		case loadCmdIDDylib:
+			if d, ok := readDylib(f.ByteOrder, raw); ok {
+				result.ID = &d
+			}
+		case loadCmdLoadDylib, loadCmdLoadWeakDylib, loadCmdReexportDylib, loadCmdLazyLoadDylib, loadCmdLoadUpwardDylib:
+			if d, ok := readDylib(f.ByteOrder, raw); ok {
+				result.Libraries = append(result.Libraries, d)
+			}
+		case loadCmdVersionMinMacOSX, loadCmdVersionMinIPhone, loadCmdVersionMinTVOS, loadCmdVersionMinWatchOS:
+			// cmd, cmdsize, version, sdk
+			if len(raw) >= 12 && result.MinimumOSVersion == "" {
+				result.MinimumOSVersion = osVersion(f.ByteOrder.Uint32(raw[8:12]))
+			}
+		case loadCmdBuildVersion:
+			// cmd, cmdsize, platform, minos, sdk, ntools (the build version is preferred over the legacy commands)
+			if len(raw) >= 16 {
+				result.MinimumOSVersion = osVersion(f.ByteOrder.Uint32(raw[12:16]))
+			}
+		}
+	}
+	return &result, nil
+}
+
+// readDylib reads a dylib_command: cmd, cmdsize, name offset, timestamp, current version, compatibility version,
+// followed by the (NUL terminated) name at the given offset.
+func readDylib(order binary.ByteOrder, raw []byte) (Dylib, bool) {
+	if len(raw) < 24 {
+		return Dylib{}, false
+	}
+
+	offset := order.Uint32(raw[8:12])
+	if offset < 24 || int(offset) >= len(raw) {
+		return Dylib{}, false
+	}
+	name := raw[offset:]
+	if i := bytes.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+
+	return Dylib{
+		InstallName:          string(name),
+		CurrentVersion:       version(order.Uint32(raw[16:20])),
+		CompatibilityVersion: version(order.Uint32(raw[20:24])),
+	}, true
+}
+
+// version formats a version packed as X.Y.Z within 32 bits (16, 8, and 8 bits respectively).
+func version(v uint32) string {
+	if v == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d.%d", v>>16, (v>>8)&0xff, v&0xff)
+}
+
+// osVersion formats an OS version packed the same as other versions, but omitting a zero patch version (e.g. "11.0").
+func osVersion(v uint32) string {
+	if v == 0 {
+		return ""
+	}
+	if v&0xff == 0 {
+		return fmt.Sprintf("%d.%d", v>>16, (v>>8)&0xff)
+	}
+	return version(v)
+}
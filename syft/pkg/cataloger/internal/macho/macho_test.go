@@ -0,0 +1,131 @@
+package macho
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMachO returns a 64-bit little-endian Mach-O binary with the given load commands.
+func newMachO(cmds ...[]byte) []byte {
+	var size int
+	for _, c := range cmds {
+		size += len(c)
+	}
+
+	var buf bytes.Buffer
+	// magic, cputype (arm64), cpusubtype, filetype (dylib), ncmds, sizeofcmds, flags, reserved
+	for _, v := range []uint32{0xfeedfacf, 0x0100000c, 0, 6, uint32(len(cmds)), uint32(size), 0, 0} {
+		_ = binary.Write(&buf, binary.LittleEndian, v)
+	}
+	for _, c := range cmds {
+		buf.Write(c)
+	}
+	return buf.Bytes()
+}
+
+func dylibCommand(cmd uint32, name string, current, compat uint32) []byte {
+	size := (24 + len(name) + 1 + 7) &^ 7
+	raw := make([]byte, size)
+	binary.LittleEndian.PutUint32(raw[0:], cmd)
+	binary.LittleEndian.PutUint32(raw[4:], uint32(size))
+	binary.LittleEndian.PutUint32(raw[8:], 24)
+	binary.LittleEndian.PutUint32(raw[16:], current)
+	binary.LittleEndian.PutUint32(raw[20:], compat)
+	copy(raw[24:], name)
+	return raw
+}
+
+func versionCommand(cmd uint32, values ...uint32) []byte {
+	raw := make([]byte, 8+4*len(values))
+	binary.LittleEndian.PutUint32(raw[0:], cmd)
+	binary.LittleEndian.PutUint32(raw[4:], uint32(len(raw)))
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(raw[8+4*i:], v)
+	}
+	return raw
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want *File
+	}{
+		{
+			name: "dynamic library",
+			data: newMachO(
+				dylibCommand(loadCmdIDDylib, "/usr/local/lib/libcrypto.3.dylib", 3<<16|2<<8|1, 3<<16),
+				dylibCommand(loadCmdLoadDylib, "/usr/lib/libSystem.B.dylib", 1351<<16, 1<<16),
+				dylibCommand(loadCmdLoadWeakDylib, "@rpath/libz.1.dylib", 1<<16|2<<8|13, 1<<16),
+				versionCommand(loadCmdVersionMinMacOSX, 10<<16|13<<8, 0),
+			),
+			want: &File{
+				ID: &Dylib{
+					InstallName:          "/usr/local/lib/libcrypto.3.dylib",
+					CurrentVersion:       "3.2.1",
+					CompatibilityVersion: "3.0.0",
+				},
+				Libraries: []Dylib{
+					{
+						InstallName:          "/usr/lib/libSystem.B.dylib",
+						CurrentVersion:       "1351.0.0",
+						CompatibilityVersion: "1.0.0",
+					},
+					{
+						InstallName:          "@rpath/libz.1.dylib",
+						CurrentVersion:       "1.2.13",
+						CompatibilityVersion: "1.0.0",
+					},
+				},
+				MinimumOSVersion: "10.13",
+			},
+		},
+		{
+			name: "executable with build version",
+			data: newMachO(
+				dylibCommand(loadCmdLoadDylib, "@rpath/Sparkle.framework/Versions/B/Sparkle", 0, 0),
+				// platform (macOS), minos, sdk, ntools
+				versionCommand(loadCmdBuildVersion, 1, 11<<16|2<<8|1, 14<<16, 0),
+			),
+			want: &File{
+				Libraries: []Dylib{
+					{
+						InstallName: "@rpath/Sparkle.framework/Versions/B/Sparkle",
+					},
+				},
+				MinimumOSVersion: "11.2.1",
+			},
+		},
+		{
+			name: "no load commands",
+			data: newMachO(),
+			want: &File{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(bytes.NewReader(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	_, err := Read(bytes.NewReader([]byte("not a mach-o binary")))
+	require.Error(t, err)
+}
+
+func TestFile_InstallNames(t *testing.T) {
+	f := File{
+		Libraries: []Dylib{
+			{InstallName: "/usr/lib/libSystem.B.dylib"},
+			{InstallName: "@rpath/libz.1.dylib"},
+		},
+	}
+	assert.Equal(t, []string{"/usr/lib/libSystem.B.dylib", "@rpath/libz.1.dylib"}, f.InstallNames())
+}
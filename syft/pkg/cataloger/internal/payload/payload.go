@@ -0,0 +1,180 @@
+/*
+Package payload provides a way to catalog the files held within a container file (such as an installer or filesystem
+image) by extracting them into a temporary directory, reporting the packages found as nested locations of the
+container file.
+*/
+package payload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/fileresolver"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// ErrTooLarge is returned when a file would exceed the maximum size of a payload.
+var ErrTooLarge = errors.New("payload is too large")
+
+// Payload is the set of files extracted from a container file (e.g. the files within the cabinets of an MSI, or
+// within a snap) into a temporary directory, so that the files can be cataloged as if they had been installed.
+type Payload struct {
+	dir     string
+	maxSize int64
+	size    int64
+	files   []string
+}
+
+// New returns an empty payload, where no more than the given amount of (uncompressed) data may be extracted, which
+// guards against decompression bombs (the remainder of a larger payload is not cataloged).
+func New(maxSize int64) (*Payload, error) {
+	dir, err := os.MkdirTemp("", "syft-payload-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create tempdir for payload: %w", err)
+	}
+	return &Payload{dir: dir, maxSize: maxSize}, nil
+}
+
+// Add extracts a file of the payload, where the given path is relative to the root of the payload (and may use
+// either path separator).
+func (p *Payload) Add(name string, reader io.Reader) error {
+	name = Path(name)
+	if name == "" {
+		return nil
+	}
+
+	target := filepath.Join(p.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("unable to create directory for payload file %q: %w", name, err)
+	}
+	f, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("unable to create payload file %q: %w", name, err)
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, io.LimitReader(reader, p.maxSize-p.size+1))
+	p.size += n
+	if err != nil {
+		return fmt.Errorf("unable to extract payload file %q: %w", name, err)
+	}
+	if p.size > p.maxSize {
+		return ErrTooLarge
+	}
+
+	p.files = append(p.files, name)
+	return nil
+}
+
+// Path returns the given path within a payload, relative to the root of the payload (where any parent references
+// cannot escape the root).
+func Path(name string) string {
+	name = path.Clean("/" + strings.ReplaceAll(name, `\`, "/"))
+	return strings.TrimPrefix(name, "/")
+}
+
+// Cleanup removes the extracted files of the payload.
+func (p *Payload) Cleanup() {
+	if err := os.RemoveAll(p.dir); err != nil {
+		log.Errorf("unable to cleanup payload tempdir: %+v", err)
+	}
+}
+
+// Catalog runs the given catalogers against the extracted payload, returning the packages found within the payload (as
+// found by the given cataloger) at nested locations of the container file, along with the relationships between them
+// (where any relationships to files of the payload are dropped, since those files do not exist within the scanned
+// source).
+func (p *Payload) Catalog(ctx context.Context, catalogers []pkg.Cataloger, foundBy string, container file.Location) ([]pkg.Package, []artifact.Relationship) {
+	if len(catalogers) == 0 || len(p.files) == 0 {
+		return nil, nil
+	}
+
+	// the payload is indexed, since some catalogers search for files by MIME type
+	resolver, err := fileresolver.NewFromDirectory(p.dir, p.dir)
+	if err != nil {
+		log.WithFields("container", container.Path(), "error", err).Debug("unable to index payload")
+		return nil, nil
+	}
+
+	var pkgs []pkg.Package
+	var relationships []artifact.Relationship
+	for _, c := range catalogers {
+		found, rels, err := c.Catalog(ctx, resolver)
+		if err != nil {
+			log.WithFields("container", container.Path(), "cataloger", c.Name(), "error", err).Debug("unable to catalog payload")
+		}
+
+		relocated := make(map[artifact.ID]pkg.Package, len(found))
+		for _, f := range found {
+			id := f.ID()
+			f.FoundBy = foundBy
+			f.Locations = relocateLocations(f.Locations, container)
+			f.Licenses = relocateLicenses(f.Licenses, container)
+			f.SetID()
+			relocated[id] = f
+			pkgs = append(pkgs, f)
+		}
+
+		for _, r := range rels {
+			from, fromOK := relocated[r.From.ID()]
+			to, toOK := relocated[r.To.ID()]
+			if !fromOK || !toOK {
+				continue
+			}
+			r.From, r.To = from, to
+			relationships = append(relationships, r)
+		}
+	}
+	return pkgs, relationships
+}
+
+// relocateLocation returns the location of a file of the payload as a nested location of the container file (e.g.
+// "/setup.exe:$INSTDIR/lib/app.jar"), in the same way that files nested within java archives are described.
+func relocateLocation(l file.Location, container file.Location) file.Location {
+	nested := file.NewLocationFromCoordinates(container.Coordinates)
+	nested.AccessPath = fmt.Sprintf("%s:%s", container.Path(), strings.TrimPrefix(l.Path(), "/"))
+	for k, v := range l.Annotations {
+		nested.Annotations[k] = v
+	}
+	return nested
+}
+
+func relocateLocations(locations file.LocationSet, container file.Location) file.LocationSet {
+	relocated := file.NewLocationSet()
+	for _, l := range locations.ToSlice() {
+		relocated.Add(relocateLocation(l, container))
+	}
+	return relocated
+}
+
+func relocateLicenses(licenses pkg.LicenseSet, container file.Location) pkg.LicenseSet {
+	relocated := pkg.NewLicenseSet()
+	for _, l := range licenses.ToSlice() {
+		l.Locations = relocateLocations(l.Locations, container)
+		relocated.Add(l)
+	}
+	return relocated
+}
+
+// ContainsRelationships returns relationships from the package of a container file to each of the packages within its
+// payload.
+func ContainsRelationships(container pkg.Package, payload []pkg.Package) []artifact.Relationship {
+	var relationships []artifact.Relationship
+	for _, p := range payload {
+		relationships = append(relationships, artifact.Relationship{
+			From: container,
+			To:   p,
+			Type: artifact.ContainsRelationship,
+		})
+	}
+	return relationships
+}
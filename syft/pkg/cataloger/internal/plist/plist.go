@@ -0,0 +1,365 @@
+/*
+Package plist provides a minimal decoder for Apple property lists (e.g. the Info.plist of app and framework bundles)
+in either the binary or the XML format.
+*/
+package plist
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+var (
+	binaryPlistMagic = []byte("bplist00")
+
+	errTruncated = errors.New("unexpected end of data")
+
+	referenceDate = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// the maximum nesting of arrays and dictionaries that is decoded
+const maxPlistDepth = 32
+
+// Decode returns the top-level dictionary of a property list in either the binary or the XML format. Values are
+// decoded as strings, int64s, float64s, bools, time.Times, []byte, []any, and map[string]any.
+func Decode(data []byte) (map[string]any, error) {
+	var (
+		value any
+		err   error
+	)
+	if bytes.HasPrefix(data, binaryPlistMagic) {
+		value, err = decodeBinaryPlist(data)
+	} else {
+		value, err = decodeXMLPlist(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dict, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("property list is not a dictionary")
+	}
+	return dict, nil
+}
+
+// binaryPlist decodes the objects of a binary property list (see CFBinaryPList.c within CoreFoundation).
+type binaryPlist struct {
+	data          []byte
+	offsets       []uint64
+	objectRefSize int
+}
+
+func decodeBinaryPlist(data []byte) (any, error) {
+	if len(data) < len(binaryPlistMagic)+32 {
+		return nil, errTruncated
+	}
+
+	trailer := data[len(data)-32:]
+	offsetIntSize := int(trailer[6])
+	objectRefSize := int(trailer[7])
+	numObjects := binary.BigEndian.Uint64(trailer[8:])
+	topObject := binary.BigEndian.Uint64(trailer[16:])
+	offsetTableOffset := binary.BigEndian.Uint64(trailer[24:])
+
+	if offsetIntSize < 1 || offsetIntSize > 8 || objectRefSize < 1 || objectRefSize > 8 {
+		return nil, fmt.Errorf("invalid binary property list trailer")
+	}
+	if offsetTableOffset > uint64(len(data)) || numObjects > (uint64(len(data))-offsetTableOffset)/uint64(offsetIntSize) {
+		return nil, errTruncated
+	}
+
+	p := binaryPlist{
+		data:          data,
+		offsets:       make([]uint64, numObjects),
+		objectRefSize: objectRefSize,
+	}
+	for i := range p.offsets {
+		start := int(offsetTableOffset) + i*offsetIntSize
+		p.offsets[i] = readUint(data[start : start+offsetIntSize])
+	}
+
+	return p.object(topObject, 0)
+}
+
+func (p binaryPlist) object(ref uint64, depth int) (any, error) {
+	if depth > maxPlistDepth {
+		return nil, errors.New("property list is nested too deeply")
+	}
+	if ref >= uint64(len(p.offsets)) || p.offsets[ref] >= uint64(len(p.data)) {
+		return nil, fmt.Errorf("invalid object reference: %d", ref)
+	}
+
+	offset := p.offsets[ref]
+	marker := p.data[offset]
+	kind, info := marker>>4, int(marker&0x0f)
+	content := p.data[offset+1:]
+
+	switch kind {
+	case 0x0:
+		switch marker {
+		case 0x08:
+			return false, nil
+		case 0x09:
+			return true, nil
+		}
+		return nil, nil
+	case 0x1:
+		size := 1 << info
+		if size > len(content) {
+			return nil, errTruncated
+		}
+		if size == 16 {
+			// 128-bit integers hold the value in the lower 8 bytes
+			return int64(binary.BigEndian.Uint64(content[8:16])), nil
+		}
+		if size > 8 {
+			return nil, fmt.Errorf("unsupported integer size: %d", size)
+		}
+		return int64(readUint(content[:size])), nil
+	case 0x3:
+		// dates are the number of seconds since 2001-01-01 (the Core Foundation reference date)
+		if len(content) < 8 {
+			return nil, errTruncated
+		}
+		seconds := math.Float64frombits(binary.BigEndian.Uint64(content))
+		return referenceDate.Add(time.Duration(seconds * float64(time.Second))).UTC(), nil
+	case 0x2:
+		size := 1 << info
+		if size > len(content) {
+			return nil, errTruncated
+		}
+		switch size {
+		case 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(content))), nil
+		case 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(content)), nil
+		}
+		return nil, fmt.Errorf("unsupported real size: %d", size)
+	case 0x4, 0x5, 0x6:
+		length, content, err := p.length(info, content)
+		if err != nil {
+			return nil, err
+		}
+		if kind == 0x6 {
+			// UTF-16 strings are measured in code units
+			if length*2 > len(content) {
+				return nil, errTruncated
+			}
+			units := make([]uint16, length)
+			for i := range units {
+				units[i] = binary.BigEndian.Uint16(content[i*2:])
+			}
+			return string(utf16.Decode(units)), nil
+		}
+		if length > len(content) {
+			return nil, errTruncated
+		}
+		if kind == 0x4 {
+			return append([]byte{}, content[:length]...), nil
+		}
+		return string(content[:length]), nil
+	case 0xa, 0xd:
+		count, content, err := p.length(info, content)
+		if err != nil {
+			return nil, err
+		}
+		refs := count
+		if kind == 0xd {
+			refs *= 2
+		}
+		if refs*p.objectRefSize > len(content) {
+			return nil, errTruncated
+		}
+		ref := func(i int) uint64 {
+			return readUint(content[i*p.objectRefSize : (i+1)*p.objectRefSize])
+		}
+
+		if kind == 0xa {
+			values := make([]any, 0, count)
+			for i := 0; i < count; i++ {
+				value, err := p.object(ref(i), depth+1)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			return values, nil
+		}
+
+		dict := make(map[string]any, count)
+		for i := 0; i < count; i++ {
+			key, err := p.object(ref(i), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := p.object(ref(count+i), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if k, ok := key.(string); ok {
+				dict[k] = value
+			}
+		}
+		return dict, nil
+	}
+
+	// other objects (e.g. UIDs and sets) are not of interest
+	return nil, nil
+}
+
+// length returns the length of a variable-length object, which is stored within the marker or (when the marker holds
+// 0xf) as a following integer object.
+func (p binaryPlist) length(info int, content []byte) (int, []byte, error) {
+	if info != 0x0f {
+		return info, content, nil
+	}
+	if len(content) < 1 || content[0]>>4 != 0x1 {
+		return 0, nil, errors.New("invalid object length")
+	}
+	size := 1 << (content[0] & 0x0f)
+	if size > 8 || 1+size > len(content) {
+		return 0, nil, errTruncated
+	}
+	length := readUint(content[1 : 1+size])
+	if length > uint64(len(p.data)) {
+		return 0, nil, errTruncated
+	}
+	return int(length), content[1+size:], nil
+}
+
+func readUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func decodeXMLPlist(data []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, errors.New("no property list value found")
+			}
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local != "plist" {
+			return decodeXMLValue(decoder, start, 0)
+		}
+	}
+}
+
+func decodeXMLValue(decoder *xml.Decoder, start xml.StartElement, depth int) (any, error) {
+	if depth > maxPlistDepth {
+		return nil, errors.New("property list is nested too deeply")
+	}
+
+	switch start.Name.Local {
+	case "dict":
+		dict := make(map[string]any)
+		var key string
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				if t.Name.Local == "key" {
+					if err := decoder.DecodeElement(&key, &t); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				value, err := decodeXMLValue(decoder, t, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				dict[key] = value
+			case xml.EndElement:
+				return dict, nil
+			}
+		}
+	case "array":
+		var values []any
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch t := token.(type) {
+			case xml.StartElement:
+				value, err := decodeXMLValue(decoder, t, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			case xml.EndElement:
+				return values, nil
+			}
+		}
+	case "true", "false":
+		if err := decoder.Skip(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	var text string
+	if err := decoder.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+	text = strings.TrimSpace(text)
+
+	switch start.Name.Local {
+	case "integer":
+		return strconv.ParseInt(text, 10, 64)
+	case "real":
+		return strconv.ParseFloat(text, 64)
+	case "data":
+		return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+	case "date":
+		return time.Parse(time.RFC3339, text)
+	}
+	// strings and anything unknown
+	return text, nil
+}
+
+// String returns the string value of the given key (or an empty string when missing or not a string).
+func String(dict map[string]any, key string) string {
+	s, _ := dict[key].(string)
+	return strings.TrimSpace(s)
+}
+
+// Time returns the date value of the given key (or the zero time when missing or not a date).
+func Time(dict map[string]any, key string) time.Time {
+	t, _ := dict[key].(time.Time)
+	return t
+}
+
+// Strings returns the string values of the array of the given key.
+func Strings(dict map[string]any, key string) []string {
+	values, _ := dict[key].([]any)
+	var result []string
+	for _, v := range values {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
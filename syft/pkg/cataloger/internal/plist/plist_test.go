@@ -0,0 +1,77 @@
+package plist
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Decode(t *testing.T) {
+	expected := map[string]any{
+		"string":   "hello",
+		"unicode":  "héllo ✓",
+		"integer":  int64(42),
+		"negative": int64(-1),
+		"real":     1.5,
+		"true":     true,
+		"false":    false,
+		"data":     []byte{0x00, 0x01, 0x02},
+		"date":     time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+		"array":    []any{"a", "b"},
+		"dict":     map[string]any{"nested": "value"},
+		"long":     "xxxxxxxxxxxxxxxxxxxx",
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+	}{
+		{
+			name:    "xml",
+			fixture: "test-fixtures/sample.plist",
+		},
+		{
+			name:    "binary",
+			fixture: "test-fixtures/sample.bplist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := os.ReadFile(tt.fixture)
+			require.NoError(t, err)
+
+			got, err := Decode(data)
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+		})
+	}
+}
+
+func TestDecode_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "truncated binary",
+			data: "bplist00\xd1\x01\x02",
+		},
+		{
+			name: "not a dictionary",
+			data: `<plist version="1.0"><array><string>a</string></array></plist>`,
+		},
+		{
+			name: "not a property list",
+			data: "hello",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode([]byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Package starlark provides a minimal reader for the subset of Starlark found in build system manifests (e.g. MODULE.bazel
+or BUCK files). Only function calls and their literal arguments are extracted; no evaluation is performed.
+*/
+package starlark
+
+import (
+	"io"
+	"strings"
+)
+
+// ValueKind describes the kind of literal an argument value was parsed from.
+type ValueKind int
+
+const (
+	// OtherValue is any expression that is not a simple literal (e.g. a variable reference or a nested call).
+	OtherValue ValueKind = iota
+	StringValue
+	BoolValue
+	ListValue
+)
+
+// Value is a literal argument value.
+type Value struct {
+	Kind   ValueKind
+	String string
+	Bool   bool
+	List   []Value
+}
+
+// Call is a single function call found within a manifest, such as `bazel_dep(name = "rules_cc", version = "0.0.9")`.
+type Call struct {
+	// Name is the (possibly dotted) name of the function being called (e.g. "bazel_dep" or "maven.install").
+	Name string
+	// Positional holds all arguments that were not passed by keyword, in order.
+	Positional []Value
+	// Keywords holds all arguments passed by keyword.
+	Keywords map[string]Value
+}
+
+// String returns the string value for the given keyword argument (or an empty string if missing or not a string).
+func (c Call) String(key string) string {
+	v, ok := c.Keywords[key]
+	if !ok || v.Kind != StringValue {
+		return ""
+	}
+	return v.String
+}
+
+// Bool returns the boolean value for the given keyword argument (or false if missing or not a boolean).
+func (c Call) Bool(key string) bool {
+	v, ok := c.Keywords[key]
+	if !ok || v.Kind != BoolValue {
+		return false
+	}
+	return v.Bool
+}
+
+// Strings returns all string values for the given keyword argument. A single string is returned as a one-element
+// slice and any non-string list elements are ignored.
+func (c Call) Strings(key string) []string {
+	v, ok := c.Keywords[key]
+	if !ok {
+		return nil
+	}
+	switch v.Kind {
+	case StringValue:
+		return []string{v.String}
+	case ListValue:
+		var out []string
+		for _, e := range v.List {
+			if e.Kind == StringValue {
+				out = append(out, e.String)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// ReadCalls returns all function calls made outside of any other call, list, or dictionary expression, in the
+// order they appear.
+func ReadCalls(reader io.Reader) ([]Call, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{tokens: tokenize(string(contents))}
+	return p.calls(), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return token{kind: eofToken}
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	t := p.peek(0)
+	if t.kind != eofToken {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) calls() []Call {
+	var calls []Call
+	for p.peek(0).kind != eofToken {
+		t := p.peek(0)
+		if t.kind != identToken {
+			p.skipToken()
+			continue
+		}
+
+		name, ok := p.dottedName()
+		if !ok || !p.peek(0).is("(") {
+			continue
+		}
+		calls = append(calls, p.call(name))
+	}
+	return calls
+}
+
+// dottedName consumes an identifier chain such as "a.b.c".
+func (p *parser) dottedName() (string, bool) {
+	var parts []string
+	for {
+		t := p.peek(0)
+		if t.kind != identToken {
+			return strings.Join(parts, "."), len(parts) > 0
+		}
+		parts = append(parts, p.next().text)
+		if !p.peek(0).is(".") {
+			return strings.Join(parts, "."), true
+		}
+		p.next()
+	}
+}
+
+// call parses the argument list of a call, where the current token is the opening parenthesis.
+func (p *parser) call(name string) Call {
+	c := Call{Name: name, Keywords: make(map[string]Value)}
+	p.next() // (
+
+	for {
+		t := p.peek(0)
+		switch {
+		case t.kind == eofToken:
+			return c
+		case t.is(")"):
+			p.next()
+			return c
+		case t.is(","):
+			p.next()
+			continue
+		}
+
+		if t.kind == identToken && p.peek(1).is("=") {
+			p.next()
+			p.next()
+			c.Keywords[t.text] = p.value()
+		} else {
+			c.Positional = append(c.Positional, p.value())
+		}
+	}
+}
+
+// value parses a single expression up to (but not including) the next top-level "," or closing bracket.
+func (p *parser) value() Value {
+	v := p.operand()
+	for {
+		t := p.peek(0)
+		if t.kind == eofToken || t.is(",") || t.isClosing() {
+			return v
+		}
+		if t.is("+") && v.Kind == StringValue && p.peek(1).kind == stringToken {
+			// string concatenation of literals
+			p.next()
+			v.String += p.next().text
+			continue
+		}
+		// any other operator makes this a non-literal expression
+		v = Value{Kind: OtherValue}
+		p.skipToken()
+	}
+}
+
+func (p *parser) operand() Value {
+	t := p.peek(0)
+	switch {
+	case t.kind == stringToken:
+		p.next()
+		s := t.text
+		// adjacent string literals are implicitly concatenated
+		for p.peek(0).kind == stringToken {
+			s += p.next().text
+		}
+		return Value{Kind: StringValue, String: s}
+	case t.kind == identToken && (t.text == "True" || t.text == "False"):
+		p.next()
+		return Value{Kind: BoolValue, Bool: t.text == "True"}
+	case t.is("["):
+		return p.list()
+	}
+	if t.kind != eofToken && !t.is(",") && !t.isClosing() {
+		p.skipToken()
+	}
+	return Value{Kind: OtherValue}
+}
+
+func (p *parser) list() Value {
+	v := Value{Kind: ListValue}
+	p.next() // [
+	for {
+		t := p.peek(0)
+		switch {
+		case t.kind == eofToken:
+			return v
+		case t.is("]"):
+			p.next()
+			return v
+		case t.is(","):
+			p.next()
+			continue
+		case t.isClosing():
+			// unbalanced input, let the caller deal with it
+			return v
+		}
+		v.List = append(v.List, p.value())
+	}
+}
+
+// skipToken consumes a single token, or an entire bracketed expression if the token opens one.
+func (p *parser) skipToken() {
+	t := p.next()
+	if !t.isOpening() {
+		return
+	}
+	depth := 1
+	for depth > 0 {
+		t = p.next()
+		switch {
+		case t.kind == eofToken:
+			return
+		case t.isOpening():
+			depth++
+		case t.isClosing():
+			depth--
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package starlark
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []Call
+	}{
+		{
+			name: "keyword arguments",
+			input: `
+# a comment with a call(name = "ignored")
+module(name = "my-module", version = "1.0")
+
+bazel_dep(name = "rules_cc", version = "0.0.9", dev_dependency = True)
+`,
+			expected: []Call{
+				{
+					Name: "module",
+					Keywords: map[string]Value{
+						"name":    {Kind: StringValue, String: "my-module"},
+						"version": {Kind: StringValue, String: "1.0"},
+					},
+				},
+				{
+					Name: "bazel_dep",
+					Keywords: map[string]Value{
+						"name":           {Kind: StringValue, String: "rules_cc"},
+						"version":        {Kind: StringValue, String: "0.0.9"},
+						"dev_dependency": {Kind: BoolValue, Bool: true},
+					},
+				},
+			},
+		},
+		{
+			name: "positional arguments, lists, and dotted names",
+			input: `
+load("@prelude//:rules.bzl", 'http_archive')
+maven = use_extension("@rules_jvm_external//:extensions.bzl", "maven")
+maven.install(
+    artifacts = [
+        "junit:junit:4.13.2",
+        "com.google.guava:guava:" + "32.0.1-jre",
+    ],
+    repositories = [REPO, "https://repo1.maven.org/maven2"],
+)
+`,
+			expected: []Call{
+				{
+					Name: "load",
+					Positional: []Value{
+						{Kind: StringValue, String: "@prelude//:rules.bzl"},
+						{Kind: StringValue, String: "http_archive"},
+					},
+					Keywords: map[string]Value{},
+				},
+				{
+					Name: "use_extension",
+					Positional: []Value{
+						{Kind: StringValue, String: "@rules_jvm_external//:extensions.bzl"},
+						{Kind: StringValue, String: "maven"},
+					},
+					Keywords: map[string]Value{},
+				},
+				{
+					Name: "maven.install",
+					Keywords: map[string]Value{
+						"artifacts": {Kind: ListValue, List: []Value{
+							{Kind: StringValue, String: "junit:junit:4.13.2"},
+							{Kind: StringValue, String: "com.google.guava:guava:32.0.1-jre"},
+						}},
+						"repositories": {Kind: ListValue, List: []Value{
+							{Kind: OtherValue},
+							{Kind: StringValue, String: "https://repo1.maven.org/maven2"},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "non-literal expressions and nested calls",
+			input: `
+http_archive(
+    name = "zlib",
+    urls = ["https://zlib.net/zlib-{}.tar.gz".format(VERSION)],
+    sha256 = SHA,
+    build_file = Label("//third_party:zlib.BUILD"),
+    strip_prefix = r"zlib-1.3\1",
+    enabled = x == "y",
+)
+`,
+			expected: []Call{
+				{
+					Name: "http_archive",
+					Keywords: map[string]Value{
+						"name":         {Kind: StringValue, String: "zlib"},
+						"urls":         {Kind: ListValue, List: []Value{{Kind: OtherValue}}},
+						"sha256":       {Kind: OtherValue},
+						"build_file":   {Kind: OtherValue},
+						"strip_prefix": {Kind: StringValue, String: `zlib-1.3\1`},
+						"enabled":      {Kind: OtherValue},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls, err := ReadCalls(strings.NewReader(test.input))
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, calls)
+		})
+	}
+}
+
+func TestCall_Accessors(t *testing.T) {
+	c := Call{
+		Name: "git_override",
+		Keywords: map[string]Value{
+			"module_name": {Kind: StringValue, String: "rules_go"},
+			"urls":        {Kind: ListValue, List: []Value{{Kind: StringValue, String: "a"}, {Kind: OtherValue}, {Kind: StringValue, String: "b"}}},
+			"enabled":     {Kind: BoolValue, Bool: true},
+		},
+	}
+
+	assert.Equal(t, "rules_go", c.String("module_name"))
+	assert.Equal(t, "", c.String("urls"))
+	assert.Equal(t, []string{"a", "b"}, c.Strings("urls"))
+	assert.Equal(t, []string{"rules_go"}, c.Strings("module_name"))
+	assert.Nil(t, c.Strings("missing"))
+	assert.True(t, c.Bool("enabled"))
+	assert.False(t, c.Bool("module_name"))
+}
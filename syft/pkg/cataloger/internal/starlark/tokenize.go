@@ -0,0 +1,158 @@
+package starlark
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	identToken
+	stringToken
+	numberToken
+	punctToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func (t token) is(punct string) bool {
+	return t.kind == punctToken && t.text == punct
+}
+
+func (t token) isOpening() bool {
+	return t.is("(") || t.is("[") || t.is("{")
+}
+
+func (t token) isClosing() bool {
+	return t.is(")") || t.is("]") || t.is("}")
+}
+
+// multi-character operators that must not be confused with their single-character prefixes (notably "==" vs "=")
+var operators = []string{"**=", "//=", ">>=", "<<=", "==", "!=", "<=", ">=", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "**", "//", "<<", ">>", "->"}
+
+//nolint:funlen
+func tokenize(src string) []token {
+	var tokens []token
+	runes := []rune(src)
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case unicode.IsSpace(r), r == '\\':
+			i++
+		case r == '"' || r == '\'':
+			var s string
+			s, i = readString(runes, i, false)
+			tokens = append(tokens, token{kind: stringToken, text: s})
+		case isStringPrefix(runes, i):
+			raw := strings.ContainsRune("rR", runes[i]) || strings.ContainsRune("rR", runes[i+1])
+			for runes[i] != '"' && runes[i] != '\'' {
+				i++
+			}
+			var s string
+			s, i = readString(runes, i, raw)
+			tokens = append(tokens, token{kind: stringToken, text: s})
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: identToken, text: string(runes[start:i])})
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || unicode.IsLetter(runes[i]) || runes[i] == '.' || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: string(runes[start:i])})
+		default:
+			op := string(r)
+			for _, candidate := range operators {
+				if strings.HasPrefix(string(runes[i:min(i+len(candidate), len(runes))]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			i += len([]rune(op))
+			tokens = append(tokens, token{kind: punctToken, text: op})
+		}
+	}
+	return tokens
+}
+
+// isStringPrefix indicates if the runes at the given index are a string prefix (e.g. r"..." or b'...') followed by a quote.
+func isStringPrefix(runes []rune, i int) bool {
+	if !strings.ContainsRune("rRbB", runes[i]) {
+		return false
+	}
+	if i > 0 && (runes[i-1] == '_' || unicode.IsLetter(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+		return false
+	}
+	for j := i + 1; j < len(runes) && j <= i+2; j++ {
+		switch {
+		case runes[j] == '"' || runes[j] == '\'':
+			return true
+		case !strings.ContainsRune("rRbB", runes[j]):
+			return false
+		}
+	}
+	return false
+}
+
+// readString reads a (possibly triple-quoted) string literal starting at the opening quote, returning the
+// unquoted value and the index just past the closing quote.
+func readString(runes []rune, i int, raw bool) (string, int) {
+	quote := runes[i]
+	triple := i+2 < len(runes) && runes[i+1] == quote && runes[i+2] == quote
+	if triple {
+		i += 3
+	} else {
+		i++
+	}
+
+	var sb strings.Builder
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes):
+			if raw {
+				sb.WriteRune(r)
+				sb.WriteRune(runes[i+1])
+			} else {
+				sb.WriteRune(unescape(runes[i+1]))
+			}
+			i += 2
+			continue
+		case r == quote && !triple:
+			return sb.String(), i + 1
+		case r == quote && triple && i+2 < len(runes) && runes[i+1] == quote && runes[i+2] == quote:
+			return sb.String(), i + 3
+		case r == '\n' && !triple:
+			// unterminated string literal
+			return sb.String(), i
+		}
+		sb.WriteRune(r)
+		i++
+	}
+	return sb.String(), i
+}
+
+func unescape(r rune) rune {
+	switch r {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	}
+	return r
+}
@@ -0,0 +1,136 @@
+/*
+Package systemd provides a minimal reader for systemd unit files (e.g. timer, socket, and service units), including the
+lookup of the service unit activated by another unit.
+*/
+package systemd
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+// unitDirs are the directories searched for a unit referenced by another unit (when not next to the referencing unit)
+var unitDirs = []string{
+	"/etc/systemd/system",
+	"/usr/lib/systemd/system",
+	"/lib/systemd/system",
+}
+
+// Unit is the settings of a unit file by section, where a setting may be given more than once.
+type Unit map[string]map[string][]string
+
+// Values returns all values of a setting within the given section.
+func (u Unit) Values(section, key string) []string {
+	return u[section][key]
+}
+
+// Value returns the first value of a setting within the given section (or an empty string if missing).
+func (u Unit) Value(section, key string) string {
+	if values := u[section][key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Read parses the sections and settings of a unit file (see systemd.syntax(7)). Line continuations are joined, and an
+// empty value resets the values of a setting given earlier.
+func Read(reader io.Reader) (Unit, error) {
+	unit := make(Unit)
+
+	var section string
+	var continued string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// a trailing backslash continues the setting on the next line
+		if strings.HasSuffix(line, "\\") {
+			continued += strings.TrimSpace(strings.TrimSuffix(line, "\\")) + " "
+			continue
+		}
+		line, continued = continued+line, ""
+
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSuffix(strings.TrimPrefix(line, "["), "]")
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found || section == "" {
+			continue
+		}
+
+		if unit[section] == nil {
+			unit[section] = make(map[string][]string)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if value == "" {
+			delete(unit[section], key)
+			continue
+		}
+		unit[section][key] = append(unit[section][key], value)
+	}
+
+	return unit, scanner.Err()
+}
+
+// ReadRelatedUnit returns the unit with the given name referenced by the unit at the given location (e.g. the service
+// activated by a timer), which is searched for next to the referencing unit and then within the system unit directories.
+func ReadRelatedUnit(resolver file.Resolver, location file.Location, name string) (Unit, *file.Location) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	candidates := []string{path.Join(path.Dir(location.RealPath), name)}
+	for _, dir := range unitDirs {
+		candidates = append(candidates, path.Join(dir, name))
+	}
+
+	for _, candidate := range candidates {
+		unitLocation := resolver.RelativeFileByPath(location, candidate)
+		if unitLocation == nil {
+			continue
+		}
+
+		unit, err := readUnit(resolver, *unitLocation)
+		if err != nil {
+			log.WithFields("error", err, "path", candidate).Trace("unable to read systemd unit")
+			continue
+		}
+		return unit, unitLocation
+	}
+	return nil, nil
+}
+
+// ExecutablePath returns the program of an ExecStart (or similar) setting, which may be prefixed with special
+// characters changing how the program is run (e.g. "-" to ignore failures). Only absolute paths are returned.
+func ExecutablePath(command string) string {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return ""
+	}
+	executable := strings.TrimLeft(fields[0], "@-:+!|")
+	if !strings.HasPrefix(executable, "/") {
+		return ""
+	}
+	return executable
+}
+
+func readUnit(resolver file.Resolver, location file.Location) (Unit, error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	return Read(contents)
+}
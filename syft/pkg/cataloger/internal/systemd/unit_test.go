@@ -0,0 +1,58 @@
+package systemd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRead(t *testing.T) {
+	input := `
+ignored=before any section
+
+# a comment
+[Unit]
+Description=Daily apt download activities
+; another comment
+
+[Timer]
+OnCalendar=Mon..Fri 02:00
+OnCalendar=Sat,Sun 04:00
+Persistent=true
+Persistent=
+
+[Service]
+ExecStart=-/usr/bin/restic backup \
+    --exclude-caches /home
+`
+
+	unit, err := Read(strings.NewReader(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Daily apt download activities", unit.Value("Unit", "Description"))
+	assert.Equal(t, []string{"Mon..Fri 02:00", "Sat,Sun 04:00"}, unit.Values("Timer", "OnCalendar"))
+	assert.Empty(t, unit.Values("Timer", "Persistent"))
+	assert.Equal(t, "-/usr/bin/restic backup --exclude-caches /home", unit.Value("Service", "ExecStart"))
+	assert.Empty(t, unit.Value("Service", "missing"))
+	assert.Empty(t, unit.Value("missing", "ExecStart"))
+}
+
+func TestExecutablePath(t *testing.T) {
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{command: "/usr/lib/apt/apt.systemd.daily update", want: "/usr/lib/apt/apt.systemd.daily"},
+		{command: "-/usr/bin/restic backup", want: "/usr/bin/restic"},
+		{command: "@/usr/sbin/sshd sshd -D", want: "/usr/sbin/sshd"},
+		{command: "sshd -D", want: ""},
+		{command: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExecutablePath(tt.command))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package ios provides a concrete Cataloger implementation for iOS applications (IPA archives).
+*/
+package ios
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for iOS applications, describing each IPA archive by the Info.plist of
+// the app along with the frameworks and dynamic libraries embedded within the app.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("ios-app-cataloger").
+		WithParserByGlobs(parseIPA, "**/*.ipa")
+}
@@ -0,0 +1,58 @@
+package ios
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newAppPackage(entry pkg.IOSAppEntry, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.BundleIdentifier,
+		Version:   bundleVersion(entry.ShortVersion, entry.BundleVersion),
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.IOSAppPkg,
+		Metadata:  entry,
+	}
+	p.PURL = packageURL(pkg.IOSAppPkg.PackageURLType(), p.Name, p.Version)
+
+	p.SetID()
+
+	return p
+}
+
+// newFrameworkPackage returns a package for a framework bundle or dynamic library embedded within the app.
+func newFrameworkPackage(name, version string, entry pkg.IOSFrameworkEntry, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		PURL:      packageURL(pkg.IOSFrameworkPkg.PackageURLType(), name, version),
+		Type:      pkg.IOSFrameworkPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// bundleVersion returns the release version of a bundle, falling back to the build version (which is required, unlike
+// the release version).
+func bundleVersion(shortVersion, version string) string {
+	if shortVersion != "" {
+		return shortVersion
+	}
+	return version
+}
+
+func packageURL(ty, name, version string) string {
+	return packageurl.NewPackageURL(
+		ty,
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
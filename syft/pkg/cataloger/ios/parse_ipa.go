@@ -0,0 +1,214 @@
+package ios
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/macho"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/plist"
+)
+
+var _ generic.Parser = parseIPA
+
+const (
+	frameworkKind = "framework"
+	dylibKind     = "dylib"
+
+	infoPlist = "Info.plist"
+)
+
+// parseIPA is a parser function for IPA archives, returning a package for the app along with a package for each
+// framework and dynamic library (e.g. the Swift runtime libraries) embedded within the app.
+func parseIPA(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	archive, err := openArchive(reader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read IPA as a zip archive")
+		return nil, nil, nil
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var (
+		app        *pkg.Package
+		frameworks []pkg.Package
+		seen       = make(map[string]struct{})
+	)
+
+	addFramework := func(p pkg.Package) {
+		if _, exists := seen[p.PURL]; exists {
+			// the Swift runtime libraries are commonly found within both the app and the SwiftSupport directory
+			return
+		}
+		seen[p.PURL] = struct{}{}
+		frameworks = append(frameworks, p)
+	}
+
+	for _, f := range archive.File {
+		bundle, name := bundlePath(f.Name)
+		switch {
+		case bundle == "" && strings.HasPrefix(f.Name, "SwiftSupport/") && path.Ext(f.Name) == ".dylib":
+			addFramework(readDylib(f, reader.RealPath, location))
+		case bundle == "":
+			continue
+		case name == infoPlist:
+			entry, err := readAppEntry(f)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to parse iOS app Info.plist: %w", err)
+			}
+			if entry != nil {
+				p := newAppPackage(*entry, location)
+				app = &p
+			}
+		case path.Dir(name) == "Frameworks" && path.Ext(name) == ".dylib":
+			addFramework(readDylib(f, reader.RealPath, location))
+		case path.Dir(path.Dir(name)) == "Frameworks" && path.Ext(path.Dir(name)) == ".framework" && path.Base(name) == infoPlist:
+			p, err := readFramework(f, path.Dir(f.Name), location)
+			if err != nil {
+				log.WithFields("path", reader.RealPath, "framework", path.Dir(f.Name), "error", err).Trace("unable to read framework Info.plist")
+				continue
+			}
+			addFramework(p)
+		}
+	}
+
+	if app == nil {
+		return nil, nil, nil
+	}
+
+	pkgs := []pkg.Package{*app}
+	var relationships []artifact.Relationship
+	for _, f := range frameworks {
+		pkgs = append(pkgs, f)
+		relationships = append(relationships, artifact.Relationship{
+			From: f,
+			To:   *app,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	return pkgs, relationships, nil
+}
+
+func openArchive(reader io.ReadCloser) (*zip.Reader, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return zip.NewReader(unionReader, size)
+}
+
+// bundlePath returns the app bundle and the path within the bundle of the given archive entry
+// (e.g. "Payload/Hello.app/Frameworks/libswiftCore.dylib" becomes "Hello.app" and "Frameworks/libswiftCore.dylib").
+// Entries outside of the app bundle are returned with an empty bundle.
+func bundlePath(name string) (string, string) {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) != 3 || parts[0] != "Payload" || path.Ext(parts[1]) != ".app" {
+		return "", ""
+	}
+	return parts[1], parts[2]
+}
+
+func readAppEntry(f *zip.File) (*pkg.IOSAppEntry, error) {
+	dict, err := readPlist(f)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := pkg.IOSAppEntry{
+		BundleIdentifier: plist.String(dict, "CFBundleIdentifier"),
+		BundleName:       plist.String(dict, "CFBundleName"),
+		DisplayName:      plist.String(dict, "CFBundleDisplayName"),
+		ShortVersion:     plist.String(dict, "CFBundleShortVersionString"),
+		BundleVersion:    plist.String(dict, "CFBundleVersion"),
+		MinimumOSVersion: plist.String(dict, "MinimumOSVersion"),
+		Executable:       plist.String(dict, "CFBundleExecutable"),
+		Platforms:        plist.Strings(dict, "CFBundleSupportedPlatforms"),
+	}
+	if entry.BundleIdentifier == "" {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// readFramework returns a package for a framework bundle, described by the Info.plist within the bundle.
+func readFramework(f *zip.File, bundle string, location file.Location) (pkg.Package, error) {
+	dict, err := readPlist(f)
+	if err != nil {
+		return pkg.Package{}, err
+	}
+
+	entry := pkg.IOSFrameworkEntry{
+		Kind:             frameworkKind,
+		Path:             bundle,
+		BundleIdentifier: plist.String(dict, "CFBundleIdentifier"),
+		ShortVersion:     plist.String(dict, "CFBundleShortVersionString"),
+		BundleVersion:    plist.String(dict, "CFBundleVersion"),
+	}
+
+	name := plist.String(dict, "CFBundleName")
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(bundle), ".framework")
+	}
+
+	return newFrameworkPackage(name, bundleVersion(entry.ShortVersion, entry.BundleVersion), entry, location), nil
+}
+
+// readDylib returns a package for a standalone dynamic library, where the version is taken from the Mach-O binary when
+// available.
+func readDylib(f *zip.File, archivePath string, location file.Location) pkg.Package {
+	entry := pkg.IOSFrameworkEntry{
+		Kind: dylibKind,
+		Path: f.Name,
+	}
+
+	var version string
+	data, err := readFile(f)
+	if err == nil {
+		var info *macho.File
+		info, err = macho.Read(bytes.NewReader(data))
+		if err == nil && info.ID != nil {
+			version, entry.CompatibilityVersion = info.ID.CurrentVersion, info.ID.CompatibilityVersion
+		}
+	}
+	if err != nil {
+		log.WithFields("path", archivePath, "library", f.Name, "error", err).Trace("unable to read dynamic library version")
+	}
+
+	return newFrameworkPackage(strings.TrimSuffix(path.Base(f.Name), ".dylib"), version, entry, location)
+}
+
+func readPlist(f *zip.File) (map[string]any, error) {
+	data, err := readFile(f)
+	if err != nil {
+		return nil, err
+	}
+	return plist.Decode(data)
+}
+
+func readFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(rc, f.Name)
+
+	return io.ReadAll(rc)
+}
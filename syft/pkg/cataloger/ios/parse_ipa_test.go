@@ -0,0 +1,99 @@
+package ios
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseIPA(t *testing.T) {
+	location := file.NewLocation("test-fixtures/ipa/app.ipa")
+
+	app := pkg.Package{
+		Name:      "com.example.hello",
+		Version:   "1.2.3",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:ios/com.example.hello@1.2.3",
+		Type:      pkg.IOSAppPkg,
+		Metadata: pkg.IOSAppEntry{
+			BundleIdentifier: "com.example.hello",
+			BundleName:       "Hello",
+			DisplayName:      "Hello World",
+			ShortVersion:     "1.2.3",
+			BundleVersion:    "45",
+			MinimumOSVersion: "15.0",
+			Executable:       "Hello",
+			Platforms:        []string{"iPhoneOS"},
+		},
+	}
+
+	alamofire := pkg.Package{
+		Name:      "Alamofire",
+		Version:   "5.8.1",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:generic/Alamofire@5.8.1",
+		Type:      pkg.IOSFrameworkPkg,
+		Metadata: pkg.IOSFrameworkEntry{
+			Kind:             "framework",
+			Path:             "Payload/Hello.app/Frameworks/Alamofire.framework",
+			BundleIdentifier: "org.alamofire.Alamofire",
+			ShortVersion:     "5.8.1",
+			BundleVersion:    "1",
+		},
+	}
+
+	// without a bundle name or release version
+	analytics := pkg.Package{
+		Name:      "Analytics",
+		Version:   "2.0",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:generic/Analytics@2.0",
+		Type:      pkg.IOSFrameworkPkg,
+		Metadata: pkg.IOSFrameworkEntry{
+			Kind:             "framework",
+			Path:             "Payload/Hello.app/Frameworks/Analytics.framework",
+			BundleIdentifier: "com.example.Analytics",
+			BundleVersion:    "2.0",
+		},
+	}
+
+	// also found within the SwiftSupport directory
+	swiftCore := pkg.Package{
+		Name:      "libswiftCore",
+		Version:   "5.9.2",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:generic/libswiftCore@5.9.2",
+		Type:      pkg.IOSFrameworkPkg,
+		Metadata: pkg.IOSFrameworkEntry{
+			Kind:                 "dylib",
+			Path:                 "Payload/Hello.app/Frameworks/libswiftCore.dylib",
+			CompatibilityVersion: "1.0.0",
+		},
+	}
+
+	expectedPkgs := []pkg.Package{app, alamofire, analytics, swiftCore}
+
+	var expectedRelationships []artifact.Relationship
+	for _, f := range []pkg.Package{alamofire, analytics, swiftCore} {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{
+			From: f,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/ipa/app.ipa").
+		Expects(expectedPkgs, expectedRelationships).
+		TestParser(t, parseIPA)
+}
+
+func TestParseIPA_NotZip(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromFile(t, "test-fixtures/invalid/app.ipa").
+		Expects(nil, nil).
+		TestParser(t, parseIPA)
+}
@@ -133,9 +133,14 @@ func (j *archiveParser) parse(ctx context.Context) ([]pkg.Package, []artifact.Re
 	}
 	pkgs = append(pkgs, auxPkgs...)
 
+	// find artifacts shaded into the archive with relocated packages, which may not have kept their pom.properties
+	pkgs = append(pkgs, j.discoverPkgsFromRelocatedClasses(parentPkg, pkgs)...)
+
+	var nestedPkgs []pkg.Package
 	if j.detectNested {
 		// find nested java archive packages
-		nestedPkgs, nestedRelationships, err := j.discoverPkgsFromNestedArchives(ctx, parentPkg)
+		var nestedRelationships []artifact.Relationship
+		nestedPkgs, nestedRelationships, err = j.discoverPkgsFromNestedArchives(ctx, parentPkg)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -166,9 +171,34 @@ func (j *archiveParser) parse(ctx context.Context) ([]pkg.Package, []artifact.Re
 		p.SetID()
 	}
 
+	// relate the parent package to the packages of the archives directly nested within it (e.g. the jars
+	// within BOOT-INF/lib of a spring boot jar, or the wars within an ear), which in turn are related to the
+	// archives nested within them.
+	if parentPkg != nil {
+		relationships = append(relationships, nestedArchiveRelationships(pkgs[0], parentPkg, pkgs[len(pkgs)-len(nestedPkgs):])...)
+	}
+
 	return pkgs, relationships, nil
 }
 
+// nestedArchiveRelationships creates a containment relationship from the given parent package to each of the given
+// nested packages that were directly associated with it (as opposed to an archive nested further within).
+func nestedArchiveRelationships(parent pkg.Package, parentPkg *pkg.Package, nestedPkgs []pkg.Package) []artifact.Relationship {
+	var relationships []artifact.Relationship
+	for _, p := range nestedPkgs {
+		metadata, ok := p.Metadata.(pkg.JavaArchive)
+		if !ok || metadata.Parent != parentPkg {
+			continue
+		}
+		relationships = append(relationships, artifact.Relationship{
+			From: parent,
+			To:   p,
+			Type: artifact.ContainsRelationship,
+		})
+	}
+	return relationships
+}
+
 // discoverMainPackage parses the root Java manifest used as the parent package to all discovered nested packages.
 func (j *archiveParser) discoverMainPackage(ctx context.Context) (*pkg.Package, error) {
 	// search and parse java manifest files
@@ -226,6 +256,7 @@ func (j *archiveParser) discoverMainPackage(ctx context.Context) (*pkg.Package,
 			VirtualPath:    j.location.Path(),
 			Manifest:       manifest,
 			ArchiveDigests: digests,
+			SpringBoot:     newJavaSpringBoot(manifest),
 		},
 	}, nil
 }
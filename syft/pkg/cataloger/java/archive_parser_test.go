@@ -688,6 +688,105 @@ func TestParseNestedJar(t *testing.T) {
 	}
 }
 
+func TestParseNestedArchiveRelationships(t *testing.T) {
+	tests := []struct {
+		name             string
+		fixtureName      string
+		fileExtension    string
+		wantParents      map[string]string
+		wantContains     []string
+		wantSpringBootBy map[string]*pkg.JavaSpringBoot
+	}{
+		{
+			name:        "spring boot fat jar",
+			fixtureName: "spring-boot-app-1.0.0",
+			wantParents: map[string]string{
+				"spring-boot-app@1.0.0": "",
+				"greeter@2.1.0":         "spring-boot-app@1.0.0",
+			},
+			wantContains: []string{
+				"spring-boot-app@1.0.0 -> greeter@2.1.0",
+			},
+			wantSpringBootBy: map[string]*pkg.JavaSpringBoot{
+				"spring-boot-app@1.0.0": {
+					Version:        "3.2.4",
+					Launcher:       "org.springframework.boot.loader.launch.JarLauncher",
+					StartClass:     "com.example.Application",
+					Classes:        "BOOT-INF/classes/",
+					Lib:            "BOOT-INF/lib/",
+					ClasspathIndex: "BOOT-INF/classpath.idx",
+					LayersIndex:    "BOOT-INF/layers.idx",
+				},
+			},
+		},
+		{
+			name:          "jar in war in ear",
+			fixtureName:   "example-ear-1.0.0",
+			fileExtension: "ear",
+			wantParents: map[string]string{
+				"example-ear@1.0.0": "",
+				"example-web@1.0.0": "example-ear@1.0.0",
+				"greeter@2.1.0":     "example-web@1.0.0",
+			},
+			wantContains: []string{
+				"example-ear@1.0.0 -> example-web@1.0.0",
+				"example-web@1.0.0 -> greeter@2.1.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture, err := os.Open(generateJavaMetadataJarFixture(t, tt.fixtureName, tt.fileExtension))
+			require.NoError(t, err)
+
+			gap := newGenericArchiveParserAdapter(ArchiveCatalogerConfig{})
+			pkgs, relationships, err := gap.parseJavaArchive(context.Background(), nil, nil, file.LocationReadCloser{
+				Location:   file.NewLocation(fixture.Name()),
+				ReadCloser: fixture,
+			})
+			require.NoError(t, err)
+
+			key := func(p pkg.Package) string {
+				return p.Name + "@" + p.Version
+			}
+
+			gotParents := make(map[string]string)
+			gotSpringBootBy := make(map[string]*pkg.JavaSpringBoot)
+			byID := make(map[artifact.ID]pkg.Package)
+			for _, p := range pkgs {
+				byID[p.ID()] = p
+				metadata, ok := p.Metadata.(pkg.JavaArchive)
+				require.True(t, ok)
+				gotParents[key(p)] = ""
+				if metadata.Parent != nil {
+					gotParents[key(p)] = key(*metadata.Parent)
+				}
+				if metadata.SpringBoot != nil {
+					gotSpringBootBy[key(p)] = metadata.SpringBoot
+				}
+			}
+			assert.Equal(t, tt.wantParents, gotParents)
+
+			if tt.wantSpringBootBy == nil {
+				tt.wantSpringBootBy = make(map[string]*pkg.JavaSpringBoot)
+			}
+			assert.Equal(t, tt.wantSpringBootBy, gotSpringBootBy)
+
+			var gotContains []string
+			for _, r := range relationships {
+				require.Equal(t, artifact.ContainsRelationship, r.Type)
+				from, ok := byID[r.From.ID()]
+				require.True(t, ok, "relationship from unknown package %q", r.From.ID())
+				to, ok := byID[r.To.ID()]
+				require.True(t, ok, "relationship to unknown package %q", r.To.ID())
+				gotContains = append(gotContains, key(from)+" -> "+key(to))
+			}
+			assert.ElementsMatch(t, tt.wantContains, gotContains)
+		})
+	}
+}
+
 func Test_newPackageFromMavenData(t *testing.T) {
 	virtualPath := "given/virtual/path"
 	tests := []struct {
@@ -1270,6 +1369,75 @@ func Test_parseJavaArchive_regressions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "shaded dependencies with relocated packages",
+			fixtureName:  "shaded-app-1.0.0",
+			assignParent: true,
+			expectedPkgs: []pkg.Package{
+				{
+					Name:      "shaded-app",
+					Version:   "1.0.0",
+					Type:      pkg.JavaPkg,
+					Language:  pkg.Java,
+					PURL:      "pkg:maven/com.example/shaded-app@1.0.0",
+					Locations: file.NewLocationSet(file.NewLocation("test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar")),
+					Metadata: pkg.JavaArchive{
+						VirtualPath: "test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar",
+						Manifest: &pkg.JavaManifest{
+							Main: pkg.KeyValues{
+								{Key: "Manifest-Version", Value: "1.0"},
+								{Key: "Implementation-Title", Value: "shaded-app"},
+								{Key: "Implementation-Version", Value: "1.0.0"},
+								{Key: "Main-Class", Value: "com.example.App"},
+							},
+						},
+						PomProperties: &pkg.JavaPomProperties{
+							Path:       "META-INF/maven/com.example/shaded-app/pom.properties",
+							GroupID:    "com.example",
+							ArtifactID: "shaded-app",
+							Version:    "1.0.0",
+						},
+					},
+				},
+				{
+					// the pom.properties was kept when shading, so only the relocation is added
+					Name:      "guava",
+					Version:   "32.1.3-jre",
+					PURL:      "pkg:maven/com.google.guava/guava@32.1.3-jre",
+					Locations: file.NewLocationSet(file.NewLocation("test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar")),
+					Type:      pkg.JavaPkg,
+					Language:  pkg.Java,
+					Metadata: pkg.JavaArchive{
+						VirtualPath: "test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar:com.google.guava:guava",
+						PomProperties: &pkg.JavaPomProperties{
+							Path:       "META-INF/maven/com.google.guava/guava/pom.properties",
+							GroupID:    "com.google.guava",
+							ArtifactID: "guava",
+							Version:    "32.1.3-jre",
+						},
+						Relocation: &pkg.JavaRelocation{
+							OriginalPackage:  "com.google.common",
+							RelocatedPackage: "com.example.shaded.com.google.common",
+						},
+					},
+				},
+				{
+					// only the relocated classes remain, so the version is unknown
+					Name:      "commons-lang3",
+					PURL:      "pkg:maven/org.apache.commons/commons-lang3",
+					Locations: file.NewLocationSet(file.NewLocation("test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar")),
+					Type:      pkg.JavaPkg,
+					Language:  pkg.Java,
+					Metadata: pkg.JavaArchive{
+						VirtualPath: "test-fixtures/jar-metadata/cache/shaded-app-1.0.0.jar:org.apache.commons:commons-lang3",
+						Relocation: &pkg.JavaRelocation{
+							OriginalPackage:  "org.apache.commons.lang3",
+							RelocatedPackage: "com.example.shaded.org.apache.commons.lang3",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:         "exclude instrumentation jars with Weave-Classes in manifest",
 			fixtureName:  "spring-instrumentation-4.3.0-1.0",
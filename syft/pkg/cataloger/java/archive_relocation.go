@@ -0,0 +1,179 @@
+package java
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// knownRelocation is the package of a commonly shaded artifact along with a class that is characteristic of the
+// artifact, so that a relocated copy of the package can be told apart from an unrelated package of the same name.
+type knownRelocation struct {
+	// pkg is the original package of the artifact (slash separated, as within the archive)
+	pkg string
+	// class is the path of the characteristic class, relative to the package
+	class      string
+	groupID    string
+	artifactID string
+}
+
+// knownRelocations are the artifacts that are commonly shaded into uber-jars with relocated packages (e.g. by the
+// maven-shade-plugin or the gradle shadow plugin), which would otherwise be hidden when the pom.properties of the
+// artifact is not kept.
+var knownRelocations = []knownRelocation{
+	{pkg: "com/fasterxml/jackson/core", class: "JsonParser.class", groupID: "com.fasterxml.jackson.core", artifactID: "jackson-core"},
+	{pkg: "com/fasterxml/jackson/databind", class: "ObjectMapper.class", groupID: "com.fasterxml.jackson.core", artifactID: "jackson-databind"},
+	{pkg: "com/google/common", class: "collect/ImmutableList.class", groupID: "com.google.guava", artifactID: "guava"},
+	{pkg: "com/google/gson", class: "Gson.class", groupID: "com.google.code.gson", artifactID: "gson"},
+	{pkg: "com/google/protobuf", class: "GeneratedMessageV3.class", groupID: "com.google.protobuf", artifactID: "protobuf-java"},
+	{pkg: "io/grpc", class: "ManagedChannel.class", groupID: "io.grpc", artifactID: "grpc-api"},
+	{pkg: "io/netty/buffer", class: "ByteBuf.class", groupID: "io.netty", artifactID: "netty-buffer"},
+	{pkg: "io/netty/channel", class: "Channel.class", groupID: "io.netty", artifactID: "netty-transport"},
+	{pkg: "io/netty/handler/codec/http", class: "HttpObjectDecoder.class", groupID: "io.netty", artifactID: "netty-codec-http"},
+	{pkg: "io/netty/util", class: "Version.class", groupID: "io.netty", artifactID: "netty-common"},
+	{pkg: "kotlin", class: "KotlinVersion.class", groupID: "org.jetbrains.kotlin", artifactID: "kotlin-stdlib"},
+	{pkg: "net/bytebuddy", class: "ByteBuddy.class", groupID: "net.bytebuddy", artifactID: "byte-buddy"},
+	{pkg: "okhttp3", class: "OkHttpClient.class", groupID: "com.squareup.okhttp3", artifactID: "okhttp"},
+	{pkg: "okio", class: "Okio.class", groupID: "com.squareup.okio", artifactID: "okio"},
+	{pkg: "org/antlr/v4/runtime", class: "Parser.class", groupID: "org.antlr", artifactID: "antlr4-runtime"},
+	{pkg: "org/apache/commons/codec", class: "binary/Base64.class", groupID: "commons-codec", artifactID: "commons-codec"},
+	{pkg: "org/apache/commons/io", class: "IOUtils.class", groupID: "commons-io", artifactID: "commons-io"},
+	{pkg: "org/apache/commons/lang3", class: "StringUtils.class", groupID: "org.apache.commons", artifactID: "commons-lang3"},
+	{pkg: "org/apache/commons/text", class: "StringSubstitutor.class", groupID: "org.apache.commons", artifactID: "commons-text"},
+	{pkg: "org/apache/http", class: "HttpEntity.class", groupID: "org.apache.httpcomponents", artifactID: "httpcore"},
+	{pkg: "org/apache/http/impl/client", class: "HttpClients.class", groupID: "org.apache.httpcomponents", artifactID: "httpclient"},
+	{pkg: "org/apache/logging/log4j/core", class: "LoggerContext.class", groupID: "org.apache.logging.log4j", artifactID: "log4j-core"},
+	{pkg: "org/joda/time", class: "DateTime.class", groupID: "joda-time", artifactID: "joda-time"},
+	{pkg: "org/objectweb/asm", class: "ClassReader.class", groupID: "org.ow2.asm", artifactID: "asm"},
+	{pkg: "org/slf4j", class: "LoggerFactory.class", groupID: "org.slf4j", artifactID: "slf4j-api"},
+	{pkg: "org/yaml/snakeyaml", class: "Yaml.class", groupID: "org.yaml", artifactID: "snakeyaml"},
+}
+
+// classRootPattern matches the directories of an archive that hold classes without implying a relocation (e.g. the
+// classes of a spring boot application or a web application, or the classes of a multi-release jar).
+var classRootPattern = regexp.MustCompile(`^(?:BOOT-INF/classes/|WEB-INF/classes/)?(?:META-INF/versions/\d+/)?`)
+
+type relocatedArtifact struct {
+	known            knownRelocation
+	relocatedPackage string
+}
+
+// discoverPkgsFromRelocatedClasses finds the artifacts that were shaded into the archive with relocated packages. The
+// already discovered packages (from the pom.properties kept by the shading tool) are annotated with the relocation,
+// otherwise a package is returned for the artifact (where the version is unknown).
+func (j *archiveParser) discoverPkgsFromRelocatedClasses(parentPkg *pkg.Package, discovered []pkg.Package) []pkg.Package {
+	if parentPkg == nil {
+		return nil
+	}
+
+	var pkgs []pkg.Package
+	for _, r := range findRelocatedArtifacts(j.fileManifest) {
+		relocation := &pkg.JavaRelocation{
+			OriginalPackage:  strings.ReplaceAll(r.known.pkg, "/", "."),
+			RelocatedPackage: strings.ReplaceAll(r.relocatedPackage, "/", "."),
+		}
+
+		if annotateRelocation(discovered, r.known, relocation) {
+			continue
+		}
+
+		pkgs = append(pkgs, newPackageFromRelocation(r.known, relocation, parentPkg, j.location))
+	}
+
+	return pkgs
+}
+
+// findRelocatedArtifacts returns the known artifacts with a characteristic class found beneath a package other than the
+// original package of the artifact.
+func findRelocatedArtifacts(manifest intFile.ZipFileManifest) []relocatedArtifact {
+	relocated := make(map[int]string)
+	for entry := range manifest {
+		if !strings.HasSuffix(entry, ".class") {
+			continue
+		}
+		for i, known := range knownRelocations {
+			suffix := known.pkg + "/" + known.class
+			if !strings.HasSuffix(entry, "/"+suffix) {
+				continue
+			}
+
+			prefix := strings.TrimSuffix(entry, suffix)
+			prefix = prefix[len(classRootPattern.FindString(prefix)):]
+			if prefix == "" {
+				// the classes are not relocated (e.g. the archive is the artifact itself)
+				continue
+			}
+
+			// prefer the shortest relocation (and so a stable choice) when the artifact is shaded more than once
+			relocatedPackage := prefix + known.pkg
+			if existing, ok := relocated[i]; !ok || len(relocatedPackage) < len(existing) ||
+				(len(relocatedPackage) == len(existing) && relocatedPackage < existing) {
+				relocated[i] = relocatedPackage
+			}
+		}
+	}
+
+	var results []relocatedArtifact
+	for i, relocatedPackage := range relocated {
+		results = append(results, relocatedArtifact{
+			known:            knownRelocations[i],
+			relocatedPackage: relocatedPackage,
+		})
+	}
+	sort.Slice(results, func(a, b int) bool {
+		return results[a].relocatedPackage < results[b].relocatedPackage
+	})
+
+	return results
+}
+
+// annotateRelocation sets the relocation of the discovered package for the given artifact, returning false if the
+// artifact has not been discovered.
+func annotateRelocation(discovered []pkg.Package, known knownRelocation, relocation *pkg.JavaRelocation) bool {
+	for i := range discovered {
+		metadata, ok := discovered[i].Metadata.(pkg.JavaArchive)
+		if !ok || metadata.PomProperties == nil {
+			continue
+		}
+		if metadata.PomProperties.GroupID != known.groupID || metadata.PomProperties.ArtifactID != known.artifactID {
+			continue
+		}
+		metadata.Relocation = relocation
+		discovered[i].Metadata = metadata
+		return true
+	}
+	return false
+}
+
+func newPackageFromRelocation(known knownRelocation, relocation *pkg.JavaRelocation, parentPkg *pkg.Package, location file.Location) pkg.Package {
+	return pkg.Package{
+		Name: known.artifactID,
+		Locations: file.NewLocationSet(
+			location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+		Language: pkg.Java,
+		Type:     pkg.JavaPkg,
+		Metadata: pkg.JavaArchive{
+			VirtualPath: location.Path() + ":" + known.groupID + ":" + known.artifactID,
+			Relocation:  relocation,
+			Parent:      parentPkg,
+		},
+	}
+}
+
+// groupIDFromRelocation returns the group ID of the known artifact that was relocated.
+func groupIDFromRelocation(relocation *pkg.JavaRelocation) string {
+	if relocation == nil {
+		return ""
+	}
+	for _, known := range knownRelocations {
+		if strings.ReplaceAll(known.pkg, "/", ".") == relocation.OriginalPackage {
+			return known.groupID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	intFile "github.com/anchore/syft/internal/file"
+)
+
+func Test_findRelocatedArtifacts(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []string
+		want    map[string]string
+	}{
+		{
+			name: "relocated packages",
+			entries: []string{
+				"com/example/shaded/org/apache/commons/lang3/StringUtils.class",
+				"com/example/shaded/io/netty/buffer/ByteBuf.class",
+			},
+			want: map[string]string{
+				"commons-lang3": "com/example/shaded/org/apache/commons/lang3",
+				"netty-buffer":  "com/example/shaded/io/netty/buffer",
+			},
+		},
+		{
+			name: "packages that are not relocated",
+			entries: []string{
+				"org/apache/commons/lang3/StringUtils.class",
+				"BOOT-INF/classes/com/google/gson/Gson.class",
+				"META-INF/versions/11/org/yaml/snakeyaml/Yaml.class",
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "relocated packages within class roots",
+			entries: []string{
+				"WEB-INF/classes/com/example/repackaged/com/google/gson/Gson.class",
+				"META-INF/versions/9/com/example/repackaged/org/objectweb/asm/ClassReader.class",
+			},
+			want: map[string]string{
+				"gson": "com/example/repackaged/com/google/gson",
+				"asm":  "com/example/repackaged/org/objectweb/asm",
+			},
+		},
+		{
+			name: "packages without the characteristic class",
+			entries: []string{
+				"com/example/shaded/org/apache/commons/lang3/Other.class",
+				"com/example/shaded/org/slf4j/Logger.class",
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "the shortest relocation is preferred",
+			entries: []string{
+				"com/example/internal/shaded/okio/Okio.class",
+				"com/example/shaded/okio/Okio.class",
+			},
+			want: map[string]string{
+				"okio": "com/example/shaded/okio",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := make(intFile.ZipFileManifest)
+			for _, entry := range tt.entries {
+				manifest.Add(entry, nil)
+			}
+
+			got := make(map[string]string)
+			for _, r := range findRelocatedArtifacts(manifest) {
+				got[r.known.artifactID] = r.relocatedPackage
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
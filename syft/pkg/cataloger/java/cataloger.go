@@ -43,3 +43,23 @@ func NewGradleLockfileCataloger() pkg.Cataloger {
 	return generic.NewCataloger("java-gradle-lockfile-cataloger").
 		WithParserByGlobs(parseGradleLockfile, gradleLockfileGlob)
 }
+
+// NewBuildToolWrapperCataloger returns a cataloger capable of parsing the build tools pinned by the Gradle and Maven
+// wrappers (gradle-wrapper.properties and maven-wrapper.properties), so that the build toolchain is included.
+func NewBuildToolWrapperCataloger() pkg.Cataloger {
+	return generic.NewCataloger("java-build-tool-wrapper-cataloger").
+		WithParserByGlobs(parseGradleWrapperProperties, gradleWrapperPropertiesGlob).
+		WithParserByGlobs(parseMavenWrapperProperties, mavenWrapperPropertiesGlob)
+}
+
+// NewOSGiBundleCataloger returns a cataloger capable of finding the OSGi bundles installed within OSGi runtimes (such as
+// Eclipse-based products, Apache Karaf, and Apache Felix) or published within Eclipse p2 repositories, which are
+// identified by their bundle symbolic name rather than by maven coordinates.
+func NewOSGiBundleCataloger() pkg.Cataloger {
+	return generic.NewCataloger("java-osgi-bundle-cataloger").
+		WithParserByGlobs(parseOSGiBundleManifest, osgiBundleManifestGlob).
+		WithParserByGlobs(parseFelixBundleCache, felixBundleCacheGlob).
+		WithParserByGlobs(parseEclipseBundlesInfo, eclipseBundlesInfoGlob).
+		WithParserByGlobs(parseP2ArtifactsXML, p2ArtifactsXMLGlob).
+		WithParserByGlobs(parseP2ArtifactsJar, p2ArtifactsJarGlob)
+}
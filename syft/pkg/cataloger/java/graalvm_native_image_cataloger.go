@@ -139,8 +139,9 @@ func getPackage(component nativeImageComponent) pkg.Package {
 	}
 }
 
-// decompressSbom returns the packages given within a native image executable's SBOM.
-func decompressSbom(dataBuf []byte, sbomStart uint64, lengthStart uint64) ([]pkg.Package, error) {
+// decompressSbom returns the packages given within a native image executable's SBOM. The SBOM length is stored
+// using the byte order of the target platform (not the platform syft is running on).
+func decompressSbom(dataBuf []byte, sbomStart uint64, lengthStart uint64, order binary.ByteOrder) ([]pkg.Package, error) {
 	var pkgs []pkg.Package
 
 	lengthEnd := lengthStart + 8
@@ -152,7 +153,7 @@ func decompressSbom(dataBuf []byte, sbomStart uint64, lengthStart uint64) ([]pkg
 	length := dataBuf[lengthStart:lengthEnd]
 	p := bytes.NewBuffer(length)
 	var storedLength uint64
-	err := binary.Read(p, binary.LittleEndian, &storedLength)
+	err := binary.Read(p, order, &storedLength)
 	if err != nil {
 		return nil, fmt.Errorf("could not read from binary file: %w", err)
 	}
@@ -340,7 +341,7 @@ func (ni nativeImageElf) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbom.Value - dataSectionBase
 	lengthLocation := sbomLength.Value - dataSectionBase
 
-	return decompressSbom(data, sbomLocation, lengthLocation)
+	return decompressSbom(data, sbomLocation, lengthLocation, ni.file.ByteOrder)
 }
 
 // fetchPkgs obtains the packages from a Native Image given as a Mach O file.
@@ -387,7 +388,7 @@ func (ni nativeImageMachO) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbom.Value - dataSegment.Addr
 	lengthLocation := sbomLength.Value - dataSegment.Addr
 
-	return decompressSbom(dataBuf, sbomLocation, lengthLocation)
+	return decompressSbom(dataBuf, sbomLocation, lengthLocation, ni.file.ByteOrder)
 }
 
 // fetchExportAttribute obtains an attribute from the exported symbols directory entry.
@@ -536,7 +537,8 @@ func (ni nativeImagePE) fetchPkgs() (pkgs []pkg.Package, retErr error) {
 	sbomLocation := sbomAddress - dataSection.VirtualAddress
 	lengthLocation := sbomLengthAddress - dataSection.VirtualAddress
 
-	return decompressSbom(dataBuf, uint64(sbomLocation), uint64(lengthLocation))
+	// PE files are always little endian
+	return decompressSbom(dataBuf, uint64(sbomLocation), uint64(lengthLocation), binary.LittleEndian)
 }
 
 // fetchPkgs provides the packages available in a UnionReader.
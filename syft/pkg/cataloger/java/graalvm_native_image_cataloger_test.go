@@ -112,25 +112,27 @@ func TestParseNativeImageSbom(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		t.Run(path.Base(test.fixture), func(t *testing.T) {
-			// Create a buffer to resemble a compressed SBOM in a native image.
-			sbom, err := os.ReadFile(test.fixture)
-			assert.NoError(t, err)
-			var b bytes.Buffer
-			writebytes := bufio.NewWriter(&b)
-			z := gzip.NewWriter(writebytes)
-			_, err = z.Write(sbom)
-			assert.NoError(t, err)
-			_ = z.Close()
-			_ = writebytes.Flush()
-			compressedsbom := b.Bytes()
-			sbomlength := uint64(len(compressedsbom))
-			_ = binary.Write(writebytes, binary.LittleEndian, sbomlength)
-			_ = writebytes.Flush()
-			compressedsbom = b.Bytes()
-			actual, err := decompressSbom(compressedsbom, 0, sbomlength)
-			assert.NoError(t, err)
-			assert.Equal(t, test.expected, actual)
-		})
+		for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+			t.Run(path.Base(test.fixture)+"/"+order.String(), func(t *testing.T) {
+				// Create a buffer to resemble a compressed SBOM in a native image.
+				sbom, err := os.ReadFile(test.fixture)
+				assert.NoError(t, err)
+				var b bytes.Buffer
+				writebytes := bufio.NewWriter(&b)
+				z := gzip.NewWriter(writebytes)
+				_, err = z.Write(sbom)
+				assert.NoError(t, err)
+				_ = z.Close()
+				_ = writebytes.Flush()
+				compressedsbom := b.Bytes()
+				sbomlength := uint64(len(compressedsbom))
+				_ = binary.Write(writebytes, order, sbomlength)
+				_ = writebytes.Flush()
+				compressedsbom = b.Bytes()
+				actual, err := decompressSbom(compressedsbom, 0, sbomlength, order)
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, actual)
+			})
+		}
 	}
 }
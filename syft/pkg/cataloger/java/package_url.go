@@ -30,8 +30,9 @@ func packageURL(name, version string, metadata pkg.JavaArchive) string {
 // The order of precedence is:
 // 1. The group ID from the POM properties
 // 2. The group ID from the POM project
-// 3. The group ID from a select map of known group IDs
-// 4. The group ID from the Java manifest
+// 3. The group ID of a known artifact that was relocated into a shaded archive
+// 4. The group ID from a select map of known group IDs
+// 5. The group ID from the Java manifest
 func groupIDFromJavaMetadata(pkgName string, metadata pkg.JavaArchive) (groupID string) {
 	if groupID = groupIDFromPomProperties(metadata.PomProperties); groupID != "" {
 		return groupID
@@ -41,6 +42,10 @@ func groupIDFromJavaMetadata(pkgName string, metadata pkg.JavaArchive) (groupID
 		return groupID
 	}
 
+	if groupID = groupIDFromRelocation(metadata.Relocation); groupID != "" {
+		return groupID
+	}
+
 	if groupID = groupIDFromKnownPackageList(pkgName); groupID != "" {
 		return groupID
 	}
@@ -0,0 +1,211 @@
+package java
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	gradleWrapperPropertiesGlob = "**/gradle/wrapper/gradle-wrapper.properties"
+	mavenWrapperPropertiesGlob  = "**/.mvn/wrapper/maven-wrapper.properties"
+
+	// mavenWrapperScript is the wrapper script at the root of the project (the parent of the .mvn directory)
+	mavenWrapperScript = "mvnw"
+)
+
+var (
+	_ generic.Parser = parseGradleWrapperProperties
+	_ generic.Parser = parseMavenWrapperProperties
+)
+
+var (
+	// https://services.gradle.org/distributions/gradle-8.5-bin.zip
+	gradleDistributionPattern = regexp.MustCompile(`/gradle-(?P<version>[^/]+?)-(?:bin|all)\.zip$`)
+
+	// https://repo.maven.apache.org/maven2/org/apache/maven/apache-maven/3.9.6/apache-maven-3.9.6-bin.zip
+	mavenDistributionPattern = regexp.MustCompile(`/apache-maven-(?P<version>[^/]+?)-bin\.(?:zip|tar\.gz)$`)
+
+	// https://repo.maven.apache.org/maven2/org/apache/maven/wrapper/maven-wrapper/3.2.0/maven-wrapper-3.2.0.jar
+	mavenWrapperJarPattern = regexp.MustCompile(`/maven-wrapper-(?P<version>[^/]+?)\.jar$`)
+
+	// # Apache Maven Wrapper startup batch script, version 3.2.0
+	mavenWrapperScriptPattern = regexp.MustCompile(`(?m)^# Apache Maven Wrapper startup (?:batch |shell )?script, version (?P<version>\S+)`)
+
+	// propertyUnescaper unescapes the separators within values of a properties file
+	propertyUnescaper = strings.NewReplacer(`\:`, ":", `\=`, "=", `\\`, `\`)
+)
+
+// parseGradleWrapperProperties is a parser function for the Gradle wrapper configuration, returning the Gradle
+// distribution pinned by the wrapper.
+func parseGradleWrapperProperties(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	props, err := readProperties(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse gradle-wrapper.properties: %w", err)
+	}
+
+	url := props["distributionUrl"]
+	version := internal.MatchNamedCaptureGroups(gradleDistributionPattern, url)["version"]
+	if version == "" {
+		return nil, nil, nil
+	}
+
+	purl := packageurl.NewPackageURL(
+		packageurl.TypeGeneric,
+		"",
+		"gradle",
+		version,
+		packageurl.Qualifiers{{Key: "download_url", Value: url}},
+		"",
+	).ToString()
+
+	entry := pkg.JavaBuildToolWrapperEntry{
+		DistributionURL:       url,
+		DistributionSha256Sum: props["distributionSha256Sum"],
+	}
+
+	return []pkg.Package{
+		newBuildToolWrapperPackage("gradle", version, purl, entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// parseMavenWrapperProperties is a parser function for the Maven wrapper configuration, returning the Maven
+// distribution pinned by the wrapper along with the wrapper itself. The version of the wrapper is taken from the
+// configuration when given, otherwise from the mvnw script at the root of the project.
+func parseMavenWrapperProperties(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	props, err := readProperties(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse maven-wrapper.properties: %w", err)
+	}
+
+	primary := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+
+	url := props["distributionUrl"]
+	if version := internal.MatchNamedCaptureGroups(mavenDistributionPattern, url)["version"]; version != "" {
+		entry := pkg.JavaBuildToolWrapperEntry{
+			DistributionURL:       url,
+			DistributionSha256Sum: props["distributionSha256Sum"],
+		}
+		pkgs = append(pkgs, newBuildToolWrapperPackage("apache-maven", version, mavenPackageURL("org.apache.maven", "apache-maven", version), entry, primary))
+	}
+
+	wrapperURL := props["wrapperUrl"]
+	version := props["wrapperVersion"]
+	if version == "" {
+		version = internal.MatchNamedCaptureGroups(mavenWrapperJarPattern, wrapperURL)["version"]
+	}
+	locations := []file.Location{primary}
+	if version == "" {
+		var script *file.Location
+		version, script = readMavenWrapperScriptVersion(resolver, reader.Location)
+		if script != nil {
+			locations = append(locations, script.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+	if version != "" {
+		entry := pkg.JavaBuildToolWrapperEntry{
+			DistributionURL:       wrapperURL,
+			DistributionSha256Sum: props["wrapperSha256Sum"],
+		}
+		pkgs = append(pkgs, newBuildToolWrapperPackage("maven-wrapper", version, mavenPackageURL("org.apache.maven.wrapper", "maven-wrapper", version), entry, locations...))
+	}
+
+	return pkgs, nil, nil
+}
+
+// readMavenWrapperScriptVersion returns the version of the Maven wrapper stated by the mvnw script at the root of the
+// project holding the given wrapper configuration (.mvn/wrapper/maven-wrapper.properties), along with the location of
+// the script.
+func readMavenWrapperScriptVersion(resolver file.Resolver, config file.Location) (string, *file.Location) {
+	if resolver == nil {
+		return "", nil
+	}
+
+	root := path.Dir(path.Dir(path.Dir(config.Path())))
+	location := resolver.RelativeFileByPath(config, path.Join(root, mavenWrapperScript))
+	if location == nil {
+		return "", nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read maven wrapper script")
+		return "", nil
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read maven wrapper script")
+		return "", nil
+	}
+
+	version := internal.MatchNamedCaptureGroups(mavenWrapperScriptPattern, string(data))["version"]
+	if version == "" {
+		return "", nil
+	}
+	return version, location
+}
+
+func newBuildToolWrapperPackage(name, version, purl string, entry pkg.JavaBuildToolWrapperEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		Language:  pkg.Java,
+		Type:      pkg.JavaPkg,
+		PURL:      purl,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func mavenPackageURL(groupID, artifactID, version string) string {
+	return packageurl.NewPackageURL(packageurl.TypeMaven, groupID, artifactID, version, nil, "").ToString()
+}
+
+// readProperties returns the key-value pairs of a Java properties file, where escaped separators within values are
+// unescaped (e.g. "https\://services.gradle.org").
+func readProperties(reader io.Reader) (map[string]string, error) {
+	props := make(map[string]string)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// ignore empty lines and comments
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+
+		idx := strings.IndexAny(line, "=:")
+		if idx == -1 {
+			continue
+		}
+
+		key := strings.TrimSpace(line[:idx])
+		value := strings.TrimSpace(line[idx+1:])
+		props[key] = propertyUnescaper.Replace(value)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
@@ -0,0 +1,126 @@
+package java
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_BuildToolWrapperCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"gradle/wrapper/gradle-wrapper.properties",
+			".mvn/wrapper/maven-wrapper.properties",
+		}).
+		IgnoreUnfulfilledPathResponses("mvnw").
+		TestCataloger(t, NewBuildToolWrapperCataloger())
+}
+
+func Test_BuildToolWrapperCataloger(t *testing.T) {
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	const (
+		gradleConfig       = "gradle-app/gradle/wrapper/gradle-wrapper.properties"
+		mavenConfig        = "maven-app/.mvn/wrapper/maven-wrapper.properties"
+		mavenLegacyConfig  = "maven-legacy-app/.mvn/wrapper/maven-wrapper.properties"
+		mavenLegacyWrapper = "maven-legacy-app/mvnw"
+	)
+
+	expected := []pkg.Package{
+		{
+			Name:      "gradle",
+			Version:   "8.5",
+			FoundBy:   "java-build-tool-wrapper-cataloger",
+			Locations: file.NewLocationSet(primary(gradleConfig)),
+			Language:  pkg.Java,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:generic/gradle@8.5?download_url=https://services.gradle.org/distributions/gradle-8.5-bin.zip",
+			Metadata: pkg.JavaBuildToolWrapperEntry{
+				DistributionURL:       "https://services.gradle.org/distributions/gradle-8.5-bin.zip",
+				DistributionSha256Sum: "9d926787066a081739e8200858338b4a69e837c3a821a33aca9db09dd4a41026",
+			},
+		},
+		{
+			Name:      "apache-maven",
+			Version:   "3.9.6",
+			FoundBy:   "java-build-tool-wrapper-cataloger",
+			Locations: file.NewLocationSet(primary(mavenConfig)),
+			Language:  pkg.Java,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:maven/org.apache.maven/apache-maven@3.9.6",
+			Metadata: pkg.JavaBuildToolWrapperEntry{
+				DistributionURL:       "https://repo.maven.apache.org/maven2/org/apache/maven/apache-maven/3.9.6/apache-maven-3.9.6-bin.zip",
+				DistributionSha256Sum: "6eedd2cae3626d6ad3a5c9ee324bd265853d64297f07f033430755bd0e0c3a4b",
+			},
+		},
+		{
+			Name:      "maven-wrapper",
+			Version:   "3.2.0",
+			FoundBy:   "java-build-tool-wrapper-cataloger",
+			Locations: file.NewLocationSet(primary(mavenConfig)),
+			Language:  pkg.Java,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:maven/org.apache.maven.wrapper/maven-wrapper@3.2.0",
+			Metadata: pkg.JavaBuildToolWrapperEntry{
+				DistributionURL: "https://repo.maven.apache.org/maven2/org/apache/maven/wrapper/maven-wrapper/3.2.0/maven-wrapper-3.2.0.jar",
+			},
+		},
+		{
+			Name:      "apache-maven",
+			Version:   "3.6.3",
+			FoundBy:   "java-build-tool-wrapper-cataloger",
+			Locations: file.NewLocationSet(primary(mavenLegacyConfig)),
+			Language:  pkg.Java,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:maven/org.apache.maven/apache-maven@3.6.3",
+			Metadata: pkg.JavaBuildToolWrapperEntry{
+				DistributionURL: "https://repo.maven.apache.org/maven2/org/apache/maven/apache-maven/3.6.3/apache-maven-3.6.3-bin.zip",
+			},
+		},
+		{
+			// the version of the wrapper is only stated by the wrapper script
+			Name:      "maven-wrapper",
+			Version:   "3.1.1",
+			FoundBy:   "java-build-tool-wrapper-cataloger",
+			Locations: file.NewLocationSet(primary(mavenLegacyConfig), supporting(mavenLegacyWrapper)),
+			Language:  pkg.Java,
+			Type:      pkg.JavaPkg,
+			PURL:      "pkg:maven/org.apache.maven.wrapper/maven-wrapper@3.1.1",
+			Metadata:  pkg.JavaBuildToolWrapperEntry{},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/build-tool-wrappers").
+		Expects(expected, nil).
+		TestCataloger(t, NewBuildToolWrapperCataloger())
+}
+
+func Test_readProperties(t *testing.T) {
+	props, err := readProperties(strings.NewReader(`# comment
+! another comment
+distributionUrl=https\://services.gradle.org/distributions/gradle-8.5-all.zip
+wrapperVersion = 3.3.2
+
+networkTimeout: 10000
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"distributionUrl": "https://services.gradle.org/distributions/gradle-8.5-all.zip",
+		"wrapperVersion":  "3.3.2",
+		"networkTimeout":  "10000",
+	}, props)
+}
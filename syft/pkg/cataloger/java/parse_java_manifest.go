@@ -281,3 +281,27 @@ func fieldValueFromManifest(manifest pkg.JavaManifest, fieldName string) string
 
 	return ""
 }
+
+// newJavaSpringBoot extracts the details of the Spring Boot loader from the manifest of an executable archive that
+// was repackaged by Spring Boot (e.g. a fat jar or an executable war), returning nil for any other archive.
+func newJavaSpringBoot(manifest *pkg.JavaManifest) *pkg.JavaSpringBoot {
+	if manifest == nil {
+		return nil
+	}
+
+	launcher := manifest.Main.MustGet("Main-Class")
+	version := manifest.Main.MustGet("Spring-Boot-Version")
+	if version == "" && !strings.HasPrefix(launcher, "org.springframework.boot.loader.") {
+		return nil
+	}
+
+	return &pkg.JavaSpringBoot{
+		Version:        version,
+		Launcher:       launcher,
+		StartClass:     manifest.Main.MustGet("Start-Class"),
+		Classes:        manifest.Main.MustGet("Spring-Boot-Classes"),
+		Lib:            manifest.Main.MustGet("Spring-Boot-Lib"),
+		ClasspathIndex: manifest.Main.MustGet("Spring-Boot-Classpath-Index"),
+		LayersIndex:    manifest.Main.MustGet("Spring-Boot-Layers-Index"),
+	}
+}
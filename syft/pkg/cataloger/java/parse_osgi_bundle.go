@@ -0,0 +1,263 @@
+package java
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	// bundles that are installed unpacked (e.g. within the plugins directory of an Eclipse-based product)
+	osgiBundleManifestGlob = "**/META-INF/MANIFEST.MF"
+
+	// bundles installed within the cache of the Apache Felix framework (also used by Apache Karaf), e.g.
+	// felix-cache/bundle12/version0.0/bundle.jar
+	felixBundleCacheGlob = "**/bundle*/version*/bundle.jar"
+
+	// bundles installed within an Eclipse-based product, as configured by the simple configurator
+	eclipseBundlesInfoGlob = "**/org.eclipse.equinox.simpleconfigurator/bundles.info"
+
+	// p2 artifact repositories (an Eclipse installation, a bundle pool, or an update site)
+	p2ArtifactsXMLGlob = "**/artifacts.xml"
+	p2ArtifactsJarGlob = "**/artifacts.jar"
+
+	p2ArtifactsXML = "artifacts.xml"
+
+	p2BundleClassifier  = "osgi.bundle"
+	p2FeatureClassifier = "org.eclipse.update.feature"
+)
+
+var (
+	_ generic.Parser = parseOSGiBundleManifest
+	_ generic.Parser = parseFelixBundleCache
+	_ generic.Parser = parseEclipseBundlesInfo
+	_ generic.Parser = parseP2ArtifactsXML
+	_ generic.Parser = parseP2ArtifactsJar
+)
+
+type p2ArtifactRepository struct {
+	XMLName   xml.Name `xml:"repository"`
+	Artifacts []struct {
+		Classifier string `xml:"classifier,attr"`
+		ID         string `xml:"id,attr"`
+		Version    string `xml:"version,attr"`
+	} `xml:"artifacts>artifact"`
+}
+
+// parseOSGiBundleManifest is a parser function for the manifest of bundles that are installed unpacked, returning the
+// bundle described by the manifest (manifests without a Bundle-SymbolicName header are not OSGi bundles and are ignored).
+func parseOSGiBundleManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	manifest, err := parseJavaManifest(reader.RealPath, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, ok := newOSGiBundlePackageFromManifest(manifest, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+	if !ok {
+		return nil, nil, nil
+	}
+	return []pkg.Package{p}, nil, nil
+}
+
+// parseFelixBundleCache is a parser function for the bundles installed within the cache of the Apache Felix framework,
+// returning the bundle described by the manifest within the cached bundle jar.
+func parseFelixBundleCache(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := readZipEntry(reader, manifestGlob[1:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read cached bundle: %w", err)
+	}
+	if contents == nil {
+		return nil, nil, nil
+	}
+
+	manifest, err := parseJavaManifest(reader.RealPath, bytes.NewReader(contents))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p, ok := newOSGiBundlePackageFromManifest(manifest, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+	if !ok {
+		return nil, nil, nil
+	}
+	return []pkg.Package{p}, nil, nil
+}
+
+// parseEclipseBundlesInfo is a parser function for the bundles.info file of the Eclipse simple configurator, returning
+// the bundles installed within an Eclipse-based product. Each line is of the form
+// "<symbolic-name>,<version>,<location>,<start-level>,<auto-start>".
+func parseEclipseBundlesInfo(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) < 3 || fields[0] == "" {
+			continue
+		}
+
+		pkgs = append(pkgs, newOSGiBundlePackage(fields[0], fields[1], pkg.JavaOSGiBundleEntry{
+			BundleLocation: fields[2],
+		}, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("unable to read bundles.info: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
+
+// parseP2ArtifactsXML is a parser function for p2 artifact repositories, returning the bundles and features within the
+// repository (other artifacts, such as native launchers, are not considered).
+func parseP2ArtifactsXML(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	return p2Artifacts(reader, reader.Location)
+}
+
+// parseP2ArtifactsJar is a parser function for compressed p2 artifact repositories (an artifacts.xml file within a jar),
+// as commonly published by update sites.
+func parseP2ArtifactsJar(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := readZipEntry(reader, p2ArtifactsXML)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read p2 artifact repository: %w", err)
+	}
+	if contents == nil {
+		return nil, nil, nil
+	}
+
+	return p2Artifacts(bytes.NewReader(contents), reader.Location)
+}
+
+func p2Artifacts(reader io.Reader, location file.Location) ([]pkg.Package, []artifact.Relationship, error) {
+	var repository p2ArtifactRepository
+	if err := xml.NewDecoder(reader).Decode(&repository); err != nil {
+		var unexpected xml.UnmarshalError
+		if errors.As(err, &unexpected) {
+			// not all files named artifacts.xml are p2 artifact repositories
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("unable to parse p2 artifact repository: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, a := range repository.Artifacts {
+		if a.ID == "" || (a.Classifier != p2BundleClassifier && a.Classifier != p2FeatureClassifier) {
+			continue
+		}
+		pkgs = append(pkgs, newOSGiBundlePackage(a.ID, a.Version, pkg.JavaOSGiBundleEntry{
+			Classifier: a.Classifier,
+		}, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+
+	return pkgs, nil, nil
+}
+
+// newOSGiBundlePackageFromManifest returns a package for the bundle described by the given manifest, returning false if
+// the manifest does not describe an OSGi bundle.
+func newOSGiBundlePackageFromManifest(manifest *pkg.JavaManifest, location file.Location) (pkg.Package, bool) {
+	if manifest == nil {
+		return pkg.Package{}, false
+	}
+
+	name := headerValue(manifest.Main.MustGet("Bundle-SymbolicName"))
+	if name == "" {
+		return pkg.Package{}, false
+	}
+
+	entry := pkg.JavaOSGiBundleEntry{
+		BundleName:   localizedHeader(manifest.Main.MustGet("Bundle-Name")),
+		Vendor:       localizedHeader(manifest.Main.MustGet("Bundle-Vendor")),
+		FragmentHost: headerValue(manifest.Main.MustGet("Fragment-Host")),
+	}
+
+	licenses := pkg.NewLicensesFromLocation(location, selectLicenses(manifest)...)
+	return newOSGiBundlePackage(name, manifest.Main.MustGet("Bundle-Version"), entry, location, licenses...), true
+}
+
+func newOSGiBundlePackage(name, version string, entry pkg.JavaOSGiBundleEntry, location file.Location, licenses ...pkg.License) pkg.Package {
+	name = strings.TrimSpace(name)
+	version = strings.TrimSpace(version)
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		Licenses:  pkg.NewLicenseSet(licenses...),
+		Language:  pkg.Java,
+		Type:      pkg.OSGiBundlePkg,
+		PURL:      packageurl.NewPackageURL(pkg.OSGiBundlePkg.PackageURLType(), "", name, version, nil, "").ToString(),
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// headerValue returns the value of a manifest header without any attributes or directives (e.g.
+// "org.eclipse.core.runtime;singleton:=true" is "org.eclipse.core.runtime").
+func headerValue(value string) string {
+	if idx := strings.Index(value, ";"); idx >= 0 {
+		value = value[:idx]
+	}
+	return strings.TrimSpace(value)
+}
+
+// localizedHeader returns the value of a manifest header, unless the value is a key into the localization properties
+// of the bundle (e.g. "%pluginName").
+func localizedHeader(value string) string {
+	if strings.HasPrefix(value, "%") {
+		return ""
+	}
+	return value
+}
+
+// readZipEntry returns the contents of the given entry within a zip archive (or nil when there is no such entry).
+func readZipEntry(reader io.ReadCloser, name string) ([]byte, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(unionReader, size)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range archive.File {
+		if f.Name != name {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer internal.CloseAndLogError(rc, f.Name)
+
+		return io.ReadAll(rc)
+	}
+
+	return nil, nil
+}
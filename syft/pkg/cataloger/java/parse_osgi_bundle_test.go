@@ -0,0 +1,165 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_OSGiBundleCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/osgi-glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"eclipse/plugins/org.example_1.0.0/META-INF/MANIFEST.MF",
+			"eclipse/configuration/org.eclipse.equinox.simpleconfigurator/bundles.info",
+			"eclipse/artifacts.xml",
+			"site/artifacts.jar",
+			"felix-cache/bundle3/version0.0/bundle.jar",
+		}).
+		TestCataloger(t, NewOSGiBundleCataloger())
+}
+
+func Test_OSGiBundleCataloger(t *testing.T) {
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+
+	const (
+		runtimeManifest = "eclipse/plugins/org.eclipse.core.runtime_3.29.0.v20230726-0617/META-INF/MANIFEST.MF"
+		swtManifest     = "eclipse/plugins/org.eclipse.swt.gtk.linux.x86_64_3.124.0.v20230825-1346/META-INF/MANIFEST.MF"
+		bundlesInfo     = "eclipse/configuration/org.eclipse.equinox.simpleconfigurator/bundles.info"
+		artifactsXML    = "eclipse/artifacts.xml"
+		artifactsJar    = "update-site/artifacts.jar"
+		karafBundle     = "karaf/data/cache/bundle12/version0.0/bundle.jar"
+	)
+
+	// note: manifests without a symbolic name, unrelated artifacts.xml files, and p2 artifacts other than bundles and
+	// features are not considered
+	expected := []pkg.Package{
+		{
+			// localized headers are not used
+			Name:      "org.eclipse.core.runtime",
+			Version:   "3.29.0.v20230726-0617",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(runtimeManifest)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.core.runtime@3.29.0.v20230726-0617",
+			Metadata:  pkg.JavaOSGiBundleEntry{},
+		},
+		{
+			Name:      "org.eclipse.swt.gtk.linux.x86_64",
+			Version:   "3.124.0.v20230825-1346",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(swtManifest)),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(file.NewLocation(swtManifest), "EPL-2.0")...),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.swt.gtk.linux.x86_64@3.124.0.v20230825-1346",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				BundleName:   "Standard Widget Toolkit for GTK",
+				Vendor:       "Eclipse.org",
+				FragmentHost: "org.eclipse.swt",
+			},
+		},
+		{
+			Name:      "org.eclipse.core.runtime",
+			Version:   "3.29.0.v20230726-0617",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(bundlesInfo)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.core.runtime@3.29.0.v20230726-0617",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				BundleLocation: "plugins/org.eclipse.core.runtime_3.29.0.v20230726-0617/",
+			},
+		},
+		{
+			Name:      "org.eclipse.equinox.common",
+			Version:   "3.18.100.v20230730-1117",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(bundlesInfo)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.equinox.common@3.18.100.v20230730-1117",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				BundleLocation: "plugins/org.eclipse.equinox.common_3.18.100.v20230730-1117.jar",
+			},
+		},
+		{
+			Name:      "org.eclipse.equinox.common",
+			Version:   "3.18.100.v20230730-1117",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(artifactsXML)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.equinox.common@3.18.100.v20230730-1117",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				Classifier: "osgi.bundle",
+			},
+		},
+		{
+			Name:      "org.eclipse.platform",
+			Version:   "4.29.0.v20230831-0320",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(artifactsXML)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.eclipse.platform@4.29.0.v20230831-0320",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				Classifier: "org.eclipse.update.feature",
+			},
+		},
+		{
+			Name:      "com.example.tools",
+			Version:   "1.2.0.202401010000",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(artifactsJar)),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/com.example.tools@1.2.0.202401010000",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				Classifier: "osgi.bundle",
+			},
+		},
+		{
+			Name:      "org.apache.karaf.features.core",
+			Version:   "4.4.3",
+			FoundBy:   "java-osgi-bundle-cataloger",
+			Locations: file.NewLocationSet(primary(karafBundle)),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(file.NewLocation(karafBundle), "http://www.apache.org/licenses/LICENSE-2.0.txt")...),
+			Language:  pkg.Java,
+			Type:      pkg.OSGiBundlePkg,
+			PURL:      "pkg:generic/org.apache.karaf.features.core@4.4.3",
+			Metadata: pkg.JavaOSGiBundleEntry{
+				BundleName: "Apache Karaf :: Features :: Core",
+				Vendor:     "The Apache Software Foundation",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/osgi").
+		Expects(expected, nil).
+		TestCataloger(t, NewOSGiBundleCataloger())
+}
+
+func Test_headerValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "org.eclipse.core.runtime", want: "org.eclipse.core.runtime"},
+		{value: "org.eclipse.core.runtime; singleton:=true", want: "org.eclipse.core.runtime"},
+		{value: `org.eclipse.swt;bundle-version="[3.0.0,4.0.0)"`, want: "org.eclipse.swt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, headerValue(tt.value))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package javascript
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+)
+
+const (
+	// maxAsarHeaderSize caps the size of the JSON header of an asar archive
+	maxAsarHeaderSize = 64 * 1024 * 1024
+	// maxAsarMemberSize caps how much of any single file within an asar archive is read
+	maxAsarMemberSize = 16 * 1024 * 1024
+)
+
+// asarArchive is an Electron application archive (see https://github.com/electron/asar), which is laid out as a
+// pickled JSON header describing the file tree, followed by the concatenated contents of the files. Files marked as
+// unpacked are stored within the adjacent "app.asar.unpacked" directory instead.
+type asarArchive struct {
+	reader io.ReaderAt
+	// base is the offset of the file contents, which the offsets of the files are relative to
+	base    int64
+	entries map[string]asarEntry
+	order   []string
+}
+
+type asarEntry struct {
+	offset   int64
+	size     int64
+	unpacked bool
+}
+
+// asarNode is a directory, file, or symlink within the header of an asar archive
+type asarNode struct {
+	Files    map[string]*asarNode `json:"files"`
+	Offset   string               `json:"offset"`
+	Size     int64                `json:"size"`
+	Unpacked bool                 `json:"unpacked"`
+	Link     string               `json:"link"`
+}
+
+func openAsarArchive(r io.ReaderAt, size int64) (*asarArchive, error) {
+	// the archive starts with a pickle holding the size of the header pickle, which in turn holds the size of the
+	// pickle payload and the length of the JSON string
+	var prefix [16]byte
+	if _, err := r.ReadAt(prefix[:], 0); err != nil {
+		return nil, fmt.Errorf("unable to read asar header: %w", err)
+	}
+	if binary.LittleEndian.Uint32(prefix[0:]) != 4 {
+		return nil, fmt.Errorf("not an asar archive")
+	}
+	headerSize := int64(binary.LittleEndian.Uint32(prefix[4:]))
+	jsonSize := int64(binary.LittleEndian.Uint32(prefix[12:]))
+	if headerSize > maxAsarHeaderSize || jsonSize > headerSize-8 || 8+headerSize > size {
+		return nil, fmt.Errorf("invalid asar header size")
+	}
+
+	header := make([]byte, jsonSize)
+	if _, err := r.ReadAt(header, 16); err != nil {
+		return nil, fmt.Errorf("unable to read asar header: %w", err)
+	}
+
+	var root asarNode
+	if err := json.Unmarshal(header, &root); err != nil {
+		return nil, fmt.Errorf("unable to parse asar header: %w", err)
+	}
+
+	archive := &asarArchive{
+		reader:  r,
+		base:    8 + headerSize,
+		entries: make(map[string]asarEntry),
+	}
+	archive.add("", &root)
+	return archive, nil
+}
+
+func (a *asarArchive) add(dir string, node *asarNode) {
+	names := make([]string, 0, len(node.Files))
+	for name := range node.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		child := node.Files[name]
+		p := path.Join(dir, name)
+		switch {
+		case child == nil || child.Link != "":
+			continue
+		case child.Files != nil:
+			a.add(p, child)
+		default:
+			offset, err := strconv.ParseInt(child.Offset, 10, 64)
+			if err != nil && !child.Unpacked {
+				continue
+			}
+			a.entries[p] = asarEntry{offset: offset, size: child.Size, unpacked: child.Unpacked}
+			a.order = append(a.order, p)
+		}
+	}
+}
+
+// names returns the path of every file within the archive (including the unpacked files)
+func (a *asarArchive) names() []string {
+	return a.order
+}
+
+func (a *asarArchive) read(name string) ([]byte, error) {
+	entry, ok := a.entries[name]
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("file not found: %s", name)
+	case entry.unpacked:
+		return nil, fmt.Errorf("file is not packed within the archive: %s", name)
+	case entry.size < 0 || entry.size > maxAsarMemberSize:
+		return nil, fmt.Errorf("file is too large: %s", name)
+	}
+
+	data := make([]byte, entry.size)
+	if _, err := a.reader.ReadAt(data, a.base+entry.offset); err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+	return data, nil
+}
@@ -14,12 +14,55 @@ func NewPackageCataloger() pkg.Cataloger {
 		WithParserByGlobs(parsePackageJSON, "**/package.json")
 }
 
-// NewLockCataloger returns a new cataloger object for NPM (and NPM-adjacent, such as yarn) lock files.
+// NewGlobalPackageCataloger returns a new cataloger object for packages installed globally with npm
+// (`npm install --global`) or yarn (`yarn global add`), such as command line tools installed within the npm prefix
+// (e.g. /usr/local/lib/node_modules) or the yarn global folder (e.g. ~/.config/yarn/global), as well as the package
+// manager releases provisioned by corepack (e.g. within ~/.cache/node/corepack).
+func NewGlobalPackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("javascript-global-package-cataloger").
+		WithParserByGlobs(parseNpmGlobalPackage,
+			"**/lib/node_modules/*/package.json",
+			"**/lib/node_modules/@*/*/package.json",
+		).
+		WithParserByGlobs(parseYarnGlobalManifest, "**/yarn/global/package.json").
+		WithParserByGlobs(parseCorepackPackage,
+			"**/corepack/*/*/package.json",
+			"**/corepack/v1/*/*/package.json",
+		)
+}
+
+// NewBundleCataloger returns a new cataloger object for the npm packages compiled into JavaScript bundles (e.g. by
+// webpack, rollup, or esbuild), as recovered from the sourcemaps of the bundles and from the banner comments retained
+// within them. Since bundles don't carry package manifests, the packages found are backed by supporting evidence only.
+func NewBundleCataloger() pkg.Cataloger {
+	var bundleGlobs []string
+	for _, suffix := range bundleSuffixes {
+		bundleGlobs = append(bundleGlobs, "**/*"+suffix)
+	}
+	return generic.NewCataloger("javascript-bundle-cataloger").
+		WithParserByGlobs(parseBundle, bundleGlobs...).
+		WithParserByGlobs(parseBundleSourceMap, "**/*.js.map", "**/*.mjs.map", "**/*.cjs.map")
+}
+
+// NewLockCataloger returns a new cataloger object for NPM (and NPM-adjacent, such as yarn, pnpm, and bun) lock files,
+// as well as yarn berry Plug'n'Play install state and cache archives (for zero-install projects without node_modules).
 func NewLockCataloger(cfg CatalogerConfig) pkg.Cataloger {
 	yarnLockAdapter := newGenericYarnLockAdapter(cfg)
 	packageLockAdapter := newGenericPackageLockAdapter(cfg)
+	bunLockAdapter := newGenericBunLockAdapter(cfg)
 	return generic.NewCataloger("javascript-lock-cataloger").
 		WithParserByGlobs(packageLockAdapter.parsePackageLock, "**/package-lock.json").
 		WithParserByGlobs(yarnLockAdapter.parseYarnLock, "**/yarn.lock").
-		WithParserByGlobs(parsePnpmLock, "**/pnpm-lock.yaml")
+		WithParserByGlobs(parsePnpmLock, "**/pnpm-lock.yaml").
+		WithParserByGlobs(bunLockAdapter.parseBunLock, "**/bun.lock").
+		WithParserByGlobs(bunLockAdapter.parseBunLockb, "**/bun.lockb").
+		WithParserByGlobs(parseYarnPnp, "**/.pnp.cjs", "**/.pnp.js", "**/.pnp.data.json").
+		WithParserByGlobs(parseYarnCache, "**/.yarn/cache/*.zip")
+}
+
+// NewElectronCataloger returns a new cataloger object for Electron desktop applications, finding the packages within
+// the app.asar archive of the application as well as the Electron, Chromium, and Node.js releases it is built on.
+func NewElectronCataloger() pkg.Cataloger {
+	return generic.NewCataloger("javascript-electron-cataloger").
+		WithParserByGlobs(parseElectronApp, "**/resources/app.asar", "**/Resources/app.asar")
 }
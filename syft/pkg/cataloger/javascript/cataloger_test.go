@@ -171,6 +171,12 @@ func Test_LockCataloger_Globs(t *testing.T) {
 			name:    "obtain package files",
 			fixture: "test-fixtures/glob-paths",
 			expected: []string{
+				"src/.pnp.cjs",
+				"src/.pnp.data.json",
+				"src/.pnp.js",
+				"src/.yarn/cache/pkg.zip",
+				"src/bun.lock",
+				"src/bun.lockb",
 				"src/package-lock.json",
 				"src/pnpm-lock.yaml",
 				"src/yarn.lock",
@@ -187,3 +193,61 @@ func Test_LockCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func Test_GlobalPackageCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain global package files",
+			fixture: "test-fixtures/global-glob-paths",
+			expected: []string{
+				"usr/lib/node_modules/corepack/package.json",
+				"usr/lib/node_modules/@scope/tool/package.json",
+				"home/user/.config/yarn/global/package.json",
+				"root/.cache/node/corepack/pnpm/8.6.0/package.json",
+				"root/.cache/node/corepack/v1/yarn/1.22.19/package.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewGlobalPackageCataloger())
+		})
+	}
+}
+
+func Test_BundleCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/bundle-glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"public/js/app.min.js",
+			"static/js/main.bundle.js",
+			"static/js/2.chunk.js",
+			"dist/index.js.map",
+			"dist/index.mjs.map",
+			"dist/index.cjs.map",
+		}).
+		IgnoreUnfulfilledPathResponses(
+			"public/js/app.min.js.map",
+			"static/js/main.bundle.js.map",
+			"static/js/2.chunk.js.map",
+		).
+		TestCataloger(t, NewBundleCataloger())
+}
+
+func Test_ElectronCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/electron-glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"opt/App/resources/app.asar",
+			"Applications/App.app/Contents/Resources/app.asar",
+		}).
+		TestCataloger(t, NewElectronCataloger())
+}
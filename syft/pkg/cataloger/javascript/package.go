@@ -13,6 +13,7 @@ import (
 	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/cpe"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/pkg"
 )
@@ -48,6 +49,64 @@ func newPackageJSONPackage(u packageJSON, indexLocation file.Location) pkg.Packa
 	return p
 }
 
+func newGlobalPackage(resolver file.Resolver, manager string, u globalPackageJSON, binDir string, locations ...file.Location) pkg.Package {
+	licenseCandidates, err := u.licensesFromJSON()
+	if err != nil {
+		log.Warnf("unable to extract licenses from javascript package.json: %+v", err)
+	}
+
+	bins := u.bins()
+	if resolver != nil && binDir != "" {
+		for _, bin := range bins {
+			binLocation := resolver.RelativeFileByPath(locations[0], path.Join(binDir, bin))
+			if binLocation == nil {
+				continue
+			}
+			locations = append(locations, binLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	p := pkg.Package{
+		Name:      u.Name,
+		Version:   u.Version,
+		PURL:      packageURL(u.Name, u.Version),
+		Locations: file.NewLocationSet(locations...),
+		Language:  pkg.JavaScript,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0], licenseCandidates...)...),
+		Type:      pkg.NpmPkg,
+		Metadata: pkg.NpmGlobalPackageEntry{
+			Manager: manager,
+			Bins:    bins,
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newBundledPackage returns a package for an npm package compiled into a JavaScript bundle, where the bundle (and its
+// sourcemap) are only supporting evidence of the package.
+func newBundledPackage(b bundledPackage, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      b.name,
+		Version:   b.version,
+		PURL:      packageURL(b.name, b.version),
+		Locations: file.NewLocationSet(locations...),
+		Language:  pkg.JavaScript,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0], b.licenses...)...),
+		Type:      pkg.NpmPkg,
+		Metadata: pkg.NpmBundledPackageEntry{
+			Evidence:    b.evidence,
+			SourceFiles: b.sourceFiles,
+		},
+	}
+
+	p.SetID()
+
+	return p
+}
+
 func newPackageLockV1Package(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name string, u lockDependency) pkg.Package {
 	version := u.Version
 
@@ -140,6 +199,21 @@ func newPnpmPackage(resolver file.Resolver, location file.Location, name, versio
 	)
 }
 
+func newYarnPnpPackage(resolver file.Resolver, location file.Location, name, version string) pkg.Package {
+	return finalizeLockPkg(
+		resolver,
+		location,
+		pkg.Package{
+			Name:      name,
+			Version:   version,
+			Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			PURL:      packageURL(name, version),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+		},
+	)
+}
+
 func newYarnLockPackage(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name, version string, resolved string, integrity string) pkg.Package {
 	var licenseSet pkg.LicenseSet
 
@@ -169,6 +243,35 @@ func newYarnLockPackage(cfg CatalogerConfig, resolver file.Resolver, location fi
 	)
 }
 
+func newBunLockPackage(cfg CatalogerConfig, resolver file.Resolver, location file.Location, name, version string, resolved string, integrity string) pkg.Package {
+	var licenseSet pkg.LicenseSet
+
+	if cfg.SearchRemoteLicenses {
+		license, err := getLicenseFromNpmRegistry(cfg.NPMBaseURL, name, version)
+		if err == nil && license != "" {
+			licenses := pkg.NewLicensesFromValues(license)
+			licenseSet = pkg.NewLicenseSet(licenses...)
+		}
+		if err != nil {
+			log.Warnf("unable to extract licenses from javascript bun lock for package %s:%s: %+v", name, version, err)
+		}
+	}
+	return finalizeLockPkg(
+		resolver,
+		location,
+		pkg.Package{
+			Name:      name,
+			Version:   version,
+			Licenses:  licenseSet,
+			Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			PURL:      packageURL(name, version),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata:  pkg.BunLockEntry{Resolved: resolved, Integrity: integrity},
+		},
+	)
+}
+
 func formatNpmRegistryURL(baseURL, packageName, version string) (requestURL string, err error) {
 	urlPath := []string{packageName, version}
 	requestURL, err = url.JoinPath(baseURL, urlPath...)
@@ -312,3 +415,21 @@ func packageURL(name, version string) string {
 		"",
 	).ToString()
 }
+
+// newElectronRuntimePackage returns a package for a runtime component (Electron, Chromium, or Node.js) of an Electron
+// application.
+func newElectronRuntimePackage(name, version, cpePrefix string, metadata pkg.ElectronRuntimeEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      packageurl.NewPackageURL(packageurl.TypeGeneric, "", name, version, nil, "").ToString(),
+		CPEs:      []cpe.CPE{cpe.Must(fmt.Sprintf("%s:%s:*:*:*:*:*:*:*", cpePrefix, version), cpe.GeneratedSource)},
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.BinaryPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
@@ -0,0 +1,182 @@
+package javascript
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const defaultNpmRegistryURL = "https://registry.npmjs.org/"
+
+// bunLock represents the text-based bun.lock file (bun v1.1.39+). Each entry within "packages" is an array whose
+// shape depends on how the package was resolved. For packages resolved from an npm registry the shape is:
+//
+//	"name": ["name@version", "registry-or-tarball-url", { ...dependency info }, "integrity"]
+type bunLock struct {
+	LockfileVersion int                          `json:"lockfileVersion"`
+	Packages        map[string][]json.RawMessage `json:"packages"`
+}
+
+type genericBunLockAdapter struct {
+	cfg CatalogerConfig
+}
+
+func newGenericBunLockAdapter(cfg CatalogerConfig) genericBunLockAdapter {
+	return genericBunLockAdapter{
+		cfg: cfg,
+	}
+}
+
+// parseBunLock is a parser function for bun.lock contents, returning all npm packages discovered.
+func (a genericBunLockAdapter) parseBunLock(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	// in the case we find bun.lock files in the node_modules directories, skip those
+	// as the whole purpose of the lock file is for the specific dependencies of the project
+	if pathContainsNodeModulesDirectory(reader.Path()) {
+		return nil, nil, nil
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read bun.lock file: %w", err)
+	}
+
+	var lock bunLock
+	// bun.lock files are JSONC (allowing for trailing commas)
+	if err := json.Unmarshal(removeTrailingCommas(contents), &lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse bun.lock file: %w", err)
+	}
+
+	// iterate in a stable order so that duplicate packages are resolved consistently
+	keys := make([]string, 0, len(lock.Packages))
+	for key := range lock.Packages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pkgs []pkg.Package
+	seen := strset.New()
+	for _, key := range keys {
+		name, version, resolved, integrity, ok := parseBunLockNpmEntry(lock.Packages[key])
+		if !ok {
+			log.WithFields("package", key).Trace("skipping non-npm bun.lock package entry")
+			continue
+		}
+
+		if seen.Has(name + "@" + version) {
+			continue
+		}
+		seen.Add(name + "@" + version)
+
+		pkgs = append(pkgs, newBunLockPackage(a.cfg, resolver, reader.Location, name, version, resolved, integrity))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// parseBunLockNpmEntry returns the name, version, resolved tarball URL, and integrity of the given bun.lock package
+// entry, returning false if the package was not resolved from an npm registry (e.g. git, tarball, workspace, or
+// folder dependencies).
+func parseBunLockNpmEntry(entry []json.RawMessage) (string, string, string, string, bool) {
+	if len(entry) != 4 {
+		return "", "", "", "", false
+	}
+
+	var ident, registry, integrity string
+	for i, target := range map[int]*string{0: &ident, 1: &registry, 3: &integrity} {
+		if err := json.Unmarshal(entry[i], target); err != nil {
+			return "", "", "", "", false
+		}
+	}
+
+	name, version := splitBunIdent(ident)
+	if name == "" || version == "" || strings.Contains(version, ":") {
+		return "", "", "", "", false
+	}
+
+	return name, version, bunTarballURL(registry, name, version), integrity, true
+}
+
+// splitBunIdent splits a "name@version" package identifier (where the name may be scoped, e.g. "@scope/name@1.0.0").
+func splitBunIdent(ident string) (string, string) {
+	idx := strings.LastIndex(ident, "@")
+	if idx <= 0 {
+		return "", ""
+	}
+	return ident[:idx], ident[idx+1:]
+}
+
+// bunTarballURL returns the tarball URL for the given npm package. Bun only records the registry when it differs from
+// the default registry (and may record the full tarball URL instead).
+func bunTarballURL(registry, name, version string) string {
+	if strings.HasSuffix(registry, ".tgz") {
+		return registry
+	}
+	if registry == "" {
+		registry = defaultNpmRegistryURL
+	}
+
+	baseName := name
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		baseName = name[idx+1:]
+	}
+
+	return strings.TrimSuffix(registry, "/") + "/" + name + "/-/" + baseName + "-" + version + ".tgz"
+}
+
+// removeTrailingCommas removes any commas that directly precede a closing bracket or brace (ignoring whitespace),
+// which are allowed within JSONC documents but not within JSON.
+func removeTrailingCommas(contents []byte) []byte {
+	var out bytes.Buffer
+	out.Grow(len(contents))
+
+	inString := false
+	escaped := false
+	pendingComma := -1
+
+	for _, c := range contents {
+		switch {
+		case inString:
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			pendingComma = -1
+		case c == ',':
+			pendingComma = out.Len()
+		case c == '}' || c == ']':
+			if pendingComma >= 0 {
+				// drop the comma but keep any whitespace that followed it
+				b := out.Bytes()
+				copy(b[pendingComma:], b[pendingComma+1:])
+				out.Truncate(out.Len() - 1)
+			}
+			pendingComma = -1
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		default:
+			pendingComma = -1
+		}
+		out.WriteByte(c)
+	}
+
+	return out.Bytes()
+}
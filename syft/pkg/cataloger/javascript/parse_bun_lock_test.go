@@ -0,0 +1,135 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseBunLock(t *testing.T) {
+	var expectedRelationships []artifact.Relationship
+	fixture := "test-fixtures/bun/bun.lock"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "@babel/code-frame",
+			Version:   "7.10.4",
+			Locations: locations,
+			PURL:      "pkg:npm/%40babel/code-frame@7.10.4",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/@babel/code-frame/-/code-frame-7.10.4.tgz",
+				Integrity: "sha512-vG6SvB6oYEhvgisZNFRmRCUkLz11c7rp+tbNTynGqc6mS1d5ATd/sGyV6W0KZZnXRKMTzZDRgQT3Ou9jhpAfUg==",
+			},
+		},
+		{
+			Name:      "@babel/highlight",
+			Version:   "7.10.4",
+			Locations: locations,
+			PURL:      "pkg:npm/%40babel/highlight@7.10.4",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.example.com/@babel/highlight/-/highlight-7.10.4.tgz",
+				Integrity: "sha512-i6rgnR/YgPEQzZZnbTHHuZdlE8qyoBNalD6F+q4vAFlcMEcqmkoG+mPqJYJCo63qPf74+Y1UZsl3l6f7/RIkmA==",
+			},
+		},
+		{
+			Name:      "left-pad",
+			Version:   "1.3.0",
+			Locations: locations,
+			PURL:      "pkg:npm/left-pad@1.3.0",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz",
+				Integrity: "sha512-XI5MPzVNApjAyhQzphX8BkmKsKUxD4LdyK24iZeQC3CoBPK3QqHGzPUm+ZLvPRyOKK8fkF1Pdkl6vWUK0gRrgA==",
+			},
+		},
+		{
+			Name:      "ms",
+			Version:   "2.1.3",
+			Locations: locations,
+			PURL:      "pkg:npm/ms@2.1.3",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.example.com/ms/-/ms-2.1.3.tgz",
+				Integrity: "sha512-6FlzubTLZG3J2a/NVCAleEhjzq5oxgHyaCU9yYXvcLsvoVaHJq/s5xXI6/XXP6tz7R9xAOtHnSO/tXtF3WRTlA==",
+			},
+		},
+	}
+
+	adapter := newGenericBunLockAdapter(CatalogerConfig{})
+	pkgtest.TestFileParser(t, fixture, adapter.parseBunLock, expectedPkgs, expectedRelationships)
+}
+
+func Test_removeTrailingCommas(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "no trailing commas",
+			input:    `{"a": [1, 2], "b": {}}`,
+			expected: `{"a": [1, 2], "b": {}}`,
+		},
+		{
+			name:     "trailing commas in objects and arrays",
+			input:    "{\"a\": [1, 2,], \"b\": {\"c\": 1,\n},\n}",
+			expected: "{\"a\": [1, 2], \"b\": {\"c\": 1\n}\n}",
+		},
+		{
+			name:     "commas within strings are preserved",
+			input:    `{"a": ",]", "b": "\",}",}`,
+			expected: `{"a": ",]", "b": "\",}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, string(removeTrailingCommas([]byte(tt.input))))
+		})
+	}
+}
+
+func Test_bunTarballURL(t *testing.T) {
+	tests := []struct {
+		registry string
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "left-pad",
+			version:  "1.3.0",
+			expected: "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz",
+		},
+		{
+			registry: "https://npm.example.com/",
+			name:     "@scope/name",
+			version:  "1.0.0-rc.1",
+			expected: "https://npm.example.com/@scope/name/-/name-1.0.0-rc.1.tgz",
+		},
+		{
+			registry: "https://npm.example.com/custom/left-pad.tgz",
+			name:     "left-pad",
+			version:  "1.3.0",
+			expected: "https://npm.example.com/custom/left-pad.tgz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, bunTarballURL(tt.registry, tt.name, tt.version))
+		})
+	}
+}
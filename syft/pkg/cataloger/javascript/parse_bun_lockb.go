@@ -0,0 +1,280 @@
+package javascript
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// the binary bun.lockb format is a serialized form of bun's in-memory lockfile. The layout is:
+//
+//	header ("#!/usr/bin/env bun\nbun-lockfile-format-v0\n")
+//	format version (u32), meta hash ([32]u8), total size (u64)
+//	package list: length (u64), alignment (u64), field count (u64), begin (u64), end (u64), then one column per
+//	              package field (sorted by alignment), each column holding the field value for every package
+//	buffers: each is a begin (u64), end (u64), a "\n<type> N sizeof, M alignof\n" marker, padding, then the data
+//
+// all integers are little endian. See https://github.com/oven-sh/bun/blob/main/src/install/lockfile.zig
+const (
+	bunLockbHeader         = "#!/usr/bin/env bun\nbun-lockfile-format-v0\n"
+	bunLockbStringsMarker  = "\n<u8> 1 sizeof, 1 alignof\n"
+	bunLockbPackageFields  = 8
+	bunLockbPackagesOffset = len(bunLockbHeader) + 4 + 32 + 8
+
+	// sizes of package fields that do not vary between lockfile format versions
+	bunNameHashSize     = 8
+	bunDependenciesSize = 8
+	bunResolutionsSize  = 8
+	bunMetaSize         = 88
+	bunBinSize          = 20
+	bunNameSize         = 8
+	bunScriptsSize      = 49
+
+	// the smallest resolution size (lockfiles with 32-bit semver components)
+	bunMinResolutionSize = 64
+
+	// resolution tag for packages resolved from an npm registry
+	bunResolutionNpm = 2
+
+	// offset of the integrity (a u8 tag followed by the digest) within the package meta field
+	bunMetaIntegrityOffset = 20
+)
+
+var errInvalidBunLockb = errors.New("invalid bun.lockb file")
+
+// bunIntegrityAlgorithms maps the integrity tag to the algorithm name and digest length.
+var bunIntegrityAlgorithms = map[byte]struct {
+	name string
+	size int
+}{
+	1: {name: "sha1", size: 20},
+	2: {name: "sha256", size: 32},
+	3: {name: "sha384", size: 48},
+	4: {name: "sha512", size: 64},
+}
+
+type bunLockbPackage struct {
+	name           string
+	version        string
+	resolved       string
+	integrity      string
+	resolutionType byte
+}
+
+// parseBunLockb is a parser function for bun.lockb contents, returning all npm packages discovered.
+func (a genericBunLockAdapter) parseBunLockb(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	// in the case we find bun.lockb files in the node_modules directories, skip those
+	// as the whole purpose of the lock file is for the specific dependencies of the project
+	if pathContainsNodeModulesDirectory(reader.Path()) {
+		return nil, nil, nil
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read bun.lockb file: %w", err)
+	}
+
+	entries, err := readBunLockb(contents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse bun.lockb file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	seen := strset.New()
+	for _, e := range entries {
+		if e.resolutionType != bunResolutionNpm || e.name == "" || e.version == "" {
+			continue
+		}
+
+		if seen.Has(e.name + "@" + e.version) {
+			continue
+		}
+		seen.Add(e.name + "@" + e.version)
+
+		pkgs = append(pkgs, newBunLockPackage(a.cfg, resolver, reader.Location, e.name, e.version, e.resolved, e.integrity))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+func readBunLockb(contents []byte) ([]bunLockbPackage, error) {
+	if !bytes.HasPrefix(contents, []byte(bunLockbHeader)) {
+		return nil, fmt.Errorf("%w: missing header", errInvalidBunLockb)
+	}
+
+	if len(contents) < bunLockbPackagesOffset+40 {
+		return nil, fmt.Errorf("%w: truncated package list", errInvalidBunLockb)
+	}
+
+	le := binary.LittleEndian
+	header := contents[bunLockbPackagesOffset:]
+	count := le.Uint64(header[0:8])
+	fields := le.Uint64(header[16:24])
+	begin := le.Uint64(header[24:32])
+	end := le.Uint64(header[32:40])
+
+	if fields != bunLockbPackageFields {
+		return nil, fmt.Errorf("%w: unsupported package field count %d", errInvalidBunLockb, fields)
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	if begin > end || end > uint64(len(contents)) || (end-begin)%count != 0 {
+		return nil, fmt.Errorf("%w: invalid package list bounds", errInvalidBunLockb)
+	}
+
+	rowSize := (end - begin) / count
+	fixedSize := uint64(bunNameHashSize + bunDependenciesSize + bunResolutionsSize + bunMetaSize + bunBinSize + bunNameSize + bunScriptsSize)
+	if rowSize < fixedSize+bunMinResolutionSize {
+		return nil, fmt.Errorf("%w: unsupported package size %d", errInvalidBunLockb, rowSize)
+	}
+	resolutionSize := rowSize - fixedSize
+
+	strs, err := findBunLockbStrings(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	// columns are ordered by alignment (and then by declaration order)
+	n := int(count)
+	columns := contents[begin:end]
+	column := func(offset, size uint64) func(i int) []byte {
+		start := offset * count
+		return func(i int) []byte {
+			at := start + uint64(i)*size
+			return columns[at : at+size]
+		}
+	}
+
+	offset := uint64(bunNameHashSize)
+	resolutions := column(offset, resolutionSize)
+	offset += resolutionSize + bunDependenciesSize + bunResolutionsSize
+	metas := column(offset, bunMetaSize)
+	offset += bunMetaSize + bunBinSize
+	names := column(offset, bunNameSize)
+
+	entries := make([]bunLockbPackage, 0, n)
+	for i := 0; i < n; i++ {
+		resolution := resolutions(i)
+
+		name, err := strs.get(names(i))
+		if err != nil {
+			return nil, err
+		}
+
+		entry := bunLockbPackage{
+			name:           name,
+			resolutionType: resolution[0],
+		}
+
+		if entry.resolutionType == bunResolutionNpm {
+			entry.resolved, err = strs.get(resolution[8:16])
+			if err != nil {
+				return nil, err
+			}
+			entry.version, err = strs.version(resolution)
+			if err != nil {
+				return nil, err
+			}
+			entry.integrity = bunIntegrity(metas(i)[bunMetaIntegrityOffset:])
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// bunLockbStrings is the string buffer that all strings (that are too large to be inlined) point into.
+type bunLockbStrings []byte
+
+func findBunLockbStrings(contents []byte) (bunLockbStrings, error) {
+	idx := bytes.Index(contents, []byte(bunLockbStringsMarker))
+	if idx < 16 {
+		return nil, fmt.Errorf("%w: missing string buffer", errInvalidBunLockb)
+	}
+
+	begin := binary.LittleEndian.Uint64(contents[idx-16 : idx-8])
+	end := binary.LittleEndian.Uint64(contents[idx-8 : idx])
+	if begin > end || end > uint64(len(contents)) {
+		return nil, fmt.Errorf("%w: invalid string buffer bounds", errInvalidBunLockb)
+	}
+
+	return contents[begin:end], nil
+}
+
+// get returns the value of the given 8-byte string, which is either inlined (when the high bit of the last byte is
+// unset) or is an offset and length (with the high bit set) into the string buffer.
+func (s bunLockbStrings) get(value []byte) (string, error) {
+	if value[7]&0x80 == 0 {
+		return string(bytes.TrimRight(value, "\x00")), nil
+	}
+
+	off := uint64(binary.LittleEndian.Uint32(value[0:4]))
+	length := uint64(binary.LittleEndian.Uint32(value[4:8]) &^ (1 << 31))
+	if off+length > uint64(len(s)) {
+		return "", fmt.Errorf("%w: string out of bounds", errInvalidBunLockb)
+	}
+
+	return string(s[off : off+length]), nil
+}
+
+// version returns the semantic version within the given npm resolution. Older lockfiles store each version component
+// as a u32 while newer lockfiles use a u64 (which is reflected in the overall resolution size). In both cases the
+// pre-release and build tags are the last two fields (each a string followed by its hash).
+func (s bunLockbStrings) version(resolution []byte) (string, error) {
+	le := binary.LittleEndian
+	v := resolution[16:]
+
+	var major, minor, patch uint64
+	if len(resolution) == bunMinResolutionSize {
+		major, minor, patch = uint64(le.Uint32(v[0:4])), uint64(le.Uint32(v[4:8])), uint64(le.Uint32(v[8:12]))
+	} else {
+		major, minor, patch = le.Uint64(v[0:8]), le.Uint64(v[8:16]), le.Uint64(v[16:24])
+	}
+
+	tags := resolution[len(resolution)-32:]
+	pre, err := s.get(tags[0:8])
+	if err != nil {
+		return "", err
+	}
+	build, err := s.get(tags[16:24])
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%d.%d.%d", major, minor, patch))
+	if pre != "" {
+		sb.WriteString("-" + pre)
+	}
+	if build != "" {
+		sb.WriteString("+" + build)
+	}
+	return sb.String(), nil
+}
+
+// bunIntegrity returns the subresource integrity string (e.g. "sha512-...") for the given tag and digest.
+func bunIntegrity(integrity []byte) string {
+	alg, ok := bunIntegrityAlgorithms[integrity[0]]
+	if !ok || len(integrity) < alg.size+1 {
+		return ""
+	}
+	return alg.name + "-" + base64.StdEncoding.EncodeToString(integrity[1:alg.size+1])
+}
@@ -0,0 +1,99 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseBunLockb(t *testing.T) {
+	var expectedRelationships []artifact.Relationship
+	fixture := "test-fixtures/bun/bun.lockb"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "@babel/code-frame",
+			Version:   "7.10.4",
+			Locations: locations,
+			PURL:      "pkg:npm/%40babel/code-frame@7.10.4",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/@babel/code-frame/-/code-frame-7.10.4.tgz",
+				Integrity: "sha512-vG6SvB6oYEhvgisZNFRmRCUkLz11c7rp+tbNTynGqc6mS1d5ATd/sGyV6W0KZZnXRKMTzZDRgQT3Ou9jhpAfUg==",
+			},
+		},
+		{
+			Name:      "left-pad",
+			Version:   "1.3.0",
+			Locations: locations,
+			PURL:      "pkg:npm/left-pad@1.3.0",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/left-pad/-/left-pad-1.3.0.tgz",
+				Integrity: "sha512-XI5MPzVNApjAyhQzphX8BkmKsKUxD4LdyK24iZeQC3CoBPK3QqHGzPUm+ZLvPRyOKK8fkF1Pdkl6vWUK0gRrgA==",
+			},
+		},
+		{
+			Name:      "ms",
+			Version:   "2.1.3",
+			Locations: locations,
+			PURL:      "pkg:npm/ms@2.1.3",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/ms/-/ms-2.1.3.tgz",
+				Integrity: "sha1-V0yBOM4dK1hh8xhNjBLZ0T2TMzk=",
+			},
+		},
+		{
+			Name:      "typescript",
+			Version:   "5.4.0-beta",
+			Locations: locations,
+			PURL:      "pkg:npm/typescript@5.4.0-beta",
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.BunLockEntry{
+				Resolved:  "https://registry.npmjs.org/typescript/-/typescript-5.4.0-beta.tgz",
+				Integrity: "sha512-ZF7Ip1ZV5Nc4zYw+1hZnBS2Z5tpCLPi5Em5Ya0x6zXyaU3EUYk6NpKz+u8mR+cTWySPJzWKe0/Uu2kNPdpCRog==",
+			},
+		},
+	}
+
+	adapter := newGenericBunLockAdapter(CatalogerConfig{})
+	pkgtest.TestFileParser(t, fixture, adapter.parseBunLockb, expectedPkgs, expectedRelationships)
+}
+
+func Test_readBunLockb_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "missing header",
+			input:   "bogus",
+			wantErr: "missing header",
+		},
+		{
+			name:    "truncated",
+			input:   bunLockbHeader + "\x02\x00\x00\x00",
+			wantErr: "truncated package list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readBunLockb([]byte(tt.input))
+			require.ErrorIs(t, err, errInvalidBunLockb)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package javascript
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/file/cataloger/snippet"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parseBundle
+	_ generic.Parser = parseBundleSourceMap
+)
+
+const (
+	sourceMapBundleEvidence = "sourcemap"
+	bannerBundleEvidence    = "banner"
+)
+
+// bundleSuffixes are the conventional file name suffixes of the JavaScript bundles emitted by bundlers (e.g. webpack,
+// rollup, or esbuild), which are searched for banner comments even when no sourcemap is shipped alongside them.
+var bundleSuffixes = []string{".min.js", ".bundle.js", ".chunk.js"}
+
+var (
+	// e.g. "//# sourceMappingURL=app.min.js.map" (or the legacy "//@ sourceMappingURL=...")
+	sourceMappingURLPattern = regexp.MustCompile(`(?m)^\s*//[#@]\s*sourceMappingURL=(\S+)\s*$`)
+
+	// e.g. ".../node_modules/.pnpm/react-dom@18.2.0_react@18.2.0/" (the install directory of a package with pnpm)
+	pnpmInstallPattern = regexp.MustCompile(`\.pnpm/([^/]+)/$`)
+
+	// e.g. ".../.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/" (the cache archive of a package with yarn
+	// Plug'n'Play)
+	yarnCacheInstallPattern = regexp.MustCompile(`-npm-(\d[^/]*?)-[0-9a-f]{10}(?:-[0-9a-f]{10})?\.zip/$`)
+)
+
+type sourceMap struct {
+	File           string             `json:"file"`
+	Sources        []string           `json:"sources"`
+	SourcesContent []*string          `json:"sourcesContent"`
+	Sections       []sourceMapSection `json:"sections"`
+}
+
+// sourceMapSection is a section of an index map, which combines the sourcemaps of several bundles.
+type sourceMapSection struct {
+	Map *sourceMap `json:"map"`
+}
+
+// parseBundle is a parser function for a JavaScript bundle (e.g. dist/app.min.js), returning the npm packages compiled
+// into the bundle, as listed by the sourcemap of the bundle (referenced by the bundle or alongside it) and as named by
+// the banner comments retained within the bundle.
+func parseBundle(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if inNodeModules(reader.RealPath) {
+		// packages within node_modules are cataloged from their package.json files
+		return nil, nil, nil
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read JavaScript bundle: %w", err)
+	}
+
+	packages := newBundledPackages()
+	packages.addBanners(contents)
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)}
+	if sm, location := readBundleSourceMap(resolver, reader.Location, contents); sm != nil {
+		packages.addSourceMap(sm)
+		if location != nil {
+			locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	return packages.list(locations...), nil, nil
+}
+
+// parseBundleSourceMap is a parser function for the sourcemap of a JavaScript bundle (e.g. dist/main.js.map), returning
+// the npm packages compiled into the bundle. Sourcemaps of bundles that are named as such (e.g. app.min.js) are
+// skipped, since these are read when parsing the bundle itself.
+func parseBundleSourceMap(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if inNodeModules(reader.RealPath) {
+		return nil, nil, nil
+	}
+
+	sm, err := decodeSourceMap(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse JavaScript sourcemap: %w", err)
+	}
+
+	bundlePath := strings.TrimSuffix(reader.RealPath, ".map")
+	if sm.File != "" && !strings.Contains(sm.File, "/") {
+		bundlePath = path.Join(path.Dir(reader.RealPath), sm.File)
+	}
+
+	packages := newBundledPackages()
+	packages.addSourceMap(sm)
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)}
+	if bundleLocation := relativeFile(resolver, reader.Location, bundlePath); bundleLocation != nil {
+		if isBundleName(bundlePath) {
+			return nil, nil, nil
+		}
+		if contents := readFile(resolver, *bundleLocation); contents != nil {
+			packages.addBanners(contents)
+		}
+		locations = append([]file.Location{bundleLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)}, locations...)
+	}
+
+	return packages.list(locations...), nil, nil
+}
+
+// readBundleSourceMap returns the sourcemap of the given bundle, which is either referenced by the bundle (within a
+// sourceMappingURL comment, which may inline the sourcemap as a data URL) or found alongside the bundle (<bundle>.map).
+// The location of the sourcemap is returned when it is a separate file.
+func readBundleSourceMap(resolver file.Resolver, bundle file.Location, contents []byte) (*sourceMap, *file.Location) {
+	mapPath := bundle.RealPath + ".map"
+	if matches := sourceMappingURLPattern.FindAllSubmatch(contents, -1); len(matches) > 0 {
+		url := string(matches[len(matches)-1][1])
+		switch {
+		case strings.HasPrefix(url, "data:"):
+			return decodeInlineSourceMap(url), nil
+		case strings.Contains(url, "://"):
+			// the sourcemap is hosted elsewhere
+			return nil, nil
+		default:
+			mapPath = path.Join(path.Dir(bundle.RealPath), url)
+		}
+	}
+
+	location := relativeFile(resolver, bundle, mapPath)
+	if location == nil {
+		return nil, nil
+	}
+
+	contents = readFile(resolver, *location)
+	if contents == nil {
+		return nil, nil
+	}
+
+	sm, err := decodeSourceMap(bytes.NewReader(contents))
+	if err != nil {
+		log.WithFields("error", err, "path", location.RealPath).Trace("unable to parse JavaScript sourcemap")
+		return nil, nil
+	}
+	return sm, location
+}
+
+// decodeInlineSourceMap returns the sourcemap within the given data URL
+// (e.g. "data:application/json;charset=utf-8;base64,eyJ2ZXJzaW9uIjozfQ==").
+func decodeInlineSourceMap(url string) *sourceMap {
+	_, data, ok := strings.Cut(url, ";base64,")
+	if !ok {
+		return nil
+	}
+
+	contents, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		log.WithFields("error", err).Trace("unable to decode inline JavaScript sourcemap")
+		return nil
+	}
+
+	sm, err := decodeSourceMap(bytes.NewReader(contents))
+	if err != nil {
+		log.WithFields("error", err).Trace("unable to parse inline JavaScript sourcemap")
+		return nil
+	}
+	return sm
+}
+
+func decodeSourceMap(reader io.Reader) (*sourceMap, error) {
+	var sm sourceMap
+	if err := json.NewDecoder(reader).Decode(&sm); err != nil {
+		return nil, err
+	}
+	return &sm, nil
+}
+
+func relativeFile(resolver file.Resolver, relativeTo file.Location, p string) *file.Location {
+	if resolver == nil {
+		return nil
+	}
+	return resolver.RelativeFileByPath(relativeTo, p)
+}
+
+func readFile(resolver file.Resolver, location file.Location) []byte {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.WithFields("error", err, "path", location.RealPath).Trace("unable to read file")
+		return nil
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		log.WithFields("error", err, "path", location.RealPath).Trace("unable to read file")
+		return nil
+	}
+	return contents
+}
+
+func isBundleName(p string) bool {
+	for _, suffix := range bundleSuffixes {
+		if strings.HasSuffix(p, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func inNodeModules(p string) bool {
+	return strings.Contains("/"+p, "/node_modules/")
+}
+
+// bundledPackage is an npm package found within a bundle, along with the evidence that it was found by.
+type bundledPackage struct {
+	name        string
+	version     string
+	licenses    []string
+	evidence    []string
+	sourceFiles int
+}
+
+func (p *bundledPackage) addEvidence(evidence string) {
+	for _, e := range p.evidence {
+		if e == evidence {
+			return
+		}
+	}
+	p.evidence = append(p.evidence, evidence)
+}
+
+// bundledPackages are the npm packages found within a bundle, in the order that they were found.
+type bundledPackages struct {
+	packages []*bundledPackage
+}
+
+func newBundledPackages() *bundledPackages {
+	return &bundledPackages{}
+}
+
+func (b *bundledPackages) get(name, version string) *bundledPackage {
+	for _, p := range b.packages {
+		if p.name == name && p.version == version {
+			return p
+		}
+	}
+	p := &bundledPackage{name: name, version: version}
+	b.packages = append(b.packages, p)
+	return p
+}
+
+// addBanners adds the packages named (along with a version) by the banner comments within the given bundle.
+func (b *bundledPackages) addBanners(contents []byte) {
+	for _, s := range snippet.Find(contents) {
+		if s.Name == "" || s.Version == "" {
+			continue
+		}
+		p := b.get(strings.ToLower(s.Name), s.Version)
+		p.addEvidence(bannerBundleEvidence)
+		if len(p.licenses) == 0 {
+			p.licenses = s.Licenses
+		}
+	}
+}
+
+// addSourceMap adds the packages that the sources listed by the given sourcemap belong to.
+func (b *bundledPackages) addSourceMap(sm *sourceMap) {
+	for i, source := range sm.Sources {
+		name, version := packageFromSource(source)
+		if name == "" {
+			continue
+		}
+		if version == "" && i < len(sm.SourcesContent) && sm.SourcesContent[i] != nil && path.Base(source) == "package.json" {
+			version = versionFromPackageJSON(*sm.SourcesContent[i])
+		}
+		p := b.get(name, version)
+		p.addEvidence(sourceMapBundleEvidence)
+		p.sourceFiles++
+	}
+
+	for _, section := range sm.Sections {
+		if section.Map != nil {
+			b.addSourceMap(section.Map)
+		}
+	}
+}
+
+// list returns the packages found within the bundle. Packages without a version (e.g. only listed within the
+// sourcemap) are combined with the same package when exactly one version of it was found otherwise (e.g. from a
+// banner comment or the package.json file of the package).
+func (b *bundledPackages) list(locations ...file.Location) []pkg.Package {
+	versions := make(map[string][]*bundledPackage)
+	for _, p := range b.packages {
+		if p.version != "" {
+			versions[p.name] = append(versions[p.name], p)
+		}
+	}
+
+	var remaining []*bundledPackage
+	for _, p := range b.packages {
+		if p.version != "" || len(versions[p.name]) != 1 {
+			remaining = append(remaining, p)
+			continue
+		}
+		versioned := versions[p.name][0]
+		for _, e := range p.evidence {
+			versioned.addEvidence(e)
+		}
+		versioned.sourceFiles += p.sourceFiles
+	}
+
+	var pkgs []pkg.Package
+	for _, p := range remaining {
+		pkgs = append(pkgs, newBundledPackage(*p, locations...))
+	}
+	return pkgs
+}
+
+// packageFromSource returns the name of the npm package that the given sourcemap source belongs to
+// (e.g. "webpack://app/./node_modules/react/index.js"), along with the version of the package when it is part of the
+// install path (as with pnpm or yarn Plug'n'Play). Nothing is returned for sources that are not within node_modules.
+func packageFromSource(source string) (string, string) {
+	if i := strings.IndexAny(source, "?#"); i >= 0 {
+		source = source[:i]
+	}
+	source = strings.ReplaceAll(source, `\`, "/")
+
+	const nodeModules = "node_modules/"
+	i := strings.LastIndex(source, nodeModules)
+	if i < 0 {
+		return "", ""
+	}
+	prefix, fields := source[:i], strings.Split(source[i+len(nodeModules):], "/")
+
+	name := fields[0]
+	if strings.HasPrefix(name, "@") {
+		if len(fields) < 3 {
+			return "", ""
+		}
+		name += "/" + fields[1]
+	} else if len(fields) < 2 {
+		return "", ""
+	}
+	if name == "" || strings.HasPrefix(name, ".") || strings.HasSuffix(name, "/") {
+		return "", ""
+	}
+
+	return name, versionFromInstallPath(prefix, name)
+}
+
+// versionFromInstallPath returns the version of the package with the given name from the path of the node_modules
+// directory that the package is installed within, when the path is specific to the package version.
+func versionFromInstallPath(prefix, name string) string {
+	if match := pnpmInstallPattern.FindStringSubmatch(prefix); match != nil {
+		// e.g. "react@18.2.0", "@babel+runtime@7.23.0", or "react-dom@18.2.0_react@18.2.0" (peer dependencies are
+		// appended to the version)
+		version, ok := strings.CutPrefix(match[1], strings.ReplaceAll(name, "/", "+")+"@")
+		if !ok {
+			return ""
+		}
+		if i := strings.IndexAny(version, "_("); i >= 0 {
+			version = version[:i]
+		}
+		return version
+	}
+	if match := yarnCacheInstallPattern.FindStringSubmatch(prefix); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+func versionFromPackageJSON(contents string) string {
+	var p struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal([]byte(contents), &p); err != nil {
+		return ""
+	}
+	return p.Version
+}
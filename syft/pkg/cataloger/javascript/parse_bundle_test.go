@@ -0,0 +1,189 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestBundleCataloger(t *testing.T) {
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	// the bundle retains a banner comment and references a sourcemap alongside it
+	app := file.NewLocationSet(supporting("dist/app.min.js"), supporting("dist/app.min.js.map"))
+	// the sourcemap is of a bundle that is not named as such, so is cataloged from the sourcemap
+	vendor := file.NewLocationSet(supporting("dist/vendor.js"), supporting("dist/vendor.js.map"))
+	// the bundle inlines its sourcemap
+	main := file.NewLocationSet(supporting("dist/main.bundle.js"))
+
+	expected := []pkg.Package{
+		{
+			Name:      "jquery",
+			Version:   "3.7.1",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/jquery@3.7.1",
+			Locations: app,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"banner", "sourcemap"},
+				SourceFiles: 1,
+			},
+		},
+		{
+			Name:      "@babel/runtime",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/%40babel/runtime",
+			Locations: app,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap"},
+				SourceFiles: 1,
+			},
+		},
+		{
+			Name:      "react",
+			Version:   "18.2.0",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/react@18.2.0",
+			Locations: app,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap"},
+				SourceFiles: 2,
+			},
+		},
+		{
+			Name:      "lodash",
+			Version:   "4.17.21",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/lodash@4.17.21",
+			Locations: app,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap"},
+				SourceFiles: 2,
+			},
+		},
+		{
+			Name:      "preact",
+			Version:   "10.19.3",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/preact@10.19.3",
+			Locations: vendor,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("MIT", file.NewLocation("dist/vendor.js")),
+			),
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap", "banner"},
+				SourceFiles: 1,
+			},
+		},
+		{
+			Name:      "@preact/signals",
+			Version:   "1.2.2",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/%40preact/signals@1.2.2",
+			Locations: vendor,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap"},
+				SourceFiles: 1,
+			},
+		},
+		{
+			Name:      "zod",
+			FoundBy:   "javascript-bundle-cataloger",
+			PURL:      "pkg:npm/zod",
+			Locations: main,
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmBundledPackageEntry{
+				Evidence:    []string{"sourcemap"},
+				SourceFiles: 1,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/bundle").
+		Expects(expected, nil).
+		TestCataloger(t, NewBundleCataloger())
+}
+
+func Test_packageFromSource(t *testing.T) {
+	tests := []struct {
+		source      string
+		wantName    string
+		wantVersion string
+	}{
+		{
+			source:   "webpack://app/./node_modules/react/index.js",
+			wantName: "react",
+		},
+		{
+			source:   "webpack://app/./node_modules/@babel/runtime/helpers/esm/defineProperty.js?5a1b",
+			wantName: "@babel/runtime",
+		},
+		{
+			source:   "../node_modules/left-pad/node_modules/nested/lib/index.js",
+			wantName: "nested",
+		},
+		{
+			source:      "../node_modules/.pnpm/react-dom@18.2.0_react@18.2.0/node_modules/react-dom/index.js",
+			wantName:    "react-dom",
+			wantVersion: "18.2.0",
+		},
+		{
+			source:      "../node_modules/.pnpm/@babel+runtime@7.23.0/node_modules/@babel/runtime/helpers/extends.js",
+			wantName:    "@babel/runtime",
+			wantVersion: "7.23.0",
+		},
+		{
+			source:      "../node_modules/.pnpm/react-dom@18.2.0(react@18.2.0)/node_modules/react-dom/index.js",
+			wantName:    "react-dom",
+			wantVersion: "18.2.0",
+		},
+		{
+			source:      "../.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash/lodash.js",
+			wantName:    "lodash",
+			wantVersion: "4.17.21",
+		},
+		{
+			source:      "../.yarn/cache/typescript-npm-5.4.0-beta-1a2b3c4d5e-6f7a8b9c0d.zip/node_modules/typescript/lib/tslib.js",
+			wantName:    "typescript",
+			wantVersion: "5.4.0-beta",
+		},
+		{
+			source: "webpack://app/./src/index.js",
+		},
+		{
+			source: "webpack://app/webpack/runtime/define property getters",
+		},
+		{
+			source: "../node_modules/.cache/babel-loader/1a2b.json",
+		},
+		{
+			source: "../node_modules/not-a-file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			name, version := packageFromSource(tt.source)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
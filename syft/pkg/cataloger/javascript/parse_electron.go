@@ -0,0 +1,331 @@
+package javascript
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// integrity check
+var _ generic.Parser = parseElectronApp
+
+// asarPackageJSONPattern matches the package.json of every package installed within the node_modules of an asar
+// archive (including scoped and nested packages).
+var asarPackageJSONPattern = regexp.MustCompile(`(?:^|/)node_modules/(?:@[^/]+/)?[^/]+/package\.json$`)
+
+var (
+	electronVersionPattern = regexp.MustCompile(`^v?(\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?)$`)
+
+	// the user agent components compiled into Electron, and the version marker used to classify Node.js binaries
+	electronBinaryVersionPattern = regexp.MustCompile(`Electron/(\d+\.\d+\.\d+(?:-(?:alpha|beta|nightly)\.[0-9.]+)?)`)
+	chromiumBinaryVersionPattern = regexp.MustCompile(`Chrome/(\d+\.\d+\.\d+\.\d+)`)
+	nodeBinaryVersionPattern     = regexp.MustCompile(`node\.js/v(\d+\.\d+\.\d+)`)
+)
+
+const (
+	electronScanChunkSize = 4 * 1024 * 1024
+	electronScanOverlap   = 256
+)
+
+// electronLibraryExtensions are the files alongside the Electron executable that are never the executable itself
+var electronLibraryExtensions = []string{".so", ".dll", ".dylib", ".node", ".pak", ".dat", ".bin", ".json", ".html"}
+
+type electronRuntime struct {
+	electron, chromium, node string
+}
+
+func (r electronRuntime) complete() bool {
+	return r.electron != "" && r.chromium != "" && r.node != ""
+}
+
+// parseElectronApp parses the app.asar archive of an Electron application, returning the application itself, the
+// packages installed within its node_modules, and the Electron, Chromium, and Node.js releases it runs on.
+func parseElectronApp(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for file: %w", err)
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to determine file size: %w", err)
+	}
+
+	archive, err := openAsarArchive(unionReader, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read electron application archive: %w", err)
+	}
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var app *pkg.Package
+	if u, err := readAsarPackageJSON(archive, "package.json"); err == nil && u.hasNameAndVersionValues() {
+		p := newPackageJSONPackage(*u, location)
+		app = &p
+	}
+
+	var pkgs []pkg.Package
+	seen := make(map[string]bool)
+	for _, name := range archive.names() {
+		if !asarPackageJSONPattern.MatchString(name) {
+			continue
+		}
+		if archive.entries[name].unpacked {
+			// unpacked packages reside on disk (within app.asar.unpacked), where they are found by the package cataloger
+			continue
+		}
+
+		u, err := readAsarPackageJSON(archive, name)
+		if err != nil {
+			log.WithFields("path", reader.RealPath, "file", name, "error", err).Trace("unable to read package.json within asar archive")
+			continue
+		}
+		if !u.hasNameAndVersionValues() {
+			continue
+		}
+
+		key := u.Name + "@" + u.Version
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		pkgs = append(pkgs, newPackageJSONPackage(*u, location))
+	}
+
+	appName := ""
+	if app != nil {
+		appName = app.Name
+	}
+	pkgs = append(pkgs, electronRuntimePackages(resolver, reader.Location, appName)...)
+
+	if app == nil {
+		return pkgs, nil, nil
+	}
+
+	var relationships []artifact.Relationship
+	for _, p := range pkgs {
+		relationships = append(relationships, artifact.Relationship{
+			From: p,
+			To:   *app,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	return append([]pkg.Package{*app}, pkgs...), relationships, nil
+}
+
+func readAsarPackageJSON(archive *asarArchive, name string) (*packageJSON, error) {
+	contents, err := archive.read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var u packageJSON
+	if err := json.Unmarshal(contents, &u); err != nil {
+		return nil, fmt.Errorf("unable to parse package.json: %w", err)
+	}
+	return &u, nil
+}
+
+// electronRuntimePackages returns the Electron, Chromium, and Node.js packages of the Electron distribution the
+// app.asar archive (at <root>/resources/app.asar, or <app>.app/Contents/Resources/app.asar on macOS) belongs to.
+func electronRuntimePackages(resolver file.Resolver, asarLocation file.Location, appName string) []pkg.Package {
+	if resolver == nil {
+		return nil
+	}
+	root := path.Dir(path.Dir(asarLocation.RealPath))
+
+	var runtime electronRuntime
+	var evidence []file.Location
+
+	// electron distributions carry a version file (though packagers may remove it)
+	if loc := resolver.RelativeFileByPath(asarLocation, path.Join(root, "version")); loc != nil {
+		if version := readElectronVersionFile(resolver, *loc); version != "" {
+			runtime.electron = version
+			evidence = append(evidence, loc.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		}
+	}
+
+	executable := findElectronExecutable(resolver, root)
+	if executable != nil {
+		found := scanElectronExecutable(resolver, *executable)
+		if found.electron != "" || found.chromium != "" || found.node != "" {
+			if runtime.electron == "" {
+				runtime.electron = found.electron
+			}
+			runtime.chromium = found.chromium
+			runtime.node = found.node
+			evidence = append(evidence, executable.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		} else {
+			executable = nil
+		}
+	}
+
+	metadata := pkg.ElectronRuntimeEntry{Application: appName}
+	if executable != nil {
+		metadata.Executable = executable.RealPath
+	}
+
+	var pkgs []pkg.Package
+	if runtime.electron != "" {
+		pkgs = append(pkgs, newElectronRuntimePackage("electron", runtime.electron, "cpe:2.3:a:electronjs:electron", metadata, evidence...))
+	}
+	if runtime.chromium != "" {
+		pkgs = append(pkgs, newElectronRuntimePackage("chromium", runtime.chromium, "cpe:2.3:a:google:chrome", metadata, evidence...))
+	}
+	if runtime.node != "" {
+		pkgs = append(pkgs, newElectronRuntimePackage("node", runtime.node, "cpe:2.3:a:nodejs:node.js", metadata, evidence...))
+	}
+	return pkgs
+}
+
+func readElectronVersionFile(resolver file.Resolver, location file.Location) string {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return ""
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	contents, err := io.ReadAll(io.LimitReader(reader, 64))
+	if err != nil {
+		return ""
+	}
+	match := electronVersionPattern.FindStringSubmatch(strings.TrimSpace(string(contents)))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// findElectronExecutable returns the largest executable within the root of the Electron distribution (or the
+// Electron framework on macOS), which is the one carrying the Electron, Chromium, and Node.js version strings.
+func findElectronExecutable(resolver file.Resolver, root string) *file.Location {
+	// note: the globs are not anchored, since paths may be relative to the root of the resolver
+	dir := strings.TrimPrefix(root, "/")
+	candidates, err := resolver.FilesByGlob(
+		path.Join("**", dir, "*"),
+		path.Join("**", dir, "Frameworks/Electron Framework.framework/Versions/*/Electron Framework"),
+	)
+	if err != nil {
+		return nil
+	}
+
+	var largest *file.Location
+	var largestSize int64
+	for i := range candidates {
+		candidate := candidates[i]
+		if !strings.HasPrefix(strings.TrimPrefix(candidate.RealPath, "/"), dir+"/") {
+			continue
+		}
+		if isElectronLibrary(path.Base(candidate.RealPath)) || !isExecutable(resolver, candidate) {
+			continue
+		}
+
+		var size int64
+		if metadata, err := resolver.FileMetadataByLocation(candidate); err == nil {
+			size = metadata.Size()
+		}
+		if largest == nil || size > largestSize {
+			largest = &candidate
+			largestSize = size
+		}
+	}
+	return largest
+}
+
+func isElectronLibrary(name string) bool {
+	name = strings.ToLower(name)
+	if strings.Contains(name, ".so.") {
+		return true
+	}
+	for _, ext := range electronLibraryExtensions {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExecutable indicates whether the file is an ELF, PE, or Mach-O binary
+func isExecutable(resolver file.Resolver, location file.Location) bool {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return false
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(reader, magic); err != nil {
+		return false
+	}
+	switch {
+	case bytes.Equal(magic, []byte("\x7fELF")), bytes.HasPrefix(magic, []byte("MZ")):
+		return true
+	case bytes.Equal(magic, []byte{0xcf, 0xfa, 0xed, 0xfe}), bytes.Equal(magic, []byte{0xce, 0xfa, 0xed, 0xfe}),
+		bytes.Equal(magic, []byte{0xca, 0xfe, 0xba, 0xbe}):
+		return true
+	}
+	return false
+}
+
+// scanElectronExecutable searches the executable for the version strings compiled into Electron.
+func scanElectronExecutable(resolver file.Resolver, location file.Location) electronRuntime {
+	var runtime electronRuntime
+
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read electron executable")
+		return runtime
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	chunk := make([]byte, electronScanChunkSize)
+	var window []byte
+	for !runtime.complete() {
+		n, err := io.ReadFull(reader, chunk)
+		if n > 0 {
+			window = append(window, chunk[:n]...)
+			runtime.find(window)
+
+			// retain the end of the window, since version strings may span chunks
+			if len(window) > electronScanOverlap {
+				window = append(window[:0], window[len(window)-electronScanOverlap:]...)
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	return runtime
+}
+
+func (r *electronRuntime) find(data []byte) {
+	if r.electron == "" {
+		if match := electronBinaryVersionPattern.FindSubmatch(data); match != nil {
+			r.electron = string(match[1])
+		}
+	}
+	if r.chromium == "" {
+		if match := chromiumBinaryVersionPattern.FindSubmatch(data); match != nil {
+			r.chromium = string(match[1])
+		}
+	}
+	if r.node == "" {
+		if match := nodeBinaryVersionPattern.FindSubmatch(data); match != nil {
+			r.node = string(match[1])
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseElectronApp(t *testing.T) {
+	npmPackage := func(location file.Location, name, version, description string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      packageURL(name, version),
+			Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("MIT", location),
+			),
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmPackage{
+				Name:        name,
+				Version:     version,
+				Description: description,
+			},
+		}
+	}
+	runtimePackage := func(name, version, cpePrefix, executable string, locations ...file.Location) pkg.Package {
+		var evidence []file.Location
+		for _, l := range locations {
+			evidence = append(evidence, l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		}
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      "pkg:generic/" + name + "@" + version,
+			CPEs:      []cpe.CPE{cpe.Must(cpePrefix+":"+version+":*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			Locations: file.NewLocationSet(evidence...),
+			Type:      pkg.BinaryPkg,
+			Metadata: pkg.ElectronRuntimeEntry{
+				Application: "example-app",
+				Executable:  executable,
+			},
+		}
+	}
+	dependencyOf := func(app pkg.Package, deps ...pkg.Package) []artifact.Relationship {
+		var relationships []artifact.Relationship
+		for _, dep := range deps {
+			relationships = append(relationships, artifact.Relationship{
+				From: dep,
+				To:   app,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+		return relationships
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+		build   func() ([]pkg.Package, []artifact.Relationship)
+	}{
+		{
+			name:    "linux distribution with a version file",
+			fixture: "test-fixtures/electron/linux",
+			build: func() ([]pkg.Package, []artifact.Relationship) {
+				asar := file.NewLocation("opt/Example/resources/app.asar")
+				versionFile := file.NewLocation("opt/Example/version")
+				executable := file.NewLocation("opt/Example/example")
+
+				app := npmPackage(asar, "example-app", "1.4.2", "an example app")
+				deps := []pkg.Package{
+					npmPackage(asar, "@electron/remote", "2.1.0", ""),
+					npmPackage(asar, "debug", "4.3.4", ""),
+					// nested within debug/node_modules
+					npmPackage(asar, "ms", "2.1.2", ""),
+					npmPackage(asar, "ms", "2.1.3", ""),
+					runtimePackage("electron", "28.1.0", "cpe:2.3:a:electronjs:electron", "opt/Example/example", versionFile, executable),
+					runtimePackage("chromium", "120.0.6099.109", "cpe:2.3:a:google:chrome", "opt/Example/example", versionFile, executable),
+					runtimePackage("node", "18.18.2", "cpe:2.3:a:nodejs:node.js", "opt/Example/example", versionFile, executable),
+				}
+				return append([]pkg.Package{app}, deps...), dependencyOf(app, deps...)
+			},
+		},
+		{
+			name:    "macos application bundle",
+			fixture: "test-fixtures/electron/macos",
+			build: func() ([]pkg.Package, []artifact.Relationship) {
+				asar := file.NewLocation("Applications/Example.app/Contents/Resources/app.asar")
+				framework := file.NewLocation("Applications/Example.app/Contents/Frameworks/Electron Framework.framework/Versions/A/Electron Framework")
+
+				app := npmPackage(asar, "example-app", "1.4.2", "")
+				deps := []pkg.Package{
+					npmPackage(asar, "ms", "2.1.3", ""),
+					runtimePackage("electron", "27.3.2", "cpe:2.3:a:electronjs:electron", framework.RealPath, framework),
+					runtimePackage("chromium", "118.0.5993.159", "cpe:2.3:a:google:chrome", framework.RealPath, framework),
+					runtimePackage("node", "18.17.1", "cpe:2.3:a:nodejs:node.js", framework.RealPath, framework),
+				}
+				return append([]pkg.Package{app}, deps...), dependencyOf(app, deps...)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgs, relationships := test.build()
+			for i := range pkgs {
+				pkgs[i].FoundBy = "javascript-electron-cataloger"
+			}
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				Expects(pkgs, relationships).
+				TestCataloger(t, NewElectronCataloger())
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package javascript
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	npmManager      = "npm"
+	yarnManager     = "yarn"
+	corepackManager = "corepack"
+
+	// npmGlobalModulesDir is the directory within the npm prefix (e.g. /usr/local or ~/.npm-global) that packages
+	// installed with `npm install --global` are installed into, where the commands they provide are linked within the
+	// bin directory of the prefix.
+	npmGlobalModulesDir = "/lib/node_modules/"
+
+	// corepackHomeDir is the name of the directory that corepack provisions package managers into (COREPACK_HOME,
+	// e.g. ~/.cache/node/corepack), where each release is installed within a <name>/<version> directory (or within a
+	// v1/<name>/<version> directory for newer releases of corepack).
+	corepackHomeDir = "corepack"
+
+	// corepackLastKnownGoodFile records the version of each package manager that the corepack shims run outside of
+	// projects that pin a version.
+	corepackLastKnownGoodFile = "lastKnownGood.json"
+)
+
+// corepackManagedPackages are the package managers that corepack provisions.
+var corepackManagedPackages = strset.New("npm", "pnpm", "yarn")
+
+var (
+	_ generic.Parser = parseNpmGlobalPackage
+	_ generic.Parser = parseYarnGlobalManifest
+	_ generic.Parser = parseCorepackPackage
+)
+
+// globalPackageJSON represents the package.json file of a globally installed package, along with the commands it
+// provides.
+type globalPackageJSON struct {
+	packageJSON
+	Bin json.RawMessage `json:"bin"`
+}
+
+// yarnGlobalManifest represents the package.json file of the yarn global folder (e.g. ~/.config/yarn/global), which
+// declares the packages installed with `yarn global add`.
+type yarnGlobalManifest struct {
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// parseNpmGlobalPackage is a parser function for the package.json files of packages installed with
+// `npm install --global`, returning the package along with the commands it links within the bin directory of the prefix.
+func parseNpmGlobalPackage(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	p, err := decodeGlobalPackageJSON(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !p.hasNameAndVersionValues() {
+		log.Debugf("encountered global package.json file without a name and/or version field, ignoring (path=%q)", reader.Path())
+		return nil, nil, nil
+	}
+
+	var binDir string
+	if i := strings.LastIndex(reader.RealPath, npmGlobalModulesDir); i >= 0 {
+		binDir = path.Join(reader.RealPath[:i], "bin")
+	}
+
+	return []pkg.Package{
+		newGlobalPackage(resolver, npmManager, p, binDir, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// parseYarnGlobalManifest is a parser function for the package.json file of the yarn global folder, returning the
+// packages installed with `yarn global add` (as found within the node_modules directory of the global folder).
+func parseYarnGlobalManifest(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var manifest yarnGlobalManifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse yarn global package.json file: %w", err)
+	}
+
+	if resolver == nil {
+		return nil, nil, nil
+	}
+
+	modulesDir := path.Join(path.Dir(reader.RealPath), "node_modules")
+	manifestLocation := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	for name := range manifest.Dependencies {
+		pkgJSONPath := path.Join(modulesDir, name, "package.json")
+		location := resolver.RelativeFileByPath(reader.Location, pkgJSONPath)
+		if location == nil {
+			log.WithFields("path", pkgJSONPath).Trace("unable to find package.json for yarn global package")
+			continue
+		}
+
+		p, err := readGlobalPackageJSON(resolver, *location)
+		if err != nil {
+			log.WithFields("error", err, "path", pkgJSONPath).Trace("unable to read package.json for yarn global package")
+			continue
+		}
+
+		if !p.hasNameAndVersionValues() {
+			continue
+		}
+
+		pkgs = append(pkgs, newGlobalPackage(
+			resolver,
+			yarnManager,
+			*p,
+			path.Join(modulesDir, ".bin"),
+			location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			manifestLocation,
+		))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// parseCorepackPackage is a parser function for the package.json files of the package manager releases provisioned by
+// corepack (e.g. with `corepack prepare pnpm@8.6.0 --activate` or when running the corepack shims), returning the
+// package manager release and whether it is the default version run by the corepack shims.
+func parseCorepackPackage(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	versionDir := path.Dir(reader.RealPath)
+	managerDir := path.Dir(versionDir)
+	homeDir := path.Dir(managerDir)
+	if path.Base(homeDir) == "v1" {
+		homeDir = path.Dir(homeDir)
+	}
+
+	manager := path.Base(managerDir)
+	if path.Base(homeDir) != corepackHomeDir || !corepackManagedPackages.Has(manager) || !isCorepackVersion(path.Base(versionDir)) {
+		// this is not a package manager release within the corepack home
+		return nil, nil, nil
+	}
+
+	p, err := decodeGlobalPackageJSON(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !p.hasNameAndVersionValues() {
+		log.Debugf("encountered corepack package.json file without a name and/or version field, ignoring (path=%q)", reader.Path())
+		return nil, nil, nil
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+	lastKnownGood := readCorepackLastKnownGood(resolver, reader.Location, path.Join(homeDir, corepackLastKnownGoodFile))
+	isDefault := lastKnownGood.versions[manager] == p.Version
+	if isDefault {
+		locations = append(locations, lastKnownGood.location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	// the commands of the package manager are provided by the corepack shims rather than linked to the release
+	globalPkg := newGlobalPackage(resolver, corepackManager, p, "", locations...)
+	if isDefault {
+		metadata := globalPkg.Metadata.(pkg.NpmGlobalPackageEntry)
+		metadata.Default = true
+		globalPkg.Metadata = metadata
+		globalPkg.SetID()
+	}
+
+	return []pkg.Package{globalPkg}, nil, nil
+}
+
+// isCorepackVersion indicates whether the given directory name within the corepack home is a package manager version.
+func isCorepackVersion(name string) bool {
+	return name != "" && name[0] >= '0' && name[0] <= '9'
+}
+
+// corepackLastKnownGood is the version of each package manager that the corepack shims run by default.
+type corepackLastKnownGood struct {
+	location file.Location
+	versions map[string]string
+}
+
+func readCorepackLastKnownGood(resolver file.Resolver, from file.Location, lastKnownGoodPath string) corepackLastKnownGood {
+	if resolver == nil {
+		return corepackLastKnownGood{}
+	}
+
+	location := resolver.RelativeFileByPath(from, lastKnownGoodPath)
+	if location == nil {
+		return corepackLastKnownGood{}
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("error", err, "path", lastKnownGoodPath).Trace("unable to read corepack lastKnownGood.json")
+		return corepackLastKnownGood{}
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	var references map[string]string
+	if err := json.NewDecoder(contents).Decode(&references); err != nil {
+		log.WithFields("error", err, "path", lastKnownGoodPath).Trace("unable to parse corepack lastKnownGood.json")
+		return corepackLastKnownGood{}
+	}
+
+	versions := make(map[string]string, len(references))
+	for manager, reference := range references {
+		// newer releases of corepack record the hash of the release along with the version (e.g. "8.6.0+sha224.1234...")
+		version, _, _ := strings.Cut(reference, "+")
+		versions[manager] = version
+	}
+
+	return corepackLastKnownGood{location: *location, versions: versions}
+}
+
+func readGlobalPackageJSON(resolver file.Resolver, location file.Location) (*globalPackageJSON, error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	p, err := decodeGlobalPackageJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func decodeGlobalPackageJSON(reader io.Reader) (globalPackageJSON, error) {
+	var p globalPackageJSON
+	if err := json.NewDecoder(reader).Decode(&p); err != nil {
+		return p, fmt.Errorf("failed to parse package.json file: %w", err)
+	}
+	return p, nil
+}
+
+// bins returns the names of the commands provided by the package, where a single command (given as a path rather than
+// a map of names to paths) is named after the package (without the scope).
+func (p globalPackageJSON) bins() []string {
+	if len(p.Bin) == 0 {
+		return nil
+	}
+
+	var single string
+	if err := json.Unmarshal(p.Bin, &single); err == nil {
+		if single == "" {
+			return nil
+		}
+		return []string{path.Base(p.Name)}
+	}
+
+	var named map[string]string
+	if err := json.Unmarshal(p.Bin, &named); err != nil {
+		log.WithFields("package", p.Name).Trace("unable to parse package.json bin field")
+		return nil
+	}
+
+	var bins []string
+	for name := range named {
+		bins = append(bins, name)
+	}
+	sort.Strings(bins)
+	return bins
+}
@@ -0,0 +1,222 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestGlobalPackageCataloger(t *testing.T) {
+	fixture := "test-fixtures/global-package"
+	angular := file.NewLocation("usr/local/lib/node_modules/@angular/cli/package.json")
+	npm := file.NewLocation("usr/local/lib/node_modules/npm/package.json")
+	typescript := file.NewLocation("usr/local/lib/node_modules/typescript/package.json")
+	prettier := file.NewLocation("home/user/.config/yarn/global/node_modules/prettier/package.json")
+	yarnManifest := file.NewLocation("home/user/.config/yarn/global/package.json")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "@angular/cli",
+			Version:   "17.3.0",
+			PURL:      "pkg:npm/%40angular/cli@17.3.0",
+			FoundBy:   "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(angular, file.NewLocation("usr/local/bin/ng")),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", angular)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "npm",
+				Bins:    []string{"ng"},
+			},
+		},
+		{
+			Name:    "npm",
+			Version: "10.5.0",
+			PURL:    "pkg:npm/npm@10.5.0",
+			FoundBy: "javascript-global-package-cataloger",
+			// note: the commands are not linked within the fixture
+			Locations: file.NewLocationSet(npm),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Artistic-2.0", npm)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "npm",
+				Bins:    []string{"npm", "npx"},
+			},
+		},
+		{
+			Name:    "prettier",
+			Version: "3.2.5",
+			PURL:    "pkg:npm/prettier@3.2.5",
+			FoundBy: "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(
+				prettier,
+				yarnManifest,
+				file.NewLocation("home/user/.config/yarn/global/node_modules/.bin/prettier"),
+			),
+			Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", prettier)),
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "yarn",
+				Bins:    []string{"prettier"},
+			},
+		},
+		{
+			Name:    "typescript",
+			Version: "5.4.2",
+			PURL:    "pkg:npm/typescript@5.4.2",
+			FoundBy: "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(
+				typescript,
+				file.NewLocation("usr/local/bin/tsc"),
+				file.NewLocation("usr/local/bin/tsserver"),
+			),
+			Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", typescript)),
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "npm",
+				Bins:    []string{"tsc", "tsserver"},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, fixture).
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewGlobalPackageCataloger())
+}
+
+func TestGlobalPackageCataloger_Corepack(t *testing.T) {
+	fixture := "test-fixtures/corepack"
+	corepack := file.NewLocation("usr/local/lib/node_modules/corepack/package.json")
+	pnpm7 := file.NewLocation("root/.cache/node/corepack/v1/pnpm/7.33.0/package.json")
+	pnpm8 := file.NewLocation("root/.cache/node/corepack/v1/pnpm/8.15.1/package.json")
+	yarn := file.NewLocation("root/.cache/node/corepack/v1/yarn/1.22.19/package.json")
+	lastKnownGood := file.NewLocation("root/.cache/node/corepack/lastKnownGood.json")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:    "corepack",
+			Version: "0.24.0",
+			PURL:    "pkg:npm/corepack@0.24.0",
+			FoundBy: "javascript-global-package-cataloger",
+			// the shims of the package managers are linked to corepack
+			Locations: file.NewLocationSet(
+				corepack,
+				file.NewLocation("usr/local/bin/corepack"),
+				file.NewLocation("usr/local/bin/pnpm"),
+				file.NewLocation("usr/local/bin/pnpx"),
+				file.NewLocation("usr/local/bin/yarn"),
+				file.NewLocation("usr/local/bin/yarnpkg"),
+			),
+			Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", corepack)),
+			Language: pkg.JavaScript,
+			Type:     pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "npm",
+				Bins:    []string{"corepack", "pnpm", "pnpx", "yarn", "yarnpkg"},
+			},
+		},
+		{
+			Name:      "pnpm",
+			Version:   "7.33.0",
+			PURL:      "pkg:npm/pnpm@7.33.0",
+			FoundBy:   "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(pnpm7),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", pnpm7)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "corepack",
+				Bins:    []string{"pnpm", "pnpx"},
+			},
+		},
+		{
+			Name:      "pnpm",
+			Version:   "8.15.1",
+			PURL:      "pkg:npm/pnpm@8.15.1",
+			FoundBy:   "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(pnpm8, lastKnownGood),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", pnpm8)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "corepack",
+				Bins:    []string{"pnpm", "pnpx"},
+				Default: true,
+			},
+		},
+		{
+			Name:      "yarn",
+			Version:   "1.22.19",
+			PURL:      "pkg:npm/yarn@1.22.19",
+			FoundBy:   "javascript-global-package-cataloger",
+			Locations: file.NewLocationSet(yarn, lastKnownGood),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("BSD-2-Clause", yarn)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.NpmPkg,
+			Metadata: pkg.NpmGlobalPackageEntry{
+				Manager: "corepack",
+				Bins:    []string{"yarn", "yarnpkg"},
+				Default: true,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, fixture).
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewGlobalPackageCataloger())
+}
+
+func Test_globalPackageJSON_bins(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  string
+		bin  string
+		want []string
+	}{
+		{
+			name: "no bin field",
+			pkg:  "left-pad",
+		},
+		{
+			name: "single command named after the package",
+			pkg:  "prettier",
+			bin:  `"./bin/prettier.cjs"`,
+			want: []string{"prettier"},
+		},
+		{
+			name: "single command named after the scoped package",
+			pkg:  "@vue/cli",
+			bin:  `"./bin/vue.js"`,
+			want: []string{"cli"},
+		},
+		{
+			name: "named commands",
+			pkg:  "typescript",
+			bin:  `{"tsserver": "./bin/tsserver", "tsc": "./bin/tsc"}`,
+			want: []string{"tsc", "tsserver"},
+		},
+		{
+			name: "unexpected value",
+			pkg:  "broken",
+			bin:  `["./bin/broken"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := globalPackageJSON{packageJSON: packageJSON{Name: tt.pkg}}
+			if tt.bin != "" {
+				p.Bin = []byte(tt.bin)
+			}
+			assert.Equal(t, tt.want, p.bins())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package javascript
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// yarnCachePackageJSONExp matches the package.json of the package stored within a yarn berry cache archive, for example:
+// "node_modules/lodash/package.json" or "node_modules/@babel/core/package.json"
+var yarnCachePackageJSONExp = regexp.MustCompile(`^node_modules/(?:@[^/]+/)?[^/]+/package\.json$`)
+
+// parseYarnCache is a parser function for yarn berry cache archives (e.g. .yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip),
+// which are committed to the repository for zero-install projects, returning the npm package within the archive.
+func parseYarnCache(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read yarn cache archive: %w", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open yarn cache archive: %w", err)
+	}
+
+	for _, f := range archive.File {
+		if !yarnCachePackageJSONExp.MatchString(f.Name) {
+			continue
+		}
+
+		p, err := readYarnCachePackageJSON(f)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %q within yarn cache archive: %w", f.Name, err)
+		}
+
+		if !p.hasNameAndVersionValues() {
+			log.Debugf("encountered package.json file without a name and/or version field within yarn cache archive, ignoring (path=%q)", reader.Path())
+			return nil, nil, nil
+		}
+
+		return []pkg.Package{
+			newPackageJSONPackage(p, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		}, nil, nil
+	}
+
+	return nil, nil, nil
+}
+
+func readYarnCachePackageJSON(f *zip.File) (packageJSON, error) {
+	var p packageJSON
+
+	rc, err := f.Open()
+	if err != nil {
+		return p, err
+	}
+	defer internal.CloseAndLogError(rc, f.Name)
+
+	err = json.NewDecoder(rc).Decode(&p)
+	return p, err
+}
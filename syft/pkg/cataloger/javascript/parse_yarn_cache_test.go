@@ -0,0 +1,68 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseYarnCache(t *testing.T) {
+	tests := []struct {
+		fixture     string
+		expectedPkg pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/yarn-cache/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip",
+			expectedPkg: pkg.Package{
+				Name:     "lodash",
+				Version:  "4.17.21",
+				PURL:     "pkg:npm/lodash@4.17.21",
+				Type:     pkg.NpmPkg,
+				Language: pkg.JavaScript,
+				Licenses: pkg.NewLicenseSet(
+					pkg.NewLicenseFromLocations("MIT", file.NewLocation("test-fixtures/yarn-cache/.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip")),
+				),
+				Metadata: pkg.NpmPackage{
+					Name:        "lodash",
+					Version:     "4.17.21",
+					Author:      "John-David Dalton <john.david.dalton@gmail.com>",
+					Homepage:    "https://lodash.com/",
+					Description: "Lodash modular utilities.",
+					URL:         "lodash/lodash",
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/yarn-cache/.yarn/cache/@babel-code-frame-npm-7.10.4-ab1ee3c93e-feb4543c8a.zip",
+			expectedPkg: pkg.Package{
+				Name:     "@babel/code-frame",
+				Version:  "7.10.4",
+				PURL:     "pkg:npm/%40babel/code-frame@7.10.4",
+				Type:     pkg.NpmPkg,
+				Language: pkg.JavaScript,
+				Licenses: pkg.NewLicenseSet(
+					pkg.NewLicenseFromLocations("MIT", file.NewLocation("test-fixtures/yarn-cache/.yarn/cache/@babel-code-frame-npm-7.10.4-ab1ee3c93e-feb4543c8a.zip")),
+				),
+				Metadata: pkg.NpmPackage{
+					Name:        "@babel/code-frame",
+					Version:     "7.10.4",
+					Author:      "Sebastian McKenzie <sebmck@gmail.com>",
+					Homepage:    "https://babeljs.io/",
+					Description: "Generate errors that contain a code frame that point to source locations.",
+					URL:         "https://github.com/babel/babel.git",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			var expectedRelationships []artifact.Relationship
+			test.expectedPkg.Locations = file.NewLocationSet(file.NewLocation(test.fixture))
+			pkgtest.TestFileParser(t, test.fixture, parseYarnCache, []pkg.Package{test.expectedPkg}, expectedRelationships)
+		})
+	}
+}
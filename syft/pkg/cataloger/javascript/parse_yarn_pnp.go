@@ -0,0 +1,180 @@
+package javascript
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	// pnpRawRuntimeStateExp matches the start of the inlined PnP data within a yarn 3+ .pnp.cjs file, for example:
+	//	const RAW_RUNTIME_STATE =
+	//	'{\
+	//	  "__info": [\
+	pnpRawRuntimeStateExp = regexp.MustCompile(`RAW_RUNTIME_STATE\s*=\s*'`)
+
+	// pnpHydrateRuntimeStateExp matches the start of the inlined PnP data within a yarn 2 .pnp.js or .pnp.cjs file, for example:
+	//	function $$SETUP_STATE(hydrateRuntimeState, basePath) {
+	//	  return hydrateRuntimeState({
+	pnpHydrateRuntimeStateExp = regexp.MustCompile(`return\s+hydrateRuntimeState\(\s*\{`)
+)
+
+// yarnPnpData is the serialized Plug'n'Play state, found within .pnp.data.json or inlined within .pnp.cjs files.
+type yarnPnpData struct {
+	// PackageRegistryData is a list of [name, [[reference, info], ...]] tuples, where the name and reference are
+	// null for the top-level workspace.
+	PackageRegistryData [][2]json.RawMessage `json:"packageRegistryData"`
+}
+
+// parseYarnPnp is a parser function for yarn berry Plug'n'Play files (.pnp.cjs, .pnp.js and .pnp.data.json), returning
+// all npm packages that are part of the install.
+func parseYarnPnp(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if pathContainsNodeModulesDirectory(reader.Path()) {
+		return nil, nil, nil
+	}
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read yarn PnP file: %w", err)
+	}
+
+	if !strings.HasSuffix(reader.Path(), ".json") {
+		contents, err = extractYarnPnpData(contents)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse yarn PnP file: %w", err)
+		}
+		if contents == nil {
+			// the data is not inlined (it is in a .pnp.data.json file next to this one)
+			return nil, nil, nil
+		}
+	}
+
+	var data yarnPnpData
+	if err := json.NewDecoder(bytes.NewReader(contents)).Decode(&data); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse yarn PnP data: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	seen := strset.New()
+	for _, entry := range data.PackageRegistryData {
+		var name string
+		if err := json.Unmarshal(entry[0], &name); err != nil || name == "" {
+			// the top-level workspace has a null name
+			continue
+		}
+
+		var references [][2]json.RawMessage
+		if err := json.Unmarshal(entry[1], &references); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse yarn PnP references for package %q: %w", name, err)
+		}
+
+		for _, ref := range references {
+			var reference string
+			if err := json.Unmarshal(ref[0], &reference); err != nil {
+				continue
+			}
+
+			version := yarnPnpReferenceVersion(reference)
+			if version == "" || seen.Has(name+"@"+version) {
+				continue
+			}
+			seen.Add(name + "@" + version)
+
+			pkgs = append(pkgs, newYarnPnpPackage(resolver, reader.Location, name, version))
+		}
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// extractYarnPnpData returns the JSON PnP data inlined within the given .pnp.cjs (or .pnp.js) contents, or nil if
+// the data is not inlined.
+func extractYarnPnpData(contents []byte) ([]byte, error) {
+	if loc := pnpRawRuntimeStateExp.FindIndex(contents); loc != nil {
+		return unquoteJSSingleQuotedString(contents[loc[1]:])
+	}
+
+	if loc := pnpHydrateRuntimeStateExp.FindIndex(contents); loc != nil {
+		// the object is valid JSON, the decoder will stop reading at the end of the object
+		return contents[loc[1]-1:], nil
+	}
+
+	return nil, nil
+}
+
+// unquoteJSSingleQuotedString returns the value of the single-quoted javascript string that the given contents start
+// with (after the opening quote).
+func unquoteJSSingleQuotedString(contents []byte) ([]byte, error) {
+	var out bytes.Buffer
+	for i := 0; i < len(contents); i++ {
+		c := contents[i]
+		switch c {
+		case '\'':
+			return out.Bytes(), nil
+		case '\\':
+			i++
+			if i == len(contents) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			switch contents[i] {
+			case '\n':
+				// line continuation
+			case 'n':
+				out.WriteByte('\n')
+			case 't':
+				out.WriteByte('\t')
+			default:
+				out.WriteByte(contents[i])
+			}
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return nil, fmt.Errorf("unterminated string")
+}
+
+// yarnPnpReferenceVersion returns the version of an npm package reference, for example:
+//
+//	"npm:4.17.21" returns "4.17.21"
+//	"virtual:a5cd...#npm:18.2.0" returns "18.2.0"
+//	"patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d" returns "1.22.8"
+//
+// references that do not resolve to an npm registry package (e.g. "workspace:.", "link:...", "portal:...") return "".
+func yarnPnpReferenceVersion(reference string) string {
+	if strings.HasPrefix(reference, "virtual:") {
+		_, reference, _ = strings.Cut(reference, "#")
+	}
+
+	if patch, ok := strings.CutPrefix(reference, "patch:"); ok {
+		patch, _, _ = strings.Cut(patch, "#")
+		unescaped, err := url.PathUnescape(patch)
+		if err != nil {
+			return ""
+		}
+		_, version, ok := strings.Cut(unescaped, "@npm:")
+		if !ok {
+			return ""
+		}
+		return version
+	}
+
+	version, ok := strings.CutPrefix(reference, "npm:")
+	if !ok {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,103 @@
+package javascript
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseYarnPnp(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+	}{
+		{
+			name:    "yarn 3+ inlined data",
+			fixture: "test-fixtures/yarn-pnp/inline/.pnp.cjs",
+		},
+		{
+			name:    "yarn 2 inlined data",
+			fixture: "test-fixtures/yarn-pnp/v2/.pnp.js",
+		},
+		{
+			name:    "standalone data file",
+			fixture: "test-fixtures/yarn-pnp/data/.pnp.data.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var expectedRelationships []artifact.Relationship
+			locationSet := file.NewLocationSet(file.NewLocation(tt.fixture))
+
+			expectedPkgs := []pkg.Package{
+				{
+					Name:      "@babel/code-frame",
+					Version:   "7.10.4",
+					Locations: locationSet,
+					PURL:      "pkg:npm/%40babel/code-frame@7.10.4",
+					Language:  pkg.JavaScript,
+					Type:      pkg.NpmPkg,
+				},
+				{
+					Name:      "lodash",
+					Version:   "4.17.21",
+					Locations: locationSet,
+					PURL:      "pkg:npm/lodash@4.17.21",
+					Language:  pkg.JavaScript,
+					Type:      pkg.NpmPkg,
+				},
+				{
+					Name:      "react",
+					Version:   "18.2.0",
+					Locations: locationSet,
+					PURL:      "pkg:npm/react@18.2.0",
+					Language:  pkg.JavaScript,
+					Type:      pkg.NpmPkg,
+				},
+				{
+					Name:      "resolve",
+					Version:   "1.22.8",
+					Locations: locationSet,
+					PURL:      "pkg:npm/resolve@1.22.8",
+					Language:  pkg.JavaScript,
+					Type:      pkg.NpmPkg,
+				},
+			}
+
+			pkgtest.TestFileParser(t, tt.fixture, parseYarnPnp, expectedPkgs, expectedRelationships)
+		})
+	}
+}
+
+func TestParseYarnPnp_DataNotInlined(t *testing.T) {
+	pkgtest.TestFileParser(t, "test-fixtures/yarn-pnp/data/.pnp.cjs", parseYarnPnp, nil, nil)
+}
+
+func Test_yarnPnpReferenceVersion(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      string
+	}{
+		{reference: "npm:4.17.21", want: "4.17.21"},
+		{reference: "npm:1.0.0-beta.1", want: "1.0.0-beta.1"},
+		{reference: "virtual:0c5a6e1f7e2c#npm:18.2.0", want: "18.2.0"},
+		{reference: "patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d", want: "1.22.8"},
+		{reference: "patch:@types/node@npm%3A20.1.0#./patches/node.patch::locator=app%40workspace%3A.", want: "20.1.0"},
+		{reference: "workspace:.", want: ""},
+		{reference: "link:./local", want: ""},
+		{reference: "portal:../other", want: ""},
+		{reference: "virtual:0c5a6e1f7e2c#workspace:packages/a", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			assert.Equal(t, tt.want, yarnPnpReferenceVersion(tt.reference))
+		})
+	}
+}
@@ -0,0 +1,4 @@
+/*! jQuery v3.7.1 | (c) OpenJS Foundation and other contributors | jquery.org/license */
+!function(e,t){"use strict";e.jQuery=t()}(this,function(){return{}});
+(()=>{var e={};console.log("hello")})();
+//# sourceMappingURL=app.min.js.map
@@ -0,0 +1,2 @@
+(()=>{console.log("main")})();
+//# sourceMappingURL=data:application/json;charset=utf-8;base64,eyJ2ZXJzaW9uIjogMywgInNlY3Rpb25zIjogW3sib2Zmc2V0IjogeyJsaW5lIjogMCwgImNvbHVtbiI6IDB9LCAibWFwIjogeyJ2ZXJzaW9uIjogMywgIm1hcHBpbmdzIjogIkFBQUEiLCAic291cmNlcyI6IFsiLi4vbm9kZV9tb2R1bGVzL3pvZC9saWIvaW5kZXgubWpzIiwgIi4uL3NyYy9tYWluLnRzIl0sICJuYW1lcyI6IFtdfX1dfQ==
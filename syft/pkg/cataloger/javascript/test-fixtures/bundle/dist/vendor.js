@@ -0,0 +1,3 @@
+/*! preact v10.19.3 | MIT License */
+var n,l,u;n={};export{n as h};
+//# sourceMappingURL=vendor.js.map
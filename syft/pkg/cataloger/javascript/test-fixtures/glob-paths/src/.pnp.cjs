@@ -0,0 +1 @@
+bogus
@@ -0,0 +1,11 @@
+#!/usr/bin/env node
+/* eslint-disable */
+"use strict";
+
+function $$SETUP_STATE(hydrateRuntimeState, basePath) {
+  return hydrateRuntimeState(require('./.pnp.data.json'), {basePath: basePath || __dirname});
+}
+
+function $$SETUP_STATIC_TABLES() {
+  // the remainder of the PnP runtime has been omitted from this fixture
+}
@@ -0,0 +1,174 @@
+#!/usr/bin/env node
+/* eslint-disable */
+"use strict";
+
+const RAW_RUNTIME_STATE =
+'{\
+  "__info": [\
+    "This file is automatically generated. Do not touch it, or risk",\
+    "your modifications being lost."\
+  ],\
+  "dependencyTreeRoots": [\
+    {\
+      "name": "yarn-pnp-fixture",\
+      "reference": "workspace:."\
+    }\
+  ],\
+  "enableTopLevelFallback": true,\
+  "ignorePatternData": null,\
+  "fallbackExclusionList": [],\
+  "fallbackPool": [],\
+  "packageRegistryData": [\
+    [\
+      null,\
+      [\
+        [\
+          null,\
+          {\
+            "packageLocation": "./",\
+            "packageDependencies": [\
+              [\
+                "lodash",\
+                "npm:4.17.21"\
+              ],\
+              [\
+                "react",\
+                "virtual:0c5a6e1f7e2c#npm:18.2.0"\
+              ],\
+              [\
+                "resolve",\
+                "patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d"\
+              ]\
+            ],\
+            "linkType": "SOFT"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "@babel/code-frame",\
+      [\
+        [\
+          "npm:7.10.4",\
+          {\
+            "packageLocation": "./.yarn/cache/@babel-code-frame-npm-7.10.4-ab1ee3c93e-feb4543c8a.zip/node_modules/@babel/code-frame/",\
+            "packageDependencies": [\
+              [\
+                "@babel/code-frame",\
+                "npm:7.10.4"\
+              ]\
+            ],\
+            "linkType": "HARD"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "lodash",\
+      [\
+        [\
+          "npm:4.17.21",\
+          {\
+            "packageLocation": "./.yarn/cache/lodash-npm-4.17.21-6382451519-eb835a2e51.zip/node_modules/lodash/",\
+            "packageDependencies": [\
+              [\
+                "lodash",\
+                "npm:4.17.21"\
+              ]\
+            ],\
+            "linkType": "HARD"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "react",\
+      [\
+        [\
+          "npm:18.2.0",\
+          {\
+            "packageLocation": "./.yarn/cache/react-npm-18.2.0-1eae08fee2-88e38092da.zip/node_modules/react/",\
+            "packageDependencies": [\
+              [\
+                "react",\
+                "npm:18.2.0"\
+              ]\
+            ],\
+            "linkType": "HARD"\
+          }\
+        ],\
+        [\
+          "virtual:0c5a6e1f7e2c#npm:18.2.0",\
+          {\
+            "packageLocation": "./.yarn/__virtual__/react-virtual-5d1a0e9b3c/0/cache/react-npm-18.2.0-1eae08fee2-88e38092da.zip/node_modules/react/",\
+            "packageDependencies": [\
+              [\
+                "react",\
+                "virtual:0c5a6e1f7e2c#npm:18.2.0"\
+              ]\
+            ],\
+            "linkType": "HARD"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "resolve",\
+      [\
+        [\
+          "patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d",\
+          {\
+            "packageLocation": "./.yarn/cache/resolve-patch-4254c24959-f345cd37f5.zip/node_modules/resolve/",\
+            "packageDependencies": [\
+              [\
+                "resolve",\
+                "patch:resolve@npm%3A1.22.8#optional!builtin<compat/resolve>::version=1.22.8&hash=c3c19d"\
+              ]\
+            ],\
+            "linkType": "HARD"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "shared-utils",\
+      [\
+        [\
+          "workspace:packages/shared-utils",\
+          {\
+            "packageLocation": "./packages/shared-utils/",\
+            "packageDependencies": [\
+              [\
+                "shared-utils",\
+                "workspace:packages/shared-utils"\
+              ]\
+            ],\
+            "linkType": "SOFT"\
+          }\
+        ]\
+      ]\
+    ],\
+    [\
+      "yarn-pnp-fixture",\
+      [\
+        [\
+          "workspace:.",\
+          {\
+            "packageLocation": "./",\
+            "packageDependencies": [\
+              [\
+                "yarn-pnp-fixture",\
+                "workspace:."\
+              ]\
+            ],\
+            "linkType": "SOFT"\
+          }\
+        ]\
+      ]\
+    ]\
+  ]\
+}';
+
+function $$SETUP_STATIC_TABLES() {
+  // the remainder of the PnP runtime has been omitted from this fixture
+}
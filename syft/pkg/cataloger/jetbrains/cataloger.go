@@ -0,0 +1,18 @@
+/*
+Package jetbrains provides a concrete Cataloger implementation for plugins of IntelliJ-based IDEs.
+*/
+package jetbrains
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewPluginCataloger returns a new cataloger object for the plugins bundled with IntelliJ-based IDEs (within the
+// plugins directory of the installation) and the plugins installed by users (within the JetBrains data directory).
+func NewPluginCataloger() pkg.Cataloger {
+	return generic.NewCataloger("jetbrains-plugin-cataloger").
+		WithParserByGlobs(parsePluginJar, "**/plugins/*/lib/*.jar", "**/JetBrains/*/*/lib/*.jar").
+		WithProcessors(dependency.Processor(pluginDependencySpecifier))
+}
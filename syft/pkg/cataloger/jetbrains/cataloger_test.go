@@ -0,0 +1,131 @@
+package jetbrains
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain bundled and user installed plugin jars",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"opt/idea/plugins/java/lib/java-impl.jar",
+				"opt/idea/plugins/java/lib/java-frontback.jar",
+				"home/dev/.local/share/JetBrains/IntelliJIdea2024.1/some-plugin/lib/some-plugin.jar",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewPluginCataloger())
+		})
+	}
+}
+
+func TestCataloger_IDE(t *testing.T) {
+	buildLocation := file.NewLocation("idea-IU-241.14494.240/build.txt")
+	javaLocation := file.NewLocation("idea-IU-241.14494.240/plugins/java/lib/java-impl.jar")
+	kotlinLocation := file.NewLocation("idea-IU-241.14494.240/plugins/Kotlin/lib/kotlin-plugin.jar")
+
+	// note: the java plugin does not declare a version, so the build of the IDE is used
+	java := pkg.Package{
+		Name:      "Java",
+		Version:   "241.14494.240",
+		FoundBy:   "jetbrains-plugin-cataloger",
+		PURL:      "pkg:jetbrains-plugin/com.intellij.java@241.14494.240",
+		Locations: file.NewLocationSet(javaLocation, buildLocation),
+		Language:  pkg.Java,
+		Type:      pkg.JetBrainsPluginPkg,
+		Metadata: pkg.JetBrainsPluginEntry{
+			PluginID: "com.intellij.java",
+			Vendor:   "JetBrains",
+			Bundled:  true,
+			Modules:  []string{"com.intellij.modules.java"},
+			Depends: []pkg.JetBrainsPluginDependency{
+				{ID: "com.intellij.modules.platform"},
+				{ID: "com.intellij.copyright", Optional: true},
+			},
+		},
+	}
+
+	kotlin := pkg.Package{
+		Name:      "Kotlin",
+		Version:   "241.14494.240-IJ",
+		FoundBy:   "jetbrains-plugin-cataloger",
+		PURL:      "pkg:jetbrains-plugin/org.jetbrains.kotlin@241.14494.240-IJ",
+		Locations: file.NewLocationSet(kotlinLocation),
+		Language:  pkg.Java,
+		Type:      pkg.JetBrainsPluginPkg,
+		Metadata: pkg.JetBrainsPluginEntry{
+			PluginID:   "org.jetbrains.kotlin",
+			Vendor:     "JetBrains",
+			VendorURL:  "http://www.jetbrains.com",
+			SinceBuild: "241.14494",
+			UntilBuild: "241.14494.*",
+			Bundled:    true,
+			Depends: []pkg.JetBrainsPluginDependency{
+				{ID: "com.intellij.modules.platform"},
+				{ID: "com.intellij.modules.java"},
+				{ID: "com.intellij.gradle", Optional: true},
+			},
+		},
+	}
+
+	expectedRelationships := []artifact.Relationship{
+		{
+			// the kotlin plugin depends on the module provided by the java plugin
+			From: java,
+			To:   kotlin,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/ide").
+		Expects([]pkg.Package{java, kotlin}, expectedRelationships).
+		TestCataloger(t, NewPluginCataloger())
+}
+
+func TestCataloger_UserInstalled(t *testing.T) {
+	location := file.NewLocation(".local/share/JetBrains/IntelliJIdea2024.1/intellij-rainbow-brackets/lib/intellij-rainbow-brackets-2024.1.0.jar")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "Rainbow Brackets",
+			Version:   "2024.1.0",
+			FoundBy:   "jetbrains-plugin-cataloger",
+			PURL:      "pkg:jetbrains-plugin/izhangzhihao.rainbow.brackets@2024.1.0",
+			Locations: file.NewLocationSet(location),
+			Language:  pkg.Java,
+			Type:      pkg.JetBrainsPluginPkg,
+			Metadata: pkg.JetBrainsPluginEntry{
+				PluginID:   "izhangzhihao.rainbow.brackets",
+				Vendor:     "izhangzhihao",
+				VendorURL:  "https://github.com/izhangzhihao",
+				SinceBuild: "233.11799",
+				Depends: []pkg.JetBrainsPluginDependency{
+					{ID: "com.intellij.modules.lang"},
+				},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/user").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewPluginCataloger())
+}
@@ -0,0 +1,34 @@
+package jetbrains
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = pluginDependencySpecifier
+
+// pluginDependencySpecifier describes plugins as providing their plugin ID (and any modules they declare) and
+// requiring the plugins (or modules) they depend on. Optional dependencies are not required for the plugin to work,
+// so these are not considered.
+func pluginDependencySpecifier(p pkg.Package) dependency.Specification {
+	metadata, ok := p.Metadata.(pkg.JetBrainsPluginEntry)
+	if !ok {
+		return dependency.Specification{}
+	}
+
+	provides := append([]string{metadata.PluginID}, metadata.Modules...)
+
+	var requires []string
+	for _, d := range metadata.Depends {
+		if !d.Optional {
+			requires = append(requires, d.ID)
+		}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: provides,
+			Requires: requires,
+		},
+	}
+}
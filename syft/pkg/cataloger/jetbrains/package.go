@@ -0,0 +1,35 @@
+package jetbrains
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPluginPackage(name, version string, entry pkg.JetBrainsPluginEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(entry.PluginID, version),
+		Language:  pkg.Java,
+		Type:      pkg.JetBrainsPluginPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL of a plugin, which is identified by the plugin ID (rather than the display name).
+func packageURL(pluginID, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.JetBrainsPluginPkg.PackageURLType(),
+		"",
+		pluginID,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,192 @@
+package jetbrains
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePluginJar
+
+// pluginDescriptorPath is the path of the plugin descriptor within the plugin jar. Note that a plugin is made up of
+// all jars within the lib directory of the plugin, but only one of these has the descriptor.
+const pluginDescriptorPath = "META-INF/plugin.xml"
+
+// pluginDescriptor represents a plugin.xml file (see
+// https://plugins.jetbrains.com/docs/intellij/plugin-configuration-file.html).
+type pluginDescriptor struct {
+	ID      string `xml:"id"`
+	Name    string `xml:"name"`
+	Version string `xml:"version"`
+	Vendor  struct {
+		Name string `xml:",chardata"`
+		URL  string `xml:"url,attr"`
+	} `xml:"vendor"`
+	IdeaVersion struct {
+		SinceBuild string `xml:"since-build,attr"`
+		UntilBuild string `xml:"until-build,attr"`
+	} `xml:"idea-version"`
+	Depends []struct {
+		ID       string `xml:",chardata"`
+		Optional bool   `xml:"optional,attr"`
+	} `xml:"depends"`
+	Modules []struct {
+		Value string `xml:"value,attr"`
+	} `xml:"module"`
+	// dependencies of plugins using the newer (v2) descriptor format
+	Dependencies struct {
+		Plugins []struct {
+			ID string `xml:"id,attr"`
+		} `xml:"plugin"`
+	} `xml:"dependencies"`
+}
+
+func parsePluginJar(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	descriptor, found, err := readPluginDescriptor(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read plugin descriptor: %w", err)
+	}
+	if !found {
+		// most jars of a plugin do not have a descriptor
+		return nil, nil, nil
+	}
+
+	// the ID defaults to the name (and vice versa) when not specified
+	id := strings.TrimSpace(descriptor.ID)
+	name := strings.TrimSpace(descriptor.Name)
+	if id == "" {
+		id = name
+	}
+	if name == "" {
+		name = id
+	}
+	if id == "" {
+		return nil, nil, nil
+	}
+
+	entry := pkg.JetBrainsPluginEntry{
+		PluginID:   id,
+		Vendor:     strings.TrimSpace(descriptor.Vendor.Name),
+		VendorURL:  strings.TrimSpace(descriptor.Vendor.URL),
+		SinceBuild: descriptor.IdeaVersion.SinceBuild,
+		UntilBuild: descriptor.IdeaVersion.UntilBuild,
+	}
+	for _, m := range descriptor.Modules {
+		if m.Value != "" {
+			entry.Modules = append(entry.Modules, m.Value)
+		}
+	}
+	for _, d := range descriptor.Depends {
+		if id := strings.TrimSpace(d.ID); id != "" {
+			entry.Depends = append(entry.Depends, pkg.JetBrainsPluginDependency{ID: id, Optional: d.Optional})
+		}
+	}
+	for _, p := range descriptor.Dependencies.Plugins {
+		if p.ID != "" {
+			entry.Depends = append(entry.Depends, pkg.JetBrainsPluginDependency{ID: p.ID})
+		}
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	version := strings.TrimSpace(descriptor.Version)
+	if build, buildLocation := ideBuild(resolver, reader.Location); buildLocation != nil {
+		entry.Bundled = true
+		// bundled plugins are commonly versioned by the build of the IDE (which is set while building the IDE)
+		if version == "" {
+			version = build
+			locations = append(locations, buildLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	return []pkg.Package{newPluginPackage(name, version, entry, locations...)}, nil, nil
+}
+
+// readPluginDescriptor returns the plugin descriptor within the given jar, if there is one.
+func readPluginDescriptor(reader io.ReadCloser) (*pluginDescriptor, bool, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, false, err
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, false, err
+	}
+
+	archive, err := zip.NewReader(unionReader, size)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, f := range archive.File {
+		if f.Name != pluginDescriptorPath {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, false, err
+		}
+		defer internal.CloseAndLogError(rc, f.Name)
+
+		var descriptor pluginDescriptor
+		if err := xml.NewDecoder(rc).Decode(&descriptor); err != nil {
+			return nil, false, err
+		}
+		return &descriptor, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// ideBuild returns the build of the IDE installation that the plugin jar is bundled with, as found within the
+// build.txt file at the root of the installation (for example "IU-241.14494.240", where the product code is dropped).
+func ideBuild(resolver file.Resolver, location file.Location) (string, *file.Location) {
+	if resolver == nil {
+		return "", nil
+	}
+
+	// <installation>/plugins/<plugin>/lib/<jar>
+	pluginsDir := path.Dir(path.Dir(path.Dir(location.RealPath)))
+	if path.Base(pluginsDir) != "plugins" {
+		return "", nil
+	}
+
+	buildPath := path.Join(path.Dir(pluginsDir), "build.txt")
+	buildLocation := resolver.RelativeFileByPath(location, buildPath)
+	if buildLocation == nil {
+		return "", nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*buildLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", buildPath).Trace("unable to read IDE build")
+		return "", nil
+	}
+	defer internal.CloseAndLogError(contents, buildLocation.RealPath)
+
+	b, err := io.ReadAll(contents)
+	if err != nil {
+		log.WithFields("error", err, "path", buildPath).Trace("unable to read IDE build")
+		return "", nil
+	}
+
+	build := strings.TrimSpace(string(b))
+	if _, number, found := strings.Cut(build, "-"); found {
+		build = number
+	}
+	return build, buildLocation
+}
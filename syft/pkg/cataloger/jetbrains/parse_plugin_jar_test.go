@@ -0,0 +1,47 @@
+package jetbrains
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePluginJar(t *testing.T) {
+	fixture := "test-fixtures/ide/idea-IU-241.14494.240/plugins/java/lib/java-impl.jar"
+
+	// note: without a resolver the IDE build cannot be read, so the bundled plugin is unversioned
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "Java",
+			PURL:      "pkg:jetbrains-plugin/com.intellij.java",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.Java,
+			Type:      pkg.JetBrainsPluginPkg,
+			Metadata: pkg.JetBrainsPluginEntry{
+				PluginID: "com.intellij.java",
+				Vendor:   "JetBrains",
+				Modules:  []string{"com.intellij.modules.java"},
+				Depends: []pkg.JetBrainsPluginDependency{
+					{ID: "com.intellij.modules.platform"},
+					{ID: "com.intellij.copyright", Optional: true},
+				},
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parsePluginJar, expectedPkgs, nil)
+}
+
+func TestParsePluginJar_NoDescriptor(t *testing.T) {
+	fixture := "test-fixtures/ide/idea-IU-241.14494.240/plugins/java/lib/java-frontback.jar"
+	pkgtest.TestFileParser(t, fixture, parsePluginJar, nil, nil)
+}
+
+func TestParsePluginJar_NotAnArchive(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromString("plugins/foo/lib/foo.jar", "not a jar").
+		WithError().
+		TestParser(t, parsePluginJar)
+}
@@ -65,6 +65,12 @@ func (l linuxKernelCataloger) Catalog(ctx context.Context, resolver file.Resolve
 		errs = multierror.Append(errs, err)
 	}
 
+	builds, err := findKernelBuildFiles(resolver)
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	kernelPackages = mergeKernelBuildFiles(l.Name(), kernelPackages, builds)
+
 	allRelationships = append(allRelationships, kernelRelationships...)
 	allPackages = append(allPackages, kernelPackages...)
 
@@ -79,6 +85,12 @@ func (l linuxKernelCataloger) Catalog(ctx context.Context, resolver file.Resolve
 		moduleToKernelRelationships := createKernelToModuleRelationships(kernelPackages, modulePackages)
 		allRelationships = append(allRelationships, moduleRelationships...)
 		allRelationships = append(allRelationships, moduleToKernelRelationships...)
+
+		deps, err := findModuleDependencies(resolver)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		allRelationships = append(allRelationships, createModuleDependencyRelationships(modulePackages, deps)...)
 	}
 
 	return allPackages, allRelationships, errs
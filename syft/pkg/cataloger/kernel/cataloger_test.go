@@ -66,6 +66,10 @@ func Test_KernelCataloger(t *testing.T) {
 			KernelVersion: "6.0.7-301.fc37.x86_64",
 			VersionMagic:  "6.0.7-301.fc37.x86_64 SMP preempt mod_unload ",
 			Parameters:    map[string]pkg.LinuxKernelModuleParameter{},
+			// Fedora signs the modules shipped with the kernel
+			SignatureType:          "PKCS#7",
+			Signer:                 "Fedora kernel signing key",
+			SignatureHashAlgorithm: "sha256",
 		},
 	}
 
@@ -93,3 +97,100 @@ func Test_KernelCataloger(t *testing.T) {
 			),
 		)
 }
+
+func Test_KernelCataloger_BuildFiles(t *testing.T) {
+	configLocation := file.NewLocation("boot/config-6.1.0-test")
+	systemMapLocation := file.NewLocation("boot/System.map-6.1.0-test")
+
+	kernelPkg := pkg.Package{
+		Name:    "linux-kernel",
+		Version: "6.1.0-test",
+		FoundBy: "linux-kernel-cataloger",
+		Locations: file.NewLocationSet(
+			configLocation,
+			systemMapLocation,
+		),
+		Type: pkg.LinuxKernelPkg,
+		PURL: "pkg:generic/linux-kernel@6.1.0-test",
+		Metadata: pkg.LinuxKernel{
+			Architecture: "x86",
+			Version:      "6.1.0-test",
+			ConfigHash:   "sha256:b18d3b8a03cdfe15739da8d1928833491b18f3497e5696d289892d0d0f37b25f",
+		},
+	}
+
+	fooLocation := file.NewLocation("lib/modules/6.1.0-test/kernel/drivers/misc/foo.ko")
+	fooPkg := pkg.Package{
+		Name:      "foo",
+		FoundBy:   "linux-kernel-cataloger",
+		Locations: file.NewLocationSet(fooLocation),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("GPL", fooLocation)),
+		Type:      pkg.LinuxKernelModulePkg,
+		PURL:      "pkg:generic/foo",
+		Metadata: pkg.LinuxKernelModule{
+			Name:                   "foo",
+			License:                "GPL",
+			Path:                   "lib/modules/6.1.0-test/kernel/drivers/misc/foo.ko",
+			Description:            "Example in-tree module",
+			Author:                 "Example Author",
+			KernelVersion:          "6.1.0-test",
+			VersionMagic:           "6.1.0-test SMP preempt mod_unload modversions ",
+			Parameters:             map[string]pkg.LinuxKernelModuleParameter{},
+			SignatureType:          "PKCS#7",
+			Signer:                 "Example kernel signing key",
+			SignatureHashAlgorithm: "sha256",
+		},
+	}
+
+	barLocation := file.NewLocation("lib/modules/6.1.0-test/updates/dkms/bar.ko")
+	barPkg := pkg.Package{
+		Name:      "bar",
+		Version:   "1.2.3",
+		FoundBy:   "linux-kernel-cataloger",
+		Locations: file.NewLocationSet(barLocation),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(barLocation, "Dual MIT/GPL")...),
+		Type:      pkg.LinuxKernelModulePkg,
+		PURL:      "pkg:generic/bar@1.2.3",
+		Metadata: pkg.LinuxKernelModule{
+			Name:          "bar",
+			Version:       "1.2.3",
+			SourceVersion: "0123456789ABCDEF0123456",
+			License:       "Dual MIT/GPL",
+			Path:          "lib/modules/6.1.0-test/updates/dkms/bar.ko",
+			Description:   "Example out-of-tree module",
+			KernelVersion: "6.1.0-test",
+			VersionMagic:  "6.1.0-test SMP preempt mod_unload modversions ",
+			Parameters:    map[string]pkg.LinuxKernelModuleParameter{},
+			Depends:       []string{"foo"},
+			OutOfTree:     true,
+		},
+	}
+
+	expectedPkgs := []pkg.Package{
+		kernelPkg,
+		fooPkg,
+		barPkg,
+	}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: kernelPkg,
+			To:   fooPkg,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: kernelPkg,
+			To:   barPkg,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: fooPkg,
+			To:   barPkg,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/build-files").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewLinuxKernelCataloger(DefaultLinuxKernelCatalogerConfig()))
+}
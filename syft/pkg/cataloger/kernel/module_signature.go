@@ -0,0 +1,180 @@
+package kernel
+
+import (
+	"bytes"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// moduleSignatureMagic is appended to signed kernel modules (see scripts/sign-file.c within the kernel source)
+const moduleSignatureMagic = "~Module signature appended~\n"
+
+// moduleSignatureInfo is the trailer describing the signature appended to a kernel module (see struct
+// module_signature within include/linux/module_signature.h of the kernel source).
+type moduleSignatureInfo struct {
+	Algorithm uint8
+	Hash      uint8
+	IDType    uint8
+	SignerLen uint8
+	KeyIDLen  uint8
+	_         [3]uint8
+	SigLen    uint32
+}
+
+const pkcs7IDType = 2
+
+// moduleSignatureIDTypes are the names of the signature types, as reported by modinfo
+var moduleSignatureIDTypes = map[uint8]string{
+	0:           "PGP",
+	1:           "X509",
+	pkcs7IDType: "PKCS#7",
+}
+
+var hashAlgorithms = map[string]string{
+	"1.3.14.3.2.26":           "sha1",
+	"2.16.840.1.101.3.4.2.4":  "sha224",
+	"2.16.840.1.101.3.4.2.1":  "sha256",
+	"2.16.840.1.101.3.4.2.2":  "sha384",
+	"2.16.840.1.101.3.4.2.3":  "sha512",
+	"2.16.840.1.101.3.4.2.7":  "sha3-224",
+	"2.16.840.1.101.3.4.2.8":  "sha3-256",
+	"2.16.840.1.101.3.4.2.9":  "sha3-384",
+	"2.16.840.1.101.3.4.2.10": "sha3-512",
+	"1.2.156.10197.1.401":     "sm3",
+}
+
+type moduleSignature struct {
+	Type          string
+	Signer        string
+	HashAlgorithm string
+}
+
+// pkcs7ContentInfo and the following types are the parts of a PKCS#7 (RFC 2315) signature needed to describe the signer.
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue     `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue     `asn1:"optional,tag:1"`
+	SignerInfos      []pkcs7SignerInfo `asn1:"set"`
+}
+
+type pkcs7SignerInfo struct {
+	Version         int
+	SignerID        asn1.RawValue
+	DigestAlgorithm pkix.AlgorithmIdentifier
+}
+
+type pkcs7IssuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial asn1.RawValue
+}
+
+// readModuleSignature returns the signature appended to a kernel module, or nil if the module is not signed.
+func readModuleSignature(r io.ReadSeeker) (*moduleSignature, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	infoSize := int64(binary.Size(moduleSignatureInfo{}))
+	trailerSize := infoSize + int64(len(moduleSignatureMagic))
+	if size < trailerSize {
+		return nil, nil
+	}
+
+	trailer := make([]byte, trailerSize)
+	if _, err := r.Seek(size-trailerSize, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, trailer); err != nil {
+		return nil, err
+	}
+	if !bytes.HasSuffix(trailer, []byte(moduleSignatureMagic)) {
+		return nil, nil
+	}
+
+	var info moduleSignatureInfo
+	if err := binary.Read(bytes.NewReader(trailer), binary.BigEndian, &info); err != nil {
+		return nil, err
+	}
+
+	sig := &moduleSignature{Type: moduleSignatureIDTypes[info.IDType]}
+	if info.IDType != pkcs7IDType {
+		// other signature types are no longer produced by the kernel (since v4.3)
+		return sig, nil
+	}
+
+	if int64(info.SigLen) > size-trailerSize {
+		return nil, fmt.Errorf("invalid module signature length: %d", info.SigLen)
+	}
+
+	data := make([]byte, info.SigLen)
+	if _, err := r.Seek(size-trailerSize-int64(info.SigLen), io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	signer, hashAlgorithm, err := parsePKCS7Signer(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse module signature: %w", err)
+	}
+	sig.Signer = signer
+	sig.HashAlgorithm = hashAlgorithm
+
+	return sig, nil
+}
+
+// parsePKCS7Signer returns the signer (the common name of the certificate issuer) and digest algorithm of the first
+// signer of a PKCS#7 signature, which is how modinfo describes the signature.
+func parsePKCS7Signer(data []byte) (string, string, error) {
+	var info pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(data, &info); err != nil {
+		return "", "", err
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &signedData); err != nil {
+		return "", "", err
+	}
+	if len(signedData.SignerInfos) == 0 {
+		return "", "", fmt.Errorf("no signer information")
+	}
+
+	signerInfo := signedData.SignerInfos[0]
+	hashAlgorithm := hashAlgorithms[signerInfo.DigestAlgorithm.Algorithm.String()]
+
+	// the signer may be identified by the subject key identifier instead of the issuer (which has no name)
+	if signerInfo.SignerID.Class != asn1.ClassUniversal || signerInfo.SignerID.Tag != asn1.TagSequence {
+		return "", hashAlgorithm, nil
+	}
+
+	var sid pkcs7IssuerAndSerial
+	if _, err := asn1.Unmarshal(signerInfo.SignerID.FullBytes, &sid); err != nil {
+		return "", "", err
+	}
+
+	var issuer pkix.RDNSequence
+	if _, err := asn1.Unmarshal(sid.Issuer.FullBytes, &issuer); err != nil {
+		return "", "", err
+	}
+
+	var name pkix.Name
+	name.FillFromRDNSequence(&issuer)
+
+	signer := name.CommonName
+	if signer == "" && len(name.Organization) > 0 {
+		signer = name.Organization[0]
+	}
+	return signer, hashAlgorithm, nil
+}
@@ -0,0 +1,290 @@
+package kernel
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+var kernelBuildFileGlobs = []string{
+	"**/boot/config-*",
+	"**/boot/System.map-*",
+	"**/lib/modules/*/config",
+	"**/lib/modules/*/System.map",
+}
+
+var kernelModulesDepGlobs = []string{
+	"**/lib/modules/*/modules.dep",
+}
+
+// the number of lines at the start of a kernel config file searched for the header
+const kernelConfigHeaderLines = 10
+
+var (
+	// e.g. "# Linux/x86 6.0.7-301.fc37.x86_64 Kernel Configuration"
+	kernelConfigHeaderPattern = regexp.MustCompile(`^# Linux/(\S+) (\S+) Kernel Configuration$`)
+
+	// e.g. "ffffffff81000000 T _text"
+	systemMapPattern = regexp.MustCompile(`^[0-9a-fA-F]+ [a-zA-Z] \S+$`)
+)
+
+// kernelBuildFiles are the files installed alongside a kernel release that describe how the kernel was built.
+type kernelBuildFiles struct {
+	release      string
+	architecture string
+	configHash   string
+	locations    []file.Location
+}
+
+// findKernelBuildFiles returns the kernel config and System.map files found, by kernel release.
+func findKernelBuildFiles(resolver file.Resolver) (map[string]*kernelBuildFiles, error) {
+	locations, err := resolver.FilesByGlob(kernelBuildFileGlobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	builds := make(map[string]*kernelBuildFiles)
+	for _, location := range locations {
+		name := path.Base(location.RealPath)
+		isConfig := strings.HasPrefix(name, "config")
+
+		release := kernelRelease(location.RealPath)
+		if release == "" {
+			continue
+		}
+
+		b, ok := builds[release]
+		if !ok {
+			b = &kernelBuildFiles{release: release}
+		}
+
+		if isConfig {
+			architecture, hash, err := readKernelConfig(resolver, location)
+			if err != nil {
+				log.WithFields("path", location.RealPath, "error", err).Trace("unable to read kernel config")
+				continue
+			}
+			if hash == "" {
+				continue
+			}
+			b.architecture = architecture
+			b.configHash = hash
+		} else if !isSystemMap(resolver, location) {
+			continue
+		}
+
+		b.locations = append(b.locations, location)
+		builds[release] = b
+	}
+
+	return builds, nil
+}
+
+// kernelRelease returns the kernel release that a build file belongs to, which is either part of the file name
+// (e.g. /boot/config-6.0.7-301.fc37.x86_64) or the name of the module directory (e.g. /lib/modules/6.0.7-301.fc37.x86_64/config).
+func kernelRelease(p string) string {
+	name := path.Base(p)
+	if _, release, found := strings.Cut(name, "-"); found {
+		return release
+	}
+	if path.Base(path.Dir(path.Dir(p))) == "modules" {
+		return path.Base(path.Dir(p))
+	}
+	return ""
+}
+
+// readKernelConfig returns the architecture and digest of a kernel config file. An empty digest is returned if the
+// file is not a kernel config.
+func readKernelConfig(resolver file.Resolver, location file.Location) (string, string, error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return "", "", err
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	hasher := sha256.New()
+	reader := bufio.NewReader(io.TeeReader(contents, hasher))
+
+	var (
+		architecture string
+		isConfig     bool
+	)
+	for i := 0; i < kernelConfigHeaderLines; i++ {
+		line, err := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if match := kernelConfigHeaderPattern.FindStringSubmatch(line); match != nil {
+			architecture = match[1]
+			isConfig = true
+			break
+		}
+		if strings.HasPrefix(line, "CONFIG_") {
+			isConfig = true
+			break
+		}
+		if err != nil {
+			break
+		}
+	}
+	if !isConfig {
+		return "", "", nil
+	}
+
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return "", "", err
+	}
+
+	return architecture, fmt.Sprintf("sha256:%x", hasher.Sum(nil)), nil
+}
+
+func isSystemMap(resolver file.Resolver, location file.Location) bool {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read System.map")
+		return false
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	scanner := bufio.NewScanner(contents)
+	return scanner.Scan() && systemMapPattern.MatchString(scanner.Text())
+}
+
+// mergeKernelBuildFiles describes the kernel packages with the build files of the same release, adding a kernel package
+// for each release with build files but without a kernel image (e.g. when only the kernel headers are installed).
+func mergeKernelBuildFiles(catalogerName string, kernelPackages []pkg.Package, builds map[string]*kernelBuildFiles) []pkg.Package {
+	merged := make(map[string]struct{})
+	for i, p := range kernelPackages {
+		b, ok := builds[p.Version]
+		if !ok {
+			continue
+		}
+		merged[b.release] = struct{}{}
+
+		if metadata, ok := p.Metadata.(pkg.LinuxKernel); ok {
+			metadata.ConfigHash = b.configHash
+			p.Metadata = metadata
+		}
+		for _, l := range b.locations {
+			p.Locations.Add(l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+		p.SetID()
+		kernelPackages[i] = p
+	}
+
+	var releases []string
+	for release := range builds {
+		if _, ok := merged[release]; !ok {
+			releases = append(releases, release)
+		}
+	}
+	sort.Strings(releases)
+
+	for _, release := range releases {
+		b := builds[release]
+		p := newLinuxKernelPackage(pkg.LinuxKernel{
+			Version:      b.release,
+			Architecture: b.architecture,
+			ConfigHash:   b.configHash,
+		}, b.locations[0])
+		for _, l := range b.locations[1:] {
+			p.Locations.Add(l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+		p.FoundBy = catalogerName
+		p.SetID()
+		kernelPackages = append(kernelPackages, p)
+	}
+
+	return kernelPackages
+}
+
+// findModuleDependencies returns the paths of the modules each module depends on, as described by the modules.dep
+// files (generated by depmod) of each kernel release.
+func findModuleDependencies(resolver file.Resolver) (map[string][]string, error) {
+	locations, err := resolver.FilesByGlob(kernelModulesDepGlobs...)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make(map[string][]string)
+	for _, location := range locations {
+		if err := readModulesDep(resolver, location, deps); err != nil {
+			log.WithFields("path", location.RealPath, "error", err).Trace("unable to read modules.dep")
+		}
+	}
+	return deps, nil
+}
+
+// readModulesDep reads the dependencies of a modules.dep file, where each line is a module followed by the modules it
+// depends on (e.g. "kernel/fs/nfs/nfs.ko: kernel/net/sunrpc/sunrpc.ko kernel/fs/lockd/lockd.ko"), relative to the
+// directory of the modules.dep file.
+func readModulesDep(resolver file.Resolver, location file.Location, deps map[string][]string) error {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return err
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	dir := path.Dir(location.RealPath)
+	resolve := func(p string) string {
+		if path.IsAbs(p) {
+			// older versions of depmod write absolute paths
+			return p
+		}
+		return path.Join(dir, p)
+	}
+
+	scanner := bufio.NewScanner(contents)
+	for scanner.Scan() {
+		module, dependencies, found := strings.Cut(scanner.Text(), ":")
+		if !found || strings.TrimSpace(module) == "" {
+			continue
+		}
+		modulePath := resolve(strings.TrimSpace(module))
+		for _, dep := range strings.Fields(dependencies) {
+			deps[modulePath] = append(deps[modulePath], resolve(dep))
+		}
+	}
+	return scanner.Err()
+}
+
+// createModuleDependencyRelationships returns the relationships between modules and the modules they depend on:
+// [dependency module] --(dependency of)--> [module]
+func createModuleDependencyRelationships(modulePackages []pkg.Package, deps map[string][]string) []artifact.Relationship {
+	modulesByPath := make(map[string]pkg.Package)
+	for _, p := range modulePackages {
+		if m, ok := p.Metadata.(pkg.LinuxKernelModule); ok && m.Path != "" {
+			modulesByPath[m.Path] = p
+		}
+	}
+
+	var relationships []artifact.Relationship
+	for _, p := range modulePackages {
+		m, ok := p.Metadata.(pkg.LinuxKernelModule)
+		if !ok {
+			continue
+		}
+		for _, depPath := range deps[m.Path] {
+			dep, ok := modulesByPath[depPath]
+			if !ok {
+				// e.g. compressed modules, which are not cataloged
+				continue
+			}
+			relationships = append(relationships, artifact.Relationship{
+				From: dep,
+				To:   p,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+	return relationships
+}
@@ -0,0 +1,74 @@
+package kernel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func Test_kernelRelease(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/boot/config-6.0.7-301.fc37.x86_64", want: "6.0.7-301.fc37.x86_64"},
+		{path: "/boot/System.map-6.1.0-13-amd64", want: "6.1.0-13-amd64"},
+		{path: "/lib/modules/6.8.0-45-generic/config", want: "6.8.0-45-generic"},
+		{path: "/lib/modules/6.8.0-45-generic/System.map", want: "6.8.0-45-generic"},
+		{path: "/boot/config", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, kernelRelease(tt.path))
+		})
+	}
+}
+
+func Test_mergeKernelBuildFiles(t *testing.T) {
+	image := newLinuxKernelPackage(pkg.LinuxKernel{
+		Version:      "6.1.0-13-amd64",
+		Architecture: "x86",
+		Format:       "bzImage",
+	}, file.NewLocation("/boot/vmlinuz-6.1.0-13-amd64"))
+	image.FoundBy = "linux-kernel-cataloger"
+
+	builds := map[string]*kernelBuildFiles{
+		"6.1.0-13-amd64": {
+			release:      "6.1.0-13-amd64",
+			architecture: "x86",
+			configHash:   "sha256:abc",
+			locations:    []file.Location{file.NewLocation("/boot/config-6.1.0-13-amd64")},
+		},
+		"6.1.0-12-amd64": {
+			release:   "6.1.0-12-amd64",
+			locations: []file.Location{file.NewLocation("/boot/System.map-6.1.0-12-amd64")},
+		},
+	}
+
+	got := mergeKernelBuildFiles("linux-kernel-cataloger", []pkg.Package{image}, builds)
+	require.Len(t, got, 2)
+
+	// the build files describe the kernel image of the same release
+	merged := got[0]
+	assert.NotEqual(t, image.ID(), merged.ID())
+	assert.Equal(t, "sha256:abc", merged.Metadata.(pkg.LinuxKernel).ConfigHash)
+	assert.Equal(t, "bzImage", merged.Metadata.(pkg.LinuxKernel).Format)
+	assert.Len(t, merged.Locations.ToSlice(), 2)
+	for _, l := range merged.Locations.ToSlice() {
+		want := pkg.SupportingEvidenceAnnotation
+		if l.RealPath == "/boot/vmlinuz-6.1.0-13-amd64" {
+			want = pkg.PrimaryEvidenceAnnotation
+		}
+		assert.Equal(t, want, l.Annotations[pkg.EvidenceAnnotationKey], l.RealPath)
+	}
+
+	// a release without a kernel image is described by the build files alone
+	added := got[1]
+	assert.Equal(t, "6.1.0-12-amd64", added.Version)
+	assert.Equal(t, "linux-kernel-cataloger", added.FoundBy)
+	assert.Equal(t, "pkg:generic/linux-kernel@6.1.0-12-amd64", added.PURL)
+}
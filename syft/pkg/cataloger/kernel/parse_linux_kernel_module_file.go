@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/anchore/syft/internal/log"
 	"github.com/anchore/syft/syft/artifact"
 	"github.com/anchore/syft/syft/file"
 	"github.com/anchore/syft/syft/internal/unionreader"
@@ -30,6 +31,16 @@ func parseLinuxKernelModuleFile(_ context.Context, _ file.Resolver, _ *generic.E
 
 	metadata.Path = reader.Location.RealPath
 
+	signature, err := readModuleSignature(unionReader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read kernel module signature")
+	}
+	if signature != nil {
+		metadata.SignatureType = signature.Type
+		metadata.Signer = signature.Signer
+		metadata.SignatureHashAlgorithm = signature.HashAlgorithm
+	}
+
 	return []pkg.Package{
 		newLinuxKernelModulePackage(
 			*metadata,
@@ -67,6 +78,8 @@ func parseLinuxKernelModuleMetadata(r unionreader.UnionReader) (p *pkg.LinuxKern
 	// vermagic:       5.10.121-linuxkit SMP mod_unload
 	p = &pkg.LinuxKernelModule{
 		Parameters: make(map[string]pkg.LinuxKernelModuleParameter),
+		// modules built within the kernel source tree are marked with "intree=Y"
+		OutOfTree: true,
 	}
 	f, err := elf.NewFile(r)
 	if err != nil {
@@ -133,6 +146,14 @@ func addLinuxKernelModuleEntry(k *pkg.LinuxKernelModule, entry []byte) error {
 		k.SourceVersion = value
 	case "description":
 		k.Description = value
+	case "depends":
+		for _, dep := range strings.Split(value, ",") {
+			if dep = strings.TrimSpace(dep); dep != "" {
+				k.Depends = append(k.Depends, dep)
+			}
+		}
+	case "intree":
+		k.OutOfTree = value != "Y"
 	case "parm":
 		parts := strings.SplitN(value, ":", 2)
 		if len(parts) != 2 {
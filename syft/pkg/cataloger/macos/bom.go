@@ -0,0 +1,260 @@
+package macos
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// the magic of a bill of materials (BOM) file, which installer packages use to describe the paths that they install
+var bomMagic = []byte("BOMStore")
+
+const (
+	bomHeaderSize = 32
+
+	// the maximum depth of the paths tree (and of the directory hierarchy) that is followed
+	maxBOMDepth = 64
+
+	bomFileType      = 1
+	bomDirectoryType = 2
+	bomLinkType      = 3
+)
+
+var errBOMTruncated = errors.New("unexpected end of BOM data")
+
+// bomEntry is a single path within a bill of materials.
+type bomEntry struct {
+	Path string
+	Type uint8
+	Mode uint16
+	Size uint32
+}
+
+// bom is a bill of materials file (the "BOMStore" format read by lsbom), which consists of numbered blocks of data
+// along with named variables that refer to blocks. The "Paths" variable refers to a B+ tree, whose leaves refer to
+// the name and parent of each path along with the details of the file at that path.
+type bom struct {
+	data   []byte
+	blocks [][2]uint32
+	vars   map[string]uint32
+}
+
+// readBOM returns the paths described by a bill of materials, sorted by path. Paths are relative to the install
+// location of the package (e.g. "./usr/local/bin/hello").
+func readBOM(data []byte) ([]bomEntry, error) {
+	b, err := newBOM(data)
+	if err != nil {
+		return nil, err
+	}
+	return b.paths()
+}
+
+func newBOM(data []byte) (*bom, error) {
+	if len(data) < bomHeaderSize || string(data[:len(bomMagic)]) != string(bomMagic) {
+		return nil, errors.New("not a BOM file")
+	}
+
+	indexOffset := binary.BigEndian.Uint32(data[16:20])
+	varsOffset := binary.BigEndian.Uint32(data[24:28])
+
+	b := &bom{
+		data: data,
+		vars: make(map[string]uint32),
+	}
+
+	index, err := b.slice(indexOffset, 4)
+	if err != nil {
+		return nil, err
+	}
+	count := binary.BigEndian.Uint32(index)
+	pointers, err := b.slice(indexOffset+4, uint64(count)*8)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < count; i++ {
+		b.blocks = append(b.blocks, [2]uint32{
+			binary.BigEndian.Uint32(pointers[i*8:]),
+			binary.BigEndian.Uint32(pointers[i*8+4:]),
+		})
+	}
+
+	vars, err := b.slice(varsOffset, 4)
+	if err != nil {
+		return nil, err
+	}
+	offset := uint64(varsOffset) + 4
+	for i := binary.BigEndian.Uint32(vars); i > 0; i-- {
+		v, err := b.slice(uint32(offset), 5)
+		if err != nil {
+			return nil, err
+		}
+		name, err := b.slice(uint32(offset+5), uint64(v[4]))
+		if err != nil {
+			return nil, err
+		}
+		b.vars[string(name)] = binary.BigEndian.Uint32(v)
+		offset += 5 + uint64(v[4])
+	}
+
+	return b, nil
+}
+
+// slice returns the given range of the file, or an error when the range is beyond the end of the file.
+func (b *bom) slice(offset uint32, length uint64) ([]byte, error) {
+	if uint64(offset)+length > uint64(len(b.data)) {
+		return nil, errBOMTruncated
+	}
+	return b.data[offset : uint64(offset)+length], nil
+}
+
+// block returns the contents of the block with the given index.
+func (b *bom) block(index uint32) ([]byte, error) {
+	if index == 0 || int(index) >= len(b.blocks) {
+		return nil, fmt.Errorf("invalid BOM block: %d", index)
+	}
+	return b.slice(b.blocks[index][0], uint64(b.blocks[index][1]))
+}
+
+type bomPathNode struct {
+	parent uint32
+	name   string
+}
+
+func (b *bom) paths() ([]bomEntry, error) {
+	treeIndex, ok := b.vars["Paths"]
+	if !ok {
+		return nil, errors.New("BOM has no paths")
+	}
+	tree, err := b.block(treeIndex)
+	if err != nil {
+		return nil, err
+	}
+	if len(tree) < 12 || string(tree[:4]) != "tree" {
+		return nil, errors.New("invalid BOM paths tree")
+	}
+
+	// descend to the first leaf of the tree...
+	node := binary.BigEndian.Uint32(tree[8:12])
+	var paths []byte
+	for depth := 0; ; depth++ {
+		if depth > maxBOMDepth {
+			return nil, errors.New("BOM paths tree is nested too deeply")
+		}
+		if paths, err = b.block(node); err != nil {
+			return nil, err
+		}
+		if len(paths) < 12 {
+			return nil, errBOMTruncated
+		}
+		if binary.BigEndian.Uint16(paths[0:2]) != 0 {
+			break
+		}
+		// the first index of a branch refers to the child node
+		if len(paths) < 16 {
+			return nil, errBOMTruncated
+		}
+		node = binary.BigEndian.Uint32(paths[12:16])
+	}
+
+	// ...then follow the leaves from one to the next
+	nodes := make(map[uint32]bomPathNode)
+	var ids []uint32
+	entries := make(map[uint32]bomEntry)
+	visited := make(map[uint32]bool)
+	for node != 0 && !visited[node] {
+		visited[node] = true
+		if paths, err = b.block(node); err != nil {
+			return nil, err
+		}
+		if len(paths) < 12 {
+			return nil, errBOMTruncated
+		}
+		count := int(binary.BigEndian.Uint16(paths[2:4]))
+		if len(paths) < 12+count*8 {
+			return nil, errBOMTruncated
+		}
+		for i := 0; i < count; i++ {
+			id, entry, parent, name, err := b.pathEntry(
+				binary.BigEndian.Uint32(paths[12+i*8:]),
+				binary.BigEndian.Uint32(paths[16+i*8:]),
+			)
+			if err != nil {
+				return nil, err
+			}
+			nodes[id] = bomPathNode{parent: parent, name: name}
+			entries[id] = entry
+			ids = append(ids, id)
+		}
+		node = binary.BigEndian.Uint32(paths[4:8])
+	}
+
+	var result []bomEntry
+	for _, id := range ids {
+		entry := entries[id]
+		entry.Path = fullPath(nodes, id)
+		if entry.Path == "" {
+			continue
+		}
+		result = append(result, entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
+// pathEntry returns the ID, details, parent ID, and name of a path from the blocks referred to by a leaf of the tree.
+func (b *bom) pathEntry(infoIndex, fileIndex uint32) (uint32, bomEntry, uint32, string, error) {
+	info, err := b.block(infoIndex)
+	if err != nil {
+		return 0, bomEntry{}, 0, "", err
+	}
+	if len(info) < 8 {
+		return 0, bomEntry{}, 0, "", errBOMTruncated
+	}
+	id := binary.BigEndian.Uint32(info[0:4])
+
+	var entry bomEntry
+	details, err := b.block(binary.BigEndian.Uint32(info[4:8]))
+	if err == nil && len(details) >= 22 {
+		entry.Type = details[0]
+		entry.Mode = binary.BigEndian.Uint16(details[4:6])
+		entry.Size = binary.BigEndian.Uint32(details[18:22])
+	}
+
+	f, err := b.block(fileIndex)
+	if err != nil {
+		return 0, bomEntry{}, 0, "", err
+	}
+	if len(f) < 4 {
+		return 0, bomEntry{}, 0, "", errBOMTruncated
+	}
+	name := string(f[4:])
+	if i := strings.IndexByte(name, 0); i >= 0 {
+		name = name[:i]
+	}
+	return id, entry, binary.BigEndian.Uint32(f[0:4]), name, nil
+}
+
+// fullPath joins the names of the given path and its parents.
+func fullPath(nodes map[uint32]bomPathNode, id uint32) string {
+	var names []string
+	for depth := 0; depth <= maxBOMDepth; depth++ {
+		node, ok := nodes[id]
+		if !ok {
+			break
+		}
+		names = append([]string{node.name}, names...)
+		if node.parent == 0 || node.parent == id {
+			break
+		}
+		id = node.parent
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return path.Join(names...)
+}
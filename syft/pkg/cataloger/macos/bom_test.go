@@ -0,0 +1,54 @@
+package macos
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readBOM(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/receipts/var/db/receipts/com.example.hello.bom")
+	require.NoError(t, err)
+
+	entries, err := readBOM(data)
+	require.NoError(t, err)
+
+	// the paths tree has a branch with two leaves
+	assert.Equal(t, []bomEntry{
+		{Path: ".", Type: bomDirectoryType, Mode: 0o40755},
+		{Path: "usr", Type: bomDirectoryType, Mode: 0o40755},
+		{Path: "usr/local", Type: bomDirectoryType, Mode: 0o40755},
+		{Path: "usr/local/bin", Type: bomDirectoryType, Mode: 0o40755},
+		{Path: "usr/local/bin/hello", Type: bomFileType, Mode: 0o100755, Size: 1234},
+		{Path: "usr/local/bin/hi", Type: bomLinkType, Mode: 0o120755, Size: 5},
+		{Path: "usr/local/share", Type: bomDirectoryType, Mode: 0o40755},
+		{Path: "usr/local/share/README", Type: bomFileType, Mode: 0o100644, Size: 42},
+	}, entries)
+}
+
+func Test_readBOM_invalid(t *testing.T) {
+	data, err := os.ReadFile("test-fixtures/receipts/var/db/receipts/com.example.hello.bom")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "not a BOM",
+			data: []byte("hello"),
+		},
+		{
+			name: "truncated",
+			data: data[:len(data)/2],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readBOM(tt.data)
+			assert.Error(t, err)
+		})
+	}
+}
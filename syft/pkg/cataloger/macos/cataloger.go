@@ -0,0 +1,29 @@
+/*
+Package macos provides concrete Cataloger implementations for macOS application bundles (along with the framework
+bundles and dynamic libraries that they link against) and for installed installer packages.
+*/
+package macos
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewCataloger returns a new cataloger object for macOS applications, describing each app and framework bundle by its
+// Info.plist and each dynamic library by its Mach-O load commands. Apps, frameworks, and libraries are related to the
+// frameworks and libraries that they link against.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("macos-app-cataloger").
+		WithParserByGlobs(parseAppBundle, "**/*.app/Contents/Info.plist").
+		WithParserByGlobs(parseFrameworkBundle, "**/*.framework/Versions/*/Resources/Info.plist", "**/*.framework/Resources/Info.plist").
+		WithParserByGlobs(parseDylib, "**/*.dylib").
+		WithProcessors(dependency.Processor(linkedLibrarySpecifier))
+}
+
+// NewReceiptCataloger returns a new cataloger object for the installer packages (.pkg) installed on a macOS host, as
+// tracked by pkgutil from the receipt and bill of materials written for each package.
+func NewReceiptCataloger() pkg.Cataloger {
+	return generic.NewCataloger("macos-receipt-cataloger").
+		WithParserByGlobs(parseReceipt, "**/var/db/receipts/*.plist")
+}
@@ -0,0 +1,197 @@
+package macos
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"Applications/Hello.app/Contents/Info.plist",
+			"Library/Frameworks/Sparkle.framework/Versions/B/Resources/Info.plist",
+			"Library/Frameworks/Flat.framework/Resources/Info.plist",
+			"usr/local/lib/libcrypto.3.dylib",
+		}).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger(t *testing.T) {
+	const contents = "Applications/Hello.app/Contents"
+
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	app := pkg.Package{
+		Name:    "com.example.hello",
+		Version: "1.2.3",
+		FoundBy: "macos-app-cataloger",
+		Locations: file.NewLocationSet(
+			primary(contents+"/Info.plist"),
+			supporting(contents+"/MacOS/Hello"),
+		),
+		PURL: "pkg:macos/com.example.hello@1.2.3",
+		Type: pkg.MacOSAppPkg,
+		Metadata: pkg.MacOSAppEntry{
+			BundleIdentifier:     "com.example.hello",
+			BundleName:           "Hello",
+			ShortVersion:         "1.2.3",
+			BundleVersion:        "45",
+			MinimumSystemVersion: "11.0",
+			Executable:           "Hello",
+			LinkedLibraries: []string{
+				"@rpath/Sparkle.framework/Versions/B/Sparkle",
+				"@rpath/libcrypto.3.dylib",
+				"/usr/lib/libSystem.B.dylib",
+			},
+		},
+	}
+
+	// the Info.plist is in the binary format
+	sparkle := pkg.Package{
+		Name:    "Sparkle",
+		Version: "2.6.0",
+		FoundBy: "macos-app-cataloger",
+		Locations: file.NewLocationSet(
+			primary(contents+"/Frameworks/Sparkle.framework/Versions/B/Resources/Info.plist"),
+			supporting(contents+"/Frameworks/Sparkle.framework/Versions/B/Sparkle"),
+		),
+		PURL: "pkg:generic/Sparkle@2.6.0",
+		Type: pkg.MacOSFrameworkPkg,
+		Metadata: pkg.MacOSFrameworkEntry{
+			Kind:                 "framework",
+			BundleIdentifier:     "org.sparkle-project.Sparkle",
+			ShortVersion:         "2.6.0",
+			BundleVersion:        "2017",
+			InstallName:          "@rpath/Sparkle.framework/Versions/B/Sparkle",
+			CurrentVersion:       "2.6.0",
+			CompatibilityVersion: "1.6.0",
+			MinimumSystemVersion: "10.13",
+			LinkedLibraries: []string{
+				"@rpath/libcrypto.3.dylib",
+				"/System/Library/Frameworks/Foundation.framework/Versions/C/Foundation",
+			},
+		},
+	}
+
+	// the loadable bundle within PlugIns has no LC_ID_DYLIB command, so is not a library
+	libcrypto := pkg.Package{
+		Name:      "libcrypto.3",
+		Version:   "3.0.0",
+		FoundBy:   "macos-app-cataloger",
+		Locations: file.NewLocationSet(primary(contents + "/Frameworks/libcrypto.3.dylib")),
+		PURL:      "pkg:generic/libcrypto.3@3.0.0",
+		Type:      pkg.MacOSFrameworkPkg,
+		Metadata: pkg.MacOSFrameworkEntry{
+			Kind:                 "dylib",
+			InstallName:          "/usr/local/opt/openssl@3/lib/libcrypto.3.dylib",
+			CurrentVersion:       "3.0.0",
+			CompatibilityVersion: "3.0.0",
+			MinimumSystemVersion: "10.15",
+			LinkedLibraries:      []string{"/usr/lib/libSystem.B.dylib"},
+		},
+	}
+
+	expectedPkgs := []pkg.Package{app, sparkle, libcrypto}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: sparkle,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: libcrypto,
+			To:   app,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: libcrypto,
+			To:   sparkle,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/apps").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewCataloger())
+}
+
+func Test_ReceiptCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"var/db/receipts/com.example.hello.plist",
+			"private/var/db/receipts/com.example.tools.plist",
+		}).
+		TestCataloger(t, NewReceiptCataloger())
+}
+
+func TestReceiptCataloger(t *testing.T) {
+	const receipts = "var/db/receipts"
+
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	// the receipt is in the binary format, and is accompanied by a bill of materials
+	hello := pkg.Package{
+		Name:    "com.example.hello",
+		Version: "1.0.2",
+		FoundBy: "macos-receipt-cataloger",
+		Locations: file.NewLocationSet(
+			primary(receipts+"/com.example.hello.plist"),
+			supporting(receipts+"/com.example.hello.bom"),
+		),
+		PURL: "pkg:generic/com.example.hello@1.0.2",
+		Type: pkg.MacOSInstallerPkg,
+		Metadata: pkg.MacOSReceiptEntry{
+			PackageIdentifier:  "com.example.hello",
+			PackageVersion:     "1.0.2",
+			PackageFileName:    "Hello-1.0.2.pkg",
+			InstallDate:        "2024-05-06T07:08:09Z",
+			InstallPrefixPath:  "/",
+			InstallProcessName: "installer",
+			Files: []pkg.MacOSReceiptFileRecord{
+				{Path: "/usr/local/bin/hello", Mode: "755", Size: 1234},
+				{Path: "/usr/local/bin/hi", Mode: "755", Size: 5},
+				{Path: "/usr/local/share/README", Mode: "644", Size: 42},
+			},
+		},
+	}
+
+	// the receipt is in the XML format, without a bill of materials
+	tools := pkg.Package{
+		Name:      "com.example.tools",
+		Version:   "3.1",
+		FoundBy:   "macos-receipt-cataloger",
+		Locations: file.NewLocationSet(primary(receipts + "/com.example.tools.plist")),
+		PURL:      "pkg:generic/com.example.tools@3.1",
+		Type:      pkg.MacOSInstallerPkg,
+		Metadata: pkg.MacOSReceiptEntry{
+			PackageIdentifier:  "com.example.tools",
+			PackageVersion:     "3.1",
+			PackageFileName:    "Tools.pkg",
+			InstallDate:        "2023-01-02T03:04:05Z",
+			InstallPrefixPath:  "Library/Tools",
+			InstallProcessName: "softwareupdated",
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/receipts").
+		Expects([]pkg.Package{hello, tools}, nil).
+		TestCataloger(t, NewReceiptCataloger())
+}
@@ -0,0 +1,46 @@
+package macos
+
+import (
+	"path"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = linkedLibrarySpecifier
+
+// linkedLibrarySpecifier describes frameworks and dynamic libraries as providing the file name of their install name
+// (and of the library file itself), and apps, frameworks, and libraries as requiring the file names of the libraries
+// that they link against. File names are used since install names are commonly relative to the runpath of the binary
+// (e.g. "@rpath/Sparkle.framework/Versions/B/Sparkle").
+func linkedLibrarySpecifier(p pkg.Package) dependency.Specification {
+	var provides, linked []string
+	switch meta := p.Metadata.(type) {
+	case pkg.MacOSAppEntry:
+		linked = meta.LinkedLibraries
+	case pkg.MacOSFrameworkEntry:
+		if meta.InstallName != "" {
+			provides = append(provides, path.Base(meta.InstallName))
+		}
+		if meta.Kind == dylibKind {
+			for _, l := range p.Locations.ToSlice() {
+				provides = append(provides, path.Base(l.RealPath))
+			}
+		}
+		linked = meta.LinkedLibraries
+	default:
+		return dependency.Specification{}
+	}
+
+	var requires []string
+	for _, l := range linked {
+		requires = append(requires, path.Base(l))
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: provides,
+			Requires: requires,
+		},
+	}
+}
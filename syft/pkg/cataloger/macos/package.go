@@ -0,0 +1,74 @@
+package macos
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newAppPackage(entry pkg.MacOSAppEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.BundleIdentifier,
+		Version:   bundleVersion(entry.ShortVersion, entry.BundleVersion),
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.MacOSAppPkg,
+		Metadata:  entry,
+	}
+	p.PURL = packageURL(pkg.MacOSAppPkg.PackageURLType(), p.Name, p.Version)
+
+	p.SetID()
+
+	return p
+}
+
+// newFrameworkPackage returns a package for a framework bundle or dynamic library.
+func newFrameworkPackage(name, version string, entry pkg.MacOSFrameworkEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(pkg.MacOSFrameworkPkg.PackageURLType(), name, version),
+		Type:      pkg.MacOSFrameworkPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newReceiptPackage returns a package for an installed installer package.
+func newReceiptPackage(entry pkg.MacOSReceiptEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.PackageIdentifier,
+		Version:   entry.PackageVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(pkg.MacOSInstallerPkg.PackageURLType(), entry.PackageIdentifier, entry.PackageVersion),
+		Type:      pkg.MacOSInstallerPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// bundleVersion returns the release version of a bundle, falling back to the build version (which is required, unlike
+// the release version).
+func bundleVersion(shortVersion, version string) string {
+	if shortVersion != "" {
+		return shortVersion
+	}
+	return version
+}
+
+func packageURL(ty, name, version string) string {
+	return packageurl.NewPackageURL(
+		ty,
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,164 @@
+package macos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/macho"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/plist"
+)
+
+var (
+	_ generic.Parser = parseAppBundle
+	_ generic.Parser = parseFrameworkBundle
+)
+
+const (
+	frameworkKind = "framework"
+	dylibKind     = "dylib"
+)
+
+// parseAppBundle is a parser function for the Info.plist of an app bundle (<name>.app/Contents/Info.plist), returning a
+// package for the app described by the Info.plist along with the libraries linked by the main executable.
+func parseAppBundle(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	dict, err := readPlist(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse macOS app Info.plist: %w", err)
+	}
+
+	entry := pkg.MacOSAppEntry{
+		BundleIdentifier:     plist.String(dict, "CFBundleIdentifier"),
+		BundleName:           plist.String(dict, "CFBundleName"),
+		ShortVersion:         plist.String(dict, "CFBundleShortVersionString"),
+		BundleVersion:        plist.String(dict, "CFBundleVersion"),
+		MinimumSystemVersion: plist.String(dict, "LSMinimumSystemVersion"),
+		Executable:           plist.String(dict, "CFBundleExecutable"),
+	}
+	if entry.BundleIdentifier == "" {
+		return nil, nil, nil
+	}
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	if entry.Executable != "" {
+		executablePath := path.Join(path.Dir(reader.RealPath), "MacOS", entry.Executable)
+		if binary, location := readBinary(resolver, reader.Location, executablePath); binary != nil {
+			entry.LinkedLibraries = binary.InstallNames()
+			if entry.MinimumSystemVersion == "" {
+				entry.MinimumSystemVersion = binary.MinimumOSVersion
+			}
+			locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	return []pkg.Package{newAppPackage(entry, locations...)}, nil, nil
+}
+
+// parseFrameworkBundle is a parser function for the Info.plist of a framework bundle, which is found within each
+// version of the framework (<name>.framework/Versions/<version>/Resources/Info.plist) and holds the name of the
+// framework binary found alongside the Resources directory.
+func parseFrameworkBundle(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	dict, err := readPlist(reader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse framework Info.plist")
+		return nil, nil, nil
+	}
+
+	versionDir := path.Dir(path.Dir(reader.RealPath))
+	bundle := versionDir
+	if path.Base(path.Dir(versionDir)) == "Versions" {
+		bundle = path.Dir(path.Dir(versionDir))
+	}
+	bundleName := strings.TrimSuffix(path.Base(bundle), ".framework")
+
+	entry := pkg.MacOSFrameworkEntry{
+		Kind:                 frameworkKind,
+		BundleIdentifier:     plist.String(dict, "CFBundleIdentifier"),
+		ShortVersion:         plist.String(dict, "CFBundleShortVersionString"),
+		BundleVersion:        plist.String(dict, "CFBundleVersion"),
+		MinimumSystemVersion: plist.String(dict, "LSMinimumSystemVersion"),
+	}
+
+	name := plist.String(dict, "CFBundleName")
+	if name == "" {
+		name = bundleName
+	}
+
+	executable := plist.String(dict, "CFBundleExecutable")
+	if executable == "" {
+		executable = bundleName
+	}
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	if binary, location := readBinary(resolver, reader.Location, path.Join(versionDir, executable)); binary != nil {
+		if binary.ID != nil {
+			entry.InstallName = binary.ID.InstallName
+			entry.CurrentVersion = binary.ID.CurrentVersion
+			entry.CompatibilityVersion = binary.ID.CompatibilityVersion
+		}
+		entry.LinkedLibraries = binary.InstallNames()
+		if entry.MinimumSystemVersion == "" {
+			entry.MinimumSystemVersion = binary.MinimumOSVersion
+		}
+		locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	version := bundleVersion(entry.ShortVersion, entry.BundleVersion)
+	if version == "" {
+		version = entry.CurrentVersion
+	}
+
+	return []pkg.Package{newFrameworkPackage(name, version, entry, locations...)}, nil, nil
+}
+
+func readPlist(reader io.Reader) (map[string]any, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return plist.Decode(data)
+}
+
+// readBinary returns the load commands of the Mach-O binary at the given path, relative to the given location. Nothing
+// is returned when the binary cannot be found or read.
+func readBinary(resolver file.Resolver, relativeTo file.Location, binaryPath string) (*macho.File, file.Location) {
+	if resolver == nil {
+		return nil, file.Location{}
+	}
+
+	location := resolver.RelativeFileByPath(relativeTo, binaryPath)
+	if location == nil {
+		return nil, file.Location{}
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("error", err, "path", binaryPath).Trace("unable to read Mach-O binary")
+		return nil, file.Location{}
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	unionReader, err := unionreader.GetUnionReader(contents)
+	if err != nil {
+		log.WithFields("error", err, "path", binaryPath).Trace("unable to read Mach-O binary")
+		return nil, file.Location{}
+	}
+
+	binary, err := macho.Read(unionReader)
+	if err != nil {
+		log.WithFields("error", err, "path", binaryPath).Trace("unable to parse Mach-O binary")
+		return nil, file.Location{}
+	}
+	return binary, *location
+}
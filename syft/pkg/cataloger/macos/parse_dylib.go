@@ -0,0 +1,50 @@
+package macos
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/macho"
+)
+
+var _ generic.Parser = parseDylib
+
+// parseDylib is a parser function for dynamic libraries, returning a package described by the LC_ID_DYLIB command of
+// the library. Mach-O binaries without an LC_ID_DYLIB command (e.g. loadable bundles) are not libraries that can be
+// linked against, so are not returned.
+func parseDylib(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	binary, err := macho.Read(unionReader)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse dynamic library as a Mach-O binary")
+		return nil, nil, nil
+	}
+	if binary.ID == nil {
+		return nil, nil, nil
+	}
+
+	entry := pkg.MacOSFrameworkEntry{
+		Kind:                 dylibKind,
+		InstallName:          binary.ID.InstallName,
+		CurrentVersion:       binary.ID.CurrentVersion,
+		CompatibilityVersion: binary.ID.CompatibilityVersion,
+		MinimumSystemVersion: binary.MinimumOSVersion,
+		LinkedLibraries:      binary.InstallNames(),
+	}
+
+	name := strings.TrimSuffix(path.Base(reader.RealPath), ".dylib")
+	location := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	return []pkg.Package{newFrameworkPackage(name, entry.CurrentVersion, entry, location)}, nil, nil
+}
@@ -0,0 +1,105 @@
+package macos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/plist"
+)
+
+var _ generic.Parser = parseReceipt
+
+// parseReceipt is a parser function for the receipt of an installed installer package (/var/db/receipts/<id>.plist),
+// returning a package for the installer package along with the files listed in the bill of materials that the
+// installer writes alongside the receipt (<id>.bom).
+func parseReceipt(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	dict, err := readPlist(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse macOS installer receipt: %w", err)
+	}
+
+	entry := pkg.MacOSReceiptEntry{
+		PackageIdentifier:  plist.String(dict, "PackageIdentifier"),
+		PackageVersion:     plist.String(dict, "PackageVersion"),
+		PackageFileName:    plist.String(dict, "PackageFileName"),
+		InstallPrefixPath:  plist.String(dict, "InstallPrefixPath"),
+		InstallProcessName: plist.String(dict, "InstallProcessName"),
+	}
+	if entry.PackageIdentifier == "" {
+		return nil, nil, nil
+	}
+	if installDate := plist.Time(dict, "InstallDate"); !installDate.IsZero() {
+		entry.InstallDate = installDate.UTC().Format(time.RFC3339)
+	}
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	bomPath := strings.TrimSuffix(reader.RealPath, path.Ext(reader.RealPath)) + ".bom"
+	if entries, location := readReceiptBOM(resolver, reader.Location, bomPath); location != nil {
+		entry.Files = receiptFiles(entry.InstallPrefixPath, entries)
+		locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	return []pkg.Package{newReceiptPackage(entry, locations...)}, nil, nil
+}
+
+// readReceiptBOM returns the paths within the bill of materials at the given path, relative to the given location.
+// Nothing is returned when the bill of materials cannot be found or read.
+func readReceiptBOM(resolver file.Resolver, relativeTo file.Location, bomPath string) ([]bomEntry, *file.Location) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	location := resolver.RelativeFileByPath(relativeTo, bomPath)
+	if location == nil {
+		return nil, nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("error", err, "path", bomPath).Trace("unable to read installer package bill of materials")
+		return nil, nil
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	data, err := io.ReadAll(contents)
+	if err != nil {
+		log.WithFields("error", err, "path", bomPath).Trace("unable to read installer package bill of materials")
+		return nil, nil
+	}
+
+	entries, err := readBOM(data)
+	if err != nil {
+		log.WithFields("error", err, "path", bomPath).Trace("unable to parse installer package bill of materials")
+		return nil, nil
+	}
+	return entries, location
+}
+
+// receiptFiles returns the files and links of the given bill of materials, as absolute paths within the given install
+// location (directories are shared between packages, so are not considered to be owned by a package).
+func receiptFiles(prefix string, entries []bomEntry) []pkg.MacOSReceiptFileRecord {
+	var files []pkg.MacOSReceiptFileRecord
+	for _, e := range entries {
+		if e.Type != bomFileType && e.Type != bomLinkType {
+			continue
+		}
+		files = append(files, pkg.MacOSReceiptFileRecord{
+			Path: path.Join("/", prefix, e.Path),
+			Mode: strconv.FormatUint(uint64(e.Mode&0o7777), 8),
+			Size: int64(e.Size),
+		})
+	}
+	return files
+}
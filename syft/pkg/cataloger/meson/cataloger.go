@@ -0,0 +1,15 @@
+/*
+Package meson provides a concrete Cataloger implementation for Meson subprojects declared with wrap files.
+*/
+package meson
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewWrapCataloger returns a new Meson wrap cataloger object based on subprojects/*.wrap files.
+func NewWrapCataloger() pkg.Cataloger {
+	return generic.NewCataloger("meson-wrap-cataloger").
+		WithParserByGlobs(parseWrapFile, "**/subprojects/*.wrap")
+}
@@ -0,0 +1,53 @@
+package meson
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newWrapPackage(w pkg.MesonWrapEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      w.Name,
+		Version:   w.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(w),
+		Type:      pkg.MesonWrapPkg,
+		Language:  pkg.CPP,
+		Metadata:  w,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(w pkg.MesonWrapEntry) string {
+	var qualifiers packageurl.Qualifiers
+
+	switch {
+	case w.SourceURL != "":
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "download_url",
+			Value: w.SourceURL,
+		})
+	case w.URL != "":
+		vcsURL := w.URL
+		if w.Revision != "" {
+			vcsURL += "@" + w.Revision
+		}
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "vcs_url",
+			Value: vcsURL,
+		})
+	}
+
+	return packageurl.NewPackageURL(
+		"meson",
+		"",
+		w.Name,
+		w.Version,
+		qualifiers,
+		"",
+	).ToString()
+}
@@ -0,0 +1,131 @@
+package meson
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/ini"
+)
+
+var _ generic.Parser = parseWrapFile
+
+var (
+	// e.g. zlib-1.3.1 or libfoo-v2.0
+	nameVersionPattern = regexp.MustCompile(`^(?P<name>.+?)-v?(?P<version>\d[\w.+~]*)$`)
+
+	// e.g. 1.3.1-1, where the trailing number is the WrapDB revision of the wrap itself
+	wrapDBVersionPattern = regexp.MustCompile(`^(?P<version>.+)-\d+$`)
+
+	archiveSuffixes = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst", ".tgz", ".tar", ".zip"}
+)
+
+// wrapMethods are the section names that describe how a subproject is fetched (the section name prefix is stripped
+// to get the method).
+var wrapMethods = []string{"wrap-file", "wrap-git", "wrap-hg", "wrap-svn"}
+
+// parseWrapFile is a parser function for Meson subprojects/*.wrap contents, returning the subproject the wrap describes.
+func parseWrapFile(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	sections, err := ini.Read(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read wrap file: %w", err)
+	}
+
+	// the subproject name is always the name of the wrap file
+	name := strings.TrimSuffix(path.Base(reader.Location.RealPath), ".wrap")
+
+	for _, section := range wrapMethods {
+		values, ok := sections[section]
+		if !ok {
+			continue
+		}
+
+		w := pkg.MesonWrapEntry{
+			Name:          name,
+			Method:        strings.TrimPrefix(section, "wrap-"),
+			Directory:     values["directory"],
+			SourceURL:     values["source_url"],
+			SourceHash:    values["source_hash"],
+			PatchURL:      values["patch_url"],
+			PatchHash:     values["patch_hash"],
+			WrapDBVersion: values["wrapdb_version"],
+			URL:           values["url"],
+			Revision:      values["revision"],
+			Provides:      provides(sections),
+		}
+		w.Version = wrapVersion(w, values["source_filename"])
+
+		return []pkg.Package{
+			newWrapPackage(
+				w,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		}, nil, nil
+	}
+
+	return nil, nil, nil
+}
+
+// wrapVersion makes a best-effort attempt to determine the subproject version, preferring the WrapDB version, then
+// the VCS revision, then the extraction directory, and finally the source archive filename.
+func wrapVersion(w pkg.MesonWrapEntry, sourceFilename string) string {
+	if w.WrapDBVersion != "" {
+		if match := wrapDBVersionPattern.FindStringSubmatch(w.WrapDBVersion); match != nil {
+			return match[wrapDBVersionPattern.SubexpIndex("version")]
+		}
+		return w.WrapDBVersion
+	}
+
+	if w.Method != "file" {
+		// a branch name (e.g. "head") does not describe a version
+		if strings.EqualFold(w.Revision, "head") {
+			return ""
+		}
+		return w.Revision
+	}
+
+	for _, candidate := range []string{w.Directory, sourceFilename} {
+		candidate = trimArchiveSuffix(candidate)
+		if match := nameVersionPattern.FindStringSubmatch(candidate); match != nil {
+			return match[nameVersionPattern.SubexpIndex("version")]
+		}
+	}
+
+	return ""
+}
+
+// provides returns all dependency names (and program names) listed in the [provide] section.
+func provides(sections ini.Sections) []string {
+	var names []string
+	for key, value := range sections["provide"] {
+		switch key {
+		case "dependency_names", "program_names":
+			for _, n := range strings.Split(value, ",") {
+				if n = strings.TrimSpace(n); n != "" {
+					names = append(names, n)
+				}
+			}
+		default:
+			// the "<dependency> = <variable>" form
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func trimArchiveSuffix(s string) string {
+	for _, suffix := range archiveSuffixes {
+		if strings.HasSuffix(s, suffix) {
+			return strings.TrimSuffix(s, suffix)
+		}
+	}
+	return s
+}
@@ -0,0 +1,113 @@
+package meson
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseWrapFile(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected []pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/subprojects/zlib.wrap",
+			expected: []pkg.Package{
+				{
+					Name:     "zlib",
+					Version:  "1.3.1",
+					PURL:     "pkg:meson/zlib@1.3.1?download_url=http://zlib.net/fossils/zlib-1.3.1.tar.gz",
+					Type:     pkg.MesonWrapPkg,
+					Language: pkg.CPP,
+					Metadata: pkg.MesonWrapEntry{
+						Name:          "zlib",
+						Version:       "1.3.1",
+						Method:        "file",
+						Directory:     "zlib-1.3.1",
+						SourceURL:     "http://zlib.net/fossils/zlib-1.3.1.tar.gz",
+						SourceHash:    "9a93b2b7dfdac77ceba5a558a580e74667dd6fede4585b91eefb60f03b72df23",
+						PatchURL:      "https://wrapdb.mesonbuild.com/v2/zlib_1.3.1-1/get_patch",
+						PatchHash:     "e79b98eb24a75392009cec6f99ca5cdca9881ff20bfa174e8b8926d5c7a47095",
+						WrapDBVersion: "1.3.1-1",
+						Provides:      []string{"zlib"},
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/subprojects/libfoobar.wrap",
+			expected: []pkg.Package{
+				{
+					Name:     "libfoobar",
+					Version:  "1.0",
+					PURL:     "pkg:meson/libfoobar@1.0?download_url=https://example.com/foobar-1.0.tar.gz",
+					Type:     pkg.MesonWrapPkg,
+					Language: pkg.CPP,
+					Metadata: pkg.MesonWrapEntry{
+						Name:       "libfoobar",
+						Version:    "1.0",
+						Method:     "file",
+						Directory:  "libfoobar-1.0",
+						SourceURL:  "https://example.com/foobar-1.0.tar.gz",
+						SourceHash: "5ebeea0dfb75d090ea0e7ff84799b2a7a1550db3fe61eb5f6f61c2e971e57663",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/subprojects/fmt.wrap",
+			expected: []pkg.Package{
+				{
+					Name:     "fmt",
+					Version:  "10.2.1",
+					PURL:     "pkg:meson/fmt@10.2.1?vcs_url=https://github.com/fmtlib/fmt.git%4010.2.1",
+					Type:     pkg.MesonWrapPkg,
+					Language: pkg.CPP,
+					Metadata: pkg.MesonWrapEntry{
+						Name:     "fmt",
+						Version:  "10.2.1",
+						Method:   "git",
+						URL:      "https://github.com/fmtlib/fmt.git",
+						Revision: "10.2.1",
+						Provides: []string{"fmt", "fmt-header-only"},
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/subprojects/samurai.wrap",
+			expected: []pkg.Package{
+				{
+					Name:     "samurai",
+					PURL:     "pkg:meson/samurai?vcs_url=https://github.com/michaelforney/samurai.git%40head",
+					Type:     pkg.MesonWrapPkg,
+					Language: pkg.CPP,
+					Metadata: pkg.MesonWrapEntry{
+						Name:      "samurai",
+						Method:    "git",
+						Directory: "samurai",
+						URL:       "https://github.com/michaelforney/samurai.git",
+						Revision:  "head",
+					},
+				},
+			},
+		},
+		{
+			fixture: "test-fixtures/subprojects/not-a-wrap.wrap",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			for i := range tt.expected {
+				tt.expected[i].Locations = file.NewLocationSet(file.NewLocation(tt.fixture))
+			}
+			var expectedRelationships []artifact.Relationship
+			pkgtest.TestFileParser(t, tt.fixture, parseWrapFile, tt.expected, expectedRelationships)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+/*
+Package mlmodel provides a concrete Cataloger implementation for machine learning models (GGUF, ONNX, and safetensors
+model files, and HuggingFace model directories).
+*/
+package mlmodel
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for machine learning models, describing each model file by its header
+// and each HuggingFace model directory by its configuration (config.json or model_index.json).
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("ml-model-cataloger").
+		WithParserByGlobs(parseGGUF, "**/*.gguf").
+		WithParserByGlobs(parseONNX, "**/*.onnx").
+		WithParserByGlobs(parseSafetensors, "**/*.safetensors").
+		WithParserByGlobs(parseHuggingFaceConfig, "**/"+huggingFaceConfig, "**/"+diffusersIndex)
+}
@@ -0,0 +1,180 @@
+package mlmodel
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"models/tiny.gguf",
+			"models/resnet.onnx",
+			"models/bert/model.safetensors",
+			"models/bert/config.json",
+			"models/sd/model_index.json",
+		}).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_GGUF(t *testing.T) {
+	location := file.NewLocation("tinyllama-1.1b-chat.Q4_K_M.gguf")
+
+	expected := []pkg.Package{
+		{
+			Name:      "TinyLlama",
+			Version:   "v1.1",
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("apache-2.0", location)),
+			FoundBy:   "ml-model-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/TinyLlama@v1.1",
+			Type:      pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:        "gguf",
+				Architecture:  "llama",
+				Quantization:  "Q4_K_M",
+				Parameters:    2112,
+				ContextLength: 2048,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/gguf").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_ONNX(t *testing.T) {
+	location := file.NewLocation("resnet50.onnx")
+
+	expected := []pkg.Package{
+		{
+			Name:      "resnet50",
+			Version:   "3",
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", location)),
+			FoundBy:   "ml-model-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/resnet50@3",
+			Type:      pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:          "onnx",
+				Architecture:    "ResNet50",
+				Producer:        "pytorch",
+				ProducerVersion: "2.1.0",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/onnx").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_ONNX_Invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/onnx-invalid").
+		Expects(nil, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_Safetensors(t *testing.T) {
+	location := file.NewLocation("bert-tiny/model.safetensors")
+
+	expected := []pkg.Package{
+		{
+			Name:      "bert-tiny",
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("mit", location)),
+			FoundBy:   "ml-model-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/bert-tiny",
+			Type:      pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:       "safetensors",
+				Quantization: "F16",
+				Parameters:   106,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/safetensors").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_HuggingFace(t *testing.T) {
+	const snapshot = "hub/models--meta-llama--Llama-3.2-1B/snapshots/4e20de362430cd3b72f300e6b0f18e50e7166e08"
+
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	expected := []pkg.Package{
+		{
+			// named by the hub cache (rather than the local path within the configuration), with the weights of
+			// all shards
+			Name:     "meta-llama/Llama-3.2-1B",
+			Version:  "4e20de362430cd3b72f300e6b0f18e50e7166e08",
+			Licenses: pkg.NewLicenseSet(pkg.NewLicenseFromLocations("llama3.2", primary(snapshot+"/config.json"))),
+			FoundBy:  "ml-model-cataloger",
+			Locations: file.NewLocationSet(
+				primary(snapshot+"/config.json"),
+				supporting(snapshot+"/model-00001-of-00002.safetensors"),
+				supporting(snapshot+"/model-00002-of-00002.safetensors"),
+			),
+			PURL: "pkg:generic/meta-llama/Llama-3.2-1B@4e20de362430cd3b72f300e6b0f18e50e7166e08",
+			Type: pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:          "huggingface",
+				Architecture:    "LlamaForCausalLM",
+				Quantization:    "BF16",
+				Parameters:      4112,
+				ContextLength:   131072,
+				Producer:        "transformers",
+				ProducerVersion: "4.45.0",
+			},
+		},
+		{
+			Name:      "TheBloke/Mistral-7B-v0.1-GPTQ",
+			FoundBy:   "ml-model-cataloger",
+			Locations: file.NewLocationSet(primary("local/mistral-gptq/config.json")),
+			PURL:      "pkg:generic/TheBloke/Mistral-7B-v0.1-GPTQ",
+			Type:      pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:          "huggingface",
+				Architecture:    "MistralForCausalLM",
+				Quantization:    "gptq-4bit",
+				ContextLength:   32768,
+				Producer:        "transformers",
+				ProducerVersion: "4.34.0",
+			},
+		},
+		{
+			Name:      "sd-turbo",
+			FoundBy:   "ml-model-cataloger",
+			Locations: file.NewLocationSet(primary("local/sd-turbo/model_index.json")),
+			PURL:      "pkg:generic/sd-turbo",
+			Type:      pkg.MLModelPkg,
+			Metadata: pkg.MLModelEntry{
+				Format:          "huggingface",
+				Architecture:    "StableDiffusionPipeline",
+				Producer:        "diffusers",
+				ProducerVersion: "0.24.0",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/huggingface").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
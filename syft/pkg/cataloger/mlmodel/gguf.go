@@ -0,0 +1,309 @@
+package mlmodel
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// GGUF value types, from https://github.com/ggerganov/ggml/blob/master/docs/gguf.md
+const (
+	ggufUint8 = iota
+	ggufInt8
+	ggufUint16
+	ggufInt16
+	ggufUint32
+	ggufInt32
+	ggufFloat32
+	ggufBool
+	ggufString
+	ggufArray
+	ggufUint64
+	ggufInt64
+	ggufFloat64
+)
+
+const (
+	// the largest string that is read (larger strings, such as chat templates, are skipped)
+	maxGGUFString = 1 << 20
+
+	// the most tensors or metadata key-value pairs that are read
+	maxGGUFCount = 1 << 20
+)
+
+var (
+	ggufMagic = []byte("GGUF")
+
+	errGGUFLimit = errors.New("GGUF header exceeds limits")
+)
+
+// ggufFileTypes are the names of the predominant weight types of a model (general.file_type), from llama.cpp.
+var ggufFileTypes = map[uint64]string{
+	0:  "F32",
+	1:  "F16",
+	2:  "Q4_0",
+	3:  "Q4_1",
+	7:  "Q8_0",
+	8:  "Q5_0",
+	9:  "Q5_1",
+	10: "Q2_K",
+	11: "Q3_K_S",
+	12: "Q3_K_M",
+	13: "Q3_K_L",
+	14: "Q4_K_S",
+	15: "Q4_K_M",
+	16: "Q5_K_S",
+	17: "Q5_K_M",
+	18: "Q6_K",
+	19: "IQ2_XXS",
+	20: "IQ2_XS",
+	21: "Q2_K_S",
+	22: "IQ3_XS",
+	23: "IQ3_XXS",
+	24: "IQ1_S",
+	25: "IQ4_NL",
+	26: "IQ3_S",
+	27: "IQ3_M",
+	28: "IQ2_S",
+	29: "IQ2_M",
+	30: "IQ4_XS",
+	31: "IQ1_M",
+	32: "BF16",
+}
+
+// ggufHeader is the metadata of a GGUF model file along with the number of parameters of its tensors.
+type ggufHeader struct {
+	// metadata values that are strings or integers, by key (arrays are not retained)
+	metadata map[string]any
+
+	parameters uint64
+}
+
+// string returns the string metadata value of the given key (or an empty string when missing or not a string).
+func (h ggufHeader) string(key string) string {
+	if v, ok := h.metadata[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// uint returns the unsigned integer metadata value of the given key (or zero when missing or not an integer).
+func (h ggufHeader) uint(key string) uint64 {
+	switch v := h.metadata[key].(type) {
+	case uint64:
+		return v
+	case int64:
+		if v > 0 {
+			return uint64(v)
+		}
+	}
+	return 0
+}
+
+// readGGUF reads the metadata and tensor information of a GGUF (version 2 or later) model file. A nil header is
+// returned when the content is not GGUF.
+func readGGUF(r io.Reader) (*ggufHeader, error) {
+	g := ggufReader{r: bufio.NewReader(r)}
+
+	magic := make([]byte, len(ggufMagic))
+	if _, err := io.ReadFull(g.r, magic); err != nil || string(magic) != string(ggufMagic) {
+		return nil, nil
+	}
+
+	version, err := g.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if version < 2 {
+		return nil, fmt.Errorf("unsupported GGUF version: %d", version)
+	}
+
+	tensorCount, err := g.uint64()
+	if err != nil {
+		return nil, err
+	}
+	kvCount, err := g.uint64()
+	if err != nil {
+		return nil, err
+	}
+	if tensorCount > maxGGUFCount || kvCount > maxGGUFCount {
+		return nil, errGGUFLimit
+	}
+
+	h := &ggufHeader{metadata: make(map[string]any)}
+	for i := uint64(0); i < kvCount; i++ {
+		key, err := g.string()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read GGUF metadata key: %w", err)
+		}
+		typ, err := g.uint32()
+		if err != nil {
+			return nil, err
+		}
+		value, err := g.value(typ)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read GGUF metadata value %q: %w", key, err)
+		}
+		if value != nil {
+			h.metadata[key] = value
+		}
+	}
+
+	// tensor info: name, dimensions, type, and offset
+	for i := uint64(0); i < tensorCount; i++ {
+		if err := g.skipString(); err != nil {
+			return nil, fmt.Errorf("unable to read GGUF tensor info: %w", err)
+		}
+		dims, err := g.uint32()
+		if err != nil {
+			return nil, err
+		}
+		elements := uint64(1)
+		for d := uint32(0); d < dims; d++ {
+			n, err := g.uint64()
+			if err != nil {
+				return nil, err
+			}
+			elements *= n
+		}
+		if err := g.skip(4 + 8); err != nil {
+			return nil, err
+		}
+		h.parameters += elements
+	}
+
+	return h, nil
+}
+
+type ggufReader struct {
+	r *bufio.Reader
+}
+
+func (g ggufReader) uint32() (uint32, error) {
+	var v uint32
+	err := binary.Read(g.r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func (g ggufReader) uint64() (uint64, error) {
+	var v uint64
+	err := binary.Read(g.r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func (g ggufReader) skip(n uint64) error {
+	if n > math.MaxInt {
+		return errGGUFLimit
+	}
+	_, err := g.r.Discard(int(n))
+	return err
+}
+
+func (g ggufReader) string() (string, error) {
+	n, err := g.uint64()
+	if err != nil {
+		return "", err
+	}
+	if n > maxGGUFString {
+		// the value is not retained, though the remainder of the header can still be read
+		return "", g.skip(n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(g.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (g ggufReader) skipString() error {
+	n, err := g.uint64()
+	if err != nil {
+		return err
+	}
+	return g.skip(n)
+}
+
+// value reads a metadata value of the given type, where only strings and integers are returned (other values, such
+// as the token arrays of the tokenizer, are skipped).
+func (g ggufReader) value(typ uint32) (any, error) {
+	switch typ {
+	case ggufString:
+		return g.string()
+	case ggufArray:
+		return nil, g.skipArray()
+	case ggufUint8, ggufUint16, ggufUint32, ggufUint64:
+		size := ggufSize(typ)
+		b := make([]byte, 8)
+		if _, err := io.ReadFull(g.r, b[:size]); err != nil {
+			return nil, err
+		}
+		return binary.LittleEndian.Uint64(b), nil
+	case ggufInt8, ggufInt16, ggufInt32, ggufInt64:
+		size := ggufSize(typ)
+		b := make([]byte, 8)
+		if _, err := io.ReadFull(g.r, b[:size]); err != nil {
+			return nil, err
+		}
+		// sign extend to 64 bits
+		shift := 64 - 8*size
+		return int64(binary.LittleEndian.Uint64(b)<<shift) >> shift, nil
+	case ggufFloat32, ggufFloat64, ggufBool:
+		return nil, g.skip(ggufSize(typ))
+	}
+	return nil, fmt.Errorf("unknown GGUF value type: %d", typ)
+}
+
+func (g ggufReader) skipArray() error {
+	typ, err := g.uint32()
+	if err != nil {
+		return err
+	}
+	count, err := g.uint64()
+	if err != nil {
+		return err
+	}
+
+	switch typ {
+	case ggufString:
+		for i := uint64(0); i < count; i++ {
+			if err := g.skipString(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ggufArray:
+		for i := uint64(0); i < count; i++ {
+			if err := g.skipArray(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	size := ggufSize(typ)
+	if size == 0 {
+		return fmt.Errorf("unknown GGUF array type: %d", typ)
+	}
+	if count > math.MaxInt64/size {
+		return errGGUFLimit
+	}
+	return g.skip(count * size)
+}
+
+// ggufSize returns the size of a fixed-size value type (or zero for variable-size types).
+func ggufSize(typ uint32) uint64 {
+	switch typ {
+	case ggufUint8, ggufInt8, ggufBool:
+		return 1
+	case ggufUint16, ggufInt16:
+		return 2
+	case ggufUint32, ggufInt32, ggufFloat32:
+		return 4
+	case ggufUint64, ggufInt64, ggufFloat64:
+		return 8
+	}
+	return 0
+}
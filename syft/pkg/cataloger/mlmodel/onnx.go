@@ -0,0 +1,202 @@
+package mlmodel
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ModelProto fields, from https://github.com/onnx/onnx/blob/main/onnx/onnx.proto
+const (
+	onnxIRVersion       = 1
+	onnxProducerName    = 2
+	onnxProducerVersion = 3
+	onnxModelVersion    = 5
+	onnxMetadataProps   = 14
+)
+
+// protobuf wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// the largest string field that is read (larger fields, such as the graph, are skipped)
+const maxONNXString = 1 << 20
+
+var errNotONNX = errors.New("not an ONNX model")
+
+// onnxModel is the top-level metadata of an ONNX model (the graph is not read).
+type onnxModel struct {
+	producerName    string
+	producerVersion string
+	modelVersion    uint64
+	metadata        map[string]string
+}
+
+// readONNX reads the top-level fields of an ONNX model, seeking past the graph (which holds the weights of the
+// model). Since protobuf has no magic number, content that does not begin with the IR version is not considered ONNX.
+func readONNX(r io.ReadSeeker) (*onnxModel, error) {
+	p := protoReader{r: r}
+	m := &onnxModel{metadata: make(map[string]string)}
+
+	first := true
+	for {
+		field, wire, err := p.tag()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first && (field != onnxIRVersion || wire != wireVarint) {
+			return nil, errNotONNX
+		}
+		first = false
+
+		switch {
+		case field == onnxModelVersion && wire == wireVarint:
+			m.modelVersion, err = p.varint()
+		case field == onnxProducerName && wire == wireBytes:
+			m.producerName, err = p.string()
+		case field == onnxProducerVersion && wire == wireBytes:
+			m.producerVersion, err = p.string()
+		case field == onnxMetadataProps && wire == wireBytes:
+			var key, value string
+			key, value, err = p.stringEntry()
+			if key != "" {
+				m.metadata[key] = value
+			}
+		default:
+			err = p.skip(wire)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ONNX field %d: %w", field, err)
+		}
+	}
+
+	if first {
+		return nil, errNotONNX
+	}
+	return m, nil
+}
+
+// version returns the version of the model (model_version), which is an integer.
+func (m onnxModel) version() string {
+	if m.modelVersion == 0 {
+		return ""
+	}
+	return strconv.FormatUint(m.modelVersion, 10)
+}
+
+// protoReader reads protobuf fields from a seekable reader, so that large fields can be skipped without reading them.
+type protoReader struct {
+	r io.ReadSeeker
+}
+
+func (p protoReader) tag() (int, int, error) {
+	v, err := p.varint()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(v >> 3), int(v & 0x7), nil
+}
+
+func (p protoReader) varint() (uint64, error) {
+	var (
+		v     uint64
+		b     = make([]byte, 1)
+		shift uint
+	)
+	for i := 0; i < 10; i++ {
+		if _, err := io.ReadFull(p.r, b); err != nil {
+			if i > 0 && errors.Is(err, io.EOF) {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		v |= uint64(b[0]&0x7f) << shift
+		if b[0] < 0x80 {
+			return v, nil
+		}
+		shift += 7
+	}
+	return 0, errors.New("invalid varint")
+}
+
+func (p protoReader) bytes() ([]byte, error) {
+	n, err := p.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > maxONNXString {
+		return nil, fmt.Errorf("field too large: %d bytes", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(p.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (p protoReader) string() (string, error) {
+	b, err := p.bytes()
+	return string(b), err
+}
+
+// stringEntry reads a StringStringEntryProto message (key = 1, value = 2).
+func (p protoReader) stringEntry() (string, string, error) {
+	b, err := p.bytes()
+	if err != nil {
+		return "", "", err
+	}
+
+	var key, value string
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 || tag&0x7 != wireBytes {
+			return "", "", errors.New("invalid metadata entry")
+		}
+		b = b[n:]
+		length, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b)-n) < length {
+			return "", "", errors.New("invalid metadata entry")
+		}
+		s := string(b[n : n+int(length)])
+		b = b[n+int(length):]
+		switch tag >> 3 {
+		case 1:
+			key = s
+		case 2:
+			value = s
+		}
+	}
+	return key, value, nil
+}
+
+func (p protoReader) skip(wire int) error {
+	var n int64
+	switch wire {
+	case wireVarint:
+		_, err := p.varint()
+		return err
+	case wireFixed64:
+		n = 8
+	case wireFixed32:
+		n = 4
+	case wireBytes:
+		length, err := p.varint()
+		if err != nil {
+			return err
+		}
+		n = int64(length)
+	default:
+		return fmt.Errorf("unsupported wire type: %d", wire)
+	}
+	_, err := p.r.Seek(n, io.SeekCurrent)
+	return err
+}
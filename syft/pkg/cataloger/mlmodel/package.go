@@ -0,0 +1,100 @@
+package mlmodel
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// shardPattern matches the suffix of a model file that is split into shards (e.g. "model-00001-of-00004").
+var shardPattern = regexp.MustCompile(`-\d+-of-\d+$`)
+
+// genericFileNames are the names of model files that do not name the model, where the name of the directory holding
+// the file is used instead.
+var genericFileNames = map[string]bool{
+	"adapter_model":           true,
+	"config":                  true,
+	"consolidated":            true,
+	"diffusion_pytorch_model": true,
+	"model":                   true,
+	"model_index":             true,
+	"pytorch_model":           true,
+}
+
+func newPackage(name, version, license string, entry pkg.MLModelEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Type:      pkg.MLModelPkg,
+		Metadata:  entry,
+	}
+
+	if license != "" && len(locations) > 0 {
+		p.Licenses = pkg.NewLicenseSet(pkg.NewLicenseFromLocations(license, locations[0]))
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// modelName returns the name and revision of the model described by the file at the given path, which is the
+// repository of the model when found within the HuggingFace hub cache, otherwise the name of the file (or the name of
+// the directory holding the file, when the file name does not name the model).
+func modelName(p string) (string, string) {
+	if repo, revision := hubModel(p); repo != "" {
+		return repo, revision
+	}
+
+	name := shardPattern.ReplaceAllString(stem(p), "")
+	if genericFileNames[name] {
+		if dir := path.Base(path.Dir(p)); dir != "/" && dir != "." {
+			return dir, ""
+		}
+	}
+	return name, ""
+}
+
+// hubModel returns the repository and revision of a model within the HuggingFace hub cache, which is laid out as
+// "models--<org>--<name>/snapshots/<revision>/<file>".
+func hubModel(p string) (string, string) {
+	parts := strings.Split(p, "/")
+	for i, part := range parts {
+		repo, found := strings.CutPrefix(part, "models--")
+		if !found || repo == "" {
+			continue
+		}
+		repo = strings.ReplaceAll(repo, "--", "/")
+
+		var revision string
+		if i+2 < len(parts) && parts[i+1] == "snapshots" {
+			revision = parts[i+2]
+		}
+		return repo, revision
+	}
+	return "", ""
+}
+
+// packageURL returns the package URL for a model, where the organization of a HuggingFace repository is the
+// namespace (e.g. pkg:generic/meta-llama/Llama-3.1-8B).
+func packageURL(name, version string) string {
+	var namespace string
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.MLModelPkg.PackageURLType(),
+		namespace,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,93 @@
+package mlmodel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_modelName(t *testing.T) {
+	tests := []struct {
+		path        string
+		wantName    string
+		wantVersion string
+	}{
+		{
+			path:     "/models/tinyllama-1.1b-chat.Q4_K_M.gguf",
+			wantName: "tinyllama-1.1b-chat.Q4_K_M",
+		},
+		{
+			path:     "/models/bert-base-uncased/model.safetensors",
+			wantName: "bert-base-uncased",
+		},
+		{
+			path:     "/models/llama/model-00001-of-00004.safetensors",
+			wantName: "llama",
+		},
+		{
+			path:        "/root/.cache/huggingface/hub/models--meta-llama--Llama-3.2-1B/snapshots/4e20de3/config.json",
+			wantName:    "meta-llama/Llama-3.2-1B",
+			wantVersion: "4e20de3",
+		},
+		{
+			path:     "/root/.cache/huggingface/hub/models--gpt2/blobs/abc123",
+			wantName: "gpt2",
+		},
+		{
+			path:     "model.onnx",
+			wantName: "model",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			name, version := modelName(tt.path)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
+
+func Test_isSecondaryShard(t *testing.T) {
+	assert.False(t, isSecondaryShard("/models/model.safetensors"))
+	assert.False(t, isSecondaryShard("/models/model-00001-of-00004.safetensors"))
+	assert.True(t, isSecondaryShard("/models/model-00002-of-00004.safetensors"))
+	assert.True(t, isSecondaryShard("/models/llama-3-70b-00010-of-00011.gguf"))
+}
+
+func Test_packageURL(t *testing.T) {
+	assert.Equal(t, "pkg:generic/meta-llama/Llama-3.2-1B@4e20de3", packageURL("meta-llama/Llama-3.2-1B", "4e20de3"))
+	assert.Equal(t, "pkg:generic/TinyLlama@v1.1", packageURL("TinyLlama", "v1.1"))
+}
+
+func Test_modelCardLicense(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "front matter",
+			content: "---\nlanguage: en\nlicense: apache-2.0\n---\n# Model\n",
+			want:    "apache-2.0",
+		},
+		{
+			name:    "quoted",
+			content: "---\nlicense: \"mit\"\n---\n",
+			want:    "mit",
+		},
+		{
+			name:    "only within the front matter",
+			content: "---\nlanguage: en\n---\nlicense: mit\n",
+		},
+		{
+			name:    "no front matter",
+			content: "# Model\nlicense: mit\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, modelCardLicense(strings.NewReader(tt.content)))
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package mlmodel
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseHuggingFaceConfig
+
+const (
+	huggingFaceFormat = "huggingface"
+
+	huggingFaceConfig = "config.json"
+	diffusersIndex    = "model_index.json"
+	modelCard         = "README.md"
+)
+
+// huggingFaceConfigFile is the configuration of a transformers model (config.json) or of a diffusers pipeline
+// (model_index.json).
+type huggingFaceConfigFile struct {
+	Architectures         []string `json:"architectures"`
+	ModelType             string   `json:"model_type"`
+	NameOrPath            string   `json:"_name_or_path"`
+	TorchDType            string   `json:"torch_dtype"`
+	MaxPositionEmbeddings uint64   `json:"max_position_embeddings"`
+	TransformersVersion   string   `json:"transformers_version"`
+	QuantizationConfig    *struct {
+		QuantMethod string `json:"quant_method"`
+		Bits        int    `json:"bits"`
+		LoadIn4Bit  bool   `json:"load_in_4bit"`
+		LoadIn8Bit  bool   `json:"load_in_8bit"`
+	} `json:"quantization_config"`
+
+	// diffusers pipelines (model_index.json)
+	ClassName        string `json:"_class_name"`
+	DiffusersVersion string `json:"_diffusers_version"`
+}
+
+// parseHuggingFaceConfig is a parser function for the configuration of a HuggingFace model directory, returning a
+// package for the model described by the configuration along with the safetensors weights within the directory. JSON
+// files that are not model configurations are ignored.
+func parseHuggingFaceConfig(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var cfg huggingFaceConfigFile
+	if err := json.NewDecoder(reader).Decode(&cfg); err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse JSON as a model configuration")
+		return nil, nil, nil
+	}
+
+	entry := pkg.MLModelEntry{
+		Format:        huggingFaceFormat,
+		ContextLength: cfg.MaxPositionEmbeddings,
+		Quantization:  cfg.quantization(),
+	}
+
+	switch path.Base(reader.RealPath) {
+	case diffusersIndex:
+		if cfg.ClassName == "" {
+			return nil, nil, nil
+		}
+		entry.Architecture = cfg.ClassName
+		if cfg.DiffusersVersion != "" {
+			entry.Producer, entry.ProducerVersion = "diffusers", cfg.DiffusersVersion
+		}
+	default:
+		if len(cfg.Architectures) == 0 && cfg.ModelType == "" {
+			return nil, nil, nil
+		}
+		entry.Architecture = cfg.ModelType
+		if len(cfg.Architectures) > 0 {
+			entry.Architecture = cfg.Architectures[0]
+		}
+		if cfg.TransformersVersion != "" {
+			entry.Producer, entry.ProducerVersion = "transformers", cfg.TransformersVersion
+		}
+	}
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	weights, weightLocations := readSafetensorsWeights(resolver, reader.Location)
+	if weights != nil {
+		entry.Parameters = weights.totalParameters()
+		if entry.Quantization == "" {
+			entry.Quantization = weights.dtype()
+		}
+	}
+	if entry.Quantization == "" {
+		entry.Quantization = cfg.TorchDType
+	}
+	for _, l := range weightLocations {
+		locations = append(locations, l.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	name, version := modelName(reader.Path())
+	if _, revision := hubModel(reader.Path()); revision == "" && isRepository(cfg.NameOrPath) {
+		name = cfg.NameOrPath
+	}
+
+	return []pkg.Package{
+		newPackage(name, version, readModelCardLicense(resolver, reader.Location), entry, locations...),
+	}, nil, nil
+}
+
+// quantization returns the quantization of the model weights from the quantization configuration (e.g. "gptq-4bit").
+func (c huggingFaceConfigFile) quantization() string {
+	q := c.QuantizationConfig
+	if q == nil || q.QuantMethod == "" {
+		return ""
+	}
+
+	bits := q.Bits
+	switch {
+	case q.LoadIn4Bit:
+		bits = 4
+	case q.LoadIn8Bit:
+		bits = 8
+	}
+	if bits == 0 {
+		return q.QuantMethod
+	}
+	return fmt.Sprintf("%s-%dbit", q.QuantMethod, bits)
+}
+
+// isRepository indicates if the given name is a HuggingFace repository (e.g. "meta-llama/Llama-3.1-8B") as opposed to
+// a local path.
+func isRepository(name string) bool {
+	if strings.HasPrefix(name, "/") || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "~") {
+		return false
+	}
+	return strings.Count(name, "/") == 1
+}
+
+// readSafetensorsWeights returns the combined header of the safetensors files within the same directory as the given
+// configuration (which may be shards of the same model), along with the locations of the files.
+func readSafetensorsWeights(resolver file.Resolver, config file.Location) (*safetensorsHeader, []file.Location) {
+	if resolver == nil {
+		return nil, nil
+	}
+
+	// note: the glob is not anchored, since paths may be relative to the root of the resolver
+	dir := strings.TrimPrefix(path.Dir(config.Path()), "/")
+	locations, err := resolver.FilesByGlob(path.Join("**", dir, "*.safetensors"))
+	if err != nil || len(locations) == 0 {
+		return nil, nil
+	}
+
+	combined := &safetensorsHeader{parameters: make(map[string]uint64)}
+	var found []file.Location
+	for _, l := range locations {
+		if strings.TrimPrefix(path.Dir(l.Path()), "/") != dir {
+			continue
+		}
+		h, err := readSafetensorsFile(resolver, l)
+		if err != nil {
+			log.WithFields("path", l.RealPath, "error", err).Trace("unable to read safetensors header")
+			continue
+		}
+		for dtype, n := range h.parameters {
+			combined.parameters[dtype] += n
+		}
+		found = append(found, l)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return combined, found
+}
+
+func readSafetensorsFile(resolver file.Resolver, location file.Location) (*safetensorsHeader, error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	return readSafetensors(contents)
+}
+
+// readModelCardLicense returns the license from the metadata of the model card (the YAML front matter of the README.md
+// within the same directory as the given configuration).
+func readModelCardLicense(resolver file.Resolver, config file.Location) string {
+	if resolver == nil {
+		return ""
+	}
+
+	location := resolver.RelativeFileByPath(config, path.Join(path.Dir(config.Path()), modelCard))
+	if location == nil {
+		return ""
+	}
+
+	contents, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read model card")
+		return ""
+	}
+	defer internal.CloseAndLogError(contents, location.RealPath)
+
+	return modelCardLicense(contents)
+}
+
+// modelCardLicense returns the "license" field of the YAML front matter of a model card (e.g. "license: apache-2.0").
+func modelCardLicense(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "---" {
+		return ""
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "---" {
+			break
+		}
+		value, found := strings.CutPrefix(line, "license:")
+		if !found {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package mlmodel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parseGGUF
+	_ generic.Parser = parseONNX
+	_ generic.Parser = parseSafetensors
+)
+
+const (
+	ggufFormat        = "gguf"
+	onnxFormat        = "onnx"
+	safetensorsFormat = "safetensors"
+)
+
+// firstShardPattern matches the first shard of a model file that is split into shards (e.g. "model-00001-of-00004").
+var firstShardPattern = regexp.MustCompile(`-0*1-of-\d+$`)
+
+// parseGGUF is a parser function for GGUF model files (as used by llama.cpp), returning a package described by the
+// "general.*" metadata of the model.
+func parseGGUF(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if isSecondaryShard(reader.Path()) {
+		return nil, nil, nil
+	}
+
+	h, err := readGGUF(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse GGUF model: %w", err)
+	}
+	if h == nil {
+		return nil, nil, nil
+	}
+
+	architecture := h.string("general.architecture")
+	entry := pkg.MLModelEntry{
+		Format:        ggufFormat,
+		Architecture:  architecture,
+		Parameters:    h.parameters,
+		ContextLength: h.uint(architecture + ".context_length"),
+	}
+	if fileType, ok := h.metadata["general.file_type"].(uint64); ok {
+		entry.Quantization = ggufFileTypes[fileType]
+	}
+
+	name, version := modelName(reader.Path())
+	if n := h.string("general.name"); n != "" {
+		name = n
+	}
+	if v := h.string("general.version"); v != "" {
+		version = v
+	}
+
+	return []pkg.Package{
+		newPackage(name, version, h.string("general.license"), entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// parseONNX is a parser function for ONNX models, returning a package described by the top-level fields of the model.
+func parseONNX(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := readONNX(unionReader)
+	if errors.Is(err, errNotONNX) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse ONNX model: %w", err)
+	}
+
+	entry := pkg.MLModelEntry{
+		Format:          onnxFormat,
+		Architecture:    m.metadata["architecture"],
+		Producer:        m.producerName,
+		ProducerVersion: m.producerVersion,
+	}
+
+	name, version := modelName(reader.Path())
+	if v := m.version(); v != "" {
+		version = v
+	}
+
+	return []pkg.Package{
+		newPackage(name, version, m.metadata["license"], entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// parseSafetensors is a parser function for safetensors files, returning a package described by the tensors of the
+// file. Files alongside a HuggingFace config.json are described by the configuration instead (see
+// parseHuggingFaceConfig), and only the first shard of a model without a configuration is considered.
+func parseSafetensors(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if isSecondaryShard(reader.Path()) || hasSibling(resolver, reader.Location, huggingFaceConfig) {
+		return nil, nil, nil
+	}
+
+	h, err := readSafetensors(reader)
+	if errors.Is(err, errNotSafetensors) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := pkg.MLModelEntry{
+		Format:       safetensorsFormat,
+		Architecture: h.metadata["architecture"],
+		Quantization: h.dtype(),
+	}
+	if !isShard(reader.Path()) {
+		// the parameters of the other shards are not known
+		entry.Parameters = h.totalParameters()
+	}
+
+	name, version := modelName(reader.Path())
+	if version == "" {
+		version = h.metadata["version"]
+	}
+
+	return []pkg.Package{
+		newPackage(name, version, h.metadata["license"], entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func isShard(p string) bool {
+	return shardPattern.MatchString(stem(p))
+}
+
+// isSecondaryShard indicates if the file is a shard of a model other than the first shard (which describes the model).
+func isSecondaryShard(p string) bool {
+	return isShard(p) && !firstShardPattern.MatchString(stem(p))
+}
+
+func stem(p string) string {
+	base := path.Base(p)
+	return base[:len(base)-len(path.Ext(base))]
+}
+
+// hasSibling indicates if a file with the given name exists within the same directory as the given location.
+func hasSibling(resolver file.Resolver, location file.Location, name string) bool {
+	if resolver == nil {
+		return false
+	}
+	return resolver.RelativeFileByPath(location, path.Join(path.Dir(location.Path()), name)) != nil
+}
@@ -0,0 +1,102 @@
+package mlmodel
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// the largest header that is read (the format limits headers to 100MB)
+const maxSafetensorsHeader = 100 << 20
+
+var errNotSafetensors = errors.New("not a safetensors file")
+
+// safetensorsHeader is the JSON header of a safetensors file, see https://github.com/huggingface/safetensors
+type safetensorsHeader struct {
+	// metadata is the free-form "__metadata__" of the file
+	metadata map[string]string
+
+	// parameters is the number of elements by data type (e.g. "BF16") across all tensors
+	parameters map[string]uint64
+}
+
+type safetensorsTensor struct {
+	DType string   `json:"dtype"`
+	Shape []uint64 `json:"shape"`
+}
+
+// readSafetensors reads the header of a safetensors file: the size of the header as a little-endian uint64, followed
+// by the JSON header describing each tensor.
+func readSafetensors(r io.Reader) (*safetensorsHeader, error) {
+	var size uint64
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, errNotSafetensors
+	}
+	if size < 2 || size > maxSafetensorsHeader {
+		return nil, errNotSafetensors
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("unable to read safetensors header: %w", err)
+	}
+	if data[0] != '{' {
+		return nil, errNotSafetensors
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse safetensors header: %w", err)
+	}
+
+	h := &safetensorsHeader{parameters: make(map[string]uint64)}
+	for name, value := range raw {
+		if name == "__metadata__" {
+			if err := json.Unmarshal(value, &h.metadata); err != nil {
+				return nil, fmt.Errorf("unable to parse safetensors metadata: %w", err)
+			}
+			continue
+		}
+
+		var tensor safetensorsTensor
+		if err := json.Unmarshal(value, &tensor); err != nil {
+			return nil, fmt.Errorf("unable to parse safetensors tensor %q: %w", name, err)
+		}
+		elements := uint64(1)
+		for _, d := range tensor.Shape {
+			elements *= d
+		}
+		h.parameters[tensor.DType] += elements
+	}
+	return h, nil
+}
+
+// totalParameters returns the number of elements across all tensors.
+func (h safetensorsHeader) totalParameters() uint64 {
+	var total uint64
+	for _, n := range h.parameters {
+		total += n
+	}
+	return total
+}
+
+// dtype returns the data type holding the most elements, which is the data type of the weights of the model (smaller
+// tensors, such as norms, are commonly kept at a higher precision).
+func (h safetensorsHeader) dtype() string {
+	var types []string
+	for t := range h.parameters {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	var found string
+	for _, t := range types {
+		if found == "" || h.parameters[t] > h.parameters[found] {
+			found = t
+		}
+	}
+	return found
+}
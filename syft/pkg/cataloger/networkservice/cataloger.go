@@ -0,0 +1,23 @@
+/*
+Package networkservice provides a concrete Cataloger implementation for services configured to listen on the network:
+systemd socket units, xinetd services, nginx server blocks, and Apache HTTP Server listeners and virtual hosts.
+*/
+package networkservice
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for the services configured to listen on the network (or a local
+// socket). Only the configuration is considered, not whether the service is enabled or running.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("network-service-cataloger").
+		WithParserByGlobs(parseSystemdSocket, "**/systemd/system/*.socket").
+		WithParserByGlobs(parseXinetdConfig, "**/etc/xinetd.d/*").
+		WithParserByGlobs(parseNginxConfig, "**/etc/nginx/nginx.conf", "**/etc/nginx/conf.d/*.conf", "**/etc/nginx/sites-enabled/*").
+		WithParserByGlobs(parseApacheConfig,
+			"**/etc/apache2/apache2.conf", "**/etc/apache2/ports.conf", "**/etc/apache2/sites-enabled/*",
+			"**/etc/httpd/conf/httpd.conf", "**/etc/httpd/conf.d/*.conf",
+		)
+}
@@ -0,0 +1,179 @@
+package networkservice
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain systemd sockets, xinetd services, and nginx and apache configuration files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"etc/systemd/system/a.socket",
+				"usr/lib/systemd/system/b.socket",
+				"etc/xinetd.d/x",
+				"etc/nginx/nginx.conf",
+				"etc/nginx/conf.d/x.conf",
+				"etc/nginx/sites-enabled/x",
+				"etc/apache2/apache2.conf",
+				"etc/apache2/ports.conf",
+				"etc/apache2/sites-enabled/x.conf",
+				"etc/httpd/conf/httpd.conf",
+				"etc/httpd/conf.d/x.conf",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewCataloger())
+		})
+	}
+}
+
+func TestCataloger_System(t *testing.T) {
+	sshSocketLocation := file.NewLocation("lib/systemd/system/ssh.socket")
+	sshServiceLocation := file.NewLocation("lib/systemd/system/ssh@.service")
+	journalSocketLocation := file.NewLocation("lib/systemd/system/systemd-journald-dev-log.socket")
+	metricsSocketLocation := file.NewLocation("etc/systemd/system/metrics.socket")
+	tftpLocation := file.NewLocation("etc/xinetd.d/tftp")
+	echoLocation := file.NewLocation("etc/xinetd.d/echo")
+	nginxLocation := file.NewLocation("etc/nginx/nginx.conf")
+	siteLocation := file.NewLocation("etc/nginx/sites-enabled/example.com")
+	portsLocation := file.NewLocation("etc/apache2/ports.conf")
+	defaultSiteLocation := file.NewLocation("etc/apache2/sites-enabled/000-default.conf")
+
+	service := func(name string, entry pkg.NetworkServiceEntry, locations ...file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			FoundBy:   "network-service-cataloger",
+			Locations: file.NewLocationSet(locations...),
+			Type:      pkg.NetworkServicePkg,
+			Metadata:  entry,
+		}
+	}
+
+	expected := []pkg.Package{
+		service("ssh", pkg.NetworkServiceEntry{
+			Source: pkg.SystemdSocketServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "0.0.0.0:22", Protocol: "tcp"},
+				{Address: "[::]:22", Protocol: "tcp"},
+			},
+			Unit:       "ssh@.service",
+			Command:    "-/usr/sbin/sshd -i $SSHD_OPTS",
+			Executable: "/usr/sbin/sshd",
+		}, sshSocketLocation, sshServiceLocation),
+		// note: the service unit is not within the fixture, so there is no command
+		service("systemd-journald-dev-log", pkg.NetworkServiceEntry{
+			Source: pkg.SystemdSocketServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "/run/systemd/journal/dev-log", Protocol: "unix"},
+			},
+			Unit: "systemd-journald.service",
+		}, journalSocketLocation),
+		service("metrics", pkg.NetworkServiceEntry{
+			Source: pkg.SystemdSocketServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "9100", Protocol: "tcp"},
+				{Address: "8125", Protocol: "udp"},
+			},
+			Unit: "metrics.service",
+		}, metricsSocketLocation),
+		service("tftp", pkg.NetworkServiceEntry{
+			Source: pkg.XinetdServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "tftp", Protocol: "udp"},
+			},
+			Command:    "/usr/sbin/in.tftpd -s /var/lib/tftpboot",
+			Executable: "/usr/sbin/in.tftpd",
+			User:       "root",
+			Disabled:   true,
+		}, tftpLocation),
+		service("echo", pkg.NetworkServiceEntry{
+			Source: pkg.XinetdServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "echo", Protocol: "tcp"},
+			},
+			User: "root",
+		}, echoLocation),
+		service("rsync", pkg.NetworkServiceEntry{
+			Source: pkg.XinetdServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "127.0.0.1:873", Protocol: "tcp"},
+			},
+			Command:    "/usr/bin/rsync --daemon",
+			Executable: "/usr/bin/rsync",
+			User:       "nobody",
+		}, echoLocation),
+		// note: upstream servers are not server blocks
+		service("nginx", pkg.NetworkServiceEntry{
+			Source: pkg.NginxServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "80", Protocol: "tcp"},
+				{Address: "[::]:80", Protocol: "tcp"},
+			},
+			ServerNames: []string{"_"},
+		}, nginxLocation),
+		service("nginx", pkg.NetworkServiceEntry{
+			Source: pkg.NginxServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "53", Protocol: "udp"},
+			},
+		}, nginxLocation),
+		// note: listen directives within location blocks are not server listeners
+		service("example.com", pkg.NetworkServiceEntry{
+			Source: pkg.NginxServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "443", Protocol: "tcp"},
+				{Address: "443", Protocol: "udp"},
+			},
+			ServerNames: []string{"example.com", "www.example.com"},
+		}, siteLocation),
+		service("internal.example.com", pkg.NetworkServiceEntry{
+			Source: pkg.NginxServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "80", Protocol: "tcp"},
+			},
+			ServerNames: []string{"internal.example.com"},
+		}, siteLocation),
+		service("apache", pkg.NetworkServiceEntry{
+			Source: pkg.ApacheServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "80", Protocol: "tcp"},
+				{Address: "443", Protocol: "tcp"},
+			},
+		}, portsLocation),
+		service("apache", pkg.NetworkServiceEntry{
+			Source: pkg.ApacheServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "*:80", Protocol: "tcp"},
+			},
+		}, defaultSiteLocation),
+		service("www.example.org", pkg.NetworkServiceEntry{
+			Source: pkg.ApacheServiceSource,
+			Listeners: []pkg.NetworkServiceListener{
+				{Address: "192.0.2.1:443", Protocol: "tcp"},
+				{Address: "[2001:db8::1]:443", Protocol: "tcp"},
+			},
+			ServerNames: []string{"www.example.org", "shop.example.org"},
+		}, defaultSiteLocation),
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/system").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
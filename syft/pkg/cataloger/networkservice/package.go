@@ -0,0 +1,26 @@
+package networkservice
+
+import (
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// protocols of listeners
+const (
+	tcp  = "tcp"
+	udp  = "udp"
+	unix = "unix"
+)
+
+func newNetworkServicePackage(name string, entry pkg.NetworkServiceEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.NetworkServicePkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
@@ -0,0 +1,80 @@
+package networkservice
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseApacheConfig
+
+// parseApacheConfig is a parser function for Apache HTTP Server configuration files. Listen directives raise a single
+// package for the server, and each VirtualHost section raises a package with the addresses and server names of the
+// virtual host. Include directives are not followed, as included files are cataloged on their own.
+func parseApacheConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	var listeners []pkg.NetworkServiceListener
+	var virtualHost *pkg.NetworkServiceEntry
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		directive := strings.ToLower(fields[0])
+		switch {
+		case directive == "<virtualhost":
+			virtualHost = &pkg.NetworkServiceEntry{Source: pkg.ApacheServiceSource}
+			for _, address := range fields[1:] {
+				address = strings.TrimSuffix(address, ">")
+				if address != "" {
+					virtualHost.Listeners = append(virtualHost.Listeners, pkg.NetworkServiceListener{Address: address, Protocol: tcp})
+				}
+			}
+		case directive == "</virtualhost>":
+			if virtualHost != nil {
+				pkgs = append(pkgs, newNetworkServicePackage(apacheServerName(virtualHost.ServerNames), *virtualHost, location))
+			}
+			virtualHost = nil
+		case directive == "listen" && virtualHost == nil && len(fields) > 1:
+			// the optional protocol argument (e.g. "https") is the application protocol, not the transport
+			listeners = append(listeners, pkg.NetworkServiceListener{Address: fields[1], Protocol: tcp})
+		case directive == "servername" && virtualHost != nil && len(fields) > 1:
+			virtualHost.ServerNames = append([]string{fields[1]}, virtualHost.ServerNames...)
+		case directive == "serveralias" && virtualHost != nil:
+			virtualHost.ServerNames = append(virtualHost.ServerNames, fields[1:]...)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read apache configuration file: %w", err)
+	}
+
+	if len(listeners) > 0 {
+		server := newNetworkServicePackage("apache", pkg.NetworkServiceEntry{
+			Source:    pkg.ApacheServiceSource,
+			Listeners: listeners,
+		}, location)
+		pkgs = append([]pkg.Package{server}, pkgs...)
+	}
+
+	return pkgs, nil, nil
+}
+
+// apacheServerName returns the name of a virtual host (its ServerName, which is kept ahead of any aliases).
+func apacheServerName(serverNames []string) string {
+	if len(serverNames) > 0 {
+		return serverNames[0]
+	}
+	return "apache"
+}
@@ -0,0 +1,207 @@
+package networkservice
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseNginxConfig
+
+// nginxServer is a server block within an nginx configuration file.
+type nginxServer struct {
+	listeners   []pkg.NetworkServiceListener
+	serverNames []string
+	// stream is true for servers within a stream block (TCP/UDP proxies), which have no default listener
+	stream bool
+}
+
+// parseNginxConfig is a parser function for nginx configuration files, raising a package for each server block
+// (virtual host) with the addresses it listens on and the server names it answers to. Include directives are not
+// followed, as included files are cataloged on their own.
+func parseNginxConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	servers, err := readNginxServers(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read nginx configuration file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, server := range servers {
+		listeners := server.listeners
+		if len(listeners) == 0 {
+			if server.stream {
+				continue
+			}
+			listeners = []pkg.NetworkServiceListener{{Address: "80", Protocol: tcp}}
+		}
+
+		pkgs = append(pkgs, newNetworkServicePackage(
+			nginxServerName(server.serverNames),
+			pkg.NetworkServiceEntry{
+				Source:      pkg.NginxServiceSource,
+				Listeners:   listeners,
+				ServerNames: server.serverNames,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+// nginxServerName returns the first server name that names a host, skipping the catch-all "_" and empty names.
+func nginxServerName(serverNames []string) string {
+	for _, name := range serverNames {
+		if name != "_" && name != "" && name != `""` {
+			return name
+		}
+	}
+	return "nginx"
+}
+
+func readNginxServers(reader io.Reader) ([]nginxServer, error) {
+	tokens, err := nginxTokens(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []nginxServer
+	// blocks is the stack of open block names, with the server being built for each open server block
+	var blocks []string
+	var current *nginxServer
+	var directive []string
+
+	inStream := func() bool {
+		for _, block := range blocks {
+			if block == "stream" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, token := range tokens {
+		switch token {
+		case "{":
+			name := ""
+			if len(directive) > 0 {
+				name = directive[0]
+			}
+			// server blocks also appear within upstream blocks as directives, not blocks, so only "server {" counts
+			if name == "server" && len(directive) == 1 && current == nil {
+				current = &nginxServer{stream: inStream()}
+			}
+			blocks = append(blocks, name)
+			directive = nil
+		case "}":
+			if len(blocks) > 0 {
+				if blocks[len(blocks)-1] == "server" && current != nil && isServerBlock(blocks) {
+					servers = append(servers, *current)
+					current = nil
+				}
+				blocks = blocks[:len(blocks)-1]
+			}
+			directive = nil
+		case ";":
+			// only directives directly within the server block apply (not those within location blocks)
+			if current != nil && len(directive) > 0 && len(blocks) > 0 && blocks[len(blocks)-1] == "server" {
+				switch directive[0] {
+				case "listen":
+					if len(directive) > 1 {
+						current.listeners = append(current.listeners, nginxListener(directive[1], directive[2:]))
+					}
+				case "server_name":
+					current.serverNames = append(current.serverNames, directive[1:]...)
+				}
+			}
+			directive = nil
+		default:
+			directive = append(directive, token)
+		}
+	}
+
+	return servers, nil
+}
+
+// isServerBlock reports whether the innermost open block is the outermost server block (servers do not nest).
+func isServerBlock(blocks []string) bool {
+	for _, block := range blocks[:len(blocks)-1] {
+		if block == "server" {
+			return false
+		}
+	}
+	return true
+}
+
+// nginxListener returns the listener for the address and parameters of a listen directive.
+func nginxListener(address string, parameters []string) pkg.NetworkServiceListener {
+	if socket, ok := strings.CutPrefix(address, "unix:"); ok {
+		return pkg.NetworkServiceListener{Address: socket, Protocol: unix}
+	}
+
+	protocol := tcp
+	for _, parameter := range parameters {
+		if parameter == "udp" || parameter == "quic" {
+			protocol = udp
+		}
+	}
+
+	return pkg.NetworkServiceListener{Address: address, Protocol: protocol}
+}
+
+// nginxTokens splits the configuration into words and the ";", "{", and "}" delimiters, dropping comments and the
+// quotes around quoted words.
+func nginxTokens(reader io.Reader) ([]string, error) {
+	var tokens []string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		var word strings.Builder
+		var quote rune
+		flush := func() {
+			if word.Len() > 0 {
+				tokens = append(tokens, word.String())
+				word.Reset()
+			}
+		}
+
+	scan:
+		for _, r := range line {
+			switch {
+			case quote != 0:
+				if r == quote {
+					quote = 0
+					// keep empty quoted words (e.g. server_name "")
+					if word.Len() == 0 {
+						tokens = append(tokens, `""`)
+					}
+					continue
+				}
+				word.WriteRune(r)
+			case r == '"' || r == '\'':
+				quote = r
+			case r == '#':
+				break scan
+			case r == ';' || r == '{' || r == '}':
+				flush()
+				tokens = append(tokens, string(r))
+			case r == ' ' || r == '\t':
+				flush()
+			default:
+				word.WriteRune(r)
+			}
+		}
+		flush()
+	}
+
+	return tokens, scanner.Err()
+}
@@ -0,0 +1,84 @@
+package networkservice
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/systemd"
+)
+
+var _ generic.Parser = parseSystemdSocket
+
+// parseSystemdSocket is a parser function for systemd socket units, which describe the addresses listened on for the
+// service activated by the socket. The command run is read from the service unit (which is named after the socket
+// unless otherwise set).
+func parseSystemdSocket(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	socket, err := systemd.Read(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse systemd socket unit: %w", err)
+	}
+
+	var listeners []pkg.NetworkServiceListener
+	for _, address := range socket.Values("Socket", "ListenStream") {
+		listeners = append(listeners, socketListener(address, tcp))
+	}
+	for _, address := range socket.Values("Socket", "ListenDatagram") {
+		listeners = append(listeners, socketListener(address, udp))
+	}
+	for _, address := range socket.Values("Socket", "ListenSequentialPacket") {
+		listeners = append(listeners, pkg.NetworkServiceListener{Address: address, Protocol: unix})
+	}
+	if len(listeners) == 0 {
+		return nil, nil, nil
+	}
+
+	name := strings.TrimSuffix(path.Base(reader.RealPath), ".socket")
+	unit := socket.Value("Socket", "Service")
+	if unit == "" {
+		// sockets accepting connections activate an instance of a template service for each connection
+		if isTrue(socket.Value("Socket", "Accept")) {
+			unit = name + "@.service"
+		} else {
+			unit = name + ".service"
+		}
+	}
+
+	entry := pkg.NetworkServiceEntry{
+		Source:    pkg.SystemdSocketServiceSource,
+		Listeners: listeners,
+		Unit:      unit,
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	if service, serviceLocation := systemd.ReadRelatedUnit(resolver, reader.Location, unit); service != nil {
+		entry.Command = service.Value("Service", "ExecStart")
+		entry.Executable = systemd.ExecutablePath(entry.Command)
+		locations = append(locations, serviceLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	return []pkg.Package{newNetworkServicePackage(name, entry, locations...)}, nil, nil
+}
+
+// socketListener returns the listener for a stream or datagram socket address, which is either a unix socket (a path
+// or an abstract namespace name starting with "@") or an internet socket (a port, optionally with a host).
+func socketListener(address, protocol string) pkg.NetworkServiceListener {
+	if strings.HasPrefix(address, "/") || strings.HasPrefix(address, "@") {
+		protocol = unix
+	}
+	return pkg.NetworkServiceListener{Address: address, Protocol: protocol}
+}
+
+func isTrue(value string) bool {
+	switch strings.ToLower(value) {
+	case "yes", "true", "on", "1":
+		return true
+	}
+	return false
+}
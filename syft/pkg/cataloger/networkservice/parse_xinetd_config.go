@@ -0,0 +1,134 @@
+package networkservice
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseXinetdConfig
+
+// xinetdService is the attributes of a single service entry within an xinetd configuration file.
+type xinetdService struct {
+	name       string
+	attributes map[string]string
+}
+
+// parseXinetdConfig is a parser function for xinetd configuration files (within /etc/xinetd.d), which have one or more
+// service entries of the form:
+//
+//	service tftp
+//	{
+//		socket_type = dgram
+//		protocol    = udp
+//		user        = root
+//		server      = /usr/sbin/in.tftpd
+//		server_args = -s /var/lib/tftpboot
+//		disable     = yes
+//	}
+func parseXinetdConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	services, err := readXinetdServices(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read xinetd configuration file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, service := range services {
+		pkgs = append(pkgs, newNetworkServicePackage(
+			service.name,
+			xinetdEntry(service),
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+func xinetdEntry(service xinetdService) pkg.NetworkServiceEntry {
+	attributes := service.attributes
+
+	protocol := attributes["protocol"]
+	if protocol == "" {
+		switch attributes["socket_type"] {
+		case "stream":
+			protocol = tcp
+		case "dgram":
+			protocol = udp
+		}
+	}
+
+	// without a port the service is looked up by name within /etc/services
+	address := attributes["port"]
+	if address == "" {
+		address = service.name
+	}
+	if bind := attributes["bind"]; bind != "" {
+		address = bind + ":" + address
+	}
+
+	entry := pkg.NetworkServiceEntry{
+		Source:    pkg.XinetdServiceSource,
+		Listeners: []pkg.NetworkServiceListener{{Address: address, Protocol: protocol}},
+		User:      attributes["user"],
+		Disabled:  attributes["disable"] == "yes",
+	}
+
+	if server := attributes["server"]; server != "" {
+		entry.Command = strings.TrimSpace(server + " " + attributes["server_args"])
+		if strings.HasPrefix(server, "/") {
+			entry.Executable = server
+		}
+	}
+
+	return entry
+}
+
+func readXinetdServices(reader file.LocationReadCloser) ([]xinetdService, error) {
+	var services []xinetdService
+	var current *xinetdService
+	var name string
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if comment := strings.Index(line, "#"); comment >= 0 {
+			line = strings.TrimSpace(line[:comment])
+		}
+
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "service "):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "service "))
+		case line == "{":
+			if name != "" {
+				current = &xinetdService{name: name, attributes: make(map[string]string)}
+			}
+		case line == "}":
+			if current != nil {
+				services = append(services, *current)
+			}
+			current, name = nil, ""
+		case current != nil:
+			key, value, found := strings.Cut(line, "=")
+			if !found {
+				continue
+			}
+			// note: additions to list attributes ("+=") are treated as the value, removals ("-=") are ignored
+			key = strings.TrimSpace(key)
+			if strings.HasSuffix(key, "-") {
+				continue
+			}
+			key = strings.TrimSpace(strings.TrimSuffix(key, "+"))
+			current.attributes[key] = strings.TrimSpace(value)
+		}
+	}
+
+	return services, scanner.Err()
+}
@@ -0,0 +1,18 @@
+/*
+Package osaccount provides a concrete Cataloger implementation for the user and group accounts defined by an OS (within
+the passwd and group files).
+*/
+package osaccount
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for user accounts (from /etc/passwd, noting the presence of entries
+// within /etc/shadow) and groups (from /etc/group). Password hashes are never captured.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("os-account-cataloger").
+		WithParserByGlobs(parsePasswd, "**/etc/passwd").
+		WithParserByGlobs(parseGroup, "**/etc/group")
+}
@@ -0,0 +1,123 @@
+package osaccount
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain passwd and group files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"etc/passwd",
+				"etc/group",
+				"rootfs/etc/passwd",
+				"rootfs/etc/group",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				IgnoreUnfulfilledPathResponses("etc/shadow", "rootfs/etc/shadow").
+				TestCataloger(t, NewCataloger())
+		})
+	}
+}
+
+func TestCataloger_System(t *testing.T) {
+	passwdLocation := file.NewLocation("etc/passwd")
+	shadowLocation := file.NewLocation("etc/shadow")
+	groupLocation := file.NewLocation("etc/group")
+
+	user := func(name string, entry pkg.OSUserAccount) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			FoundBy:   "os-account-cataloger",
+			Locations: file.NewLocationSet(passwdLocation, shadowLocation),
+			Type:      pkg.OSAccountPkg,
+			Metadata:  entry,
+		}
+	}
+
+	group := func(name string, entry pkg.OSGroupAccount) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			FoundBy:   "os-account-cataloger",
+			Locations: file.NewLocationSet(groupLocation),
+			Type:      pkg.OSAccountPkg,
+			Metadata:  entry,
+		}
+	}
+
+	expected := []pkg.Package{
+		user("root", pkg.OSUserAccount{
+			UID:            0,
+			GID:            0,
+			Gecos:          "root",
+			HomeDirectory:  "/root",
+			Shell:          "/bin/bash",
+			Login:          true,
+			ShadowEntry:    true,
+			PasswordStatus: pkg.PasswordLocked,
+		}),
+		user("daemon", pkg.OSUserAccount{
+			UID:            1,
+			GID:            1,
+			Gecos:          "daemon",
+			HomeDirectory:  "/usr/sbin",
+			Shell:          "/usr/sbin/nologin",
+			ShadowEntry:    true,
+			PasswordStatus: pkg.PasswordLocked,
+		}),
+		user("sync", pkg.OSUserAccount{
+			UID:            4,
+			GID:            65534,
+			Gecos:          "sync",
+			HomeDirectory:  "/bin",
+			Shell:          "/bin/sync",
+			ShadowEntry:    true,
+			PasswordStatus: pkg.PasswordLocked,
+		}),
+		user("_apt", pkg.OSUserAccount{
+			UID:            42,
+			GID:            65534,
+			HomeDirectory:  "/nonexistent",
+			Shell:          "/usr/sbin/nologin",
+			ShadowEntry:    true,
+			PasswordStatus: pkg.PasswordLocked,
+		}),
+		user("dev", pkg.OSUserAccount{
+			UID:            1000,
+			GID:            1000,
+			Gecos:          "Developer,,,",
+			HomeDirectory:  "/home/dev",
+			Shell:          "/bin/bash",
+			Login:          true,
+			ShadowEntry:    true,
+			PasswordStatus: pkg.PasswordSet,
+		}),
+		group("root", pkg.OSGroupAccount{GID: 0}),
+		group("daemon", pkg.OSGroupAccount{GID: 1}),
+		group("sudo", pkg.OSGroupAccount{GID: 27, Members: []string{"dev"}}),
+		group("docker", pkg.OSGroupAccount{GID: 999, Members: []string{"dev", "ci"}}),
+		group("dev", pkg.OSGroupAccount{GID: 1000}),
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/system").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
@@ -0,0 +1,19 @@
+package osaccount
+
+import (
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newAccountPackage(name string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.OSAccountPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
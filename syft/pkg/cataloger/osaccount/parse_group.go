@@ -0,0 +1,56 @@
+package osaccount
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseGroup
+
+// parseGroup is a parser function for group files, where each line has the form "name:password:GID:members" (with
+// members as a comma-separated list of user names).
+func parseGroup(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields, ok := accountFields(scanner.Text(), 4)
+		if !ok {
+			continue
+		}
+
+		gid, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+
+		var members []string
+		for _, member := range strings.Split(fields[3], ",") {
+			if member = strings.TrimSpace(member); member != "" {
+				members = append(members, member)
+			}
+		}
+
+		pkgs = append(pkgs, newAccountPackage(
+			fields[0],
+			pkg.OSGroupAccount{
+				GID:     gid,
+				Members: members,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read group file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
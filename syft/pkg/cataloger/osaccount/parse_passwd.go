@@ -0,0 +1,165 @@
+package osaccount
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePasswd
+
+// nonLoginShells are the (base names of) shells which do not permit interactive logins
+var nonLoginShells = map[string]bool{
+	"nologin":  true,
+	"false":    true,
+	"sync":     true,
+	"shutdown": true,
+	"halt":     true,
+}
+
+// shadowFile is the shadow file next to a passwd file, with the password status of each account listed.
+type shadowFile struct {
+	location file.Location
+	statuses map[string]string
+}
+
+// parsePasswd is a parser function for passwd files, where each line has the form
+// "name:password:UID:GID:GECOS:directory:shell". The password status of shadowed accounts is read from the shadow file
+// within the same directory, when available.
+func parsePasswd(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	shadow := readShadow(resolver, reader.Location)
+
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		fields, ok := accountFields(scanner.Text(), 7)
+		if !ok {
+			continue
+		}
+
+		uid, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		gid, err := strconv.Atoi(fields[3])
+		if err != nil {
+			continue
+		}
+
+		name, password, shell := fields[0], fields[1], fields[6]
+		entry := pkg.OSUserAccount{
+			UID:           uid,
+			GID:           gid,
+			Gecos:         fields[4],
+			HomeDirectory: fields[5],
+			Shell:         shell,
+			// note: an empty shell field means the default shell (/bin/sh) is used
+			Login: shell == "" || !nonLoginShells[path.Base(shell)],
+		}
+
+		locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+		status, shadowed := shadow.status(name)
+		entry.ShadowEntry = shadowed
+		if shadowed {
+			locations = append(locations, shadow.location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+
+		if password == "x" {
+			entry.PasswordStatus = status
+		} else {
+			// the (legacy) password hash is within the passwd file itself
+			entry.PasswordStatus = passwordStatus(password)
+		}
+
+		pkgs = append(pkgs, newAccountPackage(name, entry, locations...))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read passwd file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
+
+// accountFields splits a line of a passwd, group, or shadow file into its fields, skipping comments, blank lines, and
+// NIS compatibility entries (starting with "+" or "-").
+func accountFields(line string, expected int) ([]string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+		return nil, false
+	}
+
+	fields := strings.Split(line, ":")
+	if len(fields) < expected || fields[0] == "" {
+		return nil, false
+	}
+	return fields, true
+}
+
+// passwordStatus returns the state of a password field without retaining the password hash itself.
+func passwordStatus(password string) string {
+	switch {
+	case password == "":
+		return pkg.PasswordEmpty
+	case strings.HasPrefix(password, "!"), strings.HasPrefix(password, "*"):
+		return pkg.PasswordLocked
+	}
+	return pkg.PasswordSet
+}
+
+func (s *shadowFile) status(name string) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	status, ok := s.statuses[name]
+	return status, ok
+}
+
+func readShadow(resolver file.Resolver, location file.Location) *shadowFile {
+	if resolver == nil {
+		return nil
+	}
+
+	shadowPath := path.Join(path.Dir(location.RealPath), "shadow")
+	shadowLocation := resolver.RelativeFileByPath(location, shadowPath)
+	if shadowLocation == nil {
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*shadowLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", shadowPath).Trace("unable to read shadow file")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, shadowLocation.RealPath)
+
+	statuses := make(map[string]string)
+	scanner := bufio.NewScanner(contents)
+	for scanner.Scan() {
+		fields, ok := accountFields(scanner.Text(), 2)
+		if !ok {
+			continue
+		}
+		statuses[fields[0]] = passwordStatus(fields[1])
+	}
+	if err := scanner.Err(); err != nil {
+		log.WithFields("error", err, "path", shadowPath).Trace("unable to read shadow file")
+		return nil
+	}
+
+	return &shadowFile{
+		location: *shadowLocation,
+		statuses: statuses,
+	}
+}
@@ -0,0 +1,79 @@
+package osaccount
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePasswd_Legacy(t *testing.T) {
+	fixture := "test-fixtures/legacy/etc/passwd"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	// note: the password hashes are within the passwd file itself, so there is no need for a shadow file
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "root",
+			Locations: locations,
+			Type:      pkg.OSAccountPkg,
+			Metadata: pkg.OSUserAccount{
+				Gecos:          "root",
+				HomeDirectory:  "/root",
+				Shell:          "/bin/sh",
+				Login:          true,
+				PasswordStatus: pkg.PasswordEmpty,
+			},
+		},
+		{
+			Name:      "operator",
+			Locations: locations,
+			Type:      pkg.OSAccountPkg,
+			Metadata: pkg.OSUserAccount{
+				UID:            11,
+				Gecos:          "operator",
+				HomeDirectory:  "/root",
+				Login:          true,
+				PasswordStatus: pkg.PasswordLocked,
+			},
+		},
+		{
+			Name:      "guest",
+			Locations: locations,
+			Type:      pkg.OSAccountPkg,
+			Metadata: pkg.OSUserAccount{
+				UID:            405,
+				GID:            100,
+				Gecos:          "guest",
+				HomeDirectory:  "/dev/null",
+				Shell:          "/sbin/nologin",
+				PasswordStatus: pkg.PasswordSet,
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parsePasswd, expectedPkgs, nil)
+}
+
+func TestParsePasswd_WithoutShadow(t *testing.T) {
+	// without the shadow file the status of a shadowed password is not known
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "root",
+			Locations: file.NewLocationSet(file.NewLocation("etc/passwd")),
+			Type:      pkg.OSAccountPkg,
+			Metadata: pkg.OSUserAccount{
+				Gecos:         "root",
+				HomeDirectory: "/root",
+				Shell:         "/bin/ash",
+				Login:         true,
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromString("etc/passwd", "root:x:0:0:root:/root:/bin/ash\n").
+		Expects(expectedPkgs, nil).
+		TestParser(t, parsePasswd)
+}
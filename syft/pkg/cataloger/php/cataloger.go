@@ -28,3 +28,18 @@ func NewPeclCataloger() pkg.Cataloger {
 	return generic.NewCataloger("php-pecl-serialized-cataloger").
 		WithParserByGlobs(parsePeclSerialized, "**/php/.registry/.channel.*/*.reg")
 }
+
+// NewExtensionCataloger returns a new cataloger for compiled PHP extensions within the extension_dir of a PHP
+// installation (such as those installed with pecl), along with whether they are enabled by the PHP configuration.
+func NewExtensionCataloger() pkg.Cataloger {
+	return generic.NewCataloger("php-extension-cataloger").
+		WithParserByGlobs(parseExtension,
+			// official docker images and source builds (e.g. /usr/local/lib/php/extensions/no-debug-non-zts-20220829)
+			"**/lib/php/extensions/*/*.so",
+			// debian and ubuntu (e.g. /usr/lib/php/20220829)
+			"**/lib/php/20*/*.so",
+			// alpine (e.g. /usr/lib/php82/modules) and fedora/rhel (e.g. /usr/lib64/php/modules)
+			"**/lib/php*/modules/*.so",
+			"**/lib64/php*/modules/*.so",
+		)
+}
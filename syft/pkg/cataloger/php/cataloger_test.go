@@ -80,3 +80,15 @@ func Test_PeclCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func Test_ExtensionCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"usr/local/lib/php/extensions/no-debug-non-zts-20220829/redis.so",
+			"usr/lib/php/20220829/redis.so",
+			"usr/lib/php82/modules/redis.so",
+			"usr/lib64/php/modules/redis.so",
+		}).
+		TestCataloger(t, NewExtensionCataloger())
+}
@@ -0,0 +1,258 @@
+package php
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// the offsets of the fields of the zend_module_entry struct (see Zend/zend_modules.h within the PHP source), which has
+// been stable since PHP 5.4
+type zendModuleEntryLayout struct {
+	zendAPI, name, version uint64
+}
+
+var (
+	zendModuleEntry64 = zendModuleEntryLayout{zendAPI: 4, name: 32, version: 88}
+	zendModuleEntry32 = zendModuleEntryLayout{zendAPI: 4, name: 20, version: 48}
+)
+
+const (
+	// getModuleSymbol is the function exported by every extension (see ZEND_GET_MODULE), returning its module entry
+	getModuleSymbol = "get_module"
+
+	// zendExtensionEntrySymbol is exported by extensions loaded with zend_extension (e.g. xdebug), where the
+	// zend_extension struct starts with pointers to the name and version of the extension
+	zendExtensionEntrySymbol = "zend_extension_entry"
+
+	maxExtensionStringLength = 128
+)
+
+// extensionModule is the information recovered from the module entry of a compiled PHP extension
+type extensionModule struct {
+	name          string
+	version       string
+	zendAPI       uint32
+	zendExtension bool
+}
+
+// readExtensionModule reads the zend_module_entry (or zend_extension_entry) exported by the given PHP extension. The
+// pointers within these structs are resolved with the relative relocations of the shared object.
+func readExtensionModule(r io.ReaderAt, name string) (*extensionModule, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ELF file: %w", err)
+	}
+
+	symbols, err := f.DynamicSymbols()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read dynamic symbols: %w", err)
+	}
+	if static, err := f.Symbols(); err == nil {
+		symbols = append(symbols, static...)
+	}
+
+	relocations := relativeRelocations(f)
+
+	var module extensionModule
+	var moduleEntry, extensionEntry *uint64
+	var getModule *elf.Symbol
+	for i := range symbols {
+		s := &symbols[i]
+		if s.Section == elf.SHN_UNDEF {
+			continue
+		}
+		switch s.Name {
+		case name + "_module_entry":
+			moduleEntry = &s.Value
+		case zendExtensionEntrySymbol:
+			extensionEntry = &s.Value
+		case getModuleSymbol:
+			getModule = s
+		}
+	}
+
+	// extensions are usually built with hidden visibility, leaving get_module as the only exported symbol
+	if moduleEntry == nil && getModule != nil {
+		moduleEntry = getModuleTarget(f, *getModule)
+	}
+
+	pointerSize := uint64(8)
+	layout := zendModuleEntry64
+	if f.Class == elf.ELFCLASS32 {
+		pointerSize = 4
+		layout = zendModuleEntry32
+	}
+
+	if moduleEntry != nil {
+		if api, err := readUint32(f, *moduleEntry+layout.zendAPI); err == nil {
+			module.zendAPI = api
+		}
+		module.name = readStringPointer(f, relocations, *moduleEntry+layout.name, pointerSize)
+		module.version = readStringPointer(f, relocations, *moduleEntry+layout.version, pointerSize)
+	}
+
+	if extensionEntry != nil {
+		module.zendExtension = true
+		if module.name == "" {
+			module.name = readStringPointer(f, relocations, *extensionEntry, pointerSize)
+		}
+		if module.version == "" {
+			module.version = readStringPointer(f, relocations, *extensionEntry+pointerSize, pointerSize)
+		}
+	}
+
+	if moduleEntry == nil && extensionEntry == nil {
+		return nil, fmt.Errorf("no module entry found for extension %q", name)
+	}
+	return &module, nil
+}
+
+// getModuleTarget returns the address of the module entry returned by the get_module function, decoding the
+// instructions emitted by compilers for returning the address of a local symbol.
+func getModuleTarget(f *elf.File, getModule elf.Symbol) *uint64 {
+	code, err := readAt(f, getModule.Value, 12)
+	if err != nil {
+		return nil
+	}
+
+	switch f.Machine {
+	case elf.EM_X86_64:
+		// lea rax, [rip+disp32]; ret
+		if len(code) >= 8 && code[0] == 0x48 && code[1] == 0x8d && code[2] == 0x05 && code[7] == 0xc3 {
+			target := getModule.Value + 7 + uint64(int64(int32(f.ByteOrder.Uint32(code[3:]))))
+			return &target
+		}
+	case elf.EM_AARCH64:
+		// adrp x0, page; add x0, x0, #offset; ret
+		if len(code) < 12 {
+			return nil
+		}
+		adrp := f.ByteOrder.Uint32(code[0:])
+		add := f.ByteOrder.Uint32(code[4:])
+		ret := f.ByteOrder.Uint32(code[8:])
+		if adrp&0x9f00001f != 0x90000000 || add&0xffc003ff != 0x91000000 || ret != 0xd65f03c0 {
+			return nil
+		}
+		immediate := int64((adrp>>29)&0x3 | ((adrp>>5)&0x7ffff)<<2)
+		// sign extend the 21-bit page offset
+		immediate = immediate << 43 >> 43
+		target := uint64(int64(getModule.Value&^0xfff) + immediate<<12)
+		target += uint64((add >> 10) & 0xfff)
+		return &target
+	}
+	return nil
+}
+
+// relativeRelocations returns the addends of the relative relocations of the shared object, keyed by the address
+// they apply to (where relocations without an explicit addend use the value stored at the address).
+func relativeRelocations(f *elf.File) map[uint64]uint64 {
+	relocations := make(map[uint64]uint64)
+	for _, section := range f.Sections {
+		if section.Type != elf.SHT_RELA {
+			continue
+		}
+		data, err := section.Data()
+		if err != nil {
+			continue
+		}
+
+		reader := bytes.NewReader(data)
+		switch f.Class {
+		case elf.ELFCLASS64:
+			var rela elf.Rela64
+			for binary.Read(reader, f.ByteOrder, &rela) == nil {
+				if isRelativeRelocation(f.Machine, elf.R_TYPE64(rela.Info)) {
+					relocations[rela.Off] = uint64(rela.Addend)
+				}
+			}
+		case elf.ELFCLASS32:
+			var rela elf.Rela32
+			for binary.Read(reader, f.ByteOrder, &rela) == nil {
+				if isRelativeRelocation(f.Machine, elf.R_TYPE32(rela.Info)) {
+					relocations[uint64(rela.Off)] = uint64(uint32(rela.Addend))
+				}
+			}
+		}
+	}
+	return relocations
+}
+
+func isRelativeRelocation(machine elf.Machine, relocationType uint32) bool {
+	switch machine {
+	case elf.EM_X86_64:
+		return elf.R_X86_64(relocationType) == elf.R_X86_64_RELATIVE
+	case elf.EM_AARCH64:
+		return elf.R_AARCH64(relocationType) == elf.R_AARCH64_RELATIVE
+	case elf.EM_PPC64:
+		return elf.R_PPC64(relocationType) == elf.R_PPC64_RELATIVE
+	case elf.EM_S390:
+		return elf.R_390(relocationType) == elf.R_390_RELATIVE
+	case elf.EM_RISCV:
+		return elf.R_RISCV(relocationType) == elf.R_RISCV_RELATIVE
+	}
+	return false
+}
+
+// readStringPointer returns the NUL terminated string pointed to by the pointer at the given address
+func readStringPointer(f *elf.File, relocations map[uint64]uint64, addr, pointerSize uint64) string {
+	target, ok := relocations[addr]
+	if !ok {
+		data, err := readAt(f, addr, pointerSize)
+		if err != nil || uint64(len(data)) < pointerSize {
+			return ""
+		}
+		if pointerSize == 8 {
+			target = f.ByteOrder.Uint64(data)
+		} else {
+			target = uint64(f.ByteOrder.Uint32(data))
+		}
+	}
+	if target == 0 {
+		return ""
+	}
+
+	data, err := readAt(f, target, maxExtensionStringLength)
+	if err != nil && len(data) == 0 {
+		return ""
+	}
+	s, _, terminated := bytes.Cut(data, []byte{0})
+	if !terminated {
+		return ""
+	}
+	for _, c := range s {
+		if c < 0x20 || c > 0x7e {
+			return ""
+		}
+	}
+	return strings.TrimSpace(string(s))
+}
+
+func readUint32(f *elf.File, addr uint64) (uint32, error) {
+	data, err := readAt(f, addr, 4)
+	if err != nil {
+		return 0, err
+	}
+	return f.ByteOrder.Uint32(data), nil
+}
+
+// readAt reads up to n bytes at the given virtual address (stopping at the end of the containing section)
+func readAt(f *elf.File, addr, n uint64) ([]byte, error) {
+	for _, section := range f.Sections {
+		if section.Type == elf.SHT_NOBITS || section.Addr == 0 || addr < section.Addr || addr >= section.Addr+section.Size {
+			continue
+		}
+		offset := addr - section.Addr
+		n = min(n, section.Size-offset)
+		data := make([]byte, n)
+		read, err := section.ReadAt(data, int64(offset))
+		if read == 0 && err != nil {
+			return nil, err
+		}
+		return data[:read], nil
+	}
+	return nil, fmt.Errorf("address 0x%x is not within a section", addr)
+}
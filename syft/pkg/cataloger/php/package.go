@@ -92,3 +92,19 @@ func packageURLFromPecl(pkgName, version string) string {
 		"")
 	return pURL.ToString()
 }
+
+func newExtensionPackage(m pkg.PhpExtensionEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   m.Version,
+		Locations: file.NewLocationSet(locations...),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0], m.License...)...),
+		PURL:      packageURLFromPecl(m.Name, m.Version),
+		Language:  pkg.PHP,
+		Type:      pkg.PhpPeclPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
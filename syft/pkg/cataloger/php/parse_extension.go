@@ -0,0 +1,218 @@
+package php
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elliotchance/phpserialize"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// integrity check
+var _ generic.Parser = parseExtension
+
+// the globs for the ini files that enable extensions: the php.ini file, and the scanned conf.d directories of the
+// official docker images (/usr/local/etc/php/conf.d), debian (/etc/php/8.2/cli/conf.d), alpine (/etc/php82/conf.d),
+// and fedora/rhel (/etc/php.d)
+var extensionConfigGlobs = []string{
+	"**/php.ini",
+	"**/php*/conf.d/*.ini",
+	"**/php/*/*/conf.d/*.ini",
+	"**/php.d/*.ini",
+}
+
+// the PECL channel registry (as written by "pecl install") relative to the PHP library directory, and the location used
+// by debian
+const peclRegistryDir = ".registry/.channel.pecl.php.net"
+
+var debianPeclRegistryDir = path.Join("/usr/share/php", peclRegistryDir)
+
+var extensionDirAPIPattern = regexp.MustCompile(`(?:^|-)(\d{8})$`)
+
+var extensionDirectivePattern = regexp.MustCompile(`(?i)^\s*(zend_extension|extension)\s*=\s*(.+?)\s*$`)
+
+// bundledExtensions are the extensions distributed with the PHP source (within its ext/ directory), which share the
+// version of PHP itself and are not reported as packages.
+var bundledExtensions = map[string]bool{
+	"bcmath": true, "bz2": true, "calendar": true, "ctype": true, "curl": true, "dba": true, "dl_test": true,
+	"dom": true, "enchant": true, "exif": true, "ffi": true, "fileinfo": true, "filter": true, "ftp": true, "gd": true,
+	"gettext": true, "gmp": true, "iconv": true, "imap": true, "interbase": true, "intl": true, "json": true,
+	"ldap": true, "mbstring": true, "mysqli": true, "mysqlnd": true, "oci8": true, "odbc": true, "opcache": true,
+	"pcntl": true, "pdo": true, "pdo_dblib": true, "pdo_firebird": true, "pdo_mysql": true, "pdo_oci": true,
+	"pdo_odbc": true, "pdo_pgsql": true, "pdo_sqlite": true, "pgsql": true, "phar": true, "posix": true,
+	"pspell": true, "readline": true, "recode": true, "shmop": true, "simplexml": true, "snmp": true, "soap": true,
+	"sockets": true, "sodium": true, "sqlite3": true, "sysvmsg": true, "sysvsem": true, "sysvshm": true, "tidy": true,
+	"tokenizer": true, "wddx": true, "xml": true, "xmlreader": true, "xmlrpc": true, "xmlwriter": true, "xsl": true,
+	"zend_test": true, "zip": true, "zlib": true,
+}
+
+// parseExtension parses a compiled PHP extension within the extension_dir of a PHP installation, taking the version
+// from the PECL registry (when installed with pecl) or from the module entry compiled into the extension.
+func parseExtension(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	name := extensionName(reader.RealPath)
+	if name == "" || bundledExtensions[name] {
+		return nil, nil, nil
+	}
+
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for file: %w", err)
+	}
+
+	entry := pkg.PhpExtensionEntry{Name: name}
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	module, err := readExtensionModule(unionReader, name)
+	if err != nil {
+		// without a module entry, the shared object is not a PHP extension (e.g. a library bundled alongside one)
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read PHP extension module entry")
+		return nil, nil, nil
+	}
+	entry.Version = module.version
+	entry.ZendExtension = module.zendExtension
+	if module.zendAPI != 0 {
+		entry.APIVersion = strconv.FormatUint(uint64(module.zendAPI), 10)
+	}
+	if entry.APIVersion == "" {
+		if match := extensionDirAPIPattern.FindStringSubmatch(path.Base(path.Dir(reader.RealPath))); match != nil {
+			entry.APIVersion = match[1]
+		}
+	}
+
+	if registry := findPeclRegistryEntry(resolver, reader.Location, name); registry != nil {
+		version, license := readPeclRegistryEntry(resolver, *registry)
+		if version != "" {
+			entry.Version = version
+		}
+		if license != "" {
+			entry.License = []string{license}
+		}
+		locations = append(locations, registry.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	entry.Configuration = findExtensionConfiguration(resolver, name, entry.ZendExtension)
+	entry.Enabled = len(entry.Configuration) > 0
+
+	return []pkg.Package{newExtensionPackage(entry, locations...)}, nil, nil
+}
+
+// extensionName returns the name of the extension from the path of its shared object (e.g. "redis" for
+// ".../no-debug-non-zts-20220829/redis.so", or "redis" for "php_redis.dll" on windows)
+func extensionName(p string) string {
+	base := path.Base(p)
+	ext := path.Ext(base)
+	if ext != ".so" && ext != ".dll" {
+		return ""
+	}
+	name := strings.TrimSuffix(base, ext)
+	if ext == ".dll" {
+		name = strings.TrimPrefix(name, "php_")
+	}
+	return strings.ToLower(name)
+}
+
+func findPeclRegistryEntry(resolver file.Resolver, location file.Location, name string) *file.Location {
+	if resolver == nil {
+		return nil
+	}
+
+	// e.g. /usr/local/lib/php/extensions/no-debug-non-zts-20220829/redis.so -> /usr/local/lib/php/.registry/...
+	libDir := path.Dir(path.Dir(path.Dir(location.RealPath)))
+	for _, dir := range []string{path.Join(libDir, peclRegistryDir), debianPeclRegistryDir} {
+		if registry := resolver.RelativeFileByPath(location, path.Join(dir, name+".reg")); registry != nil {
+			return registry
+		}
+	}
+	return nil
+}
+
+func readPeclRegistryEntry(resolver file.Resolver, location file.Location) (version string, license string) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return "", ""
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", ""
+	}
+
+	metadata, err := phpserialize.UnmarshalAssociativeArray(data)
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to parse pecl registry entry")
+		return "", ""
+	}
+	return readStruct(metadata, "version", "release"), readStruct(metadata, "license", "_content")
+}
+
+// findExtensionConfiguration returns the paths of the ini files that load the extension.
+func findExtensionConfiguration(resolver file.Resolver, name string, zendExtension bool) []string {
+	if resolver == nil {
+		return nil
+	}
+
+	locations, err := resolver.FilesByGlob(extensionConfigGlobs...)
+	if err != nil {
+		log.WithFields("error", err).Trace("unable to find PHP configuration files")
+		return nil
+	}
+
+	var paths []string
+	seen := make(map[string]bool)
+	for _, location := range locations {
+		if seen[location.RealPath] {
+			continue
+		}
+		seen[location.RealPath] = true
+
+		if iniLoadsExtension(resolver, location, name, zendExtension) {
+			paths = append(paths, location.RealPath)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func iniLoadsExtension(resolver file.Resolver, location file.Location, name string, zendExtension bool) bool {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return false
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		match := extensionDirectivePattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			// note: this also skips commented directives (starting with ";")
+			continue
+		}
+
+		// extensions are loaded by name, file name, or absolute path (and zend extensions must use zend_extension)
+		directive := strings.ToLower(match[1])
+		if zendExtension && directive != "zend_extension" {
+			continue
+		}
+		value, _, _ := strings.Cut(match[2], ";")
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if extensionName(value) == name || strings.EqualFold(value, name) {
+			return true
+		}
+	}
+	return false
+}
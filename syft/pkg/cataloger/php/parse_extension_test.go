@@ -0,0 +1,103 @@
+package php
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestExtensionCataloger(t *testing.T) {
+	extensionDir := "usr/local/lib/php/extensions/no-debug-non-zts-20220829"
+	primary := func(p string) file.Location {
+		return file.NewLocation(p).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	xdebugRegistry := file.NewLocation("usr/local/lib/php/.registry/.channel.pecl.php.net/xdebug.reg")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:    "imagick",
+			Version: "3.7.0",
+			PURL:    "pkg:pecl/imagick@3.7.0",
+			// built with hidden visibility, where the module entry is found through get_module
+			Locations: file.NewLocationSet(primary(extensionDir + "/imagick.so")),
+			Language:  pkg.PHP,
+			Type:      pkg.PhpPeclPkg,
+			Metadata: pkg.PhpExtensionEntry{
+				Name:       "imagick",
+				Version:    "3.7.0",
+				APIVersion: "20220829",
+				// the directive within php.ini is commented out
+				Enabled: false,
+			},
+		},
+		{
+			Name:      "redis",
+			Version:   "6.0.2",
+			PURL:      "pkg:pecl/redis@6.0.2",
+			Locations: file.NewLocationSet(primary(extensionDir + "/redis.so")),
+			Language:  pkg.PHP,
+			Type:      pkg.PhpPeclPkg,
+			Metadata: pkg.PhpExtensionEntry{
+				Name:          "redis",
+				Version:       "6.0.2",
+				APIVersion:    "20220829",
+				Enabled:       true,
+				Configuration: []string{"usr/local/etc/php/conf.d/docker-php-ext-redis.ini"},
+			},
+		},
+		{
+			Name:    "xdebug",
+			Version: "3.3.1",
+			PURL:    "pkg:pecl/xdebug@3.3.1",
+			Locations: file.NewLocationSet(
+				primary(extensionDir+"/xdebug.so"),
+				xdebugRegistry.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation),
+			),
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("Xdebug-1.03", file.NewLocation(extensionDir+"/xdebug.so")),
+			),
+			Language: pkg.PHP,
+			Type:     pkg.PhpPeclPkg,
+			Metadata: pkg.PhpExtensionEntry{
+				Name:          "xdebug",
+				Version:       "3.3.1",
+				License:       []string{"Xdebug-1.03"},
+				APIVersion:    "20220829",
+				ZendExtension: true,
+				Enabled:       true,
+				Configuration: []string{"usr/local/etc/php/conf.d/docker-php-ext-xdebug.ini"},
+			},
+		},
+		// note: gd is bundled with PHP and is not reported
+	}
+
+	for i := range expectedPkgs {
+		expectedPkgs[i].FoundBy = "php-extension-cataloger"
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/extensions").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewExtensionCataloger())
+}
+
+func TestExtensionName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/usr/local/lib/php/extensions/no-debug-non-zts-20220829/redis.so", want: "redis"},
+		{path: "/usr/lib/php82/modules/Imagick.so", want: "imagick"},
+		{path: "C:/php/ext/php_xdebug.dll", want: "xdebug"},
+		{path: "/usr/lib/php/20220829/build/Makefile", want: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.want, extensionName(test.path))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/* a stand-in for a compiled PHP extension, mirroring the layout of zend_module_entry and zend_extension (see Makefile) */
+#include <stddef.h>
+typedef struct {
+	unsigned short size;
+	unsigned int zend_api;
+	unsigned char zend_debug;
+	unsigned char zts;
+	const void *ini_entry;
+	const void *deps;
+	const char *name;
+	const void *functions;
+	void *startup, *shutdown, *request_startup, *request_shutdown, *info;
+	const char *version;
+	size_t globals_size;
+	void *globals_ptr, *globals_ctor, *globals_dtor, *post_deactivate;
+	int module_started;
+	unsigned char type;
+	void *handle;
+	int module_number;
+	const char *build_id;
+} zend_module_entry;
+
+typedef struct {
+	const char *name;
+	const char *version;
+	const char *author;
+	const char *URL;
+	const char *copyright;
+} zend_extension;
+
+
+zend_module_entry MODULE_SYMBOL = {
+	sizeof(zend_module_entry), 20220829, 0, 0, NULL, NULL, EXT_NAME, NULL,
+	NULL, NULL, NULL, NULL, NULL, EXT_VERSION, 0, NULL, NULL, NULL, NULL, 0, 0, NULL, 0, "API20220829,NTS"
+};
+
+__attribute__((visibility("default"))) zend_module_entry *get_module(void) { return &MODULE_SYMBOL; }
+
+#ifdef ZEND_EXT
+zend_extension zend_extension_entry = { EXT_NAME, EXT_VERSION, "Derick Rethans", "https://xdebug.org/", "Copyright (c) 2002-2023 by Derick Rethans" };
+#endif
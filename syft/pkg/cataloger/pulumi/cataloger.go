@@ -0,0 +1,23 @@
+/*
+Package pulumi provides concrete Cataloger implementations for Pulumi plugins (providers, language hosts, and analyzers),
+both installed within the plugin cache and required by Pulumi projects.
+*/
+package pulumi
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewPluginCataloger returns a new cataloger object for plugins installed within the Pulumi plugin cache.
+func NewPluginCataloger() pkg.Cataloger {
+	return generic.NewCataloger("pulumi-plugin-cataloger").
+		WithParserByGlobs(parsePluginBinary, "**/.pulumi/plugins/*/pulumi-*")
+}
+
+// NewProjectCataloger returns a new cataloger object for the plugins required by Pulumi projects (Pulumi.yaml), as
+// declared within the project file and as referenced by provider SDKs within the lock file of the project runtime.
+func NewProjectCataloger() pkg.Cataloger {
+	return generic.NewCataloger("pulumi-project-cataloger").
+		WithParserByGlobs(parseProject, "**/Pulumi.yaml", "**/Pulumi.yml")
+}
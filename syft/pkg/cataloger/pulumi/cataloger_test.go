@@ -0,0 +1,131 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestPluginCataloger(t *testing.T) {
+	newPluginPackage := func(name, version, kind, binary string) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "pulumi-plugin-cataloger",
+			Locations: file.NewLocationSet(file.NewLocation("root/.pulumi/plugins/" + kind + "-" + name + "-v" + version + "/" + binary)),
+			PURL:      "pkg:pulumi/" + name + "@" + version + "?kind=" + kind,
+			Type:      pkg.PulumiPluginPkg,
+			Metadata:  pkg.PulumiPluginEntry{Kind: kind},
+		}
+	}
+
+	// note: other files within the plugin directories (such as the plugin signature) are not considered
+	expectedPkgs := []pkg.Package{
+		newPluginPackage("aws", "6.45.0", "resource", "pulumi-resource-aws"),
+		newPluginPackage("azure-native", "2.50.0", "resource", "pulumi-resource-azure-native"),
+		newPluginPackage("python", "3.120.0", "language", "pulumi-language-python"),
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/plugins").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewPluginCataloger())
+}
+
+func TestProjectCataloger(t *testing.T) {
+	newProjectPackage := func(name, version, kind, purl string, entry pkg.PulumiProjectEntry, locations ...file.Location) pkg.Package {
+		entry.Kind = kind
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			FoundBy:   "pulumi-project-cataloger",
+			Locations: file.NewLocationSet(locations...),
+			PURL:      purl,
+			Type:      pkg.PulumiPluginPkg,
+			Metadata:  entry,
+		}
+	}
+
+	nodejsProject := file.NewLocation("nodejs/Pulumi.yaml")
+	nodejsLock := file.NewLocation("nodejs/package-lock.json")
+	pythonProject := file.NewLocation("python/Pulumi.yaml")
+	pythonLock := file.NewLocation("python/requirements.txt")
+	goProject := file.NewLocation("go/Pulumi.yaml")
+	goLock := file.NewLocation("go/go.mod")
+	yamlProject := file.NewLocation("yaml/Pulumi.yaml")
+
+	expectedPkgs := []pkg.Package{
+		// provider SDKs referenced by lock files (the core SDKs are not considered)
+		newProjectPackage("aws", "6.45.0", "resource", "pkg:pulumi/aws@6.45.0?kind=resource",
+			pkg.PulumiProjectEntry{Project: "web-infra", Runtime: "nodejs", SDKPackage: "@pulumi/aws"},
+			nodejsLock, nodejsProject),
+		newProjectPackage("random", "4.16.3", "resource", "pkg:pulumi/random@4.16.3?kind=resource",
+			pkg.PulumiProjectEntry{Project: "web-infra", Runtime: "nodejs", SDKPackage: "@pulumi/random"},
+			nodejsLock, nodejsProject),
+		newProjectPackage("gcp", "7.30.0", "resource", "pkg:pulumi/gcp@7.30.0?kind=resource",
+			pkg.PulumiProjectEntry{Project: "data-pipeline", Runtime: "python", SDKPackage: "pulumi-gcp"},
+			pythonLock, pythonProject),
+		newProjectPackage("kubernetes", "", "resource", "pkg:pulumi/kubernetes?kind=resource",
+			pkg.PulumiProjectEntry{Project: "data-pipeline", Runtime: "python", SDKPackage: "pulumi-kubernetes"},
+			pythonLock, pythonProject),
+		newProjectPackage("azure-native", "2.50.0", "resource", "pkg:pulumi/azure-native@2.50.0?kind=resource",
+			pkg.PulumiProjectEntry{Project: "cluster", Runtime: "go", SDKPackage: "github.com/pulumi/pulumi-azure-native/sdk/v2"},
+			goLock, goProject),
+		newProjectPackage("tls", "5.0.3", "resource", "pkg:pulumi/tls@5.0.3?kind=resource",
+			pkg.PulumiProjectEntry{Project: "cluster", Runtime: "go", SDKPackage: "github.com/pulumi/pulumi-tls/sdk/v5"},
+			goLock, goProject),
+		// plugins declared within the project file
+		newProjectPackage("aws", "6.45.0", "resource", "pkg:pulumi/aws@6.45.0?kind=resource",
+			pkg.PulumiProjectEntry{Project: "static-site", Runtime: "yaml"},
+			yamlProject),
+		newProjectPackage("mycloud", "", "resource", "pkg:pulumi/mycloud?kind=resource",
+			pkg.PulumiProjectEntry{Project: "static-site", Runtime: "yaml", Path: "./bin"},
+			yamlProject),
+		newProjectPackage("policy-pack", "1.2.0", "analyzer", "pkg:pulumi/policy-pack@1.2.0?kind=analyzer",
+			pkg.PulumiProjectEntry{Project: "static-site", Runtime: "yaml"},
+			yamlProject),
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/project").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewProjectCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain plugin binaries",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewPluginCataloger(),
+			expected: []string{
+				"root/.pulumi/plugins/resource-x-v1.0.0/pulumi-resource-x",
+			},
+		},
+		{
+			name:      "obtain project files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewProjectCataloger(),
+			expected: []string{
+				"srv/app/Pulumi.yaml",
+				"srv/other/Pulumi.yml",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
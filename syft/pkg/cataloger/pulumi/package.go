@@ -0,0 +1,40 @@
+package pulumi
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name, version, kind string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version, kind),
+		Type:      pkg.PulumiPluginPkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL for a plugin, qualified by the kind of plugin
+// (e.g. pkg:pulumi/aws@6.45.0?kind=resource).
+func packageURL(name, version, kind string) string {
+	var qualifiers packageurl.Qualifiers
+	if kind != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{"kind": kind})
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.PulumiPluginPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		qualifiers,
+		"",
+	).ToString()
+}
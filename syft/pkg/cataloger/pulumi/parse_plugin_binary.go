@@ -0,0 +1,46 @@
+package pulumi
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePluginBinary
+
+// pluginDirPattern matches the name of a plugin directory within the plugin cache, such as "resource-aws-v6.45.0"
+// or "resource-azure-native-v2.50.0".
+var pluginDirPattern = regexp.MustCompile(`^(?P<kind>resource|language|analyzer|converter|tool)-(?P<name>.+)-v(?P<version>\d[^/]*)$`)
+
+// parsePluginBinary is a parser function for the executable of a plugin within the Pulumi plugin cache, where the
+// plugin kind, name, and version are taken from the directory the plugin is installed into.
+func parsePluginBinary(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	dir, binary := path.Split(reader.Location.RealPath)
+
+	match := pluginDirPattern.FindStringSubmatch(path.Base(dir))
+	if match == nil {
+		return nil, nil, nil
+	}
+	kind, name, version := match[1], match[2], match[3]
+
+	// only consider the plugin executable itself (not other files within the plugin directory)
+	if strings.TrimSuffix(binary, ".exe") != "pulumi-"+kind+"-"+name {
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newPackage(
+			name,
+			version,
+			kind,
+			pkg.PulumiPluginEntry{Kind: kind},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
@@ -0,0 +1,172 @@
+package pulumi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseProject
+
+type project struct {
+	Name    string    `yaml:"name"`
+	Runtime yaml.Node `yaml:"runtime"`
+	Plugins struct {
+		Providers []projectPlugin `yaml:"providers"`
+		Languages []projectPlugin `yaml:"languages"`
+		Analyzers []projectPlugin `yaml:"analyzers"`
+	} `yaml:"plugins"`
+}
+
+type projectPlugin struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Path    string `yaml:"path"`
+}
+
+// sdkReference is a provider SDK found within the lock file of a project language runtime.
+type sdkReference struct {
+	plugin  string
+	pkg     string
+	version string
+}
+
+// lockFileParsers are the lock files (relative to the project file) that reference provider SDKs for each runtime.
+var lockFileParsers = map[string]struct {
+	filename string
+	parse    func(io.Reader) ([]sdkReference, error)
+}{
+	"nodejs": {filename: "package-lock.json", parse: parseNpmLockReferences},
+	"python": {filename: "requirements.txt", parse: parseRequirementsReferences},
+	"go":     {filename: "go.mod", parse: parseGoModReferences},
+}
+
+// parseProject is a parser function for a Pulumi project file (Pulumi.yaml), returning the plugins declared within
+// the project file as well as the resource plugins referenced by provider SDKs within the lock file of the project
+// runtime (e.g. "@pulumi/aws" within package-lock.json for a nodejs project).
+func parseProject(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var proj project
+	if err := yaml.NewDecoder(reader).Decode(&proj); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Pulumi project file: %w", err)
+	}
+
+	if proj.Name == "" {
+		return nil, nil, nil
+	}
+
+	runtime := runtimeName(proj.Runtime)
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	declared := make(map[string]bool)
+	for _, plugins := range []struct {
+		kind    string
+		plugins []projectPlugin
+	}{
+		{kind: "resource", plugins: proj.Plugins.Providers},
+		{kind: "language", plugins: proj.Plugins.Languages},
+		{kind: "analyzer", plugins: proj.Plugins.Analyzers},
+	} {
+		for _, p := range plugins.plugins {
+			if p.Name == "" {
+				continue
+			}
+			declared[plugins.kind+"/"+p.Name] = true
+			pkgs = append(pkgs, newPackage(
+				p.Name,
+				p.Version,
+				plugins.kind,
+				pkg.PulumiProjectEntry{
+					Project: proj.Name,
+					Runtime: runtime,
+					Kind:    plugins.kind,
+					Path:    p.Path,
+				},
+				location,
+			))
+		}
+	}
+
+	for _, ref := range findSDKReferences(resolver, reader.Location, runtime) {
+		// plugins explicitly declared within the project file take precedence
+		if declared["resource/"+ref.plugin] {
+			continue
+		}
+		pkgs = append(pkgs, newPackage(
+			ref.plugin,
+			ref.version,
+			"resource",
+			pkg.PulumiProjectEntry{
+				Project:    proj.Name,
+				Runtime:    runtime,
+				Kind:       "resource",
+				SDKPackage: ref.pkg,
+			},
+			ref.location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+// runtimeName returns the runtime name, which may be given as a plain string or as a mapping with options.
+func runtimeName(node yaml.Node) string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Value
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "name" {
+				return node.Content[i+1].Value
+			}
+		}
+	}
+	return ""
+}
+
+type locatedSDKReference struct {
+	sdkReference
+	location file.Location
+}
+
+func findSDKReferences(resolver file.Resolver, projectLocation file.Location, runtime string) []locatedSDKReference {
+	lockParser, ok := lockFileParsers[runtime]
+	if !ok || resolver == nil {
+		return nil
+	}
+
+	lockLocation := resolver.RelativeFileByPath(projectLocation, path.Join(path.Dir(projectLocation.RealPath), lockParser.filename))
+	if lockLocation == nil {
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*lockLocation)
+	if err != nil {
+		log.WithFields("path", lockLocation.RealPath, "error", err).Debug("unable to read Pulumi project lock file")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, lockLocation.RealPath)
+
+	refs, err := lockParser.parse(contents)
+	if err != nil {
+		log.WithFields("path", lockLocation.RealPath, "error", err).Debug("unable to parse Pulumi project lock file")
+		return nil
+	}
+
+	var results []locatedSDKReference
+	for _, ref := range refs {
+		results = append(results, locatedSDKReference{sdkReference: ref, location: *lockLocation})
+	}
+	return results
+}
@@ -0,0 +1,136 @@
+package pulumi
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// nonProviderSDKs are packages published alongside provider SDKs that do not correspond to resource plugins.
+var nonProviderSDKs = map[string]bool{
+	"pulumi": true,
+	"policy": true,
+}
+
+// requirementPattern matches a provider SDK requirement within a requirements.txt, such as "pulumi-aws==6.45.0".
+var requirementPattern = regexp.MustCompile(`(?i)^pulumi[-_](?P<name>[a-z0-9][a-z0-9_.-]*)\s*(?:(?P<op>===?|[~<>!]=?)\s*(?P<version>[^\s;,#]+))?`)
+
+// goModulePattern matches the module path of a provider SDK, such as "github.com/pulumi/pulumi-aws/sdk/v6".
+var goModulePattern = regexp.MustCompile(`^github\.com/pulumi/pulumi-(?P<name>[^/]+)/sdk(/v\d+)?$`)
+
+// parseNpmLockReferences returns the provider SDKs (@pulumi/<name>) installed according to a package-lock.json.
+func parseNpmLockReferences(reader io.Reader) ([]sdkReference, error) {
+	var lock struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+		} `json:"packages"`
+		Dependencies map[string]struct {
+			Version string `json:"version"`
+		} `json:"dependencies"`
+	}
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, fmt.Errorf("failed to parse package-lock.json: %w", err)
+	}
+
+	versions := make(map[string]string)
+	for key, p := range lock.Packages {
+		// only top-level installations are considered (lockfileVersion 2 and 3)
+		if name, ok := strings.CutPrefix(key, "node_modules/"); ok && !strings.Contains(name, "/node_modules/") {
+			versions[name] = p.Version
+		}
+	}
+	for name, p := range lock.Dependencies {
+		// lockfileVersion 1
+		if _, exists := versions[name]; !exists {
+			versions[name] = p.Version
+		}
+	}
+
+	var refs []sdkReference
+	for name, version := range versions {
+		plugin, ok := strings.CutPrefix(name, "@pulumi/")
+		if !ok || nonProviderSDKs[plugin] {
+			continue
+		}
+		refs = append(refs, sdkReference{plugin: plugin, pkg: name, version: version})
+	}
+
+	return sortedReferences(refs), nil
+}
+
+// parseRequirementsReferences returns the provider SDKs (pulumi-<name>) required within a requirements.txt, with the
+// version only when pinned.
+func parseRequirementsReferences(reader io.Reader) ([]sdkReference, error) {
+	var refs []sdkReference
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		match := requirementPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		plugin := strings.ReplaceAll(strings.ToLower(match[1]), "_", "-")
+		if nonProviderSDKs[plugin] {
+			continue
+		}
+
+		var version string
+		if match[2] == "==" || match[2] == "===" {
+			version = match[3]
+		}
+
+		refs = append(refs, sdkReference{
+			plugin:  plugin,
+			pkg:     "pulumi-" + plugin,
+			version: version,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse requirements.txt: %w", err)
+	}
+
+	return sortedReferences(refs), nil
+}
+
+// parseGoModReferences returns the provider SDKs (github.com/pulumi/pulumi-<name>/sdk) required within a go.mod.
+func parseGoModReferences(reader io.Reader) ([]sdkReference, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := modfile.Parse("go.mod", contents, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	var refs []sdkReference
+	for _, req := range f.Require {
+		match := goModulePattern.FindStringSubmatch(req.Mod.Path)
+		if match == nil {
+			continue
+		}
+		refs = append(refs, sdkReference{
+			plugin:  match[1],
+			pkg:     req.Mod.Path,
+			version: strings.TrimPrefix(req.Mod.Version, "v"),
+		})
+	}
+
+	return sortedReferences(refs), nil
+}
+
+func sortedReferences(refs []sdkReference) []sdkReference {
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].plugin < refs[j].plugin
+	})
+	return refs
+}
@@ -0,0 +1,10 @@
+module github.com/example/cluster
+
+go 1.22
+
+require (
+	github.com/pulumi/pulumi-azure-native/sdk/v2 v2.50.0
+	github.com/pulumi/pulumi/sdk/v3 v3.120.0
+)
+
+require github.com/pulumi/pulumi-tls/sdk/v5 v5.0.3 // indirect
@@ -0,0 +1,26 @@
+/*
+Package puppet provides concrete Cataloger implementations for Puppet modules installed from (or resolved against) the
+Puppet Forge.
+*/
+package puppet
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewModuleCataloger returns a new cataloger object for installed Puppet modules (metadata.json within a module path).
+func NewModuleCataloger() pkg.Cataloger {
+	return generic.NewCataloger("puppet-module-cataloger").
+		WithParserByGlobs(parseModuleMetadata, "**/modules/*/metadata.json").
+		WithProcessors(dependency.Processor(dependencySpecifier))
+}
+
+// NewPuppetfileLockCataloger returns a new cataloger object for the modules resolved within a librarian-puppet
+// Puppetfile.lock.
+func NewPuppetfileLockCataloger() pkg.Cataloger {
+	return generic.NewCataloger("puppetfile-lock-cataloger").
+		WithParserByGlobs(parsePuppetfileLock, "**/Puppetfile.lock").
+		WithProcessors(dependency.Processor(dependencySpecifier))
+}
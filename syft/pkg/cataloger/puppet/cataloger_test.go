@@ -0,0 +1,210 @@
+package puppet
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestModuleCataloger(t *testing.T) {
+	aptLocation := file.NewLocation("etc/puppetlabs/code/environments/production/modules/apt/metadata.json")
+	concatLocation := file.NewLocation("etc/puppetlabs/code/environments/production/modules/concat/metadata.json")
+	stdlibLocation := file.NewLocation("etc/puppetlabs/code/environments/production/modules/stdlib/metadata.json")
+
+	apt := pkg.Package{
+		Name:      "puppetlabs-apt",
+		Version:   "9.4.0",
+		FoundBy:   "puppet-module-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", aptLocation)),
+		Locations: file.NewLocationSet(aptLocation),
+		PURL:      "pkg:puppet/puppetlabs/apt@9.4.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetModuleMetadata{
+			Author:      "puppetlabs",
+			Summary:     "Provides an interface for managing Apt source, key, and definitions with Puppet",
+			Source:      "https://github.com/puppetlabs/puppetlabs-apt",
+			ProjectPage: "https://github.com/puppetlabs/puppetlabs-apt",
+			Dependencies: []pkg.PuppetModuleDependency{
+				{
+					Name:               "puppetlabs-stdlib",
+					VersionRequirement: ">= 9.0.0 < 10.0.0",
+				},
+			},
+		},
+	}
+
+	// note: older modules use "/" as the separator within the module name
+	concat := pkg.Package{
+		Name:      "puppetlabs-concat",
+		Version:   "9.0.2",
+		FoundBy:   "puppet-module-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", concatLocation)),
+		Locations: file.NewLocationSet(concatLocation),
+		PURL:      "pkg:puppet/puppetlabs/concat@9.0.2",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetModuleMetadata{
+			Author:  "puppetlabs",
+			Summary: "Construct files from multiple fragments.",
+			Source:  "https://github.com/puppetlabs/puppetlabs-concat",
+			Dependencies: []pkg.PuppetModuleDependency{
+				{
+					Name:               "puppetlabs-stdlib",
+					VersionRequirement: ">= 9.0.0 < 10.0.0",
+				},
+			},
+		},
+	}
+
+	stdlib := pkg.Package{
+		Name:      "puppetlabs-stdlib",
+		Version:   "9.6.0",
+		FoundBy:   "puppet-module-cataloger",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", stdlibLocation)),
+		Locations: file.NewLocationSet(stdlibLocation),
+		PURL:      "pkg:puppet/puppetlabs/stdlib@9.6.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetModuleMetadata{
+			Author:      "puppetlabs",
+			Summary:     "Standard library of resources for Puppet modules.",
+			Source:      "https://github.com/puppetlabs/puppetlabs-stdlib",
+			ProjectPage: "https://github.com/puppetlabs/puppetlabs-stdlib",
+		},
+	}
+
+	// note: local (site) modules without a Forge name are not cataloged
+	expectedPkgs := []pkg.Package{apt, concat, stdlib}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: stdlib,
+			To:   apt,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: stdlib,
+			To:   concat,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewModuleCataloger())
+}
+
+func TestPuppetfileLockCataloger(t *testing.T) {
+	location := file.NewLocation("Puppetfile.lock")
+
+	apt := pkg.Package{
+		Name:      "puppetlabs-apt",
+		Version:   "9.4.0",
+		FoundBy:   "puppetfile-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:puppet/puppetlabs/apt@9.4.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetfileLockEntry{
+			SourceType:   pkg.PuppetForgeSource,
+			Source:       "https://forgeapi.puppetlabs.com",
+			Dependencies: []string{"puppetlabs-stdlib"},
+		},
+	}
+
+	stdlib := pkg.Package{
+		Name:      "puppetlabs-stdlib",
+		Version:   "9.6.0",
+		FoundBy:   "puppetfile-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:puppet/puppetlabs/stdlib@9.6.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetfileLockEntry{
+			SourceType: pkg.PuppetForgeSource,
+			Source:     "https://forgeapi.puppetlabs.com",
+		},
+	}
+
+	nginx := pkg.Package{
+		Name:      "puppet-nginx",
+		Version:   "5.0.0",
+		FoundBy:   "puppetfile-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:puppet/puppet/nginx@5.0.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetfileLockEntry{
+			SourceType:   pkg.PuppetGitSource,
+			Source:       "https://github.com/voxpupuli/puppet-nginx.git",
+			Ref:          "v5.0.0",
+			Commit:       "6c9a1b8e2a3f4d5e6f7a8b9c0d1e2f3a4b5c6d7e",
+			Dependencies: []string{"puppetlabs-concat", "puppetlabs-stdlib"},
+		},
+	}
+
+	profile := pkg.Package{
+		Name:      "site-profile",
+		Version:   "0.1.0",
+		FoundBy:   "puppetfile-lock-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:puppet/site/profile@0.1.0",
+		Type:      pkg.PuppetModulePkg,
+		Metadata: pkg.PuppetfileLockEntry{
+			SourceType: pkg.PuppetPathSource,
+			Source:     "site/profile",
+		},
+	}
+
+	expectedPkgs := []pkg.Package{apt, stdlib, nginx, profile}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: stdlib,
+			To:   apt,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: stdlib,
+			To:   nginx,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/lock").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewPuppetfileLockCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
+	}{
+		{
+			name:      "obtain installed module metadata",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewModuleCataloger(),
+			expected: []string{
+				"etc/puppetlabs/code/modules/x/metadata.json",
+			},
+		},
+		{
+			name:      "obtain Puppetfile.lock files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewPuppetfileLockCataloger(),
+			expected: []string{
+				"opt/control-repo/Puppetfile.lock",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, test.cataloger)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package puppet
+
+import (
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = dependencySpecifier
+
+// dependencySpecifier describes the dependencies between modules by their full Forge name.
+func dependencySpecifier(p pkg.Package) dependency.Specification {
+	var requires []string
+	switch meta := p.Metadata.(type) {
+	case pkg.PuppetModuleMetadata:
+		for _, dep := range meta.Dependencies {
+			requires = append(requires, dep.Name)
+		}
+	case pkg.PuppetfileLockEntry:
+		requires = meta.Dependencies
+	default:
+		log.Tracef("cataloger failed to extract puppet metadata for package %+v", p.Name)
+		return dependency.Specification{}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{p.Name},
+			Requires: requires,
+		},
+	}
+}
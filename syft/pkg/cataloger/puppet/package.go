@@ -0,0 +1,49 @@
+package puppet
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name, version string, licenses []string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(locations[0].WithoutAnnotations(), licenses...)...),
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Type:      pkg.PuppetModulePkg,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// normalizeName returns the full Forge name of a module (e.g. "puppetlabs-stdlib"), since older tooling and metadata
+// may use a "/" as the separator between the author and the module name (e.g. "puppetlabs/stdlib").
+func normalizeName(name string) string {
+	return strings.Replace(strings.TrimSpace(name), "/", "-", 1)
+}
+
+// packageURL returns the package URL for a module, where the Forge author is the purl namespace
+// (e.g. pkg:puppet/puppetlabs/stdlib@9.6.0).
+func packageURL(name, version string) string {
+	namespace, shortName, found := strings.Cut(name, "-")
+	if !found {
+		namespace, shortName = "", name
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.PuppetModulePkg.PackageURLType(),
+		namespace,
+		shortName,
+		version,
+		nil,
+		"",
+	).ToString()
+}
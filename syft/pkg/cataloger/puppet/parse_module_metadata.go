@@ -0,0 +1,79 @@
+package puppet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseModuleMetadata
+
+type moduleMetadata struct {
+	Name         string             `json:"name"`
+	Version      string             `json:"version"`
+	Author       string             `json:"author"`
+	Summary      string             `json:"summary"`
+	License      string             `json:"license"`
+	Source       string             `json:"source"`
+	ProjectPage  string             `json:"project_page"`
+	Dependencies []moduleDependency `json:"dependencies"`
+}
+
+type moduleDependency struct {
+	Name               string `json:"name"`
+	VersionRequirement string `json:"version_requirement"`
+}
+
+// parseModuleMetadata is a parser function for the metadata.json of an installed Puppet module (as installed by
+// "puppet module install", r10k, or librarian-puppet).
+func parseModuleMetadata(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var metadata moduleMetadata
+	if err := json.NewDecoder(reader).Decode(&metadata); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse puppet module metadata.json: %w", err)
+	}
+
+	// a Forge module name is always qualified by the author (e.g. "puppetlabs-stdlib"), which distinguishes module
+	// metadata from any other metadata.json that happens to be within a "modules" directory
+	name := normalizeName(metadata.Name)
+	if !strings.Contains(name, "-") || metadata.Version == "" {
+		return nil, nil, nil
+	}
+
+	var deps []pkg.PuppetModuleDependency
+	for _, dep := range metadata.Dependencies {
+		if dep.Name == "" {
+			continue
+		}
+		deps = append(deps, pkg.PuppetModuleDependency{
+			Name:               normalizeName(dep.Name),
+			VersionRequirement: dep.VersionRequirement,
+		})
+	}
+
+	var licenses []string
+	if metadata.License != "" {
+		licenses = append(licenses, metadata.License)
+	}
+
+	return []pkg.Package{
+		newPackage(
+			name,
+			metadata.Version,
+			licenses,
+			pkg.PuppetModuleMetadata{
+				Author:       metadata.Author,
+				Summary:      metadata.Summary,
+				Source:       metadata.Source,
+				ProjectPage:  metadata.ProjectPage,
+				Dependencies: deps,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
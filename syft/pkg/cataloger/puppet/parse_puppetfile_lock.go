@@ -0,0 +1,126 @@
+package puppet
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePuppetfileLock
+
+// lockSourceTypes maps the sections of a Puppetfile.lock that describe resolved modules to the kind of source.
+var lockSourceTypes = map[string]string{
+	"FORGE": pkg.PuppetForgeSource,
+	"GIT":   pkg.PuppetGitSource,
+	"PATH":  pkg.PuppetPathSource,
+}
+
+type lockModule struct {
+	name    string
+	version string
+	entry   pkg.PuppetfileLockEntry
+}
+
+// parsePuppetfileLock is a parser function for Puppetfile.lock contents (written by librarian-puppet), returning all
+// modules resolved from the Forge, git repositories, and local paths. The format follows Gemfile.lock:
+//
+//	FORGE
+//	  remote: https://forgeapi.puppetlabs.com
+//	  specs:
+//	    puppetlabs-apt (9.1.0)
+//	      puppetlabs-stdlib (>= 4.16.0, < 10.0.0)
+//	    puppetlabs-stdlib (9.4.1)
+func parsePuppetfileLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var modules []lockModule
+	var source pkg.PuppetfileLockEntry
+	var inSpecs bool
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		switch indent := len(line) - len(strings.TrimLeft(line, " ")); indent {
+		case 0:
+			// start of section
+			source = pkg.PuppetfileLockEntry{SourceType: lockSourceTypes[trimmed]}
+			inSpecs = false
+		case 2:
+			if source.SourceType == "" {
+				continue
+			}
+			key, value, _ := strings.Cut(trimmed, ":")
+			value = strings.TrimSpace(value)
+			switch key {
+			case "remote":
+				source.Source = value
+			case "ref":
+				source.Ref = value
+			case "sha":
+				source.Commit = value
+			case "specs":
+				inSpecs = true
+			}
+		case 4:
+			if source.SourceType == "" || !inSpecs {
+				continue
+			}
+			name, version := parseSpec(trimmed)
+			if name == "" {
+				continue
+			}
+			modules = append(modules, lockModule{
+				name:    name,
+				version: version,
+				entry:   source,
+			})
+		case 6:
+			if source.SourceType == "" || !inSpecs || len(modules) == 0 {
+				continue
+			}
+			name, _ := parseSpec(trimmed)
+			if name == "" {
+				continue
+			}
+			current := &modules[len(modules)-1]
+			current.entry.Dependencies = append(current.entry.Dependencies, name)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Puppetfile.lock: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, m := range modules {
+		pkgs = append(pkgs,
+			newPackage(
+				m.name,
+				m.version,
+				nil,
+				m.entry,
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		)
+	}
+
+	return pkgs, nil, nil
+}
+
+// parseSpec splits a spec line such as "puppetlabs-stdlib (9.4.1)" into the normalized module name and the version
+// (or version constraint) within parenthesis.
+func parseSpec(spec string) (string, string) {
+	name, rest, _ := strings.Cut(spec, " ")
+	version := strings.TrimSpace(rest)
+	version = strings.TrimSuffix(strings.TrimPrefix(version, "("), ")")
+	return normalizeName(name), version
+}
@@ -4,6 +4,7 @@ Package python provides a concrete Cataloger implementation relating to packages
 package python
 
 import (
+	"github.com/anchore/syft/internal/mimetype"
 	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/generic"
 )
@@ -42,3 +43,18 @@ func NewInstalledPackageCataloger() pkg.Cataloger {
 			"**/*EGG-INFO/PKG-INFO",
 		).WithResolvingProcessors(wheelEggRelationships)
 }
+
+// NewPipxCataloger returns a new cataloger for python packages installed with pipx (and the packages injected into
+// their virtual environments), as recorded within the pipx_metadata.json file of each virtual environment.
+func NewPipxCataloger() pkg.Cataloger {
+	return generic.NewCataloger("python-pipx-cataloger").
+		WithParserByGlobs(parsePipxMetadata, "**/venvs/*/pipx_metadata.json")
+}
+
+// NewFrozenApplicationCataloger returns a new cataloger for python packages bundled within frozen applications:
+// executables produced by PyInstaller or py2exe, and zip applications produced by pex, shiv, or zipapp.
+func NewFrozenApplicationCataloger() pkg.Cataloger {
+	return generic.NewCataloger("python-frozen-application-cataloger").
+		WithParserByMimeTypes(parseFrozenExecutable, mimetype.ExecutableMIMETypeSet.List()...).
+		WithParserByGlobs(parseZipApplication, "**/*.pex", "**/*.pyz", "**/library.zip")
+}
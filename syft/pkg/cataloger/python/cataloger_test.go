@@ -276,6 +276,107 @@ func Test_PackageCataloger(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "editable install with setuptools finder hook",
+			fixtures: []string{
+				"test-fixtures/editable/site-packages/example_app-0.1.0.dist-info/METADATA",
+				"test-fixtures/editable/site-packages/example_app-0.1.0.dist-info/RECORD",
+				"test-fixtures/editable/site-packages/example_app-0.1.0.dist-info/direct_url.json",
+				"test-fixtures/editable/site-packages/__editable__.example_app-0.1.0.pth",
+				"test-fixtures/editable/site-packages/__editable___example_app_0_1_0_finder.py",
+			},
+			expectedPackage: pkg.Package{
+				Name:     "example-app",
+				Version:  "0.1.0",
+				PURL:     "pkg:pypi/example-app@0.1.0",
+				Type:     pkg.PythonPkg,
+				Language: pkg.Python,
+				FoundBy:  "python-installed-package-cataloger",
+				Metadata: pkg.PythonPackage{
+					Name:                 "example-app",
+					Version:              "0.1.0",
+					SitePackagesRootPath: "test-fixtures/editable/site-packages",
+					RequiresPython:       ">=3.8",
+					Files: []pkg.PythonFileRecord{
+						{Path: "__editable__.example_app-0.1.0.pth", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "Y2vj3BKNWq7bnJ4IOr0yl5hXyM0FAy4dcVZ_pY5gPQQ"}, Size: "85"},
+						{Path: "__editable___example_app_0_1_0_finder.py", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "3y_XHzA7eD1dl9G1Gg0VK-2T6e4uFvD0fpfq4xGx0ds"}, Size: "3456"},
+						{Path: "example_app-0.1.0.dist-info/INSTALLER", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "zuuue4knoyJ-UwPPXg8fezS7VCrXJQrAP7zeNuwvFQg"}, Size: "4"},
+						{Path: "example_app-0.1.0.dist-info/METADATA", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "xN5CDzG2x5kLh0Oh0n1fC8L1r9rV_3Fq7m2nUbYfU1I"}, Size: "146"},
+						{Path: "example_app-0.1.0.dist-info/RECORD"},
+						{Path: "example_app-0.1.0.dist-info/direct_url.json", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "oPtJpW3Oa8F6r8VpEKX1NCzTFSv0Y8G1qzYkP0T1uPk"}, Size: "67"},
+					},
+					DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+						URL:      "file:///home/dev/example-app",
+						Editable: true,
+						EditablePaths: []string{
+							"/home/dev/example-app/src/example_app",
+							"/home/dev/example-app/src/example_plugins",
+						},
+					},
+				},
+			},
+		},
+		{
+			// hatchling (and others) install a .pth file listing the source path, and without a direct_url.json the
+			// source path is the best indication of where the package came from
+			name: "editable install with path hook",
+			fixtures: []string{
+				"test-fixtures/editable/site-packages/greeting-1.2.0.dist-info/METADATA",
+				"test-fixtures/editable/site-packages/greeting-1.2.0.dist-info/RECORD",
+				"test-fixtures/editable/site-packages/_greeting.pth",
+			},
+			expectedPackage: pkg.Package{
+				Name:     "greeting",
+				Version:  "1.2.0",
+				PURL:     "pkg:pypi/greeting@1.2.0",
+				Type:     pkg.PythonPkg,
+				Language: pkg.Python,
+				FoundBy:  "python-installed-package-cataloger",
+				Metadata: pkg.PythonPackage{
+					Name:                 "greeting",
+					Version:              "1.2.0",
+					SitePackagesRootPath: "test-fixtures/editable/site-packages",
+					Files: []pkg.PythonFileRecord{
+						{Path: "_greeting.pth", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "5yzjU4h4lZ0R0L5L6b6E0VqC2w6p7RdqzJ0y1kCjU2c"}, Size: "23"},
+						{Path: "greeting-1.2.0.dist-info/INSTALLER", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "zuuue4knoyJ-UwPPXg8fezS7VCrXJQrAP7zeNuwvFQg"}, Size: "4"},
+						{Path: "greeting-1.2.0.dist-info/METADATA", Digest: &pkg.PythonFileDigest{Algorithm: "sha256", Value: "6mJ9hM0Wm3E4o5mJ6i4C3S5Vq1b8X5kz8nP7oXn1mPA"}, Size: "115"},
+						{Path: "greeting-1.2.0.dist-info/RECORD"},
+					},
+					DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+						URL:           "file:///home/dev/greeting/src",
+						Editable:      true,
+						EditablePaths: []string{"/home/dev/greeting/src"},
+					},
+				},
+			},
+		},
+		{
+			name: "vcs install from a subdirectory of a repository",
+			fixtures: []string{
+				"test-fixtures/vcs/site-packages/tool-2.0.0.dist-info/METADATA",
+				"test-fixtures/vcs/site-packages/tool-2.0.0.dist-info/direct_url.json",
+			},
+			expectedPackage: pkg.Package{
+				Name:     "tool",
+				Version:  "2.0.0",
+				PURL:     "pkg:pypi/tool@2.0.0?vcs_url=git%2Bhttps://github.com/example/monorepo.git%40bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				Type:     pkg.PythonPkg,
+				Language: pkg.Python,
+				FoundBy:  "python-installed-package-cataloger",
+				Metadata: pkg.PythonPackage{
+					Name:                 "tool",
+					Version:              "2.0.0",
+					SitePackagesRootPath: "test-fixtures/vcs/site-packages",
+					DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+						URL:               "https://github.com/example/monorepo.git",
+						VCS:               "git",
+						CommitID:          "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+						RequestedRevision: "v2.0.0",
+						Subdirectory:      "libs/tool",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -691,3 +792,28 @@ func stringPackage(p pkg.Package) string {
 
 	return fmt.Sprintf("%s @ %s (%s)", p.Name, p.Version, loc)
 }
+
+func Test_PipxCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain pipx metadata files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"pipx/venvs/black/pipx_metadata.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewPipxCataloger())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package python
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// marshal type codes (see Python/marshal.c within the CPython source), limited to those needed to read the table of
+// contents of a PyInstaller PYZ archive
+const (
+	marshalNull          = '0'
+	marshalNone          = 'N'
+	marshalFalse         = 'F'
+	marshalTrue          = 'T'
+	marshalInt           = 'i'
+	marshalLong          = 'l'
+	marshalString        = 's'
+	marshalInterned      = 't'
+	marshalRef           = 'r'
+	marshalTuple         = '('
+	marshalSmallTuple    = ')'
+	marshalList          = '['
+	marshalDict          = '{'
+	marshalUnicode       = 'u'
+	marshalASCII         = 'a'
+	marshalASCIIInterned = 'A'
+	marshalShortASCII    = 'z'
+	marshalShortASCIIInt = 'Z'
+
+	// marshalFlagRef is set on the type code of objects that may be referred to later (by a marshalRef object)
+	marshalFlagRef = 0x80
+
+	// marshalMaxDepth guards against deeply nested (or malicious) input
+	marshalMaxDepth = 32
+)
+
+// marshalReader decodes objects serialized with the Python marshal module, where strings are decoded as Go strings,
+// integers as int64, tuples and lists as []any, dicts as []marshalDictItem (retaining their order), and None as nil.
+type marshalReader struct {
+	data []byte
+	pos  int
+	refs []any
+}
+
+type marshalDictItem struct {
+	Key   any
+	Value any
+}
+
+func unmarshalPython(data []byte) (any, error) {
+	r := &marshalReader{data: data}
+	return r.object(0)
+}
+
+func (r *marshalReader) object(depth int) (any, error) {
+	if depth > marshalMaxDepth {
+		return nil, fmt.Errorf("marshal data is nested too deeply")
+	}
+
+	code, err := r.byte()
+	if err != nil {
+		return nil, err
+	}
+	flagged := code&marshalFlagRef != 0
+	code &^= marshalFlagRef
+
+	// reserve the reference index before reading the contents, since containers are referenced before their items
+	refIndex := -1
+	if flagged {
+		refIndex = len(r.refs)
+		r.refs = append(r.refs, nil)
+	}
+
+	value, err := r.value(code, depth)
+	if err != nil {
+		return nil, err
+	}
+	if refIndex >= 0 {
+		r.refs[refIndex] = value
+	}
+	return value, nil
+}
+
+//nolint:funlen
+func (r *marshalReader) value(code byte, depth int) (any, error) {
+	switch code {
+	case marshalNone, marshalNull:
+		return nil, nil
+	case marshalTrue:
+		return true, nil
+	case marshalFalse:
+		return false, nil
+	case marshalInt:
+		n, err := r.uint32()
+		return int64(int32(n)), err
+	case marshalLong:
+		return r.long()
+	case marshalString, marshalInterned, marshalUnicode, marshalASCII, marshalASCIIInterned:
+		n, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		return r.string(int(n))
+	case marshalShortASCII, marshalShortASCIIInt:
+		n, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		return r.string(int(n))
+	case marshalRef:
+		n, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		if int(n) >= len(r.refs) {
+			return nil, fmt.Errorf("invalid marshal reference: %d", n)
+		}
+		return r.refs[n], nil
+	case marshalSmallTuple:
+		n, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		return r.sequence(int(n), depth)
+	case marshalTuple, marshalList:
+		n, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		return r.sequence(int(n), depth)
+	case marshalDict:
+		var items []marshalDictItem
+		for {
+			key, err := r.object(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if key == nil {
+				// the dict is terminated by a null object
+				return items, nil
+			}
+			value, err := r.object(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, marshalDictItem{Key: key, Value: value})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported marshal type: %q", code)
+	}
+}
+
+func (r *marshalReader) sequence(n int, depth int) ([]any, error) {
+	if n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("invalid marshal sequence length: %d", n)
+	}
+	items := make([]any, 0, n)
+	for i := 0; i < n; i++ {
+		item, err := r.object(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// long returns an arbitrary precision integer, which is stored as a signed count of 15-bit digits (least significant
+// first).
+func (r *marshalReader) long() (any, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+	count := int32(n)
+	negative := count < 0
+	if negative {
+		count = -count
+	}
+	if int(count)*2 > len(r.data)-r.pos {
+		return nil, fmt.Errorf("invalid marshal long length: %d", count)
+	}
+
+	value := new(big.Int)
+	for i := int(count) - 1; i >= 0; i-- {
+		digit := binary.LittleEndian.Uint16(r.data[r.pos+i*2:])
+		value.Lsh(value, 15)
+		value.Or(value, big.NewInt(int64(digit)))
+	}
+	r.pos += int(count) * 2
+
+	if negative {
+		value.Neg(value)
+	}
+	if value.IsInt64() {
+		return value.Int64(), nil
+	}
+	return value, nil
+}
+
+func (r *marshalReader) byte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, fmt.Errorf("unexpected end of marshal data")
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *marshalReader) uint32() (uint32, error) {
+	if r.pos+4 > len(r.data) {
+		return 0, fmt.Errorf("unexpected end of marshal data")
+	}
+	n := binary.LittleEndian.Uint32(r.data[r.pos:])
+	r.pos += 4
+	return n, nil
+}
+
+func (r *marshalReader) string(n int) (string, error) {
+	if n < 0 || r.pos+n > len(r.data) {
+		return "", fmt.Errorf("invalid marshal string length: %d", n)
+	}
+	s := string(r.data[r.pos : r.pos+n])
+	r.pos += n
+	return s, nil
+}
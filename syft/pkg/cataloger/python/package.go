@@ -140,10 +140,22 @@ func purlQualifiersForPackage(m *pkg.PythonPackage) packageurl.Qualifiers {
 	}
 	if m.DirectURLOrigin != nil {
 		q = append(q, vcsURLQualifierForPackage(m.DirectURLOrigin)...)
+		q = append(q, downloadURLQualifierForPackage(m.DirectURLOrigin)...)
 	}
 	return q
 }
 
+// downloadURLQualifierForPackage returns the download_url qualifier for packages installed directly from a remote
+// archive (as opposed to a VCS repository or a local file or directory).
+func downloadURLQualifierForPackage(p *pkg.PythonDirectURLOriginInfo) packageurl.Qualifiers {
+	if p == nil || p.VCS != "" || p.Editable || p.URL == "" || strings.HasPrefix(p.URL, "file:") {
+		return nil
+	}
+	return packageurl.Qualifiers{
+		{Key: pkg.PURLQualifierDownloadURL, Value: p.URL},
+	}
+}
+
 func vcsURLQualifierForPackage(p *pkg.PythonDirectURLOriginInfo) packageurl.Qualifiers {
 	if p == nil || p.VCS == "" {
 		return nil
@@ -37,6 +37,30 @@ func Test_packageURL(t *testing.T) {
 			},
 			want: "pkg:pypi/name@v0.1.0?vcs_url=git%2Bhttps://github.com/test/test.git%40aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		},
+		{
+			testName: "with archive url",
+			name:     "name",
+			version:  "v0.1.0",
+			metadata: &pkg.PythonPackage{
+				DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+					URL:         "https://example.com/name-0.1.0.tar.gz",
+					ArchiveHash: "sha256=2dfb9b3e4fd8e9d8d8ba5b0c48d5fc49e4b1f6b2c6d5fb2d40bbd1e1b7c44b4e",
+				},
+			},
+			want: "pkg:pypi/name@v0.1.0?download_url=https://example.com/name-0.1.0.tar.gz",
+		},
+		{
+			testName: "with editable local directory",
+			name:     "name",
+			version:  "v0.1.0",
+			metadata: &pkg.PythonPackage{
+				DirectURLOrigin: &pkg.PythonDirectURLOriginInfo{
+					URL:      "file:///home/user/name",
+					Editable: true,
+				},
+			},
+			want: "pkg:pypi/name@v0.1.0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
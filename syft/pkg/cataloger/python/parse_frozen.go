@@ -0,0 +1,170 @@
+package python
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// maxFrozenMemberSize caps how much of any single embedded member (metadata files, the PYZ table of contents) is read
+const maxFrozenMemberSize = 32 * 1024 * 1024
+
+// distMetadataPattern matches the core metadata file of an installed distribution embedded within a frozen
+// application, for instance "requests-2.31.0.dist-info/METADATA" (PyInstaller), "site-packages/requests-2.31.0.dist-info/METADATA"
+// (shiv), ".deps/requests-2.31.0-py3-none-any.whl/requests-2.31.0.dist-info/METADATA" (pex), or
+// ".deps/six-1.16.0-py2.7.egg/EGG-INFO/PKG-INFO" (pex with eggs).
+var distMetadataPattern = regexp.MustCompile(`(?i)(?:^|/)(?:[^/]+\.dist-info/METADATA|[^/]+\.egg-info/PKG-INFO|EGG-INFO/PKG-INFO)$`)
+
+// frozenArchive is the set of members bundled within a frozen python application (a PyInstaller archive or a zip
+// application).
+type frozenArchive interface {
+	// names returns the path of every member within the archive
+	names() []string
+	// read returns the (decompressed) contents of the given member
+	read(name string) ([]byte, error)
+}
+
+// parseFrozenExecutable finds python packages within executables produced by PyInstaller, or executables with a
+// zip archive of python modules appended (as produced by py2exe).
+func parseFrozenExecutable(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for file: %w", err)
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to determine file size: %w", err)
+	}
+
+	if archive, err := openPyInstallerArchive(unionReader, size); err == nil && archive != nil {
+		return pyInstallerPackages(reader.Location, archive), nil, nil
+	} else if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read PyInstaller archive")
+	}
+
+	archive, err := openZipApplication(unionReader, size)
+	if err != nil {
+		// most executables are not frozen python applications
+		return nil, nil, nil
+	}
+	return frozenDistributionPackages(reader.Location, archive), nil, nil
+}
+
+// parseZipApplication finds python packages within zip applications, such as those produced by pex, shiv, zipapp,
+// or the library archive produced by py2exe.
+func parseZipApplication(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	unionReader, err := unionreader.GetUnionReader(reader.ReadCloser)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get union reader for file: %w", err)
+	}
+
+	size, err := unionReader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to determine file size: %w", err)
+	}
+
+	archive, err := openZipApplication(unionReader, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read python zip application: %w", err)
+	}
+	return frozenDistributionPackages(reader.Location, archive), nil, nil
+}
+
+// frozenDistributionPackages returns a package for every distribution with core metadata within the archive.
+func frozenDistributionPackages(location file.Location, archive frozenArchive) []pkg.Package {
+	var pkgs []pkg.Package
+	seen := make(map[string]bool)
+	for _, name := range archive.names() {
+		if !distMetadataPattern.MatchString(name) {
+			continue
+		}
+
+		p, err := newFrozenDistributionPackage(location, archive, name)
+		if err != nil {
+			log.WithFields("path", location.RealPath, "member", name, "error", err).Trace("unable to read embedded python metadata")
+			continue
+		}
+		if p == nil {
+			continue
+		}
+
+		// the same distribution may be bundled more than once (e.g. for several platforms within a pex)
+		key := p.Name + "@" + p.Version
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		pkgs = append(pkgs, *p)
+	}
+	return pkgs
+}
+
+func newFrozenDistributionPackage(location file.Location, archive frozenArchive, name string) (*pkg.Package, error) {
+	contents, err := archive.read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pd, err := parseWheelOrEggMetadata(file.NewLocationReadCloser(location, io.NopCloser(bytes.NewReader(contents))))
+	if err != nil {
+		return nil, err
+	}
+	if pd.Name == "" || pd.Version == "" {
+		return nil, nil
+	}
+
+	// the metadata does not reside within a site-packages directory on disk, and any license file is embedded
+	// alongside it (thus the license is only taken from the metadata fields)
+	pd.SitePackagesRootPath = ""
+	pd.LicenseLocation = file.Location{}
+	if pd.Licenses != "" || pd.LicenseExpression != "" {
+		pd.LicenseLocation = location
+	}
+
+	if topLevel, err := archive.read(path.Join(path.Dir(name), "top_level.txt")); err == nil {
+		for _, line := range strings.Split(string(topLevel), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				pd.TopLevelPackages = append(pd.TopLevelPackages, line)
+			}
+		}
+	}
+
+	p := newPackageForPackage(nil, pd, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+	return &p, nil
+}
+
+// importNamesForPackages returns the (normalized) top-level import names provided by the given packages.
+func importNamesForPackages(pkgs []pkg.Package) map[string]bool {
+	names := make(map[string]bool)
+	for _, p := range pkgs {
+		names[normalize(p.Name)] = true
+		if m, ok := p.Metadata.(pkg.PythonPackage); ok {
+			for _, name := range m.TopLevelPackages {
+				names[normalize(name)] = true
+			}
+		}
+	}
+	return names
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
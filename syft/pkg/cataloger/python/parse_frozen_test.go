@@ -0,0 +1,159 @@
+package python
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestFrozenApplicationCataloger(t *testing.T) {
+	executable := file.NewLocation("dist/app")
+	shiv := file.NewLocation("dist/tool.pyz")
+	pex := file.NewLocation("dist/service.pex")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "requests",
+			Version:   "2.31.0",
+			PURL:      "pkg:pypi/requests@2.31.0",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(executable.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("Apache 2.0", executable),
+			),
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+			Metadata: pkg.PythonPackage{
+				Name:             "requests",
+				Version:          "2.31.0",
+				Author:           "someone",
+				TopLevelPackages: []string{"requests"},
+			},
+		},
+		{
+			Name:      "pyyaml",
+			Version:   "6.0.1",
+			PURL:      "pkg:pypi/pyyaml@6.0.1",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(executable.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonPackage{
+				Name:             "PyYAML",
+				Version:          "6.0.1",
+				Author:           "someone",
+				TopLevelPackages: []string{"_yaml", "yaml"},
+				RequiresPython:   ">=3.6",
+			},
+		},
+		{
+			// only known from the PYZ archive (without any distribution metadata)
+			Name:      "urllib3",
+			PURL:      "pkg:pypi/urllib3",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(executable.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+		},
+		{
+			Name:      "click",
+			Version:   "8.1.7",
+			PURL:      "pkg:pypi/click@8.1.7",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(shiv.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("BSD-3-Clause", shiv),
+			),
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+			Metadata: pkg.PythonPackage{
+				Name:             "click",
+				Version:          "8.1.7",
+				Author:           "someone",
+				TopLevelPackages: []string{"click"},
+			},
+		},
+		{
+			Name:      "six",
+			Version:   "1.16.0",
+			PURL:      "pkg:pypi/six@1.16.0",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(pex.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Licenses: pkg.NewLicenseSet(
+				pkg.NewLicenseFromLocations("MIT", pex),
+			),
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+			Metadata: pkg.PythonPackage{
+				Name:    "six",
+				Version: "1.16.0",
+				Author:  "someone",
+			},
+		},
+		{
+			Name:      "attrs",
+			Version:   "23.1.0",
+			PURL:      "pkg:pypi/attrs@23.1.0",
+			FoundBy:   "python-frozen-application-cataloger",
+			Locations: file.NewLocationSet(pex.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonPackage{
+				Name:    "attrs",
+				Version: "23.1.0",
+				Author:  "someone",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/frozen").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewFrozenApplicationCataloger())
+}
+
+func TestReadPYZTableOfContents_ListFormat(t *testing.T) {
+	// PyInstaller < 6 marshals a list of (name, (is package, offset, length)) tuples:
+	// marshal.dumps([("requests", (True, 12, 0)), ("requests.api", (False, 12, 0))])
+	toc, err := hex.DecodeString("5b02000000a902da087265717565737473a90354e90c000000e900000000a902fa0c72657175657374732e617069a9034672030000007204000000")
+	require.NoError(t, err)
+	data := append([]byte("PYZ\x00\xa7\x0d\x0d\x0a\x00\x00\x00\x0c"), toc...)
+
+	modules := make(map[string]int64)
+	require.NoError(t, readPYZTableOfContents(data, modules))
+	assert.Equal(t, map[string]int64{"requests": pyzTypePackage, "requests.api": 0}, modules)
+}
+
+func Test_FrozenApplicationCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain python executables and zip applications",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"frozen/app.pex",
+				"frozen/app.pyz",
+				"frozen/dist/app",
+				"frozen/dist/library.zip",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewFrozenApplicationCataloger())
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package python
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePipxMetadata
+
+// pipxMetadata represents the contents of a pipx_metadata.json file, which pipx keeps within the virtual environment
+// of each package it installs (e.g. ~/.local/share/pipx/venvs/black/pipx_metadata.json).
+type pipxMetadata struct {
+	MainPackage      pipxPackage            `json:"main_package"`
+	InjectedPackages map[string]pipxPackage `json:"injected_packages"`
+	PythonVersion    string                 `json:"python_version"`
+}
+
+type pipxPackage struct {
+	Package        string   `json:"package"`
+	PackageOrURL   string   `json:"package_or_url"`
+	PackageVersion string   `json:"package_version"`
+	Apps           []string `json:"apps"`
+}
+
+// parsePipxMetadata is a parser function for pipx_metadata.json contents, returning the package installed with pipx
+// and any packages injected into its virtual environment.
+func parsePipxMetadata(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var doc pipxMetadata
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse pipx_metadata.json: %w", err)
+	}
+
+	if doc.MainPackage.Package == "" {
+		return nil, nil, nil
+	}
+
+	venv := path.Dir(reader.RealPath)
+
+	pkgs := []pkg.Package{newPipxPackage(resolver, reader.Location, venv, doc.MainPackage, doc.PythonVersion, "")}
+	for _, injected := range doc.InjectedPackages {
+		if injected.Package == "" {
+			continue
+		}
+		pkgs = append(pkgs, newPipxPackage(resolver, reader.Location, venv, injected, doc.PythonVersion, doc.MainPackage.Package))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+func newPipxPackage(resolver file.Resolver, location file.Location, venv string, p pipxPackage, pythonVersion, injectedInto string) pkg.Package {
+	apps := p.Apps
+	sort.Strings(apps)
+
+	locations := []file.Location{location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+	locations = append(locations, pipxAppLocations(resolver, location, venv, apps)...)
+
+	return newPackageForIndexWithMetadata(
+		p.Package,
+		p.PackageVersion,
+		pkg.PythonPipxEntry{
+			PackageOrURL:  p.PackageOrURL,
+			Apps:          apps,
+			PythonVersion: pythonVersion,
+			InjectedInto:  injectedInto,
+		},
+		locations...,
+	)
+}
+
+// pipxAppLocations returns the locations of the application scripts that exist within the virtual environment.
+func pipxAppLocations(resolver file.Resolver, location file.Location, venv string, apps []string) []file.Location {
+	if resolver == nil {
+		return nil
+	}
+
+	var locations []file.Location
+	for _, app := range apps {
+		appLocation := resolver.RelativeFileByPath(location, path.Join(venv, "bin", app))
+		if appLocation == nil {
+			continue
+		}
+		locations = append(locations, appLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+	return locations
+}
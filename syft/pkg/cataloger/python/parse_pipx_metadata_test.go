@@ -0,0 +1,71 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePipxMetadata(t *testing.T) {
+	fixture := "test-fixtures/pipx"
+	black := file.NewLocation("venvs/black/pipx_metadata.json")
+	httpie := file.NewLocation("venvs/httpie/pipx_metadata.json")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:    "black",
+			Version: "24.2.0",
+			PURL:    "pkg:pypi/black@24.2.0",
+			FoundBy: "python-pipx-cataloger",
+			Locations: file.NewLocationSet(
+				black,
+				file.NewLocation("venvs/black/bin/black"),
+				file.NewLocation("venvs/black/bin/blackd"),
+			),
+			Language: pkg.Python,
+			Type:     pkg.PythonPkg,
+			Metadata: pkg.PythonPipxEntry{
+				PackageOrURL:  "black==24.2.0",
+				Apps:          []string{"black", "blackd"},
+				PythonVersion: "Python 3.11.8",
+			},
+		},
+		{
+			Name:      "black-macchiato",
+			Version:   "1.3.0",
+			PURL:      "pkg:pypi/black-macchiato@1.3.0",
+			FoundBy:   "python-pipx-cataloger",
+			Locations: file.NewLocationSet(black),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonPipxEntry{
+				PackageOrURL:  "black-macchiato",
+				Apps:          []string{},
+				PythonVersion: "Python 3.11.8",
+				InjectedInto:  "black",
+			},
+		},
+		{
+			Name:    "httpie",
+			Version: "3.2.2",
+			PURL:    "pkg:pypi/httpie@3.2.2",
+			FoundBy: "python-pipx-cataloger",
+			// note: the "https" app is not within the fixture
+			Locations: file.NewLocationSet(httpie, file.NewLocation("venvs/httpie/bin/http")),
+			Language:  pkg.Python,
+			Type:      pkg.PythonPkg,
+			Metadata: pkg.PythonPipxEntry{
+				PackageOrURL:  "httpie",
+				Apps:          []string{"http", "https"},
+				PythonVersion: "Python 3.12.2",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, fixture).
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewPipxCataloger())
+}
@@ -0,0 +1,282 @@
+package python
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// the layout of a PyInstaller CArchive (see PyInstaller/archive/writers.py and bootloader/src/pyi_archive.h): the
+// archive is appended to the bootloader executable and ends with a cookie locating the table of contents, where each
+// table entry locates a (possibly zlib compressed) member relative to the start of the archive.
+var pyInstallerMagic = []byte("MEI\014\013\012\013\016")
+
+const (
+	// the cookie (since PyInstaller 2.1) is: magic[8], archive length, TOC offset, TOC length, python version, python library name[64]
+	pyInstallerCookieSize = 88
+	// the cookie of earlier releases omits the python library name
+	pyInstallerLegacyCookieSize = 24
+	// each TOC entry is: entry length, data offset, data length, uncompressed length, compression flag, type code, name
+	pyInstallerTOCEntryHeaderSize = 18
+	// the cookie is searched for within the trailing bytes of the file, allowing for any code signature appended afterwards
+	pyInstallerSearchSize = 1024 * 1024
+
+	pyInstallerTypePYZ = 'z'
+)
+
+// the layout of a PyInstaller PYZ archive (see PyInstaller/archive/writers.py): magic[4], python bytecode magic[4],
+// and the offset to the marshalled table of contents
+var pyzMagic = []byte("PYZ\x00")
+
+const pyzHeaderSize = 12
+
+// the PYZ table of contents type codes (PyInstaller >= 6, where earlier releases only use 0 and 1 for is-package)
+const (
+	pyzTypePackage   = 1
+	pyzTypeNSPackage = 3
+)
+
+// stdlibPackages are the top-level python standard library packages (not single modules), which are bundled by
+// PyInstaller alongside third-party packages.
+var stdlibPackages = map[string]bool{
+	"asyncio": true, "collections": true, "concurrent": true, "ctypes": true, "curses": true, "dbm": true,
+	"distutils": true, "email": true, "encodings": true, "ensurepip": true, "html": true, "http": true,
+	"idlelib": true, "importlib": true, "json": true, "lib2to3": true, "logging": true, "msilib": true,
+	"multiprocessing": true, "pathlib": true, "pydoc_data": true, "re": true, "sqlite3": true, "sysconfig": true,
+	"test": true, "tkinter": true, "tomllib": true, "turtledemo": true, "unittest": true, "urllib": true,
+	"venv": true, "wsgiref": true, "xml": true, "xmlrpc": true, "zoneinfo": true,
+}
+
+type pyInstallerEntry struct {
+	offset           int64
+	length           int64
+	uncompressedSize int64
+	compressed       bool
+	typeCode         byte
+	name             string
+}
+
+// pyInstallerArchive is the CArchive appended to an executable produced by PyInstaller.
+type pyInstallerArchive struct {
+	reader  io.ReaderAt
+	start   int64
+	entries map[string]pyInstallerEntry
+	order   []string
+}
+
+var _ frozenArchive = (*pyInstallerArchive)(nil)
+
+// openPyInstallerArchive returns the PyInstaller archive within the given executable, or nil if there is none.
+func openPyInstallerArchive(r io.ReaderAt, size int64) (*pyInstallerArchive, error) {
+	searchSize := min(size, pyInstallerSearchSize)
+	tail := make([]byte, searchSize)
+	if _, err := r.ReadAt(tail, size-searchSize); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	idx := bytes.LastIndex(tail, pyInstallerMagic)
+	if idx < 0 {
+		return nil, nil
+	}
+	cookiePos := size - searchSize + int64(idx)
+	cookie := tail[idx:]
+	if len(cookie) < pyInstallerLegacyCookieSize {
+		return nil, fmt.Errorf("truncated PyInstaller cookie")
+	}
+
+	archiveLength := int64(binary.BigEndian.Uint32(cookie[8:]))
+	tocOffset := int64(binary.BigEndian.Uint32(cookie[12:]))
+	tocLength := int64(binary.BigEndian.Uint32(cookie[16:]))
+
+	// the archive length includes the cookie, so the archive start depends on the cookie format
+	cookieSize := int64(pyInstallerLegacyCookieSize)
+	if len(cookie) >= pyInstallerCookieSize && cookiePos+pyInstallerCookieSize-archiveLength >= 0 {
+		cookieSize = pyInstallerCookieSize
+	}
+	start := cookiePos + cookieSize - archiveLength
+	if start < 0 || tocOffset+tocLength > archiveLength {
+		return nil, fmt.Errorf("invalid PyInstaller cookie")
+	}
+
+	toc := make([]byte, tocLength)
+	if _, err := r.ReadAt(toc, start+tocOffset); err != nil {
+		return nil, fmt.Errorf("unable to read PyInstaller table of contents: %w", err)
+	}
+
+	archive := &pyInstallerArchive{
+		reader:  r,
+		start:   start,
+		entries: make(map[string]pyInstallerEntry),
+	}
+	for pos := 0; pos+pyInstallerTOCEntryHeaderSize <= len(toc); {
+		entryLength := int(binary.BigEndian.Uint32(toc[pos:]))
+		if entryLength < pyInstallerTOCEntryHeaderSize || pos+entryLength > len(toc) {
+			return nil, fmt.Errorf("invalid PyInstaller table of contents entry")
+		}
+
+		entry := pyInstallerEntry{
+			offset:           int64(binary.BigEndian.Uint32(toc[pos+4:])),
+			length:           int64(binary.BigEndian.Uint32(toc[pos+8:])),
+			uncompressedSize: int64(binary.BigEndian.Uint32(toc[pos+12:])),
+			compressed:       toc[pos+16] != 0,
+			typeCode:         toc[pos+17],
+		}
+		name, _, _ := bytes.Cut(toc[pos+pyInstallerTOCEntryHeaderSize:pos+entryLength], []byte{0})
+		// archives built on windows use backslash separators
+		entry.name = strings.ReplaceAll(string(name), `\`, "/")
+		pos += entryLength
+
+		if entry.offset+entry.length > archiveLength {
+			return nil, fmt.Errorf("invalid PyInstaller table of contents entry: %s", entry.name)
+		}
+		if _, ok := archive.entries[entry.name]; ok {
+			continue
+		}
+		archive.entries[entry.name] = entry
+		archive.order = append(archive.order, entry.name)
+	}
+
+	return archive, nil
+}
+
+func (a *pyInstallerArchive) names() []string {
+	return a.order
+}
+
+func (a *pyInstallerArchive) read(name string) ([]byte, error) {
+	entry, ok := a.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("member not found: %s", name)
+	}
+	if entry.length > maxFrozenMemberSize || entry.uncompressedSize > maxFrozenMemberSize {
+		return nil, fmt.Errorf("member is too large: %s", name)
+	}
+
+	data := make([]byte, entry.length)
+	if _, err := a.reader.ReadAt(data, a.start+entry.offset); err != nil {
+		return nil, err
+	}
+	if !entry.compressed {
+		return data, nil
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	return io.ReadAll(io.LimitReader(zr, maxFrozenMemberSize))
+}
+
+// pyzModules returns the names and type codes of the python modules within the PYZ archive(s) bundled in the
+// PyInstaller archive.
+func (a *pyInstallerArchive) pyzModules() (map[string]int64, error) {
+	modules := make(map[string]int64)
+	for _, name := range a.order {
+		if a.entries[name].typeCode != pyInstallerTypePYZ {
+			continue
+		}
+
+		data, err := a.read(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := readPYZTableOfContents(data, modules); err != nil {
+			return nil, fmt.Errorf("unable to read PYZ archive %s: %w", name, err)
+		}
+	}
+	return modules, nil
+}
+
+func readPYZTableOfContents(data []byte, modules map[string]int64) error {
+	if len(data) < pyzHeaderSize || !bytes.HasPrefix(data, pyzMagic) {
+		return fmt.Errorf("not a PYZ archive")
+	}
+	tocOffset := int(binary.BigEndian.Uint32(data[8:]))
+	if tocOffset < pyzHeaderSize || tocOffset >= len(data) {
+		return fmt.Errorf("invalid PYZ table of contents offset")
+	}
+
+	toc, err := unmarshalPython(data[tocOffset:])
+	if err != nil {
+		return err
+	}
+
+	// the table of contents is a list of (name, (type code, offset, length)) tuples, or the equivalent dict
+	var items []marshalDictItem
+	switch t := toc.(type) {
+	case []marshalDictItem:
+		items = t
+	case []any:
+		for _, item := range t {
+			pair, ok := item.([]any)
+			if !ok || len(pair) != 2 {
+				return fmt.Errorf("unexpected PYZ table of contents entry")
+			}
+			items = append(items, marshalDictItem{Key: pair[0], Value: pair[1]})
+		}
+	default:
+		return fmt.Errorf("unexpected PYZ table of contents type: %T", toc)
+	}
+
+	for _, item := range items {
+		name, ok := item.Key.(string)
+		if !ok {
+			continue
+		}
+		var typeCode int64
+		if value, ok := item.Value.([]any); ok && len(value) > 0 {
+			switch v := value[0].(type) {
+			case int64:
+				typeCode = v
+			case bool:
+				if v {
+					typeCode = pyzTypePackage
+				}
+			}
+		}
+		modules[name] = typeCode
+	}
+	return nil
+}
+
+// pyInstallerPackages returns the distributions with core metadata bundled in the PyInstaller archive, as well as the
+// third-party top-level packages within the PYZ archive that are not accounted for by those distributions (without
+// version information, since the PYZ archive only contains compiled modules).
+func pyInstallerPackages(location file.Location, archive *pyInstallerArchive) []pkg.Package {
+	pkgs := frozenDistributionPackages(location, archive)
+
+	modules, err := archive.pyzModules()
+	if err != nil {
+		log.WithFields("path", location.RealPath, "error", err).Trace("unable to read PyInstaller PYZ archive")
+		return pkgs
+	}
+
+	provided := importNamesForPackages(pkgs)
+	topLevel := make(map[string]bool)
+	for name, typeCode := range modules {
+		root, _, nested := strings.Cut(name, ".")
+		isPackage := typeCode == pyzTypePackage || typeCode == pyzTypeNSPackage
+		if !nested && !isPackage {
+			// single top-level modules are indistinguishable from the standard library
+			continue
+		}
+		if root == "" || strings.HasPrefix(root, "_") || stdlibPackages[root] || provided[normalize(root)] {
+			continue
+		}
+		topLevel[root] = true
+	}
+
+	for _, name := range sortedKeys(topLevel) {
+		pkgs = append(pkgs, newPackageForIndex(name, "", location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)))
+	}
+	return pkgs
+}
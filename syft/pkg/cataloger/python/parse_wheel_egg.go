@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/anchore/syft/internal"
 	"github.com/anchore/syft/internal/log"
@@ -128,10 +131,11 @@ func fetchTopLevelPackages(resolver file.Resolver, metadataLocation file.Locatio
 }
 
 type directURLOrigin struct {
-	URL         string      `json:"url"`
-	VCSInfo     vcsInfo     `json:"vcs_info"`
-	ArchiveInfo archiveInfo `json:"archive_info"`
-	DirInfo     dirInfo     `json:"dir_info"`
+	URL          string      `json:"url"`
+	Subdirectory string      `json:"subdirectory"`
+	VCSInfo      vcsInfo     `json:"vcs_info"`
+	ArchiveInfo  archiveInfo `json:"archive_info"`
+	DirInfo      dirInfo     `json:"dir_info"`
 }
 
 type dirInfo struct {
@@ -139,7 +143,8 @@ type dirInfo struct {
 }
 
 type archiveInfo struct {
-	Hash string `json:"hash"`
+	Hash   string            `json:"hash"`
+	Hashes map[string]string `json:"hashes"`
 }
 
 type vcsInfo struct {
@@ -176,12 +181,164 @@ func fetchDirectURLData(resolver file.Resolver, metadataLocation file.Location)
 	}
 
 	return &pkg.PythonDirectURLOriginInfo{
-		URL:      directURLJson.URL,
-		CommitID: directURLJson.VCSInfo.CommitID,
-		VCS:      directURLJson.VCSInfo.VCS,
+		URL:               directURLJson.URL,
+		CommitID:          directURLJson.VCSInfo.CommitID,
+		VCS:               directURLJson.VCSInfo.VCS,
+		RequestedRevision: directURLJson.VCSInfo.RequestedRevision,
+		Subdirectory:      directURLJson.Subdirectory,
+		ArchiveHash:       directURLJson.ArchiveInfo.hash(),
+		Editable:          directURLJson.DirInfo.Editable,
 	}, sources, nil
 }
 
+// hash returns the strongest hash of the archive in the form "<algorithm>=<value>", preferring the (newer) hashes
+// field over the (deprecated) hash field.
+func (a archiveInfo) hash() string {
+	for _, algorithm := range []string{"sha512", "sha384", "sha256", "sha224", "sha1", "md5"} {
+		if value, ok := a.Hashes[algorithm]; ok && value != "" {
+			return algorithm + "=" + value
+		}
+	}
+	return a.Hash
+}
+
+var (
+	// editableFinderImportPattern matches the import of the finder module that setuptools installs for editable
+	// installs (PEP 660), e.g. "import __editable___example_1_0_finder; __editable___example_1_0_finder.install()".
+	editableFinderImportPattern = regexp.MustCompile(`^import\s+(__editable__\w+_finder)\b`)
+
+	// editableFinderMappingPattern matches the mapping of top-level package names to source paths within a setuptools
+	// editable finder module, e.g. "MAPPING: dict[str, str] = {'example': '/src/example/src/example'}".
+	editableFinderMappingPattern = regexp.MustCompile(`(?s)\bMAPPING\s*(?::[^=\n]*)?=\s*\{(.*?)\}`)
+
+	// editableFinderMappingEntryPattern matches a single (quoted) "name: path" entry of a setuptools editable finder mapping.
+	editableFinderMappingEntryPattern = regexp.MustCompile(`['"][^'"]*['"]\s*:\s*['"]([^'"]*)['"]`)
+
+	// windowsAbsolutePathPattern matches an absolute windows path, e.g. "C:\src\example".
+	windowsAbsolutePathPattern = regexp.MustCompile(`^[A-Za-z]:[\\/]`)
+)
+
+// fetchEditablePaths finds the .pth hooks of an editable install amongst the files recorded for the given python
+// package, returning the source paths the hooks make importable (either listed directly within the .pth file or
+// mapped by the __editable__ finder module the .pth file imports).
+func fetchEditablePaths(resolver file.Resolver, metadataLocation file.Location, pd parsedData) (paths []string, sources []file.Location, err error) {
+	for _, record := range pd.Files {
+		if !isEditableHook(record.Path, pd.Name) {
+			continue
+		}
+
+		hookPath := filepath.Join(pd.SitePackagesRootPath, record.Path)
+		hookLocation := resolver.RelativeFileByPath(metadataLocation, hookPath)
+		if hookLocation == nil {
+			continue
+		}
+
+		sources = append(sources, hookLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+
+		hookPaths, finders, err := readEditableHook(resolver, *hookLocation)
+		if err != nil {
+			return nil, nil, err
+		}
+		paths = append(paths, hookPaths...)
+
+		for _, finder := range finders {
+			finderPath := filepath.Join(pd.SitePackagesRootPath, finder+".py")
+			finderLocation := resolver.RelativeFileByPath(metadataLocation, finderPath)
+			if finderLocation == nil {
+				continue
+			}
+
+			sources = append(sources, finderLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+
+			finderPaths, err := readEditableFinder(resolver, *finderLocation)
+			if err != nil {
+				return nil, nil, err
+			}
+			paths = append(paths, finderPaths...)
+		}
+	}
+	return paths, sources, nil
+}
+
+// isEditableHook indicates if the given file (relative to the site-packages root) is a .pth file installed by an
+// editable install of the named package, such as "__editable__.example-1.0.pth" (setuptools), "_example.pth"
+// (hatchling), or "example.pth" (poetry).
+func isEditableHook(recordPath, name string) bool {
+	if filepath.Dir(recordPath) != "." || filepath.Ext(recordPath) != ".pth" {
+		return false
+	}
+	base := strings.TrimSuffix(filepath.Base(recordPath), ".pth")
+	if strings.HasPrefix(base, "__editable__.") {
+		return true
+	}
+	normalized := strings.ToLower(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+	base = strings.ToLower(base)
+	return base == normalized || base == "_"+normalized
+}
+
+// readEditableHook returns the paths listed within the given .pth file as well as the names of any __editable__
+// finder modules that it imports.
+func readEditableHook(resolver file.Resolver, location file.Location) (paths []string, finders []string, err error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer internal.CloseAndLogError(contents, location.AccessPath)
+
+	scanner := bufio.NewScanner(contents)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "import ") || strings.HasPrefix(line, "import\t"):
+			if match := editableFinderImportPattern.FindStringSubmatch(line); match != nil {
+				finders = append(finders, match[1])
+			}
+		case isAbsoluteHookPath(line):
+			// note: relative paths are resolved against site-packages, which is not indicative of an editable install
+			// (e.g. the pywin32.pth file of the pywin32 package)
+			paths = append(paths, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("could not read python editable hook: %w", err)
+	}
+
+	return paths, finders, nil
+}
+
+// isAbsoluteHookPath indicates if the given .pth line is an absolute path (on either posix or windows systems).
+func isAbsoluteHookPath(line string) bool {
+	return strings.HasPrefix(line, "/") || windowsAbsolutePathPattern.MatchString(line)
+}
+
+// readEditableFinder returns the source paths mapped by the given setuptools __editable__ finder module.
+func readEditableFinder(resolver file.Resolver, location file.Location) ([]string, error) {
+	contents, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(contents, location.AccessPath)
+
+	buffer, err := io.ReadAll(contents)
+	if err != nil {
+		return nil, fmt.Errorf("could not read python editable finder: %w", err)
+	}
+
+	mapping := editableFinderMappingPattern.FindSubmatch(buffer)
+	if mapping == nil {
+		return nil, nil
+	}
+
+	var paths []string
+	for _, entry := range editableFinderMappingEntryPattern.FindAllSubmatch(mapping[1], -1) {
+		paths = append(paths, string(entry[1]))
+	}
+	return paths, nil
+}
+
 // assembleEggOrWheelMetadata discovers and accumulates python package metadata from multiple file sources and returns a single metadata object as well as a list of files where the metadata was derived from.
 func assembleEggOrWheelMetadata(resolver file.Resolver, metadataLocation file.Location) (*parsedData, []file.Location, error) {
 	var sources = []file.Location{
@@ -234,5 +391,23 @@ func assembleEggOrWheelMetadata(resolver file.Resolver, metadataLocation file.Lo
 
 	sources = append(sources, s...)
 	pd.DirectURLOrigin = d
+
+	// attach any source paths made importable by the hooks of an editable installation
+	e, s, err := fetchEditablePaths(resolver, metadataLocation, pd)
+	if err != nil {
+		return nil, nil, err
+	}
+	sources = append(sources, s...)
+	if len(e) > 0 {
+		if pd.DirectURLOrigin == nil {
+			// the installer did not record a direct_url.json, so the first editable path is the best indication of the source
+			pd.DirectURLOrigin = &pkg.PythonDirectURLOriginInfo{
+				URL: (&url.URL{Scheme: "file", Path: e[0]}).String(),
+			}
+		}
+		pd.DirectURLOrigin.Editable = true
+		pd.DirectURLOrigin.EditablePaths = e
+	}
+
 	return &pd, sources, nil
 }
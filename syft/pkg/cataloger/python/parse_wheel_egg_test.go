@@ -0,0 +1,104 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isEditableHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordPath string
+		pkgName    string
+		want       bool
+	}{
+		{
+			name:       "setuptools hook",
+			recordPath: "__editable__.example_app-0.1.0.pth",
+			pkgName:    "example-app",
+			want:       true,
+		},
+		{
+			name:       "hatchling hook",
+			recordPath: "_example_app.pth",
+			pkgName:    "example-app",
+			want:       true,
+		},
+		{
+			name:       "poetry hook",
+			recordPath: "Example_App.pth",
+			pkgName:    "example.app",
+			want:       true,
+		},
+		{
+			name:       "pth file of another package",
+			recordPath: "distutils-precedence.pth",
+			pkgName:    "setuptools",
+		},
+		{
+			name:       "not a pth file",
+			recordPath: "example_app.py",
+			pkgName:    "example-app",
+		},
+		{
+			name:       "not at the root of site-packages",
+			recordPath: "example_app/example_app.pth",
+			pkgName:    "example-app",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isEditableHook(tt.recordPath, tt.pkgName))
+		})
+	}
+}
+
+func Test_isAbsoluteHookPath(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{line: "/home/dev/example/src", want: true},
+		{line: `C:\Users\dev\example\src`, want: true},
+		{line: "C:/Users/dev/example/src", want: true},
+		// e.g. the pywin32.pth file lists paths relative to site-packages
+		{line: "win32"},
+		{line: `win32\lib`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			assert.Equal(t, tt.want, isAbsoluteHookPath(tt.line))
+		})
+	}
+}
+
+func Test_archiveInfo_hash(t *testing.T) {
+	tests := []struct {
+		name string
+		info archiveInfo
+		want string
+	}{
+		{
+			name: "prefers hashes",
+			info: archiveInfo{
+				Hash:   "md5=c4ca4238a0b923820dcc509a6f75849b",
+				Hashes: map[string]string{"sha256": "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b", "md5": "c4ca4238a0b923820dcc509a6f75849b"},
+			},
+			want: "sha256=6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b",
+		},
+		{
+			name: "deprecated hash",
+			info: archiveInfo{Hash: "md5=c4ca4238a0b923820dcc509a6f75849b"},
+			want: "md5=c4ca4238a0b923820dcc509a6f75849b",
+		},
+		{
+			name: "no hash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.info.hash())
+		})
+	}
+}
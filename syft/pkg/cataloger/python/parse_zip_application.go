@@ -0,0 +1,56 @@
+package python
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+)
+
+// zipApplication is a zip archive of python modules and distributions, which may be prefixed with a shebang line
+// (pex, shiv, zipapp) or an executable stub (py2exe).
+type zipApplication struct {
+	files map[string]*zip.File
+	order []string
+}
+
+var _ frozenArchive = (*zipApplication)(nil)
+
+func openZipApplication(r io.ReaderAt, size int64) (*zipApplication, error) {
+	// note: the zip reader accounts for any data prepended to the archive
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	app := &zipApplication{files: make(map[string]*zip.File)}
+	for _, f := range zr.File {
+		if _, ok := app.files[f.Name]; ok {
+			continue
+		}
+		app.files[f.Name] = f
+		app.order = append(app.order, f.Name)
+	}
+	return app, nil
+}
+
+func (a *zipApplication) names() []string {
+	return a.order
+}
+
+func (a *zipApplication) read(name string) ([]byte, error) {
+	f, ok := a.files[name]
+	if !ok {
+		return nil, fmt.Errorf("member not found: %s", name)
+	}
+	if f.UncompressedSize64 > maxFrozenMemberSize {
+		return nil, fmt.Errorf("member is too large: %s", name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(io.LimitReader(rc, maxFrozenMemberSize))
+}
@@ -0,0 +1,15 @@
+import sys
+from importlib.machinery import ModuleSpec, PathFinder
+from importlib.machinery import all_suffixes as module_suffixes
+from importlib.util import spec_from_file_location
+from itertools import chain
+from pathlib import Path
+
+MAPPING: dict[str, str] = {'example_app': '/home/dev/example-app/src/example_app', 'example_plugins': '/home/dev/example-app/src/example_plugins'}
+NAMESPACES: dict[str, list[str]] = {}
+PATH_PLACEHOLDER = '__editable__.example_app-0.1.0.finder' + ".__path_hook__"
+
+
+def install():
+    if not any(finder == _EditableFinder for finder in sys.meta_path):
+        sys.meta_path.append(_EditableFinder)
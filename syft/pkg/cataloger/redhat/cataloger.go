@@ -47,6 +47,14 @@ func NewArchiveCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseRpmArchive, "**/*.rpm")
 }
 
+// NewSourcePackageCataloger returns a new cataloger object for RPM source packages, as described by .spec files and
+// source RPMs (.src.rpm), along with their build requirements and source archives.
+func NewSourcePackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("rpm-source-package-cataloger").
+		WithParserByGlobs(parseRpmSpec, "**/*.spec").
+		WithParserByGlobs(parseSourceRpm, "**/*.src.rpm")
+}
+
 func isSqliteDriverAvailable() bool {
 	db, err := sql.Open("sqlite", ":memory:")
 	if err != nil {
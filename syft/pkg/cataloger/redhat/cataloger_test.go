@@ -231,6 +231,7 @@ func Test_RPMFileCataloger_Globs(t *testing.T) {
 			fixture: "test-fixtures/glob-paths",
 			expected: []string{
 				"dive-0.10.0.rpm",
+				"SRPMS/zstd-1.5.5-1.fc39.src.rpm",
 			},
 		},
 	}
@@ -245,6 +246,16 @@ func Test_RPMFileCataloger_Globs(t *testing.T) {
 	}
 }
 
+func Test_SourcePackageCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"src/curl/curl.spec",
+			"SRPMS/zstd-1.5.5-1.fc39.src.rpm",
+		}).
+		TestCataloger(t, NewSourcePackageCataloger())
+}
+
 func Test_denySelfReferences(t *testing.T) {
 
 	a := pkg.Package{
@@ -121,3 +121,62 @@ func packageURL(name, arch string, epoch *int, srpm string, version, release str
 		"",
 	).ToString()
 }
+
+func newSourcePackage(m pkg.RpmSourceEntry, licenses []pkg.License, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      m.Name,
+		Version:   toELVersion(m.Epoch, m.Version, m.Release),
+		Licenses:  pkg.NewLicenseSet(licenses...),
+		PURL:      packageURL(m.Name, "src", m.Epoch, "", m.Version, m.Release, nil),
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Type:      pkg.RpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
+
+func newBuildRequirementPackage(name string, m pkg.RpmBuildRequirementEntry, location file.Location) pkg.Package {
+	// only an exact version relation pins the version of the build requirement
+	var version string
+	if v, ok := strings.CutPrefix(m.VersionConstraint, "= "); ok {
+		version = v
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      packageurl.NewPackageURL(packageurl.TypeRPM, "", name, version, nil, "").ToString(),
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.RpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
+
+func newSourceArchivePackage(name, version string, m pkg.RpmSourceArchiveEntry, location file.Location) pkg.Package {
+	var qualifiers packageurl.Qualifiers
+	if strings.Contains(m.Source, "://") {
+		// a "#/name" fragment only renames the downloaded file
+		downloadURL, _, _ := strings.Cut(m.Source, "#/")
+		qualifiers = append(qualifiers, packageurl.Qualifier{
+			Key:   "download_url",
+			Value: downloadURL,
+		})
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      packageurl.NewPackageURL(packageurl.TypeGeneric, "", name, version, qualifiers, "").ToString(),
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.RpmPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+	return p
+}
@@ -22,6 +22,12 @@ func parseRpmArchive(_ context.Context, _ file.Resolver, _ *generic.Environment,
 		return nil, nil, fmt.Errorf("RPM file found but unable to read: %s (%w)", reader.Location.RealPath, err)
 	}
 
+	if rpm.Header.HasTag(sourcePackageTag) {
+		// source RPMs are not installable packages, these are cataloged by the source package cataloger instead
+		log.WithFields("path", reader.Location.RealPath).Trace("skipping source RPM")
+		return nil, nil, nil
+	}
+
 	nevra, err := rpm.Header.GetNEVRA()
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,377 @@
+package redhat
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// the number of times macros are expanded within a value (macros may refer to other macros)
+const maxMacroExpansionDepth = 10
+
+var (
+	_ generic.Parser = parseRpmSpec
+
+	// specTagPattern matches a preamble tag (e.g. "BuildRequires: gcc" or "Source0: https://...").
+	specTagPattern = regexp.MustCompile(`^(?P<tag>[A-Za-z]+?)(?P<index>\d*)\s*(\([^)]*\))?\s*:\s*(?P<value>.*)$`)
+
+	// specDefinePattern matches a macro definition (e.g. "%global commit 1a2b3c"); parametric macros are not matched.
+	specDefinePattern = regexp.MustCompile(`^%(define|global)\s+(?P<name>[A-Za-z_][A-Za-z0-9_]*)\s+(?P<value>.*)$`)
+
+	// specMacroPattern matches a macro reference: "%{name}", "%{?name}", "%{!?name}", "%{?name:text}" or "%name".
+	specMacroPattern = regexp.MustCompile(`%\{(?P<conditional>!?\?)?(?P<braced>[A-Za-z_][A-Za-z0-9_]*)(:(?P<text>[^{}]*))?\}|%(?P<bare>[A-Za-z_][A-Za-z0-9_]*)`)
+
+	// requirementPattern matches a single requirement (e.g. "pkgconfig(openssl) >= 1.1").
+	requirementPattern = regexp.MustCompile(`^(?P<name>[^\s<>=]+)(\s*(?P<operator>[<>=]+)\s*(?P<constraintVersion>\S+))?$`)
+
+	// sourceArchiveNameVersionPattern matches the name and version of an archive file name without its extension
+	// (e.g. "curl-8.5.0" or "zstd-v1.5.5").
+	sourceArchiveNameVersionPattern = regexp.MustCompile(`^(?P<name>.+?)-v?(?P<version>\d[\w.+~]*)$`)
+
+	sourceArchiveSuffixes = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar.zst", ".tar.lz", ".tgz", ".tbz2", ".txz", ".tar", ".zip", ".crate", ".gem"}
+
+	// specSections are the spec file sections that follow the preamble (the "%package" section starts the preamble of
+	// a sub-package).
+	specSections = map[string]bool{
+		"description": true, "prep": true, "conf": true, "generate_buildrequires": true, "build": true,
+		"install": true, "check": true, "clean": true, "files": true, "changelog": true, "pre": true, "post": true,
+		"preun": true, "postun": true, "pretrans": true, "posttrans": true, "preuntrans": true, "postuntrans": true,
+		"verifyscript": true, "triggerprein": true, "triggerin": true, "triggerun": true, "triggerpostun": true,
+		"filetriggerin": true, "filetriggerun": true, "filetriggerpostun": true, "transfiletriggerin": true,
+		"transfiletriggerun": true, "transfiletriggerpostun": true, "sourcelist": true, "patchlist": true,
+	}
+)
+
+// parseRpmSpec is a parser function for RPM .spec files, returning the source package along with its build
+// requirements and source archives. Macros defined within the spec file are expanded, however conditionals are not
+// evaluated (so requirements from all branches are reported).
+func parseRpmSpec(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	spec, err := readRpmSpec(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse RPM spec file=%q: %w", reader.RealPath, err)
+	}
+
+	if spec.entry.Name == "" || spec.entry.Version == "" || strings.Contains(spec.entry.Name, "%") {
+		log.WithFields("path", reader.RealPath).Trace("RPM spec file has no resolvable name or version")
+		return nil, nil, nil
+	}
+
+	var licenses []pkg.License
+	if spec.license != "" {
+		licenses = pkg.NewLicensesFromLocation(reader.Location, spec.license)
+	}
+
+	pkgs, relationships := newSourcePackageWithRequirements(spec.entry, licenses, nil, reader.Location)
+	return pkgs, relationships, nil
+}
+
+type rpmSpec struct {
+	entry   pkg.RpmSourceEntry
+	license string
+}
+
+func readRpmSpec(reader file.LocationReadCloser) (*rpmSpec, error) {
+	spec := &rpmSpec{}
+	macros := make(map[string]string)
+	preamble := true
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if match := internal.MatchNamedCaptureGroups(specDefinePattern, line); match["name"] != "" {
+			macros[match["name"]] = strings.TrimSpace(match["value"])
+			continue
+		}
+
+		if fields := strings.Fields(strings.TrimPrefix(line, "%")); strings.HasPrefix(line, "%") && len(fields) > 0 {
+			switch {
+			case fields[0] == "package":
+				preamble = true
+				if name := subPackageName(fields[1:], macros); name != "" {
+					spec.entry.Binaries = append(spec.entry.Binaries, name)
+				}
+				continue
+			case specSections[fields[0]]:
+				preamble = false
+				continue
+			}
+		}
+
+		if !preamble {
+			continue
+		}
+
+		match := internal.MatchNamedCaptureGroups(specTagPattern, line)
+		if match["tag"] == "" {
+			continue
+		}
+		spec.setTag(match["tag"], expandMacros(match["value"], macros), macros)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if spec.entry.Name != "" {
+		spec.entry.Binaries = append([]string{spec.entry.Name}, spec.entry.Binaries...)
+	}
+
+	return spec, nil
+}
+
+func (s *rpmSpec) setTag(tag, value string, macros map[string]string) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(tag) {
+	case "name":
+		s.entry.Name = value
+		macros["name"] = value
+	case "version":
+		s.entry.Version = value
+		macros["version"] = value
+	case "release":
+		s.entry.Release = value
+		macros["release"] = value
+	case "epoch":
+		s.entry.Epoch = parseEpoch(value)
+		macros["epoch"] = value
+	case "license":
+		s.license = value
+	case "url":
+		s.entry.URL = value
+		macros["url"] = value
+	case "vendor":
+		s.entry.Vendor = value
+	case "packager":
+		s.entry.Packager = value
+	case "buildrequires":
+		s.entry.BuildRequires = append(s.entry.BuildRequires, splitRequirements(value)...)
+	case "source":
+		s.entry.Sources = append(s.entry.Sources, value)
+	case "patch":
+		s.entry.Patches = append(s.entry.Patches, value)
+	}
+}
+
+// subPackageName returns the name of the binary package declared by a "%package" section, which is either given in
+// full ("%package -n name") or as a suffix of the source package name ("%package devel").
+func subPackageName(args []string, macros map[string]string) string {
+	var name string
+	var full bool
+	for _, arg := range args {
+		switch {
+		case arg == "-n":
+			full = true
+		case strings.HasPrefix(arg, "-"):
+			continue
+		default:
+			name = expandMacros(arg, macros)
+		}
+	}
+
+	if name == "" || full {
+		return name
+	}
+	return macros["name"] + "-" + name
+}
+
+// expandMacros replaces references to known macros within the value. Conditional references to unknown macros
+// (e.g. "%{?dist}") are removed, while other references to unknown macros are kept as-is.
+func expandMacros(value string, macros map[string]string) string {
+	value = strings.ReplaceAll(value, "%%", "\x00")
+	for i := 0; i < maxMacroExpansionDepth && strings.Contains(value, "%"); i++ {
+		expanded := specMacroPattern.ReplaceAllStringFunc(value, func(reference string) string {
+			match := internal.MatchNamedCaptureGroups(specMacroPattern, reference)
+			name := match["braced"] + match["bare"]
+			macro, defined := macros[name]
+
+			switch match["conditional"] {
+			case "?":
+				if !defined {
+					return ""
+				}
+				if match["text"] != "" {
+					return match["text"]
+				}
+				return macro
+			case "!?":
+				if defined {
+					return ""
+				}
+				return match["text"]
+			}
+
+			if !defined {
+				return reference
+			}
+			return macro
+		})
+		if expanded == value {
+			break
+		}
+		value = expanded
+	}
+	return strings.ReplaceAll(value, "\x00", "%")
+}
+
+// splitRequirements splits the value of a requirement tag into individual requirements, which are separated by
+// commas or whitespace (where a version relation belongs to the preceding name). Rich dependencies (e.g.
+// "(foo or bar)") are kept whole.
+func splitRequirements(value string) []string {
+	var tokens []string
+	var current strings.Builder
+	depth := 0
+	for _, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && (r == ',' || unicode.IsSpace(r)):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	var requirements []string
+	for i := 0; i < len(tokens); i++ {
+		if i+2 < len(tokens) && isRequirementOperator(tokens[i+1]) {
+			requirements = append(requirements, strings.Join(tokens[i:i+3], " "))
+			i += 2
+			continue
+		}
+		requirements = append(requirements, tokens[i])
+	}
+	return requirements
+}
+
+func isRequirementOperator(token string) bool {
+	switch token {
+	case "<", "<=", "=", "==", ">=", ">":
+		return true
+	}
+	return false
+}
+
+// parseBuildRequirement returns the name and metadata for a single build requirement. Rich dependencies are not
+// considered.
+func parseBuildRequirement(requirement string) (string, pkg.RpmBuildRequirementEntry, bool) {
+	match := internal.MatchNamedCaptureGroups(requirementPattern, strings.TrimSpace(requirement))
+	name := match["name"]
+	if name == "" || strings.HasPrefix(name, "(") || strings.Contains(name, "%") {
+		return "", pkg.RpmBuildRequirementEntry{}, false
+	}
+
+	var entry pkg.RpmBuildRequirementEntry
+	if match["operator"] != "" {
+		operator := match["operator"]
+		if operator == "==" {
+			operator = "="
+		}
+		entry.VersionConstraint = operator + " " + match["constraintVersion"]
+	}
+	return name, entry, true
+}
+
+// newSourcePackageWithRequirements returns the source package along with a package for each of its build
+// requirements (related to the source package) and for each source archive (contained by the source package). Any
+// digests are keyed by the base name of the source file.
+func newSourcePackageWithRequirements(entry pkg.RpmSourceEntry, licenses []pkg.License, digests map[string]file.Digest, location file.Location) ([]pkg.Package, []artifact.Relationship) {
+	sourcePkg := newSourcePackage(entry, licenses, location)
+	pkgs := []pkg.Package{sourcePkg}
+
+	var relationships []artifact.Relationship
+	seen := make(map[string]bool)
+	for _, requirement := range entry.BuildRequires {
+		name, req, ok := parseBuildRequirement(requirement)
+		if !ok {
+			log.WithFields("requirement", requirement, "package", entry.Name).Trace("unable to parse RPM build requirement")
+			continue
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		reqPkg := newBuildRequirementPackage(name, req, location)
+		pkgs = append(pkgs, reqPkg)
+		relationships = append(relationships, artifact.Relationship{
+			From: reqPkg,
+			To:   sourcePkg,
+			Type: artifact.DependencyOfRelationship,
+		})
+	}
+
+	for _, source := range entry.Sources {
+		fileName := sourceFileName(source)
+		name, version, ok := sourceArchiveNameVersion(fileName)
+		if !ok {
+			continue
+		}
+
+		archive := pkg.RpmSourceArchiveEntry{Source: source}
+		if digest, ok := digests[fileName]; ok {
+			archive.Digest = &digest
+		}
+
+		archivePkg := newSourceArchivePackage(name, version, archive, location)
+		pkgs = append(pkgs, archivePkg)
+		relationships = append(relationships, artifact.Relationship{
+			From: sourcePkg,
+			To:   archivePkg,
+			Type: artifact.ContainsRelationship,
+		})
+	}
+
+	return pkgs, relationships
+}
+
+// sourceFileName returns the file name of a source, where a URL fragment may be used to name the downloaded file
+// (e.g. "https://github.com/org/project/archive/v1.0.tar.gz#/project-1.0.tar.gz").
+func sourceFileName(source string) string {
+	if i := strings.Index(source, "#/"); i >= 0 {
+		return path.Base(source[i+2:])
+	}
+	if i := strings.IndexAny(source, "?#"); i >= 0 && strings.Contains(source, "://") {
+		source = source[:i]
+	}
+	return path.Base(source)
+}
+
+// sourceArchiveNameVersion returns the name and version of a source archive from its file name (files that are not
+// archives, such as patches or configuration files, are not considered).
+func sourceArchiveNameVersion(fileName string) (string, string, bool) {
+	for _, suffix := range sourceArchiveSuffixes {
+		base, ok := strings.CutSuffix(fileName, suffix)
+		if !ok || base == "" || strings.Contains(base, "%") {
+			continue
+		}
+		if match := internal.MatchNamedCaptureGroups(sourceArchiveNameVersionPattern, base); match["name"] != "" {
+			return match["name"], match["version"], true
+		}
+		return base, "", true
+	}
+	return "", "", false
+}
@@ -0,0 +1,175 @@
+package redhat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseRpmSpec(t *testing.T) {
+	fixture := "test-fixtures/source-package/curl.spec"
+	location := file.NewLocation(fixture)
+
+	curl := pkg.Package{
+		Name:      "curl",
+		Version:   "1:8.5.0-1",
+		PURL:      "pkg:rpm/curl@8.5.0-1?arch=src&epoch=1",
+		Locations: file.NewLocationSet(location),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("curl", location)),
+		Type:      pkg.RpmPkg,
+		Metadata: pkg.RpmSourceEntry{
+			Name:     "curl",
+			Version:  "8.5.0",
+			Epoch:    intRef(1),
+			Release:  "1",
+			URL:      "https://curl.se/",
+			Vendor:   "Example Org",
+			Packager: "Jane Doe <jane@example.org>",
+			Binaries: []string{"curl", "libcurl", "curl-devel"},
+			// note: conditionals are not evaluated, so requirements within all branches are included
+			BuildRequires: []string{
+				"automake",
+				"libtool",
+				"gcc",
+				"make",
+				"openssl-devel >= 3.0.0",
+				"pkgconfig(libnghttp2) >= 1.12.0",
+				"zlib-devel = 1.3",
+				"(python3-impacket if python3)",
+				"libpsl-devel",
+				"gcc",
+				"libidn2-devel",
+			},
+			Sources: []string{
+				"https://curl.se/download/curl-8.5.0.tar.xz",
+				"https://github.com/libssh/libssh-mirror/archive/refs/tags/v0.10.6.tar.gz#/libssh-0.10.6.tar.gz",
+				"curl.conf",
+			},
+			Patches: []string{"0001-curl-7.32.0-multilib.patch"},
+		},
+	}
+
+	requirement := func(name, version, constraint string) pkg.Package {
+		purl := "pkg:rpm/" + name
+		if version != "" {
+			purl += "@" + version
+		}
+		return pkg.Package{
+			Name:      name,
+			Version:   version,
+			PURL:      purl,
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmBuildRequirementEntry{VersionConstraint: constraint},
+		}
+	}
+
+	// note: rich dependencies and duplicate requirements are not reported as packages
+	requirements := []pkg.Package{
+		requirement("automake", "", ""),
+		requirement("libtool", "", ""),
+		requirement("gcc", "", ""),
+		requirement("make", "", ""),
+		requirement("openssl-devel", "", ">= 3.0.0"),
+		requirement("pkgconfig(libnghttp2)", "", ">= 1.12.0"),
+		requirement("zlib-devel", "1.3", "= 1.3"),
+		requirement("libpsl-devel", "", ""),
+		requirement("libidn2-devel", "", ""),
+	}
+
+	archives := []pkg.Package{
+		{
+			Name:      "curl",
+			Version:   "8.5.0",
+			PURL:      "pkg:generic/curl@8.5.0?download_url=https://curl.se/download/curl-8.5.0.tar.xz",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmSourceArchiveEntry{Source: "https://curl.se/download/curl-8.5.0.tar.xz"},
+		},
+		{
+			// the archive is named by the URL fragment
+			Name:      "libssh",
+			Version:   "0.10.6",
+			PURL:      "pkg:generic/libssh@0.10.6?download_url=https://github.com/libssh/libssh-mirror/archive/refs/tags/v0.10.6.tar.gz",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmSourceArchiveEntry{Source: "https://github.com/libssh/libssh-mirror/archive/refs/tags/v0.10.6.tar.gz#/libssh-0.10.6.tar.gz"},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+	for _, r := range requirements {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: r, To: curl, Type: artifact.DependencyOfRelationship})
+	}
+	for _, a := range archives {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: curl, To: a, Type: artifact.ContainsRelationship})
+	}
+
+	expectedPkgs := append([]pkg.Package{curl}, requirements...)
+	expectedPkgs = append(expectedPkgs, archives...)
+
+	pkgtest.TestFileParser(t, fixture, parseRpmSpec, expectedPkgs, expectedRelationships)
+}
+
+func Test_expandMacros(t *testing.T) {
+	macros := map[string]string{
+		"name":    "curl",
+		"version": "8.5.0",
+		"nested":  "%{name}-%{version}",
+	}
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "%{name}-%{version}.tar.xz", want: "curl-8.5.0.tar.xz"},
+		{value: "%name-%version", want: "curl-8.5.0"},
+		{value: "%{nested}.tar.gz", want: "curl-8.5.0.tar.gz"},
+		{value: "1%{?dist}", want: "1"},
+		{value: "%{?name:-n %{name}}", want: "-n curl"},
+		{value: "%{!?dist:nodist}", want: "nodist"},
+		{value: "%{_bindir}/curl", want: "%{_bindir}/curl"},
+		{value: "100%%", want: "100%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, expandMacros(tt.value, macros))
+		})
+	}
+}
+
+func Test_splitRequirements(t *testing.T) {
+	assert.Equal(t,
+		[]string{"gcc", "make", "openssl-devel >= 3.0", "perl(Foo::Bar)", "(a or b)", "zlib"},
+		splitRequirements("gcc make, openssl-devel >= 3.0, perl(Foo::Bar) (a or b) zlib"),
+	)
+}
+
+func Test_sourceArchiveNameVersion(t *testing.T) {
+	tests := []struct {
+		fileName    string
+		wantName    string
+		wantVersion string
+		wantOK      bool
+	}{
+		{fileName: "curl-8.5.0.tar.xz", wantName: "curl", wantVersion: "8.5.0", wantOK: true},
+		{fileName: "zstd-v1.5.5.zip", wantName: "zstd", wantVersion: "1.5.5", wantOK: true},
+		{fileName: "python-requests-2.31.0.tar.gz", wantName: "python-requests", wantVersion: "2.31.0", wantOK: true},
+		{fileName: "vendor.tar.gz", wantName: "vendor", wantOK: true},
+		{fileName: "curl.conf", wantOK: false},
+		{fileName: "0001-fix.patch", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			name, version, ok := sourceArchiveNameVersion(tt.fileName)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
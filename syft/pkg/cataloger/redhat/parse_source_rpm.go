@@ -0,0 +1,139 @@
+package redhat
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/sassoftware/go-rpmutils"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const (
+	// sourcePackageTag is the RPMTAG_SOURCEPACKAGE header tag, which is only present within source RPMs
+	sourcePackageTag = 1106
+
+	rpmSenseLess    = rpmutils.RPMSENSE_LESS
+	rpmSenseGreater = rpmutils.RPMSENSE_GREATER
+	rpmSenseEqual   = rpmutils.RPMSENSE_EQUAL
+)
+
+var _ generic.Parser = parseSourceRpm
+
+// parseSourceRpm is a parser function for source RPM (.src.rpm) headers, returning the source package along with its
+// build requirements (the requirements of a source RPM) and source archives (with the digests of the bundled files).
+func parseSourceRpm(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	rpm, err := rpmutils.ReadRpm(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("RPM file found but unable to read: %s (%w)", reader.Location.RealPath, err)
+	}
+
+	if !rpm.Header.HasTag(sourcePackageTag) {
+		return nil, nil, nil
+	}
+
+	nevra, err := rpm.Header.GetNEVRA()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	licenses, err := rpm.Header.GetStrings(rpmutils.LICENSE)
+	logRpmArchiveErr(reader.Location, "license", err)
+
+	entry := pkg.RpmSourceEntry{
+		Name:          nevra.Name,
+		Version:       nevra.Version,
+		Epoch:         parseEpoch(nevra.Epoch),
+		Release:       nevra.Release,
+		URL:           headerString(reader.Location, rpm.Header, rpmutils.URL, "url"),
+		Vendor:        headerString(reader.Location, rpm.Header, rpmutils.VENDOR, "vendor"),
+		Packager:      headerString(reader.Location, rpm.Header, rpmutils.PACKAGER, "packager"),
+		BuildRequires: sourceRpmRequirements(reader.Location, rpm.Header),
+		Sources:       headerStrings(reader.Location, rpm.Header, rpmutils.SOURCE, "source"),
+		Patches:       headerStrings(reader.Location, rpm.Header, rpmutils.PATCH, "patch"),
+	}
+
+	// a source RPM without an epoch has an epoch of 0 according to the header, which is not part of the version
+	if !rpm.Header.HasTag(rpmutils.EPOCH) {
+		entry.Epoch = nil
+	}
+
+	files, err := rpm.Header.GetFiles()
+	logRpmArchiveErr(reader.Location, "files", err)
+
+	digestAlgorithm := getDigestAlgorithm(reader.Location, rpm.Header)
+	digests := make(map[string]file.Digest)
+	for _, f := range files {
+		if f.Digest() != "" {
+			digests[path.Base(f.Name())] = file.Digest{
+				Algorithm: digestAlgorithm,
+				Value:     f.Digest(),
+			}
+		}
+	}
+
+	pkgs, relationships := newSourcePackageWithRequirements(entry, pkg.NewLicensesFromLocation(reader.Location, licenses...), digests, reader.Location)
+	return pkgs, relationships, nil
+}
+
+// sourceRpmRequirements returns the requirements of a source RPM (which are the build requirements of the package),
+// excluding the rpmlib() features required of rpm itself.
+func sourceRpmRequirements(location file.Location, header *rpmutils.RpmHeader) []string {
+	names := headerStrings(location, header, rpmutils.REQUIRENAME, "requirename")
+	versions := headerStrings(location, header, rpmutils.REQUIREVERSION, "requireversion")
+	flags, err := header.GetUint32s(rpmutils.REQUIREFLAGS)
+	logRpmArchiveErr(location, "requireflags", err)
+
+	var requirements []string
+	for i, name := range names {
+		if strings.HasPrefix(name, "rpmlib(") {
+			continue
+		}
+		requirement := name
+		if i < len(versions) && i < len(flags) && versions[i] != "" {
+			if operator := requirementOperator(flags[i]); operator != "" {
+				requirement += " " + operator + " " + versions[i]
+			}
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements
+}
+
+// requirementOperator returns the version relation for RPM dependency sense flags.
+func requirementOperator(flags uint32) string {
+	var operator string
+	if flags&rpmSenseLess != 0 {
+		operator += "<"
+	}
+	if flags&rpmSenseGreater != 0 {
+		operator += ">"
+	}
+	if flags&rpmSenseEqual != 0 {
+		operator += "="
+	}
+	return operator
+}
+
+func headerString(location file.Location, header *rpmutils.RpmHeader, tag int, operation string) string {
+	if !header.HasTag(tag) {
+		return ""
+	}
+	value, err := header.GetString(tag)
+	logRpmArchiveErr(location, operation, err)
+	return value
+}
+
+func headerStrings(location file.Location, header *rpmutils.RpmHeader, tag int, operation string) []string {
+	if !header.HasTag(tag) {
+		return nil
+	}
+	values, err := header.GetStrings(tag)
+	logRpmArchiveErr(location, operation, err)
+	return values
+}
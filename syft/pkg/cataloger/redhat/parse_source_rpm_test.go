@@ -0,0 +1,92 @@
+package redhat
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseSourceRpm(t *testing.T) {
+	fixture := "test-fixtures/source-package/zstd-1.5.5-1.fc39.src.rpm"
+	location := file.NewLocation(fixture)
+
+	zstd := pkg.Package{
+		Name:      "zstd",
+		Version:   "1.5.5-1.fc39",
+		PURL:      "pkg:rpm/zstd@1.5.5-1.fc39?arch=src",
+		Locations: file.NewLocationSet(location),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("BSD-3-Clause AND GPL-2.0-only", location)),
+		Type:      pkg.RpmPkg,
+		Metadata: pkg.RpmSourceEntry{
+			Name:     "zstd",
+			Version:  "1.5.5",
+			Release:  "1.fc39",
+			URL:      "https://github.com/facebook/zstd",
+			Vendor:   "Fedora Project",
+			Packager: "Fedora Project",
+			// note: the rpmlib() requirements are not build requirements
+			BuildRequires: []string{"gcc", "lz4-devel >= 1.9.0", "xz-devel = 5.4.4"},
+			Sources:       []string{"zstd-1.5.5.tar.gz", "pzstd.1"},
+			Patches:       []string{"zstd-1.5.5-fix-build.patch"},
+		},
+	}
+
+	requirements := []pkg.Package{
+		{
+			Name:      "gcc",
+			PURL:      "pkg:rpm/gcc",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmBuildRequirementEntry{},
+		},
+		{
+			Name:      "lz4-devel",
+			PURL:      "pkg:rpm/lz4-devel",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmBuildRequirementEntry{VersionConstraint: ">= 1.9.0"},
+		},
+		{
+			Name:      "xz-devel",
+			Version:   "5.4.4",
+			PURL:      "pkg:rpm/xz-devel@5.4.4",
+			Locations: file.NewLocationSet(location),
+			Type:      pkg.RpmPkg,
+			Metadata:  pkg.RpmBuildRequirementEntry{VersionConstraint: "= 5.4.4"},
+		},
+	}
+
+	// the bundled archive is described with the digest from the source RPM header
+	archive := pkg.Package{
+		Name:      "zstd",
+		Version:   "1.5.5",
+		PURL:      "pkg:generic/zstd@1.5.5",
+		Locations: file.NewLocationSet(location),
+		Type:      pkg.RpmPkg,
+		Metadata: pkg.RpmSourceArchiveEntry{
+			Source: "zstd-1.5.5.tar.gz",
+			Digest: &file.Digest{
+				Algorithm: "sha256",
+				Value:     "744861c00beb2c9548ea570d0f926eadcc21e2e443fbab489227843784f0c80d",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+	for _, r := range requirements {
+		expectedRelationships = append(expectedRelationships, artifact.Relationship{From: r, To: zstd, Type: artifact.DependencyOfRelationship})
+	}
+	expectedRelationships = append(expectedRelationships, artifact.Relationship{From: zstd, To: archive, Type: artifact.ContainsRelationship})
+
+	expectedPkgs := append([]pkg.Package{zstd}, requirements...)
+	expectedPkgs = append(expectedPkgs, archive)
+
+	pkgtest.TestFileParser(t, fixture, parseSourceRpm, expectedPkgs, expectedRelationships)
+}
+
+func TestParseRpmArchive_SkipsSourceRpm(t *testing.T) {
+	pkgtest.TestFileParser(t, "test-fixtures/source-package/zstd-1.5.5-1.fc39.src.rpm", parseRpmArchive, nil, nil)
+}
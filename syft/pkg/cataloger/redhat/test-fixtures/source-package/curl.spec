@@ -0,0 +1,63 @@
+%global openssl_min 3.0.0
+%global libssh_subdir libssh-%{libssh_version}
+%define libssh_version 0.10.6
+
+Summary: A utility for getting files from remote servers (FTP, HTTP, and others)
+Name: curl
+Version: 8.5.0
+Release: 1%{?dist}
+Epoch: 1
+License: curl
+URL: https://curl.se/
+Vendor: Example Org
+Packager: Jane Doe <jane@example.org>
+
+Source0: https://curl.se/download/%{name}-%{version}.tar.xz
+Source1: https://github.com/libssh/libssh-mirror/archive/refs/tags/v%{libssh_version}.tar.gz#/%{libssh_subdir}.tar.gz
+Source2: curl.conf
+Patch0: 0001-curl-7.32.0-multilib.patch
+
+BuildRequires: automake, libtool
+BuildRequires: gcc make
+BuildRequires: openssl-devel >= %{openssl_min}
+BuildRequires: pkgconfig(libnghttp2) >= 1.12.0
+BuildRequires: zlib-devel = 1.3
+BuildRequires: (python3-impacket if python3)
+%if 0%{?fedora}
+BuildRequires: libpsl-devel
+%endif
+BuildRequires: gcc
+
+Requires: libcurl%{?_isa} = %{epoch}:%{version}-%{release}
+
+%description
+curl is a command line tool for transferring data with URL syntax.
+
+%package -n libcurl
+Summary: A library for getting files from web servers
+BuildRequires: libidn2-devel
+
+%description -n libcurl
+libcurl is a free and easy-to-use client-side URL transfer library.
+
+%package devel
+Summary: Files needed for building applications with libcurl
+
+%description devel
+The libcurl-devel package includes header files and libraries.
+
+%prep
+%autosetup -p1
+# a tag-like line outside of the preamble
+Source3: ignored.tar.gz
+
+%build
+%configure --with-openssl
+%make_build
+
+%files
+%{_bindir}/curl
+
+%changelog
+* Mon Dec 11 2023 Jane Doe <jane@example.org> - 1:8.5.0-1
+- new upstream release
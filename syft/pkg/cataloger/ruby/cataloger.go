@@ -14,10 +14,11 @@ func NewGemFileLockCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseGemFileLockEntries, "**/Gemfile.lock")
 }
 
-// NewInstalledGemSpecCataloger returns a new Bundler cataloger object tailored for detecting installations of gems (e.g. Gemspec).
+// NewInstalledGemSpecCataloger returns a new Bundler cataloger object tailored for detecting installations of gems (e.g. Gemspec),
+// including the default gems shipped with the Ruby interpreter and gems vendored into a Bundler deployment (e.g. vendor/bundle).
 func NewInstalledGemSpecCataloger() pkg.Cataloger {
 	return generic.NewCataloger("ruby-installed-gemspec-cataloger").
-		WithParserByGlobs(parseGemSpecEntries, "**/specifications/**/*.gemspec")
+		WithParserByGlobs(parseInstalledGemSpecEntries, "**/specifications/**/*.gemspec")
 }
 
 // NewGemSpecCataloger looks for gems without the additional requirement of the gem being installed.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 
@@ -26,6 +27,9 @@ type gemData struct {
 	pkg.RubyGemspec `mapstructure:",squash" json:",inline"`
 }
 
+// match example:      /app/vendor/bundle/ruby/3.2.0/specifications/rack-3.0.8.gemspec   --->   /app/vendor/bundle
+var bundlePathPattern = regexp.MustCompile(`^(?P<bundlePath>(?:.*/)?vendor/bundle)/[^/]+/[^/]+/specifications/`)
+
 // match example:      Al\u003Ex   --->   003E
 var unicodePattern = regexp.MustCompile(`\\u(?P<unicode>[0-9A-F]{4})`)
 
@@ -114,6 +118,35 @@ func parseGemSpecEntries(_ context.Context, _ file.Resolver, _ *generic.Environm
 	return pkgs, nil, nil
 }
 
+// parseInstalledGemSpecEntries parses the gemspec file of an installed gem, noting whether the gem is a default gem of
+// the Ruby interpreter or was vendored into a Bundler deployment (by the location of the gemspec).
+func parseInstalledGemSpecEntries(ctx context.Context, resolver file.Resolver, env *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	pkgs, relationships, err := parseGemSpecEntries(ctx, resolver, env, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gemspecPath := reader.Path()
+	isDefault := path.Base(path.Dir(gemspecPath)) == "default" && path.Base(path.Dir(path.Dir(gemspecPath))) == "specifications"
+	bundlePath := internal.MatchNamedCaptureGroups(bundlePathPattern, gemspecPath)["bundlePath"]
+	if !isDefault && bundlePath == "" {
+		return pkgs, relationships, nil
+	}
+
+	for i := range pkgs {
+		metadata, ok := pkgs[i].Metadata.(pkg.RubyGemspec)
+		if !ok {
+			continue
+		}
+		metadata.Default = isDefault
+		metadata.BundlePath = bundlePath
+		pkgs[i].Metadata = metadata
+		pkgs[i].SetID()
+	}
+
+	return pkgs, relationships, nil
+}
+
 // renderUtf8 takes any string escaped string subsections from the ruby string and replaces those sections with the UTF8 runes.
 func renderUtf8(s string) string {
 	fullReplacement := unicodePattern.ReplaceAllStringFunc(s, func(unicodeSection string) string {
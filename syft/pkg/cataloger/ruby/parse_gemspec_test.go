@@ -34,3 +34,67 @@ func TestParseGemspec(t *testing.T) {
 
 	pkgtest.TestFileParser(t, fixture, parseGemSpecEntries, []pkg.Package{expectedPkg}, nil)
 }
+
+func TestParseInstalledGemspec(t *testing.T) {
+	jsonLocation := file.NewLocation("usr/local/lib/ruby/gems/3.2.0/specifications/default/json-2.6.3.gemspec")
+	rakeLocation := file.NewLocation("usr/local/lib/ruby/gems/3.2.0/specifications/rake-13.0.6.gemspec")
+	rackLocation := file.NewLocation("app/vendor/bundle/ruby/3.2.0/specifications/rack-3.0.8.gemspec")
+
+	expected := []pkg.Package{
+		{
+			Name:      "json",
+			Version:   "2.6.3",
+			FoundBy:   "ruby-installed-gemspec-cataloger",
+			PURL:      "pkg:gem/json@2.6.3",
+			Locations: file.NewLocationSet(jsonLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Type:      pkg.GemPkg,
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Ruby", jsonLocation)),
+			Language:  pkg.Ruby,
+			Metadata: pkg.RubyGemspec{
+				Name:     "json",
+				Version:  "2.6.3",
+				Authors:  []string{"Florian Frank"},
+				Homepage: "http://flori.github.com/json",
+				Default:  true,
+			},
+		},
+		{
+			Name:      "rake",
+			Version:   "13.0.6",
+			FoundBy:   "ruby-installed-gemspec-cataloger",
+			PURL:      "pkg:gem/rake@13.0.6",
+			Locations: file.NewLocationSet(rakeLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Type:      pkg.GemPkg,
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", rakeLocation)),
+			Language:  pkg.Ruby,
+			Metadata: pkg.RubyGemspec{
+				Name:     "rake",
+				Version:  "13.0.6",
+				Authors:  []string{"Hiroshi SHIBATA", "Eric Hodel", "Jim Weirich"},
+				Homepage: "https://github.com/ruby/rake",
+			},
+		},
+		{
+			Name:      "rack",
+			Version:   "3.0.8",
+			FoundBy:   "ruby-installed-gemspec-cataloger",
+			PURL:      "pkg:gem/rack@3.0.8",
+			Locations: file.NewLocationSet(rackLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+			Type:      pkg.GemPkg,
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", rackLocation)),
+			Language:  pkg.Ruby,
+			Metadata: pkg.RubyGemspec{
+				Name:       "rack",
+				Version:    "3.0.8",
+				Authors:    []string{"Leah Neukirchen"},
+				Homepage:   "https://github.com/rack/rack",
+				BundlePath: "app/vendor/bundle",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expected, nil).
+		TestCataloger(t, NewInstalledGemSpecCataloger())
+}
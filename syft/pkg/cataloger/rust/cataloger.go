@@ -15,6 +15,13 @@ func NewCargoLockCataloger() pkg.Cataloger {
 		WithParserByGlobs(parseCargoLock, "**/Cargo.lock")
 }
 
+// NewCargoInstallCataloger returns a new cataloger object for the crates installed with `cargo install`, as recorded
+// within the .crates2.json file of a cargo install root (e.g. ~/.cargo).
+func NewCargoInstallCataloger() pkg.Cataloger {
+	return generic.NewCataloger("rust-cargo-install-cataloger").
+		WithParserByGlobs(parseCargoInstalls, "**/.crates2.json")
+}
+
 // NewAuditBinaryCataloger returns a new Rust auditable binary cataloger object that can detect dependencies
 // in binaries produced with https://github.com/Shnatsel/rust-audit
 func NewAuditBinaryCataloger() pkg.Cataloger {
@@ -97,3 +97,28 @@ func Test_AuditBinaryCataloger_Globs(t *testing.T) {
 		})
 	}
 }
+
+func Test_CargoInstallCataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain .crates2.json files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"home/.cargo/.crates2.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewCargoInstallCataloger())
+		})
+	}
+}
@@ -25,6 +25,22 @@ func newPackageFromCargoMetadata(m pkg.RustCargoLockEntry, locations ...file.Loc
 	return p
 }
 
+func newPackageFromCargoInstall(name, version string, m pkg.RustCargoInstallEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Language:  pkg.Rust,
+		Type:      pkg.RustPkg,
+		Metadata:  m,
+	}
+
+	p.SetID()
+
+	return p
+}
+
 func newPackagesFromAudit(location file.Location, versionInfo rustaudit.VersionInfo) []pkg.Package {
 	var pkgs []pkg.Package
 
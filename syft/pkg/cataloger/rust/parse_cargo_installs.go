@@ -0,0 +1,102 @@
+package rust
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCargoInstalls
+
+// installKeyPattern matches the keys of the installs recorded by cargo, which are the package ID of each installed
+// crate (e.g. "ripgrep 14.1.0 (registry+https://github.com/rust-lang/crates.io-index)").
+var installKeyPattern = regexp.MustCompile(`^(?P<name>\S+) (?P<version>\S+) \((?P<source>.+)\)$`)
+
+// cargoInstalls represents the contents of a .crates2.json file, which cargo keeps within the root of each install
+// directory (by default the cargo home directory) to track the crates installed with `cargo install`.
+type cargoInstalls struct {
+	Installs map[string]cargoInstall `json:"installs"`
+}
+
+type cargoInstall struct {
+	Bins              []string `json:"bins"`
+	Features          []string `json:"features"`
+	AllFeatures       bool     `json:"all_features"`
+	NoDefaultFeatures bool     `json:"no_default_features"`
+	Profile           string   `json:"profile"`
+	Target            string   `json:"target"`
+	Rustc             string   `json:"rustc"`
+}
+
+// parseCargoInstalls is a parser function for .crates2.json contents, returning the crates installed with
+// `cargo install` along with the binaries installed for them (e.g. within ~/.cargo/bin).
+func parseCargoInstalls(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var doc cargoInstalls
+	if err := json.NewDecoder(reader).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("unable to parse .crates2.json: %w", err)
+	}
+
+	installRoot := path.Dir(reader.RealPath)
+
+	var pkgs []pkg.Package
+	for key, install := range doc.Installs {
+		match := installKeyPattern.FindStringSubmatch(key)
+		if match == nil {
+			log.WithFields("path", reader.RealPath, "key", key).Trace("unable to parse cargo install package ID")
+			continue
+		}
+		name := match[installKeyPattern.SubexpIndex("name")]
+		version := match[installKeyPattern.SubexpIndex("version")]
+
+		bins := install.Bins
+		sort.Strings(bins)
+
+		entry := pkg.RustCargoInstallEntry{
+			Source:            match[installKeyPattern.SubexpIndex("source")],
+			Bins:              bins,
+			Features:          install.Features,
+			AllFeatures:       install.AllFeatures,
+			NoDefaultFeatures: install.NoDefaultFeatures,
+			Profile:           install.Profile,
+			Target:            install.Target,
+			// the compiler version is recorded as the full "rustc -vV" output, where only the first line is of interest
+			Rustc: strings.TrimSpace(strings.SplitN(install.Rustc, "\n", 2)[0]),
+		}
+
+		locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+		locations = append(locations, binLocations(resolver, reader.Location, installRoot, bins)...)
+
+		pkgs = append(pkgs, newPackageFromCargoInstall(name, version, entry, locations...))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// binLocations returns the locations of the installed binaries that exist within the bin directory of the install root.
+func binLocations(resolver file.Resolver, location file.Location, installRoot string, bins []string) []file.Location {
+	if resolver == nil {
+		return nil
+	}
+
+	var locations []file.Location
+	for _, bin := range bins {
+		binLocation := resolver.RelativeFileByPath(location, path.Join(installRoot, "bin", bin))
+		if binLocation == nil {
+			continue
+		}
+		locations = append(locations, binLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+	return locations
+}
@@ -0,0 +1,74 @@
+package rust
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCargoInstalls(t *testing.T) {
+	fixture := "test-fixtures/cargo-install"
+	crates := file.NewLocation(".cargo/.crates2.json")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "cargo-nextest",
+			Version:   "0.9.67",
+			PURL:      "pkg:cargo/cargo-nextest@0.9.67",
+			FoundBy:   "rust-cargo-install-cataloger",
+			Locations: file.NewLocationSet(crates, file.NewLocation(".cargo/bin/cargo-nextest")),
+			Language:  pkg.Rust,
+			Type:      pkg.RustPkg,
+			Metadata: pkg.RustCargoInstallEntry{
+				Source:   "registry+https://github.com/rust-lang/crates.io-index",
+				Bins:     []string{"cargo-nextest"},
+				Features: []string{},
+				Profile:  "release",
+				Target:   "x86_64-unknown-linux-gnu",
+				Rustc:    "rustc 1.76.0 (07dca489a 2024-02-04)",
+			},
+		},
+		{
+			Name:      "mytool",
+			Version:   "0.1.0",
+			PURL:      "pkg:cargo/mytool@0.1.0",
+			FoundBy:   "rust-cargo-install-cataloger",
+			Locations: file.NewLocationSet(crates),
+			Language:  pkg.Rust,
+			Type:      pkg.RustPkg,
+			Metadata: pkg.RustCargoInstallEntry{
+				Source:      "git+https://github.com/example/mytool#1d3b5c7e9f0a2b4c6d8e0f1a3b5c7d9e1f3a5b7c",
+				Bins:        []string{"mytool", "mytool-server"},
+				Features:    []string{},
+				AllFeatures: true,
+				Profile:     "release",
+				Target:      "x86_64-unknown-linux-gnu",
+				Rustc:       "rustc 1.76.0 (07dca489a 2024-02-04)",
+			},
+		},
+		{
+			Name:      "ripgrep",
+			Version:   "14.1.0",
+			PURL:      "pkg:cargo/ripgrep@14.1.0",
+			FoundBy:   "rust-cargo-install-cataloger",
+			Locations: file.NewLocationSet(crates, file.NewLocation(".cargo/bin/rg")),
+			Language:  pkg.Rust,
+			Type:      pkg.RustPkg,
+			Metadata: pkg.RustCargoInstallEntry{
+				Source:   "registry+https://github.com/rust-lang/crates.io-index",
+				Bins:     []string{"rg"},
+				Features: []string{"pcre2"},
+				Profile:  "release",
+				Target:   "x86_64-unknown-linux-gnu",
+				Rustc:    "rustc 1.76.0 (07dca489a 2024-02-04)",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, fixture).
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewCargoInstallCataloger())
+}
@@ -0,0 +1,21 @@
+/*
+Package scheduledtask provides a concrete Cataloger implementation for tasks run on a schedule or at startup by the OS:
+cron jobs, systemd timers, and rc startup scripts.
+*/
+package scheduledtask
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for cron jobs (from system and user crontabs, as well as the periodic
+// script directories), systemd timers, and SysV-style startup scripts.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("scheduled-task-cataloger").
+		WithParserByGlobs(parseSystemCrontab, "**/etc/crontab", "**/etc/cron.d/*").
+		WithParserByGlobs(parseUserCrontab, "**/var/spool/cron/crontabs/*", "**/var/spool/cron/*", "**/etc/crontabs/*").
+		WithParserByGlobs(parsePeriodicScript, "**/etc/cron.hourly/*", "**/etc/cron.daily/*", "**/etc/cron.weekly/*", "**/etc/cron.monthly/*").
+		WithParserByGlobs(parseSystemdTimer, "**/systemd/system/*.timer", "**/systemd/user/*.timer").
+		WithParserByGlobs(parseStartupScript, "**/etc/init.d/*", "**/etc/rc.d/init.d/*", "**/etc/rc.local", "**/etc/rc.d/rc.local")
+}
@@ -0,0 +1,148 @@
+package scheduledtask
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain crontabs, periodic scripts, systemd timers, and startup scripts",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"etc/crontab",
+				"etc/cron.d/job",
+				"var/spool/cron/crontabs/user",
+				"var/spool/cron/user",
+				"etc/crontabs/user",
+				"etc/cron.hourly/job",
+				"etc/cron.daily/job",
+				"etc/cron.weekly/job",
+				"etc/cron.monthly/job",
+				"etc/systemd/system/a.timer",
+				"etc/systemd/system/a.service",
+				"usr/lib/systemd/user/b.timer",
+				"usr/lib/systemd/user/b.service",
+				"etc/init.d/x",
+				"etc/rc.d/init.d/x",
+				"etc/rc.local",
+				"etc/rc.d/rc.local",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewCataloger())
+		})
+	}
+}
+
+func TestCataloger_System(t *testing.T) {
+	crontabLocation := file.NewLocation("etc/crontab")
+	cronDLocation := file.NewLocation("etc/cron.d/php")
+	userCrontabLocation := file.NewLocation("var/spool/cron/crontabs/dev")
+	aptDailyTimerLocation := file.NewLocation("lib/systemd/system/apt-daily.timer")
+	aptDailyServiceLocation := file.NewLocation("lib/systemd/system/apt-daily.service")
+	backupTimerLocation := file.NewLocation("etc/systemd/system/backup.timer")
+	backupServiceLocation := file.NewLocation("lib/systemd/system/restic-backup.service")
+
+	task := func(name string, entry pkg.ScheduledTaskEntry, locations ...file.Location) pkg.Package {
+		return pkg.Package{
+			Name:      name,
+			FoundBy:   "scheduled-task-cataloger",
+			Locations: file.NewLocationSet(locations...),
+			Type:      pkg.ScheduledTaskPkg,
+			Metadata:  entry,
+		}
+	}
+
+	expected := []pkg.Package{
+		// note: run-parts is not referenced by an absolute path, so the task is named after the crontab
+		task("crontab", pkg.ScheduledTaskEntry{
+			Scheduler: pkg.CronScheduler,
+			Schedule:  "17 * * * *",
+			User:      "root",
+			Command:   "cd / && run-parts --report /etc/cron.hourly",
+		}, crontabLocation),
+		task("sessionclean", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "09,39 * * * *",
+			User:       "root",
+			Command:    "[ -x /usr/lib/php/sessionclean ] && if [ ! -d /run/systemd/system ]; then /usr/lib/php/sessionclean; fi",
+			Executable: "/usr/lib/php/sessionclean",
+		}, cronDLocation),
+		task("logrotate", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "@daily",
+			User:       "root",
+			Executable: "/etc/cron.daily/logrotate",
+		}, file.NewLocation("etc/cron.daily/logrotate")),
+		task("start-agent.sh", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "@reboot",
+			User:       "dev",
+			Command:    "/home/dev/bin/start-agent.sh >/dev/null 2>&1",
+			Executable: "/home/dev/bin/start-agent.sh",
+		}, userCrontabLocation),
+		task("pg_dump", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "30 2 * * 1-5",
+			User:       "dev",
+			Command:    `LC_ALL=C /usr/bin/pg_dump appdb > /backups/appdb-$(date +\%Y\%m\%d).sql`,
+			Executable: "/usr/bin/pg_dump",
+		}, userCrontabLocation),
+		// note: the text after the first unescaped "%" is the standard input of the command
+		task("mail", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.CronScheduler,
+			Schedule:   "*/5 * * * *",
+			User:       "dev",
+			Command:    `/usr/bin/mail -s "heartbeat" ops@example.com`,
+			Executable: "/usr/bin/mail",
+		}, userCrontabLocation),
+		task("apt.systemd.daily", pkg.ScheduledTaskEntry{
+			Scheduler:   pkg.SystemdTimerScheduler,
+			Schedule:    "OnCalendar=*-*-* 6,18:00",
+			Command:     "/usr/lib/apt/apt.systemd.daily update",
+			Executable:  "/usr/lib/apt/apt.systemd.daily",
+			Unit:        "apt-daily.service",
+			Description: "Daily apt download activities",
+		}, aptDailyTimerLocation, aptDailyServiceLocation),
+		// note: the service is not next to the timer, so is found within the other unit directories
+		task("restic", pkg.ScheduledTaskEntry{
+			Scheduler:   pkg.SystemdTimerScheduler,
+			Schedule:    "OnBootSec=15min; OnCalendar=Mon..Fri 02:00; OnCalendar=Sat,Sun 04:00",
+			Command:     "-/usr/bin/restic backup --exclude-caches /home",
+			Executable:  "/usr/bin/restic",
+			Unit:        "restic-backup.service",
+			Description: "Nightly backup",
+		}, backupTimerLocation, backupServiceLocation),
+		task("ssh", pkg.ScheduledTaskEntry{
+			Scheduler:   pkg.RCScheduler,
+			Executable:  "/etc/init.d/ssh",
+			Description: "OpenBSD Secure Shell server",
+		}, file.NewLocation("etc/init.d/ssh")),
+		task("rc.local", pkg.ScheduledTaskEntry{
+			Scheduler:  pkg.RCScheduler,
+			Executable: "/etc/rc.local",
+		}, file.NewLocation("etc/rc.local")),
+	}
+
+	// note: the backup file within /etc/cron.d, the placeholder within /etc/cron.daily, and the README within
+	// /etc/init.d are not tasks
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/system").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
@@ -0,0 +1,83 @@
+package scheduledtask
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// commandKeywords are the shell keywords after which the next word is (still) the command to run
+var commandKeywords = map[string]bool{
+	"if":    true,
+	"then":  true,
+	"else":  true,
+	"elif":  true,
+	"do":    true,
+	"while": true,
+	"until": true,
+	"!":     true,
+	"{":     true,
+}
+
+// commandWrappers are the programs which run the command given after their own options
+var commandWrappers = map[string]bool{
+	"exec":   true,
+	"nice":   true,
+	"nohup":  true,
+	"time":   true,
+	"ionice": true,
+}
+
+// commandExecutable returns the first program referenced by an absolute path within a shell command line (for example
+// "/usr/lib/php/sessionclean" for "[ -x /usr/lib/php/sessionclean ] && /usr/lib/php/sessionclean"), considering only
+// words in the position of a command (not arguments or redirections).
+func commandExecutable(command string) string {
+	// separate the control operators from the words around them
+	command = strings.NewReplacer(";", " ; ", "&", " & ", "|", " | ", "(", " ( ", ")", " ) ").Replace(command)
+
+	commandPosition := true
+	wrapped := false
+	for _, word := range strings.Fields(command) {
+		switch {
+		case word == ";" || word == "&" || word == "|" || word == "(" || word == ")":
+			commandPosition, wrapped = true, false
+			continue
+		case !commandPosition:
+			continue
+		case commandKeywords[word] || isAssignment(word):
+			continue
+		case commandWrappers[word]:
+			wrapped = true
+			continue
+		case wrapped && (strings.HasPrefix(word, "-") || isNumber(word)):
+			// options of the wrapper (e.g. "nice -n 19")
+			continue
+		}
+
+		commandPosition, wrapped = false, false
+		word = strings.Trim(word, `"'`)
+		if strings.HasPrefix(word, "/") {
+			return word
+		}
+	}
+	return ""
+}
+
+// isAssignment indicates if a word is an environment variable assignment (e.g. "LC_ALL=C").
+func isAssignment(word string) bool {
+	name, _, found := strings.Cut(word, "=")
+	if !found || name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumber(word string) bool {
+	_, err := strconv.Atoi(word)
+	return err == nil
+}
@@ -0,0 +1,48 @@
+package scheduledtask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_commandExecutable(t *testing.T) {
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{
+			command: "/usr/bin/certbot -q renew",
+			want:    "/usr/bin/certbot",
+		},
+		{
+			command: "test -x /usr/sbin/anacron || ( cd / && run-parts --report /etc/cron.daily )",
+			want:    "",
+		},
+		{
+			command: "[ -x /usr/lib/php/sessionclean ] && if [ ! -d /run/systemd/system ]; then /usr/lib/php/sessionclean; fi",
+			want:    "/usr/lib/php/sessionclean",
+		},
+		{
+			command: "command -v debian-sa1 > /dev/null && debian-sa1 1 1",
+			want:    "",
+		},
+		{
+			command: "HOME=/root nice -n 19 /usr/local/bin/backup.sh >/dev/null 2>&1",
+			want:    "/usr/local/bin/backup.sh",
+		},
+		{
+			command: "/opt/app/bin/cleanup|/usr/bin/logger -t cleanup",
+			want:    "/opt/app/bin/cleanup",
+		},
+		{
+			command: `'/opt/app/bin/report' --daily`,
+			want:    "/opt/app/bin/report",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			assert.Equal(t, tt.want, commandExecutable(tt.command))
+		})
+	}
+}
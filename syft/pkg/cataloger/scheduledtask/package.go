@@ -0,0 +1,28 @@
+package scheduledtask
+
+import (
+	"path"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// newScheduledTaskPackage returns a package for a task, named after the program it runs (when known), otherwise after
+// the file the task is defined in.
+func newScheduledTaskPackage(entry pkg.ScheduledTaskEntry, locations ...file.Location) pkg.Package {
+	name := path.Base(entry.Executable)
+	if entry.Executable == "" && len(locations) > 0 {
+		name = path.Base(locations[0].RealPath)
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.ScheduledTaskPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
@@ -0,0 +1,140 @@
+package scheduledtask
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var (
+	_ generic.Parser = parseSystemCrontab
+	_ generic.Parser = parseUserCrontab
+	_ generic.Parser = parsePeriodicScript
+)
+
+// runPartsNameExp matches the names of files that cron runs from /etc/cron.d and the periodic script directories
+// (via run-parts), which excludes backups left behind by package managers (e.g. "job.dpkg-old") and editors.
+var runPartsNameExp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// parseSystemCrontab is a parser function for /etc/crontab and the files within /etc/cron.d, where each job names the
+// user it runs as (e.g. "17 * * * * root cd / && run-parts --report /etc/cron.hourly").
+func parseSystemCrontab(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if path.Base(path.Dir(reader.RealPath)) == "cron.d" && !runPartsNameExp.MatchString(path.Base(reader.RealPath)) {
+		return nil, nil, nil
+	}
+	return parseCrontab(reader, "")
+}
+
+// parseUserCrontab is a parser function for the crontabs of individual users (e.g. /var/spool/cron/crontabs/<user>),
+// where the jobs run as the user the file is named after.
+func parseUserCrontab(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	user := path.Base(reader.RealPath)
+	if strings.HasPrefix(user, ".") {
+		return nil, nil, nil
+	}
+	return parseCrontab(reader, user)
+}
+
+// parsePeriodicScript is a parser function for the scripts within the periodic cron directories (e.g. /etc/cron.daily),
+// which are run at the interval of the directory.
+func parsePeriodicScript(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if !runPartsNameExp.MatchString(path.Base(reader.RealPath)) {
+		return nil, nil, nil
+	}
+
+	// e.g. "cron.daily" is run "@daily"
+	interval := strings.TrimPrefix(path.Base(path.Dir(reader.RealPath)), "cron.")
+
+	entry := pkg.ScheduledTaskEntry{
+		Scheduler:  pkg.CronScheduler,
+		Schedule:   "@" + interval,
+		User:       "root",
+		Executable: absolutePath(reader.RealPath),
+	}
+
+	return []pkg.Package{
+		newScheduledTaskPackage(entry, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// parseCrontab returns the jobs within a crontab file. When the user is not given, each job is expected to name the
+// user it runs as (following the schedule).
+func parseCrontab(reader file.LocationReadCloser, user string) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if isAssignment(fields[0]) {
+			// environment settings for the jobs (e.g. "SHELL=/bin/sh")
+			continue
+		}
+
+		scheduleFields := 5
+		if strings.HasPrefix(fields[0], "@") {
+			scheduleFields = 1
+		}
+
+		required := scheduleFields + 1
+		if user == "" {
+			required++
+		}
+		if len(fields) < required {
+			continue
+		}
+
+		entry := pkg.ScheduledTaskEntry{
+			Scheduler: pkg.CronScheduler,
+			Schedule:  strings.Join(fields[:scheduleFields], " "),
+			User:      user,
+		}
+
+		commandFields := fields[scheduleFields:]
+		if user == "" {
+			entry.User = commandFields[0]
+			commandFields = commandFields[1:]
+		}
+
+		entry.Command = crontabCommand(strings.Join(commandFields, " "))
+		entry.Executable = commandExecutable(entry.Command)
+
+		pkgs = append(pkgs, newScheduledTaskPackage(entry, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read crontab file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
+
+// crontabCommand returns the command of a job, where an unescaped "%" ends the command (the remainder is the standard
+// input of the command).
+func crontabCommand(command string) string {
+	for i := 0; i < len(command); i++ {
+		switch command[i] {
+		case '\\':
+			i++
+		case '%':
+			return strings.TrimSpace(command[:i])
+		}
+	}
+	return command
+}
+
+// absolutePath returns the path of a file as seen from the root of the scanned filesystem.
+func absolutePath(p string) string {
+	return path.Join("/", p)
+}
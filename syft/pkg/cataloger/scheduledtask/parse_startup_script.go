@@ -0,0 +1,70 @@
+package scheduledtask
+
+import (
+	"bufio"
+	"context"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseStartupScript
+
+// nonStartupScripts are files within init script directories which are not run at startup themselves
+var nonStartupScripts = map[string]bool{
+	"README":    true,
+	"skeleton":  true,
+	"functions": true,
+}
+
+// parseStartupScript is a parser function for SysV-style init scripts (e.g. /etc/init.d/ssh) and the rc.local script,
+// which are run at startup. Only scripts (with a "#!" interpreter line) are considered.
+func parseStartupScript(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	name := path.Base(reader.RealPath)
+	if strings.HasPrefix(name, ".") || nonStartupScripts[name] {
+		return nil, nil, nil
+	}
+
+	scanner := bufio.NewScanner(reader)
+	if !scanner.Scan() || !strings.HasPrefix(scanner.Text(), "#!") {
+		return nil, nil, nil
+	}
+
+	entry := pkg.ScheduledTaskEntry{
+		Scheduler:   pkg.RCScheduler,
+		Executable:  absolutePath(reader.RealPath),
+		Description: lsbDescription(scanner),
+	}
+
+	return []pkg.Package{
+		newScheduledTaskPackage(entry, reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// lsbDescription returns the description from the LSB header of an init script, for example:
+//
+//	### BEGIN INIT INFO
+//	# Provides:          ssh
+//	# Short-Description: OpenBSD Secure Shell server
+//	### END INIT INFO
+func lsbDescription(scanner *bufio.Scanner) string {
+	inHeader := false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "### BEGIN INIT INFO"):
+			inHeader = true
+		case strings.HasPrefix(line, "### END INIT INFO"):
+			return ""
+		case inHeader:
+			if value, found := strings.CutPrefix(line, "# Short-Description:"); found {
+				return strings.TrimSpace(value)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package scheduledtask
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/systemd"
+)
+
+var _ generic.Parser = parseSystemdTimer
+
+// timerSettings are the settings of the [Timer] section which determine when a timer elapses
+var timerSettings = []string{
+	"OnActiveSec",
+	"OnBootSec",
+	"OnStartupSec",
+	"OnUnitActiveSec",
+	"OnUnitInactiveSec",
+	"OnCalendar",
+}
+
+// parseSystemdTimer is a parser function for systemd timer units, which describe when the service activated by the
+// timer is run. The command run is read from the service unit (which is named after the timer unless otherwise set).
+func parseSystemdTimer(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	timer, err := systemd.Read(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse systemd timer unit: %w", err)
+	}
+
+	var schedule []string
+	for _, setting := range timerSettings {
+		for _, value := range timer.Values("Timer", setting) {
+			schedule = append(schedule, setting+"="+value)
+		}
+	}
+
+	unit := timer.Value("Timer", "Unit")
+	if unit == "" {
+		unit = strings.TrimSuffix(path.Base(reader.RealPath), ".timer") + ".service"
+	}
+
+	entry := pkg.ScheduledTaskEntry{
+		Scheduler:   pkg.SystemdTimerScheduler,
+		Schedule:    strings.Join(schedule, "; "),
+		Unit:        unit,
+		Description: timer.Value("Unit", "Description"),
+	}
+
+	locations := []file.Location{reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+
+	if service, serviceLocation := systemd.ReadRelatedUnit(resolver, reader.Location, unit); service != nil {
+		entry.Command = service.Value("Service", "ExecStart")
+		entry.Executable = systemd.ExecutablePath(entry.Command)
+		locations = append(locations, serviceLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+	}
+
+	return []pkg.Package{newScheduledTaskPackage(entry, locations...)}, nil, nil
+}
@@ -0,0 +1,21 @@
+/*
+Package snap provides a concrete Cataloger implementation for snaps (.snap files) and other squashfs images found on
+disk, optionally cataloging the packages held within each image.
+*/
+package snap
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+const catalogerName = "snap-cataloger"
+
+// NewCataloger returns a new cataloger object for snaps (described by the meta/snap.yaml file within each snap). When
+// configured to search the contents of images, the files within each snap (or other squashfs image) are cataloged with
+// the given content catalogers, where packages found within a snap are related to the snap that contains them.
+func NewCataloger(cfg CatalogerConfig, contentCatalogers ...pkg.Cataloger) pkg.Cataloger {
+	p := snapParser{cfg: cfg, contentCatalogers: contentCatalogers}
+	return generic.NewCataloger(catalogerName).
+		WithParserByGlobs(p.parseSnap, "**/*.snap", "**/*.squashfs")
+}
@@ -0,0 +1,111 @@
+package snap
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"var/lib/snapd/snaps/hello_42.snap",
+			"images/rootfs.squashfs",
+		}).
+		TestCataloger(t, NewCataloger(DefaultCatalogerConfig()))
+}
+
+func TestCataloger(t *testing.T) {
+	location := file.NewLocation("var/lib/snapd/snaps/contoso-agent_42.snap")
+
+	agent := pkg.Package{
+		Name:      "contoso-agent",
+		Version:   "3.1.0",
+		FoundBy:   "snap-cataloger",
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", location)),
+		PURL:      "pkg:snap/contoso-agent@3.1.0?revision=42",
+		Type:      pkg.SnapPkg,
+		Metadata: pkg.SnapEntry{
+			Name:          "contoso-agent",
+			Version:       "3.1.0",
+			Revision:      "42",
+			SnapType:      "app",
+			Base:          "core22",
+			Grade:         "stable",
+			Confinement:   "strict",
+			Architectures: []string{"amd64"},
+			Summary:       "Contoso monitoring agent",
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		cfg                   CatalogerConfig
+		expected              []pkg.Package
+		expectedRelationships []artifact.Relationship
+	}{
+		{
+			name:     "snaps without contents",
+			cfg:      DefaultCatalogerConfig(),
+			expected: []pkg.Package{agent},
+		},
+		{
+			name: "snaps and squashfs images with contents",
+			cfg:  DefaultCatalogerConfig().WithSearchContents(true),
+			expected: []pkg.Package{
+				agent,
+				newTestContentPackage("var/lib/snapd/snaps/contoso-agent_42.snap", "lib/python3.10/site-packages/requests-2.31.0.dist-info/METADATA"),
+				// packages within squashfs images other than snaps have no containing package
+				newTestContentPackage("opt/images/rootfs.squashfs", "var/lib/dpkg/status"),
+			},
+			expectedRelationships: []artifact.Relationship{
+				{
+					From: agent,
+					To:   newTestContentPackage("var/lib/snapd/snaps/contoso-agent_42.snap", "lib/python3.10/site-packages/requests-2.31.0.dist-info/METADATA"),
+					Type: artifact.ContainsRelationship,
+				},
+			},
+		},
+		{
+			name: "contents beyond the maximum size are not cataloged",
+			cfg:  DefaultCatalogerConfig().WithSearchContents(true).WithMaxContentsSize(16),
+			expected: []pkg.Package{
+				agent,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, "test-fixtures/snaps").
+				Expects(test.expected, test.expectedRelationships).
+				TestCataloger(t, NewCataloger(test.cfg, newTestContentCataloger()))
+		})
+	}
+}
+
+func newTestContentPackage(image, p string) pkg.Package {
+	l := file.NewLocationFromCoordinates(file.NewLocation(image).Coordinates)
+	l.AccessPath = image + ":" + p
+	return pkg.Package{
+		Name:      path.Base(p),
+		FoundBy:   "snap-cataloger",
+		Locations: file.NewLocationSet(l),
+	}
+}
+
+func newTestContentCataloger() pkg.Cataloger {
+	return generic.NewCataloger("test-content-cataloger").
+		WithParserByGlobs(func(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+			return []pkg.Package{{Name: path.Base(reader.RealPath), Locations: file.NewLocationSet(reader.Location)}}, nil, nil
+		}, "**/METADATA", "**/var/lib/dpkg/status")
+}
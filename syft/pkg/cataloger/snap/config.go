@@ -0,0 +1,28 @@
+package snap
+
+type CatalogerConfig struct {
+	// SearchContents indicates whether to catalog the packages within snaps and other squashfs images, where packages
+	// found within a snap are related to the snap that contains them.
+	SearchContents bool `yaml:"search-contents" json:"search-contents" mapstructure:"search-contents"`
+
+	// MaxContentsSize is the largest amount of (uncompressed) data that will be extracted from a single image in order
+	// to catalog the packages within it (the remainder of a larger image is not cataloged).
+	MaxContentsSize int64 `yaml:"max-contents-size" json:"max-contents-size" mapstructure:"max-contents-size"`
+}
+
+func DefaultCatalogerConfig() CatalogerConfig {
+	return CatalogerConfig{
+		SearchContents:  false,
+		MaxContentsSize: 2 * 1024 * 1024 * 1024,
+	}
+}
+
+func (c CatalogerConfig) WithSearchContents(input bool) CatalogerConfig {
+	c.SearchContents = input
+	return c
+}
+
+func (c CatalogerConfig) WithMaxContentsSize(input int64) CatalogerConfig {
+	c.MaxContentsSize = input
+	return c
+}
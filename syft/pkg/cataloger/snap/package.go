@@ -0,0 +1,39 @@
+package snap
+
+import (
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newSnapPackage(entry pkg.SnapEntry, license string, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.Name,
+		Version:   entry.Version,
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(location, license)...),
+		PURL:      packageURL(entry),
+		Type:      pkg.SnapPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(entry pkg.SnapEntry) string {
+	var qualifiers packageurl.Qualifiers
+	if entry.Revision != "" {
+		qualifiers = append(qualifiers, packageurl.Qualifier{Key: "revision", Value: entry.Revision})
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.SnapPkg.PackageURLType(),
+		"",
+		entry.Name,
+		entry.Version,
+		qualifiers,
+		"",
+	).ToString()
+}
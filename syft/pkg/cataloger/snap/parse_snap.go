@@ -0,0 +1,179 @@
+package snap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/sylabs/squashfs"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/payload"
+)
+
+const snapYAMLPath = "meta/snap.yaml"
+
+// match example:      /var/lib/snapd/snaps/core22_1380.snap   --->   core22, 1380
+var snapFilePattern = regexp.MustCompile(`^(?P<name>[a-z0-9][a-z0-9-]*)_(?P<revision>x?[0-9]+)\.snap$`)
+
+// snapYAML is the subset of the meta/snap.yaml file of a snap that describes the snap.
+type snapYAML struct {
+	Name          string       `yaml:"name"`
+	Version       string       `yaml:"version"`
+	Type          string       `yaml:"type"`
+	Base          string       `yaml:"base"`
+	Grade         string       `yaml:"grade"`
+	Confinement   string       `yaml:"confinement"`
+	Architectures stringOrList `yaml:"architectures"`
+	Summary       string       `yaml:"summary"`
+	License       string       `yaml:"license"`
+}
+
+// stringOrList is a list of strings that may be written as a single string (e.g. "architectures: all").
+type stringOrList []string
+
+func (l *stringOrList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = []string{value.Value}
+		return nil
+	}
+	var values []string
+	if err := value.Decode(&values); err != nil {
+		return err
+	}
+	*l = values
+	return nil
+}
+
+// snapParser parses snaps and other squashfs images, cataloging the files within each image (when configured to) with
+// the given content catalogers.
+type snapParser struct {
+	cfg               CatalogerConfig
+	contentCatalogers []pkg.Cataloger
+}
+
+var _ generic.Parser = snapParser{}.parseSnap
+
+// parseSnap is a parser function for a squashfs image, returning a package for the snap described by the image (if the
+// image is a snap), and the packages found within the files of the image.
+func (p snapParser) parseSnap(ctx context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	r, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read squashfs image: %w", err)
+	}
+
+	image, err := squashfs.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read squashfs image: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	snapPkg, err := readSnapPackage(image, reader.Location)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Debug("unable to read snap metadata")
+	}
+	if snapPkg != nil {
+		// relationships refer to packages as they are reported, so the cataloger that found the packages is set up front
+		snapPkg.FoundBy = catalogerName
+		pkgs = append(pkgs, *snapPkg)
+	}
+
+	if !p.cfg.SearchContents || len(p.contentCatalogers) == 0 {
+		return pkgs, nil, nil
+	}
+
+	extracted, err := payload.New(p.cfg.MaxContentsSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer extracted.Cleanup()
+
+	if err := extractImage(image, extracted); err != nil {
+		// the files that were extracted are still cataloged
+		log.WithFields("path", reader.RealPath, "error", err).Debug("unable to extract squashfs image")
+	}
+
+	contentPkgs, relationships := extracted.Catalog(ctx, p.contentCatalogers, catalogerName, reader.Location)
+	if snapPkg != nil {
+		relationships = append(relationships, payload.ContainsRelationships(*snapPkg, contentPkgs)...)
+	}
+
+	return append(pkgs, contentPkgs...), relationships, nil
+}
+
+// readSnapPackage returns a package for the snap described by the meta/snap.yaml file within the image, if the image
+// is a snap.
+func readSnapPackage(image fs.FS, location file.Location) (*pkg.Package, error) {
+	contents, err := fs.ReadFile(image, snapYAMLPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// this is a squashfs image other than a snap
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var metadata snapYAML
+	if err := yaml.Unmarshal(contents, &metadata); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", snapYAMLPath, err)
+	}
+	if metadata.Name == "" {
+		return nil, nil
+	}
+
+	entry := pkg.SnapEntry{
+		Name:          metadata.Name,
+		Version:       metadata.Version,
+		SnapType:      metadata.Type,
+		Base:          metadata.Base,
+		Grade:         metadata.Grade,
+		Confinement:   metadata.Confinement,
+		Architectures: metadata.Architectures,
+		Summary:       strings.TrimSpace(metadata.Summary),
+	}
+	if entry.SnapType == "" {
+		// snaps without a declared type are applications
+		entry.SnapType = "app"
+	}
+
+	// the store revision is only known by the name that snapd gives to the snap file
+	fileName := internal.MatchNamedCaptureGroups(snapFilePattern, path.Base(location.RealPath))
+	if fileName["name"] == entry.Name {
+		entry.Revision = fileName["revision"]
+	}
+
+	p := newSnapPackage(entry, metadata.License, location)
+	return &p, nil
+}
+
+// extractImage extracts the regular files within a squashfs image into the given payload, stopping once the payload
+// has reached its maximum size.
+func extractImage(image fs.FS, extracted *payload.Payload) error {
+	return fs.WalkDir(image, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		f, err := image.Open(name)
+		if err != nil {
+			return fmt.Errorf("unable to open %q: %w", name, err)
+		}
+		defer internal.CloseAndLogError(f, name)
+
+		return extracted.Add(name, f)
+	})
+}
@@ -19,3 +19,11 @@ func NewCocoapodsCataloger() pkg.Cataloger {
 	return generic.NewCataloger("cocoapods-cataloger").
 		WithParserByGlobs(parsePodfileLock, "**/Podfile.lock")
 }
+
+// NewCarthageCataloger returns a new Carthage cataloger object, which catalogs the dependencies pinned within
+// Cartfile.resolved files and the dependencies built into Carthage/Build directories.
+func NewCarthageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("carthage-cataloger").
+		WithParserByGlobs(parseCartfileResolved, "**/Cartfile.resolved").
+		WithParserByGlobs(parseCarthageBuildVersion, "**/Carthage/Build/.*.version")
+}
@@ -3,22 +3,34 @@ package swift
 import (
 	"testing"
 
+	"github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
 )
 
 func Test_Cataloger_Globs(t *testing.T) {
 	tests := []struct {
-		name     string
-		fixture  string
-		expected []string
+		name      string
+		fixture   string
+		cataloger pkg.Cataloger
+		expected  []string
 	}{
 		{
-			name:    "obtain swift files",
-			fixture: "test-fixtures/glob-paths",
+			name:      "obtain swift files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewCocoapodsCataloger(),
 			expected: []string{
 				"src/Podfile.lock",
 			},
 		},
+		{
+			name:      "obtain carthage files",
+			fixture:   "test-fixtures/glob-paths",
+			cataloger: NewCarthageCataloger(),
+			expected: []string{
+				"src/Cartfile.resolved",
+				"src/Carthage/Build/.Alamofire.version",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -26,7 +38,7 @@ func Test_Cataloger_Globs(t *testing.T) {
 			pkgtest.NewCatalogTester().
 				FromDirectory(t, test.fixture).
 				ExpectsResolverContentQueries(test.expected).
-				TestCataloger(t, NewCocoapodsCataloger())
+				TestCataloger(t, test.cataloger)
 		})
 	}
 }
@@ -69,3 +69,32 @@ func swiftPackageManagerPackageURL(name, version, sourceURL string) string {
 		"",
 	).ToString()
 }
+
+func newCarthagePackage(namespace, name, version string, metadata any, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		PURL:      carthagePackageURL(namespace, name, version),
+		Locations: file.NewLocationSet(locations...),
+		Type:      pkg.CarthagePkg,
+		Language:  pkg.Swift,
+		Metadata:  metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func carthagePackageURL(namespace, name, version string) string {
+	var qualifiers packageurl.Qualifiers
+
+	return packageurl.NewPackageURL(
+		"carthage",
+		namespace,
+		name,
+		version,
+		qualifiers,
+		"",
+	).ToString()
+}
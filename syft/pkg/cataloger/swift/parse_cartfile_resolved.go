@@ -0,0 +1,107 @@
+package swift
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCartfileResolved
+
+// cartfileResolvedEntryExp matches a single pinned dependency within a Cartfile.resolved file, for example:
+// `github "Alamofire/Alamofire" "5.6.4"` or `binary "https://my.domain.com/release/MyFramework.json" "2.3"`
+var cartfileResolvedEntryExp = regexp.MustCompile(`^(github|git|binary)\s+"([^"]+)"\s+"([^"]+)"`)
+
+// parseCartfileResolved is a parser function for Carthage Cartfile.resolved contents, returning all dependencies pinned.
+func parseCartfileResolved(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var pkgs []pkg.Package
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		match := cartfileResolvedEntryExp.FindStringSubmatch(line)
+		if match == nil {
+			log.WithFields("path", reader.RealPath, "line", line).Trace("unable to parse Cartfile.resolved entry")
+			continue
+		}
+		origin, source, version := match[1], match[2], match[3]
+
+		namespace, name := carthageNamespaceAndName(origin, source)
+		if name == "" {
+			continue
+		}
+
+		pkgs = append(
+			pkgs,
+			newCarthagePackage(
+				namespace,
+				name,
+				version,
+				pkg.CarthageCartfileResolvedEntry{
+					Origin: origin,
+					Source: source,
+				},
+				reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			),
+		)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Cartfile.resolved file: %w", err)
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// carthageNamespaceAndName returns the package URL namespace (the host and owner of the repository) and name of a
+// Cartfile.resolved dependency, for example:
+//
+//	github "Alamofire/Alamofire" returns "github.com/Alamofire", "Alamofire"
+//	git "https://gitlab.com/owner/Project.git" returns "gitlab.com/owner", "Project"
+//	git "git@gitlab.com:owner/Project.git" returns "gitlab.com/owner", "Project"
+//	binary "https://my.domain.com/release/MyFramework.json" returns "my.domain.com/release", "MyFramework"
+func carthageNamespaceAndName(origin, source string) (string, string) {
+	location := source
+	switch {
+	case strings.Contains(source, "://"):
+		u, err := url.Parse(source)
+		if err != nil {
+			return "", ""
+		}
+		location = path.Join(u.Host, u.Path)
+	case origin == "github":
+		// the GitHub shorthand is "owner/repository"
+		location = path.Join("github.com", source)
+	default:
+		// scp-like git locations, e.g. "git@github.com:owner/repository.git"
+		if _, after, ok := strings.Cut(location, "@"); ok {
+			location = after
+		}
+		location = strings.Replace(location, ":", "/", 1)
+	}
+
+	location = strings.Trim(location, "/")
+	name := strings.TrimSuffix(strings.TrimSuffix(path.Base(location), ".git"), ".json")
+	namespace := path.Dir(location)
+	if namespace == "." {
+		namespace = ""
+	}
+
+	return namespace, name
+}
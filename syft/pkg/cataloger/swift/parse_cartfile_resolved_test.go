@@ -0,0 +1,130 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCartfileResolved(t *testing.T) {
+	fixture := "test-fixtures/Cartfile.resolved"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "Alamofire",
+			Version:   "5.6.4",
+			PURL:      "pkg:carthage/github.com/Alamofire/Alamofire@5.6.4",
+			Locations: locations,
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageCartfileResolvedEntry{
+				Origin: "github",
+				Source: "Alamofire/Alamofire",
+			},
+		},
+		{
+			Name:      "FirebaseAnalyticsBinary",
+			Version:   "10.20.0",
+			PURL:      "pkg:carthage/dl.google.com/dl/firebase/ios/carthage/FirebaseAnalyticsBinary@10.20.0",
+			Locations: locations,
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageCartfileResolvedEntry{
+				Origin: "binary",
+				Source: "https://dl.google.com/dl/firebase/ios/carthage/FirebaseAnalyticsBinary.json",
+			},
+		},
+		{
+			Name:      "Logger",
+			Version:   "0f9ab2fe8c1cf2e2d3a1d2c0e0b4b6e9c2a7d3f1",
+			PURL:      "pkg:carthage/bitbucket.org/acme/Logger@0f9ab2fe8c1cf2e2d3a1d2c0e0b4b6e9c2a7d3f1",
+			Locations: locations,
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageCartfileResolvedEntry{
+				Origin: "git",
+				Source: "https://bitbucket.org/acme/Logger.git",
+			},
+		},
+		{
+			Name:      "Networking",
+			Version:   "v2.1.0",
+			PURL:      "pkg:carthage/gitlab.com/acme/Networking@v2.1.0",
+			Locations: locations,
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageCartfileResolvedEntry{
+				Origin: "git",
+				Source: "git@gitlab.com:acme/Networking.git",
+			},
+		},
+		{
+			Name:      "ReactiveSwift",
+			Version:   "7.1.1",
+			PURL:      "pkg:carthage/github.com/ReactiveCocoa/ReactiveSwift@7.1.1",
+			Locations: locations,
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageCartfileResolvedEntry{
+				Origin: "github",
+				Source: "ReactiveCocoa/ReactiveSwift",
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseCartfileResolved, expectedPkgs, expectedRelationships)
+}
+
+func Test_carthageNamespaceAndName(t *testing.T) {
+	tests := []struct {
+		origin        string
+		source        string
+		wantNamespace string
+		wantName      string
+	}{
+		{
+			origin:        "github",
+			source:        "Alamofire/Alamofire",
+			wantNamespace: "github.com/Alamofire",
+			wantName:      "Alamofire",
+		},
+		{
+			origin:        "github",
+			source:        "https://enterprise.local/ghe/desktop/git-error-translations",
+			wantNamespace: "enterprise.local/ghe/desktop",
+			wantName:      "git-error-translations",
+		},
+		{
+			origin:        "git",
+			source:        "https://gitlab.com/owner/Project.git",
+			wantNamespace: "gitlab.com/owner",
+			wantName:      "Project",
+		},
+		{
+			origin:        "git",
+			source:        "git@gitlab.com:owner/Project.git",
+			wantNamespace: "gitlab.com/owner",
+			wantName:      "Project",
+		},
+		{
+			origin:        "binary",
+			source:        "https://my.domain.com/release/MyFramework.json",
+			wantNamespace: "my.domain.com/release",
+			wantName:      "MyFramework",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			namespace, name := carthageNamespaceAndName(tt.origin, tt.source)
+			assert.Equal(t, tt.wantNamespace, namespace)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package swift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseCarthageBuildVersion
+
+// carthageVersionFramework is a single framework built for a platform within a Carthage/Build/.<name>.version file.
+type carthageVersionFramework struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+}
+
+// parseCarthageBuildVersion is a parser function for the Carthage/Build/.<name>.version files written by Carthage
+// for each dependency built, for example:
+//
+//	{
+//	  "commitish" : "5.6.4",
+//	  "iOS" : [
+//	    {
+//	      "hash" : "1d6b4a5b...",
+//	      "name" : "Alamofire",
+//	      "linking" : "dynamic"
+//	    }
+//	  ]
+//	}
+func parseCarthageBuildVersion(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var contents map[string]json.RawMessage
+	if err := json.NewDecoder(reader).Decode(&contents); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Carthage version file: %w", err)
+	}
+
+	var commitish string
+	if raw, ok := contents["commitish"]; ok {
+		if err := json.Unmarshal(raw, &commitish); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse Carthage version file commitish: %w", err)
+		}
+	}
+
+	// the name of the dependency is only found within the file name (".<name>.version")
+	name := strings.TrimSuffix(strings.TrimPrefix(path.Base(reader.RealPath), "."), ".version")
+	if name == "" || commitish == "" {
+		return nil, nil, nil
+	}
+
+	// every other field is a platform with the frameworks built for it
+	var platforms []string
+	for key := range contents {
+		if key != "commitish" {
+			platforms = append(platforms, key)
+		}
+	}
+	sort.Strings(platforms)
+
+	var frameworks []pkg.CarthageFramework
+	for _, platform := range platforms {
+		var built []carthageVersionFramework
+		if err := json.Unmarshal(contents[platform], &built); err != nil {
+			// not a platform entry
+			continue
+		}
+		for _, f := range built {
+			frameworks = append(frameworks, pkg.CarthageFramework{
+				Name:     f.Name,
+				Platform: platform,
+				Hash:     f.Hash,
+			})
+		}
+	}
+
+	return []pkg.Package{
+		newCarthagePackage(
+			"",
+			name,
+			commitish,
+			pkg.CarthageBuildVersionEntry{
+				Commitish:  commitish,
+				Frameworks: frameworks,
+			},
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
@@ -0,0 +1,43 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseCarthageBuildVersion(t *testing.T) {
+	fixture := "test-fixtures/carthage-build/Carthage/Build/.Alamofire.version"
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "Alamofire",
+			Version:   "5.6.4",
+			PURL:      "pkg:carthage/Alamofire@5.6.4",
+			Locations: file.NewLocationSet(file.NewLocation(fixture)),
+			Language:  pkg.Swift,
+			Type:      pkg.CarthagePkg,
+			Metadata: pkg.CarthageBuildVersionEntry{
+				Commitish: "5.6.4",
+				Frameworks: []pkg.CarthageFramework{
+					{
+						Name:     "Alamofire",
+						Platform: "Mac",
+						Hash:     "b5a0a6d1ef10a3a2e9c10d52cd43c01f4b3b0ac8a1b2c1e0e4e69dfab7ec4a25",
+					},
+					{
+						Name:     "Alamofire",
+						Platform: "iOS",
+						Hash:     "0c0f0d4d34a7f9c5e1f24f7d0b5a2e2b8e0c1c3f2d7e4a6b9c8d1e0f3a2b5c4d",
+					},
+				},
+			},
+		},
+	}
+
+	var expectedRelationships []artifact.Relationship
+
+	pkgtest.TestFileParser(t, fixture, parseCarthageBuildVersion, expectedPkgs, expectedRelationships)
+}
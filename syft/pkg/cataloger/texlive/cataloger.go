@@ -0,0 +1,17 @@
+/*
+Package texlive provides a concrete Cataloger implementation for packages installed within a TeX Live distribution.
+*/
+package texlive
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewTlpdbCataloger returns a new cataloger object initialized for TeX Live package databases.
+func NewTlpdbCataloger() pkg.Cataloger {
+	return generic.NewCataloger("texlive-tlpdb-cataloger").
+		WithParserByGlobs(parseTlpdb, "**/tlpkg/texlive.tlpdb").
+		WithProcessors(dependency.Processor(tlpdbEntryDependencySpecifier))
+}
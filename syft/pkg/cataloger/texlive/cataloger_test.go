@@ -0,0 +1,108 @@
+package texlive
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestTlpdbCataloger(t *testing.T) {
+	location := file.NewLocation("usr/local/texlive/2024/tlpkg/texlive.tlpdb")
+
+	amsmath := pkg.Package{
+		Name:      "amsmath",
+		Version:   "2.17t",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("lppl1.3c", location)),
+		FoundBy:   "texlive-tlpdb-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:texlive/amsmath@2.17t",
+		Type:      pkg.TeXLivePkg,
+		Metadata: pkg.TeXLiveTlpdbEntry{
+			Category:         "Package",
+			Revision:         72779,
+			ShortDescription: "AMS mathematical facilities for LaTeX",
+			CatalogueVersion: "2.17t",
+			CTANPath:         "/macros/latex/required/amsmath",
+		},
+	}
+
+	// note: collection-basic is not installed, so there is no relationship for that dependency
+	collectionLatex := pkg.Package{
+		Name:      "collection-latex",
+		Version:   "71578",
+		FoundBy:   "texlive-tlpdb-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:texlive/collection-latex@71578",
+		Type:      pkg.TeXLivePkg,
+		Metadata: pkg.TeXLiveTlpdbEntry{
+			Category:         "Collection",
+			Revision:         71578,
+			ShortDescription: "LaTeX fundamental packages",
+			Depends:          []string{"amsmath", "kpathsea", "collection-basic"},
+		},
+	}
+
+	// note: the platform-specific binaries (kpathsea.x86_64-linux) are part of the package rather than a package
+	kpathsea := pkg.Package{
+		Name:      "kpathsea",
+		Version:   "71435",
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("lgpl2.1", location)),
+		FoundBy:   "texlive-tlpdb-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:texlive/kpathsea@71435",
+		Type:      pkg.TeXLivePkg,
+		Metadata: pkg.TeXLiveTlpdbEntry{
+			Category:         "TLCore",
+			Revision:         71435,
+			ShortDescription: "Path searching library for TeX-related files",
+			CTANPath:         "/systems/knuth/dist/kpathsea",
+		},
+	}
+
+	expectedPkgs := []pkg.Package{amsmath, collectionLatex, kpathsea}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: amsmath,
+			To:   collectionLatex,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: kpathsea,
+			To:   collectionLatex,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewTlpdbCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain texlive package databases",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"usr/local/texlive/2024/tlpkg/texlive.tlpdb",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewTlpdbCataloger())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package texlive
+
+import (
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = tlpdbEntryDependencySpecifier
+
+func tlpdbEntryDependencySpecifier(p pkg.Package) dependency.Specification {
+	meta, ok := p.Metadata.(pkg.TeXLiveTlpdbEntry)
+	if !ok {
+		log.Tracef("cataloger failed to extract texlive metadata for package %+v", p.Name)
+		return dependency.Specification{}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{p.Name},
+			Requires: meta.Depends,
+		},
+	}
+}
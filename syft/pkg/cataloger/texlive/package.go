@@ -0,0 +1,42 @@
+package texlive
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newTlpdbPackage(entry tlpdbEntry, location file.Location) pkg.Package {
+	version := entry.metadata.CatalogueVersion
+	if version == "" {
+		version = strconv.Itoa(entry.metadata.Revision)
+	}
+
+	p := pkg.Package{
+		Name:      entry.name,
+		Version:   version,
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicensesFromLocation(location.WithoutAnnotations(), strings.Fields(entry.license)...)...),
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		PURL:      packageURL(entry.name, version),
+		Type:      pkg.TeXLivePkg,
+		Metadata:  entry.metadata,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.TeXLivePkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
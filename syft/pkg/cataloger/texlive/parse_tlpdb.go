@@ -0,0 +1,134 @@
+package texlive
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseTlpdb
+
+// platformSuffix is the suffix of dependencies on the platform-specific (binary) part of a package, which are
+// recorded as separate entries named after the package and the platform (e.g. "kpathsea.x86_64-linux").
+const platformSuffix = ".ARCH"
+
+type tlpdbEntry struct {
+	name     string
+	license  string
+	metadata pkg.TeXLiveTlpdbEntry
+}
+
+// parseTlpdb is a parser function for TeX Live package databases, which have an entry for each installed package,
+// separated by blank lines, for example:
+//
+//	name amsmath
+//	category Package
+//	revision 72779
+//	shortdesc AMS mathematical facilities for LaTeX
+//	depend amsmath.ARCH
+//	runfiles size=116
+//	 texmf-dist/tex/latex/amsmath/amsmath.sty
+//	catalogue-ctan /macros/latex/required/amsmath
+//	catalogue-license lppl1.3c
+//	catalogue-version 2.17t
+func parseTlpdb(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	entries, err := readTlpdbEntries(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read texlive package database: %w", err)
+	}
+
+	names := make(map[string]bool)
+	for _, entry := range entries {
+		names[entry.name] = true
+	}
+
+	var pkgs []pkg.Package
+	for _, entry := range entries {
+		// the installation configuration is recorded as entries too (e.g. "00texlive.installation")
+		if entry.metadata.Category == "ConfigFile" || strings.HasPrefix(entry.name, "00texlive.") {
+			continue
+		}
+		// platform-specific entries are the binaries of the package they are named after, not packages on their own
+		if base, _, found := strings.Cut(entry.name, "."); found && names[base] {
+			continue
+		}
+		pkgs = append(pkgs, newTlpdbPackage(entry, reader.Location))
+	}
+
+	return pkgs, nil, nil
+}
+
+func readTlpdbEntries(reader file.LocationReadCloser) ([]tlpdbEntry, error) {
+	var entries []tlpdbEntry
+	var current *tlpdbEntry
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// the files of a package are listed on indented lines after runfiles, docfiles, srcfiles, and binfiles
+		if strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			if current != nil {
+				entries = append(entries, *current)
+			}
+			current = nil
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		if key == "name" {
+			if current != nil {
+				entries = append(entries, *current)
+			}
+			current = &tlpdbEntry{name: value}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		switch key {
+		case "category":
+			current.metadata.Category = value
+		case "revision":
+			revision, err := strconv.Atoi(value)
+			if err != nil {
+				log.WithFields("package", current.name, "revision", value).Trace("unable to parse texlive package revision")
+				continue
+			}
+			current.metadata.Revision = revision
+		case "shortdesc":
+			current.metadata.ShortDescription = value
+		case "depend":
+			// note: the installation settings entries also use "depend", but with setting values that have a colon
+			if value == "" || strings.HasSuffix(value, platformSuffix) || strings.Contains(value, ":") {
+				continue
+			}
+			current.metadata.Depends = append(current.metadata.Depends, value)
+		case "catalogue-ctan":
+			current.metadata.CTANPath = value
+		case "catalogue-license":
+			current.license = value
+		case "catalogue-version":
+			current.metadata.CatalogueVersion = value
+		}
+	}
+
+	if current != nil {
+		entries = append(entries, *current)
+	}
+
+	return entries, scanner.Err()
+}
@@ -0,0 +1,33 @@
+/*
+Package toolchain provides concrete Cataloger implementations for developer toolchains (language runtimes and build
+tools) managed by version managers: declared by asdf and mise configurations, and installed by SDKMAN.
+*/
+package toolchain
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewManifestCataloger returns a new cataloger object for the tool versions declared within asdf (.tool-versions) and
+// mise (mise.toml) configurations, such as those within projects and home directories.
+func NewManifestCataloger() pkg.Cataloger {
+	return generic.NewCataloger("toolchain-manifest-cataloger").
+		WithParserByGlobs(parseToolVersions, "**/.tool-versions").
+		WithParserByGlobs(parseMiseConfig,
+			"**/mise.toml",
+			"**/.mise.toml",
+			"**/mise.local.toml",
+			"**/.mise.local.toml",
+			"**/mise/config.toml",
+			"**/.mise/config.toml",
+			"**/.config/mise.toml",
+		)
+}
+
+// NewSDKMANCataloger returns a new cataloger object for the candidate versions installed by SDKMAN (e.g. within
+// ~/.sdkman/candidates).
+func NewSDKMANCataloger() pkg.Cataloger {
+	return generic.NewCataloger("sdkman-candidate-cataloger").
+		WithParserByGlobs(parseSDKMANConfig, sdkmanConfigGlob)
+}
@@ -0,0 +1,224 @@
+package toolchain
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_ManifestCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"project/.tool-versions",
+			"project/mise.toml",
+			"project/.mise.toml",
+			"project/mise.local.toml",
+			"project/.mise.local.toml",
+			"project/.mise/config.toml",
+			"project/.config/mise.toml",
+			"home/.config/mise/config.toml",
+		}).
+		TestCataloger(t, NewManifestCataloger())
+}
+
+func Test_SDKMANCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"usr/local/sdkman/etc/config",
+		}).
+		IgnoreUnfulfilledPathResponses("**/usr/local/sdkman/candidates/*/*/bin/*").
+		TestCataloger(t, NewSDKMANCataloger())
+}
+
+func primary(path string) file.Location {
+	return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+}
+
+func supporting(path string) file.Location {
+	return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+}
+
+func TestManifestCataloger_ToolVersions(t *testing.T) {
+	location := primary(".tool-versions")
+
+	expected := []pkg.Package{
+		{
+			Name:      "nodejs",
+			Version:   "20.11.0",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/nodejs@20.11.0",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "asdf", Default: true},
+		},
+		{
+			Name:      "python",
+			Version:   "3.12.1",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/python@3.12.1",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "asdf", Default: true},
+		},
+		{
+			Name:      "python",
+			Version:   "2.7.18",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/python@2.7.18",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "asdf"},
+		},
+		{
+			// built from a git reference
+			Name:      "golang",
+			Version:   "go1.22.0",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/golang@go1.22.0",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "asdf", Default: true},
+		},
+		{
+			// the fallback of a local path (the system ruby is not included)
+			Name:      "terraform",
+			Version:   "1.7.3",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:generic/terraform@1.7.3",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "asdf"},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/tool-versions").
+		Expects(expected, nil).
+		TestCataloger(t, NewManifestCataloger())
+}
+
+func TestManifestCataloger_Mise(t *testing.T) {
+	project := primary("project/mise.toml")
+	home := primary("home/.config/mise/config.toml")
+
+	expected := []pkg.Package{
+		{
+			Name:      "cli/cli",
+			Version:   "2.44.1",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/cli/cli@2.44.1",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Backend: "aqua", Default: true},
+		},
+		{
+			Name:      "go",
+			Version:   "1.22.0",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/go@1.22.0",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Default: true},
+		},
+		{
+			Name:      "node",
+			Version:   "20.11.0",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/node@20.11.0",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Default: true},
+		},
+		{
+			Name:      "prettier",
+			Version:   "3.2.5",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/prettier@3.2.5",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Backend: "npm", Default: true},
+		},
+		{
+			Name:      "python",
+			Version:   "3.12.1",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/python@3.12.1",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Default: true},
+		},
+		{
+			Name:      "python",
+			Version:   "3.11.7",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(project),
+			PURL:      "pkg:generic/python@3.11.7",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise"},
+		},
+		{
+			Name:      "usage",
+			Version:   "latest",
+			FoundBy:   "toolchain-manifest-cataloger",
+			Locations: file.NewLocationSet(home),
+			PURL:      "pkg:generic/usage@latest",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "mise", Default: true},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/mise").
+		Expects(expected, nil).
+		TestCataloger(t, NewManifestCataloger())
+}
+
+func TestManifestCataloger_Mise_Invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromString("mise.toml", "[tools\nnode = ").
+		WithError().
+		TestParser(t, parseMiseConfig)
+}
+
+func TestSDKMANCataloger(t *testing.T) {
+	config := supporting(".sdkman/etc/config")
+
+	expected := []pkg.Package{
+		{
+			Name:      "gradle",
+			Version:   "8.6",
+			FoundBy:   "sdkman-candidate-cataloger",
+			Locations: file.NewLocationSet(primary(".sdkman/candidates/gradle/8.6/bin/gradle"), config),
+			PURL:      "pkg:generic/gradle@8.6",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "sdkman", Default: true},
+		},
+		{
+			Name:      "java",
+			Version:   "17.0.10-tem",
+			FoundBy:   "sdkman-candidate-cataloger",
+			Locations: file.NewLocationSet(primary(".sdkman/candidates/java/17.0.10-tem/bin/java"), config),
+			PURL:      "pkg:generic/java@17.0.10-tem",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "sdkman"},
+		},
+		{
+			Name:      "java",
+			Version:   "21.0.2-tem",
+			FoundBy:   "sdkman-candidate-cataloger",
+			Locations: file.NewLocationSet(primary(".sdkman/candidates/java/21.0.2-tem/bin/java"), config),
+			PURL:      "pkg:generic/java@21.0.2-tem",
+			Type:      pkg.ToolchainPkg,
+			Metadata:  pkg.ToolchainEntry{Manager: "sdkman", Default: true},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/sdkman").
+		Expects(expected, nil).
+		TestCataloger(t, NewSDKMANCataloger())
+}
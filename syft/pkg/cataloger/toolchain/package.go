@@ -0,0 +1,61 @@
+package toolchain
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	asdfManager   = "asdf"
+	miseManager   = "mise"
+	sdkmanManager = "sdkman"
+)
+
+func newPackage(name, version string, entry pkg.ToolchainEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Type:      pkg.ToolchainPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// packageURL returns the package URL for a tool, where the owner of tools named by repository (e.g. "cli/cli" from
+// the aqua or ubi backends of mise) is the namespace.
+func packageURL(name, version string) string {
+	var namespace string
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.ToolchainPkg.PackageURLType(),
+		namespace,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
+
+// declaredVersion returns the version of a tool as declared within a version manager configuration, where versions
+// that do not refer to a version installed by the manager (the system version or a local path) are not included.
+func declaredVersion(version string) (string, bool) {
+	switch {
+	case version == "", version == "system", strings.HasPrefix(version, "path:"):
+		return "", false
+	case strings.HasPrefix(version, "ref:"):
+		// a git reference the tool is built from
+		return strings.TrimPrefix(version, "ref:"), true
+	}
+	return version, true
+}
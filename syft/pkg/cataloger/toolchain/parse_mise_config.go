@@ -0,0 +1,89 @@
+package toolchain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseMiseConfig
+
+type miseConfig struct {
+	Tools map[string]any `toml:"tools"`
+}
+
+// parseMiseConfig is a parser function for mise configuration files, which declare the versions of each tool within
+// the [tools] table as a version, a list of versions (where the versions after the first are fallbacks), or tables
+// holding the version along with tool options, for example:
+//
+//	[tools]
+//	node = "20.11.0"
+//	python = ["3.12.1", "3.11.7"]
+//	go = { version = "1.22.0" }
+//	"npm:prettier" = "3.2.5"
+func parseMiseConfig(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var cfg miseConfig
+	if _, err := toml.NewDecoder(reader).Decode(&cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse mise configuration: %w", err)
+	}
+
+	location := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	keys := make([]string, 0, len(cfg.Tools))
+	for key := range cfg.Tools {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pkgs []pkg.Package
+	for _, key := range keys {
+		// tools may be qualified by the backend they are installed from (e.g. "npm:prettier" or "aqua:cli/cli")
+		backend, name, found := strings.Cut(key, ":")
+		if !found {
+			backend, name = "", key
+		}
+
+		for i, v := range miseToolVersions(cfg.Tools[key]) {
+			version, ok := declaredVersion(v)
+			if !ok {
+				continue
+			}
+			entry := pkg.ToolchainEntry{
+				Manager: miseManager,
+				Backend: backend,
+				Default: i == 0,
+			}
+			pkgs = append(pkgs, newPackage(name, version, entry, location))
+		}
+	}
+
+	return pkgs, nil, nil
+}
+
+// miseToolVersions returns the versions declared for a tool, which are given as a version, a table with the version
+// (along with tool options), or a list of either.
+func miseToolVersions(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case map[string]any:
+		if version, ok := v["version"].(string); ok {
+			return []string{version}
+		}
+	case []any:
+		var versions []string
+		for _, item := range v {
+			versions = append(versions, miseToolVersions(item)...)
+		}
+		return versions
+	}
+	return nil
+}
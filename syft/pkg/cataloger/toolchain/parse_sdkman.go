@@ -0,0 +1,127 @@
+package toolchain
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// sdkmanConfigGlob matches the configuration of an SDKMAN installation, which is found within ~/.sdkman by default
+// (or within the SDKMAN_DIR, such as /usr/local/sdkman within dev containers).
+const sdkmanConfigGlob = "**/*sdkman/etc/config"
+
+// sdkmanCurrentVersion is the link to the version of a candidate that is used by default.
+const sdkmanCurrentVersion = "current"
+
+var _ generic.Parser = parseSDKMANConfig
+
+// sdkmanCandidate is an installed version of an SDKMAN candidate, along with the executable kept as the evidence of
+// the version.
+type sdkmanCandidate struct {
+	name       string
+	version    string
+	executable file.Location
+}
+
+// parseSDKMANConfig is a parser function for the configuration of an SDKMAN installation, returning the candidate
+// versions installed alongside it, which are laid out as "candidates/<candidate>/<version>" (with the executables of
+// each version within the "bin" directory).
+func parseSDKMANConfig(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if resolver == nil {
+		return nil, nil, nil
+	}
+
+	candidates, err := installedCandidates(resolver, reader.Location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+
+	current := make(map[string]string)
+	var pkgs []pkg.Package
+	for _, c := range candidates {
+		// the executables of versions may differ, so the link is resolved by each version until found
+		if current[c.name] == "" {
+			current[c.name] = currentVersion(resolver, c.executable)
+		}
+		entry := pkg.ToolchainEntry{
+			Manager: sdkmanManager,
+			Default: current[c.name] == c.version,
+		}
+		pkgs = append(pkgs, newPackage(
+			c.name,
+			c.version,
+			entry,
+			c.executable.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+			config,
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+// installedCandidates returns the candidate versions installed alongside the given SDKMAN configuration
+// (<sdkman>/etc/config), ordered by candidate and version.
+func installedCandidates(resolver file.Resolver, config file.Location) ([]sdkmanCandidate, error) {
+	// note: the glob is not anchored, since paths may be relative to the root of the resolver
+	dir := strings.TrimPrefix(path.Join(path.Dir(path.Dir(config.RealPath)), "candidates"), "/")
+	locations, err := resolver.FilesByGlob(path.Join("**", dir, "*", "*", "bin", "*"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find SDKMAN candidates: %w", err)
+	}
+
+	// the first executable of each version is kept as the evidence of the version
+	found := make(map[[2]string]file.Location)
+	for _, l := range locations {
+		rel, ok := strings.CutPrefix(strings.TrimPrefix(l.RealPath, "/"), dir+"/")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(rel, "/")
+		if len(parts) != 4 || parts[1] == sdkmanCurrentVersion {
+			continue
+		}
+		key := [2]string{parts[0], parts[1]}
+		if existing, ok := found[key]; !ok || l.RealPath < existing.RealPath {
+			found[key] = l
+		}
+	}
+
+	var candidates []sdkmanCandidate
+	for key, l := range found {
+		candidates = append(candidates, sdkmanCandidate{name: key[0], version: key[1], executable: l})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].name != candidates[j].name {
+			return candidates[i].name < candidates[j].name
+		}
+		return candidates[i].version < candidates[j].version
+	})
+	return candidates, nil
+}
+
+// currentVersion returns the version of a candidate that the "current" link refers to, by resolving the given
+// executable of the candidate through the link (links to directories are not resolved on their own).
+func currentVersion(resolver file.Resolver, executable file.Location) string {
+	candidate := path.Dir(path.Dir(path.Dir(executable.RealPath)))
+	locations, err := resolver.FilesByPath(path.Join(candidate, sdkmanCurrentVersion, "bin", path.Base(executable.RealPath)))
+	if err != nil || len(locations) == 0 {
+		log.WithFields("path", candidate, "error", err).Trace("unable to resolve current SDKMAN candidate version")
+		return ""
+	}
+
+	version := path.Dir(path.Dir(locations[0].RealPath))
+	if path.Dir(version) != candidate {
+		return ""
+	}
+	return path.Base(version)
+}
@@ -0,0 +1,56 @@
+package toolchain
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseToolVersions
+
+// parseToolVersions is a parser function for asdf .tool-versions files (also read by mise), which declare one or more
+// versions for each tool where the versions after the first are fallbacks, for example:
+//
+//	nodejs 20.11.0
+//	python 3.12.1 2.7.18 # comment
+func parseToolVersions(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	location := reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	var pkgs []pkg.Package
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		name := fields[0]
+		first := true
+		for _, v := range fields[1:] {
+			version, ok := declaredVersion(v)
+			if !ok {
+				first = false
+				continue
+			}
+			entry := pkg.ToolchainEntry{
+				Manager: asdfManager,
+				Default: first,
+			}
+			pkgs = append(pkgs, newPackage(name, version, entry, location))
+			first = false
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read .tool-versions file: %w", err)
+	}
+
+	return pkgs, nil, nil
+}
@@ -0,0 +1,16 @@
+/*
+Package unity provides a concrete Cataloger implementation for packages managed by the Unity Package Manager (UPM).
+*/
+package unity
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewPackageCataloger returns a new cataloger object for Unity Packages/manifest.json and Packages/packages-lock.json files.
+func NewPackageCataloger() pkg.Cataloger {
+	return generic.NewCataloger("unity-package-cataloger").
+		WithParserByGlobs(parsePackagesLock, "**/Packages/packages-lock.json").
+		WithParserByGlobs(parseManifest, "**/Packages/manifest.json")
+}
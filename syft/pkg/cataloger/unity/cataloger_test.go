@@ -0,0 +1,73 @@
+package unity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain unity package files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/Packages/manifest.json",
+				"src/Packages/packages-lock.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewPackageCataloger())
+		})
+	}
+}
+
+func TestCataloger_PrefersPackagesLock(t *testing.T) {
+	tests := []struct {
+		name         string
+		fixture      string
+		wantMetadata any
+		wantCount    int
+	}{
+		{
+			name:         "manifest and packages lock",
+			fixture:      "test-fixtures/project",
+			wantMetadata: pkg.UnityPackagesLockEntry{},
+			wantCount:    7,
+		},
+		{
+			name:         "manifest only",
+			fixture:      "test-fixtures/manifest-only",
+			wantMetadata: pkg.UnityManifestEntry{},
+			wantCount:    6,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsAssertion(func(t *testing.T, pkgs []pkg.Package, _ []artifact.Relationship) {
+					assert.Len(t, pkgs, test.wantCount)
+					for _, p := range pkgs {
+						assert.IsType(t, test.wantMetadata, p.Metadata)
+					}
+				}).
+				TestCataloger(t, NewPackageCataloger())
+		})
+	}
+}
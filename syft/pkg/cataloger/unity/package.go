@@ -0,0 +1,141 @@
+package unity
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// package sources (as found in packages-lock.json files)
+const (
+	sourceRegistry     = "registry"
+	sourceBuiltin      = "builtin"
+	sourceGit          = "git"
+	sourceEmbedded     = "embedded"
+	sourceLocal        = "local"
+	sourceLocalTarball = "local-tarball"
+)
+
+// defaultRegistryURL is the URL of the Unity package registry, used when no scoped registry applies
+const defaultRegistryURL = "https://packages.unity.com"
+
+func newPackagesLockPackage(name string, entry pkg.UnityPackagesLockEntry, locations ...file.Location) pkg.Package {
+	version := packageVersion(name, entry.Version, entry.Source)
+	if entry.Source == sourceGit && entry.Hash != "" {
+		// prefer the commit the package was resolved to over the (possibly mutable) revision that was requested
+		version = entry.Hash
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version, entry.Version, entry.Source, entry.URL),
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newManifestPackage(name string, entry pkg.UnityManifestEntry, locations ...file.Location) pkg.Package {
+	version := packageVersion(name, entry.Version, entry.Source)
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version, entry.Version, entry.Source, entry.URL),
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// manifestSource returns the source of a dependency declared in a manifest, based on the version (which may also be
+// a git URL or local path, e.g. "file:../my-package" or "https://github.com/owner/repo.git#v1.0.0").
+func manifestSource(version string) string {
+	switch {
+	case strings.HasPrefix(version, "file:"):
+		if isTarball(version) {
+			return sourceLocalTarball
+		}
+		return sourceLocal
+	case strings.Contains(version, "://"), strings.HasPrefix(version, "git@"), strings.HasPrefix(version, "git+"), strings.HasSuffix(version, ".git"):
+		return sourceGit
+	}
+	return sourceRegistry
+}
+
+// packageVersion returns the version of the package for the given declared or locked version: for git sources this is
+// the requested revision (if any) and for local tarballs this is taken from the file name (e.g. "file:../com.example.foo-1.2.3.tgz").
+func packageVersion(name, version, source string) string {
+	switch source {
+	case sourceGit:
+		if _, revision, found := strings.Cut(version, "#"); found {
+			return revision
+		}
+		return ""
+	case sourceLocalTarball:
+		base := path.Base(strings.TrimPrefix(version, "file:"))
+		base = strings.TrimSuffix(strings.TrimSuffix(base, ".tgz"), ".tar.gz")
+		if v, found := strings.CutPrefix(base, name+"-"); found {
+			return v
+		}
+		return ""
+	case sourceLocal, sourceEmbedded:
+		return ""
+	}
+	return version
+}
+
+func isTarball(version string) bool {
+	return strings.HasSuffix(version, ".tgz") || strings.HasSuffix(version, ".tar.gz")
+}
+
+func packageURL(name, version, declaredVersion, source, registryURL string) string {
+	var qualifiers packageurl.Qualifiers
+
+	switch source {
+	case sourceRegistry:
+		if registryURL != "" && strings.TrimSuffix(registryURL, "/") != defaultRegistryURL {
+			qualifiers = append(qualifiers, packageurl.Qualifier{Key: "repository_url", Value: registryURL})
+		}
+	case sourceGit:
+		if vcsURL := gitURL(declaredVersion); vcsURL != "" {
+			qualifiers = append(qualifiers, packageurl.Qualifier{Key: pkg.PURLQualifierVCSURL, Value: vcsURL})
+		}
+	}
+
+	return packageurl.NewPackageURL(
+		pkg.UnityPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		qualifiers,
+		"",
+	).ToString()
+}
+
+// gitURL returns the repository URL of a git dependency without the revision (fragment) or the path within the
+// repository (query, e.g. "?path=/Packages/com.example.foo").
+func gitURL(version string) string {
+	version, _, _ = strings.Cut(version, "#")
+	if u, err := url.Parse(version); err == nil && u.Scheme != "" {
+		u.RawQuery = ""
+		return u.String()
+	}
+	version, _, _ = strings.Cut(version, "?")
+	return version
+}
@@ -0,0 +1,94 @@
+package unity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseManifest
+
+// manifest represents a Unity Packages/manifest.json file, which holds the packages a project directly depends on.
+type manifest struct {
+	Dependencies     map[string]string `json:"dependencies"`
+	ScopedRegistries []scopedRegistry  `json:"scopedRegistries"`
+}
+
+type scopedRegistry struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Scopes []string `json:"scopes"`
+}
+
+func parseManifest(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if hasPackagesLock(resolver, reader.Location) {
+		// the packages-lock.json file next to the manifest describes the same packages (and more), so only use the lock
+		return nil, nil, nil
+	}
+
+	var m manifest
+	if err := json.NewDecoder(reader).Decode(&m); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Unity manifest.json file: %w", err)
+	}
+
+	var names []string
+	for name := range m.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	for _, name := range names {
+		version := m.Dependencies[name]
+		entry := pkg.UnityManifestEntry{
+			Version: version,
+			Source:  manifestSource(version),
+		}
+		if entry.Source == sourceRegistry {
+			entry.URL = m.registryURL(name)
+		}
+
+		pkgs = append(pkgs, newManifestPackage(
+			name,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	pkg.Sort(pkgs)
+
+	return pkgs, nil, nil
+}
+
+// registryURL returns the URL of the scoped registry with the most specific scope matching the package name (or an
+// empty string when the default Unity registry is used).
+func (m manifest) registryURL(name string) string {
+	var match, matchURL string
+	for _, r := range m.ScopedRegistries {
+		for _, scope := range r.Scopes {
+			if (name == scope || strings.HasPrefix(name, scope+".")) && len(scope) > len(match) {
+				match = scope
+				matchURL = r.URL
+			}
+		}
+	}
+	return matchURL
+}
+
+func hasPackagesLock(resolver file.Resolver, location file.Location) bool {
+	if resolver == nil {
+		return false
+	}
+
+	lockPath := path.Join(path.Dir(location.RealPath), "packages-lock.json")
+	locations, err := resolver.FilesByPath(lockPath)
+	return err == nil && len(locations) > 0
+}
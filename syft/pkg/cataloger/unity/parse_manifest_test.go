@@ -0,0 +1,112 @@
+package unity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseManifest(t *testing.T) {
+	fixture := "test-fixtures/manifest.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "com.example.local",
+			PURL:      "pkg:unity/com.example.local",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "file:../LocalPackages/com.example.local",
+				Source:  "local",
+			},
+		},
+		{
+			Name:      "com.example.tarball",
+			Version:   "2.1.0",
+			PURL:      "pkg:unity/com.example.tarball@2.1.0",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "file:../Tarballs/com.example.tarball-2.1.0.tgz",
+				Source:  "local-tarball",
+			},
+		},
+		{
+			Name:      "com.openupm.unitask",
+			Version:   "2.5.4",
+			PURL:      "pkg:unity/com.openupm.unitask@2.5.4?vcs_url=https://github.com/Cysharp/UniTask.git",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "https://github.com/Cysharp/UniTask.git?path=src/UniTask/Assets/Plugins/UniTask#2.5.4",
+				Source:  "git",
+			},
+		},
+		{
+			Name:      "com.unity.modules.ui",
+			Version:   "1.0.0",
+			PURL:      "pkg:unity/com.unity.modules.ui@1.0.0",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "1.0.0",
+				Source:  "registry",
+			},
+		},
+		{
+			Name:      "com.unity.textmeshpro",
+			Version:   "3.0.6",
+			PURL:      "pkg:unity/com.unity.textmeshpro@3.0.6",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "3.0.6",
+				Source:  "registry",
+			},
+		},
+		{
+			Name:      "jp.keijiro.klak.motion",
+			Version:   "1.1.0",
+			PURL:      "pkg:unity/jp.keijiro.klak.motion@1.1.0?repository_url=https://registry.npmjs.com",
+			Locations: locations,
+			Language:  pkg.Dotnet,
+			Type:      pkg.UnityPkg,
+			Metadata: pkg.UnityManifestEntry{
+				Version: "1.1.0",
+				Source:  "registry",
+				URL:     "https://registry.npmjs.com",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseManifest, expectedPkgs, nil)
+}
+
+func Test_manifestSource(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "3.0.6", want: "registry"},
+		{version: "file:../com.example.foo", want: "local"},
+		{version: "file:../com.example.foo-1.0.0.tgz", want: "local-tarball"},
+		{version: "https://github.com/owner/repo.git#v1.0.0", want: "git"},
+		{version: "git@github.com:owner/repo.git", want: "git"},
+		{version: "git+https://example.com/owner/repo", want: "git"},
+		{version: "ssh://git@example.com/owner/repo.git", want: "git"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.want, manifestSource(tt.version))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package unity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/internal/relationship"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePackagesLock
+
+// packagesLock represents a Unity Packages/packages-lock.json file, which holds all resolved packages for a project
+// (both direct and transitive dependencies).
+type packagesLock struct {
+	Dependencies map[string]pkg.UnityPackagesLockEntry `json:"dependencies"`
+}
+
+func parsePackagesLock(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var lock packagesLock
+	if err := json.NewDecoder(reader).Decode(&lock); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse packages-lock.json file: %w", err)
+	}
+
+	var names []string
+	for name := range lock.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pkgs []pkg.Package
+	pkgMap := make(map[string]pkg.Package)
+	for _, name := range names {
+		p := newPackagesLockPackage(
+			name,
+			lock.Dependencies[name],
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		)
+		pkgs = append(pkgs, p)
+		pkgMap[name] = p
+	}
+
+	var relationships []artifact.Relationship
+	for _, name := range names {
+		p := pkgMap[name]
+		for depName := range lock.Dependencies[name].Dependencies {
+			// each package is resolved to a single version within a project, so the name is enough to find it
+			depPkg, ok := pkgMap[depName]
+			if !ok {
+				log.Debug("unable to find package in map", depName)
+				continue
+			}
+			relationships = append(relationships, artifact.Relationship{
+				From: depPkg,
+				To:   p,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+	}
+
+	pkg.Sort(pkgs)
+	relationship.Sort(relationships)
+
+	return pkgs, relationships, nil
+}
@@ -0,0 +1,137 @@
+package unity
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePackagesLock(t *testing.T) {
+	fixture := "test-fixtures/packages-lock.json"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	local := pkg.Package{
+		Name:      "com.example.local",
+		PURL:      "pkg:unity/com.example.local",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version:      "file:../LocalPackages/com.example.local",
+			Source:       "local",
+			Dependencies: map[string]string{},
+		},
+	}
+	tarball := pkg.Package{
+		Name:      "com.example.tarball",
+		Version:   "2.1.0",
+		PURL:      "pkg:unity/com.example.tarball@2.1.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version:      "file:../Tarballs/com.example.tarball-2.1.0.tgz",
+			Source:       "local-tarball",
+			Dependencies: map[string]string{},
+		},
+	}
+	uniTask := pkg.Package{
+		Name:      "com.openupm.unitask",
+		Version:   "f213ff497e4ff462a77319cf677cf20cc0860ca9",
+		PURL:      "pkg:unity/com.openupm.unitask@f213ff497e4ff462a77319cf677cf20cc0860ca9?vcs_url=https://github.com/Cysharp/UniTask.git",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version:      "https://github.com/Cysharp/UniTask.git?path=src/UniTask/Assets/Plugins/UniTask#2.5.4",
+			Source:       "git",
+			Hash:         "f213ff497e4ff462a77319cf677cf20cc0860ca9",
+			Dependencies: map[string]string{},
+		},
+	}
+	modulesUI := pkg.Package{
+		Name:      "com.unity.modules.ui",
+		Version:   "1.0.0",
+		PURL:      "pkg:unity/com.unity.modules.ui@1.0.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version:      "1.0.0",
+			Source:       "builtin",
+			Dependencies: map[string]string{},
+		},
+	}
+	textMeshPro := pkg.Package{
+		Name:      "com.unity.textmeshpro",
+		Version:   "3.0.6",
+		PURL:      "pkg:unity/com.unity.textmeshpro@3.0.6",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version: "3.0.6",
+			Source:  "registry",
+			URL:     "https://packages.unity.com",
+			Dependencies: map[string]string{
+				"com.unity.ugui": "1.0.0",
+			},
+		},
+	}
+	ugui := pkg.Package{
+		Name:      "com.unity.ugui",
+		Version:   "1.0.0",
+		PURL:      "pkg:unity/com.unity.ugui@1.0.0",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version: "1.0.0",
+			Source:  "builtin",
+			Depth:   1,
+			Dependencies: map[string]string{
+				"com.unity.modules.ui": "1.0.0",
+			},
+		},
+	}
+	klakMotion := pkg.Package{
+		Name:      "jp.keijiro.klak.motion",
+		Version:   "1.1.0",
+		PURL:      "pkg:unity/jp.keijiro.klak.motion@1.1.0?repository_url=https://registry.npmjs.com",
+		Locations: locations,
+		Language:  pkg.Dotnet,
+		Type:      pkg.UnityPkg,
+		Metadata: pkg.UnityPackagesLockEntry{
+			Version:      "1.1.0",
+			Source:       "registry",
+			URL:          "https://registry.npmjs.com",
+			Dependencies: map[string]string{},
+		},
+	}
+
+	expectedPkgs := []pkg.Package{local, tarball, uniTask, modulesUI, textMeshPro, ugui, klakMotion}
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: ugui,
+			To:   textMeshPro,
+			Type: artifact.DependencyOfRelationship,
+		},
+		{
+			From: modulesUI,
+			To:   ugui,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parsePackagesLock, expectedPkgs, expectedRelationships)
+}
+
+func Test_corruptPackagesLock(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromString("Packages/packages-lock.json", `{"dependencies": [`).
+		WithError().
+		TestParser(t, parsePackagesLock)
+}
@@ -0,0 +1,19 @@
+/*
+Package unreal provides a concrete Cataloger implementation for Unreal Engine plugins.
+*/
+package unreal
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// NewPluginCataloger returns a new cataloger object for Unreal Engine plugin descriptors (.uplugin files) and the
+// marketplace plugins enabled by projects (.uproject files).
+func NewPluginCataloger() pkg.Cataloger {
+	return generic.NewCataloger("unreal-plugin-cataloger").
+		WithParserByGlobs(parseUPlugin, "**/*.uplugin").
+		WithParserByGlobs(parseUProject, "**/*.uproject").
+		WithProcessors(dependency.Processor(pluginDependencySpecifier))
+}
@@ -0,0 +1,128 @@
+package unreal
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain unreal plugin and project files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"src/Game.uproject",
+				"src/Plugins/Foo/Foo.uplugin",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewPluginCataloger())
+		})
+	}
+}
+
+func TestCataloger_Project(t *testing.T) {
+	lowEntryLocation := file.NewLocation("Plugins/Marketplace/LowEntryExtStdLib/LowEntryExtStdLib.uplugin")
+	toolsLocation := file.NewLocation("Plugins/MyGameTools/MyGameTools.uplugin")
+	projectLocation := file.NewLocation("MyGame.uproject")
+
+	lowEntry := pkg.Package{
+		Name:      "LowEntryExtStdLib",
+		Version:   "3.4.0",
+		FoundBy:   "unreal-plugin-cataloger",
+		PURL:      "pkg:unreal/LowEntryExtStdLib@3.4.0",
+		Locations: file.NewLocationSet(lowEntryLocation),
+		Language:  pkg.CPP,
+		Type:      pkg.UnrealPkg,
+		Metadata: pkg.UnrealPluginEntry{
+			FriendlyName:   "Low Entry Extended Standard Library",
+			VersionNumber:  34,
+			EngineVersion:  "5.3.0",
+			Category:       "Low Entry",
+			CreatedBy:      "Low Entry",
+			CreatedByURL:   "https://lowentry.com/",
+			MarketplaceURL: "com.epicgames.launcher://ue/marketplace/content/3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+			MarketplaceID:  "3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+			Installed:      true,
+			Plugins: []pkg.UnrealPluginReference{
+				{
+					Name:    "EnhancedInput",
+					Enabled: true,
+				},
+				{
+					Name:     "OnlineSubsystemSteam",
+					Enabled:  true,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	tools := pkg.Package{
+		Name:      "MyGameTools",
+		Version:   "1.0",
+		FoundBy:   "unreal-plugin-cataloger",
+		PURL:      "pkg:unreal/MyGameTools@1.0",
+		Locations: file.NewLocationSet(toolsLocation),
+		Language:  pkg.CPP,
+		Type:      pkg.UnrealPkg,
+		Metadata: pkg.UnrealPluginEntry{
+			FriendlyName:  "My Game Tools",
+			VersionNumber: 1,
+			Category:      "Other",
+			CreatedBy:     "Example Studio",
+			Plugins: []pkg.UnrealPluginReference{
+				{
+					Name:    "LowEntryExtStdLib",
+					Enabled: true,
+				},
+				{
+					Name:    "EditorScriptingUtilities",
+					Enabled: true,
+				},
+			},
+		},
+	}
+
+	// note: LowEntryExtStdLib is also enabled by the project, but is found within the project plugins directory
+	advancedSessions := pkg.Package{
+		Name:      "AdvancedSessions",
+		FoundBy:   "unreal-plugin-cataloger",
+		PURL:      "pkg:unreal/AdvancedSessions",
+		Locations: file.NewLocationSet(projectLocation),
+		Language:  pkg.CPP,
+		Type:      pkg.UnrealPkg,
+		Metadata: pkg.UnrealProjectPluginEntry{
+			EngineAssociation: "5.3",
+			MarketplaceURL:    "com.epicgames.launcher://ue/marketplace/product/advanced-sessions-plugin",
+			MarketplaceID:     "advanced-sessions-plugin",
+		},
+	}
+
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: lowEntry,
+			To:   tools,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/project").
+		Expects([]pkg.Package{lowEntry, tools, advancedSessions}, expectedRelationships).
+		TestCataloger(t, NewPluginCataloger())
+}
@@ -0,0 +1,28 @@
+package unreal
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = pluginDependencySpecifier
+
+// pluginDependencySpecifier describes plugins as providing their name and requiring the (enabled) plugins they
+// reference, where plugins are referenced by name only.
+func pluginDependencySpecifier(p pkg.Package) dependency.Specification {
+	var requires []string
+	if metadata, ok := p.Metadata.(pkg.UnrealPluginEntry); ok {
+		for _, plugin := range metadata.Plugins {
+			if plugin.Enabled {
+				requires = append(requires, plugin.Name)
+			}
+		}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{p.Name},
+			Requires: requires,
+		},
+	}
+}
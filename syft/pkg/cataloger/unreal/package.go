@@ -0,0 +1,74 @@
+package unreal
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPluginPackage(name, version string, entry pkg.UnrealPluginEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, version),
+		Language:  pkg.CPP,
+		Type:      pkg.UnrealPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func newProjectPluginPackage(name string, entry pkg.UnrealProjectPluginEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageURL(name, ""),
+		Language:  pkg.CPP,
+		Type:      pkg.UnrealPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.UnrealPkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
+
+// marketplaceID returns the identifier of a plugin from its marketplace URL, which is the path segment following
+// the listing kind, for example:
+//
+//	com.epicgames.launcher://ue/marketplace/content/4b9d6e3a0b4c4c5e9b1f0e2a3c4d5e6f  -->  4b9d6e3a0b4c4c5e9b1f0e2a3c4d5e6f
+//	com.epicgames.launcher://ue/marketplace/product/advanced-sessions             -->  advanced-sessions
+//	https://www.fab.com/listings/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d             -->  0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d
+func marketplaceID(marketplaceURL string) string {
+	u, err := url.Parse(strings.TrimSpace(marketplaceURL))
+	if err != nil {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		switch strings.ToLower(segments[i]) {
+		case "content", "product", "listings":
+			return segments[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package unreal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseUPlugin
+
+// utf8BOM is the byte order mark that the engine (and editors on Windows) commonly write at the start of descriptors
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// uplugin represents an Unreal Engine plugin descriptor (a .uplugin file). Note that the name of the plugin is the
+// name of the descriptor file.
+type uplugin struct {
+	Version               int               `json:"Version"`
+	VersionName           string            `json:"VersionName"`
+	FriendlyName          string            `json:"FriendlyName"`
+	Category              string            `json:"Category"`
+	CreatedBy             string            `json:"CreatedBy"`
+	CreatedByURL          string            `json:"CreatedByURL"`
+	MarketplaceURL        string            `json:"MarketplaceURL"`
+	EngineVersion         string            `json:"EngineVersion"`
+	IsBetaVersion         bool              `json:"IsBetaVersion"`
+	IsExperimentalVersion bool              `json:"IsExperimentalVersion"`
+	Installed             bool              `json:"Installed"`
+	Plugins               []pluginReference `json:"Plugins"`
+}
+
+// pluginReference represents a plugin referenced by a .uplugin or .uproject file.
+type pluginReference struct {
+	Name           string `json:"Name"`
+	Enabled        bool   `json:"Enabled"`
+	Optional       bool   `json:"Optional"`
+	MarketplaceURL string `json:"MarketplaceURL"`
+}
+
+func parseUPlugin(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var descriptor uplugin
+	if err := decodeDescriptor(reader, &descriptor); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse .uplugin file: %w", err)
+	}
+
+	var plugins []pkg.UnrealPluginReference
+	for _, p := range descriptor.Plugins {
+		if p.Name == "" {
+			continue
+		}
+		plugins = append(plugins, pkg.UnrealPluginReference{
+			Name:           p.Name,
+			Enabled:        p.Enabled,
+			Optional:       p.Optional,
+			MarketplaceURL: p.MarketplaceURL,
+		})
+	}
+
+	entry := pkg.UnrealPluginEntry{
+		FriendlyName:          descriptor.FriendlyName,
+		VersionNumber:         descriptor.Version,
+		EngineVersion:         descriptor.EngineVersion,
+		Category:              descriptor.Category,
+		CreatedBy:             descriptor.CreatedBy,
+		CreatedByURL:          descriptor.CreatedByURL,
+		MarketplaceURL:        descriptor.MarketplaceURL,
+		MarketplaceID:         marketplaceID(descriptor.MarketplaceURL),
+		IsBetaVersion:         descriptor.IsBetaVersion,
+		IsExperimentalVersion: descriptor.IsExperimentalVersion,
+		Installed:             descriptor.Installed,
+		Plugins:               plugins,
+	}
+
+	version := descriptor.VersionName
+	if version == "" && descriptor.Version > 0 {
+		version = strconv.Itoa(descriptor.Version)
+	}
+
+	name := strings.TrimSuffix(path.Base(reader.RealPath), path.Ext(reader.RealPath))
+
+	return []pkg.Package{
+		newPluginPackage(
+			name,
+			version,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
+
+// decodeDescriptor decodes a .uplugin or .uproject file, which are JSON files that may start with a byte order mark.
+func decodeDescriptor(reader io.Reader, v any) error {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes.TrimPrefix(contents, utf8BOM), v)
+}
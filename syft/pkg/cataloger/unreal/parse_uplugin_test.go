@@ -0,0 +1,80 @@
+package unreal
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseUPlugin(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		expected pkg.Package
+	}{
+		{
+			fixture: "test-fixtures/LowEntryExtStdLib.uplugin",
+			expected: pkg.Package{
+				Name:     "LowEntryExtStdLib",
+				Version:  "3.4.0",
+				PURL:     "pkg:unreal/LowEntryExtStdLib@3.4.0",
+				Language: pkg.CPP,
+				Type:     pkg.UnrealPkg,
+				Metadata: pkg.UnrealPluginEntry{
+					FriendlyName:   "Low Entry Extended Standard Library",
+					VersionNumber:  34,
+					EngineVersion:  "5.3.0",
+					Category:       "Low Entry",
+					CreatedBy:      "Low Entry",
+					CreatedByURL:   "https://lowentry.com/",
+					MarketplaceURL: "com.epicgames.launcher://ue/marketplace/content/3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+					MarketplaceID:  "3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+					Installed:      true,
+					Plugins: []pkg.UnrealPluginReference{
+						{
+							Name:    "EnhancedInput",
+							Enabled: true,
+						},
+						{
+							Name:     "OnlineSubsystemSteam",
+							Enabled:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			// note: this descriptor starts with a UTF-8 byte order mark
+			fixture: "test-fixtures/VersionNumberOnly.uplugin",
+			expected: pkg.Package{
+				Name:     "VersionNumberOnly",
+				Version:  "2",
+				PURL:     "pkg:unreal/VersionNumberOnly@2",
+				Language: pkg.CPP,
+				Type:     pkg.UnrealPkg,
+				Metadata: pkg.UnrealPluginEntry{
+					FriendlyName:  "Version Number Only",
+					VersionNumber: 2,
+					CreatedBy:     "Example Studio",
+					IsBetaVersion: true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			test.expected.Locations = file.NewLocationSet(file.NewLocation(test.fixture))
+			pkgtest.TestFileParser(t, test.fixture, parseUPlugin, []pkg.Package{test.expected}, nil)
+		})
+	}
+}
+
+func TestParseUPlugin_invalid(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromString("Invalid.uplugin", "{not json").
+		WithError().
+		TestParser(t, parseUPlugin)
+}
@@ -0,0 +1,79 @@
+package unreal
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseUProject
+
+// uproject represents an Unreal Engine project descriptor (a .uproject file).
+type uproject struct {
+	EngineAssociation string            `json:"EngineAssociation"`
+	Plugins           []pluginReference `json:"Plugins"`
+}
+
+// parseUProject returns the marketplace plugins enabled by a project. Other plugins referenced by the project are
+// either part of the engine or found within the Plugins directory of the project (which are cataloged from their
+// .uplugin files instead).
+func parseUProject(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var project uproject
+	if err := decodeDescriptor(reader, &project); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse .uproject file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, p := range project.Plugins {
+		if p.Name == "" || !p.Enabled || p.MarketplaceURL == "" {
+			continue
+		}
+		if hasProjectPlugin(resolver, reader.Location, p.Name) {
+			continue
+		}
+
+		entry := pkg.UnrealProjectPluginEntry{
+			EngineAssociation: project.EngineAssociation,
+			MarketplaceURL:    p.MarketplaceURL,
+			MarketplaceID:     marketplaceID(p.MarketplaceURL),
+			Optional:          p.Optional,
+		}
+
+		pkgs = append(pkgs, newProjectPluginPackage(
+			p.Name,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		))
+	}
+
+	return pkgs, nil, nil
+}
+
+// hasProjectPlugin indicates if the given plugin is found within the Plugins directory of the project.
+func hasProjectPlugin(resolver file.Resolver, projectLocation file.Location, name string) bool {
+	if resolver == nil {
+		return false
+	}
+
+	glob := "**/" + name + ".uplugin"
+	locations, err := resolver.FilesByGlob(glob)
+	if err != nil {
+		log.WithFields("error", err, "glob", glob).Trace("unable to search for project plugin")
+		return false
+	}
+
+	pluginsDir := strings.TrimPrefix(path.Join(path.Dir(projectLocation.RealPath), "Plugins"), "/") + "/"
+	for _, l := range locations {
+		if strings.HasPrefix(strings.TrimPrefix(l.RealPath, "/"), pluginsDir) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package unreal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParseUProject(t *testing.T) {
+	fixture := "test-fixtures/MyGame.uproject"
+	locations := file.NewLocationSet(file.NewLocation(fixture))
+
+	// note: without a resolver the project plugins directory cannot be searched, so all marketplace plugins are found
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "LowEntryExtStdLib",
+			PURL:      "pkg:unreal/LowEntryExtStdLib",
+			Locations: locations,
+			Language:  pkg.CPP,
+			Type:      pkg.UnrealPkg,
+			Metadata: pkg.UnrealProjectPluginEntry{
+				EngineAssociation: "5.3",
+				MarketplaceURL:    "com.epicgames.launcher://ue/marketplace/content/3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+				MarketplaceID:     "3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+			},
+		},
+		{
+			Name:      "AdvancedSessions",
+			PURL:      "pkg:unreal/AdvancedSessions",
+			Locations: locations,
+			Language:  pkg.CPP,
+			Type:      pkg.UnrealPkg,
+			Metadata: pkg.UnrealProjectPluginEntry{
+				EngineAssociation: "5.3",
+				MarketplaceURL:    "com.epicgames.launcher://ue/marketplace/product/advanced-sessions-plugin",
+				MarketplaceID:     "advanced-sessions-plugin",
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parseUProject, expectedPkgs, nil)
+}
+
+func Test_marketplaceID(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{
+			url:  "com.epicgames.launcher://ue/marketplace/content/3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+			want: "3d7a6ad4ea2b44a4a2d4ec6bb6c2f7f6",
+		},
+		{
+			url:  "com.epicgames.launcher://ue/marketplace/product/advanced-sessions-plugin",
+			want: "advanced-sessions-plugin",
+		},
+		{
+			url:  "com.epicgames.launcher://ue/Fab/product/c2b5a7e1-4f0e-4d7b-9a55-3a8b1d9f2e44",
+			want: "c2b5a7e1-4f0e-4d7b-9a55-3a8b1d9f2e44",
+		},
+		{
+			url:  "https://www.unrealengine.com/marketplace/en-US/product/varest-plugin",
+			want: "varest-plugin",
+		},
+		{
+			url:  "https://www.fab.com/listings/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+			want: "0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+		},
+		{
+			url:  "https://example.com/",
+			want: "",
+		},
+		{
+			url:  "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, marketplaceID(tt.url))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Package vscode provides a concrete Cataloger implementation for VS Code (and code-server) extensions.
+*/
+package vscode
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+// extensionDirGlobs are the directories that extensions are installed to by VS Code, VS Code Insiders, VSCodium,
+// remote servers (e.g. for dev containers), and code-server.
+var extensionDirGlobs = []string{
+	"**/.vscode/extensions",
+	"**/.vscode-insiders/extensions",
+	"**/.vscode-oss/extensions",
+	"**/.vscode-server/extensions",
+	"**/.vscode-server-insiders/extensions",
+	"**/code-server/extensions",
+}
+
+// NewExtensionCataloger returns a new cataloger object for extensions installed by VS Code and code-server.
+func NewExtensionCataloger() pkg.Cataloger {
+	return generic.NewCataloger("vscode-extension-cataloger").
+		WithParserByGlobs(parseExtensionsJSON, globsWithin(extensionDirGlobs, "extensions.json")...).
+		WithParserByGlobs(parsePackageJSON, globsWithin(extensionDirGlobs, "*/package.json")...).
+		WithProcessors(dependency.Processor(extensionDependencySpecifier))
+}
+
+func globsWithin(dirs []string, pattern string) []string {
+	globs := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		globs = append(globs, dir+"/"+pattern)
+	}
+	return globs
+}
@@ -0,0 +1,172 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_Cataloger_Globs(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixture  string
+		expected []string
+	}{
+		{
+			name:    "obtain extension manifests and extensions.json files",
+			fixture: "test-fixtures/glob-paths",
+			expected: []string{
+				"home/dev/.vscode/extensions/extensions.json",
+				"home/dev/.vscode/extensions/pub.ext-1.0.0/package.json",
+				"home/dev/.vscode-insiders/extensions/extensions.json",
+				"home/dev/.vscode-insiders/extensions/pub.ext-1.0.0/package.json",
+				"home/dev/.vscode-oss/extensions/extensions.json",
+				"home/dev/.vscode-oss/extensions/pub.ext-1.0.0/package.json",
+				"home/dev/.vscode-server/extensions/extensions.json",
+				"home/dev/.vscode-server/extensions/pub.ext-1.0.0/package.json",
+				"home/dev/.vscode-server-insiders/extensions/extensions.json",
+				"home/dev/.vscode-server-insiders/extensions/pub.ext-1.0.0/package.json",
+				"home/dev/.local/share/code-server/extensions/extensions.json",
+				"home/dev/.local/share/code-server/extensions/pub.ext-1.0.0/package.json",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgtest.NewCatalogTester().
+				FromDirectory(t, test.fixture).
+				ExpectsResolverContentQueries(test.expected).
+				TestCataloger(t, NewExtensionCataloger())
+		})
+	}
+}
+
+func TestCataloger_ExtensionsJSON(t *testing.T) {
+	extensionsJSONLocation := file.NewLocation(".vscode/extensions/extensions.json")
+	pythonLocation := file.NewLocation(".vscode/extensions/ms-python.python-2024.0.1/package.json")
+	pylanceLocation := file.NewLocation(".vscode/extensions/ms-python.vscode-pylance-2024.2.1/package.json")
+
+	python := pkg.Package{
+		Name:      "ms-python.python",
+		Version:   "2024.0.1",
+		FoundBy:   "vscode-extension-cataloger",
+		PURL:      "pkg:vscode-extension/ms-python/python@2024.0.1",
+		Locations: file.NewLocationSet(extensionsJSONLocation, pythonLocation),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", pythonLocation)),
+		Language:  pkg.JavaScript,
+		Type:      pkg.VSCodeExtensionPkg,
+		Metadata: pkg.VSCodeExtensionEntry{
+			Publisher:             "ms-python",
+			PublisherDisplayName:  "Microsoft",
+			DisplayName:           "Python",
+			Description:           "Python language support with extension access points for IntelliSense (Pylance), Debugging (Python Debugger), linting, formatting, refactoring, unit tests, and more.",
+			Engine:                "^1.86.0",
+			UUID:                  "f1f59ae4-9318-4f3c-a9b5-81b2eaa5f8a5",
+			Source:                "gallery",
+			ExtensionDependencies: []string{"ms-python.vscode-pylance"},
+		},
+	}
+
+	pylance := pkg.Package{
+		Name:      "ms-python.vscode-pylance",
+		Version:   "2024.2.1",
+		FoundBy:   "vscode-extension-cataloger",
+		PURL:      "pkg:vscode-extension/ms-python/vscode-pylance@2024.2.1",
+		Locations: file.NewLocationSet(extensionsJSONLocation, pylanceLocation),
+		Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("SEE LICENSE IN LICENSE.txt", pylanceLocation)),
+		Language:  pkg.JavaScript,
+		Type:      pkg.VSCodeExtensionPkg,
+		Metadata: pkg.VSCodeExtensionEntry{
+			Publisher:            "ms-python",
+			PublisherDisplayName: "Microsoft",
+			DisplayName:          "Pylance",
+			Description:          "A performant, feature-rich language server for Python in VS Code",
+			Engine:               "^1.82.0",
+			UUID:                 "364d2426-116a-433a-a5d8-a5098dc3afbd",
+			TargetPlatform:       "linux-x64",
+			Source:               "gallery",
+		},
+	}
+
+	// note: the extension directory is missing, so only the details from extensions.json are known
+	internalTools := pkg.Package{
+		Name:      "acme.internal-tools",
+		Version:   "0.3.0",
+		FoundBy:   "vscode-extension-cataloger",
+		PURL:      "pkg:vscode-extension/acme/internal-tools@0.3.0",
+		Locations: file.NewLocationSet(extensionsJSONLocation),
+		Language:  pkg.JavaScript,
+		Type:      pkg.VSCodeExtensionPkg,
+		Metadata: pkg.VSCodeExtensionEntry{
+			Publisher: "acme",
+			Source:    "vsix",
+		},
+	}
+
+	// note: the older version of the python extension is not listed in extensions.json, so is not cataloged
+	expectedPkgs := []pkg.Package{python, pylance, internalTools}
+
+	expectedRelationships := []artifact.Relationship{
+		{
+			From: pylance,
+			To:   python,
+			Type: artifact.DependencyOfRelationship,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/installed").
+		Expects(expectedPkgs, expectedRelationships).
+		TestCataloger(t, NewExtensionCataloger())
+}
+
+func TestCataloger_PackageJSON(t *testing.T) {
+	goLocation := file.NewLocation(".vscode-server/extensions/golang.go-0.41.0/package.json")
+	yamlLocation := file.NewLocation(".vscode-server/extensions/redhat.vscode-yaml-1.14.0/package.json")
+
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "golang.go",
+			Version:   "0.41.0",
+			FoundBy:   "vscode-extension-cataloger",
+			PURL:      "pkg:vscode-extension/golang/go@0.41.0",
+			Locations: file.NewLocationSet(goLocation),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", goLocation)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.VSCodeExtensionPkg,
+			Metadata: pkg.VSCodeExtensionEntry{
+				Publisher:             "golang",
+				DisplayName:           "Go",
+				Description:           "Rich Go language support for Visual Studio Code",
+				Engine:                "^1.75.0",
+				ExtensionDependencies: []string{},
+			},
+		},
+		{
+			// note: there is no package.nls.json file, so the placeholder description is dropped
+			Name:      "redhat.vscode-yaml",
+			Version:   "1.14.0",
+			FoundBy:   "vscode-extension-cataloger",
+			PURL:      "pkg:vscode-extension/redhat/vscode-yaml@1.14.0",
+			Locations: file.NewLocationSet(yamlLocation),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", yamlLocation)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.VSCodeExtensionPkg,
+			Metadata: pkg.VSCodeExtensionEntry{
+				Publisher:     "redhat",
+				DisplayName:   "YAML",
+				Engine:        "^1.63.0",
+				ExtensionPack: []string{"redhat.vscode-commons"},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/legacy").
+		Expects(expectedPkgs, nil).
+		TestCataloger(t, NewExtensionCataloger())
+}
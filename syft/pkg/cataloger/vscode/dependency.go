@@ -0,0 +1,28 @@
+package vscode
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/dependency"
+)
+
+var _ dependency.Specifier = extensionDependencySpecifier
+
+// extensionDependencySpecifier describes extensions as providing their ID and requiring the extensions they depend
+// on. Note that extension IDs are case-insensitive.
+func extensionDependencySpecifier(p pkg.Package) dependency.Specification {
+	var requires []string
+	if metadata, ok := p.Metadata.(pkg.VSCodeExtensionEntry); ok {
+		for _, id := range metadata.ExtensionDependencies {
+			requires = append(requires, strings.ToLower(id))
+		}
+	}
+
+	return dependency.Specification{
+		ProvidesRequires: dependency.ProvidesRequires{
+			Provides: []string{strings.ToLower(p.Name)},
+			Requires: requires,
+		},
+	}
+}
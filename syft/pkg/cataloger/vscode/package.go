@@ -0,0 +1,55 @@
+package vscode
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newExtensionPackage(name, version string, licenses []pkg.License, entry pkg.VSCodeExtensionEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      extensionID(entry.Publisher, name),
+		Version:   version,
+		Locations: file.NewLocationSet(locations...),
+		Licenses:  pkg.NewLicenseSet(licenses...),
+		PURL:      packageURL(entry.Publisher, name, version),
+		Language:  pkg.JavaScript,
+		Type:      pkg.VSCodeExtensionPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+func packageURL(publisher, name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.VSCodeExtensionPkg.PackageURLType(),
+		publisher,
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
+
+// extensionID returns the ID of an extension, which is used to reference the extension from other extensions and
+// from the marketplace (e.g. "ms-python.python").
+func extensionID(publisher, name string) string {
+	if publisher == "" {
+		return name
+	}
+	return publisher + "." + name
+}
+
+// splitExtensionID returns the publisher and name of an extension from its ID.
+func splitExtensionID(id string) (string, string) {
+	publisher, name, found := strings.Cut(id, ".")
+	if !found {
+		return "", id
+	}
+	return publisher, name
+}
@@ -0,0 +1,145 @@
+package vscode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseExtensionsJSON
+
+// installedExtension represents an entry of the extensions.json file within an extensions directory, which is
+// maintained by VS Code (since 1.74) and code-server as the record of the installed extensions.
+type installedExtension struct {
+	Identifier struct {
+		ID   string `json:"id"`
+		UUID string `json:"uuid"`
+	} `json:"identifier"`
+	Version  string `json:"version"`
+	Location struct {
+		Path string `json:"path"`
+	} `json:"location"`
+	RelativeLocation string `json:"relativeLocation"`
+	Metadata         struct {
+		ID                   string `json:"id"`
+		PublisherDisplayName string `json:"publisherDisplayName"`
+		TargetPlatform       string `json:"targetPlatform"`
+		IsPreReleaseVersion  bool   `json:"isPreReleaseVersion"`
+		Source               string `json:"source"`
+	} `json:"metadata"`
+}
+
+// parseExtensionsJSON returns the extensions installed to an extensions directory. The package.json file of each
+// extension is used (when found) for the details that are not recorded in the extensions.json file.
+func parseExtensionsJSON(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	var installed []installedExtension
+	if err := json.NewDecoder(reader).Decode(&installed); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse extensions.json file: %w", err)
+	}
+
+	var pkgs []pkg.Package
+	for _, ext := range installed {
+		if ext.Identifier.ID == "" {
+			continue
+		}
+		pkgs = append(pkgs, newInstalledExtensionPackage(resolver, reader.Location, ext))
+	}
+
+	return pkgs, nil, nil
+}
+
+func newInstalledExtensionPackage(resolver file.Resolver, location file.Location, ext installedExtension) pkg.Package {
+	publisher, name := splitExtensionID(ext.Identifier.ID)
+
+	entry := pkg.VSCodeExtensionEntry{
+		Publisher:            publisher,
+		PublisherDisplayName: ext.Metadata.PublisherDisplayName,
+		UUID:                 ext.Identifier.UUID,
+		TargetPlatform:       targetPlatform(ext.Metadata.TargetPlatform),
+		Source:               ext.Metadata.Source,
+		PreRelease:           ext.Metadata.IsPreReleaseVersion,
+	}
+	if entry.UUID == "" {
+		entry.UUID = ext.Metadata.ID
+	}
+
+	locations := []file.Location{location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+	var licenses []pkg.License
+
+	manifest, manifestLocation := readManifest(resolver, location, ext)
+	if manifestLocation != nil {
+		supporting := manifestLocation.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+		locations = append(locations, supporting)
+		licenses = manifest.licenses(supporting)
+
+		// prefer the case of the publisher and name as declared by the extension (IDs are stored in lowercase)
+		if manifest.Publisher != "" && manifest.Name != "" {
+			publisher, name = manifest.Publisher, manifest.Name
+			entry.Publisher = publisher
+		}
+		entry.DisplayName = manifest.DisplayName
+		entry.Description = manifest.Description
+		entry.Engine = manifest.Engines.VSCode
+		entry.ExtensionDependencies = manifest.ExtensionDependencies
+		entry.ExtensionPack = manifest.ExtensionPack
+	}
+
+	return newExtensionPackage(name, ext.Version, licenses, entry, locations...)
+}
+
+// readManifest returns the package.json file of an installed extension, which is found within the extension
+// directory named by the extensions.json entry (relative to the extensions directory).
+func readManifest(resolver file.Resolver, location file.Location, ext installedExtension) (extensionManifest, *file.Location) {
+	if resolver == nil {
+		return extensionManifest{}, nil
+	}
+
+	dir := ext.RelativeLocation
+	if dir == "" {
+		// older entries only have the absolute location of the extension (which may be from another filesystem)
+		dir = path.Base(ext.Location.Path)
+	}
+	if dir == "" || dir == "." || dir == "/" {
+		return extensionManifest{}, nil
+	}
+
+	manifestPath := path.Join(path.Dir(location.RealPath), dir, "package.json")
+	manifestLocation := resolver.RelativeFileByPath(location, manifestPath)
+	if manifestLocation == nil {
+		return extensionManifest{}, nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*manifestLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", manifestPath).Trace("unable to read extension package.json")
+		return extensionManifest{}, nil
+	}
+	defer internal.CloseAndLogError(contents, manifestLocation.RealPath)
+
+	manifest, err := decodeManifest(contents)
+	if err != nil {
+		log.WithFields("error", err, "path", manifestPath).Trace("unable to parse extension package.json")
+		return extensionManifest{}, nil
+	}
+	manifest.localize(resolver, *manifestLocation)
+
+	return manifest, manifestLocation
+}
+
+// targetPlatform returns the platform the extension was built for, where "undefined" and "universal" indicate the
+// extension is not platform-specific.
+func targetPlatform(platform string) string {
+	switch platform {
+	case "undefined", "universal":
+		return ""
+	}
+	return platform
+}
@@ -0,0 +1,175 @@
+package vscode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parsePackageJSON
+
+// extensionManifest represents the package.json file of an installed extension (see
+// https://code.visualstudio.com/api/references/extension-manifest).
+type extensionManifest struct {
+	Name                  string   `json:"name"`
+	Publisher             string   `json:"publisher"`
+	Version               string   `json:"version"`
+	DisplayName           string   `json:"displayName"`
+	Description           string   `json:"description"`
+	License               string   `json:"license"`
+	Engines               engines  `json:"engines"`
+	ExtensionDependencies []string `json:"extensionDependencies"`
+	ExtensionPack         []string `json:"extensionPack"`
+}
+
+type engines struct {
+	VSCode string `json:"vscode"`
+}
+
+// parsePackageJSON returns the extension described by the package.json file of an extension directory. Extensions
+// directories that have an extensions.json file are cataloged from that file instead, since it is the record of
+// which extensions are installed (older versions of extensions may be left behind until they are cleaned up).
+func parsePackageJSON(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	if hasExtensionsJSON(resolver, reader.Location) {
+		return nil, nil, nil
+	}
+
+	manifest, err := decodeManifest(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse extension package.json file: %w", err)
+	}
+
+	// all extensions must declare the versions of VS Code they are compatible with, otherwise this is some other
+	// package.json file (e.g. from the node_modules of an extension)
+	if manifest.Name == "" || manifest.Engines.VSCode == "" {
+		return nil, nil, nil
+	}
+
+	manifest.localize(resolver, reader.Location)
+
+	location := reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	return []pkg.Package{
+		newExtensionPackage(
+			manifest.Name,
+			manifest.Version,
+			manifest.licenses(location),
+			manifest.entry(),
+			location,
+		),
+	}, nil, nil
+}
+
+func decodeManifest(reader io.Reader) (extensionManifest, error) {
+	var manifest extensionManifest
+	if err := json.NewDecoder(reader).Decode(&manifest); err != nil {
+		return extensionManifest{}, err
+	}
+	return manifest, nil
+}
+
+func (m extensionManifest) entry() pkg.VSCodeExtensionEntry {
+	return pkg.VSCodeExtensionEntry{
+		Publisher:             m.Publisher,
+		DisplayName:           m.DisplayName,
+		Description:           m.Description,
+		Engine:                m.Engines.VSCode,
+		ExtensionDependencies: m.ExtensionDependencies,
+		ExtensionPack:         m.ExtensionPack,
+	}
+}
+
+func (m extensionManifest) licenses(location file.Location) []pkg.License {
+	if m.License == "" {
+		return nil
+	}
+	return pkg.NewLicensesFromLocation(location, m.License)
+}
+
+// localize replaces the placeholders used for translated values (e.g. "%displayName%") with the values from the
+// package.nls.json file next to the package.json file, otherwise the placeholders are dropped.
+func (m *extensionManifest) localize(resolver file.Resolver, location file.Location) {
+	if !isPlaceholder(m.DisplayName) && !isPlaceholder(m.Description) {
+		return
+	}
+
+	messages := readMessages(resolver, location)
+	m.DisplayName = localizedValue(messages, m.DisplayName)
+	m.Description = localizedValue(messages, m.Description)
+}
+
+func isPlaceholder(value string) bool {
+	return len(value) > 2 && strings.HasPrefix(value, "%") && strings.HasSuffix(value, "%")
+}
+
+func localizedValue(messages map[string]string, value string) string {
+	if !isPlaceholder(value) {
+		return value
+	}
+	return messages[strings.Trim(value, "%")]
+}
+
+// nlsMessage is a translated value from a package.nls.json file, which is either a string or an object with a
+// message and comments for translators.
+type nlsMessage struct {
+	Message string `json:"message"`
+}
+
+func readMessages(resolver file.Resolver, location file.Location) map[string]string {
+	if resolver == nil {
+		return nil
+	}
+
+	nlsPath := path.Join(path.Dir(location.RealPath), "package.nls.json")
+	nlsLocation := resolver.RelativeFileByPath(location, nlsPath)
+	if nlsLocation == nil {
+		return nil
+	}
+
+	contents, err := resolver.FileContentsByLocation(*nlsLocation)
+	if err != nil {
+		log.WithFields("error", err, "path", nlsPath).Trace("unable to read extension translations")
+		return nil
+	}
+	defer internal.CloseAndLogError(contents, nlsLocation.RealPath)
+
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(contents).Decode(&raw); err != nil {
+		log.WithFields("error", err, "path", nlsPath).Trace("unable to parse extension translations")
+		return nil
+	}
+
+	messages := make(map[string]string, len(raw))
+	for key, value := range raw {
+		var message string
+		if err := json.Unmarshal(value, &message); err == nil {
+			messages[key] = message
+			continue
+		}
+		var obj nlsMessage
+		if err := json.Unmarshal(value, &obj); err == nil {
+			messages[key] = obj.Message
+		}
+	}
+	return messages
+}
+
+// hasExtensionsJSON indicates if the extensions directory holding the given package.json file (within an extension
+// directory) has an extensions.json file.
+func hasExtensionsJSON(resolver file.Resolver, location file.Location) bool {
+	if resolver == nil {
+		return false
+	}
+	extensionsJSONPath := path.Join(path.Dir(path.Dir(location.RealPath)), "extensions.json")
+	return resolver.RelativeFileByPath(location, extensionsJSONPath) != nil
+}
@@ -0,0 +1,77 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestParsePackageJSON(t *testing.T) {
+	fixture := "test-fixtures/installed/.vscode/extensions/ms-python.python-2024.0.1/package.json"
+	location := file.NewLocation(fixture)
+
+	// note: without a resolver the translations cannot be read, so the placeholder values are dropped
+	expectedPkgs := []pkg.Package{
+		{
+			Name:      "ms-python.python",
+			Version:   "2024.0.1",
+			PURL:      "pkg:vscode-extension/ms-python/python@2024.0.1",
+			Locations: file.NewLocationSet(location),
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("MIT", location)),
+			Language:  pkg.JavaScript,
+			Type:      pkg.VSCodeExtensionPkg,
+			Metadata: pkg.VSCodeExtensionEntry{
+				Publisher:             "ms-python",
+				Engine:                "^1.86.0",
+				ExtensionDependencies: []string{"ms-python.vscode-pylance"},
+			},
+		},
+	}
+
+	pkgtest.TestFileParser(t, fixture, parsePackageJSON, expectedPkgs, nil)
+}
+
+func TestParsePackageJSON_NotAnExtension(t *testing.T) {
+	fixture := "test-fixtures/legacy/.vscode-server/extensions/not-an-extension/package.json"
+	pkgtest.TestFileParser(t, fixture, parsePackageJSON, nil, nil)
+}
+
+func TestParsePackageJSON_Malformed(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromString("extensions/foo.bar-1.0.0/package.json", `{"name": `).
+		WithError().
+		TestParser(t, parsePackageJSON)
+}
+
+func Test_splitExtensionID(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantPublisher string
+		wantName      string
+	}{
+		{
+			id:            "ms-python.python",
+			wantPublisher: "ms-python",
+			wantName:      "python",
+		},
+		{
+			id:            "ms-vscode.cpptools-extension-pack",
+			wantPublisher: "ms-vscode",
+			wantName:      "cpptools-extension-pack",
+		},
+		{
+			id:       "no-publisher",
+			wantName: "no-publisher",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			publisher, name := splitExtensionID(tt.id)
+			if publisher != tt.wantPublisher || name != tt.wantName {
+				t.Errorf("splitExtensionID(%q) = (%q, %q), want (%q, %q)", tt.id, publisher, name, tt.wantPublisher, tt.wantName)
+			}
+		})
+	}
+}
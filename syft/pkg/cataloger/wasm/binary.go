@@ -0,0 +1,212 @@
+package wasm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+const (
+	moduleKind    = "module"
+	componentKind = "component"
+
+	customSectionID = 0
+)
+
+var (
+	magic = []byte{0x00, 'a', 's', 'm'}
+
+	// the version field of core modules (version 1)
+	moduleVersion = []byte{0x01, 0x00, 0x00, 0x00}
+
+	// the layer of components is found in the upper half of the version field (layer 1)
+	componentLayer = []byte{0x01, 0x00}
+
+	errTruncated = errors.New("unexpected end of data")
+)
+
+// binary is the raw content of a WebAssembly binary that is relevant for cataloging.
+type binary struct {
+	kind string
+
+	// custom sections by name (later sections with the same name are ignored)
+	custom map[string][]byte
+}
+
+// readBinary reads the top-level sections of a WebAssembly module or component. Nested modules and components are
+// not considered. A nil binary is returned when the content is not WebAssembly.
+func readBinary(data []byte) (*binary, error) {
+	if len(data) < 8 || !bytes.Equal(data[:4], magic) {
+		return nil, nil
+	}
+
+	b := &binary{custom: make(map[string][]byte)}
+	switch {
+	case bytes.Equal(data[4:8], moduleVersion):
+		b.kind = moduleKind
+	case bytes.Equal(data[6:8], componentLayer):
+		b.kind = componentKind
+	default:
+		return nil, fmt.Errorf("unsupported WebAssembly version: %x", data[4:8])
+	}
+
+	r := &reader{data: data[8:]}
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.sized()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read section %d: %w", id, err)
+		}
+		if id != customSectionID {
+			continue
+		}
+
+		section := &reader{data: content}
+		name, err := section.name()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read custom section name: %w", err)
+		}
+		if _, exists := b.custom[name]; !exists {
+			b.custom[name] = section.data
+		}
+	}
+
+	return b, nil
+}
+
+// moduleName returns the name of the module (or component) from the "name" (or "component-name") custom section.
+func (b binary) moduleName() string {
+	section := "name"
+	if b.kind == componentKind {
+		section = "component-name"
+	}
+
+	content, ok := b.custom[section]
+	if !ok {
+		return ""
+	}
+
+	r := &reader{data: content}
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return ""
+		}
+		subsection, err := r.sized()
+		if err != nil {
+			return ""
+		}
+		// the module (or component) name subsection always has ID 0
+		if id == 0 {
+			name, err := (&reader{data: subsection}).name()
+			if err != nil {
+				return ""
+			}
+			return name
+		}
+	}
+	return ""
+}
+
+// producers returns the fields of the "producers" custom section (e.g. "language", "processed-by", and "sdk").
+func (b binary) producers() (map[string][]producer, error) {
+	content, ok := b.custom["producers"]
+	if !ok {
+		return nil, nil
+	}
+
+	r := &reader{data: content}
+	fieldCount, err := r.uleb()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string][]producer)
+	for i := uint64(0); i < fieldCount; i++ {
+		field, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+		valueCount, err := r.uleb()
+		if err != nil {
+			return nil, err
+		}
+		for j := uint64(0); j < valueCount; j++ {
+			name, err := r.name()
+			if err != nil {
+				return nil, err
+			}
+			version, err := r.name()
+			if err != nil {
+				return nil, err
+			}
+			fields[field] = append(fields[field], producer{name: name, version: version})
+		}
+	}
+	return fields, nil
+}
+
+type producer struct {
+	name    string
+	version string
+}
+
+// reader reads the primitive encodings of the WebAssembly binary format.
+type reader struct {
+	data []byte
+}
+
+func (r *reader) done() bool {
+	return len(r.data) == 0
+}
+
+func (r *reader) byte() (byte, error) {
+	if len(r.data) < 1 {
+		return 0, errTruncated
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b, nil
+}
+
+// uleb reads an unsigned LEB128 encoded integer.
+func (r *reader) uleb() (uint64, error) {
+	var result uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+	}
+	return 0, errors.New("invalid LEB128 integer")
+}
+
+// sized reads a length-prefixed byte sequence.
+func (r *reader) sized() ([]byte, error) {
+	size, err := r.uleb()
+	if err != nil {
+		return nil, err
+	}
+	if size > uint64(len(r.data)) {
+		return nil, errTruncated
+	}
+	content := r.data[:size]
+	r.data = r.data[size:]
+	return content, nil
+}
+
+// name reads a length-prefixed UTF-8 string.
+func (r *reader) name() (string, error) {
+	content, err := r.sized()
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
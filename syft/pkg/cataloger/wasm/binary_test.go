@@ -0,0 +1,62 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readBinary(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		wantKind string
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "not webassembly",
+			data: []byte("#!/bin/sh\necho hello\n"),
+		},
+		{
+			name:     "empty module",
+			data:     []byte("\x00asm\x01\x00\x00\x00"),
+			wantKind: moduleKind,
+		},
+		{
+			name:     "empty component",
+			data:     []byte("\x00asm\x0d\x00\x01\x00"),
+			wantKind: componentKind,
+		},
+		{
+			name:    "unsupported version",
+			data:    []byte("\x00asm\x02\x00\x00\x00"),
+			wantErr: require.Error,
+		},
+		{
+			name:    "truncated section",
+			data:    []byte("\x00asm\x01\x00\x00\x00\x00\x10\x04name"),
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			got, err := readBinary(tt.data)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			if tt.wantKind == "" {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantKind, got.kind)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package wasm provides a concrete Cataloger implementation for WebAssembly binaries (core modules and components).
+*/
+package wasm
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewCataloger returns a new cataloger object for WebAssembly binaries, describing each module or component by the
+// name, producers, and component model metadata found within its custom sections.
+func NewCataloger() pkg.Cataloger {
+	return generic.NewCataloger("wasm-module-cataloger").
+		WithParserByGlobs(parseWasm, "**/*.wasm")
+}
@@ -0,0 +1,108 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func TestCataloger_Module(t *testing.T) {
+	location := file.NewLocation("hello.wasm")
+
+	expected := []pkg.Package{
+		{
+			Name:      "hello",
+			Version:   "0.1.0",
+			Language:  pkg.Rust,
+			FoundBy:   "wasm-module-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:wasm/hello@0.1.0",
+			Type:      pkg.WasmModulePkg,
+			Metadata: pkg.WasmModuleEntry{
+				Kind: "module",
+				Languages: []pkg.WasmProducer{
+					{Name: "Rust"},
+				},
+				ProcessedBy: []pkg.WasmProducer{
+					{Name: "rustc", Version: "1.79.0 (129f3b996 2024-06-10)"},
+					{Name: "walrus", Version: "0.20.3"},
+				},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/module").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_Component(t *testing.T) {
+	location := file.NewLocation("greeter.wasm")
+
+	expected := []pkg.Package{
+		{
+			Name:      "greeter",
+			Version:   "1.2.3",
+			Language:  pkg.Go,
+			Licenses:  pkg.NewLicenseSet(pkg.NewLicenseFromLocations("Apache-2.0", location)),
+			FoundBy:   "wasm-module-cataloger",
+			Locations: file.NewLocationSet(location),
+			PURL:      "pkg:wasm/greeter@1.2.3",
+			Type:      pkg.WasmModulePkg,
+			Metadata: pkg.WasmModuleEntry{
+				Kind: "component",
+				Languages: []pkg.WasmProducer{
+					{Name: "Go", Version: "1.22"},
+				},
+				ProcessedBy: []pkg.WasmProducer{
+					{Name: "tinygo", Version: "0.32.0"},
+					{Name: "wit-component", Version: "0.215.0"},
+				},
+				SDKs: []pkg.WasmProducer{
+					{Name: "wasi-sdk", Version: "22"},
+				},
+				Authors:     []string{"Jane Doe <jane@example.com>"},
+				Description: "Greets the world",
+				Source:      "https://github.com/example/greeter",
+				Homepage:    "https://example.com/greeter",
+				Revision:    "3f2a1c9",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/component").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func TestCataloger_WithoutMetadata(t *testing.T) {
+	// note: files with a .wasm extension that are not WebAssembly binaries are not cataloged
+	expected := []pkg.Package{
+		{
+			Name:      "plain",
+			FoundBy:   "wasm-module-cataloger",
+			Locations: file.NewLocationSet(file.NewLocation("plain.wasm")),
+			PURL:      "pkg:wasm/plain",
+			Type:      pkg.WasmModulePkg,
+			Metadata: pkg.WasmModuleEntry{
+				Kind: "module",
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/plain").
+		Expects(expected, nil).
+		TestCataloger(t, NewCataloger())
+}
+
+func Test_Cataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{"opt/plugins/filter.wasm"}).
+		TestCataloger(t, NewCataloger())
+}
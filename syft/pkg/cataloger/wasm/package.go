@@ -0,0 +1,63 @@
+package wasm
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newPackage(name, version, license string, entry pkg.WasmModuleEntry, location file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		Locations: file.NewLocationSet(location),
+		Language:  language(entry.Languages),
+		PURL:      packageURL(name, version),
+		Type:      pkg.WasmModulePkg,
+		Metadata:  entry,
+	}
+
+	if license != "" {
+		p.Licenses = pkg.NewLicenseSet(pkg.NewLicenseFromLocations(license, location))
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// language returns the language ecosystem of the first source language (from the "producers" section) that syft
+// has a language for.
+func language(languages []pkg.WasmProducer) pkg.Language {
+	for _, l := range languages {
+		switch strings.ToLower(l.Name) {
+		case "rust":
+			return pkg.Rust
+		case "c", "c++", "c11", "c++11", "c++14", "c++17", "c++20":
+			return pkg.CPP
+		case "go", "tinygo":
+			return pkg.Go
+		case "javascript", "typescript":
+			return pkg.JavaScript
+		case "swift":
+			return pkg.Swift
+		case "python":
+			return pkg.Python
+		}
+	}
+	return pkg.UnknownLanguage
+}
+
+// packageURL returns the package URL for a module or component (e.g. pkg:wasm/hello@1.0.0).
+func packageURL(name, version string) string {
+	return packageurl.NewPackageURL(
+		pkg.WasmModulePkg.PackageURLType(),
+		"",
+		name,
+		version,
+		nil,
+		"",
+	).ToString()
+}
@@ -0,0 +1,120 @@
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseWasm
+
+// registryMetadata is the JSON content of the "registry-metadata" custom section written by older versions of the
+// component model tooling (newer versions write a custom section per field instead).
+type registryMetadata struct {
+	Authors     []string `json:"authors"`
+	Description string   `json:"description"`
+	License     string   `json:"license"`
+	Links       []struct {
+		Type  string `json:"ty"`
+		Value string `json:"value"`
+	} `json:"links"`
+}
+
+// parseWasm is a parser function for WebAssembly binaries, returning a package for each module or component.
+func parseWasm(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read WebAssembly binary: %w", err)
+	}
+
+	b, err := readBinary(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse WebAssembly binary: %w", err)
+	}
+	if b == nil {
+		return nil, nil, nil
+	}
+
+	entry := pkg.WasmModuleEntry{
+		Kind:        b.kind,
+		Description: b.text("description"),
+		Source:      b.text("source"),
+		Homepage:    b.text("homepage"),
+		Revision:    b.text("revision"),
+	}
+
+	producers, err := b.producers()
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse WebAssembly producers section")
+	}
+	entry.Languages = toProducers(producers["language"])
+	entry.ProcessedBy = toProducers(producers["processed-by"])
+	entry.SDKs = toProducers(producers["sdk"])
+
+	if authors := b.text("authors"); authors != "" {
+		entry.Authors = []string{authors}
+	}
+
+	licenses := b.text("licenses")
+
+	if content, ok := b.custom["registry-metadata"]; ok {
+		var md registryMetadata
+		if err := json.Unmarshal(content, &md); err != nil {
+			log.WithFields("path", reader.RealPath, "error", err).Trace("unable to parse WebAssembly registry metadata")
+		}
+		if len(entry.Authors) == 0 {
+			entry.Authors = md.Authors
+		}
+		if entry.Description == "" {
+			entry.Description = md.Description
+		}
+		if licenses == "" {
+			licenses = md.License
+		}
+		for _, link := range md.Links {
+			switch {
+			case strings.EqualFold(link.Type, "repository") && entry.Source == "":
+				entry.Source = link.Value
+			case strings.EqualFold(link.Type, "homepage") && entry.Homepage == "":
+				entry.Homepage = link.Value
+			}
+		}
+	}
+
+	name := b.moduleName()
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(reader.RealPath), ".wasm")
+	}
+
+	return []pkg.Package{
+		newPackage(
+			name,
+			b.text("version"),
+			licenses,
+			entry,
+			reader.Location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation),
+		),
+	}, nil, nil
+}
+
+// text returns the content of a custom section holding a single UTF-8 string (e.g. the "version" section).
+func (b binary) text(section string) string {
+	return strings.TrimSpace(string(b.custom[section]))
+}
+
+func toProducers(values []producer) []pkg.WasmProducer {
+	var producers []pkg.WasmProducer
+	for _, v := range values {
+		producers = append(producers, pkg.WasmProducer{Name: v.name, Version: v.version})
+	}
+	return producers
+}
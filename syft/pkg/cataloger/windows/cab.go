@@ -0,0 +1,262 @@
+package windows
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// cabinet file (.cab) format constants (see [MS-CAB])
+const (
+	cabHeaderSize = 36
+	cabDataSize   = 8
+
+	cabFlagPrevCabinet    = 0x0001
+	cabFlagNextCabinet    = 0x0002
+	cabFlagReservePresent = 0x0004
+
+	cabCompressionMask  = 0x000f
+	cabCompressionNone  = 0x0000
+	cabCompressionMSZIP = 0x0001
+
+	// cabFolderContinued is the first of the folder indexes that refer to folders continued from (or into) another
+	// cabinet within a set of cabinets
+	cabFolderContinued = 0xfffd
+
+	// cabMSZIPWindowSize is the size of the history that each MSZIP block may refer back to (including the output of
+	// previous blocks)
+	cabMSZIPWindowSize = 32 * 1024
+)
+
+var cabSignature = []byte("MSCF")
+
+type cabHeader struct {
+	Signature    [4]byte
+	_            uint32
+	CabinetSize  uint32
+	_            uint32
+	FilesOffset  uint32
+	_            uint32
+	VersionMinor uint8
+	VersionMajor uint8
+	Folders      uint16
+	Files        uint16
+	Flags        uint16
+	SetID        uint16
+	Index        uint16
+}
+
+type cabFolder struct {
+	DataOffset  uint32
+	DataBlocks  uint16
+	Compression uint16
+}
+
+type cabFileEntry struct {
+	Size         uint32
+	FolderOffset uint32
+	Folder       uint16
+	Date         uint16
+	Time         uint16
+	Attributes   uint16
+}
+
+type cabFile struct {
+	cabFileEntry
+	name string
+}
+
+// cabinet is a reader of the files within a cabinet file, as used for the payload of Windows Installer packages. Only
+// uncompressed and MSZIP compressed folders are supported (which excludes LZX and Quantum compressed folders).
+type cabinet struct {
+	reader          io.ReaderAt
+	folders         []cabFolder
+	files           []cabFile
+	dataReserveSize int
+}
+
+func openCabinet(reader io.ReaderAt) (*cabinet, error) {
+	var header cabHeader
+	if err := binary.Read(io.NewSectionReader(reader, 0, cabHeaderSize), binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("unable to read cabinet header: %w", err)
+	}
+	if !bytes.Equal(header.Signature[:], cabSignature) {
+		return nil, fmt.Errorf("not a cabinet file")
+	}
+
+	r := bufio.NewReader(io.NewSectionReader(reader, cabHeaderSize, int64(header.CabinetSize)))
+	var folderReserveSize int
+	c := &cabinet{reader: reader}
+	if header.Flags&cabFlagReservePresent != 0 {
+		var reserve struct {
+			Header uint16
+			Folder uint8
+			Data   uint8
+		}
+		if err := binary.Read(r, binary.LittleEndian, &reserve); err != nil {
+			return nil, fmt.Errorf("unable to read cabinet reserve sizes: %w", err)
+		}
+		if _, err := r.Discard(int(reserve.Header)); err != nil {
+			return nil, fmt.Errorf("unable to read cabinet header: %w", err)
+		}
+		folderReserveSize, c.dataReserveSize = int(reserve.Folder), int(reserve.Data)
+	}
+	// the names of the previous and next cabinets (and their disks) within a set of cabinets
+	for _, flag := range []uint16{cabFlagPrevCabinet, cabFlagNextCabinet} {
+		if header.Flags&flag == 0 {
+			continue
+		}
+		for i := 0; i < 2; i++ {
+			if _, err := r.ReadString(0); err != nil {
+				return nil, fmt.Errorf("unable to read cabinet header: %w", err)
+			}
+		}
+	}
+
+	for i := 0; i < int(header.Folders); i++ {
+		var folder cabFolder
+		if err := binary.Read(r, binary.LittleEndian, &folder); err != nil {
+			return nil, fmt.Errorf("unable to read cabinet folder: %w", err)
+		}
+		if _, err := r.Discard(folderReserveSize); err != nil {
+			return nil, fmt.Errorf("unable to read cabinet folder: %w", err)
+		}
+		c.folders = append(c.folders, folder)
+	}
+
+	r = bufio.NewReader(io.NewSectionReader(reader, int64(header.FilesOffset), int64(header.CabinetSize)))
+	for i := 0; i < int(header.Files); i++ {
+		var f cabFile
+		if err := binary.Read(r, binary.LittleEndian, &f.cabFileEntry); err != nil {
+			return nil, fmt.Errorf("unable to read cabinet file entry: %w", err)
+		}
+		name, err := r.ReadString(0)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cabinet file entry: %w", err)
+		}
+		f.name = name[:len(name)-1]
+		c.files = append(c.files, f)
+	}
+	return c, nil
+}
+
+// extract reads the contents of each file within the cabinet (in the order that the files are stored), calling the
+// given function with the name and contents of each file. Files within folders that cannot be decompressed (or that
+// continue from or into another cabinet) are skipped.
+func (c *cabinet) extract(fn func(name string, reader io.Reader) error) error {
+	byFolder := make(map[int][]cabFile)
+	for _, f := range c.files {
+		if f.Folder >= cabFolderContinued || int(f.Folder) >= len(c.folders) {
+			continue
+		}
+		byFolder[int(f.Folder)] = append(byFolder[int(f.Folder)], f)
+	}
+
+	for i, folder := range c.folders {
+		files := byFolder[i]
+		if len(files) == 0 {
+			continue
+		}
+		sort.SliceStable(files, func(a, b int) bool {
+			return files[a].FolderOffset < files[b].FolderOffset
+		})
+
+		switch folder.Compression & cabCompressionMask {
+		case cabCompressionNone, cabCompressionMSZIP:
+		default:
+			// TODO: support LZX compression, which is used by cabinets that are built with high compression
+			log.Debugf("skipping %d files within cabinet folder with unsupported compression: %#x", len(files), folder.Compression&cabCompressionMask)
+			continue
+		}
+
+		r := bufio.NewReader(&cabFolderReader{cabinet: c, folder: folder, offset: int64(folder.DataOffset)})
+		var position int64
+		for _, f := range files {
+			if int64(f.FolderOffset) < position {
+				// the file shares its contents with a previous file
+				continue
+			}
+			if _, err := r.Discard(int(int64(f.FolderOffset) - position)); err != nil {
+				return fmt.Errorf("unable to read cabinet folder: %w", err)
+			}
+			contents := io.LimitReader(r, int64(f.Size))
+			if err := fn(f.name, contents); err != nil {
+				return err
+			}
+			// discard anything not read by the caller
+			if _, err := io.Copy(io.Discard, contents); err != nil {
+				return fmt.Errorf("unable to read cabinet folder: %w", err)
+			}
+			position = int64(f.FolderOffset) + int64(f.Size)
+		}
+	}
+	return nil
+}
+
+// cabFolderReader reads the uncompressed contents of a folder within a cabinet, which is stored as a series of data
+// blocks (each of which is compressed independently, but with MSZIP compression may refer back to previous blocks).
+type cabFolderReader struct {
+	cabinet *cabinet
+	folder  cabFolder
+	offset  int64
+	block   int
+	pending []byte
+	window  []byte
+}
+
+func (r *cabFolderReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.block >= int(r.folder.DataBlocks) {
+			return 0, io.EOF
+		}
+		if err := r.readBlock(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+func (r *cabFolderReader) readBlock() error {
+	var header struct {
+		Checksum         uint32
+		CompressedSize   uint16
+		UncompressedSize uint16
+	}
+	if err := binary.Read(io.NewSectionReader(r.cabinet.reader, r.offset, cabDataSize), binary.LittleEndian, &header); err != nil {
+		return fmt.Errorf("unable to read cabinet data block: %w", err)
+	}
+	data := make([]byte, header.CompressedSize)
+	if _, err := r.cabinet.reader.ReadAt(data, r.offset+cabDataSize+int64(r.cabinet.dataReserveSize)); err != nil {
+		return fmt.Errorf("unable to read cabinet data block: %w", err)
+	}
+	r.offset += cabDataSize + int64(r.cabinet.dataReserveSize) + int64(header.CompressedSize)
+	r.block++
+
+	if r.folder.Compression&cabCompressionMask == cabCompressionNone {
+		r.pending = data
+		return nil
+	}
+
+	// each MSZIP block is a deflate stream prefixed by "CK", which may refer back to the output of previous blocks
+	if len(data) < 2 || data[0] != 'C' || data[1] != 'K' {
+		return fmt.Errorf("invalid MSZIP cabinet data block")
+	}
+	block := make([]byte, header.UncompressedSize)
+	if _, err := io.ReadFull(flate.NewReaderDict(bytes.NewReader(data[2:]), r.window), block); err != nil {
+		return fmt.Errorf("unable to decompress cabinet data block: %w", err)
+	}
+	r.window = append(r.window, block...)
+	if len(r.window) > cabMSZIPWindowSize {
+		r.window = r.window[len(r.window)-cabMSZIPWindowSize:]
+	}
+	r.pending = block
+	return nil
+}
@@ -0,0 +1,81 @@
+package windows
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+)
+
+// pkcs7ContentInfo and the following types are the parts of a PKCS#7 (RFC 2315) signature needed to describe the signer
+// of a catalog file (which is PKCS#7 signed data holding a certificate trust list).
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue     `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue     `asn1:"optional,tag:1"`
+	SignerInfos      []pkcs7SignerInfo `asn1:"set"`
+}
+
+type pkcs7SignerInfo struct {
+	Version  int
+	SignerID asn1.RawValue
+}
+
+type pkcs7IssuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial *big.Int
+}
+
+// catalogSigner returns the name of the publisher that signed the given catalog file, which is the subject of the
+// certificate of the first signer (as shown by the "Signer Name" of pnputil).
+func catalogSigner(data []byte) (string, error) {
+	var info pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(data, &info); err != nil {
+		return "", err
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &signedData); err != nil {
+		return "", err
+	}
+	if len(signedData.SignerInfos) == 0 {
+		return "", fmt.Errorf("no signer information")
+	}
+
+	// the signer may be identified by the subject key identifier instead of the issuer and serial number
+	signerID := signedData.SignerInfos[0].SignerID
+	if signerID.Class != asn1.ClassUniversal || signerID.Tag != asn1.TagSequence {
+		return "", nil
+	}
+
+	var sid pkcs7IssuerAndSerial
+	if _, err := asn1.Unmarshal(signerID.FullBytes, &sid); err != nil {
+		return "", err
+	}
+
+	certs, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return "", err
+	}
+
+	for _, cert := range certs {
+		if cert.SerialNumber.Cmp(sid.Serial) != 0 || !bytes.Equal(cert.RawIssuer, sid.Issuer.FullBytes) {
+			continue
+		}
+		if cert.Subject.CommonName != "" {
+			return cert.Subject.CommonName, nil
+		}
+		if len(cert.Subject.Organization) > 0 {
+			return cert.Subject.Organization[0], nil
+		}
+	}
+	return "", nil
+}
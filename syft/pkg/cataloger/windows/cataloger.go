@@ -0,0 +1,49 @@
+/*
+Package windows provides concrete Cataloger implementations for the assemblies installed within an offline Windows
+filesystem (in-box components from the WinSxS component store and strong-named .NET assemblies from the Global
+Assembly Cache) and for the driver packages staged within its driver store, as well as for Windows Installer packages
+(.msi) and executable setup programs (NSIS and Inno Setup) found on disk, along with the files that they install.
+*/
+package windows
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+// NewAssemblyCataloger returns a new cataloger object for Windows assemblies, describing each side-by-side assembly by
+// its WinSxS component manifest and each Global Assembly Cache assembly by the directory layout of the cache (which
+// encodes the assembly identity).
+func NewAssemblyCataloger() pkg.Cataloger {
+	return generic.NewCataloger("windows-assembly-cataloger").
+		WithParserByGlobs(parseSxSManifest, "**/WinSxS/Manifests/*.manifest", "**/winsxs/manifests/*.manifest").
+		WithParserByGlobs(parseGACAssembly, "**/assembly/GAC*/*/*/*.dll", "**/assembly/GAC*/*/*/*.exe")
+}
+
+// NewDriverCataloger returns a new cataloger object for the driver packages within the driver store of a Windows
+// filesystem (including third-party drivers), describing each driver package by its INF file and the signer of its
+// catalog file.
+func NewDriverCataloger() pkg.Cataloger {
+	return generic.NewCataloger("windows-driver-cataloger").
+		WithParserByGlobs(parseDriverINF, "**/System32/DriverStore/FileRepository/*/*.inf", "**/system32/driverstore/filerepository/*/*.inf")
+}
+
+// NewMSICataloger returns a new cataloger object for Windows Installer packages (.msi), describing the product that each
+// installer installs (whether or not it has been installed) by the tables of the installer database. The files within
+// the cabinets of each installer are cataloged with the given payload catalogers, where packages found within the
+// payload are related to the product of the installer.
+func NewMSICataloger(payloadCatalogers ...pkg.Cataloger) pkg.Cataloger {
+	p := msiParser{cataloger: "windows-msi-cataloger", payloadCatalogers: payloadCatalogers}
+	return generic.NewCataloger(p.cataloger).
+		WithParserByGlobs(p.parseMSI, "**/*.msi")
+}
+
+// NewInstallerCataloger returns a new cataloger object for executable setup programs built with NSIS or Inno Setup,
+// describing the product that each installer installs by the product information embedded within the installer. The
+// files within the payload of NSIS installers are cataloged with the given payload catalogers, where packages found
+// within the payload are related to the product of the installer.
+func NewInstallerCataloger(payloadCatalogers ...pkg.Cataloger) pkg.Cataloger {
+	p := installerParser{cataloger: "windows-installer-cataloger", payloadCatalogers: payloadCatalogers}
+	return generic.NewCataloger(p.cataloger).
+		WithParserByGlobs(p.parseInstaller, "**/*.exe", "**/*.EXE")
+}
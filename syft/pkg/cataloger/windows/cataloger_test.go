@@ -0,0 +1,426 @@
+package windows
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/pkgtest"
+)
+
+func Test_AssemblyCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"Windows/WinSxS/Manifests/amd64_microsoft-windows-foo_31bf3856ad364e35_10.0.19041.1_none_0123456789abcdef.manifest",
+			"mnt/windows/winsxs/manifests/x86_microsoft-windows-bar_31bf3856ad364e35_10.0.19041.1_none_0123456789abcdef.manifest",
+			"Windows/assembly/GAC_MSIL/System.Foo/2.0.0.0__b77a5c561934e089/System.Foo.dll",
+			"Windows/Microsoft.NET/assembly/GAC_64/Bar/v4.0_4.0.0.0__b03f5f7f11d50a3a/Bar.exe",
+		}).
+		TestCataloger(t, NewAssemblyCataloger())
+}
+
+func TestAssemblyCataloger(t *testing.T) {
+	const (
+		manifests = "Windows/WinSxS/Manifests"
+		gac       = "Windows/assembly"
+		netGAC    = "Windows/Microsoft.NET/assembly"
+	)
+
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+
+	// the manifest is uncompressed, so the identity is read from the manifest itself
+	commonControls := pkg.Package{
+		Name:      "Microsoft.Windows.Common-Controls",
+		Version:   "6.0.19041.1110",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(manifests + "/amd64_microsoft.windows.common-controls_6595b64144ccf1df_6.0.19041.1110_none_60b5254171f9507e.manifest")),
+		PURL:      "pkg:generic/Microsoft.Windows.Common-Controls@6.0.19041.1110?arch=amd64",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "Microsoft.Windows.Common-Controls",
+			Version:               "6.0.19041.1110",
+			ProcessorArchitecture: "amd64",
+			PublicKeyToken:        "6595b64144ccf1df",
+			Type:                  "win32",
+			Store:                 pkg.WindowsSxSAssemblyStore,
+		},
+	}
+
+	// the manifest is compressed, so the identity is taken from the manifest name (the compressed manifest with a
+	// truncated name is not reported)
+	notepad := pkg.Package{
+		Name:      "microsoft-windows-notepad",
+		Version:   "10.0.19041.1865",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(manifests + "/wow64_microsoft-windows-notepad_31bf3856ad364e35_10.0.19041.1865_en-us_b8d3b1bd0eb6e0a4.manifest")),
+		PURL:      "pkg:generic/microsoft-windows-notepad@10.0.19041.1865?arch=wow64",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "microsoft-windows-notepad",
+			Version:               "10.0.19041.1865",
+			ProcessorArchitecture: "wow64",
+			Culture:               "en-us",
+			PublicKeyToken:        "31bf3856ad364e35",
+			Store:                 pkg.WindowsSxSAssemblyStore,
+		},
+	}
+
+	systemDataMSIL := pkg.Package{
+		Name:      "System.Data",
+		Version:   "2.0.0.0",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(gac + "/GAC_MSIL/System.Data/2.0.0.0__b77a5c561934e089/System.Data.dll")),
+		PURL:      "pkg:generic/System.Data@2.0.0.0?arch=msil",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "System.Data",
+			Version:               "2.0.0.0",
+			ProcessorArchitecture: "msil",
+			PublicKeyToken:        "b77a5c561934e089",
+			Store:                 pkg.WindowsGACAssemblyStore,
+		},
+	}
+
+	systemData32 := pkg.Package{
+		Name:      "System.Data",
+		Version:   "2.0.0.0",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(gac + "/GAC_32/System.Data/2.0.0.0__b77a5c561934e089/System.Data.dll")),
+		PURL:      "pkg:generic/System.Data@2.0.0.0?arch=x86",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "System.Data",
+			Version:               "2.0.0.0",
+			ProcessorArchitecture: "x86",
+			PublicKeyToken:        "b77a5c561934e089",
+			Store:                 pkg.WindowsGACAssemblyStore,
+		},
+	}
+
+	// the .NET 4 cache prefixes the version directory with the runtime version (other files within the directory
+	// are not reported)
+	systemXML := pkg.Package{
+		Name:      "System.Xml",
+		Version:   "4.0.0.0",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(netGAC + "/GAC_MSIL/System.Xml/v4.0_4.0.0.0__b77a5c561934e089/System.Xml.dll")),
+		PURL:      "pkg:generic/System.Xml@4.0.0.0?arch=msil",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "System.Xml",
+			Version:               "4.0.0.0",
+			ProcessorArchitecture: "msil",
+			PublicKeyToken:        "b77a5c561934e089",
+			Store:                 pkg.WindowsGACAssemblyStore,
+		},
+	}
+
+	visualBasicResources := pkg.Package{
+		Name:      "Microsoft.VisualBasic.resources",
+		Version:   "10.0.0.0",
+		FoundBy:   "windows-assembly-cataloger",
+		Locations: file.NewLocationSet(primary(netGAC + "/GAC_MSIL/Microsoft.VisualBasic.resources/v4.0_10.0.0.0_de_b03f5f7f11d50a3a/Microsoft.VisualBasic.resources.dll")),
+		PURL:      "pkg:generic/Microsoft.VisualBasic.resources@10.0.0.0?arch=msil",
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata: pkg.WindowsAssemblyEntry{
+			Name:                  "Microsoft.VisualBasic.resources",
+			Version:               "10.0.0.0",
+			ProcessorArchitecture: "msil",
+			Culture:               "de",
+			PublicKeyToken:        "b03f5f7f11d50a3a",
+			Store:                 pkg.WindowsGACAssemblyStore,
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/windows-root").
+		Expects([]pkg.Package{commonControls, notepad, systemDataMSIL, systemData32, systemXML, visualBasicResources}, nil).
+		TestCataloger(t, NewAssemblyCataloger())
+}
+
+func Test_DriverCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"Windows/System32/DriverStore/FileRepository/foo.inf_amd64_0123456789abcdef/foo.inf",
+			"mnt/windows/system32/driverstore/filerepository/bar.inf_x86_0123456789abcdef/bar.inf",
+		}).
+		TestCataloger(t, NewDriverCataloger())
+}
+
+func TestDriverCataloger(t *testing.T) {
+	const repository = "Windows/System32/DriverStore/FileRepository"
+
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+	supporting := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation)
+	}
+
+	// the INF file is UTF-16 encoded and names an architecture specific catalog file, which is signed
+	contoso := pkg.Package{
+		Name:    "contosonet",
+		Version: "2.4.1.0",
+		FoundBy: "windows-driver-cataloger",
+		Locations: file.NewLocationSet(
+			primary(repository+"/contosonet.inf_amd64_0123456789abcdef/contosonet.inf"),
+			supporting(repository+"/contosonet.inf_amd64_0123456789abcdef/contosonet.cat"),
+		),
+		PURL: "pkg:generic/contosonet@2.4.1.0?arch=amd64",
+		Type: pkg.WindowsDriverPkg,
+		Metadata: pkg.WindowsDriverEntry{
+			InfName:       "contosonet.inf",
+			Provider:      "Contoso Ltd.",
+			Class:         "Net",
+			ClassGUID:     "{4d36e972-e325-11ce-bfc1-08002be10318}",
+			DriverVersion: "2.4.1.0",
+			DriverDate:    "2023-03-14",
+			Architecture:  "amd64",
+			CatalogFile:   "contosonet.cat",
+			Signer:        "Contoso Hardware Publisher",
+		},
+	}
+
+	// the provider is only defined within a localized strings section, and the catalog file is missing
+	usbstor := pkg.Package{
+		Name:      "usbstor",
+		Version:   "10.0.19041.1",
+		FoundBy:   "windows-driver-cataloger",
+		Locations: file.NewLocationSet(primary(repository + "/usbstor.inf_amd64_fedcba9876543210/usbstor.inf")),
+		PURL:      "pkg:generic/usbstor@10.0.19041.1?arch=amd64",
+		Type:      pkg.WindowsDriverPkg,
+		Metadata: pkg.WindowsDriverEntry{
+			InfName:       "usbstor.inf",
+			Provider:      "Microsoft",
+			Class:         "USB",
+			ClassGUID:     "{36FC9E60-C465-11CF-8056-444553540000}",
+			DriverVersion: "10.0.19041.1",
+			DriverDate:    "2006-06-21",
+			Architecture:  "amd64",
+			CatalogFile:   "usbstor.cat",
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/driver-store").
+		Expects([]pkg.Package{contoso, usbstor}, nil).
+		TestCataloger(t, NewDriverCataloger())
+}
+
+func Test_MSICataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"installers/setup.msi",
+		}).
+		TestCataloger(t, NewMSICataloger())
+}
+
+func TestMSICataloger(t *testing.T) {
+	location := file.NewLocation("installers/contoso-widget.msi").WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+
+	// the manufacturer is encoded with the Windows-1252 code page
+	widget := pkg.Package{
+		Name:      "Contoso Widget",
+		Version:   "4.2.0.1200",
+		FoundBy:   "windows-msi-cataloger",
+		Locations: file.NewLocationSet(location),
+		PURL:      "pkg:generic/Contoso%20Widget@4.2.0.1200",
+		Type:      pkg.WindowsMSIPkg,
+		Metadata: pkg.WindowsMSIEntry{
+			ProductName:     "Contoso Widget",
+			ProductVersion:  "4.2.0.1200",
+			Manufacturer:    "Contoso® Ltd.",
+			ProductCode:     "{8E9F1A7B-2C3D-4E5F-8091-A2B3C4D5E6F7}",
+			UpgradeCode:     "{1A2B3C4D-5E6F-4071-8293-A4B5C6D7E8F9}",
+			ProductLanguage: "1033",
+			Files: []pkg.WindowsMSIFileRecord{
+				{Name: "widget.exe", Size: 482304, Version: "4.2.0.1200"},
+				{Name: "widget.dll", Size: 120832, Version: "4.2.0.1200"},
+				{Name: "readme.txt", Size: 1024},
+			},
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/msi").
+		Expects([]pkg.Package{widget}, nil).
+		TestCataloger(t, NewMSICataloger())
+}
+
+func TestMSICataloger_Payload(t *testing.T) {
+	location := file.NewLocation("installers/contoso-service.msi")
+	nested := func(p string) file.Location {
+		l := file.NewLocationFromCoordinates(location.Coordinates)
+		l.AccessPath = "installers/contoso-service.msi:" + p
+		return l
+	}
+
+	service := pkg.Package{
+		Name:      "Contoso Service",
+		Version:   "2.0.0",
+		FoundBy:   "windows-msi-cataloger",
+		Locations: file.NewLocationSet(location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+		PURL:      "pkg:generic/Contoso%20Service@2.0.0",
+		Type:      pkg.WindowsMSIPkg,
+		Metadata: pkg.WindowsMSIEntry{
+			ProductName:     "Contoso Service",
+			ProductVersion:  "2.0.0",
+			Manufacturer:    "Contoso Ltd.",
+			ProductCode:     "{0F1E2D3C-4B5A-4697-8877-665544332211}",
+			ProductLanguage: "1033",
+			Files: []pkg.WindowsMSIFileRecord{
+				{Name: "service.exe", Size: 16, Version: "2.0.0.0"},
+				{Name: "service.json", Size: 37},
+				{Name: "readme.txt", Size: 14},
+			},
+		},
+	}
+
+	// the files within the embedded cabinet are extracted to the directories that they are installed to
+	var payload []pkg.Package
+	for _, p := range []string{
+		"ProgramFilesFolder/Contoso/Service/bin/service.exe",
+		"ProgramFilesFolder/Contoso/Service/bin/service.json",
+		"ProgramFilesFolder/Contoso/Service/readme.txt",
+	} {
+		payload = append(payload, pkg.Package{
+			Name:      path.Base(p),
+			FoundBy:   "windows-msi-cataloger",
+			Locations: file.NewLocationSet(nested(p)),
+		})
+	}
+
+	var relationships []artifact.Relationship
+	for _, p := range payload {
+		relationships = append(relationships, artifact.Relationship{From: service, To: p, Type: artifact.ContainsRelationship})
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/msi-cabinet").
+		Expects(append([]pkg.Package{service}, payload...), relationships).
+		TestCataloger(t, NewMSICataloger(newTestPayloadCataloger()))
+}
+
+func Test_InstallerCataloger_Globs(t *testing.T) {
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/glob-paths").
+		ExpectsResolverContentQueries([]string{
+			"installers/setup.exe",
+			"installers/UPDATE.EXE",
+			"Windows/Microsoft.NET/assembly/GAC_64/Bar/v4.0_4.0.0.0__b03f5f7f11d50a3a/Bar.exe",
+		}).
+		TestCataloger(t, NewInstallerCataloger())
+}
+
+func TestInstallerCataloger(t *testing.T) {
+	primary := func(path string) file.Location {
+		return file.NewLocation(path).WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)
+	}
+
+	// the product information is written to the uninstall registry key by the installer script, and the nested
+	// installer is extracted from the (solid LZMA compressed) data block of the installer
+	agent := pkg.Package{
+		Name:      "Contoso Agent",
+		Version:   "3.1.0",
+		FoundBy:   "windows-installer-cataloger",
+		Locations: file.NewLocationSet(primary("contoso-agent-setup.exe")),
+		PURL:      "pkg:generic/Contoso%20Agent@3.1.0",
+		Type:      pkg.WindowsInstallerPkg,
+		Metadata: pkg.WindowsInstallerEntry{
+			Format:         "nsis",
+			ProductName:    "Contoso Agent",
+			ProductVersion: "3.1.0",
+			Publisher:      "Contoso Ltd.",
+			URL:            "https://contoso.example/agent",
+			Files: []string{
+				"$INSTDIR/contoso-widget.msi",
+				"$INSTDIR/docs/readme.txt",
+			},
+		},
+	}
+
+	widgetLocation := file.NewLocationFromCoordinates(file.NewLocation("contoso-agent-setup.exe").Coordinates)
+	widgetLocation.AccessPath = "contoso-agent-setup.exe:$INSTDIR/contoso-widget.msi"
+	widgetLocation.Annotations[pkg.EvidenceAnnotationKey] = pkg.PrimaryEvidenceAnnotation
+	widget := pkg.Package{
+		Name:      "Contoso Widget",
+		Version:   "4.2.0.1200",
+		FoundBy:   "windows-installer-cataloger",
+		Locations: file.NewLocationSet(widgetLocation),
+		PURL:      "pkg:generic/Contoso%20Widget@4.2.0.1200",
+		Type:      pkg.WindowsMSIPkg,
+		Metadata: pkg.WindowsMSIEntry{
+			ProductName:     "Contoso Widget",
+			ProductVersion:  "4.2.0.1200",
+			Manufacturer:    "Contoso® Ltd.",
+			ProductCode:     "{8E9F1A7B-2C3D-4E5F-8091-A2B3C4D5E6F7}",
+			UpgradeCode:     "{1A2B3C4D-5E6F-4071-8293-A4B5C6D7E8F9}",
+			ProductLanguage: "1033",
+			Files: []pkg.WindowsMSIFileRecord{
+				{Name: "widget.exe", Size: 482304, Version: "4.2.0.1200"},
+				{Name: "widget.dll", Size: 120832, Version: "4.2.0.1200"},
+				{Name: "readme.txt", Size: 1024},
+			},
+		},
+	}
+
+	// the installer does not register itself for uninstallation, so the product is described by the version resource
+	tools := pkg.Package{
+		Name:      "Contoso Tools",
+		Version:   "1.5.2",
+		FoundBy:   "windows-installer-cataloger",
+		Locations: file.NewLocationSet(primary("contoso-tools-setup.exe")),
+		PURL:      "pkg:generic/Contoso%20Tools@1.5.2",
+		Type:      pkg.WindowsInstallerPkg,
+		Metadata: pkg.WindowsInstallerEntry{
+			Format:         "nsis",
+			ProductName:    "Contoso Tools",
+			ProductVersion: "1.5.2",
+			Publisher:      "Contoso Ltd.",
+			Files: []string{
+				"$INSTDIR/tools.txt",
+			},
+		},
+	}
+
+	client := pkg.Package{
+		Name:      "Contoso Client",
+		Version:   "2.4.1",
+		FoundBy:   "windows-installer-cataloger",
+		Locations: file.NewLocationSet(primary("contoso-client-setup.exe")),
+		PURL:      "pkg:generic/Contoso%20Client@2.4.1",
+		Type:      pkg.WindowsInstallerPkg,
+		Metadata: pkg.WindowsInstallerEntry{
+			Format:         "inno-setup",
+			ProductName:    "Contoso Client",
+			ProductVersion: "2.4.1",
+			Publisher:      "Contoso Ltd.",
+			AppID:          "5C1F0C8E-2B7A-4D3E-9F61-0A8B7C6D5E4F",
+			URL:            "https://contoso.example/client",
+		},
+	}
+
+	pkgtest.NewCatalogTester().
+		FromDirectory(t, "test-fixtures/setup").
+		Expects([]pkg.Package{agent, widget, tools, client}, []artifact.Relationship{
+			{From: agent, To: widget, Type: artifact.ContainsRelationship},
+		}).
+		TestCataloger(t, NewInstallerCataloger(NewMSICataloger()))
+}
+
+// newTestPayloadCataloger returns a cataloger that reports a package for each file within the payload of an installer.
+func newTestPayloadCataloger() pkg.Cataloger {
+	return generic.NewCataloger("test-payload-cataloger").
+		WithParserByGlobs(func(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+			return []pkg.Package{{Name: path.Base(reader.RealPath), Locations: file.NewLocationSet(reader.Location)}}, nil, nil
+		}, "**/*")
+}
@@ -0,0 +1,277 @@
+package windows
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// compound file binary (CFB, also known as OLE structured storage) format constants (see [MS-CFB])
+const (
+	cfbHeaderSize         = 512
+	cfbDirectoryEntrySize = 128
+	cfbHeaderDIFATCount   = 109
+
+	cfbEndOfChain  = 0xfffffffe
+	cfbMaxSector   = 0xfffffffa
+	cfbNoStream    = 0xffffffff
+	cfbStorageType = 1
+	cfbStreamType  = 2
+	cfbRootType    = 5
+
+	// cfbMaxStreamSize is the largest stream that will be read, which is far larger than the database tables of an
+	// installer (but not necessarily the cabinets embedded within an installer, which are not extracted when larger)
+	cfbMaxStreamSize = 512 * 1024 * 1024
+)
+
+var cfbSignature = []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}
+
+type cfbHeader struct {
+	Signature            [8]byte
+	CLSID                [16]byte
+	MinorVersion         uint16
+	MajorVersion         uint16
+	ByteOrder            uint16
+	SectorShift          uint16
+	MiniSectorShift      uint16
+	_                    [6]byte
+	DirectorySectors     uint32
+	FATSectors           uint32
+	FirstDirectorySector uint32
+	TransactionSignature uint32
+	MiniStreamCutoff     uint32
+	FirstMiniFATSector   uint32
+	MiniFATSectors       uint32
+	FirstDIFATSector     uint32
+	DIFATSectors         uint32
+	DIFAT                [cfbHeaderDIFATCount]uint32
+}
+
+type cfbDirectoryEntry struct {
+	Name         [32]uint16
+	NameLength   uint16
+	Type         uint8
+	Color        uint8
+	LeftSibling  uint32
+	RightSibling uint32
+	Child        uint32
+	CLSID        [16]byte
+	StateBits    uint32
+	Created      uint64
+	Modified     uint64
+	StartSector  uint32
+	Size         uint64
+}
+
+func (e cfbDirectoryEntry) name() string {
+	n := int(e.NameLength/2) - 1 // the length includes the terminating null character
+	if n < 0 || n > len(e.Name) {
+		return ""
+	}
+	return string(utf16.Decode(e.Name[:n]))
+}
+
+// compoundFile is a reader of the streams within the root storage of a compound file (such as a Windows Installer
+// database), which reads only the sectors of the streams that are requested.
+type compoundFile struct {
+	reader         io.ReaderAt
+	header         cfbHeader
+	sectorSize     int64
+	miniSectorSize int64
+	fat            []uint32
+	miniFAT        []uint32
+	entries        []cfbDirectoryEntry
+	miniStream     []byte
+}
+
+func openCompoundFile(reader io.ReaderAt) (*compoundFile, error) {
+	cf := &compoundFile{reader: reader}
+
+	if err := binary.Read(io.NewSectionReader(reader, 0, cfbHeaderSize), binary.LittleEndian, &cf.header); err != nil {
+		return nil, fmt.Errorf("unable to read compound file header: %w", err)
+	}
+	if !bytes.Equal(cf.header.Signature[:], cfbSignature) {
+		return nil, fmt.Errorf("not a compound file")
+	}
+	if cf.header.SectorShift != 9 && cf.header.SectorShift != 12 {
+		return nil, fmt.Errorf("invalid compound file sector shift: %d", cf.header.SectorShift)
+	}
+	if cf.header.MiniSectorShift != 6 {
+		return nil, fmt.Errorf("invalid compound file mini sector shift: %d", cf.header.MiniSectorShift)
+	}
+	cf.sectorSize = 1 << cf.header.SectorShift
+	cf.miniSectorSize = 1 << cf.header.MiniSectorShift
+
+	if err := cf.readFAT(); err != nil {
+		return nil, err
+	}
+
+	directory, err := cf.readChain(cf.header.FirstDirectorySector, cf.fat, cf.sectorSize, cf.readSector, -1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read compound file directory: %w", err)
+	}
+	cf.entries = make([]cfbDirectoryEntry, len(directory)/cfbDirectoryEntrySize)
+	if err := binary.Read(bytes.NewReader(directory), binary.LittleEndian, cf.entries); err != nil {
+		return nil, fmt.Errorf("unable to read compound file directory: %w", err)
+	}
+	if len(cf.entries) == 0 || cf.entries[0].Type != cfbRootType {
+		return nil, fmt.Errorf("no root storage found within compound file")
+	}
+
+	if cf.header.MiniFATSectors > 0 {
+		miniFAT, err := cf.readChain(cf.header.FirstMiniFATSector, cf.fat, cf.sectorSize, cf.readSector, -1)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file mini FAT: %w", err)
+		}
+		cf.miniFAT = toUint32s(miniFAT)
+	}
+
+	return cf, nil
+}
+
+// readFAT reads the file allocation table, which is held within the sectors listed by the DIFAT (the first of which
+// are within the header, followed by a chain of DIFAT sectors).
+func (cf *compoundFile) readFAT() error {
+	var sectors []uint32
+	for i := 0; i < cfbHeaderDIFATCount && uint32(len(sectors)) < cf.header.FATSectors; i++ {
+		sectors = append(sectors, cf.header.DIFAT[i])
+	}
+
+	next := cf.header.FirstDIFATSector
+	for i := uint32(0); i < cf.header.DIFATSectors && next <= cfbMaxSector; i++ {
+		data, err := cf.readSector(next)
+		if err != nil {
+			return fmt.Errorf("unable to read compound file DIFAT: %w", err)
+		}
+		entries := toUint32s(data)
+		for _, s := range entries[:len(entries)-1] {
+			if uint32(len(sectors)) < cf.header.FATSectors {
+				sectors = append(sectors, s)
+			}
+		}
+		next = entries[len(entries)-1]
+	}
+
+	for _, s := range sectors {
+		data, err := cf.readSector(s)
+		if err != nil {
+			return fmt.Errorf("unable to read compound file FAT: %w", err)
+		}
+		cf.fat = append(cf.fat, toUint32s(data)...)
+	}
+	return nil
+}
+
+func (cf *compoundFile) readSector(sector uint32) ([]byte, error) {
+	if sector > cfbMaxSector {
+		return nil, fmt.Errorf("invalid sector: %#x", sector)
+	}
+	data := make([]byte, cf.sectorSize)
+	if _, err := cf.reader.ReadAt(data, (int64(sector)+1)*cf.sectorSize); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (cf *compoundFile) readMiniSector(sector uint32) ([]byte, error) {
+	start := int64(sector) * cf.miniSectorSize
+	if start+cf.miniSectorSize > int64(len(cf.miniStream)) {
+		return nil, fmt.Errorf("invalid mini sector: %#x", sector)
+	}
+	return cf.miniStream[start : start+cf.miniSectorSize], nil
+}
+
+// readChain reads the chain of sectors starting at the given sector, as linked by the given allocation table. Reading
+// stops once the given size has been read (when not negative).
+func (cf *compoundFile) readChain(start uint32, table []uint32, sectorSize int64, read func(uint32) ([]byte, error), size int64) ([]byte, error) {
+	var data []byte
+	for sector, count := start, 0; sector != cfbEndOfChain; count++ {
+		if size >= 0 && int64(len(data)) >= size {
+			break
+		}
+		if int(sector) >= len(table) || count > len(table) {
+			return nil, fmt.Errorf("invalid sector chain")
+		}
+		if int64(len(data))+sectorSize > cfbMaxStreamSize {
+			return nil, fmt.Errorf("stream is too large")
+		}
+
+		sectorData, err := read(sector)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, sectorData...)
+		sector = table[sector]
+	}
+
+	if size >= 0 {
+		if int64(len(data)) < size {
+			return nil, fmt.Errorf("stream is truncated")
+		}
+		data = data[:size]
+	}
+	return data, nil
+}
+
+// streams returns the streams within the root storage of the compound file, by name.
+func (cf *compoundFile) streams() map[string]cfbDirectoryEntry {
+	streams := make(map[string]cfbDirectoryEntry)
+
+	visited := make(map[uint32]bool)
+	pending := []uint32{cf.entries[0].Child}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if id == cfbNoStream || int(id) >= len(cf.entries) || visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		entry := cf.entries[id]
+		if entry.Type == cfbStreamType {
+			streams[entry.name()] = entry
+		}
+		pending = append(pending, entry.LeftSibling, entry.RightSibling)
+	}
+	return streams
+}
+
+// readStream returns the contents of the given stream, which is held within the mini stream when smaller than the
+// mini stream cutoff size.
+func (cf *compoundFile) readStream(entry cfbDirectoryEntry) ([]byte, error) {
+	size := int64(entry.Size)
+	if cf.header.MajorVersion == 3 {
+		// the high bits of the size may not be zeroed within version 3 files
+		size &= 0xffffffff
+	}
+	if size > cfbMaxStreamSize {
+		return nil, fmt.Errorf("stream is too large: %d bytes", size)
+	}
+	if size == 0 {
+		return nil, nil
+	}
+
+	if size >= int64(cf.header.MiniStreamCutoff) {
+		return cf.readChain(entry.StartSector, cf.fat, cf.sectorSize, cf.readSector, size)
+	}
+
+	if cf.miniStream == nil {
+		root := cf.entries[0]
+		miniStream, err := cf.readChain(root.StartSector, cf.fat, cf.sectorSize, cf.readSector, int64(root.Size&0xffffffff))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compound file mini stream: %w", err)
+		}
+		cf.miniStream = miniStream
+	}
+	return cf.readChain(entry.StartSector, cf.miniFAT, cf.miniSectorSize, cf.readMiniSector, size)
+}
+
+func toUint32s(data []byte) []uint32 {
+	values := make([]uint32, len(data)/4)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(data[i*4:])
+	}
+	return values
+}
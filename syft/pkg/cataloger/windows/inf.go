@@ -0,0 +1,149 @@
+package windows
+
+import (
+	"bytes"
+	"encoding/binary"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+var (
+	utf16LEBOM = []byte{0xff, 0xfe}
+	utf16BEBOM = []byte{0xfe, 0xff}
+
+	// e.g. "%ProviderName%", which is replaced with the value of the ProviderName key within the [Strings] section
+	infStringTokenPattern = regexp.MustCompile(`%([^%]*)%`)
+)
+
+// infFile is the contents of an INF file, as the (lower-cased) names of its sections to the entries within each section
+// (by lower-cased key). Only the first entry for a key within a section is kept.
+type infFile map[string]map[string]string
+
+// readINF returns the sections of the given INF file, which may be encoded as UTF-8 or UTF-16 (as is common for the INF
+// files of driver packages).
+func readINF(contents []byte) infFile {
+	inf := make(infFile)
+
+	var section map[string]string
+	var continued string
+	for _, line := range strings.Split(decodeINFText(contents), "\n") {
+		line = strings.TrimSpace(stripINFComment(strings.TrimRight(line, "\r")))
+		if strings.HasSuffix(line, `\`) {
+			// the entry continues on the next line
+			continued += strings.TrimSuffix(line, `\`)
+			continue
+		}
+		line, continued = strings.TrimSpace(continued+line), ""
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			section = nil
+			if end := strings.Index(line, "]"); end > 0 {
+				name := strings.ToLower(strings.TrimSpace(line[1:end]))
+				if inf[name] == nil {
+					inf[name] = make(map[string]string)
+				}
+				section = inf[name]
+			}
+			continue
+		}
+		if section == nil {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(unquoteINFValue(strings.TrimSpace(key)))
+		if _, exists := section[key]; !exists {
+			section[key] = unquoteINFValue(strings.TrimSpace(value))
+		}
+	}
+	return inf
+}
+
+// value returns the value of the given key within the given section, with any string tokens (e.g. "%ProviderName%")
+// replaced with their values from the [Strings] section (or a localized [Strings.<language>] section).
+func (inf infFile) value(section, key string) string {
+	value := inf[section][key]
+	return infStringTokenPattern.ReplaceAllStringFunc(value, func(token string) string {
+		name := strings.ToLower(token[1 : len(token)-1])
+		if name == "" {
+			// "%%" is an escaped percent sign
+			return "%"
+		}
+		if s, ok := inf.stringValue(name); ok {
+			return s
+		}
+		return token
+	})
+}
+
+func (inf infFile) stringValue(name string) (string, bool) {
+	if s, ok := inf["strings"][name]; ok {
+		return s, true
+	}
+
+	var localized []string
+	for section := range inf {
+		if strings.HasPrefix(section, "strings.") {
+			localized = append(localized, section)
+		}
+	}
+	sort.Strings(localized)
+
+	for _, section := range localized {
+		if s, ok := inf[section][name]; ok {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+func decodeINFText(contents []byte) string {
+	var order binary.ByteOrder
+	switch {
+	case bytes.HasPrefix(contents, utf16LEBOM):
+		order, contents = binary.LittleEndian, contents[len(utf16LEBOM):]
+	case bytes.HasPrefix(contents, utf16BEBOM):
+		order, contents = binary.BigEndian, contents[len(utf16BEBOM):]
+	default:
+		return string(bytes.TrimPrefix(contents, utf8BOM))
+	}
+
+	units := make([]uint16, len(contents)/2)
+	for i := range units {
+		units[i] = order.Uint16(contents[i*2:])
+	}
+	return string(utf16.Decode(units))
+}
+
+// stripINFComment returns the given line without a trailing comment (starting with ";" outside of a quoted string).
+func stripINFComment(line string) string {
+	var quoted bool
+	for i, r := range line {
+		switch r {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// unquoteINFValue returns the given value without enclosing quotes, where a doubled quote within a quoted string is an
+// escaped quote.
+func unquoteINFValue(value string) string {
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return value
+	}
+	return strings.ReplaceAll(value[1:len(value)-1], `""`, `"`)
+}
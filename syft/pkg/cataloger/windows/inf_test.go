@@ -0,0 +1,59 @@
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_readINF(t *testing.T) {
+	contents := []byte(`; a comment
+[Version]
+Provider = %Company% %Product% ; trailing comment
+Description = "a ""quoted"" value; not a comment"
+DriverVer = 01/02/2024, \
+    1.2.3.4
+Provider = ignored duplicate
+Percent = 100%%
+
+[version]
+Class = Merged
+
+[Strings]
+COMPANY = "Contoso Ltd."
+
+[Strings.0409]
+Product = Widget
+Company = "Localized Contoso"
+`)
+
+	inf := readINF(contents)
+
+	assert.Equal(t, "Contoso Ltd. Widget", inf.value("version", "provider"))
+	assert.Equal(t, `a "quoted" value; not a comment`, inf.value("version", "description"))
+	assert.Equal(t, "01/02/2024, 1.2.3.4", inf.value("version", "driverver"))
+	assert.Equal(t, "100%", inf.value("version", "percent"))
+	assert.Equal(t, "Merged", inf.value("version", "class"))
+	assert.Equal(t, "", inf.value("version", "missing"))
+}
+
+func Test_parseDriverVer(t *testing.T) {
+	tests := []struct {
+		value       string
+		wantDate    string
+		wantVersion string
+	}{
+		{value: "06/21/2006,10.0.19041.1", wantDate: "2006-06-21", wantVersion: "10.0.19041.1"},
+		{value: "3/4/2023, 2.4.1.0", wantDate: "2023-03-04", wantVersion: "2.4.1.0"},
+		{value: "12/31/2019", wantDate: "2019-12-31"},
+		{value: "not a date,1.0", wantVersion: "1.0"},
+		{value: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			date, version := parseDriverVer(tt.value)
+			assert.Equal(t, tt.wantDate, date)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
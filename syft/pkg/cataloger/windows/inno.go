@@ -0,0 +1,208 @@
+package windows
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Inno Setup installer format constants (see Shared.Struct.pas of the Inno Setup sources, and innoextract)
+const (
+	innoSetupIDSize = 64
+
+	// innoChunkSize is the size of the chunks that the compressed setup header is split into (each prefixed by a CRC32)
+	innoChunkSize = 4096
+
+	// innoMaxSearchSize is how far past the end of an executable to search for the setup header
+	innoMaxSearchSize = 64 * 1024 * 1024
+)
+
+var (
+	innoSetupIDPrefix  = []byte("Inno Setup Setup Data (")
+	innoVersionPattern = regexp.MustCompile(`^Inno Setup Setup Data \((\d+)\.(\d+)\.(\d+)(?:\.\d+)?\)(?: \(([uU])\))?`)
+)
+
+// innoProduct is the product information within the setup header of an Inno Setup installer.
+type innoProduct struct {
+	name      string
+	verName   string
+	appID     string
+	publisher string
+	url       string
+	appVer    string
+}
+
+// readInnoSetupProduct returns the product information of the Inno Setup installer within the data appended to the
+// given executable (starting at the given offset), or nil if the executable is not an Inno Setup installer. The files
+// of the installer are not read, since they are described by (and only reachable through) the remainder of the setup
+// header.
+func readInnoSetupProduct(reader io.ReaderAt, overlay, size int64) (*innoProduct, error) {
+	var product *innoProduct
+	var err error
+	scanErr := scanInnoSetupIDs(reader, overlay, size, func(offset int64) bool {
+		product, err = readInnoSetupHeader(reader, offset)
+		return product != nil || err != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return product, scanErr
+}
+
+// readInnoSetupHeader reads the product information from the setup header at the given offset, or returns nil if
+// there is no setup header at the offset (e.g. the setup loader holds the ID of the setup header as a constant).
+func readInnoSetupHeader(reader io.ReaderAt, offset int64) (*innoProduct, error) {
+	// the setup header is a compressed block following the ID: the CRC32 of the block header, the stored size of the
+	// block, whether the block is compressed, followed by the block data (as chunks each prefixed by a CRC32)
+	header := make([]byte, innoSetupIDSize+9)
+	if _, err := reader.ReadAt(header, offset); err != nil {
+		return nil, nil
+	}
+	id, blockHeader := header[:innoSetupIDSize], header[innoSetupIDSize:]
+	if crc32.ChecksumIEEE(blockHeader[4:]) != binary.LittleEndian.Uint32(blockHeader) {
+		return nil, nil
+	}
+
+	match := innoVersionPattern.FindSubmatch(id)
+	if match == nil {
+		return nil, fmt.Errorf("unsupported Inno Setup version: %q", bytes.TrimRight(id, "\x00"))
+	}
+	major, _ := strconv.Atoi(string(match[1]))
+	minor, _ := strconv.Atoi(string(match[2]))
+	patch, _ := strconv.Atoi(string(match[3]))
+	version := major*10000 + minor*100 + patch
+	if version < 50113 {
+		// TODO: support older installers, which have a different setup header layout
+		return nil, fmt.Errorf("unsupported Inno Setup version: %d.%d.%d", major, minor, patch)
+	}
+	// unicode installers are marked as such, up until 6.3 (when ANSI installers were no longer supported)
+	unicode := len(match[4]) > 0 || version >= 60300
+
+	storedSize := binary.LittleEndian.Uint32(blockHeader[4:])
+	var r io.Reader = &innoChunkReader{reader: io.NewSectionReader(reader, offset+int64(len(header)), int64(storedSize))}
+	if blockHeader[8] != 0 {
+		var err error
+		r, err = newNSISLZMAReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decompress Inno Setup header: %w", err)
+		}
+	}
+
+	strs, err := readInnoStrings(bufio.NewReader(r), unicode, 10)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read Inno Setup header: %w", err)
+	}
+
+	return &innoProduct{
+		name:      strs[0],
+		verName:   strs[1],
+		appID:     strs[2],
+		publisher: strs[4],
+		url:       strs[5],
+		appVer:    strs[9],
+	}, nil
+}
+
+// scanInnoSetupIDs calls the given function with the offset of each occurrence of the prefix of the ID of the setup
+// header (which follows the executable stub, and the compressed setup program in older versions), until the function
+// returns true.
+func scanInnoSetupIDs(reader io.ReaderAt, start, size int64, fn func(int64) bool) error {
+	if size-start > innoMaxSearchSize {
+		size = start + innoMaxSearchSize
+	}
+	r := bufio.NewReaderSize(io.NewSectionReader(reader, start, size-start), 64*1024)
+	offset := start
+	for {
+		line, err := r.ReadSlice(innoSetupIDPrefix[0])
+		offset += int64(len(line))
+		switch {
+		case err == io.EOF:
+			return nil
+		case err == bufio.ErrBufferFull:
+			continue
+		case err != nil:
+			return err
+		}
+		peek, _ := r.Peek(len(innoSetupIDPrefix) - 1)
+		if bytes.Equal(peek, innoSetupIDPrefix[1:]) && fn(offset-1) {
+			return nil
+		}
+	}
+}
+
+// readInnoStrings reads the given number of length-prefixed strings from the start of the setup header.
+func readInnoStrings(r io.Reader, unicode bool, count int) ([]string, error) {
+	var strs []string
+	for i := 0; i < count; i++ {
+		var length uint32
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+		if length > 64*1024 {
+			return nil, fmt.Errorf("string is too long: %d bytes", length)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		if !unicode {
+			strs = append(strs, decodeMSIString(data))
+			continue
+		}
+		codes := make([]uint16, len(data)/2)
+		for j := range codes {
+			codes[j] = binary.LittleEndian.Uint16(data[j*2:])
+		}
+		strs = append(strs, string(utf16.Decode(codes)))
+	}
+	return strs, nil
+}
+
+// innoChunkReader reads the data of a compressed block of an Inno Setup installer, skipping the CRC32 that prefixes each
+// chunk of the block.
+type innoChunkReader struct {
+	reader    io.Reader
+	remaining int
+}
+
+func (r *innoChunkReader) Read(p []byte) (int, error) {
+	if r.remaining == 0 {
+		var crc [4]byte
+		if _, err := io.ReadFull(r.reader, crc[:]); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+		r.remaining = innoChunkSize
+	}
+	if len(p) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= n
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// productVersion returns the version of the product, which may only be given as part of the name of the product with
+// its version (e.g. "Contoso Client 2.4.1").
+func (p innoProduct) productVersion() string {
+	if p.appVer != "" {
+		return p.appVer
+	}
+	if v := strings.TrimSpace(strings.TrimPrefix(p.verName, p.name)); v != p.verName {
+		return strings.TrimPrefix(v, "version ")
+	}
+	return ""
+}
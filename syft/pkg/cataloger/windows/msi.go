@@ -0,0 +1,295 @@
+package windows
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Windows Installer database constants (see the msi table and string pool handling of Wine or msitools)
+const (
+	// msiTableNamePrefix is the (encoded) character that the stream names of tables start with
+	msiTableNamePrefix = 0x4840
+
+	msiStringPoolStream = "_StringPool"
+	msiStringDataStream = "_StringData"
+	msiColumnsTable     = "_Columns"
+
+	// msiLongStringRefs is set within the codepage of the string pool when string references are 3 bytes (rather than 2)
+	msiLongStringRefs = 0x80000000
+
+	msiTypeValid    = 0x0100
+	msiTypeString   = 0x0800
+	msiTypeNullable = 0x1000
+)
+
+// msiStreamNameCharacters are the characters that stream names are encoded with, either as pairs of characters or
+// single characters within the range of 0x3800-0x4840.
+const msiStreamNameCharacters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz._"
+
+// msiDatabase is a reader of the tables within a Windows Installer database (.msi), which is a compound file holding
+// each table as a stream (of column-major rows), along with a string pool that the string values of the tables refer to.
+type msiDatabase struct {
+	file          *compoundFile
+	tables        map[string]cfbDirectoryEntry
+	streams       map[string]cfbDirectoryEntry
+	strings       []string
+	stringRefSize int
+	columns       map[string][]msiColumn
+}
+
+type msiColumn struct {
+	name   string
+	number int
+	typ    int
+}
+
+// size returns the number of bytes that values of the column are stored with.
+func (c msiColumn) size(stringRefSize int) int {
+	switch {
+	case c.typ&^msiTypeNullable == msiTypeString|msiTypeValid:
+		// binary (stream) columns are referred to by 2 byte values
+		return 2
+	case c.typ&msiTypeString != 0:
+		return stringRefSize
+	case c.typ&0xff == 4:
+		return 4
+	default:
+		return 2
+	}
+}
+
+func (c msiColumn) isString() bool {
+	return c.typ&msiTypeString != 0
+}
+
+func openMSIDatabase(reader io.ReaderAt) (*msiDatabase, error) {
+	cf, err := openCompoundFile(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &msiDatabase{
+		file:    cf,
+		tables:  make(map[string]cfbDirectoryEntry),
+		streams: make(map[string]cfbDirectoryEntry),
+	}
+	for name, entry := range cf.streams() {
+		if decoded, isTable := decodeMSIStreamName(name); isTable {
+			db.tables[decoded] = entry
+		} else {
+			db.streams[decoded] = entry
+		}
+	}
+
+	if err := db.readStringPool(); err != nil {
+		return nil, err
+	}
+	if err := db.readColumns(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// decodeMSIStreamName returns the name of a stream within an installer database, which is compressed by encoding pairs
+// of characters as a single character. Table streams are additionally prefixed by a marker character.
+func decodeMSIStreamName(name string) (string, bool) {
+	var decoded strings.Builder
+	var isTable bool
+	for i, r := range name {
+		switch {
+		case i == 0 && r == msiTableNamePrefix:
+			isTable = true
+		case r >= 0x3800 && r < 0x4800:
+			r -= 0x3800
+			decoded.WriteByte(msiStreamNameCharacters[r&0x3f])
+			decoded.WriteByte(msiStreamNameCharacters[(r>>6)&0x3f])
+		case r >= 0x4800 && r < 0x4840:
+			decoded.WriteByte(msiStreamNameCharacters[r-0x4800])
+		default:
+			decoded.WriteRune(r)
+		}
+	}
+	return decoded.String(), isTable
+}
+
+func (db *msiDatabase) readStream(table string) ([]byte, error) {
+	entry, ok := db.tables[table]
+	if !ok {
+		return nil, nil
+	}
+	return db.file.readStream(entry)
+}
+
+// stream returns the contents of a stream that is not a table (e.g. an embedded cabinet), or nil if there is no such
+// stream.
+func (db *msiDatabase) stream(name string) ([]byte, error) {
+	entry, ok := db.streams[name]
+	if !ok {
+		return nil, nil
+	}
+	return db.file.readStream(entry)
+}
+
+// readStringPool reads the strings that the values of string columns refer to (by index, where 0 is a null string). The
+// pool holds the length and reference count of each string, while the string data holds the strings back to back.
+func (db *msiDatabase) readStringPool() error {
+	pool, err := db.readStream(msiStringPoolStream)
+	if err != nil {
+		return fmt.Errorf("unable to read installer string pool: %w", err)
+	}
+	data, err := db.readStream(msiStringDataStream)
+	if err != nil {
+		return fmt.Errorf("unable to read installer string data: %w", err)
+	}
+	if len(pool) < 4 {
+		return fmt.Errorf("no string pool found within installer database")
+	}
+
+	db.stringRefSize = 2
+	if binary.LittleEndian.Uint32(pool)&msiLongStringRefs != 0 {
+		db.stringRefSize = 3
+	}
+
+	entries := make([][2]uint16, (len(pool)-4)/4)
+	for i := range entries {
+		entries[i] = [2]uint16{binary.LittleEndian.Uint16(pool[4+i*4:]), binary.LittleEndian.Uint16(pool[6+i*4:])}
+	}
+
+	db.strings = []string{""}
+	var offset int
+	for i := 0; i < len(entries); i++ {
+		length, refs := int(entries[i][0]), entries[i][1]
+		if length == 0 && refs != 0 {
+			// strings longer than 64KiB hold the high word of the length within the reference count field, followed
+			// by an entry for the low word of the length (and the actual reference count)
+			if i+1 >= len(entries) {
+				break
+			}
+			length = int(refs)<<16 | int(entries[i+1][0])
+			i++
+		}
+		if offset+length > len(data) {
+			return fmt.Errorf("installer string pool is truncated")
+		}
+		db.strings = append(db.strings, decodeMSIString(data[offset:offset+length]))
+		offset += length
+	}
+	return nil
+}
+
+// decodeMSIString returns the given string from the string pool, which is usually encoded with a Windows code page
+// (where anything that is not valid UTF-8 is assumed to be Windows-1252, which is a superset of Latin-1).
+func decodeMSIString(data []byte) string {
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	runes := make([]rune, len(data))
+	for i, b := range data {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// readColumns reads the _Columns table, which describes the columns of all other tables (in a fixed layout of table
+// name, column number, column name, and column type).
+func (db *msiDatabase) readColumns() error {
+	data, err := db.readStream(msiColumnsTable)
+	if err != nil {
+		return fmt.Errorf("unable to read installer columns table: %w", err)
+	}
+
+	layout := []msiColumn{
+		{name: "Table", typ: msiTypeString | msiTypeValid | 64},
+		{name: "Number", typ: msiTypeValid | 2},
+		{name: "Name", typ: msiTypeString | msiTypeValid | 64},
+		{name: "Type", typ: msiTypeValid | 2},
+	}
+
+	db.columns = make(map[string][]msiColumn)
+	for _, row := range db.readRows(data, layout) {
+		number, _ := strconv.Atoi(row["Number"])
+		typ, _ := strconv.Atoi(row["Type"])
+		db.columns[row["Table"]] = append(db.columns[row["Table"]], msiColumn{
+			name:   row["Name"],
+			number: number,
+			typ:    typ,
+		})
+	}
+
+	for _, columns := range db.columns {
+		sort.Slice(columns, func(i, j int) bool {
+			return columns[i].number < columns[j].number
+		})
+	}
+	return nil
+}
+
+// table returns the rows of the given table, as the column names to the values of each row (where integer values are
+// formatted as decimal strings, and null values are empty).
+func (db *msiDatabase) table(name string) ([]map[string]string, error) {
+	columns, ok := db.columns[name]
+	if !ok {
+		return nil, nil
+	}
+	data, err := db.readStream(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read installer table %q: %w", name, err)
+	}
+	return db.readRows(data, columns), nil
+}
+
+// readRows returns the rows of a table with the given columns, where the values are stored column by column (all values
+// of the first column, followed by all values of the second column, and so on).
+func (db *msiDatabase) readRows(data []byte, columns []msiColumn) []map[string]string {
+	var rowSize int
+	for _, c := range columns {
+		rowSize += c.size(db.stringRefSize)
+	}
+	if rowSize == 0 {
+		return nil
+	}
+
+	count := len(data) / rowSize
+	rows := make([]map[string]string, count)
+	for i := range rows {
+		rows[i] = make(map[string]string, len(columns))
+	}
+
+	offset := 0
+	for _, c := range columns {
+		size := c.size(db.stringRefSize)
+		for i := range rows {
+			value := readMSIInt(data[offset+i*size:], size)
+			switch {
+			case c.isString() && size == db.stringRefSize:
+				if int(value) < len(db.strings) {
+					rows[i][c.name] = db.strings[value]
+				}
+			case c.isString():
+				// binary values are the names of streams, which are not read
+			case value != 0:
+				// integers are stored with the high bit flipped, so that a stored zero is null
+				if size == 4 {
+					rows[i][c.name] = strconv.Itoa(int(int32(value ^ 0x80000000)))
+				} else {
+					rows[i][c.name] = strconv.Itoa(int(int16(value ^ 0x8000)))
+				}
+			}
+		}
+		offset += count * size
+	}
+	return rows
+}
+
+func readMSIInt(data []byte, size int) uint32 {
+	var value uint32
+	for i := 0; i < size; i++ {
+		value |= uint32(data[i]) << (8 * i)
+	}
+	return value
+}
@@ -0,0 +1,41 @@
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_decodeMSIStreamName(t *testing.T) {
+	tests := []struct {
+		name        string
+		encoded     string
+		wantName    string
+		wantIsTable bool
+	}{
+		{
+			name:        "table with an even number of characters",
+			encoded:     "䡀䕙䓲䕨䜷",
+			wantName:    "Property",
+			wantIsTable: true,
+		},
+		{
+			name:        "table with an odd number of characters",
+			encoded:     "䡀䈖䌧䠤",
+			wantName:    "Media",
+			wantIsTable: true,
+		},
+		{
+			name:     "stream that is not a table",
+			encoded:  "\u0005SummaryInformation",
+			wantName: "\u0005SummaryInformation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, isTable := decodeMSIStreamName(tt.encoded)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantIsTable, isTable)
+		})
+	}
+}
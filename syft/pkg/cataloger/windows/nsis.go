@@ -0,0 +1,465 @@
+package windows
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/ulikunitz/xz/lzma"
+)
+
+// NSIS (Nullsoft Scriptable Install System) installer format constants (see fileform.h of the NSIS sources)
+const (
+	nsisFirstHeaderSize = 28
+	nsisSignature       = 0xdeadbeef
+	nsisEntrySize       = 28
+
+	// nsisCompressedFlag is set within the size of a (non-solid) data block when the block is compressed
+	nsisCompressedFlag = 0x80000000
+
+	// the index of the blocks within the header (of which there are 8)
+	nsisEntriesBlock = 2
+	nsisStringsBlock = 3
+	nsisBlockCount   = 8
+
+	// opcodes of the entries (instructions) of an installer, which are the same across NSIS 2 and 3
+	nsisOpCreateDir   = 11
+	nsisOpExtractFile = 20
+	nsisOpWriteReg    = 51
+
+	// nsisMaxHeaderSize is the largest (uncompressed) header that will be read
+	nsisMaxHeaderSize = 64 * 1024 * 1024
+
+	// nsisMaxDictionarySize is the largest LZMA dictionary that will be allocated to decompress an installer
+	nsisMaxDictionarySize = 256 * 1024 * 1024
+)
+
+var nsisMagic = []byte("NullsoftInst")
+
+// variables with special meaning (following $0-$9 and $R0-$R9)
+var nsisVariables = []string{"CMDLINE", "INSTDIR", "OUTDIR", "EXEDIR", "LANGUAGE", "TEMP", "PLUGINSDIR", "EXEPATH", "EXEFILE", "HWNDPARENT", "_CLICK", "_OUTDIR"}
+
+type nsisCompression int
+
+const (
+	nsisDeflate nsisCompression = iota
+	nsisLZMA
+	nsisBZip2
+	nsisStored
+)
+
+// nsisInstaller is a reader of the script and files of an NSIS installer, which are held within a data block appended
+// to the installer executable (either compressed as a whole, "solid", or as a series of independently compressed
+// blocks).
+type nsisInstaller struct {
+	reader      io.ReaderAt
+	start       int64
+	end         int64
+	solid       bool
+	compression nsisCompression
+	header      []byte
+	dataStart   int64
+
+	unicode bool
+	nsis3   bool
+	strings []byte
+}
+
+// nsisFile is a file that the installer extracts, by the path that it is extracted to and the offset of its contents
+// within the data block.
+type nsisFile struct {
+	path   string
+	offset uint32
+}
+
+// nsisProduct is the product information written to the uninstall registry key by the installer.
+type nsisProduct struct {
+	name      string
+	version   string
+	publisher string
+	url       string
+}
+
+// findNSISInstaller returns the installer within the data appended to the given executable (starting at the given
+// offset), or nil if the executable is not an NSIS installer.
+func findNSISInstaller(reader io.ReaderAt, overlay, size int64) (*nsisInstaller, error) {
+	// the data block starts at a 512-byte boundary following the executable stub
+	var header [nsisFirstHeaderSize]byte
+	for offset := (overlay + 511) &^ 511; offset+nsisFirstHeaderSize <= size; offset += 512 {
+		if _, err := reader.ReadAt(header[:], offset); err != nil {
+			return nil, err
+		}
+		if binary.LittleEndian.Uint32(header[4:]) == nsisSignature && bytes.Equal(header[8:20], nsisMagic) {
+			return openNSISInstaller(reader, offset, size, header[:])
+		}
+		if offset-overlay > 4*1024*1024 {
+			break
+		}
+	}
+	return nil, nil
+}
+
+func openNSISInstaller(reader io.ReaderAt, offset, size int64, firstHeader []byte) (*nsisInstaller, error) {
+	headerSize := binary.LittleEndian.Uint32(firstHeader[20:])
+	if headerSize > nsisMaxHeaderSize {
+		return nil, fmt.Errorf("NSIS header is too large: %d bytes", headerSize)
+	}
+
+	n := &nsisInstaller{
+		reader: reader,
+		start:  offset + nsisFirstHeaderSize,
+		end:    size,
+	}
+
+	var sig [12]byte
+	if _, err := reader.ReadAt(sig[:], n.start); err != nil {
+		return nil, fmt.Errorf("unable to read NSIS data: %w", err)
+	}
+	blockSize := binary.LittleEndian.Uint32(sig[:])
+	switch {
+	case isNSISLZMA(sig[:]):
+		n.solid, n.compression = true, nsisLZMA
+	case isNSISLZMA(sig[4:]):
+		n.compression = nsisLZMA
+	case blockSize&nsisCompressedFlag != 0:
+		n.compression = nsisDeflate
+		if isNSISBZip2(sig[4:]) {
+			n.compression = nsisBZip2
+		}
+	case blockSize == headerSize:
+		n.compression = nsisStored
+	case isNSISBZip2(sig[:]):
+		n.solid, n.compression = true, nsisBZip2
+	default:
+		n.solid, n.compression = true, nsisDeflate
+	}
+
+	if n.compression == nsisBZip2 {
+		// TODO: support bzip2 compressed installers (which are rare, since the NSIS default is zlib or LZMA)
+		return nil, fmt.Errorf("unsupported NSIS compression: bzip2")
+	}
+
+	if err := n.readHeader(headerSize); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// isNSISLZMA returns whether the given data is the start of an LZMA stream, as written by NSIS (the properties byte
+// followed by the dictionary size, which is always a power of two).
+func isNSISLZMA(data []byte) bool {
+	dict := binary.LittleEndian.Uint32(data[1:])
+	return data[0] == 0x5d && dict >= 1<<12 && dict&(dict-1) == 0
+}
+
+func isNSISBZip2(data []byte) bool {
+	return data[0] == 0x31 && data[1] < 14
+}
+
+func (n *nsisInstaller) readHeader(size uint32) error {
+	var r io.Reader
+	if n.solid {
+		d, err := n.decompressor(io.NewSectionReader(n.reader, n.start, n.end-n.start))
+		if err != nil {
+			return err
+		}
+		var length uint32
+		if err := binary.Read(d, binary.LittleEndian, &length); err != nil {
+			return fmt.Errorf("unable to read NSIS header: %w", err)
+		}
+		r = d
+	} else {
+		block, length, err := n.block(n.start)
+		if err != nil {
+			return err
+		}
+		n.dataStart = n.start + 4 + int64(length)
+		r = block
+	}
+
+	n.header = make([]byte, size)
+	if _, err := io.ReadFull(r, n.header); err != nil {
+		return fmt.Errorf("unable to read NSIS header: %w", err)
+	}
+
+	stringsOffset, _ := n.blockHeader(nsisStringsBlock)
+	if int(stringsOffset) >= len(n.header) {
+		return fmt.Errorf("invalid NSIS header")
+	}
+	n.strings = n.header[stringsOffset:]
+	// the first string is empty, which is a single null character (which is two bytes for unicode installers)
+	n.unicode = len(n.strings) > 1 && n.strings[0] == 0 && n.strings[1] == 0
+	// NSIS 3 encodes variables (and other special characters) with codes 1-4 rather than 252-255
+	n.nsis3 = n.unicode || bytes.ContainsAny(n.strings, "\x01\x02\x03\x04")
+	return nil
+}
+
+// blockHeader returns the offset (within the header) and count of the given block.
+func (n *nsisInstaller) blockHeader(index int) (uint32, uint32) {
+	offset := 4 + index*8
+	if offset+8 > len(n.header) {
+		return 0, 0
+	}
+	return binary.LittleEndian.Uint32(n.header[offset:]), binary.LittleEndian.Uint32(n.header[offset+4:])
+}
+
+// block returns a reader of the (non-solid) data block at the given offset, which is prefixed by its size (along with
+// whether it is compressed), along with the stored size of the block.
+func (n *nsisInstaller) block(offset int64) (io.Reader, uint32, error) {
+	var size uint32
+	if err := binary.Read(io.NewSectionReader(n.reader, offset, 4), binary.LittleEndian, &size); err != nil {
+		return nil, 0, fmt.Errorf("unable to read NSIS data block: %w", err)
+	}
+	length := size &^ nsisCompressedFlag
+	data := io.NewSectionReader(n.reader, offset+4, int64(length))
+	if size&nsisCompressedFlag == 0 {
+		return data, length, nil
+	}
+	r, err := n.decompressor(data)
+	return r, length, err
+}
+
+func (n *nsisInstaller) decompressor(r io.Reader) (io.Reader, error) {
+	switch n.compression {
+	case nsisLZMA:
+		return newNSISLZMAReader(r)
+	case nsisDeflate:
+		return flate.NewReader(r), nil
+	default:
+		return r, nil
+	}
+}
+
+// newNSISLZMAReader returns a reader of an LZMA stream that is prefixed only by the properties and dictionary size
+// (without the uncompressed size of the classic LZMA header), as written by NSIS and Inno Setup.
+func newNSISLZMAReader(r io.Reader) (io.Reader, error) {
+	header := make([]byte, lzma.HeaderLen)
+	if _, err := io.ReadFull(r, header[:5]); err != nil {
+		return nil, fmt.Errorf("unable to read LZMA properties: %w", err)
+	}
+	if binary.LittleEndian.Uint32(header[1:]) > nsisMaxDictionarySize {
+		return nil, fmt.Errorf("LZMA dictionary is too large")
+	}
+	// the uncompressed size is unknown
+	for i := 5; i < len(header); i++ {
+		header[i] = 0xff
+	}
+	return lzma.NewReader(io.MultiReader(bytes.NewReader(header), r))
+}
+
+// entries returns the instructions of the installer script, as the opcode followed by its parameters.
+func (n *nsisInstaller) entries() [][7]uint32 {
+	offset, count := n.blockHeader(nsisEntriesBlock)
+	var entries [][7]uint32
+	for i := uint32(0); i < count; i++ {
+		start := int(offset) + int(i)*nsisEntrySize
+		if start+nsisEntrySize > len(n.header) {
+			break
+		}
+		var entry [7]uint32
+		for j := range entry {
+			entry[j] = binary.LittleEndian.Uint32(n.header[start+j*4:])
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// files returns the files that the installer extracts (by following the output directory set by each SetOutPath
+// instruction), along with the product information written to the uninstall registry key of the product.
+func (n *nsisInstaller) files() ([]nsisFile, nsisProduct) {
+	var files []nsisFile
+	var product nsisProduct
+	outDir := "$INSTDIR"
+	for _, entry := range n.entries() {
+		switch entry[0] {
+		case nsisOpCreateDir:
+			if entry[2] != 0 {
+				outDir = n.string(entry[1])
+			}
+		case nsisOpExtractFile:
+			name := n.string(entry[2])
+			switch {
+			case strings.HasPrefix(name, "$OUTDIR"):
+				name = outDir + strings.TrimPrefix(name, "$OUTDIR")
+			case !strings.HasPrefix(name, "$"):
+				name = outDir + `\` + name
+			}
+			files = append(files, nsisFile{path: name, offset: entry[3]})
+		case nsisOpWriteReg:
+			if !strings.Contains(strings.ToLower(n.string(entry[2])), `\uninstall\`) {
+				continue
+			}
+			value := n.string(entry[4])
+			if strings.Contains(value, "$") {
+				// the value is only known at installation time (e.g. it refers to a language string)
+				continue
+			}
+			switch n.string(entry[3]) {
+			case "DisplayName":
+				product.name = value
+			case "DisplayVersion":
+				product.version = value
+			case "Publisher":
+				product.publisher = value
+			case "URLInfoAbout":
+				product.url = value
+			}
+		}
+	}
+	return files, product
+}
+
+// string returns the string at the given offset within the string table of the installer, where variables are written
+// by name (e.g. "$INSTDIR"), and language strings and shell folders are written as placeholders.
+func (n *nsisInstaller) string(offset uint32) string {
+	var codes []uint16
+	if n.unicode {
+		for i := int(offset) * 2; i+1 < len(n.strings); i += 2 {
+			c := binary.LittleEndian.Uint16(n.strings[i:])
+			if c == 0 {
+				break
+			}
+			codes = append(codes, c)
+		}
+	} else {
+		for i := int(offset); i < len(n.strings) && n.strings[i] != 0; i++ {
+			codes = append(codes, uint16(n.strings[i]))
+		}
+	}
+
+	langCode, shellCode, varCode, skipCode := uint16(1), uint16(2), uint16(3), uint16(4)
+	if !n.nsis3 {
+		langCode, shellCode, varCode, skipCode = 255, 254, 253, 252
+	}
+
+	var s []uint16
+	for i := 0; i < len(codes); i++ {
+		c := codes[i]
+		switch c {
+		case skipCode:
+			// the next character is a literal
+			i++
+			if i < len(codes) {
+				s = append(s, codes[i])
+			}
+			continue
+		case langCode, shellCode, varCode:
+		default:
+			s = append(s, c)
+			continue
+		}
+
+		// the code is followed by its parameter (which is two bytes for ANSI installers, each holding 7 bits)
+		var param int
+		switch {
+		case n.unicode && i+1 < len(codes):
+			param = int(codes[i+1] & 0x7fff)
+			i++
+		case !n.unicode && i+2 < len(codes):
+			param = int(codes[i+1]&0x7f) | int(codes[i+2]&0x7f)<<7
+			i += 2
+		default:
+			i = len(codes)
+			continue
+		}
+
+		var special string
+		switch c {
+		case varCode:
+			special = nsisVariableName(param)
+		case shellCode:
+			special = "$SHELLFOLDER"
+		case langCode:
+			special = fmt.Sprintf("$(LSTR_%d)", param)
+		}
+		s = append(s, utf16.Encode([]rune(special))...)
+	}
+	return string(utf16.Decode(s))
+}
+
+func nsisVariableName(index int) string {
+	switch {
+	case index < 10:
+		return fmt.Sprintf("$%d", index)
+	case index < 20:
+		return fmt.Sprintf("$R%d", index-10)
+	case index-20 < len(nsisVariables):
+		return "$" + nsisVariables[index-20]
+	}
+	return fmt.Sprintf("$_%d_", index)
+}
+
+// extract reads the contents of each of the given files (which may be extracted by more than one instruction, of
+// which only the first is read).
+func (n *nsisInstaller) extract(files []nsisFile, fn func(name string, reader io.Reader) error) error {
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].offset < files[j].offset
+	})
+
+	if !n.solid {
+		seen := make(map[uint32]bool)
+		for _, f := range files {
+			if seen[f.offset] {
+				continue
+			}
+			seen[f.offset] = true
+
+			block, _, err := n.block(n.dataStart + int64(f.offset))
+			if err != nil {
+				return err
+			}
+			if err := fn(f.path, block); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// the files of solid installers follow the header within the same compressed stream, each prefixed by its size
+	d, err := n.decompressor(io.NewSectionReader(n.reader, n.start, n.end-n.start))
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(d)
+	var headerSize uint32
+	if err := binary.Read(r, binary.LittleEndian, &headerSize); err != nil {
+		return fmt.Errorf("unable to read NSIS data: %w", err)
+	}
+	if _, err := r.Discard(int(headerSize)); err != nil {
+		return fmt.Errorf("unable to read NSIS data: %w", err)
+	}
+
+	var position int64
+	for i, f := range files {
+		if i > 0 && files[i-1].offset == f.offset {
+			continue
+		}
+		if int64(f.offset) < position {
+			return errors.New("invalid NSIS file offset")
+		}
+		if _, err := r.Discard(int(int64(f.offset) - position)); err != nil {
+			return fmt.Errorf("unable to read NSIS data: %w", err)
+		}
+		var size uint32
+		if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return fmt.Errorf("unable to read NSIS data: %w", err)
+		}
+		contents := io.LimitReader(r, int64(size))
+		if err := fn(f.path, contents); err != nil {
+			return err
+		}
+		if _, err := io.Copy(io.Discard, contents); err != nil {
+			return fmt.Errorf("unable to read NSIS data: %w", err)
+		}
+		position = int64(f.offset) + 4 + int64(size)
+	}
+	return nil
+}
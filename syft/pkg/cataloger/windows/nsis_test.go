@@ -0,0 +1,48 @@
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_nsisInstaller_string(t *testing.T) {
+	tests := []struct {
+		name      string
+		installer nsisInstaller
+		want      string
+	}{
+		{
+			name: "NSIS 3 unicode variable",
+			installer: nsisInstaller{
+				unicode: true,
+				nsis3:   true,
+				// "$INSTDIR\bin"
+				strings: []byte{3, 0, 21, 0x80, '\\', 0, 'b', 0, 'i', 0, 'n', 0, 0, 0},
+			},
+			want: `$INSTDIR\bin`,
+		},
+		{
+			name: "NSIS 3 ANSI variable and language string",
+			installer: nsisInstaller{
+				nsis3: true,
+				// "$(LSTR_130) $OUTDIR"
+				strings: []byte{1, 0x82, 0x81, ' ', 3, 0x80 | 22, 0x80, 0},
+			},
+			want: "$(LSTR_130) $OUTDIR",
+		},
+		{
+			name: "NSIS 2 ANSI variable and skipped code",
+			installer: nsisInstaller{
+				// "$R1 ü"
+				strings: []byte{253, 0x80 | 11, 0x80, ' ', 252, 252, 0},
+			},
+			want: "$R1 ü",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.installer.string(0))
+		})
+	}
+}
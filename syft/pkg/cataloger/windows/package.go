@@ -0,0 +1,117 @@
+package windows
+
+import (
+	"path"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+)
+
+func newAssemblyPackage(entry pkg.WindowsAssemblyEntry, locations ...file.Location) pkg.Package {
+	var qualifiers packageurl.Qualifiers
+	if entry.ProcessorArchitecture != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{
+			pkg.PURLQualifierArch: entry.ProcessorArchitecture,
+		})
+	}
+
+	p := pkg.Package{
+		Name:      entry.Name,
+		Version:   entry.Version,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageurl.NewPackageURL(pkg.WindowsAssemblyPkg.PackageURLType(), "", entry.Name, entry.Version, qualifiers, "").ToString(),
+		Type:      pkg.WindowsAssemblyPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newDriverPackage returns a package for a driver package within the driver store, named after its INF file.
+func newDriverPackage(entry pkg.WindowsDriverEntry, locations ...file.Location) pkg.Package {
+	var qualifiers packageurl.Qualifiers
+	if entry.Architecture != "" {
+		qualifiers = packageurl.QualifiersFromMap(map[string]string{
+			pkg.PURLQualifierArch: entry.Architecture,
+		})
+	}
+
+	name := strings.TrimSuffix(entry.InfName, path.Ext(entry.InfName))
+	p := pkg.Package{
+		Name:      name,
+		Version:   entry.DriverVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageurl.NewPackageURL(pkg.WindowsDriverPkg.PackageURLType(), "", name, entry.DriverVersion, qualifiers, "").ToString(),
+		Type:      pkg.WindowsDriverPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newMSIPackage returns a package for the product that a Windows Installer package installs.
+func newMSIPackage(entry pkg.WindowsMSIEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.ProductName,
+		Version:   entry.ProductVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageurl.NewPackageURL(pkg.WindowsMSIPkg.PackageURLType(), "", entry.ProductName, entry.ProductVersion, nil, "").ToString(),
+		Type:      pkg.WindowsMSIPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// newInstallerPackage returns a package for the product that an executable setup program installs.
+func newInstallerPackage(entry pkg.WindowsInstallerEntry, locations ...file.Location) pkg.Package {
+	p := pkg.Package{
+		Name:      entry.ProductName,
+		Version:   entry.ProductVersion,
+		Locations: file.NewLocationSet(locations...),
+		PURL:      packageurl.NewPackageURL(pkg.WindowsInstallerPkg.PackageURLType(), "", entry.ProductName, entry.ProductVersion, nil, "").ToString(),
+		Type:      pkg.WindowsInstallerPkg,
+		Metadata:  entry,
+	}
+
+	p.SetID()
+
+	return p
+}
+
+// normalizeArchitecture returns the processor architecture of an assembly identity, where assemblies that are not
+// specific to an architecture have no architecture.
+func normalizeArchitecture(arch string) string {
+	arch = strings.ToLower(arch)
+	switch arch {
+	case "*", "none":
+		return ""
+	}
+	return arch
+}
+
+// normalizeCulture returns the culture of an assembly identity, where culture-neutral assemblies have no culture.
+func normalizeCulture(culture string) string {
+	switch culture {
+	case "", "*", "none", "neutral":
+		return ""
+	}
+	return culture
+}
+
+// normalizePublicKeyToken returns the public key token of an assembly identity, where unsigned assemblies have no token.
+func normalizePublicKeyToken(token string) string {
+	switch token {
+	case "", "none":
+		return ""
+	}
+	return token
+}
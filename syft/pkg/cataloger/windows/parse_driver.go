@@ -0,0 +1,122 @@
+package windows
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseDriverINF
+
+// driverRepositoryDirPattern matches the directory of a driver package within the driver store, which is named after the
+// INF file of the package, the architecture the package was staged for, and a hash: <inf name>_<arch>_<hash>
+var driverRepositoryDirPattern = regexp.MustCompile(`(?i)\.inf_([a-z0-9]+)_[0-9a-f]{16}$`)
+
+// parseDriverINF is a parser function for the INF file of a driver package within the driver store
+// (Windows\System32\DriverStore\FileRepository\<package dir>\<name>.inf), returning a package for the driver package
+// as described by the [Version] section of the INF file. The signer of the package is read from the catalog file that
+// the INF file names.
+func parseDriverINF(_ context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read driver INF file: %w", err)
+	}
+
+	inf := readINF(contents)
+	if inf["version"] == nil {
+		return nil, nil, fmt.Errorf("no [Version] section found within driver INF file")
+	}
+
+	entry := pkg.WindowsDriverEntry{
+		InfName:   path.Base(reader.RealPath),
+		Provider:  inf.value("version", "provider"),
+		Class:     inf.value("version", "class"),
+		ClassGUID: inf.value("version", "classguid"),
+	}
+	entry.DriverDate, entry.DriverVersion = parseDriverVer(inf.value("version", "driverver"))
+	if match := driverRepositoryDirPattern.FindStringSubmatch(path.Dir(reader.RealPath)); match != nil {
+		entry.Architecture = strings.ToLower(match[1])
+	}
+	entry.CatalogFile = driverCatalogFile(inf, entry.Architecture)
+
+	locations := []file.Location{reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)}
+	if entry.CatalogFile != "" {
+		catalogPath := path.Join(path.Dir(reader.RealPath), entry.CatalogFile)
+		if signer, location := readCatalogSigner(resolver, reader.Location, catalogPath); location != nil {
+			entry.Signer = signer
+			locations = append(locations, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.SupportingEvidenceAnnotation))
+		}
+	}
+
+	return []pkg.Package{newDriverPackage(entry, locations...)}, nil, nil
+}
+
+// parseDriverVer returns the date (as YYYY-MM-DD) and version of a DriverVer directive (e.g. "06/21/2006,10.0.19041.1").
+func parseDriverVer(value string) (string, string) {
+	date, version, _ := strings.Cut(value, ",")
+	version = strings.TrimSpace(version)
+
+	parsed, err := time.Parse("1/2/2006", strings.TrimSpace(date))
+	if err != nil {
+		return "", version
+	}
+	return parsed.Format(time.DateOnly), version
+}
+
+// driverCatalogFile returns the name of the catalog file of a driver package, preferring the catalog file specific to
+// the architecture that the package was staged for (e.g. CatalogFile.NTamd64).
+func driverCatalogFile(inf infFile, arch string) string {
+	keys := []string{"catalogfile.nt", "catalogfile"}
+	if arch != "" {
+		keys = append([]string{"catalogfile.nt" + arch}, keys...)
+	}
+	for _, key := range keys {
+		if value := inf.value("version", key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// readCatalogSigner returns the signer of the catalog file at the given path, relative to the given location. Nothing
+// is returned when the catalog file cannot be found, and no signer is returned when it cannot be read.
+func readCatalogSigner(resolver file.Resolver, relativeTo file.Location, catalogPath string) (string, *file.Location) {
+	if resolver == nil {
+		return "", nil
+	}
+
+	location := resolver.RelativeFileByPath(relativeTo, catalogPath)
+	if location == nil {
+		return "", nil
+	}
+
+	reader, err := resolver.FileContentsByLocation(*location)
+	if err != nil {
+		log.WithFields("error", err, "path", catalogPath).Trace("unable to read driver catalog file")
+		return "", location
+	}
+	defer internal.CloseAndLogError(reader, location.RealPath)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.WithFields("error", err, "path", catalogPath).Trace("unable to read driver catalog file")
+		return "", location
+	}
+
+	signer, err := catalogSigner(data)
+	if err != nil {
+		log.WithFields("error", err, "path", catalogPath).Trace("unable to parse driver catalog file signature")
+	}
+	return signer, location
+}
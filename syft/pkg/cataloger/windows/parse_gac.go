@@ -0,0 +1,76 @@
+package windows
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseGACAssembly
+
+// gacVersionDirPattern matches the directory of an assembly version within the Global Assembly Cache, which is named
+// after the assembly identity: [v<runtime version>_]<version>_<culture>_<public key token>. The runtime version prefix
+// is only present within the .NET 4 cache (Windows\Microsoft.NET\assembly).
+var gacVersionDirPattern = regexp.MustCompile(`^(?:v\d+\.\d+_)?(?P<version>\d+(?:\.\d+){3})_(?P<culture>[^_]*)_(?P<token>[0-9a-fA-F]{16})$`)
+
+// gacArchitectures maps the directories of the Global Assembly Cache to the processor architecture of the assemblies
+// within them (assemblies directly within the "GAC" directory are from .NET 1.x, which has no architecture).
+var gacArchitectures = map[string]string{
+	"gac":      "",
+	"gac_msil": "msil",
+	"gac_32":   "x86",
+	"gac_64":   "amd64",
+}
+
+// parseGACAssembly is a parser function for an assembly within the Global Assembly Cache
+// (Windows\assembly\GAC*\<name>\<version dir>\<name>.dll or Windows\Microsoft.NET\assembly\GAC*\...), returning a
+// package for the strong-named assembly as identified by the layout of the cache. The assembly itself is not read.
+func parseGACAssembly(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	entry := gacEntryFromPath(reader.RealPath)
+	if entry == nil {
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newAssemblyPackage(*entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// gacEntryFromPath returns the assembly identity encoded within the given path of an assembly within the Global
+// Assembly Cache. Nothing is returned for files that are not the assembly of their directory (e.g. satellite files).
+func gacEntryFromPath(p string) *pkg.WindowsAssemblyEntry {
+	versionDir := path.Dir(p)
+	nameDir := path.Dir(versionDir)
+	gacDir := path.Dir(nameDir)
+
+	arch, ok := gacArchitectures[strings.ToLower(path.Base(gacDir))]
+	if !ok {
+		return nil
+	}
+
+	name := path.Base(nameDir)
+	fileName := path.Base(p)
+	if !strings.EqualFold(strings.TrimSuffix(fileName, path.Ext(fileName)), name) {
+		return nil
+	}
+
+	match := gacVersionDirPattern.FindStringSubmatch(path.Base(versionDir))
+	if match == nil {
+		return nil
+	}
+
+	return &pkg.WindowsAssemblyEntry{
+		Name:                  name,
+		Version:               match[gacVersionDirPattern.SubexpIndex("version")],
+		ProcessorArchitecture: arch,
+		Culture:               normalizeCulture(match[gacVersionDirPattern.SubexpIndex("culture")]),
+		PublicKeyToken:        strings.ToLower(match[gacVersionDirPattern.SubexpIndex("token")]),
+		Store:                 pkg.WindowsGACAssemblyStore,
+	}
+}
@@ -0,0 +1,200 @@
+package windows
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/saferwall/pe"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/payload"
+)
+
+const (
+	installerFormatNSIS        = "nsis"
+	installerFormatInnoSetup   = "inno-setup"
+	installerMaxExecutableStub = 16 * 1024 * 1024
+
+	// maxInstallerPayloadSize is the largest amount of (uncompressed) data that will be extracted from a single installer
+	maxInstallerPayloadSize = 2 * 1024 * 1024 * 1024
+)
+
+// installerParser parses setup programs built with NSIS or Inno Setup, cataloging the files within the payload of each
+// installer (where supported) with the given payload catalogers (where all packages are reported as found by the named
+// cataloger).
+type installerParser struct {
+	cataloger         string
+	payloadCatalogers []pkg.Cataloger
+}
+
+var _ generic.Parser = installerParser{}.parseInstaller
+
+// parseInstaller is a parser function for an executable setup program, returning a package for the product that the
+// installer installs (described by the product information embedded within the installer, or the version resource of
+// the executable), and the packages found within the files that the installer installs.
+func (p installerParser) parseInstaller(ctx context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	r, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read setup program: %w", err)
+	}
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read setup program: %w", err)
+	}
+
+	overlay, ok := peOverlayOffset(r)
+	if !ok || overlay >= size {
+		// this is not an executable, or there is no data appended to the executable (which all installers have)
+		return nil, nil, nil
+	}
+
+	nsis, err := findNSISInstaller(r, overlay, size)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read NSIS installer")
+	}
+	if nsis != nil {
+		return p.parseNSIS(ctx, r, overlay, nsis, reader.Location)
+	}
+
+	inno, err := readInnoSetupProduct(r, overlay, size)
+	if err != nil {
+		log.WithFields("path", reader.RealPath, "error", err).Trace("unable to read Inno Setup installer")
+	}
+	if inno == nil {
+		return nil, nil, nil
+	}
+
+	entry := pkg.WindowsInstallerEntry{
+		Format:         installerFormatInnoSetup,
+		ProductName:    inno.name,
+		ProductVersion: inno.productVersion(),
+		Publisher:      inno.publisher,
+		AppID:          strings.Trim(inno.appID, "{}"),
+		URL:            inno.url,
+	}
+	if entry.ProductName == "" {
+		return nil, nil, nil
+	}
+	return []pkg.Package{
+		newInstallerPackage(entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+func (p installerParser) parseNSIS(ctx context.Context, r io.ReaderAt, overlay int64, nsis *nsisInstaller, location file.Location) ([]pkg.Package, []artifact.Relationship, error) {
+	files, product := nsis.files()
+
+	entry := pkg.WindowsInstallerEntry{
+		Format:         installerFormatNSIS,
+		ProductName:    product.name,
+		ProductVersion: product.version,
+		Publisher:      product.publisher,
+		URL:            product.url,
+	}
+	if entry.ProductName == "" {
+		// not all installers register themselves for uninstallation, so fall back to the version resource
+		versionResources := peVersionResources(r, overlay)
+		entry.ProductName = strings.TrimSpace(versionResources["ProductName"])
+		entry.ProductVersion = strings.TrimSpace(versionResources["ProductVersion"])
+		entry.Publisher = strings.TrimSpace(versionResources["CompanyName"])
+	}
+
+	var pkgs []pkg.Package
+	if entry.ProductName != "" {
+		for _, f := range files {
+			entry.Files = append(entry.Files, payload.Path(f.path))
+		}
+		installerPkg := newInstallerPackage(entry, location.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+		// relationships refer to packages as they are reported, so the cataloger that found the packages is set up front
+		installerPkg.FoundBy = p.cataloger
+		pkgs = append(pkgs, installerPkg)
+	}
+
+	if len(p.payloadCatalogers) == 0 || len(files) == 0 {
+		return pkgs, nil, nil
+	}
+
+	extracted, err := payload.New(maxInstallerPayloadSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer extracted.Cleanup()
+
+	if err := nsis.extract(files, extracted.Add); err != nil {
+		// the files that were extracted are still cataloged
+		log.WithFields("path", location.RealPath, "error", err).Debug("unable to extract installer payload")
+	}
+
+	payloadPkgs, relationships := extracted.Catalog(ctx, p.payloadCatalogers, p.cataloger, location)
+	if len(pkgs) > 0 {
+		relationships = append(relationships, payload.ContainsRelationships(pkgs[0], payloadPkgs)...)
+	}
+
+	return append(pkgs, payloadPkgs...), relationships, nil
+}
+
+// peOverlayOffset returns the offset of the data appended to a PE executable (the "overlay"), which follows the last
+// section of the executable.
+func peOverlayOffset(r io.ReaderAt) (int64, bool) {
+	var dos [64]byte
+	if _, err := r.ReadAt(dos[:], 0); err != nil || dos[0] != 'M' || dos[1] != 'Z' {
+		return 0, false
+	}
+	peOffset := int64(binary.LittleEndian.Uint32(dos[0x3c:]))
+
+	var header [24]byte
+	if _, err := r.ReadAt(header[:], peOffset); err != nil || string(header[:4]) != "PE\x00\x00" {
+		return 0, false
+	}
+	sections := int(binary.LittleEndian.Uint16(header[6:]))
+	optionalHeaderSize := int64(binary.LittleEndian.Uint16(header[20:]))
+
+	var end int64
+	sectionTable := peOffset + int64(len(header)) + optionalHeaderSize
+	for i := 0; i < sections; i++ {
+		var section [40]byte
+		if _, err := r.ReadAt(section[:], sectionTable+int64(i)*40); err != nil {
+			return 0, false
+		}
+		rawSize := int64(binary.LittleEndian.Uint32(section[16:]))
+		rawOffset := int64(binary.LittleEndian.Uint32(section[20:]))
+		if rawOffset+rawSize > end {
+			end = rawOffset + rawSize
+		}
+	}
+	return end, end > 0
+}
+
+// peVersionResources returns the string values of the version resource of the executable stub of an installer.
+func peVersionResources(r io.ReaderAt, size int64) map[string]string {
+	if size > installerMaxExecutableStub {
+		return nil
+	}
+	data := make([]byte, size)
+	if _, err := r.ReadAt(data, 0); err != nil {
+		return nil
+	}
+
+	peFile, err := pe.NewBytes(data, &pe.Options{
+		DisableCertValidation:      true,
+		DisableSignatureValidation: true,
+	})
+	if err != nil {
+		return nil
+	}
+	if err := peFile.Parse(); err != nil {
+		return nil
+	}
+	versionResources, err := peFile.ParseVersionResources()
+	if err != nil {
+		return nil
+	}
+	return versionResources
+}
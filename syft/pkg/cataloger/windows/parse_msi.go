@@ -0,0 +1,278 @@
+package windows
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/internal/unionreader"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+	"github.com/anchore/syft/syft/pkg/cataloger/internal/payload"
+)
+
+// msiParser parses Windows Installer packages, cataloging the files within the cabinets of each installer with the given
+// payload catalogers (where all packages are reported as found by the named cataloger).
+type msiParser struct {
+	cataloger         string
+	payloadCatalogers []pkg.Cataloger
+}
+
+var _ generic.Parser = msiParser{}.parseMSI
+
+// parseMSI is a parser function for a Windows Installer package (.msi), returning a package for the product that the
+// installer installs, as described by the Property table of the installer database (along with the files listed within
+// the File table), and the packages found within the files that the installer installs.
+func (p msiParser) parseMSI(ctx context.Context, resolver file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	r, err := unionreader.GetUnionReader(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read installer package: %w", err)
+	}
+
+	db, err := openMSIDatabase(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse installer package: %w", err)
+	}
+
+	properties, err := msiProperties(db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry := pkg.WindowsMSIEntry{
+		ProductName:     properties["ProductName"],
+		ProductVersion:  properties["ProductVersion"],
+		Manufacturer:    properties["Manufacturer"],
+		ProductCode:     properties["ProductCode"],
+		UpgradeCode:     properties["UpgradeCode"],
+		ProductLanguage: properties["ProductLanguage"],
+	}
+	if entry.ProductName == "" {
+		// this is not an installer database (e.g. a merge module or patch), or it is missing required properties
+		return nil, nil, nil
+	}
+
+	entry.Files, err = msiFiles(db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msiPkg := newMSIPackage(entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation))
+	if len(p.payloadCatalogers) == 0 {
+		return []pkg.Package{msiPkg}, nil, nil
+	}
+
+	extracted, err := payload.New(maxInstallerPayloadSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer extracted.Cleanup()
+
+	if err := extractMSIPayload(resolver, reader.Location, db, extracted); err != nil {
+		// the files that were extracted are still cataloged
+		log.WithFields("path", reader.RealPath, "error", err).Debug("unable to extract installer payload")
+	}
+
+	// relationships refer to packages as they are reported, so the cataloger that found the packages is set up front
+	msiPkg.FoundBy = p.cataloger
+	payloadPkgs, relationships := extracted.Catalog(ctx, p.payloadCatalogers, p.cataloger, reader.Location)
+	relationships = append(relationships, payload.ContainsRelationships(msiPkg, payloadPkgs)...)
+
+	return append([]pkg.Package{msiPkg}, payloadPkgs...), relationships, nil
+}
+
+func msiProperties(db *msiDatabase) (map[string]string, error) {
+	rows, err := db.table("Property")
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]string, len(rows))
+	for _, row := range rows {
+		properties[row["Property"]] = row["Value"]
+	}
+	return properties, nil
+}
+
+func msiFiles(db *msiDatabase) ([]pkg.WindowsMSIFileRecord, error) {
+	rows, err := db.table("File")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []pkg.WindowsMSIFileRecord
+	for _, row := range rows {
+		size, _ := strconv.ParseInt(row["FileSize"], 10, 64)
+		files = append(files, pkg.WindowsMSIFileRecord{
+			Name:    msiLongFileName(row["FileName"]),
+			Size:    size,
+			Version: row["Version"],
+		})
+	}
+	return files, nil
+}
+
+// msiLongFileName returns the long name of a file from the File table, which may hold both the short (8.3) and long
+// names of the file (e.g. "README~1.TXT|readme.txt").
+func msiLongFileName(name string) string {
+	if _, long, ok := strings.Cut(name, "|"); ok {
+		return long
+	}
+	return name
+}
+
+// extractMSIPayload extracts the files within the cabinets listed by the Media table of the installer database, which
+// are either embedded within the installer (as a stream named after the cabinet) or alongside the installer. Files are
+// extracted to the paths that they are installed to, relative to the root of the target directory tree.
+func extractMSIPayload(resolver file.Resolver, location file.Location, db *msiDatabase, extracted *payload.Payload) error {
+	media, err := db.table("Media")
+	if err != nil {
+		return err
+	}
+
+	paths, err := msiFilePaths(db)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range media {
+		cabinet := row["Cabinet"]
+		if cabinet == "" {
+			// the files of this media are uncompressed, and held alongside the installer (which is not supported)
+			continue
+		}
+
+		if err := extractMSICabinet(resolver, location, db, cabinet, paths, extracted); err != nil {
+			return fmt.Errorf("unable to extract installer cabinet %q: %w", cabinet, err)
+		}
+	}
+	return nil
+}
+
+// extractMSICabinet extracts the files within a cabinet listed within the Media table, where cabinets prefixed with "#"
+// are embedded within the installer database and others are relative to the installer.
+func extractMSICabinet(resolver file.Resolver, location file.Location, db *msiDatabase, cabinet string, paths map[string]string, extracted *payload.Payload) error {
+	var reader io.ReaderAt
+	if name, embedded := strings.CutPrefix(cabinet, "#"); embedded {
+		data, err := db.stream(name)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			log.WithFields("path", location.RealPath, "cabinet", cabinet).Trace("unable to find embedded installer cabinet")
+			return nil
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		cabLocation := resolver.RelativeFileByPath(location, path.Join(path.Dir(location.RealPath), cabinet))
+		if cabLocation == nil {
+			log.WithFields("path", location.RealPath, "cabinet", cabinet).Trace("unable to find installer cabinet")
+			return nil
+		}
+		contents, err := resolver.FileContentsByLocation(*cabLocation)
+		if err != nil {
+			return err
+		}
+		defer internal.CloseAndLogError(contents, cabLocation.RealPath)
+
+		reader, err = unionreader.GetUnionReader(contents)
+		if err != nil {
+			return err
+		}
+	}
+
+	cab, err := openCabinet(reader)
+	if err != nil {
+		return err
+	}
+
+	return cab.extract(func(name string, r io.Reader) error {
+		// the files within the cabinets of an installer are named by the key of the file within the File table
+		if p, ok := paths[name]; ok {
+			name = p
+		}
+		return extracted.Add(name, r)
+	})
+}
+
+// msiFilePaths returns the path that each file (by its key within the File table) is installed to, as described by the
+// component of the file and the directory of the component (within the Directory table).
+func msiFilePaths(db *msiDatabase) (map[string]string, error) {
+	files, err := db.table("File")
+	if err != nil {
+		return nil, err
+	}
+	components, err := db.table("Component")
+	if err != nil {
+		return nil, err
+	}
+	directories, err := db.table("Directory")
+	if err != nil {
+		return nil, err
+	}
+
+	componentDirectories := make(map[string]string, len(components))
+	for _, row := range components {
+		componentDirectories[row["Component"]] = row["Directory_"]
+	}
+	dirs := make(map[string]map[string]string, len(directories))
+	for _, row := range directories {
+		dirs[row["Directory"]] = row
+	}
+
+	resolved := make(map[string]string)
+	paths := make(map[string]string, len(files))
+	for _, row := range files {
+		dir := msiDirectoryPath(dirs, componentDirectories[row["Component_"]], resolved, 0)
+		paths[row["File"]] = path.Join(dir, msiLongFileName(row["FileName"]))
+	}
+	return paths, nil
+}
+
+// msiDirectoryPath returns the path of the given directory, relative to the root of the target directory tree (usually
+// "TARGETDIR"). Directories directly beneath the root are named by their key, which for system folders is the property
+// holding the actual location (e.g. "ProgramFilesFolder"), since that is only known at installation time.
+func msiDirectoryPath(dirs map[string]map[string]string, key string, resolved map[string]string, depth int) string {
+	if p, ok := resolved[key]; ok {
+		return p
+	}
+	row, ok := dirs[key]
+	if !ok || depth > len(dirs) {
+		return ""
+	}
+
+	parent := row["Directory_Parent"]
+	if parent == "" || parent == key {
+		resolved[key] = ""
+		return ""
+	}
+
+	name := msiTargetDirectoryName(row["DefaultDir"])
+	if parentRow, ok := dirs[parent]; ok && (parentRow["Directory_Parent"] == "" || parentRow["Directory_Parent"] == parent) {
+		name = key
+	}
+
+	p := path.Join(msiDirectoryPath(dirs, parent, resolved, depth+1), name)
+	resolved[key] = p
+	return p
+}
+
+// msiTargetDirectoryName returns the (long) name of a directory on the target system from the DefaultDir column of the
+// Directory table, which may hold both the target and source names of the directory (e.g. "CONTOS~1|Contoso:Source"),
+// where a name of "." refers to the parent directory.
+func msiTargetDirectoryName(defaultDir string) string {
+	target, _, _ := strings.Cut(defaultDir, ":")
+	name := msiLongFileName(target)
+	if name == "." {
+		return ""
+	}
+	return name
+}
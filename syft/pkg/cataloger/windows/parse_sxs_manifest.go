@@ -0,0 +1,118 @@
+package windows
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/pkg/cataloger/generic"
+)
+
+var _ generic.Parser = parseSxSManifest
+
+// sxsManifestFileNamePattern matches the name of a component manifest within the WinSxS component store, which is
+// derived from the assembly identity: <arch>_<name>_<public key token>_<version>_<culture>_<hash>.manifest
+var sxsManifestFileNamePattern = regexp.MustCompile(`^(?P<arch>[^_]+)_(?P<name>.+)_(?P<token>[0-9a-f]{16}|none)_(?P<version>\d+(?:\.\d+){1,3})_(?P<culture>[^_]+)_(?P<hash>[0-9a-f]{16})\.manifest$`)
+
+// compressedManifestMagic is the header of a component manifest that is stored as a delta compressed (DCM) file, which
+// is the case for most manifests of Windows 8 and later.
+var compressedManifestMagic = []byte("DCM")
+
+var utf8BOM = []byte{0xef, 0xbb, 0xbf}
+
+type sxsManifest struct {
+	AssemblyIdentity *sxsAssemblyIdentity `xml:"assemblyIdentity"`
+}
+
+type sxsAssemblyIdentity struct {
+	Name                  string `xml:"name,attr"`
+	Version               string `xml:"version,attr"`
+	ProcessorArchitecture string `xml:"processorArchitecture,attr"`
+	Language              string `xml:"language,attr"`
+	PublicKeyToken        string `xml:"publicKeyToken,attr"`
+	Type                  string `xml:"type,attr"`
+}
+
+// parseSxSManifest is a parser function for a component manifest within the WinSxS component store
+// (Windows\WinSxS\Manifests\*.manifest), returning a package for the side-by-side assembly that the manifest describes.
+// Compressed manifests cannot be read, in which case the assembly identity is taken from the name of the manifest.
+func parseSxSManifest(_ context.Context, _ file.Resolver, _ *generic.Environment, reader file.LocationReadCloser) ([]pkg.Package, []artifact.Relationship, error) {
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read WinSxS manifest: %w", err)
+	}
+
+	var entry *pkg.WindowsAssemblyEntry
+	if bytes.HasPrefix(contents, compressedManifestMagic) {
+		entry = sxsEntryFromFileName(path.Base(reader.RealPath))
+	} else {
+		entry, err = sxsEntryFromManifest(contents)
+		if err != nil {
+			log.WithFields("error", err, "path", reader.RealPath).Trace("unable to parse WinSxS manifest, falling back to the manifest name")
+			entry = sxsEntryFromFileName(path.Base(reader.RealPath))
+		}
+	}
+	if entry == nil {
+		return nil, nil, nil
+	}
+
+	return []pkg.Package{
+		newAssemblyPackage(*entry, reader.WithAnnotation(pkg.EvidenceAnnotationKey, pkg.PrimaryEvidenceAnnotation)),
+	}, nil, nil
+}
+
+// sxsEntryFromManifest returns the assembly identity declared by the given (uncompressed) component manifest.
+func sxsEntryFromManifest(contents []byte) (*pkg.WindowsAssemblyEntry, error) {
+	var manifest sxsManifest
+	if err := xml.Unmarshal(bytes.TrimPrefix(contents, utf8BOM), &manifest); err != nil {
+		return nil, err
+	}
+
+	identity := manifest.AssemblyIdentity
+	if identity == nil || identity.Name == "" || identity.Version == "" {
+		return nil, fmt.Errorf("no assembly identity found")
+	}
+
+	return &pkg.WindowsAssemblyEntry{
+		Name:                  identity.Name,
+		Version:               identity.Version,
+		ProcessorArchitecture: normalizeArchitecture(identity.ProcessorArchitecture),
+		Culture:               normalizeCulture(identity.Language),
+		PublicKeyToken:        normalizePublicKeyToken(strings.ToLower(identity.PublicKeyToken)),
+		Type:                  identity.Type,
+		Store:                 pkg.WindowsSxSAssemblyStore,
+	}, nil
+}
+
+// sxsEntryFromFileName returns the assembly identity encoded within the given component manifest name. Nothing is
+// returned when the name does not match the expected layout or when the assembly name has been truncated (long names
+// are shortened with ".." by the component store, so the original name cannot be recovered).
+func sxsEntryFromFileName(name string) *pkg.WindowsAssemblyEntry {
+	match := sxsManifestFileNamePattern.FindStringSubmatch(strings.ToLower(name))
+	if match == nil {
+		return nil
+	}
+
+	assemblyName := match[sxsManifestFileNamePattern.SubexpIndex("name")]
+	if strings.Contains(assemblyName, "..") {
+		return nil
+	}
+
+	return &pkg.WindowsAssemblyEntry{
+		Name:                  assemblyName,
+		Version:               match[sxsManifestFileNamePattern.SubexpIndex("version")],
+		ProcessorArchitecture: normalizeArchitecture(match[sxsManifestFileNamePattern.SubexpIndex("arch")]),
+		Culture:               normalizeCulture(match[sxsManifestFileNamePattern.SubexpIndex("culture")]),
+		PublicKeyToken:        normalizePublicKeyToken(match[sxsManifestFileNamePattern.SubexpIndex("token")]),
+		Store:                 pkg.WindowsSxSAssemblyStore,
+	}
+}
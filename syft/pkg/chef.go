@@ -0,0 +1,37 @@
+package pkg
+
+// ChefCookbookMetadata represents the metadata of an installed (or vendored) Chef cookbook (from the metadata.json or
+// metadata.rb at the root of the cookbook).
+type ChefCookbookMetadata struct {
+	// Maintainer is the person or organization that maintains the cookbook.
+	Maintainer string `json:"maintainer,omitempty"`
+
+	// MaintainerEmail is the email address of the maintainer.
+	MaintainerEmail string `json:"maintainerEmail,omitempty"`
+
+	// Description is the short description of the cookbook.
+	Description string `json:"description,omitempty"`
+
+	// SourceURL is the URL of the source repository of the cookbook.
+	SourceURL string `json:"sourceUrl,omitempty"`
+
+	// Dependencies is the cookbooks required by this cookbook, keyed by cookbook name, with the version constraint as
+	// the value.
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// ChefCookbookLockEntry represents a single cookbook resolved within a Berksfile.lock or Policyfile.lock.json.
+type ChefCookbookLockEntry struct {
+	// Source is where the cookbook was resolved from, when recorded (e.g. a git repository URL, a local path, or a
+	// Supermarket download URL).
+	Source string `json:"source,omitempty"`
+
+	// Revision is the git revision the cookbook was resolved to, for git sourced cookbooks.
+	Revision string `json:"revision,omitempty"`
+
+	// Identifier is the content identifier of the cookbook recorded within a Policyfile.lock.json.
+	Identifier string `json:"identifier,omitempty"`
+
+	// Dependencies is the names of the cookbooks this cookbook requires.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
@@ -0,0 +1,31 @@
+package pkg
+
+import (
+	"sort"
+	"strings"
+)
+
+// ClassificationAnnotationKey is the location annotation holding the user-defined classifications (comma separated)
+// of the path rules that the location matched.
+const ClassificationAnnotationKey = "classification"
+
+// Classifications returns the sorted, unique set of user-defined classifications across all locations of the package.
+func Classifications(p Package) []string {
+	set := make(map[string]struct{})
+	for _, l := range p.Locations.ToSlice() {
+		value := l.Annotations[ClassificationAnnotationKey]
+		if value == "" {
+			continue
+		}
+		for _, c := range strings.Split(value, ",") {
+			set[c] = struct{}{}
+		}
+	}
+
+	var classifications []string
+	for c := range set {
+		classifications = append(classifications, c)
+	}
+	sort.Strings(classifications)
+	return classifications
+}
@@ -0,0 +1,22 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestClassifications(t *testing.T) {
+	p := Package{
+		Locations: file.NewLocationSet(
+			file.NewLocation("/app/package.json").WithAnnotation(ClassificationAnnotationKey, "first-party"),
+			file.NewLocation("/app/vendor/package.json").WithAnnotation(ClassificationAnnotationKey, "vendored,first-party"),
+			file.NewLocation("/usr/lib/package.json"),
+		),
+	}
+
+	assert.Equal(t, []string{"first-party", "vendored"}, Classifications(p))
+	assert.Empty(t, Classifications(Package{}))
+}
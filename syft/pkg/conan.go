@@ -22,9 +22,14 @@ type ConanV2LockEntry struct {
 	RecipeRevision  string `json:"recipeRevision,omitempty"`
 	PackageRevision string `json:"packageRevision,omitempty"`
 	TimeStamp       string `json:"timestamp,omitempty"`
+
+	// RequirementKind is the kind of requirement that the lockfile lists the reference as, which is one of "requires"
+	// (a library or application used at runtime), "build_requires" (a tool used while building), "python_requires"
+	// (python code reused by recipes), or "config_requires" (a conan configuration package)
+	RequirementKind string `json:"requirementKind,omitempty"`
 }
 
-// ConanfileEntry represents a single "Requires" entry from a conanfile.txt.
+// ConanfileEntry represents a single "Requires" entry from a conanfile.txt or conanfile.py.
 type ConanfileEntry struct {
 	Ref string `mapstructure:"ref" json:"ref"`
 }
@@ -0,0 +1,35 @@
+package pkg
+
+// ContainerImageReferenceEntry represents a container image referenced by a deployment manifest, such as the image of
+// a docker compose service or of a container within a Kubernetes workload (including rendered Helm templates), or by a
+// development environment configuration (a dev container or a devfile).
+type ContainerImageReferenceEntry struct {
+	// Reference is the image reference as declared within the manifest (e.g. "nginx:1.27").
+	Reference string `json:"reference"`
+
+	// Registry is the registry hosting the image, normalized as docker would (e.g. "docker.io").
+	Registry string `json:"registry,omitempty"`
+
+	// Repository is the repository of the image within the registry, normalized as docker would (e.g. "library/nginx").
+	Repository string `json:"repository,omitempty"`
+
+	// Tag is the tag of the image, when referenced by tag.
+	Tag string `json:"tag,omitempty"`
+
+	// Digest is the digest of the image manifest (or index), when referenced by digest.
+	Digest string `json:"digest,omitempty"`
+
+	// ManifestType is the kind of manifest the reference was found in ("compose", "kubernetes", "devcontainer",
+	// "devfile", or "dockerfile" for the base images of a Dockerfile built by a dev environment).
+	ManifestType string `json:"manifestType"`
+
+	// Workload is the compose service name, the kind and name of the Kubernetes resource (e.g. "Deployment/web"), the
+	// name of the dev container, or the name of the devfile component.
+	Workload string `json:"workload,omitempty"`
+
+	// Namespace is the Kubernetes namespace of the resource, when declared.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Container is the name of the container within the Kubernetes resource that uses the image.
+	Container string `json:"container,omitempty"`
+}
@@ -0,0 +1,76 @@
+package pkg
+
+// CryptoCertificate represents an X.509 certificate (found within a PEM or DER encoded file).
+type CryptoCertificate struct {
+	SubjectName  string `json:"subjectName" cyclonedx:"subjectName"`
+	IssuerName   string `json:"issuerName" cyclonedx:"issuerName"`
+	SerialNumber string `json:"serialNumber" cyclonedx:"serialNumber"`
+
+	// NotValidBefore and NotValidAfter are the validity period of the certificate (RFC 3339 timestamps).
+	NotValidBefore string `json:"notValidBefore" cyclonedx:"notValidBefore"`
+	NotValidAfter  string `json:"notValidAfter" cyclonedx:"notValidAfter"`
+
+	// SignatureAlgorithm is the algorithm the certificate is signed with (e.g. "SHA256-RSA" or "ECDSA-SHA384").
+	SignatureAlgorithm string `json:"signatureAlgorithm" cyclonedx:"signatureAlgorithm"`
+
+	// PublicKeyAlgorithm is the algorithm of the subject public key (e.g. "RSA", "ECDSA", or "Ed25519").
+	PublicKeyAlgorithm string `json:"publicKeyAlgorithm" cyclonedx:"publicKeyAlgorithm"`
+
+	// PublicKeySize is the size of the subject public key in bits.
+	PublicKeySize int `json:"publicKeySize,omitempty" cyclonedx:"publicKeySize"`
+
+	// Curve is the named elliptic curve of the subject public key (e.g. "P-256").
+	Curve string `json:"curve,omitempty" cyclonedx:"curve"`
+
+	IsCA bool `json:"isCA" cyclonedx:"isCA"`
+
+	// Encoding is how the certificate is stored: "PEM" or "DER".
+	Encoding string `json:"encoding" cyclonedx:"encoding"`
+
+	// SHA256Fingerprint is the hex encoded sha256 digest of the DER encoded certificate.
+	SHA256Fingerprint string `json:"sha256Fingerprint" cyclonedx:"sha256Fingerprint"`
+}
+
+// CryptoKey represents a private or public key. Note that the key material itself is never captured.
+type CryptoKey struct {
+	// KeyType is either "private-key" or "public-key".
+	KeyType string `json:"keyType" cyclonedx:"keyType"`
+
+	// Algorithm is the algorithm of the key (e.g. "RSA", "ECDSA", "Ed25519" or "DSA"), which is unknown for encrypted
+	// PKCS#8 private keys.
+	Algorithm string `json:"algorithm,omitempty" cyclonedx:"algorithm"`
+
+	// Size is the size of the key in bits.
+	Size int `json:"size,omitempty" cyclonedx:"size"`
+
+	// Curve is the named elliptic curve of the key (e.g. "P-256").
+	Curve string `json:"curve,omitempty" cyclonedx:"curve"`
+
+	// Format is the encoding of the key: "PKCS#1", "PKCS#8", "SEC1", "PKIX", or "OpenSSH".
+	Format string `json:"format" cyclonedx:"format"`
+
+	// Encrypted indicates if the (private) key is protected by a passphrase.
+	Encrypted bool `json:"encrypted" cyclonedx:"encrypted"`
+
+	// PublicKeyFingerprint is the hex encoded sha256 digest of the public key (PKIX DER or SSH wire format), when known.
+	PublicKeyFingerprint string `json:"publicKeyFingerprint,omitempty" cyclonedx:"publicKeyFingerprint"`
+}
+
+// CryptoKeystore represents a keystore file holding keys and certificates.
+type CryptoKeystore struct {
+	// Format is the kind of keystore: "JKS", "JCEKS", or "PKCS#12".
+	Format string `json:"format" cyclonedx:"format"`
+
+	// Version is the version of the keystore format.
+	Version int `json:"version,omitempty" cyclonedx:"version"`
+
+	// Entries is the number of keys and certificates within the keystore (not known for PKCS#12 keystores, where the
+	// contents are encrypted).
+	Entries int `json:"entries,omitempty" cyclonedx:"entries"`
+}
+
+// CryptoLibrary represents a shared library implementing cryptographic functionality (e.g. libcrypto from OpenSSL).
+type CryptoLibrary struct {
+	// Implementation is the project the library is part of (e.g. "OpenSSL", "libgcrypt", or "GnuTLS").
+	Implementation string `json:"implementation" cyclonedx:"implementation"`
+}
@@ -0,0 +1,24 @@
+package pkg
+
+// DevContainerFeatureEntry represents a dev container feature referenced by a dev container configuration
+// (devcontainer.json), which is distributed as an OCI artifact (or as a tarball).
+type DevContainerFeatureEntry struct {
+	// Reference is the feature reference as declared within the configuration (e.g.
+	// "ghcr.io/devcontainers/features/node:1").
+	Reference string `json:"reference"`
+
+	// Registry is the registry hosting the feature (e.g. "ghcr.io").
+	Registry string `json:"registry,omitempty"`
+
+	// Repository is the repository of the feature within the registry (e.g. "devcontainers/features/node").
+	Repository string `json:"repository,omitempty"`
+
+	// Tag is the tag of the feature, when referenced by tag.
+	Tag string `json:"tag,omitempty"`
+
+	// Digest is the digest of the feature manifest, when referenced by digest.
+	Digest string `json:"digest,omitempty"`
+
+	// Options are the options the feature is installed with (e.g. the version of the tool installed by the feature).
+	Options KeyValues `json:"options,omitempty"`
+}
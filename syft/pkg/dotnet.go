@@ -18,4 +18,26 @@ type DotnetPortableExecutableEntry struct {
 	CompanyName     string `json:"companyName"`
 	ProductName     string `json:"productName"`
 	ProductVersion  string `json:"productVersion"`
+
+	// ImportedLibraries are the names of the DLLs imported (directly or delay-loaded) by the binary.
+	ImportedLibraries []string `json:"importedLibraries,omitempty"`
+
+	// SignerSubject is the subject of the Authenticode signer certificate (when the binary is signed).
+	SignerSubject string `json:"signerSubject,omitempty"`
+}
+
+// DotnetPackagesLockEntry is a struct that represents a single resolved package found in a NuGet packages.lock.json file.
+type DotnetPackagesLockEntry struct {
+	Name        string `mapstructure:"name" json:"name"`
+	Version     string `mapstructure:"version" json:"version"`
+	ContentHash string `mapstructure:"contentHash" json:"contentHash,omitempty"`
+	Type        string `mapstructure:"type" json:"type,omitempty"`
+}
+
+// DotnetPaketLockEntry is a struct that represents a single NuGet package found in a paket.lock file.
+type DotnetPaketLockEntry struct {
+	Name    string `mapstructure:"name" json:"name"`
+	Version string `mapstructure:"version" json:"version"`
+	Remote  string `mapstructure:"remote" json:"remote,omitempty"`
+	Group   string `mapstructure:"group" json:"group,omitempty"`
 }
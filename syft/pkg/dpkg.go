@@ -76,3 +76,60 @@ func (m DpkgDBEntry) OwnedFiles() (result []string) {
 	sort.Strings(result)
 	return
 }
+
+// DpkgSourceEntry represents a Debian source package, as described by either the debian/control file of a source tree
+// (where the version is taken from the latest debian/changelog entry) or a .dsc source control file. Field definitions
+// can be found at https://www.debian.org/doc/debian-policy/ch-controlfields.html#debian-source-package-template-control-files-debian-control
+// and https://www.debian.org/doc/debian-policy/ch-controlfields.html#debian-source-control-files-dsc.
+type DpkgSourceEntry struct {
+	// Format is the format of the source package (e.g. "3.0 (quilt)"), from the .dsc file or debian/source/format.
+	Format string `json:"format,omitempty"`
+
+	// Maintainer is the package maintainer's name and email address (in RFC822 format).
+	Maintainer string `json:"maintainer,omitempty"`
+
+	// Uploaders are the names and email addresses of co-maintainers.
+	Uploaders []string `json:"uploaders,omitempty"`
+
+	Homepage         string `json:"homepage,omitempty"`
+	StandardsVersion string `json:"standardsVersion,omitempty"`
+	VcsBrowser       string `json:"vcsBrowser,omitempty"`
+	VcsGit           string `json:"vcsGit,omitempty"`
+
+	// Distribution is the distribution that the latest debian/changelog entry was uploaded to (e.g. "unstable").
+	Distribution string `json:"distribution,omitempty"`
+
+	// Binaries are the names of the binary packages built from the source package.
+	Binaries []string `json:"binaries,omitempty"`
+
+	// BuildDepends are the packages needed to build any of the binary packages (Build-Depends).
+	BuildDepends []string `json:"buildDepends,omitempty"`
+
+	// BuildDependsIndep are the packages additionally needed to build the architecture-independent binary packages
+	// (Build-Depends-Indep).
+	BuildDependsIndep []string `json:"buildDependsIndep,omitempty"`
+
+	// BuildDependsArch are the packages additionally needed to build the architecture-dependent binary packages
+	// (Build-Depends-Arch).
+	BuildDependsArch []string `json:"buildDependsArch,omitempty"`
+}
+
+// DpkgBuildDependencyEntry represents a single build dependency declared by a Debian source package. Where
+// alternatives are given (e.g. "default-jdk | openjdk-17-jdk") the dependency is the first alternative, which is the
+// one that is installed by the Debian build daemons.
+type DpkgBuildDependencyEntry struct {
+	// Field is the field that the dependency is declared within (Build-Depends, Build-Depends-Indep, or Build-Depends-Arch).
+	Field string `json:"field"`
+
+	// VersionConstraint is the version relation that the dependency must satisfy (e.g. ">= 1.2").
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+
+	// Architectures restricts the architectures that the dependency applies to (e.g. "amd64", "!hurd-any").
+	Architectures []string `json:"architectures,omitempty"`
+
+	// Profiles are the build profile restriction formulas that the dependency applies to (e.g. "!nocheck").
+	Profiles []string `json:"profiles,omitempty"`
+
+	// Alternatives are the other packages that may satisfy the dependency instead.
+	Alternatives []string `json:"alternatives,omitempty"`
+}
@@ -0,0 +1,95 @@
+package pkg
+
+import (
+	"sort"
+
+	"github.com/anchore/packageurl-go"
+)
+
+// Duplicate is a package that is present in multiple versions within the same collection (e.g. several versions of a
+// library that are bundled by different applications).
+type Duplicate struct {
+	// Name is the name of the package.
+	Name string
+
+	// Type is the package type.
+	Type Type
+
+	// Versions are the distinct versions of the package, sorted by version.
+	Versions []DuplicateVersion
+}
+
+// DuplicateVersion is a single version of a package that is present in multiple versions.
+type DuplicateVersion struct {
+	// Version is the version of the package.
+	Version string
+
+	// Packages are all packages found with this version.
+	Packages []Package
+}
+
+// Duplicates returns all packages within the collection that are present in more than one version. Packages are
+// considered to be the same when their package URLs match (ignoring the version, qualifiers, and subpath), or when
+// their type and name match for packages without a package URL. Packages without a version are not considered.
+func Duplicates(c *Collection) []Duplicate {
+	if c == nil {
+		return nil
+	}
+
+	type group struct {
+		name     string
+		ty       Type
+		versions map[string][]Package
+	}
+
+	groups := make(map[string]*group)
+	for _, p := range c.Sorted() {
+		if p.Version == "" {
+			continue
+		}
+
+		key := duplicateKey(p)
+		g, ok := groups[key]
+		if !ok {
+			g = &group{name: p.Name, ty: p.Type, versions: make(map[string][]Package)}
+			groups[key] = g
+		}
+		g.versions[p.Version] = append(g.versions[p.Version], p)
+	}
+
+	var duplicates []Duplicate
+	for _, g := range groups {
+		if len(g.versions) < 2 {
+			continue
+		}
+
+		d := Duplicate{Name: g.name, Type: g.ty}
+		for version, pkgs := range g.versions {
+			d.Versions = append(d.Versions, DuplicateVersion{Version: version, Packages: pkgs})
+		}
+		sort.Slice(d.Versions, func(i, j int) bool {
+			return d.Versions[i].Version < d.Versions[j].Version
+		})
+		duplicates = append(duplicates, d)
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].Type != duplicates[j].Type {
+			return duplicates[i].Type < duplicates[j].Type
+		}
+		return duplicates[i].Name < duplicates[j].Name
+	})
+	return duplicates
+}
+
+// duplicateKey returns the identity of the package without the version, preferring the package URL (which accounts
+// for namespaces, such as the group ID of java packages) over the name.
+func duplicateKey(p Package) string {
+	if purl, err := packageurl.FromString(p.PURL); err == nil && purl.Name != "" {
+		purl.Version = ""
+		purl.Qualifiers = nil
+		purl.Subpath = ""
+		return purl.ToString()
+	}
+	return string(p.Type) + ":" + p.Name
+}
@@ -0,0 +1,60 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestDuplicates(t *testing.T) {
+	newPackage := func(p Package, path string) Package {
+		p.Locations = file.NewLocationSet(file.NewLocation(path))
+		p.SetID()
+		return p
+	}
+
+	log4j1 := newPackage(Package{Name: "log4j-core", Version: "2.14.1", Type: JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}, "/app/a.jar")
+	log4j2 := newPackage(Package{Name: "log4j-core", Version: "2.17.1", Type: JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1"}, "/app/b.jar")
+	log4j3 := newPackage(Package{Name: "log4j-core", Version: "2.17.1", Type: JavaPkg, PURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.1"}, "/app/c.jar")
+	// same name, but a different group
+	otherCore := newPackage(Package{Name: "log4j-core", Version: "1.0.0", Type: JavaPkg, PURL: "pkg:maven/com.example/log4j-core@1.0.0"}, "/app/d.jar")
+
+	// without package URLs, the type and name are used
+	lodash1 := newPackage(Package{Name: "lodash", Version: "4.17.20", Type: NpmPkg}, "/app/node_modules/lodash/package.json")
+	lodash2 := newPackage(Package{Name: "lodash", Version: "4.17.21", Type: NpmPkg}, "/app/node_modules/x/node_modules/lodash/package.json")
+
+	// qualifiers (e.g. the architecture) are not considered
+	zlib1 := newPackage(Package{Name: "zlib1g", Version: "1:1.2.13", Type: DebPkg, PURL: "pkg:deb/debian/zlib1g@1:1.2.13?arch=amd64"}, "/var/lib/dpkg/status")
+	zlib2 := newPackage(Package{Name: "zlib1g", Version: "1:1.3", Type: DebPkg, PURL: "pkg:deb/debian/zlib1g@1:1.3?arch=i386"}, "/var/lib/dpkg/status")
+
+	unique := newPackage(Package{Name: "bash", Version: "5.2", Type: DebPkg}, "/var/lib/dpkg/status")
+	versionless := newPackage(Package{Name: "bash", Type: DebPkg}, "/usr/bin/bash")
+
+	got := Duplicates(NewCollection(log4j1, log4j2, log4j3, otherCore, lodash1, lodash2, zlib1, zlib2, unique, versionless))
+
+	require.Len(t, got, 3)
+
+	assert.Equal(t, "zlib1g", got[0].Name)
+	assert.Equal(t, DebPkg, got[0].Type)
+	require.Len(t, got[0].Versions, 2)
+	assert.Equal(t, "1:1.2.13", got[0].Versions[0].Version)
+	assert.Equal(t, "1:1.3", got[0].Versions[1].Version)
+
+	assert.Equal(t, "log4j-core", got[1].Name)
+	assert.Equal(t, JavaPkg, got[1].Type)
+	require.Len(t, got[1].Versions, 2)
+	assert.Equal(t, "2.14.1", got[1].Versions[0].Version)
+	assert.Equal(t, []Package{log4j1}, got[1].Versions[0].Packages)
+	assert.Equal(t, "2.17.1", got[1].Versions[1].Version)
+	assert.ElementsMatch(t, []Package{log4j2, log4j3}, got[1].Versions[1].Packages)
+
+	assert.Equal(t, "lodash", got[2].Name)
+	assert.Equal(t, NpmPkg, got[2].Type)
+	require.Len(t, got[2].Versions, 2)
+
+	assert.Empty(t, Duplicates(NewCollection(unique, versionless)))
+	assert.Empty(t, Duplicates(nil))
+}
@@ -0,0 +1,13 @@
+package pkg
+
+// ElectronRuntimeEntry represents a runtime component of an Electron desktop application: Electron itself, or the
+// Chromium and Node.js releases compiled into it.
+type ElectronRuntimeEntry struct {
+	// Application is the name of the Electron application the runtime is bundled with (as declared by the package.json
+	// within its app.asar archive).
+	Application string `mapstructure:"application" json:"application,omitempty"`
+
+	// Executable is the path of the executable the runtime version was recovered from (empty when the version was only
+	// found within the version file of the Electron distribution).
+	Executable string `mapstructure:"executable" json:"executable,omitempty"`
+}
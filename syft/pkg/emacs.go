@@ -0,0 +1,8 @@
+package pkg
+
+// EmacsStraightLockEntry represents a single package repository within a straight.el version lockfile (e.g.
+// straight/versions/default.el, written by straight-freeze-versions).
+type EmacsStraightLockEntry struct {
+	// Commit is the commit of the package repository that is installed.
+	Commit string `json:"commit"`
+}
@@ -0,0 +1,29 @@
+package pkg
+
+// EndOfLifeAnnotationKey is the location annotation holding when the release cycle of the package reaches its end of
+// life: either a date (YYYY-MM-DD), or "true" or "false" when it is only known whether the end of life was reached.
+const EndOfLifeAnnotationKey = "eol"
+
+// ReleaseCycleAnnotationKey is the location annotation holding the release cycle of the package (e.g. "3.12" for
+// python 3.12.1).
+const ReleaseCycleAnnotationKey = "releaseCycle"
+
+// EndOfLife returns when the release cycle of the package reaches its end of life (or an empty string when the
+// package has not been annotated).
+func EndOfLife(p Package) string {
+	return firstAnnotation(p, EndOfLifeAnnotationKey)
+}
+
+// ReleaseCycle returns the release cycle of the package (or an empty string when the package has not been annotated).
+func ReleaseCycle(p Package) string {
+	return firstAnnotation(p, ReleaseCycleAnnotationKey)
+}
+
+func firstAnnotation(p Package, key string) string {
+	for _, l := range p.Locations.ToSlice() {
+		if value := l.Annotations[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestEndOfLife(t *testing.T) {
+	annotated := Package{
+		Name: "python",
+		Locations: file.NewLocationSet(
+			file.NewLocation("/usr/bin/python3.8"),
+			file.NewLocation("/usr/lib/python3.8/os.py").
+				WithAnnotation(EndOfLifeAnnotationKey, "2024-10-07").
+				WithAnnotation(ReleaseCycleAnnotationKey, "3.8"),
+		),
+	}
+
+	assert.Equal(t, "2024-10-07", EndOfLife(annotated))
+	assert.Equal(t, "3.8", ReleaseCycle(annotated))
+
+	unannotated := Package{Name: "curl", Locations: file.NewLocationSet(file.NewLocation("/usr/bin/curl"))}
+	assert.Empty(t, EndOfLife(unannotated))
+	assert.Empty(t, ReleaseCycle(unannotated))
+}
@@ -0,0 +1,130 @@
+package pkg
+
+// UEFIFirmwareEntry represents a UEFI firmware image, being either a capsule (as delivered for firmware updates) or an
+// image holding firmware volumes (such as a flash image or a standalone firmware volume).
+type UEFIFirmwareEntry struct {
+	// Format is the format of the firmware image ("capsule" or "firmware-volume").
+	Format string `json:"format"`
+
+	// Vendor is the vendor of the firmware delivered by a capsule, as given by the fwupd metainfo file that accompanies
+	// the capsule (e.g. within the cabinet archive distributed by the Linux Vendor Firmware Service).
+	Vendor string `json:"vendor,omitempty"`
+
+	// CapsuleGUID is the GUID identifying the type of the capsule (e.g. 6dcbd5ed-e82d-4c44-bda1-7194199ad92a for
+	// capsules delivering firmware to the Firmware Management Protocol).
+	CapsuleGUID string `json:"capsuleGuid,omitempty"`
+
+	// Payloads are the firmware images delivered by a Firmware Management Protocol (FMP) capsule.
+	Payloads []UEFICapsulePayload `json:"payloads,omitempty"`
+
+	// Volumes are the firmware volumes found within the image.
+	Volumes []UEFIFirmwareVolume `json:"volumes,omitempty"`
+}
+
+// UEFICapsulePayload represents a firmware image delivered by a Firmware Management Protocol (FMP) capsule.
+type UEFICapsulePayload struct {
+	// ImageTypeID is the GUID identifying the type of firmware (and so the device) the image updates.
+	ImageTypeID string `json:"imageTypeId"`
+
+	// ImageIndex is the index of the firmware image of the device that is updated.
+	ImageIndex uint8 `json:"imageIndex,omitempty"`
+
+	// HardwareInstance identifies the device instance that is updated (zero when any instance is updated).
+	HardwareInstance uint64 `json:"hardwareInstance,omitempty"`
+
+	// Version is the version of the firmware within the image, as stated by the FMP payload header.
+	Version uint32 `json:"version,omitempty"`
+
+	// LowestSupportedVersion is the lowest firmware version that can be updated by the image.
+	LowestSupportedVersion uint32 `json:"lowestSupportedVersion,omitempty"`
+}
+
+// UEFIFirmwareVolume represents a firmware volume along with the firmware files (FFS) it holds.
+type UEFIFirmwareVolume struct {
+	// GUID is the name of the firmware volume, as given by the extended header (when present).
+	GUID string `json:"guid,omitempty"`
+
+	// FileSystemGUID is the GUID identifying the file system of the firmware volume (e.g. FFSv2 or FFSv3).
+	FileSystemGUID string `json:"fileSystemGuid"`
+
+	// Size is the size of the firmware volume in bytes.
+	Size uint64 `json:"size"`
+
+	// Files are the firmware files held by the volume (padding files are not included).
+	Files []UEFIFirmwareFile `json:"files,omitempty"`
+}
+
+// UEFIFirmwareFile represents a firmware file (FFS) held by a firmware volume.
+type UEFIFirmwareFile struct {
+	// GUID is the name of the firmware file.
+	GUID string `json:"guid"`
+
+	// Type is the type of the firmware file (e.g. "DRIVER", "PEIM", or "APPLICATION").
+	Type string `json:"type"`
+
+	// Name is the name of the module, as given by the user interface section of the file.
+	Name string `json:"name,omitempty"`
+
+	// Version is the version of the module, as given by the version section of the file.
+	Version string `json:"version,omitempty"`
+}
+
+// DeviceTreeEntry represents a flattened device tree blob (DTB) or overlay (DTBO), as described by its root node.
+type DeviceTreeEntry struct {
+	// Model is the model of the board described by the device tree (e.g. "Raspberry Pi 4 Model B").
+	Model string `json:"model,omitempty"`
+
+	// Compatible are the platforms the device tree is compatible with, from the most to the least specific (e.g.
+	// "raspberrypi,4-model-b" and "brcm,bcm2711").
+	Compatible []string `json:"compatible,omitempty"`
+
+	// Overlay is true when the blob is an overlay that is applied onto a base device tree.
+	Overlay bool `json:"overlay,omitempty"`
+}
+
+// LinuxFirmwareEntry represents the firmware files installed for a Linux kernel driver (e.g. within /lib/firmware), as
+// described by the WHENCE file of the linux-firmware repository.
+type LinuxFirmwareEntry struct {
+	// Description is the description of the driver the firmware is loaded by (e.g. "Intel Wireless Wifi" for iwlwifi).
+	Description string `json:"description,omitempty"`
+
+	// Files are the firmware files found for the driver.
+	Files []LinuxFirmwareFile `json:"files"`
+}
+
+// LinuxFirmwareFile represents a single firmware file loaded by a Linux kernel driver.
+type LinuxFirmwareFile struct {
+	// Path is the path of the file relative to the firmware directory (e.g. "rtl_nic/rtl8168h-2.fw").
+	Path string `json:"path"`
+
+	// Version is the version of the file, when stated by the WHENCE file.
+	Version string `json:"version,omitempty"`
+}
+
+// CPUMicrocodeEntry represents a CPU microcode update, as loaded by the operating system (e.g. from
+// /lib/firmware/intel-ucode or /lib/firmware/amd-ucode), where the package version is the microcode revision delivered
+// by the update.
+type CPUMicrocodeEntry struct {
+	// Vendor is the vendor of the processors the update applies to ("Intel" or "AMD").
+	Vendor string `json:"vendor"`
+
+	// ProcessorSignatures are the processors the update applies to.
+	ProcessorSignatures []CPUProcessorSignature `json:"processorSignatures,omitempty"`
+
+	// ProcessorFlags is the mask of platform IDs the update applies to (e.g. "0xc0"), only given for Intel updates.
+	ProcessorFlags string `json:"processorFlags,omitempty"`
+
+	// Date is the release date of the update (e.g. "2023-02-22").
+	Date string `json:"date,omitempty"`
+}
+
+// CPUProcessorSignature represents a processor by its CPUID signature, along with the family, model, and stepping
+// decoded from the signature.
+type CPUProcessorSignature struct {
+	// Signature is the CPUID signature of the processor (e.g. "0x000806ec").
+	Signature string `json:"signature"`
+
+	Family   int `json:"family"`
+	Model    int `json:"model"`
+	Stepping int `json:"stepping"`
+}
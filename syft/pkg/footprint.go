@@ -0,0 +1,62 @@
+package pkg
+
+import "sort"
+
+// Footprint is the disk usage of the files owned by a package (the installed size of the package).
+type Footprint struct {
+	// Size is the total size (in bytes) of the regular files owned by the package.
+	Size int64 `json:"size"`
+
+	// FileCount is the number of regular files owned by the package.
+	FileCount int `json:"fileCount"`
+
+	// Layers is the breakdown of the footprint by the container image layer each file was found in.
+	Layers []LayerFootprint `json:"layers,omitempty"`
+}
+
+// LayerFootprint is the disk usage of the package-owned files within a single container image layer.
+type LayerFootprint struct {
+	// Layer is the digest of the layer.
+	Layer string `json:"layer"`
+
+	// Size is the total size (in bytes) of the package-owned regular files within the layer.
+	Size int64 `json:"size"`
+
+	// FileCount is the number of package-owned regular files within the layer.
+	FileCount int `json:"fileCount"`
+}
+
+// LayerFootprints sums the given package footprints by layer. Layers are returned in the given order, followed by any
+// remaining layers sorted by digest.
+func LayerFootprints(footprints []Footprint, layerOrder ...string) []LayerFootprint {
+	totals := make(map[string]*LayerFootprint)
+	for _, f := range footprints {
+		for _, l := range f.Layers {
+			total, ok := totals[l.Layer]
+			if !ok {
+				total = &LayerFootprint{Layer: l.Layer}
+				totals[l.Layer] = total
+			}
+			total.Size += l.Size
+			total.FileCount += l.FileCount
+		}
+	}
+
+	var result []LayerFootprint
+	for _, layer := range layerOrder {
+		if total, ok := totals[layer]; ok {
+			result = append(result, *total)
+			delete(totals, layer)
+		}
+	}
+
+	var remaining []string
+	for layer := range totals {
+		remaining = append(remaining, layer)
+	}
+	sort.Strings(remaining)
+	for _, layer := range remaining {
+		result = append(result, *totals[layer])
+	}
+	return result
+}
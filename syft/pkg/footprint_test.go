@@ -0,0 +1,64 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayerFootprints(t *testing.T) {
+	footprints := []Footprint{
+		{
+			Size:      300,
+			FileCount: 3,
+			Layers: []LayerFootprint{
+				{Layer: "sha256:base", Size: 100, FileCount: 1},
+				{Layer: "sha256:app", Size: 200, FileCount: 2},
+			},
+		},
+		{
+			Size:      70,
+			FileCount: 2,
+			Layers: []LayerFootprint{
+				{Layer: "sha256:base", Size: 30, FileCount: 1},
+				{Layer: "sha256:unknown", Size: 40, FileCount: 1},
+			},
+		},
+		{
+			// directory sources have no layers
+			Size:      10,
+			FileCount: 1,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		layerOrder []string
+		want       []LayerFootprint
+	}{
+		{
+			name: "sorted by digest without an order",
+			want: []LayerFootprint{
+				{Layer: "sha256:app", Size: 200, FileCount: 2},
+				{Layer: "sha256:base", Size: 130, FileCount: 2},
+				{Layer: "sha256:unknown", Size: 40, FileCount: 1},
+			},
+		},
+		{
+			name:       "given order followed by remaining layers",
+			layerOrder: []string{"sha256:base", "sha256:empty", "sha256:app"},
+			want: []LayerFootprint{
+				{Layer: "sha256:base", Size: 130, FileCount: 2},
+				{Layer: "sha256:app", Size: 200, FileCount: 2},
+				{Layer: "sha256:unknown", Size: 40, FileCount: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LayerFootprints(footprints, tt.layerOrder...))
+		})
+	}
+
+	assert.Empty(t, LayerFootprints(nil))
+}
@@ -0,0 +1,67 @@
+package pkg
+
+// HelmChartMetadata represents the metadata of a Helm chart, either unpacked (from the Chart.yaml at the root of the
+// chart) or packaged as a chart archive (.tgz).
+type HelmChartMetadata struct {
+	// APIVersion is the chart API version (e.g. "v2").
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Type is the type of chart (e.g. "application" or "library").
+	Type string `json:"type,omitempty"`
+
+	// AppVersion is the version of the application deployed by the chart.
+	AppVersion string `json:"appVersion,omitempty"`
+
+	// Description is the short description of the chart.
+	Description string `json:"description,omitempty"`
+
+	// Home is the URL of the home page of the chart.
+	Home string `json:"home,omitempty"`
+
+	// Sources is the URLs of the source code for the chart.
+	Sources []string `json:"sources,omitempty"`
+
+	// Maintainers is the people that maintain the chart.
+	Maintainers []HelmChartMaintainer `json:"maintainers,omitempty"`
+
+	// Dependencies is the charts required by this chart, as declared within the Chart.yaml.
+	Dependencies []HelmChartDependency `json:"dependencies,omitempty"`
+
+	// Images is the container image references declared within the default values of the chart (values.yaml), only
+	// populated when enabled within the cataloger configuration.
+	Images []string `json:"images,omitempty"`
+}
+
+// HelmChartMaintainer represents a single maintainer of a Helm chart.
+type HelmChartMaintainer struct {
+	// Name is the name of the maintainer.
+	Name string `json:"name"`
+
+	// Email is the email address of the maintainer.
+	Email string `json:"email,omitempty"`
+
+	// URL is the URL for the maintainer.
+	URL string `json:"url,omitempty"`
+}
+
+// HelmChartDependency represents a single chart dependency declared within a Chart.yaml.
+type HelmChartDependency struct {
+	// Name is the name of the required chart.
+	Name string `json:"name"`
+
+	// Version is the version constraint of the required chart (e.g. "~15.5.0").
+	Version string `json:"version,omitempty"`
+
+	// Repository is the repository URL (or alias) the required chart is fetched from.
+	Repository string `json:"repository,omitempty"`
+}
+
+// HelmChartLockEntry represents a single chart dependency resolved within a Chart.lock.
+type HelmChartLockEntry struct {
+	// Repository is the repository URL the chart was resolved from (e.g. "https://charts.bitnami.com/bitnami" or
+	// "oci://registry-1.docker.io/bitnamicharts").
+	Repository string `json:"repository,omitempty"`
+
+	// Digest is the digest recorded within the Chart.lock for the set of resolved dependencies.
+	Digest string `json:"digest,omitempty"`
+}
@@ -0,0 +1,50 @@
+package pkg
+
+// IOSAppEntry represents an iOS application bundle distributed as an IPA archive, with the metadata found within the
+// Info.plist of the app.
+type IOSAppEntry struct {
+	// BundleIdentifier is the unique identifier of the app (CFBundleIdentifier, e.g. "com.example.app").
+	BundleIdentifier string `json:"bundleIdentifier"`
+
+	// BundleName is the short name of the app (CFBundleName).
+	BundleName string `json:"bundleName,omitempty"`
+
+	// DisplayName is the user-visible name of the app (CFBundleDisplayName).
+	DisplayName string `json:"displayName,omitempty"`
+
+	// ShortVersion is the release version of the app (CFBundleShortVersionString, e.g. "1.2.3").
+	ShortVersion string `json:"shortVersion,omitempty"`
+
+	// BundleVersion is the build version of the app (CFBundleVersion).
+	BundleVersion string `json:"bundleVersion,omitempty"`
+
+	// MinimumOSVersion is the minimum version of the OS required to run the app.
+	MinimumOSVersion string `json:"minimumOSVersion,omitempty"`
+
+	// Executable is the name of the main executable of the app (CFBundleExecutable).
+	Executable string `json:"executable,omitempty"`
+
+	// Platforms are the platforms the app supports (CFBundleSupportedPlatforms, e.g. "iPhoneOS").
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// IOSFrameworkEntry represents a framework or dynamic library embedded within an iOS application bundle.
+type IOSFrameworkEntry struct {
+	// Kind is whether this is a "framework" bundle or a standalone "dylib" (e.g. the Swift runtime libraries).
+	Kind string `json:"kind"`
+
+	// Path is the path of the framework bundle or dynamic library within the IPA archive.
+	Path string `json:"path"`
+
+	// BundleIdentifier is the unique identifier of the framework (CFBundleIdentifier).
+	BundleIdentifier string `json:"bundleIdentifier,omitempty"`
+
+	// ShortVersion is the release version of the framework (CFBundleShortVersionString).
+	ShortVersion string `json:"shortVersion,omitempty"`
+
+	// BundleVersion is the build version of the framework (CFBundleVersion).
+	BundleVersion string `json:"bundleVersion,omitempty"`
+
+	// CompatibilityVersion is the compatibility version of a dynamic library (from the Mach-O LC_ID_DYLIB command).
+	CompatibilityVersion string `json:"compatibilityVersion,omitempty"`
+}
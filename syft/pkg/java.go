@@ -25,9 +25,85 @@ type JavaArchive struct {
 	PomProperties  *JavaPomProperties `mapstructure:"PomProperties" json:"pomProperties,omitempty" cyclonedx:"-"`
 	PomProject     *JavaPomProject    `mapstructure:"PomProject" json:"pomProject,omitempty"`
 	ArchiveDigests []file.Digest      `hash:"ignore" json:"digest,omitempty"`
+	Relocation     *JavaRelocation    `hash:"ignore" mapstructure:"Relocation" json:"relocation,omitempty"`
+	SpringBoot     *JavaSpringBoot    `hash:"ignore" mapstructure:"SpringBoot" json:"springBoot,omitempty"`
 	Parent         *Package           `hash:"ignore" json:"-"` // note: the parent cannot be included in the minimal definition of uniqueness since this field is not reproducible in an encode-decode cycle (is lossy).
 }
 
+// JavaRelocation represents the classes of an artifact that were shaded into a parent archive (e.g. an uber-jar) and
+// relocated to another package to avoid conflicting with other copies of the artifact on the classpath.
+type JavaRelocation struct {
+	// OriginalPackage is the package of the artifact before relocation (e.g. "org.apache.commons.lang3").
+	OriginalPackage string `mapstructure:"originalPackage" json:"originalPackage"`
+
+	// RelocatedPackage is the package the classes were relocated to within the parent archive (e.g.
+	// "com.example.shaded.org.apache.commons.lang3").
+	RelocatedPackage string `mapstructure:"relocatedPackage" json:"relocatedPackage"`
+}
+
+// JavaSpringBoot represents the Spring Boot loader of an executable archive repackaged by Spring Boot (e.g. a fat jar
+// with nested jars under BOOT-INF/lib, or an executable war), as described by the archive manifest.
+type JavaSpringBoot struct {
+	// Version is the version of Spring Boot (and of the loader classes bundled into the archive) that repackaged the
+	// archive (Spring-Boot-Version).
+	Version string `mapstructure:"version" json:"version,omitempty"`
+
+	// Launcher is the loader class that launches the application (Main-Class, e.g.
+	// "org.springframework.boot.loader.launch.JarLauncher").
+	Launcher string `mapstructure:"launcher" json:"launcher,omitempty"`
+
+	// StartClass is the main class of the application invoked by the launcher (Start-Class).
+	StartClass string `mapstructure:"startClass" json:"startClass,omitempty"`
+
+	// Classes is the path of the application classes within the archive (Spring-Boot-Classes, e.g. "BOOT-INF/classes/").
+	Classes string `mapstructure:"classes" json:"classes,omitempty"`
+
+	// Lib is the path of the nested dependency jars within the archive (Spring-Boot-Lib, e.g. "BOOT-INF/lib/").
+	Lib string `mapstructure:"lib" json:"lib,omitempty"`
+
+	// ClasspathIndex is the path of the index listing the order of the nested jars on the classpath
+	// (Spring-Boot-Classpath-Index).
+	ClasspathIndex string `mapstructure:"classpathIndex" json:"classpathIndex,omitempty"`
+
+	// LayersIndex is the path of the index assigning the archive contents to layers (Spring-Boot-Layers-Index).
+	LayersIndex string `mapstructure:"layersIndex" json:"layersIndex,omitempty"`
+}
+
+// JavaBuildToolWrapperEntry represents a build tool (or the wrapper itself) pinned by a build tool wrapper, such as the
+// Gradle wrapper (gradle/wrapper/gradle-wrapper.properties) or the Maven wrapper (.mvn/wrapper/maven-wrapper.properties),
+// which is downloaded by the wrapper when building the project.
+type JavaBuildToolWrapperEntry struct {
+	// DistributionURL is the URL that the wrapper downloads the distribution from.
+	DistributionURL string `mapstructure:"distributionUrl" json:"distributionUrl,omitempty"`
+
+	// DistributionSha256Sum is the SHA-256 checksum that the wrapper verifies the downloaded distribution against (when
+	// pinned).
+	DistributionSha256Sum string `mapstructure:"distributionSha256Sum" json:"distributionSha256Sum,omitempty"`
+}
+
+// JavaOSGiBundleEntry represents an OSGi bundle (or an Eclipse feature) installed within an OSGi runtime (such as an
+// Eclipse-based product, Apache Karaf, or Apache Felix) or published within an Eclipse p2 repository, as described by
+// the bundle manifest (META-INF/MANIFEST.MF), the Eclipse simple configurator (bundles.info), or the p2 artifact
+// repository (artifacts.xml).
+type JavaOSGiBundleEntry struct {
+	// BundleName is the human-readable name of the bundle (Bundle-Name), when not localized.
+	BundleName string `mapstructure:"bundleName" json:"bundleName,omitempty"`
+
+	// Vendor is the vendor of the bundle (Bundle-Vendor), when not localized.
+	Vendor string `mapstructure:"vendor" json:"vendor,omitempty"`
+
+	// FragmentHost is the symbolic name of the host bundle, when the bundle is a fragment (Fragment-Host).
+	FragmentHost string `mapstructure:"fragmentHost" json:"fragmentHost,omitempty"`
+
+	// Classifier is the kind of p2 artifact ("osgi.bundle" or "org.eclipse.update.feature"), when found within a p2
+	// artifact repository.
+	Classifier string `mapstructure:"classifier" json:"classifier,omitempty"`
+
+	// BundleLocation is the location the bundle is installed from, as recorded by the OSGi runtime (e.g.
+	// "plugins/org.eclipse.core.runtime_3.29.0.v20230726-0617.jar").
+	BundleLocation string `mapstructure:"bundleLocation" json:"bundleLocation,omitempty"`
+}
+
 // JavaPomProperties represents the fields of interest extracted from a Java archive's pom.properties file.
 type JavaPomProperties struct {
 	Path       string            `mapstructure:"path" json:"path"`
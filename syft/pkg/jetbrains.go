@@ -0,0 +1,33 @@
+package pkg
+
+// JetBrainsPluginEntry is a struct that represents a plugin for an IntelliJ-based IDE, as described by the
+// META-INF/plugin.xml file within the plugin jar.
+type JetBrainsPluginEntry struct {
+	// PluginID is the unique identifier of the plugin (e.g. "org.jetbrains.kotlin"), which is used by other plugins to
+	// depend on this plugin and by the JetBrains Marketplace.
+	PluginID string `mapstructure:"pluginId" json:"pluginId"`
+
+	Vendor    string `mapstructure:"vendor" json:"vendor,omitempty"`
+	VendorURL string `mapstructure:"vendorUrl" json:"vendorUrl,omitempty"`
+
+	// SinceBuild and UntilBuild are the range of IDE builds the plugin is compatible with (e.g. "241" and "241.*").
+	SinceBuild string `mapstructure:"sinceBuild" json:"sinceBuild,omitempty"`
+	UntilBuild string `mapstructure:"untilBuild" json:"untilBuild,omitempty"`
+
+	// Bundled indicates the plugin is part of an IDE installation rather than being installed by the user.
+	Bundled bool `mapstructure:"bundled" json:"bundled,omitempty"`
+
+	// Modules are the modules provided by the plugin, which other plugins may depend on instead of the plugin ID.
+	Modules []string `mapstructure:"modules" json:"modules,omitempty"`
+
+	// Depends are the plugins (or modules) this plugin depends on.
+	Depends []JetBrainsPluginDependency `mapstructure:"depends" json:"depends,omitempty"`
+}
+
+// JetBrainsPluginDependency is a struct that represents a dependency of a JetBrains plugin on another plugin or module.
+type JetBrainsPluginDependency struct {
+	ID string `mapstructure:"id" json:"id"`
+
+	// Optional indicates the plugin is able to run without the dependency (with reduced functionality).
+	Optional bool `mapstructure:"optional" json:"optional,omitempty"`
+}
@@ -68,13 +68,13 @@ func LanguageFromPURL(p string) Language {
 
 func LanguageByName(name string) Language {
 	switch strings.ToLower(name) {
-	case packageurl.TypeMaven, string(purlGradlePkgType), string(JavaPkg), string(Java):
+	case packageurl.TypeMaven, string(purlGradlePkgType), string(JavaPkg), string(Java), string(JetBrainsPluginPkg):
 		return Java
 	case packageurl.TypeComposer, string(PhpComposerPkg), string(PHP):
 		return PHP
 	case packageurl.TypeGolang, string(GoModulePkg), string(Go):
 		return Go
-	case packageurl.TypeNPM, string(JavaScript), "nodejs", "node.js":
+	case packageurl.TypeNPM, string(JavaScript), "nodejs", "node.js", string(VSCodeExtensionPkg), string(BrowserExtensionPkg):
 		return JavaScript
 	case packageurl.TypeLuaRocks, string(Lua):
 		return Lua
@@ -86,13 +86,13 @@ func LanguageByName(name string) Language {
 		return Rust
 	case packageurl.TypePub, string(DartPubPkg), string(Dart):
 		return Dart
-	case string(Dotnet), ".net", packageurl.TypeNuget:
+	case string(Dotnet), ".net", packageurl.TypeNuget, string(UnityPkg):
 		return Dotnet
-	case packageurl.TypeCocoapods, packageurl.TypeSwift, string(CocoapodsPkg):
+	case packageurl.TypeCocoapods, packageurl.TypeSwift, string(CocoapodsPkg), string(CarthagePkg):
 		return Swift
 	case "swipl", string(SwiplPackPkg):
 		return Swipl
-	case packageurl.TypeConan, string(CPP):
+	case packageurl.TypeConan, string(CPP), string(UnrealPkg):
 		return CPP
 	case packageurl.TypeHackage, string(Haskell):
 		return Haskell
@@ -58,6 +58,26 @@ func TestLanguageFromPURL(t *testing.T) {
 			purl: "pkg:cocoapods/GlossButtonNode@3.1.2",
 			want: Swift,
 		},
+		{
+			purl: "pkg:carthage/github.com/Alamofire/Alamofire@5.6.4",
+			want: Swift,
+		},
+		{
+			purl: "pkg:unity/com.unity.textmeshpro@3.0.6",
+			want: Dotnet,
+		},
+		{
+			purl: "pkg:unreal/LowEntryExtStdLib@3.4.0",
+			want: CPP,
+		},
+		{
+			purl: "pkg:vscode-extension/ms-python/python@2024.0.1",
+			want: JavaScript,
+		},
+		{
+			purl: "pkg:browser-extension/firefox/uBlock0%40raymondhill.net@1.55.0",
+			want: JavaScript,
+		},
 		{
 			purl: "pkg:conan/catch2@2.13.8",
 			want: CPP,
@@ -70,6 +90,10 @@ func TestLanguageFromPURL(t *testing.T) {
 			purl: "pkg:hex/hpax/hpax@0.1.1",
 			want: UnknownLanguage,
 		},
+		{
+			purl: "pkg:jetbrains-plugin/org.jetbrains.kotlin@241.14494.240-IJ",
+			want: Java,
+		},
 		{
 			purl: "pkg:cran/base@4.3.0",
 			want: R,
@@ -123,6 +147,10 @@ func TestLanguageByName(t *testing.T) {
 			name:     "maven",
 			language: Java,
 		},
+		{
+			name:     "jetbrains-plugin",
+			language: Java,
+		},
 		{
 			name:     "java",
 			language: Java,
@@ -235,6 +263,26 @@ func TestLanguageByName(t *testing.T) {
 			name:     "cocoapods",
 			language: Swift,
 		},
+		{
+			name:     "carthage",
+			language: Swift,
+		},
+		{
+			name:     "unity",
+			language: Dotnet,
+		},
+		{
+			name:     "unreal",
+			language: CPP,
+		},
+		{
+			name:     "vscode-extension",
+			language: JavaScript,
+		},
+		{
+			name:     "browser-extension",
+			language: JavaScript,
+		},
 		{
 			name:     "unknown",
 			language: UnknownLanguage,
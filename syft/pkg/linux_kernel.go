@@ -13,19 +13,25 @@ type LinuxKernel struct {
 	SwapDevice      int    `mapstructure:"swapDevice" json:"swapDevice,omitempty" cyclonedx:"swapDevice"`
 	RootDevice      int    `mapstructure:"rootDevice" json:"rootDevice,omitempty" cyclonedx:"rootDevice"`
 	VideoMode       string `mapstructure:"videoMode" json:"videoMode,omitempty" cyclonedx:"videoMode"`
+	ConfigHash      string `mapstructure:"configHash" json:"configHash,omitempty" cyclonedx:"configHash"`
 }
 
 type LinuxKernelModule struct {
-	Name          string                                `mapstructure:"name" json:"name,omitempty" cyclonedx:"name"`
-	Version       string                                `mapstructure:"version" json:"version,omitempty" cyclonedx:"version"`
-	SourceVersion string                                `mapstructure:"sourceVersion" json:"sourceVersion,omitempty" cyclonedx:"sourceVersion"`
-	Path          string                                `mapstructure:"path" json:"path,omitempty" cyclonedx:"path"`
-	Description   string                                `mapstructure:"description" json:"description,omitempty" cyclonedx:"description"`
-	Author        string                                `mapstructure:"author" json:"author,omitempty" cyclonedx:"author"`
-	License       string                                `mapstructure:"license" json:"license,omitempty" cyclonedx:"license"`
-	KernelVersion string                                `mapstructure:"kernelVersion" json:"kernelVersion,omitempty" cyclonedx:"kernelVersion"`
-	VersionMagic  string                                `mapstructure:"versionMagic" json:"versionMagic,omitempty" cyclonedx:"versionMagic"`
-	Parameters    map[string]LinuxKernelModuleParameter `mapstructure:"parameters" json:"parameters,omitempty" cyclonedx:"parameters"`
+	Name                   string                                `mapstructure:"name" json:"name,omitempty" cyclonedx:"name"`
+	Version                string                                `mapstructure:"version" json:"version,omitempty" cyclonedx:"version"`
+	SourceVersion          string                                `mapstructure:"sourceVersion" json:"sourceVersion,omitempty" cyclonedx:"sourceVersion"`
+	Path                   string                                `mapstructure:"path" json:"path,omitempty" cyclonedx:"path"`
+	Description            string                                `mapstructure:"description" json:"description,omitempty" cyclonedx:"description"`
+	Author                 string                                `mapstructure:"author" json:"author,omitempty" cyclonedx:"author"`
+	License                string                                `mapstructure:"license" json:"license,omitempty" cyclonedx:"license"`
+	KernelVersion          string                                `mapstructure:"kernelVersion" json:"kernelVersion,omitempty" cyclonedx:"kernelVersion"`
+	VersionMagic           string                                `mapstructure:"versionMagic" json:"versionMagic,omitempty" cyclonedx:"versionMagic"`
+	Parameters             map[string]LinuxKernelModuleParameter `mapstructure:"parameters" json:"parameters,omitempty" cyclonedx:"parameters"`
+	Depends                []string                              `mapstructure:"depends" json:"depends,omitempty" cyclonedx:"depends"`
+	OutOfTree              bool                                  `mapstructure:"outOfTree" json:"outOfTree,omitempty" cyclonedx:"outOfTree"`
+	SignatureType          string                                `mapstructure:"signatureType" json:"signatureType,omitempty" cyclonedx:"signatureType"`
+	Signer                 string                                `mapstructure:"signer" json:"signer,omitempty" cyclonedx:"signer"`
+	SignatureHashAlgorithm string                                `mapstructure:"signatureHashAlgorithm" json:"signatureHashAlgorithm,omitempty" cyclonedx:"signatureHashAlgorithm"`
 }
 
 type LinuxKernelModuleParameter struct {
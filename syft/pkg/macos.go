@@ -0,0 +1,116 @@
+package pkg
+
+import (
+	"sort"
+
+	"github.com/scylladb/go-set/strset"
+)
+
+// MacOSAppEntry represents a macOS application bundle (e.g. "/Applications/Hello.app"), with the metadata found within
+// the Info.plist of the app and the load commands of its main executable.
+type MacOSAppEntry struct {
+	// BundleIdentifier is the unique identifier of the app (CFBundleIdentifier, e.g. "com.example.app").
+	BundleIdentifier string `json:"bundleIdentifier"`
+
+	// BundleName is the short name of the app (CFBundleName).
+	BundleName string `json:"bundleName,omitempty"`
+
+	// ShortVersion is the release version of the app (CFBundleShortVersionString, e.g. "1.2.3").
+	ShortVersion string `json:"shortVersion,omitempty"`
+
+	// BundleVersion is the build version of the app (CFBundleVersion).
+	BundleVersion string `json:"bundleVersion,omitempty"`
+
+	// MinimumSystemVersion is the minimum version of macOS required to run the app (LSMinimumSystemVersion, otherwise
+	// from the LC_VERSION_MIN_MACOSX or LC_BUILD_VERSION command of the executable).
+	MinimumSystemVersion string `json:"minimumSystemVersion,omitempty"`
+
+	// Executable is the name of the main executable of the app (CFBundleExecutable).
+	Executable string `json:"executable,omitempty"`
+
+	// LinkedLibraries are the install names of the frameworks and dynamic libraries that the main executable links
+	// against (from the LC_LOAD_DYLIB commands, e.g. "@rpath/Sparkle.framework/Versions/B/Sparkle").
+	LinkedLibraries []string `json:"linkedLibraries,omitempty"`
+}
+
+// MacOSFrameworkEntry represents a macOS framework bundle or dynamic library.
+type MacOSFrameworkEntry struct {
+	// Kind is whether this is a "framework" bundle or a standalone "dylib".
+	Kind string `json:"kind"`
+
+	// BundleIdentifier is the unique identifier of the framework (CFBundleIdentifier).
+	BundleIdentifier string `json:"bundleIdentifier,omitempty"`
+
+	// ShortVersion is the release version of the framework (CFBundleShortVersionString).
+	ShortVersion string `json:"shortVersion,omitempty"`
+
+	// BundleVersion is the build version of the framework (CFBundleVersion).
+	BundleVersion string `json:"bundleVersion,omitempty"`
+
+	// InstallName is the path that binaries load the library from (from the Mach-O LC_ID_DYLIB command).
+	InstallName string `json:"installName,omitempty"`
+
+	// CurrentVersion is the current version of the library (from the Mach-O LC_ID_DYLIB command).
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// CompatibilityVersion is the compatibility version of the library (from the Mach-O LC_ID_DYLIB command).
+	CompatibilityVersion string `json:"compatibilityVersion,omitempty"`
+
+	// MinimumSystemVersion is the minimum version of macOS required by the library.
+	MinimumSystemVersion string `json:"minimumSystemVersion,omitempty"`
+
+	// LinkedLibraries are the install names of the frameworks and dynamic libraries that the library links against.
+	LinkedLibraries []string `json:"linkedLibraries,omitempty"`
+}
+
+var _ FileOwner = (*MacOSReceiptEntry)(nil)
+
+// MacOSReceiptEntry represents an installer package (.pkg) installed on a macOS host, as recorded by the receipt that
+// the installer writes (e.g. "/var/db/receipts/com.example.hello.plist") and reported by pkgutil.
+type MacOSReceiptEntry struct {
+	// PackageIdentifier is the unique identifier of the installer package (e.g. "com.apple.pkg.CLTools_Executables").
+	PackageIdentifier string `json:"packageIdentifier"`
+
+	// PackageVersion is the version of the installer package.
+	PackageVersion string `json:"packageVersion,omitempty"`
+
+	// PackageFileName is the name of the installer package file that was installed (e.g. "Hello-1.0.pkg").
+	PackageFileName string `json:"packageFileName,omitempty"`
+
+	// InstallDate is when the package was installed (RFC 3339).
+	InstallDate string `json:"installDate,omitempty"`
+
+	// InstallPrefixPath is the location that the package was installed to, which the files are relative to.
+	InstallPrefixPath string `json:"installPrefixPath,omitempty"`
+
+	// InstallProcessName is the name of the process that installed the package (e.g. "installer" or
+	// "softwareupdated").
+	InstallProcessName string `json:"installProcessName,omitempty"`
+
+	// Files are the files and links installed by the package, from the bill of materials (.bom) alongside the receipt.
+	Files []MacOSReceiptFileRecord `json:"files,omitempty"`
+}
+
+// MacOSReceiptFileRecord is a file or link installed by a macOS installer package.
+type MacOSReceiptFileRecord struct {
+	// Path is the absolute path of the installed file.
+	Path string `json:"path"`
+
+	// Mode is the file mode (in octal, e.g. "755").
+	Mode string `json:"mode,omitempty"`
+
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size,omitempty"`
+}
+
+func (m MacOSReceiptEntry) OwnedFiles() (result []string) {
+	s := strset.New()
+	for _, f := range m.Files {
+		if f.Path != "" {
+			s.Add(f.Path)
+		}
+	}
+	result = s.List()
+	sort.Strings(result)
+	return
+}
@@ -0,0 +1,37 @@
+package pkg
+
+// MesonWrapEntry represents a single Meson subproject dependency declared within a subprojects/*.wrap file.
+type MesonWrapEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// Method is the kind of wrap the subproject is fetched with: "file", "git", "hg", or "svn".
+	Method string `json:"method"`
+
+	// Directory is the name of the directory the subproject is extracted or cloned into.
+	Directory string `json:"directory,omitempty"`
+
+	// SourceURL is the location the source archive is downloaded from (wrap-file only).
+	SourceURL string `json:"sourceUrl,omitempty"`
+
+	// SourceHash is the sha256 digest of the source archive (wrap-file only).
+	SourceHash string `json:"sourceHash,omitempty"`
+
+	// PatchURL is the location of an archive overlaid on the extracted source (e.g. meson.build files from the WrapDB).
+	PatchURL string `json:"patchUrl,omitempty"`
+
+	// PatchHash is the sha256 digest of the patch archive.
+	PatchHash string `json:"patchHash,omitempty"`
+
+	// WrapDBVersion is the version of the wrap within the Meson WrapDB (e.g. "1.3.1-1").
+	WrapDBVersion string `json:"wrapdbVersion,omitempty"`
+
+	// URL is the repository the subproject is cloned from (VCS wraps only).
+	URL string `json:"url,omitempty"`
+
+	// Revision is the branch, tag, or commit the repository is checked out at (VCS wraps only).
+	Revision string `json:"revision,omitempty"`
+
+	// Provides are the dependency names the subproject can satisfy (from the [provide] section).
+	Provides []string `json:"provides,omitempty"`
+}
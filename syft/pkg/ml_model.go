@@ -0,0 +1,26 @@
+package pkg
+
+// MLModelEntry represents a machine learning model, described by the header of a model file (GGUF, ONNX, or
+// safetensors) or by the configuration of a HuggingFace model directory.
+type MLModelEntry struct {
+	// Format is the format the model is described by ("gguf", "onnx", "safetensors", or "huggingface").
+	Format string `json:"format"`
+
+	// Architecture is the model architecture (e.g. "llama" for GGUF or "LlamaForCausalLM" for HuggingFace models).
+	Architecture string `json:"architecture,omitempty"`
+
+	// Quantization is the quantization or data type of the model weights (e.g. "Q4_K_M", "BF16", or "gptq-4bit").
+	Quantization string `json:"quantization,omitempty"`
+
+	// Parameters is the number of parameters of the model, when it can be determined from the tensors of the model.
+	Parameters uint64 `json:"parameters,omitempty"`
+
+	// ContextLength is the maximum number of tokens of the model context.
+	ContextLength uint64 `json:"contextLength,omitempty"`
+
+	// Producer is the tool that produced the model (e.g. "pytorch" for ONNX models).
+	Producer string `json:"producer,omitempty"`
+
+	// ProducerVersion is the version of the tool that produced the model.
+	ProducerVersion string `json:"producerVersion,omitempty"`
+}
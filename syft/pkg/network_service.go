@@ -0,0 +1,54 @@
+package pkg
+
+const (
+	// SystemdSocketServiceSource is for services activated by systemd socket units.
+	SystemdSocketServiceSource = "systemd-socket"
+
+	// XinetdServiceSource is for services run by xinetd (from /etc/xinetd.d).
+	XinetdServiceSource = "xinetd"
+
+	// NginxServiceSource is for nginx server blocks.
+	NginxServiceSource = "nginx"
+
+	// ApacheServiceSource is for Apache HTTP Server listeners and virtual hosts.
+	ApacheServiceSource = "apache"
+)
+
+// NetworkServiceEntry represents a service configured to listen on the network (or a local socket), as found within
+// the configuration of systemd, xinetd, nginx, or the Apache HTTP Server.
+type NetworkServiceEntry struct {
+	// Source is the configuration the service was found in: "systemd-socket", "xinetd", "nginx", or "apache".
+	Source string `json:"source"`
+
+	// Listeners are the addresses the service is configured to listen on.
+	Listeners []NetworkServiceListener `json:"listeners,omitempty"`
+
+	// ServerNames are the host names served (for nginx server blocks and Apache virtual hosts).
+	ServerNames []string `json:"serverNames,omitempty"`
+
+	// Unit is the service unit activated by a systemd socket.
+	Unit string `json:"unit,omitempty"`
+
+	// Command is the command line run for connections (the server of an xinetd service or the ExecStart of the service
+	// activated by a systemd socket).
+	Command string `json:"command,omitempty"`
+
+	// Executable is the absolute path of the program run for connections, when it can be determined.
+	Executable string `json:"executable,omitempty"`
+
+	// User is the account the service runs as (only known for xinetd services).
+	User string `json:"user,omitempty"`
+
+	// Disabled indicates if the service is configured but turned off (only known for xinetd services).
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// NetworkServiceListener represents an address a service listens on.
+type NetworkServiceListener struct {
+	// Address is the address as configured: a port (e.g. "80"), a host and port (e.g. "0.0.0.0:443" or "[::]:80"), the
+	// path of a unix socket, or the name of a service listed in /etc/services (for xinetd services without a port).
+	Address string `json:"address"`
+
+	// Protocol is the transport of the listener: "tcp", "udp", or "unix".
+	Protocol string `json:"protocol,omitempty"`
+}
@@ -22,3 +22,37 @@ type YarnLockEntry struct {
 	Resolved  string `mapstructure:"resolved" json:"resolved"`
 	Integrity string `mapstructure:"integrity" json:"integrity"`
 }
+
+// BunLockEntry represents a single npm package entry from a bun.lock (text) or bun.lockb (binary) file.
+type BunLockEntry struct {
+	Resolved  string `mapstructure:"resolved" json:"resolved"`
+	Integrity string `mapstructure:"integrity" json:"integrity"`
+}
+
+// NpmGlobalPackageEntry represents a package installed globally with npm (`npm install --global`) or yarn
+// (`yarn global add`), such as a command line tool installed on a developer workstation or build agent, or a package
+// manager release provisioned by corepack.
+type NpmGlobalPackageEntry struct {
+	// Manager is the package manager the package was installed with ("npm", "yarn", or "corepack").
+	Manager string `json:"manager"`
+
+	// Bins are the names of the commands the package provides (from the "bin" field of the package.json file).
+	Bins []string `json:"bins,omitempty"`
+
+	// Default indicates that a package manager provisioned by corepack is the version that the corepack shims run
+	// outside of projects that pin a version with the "packageManager" field (as recorded in lastKnownGood.json).
+	Default bool `json:"default,omitempty"`
+}
+
+// NpmBundledPackageEntry represents an npm package that was compiled into a JavaScript bundle (e.g. by webpack, rollup,
+// or esbuild), as recovered from the sourcemap of the bundle or from the banner comments that the bundler preserved.
+// Since the package.json file of the package is not shipped with the bundle, this is weaker evidence than that of an
+// installed package (the version in particular may be missing).
+type NpmBundledPackageEntry struct {
+	// Evidence is how the package was recovered from the bundle: "sourcemap" (the package sources are listed within
+	// the sourcemap of the bundle) and/or "banner" (the bundle retains the license banner comment of the package).
+	Evidence []string `json:"evidence"`
+
+	// SourceFiles is the number of source files of the package listed within the sourcemap of the bundle.
+	SourceFiles int `json:"sourceFiles,omitempty"`
+}
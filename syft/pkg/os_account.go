@@ -0,0 +1,47 @@
+package pkg
+
+const (
+	// PasswordSet indicates that the account has a password (the hash itself is never captured).
+	PasswordSet = "set"
+
+	// PasswordLocked indicates that password authentication is disabled for the account (the password field
+	// starts with "!" or "*").
+	PasswordLocked = "locked"
+
+	// PasswordEmpty indicates that the account has no password, allowing authentication without one.
+	PasswordEmpty = "empty"
+)
+
+// OSUserAccount represents a user account defined within the passwd file of an OS (e.g. /etc/passwd).
+type OSUserAccount struct {
+	UID int `json:"uid"`
+
+	// GID is the ID of the primary group of the user.
+	GID int `json:"gid"`
+
+	// Gecos is the comment field of the account, typically the full name of the user.
+	Gecos string `json:"gecos,omitempty"`
+
+	HomeDirectory string `json:"homeDirectory,omitempty"`
+
+	Shell string `json:"shell,omitempty"`
+
+	// Login indicates if the shell permits interactive logins (i.e. it is not nologin, false, or similar).
+	Login bool `json:"login"`
+
+	// ShadowEntry indicates if the account has an entry within the shadow file (e.g. /etc/shadow).
+	ShadowEntry bool `json:"shadowEntry"`
+
+	// PasswordStatus is the state of the password of the account: "set", "locked", or "empty" (unknown when the
+	// password is shadowed but the shadow file could not be read).
+	PasswordStatus string `json:"passwordStatus,omitempty"`
+}
+
+// OSGroupAccount represents a group defined within the group file of an OS (e.g. /etc/group).
+type OSGroupAccount struct {
+	GID int `json:"gid"`
+
+	// Members are the names of the users with this group as a supplementary group (users with this group as their
+	// primary group are not listed within the group file).
+	Members []string `json:"members,omitempty"`
+}
@@ -0,0 +1,25 @@
+package pkg
+
+// OwnershipAnnotationKey is the location annotation holding whether the package is internal (first-party) or
+// third-party, as determined by the configured ownership heuristics.
+const OwnershipAnnotationKey = "ownership"
+
+const (
+	InternalOwnership   = "internal"
+	ThirdPartyOwnership = "third-party"
+)
+
+// Ownership returns whether the package is internal or third-party (or an empty string when the package has not been
+// classified). A package is internal when any of its locations is annotated as internal.
+func Ownership(p Package) string {
+	var ownership string
+	for _, l := range p.Locations.ToSlice() {
+		switch l.Annotations[OwnershipAnnotationKey] {
+		case InternalOwnership:
+			return InternalOwnership
+		case ThirdPartyOwnership:
+			ownership = ThirdPartyOwnership
+		}
+	}
+	return ownership
+}
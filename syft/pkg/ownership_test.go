@@ -0,0 +1,45 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestOwnership(t *testing.T) {
+	tests := []struct {
+		name      string
+		locations []file.Location
+		want      string
+	}{
+		{
+			name: "not classified",
+			locations: []file.Location{
+				file.NewLocation("/app/package.json"),
+			},
+			want: "",
+		},
+		{
+			name: "third-party",
+			locations: []file.Location{
+				file.NewLocation("/app/package.json").WithAnnotation(OwnershipAnnotationKey, ThirdPartyOwnership),
+			},
+			want: ThirdPartyOwnership,
+		},
+		{
+			name: "any internal location",
+			locations: []file.Location{
+				file.NewLocation("/app/package.json").WithAnnotation(OwnershipAnnotationKey, ThirdPartyOwnership),
+				file.NewLocation("/app/package-lock.json").WithAnnotation(OwnershipAnnotationKey, InternalOwnership),
+			},
+			want: InternalOwnership,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Ownership(Package{Locations: file.NewLocationSet(tt.locations...)}))
+		})
+	}
+}
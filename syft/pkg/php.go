@@ -42,3 +42,23 @@ type PhpPeclEntry struct {
 	Version string   `json:"version"`
 	License []string `json:"license,omitempty"`
 }
+
+// PhpExtensionEntry represents a compiled PHP extension (a shared object within the extension_dir of a PHP
+// installation), such as those installed with pecl or docker-php-ext-install.
+type PhpExtensionEntry struct {
+	Name    string   `json:"name"`
+	Version string   `json:"version,omitempty"`
+	License []string `json:"license,omitempty"`
+
+	// APIVersion is the PHP extension API version the extension was built against (e.g. "20220829" for PHP 8.2).
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// ZendExtension indicates that the extension must be loaded with the zend_extension directive (e.g. xdebug).
+	ZendExtension bool `json:"zendExtension,omitempty"`
+
+	// Enabled indicates that the extension is loaded by the php.ini file or an ini file within a scanned conf.d directory.
+	Enabled bool `json:"enabled"`
+
+	// Configuration are the paths of the ini files that load the extension.
+	Configuration []string `json:"configuration,omitempty"`
+}
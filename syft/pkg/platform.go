@@ -0,0 +1,11 @@
+package pkg
+
+// PlatformAnnotationKey is the location annotation holding the platform (e.g. "linux/arm64") of the image the package
+// was found in, used when the packages of several platforms of a multi-platform image are combined into one SBOM.
+const PlatformAnnotationKey = "platform"
+
+// Platform returns the platform of the image the package was found in (or an empty string when the package has not
+// been annotated).
+func Platform(p Package) string {
+	return firstAnnotation(p, PlatformAnnotationKey)
+}
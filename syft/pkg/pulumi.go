@@ -0,0 +1,27 @@
+package pkg
+
+// PulumiPluginEntry represents a Pulumi plugin installed within the plugin cache (e.g. ~/.pulumi/plugins/resource-aws-v6.45.0).
+type PulumiPluginEntry struct {
+	// Kind is the kind of plugin (e.g. "resource", "language", "analyzer", "converter", or "tool").
+	Kind string `json:"kind"`
+}
+
+// PulumiProjectEntry represents a Pulumi plugin required by a Pulumi project, either declared explicitly within the
+// project file (Pulumi.yaml) or referenced by the provider SDK within the lock file of the project language runtime.
+type PulumiProjectEntry struct {
+	// Project is the name of the Pulumi project.
+	Project string `json:"project"`
+
+	// Runtime is the language runtime of the project (e.g. "nodejs", "python", "go", or "yaml").
+	Runtime string `json:"runtime,omitempty"`
+
+	// Kind is the kind of plugin (e.g. "resource", "language", or "analyzer").
+	Kind string `json:"kind"`
+
+	// SDKPackage is the name of the language package of the provider SDK that references the plugin, when found
+	// within a lock file (e.g. "@pulumi/aws").
+	SDKPackage string `json:"sdkPackage,omitempty"`
+
+	// Path is the local path the plugin is loaded from, when declared within the project file.
+	Path string `json:"path,omitempty"`
+}
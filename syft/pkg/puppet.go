@@ -0,0 +1,58 @@
+package pkg
+
+const (
+	// PuppetForgeSource is a module resolved from a Puppet Forge server.
+	PuppetForgeSource = "forge"
+
+	// PuppetGitSource is a module resolved from a git repository.
+	PuppetGitSource = "git"
+
+	// PuppetPathSource is a module resolved from a local path.
+	PuppetPathSource = "path"
+)
+
+// PuppetModuleMetadata represents the module information of an installed Puppet module (from
+// <modulepath>/<module>/metadata.json).
+type PuppetModuleMetadata struct {
+	// Author is the Forge user (or organization) that publishes the module.
+	Author string `json:"author,omitempty"`
+
+	// Summary is the one-line description of the module.
+	Summary string `json:"summary,omitempty"`
+
+	// Source is the URL of the source repository of the module.
+	Source string `json:"source,omitempty"`
+
+	// ProjectPage is the URL of the project homepage of the module.
+	ProjectPage string `json:"projectPage,omitempty"`
+
+	// Dependencies is the modules this module requires.
+	Dependencies []PuppetModuleDependency `json:"dependencies,omitempty"`
+}
+
+// PuppetModuleDependency is a single module requirement declared within a Puppet module metadata.json.
+type PuppetModuleDependency struct {
+	// Name is the full Forge name of the required module (e.g. "puppetlabs-stdlib").
+	Name string `json:"name"`
+
+	// VersionRequirement is the version range of the required module, as given (e.g. ">= 4.13.1 < 10.0.0").
+	VersionRequirement string `json:"versionRequirement,omitempty"`
+}
+
+// PuppetfileLockEntry represents a single module resolved within a Puppetfile.lock (written by librarian-puppet).
+type PuppetfileLockEntry struct {
+	// SourceType is where the module was resolved from ("forge", "git", or "path").
+	SourceType string `json:"sourceType"`
+
+	// Source is the Forge server URL, git repository URL, or local path the module was resolved from.
+	Source string `json:"source,omitempty"`
+
+	// Ref is the git reference requested for a git sourced module.
+	Ref string `json:"ref,omitempty"`
+
+	// Commit is the git commit a git sourced module was resolved to.
+	Commit string `json:"commit,omitempty"`
+
+	// Dependencies is the full Forge names of the modules this module requires.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
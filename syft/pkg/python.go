@@ -40,10 +40,30 @@ type PythonFileRecord struct {
 	Size   string            `json:"size,omitempty"`
 }
 
+// PythonDirectURLOriginInfo represents where a python package was installed from when it was not installed from a
+// package index, as recorded by the installer within direct_url.json (PEP 610) and by the hooks of editable installs.
 type PythonDirectURLOriginInfo struct {
 	URL      string `json:"url"`
 	CommitID string `json:"commitId,omitempty"`
 	VCS      string `json:"vcs,omitempty"`
+
+	// RequestedRevision is the VCS revision (branch, tag, or ref) that was requested when installing, which CommitID
+	// was resolved from.
+	RequestedRevision string `json:"requestedRevision,omitempty"`
+
+	// Subdirectory is the path of the python project within the VCS repository or archive, when not at the root.
+	Subdirectory string `json:"subdirectory,omitempty"`
+
+	// ArchiveHash is the hash of the archive the package was installed from, in the form "<algorithm>=<value>".
+	ArchiveHash string `json:"archiveHash,omitempty"`
+
+	// Editable indicates that the package was installed in editable (development) mode, so the installed code is
+	// imported directly from the source paths instead of being copied into site-packages.
+	Editable bool `json:"editable,omitempty"`
+
+	// EditablePaths are the source paths made importable by the .pth file or __editable__ finder hooks of an
+	// editable install.
+	EditablePaths []string `json:"editablePaths,omitempty"`
 }
 
 func (m PythonPackage) OwnedFiles() (result []string) {
@@ -92,3 +112,20 @@ type PythonRequirementsEntry struct {
 	URL               string   `json:"url,omitempty" mapstruct:"URL"`
 	Markers           string   `json:"markers,omitempty" mapstruct:"Markers"`
 }
+
+// PythonPipxEntry represents a single package installed with pipx (or injected into the virtual environment of a
+// package installed with pipx), as recorded within the pipx_metadata.json file of the virtual environment.
+type PythonPipxEntry struct {
+	// PackageOrURL is the package specification the package was installed from (e.g. "black", "black==24.2.0", or a URL).
+	PackageOrURL string `json:"packageOrUrl,omitempty"`
+
+	// Apps are the names of the applications exposed by the package (e.g. linked within ~/.local/bin).
+	Apps []string `json:"apps,omitempty"`
+
+	// PythonVersion is the version of the interpreter of the virtual environment (e.g. "Python 3.11.8").
+	PythonVersion string `json:"pythonVersion,omitempty"`
+
+	// InjectedInto is the name of the package whose virtual environment the package was injected into, when the package
+	// was installed with `pipx inject` rather than `pipx install`.
+	InjectedInto string `json:"injectedInto,omitempty"`
+}
@@ -0,0 +1,58 @@
+package pkg
+
+import (
+	"sort"
+	"strings"
+)
+
+// ReachabilityAnnotationKey is the location annotation holding hints (comma separated) of how the package is reachable
+// at runtime.
+const ReachabilityAnnotationKey = "reachability"
+
+// EntrypointClosureReachability denotes a package with files that are reachable from the executables run by the
+// entrypoint (or cmd) of a container image, either directly, via dynamic linking, or via script interpreters.
+const EntrypointClosureReachability = "entrypoint-closure"
+
+// ImportedReachability denotes a package that is imported by first-party source code (as found by static analysis of
+// the imports within Python, JavaScript, and Ruby source files).
+const ImportedReachability = "imported"
+
+// Reachability returns the sorted, unique set of reachability hints across all locations of the package.
+func Reachability(p Package) []string {
+	set := make(map[string]struct{})
+	for _, l := range p.Locations.ToSlice() {
+		value := l.Annotations[ReachabilityAnnotationKey]
+		if value == "" {
+			continue
+		}
+		for _, r := range strings.Split(value, ",") {
+			set[r] = struct{}{}
+		}
+	}
+
+	var hints []string
+	for r := range set {
+		hints = append(hints, r)
+	}
+	sort.Strings(hints)
+	return hints
+}
+
+// InEntrypointClosure indicates if any location of the package is annotated as within the entrypoint closure.
+func InEntrypointClosure(p Package) bool {
+	return hasReachability(p, EntrypointClosureReachability)
+}
+
+// IsImported indicates if any location of the package is annotated as imported by first-party source code.
+func IsImported(p Package) bool {
+	return hasReachability(p, ImportedReachability)
+}
+
+func hasReachability(p Package, hint string) bool {
+	for _, r := range Reachability(p) {
+		if r == hint {
+			return true
+		}
+	}
+	return false
+}
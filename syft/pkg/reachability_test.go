@@ -0,0 +1,36 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestInEntrypointClosure(t *testing.T) {
+	assert.False(t, InEntrypointClosure(Package{
+		Locations: file.NewLocationSet(file.NewLocation("/usr/bin/curl")),
+	}))
+	assert.True(t, InEntrypointClosure(Package{
+		Locations: file.NewLocationSet(
+			file.NewLocation("/usr/bin/curl"),
+			file.NewLocation("/usr/bin/app").WithAnnotation(ReachabilityAnnotationKey, EntrypointClosureReachability),
+		),
+	}))
+}
+
+func TestReachability(t *testing.T) {
+	p := Package{
+		Locations: file.NewLocationSet(
+			file.NewLocation("/app/requirements.txt"),
+			file.NewLocation("/usr/lib/python3/dist-packages/requests/__init__.py").WithAnnotation(ReachabilityAnnotationKey, "imported,entrypoint-closure"),
+			file.NewLocation("/usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA").WithAnnotation(ReachabilityAnnotationKey, "imported"),
+		),
+	}
+
+	assert.Equal(t, []string{EntrypointClosureReachability, ImportedReachability}, Reachability(p))
+	assert.True(t, InEntrypointClosure(p))
+	assert.True(t, IsImported(p))
+	assert.Empty(t, Reachability(Package{}))
+}
@@ -65,3 +65,46 @@ func (m RpmDBEntry) OwnedFiles() (result []string) {
 	sort.Strings(result)
 	return result
 }
+
+// RpmSourceEntry represents an RPM source package, as described by either a .spec file or the header of a source RPM
+// (.src.rpm).
+type RpmSourceEntry struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Epoch   *int   `json:"epoch" cyclonedx:"epoch" jsonschema:"nullable"`
+	Release string `json:"release" cyclonedx:"release"`
+
+	// URL is the upstream project URL (the URL tag).
+	URL string `json:"url,omitempty"`
+
+	Vendor   string `json:"vendor,omitempty"`
+	Packager string `json:"packager,omitempty"`
+
+	// Binaries are the names of the binary packages built from the source package (only known from .spec files).
+	Binaries []string `json:"binaries,omitempty"`
+
+	// BuildRequires are the capabilities needed to build the package (e.g. "openssl-devel >= 1.1").
+	BuildRequires []string `json:"buildRequires,omitempty"`
+
+	// Sources are the source files of the package (the SourceN tags), which are URLs or file names.
+	Sources []string `json:"sources,omitempty"`
+
+	// Patches are the patch files applied to the sources (the PatchN tags).
+	Patches []string `json:"patches,omitempty"`
+}
+
+// RpmBuildRequirementEntry represents a single build requirement (BuildRequires) declared by an RPM source package.
+type RpmBuildRequirementEntry struct {
+	// VersionConstraint is the version relation that the requirement must satisfy (e.g. ">= 1.2").
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+}
+
+// RpmSourceArchiveEntry represents an archive (typically an upstream release tarball) that is a source of an RPM
+// source package, where the name and version are derived from the archive file name.
+type RpmSourceArchiveEntry struct {
+	// Source is the source as declared by the package (a URL or file name).
+	Source string `json:"source"`
+
+	// Digest is the digest of the archive as recorded within a source RPM.
+	Digest *file.Digest `json:"digest,omitempty"`
+}
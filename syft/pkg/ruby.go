@@ -22,4 +22,12 @@ type RubyGemspec struct {
 	Files    []string `mapstructure:"files" json:"files,omitempty"`
 	Authors  []string `mapstructure:"authors" json:"authors,omitempty"`
 	Homepage string   `mapstructure:"homepage" json:"homepage,omitempty"`
+
+	// Default indicates that the gem is a default gem shipped with the Ruby interpreter (described within
+	// "specifications/default"), which is part of the standard library and cannot be uninstalled.
+	Default bool `mapstructure:"default" json:"default,omitempty"`
+
+	// BundlePath is the directory of the Bundler deployment that the gem was installed into (e.g. "/app/vendor/bundle"),
+	// for gems vendored alongside an application rather than installed into the system gem home.
+	BundlePath string `mapstructure:"bundlePath" json:"bundlePath,omitempty"`
 }
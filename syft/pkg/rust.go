@@ -13,3 +13,31 @@ type RustBinaryAuditEntry struct {
 	Version string `toml:"version" json:"version"`
 	Source  string `toml:"source" json:"source"`
 }
+
+// RustCargoInstallEntry represents a single crate installed with `cargo install`, as recorded within the
+// .crates2.json file of the cargo home directory (e.g. ~/.cargo/.crates2.json).
+type RustCargoInstallEntry struct {
+	// Source is the source the crate was installed from (e.g. "registry+https://github.com/rust-lang/crates.io-index").
+	Source string `json:"source"`
+
+	// Bins are the names of the binaries installed into the bin directory of the cargo home directory.
+	Bins []string `json:"bins,omitempty"`
+
+	// Features are the features explicitly enabled when the crate was built.
+	Features []string `json:"features,omitempty"`
+
+	// AllFeatures is true when the crate was built with all features enabled.
+	AllFeatures bool `json:"allFeatures,omitempty"`
+
+	// NoDefaultFeatures is true when the crate was built without its default features.
+	NoDefaultFeatures bool `json:"noDefaultFeatures,omitempty"`
+
+	// Profile is the build profile the crate was built with (e.g. "release").
+	Profile string `json:"profile,omitempty"`
+
+	// Target is the target triple the crate was built for (e.g. "x86_64-unknown-linux-gnu").
+	Target string `json:"target,omitempty"`
+
+	// Rustc is the version of the compiler the crate was built with (e.g. "rustc 1.76.0 (07dca489a 2024-02-04)").
+	Rustc string `json:"rustc,omitempty"`
+}
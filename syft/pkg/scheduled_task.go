@@ -0,0 +1,38 @@
+package pkg
+
+const (
+	// CronScheduler is for tasks run by cron, from crontab files or the periodic script directories (e.g. /etc/cron.daily).
+	CronScheduler = "cron"
+
+	// SystemdTimerScheduler is for services activated by systemd timer units.
+	SystemdTimerScheduler = "systemd-timer"
+
+	// RCScheduler is for startup scripts run by SysV-style init (e.g. /etc/init.d/* and /etc/rc.local).
+	RCScheduler = "rc"
+)
+
+// ScheduledTaskEntry represents a task run on a schedule (or at startup) by the OS: a cron job, a systemd timer, or an
+// rc startup script.
+type ScheduledTaskEntry struct {
+	// Scheduler is what runs the task: "cron", "systemd-timer", or "rc".
+	Scheduler string `json:"scheduler"`
+
+	// Schedule is when the task runs: a cron expression (e.g. "17 * * * *" or "@daily") or the timer settings of a
+	// systemd timer (e.g. "OnCalendar=daily"). Startup scripts have no schedule.
+	Schedule string `json:"schedule,omitempty"`
+
+	// User is the account the task runs as (only known for cron jobs).
+	User string `json:"user,omitempty"`
+
+	// Command is the command line run by the task (the crontab command or the ExecStart of the activated service).
+	Command string `json:"command,omitempty"`
+
+	// Executable is the absolute path of the program run by the task, when it can be determined.
+	Executable string `json:"executable,omitempty"`
+
+	// Unit is the service unit activated by a systemd timer.
+	Unit string `json:"unit,omitempty"`
+
+	// Description is the description of a systemd timer or the LSB header description of an init script.
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,33 @@
+package pkg
+
+// SnapEntry represents a snap (a squashfs image holding an application along with the libraries that it depends on, as
+// distributed by the Snap Store), as described by the meta/snap.yaml file within the snap.
+type SnapEntry struct {
+	// Name is the name of the snap
+	Name string `json:"name"`
+
+	// Version is the version of the software within the snap, as declared by the snap publisher
+	Version string `json:"version"`
+
+	// Revision is the store revision of the snap, taken from the name of the snap file (e.g. "42" for
+	// "/var/lib/snapd/snaps/hello_42.snap"), where revisions of locally installed snaps are prefixed with "x"
+	Revision string `json:"revision,omitempty"`
+
+	// SnapType is the type of the snap (e.g. "app", "base", "core", "gadget", "kernel", or "snapd")
+	SnapType string `json:"snapType,omitempty"`
+
+	// Base is the base snap providing the runtime environment of the snap (e.g. "core22")
+	Base string `json:"base,omitempty"`
+
+	// Grade is the release quality of the snap ("stable" or "devel")
+	Grade string `json:"grade,omitempty"`
+
+	// Confinement is the security confinement of the snap ("strict", "classic", or "devmode")
+	Confinement string `json:"confinement,omitempty"`
+
+	// Architectures are the architectures that the snap was built for
+	Architectures []string `json:"architectures,omitempty"`
+
+	// Summary is the short description of the snap
+	Summary string `json:"summary,omitempty"`
+}
@@ -0,0 +1,22 @@
+package pkg
+
+// TyposquatAnnotationKey is the location annotation holding the name of the popular package that the name of the
+// package is a near-miss of (e.g. "requests" for "reqeusts").
+const TyposquatAnnotationKey = "typosquatOf"
+
+// DependencyConfusionAnnotationKey is the location annotation holding the higher version of an internal package that
+// exists on a public registry under the same name, which a package manager may resolve in place of the internal
+// package.
+const DependencyConfusionAnnotationKey = "dependencyConfusion"
+
+// TyposquatOf returns the name of the popular package that the package may be a typosquat of (or an empty string when
+// the package has not been flagged).
+func TyposquatOf(p Package) string {
+	return firstAnnotation(p, TyposquatAnnotationKey)
+}
+
+// DependencyConfusion returns the higher version of the package found on a public registry (or an empty string when
+// the package has not been flagged).
+func DependencyConfusion(p Package) string {
+	return firstAnnotation(p, DependencyConfusionAnnotationKey)
+}
@@ -0,0 +1,29 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestSupplyChainAnnotations(t *testing.T) {
+	typosquat := Package{
+		Name: "reqeusts",
+		Locations: file.NewLocationSet(
+			file.NewLocation("/app/requirements.txt").WithAnnotation(TyposquatAnnotationKey, "requests"),
+		),
+	}
+	assert.Equal(t, "requests", TyposquatOf(typosquat))
+	assert.Empty(t, DependencyConfusion(typosquat))
+
+	confused := Package{
+		Name: "lodash",
+		Locations: file.NewLocationSet(
+			file.NewLocation("/app/package-lock.json").WithAnnotation(DependencyConfusionAnnotationKey, "4.17.21"),
+		),
+	}
+	assert.Equal(t, "4.17.21", DependencyConfusion(confused))
+	assert.Empty(t, TyposquatOf(confused))
+}
@@ -0,0 +1,23 @@
+package pkg
+
+// TeXLiveTlpdbEntry represents a single package entry within a TeX Live package database (tlpkg/texlive.tlpdb).
+type TeXLiveTlpdbEntry struct {
+	// Category is the kind of TeX Live package (e.g. "Package", "Collection", "Scheme", or "TLCore").
+	Category string `json:"category"`
+
+	// Revision is the TeX Live repository revision of the package, which is what tlmgr updates are based on.
+	Revision int `json:"revision"`
+
+	// ShortDescription is the one-line description of the package.
+	ShortDescription string `json:"shortDescription,omitempty"`
+
+	// CatalogueVersion is the upstream version of the package as recorded in the CTAN catalogue (not all packages
+	// have one, in which case the package version is the revision).
+	CatalogueVersion string `json:"catalogueVersion,omitempty"`
+
+	// CTANPath is the location of the package within the CTAN archive (e.g. "/macros/latex/required/amsmath").
+	CTANPath string `json:"ctanPath,omitempty"`
+
+	// Depends is the names of the TeX Live packages this package depends on.
+	Depends []string `json:"depends,omitempty"`
+}
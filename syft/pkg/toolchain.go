@@ -0,0 +1,16 @@
+package pkg
+
+// ToolchainEntry represents a version of a developer tool (such as a language runtime or build tool) that is declared
+// within a version manager configuration (asdf .tool-versions or mise.toml) or installed by a version manager (SDKMAN).
+type ToolchainEntry struct {
+	// Manager is the version manager the tool is declared for or installed by ("asdf", "mise", or "sdkman").
+	Manager string `json:"manager"`
+
+	// Backend is the backend the tool is installed from, when stated by a mise tool name (e.g. "npm" for
+	// "npm:prettier").
+	Backend string `json:"backend,omitempty"`
+
+	// Default is true when the version is the one used by default, being the first version declared for the tool (with
+	// the remaining versions as fallbacks) or the current version of an SDKMAN candidate.
+	Default bool `json:"default,omitempty"`
+}
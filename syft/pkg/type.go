@@ -11,75 +11,157 @@ const (
 	// the full set of supported packages
 	UnknownPkg              Type = "UnknownPackage"
 	AlpmPkg                 Type = "alpm"
+	AndroidAppPkg           Type = "android-app"
+	AnsibleCollectionPkg    Type = "ansible-collection"
+	AnsibleRolePkg          Type = "ansible-role"
+	APIServicePkg           Type = "api-service"
 	ApkPkg                  Type = "apk"
+	BazelModulePkg          Type = "bazel-module"
 	BinaryPkg               Type = "binary"
+	BrowserExtensionPkg     Type = "browser-extension"
+	BuckPkg                 Type = "buck"
+	CarthagePkg             Type = "carthage"
+	ChefCookbookPkg         Type = "chef-cookbook"
 	CocoapodsPkg            Type = "pod"
 	ConanPkg                Type = "conan"
+	ContainerImagePkg       Type = "container-image"
+	CryptoAssetPkg          Type = "crypto-asset"
 	DartPubPkg              Type = "dart-pub"
 	DebPkg                  Type = "deb"
+	DevContainerFeaturePkg  Type = "devcontainer-feature"
 	DotnetPkg               Type = "dotnet"
+	EmacsPackagePkg         Type = "emacs-package"
 	ErlangOTPPkg            Type = "erlang-otp"
+	FirmwarePkg             Type = "firmware"
 	GemPkg                  Type = "gem"
 	GithubActionPkg         Type = "github-action"
 	GithubActionWorkflowPkg Type = "github-action-workflow"
 	GoModulePkg             Type = "go-module"
 	GraalVMNativeImagePkg   Type = "graalvm-native-image"
 	HackagePkg              Type = "hackage"
+	HelmChartPkg            Type = "helm-chart"
 	HexPkg                  Type = "hex"
+	IOSAppPkg               Type = "ios-app"
+	IOSFrameworkPkg         Type = "ios-framework"
 	JavaPkg                 Type = "java-archive"
 	JenkinsPluginPkg        Type = "jenkins-plugin"
+	JetBrainsPluginPkg      Type = "jetbrains-plugin"
 	KbPkg                   Type = "msrc-kb"
 	LinuxKernelPkg          Type = "linux-kernel"
 	LinuxKernelModulePkg    Type = "linux-kernel-module"
+	MacOSAppPkg             Type = "macos-app"
+	MacOSFrameworkPkg       Type = "macos-framework"
+	MacOSInstallerPkg       Type = "macos-pkg"
+	MesonWrapPkg            Type = "meson-wrap"
+	MLModelPkg              Type = "ml-model"
+	NetworkServicePkg       Type = "network-service"
 	NixPkg                  Type = "nix"
 	NpmPkg                  Type = "npm"
+	OSGiBundlePkg           Type = "osgi-bundle"
+	OSAccountPkg            Type = "os-account"
 	PhpComposerPkg          Type = "php-composer"
 	PhpPeclPkg              Type = "php-pecl"
 	PortagePkg              Type = "portage"
+	PulumiPluginPkg         Type = "pulumi-plugin"
+	PuppetModulePkg         Type = "puppet-module"
 	PythonPkg               Type = "python"
 	Rpkg                    Type = "R-package"
 	LuaRocksPkg             Type = "lua-rocks"
 	RpmPkg                  Type = "rpm"
 	RustPkg                 Type = "rust-crate"
+	ScheduledTaskPkg        Type = "scheduled-task"
+	SnapPkg                 Type = "snap"
 	SwiftPkg                Type = "swift"
 	SwiplPackPkg            Type = "swiplpack"
+	TeXLivePkg              Type = "texlive"
+	ToolchainPkg            Type = "toolchain"
+	UnityPkg                Type = "unity"
+	UnrealPkg               Type = "unreal"
+	VimPluginPkg            Type = "vim-plugin"
+	VSCodeExtensionPkg      Type = "vscode-extension"
+	WasmModulePkg           Type = "wasm-module"
+	WindowsAssemblyPkg      Type = "windows-assembly"
+	WindowsDriverPkg        Type = "windows-driver"
+	WindowsInstallerPkg     Type = "windows-installer"
+	WindowsMSIPkg           Type = "windows-msi"
 	WordpressPluginPkg      Type = "wordpress-plugin"
 )
 
 // AllPkgs represents all supported package types
 var AllPkgs = []Type{
 	AlpmPkg,
+	AndroidAppPkg,
+	AnsibleCollectionPkg,
+	AnsibleRolePkg,
+	APIServicePkg,
 	ApkPkg,
+	BazelModulePkg,
 	BinaryPkg,
+	BrowserExtensionPkg,
+	BuckPkg,
+	CarthagePkg,
+	ChefCookbookPkg,
 	CocoapodsPkg,
 	ConanPkg,
+	ContainerImagePkg,
+	CryptoAssetPkg,
 	DartPubPkg,
 	DebPkg,
+	DevContainerFeaturePkg,
 	DotnetPkg,
+	EmacsPackagePkg,
 	ErlangOTPPkg,
+	FirmwarePkg,
 	GemPkg,
 	GithubActionPkg,
 	GithubActionWorkflowPkg,
 	GoModulePkg,
 	HackagePkg,
+	HelmChartPkg,
 	HexPkg,
+	IOSAppPkg,
+	IOSFrameworkPkg,
 	JavaPkg,
 	JenkinsPluginPkg,
+	JetBrainsPluginPkg,
 	KbPkg,
 	LinuxKernelPkg,
 	LinuxKernelModulePkg,
+	MacOSAppPkg,
+	MacOSFrameworkPkg,
+	MacOSInstallerPkg,
+	MesonWrapPkg,
+	MLModelPkg,
+	NetworkServicePkg,
 	NixPkg,
 	NpmPkg,
+	OSGiBundlePkg,
+	OSAccountPkg,
 	PhpComposerPkg,
 	PhpPeclPkg,
 	PortagePkg,
+	PulumiPluginPkg,
+	PuppetModulePkg,
 	PythonPkg,
 	Rpkg,
 	LuaRocksPkg,
 	RpmPkg,
 	RustPkg,
+	ScheduledTaskPkg,
+	SnapPkg,
 	SwiftPkg,
 	SwiplPackPkg,
+	TeXLivePkg,
+	ToolchainPkg,
+	UnityPkg,
+	UnrealPkg,
+	VimPluginPkg,
+	VSCodeExtensionPkg,
+	WasmModulePkg,
+	WindowsAssemblyPkg,
+	WindowsDriverPkg,
+	WindowsInstallerPkg,
+	WindowsMSIPkg,
 	WordpressPluginPkg,
 }
 
@@ -90,22 +172,48 @@ func (t Type) PackageURLType() string {
 	switch t {
 	case AlpmPkg:
 		return "alpm"
+	case AndroidAppPkg:
+		return "android"
+	case AnsibleCollectionPkg:
+		return "ansible-collection"
+	case AnsibleRolePkg:
+		return "ansible-role"
 	case ApkPkg:
 		return packageurl.TypeAlpine
+	case BazelModulePkg:
+		return "bazel"
+	case BrowserExtensionPkg:
+		return "browser-extension"
+	case BuckPkg:
+		return "buck"
+	case CarthagePkg:
+		return "carthage"
+	case ChefCookbookPkg:
+		return "chef"
 	case CocoapodsPkg:
 		return packageurl.TypeCocoapods
 	case ConanPkg:
 		return packageurl.TypeConan
+	case ContainerImagePkg:
+		return packageurl.TypeOCI
 	case DartPubPkg:
 		return packageurl.TypePub
 	case DebPkg:
 		return "deb"
+	case DevContainerFeaturePkg:
+		return packageurl.TypeOCI
 	case DotnetPkg:
 		return "dotnet"
+	case EmacsPackagePkg:
+		return "emacs-package"
 	case ErlangOTPPkg:
 		return packageurl.TypeOTP
+	case FirmwarePkg:
+		return packageurl.TypeGeneric
 	case GemPkg:
 		return packageurl.TypeGem
+	case HelmChartPkg:
+		return "helm"
 	case HexPkg:
 		return packageurl.TypeHex
 	case GithubActionPkg, GithubActionWorkflowPkg:
@@ -115,12 +223,26 @@ func (t Type) PackageURLType() string {
 		return packageurl.TypeGolang
 	case HackagePkg:
 		return packageurl.TypeHackage
+	case IOSAppPkg:
+		return "ios"
+	case IOSFrameworkPkg:
+		return packageurl.TypeGeneric
 	case JavaPkg, JenkinsPluginPkg:
 		return packageurl.TypeMaven
+	case JetBrainsPluginPkg:
+		return "jetbrains-plugin"
 	case LinuxKernelPkg:
 		return "generic/linux-kernel"
 	case LinuxKernelModulePkg:
 		return packageurl.TypeGeneric
+	case MacOSAppPkg:
+		return "macos"
+	case MacOSFrameworkPkg, MacOSInstallerPkg:
+		return packageurl.TypeGeneric
+	case MesonWrapPkg:
+		return "meson"
+	case MLModelPkg:
+		return packageurl.TypeGeneric
 	case PhpComposerPkg:
 		return packageurl.TypeComposer
 	case PhpPeclPkg:
@@ -129,10 +251,17 @@ func (t Type) PackageURLType() string {
 		return packageurl.TypePyPi
 	case PortagePkg:
 		return "portage"
+	case PulumiPluginPkg:
+		return "pulumi"
+	case PuppetModulePkg:
+		return "puppet"
 	case NixPkg:
 		return "nix"
 	case NpmPkg:
 		return packageurl.TypeNPM
+	case OSGiBundlePkg:
+		// there is no package URL type for OSGi bundles (which are not necessarily published to maven repositories)
+		return packageurl.TypeGeneric
 	case Rpkg:
 		return packageurl.TypeCran
 	case LuaRocksPkg:
@@ -141,10 +270,38 @@ func (t Type) PackageURLType() string {
 		return packageurl.TypeRPM
 	case RustPkg:
 		return "cargo"
+	case SnapPkg:
+		return "snap"
 	case SwiftPkg:
 		return packageurl.TypeSwift
 	case SwiplPackPkg:
 		return "swiplpack"
+	case TeXLivePkg:
+		return "texlive"
+	case ToolchainPkg:
+		return packageurl.TypeGeneric
+	case UnityPkg:
+		return "unity"
+	case UnrealPkg:
+		return "unreal"
+	case VimPluginPkg:
+		return "vim-plugin"
+	case VSCodeExtensionPkg:
+		return "vscode-extension"
+	case WasmModulePkg:
+		return "wasm"
+	case WindowsAssemblyPkg:
+		// there is no package URL type for Windows side-by-side or GAC assemblies
+		return packageurl.TypeGeneric
+	case WindowsDriverPkg:
+		// there is no package URL type for Windows driver packages
+		return packageurl.TypeGeneric
+	case WindowsInstallerPkg:
+		// there is no package URL type for setup programs
+		return packageurl.TypeGeneric
+	case WindowsMSIPkg:
+		// there is no package URL type for Windows Installer packages
+		return packageurl.TypeGeneric
 	case WordpressPluginPkg:
 		return "wordpress-plugin"
 	default:
@@ -160,8 +317,13 @@ func TypeFromPURL(p string) Type {
 	}
 
 	ptype := purl.Type
-	if ptype == "generic" {
+	switch ptype {
+	case "generic":
 		ptype = purl.Name
+	case packageurl.TypeOCI:
+		if t := purl.Qualifiers.Map()[PURLQualifierType]; t != "" {
+			ptype = t
+		}
 	}
 	return TypeByName(ptype)
 }
@@ -177,8 +339,24 @@ func TypeByName(name string) Type {
 		return LuaRocksPkg
 	case "alpm":
 		return AlpmPkg
+	case "android", "android-app":
+		return AndroidAppPkg
+	case "ansible-collection":
+		return AnsibleCollectionPkg
+	case "ansible-role":
+		return AnsibleRolePkg
 	case packageurl.TypeAlpine, "alpine":
 		return ApkPkg
+	case "bazel":
+		return BazelModulePkg
+	case "browser-extension":
+		return BrowserExtensionPkg
+	case "buck":
+		return BuckPkg
+	case "carthage":
+		return CarthagePkg
+	case "chef", "chef-cookbook":
+		return ChefCookbookPkg
 	case packageurl.TypeMaven:
 		return JavaPkg
 	case packageurl.TypeComposer:
@@ -199,30 +377,86 @@ func TypeByName(name string) Type {
 		return DartPubPkg
 	case "dotnet": // here to support legacy use cases
 		return DotnetPkg
+	case "emacs-package":
+		return EmacsPackagePkg
 	case packageurl.TypeCocoapods:
 		return CocoapodsPkg
 	case packageurl.TypeConan:
 		return ConanPkg
+	case packageurl.TypeOCI, "container-image":
+		return ContainerImagePkg
+	case "devcontainer-feature":
+		return DevContainerFeaturePkg
 	case packageurl.TypeHackage:
 		return HackagePkg
 	case "portage":
 		return PortagePkg
+	case "pulumi", "pulumi-plugin":
+		return PulumiPluginPkg
+	case "puppet", "puppet-module":
+		return PuppetModulePkg
+	case "helm", "helm-chart":
+		return HelmChartPkg
 	case packageurl.TypeHex:
 		return HexPkg
+	case "ios", "ios-app":
+		return IOSAppPkg
+	case "ios-framework":
+		return IOSFrameworkPkg
+	case "jetbrains-plugin":
+		return JetBrainsPluginPkg
 	case packageurl.TypeOTP:
 		return ErlangOTPPkg
 	case "linux-kernel":
 		return LinuxKernelPkg
 	case "linux-kernel-module":
 		return LinuxKernelModulePkg
+	case "macos", "macos-app":
+		return MacOSAppPkg
+	case "macos-framework":
+		return MacOSFrameworkPkg
+	case "macos-pkg":
+		return MacOSInstallerPkg
+	case "meson":
+		return MesonWrapPkg
+	case "ml-model":
+		return MLModelPkg
+	case "firmware":
+		return FirmwarePkg
 	case "nix":
 		return NixPkg
+	case "osgi-bundle":
+		return OSGiBundlePkg
 	case packageurl.TypeCran:
 		return Rpkg
 	case packageurl.TypeSwift:
 		return SwiftPkg
+	case "snap":
+		return SnapPkg
 	case "swiplpack":
 		return SwiplPackPkg
+	case "texlive":
+		return TeXLivePkg
+	case "toolchain":
+		return ToolchainPkg
+	case "unity":
+		return UnityPkg
+	case "unreal":
+		return UnrealPkg
+	case "vim-plugin":
+		return VimPluginPkg
+	case "vscode-extension":
+		return VSCodeExtensionPkg
+	case "wasm", "wasm-module":
+		return WasmModulePkg
+	case "windows-assembly":
+		return WindowsAssemblyPkg
+	case "windows-driver":
+		return WindowsDriverPkg
+	case "windows-installer":
+		return WindowsInstallerPkg
+	case "windows-msi":
+		return WindowsMSIPkg
 	case "wordpress-plugin":
 		return WordpressPluginPkg
 	default:
@@ -87,6 +87,10 @@ func TestTypeFromPURL(t *testing.T) {
 			purl:     "pkg:hex/hpax/hpax@0.1.1",
 			expected: HexPkg,
 		},
+		{
+			purl:     "pkg:jetbrains-plugin/org.jetbrains.kotlin@241.14494.240-IJ",
+			expected: JetBrainsPluginPkg,
+		},
 		{
 			purl:     "pkg:otp/accept@0.3.5",
 			expected: ErlangOTPPkg,
@@ -115,6 +119,102 @@ func TestTypeFromPURL(t *testing.T) {
 			purl:     "pkg:swiplpack/condition@0.1.1",
 			expected: SwiplPackPkg,
 		},
+		{
+			purl:     "pkg:bazel/rules_cc@0.0.9",
+			expected: BazelModulePkg,
+		},
+		{
+			purl:     "pkg:buck/zlib@1.3.1",
+			expected: BuckPkg,
+		},
+		{
+			purl:     "pkg:browser-extension/chromium/cjpalhdlnbpafiamejdnhcphjbkeiagm@1.55.0",
+			expected: BrowserExtensionPkg,
+		},
+		{
+			purl:     "pkg:meson/zlib@1.3.1",
+			expected: MesonWrapPkg,
+		},
+		{
+			purl:     "pkg:carthage/github.com/Alamofire/Alamofire@5.6.4",
+			expected: CarthagePkg,
+		},
+		{
+			purl:     "pkg:snap/contoso-agent@3.1.0",
+			expected: SnapPkg,
+		},
+		{
+			purl:     "pkg:unity/com.unity.textmeshpro@3.0.6",
+			expected: UnityPkg,
+		},
+		{
+			purl:     "pkg:unreal/LowEntryExtStdLib@3.4.0",
+			expected: UnrealPkg,
+		},
+		{
+			purl:     "pkg:vscode-extension/ms-python/python@2024.0.1",
+			expected: VSCodeExtensionPkg,
+		},
+		{
+			purl:     "pkg:wasm/hello-component@0.1.0",
+			expected: WasmModulePkg,
+		},
+		{
+			purl:     "pkg:android/com.example.app@1.2.3",
+			expected: AndroidAppPkg,
+		},
+		{
+			purl:     "pkg:ios/com.example.app@1.2.3",
+			expected: IOSAppPkg,
+		},
+		{
+			purl:     "pkg:macos/com.example.app@1.2.3",
+			expected: MacOSAppPkg,
+		},
+		{
+			purl:     "pkg:vim-plugin/telescope.nvim@a0bbec21143c7bc5f8bb02e0005fa0b982edc026",
+			expected: VimPluginPkg,
+		},
+		{
+			purl:     "pkg:emacs-package/magit@4992c3d1f64e0e983692c7a61d47069f47380dbf",
+			expected: EmacsPackagePkg,
+		},
+		{
+			purl:     "pkg:texlive/amsmath@2.17t",
+			expected: TeXLivePkg,
+		},
+		{
+			purl:     "pkg:chef/apt@7.5.22",
+			expected: ChefCookbookPkg,
+		},
+		{
+			purl:     "pkg:oci/nginx@sha256:8f1c4b2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b?repository_url=docker.io/library/nginx&tag=1.27",
+			expected: ContainerImagePkg,
+		},
+		{
+			purl:     "pkg:oci/go?repository_url=ghcr.io/devcontainers/features/go&tag=1&type=devcontainer-feature",
+			expected: DevContainerFeaturePkg,
+		},
+		{
+			purl:     "pkg:helm/postgresql@15.5.0?repository_url=https://charts.bitnami.com/bitnami",
+			expected: HelmChartPkg,
+		},
+		{
+			purl:     "pkg:pulumi/aws@6.45.0?kind=resource",
+			expected: PulumiPluginPkg,
+		},
+		{
+			purl:     "pkg:puppet/puppetlabs/stdlib@9.6.0",
+			expected: PuppetModulePkg,
+		},
+		{
+			purl:     "pkg:ansible-collection/community/general@8.6.0",
+			expected: AnsibleCollectionPkg,
+		},
+		{
+			purl:     "pkg:ansible-role/geerlingguy/docker@7.0.2",
+			expected: AnsibleRolePkg,
+		},
 	}
 
 	var pkgTypes []string
@@ -123,15 +223,27 @@ func TestTypeFromPURL(t *testing.T) {
 		expectedTypes.Add(string(ty))
 	}
 
-	// testing microsoft packages and jenkins-plugins and custom binary type
+	// testing microsoft packages and jenkins-plugins and custom binary type (and API services, crypto assets, and host inventory)
 	// is not valid for purl at this time
 	expectedTypes.Remove(string(KbPkg))
 	expectedTypes.Remove(string(JenkinsPluginPkg))
 	expectedTypes.Remove(string(PortagePkg))
 	expectedTypes.Remove(string(BinaryPkg))
 	expectedTypes.Remove(string(LinuxKernelModulePkg))
+	expectedTypes.Remove(string(IOSFrameworkPkg))
+	expectedTypes.Remove(string(MacOSFrameworkPkg), string(MacOSInstallerPkg))
+	expectedTypes.Remove(string(WindowsAssemblyPkg), string(WindowsDriverPkg), string(WindowsInstallerPkg), string(WindowsMSIPkg))
+	expectedTypes.Remove(string(MLModelPkg))
+	expectedTypes.Remove(string(FirmwarePkg))
+	expectedTypes.Remove(string(ToolchainPkg))
+	expectedTypes.Remove(string(OSGiBundlePkg))
 	expectedTypes.Remove(string(GithubActionPkg), string(GithubActionWorkflowPkg))
 	expectedTypes.Remove(string(WordpressPluginPkg))
+	expectedTypes.Remove(string(APIServicePkg))
+	expectedTypes.Remove(string(CryptoAssetPkg))
+	expectedTypes.Remove(string(OSAccountPkg))
+	expectedTypes.Remove(string(ScheduledTaskPkg))
+	expectedTypes.Remove(string(NetworkServicePkg))
 
 	for _, test := range tests {
 		t.Run(string(test.expected), func(t *testing.T) {
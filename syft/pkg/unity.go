@@ -0,0 +1,33 @@
+package pkg
+
+// UnityPackagesLockEntry is a struct that represents a single resolved package found in a Unity Packages/packages-lock.json file.
+type UnityPackagesLockEntry struct {
+	// Version is the resolved version as written in the lock file, which is a URL or file path for non-registry sources.
+	Version string `mapstructure:"version" json:"version"`
+
+	// Source is where the package was resolved from: "registry", "builtin", "git", "embedded", "local", or "local-tarball".
+	Source string `mapstructure:"source" json:"source"`
+
+	// Depth is the depth of the package within the dependency graph (0 for packages declared in the manifest).
+	Depth int `mapstructure:"depth" json:"depth"`
+
+	// URL is the URL of the registry the package was resolved from.
+	URL string `mapstructure:"url" json:"url,omitempty"`
+
+	// Hash is the commit hash the package was resolved to (git sources only).
+	Hash string `mapstructure:"hash" json:"hash,omitempty"`
+
+	Dependencies map[string]string `mapstructure:"dependencies" json:"dependencies,omitempty"`
+}
+
+// UnityManifestEntry is a struct that represents a single dependency declared in a Unity Packages/manifest.json file.
+type UnityManifestEntry struct {
+	// Version is the version as declared in the manifest, which is a URL or file path for non-registry sources.
+	Version string `mapstructure:"version" json:"version"`
+
+	// Source is where the package is resolved from: "registry", "git", "local", or "local-tarball".
+	Source string `mapstructure:"source" json:"source"`
+
+	// URL is the URL of the scoped registry the package is resolved from (if any).
+	URL string `mapstructure:"url" json:"url,omitempty"`
+}
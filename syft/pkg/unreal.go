@@ -0,0 +1,59 @@
+package pkg
+
+// UnrealPluginEntry is a struct that represents an Unreal Engine plugin as described by a .uplugin file.
+type UnrealPluginEntry struct {
+	// FriendlyName is the display name of the plugin.
+	FriendlyName string `mapstructure:"friendlyName" json:"friendlyName,omitempty"`
+
+	// VersionNumber is the (integer) version of the plugin, used by the engine to compare plugin versions.
+	VersionNumber int `mapstructure:"versionNumber" json:"versionNumber,omitempty"`
+
+	// EngineVersion is the version of the engine the plugin is compatible with.
+	EngineVersion string `mapstructure:"engineVersion" json:"engineVersion,omitempty"`
+
+	Category     string `mapstructure:"category" json:"category,omitempty"`
+	CreatedBy    string `mapstructure:"createdBy" json:"createdBy,omitempty"`
+	CreatedByURL string `mapstructure:"createdByUrl" json:"createdByUrl,omitempty"`
+
+	// MarketplaceURL is the URL of the plugin on the Unreal Engine marketplace (or Fab).
+	MarketplaceURL string `mapstructure:"marketplaceUrl" json:"marketplaceUrl,omitempty"`
+
+	// MarketplaceID is the identifier of the plugin on the marketplace (as found within the marketplace URL).
+	MarketplaceID string `mapstructure:"marketplaceId" json:"marketplaceId,omitempty"`
+
+	IsBetaVersion         bool `mapstructure:"isBetaVersion" json:"isBetaVersion,omitempty"`
+	IsExperimentalVersion bool `mapstructure:"isExperimentalVersion" json:"isExperimentalVersion,omitempty"`
+
+	// Installed indicates the plugin was installed to the engine (e.g. from the marketplace) rather than being part of
+	// a project or the engine itself.
+	Installed bool `mapstructure:"installed" json:"installed,omitempty"`
+
+	// Plugins are the other plugins this plugin depends on.
+	Plugins []UnrealPluginReference `mapstructure:"plugins" json:"plugins,omitempty"`
+}
+
+// UnrealPluginReference is a struct that represents a reference to a plugin from a .uplugin or .uproject file.
+type UnrealPluginReference struct {
+	Name     string `mapstructure:"name" json:"name"`
+	Enabled  bool   `mapstructure:"enabled" json:"enabled"`
+	Optional bool   `mapstructure:"optional" json:"optional,omitempty"`
+
+	// MarketplaceURL is the URL of the plugin on the Unreal Engine marketplace (or Fab), used to prompt for the
+	// installation of the plugin when it is missing.
+	MarketplaceURL string `mapstructure:"marketplaceUrl" json:"marketplaceUrl,omitempty"`
+}
+
+// UnrealProjectPluginEntry is a struct that represents a marketplace plugin enabled by an Unreal Engine project (.uproject
+// file), which is installed to the engine rather than the project.
+type UnrealProjectPluginEntry struct {
+	// EngineAssociation is the version (or identifier) of the engine the project is associated with.
+	EngineAssociation string `mapstructure:"engineAssociation" json:"engineAssociation,omitempty"`
+
+	// MarketplaceURL is the URL of the plugin on the Unreal Engine marketplace (or Fab).
+	MarketplaceURL string `mapstructure:"marketplaceUrl" json:"marketplaceUrl"`
+
+	// MarketplaceID is the identifier of the plugin on the marketplace (as found within the marketplace URL).
+	MarketplaceID string `mapstructure:"marketplaceId" json:"marketplaceId,omitempty"`
+
+	Optional bool `mapstructure:"optional" json:"optional,omitempty"`
+}
@@ -14,9 +14,15 @@ const (
 	PURLQualifierEpoch  = "epoch"
 	PURLQualifierVCSURL = "vcs_url"
 
+	PURLQualifierDownloadURL = "download_url"
+
 	// PURLQualifierUpstream this qualifier is not in the pURL spec, but is used by grype to perform indirect matching based on source information
 	PURLQualifierUpstream = "upstream"
 
+	// PURLQualifierType this qualifier is not in the pURL spec for the "oci" type, but distinguishes OCI artifacts that are
+	// not container images (e.g. "devcontainer-feature"), since all OCI artifacts share the same pURL type
+	PURLQualifierType = "type"
+
 	purlCargoPkgType  = "cargo"
 	purlGradlePkgType = "gradle"
 )
@@ -0,0 +1,140 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type debianVersion struct {
+	raw      string
+	epoch    int
+	upstream string
+	revision string
+}
+
+// parseDebian parses a version of the form "[epoch:]upstream[-revision]" as described by deb-version(7).
+func parseDebian(version string) (Version, error) {
+	v := debianVersion{raw: version}
+	remaining := strings.TrimSpace(version)
+
+	if before, after, ok := strings.Cut(remaining, ":"); ok {
+		epoch, err := strconv.Atoi(before)
+		if err != nil || epoch < 0 {
+			return nil, fmt.Errorf("invalid debian version: %q: epoch is not a number", version)
+		}
+		v.epoch = epoch
+		remaining = after
+	}
+
+	if i := strings.LastIndex(remaining, "-"); i >= 0 {
+		v.upstream, v.revision = remaining[:i], remaining[i+1:]
+		if v.revision == "" {
+			return nil, fmt.Errorf("invalid debian version: %q: empty revision", version)
+		}
+	} else {
+		v.upstream = remaining
+	}
+
+	if v.upstream == "" {
+		return nil, fmt.Errorf("invalid debian version: %q: empty upstream version", version)
+	}
+	if strings.ContainsAny(v.upstream+v.revision, " \t") {
+		return nil, fmt.Errorf("invalid debian version: %q: contains whitespace", version)
+	}
+	return v, nil
+}
+
+func (v debianVersion) Format() Format {
+	return DebianFormat
+}
+
+func (v debianVersion) String() string {
+	return v.raw
+}
+
+// Compare orders versions in the same way as dpkg, comparing the epoch, upstream version, and revision in turn.
+func (v debianVersion) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	o := other.(debianVersion)
+
+	if c := compareInts(v.epoch, o.epoch); c != 0 {
+		return c, nil
+	}
+	if c := compareDebianPart(v.upstream, o.upstream); c != 0 {
+		return c, nil
+	}
+	return compareDebianPart(v.revision, o.revision), nil
+}
+
+// compareDebianPart compares an upstream version or revision, alternating between comparing non-digit portions
+// lexically (where "~" sorts before anything, even the end of the part, and letters sort before non-letters) and
+// digit portions numerically.
+func compareDebianPart(a, b string) int {
+	at := func(s string, i int) byte {
+		if i < len(s) {
+			return s[i]
+		}
+		return 0
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := debianOrder(at(a, i)), debianOrder(at(b, j))
+			if ac != bc {
+				return compareInts(ac, bc)
+			}
+			i++
+			j++
+		}
+
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+
+		firstDiff := 0
+		for i < len(a) && j < len(b) && isDigit(a[i]) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = compareInts(int(a[i]), int(b[j]))
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+func debianOrder(c byte) int {
+	switch {
+	case c == 0, isDigit(c):
+		return 0
+	case isLetter(c):
+		return int(c)
+	case c == '~':
+		return -1
+	}
+	return int(c) + 256
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
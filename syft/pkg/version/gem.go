@@ -0,0 +1,121 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// gemPattern matches versions accepted by rubygems (see Gem::Version::ANCHORED_VERSION_PATTERN).
+var gemPattern = regexp.MustCompile(`^[0-9]+(?:\.[0-9a-zA-Z]+)*(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?$`)
+
+var gemSegmentPattern = regexp.MustCompile(`[0-9]+|[a-zA-Z]+`)
+
+// gemSegment is either a number or a (pre-release) string.
+type gemSegment struct {
+	number  string
+	text    string
+	numeric bool
+}
+
+type gemVersion struct {
+	raw      string
+	segments []gemSegment
+}
+
+// parseGem parses a version in the same way as rubygems (see Gem::Version), where a "-" is treated as a pre-release
+// (e.g. "1.0-rc1" is "1.0.pre.rc1").
+func parseGem(version string) (Version, error) {
+	trimmed := strings.TrimSpace(version)
+	if trimmed == "" {
+		trimmed = "0"
+	}
+	if !gemPattern.MatchString(trimmed) {
+		return nil, fmt.Errorf("invalid gem version: %q", version)
+	}
+	trimmed = strings.ReplaceAll(trimmed, "-", ".pre.")
+
+	var segments []gemSegment
+	for _, s := range gemSegmentPattern.FindAllString(trimmed, -1) {
+		if isDigit(s[0]) {
+			n := strings.TrimLeft(s, "0")
+			if n == "" {
+				n = "0"
+			}
+			segments = append(segments, gemSegment{number: n, numeric: true})
+			continue
+		}
+		segments = append(segments, gemSegment{text: s})
+	}
+
+	return gemVersion{raw: version, segments: canonicalGemSegments(segments)}, nil
+}
+
+// canonicalGemSegments drops zero segments trailing the release part and the pre-release part of a version (e.g.
+// "1.0.0" ---> "1", "1.0.a.0" ---> "1.a"), so that equal versions compare as equal.
+func canonicalGemSegments(segments []gemSegment) []gemSegment {
+	prerelease := len(segments)
+	for i, s := range segments {
+		if !s.numeric {
+			prerelease = i
+			break
+		}
+	}
+
+	trimZeros := func(s []gemSegment) []gemSegment {
+		for len(s) > 0 && s[len(s)-1].numeric && s[len(s)-1].number == "0" {
+			s = s[:len(s)-1]
+		}
+		return s
+	}
+
+	release := trimZeros(append([]gemSegment(nil), segments[:prerelease]...))
+	return append(release, trimZeros(append([]gemSegment(nil), segments[prerelease:]...))...)
+}
+
+func (v gemVersion) Format() Format {
+	return GemFormat
+}
+
+func (v gemVersion) String() string {
+	return v.raw
+}
+
+// Compare orders versions in the same way as rubygems, where segments are compared numerically (or lexically when
+// both are strings), and a version with a string segment is a pre-release of the corresponding version.
+func (v gemVersion) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	o := other.(gemVersion)
+
+	zero := gemSegment{number: "0", numeric: true}
+	for i := 0; i < len(v.segments) || i < len(o.segments); i++ {
+		left, right := zero, zero
+		if i < len(v.segments) {
+			left = v.segments[i]
+		}
+		if i < len(o.segments) {
+			right = o.segments[i]
+		}
+
+		switch {
+		case left.numeric && right.numeric:
+			if c := compareInts(len(left.number), len(right.number)); c != 0 {
+				return c, nil
+			}
+			if c := strings.Compare(left.number, right.number); c != 0 {
+				return c, nil
+			}
+		case left.numeric:
+			return 1, nil
+		case right.numeric:
+			return -1, nil
+		default:
+			if c := strings.Compare(left.text, right.text); c != 0 {
+				return c, nil
+			}
+		}
+	}
+	return 0, nil
+}
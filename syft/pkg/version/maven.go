@@ -0,0 +1,246 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// mavenQualifiers are the well-known qualifiers of a maven version, in order (where "" is a release).
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+var mavenQualifierAliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+// mavenItem is an element of a parsed maven version: a number, a qualifier, or a (sub)list of items.
+type mavenItem interface {
+	isNull() bool
+	// compare compares the item with another item, where nil represents a missing item
+	compare(other mavenItem) int
+}
+
+type mavenInt string
+
+type mavenString string
+
+type mavenList []mavenItem
+
+type mavenVersion struct {
+	raw   string
+	items mavenList
+}
+
+// parseMaven parses a version in the same way as maven (see ComparableVersion), where any string is a valid version.
+func parseMaven(version string) (Version, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(version))
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid maven version: %q", version)
+	}
+
+	root := &mavenList{}
+	list := root
+	stack := []*mavenList{root}
+	startSublist := func() {
+		sub := &mavenList{}
+		*list = append(*list, sub)
+		list = sub
+		stack = append(stack, sub)
+	}
+
+	isNumber := false
+	start := 0
+	for i := 0; i < len(trimmed); i++ {
+		c := trimmed[i]
+		switch {
+		case c == '.' || c == '-':
+			if i == start {
+				*list = append(*list, mavenInt("0"))
+			} else {
+				*list = append(*list, newMavenItem(isNumber, trimmed[start:i], false))
+			}
+			start = i + 1
+			if c == '-' {
+				startSublist()
+			}
+		case isDigit(c):
+			if !isNumber && i > start {
+				// e.g. "alpha1": the qualifier is followed by a number
+				*list = append(*list, newMavenItem(false, trimmed[start:i], true))
+				start = i
+				startSublist()
+			}
+			isNumber = true
+		default:
+			if isNumber && i > start {
+				*list = append(*list, newMavenItem(true, trimmed[start:i], false))
+				start = i
+				startSublist()
+			}
+			isNumber = false
+		}
+	}
+	if len(trimmed) > start {
+		*list = append(*list, newMavenItem(isNumber, trimmed[start:], false))
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+
+	return mavenVersion{raw: version, items: *root}, nil
+}
+
+func newMavenItem(isNumber bool, value string, followedByDigit bool) mavenItem {
+	if isNumber {
+		value = strings.TrimLeft(value, "0")
+		if value == "" {
+			value = "0"
+		}
+		return mavenInt(value)
+	}
+
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, ok := mavenQualifierAliases[value]; ok {
+		value = alias
+	}
+	return mavenString(value)
+}
+
+func (v mavenVersion) Format() Format {
+	return MavenFormat
+}
+
+func (v mavenVersion) String() string {
+	return v.raw
+}
+
+// Compare orders versions in the same way as maven, where numbers are compared numerically, well-known qualifiers
+// are ordered "alpha" < "beta" < "milestone" < "rc" < "snapshot" < release < "sp", and other qualifiers are
+// compared lexically (sorting after all well-known qualifiers).
+func (v mavenVersion) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	return v.items.compare(other.(mavenVersion).items), nil
+}
+
+func (i mavenInt) isNull() bool {
+	return i == "0"
+}
+
+func (i mavenInt) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case mavenInt:
+		if c := compareInts(len(i), len(o)); c != 0 {
+			return c
+		}
+		return strings.Compare(string(i), string(o))
+	}
+	// numbers are newer than qualifiers and sublists
+	return 1
+}
+
+func (s mavenString) isNull() bool {
+	return s == ""
+}
+
+func (s mavenString) comparable() string {
+	for i, q := range mavenQualifiers {
+		if string(s) == q {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(mavenQualifiers)) + "-" + string(s)
+}
+
+func (s mavenString) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		return strings.Compare(s.comparable(), mavenString("").comparable())
+	case mavenString:
+		return strings.Compare(s.comparable(), o.comparable())
+	}
+	// qualifiers are older than numbers and sublists
+	return -1
+}
+
+func (l mavenList) isNull() bool {
+	return len(l) == 0
+}
+
+// normalize removes trailing null items (e.g. "1.0.0" ---> "1", "1-ga" ---> "1").
+func (l *mavenList) normalize() {
+	for i := len(*l) - 1; i >= 0; i-- {
+		item := (*l)[i]
+		if item.isNull() {
+			*l = append((*l)[:i], (*l)[i+1:]...)
+			continue
+		}
+		if _, ok := item.(*mavenList); !ok {
+			break
+		}
+	}
+}
+
+func (l mavenList) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if len(l) == 0 {
+			return 0
+		}
+		return l[0].compare(nil)
+	case mavenInt:
+		return -1
+	case mavenString:
+		return 1
+	case *mavenList:
+		return l.compareList(*o)
+	case mavenList:
+		return l.compareList(o)
+	}
+	return 0
+}
+
+func (l mavenList) compareList(o mavenList) int {
+	for i := 0; i < len(l) || i < len(o); i++ {
+		var left, right mavenItem
+		if i < len(l) {
+			left = l[i]
+		}
+		if i < len(o) {
+			right = o[i]
+		}
+
+		var c int
+		switch {
+		case left == nil && right == nil:
+			c = 0
+		case left == nil:
+			c = -right.compare(left)
+		default:
+			c = left.compare(right)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,36 @@
+package version
+
+import (
+	"fmt"
+
+	pep440 "github.com/aquasecurity/go-pep440-version"
+)
+
+type pep440Version struct {
+	raw     string
+	version pep440.Version
+}
+
+func parsePEP440(version string) (Version, error) {
+	v, err := pep440.Parse(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PEP 440 version: %q: %w", version, err)
+	}
+	return pep440Version{raw: version, version: v}, nil
+}
+
+func (v pep440Version) Format() Format {
+	return PEP440Format
+}
+
+func (v pep440Version) String() string {
+	return v.raw
+}
+
+// Compare orders versions as described by PEP 440 (e.g. "1.0.dev1" < "1.0a1" < "1.0" < "1.0.post1").
+func (v pep440Version) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	return v.version.Compare(other.(pep440Version).version), nil
+}
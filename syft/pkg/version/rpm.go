@@ -0,0 +1,180 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type rpmVersion struct {
+	raw     string
+	epoch   int
+	version string
+	release *string
+}
+
+// parseRPM parses a version of the form "[epoch:]version[-release]", which is how RPM versions are recorded by syft.
+func parseRPM(version string) (Version, error) {
+	v := rpmVersion{raw: version}
+	remaining := strings.TrimSpace(version)
+
+	if before, after, ok := strings.Cut(remaining, ":"); ok {
+		epoch, err := strconv.Atoi(before)
+		if err != nil || epoch < 0 {
+			return nil, fmt.Errorf("invalid rpm version: %q: epoch is not a number", version)
+		}
+		v.epoch = epoch
+		remaining = after
+	}
+
+	if i := strings.LastIndex(remaining, "-"); i >= 0 {
+		release := remaining[i+1:]
+		v.version, v.release = remaining[:i], &release
+	} else {
+		v.version = remaining
+	}
+
+	if v.version == "" {
+		return nil, fmt.Errorf("invalid rpm version: %q: empty version", version)
+	}
+	return v, nil
+}
+
+func (v rpmVersion) Format() Format {
+	return RPMFormat
+}
+
+func (v rpmVersion) String() string {
+	return v.raw
+}
+
+// Compare orders versions in the same way as rpm, comparing the epoch (where a missing epoch is 0), version, and
+// release in turn (where a missing release sorts before any release).
+func (v rpmVersion) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	o := other.(rpmVersion)
+
+	if c := compareInts(v.epoch, o.epoch); c != 0 {
+		return c, nil
+	}
+	if c := rpmvercmp(v.version, o.version); c != 0 {
+		return c, nil
+	}
+
+	switch {
+	case v.release == nil && o.release == nil:
+		return 0, nil
+	case v.release == nil:
+		return -1, nil
+	case o.release == nil:
+		return 1, nil
+	}
+	return rpmvercmp(*v.release, *o.release), nil
+}
+
+// rpmvercmp compares version strings with the algorithm used by rpm: strings are split into alphabetic and numeric
+// segments (ignoring separators), where numeric segments are newer than alphabetic segments, "~" sorts before
+// anything (even the end of the string), and "^" sorts after the end of the string but before anything else.
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	isSeparator := func(c byte) bool {
+		return !isDigit(c) && !isLetter(c) && c != '~' && c != '^'
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && isSeparator(a[i]) {
+			i++
+		}
+		for j < len(b) && isSeparator(b[j]) {
+			j++
+		}
+
+		// tilde sorts before everything else
+		aTilde, bTilde := i < len(a) && a[i] == '~', j < len(b) && b[j] == '~'
+		if aTilde || bTilde {
+			if !aTilde {
+				return 1
+			}
+			if !bTilde {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		// caret sorts after the end of the string, but before anything else
+		aCaret, bCaret := i < len(a) && a[i] == '^', j < len(b) && b[j] == '^'
+		if aCaret || bCaret {
+			switch {
+			case i >= len(a):
+				return -1
+			case j >= len(b):
+				return 1
+			case !aCaret:
+				return 1
+			case !bCaret:
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		startA, startB := i, j
+		numeric := isDigit(a[i])
+		if numeric {
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+		} else {
+			for i < len(a) && isLetter(a[i]) {
+				i++
+			}
+			for j < len(b) && isLetter(b[j]) {
+				j++
+			}
+		}
+
+		segA, segB := a[startA:i], b[startB:j]
+		if segB == "" {
+			// the segments are of different types, where numeric segments are newer
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+
+		if numeric {
+			segA = strings.TrimLeft(segA, "0")
+			segB = strings.TrimLeft(segB, "0")
+			if c := compareInts(len(segA), len(segB)); c != 0 {
+				return c
+			}
+		}
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	}
+	return -1
+}
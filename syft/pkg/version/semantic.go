@@ -0,0 +1,93 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// semanticPattern matches semantic versions (https://semver.org), where a leading "v" (as used by go modules) or "="
+// is allowed.
+var semanticPattern = regexp.MustCompile(`^[v=]?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+type semanticVersion struct {
+	raw        string
+	core       [3]int
+	prerelease []string
+}
+
+func parseSemantic(version string) (Version, error) {
+	match := semanticPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if match == nil {
+		return nil, fmt.Errorf("invalid semantic version: %q", version)
+	}
+
+	v := semanticVersion{raw: version}
+	for i := range v.core {
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version: %q: %w", version, err)
+		}
+		v.core[i] = n
+	}
+	if match[4] != "" {
+		v.prerelease = strings.Split(match[4], ".")
+	}
+	return v, nil
+}
+
+func (v semanticVersion) Format() Format {
+	return SemanticFormat
+}
+
+func (v semanticVersion) String() string {
+	return v.raw
+}
+
+// Compare orders versions by their major, minor, and patch numbers, where a pre-release has a lower precedence than the
+// associated normal version. Build metadata is ignored.
+func (v semanticVersion) Compare(other Version) (int, error) {
+	if err := checkFormat(v, other); err != nil {
+		return 0, err
+	}
+	o := other.(semanticVersion)
+
+	for i := range v.core {
+		if c := compareInts(v.core[i], o.core[i]); c != 0 {
+			return c, nil
+		}
+	}
+
+	switch {
+	case len(v.prerelease) == 0 && len(o.prerelease) == 0:
+		return 0, nil
+	case len(v.prerelease) == 0:
+		return 1, nil
+	case len(o.prerelease) == 0:
+		return -1, nil
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(o.prerelease); i++ {
+		if c := comparePrereleaseIdentifier(v.prerelease[i], o.prerelease[i]); c != 0 {
+			return c, nil
+		}
+	}
+	return compareInts(len(v.prerelease), len(o.prerelease)), nil
+}
+
+// comparePrereleaseIdentifier compares identifiers of a pre-release, where numeric identifiers are compared
+// numerically and have a lower precedence than alphanumeric identifiers (which are compared lexically).
+func comparePrereleaseIdentifier(a, b string) int {
+	an, aErr := strconv.Atoi(a)
+	bn, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareInts(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,126 @@
+/*
+Package version parses and orders package versions according to the rules of each ecosystem, interpreting versions
+in the same form that syft catalogers record them (e.g. RPM versions as "epoch:version-release"), so that consumers
+of syft SBOMs can compare versions the same way that the package managers do.
+*/
+package version
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// Format is a versioning scheme used by one or more ecosystems.
+type Format string
+
+const (
+	UnknownFormat  Format = "unknown"
+	SemanticFormat Format = "semver"
+	PEP440Format   Format = "pep440"
+	DebianFormat   Format = "deb"
+	RPMFormat      Format = "rpm"
+	MavenFormat    Format = "maven"
+	GemFormat      Format = "gem"
+)
+
+// ErrFormatMismatch is returned when comparing versions of different formats.
+var ErrFormatMismatch = errors.New("cannot compare versions of different formats")
+
+// Version is a parsed version of a particular format.
+type Version interface {
+	// Format is the versioning scheme of the version.
+	Format() Format
+
+	// String is the version as given when parsed.
+	String() string
+
+	// Compare returns -1, 0, or 1 if the version is less than, equal to, or greater than the other version (which must
+	// be of the same format).
+	Compare(other Version) (int, error)
+}
+
+// Formats returns all known version formats.
+func Formats() []Format {
+	return []Format{SemanticFormat, PEP440Format, DebianFormat, RPMFormat, MavenFormat, GemFormat}
+}
+
+// Parse parses the given version according to the rules of the given format.
+func Parse(format Format, version string) (Version, error) {
+	switch format {
+	case SemanticFormat:
+		return parseSemantic(version)
+	case PEP440Format:
+		return parsePEP440(version)
+	case DebianFormat:
+		return parseDebian(version)
+	case RPMFormat:
+		return parseRPM(version)
+	case MavenFormat:
+		return parseMaven(version)
+	case GemFormat:
+		return parseGem(version)
+	}
+	return nil, fmt.Errorf("unsupported version format: %q", format)
+}
+
+// Compare parses both versions according to the rules of the given format, returning -1, 0, or 1 if a is less than,
+// equal to, or greater than b.
+func Compare(format Format, a, b string) (int, error) {
+	va, err := Parse(format, a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := Parse(format, b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb)
+}
+
+// FormatForPackageType returns the version format used by packages of the given type, or UnknownFormat if there is no
+// known format for the type.
+func FormatForPackageType(t pkg.Type) Format {
+	switch t {
+	case pkg.DebPkg:
+		return DebianFormat
+	case pkg.RpmPkg:
+		return RPMFormat
+	case pkg.PythonPkg:
+		return PEP440Format
+	case pkg.JavaPkg, pkg.JenkinsPluginPkg:
+		return MavenFormat
+	case pkg.GemPkg:
+		return GemFormat
+	case pkg.NpmPkg, pkg.RustPkg, pkg.GoModulePkg, pkg.DartPubPkg, pkg.HexPkg, pkg.HelmChartPkg:
+		return SemanticFormat
+	}
+	return UnknownFormat
+}
+
+// ForPackage parses the version of the given package according to the version format used by packages of its type.
+func ForPackage(p pkg.Package) (Version, error) {
+	format := FormatForPackageType(p.Type)
+	if format == UnknownFormat {
+		return nil, fmt.Errorf("no known version format for package type %q", p.Type)
+	}
+	return Parse(format, p.Version)
+}
+
+func checkFormat(v Version, other Version) error {
+	if other == nil || v.Format() != other.Format() {
+		return ErrFormatMismatch
+	}
+	return nil
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,202 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// assertOrdered asserts that each version is less than every version that follows it (within the same group), and that
+// all versions within a group are equal to each other.
+func assertOrdered(t *testing.T, format Format, groups ...[]string) {
+	t.Helper()
+	for i, group := range groups {
+		for _, a := range group {
+			for j, other := range groups {
+				for _, b := range other {
+					got, err := Compare(format, a, b)
+					require.NoError(t, err, "%s vs %s", a, b)
+					assert.Equal(t, compareInts(i, j), got, "%s vs %s", a, b)
+				}
+			}
+		}
+	}
+}
+
+func TestCompare_Semantic(t *testing.T) {
+	assertOrdered(t, SemanticFormat,
+		[]string{"0.9.9"},
+		[]string{"1.0.0-alpha"},
+		[]string{"1.0.0-alpha.1"},
+		[]string{"1.0.0-alpha.beta"},
+		[]string{"1.0.0-beta"},
+		[]string{"1.0.0-beta.2"},
+		[]string{"1.0.0-beta.11"},
+		[]string{"1.0.0-rc.1"},
+		[]string{"1.0.0", "v1.0.0", "1.0.0+build.5"},
+		[]string{"v1.0.1-0.20200701162849-18adb9c92b9b"},
+		[]string{"1.0.1"},
+		[]string{"1.10.0"},
+		[]string{"10.0.0"},
+	)
+}
+
+func TestCompare_PEP440(t *testing.T) {
+	assertOrdered(t, PEP440Format,
+		[]string{"1.0.dev1"},
+		[]string{"1.0a1"},
+		[]string{"1.0b2", "1.0beta2"},
+		[]string{"1.0rc1", "1.0c1"},
+		[]string{"1.0", "1.0.0", "v1.0"},
+		[]string{"1.0.post1"},
+		[]string{"1.1"},
+		[]string{"1!0.1"},
+	)
+}
+
+func TestCompare_Debian(t *testing.T) {
+	assertOrdered(t, DebianFormat,
+		[]string{"1.0~~"},
+		[]string{"1.0~rc1"},
+		[]string{"1.0~rc2"},
+		[]string{"1.0", "1.0-0", "0:1.0", "1.00"},
+		[]string{"1.0-1"},
+		[]string{"1.0-1ubuntu1"},
+		[]string{"1.0-1ubuntu1.1"},
+		[]string{"1.0-2"},
+		[]string{"1.0a"},
+		[]string{"1.0+dfsg-1"},
+		[]string{"1.1"},
+		[]string{"1:0.1"},
+	)
+}
+
+func TestCompare_RPM(t *testing.T) {
+	assertOrdered(t, RPMFormat,
+		[]string{"1.0~rc1-1"},
+		[]string{"1.0"},
+		[]string{"1.0-1.el8", "0:1.0-1.el8", "1.0-1_el8"},
+		[]string{"1.0-2.el8"},
+		[]string{"1.0-10.el8"},
+		[]string{"1.0^git1-1"},
+		[]string{"1.0a-1"},
+		[]string{"1.0.1-1", "1.00.1-1"},
+		[]string{"1.10-1", "1.010-1"},
+		[]string{"1:0.1-1"},
+		[]string{"2:0.1-1"},
+	)
+}
+
+func TestCompare_Maven(t *testing.T) {
+	assertOrdered(t, MavenFormat,
+		[]string{"1-alpha", "1-alpha-0", "1.0-a0"},
+		[]string{"1.0-alpha1", "1.0-a1"},
+		[]string{"1.0-alpha2"},
+		[]string{"1-beta"},
+		[]string{"1-milestone"},
+		[]string{"1-rc", "1-cr", "1.0-RC"},
+		[]string{"1-SNAPSHOT"},
+		[]string{"1", "1.0", "1.0.0", "1-ga", "1.0.0.RELEASE", "1-final"},
+		[]string{"1-sp"},
+		[]string{"1-abc"},
+		[]string{"1-xyz"},
+		[]string{"1.0.1"},
+		[]string{"1.1"},
+		[]string{"1.10"},
+		[]string{"2"},
+	)
+}
+
+func TestCompare_Gem(t *testing.T) {
+	assertOrdered(t, GemFormat,
+		[]string{"1.0.a"},
+		[]string{"1.0.a9"},
+		[]string{"1.0.a10"},
+		[]string{"1.0.b"},
+		[]string{"1.0-rc1", "1.0.pre.rc1"},
+		[]string{"1.0", "1", "1.0.0"},
+		[]string{"1.0.1"},
+		[]string{"1.9"},
+		[]string{"1.10"},
+	)
+}
+
+func TestParse_invalid(t *testing.T) {
+	tests := []struct {
+		format  Format
+		version string
+	}{
+		{format: SemanticFormat, version: "1.0"},
+		{format: SemanticFormat, version: "01.0.0"},
+		{format: PEP440Format, version: "not a version"},
+		{format: DebianFormat, version: "a:1.0"},
+		{format: DebianFormat, version: "1.0-"},
+		{format: RPMFormat, version: "x:1.0-1"},
+		{format: MavenFormat, version: ""},
+		{format: GemFormat, version: "1.0 beta"},
+		{format: UnknownFormat, version: "1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format)+" "+tt.version, func(t *testing.T) {
+			_, err := Parse(tt.format, tt.version)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVersion_Compare_formatMismatch(t *testing.T) {
+	a, err := Parse(DebianFormat, "1.0")
+	require.NoError(t, err)
+	b, err := Parse(RPMFormat, "1.0")
+	require.NoError(t, err)
+
+	_, err = a.Compare(b)
+	require.ErrorIs(t, err, ErrFormatMismatch)
+}
+
+func TestForPackage(t *testing.T) {
+	tests := []struct {
+		name       string
+		p          pkg.Package
+		wantFormat Format
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "rpm with epoch",
+			p:          pkg.Package{Type: pkg.RpmPkg, Version: "1:2.3-4.el9"},
+			wantFormat: RPMFormat,
+		},
+		{
+			name:       "go module",
+			p:          pkg.Package{Type: pkg.GoModulePkg, Version: "v0.0.0-20231108232855-2478ac86f678"},
+			wantFormat: SemanticFormat,
+		},
+		{
+			name:       "java archive",
+			p:          pkg.Package{Type: pkg.JavaPkg, Version: "2.0.0.RELEASE"},
+			wantFormat: MavenFormat,
+		},
+		{
+			name:    "no known format",
+			p:       pkg.Package{Type: pkg.BinaryPkg, Version: "1.0"},
+			wantErr: require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			v, err := ForPackage(tt.p)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantFormat, v.Format())
+			assert.Equal(t, tt.p.Version, v.String())
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package pkg
+
+// NeovimLazyLockEntry represents a single plugin entry within a lazy.nvim lockfile (lazy-lock.json).
+type NeovimLazyLockEntry struct {
+	// Branch is the branch of the plugin repository that is tracked.
+	Branch string `json:"branch,omitempty"`
+
+	// Commit is the commit of the plugin repository that is installed.
+	Commit string `json:"commit"`
+}
+
+// VimPlugSnapshotEntry represents a single plugin entry within a vim-plug snapshot file (written by :PlugSnapshot).
+type VimPlugSnapshotEntry struct {
+	// Commit is the commit of the plugin repository that is installed.
+	Commit string `json:"commit"`
+}
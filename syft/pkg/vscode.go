@@ -0,0 +1,35 @@
+package pkg
+
+// VSCodeExtensionEntry is a struct that represents a VS Code (or code-server) extension installed to an extensions
+// directory, as described by the package.json file of the extension and the extensions.json file of the directory.
+type VSCodeExtensionEntry struct {
+	// Publisher is the identifier of the publisher of the extension (the first part of the extension ID).
+	Publisher string `mapstructure:"publisher" json:"publisher"`
+
+	// PublisherDisplayName is the display name of the publisher on the marketplace (e.g. "Microsoft").
+	PublisherDisplayName string `mapstructure:"publisherDisplayName" json:"publisherDisplayName,omitempty"`
+
+	DisplayName string `mapstructure:"displayName" json:"displayName,omitempty"`
+	Description string `mapstructure:"description" json:"description,omitempty"`
+
+	// Engine is the range of VS Code versions the extension is compatible with (e.g. "^1.86.0").
+	Engine string `mapstructure:"engine" json:"engine,omitempty"`
+
+	// UUID is the identifier of the extension on the marketplace.
+	UUID string `mapstructure:"uuid" json:"uuid,omitempty"`
+
+	// TargetPlatform is the platform the installed extension was built for (e.g. "linux-x64"), which is only set for
+	// platform-specific extensions.
+	TargetPlatform string `mapstructure:"targetPlatform" json:"targetPlatform,omitempty"`
+
+	// Source indicates how the extension was installed (e.g. "gallery" for the marketplace or "vsix" for a file).
+	Source string `mapstructure:"source" json:"source,omitempty"`
+
+	PreRelease bool `mapstructure:"preRelease" json:"preRelease,omitempty"`
+
+	// ExtensionDependencies are the IDs of the other extensions this extension depends on.
+	ExtensionDependencies []string `mapstructure:"extensionDependencies" json:"extensionDependencies,omitempty"`
+
+	// ExtensionPack are the IDs of the extensions installed together with this extension.
+	ExtensionPack []string `mapstructure:"extensionPack" json:"extensionPack,omitempty"`
+}
@@ -0,0 +1,43 @@
+package pkg
+
+// WasmModuleEntry represents a WebAssembly binary (either a core module or a component) with the metadata found
+// within its custom sections.
+type WasmModuleEntry struct {
+	// Kind is whether the binary is a core WebAssembly "module" or a component model "component".
+	Kind string `json:"kind"`
+
+	// Languages are the source languages the binary was compiled from (from the "producers" section).
+	Languages []WasmProducer `json:"languages,omitempty"`
+
+	// ProcessedBy are the tools that produced or transformed the binary, such as compilers and optimizers
+	// (from the "producers" section).
+	ProcessedBy []WasmProducer `json:"processedBy,omitempty"`
+
+	// SDKs are the SDKs the binary was built with (from the "producers" section).
+	SDKs []WasmProducer `json:"sdks,omitempty"`
+
+	// Authors are the authors of the binary (from component model metadata).
+	Authors []string `json:"authors,omitempty"`
+
+	// Description is a human-readable description of the binary (from component model metadata).
+	Description string `json:"description,omitempty"`
+
+	// Source is the URL of the source code of the binary (from component model metadata).
+	Source string `json:"source,omitempty"`
+
+	// Homepage is the URL of the homepage of the binary (from component model metadata).
+	Homepage string `json:"homepage,omitempty"`
+
+	// Revision is the source revision (e.g. a git commit) the binary was built from (from component model metadata).
+	Revision string `json:"revision,omitempty"`
+}
+
+// WasmProducer is a single tool or language (with an optional version) listed within the "producers" section of a
+// WebAssembly binary.
+type WasmProducer struct {
+	// Name is the name of the language or tool (e.g. "Rust" or "rustc").
+	Name string `json:"name"`
+
+	// Version is the version of the language or tool, when known.
+	Version string `json:"version,omitempty"`
+}
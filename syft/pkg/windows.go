@@ -0,0 +1,131 @@
+package pkg
+
+const (
+	// WindowsSxSAssemblyStore denotes an assembly from the WinSxS component store (Windows\WinSxS).
+	WindowsSxSAssemblyStore = "WinSxS"
+
+	// WindowsGACAssemblyStore denotes a strong-named .NET assembly from the Global Assembly Cache (Windows\assembly or
+	// Windows\Microsoft.NET\assembly).
+	WindowsGACAssemblyStore = "GAC"
+)
+
+// WindowsAssemblyEntry represents an assembly found within an offline Windows filesystem, either a side-by-side
+// assembly (an in-box component) from the WinSxS component store or a strong-named .NET assembly from the Global
+// Assembly Cache, as described by its assembly identity.
+type WindowsAssemblyEntry struct {
+	// Name is the name of the assembly (e.g. "Microsoft.Windows.Common-Controls" or "System.Data")
+	Name string `json:"name"`
+
+	// Version is the four-part assembly version (e.g. "6.0.19041.1110")
+	Version string `json:"version"`
+
+	// ProcessorArchitecture is the architecture the assembly targets (e.g. "amd64", "x86", "wow64", or "msil")
+	ProcessorArchitecture string `json:"processorArchitecture,omitempty"`
+
+	// Culture is the language of the assembly, which is empty for culture-neutral assemblies
+	Culture string `json:"culture,omitempty"`
+
+	// PublicKeyToken is the hex encoded token of the public key that the assembly is signed (strong-named) with
+	PublicKeyToken string `json:"publicKeyToken,omitempty"`
+
+	// Type is the assembly type declared by a WinSxS manifest (e.g. "win32")
+	Type string `json:"type,omitempty"`
+
+	// Store is where the assembly is installed: "WinSxS" or "GAC"
+	Store string `json:"store"`
+}
+
+// WindowsDriverEntry represents a driver package within the driver store of an offline Windows filesystem
+// (Windows\System32\DriverStore\FileRepository), as described by the [Version] section of its INF file.
+type WindowsDriverEntry struct {
+	// InfName is the name of the INF file of the driver package (e.g. "nv_dispi.inf")
+	InfName string `json:"infName"`
+
+	// Provider is the provider of the driver package (e.g. "NVIDIA")
+	Provider string `json:"provider,omitempty"`
+
+	// Class is the name of the device setup class that the driver package is for (e.g. "Display")
+	Class string `json:"class,omitempty"`
+
+	// ClassGUID is the GUID of the device setup class that the driver package is for
+	ClassGUID string `json:"classGuid,omitempty"`
+
+	// DriverVersion is the version of the driver package (from the DriverVer directive)
+	DriverVersion string `json:"driverVersion,omitempty"`
+
+	// DriverDate is the date of the driver package (from the DriverVer directive) in the YYYY-MM-DD format
+	DriverDate string `json:"driverDate,omitempty"`
+
+	// Architecture is the architecture that the driver package was staged for (e.g. "amd64")
+	Architecture string `json:"architecture,omitempty"`
+
+	// CatalogFile is the name of the signed catalog file of the driver package (e.g. "nv_disp.cat")
+	CatalogFile string `json:"catalogFile,omitempty"`
+
+	// Signer is the name of the publisher that signed the catalog file of the driver package (e.g. "Microsoft Windows
+	// Hardware Compatibility Publisher")
+	Signer string `json:"signer,omitempty"`
+}
+
+// WindowsMSIEntry represents a Windows Installer package (.msi) found on disk (e.g. an installer staged within an
+// image), as described by the Property and File tables of the installer database.
+type WindowsMSIEntry struct {
+	// ProductName is the name of the product that the installer installs
+	ProductName string `json:"productName"`
+
+	// ProductVersion is the version of the product that the installer installs
+	ProductVersion string `json:"productVersion"`
+
+	// Manufacturer is the name of the manufacturer of the product
+	Manufacturer string `json:"manufacturer,omitempty"`
+
+	// ProductCode is the GUID that identifies the product (and version) that the installer installs
+	ProductCode string `json:"productCode,omitempty"`
+
+	// UpgradeCode is the GUID that identifies the family of related products (across versions)
+	UpgradeCode string `json:"upgradeCode,omitempty"`
+
+	// ProductLanguage is the numeric language identifier of the installer (e.g. "1033" for en-US)
+	ProductLanguage string `json:"productLanguage,omitempty"`
+
+	// Files are the files that the installer installs
+	Files []WindowsMSIFileRecord `json:"files,omitempty"`
+}
+
+// WindowsMSIFileRecord represents a file installed by a Windows Installer package (a row of the File table).
+type WindowsMSIFileRecord struct {
+	// Name is the (long) name of the file
+	Name string `json:"name"`
+
+	// Size is the size of the file in bytes
+	Size int64 `json:"size,omitempty"`
+
+	// Version is the version of the file (for versioned files, such as executables and libraries)
+	Version string `json:"version,omitempty"`
+}
+
+// WindowsInstallerEntry represents an executable setup program (e.g. a vendor-delivered "setup.exe") built with an
+// installer framework other than Windows Installer, as described by the product information embedded within it.
+type WindowsInstallerEntry struct {
+	// Format is the installer framework that the setup program was built with (e.g. "nsis" or "inno-setup")
+	Format string `json:"format"`
+
+	// ProductName is the name of the product that the installer installs
+	ProductName string `json:"productName"`
+
+	// ProductVersion is the version of the product that the installer installs
+	ProductVersion string `json:"productVersion,omitempty"`
+
+	// Publisher is the name of the publisher of the product
+	Publisher string `json:"publisher,omitempty"`
+
+	// AppID is the identifier of the product that is shared across versions (for Inno Setup installers)
+	AppID string `json:"appId,omitempty"`
+
+	// URL is the homepage of the product or its publisher
+	URL string `json:"url,omitempty"`
+
+	// Files are the paths of the files that the installer installs, for installers whose payload is described by the
+	// installer (where the installation directory is written as "$INSTDIR", since it is chosen at installation time)
+	Files []string `json:"files,omitempty"`
+}
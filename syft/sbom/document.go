@@ -0,0 +1,39 @@
+package sbom
+
+import "time"
+
+// Document describes the identity of an SBOM document and its place within a series of documents that describe the
+// same subject over time (e.g. one document per release). All fields are optional: identifiers that are not provided
+// are generated by the format encoders.
+type Document struct {
+	// SerialNumber is the CycloneDX serial number ("urn:uuid:..."), which is shared by all versions of a BOM.
+	SerialNumber string
+
+	// Version is the version of the document within the series (e.g. a build number), which increases with each
+	// new document.
+	Version int
+
+	// Namespace is the SPDX document namespace, which is unique to each version of a document.
+	Namespace string
+
+	// Timestamp is the time the document was created, which is used in place of the current time when set.
+	Timestamp time.Time
+
+	// Previous identifies the document that this document supersedes.
+	Previous *PreviousDocument
+}
+
+// PreviousDocument identifies an earlier document within the same series.
+type PreviousDocument struct {
+	// SerialNumber is the CycloneDX serial number of the previous document.
+	SerialNumber string
+
+	// Version is the version of the previous document within the series.
+	Version int
+
+	// Namespace is the SPDX document namespace of the previous document.
+	Namespace string
+
+	// SHA1 is the checksum of the previous document contents, which SPDX requires to reference another document.
+	SHA1 string
+}
@@ -0,0 +1,25 @@
+package sbom
+
+import (
+	"encoding/json"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
+// Extensions holds content from a decoded document that syft does not understand (e.g. fields added by other tools),
+// so that it can be written back out when the SBOM is encoded to the same format it was decoded from.
+type Extensions struct {
+	// Format is the format the fields were decoded from; the fields are only restored when encoding to this format.
+	Format FormatID
+
+	// Document holds the unknown top-level fields of the document, by field name.
+	Document map[string]json.RawMessage
+
+	// Packages holds the unknown fields of individual package elements, by package ID and field name.
+	Packages map[artifact.ID]map[string]json.RawMessage
+}
+
+// Empty indicates that there are no fields to restore.
+func (e Extensions) Empty() bool {
+	return len(e.Document) == 0 && len(e.Packages) == 0
+}
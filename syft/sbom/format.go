@@ -29,3 +29,12 @@ type FormatDecoder interface {
 	// full SBOM, only pulls the minimal information necessary to identify the format.
 	Identify(io.Reader) (FormatID, string)
 }
+
+// FormatDecoderWithWarnings is a FormatDecoder that can additionally report non-fatal problems found in a document
+// (e.g. references to elements that are not defined), which are otherwise only logged.
+type FormatDecoderWithWarnings interface {
+	FormatDecoder
+
+	// DecodeWithWarnings behaves like Decode, additionally returning any non-fatal problems found while decoding.
+	DecodeWithWarnings(io.Reader) (*SBOM, FormatID, string, []string, error)
+}
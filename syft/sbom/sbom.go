@@ -17,6 +17,8 @@ type SBOM struct {
 	Relationships []artifact.Relationship
 	Source        source.Description
 	Descriptor    Descriptor
+	Document      Document
+	Extensions    Extensions
 }
 
 type Artifacts struct {
@@ -26,7 +28,11 @@ type Artifacts struct {
 	FileContents      map[file.Coordinates]string
 	FileLicenses      map[file.Coordinates][]file.License
 	Executables       map[file.Coordinates]file.Executable
+	KeyMaterial       map[file.Coordinates]file.KeyMaterial
+	FileSnippets      map[file.Coordinates][]file.Snippet
 	LinuxDistribution *linux.Release
+	UnreadablePaths   []file.UnreadablePath
+	PackageFootprints map[artifact.ID]pkg.Footprint
 }
 
 type Descriptor struct {
@@ -62,6 +68,12 @@ func (s SBOM) AllCoordinates() []file.Coordinates {
 	for coordinates := range s.Artifacts.FileDigests {
 		set.Add(coordinates)
 	}
+	for coordinates := range s.Artifacts.KeyMaterial {
+		set.Add(coordinates)
+	}
+	for coordinates := range s.Artifacts.FileSnippets {
+		set.Add(coordinates)
+	}
 	for _, relationship := range s.Relationships {
 		for _, coordinates := range extractCoordinates(relationship) {
 			set.Add(coordinates)
@@ -116,6 +128,69 @@ func (s SBOM) CoordinatesForPackage(p pkg.Package, rt ...artifact.RelationshipTy
 	return coordinates
 }
 
+// LayerFootprints returns the installed size and number of files owned by the cataloged packages within each
+// container image layer, in the order of the layers of the image.
+func (s SBOM) LayerFootprints() []pkg.LayerFootprint {
+	if len(s.Artifacts.PackageFootprints) == 0 || s.Artifacts.Packages == nil {
+		return nil
+	}
+
+	var footprints []pkg.Footprint
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		if f, ok := s.Artifacts.PackageFootprints[p.ID()]; ok {
+			footprints = append(footprints, f)
+		}
+	}
+
+	var layerOrder []string
+	if metadata, ok := s.Source.Metadata.(source.ImageMetadata); ok {
+		for _, l := range metadata.Layers {
+			layerOrder = append(layerOrder, l.Digest)
+		}
+	}
+
+	return pkg.LayerFootprints(footprints, layerOrder...)
+}
+
+// FileDigestsByPath returns the digests of the cataloged files by path, as the paths are seen within the (squashed)
+// source. When a path was cataloged within several layers of a container image, the digests of the uppermost layer
+// (in the order of the layers of the image) are kept.
+func (s SBOM) FileDigestsByPath() map[string][]file.Digest {
+	layerIndex := make(map[string]int)
+	if metadata, ok := s.Source.Metadata.(source.ImageMetadata); ok {
+		for i, l := range metadata.Layers {
+			layerIndex[l.Digest] = i
+		}
+	}
+
+	// layers that are not part of the source metadata are considered to be below all known layers
+	rank := func(c file.Coordinates) int {
+		if i, ok := layerIndex[c.FileSystemID]; ok {
+			return i
+		}
+		return -1
+	}
+
+	uppermost := make(map[string]file.Coordinates)
+	for c, digests := range s.Artifacts.FileDigests {
+		if len(digests) == 0 {
+			continue
+		}
+		if existing, ok := uppermost[c.RealPath]; ok {
+			if rank(existing) > rank(c) || (rank(existing) == rank(c) && existing.FileSystemID > c.FileSystemID) {
+				continue
+			}
+		}
+		uppermost[c.RealPath] = c
+	}
+
+	results := make(map[string][]file.Digest, len(uppermost))
+	for path, c := range uppermost {
+		results[path] = s.Artifacts.FileDigests[c]
+	}
+	return results
+}
+
 func extractCoordinates(relationship artifact.Relationship) (results []file.Coordinates) {
 	if coordinates, exists := relationship.From.(file.Coordinates); exists {
 		results = append(results, coordinates)
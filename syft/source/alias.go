@@ -1,10 +1,15 @@
 package source
 
 type Alias struct {
-	Name    string `json:"name" yaml:"name" mapstructure:"name"`
-	Version string `json:"version" yaml:"version" mapstructure:"version"`
+	Name     string `json:"name" yaml:"name" mapstructure:"name"`
+	Version  string `json:"version" yaml:"version" mapstructure:"version"`
+	Supplier string `json:"supplier" yaml:"supplier" mapstructure:"supplier"`
+	PURL     string `json:"purl" yaml:"purl" mapstructure:"purl"`
+	CPE      string `json:"cpe" yaml:"cpe" mapstructure:"cpe"`
 }
 
+// IsEmpty returns true if no name or version has been given (only the name and version are considered in the ID of
+// the source, the remaining fields describe the identity of the top-level component).
 func (a *Alias) IsEmpty() bool {
 	if a == nil {
 		return true
@@ -5,5 +5,12 @@ type Description struct {
 	ID       string `hash:"ignore"` // the id generated from the parent source struct
 	Name     string `hash:"ignore"`
 	Version  string `hash:"ignore"`
+	Supplier string `hash:"ignore"` // the supplier (organization) of the top-level component
+	PURL     string `hash:"ignore"` // the package URL of the top-level component
+	CPE      string `hash:"ignore"` // the CPE of the top-level component
 	Metadata interface{}
+
+	// Annotations are details about the source as a whole discovered while cataloging (e.g. if the OS is configured
+	// for FIPS mode)
+	Annotations map[string]string `hash:"ignore"`
 }
@@ -122,9 +122,12 @@ func (s directorySource) Describe() source.Description {
 		}
 	}
 	return source.Description{
-		ID:      string(s.id),
-		Name:    name,
-		Version: version,
+		ID:       string(s.id),
+		Name:     name,
+		Version:  version,
+		Supplier: s.config.Alias.Supplier,
+		PURL:     s.config.Alias.PURL,
+		CPE:      s.config.Alias.CPE,
 		Metadata: source.DirectoryMetadata{
 			Path: s.config.Path,
 			Base: s.config.Base,
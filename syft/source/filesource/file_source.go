@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/mholt/archiver/v3"
@@ -130,9 +131,12 @@ func (s fileSource) Describe() source.Description {
 		}
 	}
 	return source.Description{
-		ID:      string(s.id),
-		Name:    name,
-		Version: version,
+		ID:       string(s.id),
+		Name:     name,
+		Version:  version,
+		Supplier: s.config.Alias.Supplier,
+		PURL:     s.config.Alias.PURL,
+		CPE:      s.config.Alias.CPE,
 		Metadata: source.FileMetadata{
 			Path:     s.config.Path,
 			Digests:  s.digests,
@@ -230,12 +234,28 @@ func (s *fileSource) Close() error {
 	return s.closer()
 }
 
-// fileAnalysisPath returns the path given, or in the case the path is an archive, the location where the archive
-// contents have been made available. A cleanup function is provided for any temp files created (if any).
+// fileAnalysisPath returns the path given, or in the case the path is an archive or filesystem image, the location where
+// the archive contents have been made available. A cleanup function is provided for any temp files created (if any).
 func fileAnalysisPath(path string) (string, func() error) {
 	var analysisPath = path
 	var cleanupFn = func() error { return nil }
 
+	// if the given file is a filesystem image (e.g. a firmware or live-CD root filesystem, or an installer image) then
+	// extract it and use the contents as the source.
+	if image := intFile.DetectFilesystemImage(path); image != nil {
+		extractedPath, tmpCleanup, err := extractImageToTmp(path, *image)
+		if err != nil {
+			log.Warnf("%s image could not be extracted: %+v", image.Name, err)
+		} else {
+			log.WithFields("type", image.Name).Debug("source path is a filesystem image")
+			analysisPath = extractedPath
+		}
+		if tmpCleanup != nil {
+			cleanupFn = tmpCleanup
+		}
+		return analysisPath, cleanupFn
+	}
+
 	// if the given file is an archive (as indicated by the file extension and not MIME type) then unarchive it and
 	// use the contents as the source. Note: this does NOT recursively unarchive contents, only the given path is
 	// unarchived.
@@ -287,3 +307,16 @@ func unarchiveToTmp(path string, unarchiver archiver.Unarchiver) (string, func()
 
 	return tempDir, cleanupFn, unarchiver.Unarchive(path, tempDir)
 }
+
+func extractImageToTmp(path string, image intFile.FilesystemImage) (string, func() error, error) {
+	tempDir, err := os.MkdirTemp("", "syft-"+strings.ToLower(image.Name)+"-contents-")
+	if err != nil {
+		return "", func() error { return nil }, fmt.Errorf("unable to create tempdir for %s processing: %w", image.Name, err)
+	}
+
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	return tempDir, cleanupFn, image.Extract(path, tempDir)
+}
@@ -6,6 +6,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"testing"
 
@@ -157,6 +158,80 @@ func TestNewFromFile_WithArchive(t *testing.T) {
 	}
 }
 
+func TestNewFromFile_WithSquashfs(t *testing.T) {
+	testutil.Chdir(t, "..") // run with source/test-fixtures
+
+	// squashfs images are detected by contents rather than by file extension
+	imagePath := "test-fixtures/squashfs/rootfs.img"
+
+	src, err := New(Config{
+		Path: imagePath,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, src.Close())
+	})
+
+	assert.Equal(t, imagePath, src.Describe().Metadata.(source.FileMetadata).Path)
+
+	res, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	refs, err := res.FilesByPath("/var/lib/dpkg/status")
+	require.NoError(t, err)
+	assert.Len(t, refs, 1)
+
+	// absolute symlinks within the image resolve within the image
+	refs, err = res.FilesByPath("/etc/os-release")
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.True(t, strings.HasSuffix(refs[0].RealPath, "usr/lib/os-release"), "unexpected real path %q", refs[0].RealPath)
+
+	reader, err := res.FileContentsByLocation(refs[0])
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "ID=debian")
+}
+
+func TestNewFromFile_WithISO(t *testing.T) {
+	testutil.Chdir(t, "..") // run with source/test-fixtures
+
+	imagePath := "test-fixtures/iso/installer.iso"
+
+	src, err := New(Config{
+		Path: imagePath,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, src.Close())
+	})
+
+	res, err := src.FileResolver(source.SquashedScope)
+	require.NoError(t, err)
+
+	refs, err := res.FilesByPath("/var/lib/dpkg/status")
+	require.NoError(t, err)
+	assert.Len(t, refs, 1)
+
+	// boot images that are not files within the filesystem are extracted too
+	refs, err = res.FilesByPath("/[BOOT]/1-EFI-NoEmul.img")
+	require.NoError(t, err)
+	assert.Len(t, refs, 1)
+
+	// absolute symlinks within the image resolve within the image
+	refs, err = res.FilesByPath("/etc/os-release")
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.True(t, strings.HasSuffix(refs[0].RealPath, "usr/lib/os-release"), "unexpected real path %q", refs[0].RealPath)
+
+	reader, err := res.FileContentsByLocation(refs[0])
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "ID=debian")
+}
+
 // setupArchiveTest encapsulates common test setup work for tar file tests. It returns a cleanup function,
 // which should be called (typically deferred) by the caller, the path of the created tar archive, and an error,
 // which should trigger a fatal test failure in the consuming test. The returned cleanup function will never be nil
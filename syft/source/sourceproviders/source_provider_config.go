@@ -5,16 +5,20 @@ import (
 
 	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/stereoscopesource"
 )
 
 // Config is the uber-configuration for all Syft source providers
 type Config struct {
-	Platform         *image.Platform
-	Alias            source.Alias
-	RegistryOptions  *image.RegistryOptions
-	Exclude          source.ExcludeConfig
-	DigestAlgorithms []crypto.Hash
-	BasePath         string
+	Platform          *image.Platform
+	Alias             source.Alias
+	RegistryOptions   *image.RegistryOptions
+	Exclude           source.ExcludeConfig
+	DigestAlgorithms  []crypto.Hash
+	BasePath          string
+	OCILayout         stereoscopesource.OCILayoutSelection
+	Containerd        stereoscopesource.ContainerdStoreConfig
+	ContainersStorage stereoscopesource.ContainersStorageConfig
 }
 
 func (c *Config) WithAlias(alias source.Alias) *Config {
@@ -47,6 +51,21 @@ func (c *Config) WithBasePath(basePath string) *Config {
 	return c
 }
 
+func (c *Config) WithOCILayoutSelection(selection stereoscopesource.OCILayoutSelection) *Config {
+	c.OCILayout = selection
+	return c
+}
+
+func (c *Config) WithContainerdStoreConfig(containerd stereoscopesource.ContainerdStoreConfig) *Config {
+	c.Containerd = containerd
+	return c
+}
+
+func (c *Config) WithContainersStorageConfig(containersStorage stereoscopesource.ContainersStorageConfig) *Config {
+	c.ContainersStorage = containersStorage
+	return c
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		DigestAlgorithms: []crypto.Hash{
@@ -44,8 +44,11 @@ func stereoscopeSourceProviders(userInput string, cfg *Config) collections.Tagge
 			Platform:  cfg.Platform,
 			Registry:  registry,
 		},
-		Alias:   cfg.Alias,
-		Exclude: cfg.Exclude,
+		Alias:             cfg.Alias,
+		Exclude:           cfg.Exclude,
+		OCILayout:         cfg.OCILayout,
+		Containerd:        cfg.Containerd,
+		ContainersStorage: cfg.ContainersStorage,
 	})
 	return stereoscopeProviders
 }
@@ -0,0 +1,387 @@
+package stereoscopesource
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	// ContainerdStore is the name of the provider which reads images directly from the content store of containerd
+	ContainerdStore = "containerd-store"
+
+	// DefaultContainerdRoot is the default root directory of containerd
+	DefaultContainerdRoot = "/var/lib/containerd"
+
+	containerdMetadataDB  = "io.containerd.metadata.v1.bolt/meta.db"
+	containerdContentDir  = "io.containerd.content.v1.content"
+	containerdSchema      = "v1"
+	containerdImages      = "images"
+	containerdTarget      = "target"
+	containerdDigest      = "digest"
+	containerdMediaType   = "mediatype"
+	containerdSize        = "size"
+	containerdLayoutIndex = "index.json"
+)
+
+// preferredContainerdNamespaces are searched (in order) before any other namespace when no namespace is configured:
+// the default namespace used by ctr and nerdctl, followed by the namespaces used by Kubernetes and Docker.
+var preferredContainerdNamespaces = []string{"default", "k8s.io", "moby"}
+
+// ContainerdStoreConfig describes where to find images stored by containerd.
+type ContainerdStoreConfig struct {
+	// Root is the root directory of containerd (defaults to /var/lib/containerd)
+	Root string
+	// Namespace restricts the search for the image to a single containerd namespace (by default all namespaces are
+	// searched, preferring the "default", "k8s.io", and "moby" namespaces)
+	Namespace string
+}
+
+var _ image.Provider = (*containerdStoreImageProvider)(nil)
+
+// containerdStoreImageProvider is an image.Provider which reads images from the metadata database and content store
+// of containerd on disk, so that images can be scanned without access to the containerd API (e.g. on a Kubernetes
+// node, or from a mounted disk of a node).
+type containerdStoreImageProvider struct {
+	reference string
+	platform  *image.Platform
+	cfg       ContainerdStoreConfig
+}
+
+func newContainerdStoreImageProvider(reference string, platform *image.Platform, cfg ContainerdStoreConfig) image.Provider {
+	return &containerdStoreImageProvider{
+		reference: reference,
+		platform:  platform,
+		cfg:       cfg,
+	}
+}
+
+func (p *containerdStoreImageProvider) Name() string {
+	return ContainerdStore
+}
+
+func (p *containerdStoreImageProvider) Provide(_ context.Context) (*image.Image, error) {
+	root := p.cfg.Root
+	if root == "" {
+		root = DefaultContainerdRoot
+	}
+
+	images, err := readContainerdImages(filepath.Join(root, filepath.FromSlash(containerdMetadataDB)))
+	if err != nil {
+		return nil, err
+	}
+
+	stored := selectContainerdImage(images, p.reference, p.cfg.Namespace)
+	if stored == nil {
+		return nil, fmt.Errorf("image %q not found in the containerd store at %q: %w", p.reference, root, os.ErrNotExist)
+	}
+
+	log.WithFields("image", stored.Name, "namespace", stored.Namespace, "digest", stored.Target.Digest).Debug("found image in containerd store")
+
+	layoutDir, err := newContainerdLayout(filepath.Join(root, containerdContentDir), *stored, p.platform)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(layoutDir); err != nil {
+			log.WithFields("path", layoutDir, "error", err).Trace("unable to remove containerd image layout")
+		}
+	}()
+
+	var metadata []image.AdditionalMetadata
+	if !strings.HasPrefix(stored.Name, "sha256:") && !strings.Contains(stored.Name, "@") {
+		metadata = append(metadata, image.WithTags(stored.Name))
+	}
+
+	// the image is fully read (and the layers unpacked) before returning, so the layout is no longer needed after
+	return readOCILayoutImage(layoutDir, p.platform, OCILayoutSelection{}, metadata...)
+}
+
+// containerdImage is an image record from the containerd metadata database.
+type containerdImage struct {
+	Namespace string
+	Name      string
+	Target    v1.Descriptor
+}
+
+// readContainerdImages returns all images (within every namespace) recorded in the given containerd metadata
+// database. The database is copied before reading it, since containerd holds an exclusive lock on the database while
+// it is running.
+func readContainerdImages(dbPath string) ([]containerdImage, error) {
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, fmt.Errorf("unable to find containerd metadata database: %w", err)
+	}
+
+	dbCopy, err := copyToTemp(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(dbCopy)
+
+	db, err := bolt.Open(dbCopy, 0400, &bolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open containerd metadata database %q: %w", dbPath, err)
+	}
+	defer internal.CloseAndLogError(db, dbCopy)
+
+	var images []containerdImage
+	err = db.View(func(tx *bolt.Tx) error {
+		schema := tx.Bucket([]byte(containerdSchema))
+		if schema == nil {
+			return fmt.Errorf("unsupported containerd metadata database schema (no %q bucket)", containerdSchema)
+		}
+
+		return schema.ForEach(func(namespace, v []byte) error {
+			if v != nil {
+				// not a namespace bucket (e.g. the schema version)
+				return nil
+			}
+			imagesBucket := schema.Bucket(namespace).Bucket([]byte(containerdImages))
+			if imagesBucket == nil {
+				return nil
+			}
+			return imagesBucket.ForEach(func(name, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				target, err := readContainerdTarget(imagesBucket.Bucket(name))
+				if err != nil {
+					log.WithFields("image", string(name), "namespace", string(namespace), "error", err).Trace("unable to read containerd image")
+					return nil
+				}
+				images = append(images, containerdImage{
+					Namespace: string(namespace),
+					Name:      string(name),
+					Target:    *target,
+				})
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read containerd metadata database %q: %w", dbPath, err)
+	}
+	return images, nil
+}
+
+func readContainerdTarget(imageBucket *bolt.Bucket) (*v1.Descriptor, error) {
+	targetBucket := imageBucket.Bucket([]byte(containerdTarget))
+	if targetBucket == nil {
+		return nil, errors.New("no target")
+	}
+
+	digest, err := v1.NewHash(string(targetBucket.Get([]byte(containerdDigest))))
+	if err != nil {
+		return nil, fmt.Errorf("invalid target digest: %w", err)
+	}
+	size, _ := binary.Varint(targetBucket.Get([]byte(containerdSize)))
+
+	return &v1.Descriptor{
+		MediaType: types.MediaType(targetBucket.Get([]byte(containerdMediaType))),
+		Digest:    digest,
+		Size:      size,
+	}, nil
+}
+
+// selectContainerdImage returns the image matching the given reference (by name, or by the digest of the image
+// target), preferring the configured namespace (or the preferred namespaces when none is configured).
+func selectContainerdImage(images []containerdImage, reference, namespace string) *containerdImage {
+	normalized := normalizeImageName(reference)
+
+	var candidates []containerdImage
+	for _, img := range images {
+		if namespace != "" && img.Namespace != namespace {
+			continue
+		}
+		if img.Name == reference || img.Name == normalized || img.Target.Digest.String() == reference {
+			candidates = append(candidates, img)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	rank := func(ns string) int {
+		for i, preferred := range preferredContainerdNamespaces {
+			if ns == preferred {
+				return i
+			}
+		}
+		return len(preferredContainerdNamespaces)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ri, rj := rank(candidates[i].Namespace), rank(candidates[j].Namespace)
+		if ri != rj {
+			return ri < rj
+		}
+		return candidates[i].Namespace < candidates[j].Namespace
+	})
+	return &candidates[0]
+}
+
+// normalizeImageName returns the fully qualified image name that container runtimes (containerd, CRI-O) record for
+// the given reference (e.g. "alpine" ---> "docker.io/library/alpine:latest").
+func normalizeImageName(reference string) string {
+	name, digest, hasDigest := strings.Cut(reference, "@")
+
+	domain, remainder, hasDomain := strings.Cut(name, "/")
+	if !hasDomain || (!strings.ContainsAny(domain, ".:") && domain != "localhost") {
+		domain, remainder = "docker.io", name
+	}
+	if domain == "index.docker.io" {
+		domain = "docker.io"
+	}
+	if domain == "docker.io" && !strings.Contains(remainder, "/") {
+		remainder = "library/" + remainder
+	}
+
+	normalized := domain + "/" + remainder
+	if hasDigest {
+		return normalized + "@" + digest
+	}
+	if !strings.Contains(remainder, ":") {
+		normalized += ":latest"
+	}
+	return normalized
+}
+
+// newContainerdLayout creates an OCI layout directory (within a temp directory) for the given image, which shares the
+// blobs of the containerd content store. Only the manifests present within the content store are included (since
+// containerd typically only fetches the manifest for a single platform of a multi-platform image).
+func newContainerdLayout(contentDir string, img containerdImage, platform *image.Platform) (string, error) {
+	blobs := filepath.Join(contentDir, "blobs")
+
+	manifests, err := containerdLayoutManifests(blobs, img.Target, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(manifests) == 0 {
+		return "", fmt.Errorf("no image manifests for %q found in the containerd content store", img.Name)
+	}
+	if len(manifests) > 1 && platform == nil {
+		// fall back to the platform of the host, in the same way that containerd does
+		if host := hostPlatformManifests(manifests); len(host) > 0 {
+			manifests = host
+		}
+	}
+
+	for i := range manifests {
+		if manifests[i].Annotations == nil {
+			manifests[i].Annotations = make(map[string]string)
+		}
+		manifests[i].Annotations[OCIRefNameAnnotation] = img.Name
+	}
+
+	layoutDir, err := os.MkdirTemp("", "syft-containerd-layout-")
+	if err != nil {
+		return "", fmt.Errorf("unable to create tempdir for containerd image layout: %w", err)
+	}
+
+	index, err := json.Marshal(v1.IndexManifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIImageIndex,
+		Manifests:     manifests,
+	})
+	if err == nil {
+		err = os.WriteFile(filepath.Join(layoutDir, containerdLayoutIndex), index, 0600)
+	}
+	if err == nil {
+		err = os.WriteFile(filepath.Join(layoutDir, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0600)
+	}
+	if err == nil {
+		err = os.Symlink(blobs, filepath.Join(layoutDir, "blobs"))
+	}
+	if err != nil {
+		_ = os.RemoveAll(layoutDir)
+		return "", fmt.Errorf("unable to create containerd image layout: %w", err)
+	}
+	return layoutDir, nil
+}
+
+// containerdLayoutManifests returns the image manifests reachable from the given descriptor which are present within
+// the content store, expanding any image indexes.
+func containerdLayoutManifests(blobs string, desc v1.Descriptor, platform *v1.Platform) ([]v1.Descriptor, error) {
+	blob := filepath.Join(blobs, desc.Digest.Algorithm, desc.Digest.Hex)
+	if _, err := os.Stat(blob); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !desc.MediaType.IsIndex() {
+		if !desc.MediaType.IsImage() {
+			return nil, nil
+		}
+		if desc.Platform == nil {
+			desc.Platform = platform
+		}
+		return []v1.Descriptor{desc}, nil
+	}
+
+	contents, err := os.ReadFile(blob)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image index %q: %w", desc.Digest, err)
+	}
+	var index v1.IndexManifest
+	if err := json.Unmarshal(contents, &index); err != nil {
+		return nil, fmt.Errorf("unable to parse image index %q: %w", desc.Digest, err)
+	}
+
+	var manifests []v1.Descriptor
+	for _, child := range index.Manifests {
+		found, err := containerdLayoutManifests(blobs, child, child.Platform)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, found...)
+	}
+	return manifests, nil
+}
+
+func hostPlatformManifests(manifests []v1.Descriptor) []v1.Descriptor {
+	var host []v1.Descriptor
+	for _, m := range manifests {
+		if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == runtime.GOARCH {
+			host = append(host, m)
+		}
+	}
+	return host
+}
+
+func copyToTemp(path string) (string, error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %q: %w", path, err)
+	}
+	defer internal.CloseAndLogError(in, path)
+
+	out, err := os.CreateTemp("", "syft-containerd-meta-")
+	if err != nil {
+		return "", fmt.Errorf("unable to create temp file for %q: %w", path, err)
+	}
+	defer internal.CloseAndLogError(out, out.Name())
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = os.Remove(out.Name())
+		return "", fmt.Errorf("unable to copy %q: %w", path, err)
+	}
+	return out.Name(), nil
+}
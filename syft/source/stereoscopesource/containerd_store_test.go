@@ -0,0 +1,280 @@
+package stereoscopesource
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
+
+type containerdStoreFixture struct {
+	root string
+	app  string
+	host string
+	team string
+}
+
+// newContainerdStoreFixture creates a containerd root directory with the following images:
+//   - "docker.io/library/app:latest" within the "k8s.io" namespace
+//   - "docker.io/library/app:latest" within the "team" namespace (a different image)
+//   - "registry.example.com/multi:1.0" within the "default" namespace, an index with images for the host platform
+//     and another platform, where only the image for the host platform has been fetched
+func newContainerdStoreFixture(t *testing.T) containerdStoreFixture {
+	t.Helper()
+
+	root := t.TempDir()
+	staging := filepath.Join(t.TempDir(), "staging")
+	p, err := layout.Write(staging, empty.Index)
+	require.NoError(t, err)
+
+	descriptorOf := func(img v1.Image) v1.Descriptor {
+		require.NoError(t, p.WriteImage(img))
+		d, err := partialDescriptor(img)
+		require.NoError(t, err)
+		return d
+	}
+
+	newImage := func() v1.Image {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		return img
+	}
+
+	app, team := newImage(), newImage()
+	appDesc, teamDesc := descriptorOf(app), descriptorOf(team)
+
+	otherArch := "arm64"
+	if runtime.GOARCH == otherArch {
+		otherArch = "amd64"
+	}
+	host, other := newImage(), newImage()
+	multi := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: host, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: runtime.GOARCH}}},
+		mutate.IndexAddendum{Add: other, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: otherArch}}},
+	)
+	require.NoError(t, p.WriteIndex(multi))
+	multiDigest, err := multi.Digest()
+	require.NoError(t, err)
+	multiManifest, err := multi.RawManifest()
+	require.NoError(t, err)
+	multiDesc := v1.Descriptor{MediaType: "application/vnd.oci.image.index.v1+json", Digest: multiDigest, Size: int64(len(multiManifest))}
+
+	// containerd only fetches the manifest for the platform of the host
+	otherDigest, err := other.Digest()
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(staging, "blobs", otherDigest.Algorithm, otherDigest.Hex)))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, containerdContentDir), 0755))
+	require.NoError(t, os.Rename(filepath.Join(staging, "blobs"), filepath.Join(root, containerdContentDir, "blobs")))
+
+	writeContainerdMetadata(t, filepath.Join(root, filepath.FromSlash(containerdMetadataDB)), map[string]map[string]v1.Descriptor{
+		"k8s.io":  {"docker.io/library/app:latest": appDesc},
+		"team":    {"docker.io/library/app:latest": teamDesc},
+		"default": {"registry.example.com/multi:1.0": multiDesc},
+	})
+
+	hostDigest, err := host.Digest()
+	require.NoError(t, err)
+
+	return containerdStoreFixture{
+		root: root,
+		app:  appDesc.Digest.String(),
+		host: hostDigest.String(),
+		team: teamDesc.Digest.String(),
+	}
+}
+
+func partialDescriptor(img v1.Image) (v1.Descriptor, error) {
+	digest, err := img.Digest()
+	if err != nil {
+		return v1.Descriptor{}, err
+	}
+	mediaType, err := img.MediaType()
+	if err != nil {
+		return v1.Descriptor{}, err
+	}
+	size, err := img.Size()
+	if err != nil {
+		return v1.Descriptor{}, err
+	}
+	return v1.Descriptor{MediaType: mediaType, Digest: digest, Size: size}, nil
+}
+
+func writeContainerdMetadata(t *testing.T, path string, namespaces map[string]map[string]v1.Descriptor) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	db, err := bolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		schema, err := tx.CreateBucket([]byte(containerdSchema))
+		if err != nil {
+			return err
+		}
+		if err := schema.Put([]byte("version"), binary.AppendVarint(nil, 3)); err != nil {
+			return err
+		}
+		for namespace, images := range namespaces {
+			ns, err := schema.CreateBucket([]byte(namespace))
+			if err != nil {
+				return err
+			}
+			imagesBucket, err := ns.CreateBucket([]byte(containerdImages))
+			if err != nil {
+				return err
+			}
+			for name, desc := range images {
+				imageBucket, err := imagesBucket.CreateBucket([]byte(name))
+				if err != nil {
+					return err
+				}
+				target, err := imageBucket.CreateBucket([]byte(containerdTarget))
+				if err != nil {
+					return err
+				}
+				if err := target.Put([]byte(containerdDigest), []byte(desc.Digest.String())); err != nil {
+					return err
+				}
+				if err := target.Put([]byte(containerdMediaType), []byte(desc.MediaType)); err != nil {
+					return err
+				}
+				if err := target.Put([]byte(containerdSize), binary.AppendVarint(nil, desc.Size)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}))
+}
+
+func Test_normalizeImageName(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      string
+	}{
+		{reference: "alpine", want: "docker.io/library/alpine:latest"},
+		{reference: "alpine:3.19", want: "docker.io/library/alpine:3.19"},
+		{reference: "anchore/syft", want: "docker.io/anchore/syft:latest"},
+		{reference: "index.docker.io/library/alpine:3.19", want: "docker.io/library/alpine:3.19"},
+		{reference: "registry.k8s.io/pause:3.9", want: "registry.k8s.io/pause:3.9"},
+		{reference: "localhost:5000/app", want: "localhost:5000/app:latest"},
+		{reference: "localhost/app", want: "localhost/app:latest"},
+		{reference: "alpine@sha256:abc", want: "docker.io/library/alpine@sha256:abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeImageName(tt.reference))
+		})
+	}
+}
+
+func Test_containerdStoreImageProvider(t *testing.T) {
+	f := newContainerdStoreFixture(t)
+
+	tests := []struct {
+		name       string
+		reference  string
+		namespace  string
+		platform   string
+		wantDigest string
+		wantTags   []string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "short name prefers the kubernetes namespace over others",
+			reference:  "app",
+			wantDigest: f.app,
+			wantTags:   []string{"docker.io/library/app:latest"},
+		},
+		{
+			name:       "explicit namespace",
+			reference:  "docker.io/library/app:latest",
+			namespace:  "team",
+			wantDigest: f.team,
+			wantTags:   []string{"docker.io/library/app:latest"},
+		},
+		{
+			name:       "by target digest",
+			reference:  f.app,
+			wantDigest: f.app,
+			wantTags:   []string{"docker.io/library/app:latest"},
+		},
+		{
+			name:       "multi-platform image with only the host platform fetched",
+			reference:  "registry.example.com/multi:1.0",
+			wantDigest: f.host,
+			wantTags:   []string{"registry.example.com/multi:1.0"},
+		},
+		{
+			name:       "multi-platform image with the host platform requested",
+			reference:  "registry.example.com/multi:1.0",
+			platform:   "linux/" + runtime.GOARCH,
+			wantDigest: f.host,
+			wantTags:   []string{"registry.example.com/multi:1.0"},
+		},
+		{
+			name:      "image not within namespace",
+			reference: "registry.example.com/multi:1.0",
+			namespace: "k8s.io",
+			wantErr:   require.Error,
+		},
+		{
+			name:      "unknown image",
+			reference: "missing",
+			wantErr:   require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			var platform *image.Platform
+			if tt.platform != "" {
+				p, err := image.NewPlatform(tt.platform)
+				require.NoError(t, err)
+				platform = p
+			}
+
+			provider := newContainerdStoreImageProvider(tt.reference, platform, ContainerdStoreConfig{Root: f.root, Namespace: tt.namespace})
+			img, err := provider.Provide(context.Background())
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() {
+				require.NoError(t, img.Cleanup())
+			})
+
+			assert.Equal(t, tt.wantDigest, img.Metadata.ManifestDigest)
+			var tags []string
+			for _, tag := range img.Metadata.Tags {
+				tags = append(tags, tag.String())
+			}
+			assert.Equal(t, tt.wantTags, tags)
+			assert.NotEmpty(t, img.Layers)
+		})
+	}
+}
+
+func Test_containerdStoreImageProvider_missingStore(t *testing.T) {
+	provider := newContainerdStoreImageProvider("alpine", nil, ContainerdStoreConfig{Root: t.TempDir()})
+	_, err := provider.Provide(context.Background())
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
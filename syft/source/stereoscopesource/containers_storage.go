@@ -0,0 +1,383 @@
+package stereoscopesource
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/log"
+)
+
+const (
+	// ContainersStorage is the name of the provider which reads images directly from containers-storage (as used by
+	// CRI-O, podman, and buildah)
+	ContainersStorage = "containers-storage"
+
+	// DefaultContainersStorageRoot is the default root directory of containers-storage
+	DefaultContainersStorageRoot = "/var/lib/containers/storage"
+
+	whiteoutPrefix = ".wh."
+)
+
+// containersStorageDrivers are the supported storage drivers, in the order they are searched for images.
+var containersStorageDrivers = []string{"overlay", "vfs"}
+
+// ContainersStorageConfig describes where to find images stored by containers-storage.
+type ContainersStorageConfig struct {
+	// Root is the root directory of containers-storage. By default, the storage of a rootless user (as found from
+	// storage.conf, or $XDG_DATA_HOME/containers/storage) is searched before the system storage (defaults to
+	// /var/lib/containers/storage).
+	Root string
+}
+
+var _ image.Provider = (*containersStorageImageProvider)(nil)
+
+// containersStorageImageProvider is an image.Provider which reads images from containers-storage on disk, so that
+// images pulled by CRI-O (e.g. on an OpenShift node) or podman (including rootless podman) can be scanned without
+// access to any API. Since
+// containers-storage only keeps the unpacked layers of an image, each layer is re-packed from its directory.
+type containersStorageImageProvider struct {
+	reference string
+	cfg       ContainersStorageConfig
+}
+
+func newContainersStorageImageProvider(reference string, cfg ContainersStorageConfig) image.Provider {
+	return &containersStorageImageProvider{
+		reference: reference,
+		cfg:       cfg,
+	}
+}
+
+func (p *containersStorageImageProvider) Name() string {
+	return ContainersStorage
+}
+
+// containersStorageImage is an image record from the images.json file of a storage driver.
+type containersStorageImage struct {
+	ID           string   `json:"id"`
+	Digest       string   `json:"digest"`
+	Names        []string `json:"names"`
+	TopLayer     string   `json:"layer"`
+	BigDataNames []string `json:"big-data-names"`
+}
+
+// containersStorageLayer is a layer record from the layers.json file of a storage driver.
+type containersStorageLayer struct {
+	ID     string `json:"id"`
+	Parent string `json:"parent"`
+}
+
+func (p *containersStorageImageProvider) Provide(_ context.Context) (*image.Image, error) {
+	root, driver, stored, err := searchContainersStorage(containersStorageRoots(p.cfg.Root, currentContainersStorageEnv()), p.reference)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields("image", p.reference, "id", stored.ID, "root", root, "driver", driver).Debug("found image in containers-storage")
+
+	layerDirs, err := containersStorageLayerDirs(root, driver, stored.TopLayer)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDirGen := file.NewTempDirGenerator("syft-containers-storage")
+	layerTempDir, err := tmpDirGen.NewDirectory("layers")
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := newContainersStorageImage(root, driver, *stored, layerDirs, layerTempDir)
+	if err != nil {
+		_ = tmpDirGen.Cleanup()
+		return nil, err
+	}
+
+	var metadata []image.AdditionalMetadata
+	if len(stored.Names) > 0 {
+		metadata = append(metadata, image.WithTags(stored.Names...))
+	}
+	if stored.Digest != "" {
+		metadata = append(metadata, image.WithManifestDigest(stored.Digest))
+	}
+
+	contentTempDir, err := tmpDirGen.NewDirectory("containers-storage-image")
+	if err != nil {
+		_ = tmpDirGen.Cleanup()
+		return nil, err
+	}
+
+	out := image.New(img, tmpDirGen, contentTempDir, metadata...)
+	if err := out.Read(); err != nil {
+		_ = out.Cleanup()
+		return nil, err
+	}
+	return out, nil
+}
+
+// searchContainersStorage returns the image matching the given reference from the first of the given root directories
+// holding it, along with the root directory and the name of the storage driver that holds the image.
+func searchContainersStorage(roots []string, reference string) (string, string, *containersStorageImage, error) {
+	var lastErr error
+	for _, root := range roots {
+		driver, stored, err := findContainersStorageImage(root, reference)
+		if err == nil {
+			return root, driver, stored, nil
+		}
+		log.WithFields("root", root, "error", err).Trace("image not found in containers-storage")
+		if lastErr == nil || errors.Is(lastErr, os.ErrNotExist) {
+			// prefer reporting errors other than a missing image (e.g. permission errors)
+			lastErr = err
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no containers-storage root directories to search: %w", os.ErrNotExist)
+	}
+	return "", "", nil, lastErr
+}
+
+// findContainersStorageImage returns the image matching the given reference (by name or ID), along with the name of
+// the storage driver that holds the image.
+func findContainersStorageImage(root, reference string) (string, *containersStorageImage, error) {
+	normalized := normalizeImageName(reference)
+	// images built locally (e.g. by podman or buildah) are named within the "localhost" domain
+	local := normalizeImageName("localhost/" + reference)
+	id := strings.TrimPrefix(reference, "sha256:")
+
+	var found bool
+	for _, driver := range containersStorageDrivers {
+		contents, err := os.ReadFile(filepath.Join(root, driver+"-images", "images.json"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", nil, fmt.Errorf("unable to read containers-storage images: %w", err)
+		}
+		found = true
+
+		var images []containersStorageImage
+		if err := json.Unmarshal(contents, &images); err != nil {
+			return "", nil, fmt.Errorf("unable to parse containers-storage images for the %s driver: %w", driver, err)
+		}
+
+		for i, img := range images {
+			// image IDs are commonly shown truncated (e.g. by "crictl images" or "podman images")
+			if len(id) >= 12 && strings.HasPrefix(img.ID, id) {
+				return driver, &images[i], nil
+			}
+			for _, name := range img.Names {
+				if name == reference || name == normalized || name == local {
+					return driver, &images[i], nil
+				}
+			}
+		}
+	}
+
+	if !found {
+		return "", nil, fmt.Errorf("unable to find containers-storage at %q: %w", root, os.ErrNotExist)
+	}
+	return "", nil, fmt.Errorf("image %q not found in containers-storage at %q: %w", reference, root, os.ErrNotExist)
+}
+
+// containersStorageLayerDirs returns the directories holding the contents of each layer of an image (from the base
+// layer to the given top layer).
+func containersStorageLayerDirs(root, driver, topLayer string) ([]string, error) {
+	if topLayer == "" {
+		// an image without any layers (e.g. "FROM scratch" with only metadata)
+		return nil, nil
+	}
+
+	if driver == "vfs" {
+		// each vfs layer is a full copy of the filesystem of all layers beneath it
+		return []string{filepath.Join(root, "vfs", "dir", topLayer)}, nil
+	}
+
+	contents, err := os.ReadFile(filepath.Join(root, driver+"-layers", "layers.json"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read containers-storage layers: %w", err)
+	}
+	var layers []containersStorageLayer
+	if err := json.Unmarshal(contents, &layers); err != nil {
+		return nil, fmt.Errorf("unable to parse containers-storage layers: %w", err)
+	}
+	byID := make(map[string]containersStorageLayer, len(layers))
+	for _, l := range layers {
+		byID[l.ID] = l
+	}
+
+	var dirs []string
+	for id := topLayer; id != ""; {
+		l, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("containers-storage layer %q not found", id)
+		}
+		if len(dirs) > len(layers) {
+			return nil, fmt.Errorf("cycle found in containers-storage layers at %q", id)
+		}
+		dirs = append([]string{filepath.Join(root, driver, l.ID, "diff")}, dirs...)
+		id = l.Parent
+	}
+	return dirs, nil
+}
+
+// newContainersStorageImage builds an image from the given layer directories, keeping the configuration of the
+// stored image (where the layer digests are those of the re-packed layers).
+func newContainersStorageImage(root, driver string, stored containersStorageImage, layerDirs []string, tempDir string) (v1.Image, error) {
+	var layers []v1.Layer
+	for i, dir := range layerDirs {
+		tarPath := filepath.Join(tempDir, fmt.Sprintf("layer-%d.tar", i))
+		if err := writeLayerTar(dir, tarPath); err != nil {
+			return nil, err
+		}
+		layer, err := tarball.LayerFromFile(tarPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read re-packed layer %q: %w", dir, err)
+		}
+		layers = append(layers, layer)
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, layers...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build image from containers-storage layers: %w", err)
+	}
+
+	config := readContainersStorageConfig(root, driver, stored)
+	if config == nil {
+		return img, nil
+	}
+
+	cf, err := img.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	cf = cf.DeepCopy()
+	cf.Architecture = config.Architecture
+	cf.OS = config.OS
+	cf.Variant = config.Variant
+	cf.Author = config.Author
+	cf.Created = config.Created
+	cf.Config = config.Config
+	cf.History = config.History
+
+	return mutate.ConfigFile(img, cf)
+}
+
+// readContainersStorageConfig returns the configuration of the stored image, which is kept as "big data" of the image
+// keyed by the config digest (the image ID).
+func readContainersStorageConfig(root, driver string, stored containersStorageImage) *v1.ConfigFile {
+	key := "sha256:" + stored.ID
+	for _, name := range stored.BigDataNames {
+		if name != key {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(root, driver+"-images", stored.ID, bigDataFileName(name)))
+		if err != nil {
+			log.WithFields("image", stored.ID, "error", err).Debug("unable to read containers-storage image config")
+			return nil
+		}
+		cf, err := v1.ParseConfigFile(strings.NewReader(string(contents)))
+		if err != nil {
+			log.WithFields("image", stored.ID, "error", err).Debug("unable to parse containers-storage image config")
+			return nil
+		}
+		return cf
+	}
+	return nil
+}
+
+// bigDataFileName returns the name of the file holding the given big data key of an image, where keys holding anything
+// other than lowercase letters, digits, and dots (such as digests) are base64 encoded.
+func bigDataFileName(key string) string {
+	for _, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '.') {
+			return "=" + base64.StdEncoding.EncodeToString([]byte(key))
+		}
+	}
+	return key
+}
+
+// writeLayerTar re-packs the directory of an unpacked layer into a tar file, converting overlay whiteouts (character
+// devices) into OCI whiteout files. Note: opaque directories (which overlay marks with an extended attribute) are
+// packed as regular directories.
+func writeLayerTar(dir, tarPath string) error {
+	out, err := os.Create(tarPath)
+	if err != nil {
+		return fmt.Errorf("unable to create layer tar: %w", err)
+	}
+	defer internal.CloseAndLogError(out, tarPath)
+
+	tw := tar.NewWriter(out)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&fs.ModeCharDevice != 0 {
+			// overlay represents removed files with character devices, which are whiteout files within an OCI layer
+			return tw.WriteHeader(&tar.Header{
+				Name:     filepath.ToSlash(filepath.Join(filepath.Dir(rel), whiteoutPrefix+filepath.Base(rel))),
+				Typeflag: tar.TypeReg,
+				Mode:     0600,
+			})
+		}
+
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer internal.CloseAndLogError(f, path)
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to re-pack layer %q: %w", dir, err)
+	}
+	return tw.Close()
+}
@@ -0,0 +1,138 @@
+package stereoscopesource
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/BurntSushi/toml"
+	"github.com/adrg/xdg"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// containersStorageSystemConfigs are the system-wide storage.conf files, where the first one found is used.
+var containersStorageSystemConfigs = []string{
+	"/etc/containers/storage.conf",
+	"/usr/share/containers/storage.conf",
+}
+
+// containersStorageEnv describes the environment used to find the containers-storage root directories of the current
+// user, as done by podman.
+type containersStorageEnv struct {
+	uid           int
+	home          string
+	user          string
+	configHome    string
+	dataHome      string
+	configFile    string
+	systemConfigs []string
+}
+
+// storageConf is the subset of a storage.conf file needed to find the root directory of containers-storage.
+type storageConf struct {
+	Storage struct {
+		GraphRoot           string `toml:"graphroot"`
+		RootlessStoragePath string `toml:"rootless_storage_path"`
+	} `toml:"storage"`
+}
+
+func currentContainersStorageEnv() containersStorageEnv {
+	home, _ := os.UserHomeDir()
+	return containersStorageEnv{
+		uid:           os.Getuid(),
+		home:          home,
+		user:          os.Getenv("USER"),
+		configHome:    xdg.ConfigHome,
+		dataHome:      xdg.DataHome,
+		configFile:    os.Getenv("CONTAINERS_STORAGE_CONF"),
+		systemConfigs: containersStorageSystemConfigs,
+	}
+}
+
+// containersStorageRoots returns the root directories of containers-storage to search for images, in order. Unless a
+// root is given explicitly, the storage of a rootless user (as used by rootless podman) is searched before the system
+// storage (as used by CRI-O and podman running as root).
+func containersStorageRoots(root string, env containersStorageEnv) []string {
+	if root != "" {
+		return []string{root}
+	}
+
+	var roots []string
+	if env.uid != 0 {
+		roots = append(roots, env.rootlessRoot())
+	}
+	if system := env.systemRoot(); len(roots) == 0 || roots[0] != system {
+		roots = append(roots, system)
+	}
+	return roots
+}
+
+// rootlessRoot returns the root directory of the containers-storage of a rootless user: the "graphroot" of the storage.conf
+// of the user, the "rootless_storage_path" of the system storage.conf, or otherwise $XDG_DATA_HOME/containers/storage.
+func (e containersStorageEnv) rootlessRoot() string {
+	if conf := e.userConf(); conf != nil && conf.Storage.GraphRoot != "" {
+		return e.expand(conf.Storage.GraphRoot)
+	}
+	if conf := e.systemConf(); conf != nil && conf.Storage.RootlessStoragePath != "" {
+		return e.expand(conf.Storage.RootlessStoragePath)
+	}
+	return filepath.Join(e.dataHome, "containers", "storage")
+}
+
+// systemRoot returns the root directory of the system containers-storage: the "graphroot" of the system storage.conf,
+// or otherwise /var/lib/containers/storage.
+func (e containersStorageEnv) systemRoot() string {
+	conf := e.systemConf()
+	if e.configFile != "" {
+		conf = readStorageConf(e.configFile)
+	}
+	if conf != nil && conf.Storage.GraphRoot != "" {
+		return e.expand(conf.Storage.GraphRoot)
+	}
+	return DefaultContainersStorageRoot
+}
+
+func (e containersStorageEnv) userConf() *storageConf {
+	if e.configFile != "" {
+		return readStorageConf(e.configFile)
+	}
+	return readStorageConf(filepath.Join(e.configHome, "containers", "storage.conf"))
+}
+
+func (e containersStorageEnv) systemConf() *storageConf {
+	for _, path := range e.systemConfigs {
+		if conf := readStorageConf(path); conf != nil {
+			return conf
+		}
+	}
+	return nil
+}
+
+// expand replaces the variables supported within storage.conf paths ($HOME, $UID, and $USER).
+func (e containersStorageEnv) expand(path string) string {
+	return os.Expand(path, func(name string) string {
+		switch name {
+		case "HOME":
+			return e.home
+		case "UID":
+			return strconv.Itoa(e.uid)
+		case "USER":
+			return e.user
+		}
+		return ""
+	})
+}
+
+func readStorageConf(path string) *storageConf {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var conf storageConf
+	if err := toml.Unmarshal(contents, &conf); err != nil {
+		log.WithFields("path", path, "error", err).Debug("unable to parse containers-storage configuration")
+		return nil
+	}
+	return &conf
+}
@@ -0,0 +1,110 @@
+package stereoscopesource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_containersStorageRoots(t *testing.T) {
+	writeConf := func(t *testing.T, contents string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "storage.conf")
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+		return path
+	}
+
+	tests := []struct {
+		name       string
+		root       string
+		uid        int
+		userConf   string
+		systemConf string
+		configFile string
+		want       func(env containersStorageEnv) []string
+	}{
+		{
+			name: "explicit root",
+			root: "/srv/storage",
+			uid:  1000,
+			want: func(containersStorageEnv) []string {
+				return []string{"/srv/storage"}
+			},
+		},
+		{
+			name: "root user without any configuration",
+			uid:  0,
+			want: func(containersStorageEnv) []string {
+				return []string{DefaultContainersStorageRoot}
+			},
+		},
+		{
+			name:       "root user with system configuration",
+			uid:        0,
+			systemConf: "[storage]\ndriver = \"overlay\"\ngraphroot = \"/data/containers\"\n",
+			want: func(containersStorageEnv) []string {
+				return []string{"/data/containers"}
+			},
+		},
+		{
+			name: "rootless user without any configuration",
+			uid:  1000,
+			want: func(env containersStorageEnv) []string {
+				return []string{filepath.Join(env.dataHome, "containers", "storage"), DefaultContainersStorageRoot}
+			},
+		},
+		{
+			name:     "rootless user with user configuration",
+			uid:      1000,
+			userConf: "[storage]\ngraphroot = \"$HOME/podman-storage\"\n",
+			want: func(env containersStorageEnv) []string {
+				return []string{filepath.Join(env.home, "podman-storage"), DefaultContainersStorageRoot}
+			},
+		},
+		{
+			name:       "rootless user with rootless storage path within system configuration",
+			uid:        1000,
+			systemConf: "[storage]\ngraphroot = \"/data/containers\"\nrootless_storage_path = \"/data/users/$USER-$UID\"\n",
+			want: func(containersStorageEnv) []string {
+				return []string{"/data/users/someone-1000", "/data/containers"}
+			},
+		},
+		{
+			name:       "configuration file from the environment",
+			uid:        1000,
+			userConf:   "[storage]\ngraphroot = \"/ignored\"\n",
+			configFile: "[storage]\ngraphroot = \"/custom\"\n",
+			want: func(containersStorageEnv) []string {
+				return []string{"/custom"}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := containersStorageEnv{
+				uid:        tt.uid,
+				home:       t.TempDir(),
+				user:       "someone",
+				configHome: t.TempDir(),
+				dataHome:   t.TempDir(),
+			}
+			if tt.userConf != "" {
+				path := filepath.Join(env.configHome, "containers", "storage.conf")
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(tt.userConf), 0644))
+			}
+			env.systemConfigs = []string{filepath.Join(t.TempDir(), "missing.conf")}
+			if tt.systemConf != "" {
+				env.systemConfigs = append(env.systemConfigs, writeConf(t, tt.systemConf))
+			}
+			if tt.configFile != "" {
+				env.configFile = writeConf(t, tt.configFile)
+			}
+
+			assert.Equal(t, tt.want(env), containersStorageRoots(tt.root, env))
+		})
+	}
+}
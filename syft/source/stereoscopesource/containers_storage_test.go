@@ -0,0 +1,185 @@
+package stereoscopesource
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	stereoscopeFile "github.com/anchore/stereoscope/pkg/file"
+)
+
+const (
+	containersStorageAppID   = "4d1b7d7b4f2cfbf8a7f3b7c14b4fa3a1a9a2c0b7f9e1d8c6b5a4f3e2d1c0b9a8"
+	containersStorageLocalID = "9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b"
+)
+
+// newContainersStorageFixture creates a containers-storage root directory with the following images:
+//   - "quay.io/example/app:1.0" (overlay driver), with a base layer and a layer replacing /etc/os-release
+//   - "localhost/tool:latest" (vfs driver), with a single layer
+func newContainersStorageFixture(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+	write := func(path, contents string) {
+		t.Helper()
+		path = filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	}
+	writeJSON := func(path string, v any) {
+		t.Helper()
+		contents, err := json.Marshal(v)
+		require.NoError(t, err)
+		write(path, string(contents))
+	}
+
+	configKey := "sha256:" + containersStorageAppID
+	writeJSON("overlay-images/images.json", []map[string]any{
+		{
+			"id":             containersStorageAppID,
+			"digest":         "sha256:0123456789012345678901234567890123456789012345678901234567890123",
+			"names":          []string{"quay.io/example/app:1.0"},
+			"layer":          "top",
+			"big-data-names": []string{"manifest", configKey},
+		},
+	})
+	writeJSON("overlay-images/"+containersStorageAppID+"/"+bigDataFileName(configKey), v1.ConfigFile{
+		Architecture: "amd64",
+		OS:           "linux",
+		Config:       v1.Config{Env: []string{"APP=1"}},
+	})
+	writeJSON("overlay-layers/layers.json", []map[string]any{
+		{"id": "base"},
+		{"id": "top", "parent": "base"},
+	})
+	write("overlay/base/diff/etc/os-release", "ID=base\n")
+	write("overlay/base/diff/usr/bin/app", "app")
+	write("overlay/top/diff/etc/os-release", "ID=top\n")
+
+	writeJSON("vfs-images/images.json", []map[string]any{
+		{
+			"id":    containersStorageLocalID,
+			"names": []string{"localhost/tool:latest"},
+			"layer": "tool",
+		},
+	})
+	write("vfs/dir/tool/usr/bin/tool", "tool")
+	require.NoError(t, os.Symlink("tool", filepath.Join(root, "vfs", "dir", "tool", "usr", "bin", "tool-link")))
+
+	return root
+}
+
+func Test_containersStorageImageProvider(t *testing.T) {
+	root := newContainersStorageFixture(t)
+
+	tests := []struct {
+		name       string
+		reference  string
+		wantTags   []string
+		wantLayers int
+		wantFiles  map[string]string
+		wantEnv    []string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			name:       "overlay image by name",
+			reference:  "quay.io/example/app:1.0",
+			wantTags:   []string{"quay.io/example/app:1.0"},
+			wantLayers: 2,
+			wantFiles: map[string]string{
+				"/etc/os-release": "ID=top\n",
+				"/usr/bin/app":    "app",
+			},
+			wantEnv: []string{"APP=1"},
+		},
+		{
+			name:       "overlay image by truncated ID",
+			reference:  containersStorageAppID[:12],
+			wantTags:   []string{"quay.io/example/app:1.0"},
+			wantLayers: 2,
+			wantFiles: map[string]string{
+				"/etc/os-release": "ID=top\n",
+			},
+			wantEnv: []string{"APP=1"},
+		},
+		{
+			name:       "local vfs image by short name",
+			reference:  "tool",
+			wantTags:   []string{"localhost/tool:latest"},
+			wantLayers: 1,
+			wantFiles: map[string]string{
+				"/usr/bin/tool":      "tool",
+				"/usr/bin/tool-link": "tool",
+			},
+		},
+		{
+			name:      "unknown image",
+			reference: "quay.io/example/app:2.0",
+			wantErr:   require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			img, err := newContainersStorageImageProvider(tt.reference, ContainersStorageConfig{Root: root}).Provide(context.Background())
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() {
+				require.NoError(t, img.Cleanup())
+			})
+
+			var tags []string
+			for _, tag := range img.Metadata.Tags {
+				tags = append(tags, tag.String())
+			}
+			assert.Equal(t, tt.wantTags, tags)
+			assert.Len(t, img.Layers, tt.wantLayers)
+			assert.Equal(t, tt.wantEnv, img.Metadata.Config.Config.Env)
+
+			for path, want := range tt.wantFiles {
+				reader, err := img.OpenPathFromSquash(stereoscopeFile.Path(path))
+				require.NoError(t, err, path)
+				contents, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				require.NoError(t, reader.Close())
+				assert.Equal(t, want, string(contents), path)
+			}
+		})
+	}
+}
+
+func Test_containersStorageImageProvider_missingStorage(t *testing.T) {
+	_, err := newContainersStorageImageProvider("alpine", ContainersStorageConfig{Root: t.TempDir()}).Provide(context.Background())
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_bigDataFileName(t *testing.T) {
+	assert.Equal(t, "manifest", bigDataFileName("manifest"))
+	assert.Equal(t, "=c2hhMjU2OmFiYw==", bigDataFileName("sha256:abc"))
+}
+
+func Test_searchContainersStorage(t *testing.T) {
+	empty := t.TempDir()
+	root := newContainersStorageFixture(t)
+
+	found, driver, stored, err := searchContainersStorage([]string{empty, root}, "tool")
+	require.NoError(t, err)
+	assert.Equal(t, root, found)
+	assert.Equal(t, "vfs", driver)
+	assert.Equal(t, containersStorageLocalID, stored.ID)
+
+	_, _, _, err = searchContainersStorage([]string{empty, root}, "missing")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -90,6 +90,9 @@ func (s stereoscopeImageSource) Describe() source.Description {
 		ID:       string(s.id),
 		Name:     name,
 		Version:  version,
+		Supplier: a.Supplier,
+		PURL:     a.PURL,
+		CPE:      a.CPE,
 		Metadata: s.metadata,
 	}
 }
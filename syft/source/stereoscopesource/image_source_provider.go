@@ -6,6 +6,9 @@ import (
 	"github.com/anchore/go-collections"
 	"github.com/anchore/stereoscope"
 	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/containerd"
+	"github.com/anchore/stereoscope/pkg/image/oci"
+	"github.com/anchore/stereoscope/pkg/image/podman"
 	"github.com/anchore/syft/syft/source"
 )
 
@@ -15,6 +18,9 @@ type ProviderConfig struct {
 	StereoscopeImageProviderConfig stereoscope.ImageProviderConfig
 	Exclude                        source.ExcludeConfig
 	Alias                          source.Alias
+	OCILayout                      OCILayoutSelection
+	Containerd                     ContainerdStoreConfig
+	ContainersStorage              ContainersStorageConfig
 }
 
 type stereoscopeImageSourceProvider struct {
@@ -47,12 +53,39 @@ func Providers(cfg ProviderConfig) []collections.TaggedValue[source.Provider] {
 	stereoscopeProviders := collections.TaggedValueSet[source.Provider]{}
 	providers := stereoscope.ImageProviders(cfg.StereoscopeImageProviderConfig)
 	for _, provider := range providers {
+		imageProvider := provider.Value
+		if imageProvider.Name() == oci.Directory {
+			// replace the stereoscope OCI directory provider with one that supports layouts with multiple images
+			imageProvider = newOCILayoutImageProvider(cfg.StereoscopeImageProviderConfig.UserInput, cfg.StereoscopeImageProviderConfig.Platform, cfg.OCILayout)
+		}
 		var sourceProvider source.Provider = stereoscopeImageSourceProvider{
-			stereoscopeProvider: provider.Value,
+			stereoscopeProvider: imageProvider,
 			cfg:                 cfg,
 		}
 		stereoscopeProviders = append(stereoscopeProviders,
 			collections.NewTaggedValue(sourceProvider, append([]string{provider.Value.Name(), ImageTag}, provider.Tags...)...))
+
+		if provider.Value.Name() == podman.Daemon {
+			// when the podman API socket is unavailable, images pulled by podman (including rootless podman) or CRI-O
+			// may be read directly from containers-storage on disk (this is also selected with --from podman)
+			var containersStorageProvider source.Provider = stereoscopeImageSourceProvider{
+				stereoscopeProvider: newContainersStorageImageProvider(cfg.StereoscopeImageProviderConfig.UserInput, cfg.ContainersStorage),
+				cfg:                 cfg,
+			}
+			stereoscopeProviders = append(stereoscopeProviders,
+				collections.NewTaggedValue(containersStorageProvider, ContainersStorage, ImageTag, podman.Daemon, stereoscope.PullTag))
+		}
+
+		if provider.Value.Name() == containerd.Daemon {
+			// when the containerd API is unavailable (or the image is within another namespace), the image may still
+			// be read directly from the containerd store on disk (this is also selected with --from containerd)
+			var storeProvider source.Provider = stereoscopeImageSourceProvider{
+				stereoscopeProvider: newContainerdStoreImageProvider(cfg.StereoscopeImageProviderConfig.UserInput, cfg.StereoscopeImageProviderConfig.Platform, cfg.Containerd),
+				cfg:                 cfg,
+			}
+			stereoscopeProviders = append(stereoscopeProviders,
+				collections.NewTaggedValue(storeProvider, ContainerdStore, ImageTag, containerd.Daemon, stereoscope.PullTag))
+		}
 	}
 	return stereoscopeProviders
 }
@@ -0,0 +1,147 @@
+package stereoscopesource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/oci"
+	"github.com/anchore/syft/internal/registry"
+)
+
+// unknownPlatform is the platform of non-image manifests within an index (e.g. build attestations).
+const unknownPlatform = "unknown/unknown"
+
+// IndexPlatforms returns the platforms (e.g. "linux/arm64/v8") of the images within the multi-platform image (manifest
+// list or OCI index) that the user input refers to, in index order, along with the name of the source provider to
+// read the image of each platform with. Multi-platform images may be read from OCI layout directories (where any
+// selection narrows the images considered) or from registries, and the given sources (if any) limit which are tried.
+func IndexPlatforms(ctx context.Context, userInput string, sources []string, registryOptions *image.RegistryOptions, selection OCILayoutSelection) (string, []string, error) {
+	allowed := func(name string) bool {
+		if len(sources) == 0 {
+			return true
+		}
+		for _, s := range sources {
+			if s == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if allowed(oci.Directory) && isOCILayout(userInput) {
+		images, err := OCILayoutImages(userInput)
+		if err != nil {
+			return "", nil, err
+		}
+		var platforms []string
+		for _, img := range FilterOCILayoutImages(images, selection, nil) {
+			platforms = append(platforms, img.Platform)
+		}
+		platforms = uniquePlatforms(platforms)
+		if len(platforms) == 0 {
+			return "", nil, fmt.Errorf("no images with a platform found in OCI layout %q", userInput)
+		}
+		return oci.Directory, platforms, nil
+	}
+
+	if !allowed(oci.Registry) {
+		return "", nil, fmt.Errorf("multi-platform images can only be read from OCI layout directories or registries")
+	}
+
+	var registry image.RegistryOptions
+	if registryOptions != nil {
+		registry = *registryOptions
+	}
+	platforms, err := registryIndexPlatforms(ctx, userInput, registry)
+	if err != nil {
+		return "", nil, err
+	}
+	return oci.Registry, platforms, nil
+}
+
+func isOCILayout(path string) bool {
+	_, err := os.Stat(filepath.Join(path, "index.json"))
+	return err == nil
+}
+
+func registryIndexPlatforms(ctx context.Context, reference string, registryOptions image.RegistryOptions) ([]string, error) {
+	var nameOptions []name.Option
+	if registryOptions.InsecureUseHTTP {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	ref, err := name.ParseReference(reference, nameOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse registry reference %q: %w", reference, err)
+	}
+
+	desc, err := remote.Get(ref, registry.RemoteOptions(ctx, ref, registryOptions)...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get image descriptor from registry: %w", err)
+	}
+	if !desc.MediaType.IsIndex() {
+		return nil, fmt.Errorf("image %q is not a multi-platform image (got media type %q)", reference, desc.MediaType)
+	}
+
+	index, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image index from registry: %w", err)
+	}
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse image index from registry: %w", err)
+	}
+
+	var platforms []string
+	for _, m := range indexManifest.Manifests {
+		if !m.MediaType.IsImage() || m.Platform == nil {
+			continue
+		}
+		platforms = append(platforms, m.Platform.String())
+	}
+	platforms = uniquePlatforms(platforms)
+	if len(platforms) == 0 {
+		return nil, fmt.Errorf("no images with a platform found in image index %q", reference)
+	}
+	return platforms, nil
+}
+
+// uniquePlatforms removes empty and unknown platforms, along with any repeated platforms. Any OS version (e.g. of
+// windows images) is removed from the platforms, since it cannot be used to select an image.
+func uniquePlatforms(platforms []string) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	for _, p := range platforms {
+		p, _, _ = strings.Cut(p, ":")
+		if p == "" || p == unknownPlatform {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		out = append(out, p)
+	}
+	return out
+}
+
+// MatchPlatforms returns the given platforms which match any of the requested platforms (e.g. "linux/arm64" or
+// "arm64"), in order.
+func MatchPlatforms(platforms []string, requested ...*image.Platform) []string {
+	var out []string
+	for _, p := range platforms {
+		for _, r := range requested {
+			if ociPlatformMatches(p, r) {
+				out = append(out, p)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package stereoscopesource
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/oci"
+)
+
+// newMultiPlatformIndex creates an index with an image for each of the given platforms, along with an attestation
+// manifest (as added by buildx) for the first image.
+func newMultiPlatformIndex(t *testing.T, platforms ...string) v1.ImageIndex {
+	t.Helper()
+
+	var addenda []mutate.IndexAddendum
+	for _, p := range platforms {
+		platform, err := v1.ParsePlatform(p)
+		require.NoError(t, err)
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		addenda = append(addenda, mutate.IndexAddendum{Add: img, Descriptor: v1.Descriptor{Platform: platform}})
+	}
+
+	attestation, err := random.Image(64, 1)
+	require.NoError(t, err)
+	addenda = append(addenda, mutate.IndexAddendum{
+		Add:        attestation,
+		Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "unknown", Architecture: "unknown"}},
+	})
+
+	return mutate.AppendManifests(empty.Index, addenda...)
+}
+
+func TestIndexPlatforms(t *testing.T) {
+	index := newMultiPlatformIndex(t, "linux/amd64", "linux/arm64/v8", "linux/arm/v7")
+	want := []string{"linux/amd64", "linux/arm64/v8", "linux/arm/v7"}
+
+	t.Run("OCI layout", func(t *testing.T) {
+		dir := t.TempDir()
+		p, err := layout.Write(dir, empty.Index)
+		require.NoError(t, err)
+		require.NoError(t, p.AppendIndex(index, layout.WithAnnotations(map[string]string{OCIRefNameAnnotation: "app"})))
+
+		sourceName, platforms, err := IndexPlatforms(context.Background(), dir, nil, nil, OCILayoutSelection{})
+		require.NoError(t, err)
+		assert.Equal(t, oci.Directory, sourceName)
+		assert.Equal(t, want, platforms)
+
+		_, _, err = IndexPlatforms(context.Background(), dir, nil, nil, OCILayoutSelection{Name: "other"})
+		require.Error(t, err)
+	})
+
+	t.Run("registry", func(t *testing.T) {
+		server := httptest.NewServer(registry.New())
+		t.Cleanup(server.Close)
+
+		reference := strings.TrimPrefix(server.URL, "http://") + "/app:latest"
+		ref, err := name.ParseReference(reference)
+		require.NoError(t, err)
+		require.NoError(t, remote.WriteIndex(ref, index))
+
+		sourceName, platforms, err := IndexPlatforms(context.Background(), reference, nil, &image.RegistryOptions{}, OCILayoutSelection{})
+		require.NoError(t, err)
+		assert.Equal(t, oci.Registry, sourceName)
+		assert.Equal(t, want, platforms)
+
+		// single platform images cannot be scanned by platform
+		single := strings.TrimPrefix(server.URL, "http://") + "/single:latest"
+		singleRef, err := name.ParseReference(single)
+		require.NoError(t, err)
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(singleRef, img))
+
+		_, _, err = IndexPlatforms(context.Background(), single, nil, nil, OCILayoutSelection{})
+		require.ErrorContains(t, err, "not a multi-platform image")
+	})
+
+	t.Run("unsupported source", func(t *testing.T) {
+		_, _, err := IndexPlatforms(context.Background(), "alpine:latest", []string{"docker"}, nil, OCILayoutSelection{})
+		require.Error(t, err)
+	})
+}
+
+func TestMatchPlatforms(t *testing.T) {
+	available := []string{"linux/amd64", "linux/arm64/v8", "linux/arm/v7", "windows/amd64"}
+
+	parse := func(values ...string) []*image.Platform {
+		var out []*image.Platform
+		for _, v := range values {
+			p, err := image.NewPlatform(v)
+			require.NoError(t, err)
+			out = append(out, p)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64/v8"}, MatchPlatforms(available, parse("linux/arm64", "linux/amd64")...))
+	assert.Equal(t, []string{"linux/arm/v7"}, MatchPlatforms(available, parse("linux/arm/v7")...))
+	assert.Equal(t, []string{"windows/amd64"}, MatchPlatforms(available, parse("windows/amd64")...))
+	assert.Empty(t, MatchPlatforms(available, parse("linux/s390x")...))
+}
+
+func Test_uniquePlatforms(t *testing.T) {
+	assert.Equal(t,
+		[]string{"linux/amd64", "windows/amd64"},
+		uniquePlatforms([]string{"", "linux/amd64", "unknown/unknown", "linux/amd64", "windows/amd64:10.0.17763.1879"}),
+	)
+}
@@ -0,0 +1,344 @@
+package stereoscopesource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+
+	"github.com/anchore/stereoscope/pkg/file"
+	"github.com/anchore/stereoscope/pkg/image"
+	"github.com/anchore/stereoscope/pkg/image/oci"
+)
+
+// OCIRefNameAnnotation is the annotation used within an OCI layout index to name (tag) an image.
+const OCIRefNameAnnotation = "org.opencontainers.image.ref.name"
+
+// OCILayoutImage describes a single image found within the index of an OCI image layout directory.
+type OCILayoutImage struct {
+	// Digest is the digest of the image manifest
+	Digest string
+	// Name is the value of the "org.opencontainers.image.ref.name" annotation (if any)
+	Name string
+	// Platform is the platform of the image, when described by the index (e.g. "linux/arm64")
+	Platform string
+	// Annotations are all annotations found on the index descriptor (and any parent index descriptors)
+	Annotations map[string]string
+}
+
+// String returns a human-readable identifier for the image (the name if available, otherwise the digest).
+func (i OCILayoutImage) String() string {
+	var fields []string
+	if i.Name != "" {
+		fields = append(fields, i.Name)
+	}
+	fields = append(fields, i.Digest)
+	if i.Platform != "" {
+		fields = append(fields, i.Platform)
+	}
+	return strings.Join(fields, " ")
+}
+
+// OCILayoutSelection describes which image to use from an OCI layout directory that contains more than one image.
+// All non-empty criteria must match for an image to be selected.
+type OCILayoutSelection struct {
+	// Name selects images with the given "org.opencontainers.image.ref.name" annotation
+	Name string
+	// Digest selects the image with the given manifest digest
+	Digest string
+	// Annotations selects images which have all the given annotations (and values)
+	Annotations map[string]string
+}
+
+// IsEmpty returns true if no selection criteria have been given.
+func (s OCILayoutSelection) IsEmpty() bool {
+	return s.Name == "" && s.Digest == "" && len(s.Annotations) == 0
+}
+
+func (s OCILayoutSelection) matches(img OCILayoutImage) bool {
+	if s.Name != "" && s.Name != img.Name {
+		return false
+	}
+	if s.Digest != "" && s.Digest != img.Digest {
+		return false
+	}
+	for k, v := range s.Annotations {
+		if actual, ok := img.Annotations[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (s OCILayoutSelection) String() string {
+	var fields []string
+	if s.Name != "" {
+		fields = append(fields, fmt.Sprintf("name=%q", s.Name))
+	}
+	if s.Digest != "" {
+		fields = append(fields, fmt.Sprintf("digest=%q", s.Digest))
+	}
+	var keys []string
+	for k := range s.Annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fields = append(fields, fmt.Sprintf("annotation %s=%q", k, s.Annotations[k]))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// OCILayoutImages returns all images found within the index of the given OCI image layout directory, in index order.
+// Nested indexes (e.g. multi-platform images) are expanded into the images they reference.
+func OCILayoutImages(path string) ([]OCILayoutImage, error) {
+	index, err := layout.ImageIndexFromPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OCI layout index from %q: %w", path, err)
+	}
+
+	return ociLayoutIndexImages(index, nil)
+}
+
+func ociLayoutIndexImages(index v1.ImageIndex, parent map[string]string) ([]OCILayoutImage, error) {
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OCI layout index manifest: %w", err)
+	}
+
+	var images []OCILayoutImage
+	for _, desc := range indexManifest.Manifests {
+		annotations := make(map[string]string, len(parent)+len(desc.Annotations))
+		for k, v := range parent {
+			annotations[k] = v
+		}
+		for k, v := range desc.Annotations {
+			annotations[k] = v
+		}
+
+		if desc.MediaType.IsIndex() {
+			child, err := index.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read nested OCI layout index %q: %w", desc.Digest, err)
+			}
+			childImages, err := ociLayoutIndexImages(child, annotations)
+			if err != nil {
+				return nil, err
+			}
+			images = append(images, childImages...)
+			continue
+		}
+
+		if !desc.MediaType.IsImage() {
+			// e.g. attestations, signatures, or other artifacts
+			continue
+		}
+
+		var platform string
+		if desc.Platform != nil {
+			platform = desc.Platform.String()
+		}
+
+		images = append(images, OCILayoutImage{
+			Digest:      desc.Digest.String(),
+			Name:        annotations[OCIRefNameAnnotation],
+			Platform:    platform,
+			Annotations: annotations,
+		})
+	}
+
+	return images, nil
+}
+
+// FilterOCILayoutImages returns the images which match the given selection (and optional platform).
+func FilterOCILayoutImages(images []OCILayoutImage, selection OCILayoutSelection, platform *image.Platform) []OCILayoutImage {
+	var candidates []OCILayoutImage
+	for _, img := range images {
+		if !selection.matches(img) {
+			continue
+		}
+		// images without a platform in the index can only be checked once read, so they are kept
+		if platform != nil && img.Platform != "" && !ociPlatformMatches(img.Platform, platform) {
+			continue
+		}
+		candidates = append(candidates, img)
+	}
+	return candidates
+}
+
+// SelectOCILayoutImage returns the single image described by the given selection (and optional platform). If no
+// selection is given then the layout must contain a single image (or multiple references to the same image).
+func SelectOCILayoutImage(images []OCILayoutImage, selection OCILayoutSelection, platform *image.Platform) (*OCILayoutImage, error) {
+	candidates := FilterOCILayoutImages(images, selection, nil)
+	if platform != nil && !sameOCILayoutImage(candidates) {
+		candidates = FilterOCILayoutImages(candidates, selection, platform)
+	}
+
+	switch {
+	case len(candidates) == 0 && selection.IsEmpty():
+		return nil, fmt.Errorf("no images found in OCI layout")
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("no images in OCI layout match the selection (%s); available images:%s", selection, ociLayoutImageList(images))
+	case !sameOCILayoutImage(candidates):
+		return nil, fmt.Errorf("found %d images in OCI layout, please select a single image by name, digest, or annotation; available images:%s", len(candidates), ociLayoutImageList(candidates))
+	}
+
+	return &candidates[0], nil
+}
+
+// sameOCILayoutImage returns true if all given images reference the same manifest (e.g. an image tagged twice).
+func sameOCILayoutImage(images []OCILayoutImage) bool {
+	for _, img := range images {
+		if img.Digest != images[0].Digest {
+			return false
+		}
+	}
+	return len(images) > 0
+}
+
+func ociPlatformMatches(value string, platform *image.Platform) bool {
+	p, err := v1.ParsePlatform(value)
+	if err != nil {
+		return false
+	}
+	if platform.OS != "" && p.OS != platform.OS {
+		return false
+	}
+	if platform.Architecture != "" && p.Architecture != platform.Architecture {
+		return false
+	}
+	if platform.Variant != "" && p.Variant != platform.Variant {
+		return false
+	}
+	return true
+}
+
+func ociLayoutImageList(images []OCILayoutImage) string {
+	var sb strings.Builder
+	for _, img := range images {
+		sb.WriteString("\n  - ")
+		sb.WriteString(img.String())
+	}
+	return sb.String()
+}
+
+var _ image.Provider = (*ociLayoutImageProvider)(nil)
+
+// ociLayoutImageProvider is an image.Provider for OCI layout directories which, unlike the stereoscope provider,
+// supports layouts containing multiple images by allowing a single image to be selected.
+type ociLayoutImageProvider struct {
+	path      string
+	platform  *image.Platform
+	selection OCILayoutSelection
+}
+
+func newOCILayoutImageProvider(path string, platform *image.Platform, selection OCILayoutSelection) image.Provider {
+	return &ociLayoutImageProvider{
+		path:      path,
+		platform:  platform,
+		selection: selection,
+	}
+}
+
+func (p *ociLayoutImageProvider) Name() string {
+	return oci.Directory
+}
+
+func (p *ociLayoutImageProvider) Provide(_ context.Context) (*image.Image, error) {
+	return readOCILayoutImage(p.path, p.platform, p.selection)
+}
+
+// readOCILayoutImage reads the single image described by the given selection (and optional platform) from an OCI
+// layout directory, where any additional metadata is attached to the image.
+func readOCILayoutImage(path string, platform *image.Platform, selection OCILayoutSelection, additionalMetadata ...image.AdditionalMetadata) (*image.Image, error) {
+	pathObj, err := layout.FromPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read image from OCI directory path %q: %w", path, err)
+	}
+
+	images, err := OCILayoutImages(path)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := SelectOCILayoutImage(images, selection, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := v1.NewHash(selected.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI layout image digest %q: %w", selected.Digest, err)
+	}
+
+	index, err := pathObj.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read OCI layout index from %q: %w", path, err)
+	}
+
+	img, err := findOCILayoutImage(index, digest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse OCI directory as an image: %w", err)
+	}
+
+	var metadata = append([]image.AdditionalMetadata{
+		image.WithManifestDigest(selected.Digest),
+	}, additionalMetadata...)
+
+	// make a best-effort attempt at getting the raw manifest
+	rawManifest, err := img.RawManifest()
+	if err == nil {
+		metadata = append(metadata, image.WithManifest(rawManifest))
+	}
+
+	// the platform is only known from the image configuration (which may differ between the images of a layout)
+	if cf, err := img.ConfigFile(); err == nil && cf.Architecture != "" && cf.OS != "" {
+		metadata = append(metadata, image.WithArchitecture(cf.Architecture, cf.Variant), image.WithOS(cf.OS))
+	}
+
+	tmpDirGen := file.NewTempDirGenerator("syft-oci-layout")
+	contentTempDir, err := tmpDirGen.NewDirectory("oci-dir-image")
+	if err != nil {
+		return nil, err
+	}
+
+	out := image.New(img, tmpDirGen, contentTempDir, metadata...)
+	if err := out.Read(); err != nil {
+		_ = out.Cleanup()
+		return nil, err
+	}
+	return out, nil
+}
+
+// findOCILayoutImage returns the image with the given manifest digest from the index, or from any nested index (e.g.
+// the image of a single platform of a multi-platform image).
+func findOCILayoutImage(index v1.ImageIndex, digest v1.Hash) (v1.Image, error) {
+	indexManifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, desc := range indexManifest.Manifests {
+		if desc.Digest == digest && desc.MediaType.IsImage() {
+			return index.Image(digest)
+		}
+	}
+
+	for _, desc := range indexManifest.Manifests {
+		if !desc.MediaType.IsIndex() {
+			continue
+		}
+		child, err := index.ImageIndex(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		if img, err := findOCILayoutImage(child, digest); err == nil {
+			return img, nil
+		}
+	}
+
+	return nil, fmt.Errorf("image %q not found in index", digest)
+}
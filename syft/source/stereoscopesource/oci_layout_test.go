@@ -0,0 +1,196 @@
+package stereoscopesource
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/stereoscope/pkg/image"
+)
+
+type ociLayoutFixture struct {
+	path  string
+	app   string
+	tools string
+	amd64 string
+	arm64 string
+}
+
+// newOCILayoutFixture creates an OCI layout with the following images:
+//   - "app" (also tagged as "latest") with annotation "team=a"
+//   - "tools" with annotation "team=b"
+//   - "multi", an index with linux/amd64 and linux/arm64 images, with annotation "team=a"
+func newOCILayoutFixture(t *testing.T) ociLayoutFixture {
+	t.Helper()
+
+	newImage := func() (v1.Image, string) {
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		digest, err := img.Digest()
+		require.NoError(t, err)
+		return img, digest.String()
+	}
+
+	f := ociLayoutFixture{path: t.TempDir()}
+
+	p, err := layout.Write(f.path, empty.Index)
+	require.NoError(t, err)
+
+	app, appDigest := newImage()
+	f.app = appDigest
+	require.NoError(t, p.AppendImage(app, layout.WithAnnotations(map[string]string{OCIRefNameAnnotation: "app", "team": "a"})))
+	require.NoError(t, p.AppendImage(app, layout.WithAnnotations(map[string]string{OCIRefNameAnnotation: "latest", "team": "a"})))
+
+	tools, toolsDigest := newImage()
+	f.tools = toolsDigest
+	require.NoError(t, p.AppendImage(tools, layout.WithAnnotations(map[string]string{OCIRefNameAnnotation: "tools", "team": "b"})))
+
+	amd64, amd64Digest := newImage()
+	arm64, arm64Digest := newImage()
+	f.amd64, f.arm64 = amd64Digest, arm64Digest
+	multi := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: amd64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+		mutate.IndexAddendum{Add: arm64, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}}},
+	)
+	require.NoError(t, p.AppendIndex(multi, layout.WithAnnotations(map[string]string{OCIRefNameAnnotation: "multi", "team": "a"})))
+
+	return f
+}
+
+func Test_OCILayoutImages(t *testing.T) {
+	f := newOCILayoutFixture(t)
+
+	images, err := OCILayoutImages(f.path)
+	require.NoError(t, err)
+
+	var got []string
+	for _, img := range images {
+		got = append(got, img.Name+" "+img.Digest+" "+img.Platform+" "+img.Annotations["team"])
+	}
+
+	assert.Equal(t, []string{
+		"app " + f.app + "  a",
+		"latest " + f.app + "  a",
+		"tools " + f.tools + "  b",
+		"multi " + f.amd64 + " linux/amd64 a",
+		"multi " + f.arm64 + " linux/arm64 a",
+	}, got)
+}
+
+func Test_SelectOCILayoutImage(t *testing.T) {
+	f := newOCILayoutFixture(t)
+
+	images, err := OCILayoutImages(f.path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		selection OCILayoutSelection
+		platform  string
+		want      string
+		wantErr   require.ErrorAssertionFunc
+	}{
+		{
+			name:    "no selection with multiple images",
+			wantErr: require.Error,
+		},
+		{
+			name:      "by name",
+			selection: OCILayoutSelection{Name: "tools"},
+			want:      f.tools,
+		},
+		{
+			name:      "by annotation and digest",
+			selection: OCILayoutSelection{Annotations: map[string]string{"team": "a"}, Digest: f.app},
+			want:      f.app,
+		},
+		{
+			name:      "by digest",
+			selection: OCILayoutSelection{Digest: f.arm64},
+			want:      f.arm64,
+		},
+		{
+			name:      "by annotation",
+			selection: OCILayoutSelection{Annotations: map[string]string{"team": "b"}},
+			want:      f.tools,
+		},
+		{
+			name:      "ambiguous annotation",
+			selection: OCILayoutSelection{Annotations: map[string]string{"team": "a"}},
+			wantErr:   require.Error,
+		},
+		{
+			name:      "by name requiring a platform",
+			selection: OCILayoutSelection{Name: "multi"},
+			wantErr:   require.Error,
+		},
+		{
+			name:      "by name and platform",
+			selection: OCILayoutSelection{Name: "multi"},
+			platform:  "linux/arm64",
+			want:      f.arm64,
+		},
+		{
+			name:      "no match",
+			selection: OCILayoutSelection{Name: "missing"},
+			wantErr:   require.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+
+			var platform *image.Platform
+			if tt.platform != "" {
+				p, err := image.NewPlatform(tt.platform)
+				require.NoError(t, err)
+				platform = p
+			}
+
+			got, err := SelectOCILayoutImage(images, tt.selection, platform)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got.Digest)
+		})
+	}
+}
+
+func Test_ociLayoutImageProvider(t *testing.T) {
+	f := newOCILayoutFixture(t)
+
+	provider := newOCILayoutImageProvider(f.path, nil, OCILayoutSelection{Name: "tools"})
+
+	img, err := provider.Provide(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, img.Cleanup())
+	})
+
+	assert.Equal(t, f.tools, img.Metadata.ManifestDigest)
+
+	_, err = newOCILayoutImageProvider(f.path, nil, OCILayoutSelection{}).Provide(context.Background())
+	require.ErrorContains(t, err, "please select a single image")
+
+	// images within a nested index (e.g. a single platform of a multi-platform image) can be read
+	platform, err := image.NewPlatform("linux/arm64")
+	require.NoError(t, err)
+	nested, err := newOCILayoutImageProvider(f.path, platform, OCILayoutSelection{Name: "multi"}).Provide(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nested.Cleanup())
+	})
+
+	assert.Equal(t, f.arm64, nested.Metadata.ManifestDigest)
+}
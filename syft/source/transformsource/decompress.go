@@ -0,0 +1,122 @@
+package transformsource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mholt/archiver/v3"
+
+	"github.com/anchore/syft/internal"
+)
+
+const decompressName = "decompress"
+
+// compression describes a compression format recognized by the magic bytes at the start of a file.
+type compression struct {
+	magic        []byte
+	extensions   []string
+	decompressor func() archiver.Decompressor
+}
+
+var compressions = []compression{
+	{
+		magic:        []byte{0x1f, 0x8b},
+		extensions:   []string{".gz", ".gzip"},
+		decompressor: func() archiver.Decompressor { return archiver.NewGz() },
+	},
+	{
+		magic:        []byte("BZh"),
+		extensions:   []string{".bz2", ".bzip2"},
+		decompressor: func() archiver.Decompressor { return archiver.NewBz2() },
+	},
+	{
+		magic:        []byte{0xfd, '7', 'z', 'X', 'Z', 0x00},
+		extensions:   []string{".xz"},
+		decompressor: func() archiver.Decompressor { return archiver.NewXz() },
+	},
+	{
+		magic:        []byte{0x28, 0xb5, 0x2f, 0xfd},
+		extensions:   []string{".zst", ".zstd"},
+		decompressor: func() archiver.Decompressor { return archiver.NewZstd() },
+	},
+	{
+		magic:        []byte{0x04, 0x22, 0x4d, 0x18},
+		extensions:   []string{".lz4"},
+		decompressor: func() archiver.Decompressor { return archiver.NewLz4() },
+	},
+}
+
+type decompress struct{}
+
+// Decompress returns a transformation that decompresses a file, where the compression format is detected from the
+// contents of the file.
+func Decompress() Transformation {
+	return decompress{}
+}
+
+func (d decompress) Name() string {
+	return decompressName
+}
+
+func (d decompress) Transform(ctx context.Context, input, workDir string) (string, error) {
+	in, err := os.Open(input)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %q: %w", input, err)
+	}
+	defer internal.CloseAndLogError(in, input)
+
+	header := make([]byte, 8)
+	n, err := io.ReadFull(in, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("unable to read %q: %w", input, err)
+	}
+	if _, err := in.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("unable to read %q: %w", input, err)
+	}
+
+	c := detectCompression(header[:n])
+	if c == nil {
+		return "", fmt.Errorf("unable to detect the compression format of %q", input)
+	}
+
+	output := filepath.Join(workDir, trimExtension(filepath.Base(input), c.extensions...))
+	out, err := os.Create(output)
+	if err != nil {
+		return "", fmt.Errorf("unable to create %q: %w", output, err)
+	}
+	defer internal.CloseAndLogError(out, output)
+
+	if err := c.decompressor().Decompress(contextReader{ctx: ctx, reader: in}, out); err != nil {
+		return "", fmt.Errorf("unable to decompress %q: %w", input, err)
+	}
+	return output, nil
+}
+
+func detectCompression(header []byte) *compression {
+	for i := range compressions {
+		if bytes.HasPrefix(header, compressions[i].magic) {
+			return &compressions[i]
+		}
+	}
+	return nil
+}
+
+// trimExtension removes the first matching extension from the given filename, keeping the name meaningful for any
+// later transformation (e.g. "rootfs.tar.gz" ---> "rootfs.tar"). A filename without any of the extensions is
+// returned unchanged.
+func trimExtension(name string, extensions ...string) string {
+	for _, ext := range extensions {
+		if strings.HasSuffix(strings.ToLower(name), ext) && len(name) > len(ext) {
+			return name[:len(name)-len(ext)]
+		}
+	}
+	if name == "" || name == "." || name == string(filepath.Separator) {
+		return "contents"
+	}
+	return name
+}
@@ -0,0 +1,116 @@
+package transformsource
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+)
+
+const (
+	decryptName = "decrypt"
+
+	// maxEncryptedSize is the largest encrypted file that can be decrypted, since AES-GCM authenticates the contents
+	// as a whole (so the entire file must be held in memory before any plaintext can be trusted)
+	maxEncryptedSize = 2 << 30
+
+	// hexKeyPrefix marks a key file argument as holding the hex encoded key (instead of the raw key)
+	hexKeyPrefix = "hex:"
+)
+
+type decrypt struct {
+	aead cipher.AEAD
+}
+
+// Decrypt returns a transformation that decrypts an AES-GCM encrypted file with the given 128, 192, or 256 bit key.
+// The encrypted file is expected to hold the 12 byte nonce followed by the sealed contents (including the
+// authentication tag), and may be at most 2 GiB.
+func Decrypt(key []byte) (Transformation, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decryption key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decryption key: %w", err)
+	}
+	return decrypt{aead: aead}, nil
+}
+
+func (d decrypt) Name() string {
+	return decryptName
+}
+
+func (d decrypt) Transform(ctx context.Context, input, workDir string) (string, error) {
+	fi, err := os.Stat(input)
+	if err != nil {
+		return "", fmt.Errorf("unable to read %q: %w", input, err)
+	}
+	if fi.Size() > maxEncryptedSize {
+		return "", fmt.Errorf("%q is too large to decrypt (%d bytes, limit is %d bytes)", input, fi.Size(), maxEncryptedSize)
+	}
+
+	contents, err := readFile(ctx, input, fi.Size())
+	if err != nil {
+		return "", fmt.Errorf("unable to read %q: %w", input, err)
+	}
+
+	nonceSize := d.aead.NonceSize()
+	if len(contents) < nonceSize+d.aead.Overhead() {
+		return "", fmt.Errorf("%q is too short to be encrypted", input)
+	}
+
+	// decrypt in place, so only a single copy of the contents is held in memory
+	sealed := contents[nonceSize:]
+	plaintext, err := d.aead.Open(sealed[:0], contents[:nonceSize], sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt %q (wrong key or corrupt contents): %w", input, err)
+	}
+
+	output := filepath.Join(workDir, trimExtension(filepath.Base(input), ".enc", ".aes"))
+	if err := os.WriteFile(output, plaintext, 0600); err != nil {
+		return "", fmt.Errorf("unable to write %q: %w", output, err)
+	}
+	return output, nil
+}
+
+// readFile reads the entire file of the given size, stopping once the context is done.
+func readFile(ctx context.Context, path string, size int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer internal.CloseAndLogError(f, path)
+
+	contents := make([]byte, size)
+	if _, err := io.ReadFull(contextReader{ctx: ctx, reader: f}, contents); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// readKeyFile reads a decryption key from the given file argument, which holds the raw key unless prefixed with
+// "hex:" (in which case the file holds the hex encoded key).
+func readKeyFile(arg string) ([]byte, error) {
+	path, isHex := strings.CutPrefix(arg, hexKeyPrefix)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file %q: %w", path, err)
+	}
+	if !isHex {
+		return contents, nil
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode hex encoded key file %q: %w", path, err)
+	}
+	return key, nil
+}
@@ -0,0 +1,53 @@
+package transformsource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const selectName = "select"
+
+type selectPath struct {
+	path string
+}
+
+// Select returns a transformation that selects the file or directory at the given path within a directory (e.g. a
+// disk image within an unpacked archive). The path cannot escape the directory.
+func Select(p string) Transformation {
+	return selectPath{path: p}
+}
+
+func (s selectPath) Name() string {
+	return selectName
+}
+
+func (s selectPath) Transform(_ context.Context, input, _ string) (string, error) {
+	fi, err := os.Stat(input)
+	if err != nil {
+		return "", fmt.Errorf("unable to stat %q: %w", input, err)
+	}
+	if !fi.IsDir() {
+		return "", fmt.Errorf("unable to select %q: %q is not a directory (an 'unpack' transformation may be needed first)", s.path, input)
+	}
+
+	// the selected path is always relative to the input directory, regardless of any parent references
+	relative := strings.TrimPrefix(path.Clean("/"+filepath.ToSlash(s.path)), "/")
+	selected := filepath.Join(input, filepath.FromSlash(relative))
+
+	resolved, err := filepath.EvalSymlinks(selected)
+	if err != nil {
+		return "", fmt.Errorf("unable to select %q: %w", s.path, err)
+	}
+	root, err := filepath.EvalSymlinks(input)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve %q: %w", input, err)
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("unable to select %q: the path links outside of %q", s.path, input)
+	}
+	return resolved, nil
+}
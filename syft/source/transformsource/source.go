@@ -0,0 +1,149 @@
+package transformsource
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/opencontainers/go-digest"
+
+	stereoFile "github.com/anchore/stereoscope/pkg/file"
+	intFile "github.com/anchore/syft/internal/file"
+	"github.com/anchore/syft/internal/log"
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/internal"
+)
+
+var _ source.Source = (*transformedSource)(nil)
+
+// transformedSource is a source resolved from the result of a pipeline, which describes itself in terms of the
+// original user input (instead of the temporary result of the pipeline) and removes the result of the pipeline
+// when closed.
+type transformedSource struct {
+	source.Source
+	id      artifact.ID
+	input   string
+	aliased bool
+	cleanup func() error
+
+	// inputMetadata describes the input when the result of the pipeline is a file
+	inputMetadata source.FileMetadata
+}
+
+// NewSource wraps a source resolved from the result of a pipeline run against the given input, where the given
+// cleanup function is called once the source is closed. When the source name was not explicitly set by an alias,
+// the name is derived from the input.
+func NewSource(src source.Source, input string, alias source.Alias, cleanup func() error) source.Source {
+	s := &transformedSource{
+		Source:  src,
+		id:      deriveIDFromInput(input, alias),
+		input:   input,
+		aliased: alias.Name != "",
+		cleanup: cleanup,
+	}
+	if m, ok := src.Describe().Metadata.(source.FileMetadata); ok {
+		s.inputMetadata = describeInputFile(input, m.Digests)
+	}
+	return s
+}
+
+// deriveIDFromInput derives the source ID from the original user input (instead of the result of the pipeline, which
+// may be within a randomly named temporary directory), where an alias takes precedence over the input contents.
+func deriveIDFromInput(input string, alias source.Alias) artifact.ID {
+	if !alias.IsEmpty() {
+		return internal.ArtifactIDFromDigest(digest.SHA256.FromString(fmt.Sprintf("%s@%s", alias.Name, alias.Version)).String())
+	}
+	return internal.ArtifactIDFromDigest(digest.SHA256.FromString(digestOfInput(input)).String())
+}
+
+func digestOfInput(input string) string {
+	f, err := os.Open(input)
+	if err != nil {
+		return digest.SHA256.FromString(input).String()
+	}
+	defer f.Close()
+	d, err := digest.SHA256.FromReader(f)
+	if err != nil {
+		// e.g. the input is a directory
+		return digest.SHA256.FromString(filepath.Clean(input)).String()
+	}
+	return d.String()
+}
+
+// describeInputFile describes the input with the same digest algorithms as the result of the pipeline, since the
+// digests (and MIME type) of the result describe an intermediate file instead of the input. An input that is not a
+// regular file (e.g. a directory) is described by path only.
+func describeInputFile(input string, resultDigests []file.Digest) source.FileMetadata {
+	m := source.FileMetadata{Path: input}
+
+	fi, err := os.Stat(input)
+	if err != nil || !fi.Mode().IsRegular() {
+		return m
+	}
+
+	var algorithms []string
+	for _, d := range resultDigests {
+		algorithms = append(algorithms, d.Algorithm)
+	}
+	hashes, err := intFile.Hashers(algorithms...)
+	if err != nil {
+		log.WithFields("input", input, "error", err).Debug("unable to determine digest algorithms for transformed source input")
+	}
+
+	f, err := os.Open(input)
+	if err != nil {
+		log.WithFields("input", input, "error", err).Debug("unable to describe transformed source input")
+		return m
+	}
+	defer f.Close()
+
+	m.MIMEType = stereoFile.MIMEType(f)
+	if len(hashes) == 0 {
+		return m
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		log.WithFields("input", input, "error", err).Debug("unable to describe transformed source input")
+		return m
+	}
+	m.Digests, err = intFile.NewDigestsFromFile(f, hashes)
+	if err != nil {
+		log.WithFields("input", input, "error", err).Debug("unable to calculate digests of transformed source input")
+	}
+	return m
+}
+
+func (s transformedSource) ID() artifact.ID {
+	return s.id
+}
+
+func (s transformedSource) Describe() source.Description {
+	d := s.Source.Describe()
+	d.ID = string(s.id)
+	if !s.aliased {
+		d.Name = filepath.Base(s.input)
+	}
+
+	switch m := d.Metadata.(type) {
+	case source.FileMetadata:
+		d.Metadata = s.inputMetadata
+	case source.DirectoryMetadata:
+		m.Path = s.input
+		d.Metadata = m
+	}
+	return d
+}
+
+func (s *transformedSource) Close() error {
+	err := s.Source.Close()
+	if s.cleanup != nil {
+		if cleanupErr := s.cleanup(); cleanupErr != nil {
+			log.WithFields("input", s.input, "error", cleanupErr).Warn("unable to cleanup transformed source input")
+		}
+		s.cleanup = nil
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+/*
+Package transformsource provides a composable pipeline of transformations (decompress, decrypt, unpack, select a
+sub-path) that are applied to a local file before it is resolved to a source, so that inputs which are wrapped in
+several layers (e.g. an encrypted tar holding a disk image) can be scanned without any pre-processing.
+*/
+package transformsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// Transformation is a single step of a pipeline, producing a new file or directory from the output of the previous
+// step (or the user input for the first step).
+type Transformation interface {
+	// Name is the name of the transformation, as used within --via expressions.
+	Name() string
+
+	// Transform writes the result of transforming the file or directory at the given path within the given
+	// (empty) working directory, returning the path to the result.
+	Transform(ctx context.Context, input, workDir string) (string, error)
+}
+
+// Pipeline is an ordered set of transformations, where each transformation consumes the output of the previous one.
+type Pipeline []Transformation
+
+// Run applies each transformation of the pipeline in order to the given path, returning the path of the final
+// result. The returned cleanup function removes all intermediate results and must be called once the result is no
+// longer needed.
+func (p Pipeline) Run(ctx context.Context, input string) (string, func() error, error) {
+	noop := func() error { return nil }
+	if len(p) == 0 {
+		return input, noop, nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "syft-transform-")
+	if err != nil {
+		return "", noop, fmt.Errorf("unable to create tempdir for source transformations: %w", err)
+	}
+	cleanupFn := func() error {
+		return os.RemoveAll(tempDir)
+	}
+
+	current := input
+	for i, t := range p {
+		if err := ctx.Err(); err != nil {
+			return "", cleanupFn, fmt.Errorf("source transformations cancelled before %q transformation (step %d): %w", t.Name(), i+1, err)
+		}
+
+		workDir := filepath.Join(tempDir, fmt.Sprintf("%d-%s", i, t.Name()))
+		if err := os.Mkdir(workDir, 0700); err != nil {
+			return "", cleanupFn, fmt.Errorf("unable to create working directory for %q transformation: %w", t.Name(), err)
+		}
+
+		log.WithFields("input", current, "transformation", t.Name()).Trace("transforming source input")
+
+		current, err = t.Transform(ctx, current, workDir)
+		if err != nil {
+			return "", cleanupFn, fmt.Errorf("unable to apply %q transformation (step %d): %w", t.Name(), i+1, err)
+		}
+	}
+
+	return current, cleanupFn, nil
+}
+
+// contextReader stops reading once the context is done, so that transformations over large files can be cancelled.
+type contextReader struct {
+	ctx    context.Context
+	reader io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.reader.Read(p)
+}
+
+// ParsePipeline parses a set of transformation expressions (as given by --via) into a pipeline, in order. Each
+// expression may hold several comma-separated transformations.
+func ParsePipeline(expressions ...string) (Pipeline, error) {
+	var p Pipeline
+	for _, expression := range expressions {
+		for _, e := range strings.Split(expression, ",") {
+			e = strings.TrimSpace(e)
+			if e == "" {
+				continue
+			}
+			t, err := Parse(e)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, t)
+		}
+	}
+	return p, nil
+}
+
+// Parse parses a single transformation expression, of the form "name" or "name=argument":
+//
+//   - decompress: decompress a gzip, bzip2, xz, zstd, or lz4 compressed file
+//   - decrypt=<key-file>: decrypt an AES-GCM encrypted file (of at most 2 GiB) with the raw key held in the given file,
+//     or decrypt=hex:<key-file> for a file holding the hex encoded key
+//   - unpack: unpack a tar, zip, or rar archive (which may be compressed), or a squashfs or ISO image, into a directory
+//   - select=<path>: select the file or directory at the given path within a directory
+func Parse(expression string) (Transformation, error) {
+	name, arg, hasArg := strings.Cut(expression, "=")
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	requireArg := func() error {
+		if !hasArg || arg == "" {
+			return fmt.Errorf("the %q transformation requires an argument (e.g. '%s=...')", name, name)
+		}
+		return nil
+	}
+	forbidArg := func() error {
+		if hasArg {
+			return fmt.Errorf("the %q transformation does not take an argument", name)
+		}
+		return nil
+	}
+
+	switch name {
+	case decompressName:
+		if err := forbidArg(); err != nil {
+			return nil, err
+		}
+		return Decompress(), nil
+	case decryptName:
+		if err := requireArg(); err != nil {
+			return nil, err
+		}
+		key, err := readKeyFile(arg)
+		if err != nil {
+			return nil, err
+		}
+		return Decrypt(key)
+	case unpackName:
+		if err := forbidArg(); err != nil {
+			return nil, err
+		}
+		return Unpack(), nil
+	case selectName:
+		if err := requireArg(); err != nil {
+			return nil, err
+		}
+		return Select(arg), nil
+	}
+	return nil, fmt.Errorf("unknown source transformation %q (options: %s)", name, strings.Join(Names(), ", "))
+}
+
+// Names returns the names of all known transformations.
+func Names() []string {
+	return []string{decompressName, decryptName, unpackName, selectName}
+}
@@ -0,0 +1,383 @@
+package transformsource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/source"
+	"github.com/anchore/syft/syft/source/directorysource"
+	"github.com/anchore/syft/syft/source/filesource"
+)
+
+var testKey = bytes.Repeat([]byte{0x42}, 32)
+
+func TestPipeline_Run(t *testing.T) {
+	dir := t.TempDir()
+	// an encrypted, compressed tar holding a disk image: rootfs.tar.gz.enc
+	input := filepath.Join(dir, "rootfs.tar.gz.enc")
+	require.NoError(t, os.WriteFile(input, encrypt(t, gzipped(t, tarball(t, map[string]string{
+		"images/disk.img": "disk image contents",
+		"README":          "readme",
+	}))), 0600))
+
+	tests := []struct {
+		name     string
+		pipeline Pipeline
+		wantBase string
+		wantDir  bool
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name:     "no transformations",
+			pipeline: nil,
+			wantBase: "rootfs.tar.gz.enc",
+		},
+		{
+			name:     "decrypt",
+			pipeline: Pipeline{mustDecrypt(t)},
+			wantBase: "rootfs.tar.gz",
+		},
+		{
+			name:     "decrypt and decompress",
+			pipeline: Pipeline{mustDecrypt(t), Decompress()},
+			wantBase: "rootfs.tar",
+		},
+		{
+			name:     "decrypt, decompress, and unpack",
+			pipeline: Pipeline{mustDecrypt(t), Decompress(), Unpack()},
+			wantDir:  true,
+		},
+		{
+			name:     "decrypt and unpack compressed archive",
+			pipeline: Pipeline{mustDecrypt(t), Unpack(), Select("images/disk.img")},
+			wantBase: "disk.img",
+		},
+		{
+			name:     "select cannot escape unpacked directory",
+			pipeline: Pipeline{mustDecrypt(t), Unpack(), Select("../../../images/disk.img")},
+			wantBase: "disk.img",
+		},
+		{
+			name:     "wrong key",
+			pipeline: Pipeline{mustDecryptWith(t, bytes.Repeat([]byte{0x24}, 32))},
+			wantErr:  require.Error,
+		},
+		{
+			name:     "decompress without compression",
+			pipeline: Pipeline{Decompress()},
+			wantErr:  require.Error,
+		},
+		{
+			name:     "select within file",
+			pipeline: Pipeline{mustDecrypt(t), Select("images/disk.img")},
+			wantErr:  require.Error,
+		},
+		{
+			name:     "select missing path",
+			pipeline: Pipeline{mustDecrypt(t), Unpack(), Select("images/missing.img")},
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			result, cleanup, err := tt.pipeline.Run(context.Background(), input)
+			t.Cleanup(func() { require.NoError(t, cleanup()) })
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			fi, err := os.Stat(result)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDir, fi.IsDir())
+			if tt.wantBase != "" {
+				assert.Equal(t, tt.wantBase, filepath.Base(result))
+			}
+		})
+	}
+}
+
+func TestPipeline_Run_squashfs(t *testing.T) {
+	// squashfs images are unpacked regardless of the file extension
+	result, cleanup, err := Pipeline{Unpack(), Select("var/lib/dpkg/status")}.Run(context.Background(), "../test-fixtures/squashfs/rootfs.img")
+	t.Cleanup(func() { require.NoError(t, cleanup()) })
+	require.NoError(t, err)
+
+	contents, err := os.ReadFile(result)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "Package: zlib1g")
+}
+
+func TestPipeline_Run_iso(t *testing.T) {
+	result, cleanup, err := Pipeline{Unpack(), Select("var/lib/dpkg/status")}.Run(context.Background(), "../test-fixtures/iso/installer.iso")
+	t.Cleanup(func() { require.NoError(t, cleanup()) })
+	require.NoError(t, err)
+
+	contents, err := os.ReadFile(result)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "Package: zlib1g")
+}
+
+func TestPipeline_Run_cleanup(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "rootfs.tar.gz")
+	require.NoError(t, os.WriteFile(input, gzipped(t, tarball(t, map[string]string{"etc/os-release": "ID=test"})), 0600))
+
+	result, cleanup, err := Pipeline{Decompress(), Unpack()}.Run(context.Background(), input)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(result, "etc", "os-release"))
+
+	require.NoError(t, cleanup())
+	assert.NoDirExists(t, result)
+	assert.FileExists(t, input)
+}
+
+func TestPipeline_Run_qcow2(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "disk.img")
+	require.NoError(t, os.WriteFile(input, append([]byte{'Q', 'F', 'I', 0xfb, 0, 0, 0, 3}, make([]byte, 64)...), 0600))
+
+	_, cleanup, err := Pipeline{Unpack()}.Run(context.Background(), input)
+	t.Cleanup(func() { require.NoError(t, cleanup()) })
+	require.ErrorContains(t, err, "qcow2 disk images are not supported")
+}
+
+func TestPipeline_Run_cancelled(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "rootfs.tar.gz.enc")
+	require.NoError(t, os.WriteFile(input, encrypt(t, gzipped(t, tarball(t, map[string]string{"etc/os-release": "ID=test"}))), 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, cleanup, err := Pipeline{mustDecrypt(t), Decompress(), Unpack()}.Run(ctx, input)
+	t.Cleanup(func() { require.NoError(t, cleanup()) })
+	require.ErrorIs(t, err, context.Canceled)
+
+	// transformations over the contents of a file stop reading once cancelled
+	_, err = mustDecrypt(t).Transform(ctx, input, t.TempDir())
+	require.ErrorIs(t, err, context.Canceled)
+
+	compressed := filepath.Join(t.TempDir(), "rootfs.tar.gz")
+	require.NoError(t, os.WriteFile(compressed, gzipped(t, []byte("contents")), 0600))
+	_, err = Decompress().Transform(ctx, compressed, t.TempDir())
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParsePipeline(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key.hex")
+	require.NoError(t, os.WriteFile(keyFile, []byte(hex.EncodeToString(testKey)+"\n"), 0600))
+	rawKeyFile := filepath.Join(t.TempDir(), "key.bin")
+	require.NoError(t, os.WriteFile(rawKeyFile, bytes.Repeat([]byte("z"), 32), 0600))
+
+	tests := []struct {
+		name        string
+		expressions []string
+		want        []string
+		wantErr     require.ErrorAssertionFunc
+	}{
+		{
+			name:        "comma separated",
+			expressions: []string{"decrypt=hex:" + keyFile + ",decompress, unpack,select=disk.img"},
+			want:        []string{"decrypt", "decompress", "unpack", "select"},
+		},
+		{
+			name:        "ordered across expressions",
+			expressions: []string{"unpack", "select=disk.img", "DECOMPRESS"},
+			want:        []string{"unpack", "select", "decompress"},
+		},
+		{
+			name:        "unknown transformation",
+			expressions: []string{"decompress,explode"},
+			wantErr:     require.Error,
+		},
+		{
+			name:        "missing argument",
+			expressions: []string{"select"},
+			wantErr:     require.Error,
+		},
+		{
+			name:        "unexpected argument",
+			expressions: []string{"unpack=zip"},
+			wantErr:     require.Error,
+		},
+		{
+			name:        "missing key file",
+			expressions: []string{"decrypt=" + filepath.Join(t.TempDir(), "missing")},
+			wantErr:     require.Error,
+		},
+		{
+			name:        "invalid hex key file",
+			expressions: []string{"decrypt=hex:" + rawKeyFile},
+			wantErr:     require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
+			p, err := ParsePipeline(tt.expressions...)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+
+			var names []string
+			for _, tr := range p {
+				names = append(names, tr.Name())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestNewSource(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "app.img.gz")
+	require.NoError(t, os.WriteFile(input, gzipped(t, []byte("image contents")), 0600))
+
+	tests := []struct {
+		name     string
+		alias    source.Alias
+		wantName string
+	}{
+		{
+			name:     "name from input",
+			wantName: "app.img.gz",
+		},
+		{
+			name:     "name from alias",
+			alias:    source.Alias{Name: "my-app"},
+			wantName: "my-app",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, cleanup, err := Pipeline{Decompress()}.Run(context.Background(), input)
+			require.NoError(t, err)
+
+			fileSrc, err := filesource.New(filesource.Config{Path: result, Alias: tt.alias, DigestAlgorithms: []crypto.Hash{crypto.SHA256}})
+			require.NoError(t, err)
+
+			src := NewSource(fileSrc, input, tt.alias, cleanup)
+			d := src.Describe()
+			assert.Equal(t, tt.wantName, d.Name)
+			require.IsType(t, source.FileMetadata{}, d.Metadata)
+			// the input is described instead of the (decompressed) result of the pipeline
+			inputContents, err := os.ReadFile(input)
+			require.NoError(t, err)
+			assert.Equal(t, source.FileMetadata{
+				Path:     input,
+				Digests:  []file.Digest{{Algorithm: "sha256", Value: fmt.Sprintf("%x", sha256.Sum256(inputContents))}},
+				MIMEType: "application/gzip",
+			}, d.Metadata)
+
+			require.NoError(t, src.Close())
+			assert.NoFileExists(t, result)
+		})
+	}
+}
+
+func TestReadKeyFile(t *testing.T) {
+	// a raw key that happens to be valid hex is not decoded unless explicitly marked as hex encoded
+	raw := []byte("0123456789abcdef0123456789abcdef")
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, raw, 0600))
+
+	key, err := readKeyFile(keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, raw, key)
+
+	key, err = readKeyFile(hexKeyPrefix + keyFile)
+	require.NoError(t, err)
+	assert.Len(t, key, 16)
+}
+
+func TestNewSource_stableID(t *testing.T) {
+	input := filepath.Join(t.TempDir(), "rootfs.tar.gz")
+	require.NoError(t, os.WriteFile(input, gzipped(t, tarball(t, map[string]string{"etc/os-release": "ID=test"})), 0600))
+
+	// the result of unpacking is within a randomly named temporary directory, which must not affect the source ID
+	describe := func(alias source.Alias) source.Description {
+		result, cleanup, err := Pipeline{Decompress(), Unpack()}.Run(context.Background(), input)
+		require.NoError(t, err)
+
+		dirSrc, err := directorysource.New(directorysource.Config{Path: result, Base: result, Alias: alias})
+		require.NoError(t, err)
+
+		src := NewSource(dirSrc, input, alias, cleanup)
+		t.Cleanup(func() { require.NoError(t, src.Close()) })
+		assert.Equal(t, src.ID(), artifact.ID(src.Describe().ID))
+		return src.Describe()
+	}
+
+	first := describe(source.Alias{})
+	second := describe(source.Alias{})
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, input, first.Metadata.(source.DirectoryMetadata).Path)
+
+	aliased := describe(source.Alias{Name: "my-rootfs", Version: "1.0"})
+	assert.NotEqual(t, first.ID, aliased.ID)
+	assert.Equal(t, aliased.ID, describe(source.Alias{Name: "my-rootfs", Version: "1.0"}).ID)
+}
+
+func mustDecrypt(t *testing.T) Transformation {
+	return mustDecryptWith(t, testKey)
+}
+
+func mustDecryptWith(t *testing.T, key []byte) Transformation {
+	d, err := Decrypt(key)
+	require.NoError(t, err)
+	return d
+}
+
+func encrypt(t *testing.T, plaintext []byte) []byte {
+	block, err := aes.NewCipher(testKey)
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	nonce := bytes.Repeat([]byte{0x01}, aead.NonceSize())
+	return aead.Seal(nonce, nonce, plaintext, nil)
+}
+
+func gzipped(t *testing.T, contents []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for name, contents := range files {
+		require.NoError(t, w.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := w.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
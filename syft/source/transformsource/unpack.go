@@ -0,0 +1,94 @@
+package transformsource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mholt/archiver/v3"
+
+	"github.com/anchore/syft/internal"
+	intFile "github.com/anchore/syft/internal/file"
+)
+
+const unpackName = "unpack"
+
+// qcow2Magic is the magic at the start of a QEMU copy-on-write disk image.
+var qcow2Magic = []byte{'Q', 'F', 'I', 0xfb}
+
+type unpack struct{}
+
+// Unpack returns a transformation that unpacks an archive into a directory, where the archive format is detected from
+// the file extension (which allows for compressed archives, e.g. ".tar.gz") or otherwise from the contents of the
+// file (for tar, zip, and rar archives, as well as squashfs and ISO images). Disk images (e.g. qcow2) are not supported.
+// Note that an extraction is always completed, so a cancelled pipeline stops only once the extraction is done.
+func Unpack() Transformation {
+	return unpack{}
+}
+
+func (u unpack) Name() string {
+	return unpackName
+}
+
+func (u unpack) Transform(_ context.Context, input, workDir string) (string, error) {
+	if isQcow2(input) {
+		return "", fmt.Errorf("unable to unpack %q: qcow2 disk images are not supported (convert the image to a supported format first, e.g. a tar of its filesystem)", input)
+	}
+
+	if image := intFile.DetectFilesystemImage(input); image != nil {
+		if err := image.Extract(input, workDir); err != nil {
+			return "", fmt.Errorf("unable to unpack %s image %q: %w", image.Name, input, err)
+		}
+		return workDir, nil
+	}
+
+	unarchiver, err := unarchiverFor(input)
+	if err != nil {
+		return "", err
+	}
+
+	if tar, ok := unarchiver.(*archiver.Tar); ok {
+		// when tar files are extracted, if there are multiple entries at the same location, the last entry wins
+		tar.OverwriteExisting = true
+	}
+
+	if err := unarchiver.Unarchive(input, workDir); err != nil {
+		return "", fmt.Errorf("unable to unpack %q: %w", input, err)
+	}
+	return workDir, nil
+}
+
+func isQcow2(input string) bool {
+	f, err := os.Open(input)
+	if err != nil {
+		return false
+	}
+	defer internal.CloseAndLogError(f, input)
+
+	header := make([]byte, len(qcow2Magic))
+	if _, err := io.ReadFull(f, header); err != nil {
+		return false
+	}
+	return bytes.Equal(header, qcow2Magic)
+}
+
+func unarchiverFor(input string) (archiver.Unarchiver, error) {
+	byExtension, err := archiver.ByExtension(input)
+	if unarchiver, ok := byExtension.(archiver.Unarchiver); err == nil && ok {
+		return unarchiver, nil
+	}
+
+	f, err := os.Open(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %q: %w", input, err)
+	}
+	defer internal.CloseAndLogError(f, input)
+
+	unarchiver, err := archiver.ByHeader(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect the archive format of %q: %w", input, err)
+	}
+	return unarchiver, nil
+}